@@ -0,0 +1,20 @@
+variable "dev_url" {
+  type    = string
+  default = getenv("ATLAS_DEV_URL")
+}
+
+// atlas migrate diff <name> --env local
+env "local" {
+  src = "ent://ent/schema"
+  dev = var.dev_url
+
+  migration {
+    dir = "file://ent/migrate/migrations"
+  }
+
+  // Expression indexes cannot be declared in the ent schema, so they live in
+  // hand-written migrations only and are hidden from the diff.
+  exclude = [
+    "*.entries.entry_search",
+  ]
+}
@@ -10,14 +10,16 @@ import (
 	"time"
 
 	"backend/internal/database"
+	"backend/internal/logging"
 	"backend/internal/server"
 	"backend/internal/server/docs"
+	"backend/internal/tracing"
 
 	"github.com/charmbracelet/lipgloss"
 	log "github.com/charmbracelet/log"
 )
 
-func gracefulShutdown(apiServer *http.Server, db *database.Client, done chan bool) {
+func gracefulShutdown(apiServer *http.Server, db *database.Client, shutdownTracing func(context.Context) error, done chan bool) {
 	// Create context that listens for the interrupt signal from the OS.
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
@@ -36,6 +38,10 @@ func gracefulShutdown(apiServer *http.Server, db *database.Client, done chan boo
 		log.Printf("Server forced to shutdown with error: %v", err)
 	}
 
+	if err := shutdownTracing(ctx); err != nil {
+		log.Warn("failed flushing traces", "err", err)
+	}
+
 	if db != nil {
 		if err := db.Close(); err != nil {
 			log.Warn("failed closing database client", "err", err)
@@ -67,14 +73,25 @@ func main() {
 	title := titleStyle.Render("Eloquia Backend")
 	subtitle := subtitleStyle.Render("A tiny TUI powered by Lip Gloss")
 
-	fmt.Println(title)
-	fmt.Println(subtitle)
-
-	logger := log.NewWithOptions(os.Stdout, log.Options{ReportTimestamp: true, Level: log.DebugLevel})
+	logger, err := logging.New(os.Stdout)
+	if err != nil {
+		log.Fatalf("failed to configure logging: %v", err)
+	}
 	log.SetDefault(logger)
 
+	// Keep JSON output machine-readable.
+	if format, _ := logging.Format(); format == logging.FormatText {
+		fmt.Println(title)
+		fmt.Println(subtitle)
+	}
+
 	ctx := context.Background()
 
+	shutdownTracing, err := tracing.Setup(ctx)
+	if err != nil {
+		log.Fatalf("failed to configure tracing: %v", err)
+	}
+
 	dbClient, err := database.New(ctx, logger)
 	if err != nil {
 		log.Fatalf("failed to connect to database: %v", err)
@@ -83,14 +100,14 @@ func main() {
 	server := server.NewServer(dbClient)
 	// Configure Swagger metadata served at /docs.
 	docs.SwaggerInfo.Host = "localhost:8080"
-	docs.SwaggerInfo.BasePath = "/"
+	docs.SwaggerInfo.BasePath = "/v1"
 	docs.SwaggerInfo.Schemes = []string{"http"}
 
 	// Create a done channel to signal when the shutdown is complete
 	done := make(chan bool, 1)
 
 	// Run graceful shutdown in a separate goroutine
-	go gracefulShutdown(server, dbClient, done)
+	go gracefulShutdown(server, dbClient, shutdownTracing, done)
 
 	err = server.ListenAndServe()
 	if err != nil && err != http.ErrServerClosed {
@@ -12,6 +12,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -22,6 +24,7 @@ type AnalysisJobCreate struct {
 	config
 	mutation *AnalysisJobMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -329,6 +332,7 @@ func (_c *AnalysisJobCreate) createSpec() (*AnalysisJob, *sqlgraph.CreateSpec) {
 		_node = &AnalysisJob{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(analysisjob.Table, sqlgraph.NewFieldSpec(analysisjob.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -431,11 +435,592 @@ func (_c *AnalysisJobCreate) createSpec() (*AnalysisJob, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AnalysisJob.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnalysisJobUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnalysisJobCreate) OnConflict(opts ...sql.ConflictOption) *AnalysisJobUpsertOne {
+	_c.conflict = opts
+	return &AnalysisJobUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AnalysisJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnalysisJobCreate) OnConflictColumns(columns ...string) *AnalysisJobUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnalysisJobUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AnalysisJobUpsertOne is the builder for "upsert"-ing
+	//  one AnalysisJob node.
+	AnalysisJobUpsertOne struct {
+		create *AnalysisJobCreate
+	}
+
+	// AnalysisJobUpsert is the "OnConflict" setter.
+	AnalysisJobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnalysisJobUpsert) SetUpdatedAt(v time.Time) *AnalysisJobUpsert {
+	u.Set(analysisjob.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnalysisJobUpsert) UpdateUpdatedAt() *AnalysisJobUpsert {
+	u.SetExcluded(analysisjob.FieldUpdatedAt)
+	return u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *AnalysisJobUpsert) SetPatientID(v uuid.UUID) *AnalysisJobUpsert {
+	u.Set(analysisjob.FieldPatientID, v)
+	return u
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *AnalysisJobUpsert) UpdatePatientID() *AnalysisJobUpsert {
+	u.SetExcluded(analysisjob.FieldPatientID)
+	return u
+}
+
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (u *AnalysisJobUpsert) SetCreatedByDoctorID(v uuid.UUID) *AnalysisJobUpsert {
+	u.Set(analysisjob.FieldCreatedByDoctorID, v)
+	return u
+}
+
+// UpdateCreatedByDoctorID sets the "created_by_doctor_id" field to the value that was provided on create.
+func (u *AnalysisJobUpsert) UpdateCreatedByDoctorID() *AnalysisJobUpsert {
+	u.SetExcluded(analysisjob.FieldCreatedByDoctorID)
+	return u
+}
+
+// SetObjectKey sets the "object_key" field.
+func (u *AnalysisJobUpsert) SetObjectKey(v string) *AnalysisJobUpsert {
+	u.Set(analysisjob.FieldObjectKey, v)
+	return u
+}
+
+// UpdateObjectKey sets the "object_key" field to the value that was provided on create.
+func (u *AnalysisJobUpsert) UpdateObjectKey() *AnalysisJobUpsert {
+	u.SetExcluded(analysisjob.FieldObjectKey)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *AnalysisJobUpsert) SetKind(v string) *AnalysisJobUpsert {
+	u.Set(analysisjob.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *AnalysisJobUpsert) UpdateKind() *AnalysisJobUpsert {
+	u.SetExcluded(analysisjob.FieldKind)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *AnalysisJobUpsert) SetStatus(v analysisjob.Status) *AnalysisJobUpsert {
+	u.Set(analysisjob.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AnalysisJobUpsert) UpdateStatus() *AnalysisJobUpsert {
+	u.SetExcluded(analysisjob.FieldStatus)
+	return u
+}
+
+// SetProgress sets the "progress" field.
+func (u *AnalysisJobUpsert) SetProgress(v int) *AnalysisJobUpsert {
+	u.Set(analysisjob.FieldProgress, v)
+	return u
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *AnalysisJobUpsert) UpdateProgress() *AnalysisJobUpsert {
+	u.SetExcluded(analysisjob.FieldProgress)
+	return u
+}
+
+// AddProgress adds v to the "progress" field.
+func (u *AnalysisJobUpsert) AddProgress(v int) *AnalysisJobUpsert {
+	u.Add(analysisjob.FieldProgress, v)
+	return u
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (u *AnalysisJobUpsert) ClearProgress() *AnalysisJobUpsert {
+	u.SetNull(analysisjob.FieldProgress)
+	return u
+}
+
+// SetResult sets the "result" field.
+func (u *AnalysisJobUpsert) SetResult(v map[string]interface{}) *AnalysisJobUpsert {
+	u.Set(analysisjob.FieldResult, v)
+	return u
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *AnalysisJobUpsert) UpdateResult() *AnalysisJobUpsert {
+	u.SetExcluded(analysisjob.FieldResult)
+	return u
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *AnalysisJobUpsert) ClearResult() *AnalysisJobUpsert {
+	u.SetNull(analysisjob.FieldResult)
+	return u
+}
+
+// SetMetrics sets the "metrics" field.
+func (u *AnalysisJobUpsert) SetMetrics(v map[string]interface{}) *AnalysisJobUpsert {
+	u.Set(analysisjob.FieldMetrics, v)
+	return u
+}
+
+// UpdateMetrics sets the "metrics" field to the value that was provided on create.
+func (u *AnalysisJobUpsert) UpdateMetrics() *AnalysisJobUpsert {
+	u.SetExcluded(analysisjob.FieldMetrics)
+	return u
+}
+
+// ClearMetrics clears the value of the "metrics" field.
+func (u *AnalysisJobUpsert) ClearMetrics() *AnalysisJobUpsert {
+	u.SetNull(analysisjob.FieldMetrics)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *AnalysisJobUpsert) SetErrorMessage(v string) *AnalysisJobUpsert {
+	u.Set(analysisjob.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *AnalysisJobUpsert) UpdateErrorMessage() *AnalysisJobUpsert {
+	u.SetExcluded(analysisjob.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *AnalysisJobUpsert) ClearErrorMessage() *AnalysisJobUpsert {
+	u.SetNull(analysisjob.FieldErrorMessage)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *AnalysisJobUpsert) SetStartedAt(v time.Time) *AnalysisJobUpsert {
+	u.Set(analysisjob.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *AnalysisJobUpsert) UpdateStartedAt() *AnalysisJobUpsert {
+	u.SetExcluded(analysisjob.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *AnalysisJobUpsert) ClearStartedAt() *AnalysisJobUpsert {
+	u.SetNull(analysisjob.FieldStartedAt)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *AnalysisJobUpsert) SetFinishedAt(v time.Time) *AnalysisJobUpsert {
+	u.Set(analysisjob.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *AnalysisJobUpsert) UpdateFinishedAt() *AnalysisJobUpsert {
+	u.SetExcluded(analysisjob.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *AnalysisJobUpsert) ClearFinishedAt() *AnalysisJobUpsert {
+	u.SetNull(analysisjob.FieldFinishedAt)
+	return u
+}
+
+// SetEntryID sets the "entry_id" field.
+func (u *AnalysisJobUpsert) SetEntryID(v uuid.UUID) *AnalysisJobUpsert {
+	u.Set(analysisjob.FieldEntryID, v)
+	return u
+}
+
+// UpdateEntryID sets the "entry_id" field to the value that was provided on create.
+func (u *AnalysisJobUpsert) UpdateEntryID() *AnalysisJobUpsert {
+	u.SetExcluded(analysisjob.FieldEntryID)
+	return u
+}
+
+// ClearEntryID clears the value of the "entry_id" field.
+func (u *AnalysisJobUpsert) ClearEntryID() *AnalysisJobUpsert {
+	u.SetNull(analysisjob.FieldEntryID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AnalysisJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(analysisjob.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnalysisJobUpsertOne) UpdateNewValues() *AnalysisJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(analysisjob.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(analysisjob.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AnalysisJob.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AnalysisJobUpsertOne) Ignore() *AnalysisJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnalysisJobUpsertOne) DoNothing() *AnalysisJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnalysisJobCreate.OnConflict
+// documentation for more info.
+func (u *AnalysisJobUpsertOne) Update(set func(*AnalysisJobUpsert)) *AnalysisJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnalysisJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnalysisJobUpsertOne) SetUpdatedAt(v time.Time) *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnalysisJobUpsertOne) UpdateUpdatedAt() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *AnalysisJobUpsertOne) SetPatientID(v uuid.UUID) *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *AnalysisJobUpsertOne) UpdatePatientID() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (u *AnalysisJobUpsertOne) SetCreatedByDoctorID(v uuid.UUID) *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetCreatedByDoctorID(v)
+	})
+}
+
+// UpdateCreatedByDoctorID sets the "created_by_doctor_id" field to the value that was provided on create.
+func (u *AnalysisJobUpsertOne) UpdateCreatedByDoctorID() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateCreatedByDoctorID()
+	})
+}
+
+// SetObjectKey sets the "object_key" field.
+func (u *AnalysisJobUpsertOne) SetObjectKey(v string) *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetObjectKey(v)
+	})
+}
+
+// UpdateObjectKey sets the "object_key" field to the value that was provided on create.
+func (u *AnalysisJobUpsertOne) UpdateObjectKey() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateObjectKey()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *AnalysisJobUpsertOne) SetKind(v string) *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *AnalysisJobUpsertOne) UpdateKind() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *AnalysisJobUpsertOne) SetStatus(v analysisjob.Status) *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AnalysisJobUpsertOne) UpdateStatus() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetProgress sets the "progress" field.
+func (u *AnalysisJobUpsertOne) SetProgress(v int) *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetProgress(v)
+	})
+}
+
+// AddProgress adds v to the "progress" field.
+func (u *AnalysisJobUpsertOne) AddProgress(v int) *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.AddProgress(v)
+	})
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *AnalysisJobUpsertOne) UpdateProgress() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateProgress()
+	})
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (u *AnalysisJobUpsertOne) ClearProgress() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.ClearProgress()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *AnalysisJobUpsertOne) SetResult(v map[string]interface{}) *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *AnalysisJobUpsertOne) UpdateResult() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *AnalysisJobUpsertOne) ClearResult() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.ClearResult()
+	})
+}
+
+// SetMetrics sets the "metrics" field.
+func (u *AnalysisJobUpsertOne) SetMetrics(v map[string]interface{}) *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetMetrics(v)
+	})
+}
+
+// UpdateMetrics sets the "metrics" field to the value that was provided on create.
+func (u *AnalysisJobUpsertOne) UpdateMetrics() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateMetrics()
+	})
+}
+
+// ClearMetrics clears the value of the "metrics" field.
+func (u *AnalysisJobUpsertOne) ClearMetrics() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.ClearMetrics()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *AnalysisJobUpsertOne) SetErrorMessage(v string) *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *AnalysisJobUpsertOne) UpdateErrorMessage() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *AnalysisJobUpsertOne) ClearErrorMessage() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *AnalysisJobUpsertOne) SetStartedAt(v time.Time) *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *AnalysisJobUpsertOne) UpdateStartedAt() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *AnalysisJobUpsertOne) ClearStartedAt() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *AnalysisJobUpsertOne) SetFinishedAt(v time.Time) *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *AnalysisJobUpsertOne) UpdateFinishedAt() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *AnalysisJobUpsertOne) ClearFinishedAt() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetEntryID sets the "entry_id" field.
+func (u *AnalysisJobUpsertOne) SetEntryID(v uuid.UUID) *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetEntryID(v)
+	})
+}
+
+// UpdateEntryID sets the "entry_id" field to the value that was provided on create.
+func (u *AnalysisJobUpsertOne) UpdateEntryID() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateEntryID()
+	})
+}
+
+// ClearEntryID clears the value of the "entry_id" field.
+func (u *AnalysisJobUpsertOne) ClearEntryID() *AnalysisJobUpsertOne {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.ClearEntryID()
+	})
+}
+
+// Exec executes the query.
+func (u *AnalysisJobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnalysisJobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnalysisJobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AnalysisJobUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AnalysisJobUpsertOne.ID is not supported by MySQL driver. Use AnalysisJobUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AnalysisJobUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // AnalysisJobCreateBulk is the builder for creating many AnalysisJob entities in bulk.
 type AnalysisJobCreateBulk struct {
 	config
 	err      error
 	builders []*AnalysisJobCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the AnalysisJob entities in the database.
@@ -465,6 +1050,7 @@ func (_c *AnalysisJobCreateBulk) Save(ctx context.Context) ([]*AnalysisJob, erro
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -514,3 +1100,358 @@ func (_c *AnalysisJobCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AnalysisJob.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AnalysisJobUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AnalysisJobCreateBulk) OnConflict(opts ...sql.ConflictOption) *AnalysisJobUpsertBulk {
+	_c.conflict = opts
+	return &AnalysisJobUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AnalysisJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AnalysisJobCreateBulk) OnConflictColumns(columns ...string) *AnalysisJobUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AnalysisJobUpsertBulk{
+		create: _c,
+	}
+}
+
+// AnalysisJobUpsertBulk is the builder for "upsert"-ing
+// a bulk of AnalysisJob nodes.
+type AnalysisJobUpsertBulk struct {
+	create *AnalysisJobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AnalysisJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(analysisjob.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AnalysisJobUpsertBulk) UpdateNewValues() *AnalysisJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(analysisjob.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(analysisjob.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AnalysisJob.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AnalysisJobUpsertBulk) Ignore() *AnalysisJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AnalysisJobUpsertBulk) DoNothing() *AnalysisJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AnalysisJobCreateBulk.OnConflict
+// documentation for more info.
+func (u *AnalysisJobUpsertBulk) Update(set func(*AnalysisJobUpsert)) *AnalysisJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AnalysisJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AnalysisJobUpsertBulk) SetUpdatedAt(v time.Time) *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AnalysisJobUpsertBulk) UpdateUpdatedAt() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *AnalysisJobUpsertBulk) SetPatientID(v uuid.UUID) *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *AnalysisJobUpsertBulk) UpdatePatientID() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (u *AnalysisJobUpsertBulk) SetCreatedByDoctorID(v uuid.UUID) *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetCreatedByDoctorID(v)
+	})
+}
+
+// UpdateCreatedByDoctorID sets the "created_by_doctor_id" field to the value that was provided on create.
+func (u *AnalysisJobUpsertBulk) UpdateCreatedByDoctorID() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateCreatedByDoctorID()
+	})
+}
+
+// SetObjectKey sets the "object_key" field.
+func (u *AnalysisJobUpsertBulk) SetObjectKey(v string) *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetObjectKey(v)
+	})
+}
+
+// UpdateObjectKey sets the "object_key" field to the value that was provided on create.
+func (u *AnalysisJobUpsertBulk) UpdateObjectKey() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateObjectKey()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *AnalysisJobUpsertBulk) SetKind(v string) *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *AnalysisJobUpsertBulk) UpdateKind() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *AnalysisJobUpsertBulk) SetStatus(v analysisjob.Status) *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AnalysisJobUpsertBulk) UpdateStatus() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetProgress sets the "progress" field.
+func (u *AnalysisJobUpsertBulk) SetProgress(v int) *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetProgress(v)
+	})
+}
+
+// AddProgress adds v to the "progress" field.
+func (u *AnalysisJobUpsertBulk) AddProgress(v int) *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.AddProgress(v)
+	})
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *AnalysisJobUpsertBulk) UpdateProgress() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateProgress()
+	})
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (u *AnalysisJobUpsertBulk) ClearProgress() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.ClearProgress()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *AnalysisJobUpsertBulk) SetResult(v map[string]interface{}) *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *AnalysisJobUpsertBulk) UpdateResult() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *AnalysisJobUpsertBulk) ClearResult() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.ClearResult()
+	})
+}
+
+// SetMetrics sets the "metrics" field.
+func (u *AnalysisJobUpsertBulk) SetMetrics(v map[string]interface{}) *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetMetrics(v)
+	})
+}
+
+// UpdateMetrics sets the "metrics" field to the value that was provided on create.
+func (u *AnalysisJobUpsertBulk) UpdateMetrics() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateMetrics()
+	})
+}
+
+// ClearMetrics clears the value of the "metrics" field.
+func (u *AnalysisJobUpsertBulk) ClearMetrics() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.ClearMetrics()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *AnalysisJobUpsertBulk) SetErrorMessage(v string) *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *AnalysisJobUpsertBulk) UpdateErrorMessage() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *AnalysisJobUpsertBulk) ClearErrorMessage() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *AnalysisJobUpsertBulk) SetStartedAt(v time.Time) *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *AnalysisJobUpsertBulk) UpdateStartedAt() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *AnalysisJobUpsertBulk) ClearStartedAt() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *AnalysisJobUpsertBulk) SetFinishedAt(v time.Time) *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *AnalysisJobUpsertBulk) UpdateFinishedAt() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *AnalysisJobUpsertBulk) ClearFinishedAt() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetEntryID sets the "entry_id" field.
+func (u *AnalysisJobUpsertBulk) SetEntryID(v uuid.UUID) *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.SetEntryID(v)
+	})
+}
+
+// UpdateEntryID sets the "entry_id" field to the value that was provided on create.
+func (u *AnalysisJobUpsertBulk) UpdateEntryID() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.UpdateEntryID()
+	})
+}
+
+// ClearEntryID clears the value of the "entry_id" field.
+func (u *AnalysisJobUpsertBulk) ClearEntryID() *AnalysisJobUpsertBulk {
+	return u.Update(func(s *AnalysisJobUpsert) {
+		s.ClearEntryID()
+	})
+}
+
+// Exec executes the query.
+func (u *AnalysisJobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AnalysisJobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AnalysisJobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AnalysisJobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/assignment"
+	"backend/ent/doctor"
+	"backend/ent/patient"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Assignment is the model entity for the Assignment schema.
+type Assignment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// PatientID holds the value of the "patient_id" field.
+	PatientID uuid.UUID `json:"patient_id,omitempty"`
+	// DoctorID holds the value of the "doctor_id" field.
+	DoctorID uuid.UUID `json:"doctor_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Instructions holds the value of the "instructions" field.
+	Instructions *string `json:"instructions,omitempty"`
+	// DueAt holds the value of the "due_at" field.
+	DueAt *time.Time `json:"due_at,omitempty"`
+	// Recurrence holds the value of the "recurrence" field.
+	Recurrence assignment.Recurrence `json:"recurrence,omitempty"`
+	// AttachmentKey holds the value of the "attachment_key" field.
+	AttachmentKey *string `json:"attachment_key,omitempty"`
+	// AttachmentContentType holds the value of the "attachment_content_type" field.
+	AttachmentContentType *string `json:"attachment_content_type,omitempty"`
+	// Status holds the value of the "status" field.
+	Status assignment.Status `json:"status,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AssignmentQuery when eager-loading is set.
+	Edges        AssignmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AssignmentEdges holds the relations/edges for other nodes in the graph.
+type AssignmentEdges struct {
+	// Patient holds the value of the patient edge.
+	Patient *Patient `json:"patient,omitempty"`
+	// Doctor holds the value of the doctor edge.
+	Doctor *Doctor `json:"doctor,omitempty"`
+	// Logs holds the value of the logs edge.
+	Logs []*ExerciseLog `json:"logs,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// PatientOrErr returns the Patient value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AssignmentEdges) PatientOrErr() (*Patient, error) {
+	if e.Patient != nil {
+		return e.Patient, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: patient.Label}
+	}
+	return nil, &NotLoadedError{edge: "patient"}
+}
+
+// DoctorOrErr returns the Doctor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AssignmentEdges) DoctorOrErr() (*Doctor, error) {
+	if e.Doctor != nil {
+		return e.Doctor, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: doctor.Label}
+	}
+	return nil, &NotLoadedError{edge: "doctor"}
+}
+
+// LogsOrErr returns the Logs value or an error if the edge
+// was not loaded in eager-loading.
+func (e AssignmentEdges) LogsOrErr() ([]*ExerciseLog, error) {
+	if e.loadedTypes[2] {
+		return e.Logs, nil
+	}
+	return nil, &NotLoadedError{edge: "logs"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Assignment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case assignment.FieldTitle, assignment.FieldInstructions, assignment.FieldRecurrence, assignment.FieldAttachmentKey, assignment.FieldAttachmentContentType, assignment.FieldStatus:
+			values[i] = new(sql.NullString)
+		case assignment.FieldCreatedAt, assignment.FieldUpdatedAt, assignment.FieldDueAt:
+			values[i] = new(sql.NullTime)
+		case assignment.FieldID, assignment.FieldPatientID, assignment.FieldDoctorID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Assignment fields.
+func (_m *Assignment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case assignment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case assignment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case assignment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case assignment.FieldPatientID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field patient_id", values[i])
+			} else if value != nil {
+				_m.PatientID = *value
+			}
+		case assignment.FieldDoctorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field doctor_id", values[i])
+			} else if value != nil {
+				_m.DoctorID = *value
+			}
+		case assignment.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case assignment.FieldInstructions:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field instructions", values[i])
+			} else if value.Valid {
+				_m.Instructions = new(string)
+				*_m.Instructions = value.String
+			}
+		case assignment.FieldDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_at", values[i])
+			} else if value.Valid {
+				_m.DueAt = new(time.Time)
+				*_m.DueAt = value.Time
+			}
+		case assignment.FieldRecurrence:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence", values[i])
+			} else if value.Valid {
+				_m.Recurrence = assignment.Recurrence(value.String)
+			}
+		case assignment.FieldAttachmentKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_key", values[i])
+			} else if value.Valid {
+				_m.AttachmentKey = new(string)
+				*_m.AttachmentKey = value.String
+			}
+		case assignment.FieldAttachmentContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attachment_content_type", values[i])
+			} else if value.Valid {
+				_m.AttachmentContentType = new(string)
+				*_m.AttachmentContentType = value.String
+			}
+		case assignment.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = assignment.Status(value.String)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Assignment.
+// This includes values selected through modifiers, order, etc.
+func (_m *Assignment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPatient queries the "patient" edge of the Assignment entity.
+func (_m *Assignment) QueryPatient() *PatientQuery {
+	return NewAssignmentClient(_m.config).QueryPatient(_m)
+}
+
+// QueryDoctor queries the "doctor" edge of the Assignment entity.
+func (_m *Assignment) QueryDoctor() *DoctorQuery {
+	return NewAssignmentClient(_m.config).QueryDoctor(_m)
+}
+
+// QueryLogs queries the "logs" edge of the Assignment entity.
+func (_m *Assignment) QueryLogs() *ExerciseLogQuery {
+	return NewAssignmentClient(_m.config).QueryLogs(_m)
+}
+
+// Update returns a builder for updating this Assignment.
+// Note that you need to call Assignment.Unwrap() before calling this method if this Assignment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Assignment) Update() *AssignmentUpdateOne {
+	return NewAssignmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Assignment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Assignment) Unwrap() *Assignment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Assignment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Assignment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Assignment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("patient_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PatientID))
+	builder.WriteString(", ")
+	builder.WriteString("doctor_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DoctorID))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	if v := _m.Instructions; v != nil {
+		builder.WriteString("instructions=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.DueAt; v != nil {
+		builder.WriteString("due_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("recurrence=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Recurrence))
+	builder.WriteString(", ")
+	if v := _m.AttachmentKey; v != nil {
+		builder.WriteString("attachment_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.AttachmentContentType; v != nil {
+		builder.WriteString("attachment_content_type=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Assignments is a parsable slice of Assignment.
+type Assignments []*Assignment
@@ -0,0 +1,274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package assignment
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the assignment type in the database.
+	Label = "assignment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldPatientID holds the string denoting the patient_id field in the database.
+	FieldPatientID = "patient_id"
+	// FieldDoctorID holds the string denoting the doctor_id field in the database.
+	FieldDoctorID = "doctor_id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldInstructions holds the string denoting the instructions field in the database.
+	FieldInstructions = "instructions"
+	// FieldDueAt holds the string denoting the due_at field in the database.
+	FieldDueAt = "due_at"
+	// FieldRecurrence holds the string denoting the recurrence field in the database.
+	FieldRecurrence = "recurrence"
+	// FieldAttachmentKey holds the string denoting the attachment_key field in the database.
+	FieldAttachmentKey = "attachment_key"
+	// FieldAttachmentContentType holds the string denoting the attachment_content_type field in the database.
+	FieldAttachmentContentType = "attachment_content_type"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// EdgePatient holds the string denoting the patient edge name in mutations.
+	EdgePatient = "patient"
+	// EdgeDoctor holds the string denoting the doctor edge name in mutations.
+	EdgeDoctor = "doctor"
+	// EdgeLogs holds the string denoting the logs edge name in mutations.
+	EdgeLogs = "logs"
+	// Table holds the table name of the assignment in the database.
+	Table = "assignments"
+	// PatientTable is the table that holds the patient relation/edge.
+	PatientTable = "assignments"
+	// PatientInverseTable is the table name for the Patient entity.
+	// It exists in this package in order to avoid circular dependency with the "patient" package.
+	PatientInverseTable = "patients"
+	// PatientColumn is the table column denoting the patient relation/edge.
+	PatientColumn = "patient_id"
+	// DoctorTable is the table that holds the doctor relation/edge.
+	DoctorTable = "assignments"
+	// DoctorInverseTable is the table name for the Doctor entity.
+	// It exists in this package in order to avoid circular dependency with the "doctor" package.
+	DoctorInverseTable = "doctors"
+	// DoctorColumn is the table column denoting the doctor relation/edge.
+	DoctorColumn = "doctor_id"
+	// LogsTable is the table that holds the logs relation/edge.
+	LogsTable = "exercise_logs"
+	// LogsInverseTable is the table name for the ExerciseLog entity.
+	// It exists in this package in order to avoid circular dependency with the "exerciselog" package.
+	LogsInverseTable = "exercise_logs"
+	// LogsColumn is the table column denoting the logs relation/edge.
+	LogsColumn = "assignment_id"
+)
+
+// Columns holds all SQL columns for assignment fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldPatientID,
+	FieldDoctorID,
+	FieldTitle,
+	FieldInstructions,
+	FieldDueAt,
+	FieldRecurrence,
+	FieldAttachmentKey,
+	FieldAttachmentContentType,
+	FieldStatus,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Recurrence defines the type for the "recurrence" enum field.
+type Recurrence string
+
+// RecurrenceNone is the default value of the Recurrence enum.
+const DefaultRecurrence = RecurrenceNone
+
+// Recurrence values.
+const (
+	RecurrenceNone   Recurrence = "None"
+	RecurrenceDaily  Recurrence = "Daily"
+	RecurrenceWeekly Recurrence = "Weekly"
+)
+
+func (r Recurrence) String() string {
+	return string(r)
+}
+
+// RecurrenceValidator is a validator for the "recurrence" field enum values. It is called by the builders before save.
+func RecurrenceValidator(r Recurrence) error {
+	switch r {
+	case RecurrenceNone, RecurrenceDaily, RecurrenceWeekly:
+		return nil
+	default:
+		return fmt.Errorf("assignment: invalid enum value for recurrence field: %q", r)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusActive is the default value of the Status enum.
+const DefaultStatus = StatusActive
+
+// Status values.
+const (
+	StatusActive   Status = "Active"
+	StatusArchived Status = "Archived"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusActive, StatusArchived:
+		return nil
+	default:
+		return fmt.Errorf("assignment: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Assignment queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByPatientID orders the results by the patient_id field.
+func ByPatientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPatientID, opts...).ToFunc()
+}
+
+// ByDoctorID orders the results by the doctor_id field.
+func ByDoctorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDoctorID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByInstructions orders the results by the instructions field.
+func ByInstructions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstructions, opts...).ToFunc()
+}
+
+// ByDueAt orders the results by the due_at field.
+func ByDueAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueAt, opts...).ToFunc()
+}
+
+// ByRecurrence orders the results by the recurrence field.
+func ByRecurrence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecurrence, opts...).ToFunc()
+}
+
+// ByAttachmentKey orders the results by the attachment_key field.
+func ByAttachmentKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttachmentKey, opts...).ToFunc()
+}
+
+// ByAttachmentContentType orders the results by the attachment_content_type field.
+func ByAttachmentContentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttachmentContentType, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByPatientField orders the results by patient field.
+func ByPatientField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPatientStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDoctorField orders the results by doctor field.
+func ByDoctorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDoctorStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLogsCount orders the results by logs count.
+func ByLogsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLogsStep(), opts...)
+	}
+}
+
+// ByLogs orders the results by logs terms.
+func ByLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPatientStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PatientInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PatientTable, PatientColumn),
+	)
+}
+func newDoctorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DoctorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DoctorTable, DoctorColumn),
+	)
+}
+func newLogsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LogsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LogsTable, LogsColumn),
+	)
+}
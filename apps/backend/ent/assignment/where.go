@@ -0,0 +1,686 @@
+// Code generated by ent, DO NOT EDIT.
+
+package assignment
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PatientID applies equality check predicate on the "patient_id" field. It's identical to PatientIDEQ.
+func PatientID(v uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldPatientID, v))
+}
+
+// DoctorID applies equality check predicate on the "doctor_id" field. It's identical to DoctorIDEQ.
+func DoctorID(v uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldDoctorID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldTitle, v))
+}
+
+// Instructions applies equality check predicate on the "instructions" field. It's identical to InstructionsEQ.
+func Instructions(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldInstructions, v))
+}
+
+// DueAt applies equality check predicate on the "due_at" field. It's identical to DueAtEQ.
+func DueAt(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldDueAt, v))
+}
+
+// AttachmentKey applies equality check predicate on the "attachment_key" field. It's identical to AttachmentKeyEQ.
+func AttachmentKey(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldAttachmentKey, v))
+}
+
+// AttachmentContentType applies equality check predicate on the "attachment_content_type" field. It's identical to AttachmentContentTypeEQ.
+func AttachmentContentType(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldAttachmentContentType, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// PatientIDEQ applies the EQ predicate on the "patient_id" field.
+func PatientIDEQ(v uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldPatientID, v))
+}
+
+// PatientIDNEQ applies the NEQ predicate on the "patient_id" field.
+func PatientIDNEQ(v uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldPatientID, v))
+}
+
+// PatientIDIn applies the In predicate on the "patient_id" field.
+func PatientIDIn(vs ...uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldPatientID, vs...))
+}
+
+// PatientIDNotIn applies the NotIn predicate on the "patient_id" field.
+func PatientIDNotIn(vs ...uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldPatientID, vs...))
+}
+
+// DoctorIDEQ applies the EQ predicate on the "doctor_id" field.
+func DoctorIDEQ(v uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldDoctorID, v))
+}
+
+// DoctorIDNEQ applies the NEQ predicate on the "doctor_id" field.
+func DoctorIDNEQ(v uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldDoctorID, v))
+}
+
+// DoctorIDIn applies the In predicate on the "doctor_id" field.
+func DoctorIDIn(vs ...uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldDoctorID, vs...))
+}
+
+// DoctorIDNotIn applies the NotIn predicate on the "doctor_id" field.
+func DoctorIDNotIn(vs ...uuid.UUID) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldDoctorID, vs...))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// InstructionsEQ applies the EQ predicate on the "instructions" field.
+func InstructionsEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldInstructions, v))
+}
+
+// InstructionsNEQ applies the NEQ predicate on the "instructions" field.
+func InstructionsNEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldInstructions, v))
+}
+
+// InstructionsIn applies the In predicate on the "instructions" field.
+func InstructionsIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldInstructions, vs...))
+}
+
+// InstructionsNotIn applies the NotIn predicate on the "instructions" field.
+func InstructionsNotIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldInstructions, vs...))
+}
+
+// InstructionsGT applies the GT predicate on the "instructions" field.
+func InstructionsGT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldInstructions, v))
+}
+
+// InstructionsGTE applies the GTE predicate on the "instructions" field.
+func InstructionsGTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldInstructions, v))
+}
+
+// InstructionsLT applies the LT predicate on the "instructions" field.
+func InstructionsLT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldInstructions, v))
+}
+
+// InstructionsLTE applies the LTE predicate on the "instructions" field.
+func InstructionsLTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldInstructions, v))
+}
+
+// InstructionsContains applies the Contains predicate on the "instructions" field.
+func InstructionsContains(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContains(FieldInstructions, v))
+}
+
+// InstructionsHasPrefix applies the HasPrefix predicate on the "instructions" field.
+func InstructionsHasPrefix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasPrefix(FieldInstructions, v))
+}
+
+// InstructionsHasSuffix applies the HasSuffix predicate on the "instructions" field.
+func InstructionsHasSuffix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasSuffix(FieldInstructions, v))
+}
+
+// InstructionsIsNil applies the IsNil predicate on the "instructions" field.
+func InstructionsIsNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldIsNull(FieldInstructions))
+}
+
+// InstructionsNotNil applies the NotNil predicate on the "instructions" field.
+func InstructionsNotNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotNull(FieldInstructions))
+}
+
+// InstructionsEqualFold applies the EqualFold predicate on the "instructions" field.
+func InstructionsEqualFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEqualFold(FieldInstructions, v))
+}
+
+// InstructionsContainsFold applies the ContainsFold predicate on the "instructions" field.
+func InstructionsContainsFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContainsFold(FieldInstructions, v))
+}
+
+// DueAtEQ applies the EQ predicate on the "due_at" field.
+func DueAtEQ(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldDueAt, v))
+}
+
+// DueAtNEQ applies the NEQ predicate on the "due_at" field.
+func DueAtNEQ(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldDueAt, v))
+}
+
+// DueAtIn applies the In predicate on the "due_at" field.
+func DueAtIn(vs ...time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldDueAt, vs...))
+}
+
+// DueAtNotIn applies the NotIn predicate on the "due_at" field.
+func DueAtNotIn(vs ...time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldDueAt, vs...))
+}
+
+// DueAtGT applies the GT predicate on the "due_at" field.
+func DueAtGT(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldDueAt, v))
+}
+
+// DueAtGTE applies the GTE predicate on the "due_at" field.
+func DueAtGTE(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldDueAt, v))
+}
+
+// DueAtLT applies the LT predicate on the "due_at" field.
+func DueAtLT(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldDueAt, v))
+}
+
+// DueAtLTE applies the LTE predicate on the "due_at" field.
+func DueAtLTE(v time.Time) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldDueAt, v))
+}
+
+// DueAtIsNil applies the IsNil predicate on the "due_at" field.
+func DueAtIsNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldIsNull(FieldDueAt))
+}
+
+// DueAtNotNil applies the NotNil predicate on the "due_at" field.
+func DueAtNotNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotNull(FieldDueAt))
+}
+
+// RecurrenceEQ applies the EQ predicate on the "recurrence" field.
+func RecurrenceEQ(v Recurrence) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldRecurrence, v))
+}
+
+// RecurrenceNEQ applies the NEQ predicate on the "recurrence" field.
+func RecurrenceNEQ(v Recurrence) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldRecurrence, v))
+}
+
+// RecurrenceIn applies the In predicate on the "recurrence" field.
+func RecurrenceIn(vs ...Recurrence) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldRecurrence, vs...))
+}
+
+// RecurrenceNotIn applies the NotIn predicate on the "recurrence" field.
+func RecurrenceNotIn(vs ...Recurrence) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldRecurrence, vs...))
+}
+
+// AttachmentKeyEQ applies the EQ predicate on the "attachment_key" field.
+func AttachmentKeyEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldAttachmentKey, v))
+}
+
+// AttachmentKeyNEQ applies the NEQ predicate on the "attachment_key" field.
+func AttachmentKeyNEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldAttachmentKey, v))
+}
+
+// AttachmentKeyIn applies the In predicate on the "attachment_key" field.
+func AttachmentKeyIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldAttachmentKey, vs...))
+}
+
+// AttachmentKeyNotIn applies the NotIn predicate on the "attachment_key" field.
+func AttachmentKeyNotIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldAttachmentKey, vs...))
+}
+
+// AttachmentKeyGT applies the GT predicate on the "attachment_key" field.
+func AttachmentKeyGT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldAttachmentKey, v))
+}
+
+// AttachmentKeyGTE applies the GTE predicate on the "attachment_key" field.
+func AttachmentKeyGTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldAttachmentKey, v))
+}
+
+// AttachmentKeyLT applies the LT predicate on the "attachment_key" field.
+func AttachmentKeyLT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldAttachmentKey, v))
+}
+
+// AttachmentKeyLTE applies the LTE predicate on the "attachment_key" field.
+func AttachmentKeyLTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldAttachmentKey, v))
+}
+
+// AttachmentKeyContains applies the Contains predicate on the "attachment_key" field.
+func AttachmentKeyContains(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContains(FieldAttachmentKey, v))
+}
+
+// AttachmentKeyHasPrefix applies the HasPrefix predicate on the "attachment_key" field.
+func AttachmentKeyHasPrefix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasPrefix(FieldAttachmentKey, v))
+}
+
+// AttachmentKeyHasSuffix applies the HasSuffix predicate on the "attachment_key" field.
+func AttachmentKeyHasSuffix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasSuffix(FieldAttachmentKey, v))
+}
+
+// AttachmentKeyIsNil applies the IsNil predicate on the "attachment_key" field.
+func AttachmentKeyIsNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldIsNull(FieldAttachmentKey))
+}
+
+// AttachmentKeyNotNil applies the NotNil predicate on the "attachment_key" field.
+func AttachmentKeyNotNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotNull(FieldAttachmentKey))
+}
+
+// AttachmentKeyEqualFold applies the EqualFold predicate on the "attachment_key" field.
+func AttachmentKeyEqualFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEqualFold(FieldAttachmentKey, v))
+}
+
+// AttachmentKeyContainsFold applies the ContainsFold predicate on the "attachment_key" field.
+func AttachmentKeyContainsFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContainsFold(FieldAttachmentKey, v))
+}
+
+// AttachmentContentTypeEQ applies the EQ predicate on the "attachment_content_type" field.
+func AttachmentContentTypeEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeNEQ applies the NEQ predicate on the "attachment_content_type" field.
+func AttachmentContentTypeNEQ(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeIn applies the In predicate on the "attachment_content_type" field.
+func AttachmentContentTypeIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldAttachmentContentType, vs...))
+}
+
+// AttachmentContentTypeNotIn applies the NotIn predicate on the "attachment_content_type" field.
+func AttachmentContentTypeNotIn(vs ...string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldAttachmentContentType, vs...))
+}
+
+// AttachmentContentTypeGT applies the GT predicate on the "attachment_content_type" field.
+func AttachmentContentTypeGT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGT(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeGTE applies the GTE predicate on the "attachment_content_type" field.
+func AttachmentContentTypeGTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldGTE(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeLT applies the LT predicate on the "attachment_content_type" field.
+func AttachmentContentTypeLT(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLT(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeLTE applies the LTE predicate on the "attachment_content_type" field.
+func AttachmentContentTypeLTE(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldLTE(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeContains applies the Contains predicate on the "attachment_content_type" field.
+func AttachmentContentTypeContains(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContains(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeHasPrefix applies the HasPrefix predicate on the "attachment_content_type" field.
+func AttachmentContentTypeHasPrefix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasPrefix(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeHasSuffix applies the HasSuffix predicate on the "attachment_content_type" field.
+func AttachmentContentTypeHasSuffix(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldHasSuffix(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeIsNil applies the IsNil predicate on the "attachment_content_type" field.
+func AttachmentContentTypeIsNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldIsNull(FieldAttachmentContentType))
+}
+
+// AttachmentContentTypeNotNil applies the NotNil predicate on the "attachment_content_type" field.
+func AttachmentContentTypeNotNil() predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotNull(FieldAttachmentContentType))
+}
+
+// AttachmentContentTypeEqualFold applies the EqualFold predicate on the "attachment_content_type" field.
+func AttachmentContentTypeEqualFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEqualFold(FieldAttachmentContentType, v))
+}
+
+// AttachmentContentTypeContainsFold applies the ContainsFold predicate on the "attachment_content_type" field.
+func AttachmentContentTypeContainsFold(v string) predicate.Assignment {
+	return predicate.Assignment(sql.FieldContainsFold(FieldAttachmentContentType, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Assignment {
+	return predicate.Assignment(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Assignment {
+	return predicate.Assignment(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Assignment {
+	return predicate.Assignment(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// HasPatient applies the HasEdge predicate on the "patient" edge.
+func HasPatient() predicate.Assignment {
+	return predicate.Assignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PatientTable, PatientColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPatientWith applies the HasEdge predicate on the "patient" edge with a given conditions (other predicates).
+func HasPatientWith(preds ...predicate.Patient) predicate.Assignment {
+	return predicate.Assignment(func(s *sql.Selector) {
+		step := newPatientStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDoctor applies the HasEdge predicate on the "doctor" edge.
+func HasDoctor() predicate.Assignment {
+	return predicate.Assignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DoctorTable, DoctorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDoctorWith applies the HasEdge predicate on the "doctor" edge with a given conditions (other predicates).
+func HasDoctorWith(preds ...predicate.Doctor) predicate.Assignment {
+	return predicate.Assignment(func(s *sql.Selector) {
+		step := newDoctorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLogs applies the HasEdge predicate on the "logs" edge.
+func HasLogs() predicate.Assignment {
+	return predicate.Assignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LogsTable, LogsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLogsWith applies the HasEdge predicate on the "logs" edge with a given conditions (other predicates).
+func HasLogsWith(preds ...predicate.ExerciseLog) predicate.Assignment {
+	return predicate.Assignment(func(s *sql.Selector) {
+		step := newLogsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Assignment) predicate.Assignment {
+	return predicate.Assignment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Assignment) predicate.Assignment {
+	return predicate.Assignment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Assignment) predicate.Assignment {
+	return predicate.Assignment(sql.NotPredicates(p))
+}
@@ -0,0 +1,1244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/assignment"
+	"backend/ent/doctor"
+	"backend/ent/exerciselog"
+	"backend/ent/patient"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AssignmentCreate is the builder for creating a Assignment entity.
+type AssignmentCreate struct {
+	config
+	mutation *AssignmentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AssignmentCreate) SetCreatedAt(v time.Time) *AssignmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AssignmentCreate) SetNillableCreatedAt(v *time.Time) *AssignmentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AssignmentCreate) SetUpdatedAt(v time.Time) *AssignmentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AssignmentCreate) SetNillableUpdatedAt(v *time.Time) *AssignmentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_c *AssignmentCreate) SetPatientID(v uuid.UUID) *AssignmentCreate {
+	_c.mutation.SetPatientID(v)
+	return _c
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (_c *AssignmentCreate) SetDoctorID(v uuid.UUID) *AssignmentCreate {
+	_c.mutation.SetDoctorID(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *AssignmentCreate) SetTitle(v string) *AssignmentCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetInstructions sets the "instructions" field.
+func (_c *AssignmentCreate) SetInstructions(v string) *AssignmentCreate {
+	_c.mutation.SetInstructions(v)
+	return _c
+}
+
+// SetNillableInstructions sets the "instructions" field if the given value is not nil.
+func (_c *AssignmentCreate) SetNillableInstructions(v *string) *AssignmentCreate {
+	if v != nil {
+		_c.SetInstructions(*v)
+	}
+	return _c
+}
+
+// SetDueAt sets the "due_at" field.
+func (_c *AssignmentCreate) SetDueAt(v time.Time) *AssignmentCreate {
+	_c.mutation.SetDueAt(v)
+	return _c
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_c *AssignmentCreate) SetNillableDueAt(v *time.Time) *AssignmentCreate {
+	if v != nil {
+		_c.SetDueAt(*v)
+	}
+	return _c
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (_c *AssignmentCreate) SetRecurrence(v assignment.Recurrence) *AssignmentCreate {
+	_c.mutation.SetRecurrence(v)
+	return _c
+}
+
+// SetNillableRecurrence sets the "recurrence" field if the given value is not nil.
+func (_c *AssignmentCreate) SetNillableRecurrence(v *assignment.Recurrence) *AssignmentCreate {
+	if v != nil {
+		_c.SetRecurrence(*v)
+	}
+	return _c
+}
+
+// SetAttachmentKey sets the "attachment_key" field.
+func (_c *AssignmentCreate) SetAttachmentKey(v string) *AssignmentCreate {
+	_c.mutation.SetAttachmentKey(v)
+	return _c
+}
+
+// SetNillableAttachmentKey sets the "attachment_key" field if the given value is not nil.
+func (_c *AssignmentCreate) SetNillableAttachmentKey(v *string) *AssignmentCreate {
+	if v != nil {
+		_c.SetAttachmentKey(*v)
+	}
+	return _c
+}
+
+// SetAttachmentContentType sets the "attachment_content_type" field.
+func (_c *AssignmentCreate) SetAttachmentContentType(v string) *AssignmentCreate {
+	_c.mutation.SetAttachmentContentType(v)
+	return _c
+}
+
+// SetNillableAttachmentContentType sets the "attachment_content_type" field if the given value is not nil.
+func (_c *AssignmentCreate) SetNillableAttachmentContentType(v *string) *AssignmentCreate {
+	if v != nil {
+		_c.SetAttachmentContentType(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *AssignmentCreate) SetStatus(v assignment.Status) *AssignmentCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *AssignmentCreate) SetNillableStatus(v *assignment.Status) *AssignmentCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AssignmentCreate) SetID(v uuid.UUID) *AssignmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AssignmentCreate) SetNillableID(v *uuid.UUID) *AssignmentCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_c *AssignmentCreate) SetPatient(v *Patient) *AssignmentCreate {
+	return _c.SetPatientID(v.ID)
+}
+
+// SetDoctor sets the "doctor" edge to the Doctor entity.
+func (_c *AssignmentCreate) SetDoctor(v *Doctor) *AssignmentCreate {
+	return _c.SetDoctorID(v.ID)
+}
+
+// AddLogIDs adds the "logs" edge to the ExerciseLog entity by IDs.
+func (_c *AssignmentCreate) AddLogIDs(ids ...uuid.UUID) *AssignmentCreate {
+	_c.mutation.AddLogIDs(ids...)
+	return _c
+}
+
+// AddLogs adds the "logs" edges to the ExerciseLog entity.
+func (_c *AssignmentCreate) AddLogs(v ...*ExerciseLog) *AssignmentCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLogIDs(ids...)
+}
+
+// Mutation returns the AssignmentMutation object of the builder.
+func (_c *AssignmentCreate) Mutation() *AssignmentMutation {
+	return _c.mutation
+}
+
+// Save creates the Assignment in the database.
+func (_c *AssignmentCreate) Save(ctx context.Context) (*Assignment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AssignmentCreate) SaveX(ctx context.Context) *Assignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AssignmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AssignmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AssignmentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := assignment.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := assignment.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Recurrence(); !ok {
+		v := assignment.DefaultRecurrence
+		_c.mutation.SetRecurrence(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := assignment.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := assignment.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AssignmentCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Assignment.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Assignment.updated_at"`)}
+	}
+	if _, ok := _c.mutation.PatientID(); !ok {
+		return &ValidationError{Name: "patient_id", err: errors.New(`ent: missing required field "Assignment.patient_id"`)}
+	}
+	if _, ok := _c.mutation.DoctorID(); !ok {
+		return &ValidationError{Name: "doctor_id", err: errors.New(`ent: missing required field "Assignment.doctor_id"`)}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Assignment.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := assignment.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Assignment.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Recurrence(); !ok {
+		return &ValidationError{Name: "recurrence", err: errors.New(`ent: missing required field "Assignment.recurrence"`)}
+	}
+	if v, ok := _c.mutation.Recurrence(); ok {
+		if err := assignment.RecurrenceValidator(v); err != nil {
+			return &ValidationError{Name: "recurrence", err: fmt.Errorf(`ent: validator failed for field "Assignment.recurrence": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Assignment.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := assignment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Assignment.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.PatientIDs()) == 0 {
+		return &ValidationError{Name: "patient", err: errors.New(`ent: missing required edge "Assignment.patient"`)}
+	}
+	if len(_c.mutation.DoctorIDs()) == 0 {
+		return &ValidationError{Name: "doctor", err: errors.New(`ent: missing required edge "Assignment.doctor"`)}
+	}
+	return nil
+}
+
+func (_c *AssignmentCreate) sqlSave(ctx context.Context) (*Assignment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AssignmentCreate) createSpec() (*Assignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Assignment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(assignment.Table, sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(assignment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(assignment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(assignment.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Instructions(); ok {
+		_spec.SetField(assignment.FieldInstructions, field.TypeString, value)
+		_node.Instructions = &value
+	}
+	if value, ok := _c.mutation.DueAt(); ok {
+		_spec.SetField(assignment.FieldDueAt, field.TypeTime, value)
+		_node.DueAt = &value
+	}
+	if value, ok := _c.mutation.Recurrence(); ok {
+		_spec.SetField(assignment.FieldRecurrence, field.TypeEnum, value)
+		_node.Recurrence = value
+	}
+	if value, ok := _c.mutation.AttachmentKey(); ok {
+		_spec.SetField(assignment.FieldAttachmentKey, field.TypeString, value)
+		_node.AttachmentKey = &value
+	}
+	if value, ok := _c.mutation.AttachmentContentType(); ok {
+		_spec.SetField(assignment.FieldAttachmentContentType, field.TypeString, value)
+		_node.AttachmentContentType = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(assignment.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if nodes := _c.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   assignment.PatientTable,
+			Columns: []string{assignment.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PatientID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   assignment.DoctorTable,
+			Columns: []string{assignment.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DoctorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   assignment.LogsTable,
+			Columns: []string{assignment.LogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exerciselog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Assignment.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AssignmentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AssignmentCreate) OnConflict(opts ...sql.ConflictOption) *AssignmentUpsertOne {
+	_c.conflict = opts
+	return &AssignmentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Assignment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AssignmentCreate) OnConflictColumns(columns ...string) *AssignmentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AssignmentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// AssignmentUpsertOne is the builder for "upsert"-ing
+	//  one Assignment node.
+	AssignmentUpsertOne struct {
+		create *AssignmentCreate
+	}
+
+	// AssignmentUpsert is the "OnConflict" setter.
+	AssignmentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AssignmentUpsert) SetUpdatedAt(v time.Time) *AssignmentUpsert {
+	u.Set(assignment.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateUpdatedAt() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldUpdatedAt)
+	return u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *AssignmentUpsert) SetPatientID(v uuid.UUID) *AssignmentUpsert {
+	u.Set(assignment.FieldPatientID, v)
+	return u
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdatePatientID() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldPatientID)
+	return u
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *AssignmentUpsert) SetDoctorID(v uuid.UUID) *AssignmentUpsert {
+	u.Set(assignment.FieldDoctorID, v)
+	return u
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateDoctorID() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldDoctorID)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *AssignmentUpsert) SetTitle(v string) *AssignmentUpsert {
+	u.Set(assignment.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateTitle() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldTitle)
+	return u
+}
+
+// SetInstructions sets the "instructions" field.
+func (u *AssignmentUpsert) SetInstructions(v string) *AssignmentUpsert {
+	u.Set(assignment.FieldInstructions, v)
+	return u
+}
+
+// UpdateInstructions sets the "instructions" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateInstructions() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldInstructions)
+	return u
+}
+
+// ClearInstructions clears the value of the "instructions" field.
+func (u *AssignmentUpsert) ClearInstructions() *AssignmentUpsert {
+	u.SetNull(assignment.FieldInstructions)
+	return u
+}
+
+// SetDueAt sets the "due_at" field.
+func (u *AssignmentUpsert) SetDueAt(v time.Time) *AssignmentUpsert {
+	u.Set(assignment.FieldDueAt, v)
+	return u
+}
+
+// UpdateDueAt sets the "due_at" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateDueAt() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldDueAt)
+	return u
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (u *AssignmentUpsert) ClearDueAt() *AssignmentUpsert {
+	u.SetNull(assignment.FieldDueAt)
+	return u
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (u *AssignmentUpsert) SetRecurrence(v assignment.Recurrence) *AssignmentUpsert {
+	u.Set(assignment.FieldRecurrence, v)
+	return u
+}
+
+// UpdateRecurrence sets the "recurrence" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateRecurrence() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldRecurrence)
+	return u
+}
+
+// SetAttachmentKey sets the "attachment_key" field.
+func (u *AssignmentUpsert) SetAttachmentKey(v string) *AssignmentUpsert {
+	u.Set(assignment.FieldAttachmentKey, v)
+	return u
+}
+
+// UpdateAttachmentKey sets the "attachment_key" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateAttachmentKey() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldAttachmentKey)
+	return u
+}
+
+// ClearAttachmentKey clears the value of the "attachment_key" field.
+func (u *AssignmentUpsert) ClearAttachmentKey() *AssignmentUpsert {
+	u.SetNull(assignment.FieldAttachmentKey)
+	return u
+}
+
+// SetAttachmentContentType sets the "attachment_content_type" field.
+func (u *AssignmentUpsert) SetAttachmentContentType(v string) *AssignmentUpsert {
+	u.Set(assignment.FieldAttachmentContentType, v)
+	return u
+}
+
+// UpdateAttachmentContentType sets the "attachment_content_type" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateAttachmentContentType() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldAttachmentContentType)
+	return u
+}
+
+// ClearAttachmentContentType clears the value of the "attachment_content_type" field.
+func (u *AssignmentUpsert) ClearAttachmentContentType() *AssignmentUpsert {
+	u.SetNull(assignment.FieldAttachmentContentType)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *AssignmentUpsert) SetStatus(v assignment.Status) *AssignmentUpsert {
+	u.Set(assignment.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AssignmentUpsert) UpdateStatus() *AssignmentUpsert {
+	u.SetExcluded(assignment.FieldStatus)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Assignment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(assignment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AssignmentUpsertOne) UpdateNewValues() *AssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(assignment.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(assignment.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Assignment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AssignmentUpsertOne) Ignore() *AssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AssignmentUpsertOne) DoNothing() *AssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AssignmentCreate.OnConflict
+// documentation for more info.
+func (u *AssignmentUpsertOne) Update(set func(*AssignmentUpsert)) *AssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AssignmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AssignmentUpsertOne) SetUpdatedAt(v time.Time) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateUpdatedAt() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *AssignmentUpsertOne) SetPatientID(v uuid.UUID) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdatePatientID() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *AssignmentUpsertOne) SetDoctorID(v uuid.UUID) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetDoctorID(v)
+	})
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateDoctorID() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateDoctorID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *AssignmentUpsertOne) SetTitle(v string) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateTitle() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetInstructions sets the "instructions" field.
+func (u *AssignmentUpsertOne) SetInstructions(v string) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetInstructions(v)
+	})
+}
+
+// UpdateInstructions sets the "instructions" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateInstructions() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateInstructions()
+	})
+}
+
+// ClearInstructions clears the value of the "instructions" field.
+func (u *AssignmentUpsertOne) ClearInstructions() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.ClearInstructions()
+	})
+}
+
+// SetDueAt sets the "due_at" field.
+func (u *AssignmentUpsertOne) SetDueAt(v time.Time) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetDueAt(v)
+	})
+}
+
+// UpdateDueAt sets the "due_at" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateDueAt() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateDueAt()
+	})
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (u *AssignmentUpsertOne) ClearDueAt() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.ClearDueAt()
+	})
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (u *AssignmentUpsertOne) SetRecurrence(v assignment.Recurrence) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetRecurrence(v)
+	})
+}
+
+// UpdateRecurrence sets the "recurrence" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateRecurrence() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateRecurrence()
+	})
+}
+
+// SetAttachmentKey sets the "attachment_key" field.
+func (u *AssignmentUpsertOne) SetAttachmentKey(v string) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetAttachmentKey(v)
+	})
+}
+
+// UpdateAttachmentKey sets the "attachment_key" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateAttachmentKey() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateAttachmentKey()
+	})
+}
+
+// ClearAttachmentKey clears the value of the "attachment_key" field.
+func (u *AssignmentUpsertOne) ClearAttachmentKey() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.ClearAttachmentKey()
+	})
+}
+
+// SetAttachmentContentType sets the "attachment_content_type" field.
+func (u *AssignmentUpsertOne) SetAttachmentContentType(v string) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetAttachmentContentType(v)
+	})
+}
+
+// UpdateAttachmentContentType sets the "attachment_content_type" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateAttachmentContentType() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateAttachmentContentType()
+	})
+}
+
+// ClearAttachmentContentType clears the value of the "attachment_content_type" field.
+func (u *AssignmentUpsertOne) ClearAttachmentContentType() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.ClearAttachmentContentType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *AssignmentUpsertOne) SetStatus(v assignment.Status) *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AssignmentUpsertOne) UpdateStatus() *AssignmentUpsertOne {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *AssignmentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AssignmentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AssignmentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AssignmentUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AssignmentUpsertOne.ID is not supported by MySQL driver. Use AssignmentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AssignmentUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AssignmentCreateBulk is the builder for creating many Assignment entities in bulk.
+type AssignmentCreateBulk struct {
+	config
+	err      error
+	builders []*AssignmentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Assignment entities in the database.
+func (_c *AssignmentCreateBulk) Save(ctx context.Context) ([]*Assignment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Assignment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AssignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AssignmentCreateBulk) SaveX(ctx context.Context) []*Assignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AssignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AssignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Assignment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AssignmentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *AssignmentCreateBulk) OnConflict(opts ...sql.ConflictOption) *AssignmentUpsertBulk {
+	_c.conflict = opts
+	return &AssignmentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Assignment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *AssignmentCreateBulk) OnConflictColumns(columns ...string) *AssignmentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &AssignmentUpsertBulk{
+		create: _c,
+	}
+}
+
+// AssignmentUpsertBulk is the builder for "upsert"-ing
+// a bulk of Assignment nodes.
+type AssignmentUpsertBulk struct {
+	create *AssignmentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Assignment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(assignment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AssignmentUpsertBulk) UpdateNewValues() *AssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(assignment.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(assignment.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Assignment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AssignmentUpsertBulk) Ignore() *AssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AssignmentUpsertBulk) DoNothing() *AssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AssignmentCreateBulk.OnConflict
+// documentation for more info.
+func (u *AssignmentUpsertBulk) Update(set func(*AssignmentUpsert)) *AssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AssignmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AssignmentUpsertBulk) SetUpdatedAt(v time.Time) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateUpdatedAt() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *AssignmentUpsertBulk) SetPatientID(v uuid.UUID) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdatePatientID() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *AssignmentUpsertBulk) SetDoctorID(v uuid.UUID) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetDoctorID(v)
+	})
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateDoctorID() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateDoctorID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *AssignmentUpsertBulk) SetTitle(v string) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateTitle() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetInstructions sets the "instructions" field.
+func (u *AssignmentUpsertBulk) SetInstructions(v string) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetInstructions(v)
+	})
+}
+
+// UpdateInstructions sets the "instructions" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateInstructions() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateInstructions()
+	})
+}
+
+// ClearInstructions clears the value of the "instructions" field.
+func (u *AssignmentUpsertBulk) ClearInstructions() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.ClearInstructions()
+	})
+}
+
+// SetDueAt sets the "due_at" field.
+func (u *AssignmentUpsertBulk) SetDueAt(v time.Time) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetDueAt(v)
+	})
+}
+
+// UpdateDueAt sets the "due_at" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateDueAt() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateDueAt()
+	})
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (u *AssignmentUpsertBulk) ClearDueAt() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.ClearDueAt()
+	})
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (u *AssignmentUpsertBulk) SetRecurrence(v assignment.Recurrence) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetRecurrence(v)
+	})
+}
+
+// UpdateRecurrence sets the "recurrence" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateRecurrence() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateRecurrence()
+	})
+}
+
+// SetAttachmentKey sets the "attachment_key" field.
+func (u *AssignmentUpsertBulk) SetAttachmentKey(v string) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetAttachmentKey(v)
+	})
+}
+
+// UpdateAttachmentKey sets the "attachment_key" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateAttachmentKey() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateAttachmentKey()
+	})
+}
+
+// ClearAttachmentKey clears the value of the "attachment_key" field.
+func (u *AssignmentUpsertBulk) ClearAttachmentKey() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.ClearAttachmentKey()
+	})
+}
+
+// SetAttachmentContentType sets the "attachment_content_type" field.
+func (u *AssignmentUpsertBulk) SetAttachmentContentType(v string) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetAttachmentContentType(v)
+	})
+}
+
+// UpdateAttachmentContentType sets the "attachment_content_type" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateAttachmentContentType() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateAttachmentContentType()
+	})
+}
+
+// ClearAttachmentContentType clears the value of the "attachment_content_type" field.
+func (u *AssignmentUpsertBulk) ClearAttachmentContentType() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.ClearAttachmentContentType()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *AssignmentUpsertBulk) SetStatus(v assignment.Status) *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *AssignmentUpsertBulk) UpdateStatus() *AssignmentUpsertBulk {
+	return u.Update(func(s *AssignmentUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *AssignmentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AssignmentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AssignmentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AssignmentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,962 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/assignment"
+	"backend/ent/doctor"
+	"backend/ent/exerciselog"
+	"backend/ent/patient"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AssignmentUpdate is the builder for updating Assignment entities.
+type AssignmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AssignmentMutation
+}
+
+// Where appends a list predicates to the AssignmentUpdate builder.
+func (_u *AssignmentUpdate) Where(ps ...predicate.Assignment) *AssignmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AssignmentUpdate) SetUpdatedAt(v time.Time) *AssignmentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *AssignmentUpdate) SetPatientID(v uuid.UUID) *AssignmentUpdate {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *AssignmentUpdate) SetNillablePatientID(v *uuid.UUID) *AssignmentUpdate {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (_u *AssignmentUpdate) SetDoctorID(v uuid.UUID) *AssignmentUpdate {
+	_u.mutation.SetDoctorID(v)
+	return _u
+}
+
+// SetNillableDoctorID sets the "doctor_id" field if the given value is not nil.
+func (_u *AssignmentUpdate) SetNillableDoctorID(v *uuid.UUID) *AssignmentUpdate {
+	if v != nil {
+		_u.SetDoctorID(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *AssignmentUpdate) SetTitle(v string) *AssignmentUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *AssignmentUpdate) SetNillableTitle(v *string) *AssignmentUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetInstructions sets the "instructions" field.
+func (_u *AssignmentUpdate) SetInstructions(v string) *AssignmentUpdate {
+	_u.mutation.SetInstructions(v)
+	return _u
+}
+
+// SetNillableInstructions sets the "instructions" field if the given value is not nil.
+func (_u *AssignmentUpdate) SetNillableInstructions(v *string) *AssignmentUpdate {
+	if v != nil {
+		_u.SetInstructions(*v)
+	}
+	return _u
+}
+
+// ClearInstructions clears the value of the "instructions" field.
+func (_u *AssignmentUpdate) ClearInstructions() *AssignmentUpdate {
+	_u.mutation.ClearInstructions()
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *AssignmentUpdate) SetDueAt(v time.Time) *AssignmentUpdate {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *AssignmentUpdate) SetNillableDueAt(v *time.Time) *AssignmentUpdate {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (_u *AssignmentUpdate) ClearDueAt() *AssignmentUpdate {
+	_u.mutation.ClearDueAt()
+	return _u
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (_u *AssignmentUpdate) SetRecurrence(v assignment.Recurrence) *AssignmentUpdate {
+	_u.mutation.SetRecurrence(v)
+	return _u
+}
+
+// SetNillableRecurrence sets the "recurrence" field if the given value is not nil.
+func (_u *AssignmentUpdate) SetNillableRecurrence(v *assignment.Recurrence) *AssignmentUpdate {
+	if v != nil {
+		_u.SetRecurrence(*v)
+	}
+	return _u
+}
+
+// SetAttachmentKey sets the "attachment_key" field.
+func (_u *AssignmentUpdate) SetAttachmentKey(v string) *AssignmentUpdate {
+	_u.mutation.SetAttachmentKey(v)
+	return _u
+}
+
+// SetNillableAttachmentKey sets the "attachment_key" field if the given value is not nil.
+func (_u *AssignmentUpdate) SetNillableAttachmentKey(v *string) *AssignmentUpdate {
+	if v != nil {
+		_u.SetAttachmentKey(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentKey clears the value of the "attachment_key" field.
+func (_u *AssignmentUpdate) ClearAttachmentKey() *AssignmentUpdate {
+	_u.mutation.ClearAttachmentKey()
+	return _u
+}
+
+// SetAttachmentContentType sets the "attachment_content_type" field.
+func (_u *AssignmentUpdate) SetAttachmentContentType(v string) *AssignmentUpdate {
+	_u.mutation.SetAttachmentContentType(v)
+	return _u
+}
+
+// SetNillableAttachmentContentType sets the "attachment_content_type" field if the given value is not nil.
+func (_u *AssignmentUpdate) SetNillableAttachmentContentType(v *string) *AssignmentUpdate {
+	if v != nil {
+		_u.SetAttachmentContentType(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentContentType clears the value of the "attachment_content_type" field.
+func (_u *AssignmentUpdate) ClearAttachmentContentType() *AssignmentUpdate {
+	_u.mutation.ClearAttachmentContentType()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AssignmentUpdate) SetStatus(v assignment.Status) *AssignmentUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AssignmentUpdate) SetNillableStatus(v *assignment.Status) *AssignmentUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_u *AssignmentUpdate) SetPatient(v *Patient) *AssignmentUpdate {
+	return _u.SetPatientID(v.ID)
+}
+
+// SetDoctor sets the "doctor" edge to the Doctor entity.
+func (_u *AssignmentUpdate) SetDoctor(v *Doctor) *AssignmentUpdate {
+	return _u.SetDoctorID(v.ID)
+}
+
+// AddLogIDs adds the "logs" edge to the ExerciseLog entity by IDs.
+func (_u *AssignmentUpdate) AddLogIDs(ids ...uuid.UUID) *AssignmentUpdate {
+	_u.mutation.AddLogIDs(ids...)
+	return _u
+}
+
+// AddLogs adds the "logs" edges to the ExerciseLog entity.
+func (_u *AssignmentUpdate) AddLogs(v ...*ExerciseLog) *AssignmentUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLogIDs(ids...)
+}
+
+// Mutation returns the AssignmentMutation object of the builder.
+func (_u *AssignmentUpdate) Mutation() *AssignmentMutation {
+	return _u.mutation
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (_u *AssignmentUpdate) ClearPatient() *AssignmentUpdate {
+	_u.mutation.ClearPatient()
+	return _u
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (_u *AssignmentUpdate) ClearDoctor() *AssignmentUpdate {
+	_u.mutation.ClearDoctor()
+	return _u
+}
+
+// ClearLogs clears all "logs" edges to the ExerciseLog entity.
+func (_u *AssignmentUpdate) ClearLogs() *AssignmentUpdate {
+	_u.mutation.ClearLogs()
+	return _u
+}
+
+// RemoveLogIDs removes the "logs" edge to ExerciseLog entities by IDs.
+func (_u *AssignmentUpdate) RemoveLogIDs(ids ...uuid.UUID) *AssignmentUpdate {
+	_u.mutation.RemoveLogIDs(ids...)
+	return _u
+}
+
+// RemoveLogs removes "logs" edges to ExerciseLog entities.
+func (_u *AssignmentUpdate) RemoveLogs(v ...*ExerciseLog) *AssignmentUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLogIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AssignmentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AssignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AssignmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AssignmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AssignmentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := assignment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AssignmentUpdate) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := assignment.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Assignment.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Recurrence(); ok {
+		if err := assignment.RecurrenceValidator(v); err != nil {
+			return &ValidationError{Name: "recurrence", err: fmt.Errorf(`ent: validator failed for field "Assignment.recurrence": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := assignment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Assignment.status": %w`, err)}
+		}
+	}
+	if _u.mutation.PatientCleared() && len(_u.mutation.PatientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Assignment.patient"`)
+	}
+	if _u.mutation.DoctorCleared() && len(_u.mutation.DoctorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Assignment.doctor"`)
+	}
+	return nil
+}
+
+func (_u *AssignmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(assignment.Table, assignment.Columns, sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(assignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(assignment.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Instructions(); ok {
+		_spec.SetField(assignment.FieldInstructions, field.TypeString, value)
+	}
+	if _u.mutation.InstructionsCleared() {
+		_spec.ClearField(assignment.FieldInstructions, field.TypeString)
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(assignment.FieldDueAt, field.TypeTime, value)
+	}
+	if _u.mutation.DueAtCleared() {
+		_spec.ClearField(assignment.FieldDueAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Recurrence(); ok {
+		_spec.SetField(assignment.FieldRecurrence, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AttachmentKey(); ok {
+		_spec.SetField(assignment.FieldAttachmentKey, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentKeyCleared() {
+		_spec.ClearField(assignment.FieldAttachmentKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentContentType(); ok {
+		_spec.SetField(assignment.FieldAttachmentContentType, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentContentTypeCleared() {
+		_spec.ClearField(assignment.FieldAttachmentContentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(assignment.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.PatientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   assignment.PatientTable,
+			Columns: []string{assignment.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   assignment.PatientTable,
+			Columns: []string{assignment.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DoctorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   assignment.DoctorTable,
+			Columns: []string{assignment.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   assignment.DoctorTable,
+			Columns: []string{assignment.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   assignment.LogsTable,
+			Columns: []string{assignment.LogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exerciselog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLogsIDs(); len(nodes) > 0 && !_u.mutation.LogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   assignment.LogsTable,
+			Columns: []string{assignment.LogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exerciselog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   assignment.LogsTable,
+			Columns: []string{assignment.LogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exerciselog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{assignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AssignmentUpdateOne is the builder for updating a single Assignment entity.
+type AssignmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AssignmentMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AssignmentUpdateOne) SetUpdatedAt(v time.Time) *AssignmentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *AssignmentUpdateOne) SetPatientID(v uuid.UUID) *AssignmentUpdateOne {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *AssignmentUpdateOne) SetNillablePatientID(v *uuid.UUID) *AssignmentUpdateOne {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (_u *AssignmentUpdateOne) SetDoctorID(v uuid.UUID) *AssignmentUpdateOne {
+	_u.mutation.SetDoctorID(v)
+	return _u
+}
+
+// SetNillableDoctorID sets the "doctor_id" field if the given value is not nil.
+func (_u *AssignmentUpdateOne) SetNillableDoctorID(v *uuid.UUID) *AssignmentUpdateOne {
+	if v != nil {
+		_u.SetDoctorID(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *AssignmentUpdateOne) SetTitle(v string) *AssignmentUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *AssignmentUpdateOne) SetNillableTitle(v *string) *AssignmentUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetInstructions sets the "instructions" field.
+func (_u *AssignmentUpdateOne) SetInstructions(v string) *AssignmentUpdateOne {
+	_u.mutation.SetInstructions(v)
+	return _u
+}
+
+// SetNillableInstructions sets the "instructions" field if the given value is not nil.
+func (_u *AssignmentUpdateOne) SetNillableInstructions(v *string) *AssignmentUpdateOne {
+	if v != nil {
+		_u.SetInstructions(*v)
+	}
+	return _u
+}
+
+// ClearInstructions clears the value of the "instructions" field.
+func (_u *AssignmentUpdateOne) ClearInstructions() *AssignmentUpdateOne {
+	_u.mutation.ClearInstructions()
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *AssignmentUpdateOne) SetDueAt(v time.Time) *AssignmentUpdateOne {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *AssignmentUpdateOne) SetNillableDueAt(v *time.Time) *AssignmentUpdateOne {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (_u *AssignmentUpdateOne) ClearDueAt() *AssignmentUpdateOne {
+	_u.mutation.ClearDueAt()
+	return _u
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (_u *AssignmentUpdateOne) SetRecurrence(v assignment.Recurrence) *AssignmentUpdateOne {
+	_u.mutation.SetRecurrence(v)
+	return _u
+}
+
+// SetNillableRecurrence sets the "recurrence" field if the given value is not nil.
+func (_u *AssignmentUpdateOne) SetNillableRecurrence(v *assignment.Recurrence) *AssignmentUpdateOne {
+	if v != nil {
+		_u.SetRecurrence(*v)
+	}
+	return _u
+}
+
+// SetAttachmentKey sets the "attachment_key" field.
+func (_u *AssignmentUpdateOne) SetAttachmentKey(v string) *AssignmentUpdateOne {
+	_u.mutation.SetAttachmentKey(v)
+	return _u
+}
+
+// SetNillableAttachmentKey sets the "attachment_key" field if the given value is not nil.
+func (_u *AssignmentUpdateOne) SetNillableAttachmentKey(v *string) *AssignmentUpdateOne {
+	if v != nil {
+		_u.SetAttachmentKey(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentKey clears the value of the "attachment_key" field.
+func (_u *AssignmentUpdateOne) ClearAttachmentKey() *AssignmentUpdateOne {
+	_u.mutation.ClearAttachmentKey()
+	return _u
+}
+
+// SetAttachmentContentType sets the "attachment_content_type" field.
+func (_u *AssignmentUpdateOne) SetAttachmentContentType(v string) *AssignmentUpdateOne {
+	_u.mutation.SetAttachmentContentType(v)
+	return _u
+}
+
+// SetNillableAttachmentContentType sets the "attachment_content_type" field if the given value is not nil.
+func (_u *AssignmentUpdateOne) SetNillableAttachmentContentType(v *string) *AssignmentUpdateOne {
+	if v != nil {
+		_u.SetAttachmentContentType(*v)
+	}
+	return _u
+}
+
+// ClearAttachmentContentType clears the value of the "attachment_content_type" field.
+func (_u *AssignmentUpdateOne) ClearAttachmentContentType() *AssignmentUpdateOne {
+	_u.mutation.ClearAttachmentContentType()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *AssignmentUpdateOne) SetStatus(v assignment.Status) *AssignmentUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *AssignmentUpdateOne) SetNillableStatus(v *assignment.Status) *AssignmentUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_u *AssignmentUpdateOne) SetPatient(v *Patient) *AssignmentUpdateOne {
+	return _u.SetPatientID(v.ID)
+}
+
+// SetDoctor sets the "doctor" edge to the Doctor entity.
+func (_u *AssignmentUpdateOne) SetDoctor(v *Doctor) *AssignmentUpdateOne {
+	return _u.SetDoctorID(v.ID)
+}
+
+// AddLogIDs adds the "logs" edge to the ExerciseLog entity by IDs.
+func (_u *AssignmentUpdateOne) AddLogIDs(ids ...uuid.UUID) *AssignmentUpdateOne {
+	_u.mutation.AddLogIDs(ids...)
+	return _u
+}
+
+// AddLogs adds the "logs" edges to the ExerciseLog entity.
+func (_u *AssignmentUpdateOne) AddLogs(v ...*ExerciseLog) *AssignmentUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLogIDs(ids...)
+}
+
+// Mutation returns the AssignmentMutation object of the builder.
+func (_u *AssignmentUpdateOne) Mutation() *AssignmentMutation {
+	return _u.mutation
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (_u *AssignmentUpdateOne) ClearPatient() *AssignmentUpdateOne {
+	_u.mutation.ClearPatient()
+	return _u
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (_u *AssignmentUpdateOne) ClearDoctor() *AssignmentUpdateOne {
+	_u.mutation.ClearDoctor()
+	return _u
+}
+
+// ClearLogs clears all "logs" edges to the ExerciseLog entity.
+func (_u *AssignmentUpdateOne) ClearLogs() *AssignmentUpdateOne {
+	_u.mutation.ClearLogs()
+	return _u
+}
+
+// RemoveLogIDs removes the "logs" edge to ExerciseLog entities by IDs.
+func (_u *AssignmentUpdateOne) RemoveLogIDs(ids ...uuid.UUID) *AssignmentUpdateOne {
+	_u.mutation.RemoveLogIDs(ids...)
+	return _u
+}
+
+// RemoveLogs removes "logs" edges to ExerciseLog entities.
+func (_u *AssignmentUpdateOne) RemoveLogs(v ...*ExerciseLog) *AssignmentUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLogIDs(ids...)
+}
+
+// Where appends a list predicates to the AssignmentUpdate builder.
+func (_u *AssignmentUpdateOne) Where(ps ...predicate.Assignment) *AssignmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AssignmentUpdateOne) Select(field string, fields ...string) *AssignmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Assignment entity.
+func (_u *AssignmentUpdateOne) Save(ctx context.Context) (*Assignment, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AssignmentUpdateOne) SaveX(ctx context.Context) *Assignment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AssignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AssignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AssignmentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := assignment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AssignmentUpdateOne) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := assignment.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Assignment.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Recurrence(); ok {
+		if err := assignment.RecurrenceValidator(v); err != nil {
+			return &ValidationError{Name: "recurrence", err: fmt.Errorf(`ent: validator failed for field "Assignment.recurrence": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := assignment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Assignment.status": %w`, err)}
+		}
+	}
+	if _u.mutation.PatientCleared() && len(_u.mutation.PatientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Assignment.patient"`)
+	}
+	if _u.mutation.DoctorCleared() && len(_u.mutation.DoctorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Assignment.doctor"`)
+	}
+	return nil
+}
+
+func (_u *AssignmentUpdateOne) sqlSave(ctx context.Context) (_node *Assignment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(assignment.Table, assignment.Columns, sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Assignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, assignment.FieldID)
+		for _, f := range fields {
+			if !assignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != assignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(assignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(assignment.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Instructions(); ok {
+		_spec.SetField(assignment.FieldInstructions, field.TypeString, value)
+	}
+	if _u.mutation.InstructionsCleared() {
+		_spec.ClearField(assignment.FieldInstructions, field.TypeString)
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(assignment.FieldDueAt, field.TypeTime, value)
+	}
+	if _u.mutation.DueAtCleared() {
+		_spec.ClearField(assignment.FieldDueAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Recurrence(); ok {
+		_spec.SetField(assignment.FieldRecurrence, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AttachmentKey(); ok {
+		_spec.SetField(assignment.FieldAttachmentKey, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentKeyCleared() {
+		_spec.ClearField(assignment.FieldAttachmentKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.AttachmentContentType(); ok {
+		_spec.SetField(assignment.FieldAttachmentContentType, field.TypeString, value)
+	}
+	if _u.mutation.AttachmentContentTypeCleared() {
+		_spec.ClearField(assignment.FieldAttachmentContentType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(assignment.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.PatientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   assignment.PatientTable,
+			Columns: []string{assignment.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   assignment.PatientTable,
+			Columns: []string{assignment.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DoctorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   assignment.DoctorTable,
+			Columns: []string{assignment.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   assignment.DoctorTable,
+			Columns: []string{assignment.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   assignment.LogsTable,
+			Columns: []string{assignment.LogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exerciselog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLogsIDs(); len(nodes) > 0 && !_u.mutation.LogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   assignment.LogsTable,
+			Columns: []string{assignment.LogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exerciselog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   assignment.LogsTable,
+			Columns: []string{assignment.LogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exerciselog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Assignment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{assignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,20 +12,37 @@ import (
 	"backend/ent/migrate"
 
 	"backend/ent/analysisjob"
+	"backend/ent/assignment"
 	"backend/ent/comment"
 	"backend/ent/doctor"
 	"backend/ent/doctorpatientlink"
 	"backend/ent/entry"
 	"backend/ent/entryshare"
+	"backend/ent/exerciselog"
+	"backend/ent/goal"
+	"backend/ent/idempotencykey"
+	"backend/ent/outboxevent"
 	"backend/ent/pairingcode"
 	"backend/ent/patient"
 	"backend/ent/practice"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/ent/questionnaireresponse"
+	"backend/ent/ratelimitbucket"
+	"backend/ent/reminderdelivery"
+	"backend/ent/reminderschedule"
+	"backend/ent/session"
+	"backend/ent/vocabularyterm"
+	"backend/ent/webhookdelivery"
+	"backend/ent/webhooksubscription"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -35,6 +52,8 @@ type Client struct {
 	Schema *migrate.Schema
 	// AnalysisJob is the client for interacting with the AnalysisJob builders.
 	AnalysisJob *AnalysisJobClient
+	// Assignment is the client for interacting with the Assignment builders.
+	Assignment *AssignmentClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
 	// Doctor is the client for interacting with the Doctor builders.
@@ -45,12 +64,40 @@ type Client struct {
 	Entry *EntryClient
 	// EntryShare is the client for interacting with the EntryShare builders.
 	EntryShare *EntryShareClient
+	// ExerciseLog is the client for interacting with the ExerciseLog builders.
+	ExerciseLog *ExerciseLogClient
+	// Goal is the client for interacting with the Goal builders.
+	Goal *GoalClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
 	// PairingCode is the client for interacting with the PairingCode builders.
 	PairingCode *PairingCodeClient
 	// Patient is the client for interacting with the Patient builders.
 	Patient *PatientClient
 	// Practice is the client for interacting with the Practice builders.
 	Practice *PracticeClient
+	// QuestionnaireAssignment is the client for interacting with the QuestionnaireAssignment builders.
+	QuestionnaireAssignment *QuestionnaireAssignmentClient
+	// QuestionnaireDefinition is the client for interacting with the QuestionnaireDefinition builders.
+	QuestionnaireDefinition *QuestionnaireDefinitionClient
+	// QuestionnaireResponse is the client for interacting with the QuestionnaireResponse builders.
+	QuestionnaireResponse *QuestionnaireResponseClient
+	// RateLimitBucket is the client for interacting with the RateLimitBucket builders.
+	RateLimitBucket *RateLimitBucketClient
+	// ReminderDelivery is the client for interacting with the ReminderDelivery builders.
+	ReminderDelivery *ReminderDeliveryClient
+	// ReminderSchedule is the client for interacting with the ReminderSchedule builders.
+	ReminderSchedule *ReminderScheduleClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// VocabularyTerm is the client for interacting with the VocabularyTerm builders.
+	VocabularyTerm *VocabularyTermClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookSubscription is the client for interacting with the WebhookSubscription builders.
+	WebhookSubscription *WebhookSubscriptionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -63,14 +110,29 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.AnalysisJob = NewAnalysisJobClient(c.config)
+	c.Assignment = NewAssignmentClient(c.config)
 	c.Comment = NewCommentClient(c.config)
 	c.Doctor = NewDoctorClient(c.config)
 	c.DoctorPatientLink = NewDoctorPatientLinkClient(c.config)
 	c.Entry = NewEntryClient(c.config)
 	c.EntryShare = NewEntryShareClient(c.config)
+	c.ExerciseLog = NewExerciseLogClient(c.config)
+	c.Goal = NewGoalClient(c.config)
+	c.IdempotencyKey = NewIdempotencyKeyClient(c.config)
+	c.OutboxEvent = NewOutboxEventClient(c.config)
 	c.PairingCode = NewPairingCodeClient(c.config)
 	c.Patient = NewPatientClient(c.config)
 	c.Practice = NewPracticeClient(c.config)
+	c.QuestionnaireAssignment = NewQuestionnaireAssignmentClient(c.config)
+	c.QuestionnaireDefinition = NewQuestionnaireDefinitionClient(c.config)
+	c.QuestionnaireResponse = NewQuestionnaireResponseClient(c.config)
+	c.RateLimitBucket = NewRateLimitBucketClient(c.config)
+	c.ReminderDelivery = NewReminderDeliveryClient(c.config)
+	c.ReminderSchedule = NewReminderScheduleClient(c.config)
+	c.Session = NewSessionClient(c.config)
+	c.VocabularyTerm = NewVocabularyTermClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+	c.WebhookSubscription = NewWebhookSubscriptionClient(c.config)
 }
 
 type (
@@ -161,17 +223,32 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:               ctx,
-		config:            cfg,
-		AnalysisJob:       NewAnalysisJobClient(cfg),
-		Comment:           NewCommentClient(cfg),
-		Doctor:            NewDoctorClient(cfg),
-		DoctorPatientLink: NewDoctorPatientLinkClient(cfg),
-		Entry:             NewEntryClient(cfg),
-		EntryShare:        NewEntryShareClient(cfg),
-		PairingCode:       NewPairingCodeClient(cfg),
-		Patient:           NewPatientClient(cfg),
-		Practice:          NewPracticeClient(cfg),
+		ctx:                     ctx,
+		config:                  cfg,
+		AnalysisJob:             NewAnalysisJobClient(cfg),
+		Assignment:              NewAssignmentClient(cfg),
+		Comment:                 NewCommentClient(cfg),
+		Doctor:                  NewDoctorClient(cfg),
+		DoctorPatientLink:       NewDoctorPatientLinkClient(cfg),
+		Entry:                   NewEntryClient(cfg),
+		EntryShare:              NewEntryShareClient(cfg),
+		ExerciseLog:             NewExerciseLogClient(cfg),
+		Goal:                    NewGoalClient(cfg),
+		IdempotencyKey:          NewIdempotencyKeyClient(cfg),
+		OutboxEvent:             NewOutboxEventClient(cfg),
+		PairingCode:             NewPairingCodeClient(cfg),
+		Patient:                 NewPatientClient(cfg),
+		Practice:                NewPracticeClient(cfg),
+		QuestionnaireAssignment: NewQuestionnaireAssignmentClient(cfg),
+		QuestionnaireDefinition: NewQuestionnaireDefinitionClient(cfg),
+		QuestionnaireResponse:   NewQuestionnaireResponseClient(cfg),
+		RateLimitBucket:         NewRateLimitBucketClient(cfg),
+		ReminderDelivery:        NewReminderDeliveryClient(cfg),
+		ReminderSchedule:        NewReminderScheduleClient(cfg),
+		Session:                 NewSessionClient(cfg),
+		VocabularyTerm:          NewVocabularyTermClient(cfg),
+		WebhookDelivery:         NewWebhookDeliveryClient(cfg),
+		WebhookSubscription:     NewWebhookSubscriptionClient(cfg),
 	}, nil
 }
 
@@ -189,17 +266,32 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:               ctx,
-		config:            cfg,
-		AnalysisJob:       NewAnalysisJobClient(cfg),
-		Comment:           NewCommentClient(cfg),
-		Doctor:            NewDoctorClient(cfg),
-		DoctorPatientLink: NewDoctorPatientLinkClient(cfg),
-		Entry:             NewEntryClient(cfg),
-		EntryShare:        NewEntryShareClient(cfg),
-		PairingCode:       NewPairingCodeClient(cfg),
-		Patient:           NewPatientClient(cfg),
-		Practice:          NewPracticeClient(cfg),
+		ctx:                     ctx,
+		config:                  cfg,
+		AnalysisJob:             NewAnalysisJobClient(cfg),
+		Assignment:              NewAssignmentClient(cfg),
+		Comment:                 NewCommentClient(cfg),
+		Doctor:                  NewDoctorClient(cfg),
+		DoctorPatientLink:       NewDoctorPatientLinkClient(cfg),
+		Entry:                   NewEntryClient(cfg),
+		EntryShare:              NewEntryShareClient(cfg),
+		ExerciseLog:             NewExerciseLogClient(cfg),
+		Goal:                    NewGoalClient(cfg),
+		IdempotencyKey:          NewIdempotencyKeyClient(cfg),
+		OutboxEvent:             NewOutboxEventClient(cfg),
+		PairingCode:             NewPairingCodeClient(cfg),
+		Patient:                 NewPatientClient(cfg),
+		Practice:                NewPracticeClient(cfg),
+		QuestionnaireAssignment: NewQuestionnaireAssignmentClient(cfg),
+		QuestionnaireDefinition: NewQuestionnaireDefinitionClient(cfg),
+		QuestionnaireResponse:   NewQuestionnaireResponseClient(cfg),
+		RateLimitBucket:         NewRateLimitBucketClient(cfg),
+		ReminderDelivery:        NewReminderDeliveryClient(cfg),
+		ReminderSchedule:        NewReminderScheduleClient(cfg),
+		Session:                 NewSessionClient(cfg),
+		VocabularyTerm:          NewVocabularyTermClient(cfg),
+		WebhookDelivery:         NewWebhookDeliveryClient(cfg),
+		WebhookSubscription:     NewWebhookSubscriptionClient(cfg),
 	}, nil
 }
 
@@ -229,8 +321,12 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AnalysisJob, c.Comment, c.Doctor, c.DoctorPatientLink, c.Entry, c.EntryShare,
-		c.PairingCode, c.Patient, c.Practice,
+		c.AnalysisJob, c.Assignment, c.Comment, c.Doctor, c.DoctorPatientLink, c.Entry,
+		c.EntryShare, c.ExerciseLog, c.Goal, c.IdempotencyKey, c.OutboxEvent,
+		c.PairingCode, c.Patient, c.Practice, c.QuestionnaireAssignment,
+		c.QuestionnaireDefinition, c.QuestionnaireResponse, c.RateLimitBucket,
+		c.ReminderDelivery, c.ReminderSchedule, c.Session, c.VocabularyTerm,
+		c.WebhookDelivery, c.WebhookSubscription,
 	} {
 		n.Use(hooks...)
 	}
@@ -240,8 +336,12 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AnalysisJob, c.Comment, c.Doctor, c.DoctorPatientLink, c.Entry, c.EntryShare,
-		c.PairingCode, c.Patient, c.Practice,
+		c.AnalysisJob, c.Assignment, c.Comment, c.Doctor, c.DoctorPatientLink, c.Entry,
+		c.EntryShare, c.ExerciseLog, c.Goal, c.IdempotencyKey, c.OutboxEvent,
+		c.PairingCode, c.Patient, c.Practice, c.QuestionnaireAssignment,
+		c.QuestionnaireDefinition, c.QuestionnaireResponse, c.RateLimitBucket,
+		c.ReminderDelivery, c.ReminderSchedule, c.Session, c.VocabularyTerm,
+		c.WebhookDelivery, c.WebhookSubscription,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -252,6 +352,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AnalysisJobMutation:
 		return c.AnalysisJob.mutate(ctx, m)
+	case *AssignmentMutation:
+		return c.Assignment.mutate(ctx, m)
 	case *CommentMutation:
 		return c.Comment.mutate(ctx, m)
 	case *DoctorMutation:
@@ -262,12 +364,40 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Entry.mutate(ctx, m)
 	case *EntryShareMutation:
 		return c.EntryShare.mutate(ctx, m)
+	case *ExerciseLogMutation:
+		return c.ExerciseLog.mutate(ctx, m)
+	case *GoalMutation:
+		return c.Goal.mutate(ctx, m)
+	case *IdempotencyKeyMutation:
+		return c.IdempotencyKey.mutate(ctx, m)
+	case *OutboxEventMutation:
+		return c.OutboxEvent.mutate(ctx, m)
 	case *PairingCodeMutation:
 		return c.PairingCode.mutate(ctx, m)
 	case *PatientMutation:
 		return c.Patient.mutate(ctx, m)
 	case *PracticeMutation:
 		return c.Practice.mutate(ctx, m)
+	case *QuestionnaireAssignmentMutation:
+		return c.QuestionnaireAssignment.mutate(ctx, m)
+	case *QuestionnaireDefinitionMutation:
+		return c.QuestionnaireDefinition.mutate(ctx, m)
+	case *QuestionnaireResponseMutation:
+		return c.QuestionnaireResponse.mutate(ctx, m)
+	case *RateLimitBucketMutation:
+		return c.RateLimitBucket.mutate(ctx, m)
+	case *ReminderDeliveryMutation:
+		return c.ReminderDelivery.mutate(ctx, m)
+	case *ReminderScheduleMutation:
+		return c.ReminderSchedule.mutate(ctx, m)
+	case *SessionMutation:
+		return c.Session.mutate(ctx, m)
+	case *VocabularyTermMutation:
+		return c.VocabularyTerm.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
+	case *WebhookSubscriptionMutation:
+		return c.WebhookSubscription.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -454,6 +584,187 @@ func (c *AnalysisJobClient) mutate(ctx context.Context, m *AnalysisJobMutation)
 	}
 }
 
+// AssignmentClient is a client for the Assignment schema.
+type AssignmentClient struct {
+	config
+}
+
+// NewAssignmentClient returns a client for the Assignment from the given config.
+func NewAssignmentClient(c config) *AssignmentClient {
+	return &AssignmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `assignment.Hooks(f(g(h())))`.
+func (c *AssignmentClient) Use(hooks ...Hook) {
+	c.hooks.Assignment = append(c.hooks.Assignment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `assignment.Intercept(f(g(h())))`.
+func (c *AssignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Assignment = append(c.inters.Assignment, interceptors...)
+}
+
+// Create returns a builder for creating a Assignment entity.
+func (c *AssignmentClient) Create() *AssignmentCreate {
+	mutation := newAssignmentMutation(c.config, OpCreate)
+	return &AssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Assignment entities.
+func (c *AssignmentClient) CreateBulk(builders ...*AssignmentCreate) *AssignmentCreateBulk {
+	return &AssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AssignmentClient) MapCreateBulk(slice any, setFunc func(*AssignmentCreate, int)) *AssignmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AssignmentCreateBulk{err: fmt.Errorf("calling to AssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AssignmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Assignment.
+func (c *AssignmentClient) Update() *AssignmentUpdate {
+	mutation := newAssignmentMutation(c.config, OpUpdate)
+	return &AssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AssignmentClient) UpdateOne(_m *Assignment) *AssignmentUpdateOne {
+	mutation := newAssignmentMutation(c.config, OpUpdateOne, withAssignment(_m))
+	return &AssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AssignmentClient) UpdateOneID(id uuid.UUID) *AssignmentUpdateOne {
+	mutation := newAssignmentMutation(c.config, OpUpdateOne, withAssignmentID(id))
+	return &AssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Assignment.
+func (c *AssignmentClient) Delete() *AssignmentDelete {
+	mutation := newAssignmentMutation(c.config, OpDelete)
+	return &AssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AssignmentClient) DeleteOne(_m *Assignment) *AssignmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AssignmentClient) DeleteOneID(id uuid.UUID) *AssignmentDeleteOne {
+	builder := c.Delete().Where(assignment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AssignmentDeleteOne{builder}
+}
+
+// Query returns a query builder for Assignment.
+func (c *AssignmentClient) Query() *AssignmentQuery {
+	return &AssignmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAssignment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Assignment entity by its id.
+func (c *AssignmentClient) Get(ctx context.Context, id uuid.UUID) (*Assignment, error) {
+	return c.Query().Where(assignment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AssignmentClient) GetX(ctx context.Context, id uuid.UUID) *Assignment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPatient queries the patient edge of a Assignment.
+func (c *AssignmentClient) QueryPatient(_m *Assignment) *PatientQuery {
+	query := (&PatientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(assignment.Table, assignment.FieldID, id),
+			sqlgraph.To(patient.Table, patient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, assignment.PatientTable, assignment.PatientColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDoctor queries the doctor edge of a Assignment.
+func (c *AssignmentClient) QueryDoctor(_m *Assignment) *DoctorQuery {
+	query := (&DoctorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(assignment.Table, assignment.FieldID, id),
+			sqlgraph.To(doctor.Table, doctor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, assignment.DoctorTable, assignment.DoctorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLogs queries the logs edge of a Assignment.
+func (c *AssignmentClient) QueryLogs(_m *Assignment) *ExerciseLogQuery {
+	query := (&ExerciseLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(assignment.Table, assignment.FieldID, id),
+			sqlgraph.To(exerciselog.Table, exerciselog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, assignment.LogsTable, assignment.LogsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AssignmentClient) Hooks() []Hook {
+	return c.hooks.Assignment
+}
+
+// Interceptors returns the client interceptors.
+func (c *AssignmentClient) Interceptors() []Interceptor {
+	return c.inters.Assignment
+}
+
+func (c *AssignmentClient) mutate(ctx context.Context, m *AssignmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Assignment mutation op: %q", m.Op())
+	}
+}
+
 // CommentClient is a client for the Comment schema.
 type CommentClient struct {
 	config
@@ -839,6 +1150,134 @@ func (c *DoctorClient) QueryCreatedAnalysisJobs(_m *Doctor) *AnalysisJobQuery {
 	return query
 }
 
+// QueryAssignedGoals queries the assigned_goals edge of a Doctor.
+func (c *DoctorClient) QueryAssignedGoals(_m *Doctor) *GoalQuery {
+	query := (&GoalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, id),
+			sqlgraph.To(goal.Table, goal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.AssignedGoalsTable, doctor.AssignedGoalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignedExercises queries the assigned_exercises edge of a Doctor.
+func (c *DoctorClient) QueryAssignedExercises(_m *Doctor) *AssignmentQuery {
+	query := (&AssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, id),
+			sqlgraph.To(assignment.Table, assignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.AssignedExercisesTable, doctor.AssignedExercisesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySessions queries the sessions edge of a Doctor.
+func (c *DoctorClient) QuerySessions(_m *Doctor) *SessionQuery {
+	query := (&SessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, id),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.SessionsTable, doctor.SessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuestionnaireAssignments queries the questionnaire_assignments edge of a Doctor.
+func (c *DoctorClient) QueryQuestionnaireAssignments(_m *Doctor) *QuestionnaireAssignmentQuery {
+	query := (&QuestionnaireAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, id),
+			sqlgraph.To(questionnaireassignment.Table, questionnaireassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.QuestionnaireAssignmentsTable, doctor.QuestionnaireAssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuestionnaireDefinitions queries the questionnaire_definitions edge of a Doctor.
+func (c *DoctorClient) QueryQuestionnaireDefinitions(_m *Doctor) *QuestionnaireDefinitionQuery {
+	query := (&QuestionnaireDefinitionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, id),
+			sqlgraph.To(questionnairedefinition.Table, questionnairedefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.QuestionnaireDefinitionsTable, doctor.QuestionnaireDefinitionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReminderSchedules queries the reminder_schedules edge of a Doctor.
+func (c *DoctorClient) QueryReminderSchedules(_m *Doctor) *ReminderScheduleQuery {
+	query := (&ReminderScheduleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, id),
+			sqlgraph.To(reminderschedule.Table, reminderschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.ReminderSchedulesTable, doctor.ReminderSchedulesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWebhookSubscriptions queries the webhook_subscriptions edge of a Doctor.
+func (c *DoctorClient) QueryWebhookSubscriptions(_m *Doctor) *WebhookSubscriptionQuery {
+	query := (&WebhookSubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, id),
+			sqlgraph.To(webhooksubscription.Table, webhooksubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.WebhookSubscriptionsTable, doctor.WebhookSubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVocabularyTerms queries the vocabulary_terms edge of a Doctor.
+func (c *DoctorClient) QueryVocabularyTerms(_m *Doctor) *VocabularyTermQuery {
+	query := (&VocabularyTermClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, id),
+			sqlgraph.To(vocabularyterm.Table, vocabularyterm.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.VocabularyTermsTable, doctor.VocabularyTermsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *DoctorClient) Hooks() []Hook {
 	return c.hooks.Doctor
@@ -1217,6 +1656,38 @@ func (c *EntryClient) QueryAnalysisJobs(_m *Entry) *AnalysisJobQuery {
 	return query
 }
 
+// QueryExerciseLogs queries the exercise_logs edge of a Entry.
+func (c *EntryClient) QueryExerciseLogs(_m *Entry) *ExerciseLogQuery {
+	query := (&ExerciseLogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(entry.Table, entry.FieldID, id),
+			sqlgraph.To(exerciselog.Table, exerciselog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, entry.ExerciseLogsTable, entry.ExerciseLogsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySessions queries the sessions edge of a Entry.
+func (c *EntryClient) QuerySessions(_m *Entry) *SessionQuery {
+	query := (&SessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(entry.Table, entry.FieldID, id),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, entry.SessionsTable, entry.SessionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *EntryClient) Hooks() []Hook {
 	return c.hooks.Entry
@@ -1423,76 +1894,672 @@ func (c *EntryShareClient) mutate(ctx context.Context, m *EntryShareMutation) (V
 	}
 }
 
-// PairingCodeClient is a client for the PairingCode schema.
-type PairingCodeClient struct {
+// ExerciseLogClient is a client for the ExerciseLog schema.
+type ExerciseLogClient struct {
 	config
 }
 
-// NewPairingCodeClient returns a client for the PairingCode from the given config.
-func NewPairingCodeClient(c config) *PairingCodeClient {
-	return &PairingCodeClient{config: c}
+// NewExerciseLogClient returns a client for the ExerciseLog from the given config.
+func NewExerciseLogClient(c config) *ExerciseLogClient {
+	return &ExerciseLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `pairingcode.Hooks(f(g(h())))`.
-func (c *PairingCodeClient) Use(hooks ...Hook) {
-	c.hooks.PairingCode = append(c.hooks.PairingCode, hooks...)
+// A call to `Use(f, g, h)` equals to `exerciselog.Hooks(f(g(h())))`.
+func (c *ExerciseLogClient) Use(hooks ...Hook) {
+	c.hooks.ExerciseLog = append(c.hooks.ExerciseLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `pairingcode.Intercept(f(g(h())))`.
-func (c *PairingCodeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PairingCode = append(c.inters.PairingCode, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `exerciselog.Intercept(f(g(h())))`.
+func (c *ExerciseLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExerciseLog = append(c.inters.ExerciseLog, interceptors...)
 }
 
-// Create returns a builder for creating a PairingCode entity.
-func (c *PairingCodeClient) Create() *PairingCodeCreate {
-	mutation := newPairingCodeMutation(c.config, OpCreate)
-	return &PairingCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ExerciseLog entity.
+func (c *ExerciseLogClient) Create() *ExerciseLogCreate {
+	mutation := newExerciseLogMutation(c.config, OpCreate)
+	return &ExerciseLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PairingCode entities.
-func (c *PairingCodeClient) CreateBulk(builders ...*PairingCodeCreate) *PairingCodeCreateBulk {
-	return &PairingCodeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ExerciseLog entities.
+func (c *ExerciseLogClient) CreateBulk(builders ...*ExerciseLogCreate) *ExerciseLogCreateBulk {
+	return &ExerciseLogCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PairingCodeClient) MapCreateBulk(slice any, setFunc func(*PairingCodeCreate, int)) *PairingCodeCreateBulk {
+func (c *ExerciseLogClient) MapCreateBulk(slice any, setFunc func(*ExerciseLogCreate, int)) *ExerciseLogCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PairingCodeCreateBulk{err: fmt.Errorf("calling to PairingCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ExerciseLogCreateBulk{err: fmt.Errorf("calling to ExerciseLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PairingCodeCreate, rv.Len())
+	builders := make([]*ExerciseLogCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PairingCodeCreateBulk{config: c.config, builders: builders}
+	return &ExerciseLogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PairingCode.
-func (c *PairingCodeClient) Update() *PairingCodeUpdate {
-	mutation := newPairingCodeMutation(c.config, OpUpdate)
-	return &PairingCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ExerciseLog.
+func (c *ExerciseLogClient) Update() *ExerciseLogUpdate {
+	mutation := newExerciseLogMutation(c.config, OpUpdate)
+	return &ExerciseLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PairingCodeClient) UpdateOne(_m *PairingCode) *PairingCodeUpdateOne {
-	mutation := newPairingCodeMutation(c.config, OpUpdateOne, withPairingCode(_m))
-	return &PairingCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ExerciseLogClient) UpdateOne(_m *ExerciseLog) *ExerciseLogUpdateOne {
+	mutation := newExerciseLogMutation(c.config, OpUpdateOne, withExerciseLog(_m))
+	return &ExerciseLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PairingCodeClient) UpdateOneID(id uuid.UUID) *PairingCodeUpdateOne {
-	mutation := newPairingCodeMutation(c.config, OpUpdateOne, withPairingCodeID(id))
-	return &PairingCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ExerciseLogClient) UpdateOneID(id uuid.UUID) *ExerciseLogUpdateOne {
+	mutation := newExerciseLogMutation(c.config, OpUpdateOne, withExerciseLogID(id))
+	return &ExerciseLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PairingCode.
-func (c *PairingCodeClient) Delete() *PairingCodeDelete {
-	mutation := newPairingCodeMutation(c.config, OpDelete)
-	return &PairingCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ExerciseLog.
+func (c *ExerciseLogClient) Delete() *ExerciseLogDelete {
+	mutation := newExerciseLogMutation(c.config, OpDelete)
+	return &ExerciseLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExerciseLogClient) DeleteOne(_m *ExerciseLog) *ExerciseLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExerciseLogClient) DeleteOneID(id uuid.UUID) *ExerciseLogDeleteOne {
+	builder := c.Delete().Where(exerciselog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExerciseLogDeleteOne{builder}
+}
+
+// Query returns a query builder for ExerciseLog.
+func (c *ExerciseLogClient) Query() *ExerciseLogQuery {
+	return &ExerciseLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExerciseLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExerciseLog entity by its id.
+func (c *ExerciseLogClient) Get(ctx context.Context, id uuid.UUID) (*ExerciseLog, error) {
+	return c.Query().Where(exerciselog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExerciseLogClient) GetX(ctx context.Context, id uuid.UUID) *ExerciseLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAssignment queries the assignment edge of a ExerciseLog.
+func (c *ExerciseLogClient) QueryAssignment(_m *ExerciseLog) *AssignmentQuery {
+	query := (&AssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(exerciselog.Table, exerciselog.FieldID, id),
+			sqlgraph.To(assignment.Table, assignment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, exerciselog.AssignmentTable, exerciselog.AssignmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEntry queries the entry edge of a ExerciseLog.
+func (c *ExerciseLogClient) QueryEntry(_m *ExerciseLog) *EntryQuery {
+	query := (&EntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(exerciselog.Table, exerciselog.FieldID, id),
+			sqlgraph.To(entry.Table, entry.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, exerciselog.EntryTable, exerciselog.EntryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ExerciseLogClient) Hooks() []Hook {
+	return c.hooks.ExerciseLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExerciseLogClient) Interceptors() []Interceptor {
+	return c.inters.ExerciseLog
+}
+
+func (c *ExerciseLogClient) mutate(ctx context.Context, m *ExerciseLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExerciseLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExerciseLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExerciseLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExerciseLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExerciseLog mutation op: %q", m.Op())
+	}
+}
+
+// GoalClient is a client for the Goal schema.
+type GoalClient struct {
+	config
+}
+
+// NewGoalClient returns a client for the Goal from the given config.
+func NewGoalClient(c config) *GoalClient {
+	return &GoalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `goal.Hooks(f(g(h())))`.
+func (c *GoalClient) Use(hooks ...Hook) {
+	c.hooks.Goal = append(c.hooks.Goal, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `goal.Intercept(f(g(h())))`.
+func (c *GoalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Goal = append(c.inters.Goal, interceptors...)
+}
+
+// Create returns a builder for creating a Goal entity.
+func (c *GoalClient) Create() *GoalCreate {
+	mutation := newGoalMutation(c.config, OpCreate)
+	return &GoalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Goal entities.
+func (c *GoalClient) CreateBulk(builders ...*GoalCreate) *GoalCreateBulk {
+	return &GoalCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GoalClient) MapCreateBulk(slice any, setFunc func(*GoalCreate, int)) *GoalCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GoalCreateBulk{err: fmt.Errorf("calling to GoalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GoalCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GoalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Goal.
+func (c *GoalClient) Update() *GoalUpdate {
+	mutation := newGoalMutation(c.config, OpUpdate)
+	return &GoalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GoalClient) UpdateOne(_m *Goal) *GoalUpdateOne {
+	mutation := newGoalMutation(c.config, OpUpdateOne, withGoal(_m))
+	return &GoalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GoalClient) UpdateOneID(id uuid.UUID) *GoalUpdateOne {
+	mutation := newGoalMutation(c.config, OpUpdateOne, withGoalID(id))
+	return &GoalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Goal.
+func (c *GoalClient) Delete() *GoalDelete {
+	mutation := newGoalMutation(c.config, OpDelete)
+	return &GoalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GoalClient) DeleteOne(_m *Goal) *GoalDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GoalClient) DeleteOneID(id uuid.UUID) *GoalDeleteOne {
+	builder := c.Delete().Where(goal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GoalDeleteOne{builder}
+}
+
+// Query returns a query builder for Goal.
+func (c *GoalClient) Query() *GoalQuery {
+	return &GoalQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGoal},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Goal entity by its id.
+func (c *GoalClient) Get(ctx context.Context, id uuid.UUID) (*Goal, error) {
+	return c.Query().Where(goal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GoalClient) GetX(ctx context.Context, id uuid.UUID) *Goal {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPatient queries the patient edge of a Goal.
+func (c *GoalClient) QueryPatient(_m *Goal) *PatientQuery {
+	query := (&PatientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(goal.Table, goal.FieldID, id),
+			sqlgraph.To(patient.Table, patient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, goal.PatientTable, goal.PatientColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDoctor queries the doctor edge of a Goal.
+func (c *GoalClient) QueryDoctor(_m *Goal) *DoctorQuery {
+	query := (&DoctorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(goal.Table, goal.FieldID, id),
+			sqlgraph.To(doctor.Table, doctor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, goal.DoctorTable, goal.DoctorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GoalClient) Hooks() []Hook {
+	return c.hooks.Goal
+}
+
+// Interceptors returns the client interceptors.
+func (c *GoalClient) Interceptors() []Interceptor {
+	return c.inters.Goal
+}
+
+func (c *GoalClient) mutate(ctx context.Context, m *GoalMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GoalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GoalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GoalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GoalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Goal mutation op: %q", m.Op())
+	}
+}
+
+// IdempotencyKeyClient is a client for the IdempotencyKey schema.
+type IdempotencyKeyClient struct {
+	config
+}
+
+// NewIdempotencyKeyClient returns a client for the IdempotencyKey from the given config.
+func NewIdempotencyKeyClient(c config) *IdempotencyKeyClient {
+	return &IdempotencyKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `idempotencykey.Hooks(f(g(h())))`.
+func (c *IdempotencyKeyClient) Use(hooks ...Hook) {
+	c.hooks.IdempotencyKey = append(c.hooks.IdempotencyKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `idempotencykey.Intercept(f(g(h())))`.
+func (c *IdempotencyKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdempotencyKey = append(c.inters.IdempotencyKey, interceptors...)
+}
+
+// Create returns a builder for creating a IdempotencyKey entity.
+func (c *IdempotencyKeyClient) Create() *IdempotencyKeyCreate {
+	mutation := newIdempotencyKeyMutation(c.config, OpCreate)
+	return &IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdempotencyKey entities.
+func (c *IdempotencyKeyClient) CreateBulk(builders ...*IdempotencyKeyCreate) *IdempotencyKeyCreateBulk {
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdempotencyKeyClient) MapCreateBulk(slice any, setFunc func(*IdempotencyKeyCreate, int)) *IdempotencyKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdempotencyKeyCreateBulk{err: fmt.Errorf("calling to IdempotencyKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdempotencyKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Update() *IdempotencyKeyUpdate {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdate)
+	return &IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdempotencyKeyClient) UpdateOne(_m *IdempotencyKey) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKey(_m))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdempotencyKeyClient) UpdateOneID(id string) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKeyID(id))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Delete() *IdempotencyKeyDelete {
+	mutation := newIdempotencyKeyMutation(c.config, OpDelete)
+	return &IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdempotencyKeyClient) DeleteOne(_m *IdempotencyKey) *IdempotencyKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdempotencyKeyClient) DeleteOneID(id string) *IdempotencyKeyDeleteOne {
+	builder := c.Delete().Where(idempotencykey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdempotencyKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Query() *IdempotencyKeyQuery {
+	return &IdempotencyKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdempotencyKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IdempotencyKey entity by its id.
+func (c *IdempotencyKeyClient) Get(ctx context.Context, id string) (*IdempotencyKey, error) {
+	return c.Query().Where(idempotencykey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdempotencyKeyClient) GetX(ctx context.Context, id string) *IdempotencyKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IdempotencyKeyClient) Hooks() []Hook {
+	return c.hooks.IdempotencyKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdempotencyKeyClient) Interceptors() []Interceptor {
+	return c.inters.IdempotencyKey
+}
+
+func (c *IdempotencyKeyClient) mutate(ctx context.Context, m *IdempotencyKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IdempotencyKey mutation op: %q", m.Op())
+	}
+}
+
+// OutboxEventClient is a client for the OutboxEvent schema.
+type OutboxEventClient struct {
+	config
+}
+
+// NewOutboxEventClient returns a client for the OutboxEvent from the given config.
+func NewOutboxEventClient(c config) *OutboxEventClient {
+	return &OutboxEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxevent.Hooks(f(g(h())))`.
+func (c *OutboxEventClient) Use(hooks ...Hook) {
+	c.hooks.OutboxEvent = append(c.hooks.OutboxEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outboxevent.Intercept(f(g(h())))`.
+func (c *OutboxEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxEvent = append(c.inters.OutboxEvent, interceptors...)
+}
+
+// Create returns a builder for creating a OutboxEvent entity.
+func (c *OutboxEventClient) Create() *OutboxEventCreate {
+	mutation := newOutboxEventMutation(c.config, OpCreate)
+	return &OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxEvent entities.
+func (c *OutboxEventClient) CreateBulk(builders ...*OutboxEventCreate) *OutboxEventCreateBulk {
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxEventClient) MapCreateBulk(slice any, setFunc func(*OutboxEventCreate, int)) *OutboxEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxEventCreateBulk{err: fmt.Errorf("calling to OutboxEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxEvent.
+func (c *OutboxEventClient) Update() *OutboxEventUpdate {
+	mutation := newOutboxEventMutation(c.config, OpUpdate)
+	return &OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxEventClient) UpdateOne(_m *OutboxEvent) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEvent(_m))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxEventClient) UpdateOneID(id uuid.UUID) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEventID(id))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxEvent.
+func (c *OutboxEventClient) Delete() *OutboxEventDelete {
+	mutation := newOutboxEventMutation(c.config, OpDelete)
+	return &OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxEventClient) DeleteOne(_m *OutboxEvent) *OutboxEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxEventClient) DeleteOneID(id uuid.UUID) *OutboxEventDeleteOne {
+	builder := c.Delete().Where(outboxevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxEventDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxEvent.
+func (c *OutboxEventClient) Query() *OutboxEventQuery {
+	return &OutboxEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutboxEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutboxEvent entity by its id.
+func (c *OutboxEventClient) Get(ctx context.Context, id uuid.UUID) (*OutboxEvent, error) {
+	return c.Query().Where(outboxevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxEventClient) GetX(ctx context.Context, id uuid.UUID) *OutboxEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxEventClient) Hooks() []Hook {
+	return c.hooks.OutboxEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxEventClient) Interceptors() []Interceptor {
+	return c.inters.OutboxEvent
+}
+
+func (c *OutboxEventClient) mutate(ctx context.Context, m *OutboxEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OutboxEvent mutation op: %q", m.Op())
+	}
+}
+
+// PairingCodeClient is a client for the PairingCode schema.
+type PairingCodeClient struct {
+	config
+}
+
+// NewPairingCodeClient returns a client for the PairingCode from the given config.
+func NewPairingCodeClient(c config) *PairingCodeClient {
+	return &PairingCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pairingcode.Hooks(f(g(h())))`.
+func (c *PairingCodeClient) Use(hooks ...Hook) {
+	c.hooks.PairingCode = append(c.hooks.PairingCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pairingcode.Intercept(f(g(h())))`.
+func (c *PairingCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PairingCode = append(c.inters.PairingCode, interceptors...)
+}
+
+// Create returns a builder for creating a PairingCode entity.
+func (c *PairingCodeClient) Create() *PairingCodeCreate {
+	mutation := newPairingCodeMutation(c.config, OpCreate)
+	return &PairingCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PairingCode entities.
+func (c *PairingCodeClient) CreateBulk(builders ...*PairingCodeCreate) *PairingCodeCreateBulk {
+	return &PairingCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PairingCodeClient) MapCreateBulk(slice any, setFunc func(*PairingCodeCreate, int)) *PairingCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PairingCodeCreateBulk{err: fmt.Errorf("calling to PairingCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PairingCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PairingCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PairingCode.
+func (c *PairingCodeClient) Update() *PairingCodeUpdate {
+	mutation := newPairingCodeMutation(c.config, OpUpdate)
+	return &PairingCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PairingCodeClient) UpdateOne(_m *PairingCode) *PairingCodeUpdateOne {
+	mutation := newPairingCodeMutation(c.config, OpUpdateOne, withPairingCode(_m))
+	return &PairingCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PairingCodeClient) UpdateOneID(id uuid.UUID) *PairingCodeUpdateOne {
+	mutation := newPairingCodeMutation(c.config, OpUpdateOne, withPairingCodeID(id))
+	return &PairingCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PairingCode.
+func (c *PairingCodeClient) Delete() *PairingCodeDelete {
+	mutation := newPairingCodeMutation(c.config, OpDelete)
+	return &PairingCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
@@ -1501,29 +2568,1919 @@ func (c *PairingCodeClient) DeleteOne(_m *PairingCode) *PairingCodeDeleteOne {
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PairingCodeClient) DeleteOneID(id uuid.UUID) *PairingCodeDeleteOne {
-	builder := c.Delete().Where(pairingcode.ID(id))
+func (c *PairingCodeClient) DeleteOneID(id uuid.UUID) *PairingCodeDeleteOne {
+	builder := c.Delete().Where(pairingcode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PairingCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for PairingCode.
+func (c *PairingCodeClient) Query() *PairingCodeQuery {
+	return &PairingCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePairingCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PairingCode entity by its id.
+func (c *PairingCodeClient) Get(ctx context.Context, id uuid.UUID) (*PairingCode, error) {
+	return c.Query().Where(pairingcode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PairingCodeClient) GetX(ctx context.Context, id uuid.UUID) *PairingCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDoctor queries the doctor edge of a PairingCode.
+func (c *PairingCodeClient) QueryDoctor(_m *PairingCode) *DoctorQuery {
+	query := (&DoctorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pairingcode.Table, pairingcode.FieldID, id),
+			sqlgraph.To(doctor.Table, doctor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pairingcode.DoctorTable, pairingcode.DoctorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryConsumedByPatient queries the consumed_by_patient edge of a PairingCode.
+func (c *PairingCodeClient) QueryConsumedByPatient(_m *PairingCode) *PatientQuery {
+	query := (&PatientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pairingcode.Table, pairingcode.FieldID, id),
+			sqlgraph.To(patient.Table, patient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pairingcode.ConsumedByPatientTable, pairingcode.ConsumedByPatientColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PairingCodeClient) Hooks() []Hook {
+	return c.hooks.PairingCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *PairingCodeClient) Interceptors() []Interceptor {
+	return c.inters.PairingCode
+}
+
+func (c *PairingCodeClient) mutate(ctx context.Context, m *PairingCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PairingCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PairingCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PairingCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PairingCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PairingCode mutation op: %q", m.Op())
+	}
+}
+
+// PatientClient is a client for the Patient schema.
+type PatientClient struct {
+	config
+}
+
+// NewPatientClient returns a client for the Patient from the given config.
+func NewPatientClient(c config) *PatientClient {
+	return &PatientClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `patient.Hooks(f(g(h())))`.
+func (c *PatientClient) Use(hooks ...Hook) {
+	c.hooks.Patient = append(c.hooks.Patient, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `patient.Intercept(f(g(h())))`.
+func (c *PatientClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Patient = append(c.inters.Patient, interceptors...)
+}
+
+// Create returns a builder for creating a Patient entity.
+func (c *PatientClient) Create() *PatientCreate {
+	mutation := newPatientMutation(c.config, OpCreate)
+	return &PatientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Patient entities.
+func (c *PatientClient) CreateBulk(builders ...*PatientCreate) *PatientCreateBulk {
+	return &PatientCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PatientClient) MapCreateBulk(slice any, setFunc func(*PatientCreate, int)) *PatientCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PatientCreateBulk{err: fmt.Errorf("calling to PatientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PatientCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PatientCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Patient.
+func (c *PatientClient) Update() *PatientUpdate {
+	mutation := newPatientMutation(c.config, OpUpdate)
+	return &PatientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PatientClient) UpdateOne(_m *Patient) *PatientUpdateOne {
+	mutation := newPatientMutation(c.config, OpUpdateOne, withPatient(_m))
+	return &PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PatientClient) UpdateOneID(id uuid.UUID) *PatientUpdateOne {
+	mutation := newPatientMutation(c.config, OpUpdateOne, withPatientID(id))
+	return &PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Patient.
+func (c *PatientClient) Delete() *PatientDelete {
+	mutation := newPatientMutation(c.config, OpDelete)
+	return &PatientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PatientClient) DeleteOne(_m *Patient) *PatientDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PatientClient) DeleteOneID(id uuid.UUID) *PatientDeleteOne {
+	builder := c.Delete().Where(patient.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PatientDeleteOne{builder}
+}
+
+// Query returns a query builder for Patient.
+func (c *PatientClient) Query() *PatientQuery {
+	return &PatientQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePatient},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Patient entity by its id.
+func (c *PatientClient) Get(ctx context.Context, id uuid.UUID) (*Patient, error) {
+	return c.Query().Where(patient.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PatientClient) GetX(ctx context.Context, id uuid.UUID) *Patient {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDoctorLinks queries the doctor_links edge of a Patient.
+func (c *PatientClient) QueryDoctorLinks(_m *Patient) *DoctorPatientLinkQuery {
+	query := (&DoctorPatientLinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(doctorpatientlink.Table, doctorpatientlink.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.DoctorLinksTable, patient.DoctorLinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryConsumedPairingCodes queries the consumed_pairing_codes edge of a Patient.
+func (c *PatientClient) QueryConsumedPairingCodes(_m *Patient) *PairingCodeQuery {
+	query := (&PairingCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(pairingcode.Table, pairingcode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.ConsumedPairingCodesTable, patient.ConsumedPairingCodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEntries queries the entries edge of a Patient.
+func (c *PatientClient) QueryEntries(_m *Patient) *EntryQuery {
+	query := (&EntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(entry.Table, entry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.EntriesTable, patient.EntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAnalysisJobs queries the analysis_jobs edge of a Patient.
+func (c *PatientClient) QueryAnalysisJobs(_m *Patient) *AnalysisJobQuery {
+	query := (&AnalysisJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(analysisjob.Table, analysisjob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.AnalysisJobsTable, patient.AnalysisJobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEntryShares queries the entry_shares edge of a Patient.
+func (c *PatientClient) QueryEntryShares(_m *Patient) *EntryShareQuery {
+	query := (&EntryShareClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(entryshare.Table, entryshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.EntrySharesTable, patient.EntrySharesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGoals queries the goals edge of a Patient.
+func (c *PatientClient) QueryGoals(_m *Patient) *GoalQuery {
+	query := (&GoalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(goal.Table, goal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.GoalsTable, patient.GoalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignments queries the assignments edge of a Patient.
+func (c *PatientClient) QueryAssignments(_m *Patient) *AssignmentQuery {
+	query := (&AssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(assignment.Table, assignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.AssignmentsTable, patient.AssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySessions queries the sessions edge of a Patient.
+func (c *PatientClient) QuerySessions(_m *Patient) *SessionQuery {
+	query := (&SessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.SessionsTable, patient.SessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuestionnaireAssignments queries the questionnaire_assignments edge of a Patient.
+func (c *PatientClient) QueryQuestionnaireAssignments(_m *Patient) *QuestionnaireAssignmentQuery {
+	query := (&QuestionnaireAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(questionnaireassignment.Table, questionnaireassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.QuestionnaireAssignmentsTable, patient.QuestionnaireAssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuestionnaireResponses queries the questionnaire_responses edge of a Patient.
+func (c *PatientClient) QueryQuestionnaireResponses(_m *Patient) *QuestionnaireResponseQuery {
+	query := (&QuestionnaireResponseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(questionnaireresponse.Table, questionnaireresponse.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.QuestionnaireResponsesTable, patient.QuestionnaireResponsesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReminderSchedules queries the reminder_schedules edge of a Patient.
+func (c *PatientClient) QueryReminderSchedules(_m *Patient) *ReminderScheduleQuery {
+	query := (&ReminderScheduleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(reminderschedule.Table, reminderschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.ReminderSchedulesTable, patient.ReminderSchedulesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReminderDeliveries queries the reminder_deliveries edge of a Patient.
+func (c *PatientClient) QueryReminderDeliveries(_m *Patient) *ReminderDeliveryQuery {
+	query := (&ReminderDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, id),
+			sqlgraph.To(reminderdelivery.Table, reminderdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.ReminderDeliveriesTable, patient.ReminderDeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PatientClient) Hooks() []Hook {
+	return c.hooks.Patient
+}
+
+// Interceptors returns the client interceptors.
+func (c *PatientClient) Interceptors() []Interceptor {
+	return c.inters.Patient
+}
+
+func (c *PatientClient) mutate(ctx context.Context, m *PatientMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PatientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PatientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PatientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Patient mutation op: %q", m.Op())
+	}
+}
+
+// PracticeClient is a client for the Practice schema.
+type PracticeClient struct {
+	config
+}
+
+// NewPracticeClient returns a client for the Practice from the given config.
+func NewPracticeClient(c config) *PracticeClient {
+	return &PracticeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `practice.Hooks(f(g(h())))`.
+func (c *PracticeClient) Use(hooks ...Hook) {
+	c.hooks.Practice = append(c.hooks.Practice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `practice.Intercept(f(g(h())))`.
+func (c *PracticeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Practice = append(c.inters.Practice, interceptors...)
+}
+
+// Create returns a builder for creating a Practice entity.
+func (c *PracticeClient) Create() *PracticeCreate {
+	mutation := newPracticeMutation(c.config, OpCreate)
+	return &PracticeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Practice entities.
+func (c *PracticeClient) CreateBulk(builders ...*PracticeCreate) *PracticeCreateBulk {
+	return &PracticeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PracticeClient) MapCreateBulk(slice any, setFunc func(*PracticeCreate, int)) *PracticeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PracticeCreateBulk{err: fmt.Errorf("calling to PracticeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PracticeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PracticeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Practice.
+func (c *PracticeClient) Update() *PracticeUpdate {
+	mutation := newPracticeMutation(c.config, OpUpdate)
+	return &PracticeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PracticeClient) UpdateOne(_m *Practice) *PracticeUpdateOne {
+	mutation := newPracticeMutation(c.config, OpUpdateOne, withPractice(_m))
+	return &PracticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PracticeClient) UpdateOneID(id uuid.UUID) *PracticeUpdateOne {
+	mutation := newPracticeMutation(c.config, OpUpdateOne, withPracticeID(id))
+	return &PracticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Practice.
+func (c *PracticeClient) Delete() *PracticeDelete {
+	mutation := newPracticeMutation(c.config, OpDelete)
+	return &PracticeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PracticeClient) DeleteOne(_m *Practice) *PracticeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PracticeClient) DeleteOneID(id uuid.UUID) *PracticeDeleteOne {
+	builder := c.Delete().Where(practice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PracticeDeleteOne{builder}
+}
+
+// Query returns a query builder for Practice.
+func (c *PracticeClient) Query() *PracticeQuery {
+	return &PracticeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePractice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Practice entity by its id.
+func (c *PracticeClient) Get(ctx context.Context, id uuid.UUID) (*Practice, error) {
+	return c.Query().Where(practice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PracticeClient) GetX(ctx context.Context, id uuid.UUID) *Practice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDoctors queries the doctors edge of a Practice.
+func (c *PracticeClient) QueryDoctors(_m *Practice) *DoctorQuery {
+	query := (&DoctorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(practice.Table, practice.FieldID, id),
+			sqlgraph.To(doctor.Table, doctor.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, practice.DoctorsTable, practice.DoctorsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVocabularyTerms queries the vocabulary_terms edge of a Practice.
+func (c *PracticeClient) QueryVocabularyTerms(_m *Practice) *VocabularyTermQuery {
+	query := (&VocabularyTermClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(practice.Table, practice.FieldID, id),
+			sqlgraph.To(vocabularyterm.Table, vocabularyterm.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, practice.VocabularyTermsTable, practice.VocabularyTermsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWebhookSubscriptions queries the webhook_subscriptions edge of a Practice.
+func (c *PracticeClient) QueryWebhookSubscriptions(_m *Practice) *WebhookSubscriptionQuery {
+	query := (&WebhookSubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(practice.Table, practice.FieldID, id),
+			sqlgraph.To(webhooksubscription.Table, webhooksubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, practice.WebhookSubscriptionsTable, practice.WebhookSubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PracticeClient) Hooks() []Hook {
+	return c.hooks.Practice
+}
+
+// Interceptors returns the client interceptors.
+func (c *PracticeClient) Interceptors() []Interceptor {
+	return c.inters.Practice
+}
+
+func (c *PracticeClient) mutate(ctx context.Context, m *PracticeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PracticeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PracticeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PracticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PracticeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Practice mutation op: %q", m.Op())
+	}
+}
+
+// QuestionnaireAssignmentClient is a client for the QuestionnaireAssignment schema.
+type QuestionnaireAssignmentClient struct {
+	config
+}
+
+// NewQuestionnaireAssignmentClient returns a client for the QuestionnaireAssignment from the given config.
+func NewQuestionnaireAssignmentClient(c config) *QuestionnaireAssignmentClient {
+	return &QuestionnaireAssignmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `questionnaireassignment.Hooks(f(g(h())))`.
+func (c *QuestionnaireAssignmentClient) Use(hooks ...Hook) {
+	c.hooks.QuestionnaireAssignment = append(c.hooks.QuestionnaireAssignment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `questionnaireassignment.Intercept(f(g(h())))`.
+func (c *QuestionnaireAssignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QuestionnaireAssignment = append(c.inters.QuestionnaireAssignment, interceptors...)
+}
+
+// Create returns a builder for creating a QuestionnaireAssignment entity.
+func (c *QuestionnaireAssignmentClient) Create() *QuestionnaireAssignmentCreate {
+	mutation := newQuestionnaireAssignmentMutation(c.config, OpCreate)
+	return &QuestionnaireAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QuestionnaireAssignment entities.
+func (c *QuestionnaireAssignmentClient) CreateBulk(builders ...*QuestionnaireAssignmentCreate) *QuestionnaireAssignmentCreateBulk {
+	return &QuestionnaireAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuestionnaireAssignmentClient) MapCreateBulk(slice any, setFunc func(*QuestionnaireAssignmentCreate, int)) *QuestionnaireAssignmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuestionnaireAssignmentCreateBulk{err: fmt.Errorf("calling to QuestionnaireAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuestionnaireAssignmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuestionnaireAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QuestionnaireAssignment.
+func (c *QuestionnaireAssignmentClient) Update() *QuestionnaireAssignmentUpdate {
+	mutation := newQuestionnaireAssignmentMutation(c.config, OpUpdate)
+	return &QuestionnaireAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuestionnaireAssignmentClient) UpdateOne(_m *QuestionnaireAssignment) *QuestionnaireAssignmentUpdateOne {
+	mutation := newQuestionnaireAssignmentMutation(c.config, OpUpdateOne, withQuestionnaireAssignment(_m))
+	return &QuestionnaireAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuestionnaireAssignmentClient) UpdateOneID(id uuid.UUID) *QuestionnaireAssignmentUpdateOne {
+	mutation := newQuestionnaireAssignmentMutation(c.config, OpUpdateOne, withQuestionnaireAssignmentID(id))
+	return &QuestionnaireAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QuestionnaireAssignment.
+func (c *QuestionnaireAssignmentClient) Delete() *QuestionnaireAssignmentDelete {
+	mutation := newQuestionnaireAssignmentMutation(c.config, OpDelete)
+	return &QuestionnaireAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuestionnaireAssignmentClient) DeleteOne(_m *QuestionnaireAssignment) *QuestionnaireAssignmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuestionnaireAssignmentClient) DeleteOneID(id uuid.UUID) *QuestionnaireAssignmentDeleteOne {
+	builder := c.Delete().Where(questionnaireassignment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuestionnaireAssignmentDeleteOne{builder}
+}
+
+// Query returns a query builder for QuestionnaireAssignment.
+func (c *QuestionnaireAssignmentClient) Query() *QuestionnaireAssignmentQuery {
+	return &QuestionnaireAssignmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuestionnaireAssignment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QuestionnaireAssignment entity by its id.
+func (c *QuestionnaireAssignmentClient) Get(ctx context.Context, id uuid.UUID) (*QuestionnaireAssignment, error) {
+	return c.Query().Where(questionnaireassignment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuestionnaireAssignmentClient) GetX(ctx context.Context, id uuid.UUID) *QuestionnaireAssignment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPatient queries the patient edge of a QuestionnaireAssignment.
+func (c *QuestionnaireAssignmentClient) QueryPatient(_m *QuestionnaireAssignment) *PatientQuery {
+	query := (&PatientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnaireassignment.Table, questionnaireassignment.FieldID, id),
+			sqlgraph.To(patient.Table, patient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questionnaireassignment.PatientTable, questionnaireassignment.PatientColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDoctor queries the doctor edge of a QuestionnaireAssignment.
+func (c *QuestionnaireAssignmentClient) QueryDoctor(_m *QuestionnaireAssignment) *DoctorQuery {
+	query := (&DoctorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnaireassignment.Table, questionnaireassignment.FieldID, id),
+			sqlgraph.To(doctor.Table, doctor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questionnaireassignment.DoctorTable, questionnaireassignment.DoctorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDefinition queries the definition edge of a QuestionnaireAssignment.
+func (c *QuestionnaireAssignmentClient) QueryDefinition(_m *QuestionnaireAssignment) *QuestionnaireDefinitionQuery {
+	query := (&QuestionnaireDefinitionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnaireassignment.Table, questionnaireassignment.FieldID, id),
+			sqlgraph.To(questionnairedefinition.Table, questionnairedefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questionnaireassignment.DefinitionTable, questionnaireassignment.DefinitionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResponse queries the response edge of a QuestionnaireAssignment.
+func (c *QuestionnaireAssignmentClient) QueryResponse(_m *QuestionnaireAssignment) *QuestionnaireResponseQuery {
+	query := (&QuestionnaireResponseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnaireassignment.Table, questionnaireassignment.FieldID, id),
+			sqlgraph.To(questionnaireresponse.Table, questionnaireresponse.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, questionnaireassignment.ResponseTable, questionnaireassignment.ResponseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QuestionnaireAssignmentClient) Hooks() []Hook {
+	return c.hooks.QuestionnaireAssignment
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuestionnaireAssignmentClient) Interceptors() []Interceptor {
+	return c.inters.QuestionnaireAssignment
+}
+
+func (c *QuestionnaireAssignmentClient) mutate(ctx context.Context, m *QuestionnaireAssignmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuestionnaireAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuestionnaireAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuestionnaireAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuestionnaireAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown QuestionnaireAssignment mutation op: %q", m.Op())
+	}
+}
+
+// QuestionnaireDefinitionClient is a client for the QuestionnaireDefinition schema.
+type QuestionnaireDefinitionClient struct {
+	config
+}
+
+// NewQuestionnaireDefinitionClient returns a client for the QuestionnaireDefinition from the given config.
+func NewQuestionnaireDefinitionClient(c config) *QuestionnaireDefinitionClient {
+	return &QuestionnaireDefinitionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `questionnairedefinition.Hooks(f(g(h())))`.
+func (c *QuestionnaireDefinitionClient) Use(hooks ...Hook) {
+	c.hooks.QuestionnaireDefinition = append(c.hooks.QuestionnaireDefinition, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `questionnairedefinition.Intercept(f(g(h())))`.
+func (c *QuestionnaireDefinitionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QuestionnaireDefinition = append(c.inters.QuestionnaireDefinition, interceptors...)
+}
+
+// Create returns a builder for creating a QuestionnaireDefinition entity.
+func (c *QuestionnaireDefinitionClient) Create() *QuestionnaireDefinitionCreate {
+	mutation := newQuestionnaireDefinitionMutation(c.config, OpCreate)
+	return &QuestionnaireDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QuestionnaireDefinition entities.
+func (c *QuestionnaireDefinitionClient) CreateBulk(builders ...*QuestionnaireDefinitionCreate) *QuestionnaireDefinitionCreateBulk {
+	return &QuestionnaireDefinitionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuestionnaireDefinitionClient) MapCreateBulk(slice any, setFunc func(*QuestionnaireDefinitionCreate, int)) *QuestionnaireDefinitionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuestionnaireDefinitionCreateBulk{err: fmt.Errorf("calling to QuestionnaireDefinitionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuestionnaireDefinitionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuestionnaireDefinitionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QuestionnaireDefinition.
+func (c *QuestionnaireDefinitionClient) Update() *QuestionnaireDefinitionUpdate {
+	mutation := newQuestionnaireDefinitionMutation(c.config, OpUpdate)
+	return &QuestionnaireDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuestionnaireDefinitionClient) UpdateOne(_m *QuestionnaireDefinition) *QuestionnaireDefinitionUpdateOne {
+	mutation := newQuestionnaireDefinitionMutation(c.config, OpUpdateOne, withQuestionnaireDefinition(_m))
+	return &QuestionnaireDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuestionnaireDefinitionClient) UpdateOneID(id uuid.UUID) *QuestionnaireDefinitionUpdateOne {
+	mutation := newQuestionnaireDefinitionMutation(c.config, OpUpdateOne, withQuestionnaireDefinitionID(id))
+	return &QuestionnaireDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QuestionnaireDefinition.
+func (c *QuestionnaireDefinitionClient) Delete() *QuestionnaireDefinitionDelete {
+	mutation := newQuestionnaireDefinitionMutation(c.config, OpDelete)
+	return &QuestionnaireDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuestionnaireDefinitionClient) DeleteOne(_m *QuestionnaireDefinition) *QuestionnaireDefinitionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuestionnaireDefinitionClient) DeleteOneID(id uuid.UUID) *QuestionnaireDefinitionDeleteOne {
+	builder := c.Delete().Where(questionnairedefinition.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuestionnaireDefinitionDeleteOne{builder}
+}
+
+// Query returns a query builder for QuestionnaireDefinition.
+func (c *QuestionnaireDefinitionClient) Query() *QuestionnaireDefinitionQuery {
+	return &QuestionnaireDefinitionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuestionnaireDefinition},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QuestionnaireDefinition entity by its id.
+func (c *QuestionnaireDefinitionClient) Get(ctx context.Context, id uuid.UUID) (*QuestionnaireDefinition, error) {
+	return c.Query().Where(questionnairedefinition.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuestionnaireDefinitionClient) GetX(ctx context.Context, id uuid.UUID) *QuestionnaireDefinition {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCreatedByDoctor queries the created_by_doctor edge of a QuestionnaireDefinition.
+func (c *QuestionnaireDefinitionClient) QueryCreatedByDoctor(_m *QuestionnaireDefinition) *DoctorQuery {
+	query := (&DoctorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnairedefinition.Table, questionnairedefinition.FieldID, id),
+			sqlgraph.To(doctor.Table, doctor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questionnairedefinition.CreatedByDoctorTable, questionnairedefinition.CreatedByDoctorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignments queries the assignments edge of a QuestionnaireDefinition.
+func (c *QuestionnaireDefinitionClient) QueryAssignments(_m *QuestionnaireDefinition) *QuestionnaireAssignmentQuery {
+	query := (&QuestionnaireAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnairedefinition.Table, questionnairedefinition.FieldID, id),
+			sqlgraph.To(questionnaireassignment.Table, questionnaireassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, questionnairedefinition.AssignmentsTable, questionnairedefinition.AssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResponses queries the responses edge of a QuestionnaireDefinition.
+func (c *QuestionnaireDefinitionClient) QueryResponses(_m *QuestionnaireDefinition) *QuestionnaireResponseQuery {
+	query := (&QuestionnaireResponseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnairedefinition.Table, questionnairedefinition.FieldID, id),
+			sqlgraph.To(questionnaireresponse.Table, questionnaireresponse.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, questionnairedefinition.ResponsesTable, questionnairedefinition.ResponsesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QuestionnaireDefinitionClient) Hooks() []Hook {
+	return c.hooks.QuestionnaireDefinition
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuestionnaireDefinitionClient) Interceptors() []Interceptor {
+	return c.inters.QuestionnaireDefinition
+}
+
+func (c *QuestionnaireDefinitionClient) mutate(ctx context.Context, m *QuestionnaireDefinitionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuestionnaireDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuestionnaireDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuestionnaireDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuestionnaireDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown QuestionnaireDefinition mutation op: %q", m.Op())
+	}
+}
+
+// QuestionnaireResponseClient is a client for the QuestionnaireResponse schema.
+type QuestionnaireResponseClient struct {
+	config
+}
+
+// NewQuestionnaireResponseClient returns a client for the QuestionnaireResponse from the given config.
+func NewQuestionnaireResponseClient(c config) *QuestionnaireResponseClient {
+	return &QuestionnaireResponseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `questionnaireresponse.Hooks(f(g(h())))`.
+func (c *QuestionnaireResponseClient) Use(hooks ...Hook) {
+	c.hooks.QuestionnaireResponse = append(c.hooks.QuestionnaireResponse, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `questionnaireresponse.Intercept(f(g(h())))`.
+func (c *QuestionnaireResponseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QuestionnaireResponse = append(c.inters.QuestionnaireResponse, interceptors...)
+}
+
+// Create returns a builder for creating a QuestionnaireResponse entity.
+func (c *QuestionnaireResponseClient) Create() *QuestionnaireResponseCreate {
+	mutation := newQuestionnaireResponseMutation(c.config, OpCreate)
+	return &QuestionnaireResponseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QuestionnaireResponse entities.
+func (c *QuestionnaireResponseClient) CreateBulk(builders ...*QuestionnaireResponseCreate) *QuestionnaireResponseCreateBulk {
+	return &QuestionnaireResponseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuestionnaireResponseClient) MapCreateBulk(slice any, setFunc func(*QuestionnaireResponseCreate, int)) *QuestionnaireResponseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuestionnaireResponseCreateBulk{err: fmt.Errorf("calling to QuestionnaireResponseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuestionnaireResponseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuestionnaireResponseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QuestionnaireResponse.
+func (c *QuestionnaireResponseClient) Update() *QuestionnaireResponseUpdate {
+	mutation := newQuestionnaireResponseMutation(c.config, OpUpdate)
+	return &QuestionnaireResponseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuestionnaireResponseClient) UpdateOne(_m *QuestionnaireResponse) *QuestionnaireResponseUpdateOne {
+	mutation := newQuestionnaireResponseMutation(c.config, OpUpdateOne, withQuestionnaireResponse(_m))
+	return &QuestionnaireResponseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuestionnaireResponseClient) UpdateOneID(id uuid.UUID) *QuestionnaireResponseUpdateOne {
+	mutation := newQuestionnaireResponseMutation(c.config, OpUpdateOne, withQuestionnaireResponseID(id))
+	return &QuestionnaireResponseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QuestionnaireResponse.
+func (c *QuestionnaireResponseClient) Delete() *QuestionnaireResponseDelete {
+	mutation := newQuestionnaireResponseMutation(c.config, OpDelete)
+	return &QuestionnaireResponseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuestionnaireResponseClient) DeleteOne(_m *QuestionnaireResponse) *QuestionnaireResponseDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuestionnaireResponseClient) DeleteOneID(id uuid.UUID) *QuestionnaireResponseDeleteOne {
+	builder := c.Delete().Where(questionnaireresponse.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuestionnaireResponseDeleteOne{builder}
+}
+
+// Query returns a query builder for QuestionnaireResponse.
+func (c *QuestionnaireResponseClient) Query() *QuestionnaireResponseQuery {
+	return &QuestionnaireResponseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuestionnaireResponse},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QuestionnaireResponse entity by its id.
+func (c *QuestionnaireResponseClient) Get(ctx context.Context, id uuid.UUID) (*QuestionnaireResponse, error) {
+	return c.Query().Where(questionnaireresponse.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuestionnaireResponseClient) GetX(ctx context.Context, id uuid.UUID) *QuestionnaireResponse {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPatient queries the patient edge of a QuestionnaireResponse.
+func (c *QuestionnaireResponseClient) QueryPatient(_m *QuestionnaireResponse) *PatientQuery {
+	query := (&PatientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnaireresponse.Table, questionnaireresponse.FieldID, id),
+			sqlgraph.To(patient.Table, patient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questionnaireresponse.PatientTable, questionnaireresponse.PatientColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDefinition queries the definition edge of a QuestionnaireResponse.
+func (c *QuestionnaireResponseClient) QueryDefinition(_m *QuestionnaireResponse) *QuestionnaireDefinitionQuery {
+	query := (&QuestionnaireDefinitionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnaireresponse.Table, questionnaireresponse.FieldID, id),
+			sqlgraph.To(questionnairedefinition.Table, questionnairedefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questionnaireresponse.DefinitionTable, questionnaireresponse.DefinitionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignment queries the assignment edge of a QuestionnaireResponse.
+func (c *QuestionnaireResponseClient) QueryAssignment(_m *QuestionnaireResponse) *QuestionnaireAssignmentQuery {
+	query := (&QuestionnaireAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnaireresponse.Table, questionnaireresponse.FieldID, id),
+			sqlgraph.To(questionnaireassignment.Table, questionnaireassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, questionnaireresponse.AssignmentTable, questionnaireresponse.AssignmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QuestionnaireResponseClient) Hooks() []Hook {
+	return c.hooks.QuestionnaireResponse
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuestionnaireResponseClient) Interceptors() []Interceptor {
+	return c.inters.QuestionnaireResponse
+}
+
+func (c *QuestionnaireResponseClient) mutate(ctx context.Context, m *QuestionnaireResponseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuestionnaireResponseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuestionnaireResponseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuestionnaireResponseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuestionnaireResponseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown QuestionnaireResponse mutation op: %q", m.Op())
+	}
+}
+
+// RateLimitBucketClient is a client for the RateLimitBucket schema.
+type RateLimitBucketClient struct {
+	config
+}
+
+// NewRateLimitBucketClient returns a client for the RateLimitBucket from the given config.
+func NewRateLimitBucketClient(c config) *RateLimitBucketClient {
+	return &RateLimitBucketClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ratelimitbucket.Hooks(f(g(h())))`.
+func (c *RateLimitBucketClient) Use(hooks ...Hook) {
+	c.hooks.RateLimitBucket = append(c.hooks.RateLimitBucket, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ratelimitbucket.Intercept(f(g(h())))`.
+func (c *RateLimitBucketClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RateLimitBucket = append(c.inters.RateLimitBucket, interceptors...)
+}
+
+// Create returns a builder for creating a RateLimitBucket entity.
+func (c *RateLimitBucketClient) Create() *RateLimitBucketCreate {
+	mutation := newRateLimitBucketMutation(c.config, OpCreate)
+	return &RateLimitBucketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RateLimitBucket entities.
+func (c *RateLimitBucketClient) CreateBulk(builders ...*RateLimitBucketCreate) *RateLimitBucketCreateBulk {
+	return &RateLimitBucketCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RateLimitBucketClient) MapCreateBulk(slice any, setFunc func(*RateLimitBucketCreate, int)) *RateLimitBucketCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RateLimitBucketCreateBulk{err: fmt.Errorf("calling to RateLimitBucketClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RateLimitBucketCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RateLimitBucketCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RateLimitBucket.
+func (c *RateLimitBucketClient) Update() *RateLimitBucketUpdate {
+	mutation := newRateLimitBucketMutation(c.config, OpUpdate)
+	return &RateLimitBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RateLimitBucketClient) UpdateOne(_m *RateLimitBucket) *RateLimitBucketUpdateOne {
+	mutation := newRateLimitBucketMutation(c.config, OpUpdateOne, withRateLimitBucket(_m))
+	return &RateLimitBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RateLimitBucketClient) UpdateOneID(id string) *RateLimitBucketUpdateOne {
+	mutation := newRateLimitBucketMutation(c.config, OpUpdateOne, withRateLimitBucketID(id))
+	return &RateLimitBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RateLimitBucket.
+func (c *RateLimitBucketClient) Delete() *RateLimitBucketDelete {
+	mutation := newRateLimitBucketMutation(c.config, OpDelete)
+	return &RateLimitBucketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RateLimitBucketClient) DeleteOne(_m *RateLimitBucket) *RateLimitBucketDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RateLimitBucketClient) DeleteOneID(id string) *RateLimitBucketDeleteOne {
+	builder := c.Delete().Where(ratelimitbucket.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RateLimitBucketDeleteOne{builder}
+}
+
+// Query returns a query builder for RateLimitBucket.
+func (c *RateLimitBucketClient) Query() *RateLimitBucketQuery {
+	return &RateLimitBucketQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRateLimitBucket},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RateLimitBucket entity by its id.
+func (c *RateLimitBucketClient) Get(ctx context.Context, id string) (*RateLimitBucket, error) {
+	return c.Query().Where(ratelimitbucket.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RateLimitBucketClient) GetX(ctx context.Context, id string) *RateLimitBucket {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RateLimitBucketClient) Hooks() []Hook {
+	return c.hooks.RateLimitBucket
+}
+
+// Interceptors returns the client interceptors.
+func (c *RateLimitBucketClient) Interceptors() []Interceptor {
+	return c.inters.RateLimitBucket
+}
+
+func (c *RateLimitBucketClient) mutate(ctx context.Context, m *RateLimitBucketMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RateLimitBucketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RateLimitBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RateLimitBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RateLimitBucketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RateLimitBucket mutation op: %q", m.Op())
+	}
+}
+
+// ReminderDeliveryClient is a client for the ReminderDelivery schema.
+type ReminderDeliveryClient struct {
+	config
+}
+
+// NewReminderDeliveryClient returns a client for the ReminderDelivery from the given config.
+func NewReminderDeliveryClient(c config) *ReminderDeliveryClient {
+	return &ReminderDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reminderdelivery.Hooks(f(g(h())))`.
+func (c *ReminderDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.ReminderDelivery = append(c.hooks.ReminderDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reminderdelivery.Intercept(f(g(h())))`.
+func (c *ReminderDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReminderDelivery = append(c.inters.ReminderDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a ReminderDelivery entity.
+func (c *ReminderDeliveryClient) Create() *ReminderDeliveryCreate {
+	mutation := newReminderDeliveryMutation(c.config, OpCreate)
+	return &ReminderDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReminderDelivery entities.
+func (c *ReminderDeliveryClient) CreateBulk(builders ...*ReminderDeliveryCreate) *ReminderDeliveryCreateBulk {
+	return &ReminderDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReminderDeliveryClient) MapCreateBulk(slice any, setFunc func(*ReminderDeliveryCreate, int)) *ReminderDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReminderDeliveryCreateBulk{err: fmt.Errorf("calling to ReminderDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReminderDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReminderDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReminderDelivery.
+func (c *ReminderDeliveryClient) Update() *ReminderDeliveryUpdate {
+	mutation := newReminderDeliveryMutation(c.config, OpUpdate)
+	return &ReminderDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReminderDeliveryClient) UpdateOne(_m *ReminderDelivery) *ReminderDeliveryUpdateOne {
+	mutation := newReminderDeliveryMutation(c.config, OpUpdateOne, withReminderDelivery(_m))
+	return &ReminderDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReminderDeliveryClient) UpdateOneID(id uuid.UUID) *ReminderDeliveryUpdateOne {
+	mutation := newReminderDeliveryMutation(c.config, OpUpdateOne, withReminderDeliveryID(id))
+	return &ReminderDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReminderDelivery.
+func (c *ReminderDeliveryClient) Delete() *ReminderDeliveryDelete {
+	mutation := newReminderDeliveryMutation(c.config, OpDelete)
+	return &ReminderDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReminderDeliveryClient) DeleteOne(_m *ReminderDelivery) *ReminderDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReminderDeliveryClient) DeleteOneID(id uuid.UUID) *ReminderDeliveryDeleteOne {
+	builder := c.Delete().Where(reminderdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReminderDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for ReminderDelivery.
+func (c *ReminderDeliveryClient) Query() *ReminderDeliveryQuery {
+	return &ReminderDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReminderDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReminderDelivery entity by its id.
+func (c *ReminderDeliveryClient) Get(ctx context.Context, id uuid.UUID) (*ReminderDelivery, error) {
+	return c.Query().Where(reminderdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReminderDeliveryClient) GetX(ctx context.Context, id uuid.UUID) *ReminderDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySchedule queries the schedule edge of a ReminderDelivery.
+func (c *ReminderDeliveryClient) QuerySchedule(_m *ReminderDelivery) *ReminderScheduleQuery {
+	query := (&ReminderScheduleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reminderdelivery.Table, reminderdelivery.FieldID, id),
+			sqlgraph.To(reminderschedule.Table, reminderschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reminderdelivery.ScheduleTable, reminderdelivery.ScheduleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPatient queries the patient edge of a ReminderDelivery.
+func (c *ReminderDeliveryClient) QueryPatient(_m *ReminderDelivery) *PatientQuery {
+	query := (&PatientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reminderdelivery.Table, reminderdelivery.FieldID, id),
+			sqlgraph.To(patient.Table, patient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reminderdelivery.PatientTable, reminderdelivery.PatientColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReminderDeliveryClient) Hooks() []Hook {
+	return c.hooks.ReminderDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReminderDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.ReminderDelivery
+}
+
+func (c *ReminderDeliveryClient) mutate(ctx context.Context, m *ReminderDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReminderDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReminderDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReminderDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReminderDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReminderDelivery mutation op: %q", m.Op())
+	}
+}
+
+// ReminderScheduleClient is a client for the ReminderSchedule schema.
+type ReminderScheduleClient struct {
+	config
+}
+
+// NewReminderScheduleClient returns a client for the ReminderSchedule from the given config.
+func NewReminderScheduleClient(c config) *ReminderScheduleClient {
+	return &ReminderScheduleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reminderschedule.Hooks(f(g(h())))`.
+func (c *ReminderScheduleClient) Use(hooks ...Hook) {
+	c.hooks.ReminderSchedule = append(c.hooks.ReminderSchedule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reminderschedule.Intercept(f(g(h())))`.
+func (c *ReminderScheduleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReminderSchedule = append(c.inters.ReminderSchedule, interceptors...)
+}
+
+// Create returns a builder for creating a ReminderSchedule entity.
+func (c *ReminderScheduleClient) Create() *ReminderScheduleCreate {
+	mutation := newReminderScheduleMutation(c.config, OpCreate)
+	return &ReminderScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReminderSchedule entities.
+func (c *ReminderScheduleClient) CreateBulk(builders ...*ReminderScheduleCreate) *ReminderScheduleCreateBulk {
+	return &ReminderScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReminderScheduleClient) MapCreateBulk(slice any, setFunc func(*ReminderScheduleCreate, int)) *ReminderScheduleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReminderScheduleCreateBulk{err: fmt.Errorf("calling to ReminderScheduleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReminderScheduleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReminderScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReminderSchedule.
+func (c *ReminderScheduleClient) Update() *ReminderScheduleUpdate {
+	mutation := newReminderScheduleMutation(c.config, OpUpdate)
+	return &ReminderScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReminderScheduleClient) UpdateOne(_m *ReminderSchedule) *ReminderScheduleUpdateOne {
+	mutation := newReminderScheduleMutation(c.config, OpUpdateOne, withReminderSchedule(_m))
+	return &ReminderScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReminderScheduleClient) UpdateOneID(id uuid.UUID) *ReminderScheduleUpdateOne {
+	mutation := newReminderScheduleMutation(c.config, OpUpdateOne, withReminderScheduleID(id))
+	return &ReminderScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReminderSchedule.
+func (c *ReminderScheduleClient) Delete() *ReminderScheduleDelete {
+	mutation := newReminderScheduleMutation(c.config, OpDelete)
+	return &ReminderScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReminderScheduleClient) DeleteOne(_m *ReminderSchedule) *ReminderScheduleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReminderScheduleClient) DeleteOneID(id uuid.UUID) *ReminderScheduleDeleteOne {
+	builder := c.Delete().Where(reminderschedule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReminderScheduleDeleteOne{builder}
+}
+
+// Query returns a query builder for ReminderSchedule.
+func (c *ReminderScheduleClient) Query() *ReminderScheduleQuery {
+	return &ReminderScheduleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReminderSchedule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReminderSchedule entity by its id.
+func (c *ReminderScheduleClient) Get(ctx context.Context, id uuid.UUID) (*ReminderSchedule, error) {
+	return c.Query().Where(reminderschedule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReminderScheduleClient) GetX(ctx context.Context, id uuid.UUID) *ReminderSchedule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPatient queries the patient edge of a ReminderSchedule.
+func (c *ReminderScheduleClient) QueryPatient(_m *ReminderSchedule) *PatientQuery {
+	query := (&PatientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reminderschedule.Table, reminderschedule.FieldID, id),
+			sqlgraph.To(patient.Table, patient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reminderschedule.PatientTable, reminderschedule.PatientColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreatedBy queries the created_by edge of a ReminderSchedule.
+func (c *ReminderScheduleClient) QueryCreatedBy(_m *ReminderSchedule) *DoctorQuery {
+	query := (&DoctorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reminderschedule.Table, reminderschedule.FieldID, id),
+			sqlgraph.To(doctor.Table, doctor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reminderschedule.CreatedByTable, reminderschedule.CreatedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeliveries queries the deliveries edge of a ReminderSchedule.
+func (c *ReminderScheduleClient) QueryDeliveries(_m *ReminderSchedule) *ReminderDeliveryQuery {
+	query := (&ReminderDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reminderschedule.Table, reminderschedule.FieldID, id),
+			sqlgraph.To(reminderdelivery.Table, reminderdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reminderschedule.DeliveriesTable, reminderschedule.DeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReminderScheduleClient) Hooks() []Hook {
+	return c.hooks.ReminderSchedule
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReminderScheduleClient) Interceptors() []Interceptor {
+	return c.inters.ReminderSchedule
+}
+
+func (c *ReminderScheduleClient) mutate(ctx context.Context, m *ReminderScheduleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReminderScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReminderScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReminderScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReminderScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReminderSchedule mutation op: %q", m.Op())
+	}
+}
+
+// SessionClient is a client for the Session schema.
+type SessionClient struct {
+	config
+}
+
+// NewSessionClient returns a client for the Session from the given config.
+func NewSessionClient(c config) *SessionClient {
+	return &SessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `session.Hooks(f(g(h())))`.
+func (c *SessionClient) Use(hooks ...Hook) {
+	c.hooks.Session = append(c.hooks.Session, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `session.Intercept(f(g(h())))`.
+func (c *SessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Session = append(c.inters.Session, interceptors...)
+}
+
+// Create returns a builder for creating a Session entity.
+func (c *SessionClient) Create() *SessionCreate {
+	mutation := newSessionMutation(c.config, OpCreate)
+	return &SessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Session entities.
+func (c *SessionClient) CreateBulk(builders ...*SessionCreate) *SessionCreateBulk {
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SessionClient) MapCreateBulk(slice any, setFunc func(*SessionCreate, int)) *SessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SessionCreateBulk{err: fmt.Errorf("calling to SessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Session.
+func (c *SessionClient) Update() *SessionUpdate {
+	mutation := newSessionMutation(c.config, OpUpdate)
+	return &SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SessionClient) UpdateOne(_m *Session) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSession(_m))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SessionClient) UpdateOneID(id uuid.UUID) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSessionID(id))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Session.
+func (c *SessionClient) Delete() *SessionDelete {
+	mutation := newSessionMutation(c.config, OpDelete)
+	return &SessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SessionClient) DeleteOne(_m *Session) *SessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SessionClient) DeleteOneID(id uuid.UUID) *SessionDeleteOne {
+	builder := c.Delete().Where(session.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SessionDeleteOne{builder}
+}
+
+// Query returns a query builder for Session.
+func (c *SessionClient) Query() *SessionQuery {
+	return &SessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Session entity by its id.
+func (c *SessionClient) Get(ctx context.Context, id uuid.UUID) (*Session, error) {
+	return c.Query().Where(session.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SessionClient) GetX(ctx context.Context, id uuid.UUID) *Session {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPatient queries the patient edge of a Session.
+func (c *SessionClient) QueryPatient(_m *Session) *PatientQuery {
+	query := (&PatientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(session.Table, session.FieldID, id),
+			sqlgraph.To(patient.Table, patient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, session.PatientTable, session.PatientColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDoctor queries the doctor edge of a Session.
+func (c *SessionClient) QueryDoctor(_m *Session) *DoctorQuery {
+	query := (&DoctorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(session.Table, session.FieldID, id),
+			sqlgraph.To(doctor.Table, doctor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, session.DoctorTable, session.DoctorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEntries queries the entries edge of a Session.
+func (c *SessionClient) QueryEntries(_m *Session) *EntryQuery {
+	query := (&EntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(session.Table, session.FieldID, id),
+			sqlgraph.To(entry.Table, entry.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, session.EntriesTable, session.EntriesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SessionClient) Hooks() []Hook {
+	return c.hooks.Session
+}
+
+// Interceptors returns the client interceptors.
+func (c *SessionClient) Interceptors() []Interceptor {
+	return c.inters.Session
+}
+
+func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Session mutation op: %q", m.Op())
+	}
+}
+
+// VocabularyTermClient is a client for the VocabularyTerm schema.
+type VocabularyTermClient struct {
+	config
+}
+
+// NewVocabularyTermClient returns a client for the VocabularyTerm from the given config.
+func NewVocabularyTermClient(c config) *VocabularyTermClient {
+	return &VocabularyTermClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vocabularyterm.Hooks(f(g(h())))`.
+func (c *VocabularyTermClient) Use(hooks ...Hook) {
+	c.hooks.VocabularyTerm = append(c.hooks.VocabularyTerm, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vocabularyterm.Intercept(f(g(h())))`.
+func (c *VocabularyTermClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VocabularyTerm = append(c.inters.VocabularyTerm, interceptors...)
+}
+
+// Create returns a builder for creating a VocabularyTerm entity.
+func (c *VocabularyTermClient) Create() *VocabularyTermCreate {
+	mutation := newVocabularyTermMutation(c.config, OpCreate)
+	return &VocabularyTermCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VocabularyTerm entities.
+func (c *VocabularyTermClient) CreateBulk(builders ...*VocabularyTermCreate) *VocabularyTermCreateBulk {
+	return &VocabularyTermCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VocabularyTermClient) MapCreateBulk(slice any, setFunc func(*VocabularyTermCreate, int)) *VocabularyTermCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VocabularyTermCreateBulk{err: fmt.Errorf("calling to VocabularyTermClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VocabularyTermCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VocabularyTermCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VocabularyTerm.
+func (c *VocabularyTermClient) Update() *VocabularyTermUpdate {
+	mutation := newVocabularyTermMutation(c.config, OpUpdate)
+	return &VocabularyTermUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VocabularyTermClient) UpdateOne(_m *VocabularyTerm) *VocabularyTermUpdateOne {
+	mutation := newVocabularyTermMutation(c.config, OpUpdateOne, withVocabularyTerm(_m))
+	return &VocabularyTermUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VocabularyTermClient) UpdateOneID(id uuid.UUID) *VocabularyTermUpdateOne {
+	mutation := newVocabularyTermMutation(c.config, OpUpdateOne, withVocabularyTermID(id))
+	return &VocabularyTermUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VocabularyTerm.
+func (c *VocabularyTermClient) Delete() *VocabularyTermDelete {
+	mutation := newVocabularyTermMutation(c.config, OpDelete)
+	return &VocabularyTermDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VocabularyTermClient) DeleteOne(_m *VocabularyTerm) *VocabularyTermDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VocabularyTermClient) DeleteOneID(id uuid.UUID) *VocabularyTermDeleteOne {
+	builder := c.Delete().Where(vocabularyterm.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PairingCodeDeleteOne{builder}
+	return &VocabularyTermDeleteOne{builder}
 }
 
-// Query returns a query builder for PairingCode.
-func (c *PairingCodeClient) Query() *PairingCodeQuery {
-	return &PairingCodeQuery{
+// Query returns a query builder for VocabularyTerm.
+func (c *VocabularyTermClient) Query() *VocabularyTermQuery {
+	return &VocabularyTermQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePairingCode},
+		ctx:    &QueryContext{Type: TypeVocabularyTerm},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PairingCode entity by its id.
-func (c *PairingCodeClient) Get(ctx context.Context, id uuid.UUID) (*PairingCode, error) {
-	return c.Query().Where(pairingcode.ID(id)).Only(ctx)
+// Get returns a VocabularyTerm entity by its id.
+func (c *VocabularyTermClient) Get(ctx context.Context, id uuid.UUID) (*VocabularyTerm, error) {
+	return c.Query().Where(vocabularyterm.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PairingCodeClient) GetX(ctx context.Context, id uuid.UUID) *PairingCode {
+func (c *VocabularyTermClient) GetX(ctx context.Context, id uuid.UUID) *VocabularyTerm {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1531,15 +4488,15 @@ func (c *PairingCodeClient) GetX(ctx context.Context, id uuid.UUID) *PairingCode
 	return obj
 }
 
-// QueryDoctor queries the doctor edge of a PairingCode.
-func (c *PairingCodeClient) QueryDoctor(_m *PairingCode) *DoctorQuery {
-	query := (&DoctorClient{config: c.config}).Query()
+// QueryPractice queries the practice edge of a VocabularyTerm.
+func (c *VocabularyTermClient) QueryPractice(_m *VocabularyTerm) *PracticeQuery {
+	query := (&PracticeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(pairingcode.Table, pairingcode.FieldID, id),
-			sqlgraph.To(doctor.Table, doctor.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, pairingcode.DoctorTable, pairingcode.DoctorColumn),
+			sqlgraph.From(vocabularyterm.Table, vocabularyterm.FieldID, id),
+			sqlgraph.To(practice.Table, practice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, vocabularyterm.PracticeTable, vocabularyterm.PracticeColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1547,15 +4504,15 @@ func (c *PairingCodeClient) QueryDoctor(_m *PairingCode) *DoctorQuery {
 	return query
 }
 
-// QueryConsumedByPatient queries the consumed_by_patient edge of a PairingCode.
-func (c *PairingCodeClient) QueryConsumedByPatient(_m *PairingCode) *PatientQuery {
-	query := (&PatientClient{config: c.config}).Query()
+// QueryDoctor queries the doctor edge of a VocabularyTerm.
+func (c *VocabularyTermClient) QueryDoctor(_m *VocabularyTerm) *DoctorQuery {
+	query := (&DoctorClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(pairingcode.Table, pairingcode.FieldID, id),
-			sqlgraph.To(patient.Table, patient.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, pairingcode.ConsumedByPatientTable, pairingcode.ConsumedByPatientColumn),
+			sqlgraph.From(vocabularyterm.Table, vocabularyterm.FieldID, id),
+			sqlgraph.To(doctor.Table, doctor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, vocabularyterm.DoctorTable, vocabularyterm.DoctorColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1564,131 +4521,131 @@ func (c *PairingCodeClient) QueryConsumedByPatient(_m *PairingCode) *PatientQuer
 }
 
 // Hooks returns the client hooks.
-func (c *PairingCodeClient) Hooks() []Hook {
-	return c.hooks.PairingCode
+func (c *VocabularyTermClient) Hooks() []Hook {
+	return c.hooks.VocabularyTerm
 }
 
 // Interceptors returns the client interceptors.
-func (c *PairingCodeClient) Interceptors() []Interceptor {
-	return c.inters.PairingCode
+func (c *VocabularyTermClient) Interceptors() []Interceptor {
+	return c.inters.VocabularyTerm
 }
 
-func (c *PairingCodeClient) mutate(ctx context.Context, m *PairingCodeMutation) (Value, error) {
+func (c *VocabularyTermClient) mutate(ctx context.Context, m *VocabularyTermMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PairingCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VocabularyTermCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PairingCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VocabularyTermUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PairingCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VocabularyTermUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PairingCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&VocabularyTermDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PairingCode mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown VocabularyTerm mutation op: %q", m.Op())
 	}
 }
 
-// PatientClient is a client for the Patient schema.
-type PatientClient struct {
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
 	config
 }
 
-// NewPatientClient returns a client for the Patient from the given config.
-func NewPatientClient(c config) *PatientClient {
-	return &PatientClient{config: c}
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `patient.Hooks(f(g(h())))`.
-func (c *PatientClient) Use(hooks ...Hook) {
-	c.hooks.Patient = append(c.hooks.Patient, hooks...)
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `patient.Intercept(f(g(h())))`.
-func (c *PatientClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Patient = append(c.inters.Patient, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
 }
 
-// Create returns a builder for creating a Patient entity.
-func (c *PatientClient) Create() *PatientCreate {
-	mutation := newPatientMutation(c.config, OpCreate)
-	return &PatientCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Patient entities.
-func (c *PatientClient) CreateBulk(builders ...*PatientCreate) *PatientCreateBulk {
-	return &PatientCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PatientClient) MapCreateBulk(slice any, setFunc func(*PatientCreate, int)) *PatientCreateBulk {
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PatientCreateBulk{err: fmt.Errorf("calling to PatientClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PatientCreate, rv.Len())
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PatientCreateBulk{config: c.config, builders: builders}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Patient.
-func (c *PatientClient) Update() *PatientUpdate {
-	mutation := newPatientMutation(c.config, OpUpdate)
-	return &PatientUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PatientClient) UpdateOne(_m *Patient) *PatientUpdateOne {
-	mutation := newPatientMutation(c.config, OpUpdateOne, withPatient(_m))
-	return &PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PatientClient) UpdateOneID(id uuid.UUID) *PatientUpdateOne {
-	mutation := newPatientMutation(c.config, OpUpdateOne, withPatientID(id))
-	return &PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookDeliveryClient) UpdateOneID(id uuid.UUID) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Patient.
-func (c *PatientClient) Delete() *PatientDelete {
-	mutation := newPatientMutation(c.config, OpDelete)
-	return &PatientDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PatientClient) DeleteOne(_m *Patient) *PatientDeleteOne {
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PatientClient) DeleteOneID(id uuid.UUID) *PatientDeleteOne {
-	builder := c.Delete().Where(patient.ID(id))
+func (c *WebhookDeliveryClient) DeleteOneID(id uuid.UUID) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PatientDeleteOne{builder}
+	return &WebhookDeliveryDeleteOne{builder}
 }
 
-// Query returns a query builder for Patient.
-func (c *PatientClient) Query() *PatientQuery {
-	return &PatientQuery{
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePatient},
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Patient entity by its id.
-func (c *PatientClient) Get(ctx context.Context, id uuid.UUID) (*Patient, error) {
-	return c.Query().Where(patient.ID(id)).Only(ctx)
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PatientClient) GetX(ctx context.Context, id uuid.UUID) *Patient {
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id uuid.UUID) *WebhookDelivery {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1696,79 +4653,15 @@ func (c *PatientClient) GetX(ctx context.Context, id uuid.UUID) *Patient {
 	return obj
 }
 
-// QueryDoctorLinks queries the doctor_links edge of a Patient.
-func (c *PatientClient) QueryDoctorLinks(_m *Patient) *DoctorPatientLinkQuery {
-	query := (&DoctorPatientLinkClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patient.Table, patient.FieldID, id),
-			sqlgraph.To(doctorpatientlink.Table, doctorpatientlink.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, patient.DoctorLinksTable, patient.DoctorLinksColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryConsumedPairingCodes queries the consumed_pairing_codes edge of a Patient.
-func (c *PatientClient) QueryConsumedPairingCodes(_m *Patient) *PairingCodeQuery {
-	query := (&PairingCodeClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patient.Table, patient.FieldID, id),
-			sqlgraph.To(pairingcode.Table, pairingcode.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, patient.ConsumedPairingCodesTable, patient.ConsumedPairingCodesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEntries queries the entries edge of a Patient.
-func (c *PatientClient) QueryEntries(_m *Patient) *EntryQuery {
-	query := (&EntryClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patient.Table, patient.FieldID, id),
-			sqlgraph.To(entry.Table, entry.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, patient.EntriesTable, patient.EntriesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAnalysisJobs queries the analysis_jobs edge of a Patient.
-func (c *PatientClient) QueryAnalysisJobs(_m *Patient) *AnalysisJobQuery {
-	query := (&AnalysisJobClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(patient.Table, patient.FieldID, id),
-			sqlgraph.To(analysisjob.Table, analysisjob.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, patient.AnalysisJobsTable, patient.AnalysisJobsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEntryShares queries the entry_shares edge of a Patient.
-func (c *PatientClient) QueryEntryShares(_m *Patient) *EntryShareQuery {
-	query := (&EntryShareClient{config: c.config}).Query()
+// QuerySubscription queries the subscription edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QuerySubscription(_m *WebhookDelivery) *WebhookSubscriptionQuery {
+	query := (&WebhookSubscriptionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(patient.Table, patient.FieldID, id),
-			sqlgraph.To(entryshare.Table, entryshare.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, patient.EntrySharesTable, patient.EntrySharesColumn),
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhooksubscription.Table, webhooksubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.SubscriptionTable, webhookdelivery.SubscriptionColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1777,131 +4670,131 @@ func (c *PatientClient) QueryEntryShares(_m *Patient) *EntryShareQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *PatientClient) Hooks() []Hook {
-	return c.hooks.Patient
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
 }
 
 // Interceptors returns the client interceptors.
-func (c *PatientClient) Interceptors() []Interceptor {
-	return c.inters.Patient
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
 }
 
-func (c *PatientClient) mutate(ctx context.Context, m *PatientMutation) (Value, error) {
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PatientCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PatientUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PatientUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PatientDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Patient mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
 	}
 }
 
-// PracticeClient is a client for the Practice schema.
-type PracticeClient struct {
+// WebhookSubscriptionClient is a client for the WebhookSubscription schema.
+type WebhookSubscriptionClient struct {
 	config
 }
 
-// NewPracticeClient returns a client for the Practice from the given config.
-func NewPracticeClient(c config) *PracticeClient {
-	return &PracticeClient{config: c}
+// NewWebhookSubscriptionClient returns a client for the WebhookSubscription from the given config.
+func NewWebhookSubscriptionClient(c config) *WebhookSubscriptionClient {
+	return &WebhookSubscriptionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `practice.Hooks(f(g(h())))`.
-func (c *PracticeClient) Use(hooks ...Hook) {
-	c.hooks.Practice = append(c.hooks.Practice, hooks...)
+// A call to `Use(f, g, h)` equals to `webhooksubscription.Hooks(f(g(h())))`.
+func (c *WebhookSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.WebhookSubscription = append(c.hooks.WebhookSubscription, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `practice.Intercept(f(g(h())))`.
-func (c *PracticeClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Practice = append(c.inters.Practice, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `webhooksubscription.Intercept(f(g(h())))`.
+func (c *WebhookSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookSubscription = append(c.inters.WebhookSubscription, interceptors...)
 }
 
-// Create returns a builder for creating a Practice entity.
-func (c *PracticeClient) Create() *PracticeCreate {
-	mutation := newPracticeMutation(c.config, OpCreate)
-	return &PracticeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WebhookSubscription entity.
+func (c *WebhookSubscriptionClient) Create() *WebhookSubscriptionCreate {
+	mutation := newWebhookSubscriptionMutation(c.config, OpCreate)
+	return &WebhookSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Practice entities.
-func (c *PracticeClient) CreateBulk(builders ...*PracticeCreate) *PracticeCreateBulk {
-	return &PracticeCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WebhookSubscription entities.
+func (c *WebhookSubscriptionClient) CreateBulk(builders ...*WebhookSubscriptionCreate) *WebhookSubscriptionCreateBulk {
+	return &WebhookSubscriptionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PracticeClient) MapCreateBulk(slice any, setFunc func(*PracticeCreate, int)) *PracticeCreateBulk {
+func (c *WebhookSubscriptionClient) MapCreateBulk(slice any, setFunc func(*WebhookSubscriptionCreate, int)) *WebhookSubscriptionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PracticeCreateBulk{err: fmt.Errorf("calling to PracticeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WebhookSubscriptionCreateBulk{err: fmt.Errorf("calling to WebhookSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PracticeCreate, rv.Len())
+	builders := make([]*WebhookSubscriptionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PracticeCreateBulk{config: c.config, builders: builders}
+	return &WebhookSubscriptionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Practice.
-func (c *PracticeClient) Update() *PracticeUpdate {
-	mutation := newPracticeMutation(c.config, OpUpdate)
-	return &PracticeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Update() *WebhookSubscriptionUpdate {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdate)
+	return &WebhookSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PracticeClient) UpdateOne(_m *Practice) *PracticeUpdateOne {
-	mutation := newPracticeMutation(c.config, OpUpdateOne, withPractice(_m))
-	return &PracticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookSubscriptionClient) UpdateOne(_m *WebhookSubscription) *WebhookSubscriptionUpdateOne {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdateOne, withWebhookSubscription(_m))
+	return &WebhookSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PracticeClient) UpdateOneID(id uuid.UUID) *PracticeUpdateOne {
-	mutation := newPracticeMutation(c.config, OpUpdateOne, withPracticeID(id))
-	return &PracticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookSubscriptionClient) UpdateOneID(id uuid.UUID) *WebhookSubscriptionUpdateOne {
+	mutation := newWebhookSubscriptionMutation(c.config, OpUpdateOne, withWebhookSubscriptionID(id))
+	return &WebhookSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Practice.
-func (c *PracticeClient) Delete() *PracticeDelete {
-	mutation := newPracticeMutation(c.config, OpDelete)
-	return &PracticeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Delete() *WebhookSubscriptionDelete {
+	mutation := newWebhookSubscriptionMutation(c.config, OpDelete)
+	return &WebhookSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PracticeClient) DeleteOne(_m *Practice) *PracticeDeleteOne {
+func (c *WebhookSubscriptionClient) DeleteOne(_m *WebhookSubscription) *WebhookSubscriptionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PracticeClient) DeleteOneID(id uuid.UUID) *PracticeDeleteOne {
-	builder := c.Delete().Where(practice.ID(id))
+func (c *WebhookSubscriptionClient) DeleteOneID(id uuid.UUID) *WebhookSubscriptionDeleteOne {
+	builder := c.Delete().Where(webhooksubscription.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PracticeDeleteOne{builder}
+	return &WebhookSubscriptionDeleteOne{builder}
 }
 
-// Query returns a query builder for Practice.
-func (c *PracticeClient) Query() *PracticeQuery {
-	return &PracticeQuery{
+// Query returns a query builder for WebhookSubscription.
+func (c *WebhookSubscriptionClient) Query() *WebhookSubscriptionQuery {
+	return &WebhookSubscriptionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePractice},
+		ctx:    &QueryContext{Type: TypeWebhookSubscription},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Practice entity by its id.
-func (c *PracticeClient) Get(ctx context.Context, id uuid.UUID) (*Practice, error) {
-	return c.Query().Where(practice.ID(id)).Only(ctx)
+// Get returns a WebhookSubscription entity by its id.
+func (c *WebhookSubscriptionClient) Get(ctx context.Context, id uuid.UUID) (*WebhookSubscription, error) {
+	return c.Query().Where(webhooksubscription.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PracticeClient) GetX(ctx context.Context, id uuid.UUID) *Practice {
+func (c *WebhookSubscriptionClient) GetX(ctx context.Context, id uuid.UUID) *WebhookSubscription {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1909,15 +4802,47 @@ func (c *PracticeClient) GetX(ctx context.Context, id uuid.UUID) *Practice {
 	return obj
 }
 
-// QueryDoctors queries the doctors edge of a Practice.
-func (c *PracticeClient) QueryDoctors(_m *Practice) *DoctorQuery {
+// QueryPractice queries the practice edge of a WebhookSubscription.
+func (c *WebhookSubscriptionClient) QueryPractice(_m *WebhookSubscription) *PracticeQuery {
+	query := (&PracticeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhooksubscription.Table, webhooksubscription.FieldID, id),
+			sqlgraph.To(practice.Table, practice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhooksubscription.PracticeTable, webhooksubscription.PracticeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCreatedBy queries the created_by edge of a WebhookSubscription.
+func (c *WebhookSubscriptionClient) QueryCreatedBy(_m *WebhookSubscription) *DoctorQuery {
 	query := (&DoctorClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(practice.Table, practice.FieldID, id),
+			sqlgraph.From(webhooksubscription.Table, webhooksubscription.FieldID, id),
 			sqlgraph.To(doctor.Table, doctor.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, practice.DoctorsTable, practice.DoctorsColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhooksubscription.CreatedByTable, webhooksubscription.CreatedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeliveries queries the deliveries edge of a WebhookSubscription.
+func (c *WebhookSubscriptionClient) QueryDeliveries(_m *WebhookSubscription) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhooksubscription.Table, webhooksubscription.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhooksubscription.DeliveriesTable, webhooksubscription.DeliveriesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1926,38 +4851,68 @@ func (c *PracticeClient) QueryDoctors(_m *Practice) *DoctorQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *PracticeClient) Hooks() []Hook {
-	return c.hooks.Practice
+func (c *WebhookSubscriptionClient) Hooks() []Hook {
+	return c.hooks.WebhookSubscription
 }
 
 // Interceptors returns the client interceptors.
-func (c *PracticeClient) Interceptors() []Interceptor {
-	return c.inters.Practice
+func (c *WebhookSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.WebhookSubscription
 }
 
-func (c *PracticeClient) mutate(ctx context.Context, m *PracticeMutation) (Value, error) {
+func (c *WebhookSubscriptionClient) mutate(ctx context.Context, m *WebhookSubscriptionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PracticeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PracticeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PracticeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PracticeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WebhookSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Practice mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WebhookSubscription mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AnalysisJob, Comment, Doctor, DoctorPatientLink, Entry, EntryShare, PairingCode,
-		Patient, Practice []ent.Hook
+		AnalysisJob, Assignment, Comment, Doctor, DoctorPatientLink, Entry, EntryShare,
+		ExerciseLog, Goal, IdempotencyKey, OutboxEvent, PairingCode, Patient, Practice,
+		QuestionnaireAssignment, QuestionnaireDefinition, QuestionnaireResponse,
+		RateLimitBucket, ReminderDelivery, ReminderSchedule, Session, VocabularyTerm,
+		WebhookDelivery, WebhookSubscription []ent.Hook
 	}
 	inters struct {
-		AnalysisJob, Comment, Doctor, DoctorPatientLink, Entry, EntryShare, PairingCode,
-		Patient, Practice []ent.Interceptor
+		AnalysisJob, Assignment, Comment, Doctor, DoctorPatientLink, Entry, EntryShare,
+		ExerciseLog, Goal, IdempotencyKey, OutboxEvent, PairingCode, Patient, Practice,
+		QuestionnaireAssignment, QuestionnaireDefinition, QuestionnaireResponse,
+		RateLimitBucket, ReminderDelivery, ReminderSchedule, Session, VocabularyTerm,
+		WebhookDelivery, WebhookSubscription []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
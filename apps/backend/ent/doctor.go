@@ -55,9 +55,25 @@ type DoctorEdges struct {
 	Comments []*Comment `json:"comments,omitempty"`
 	// CreatedAnalysisJobs holds the value of the created_analysis_jobs edge.
 	CreatedAnalysisJobs []*AnalysisJob `json:"created_analysis_jobs,omitempty"`
+	// AssignedGoals holds the value of the assigned_goals edge.
+	AssignedGoals []*Goal `json:"assigned_goals,omitempty"`
+	// AssignedExercises holds the value of the assigned_exercises edge.
+	AssignedExercises []*Assignment `json:"assigned_exercises,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
+	// QuestionnaireAssignments holds the value of the questionnaire_assignments edge.
+	QuestionnaireAssignments []*QuestionnaireAssignment `json:"questionnaire_assignments,omitempty"`
+	// QuestionnaireDefinitions holds the value of the questionnaire_definitions edge.
+	QuestionnaireDefinitions []*QuestionnaireDefinition `json:"questionnaire_definitions,omitempty"`
+	// ReminderSchedules holds the value of the reminder_schedules edge.
+	ReminderSchedules []*ReminderSchedule `json:"reminder_schedules,omitempty"`
+	// WebhookSubscriptions holds the value of the webhook_subscriptions edge.
+	WebhookSubscriptions []*WebhookSubscription `json:"webhook_subscriptions,omitempty"`
+	// VocabularyTerms holds the value of the vocabulary_terms edge.
+	VocabularyTerms []*VocabularyTerm `json:"vocabulary_terms,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [15]bool
 }
 
 // PracticeOrErr returns the Practice value or an error if the edge
@@ -125,6 +141,78 @@ func (e DoctorEdges) CreatedAnalysisJobsOrErr() ([]*AnalysisJob, error) {
 	return nil, &NotLoadedError{edge: "created_analysis_jobs"}
 }
 
+// AssignedGoalsOrErr returns the AssignedGoals value or an error if the edge
+// was not loaded in eager-loading.
+func (e DoctorEdges) AssignedGoalsOrErr() ([]*Goal, error) {
+	if e.loadedTypes[7] {
+		return e.AssignedGoals, nil
+	}
+	return nil, &NotLoadedError{edge: "assigned_goals"}
+}
+
+// AssignedExercisesOrErr returns the AssignedExercises value or an error if the edge
+// was not loaded in eager-loading.
+func (e DoctorEdges) AssignedExercisesOrErr() ([]*Assignment, error) {
+	if e.loadedTypes[8] {
+		return e.AssignedExercises, nil
+	}
+	return nil, &NotLoadedError{edge: "assigned_exercises"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e DoctorEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[9] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// QuestionnaireAssignmentsOrErr returns the QuestionnaireAssignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e DoctorEdges) QuestionnaireAssignmentsOrErr() ([]*QuestionnaireAssignment, error) {
+	if e.loadedTypes[10] {
+		return e.QuestionnaireAssignments, nil
+	}
+	return nil, &NotLoadedError{edge: "questionnaire_assignments"}
+}
+
+// QuestionnaireDefinitionsOrErr returns the QuestionnaireDefinitions value or an error if the edge
+// was not loaded in eager-loading.
+func (e DoctorEdges) QuestionnaireDefinitionsOrErr() ([]*QuestionnaireDefinition, error) {
+	if e.loadedTypes[11] {
+		return e.QuestionnaireDefinitions, nil
+	}
+	return nil, &NotLoadedError{edge: "questionnaire_definitions"}
+}
+
+// ReminderSchedulesOrErr returns the ReminderSchedules value or an error if the edge
+// was not loaded in eager-loading.
+func (e DoctorEdges) ReminderSchedulesOrErr() ([]*ReminderSchedule, error) {
+	if e.loadedTypes[12] {
+		return e.ReminderSchedules, nil
+	}
+	return nil, &NotLoadedError{edge: "reminder_schedules"}
+}
+
+// WebhookSubscriptionsOrErr returns the WebhookSubscriptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e DoctorEdges) WebhookSubscriptionsOrErr() ([]*WebhookSubscription, error) {
+	if e.loadedTypes[13] {
+		return e.WebhookSubscriptions, nil
+	}
+	return nil, &NotLoadedError{edge: "webhook_subscriptions"}
+}
+
+// VocabularyTermsOrErr returns the VocabularyTerms value or an error if the edge
+// was not loaded in eager-loading.
+func (e DoctorEdges) VocabularyTermsOrErr() ([]*VocabularyTerm, error) {
+	if e.loadedTypes[14] {
+		return e.VocabularyTerms, nil
+	}
+	return nil, &NotLoadedError{edge: "vocabulary_terms"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Doctor) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -250,6 +338,46 @@ func (_m *Doctor) QueryCreatedAnalysisJobs() *AnalysisJobQuery {
 	return NewDoctorClient(_m.config).QueryCreatedAnalysisJobs(_m)
 }
 
+// QueryAssignedGoals queries the "assigned_goals" edge of the Doctor entity.
+func (_m *Doctor) QueryAssignedGoals() *GoalQuery {
+	return NewDoctorClient(_m.config).QueryAssignedGoals(_m)
+}
+
+// QueryAssignedExercises queries the "assigned_exercises" edge of the Doctor entity.
+func (_m *Doctor) QueryAssignedExercises() *AssignmentQuery {
+	return NewDoctorClient(_m.config).QueryAssignedExercises(_m)
+}
+
+// QuerySessions queries the "sessions" edge of the Doctor entity.
+func (_m *Doctor) QuerySessions() *SessionQuery {
+	return NewDoctorClient(_m.config).QuerySessions(_m)
+}
+
+// QueryQuestionnaireAssignments queries the "questionnaire_assignments" edge of the Doctor entity.
+func (_m *Doctor) QueryQuestionnaireAssignments() *QuestionnaireAssignmentQuery {
+	return NewDoctorClient(_m.config).QueryQuestionnaireAssignments(_m)
+}
+
+// QueryQuestionnaireDefinitions queries the "questionnaire_definitions" edge of the Doctor entity.
+func (_m *Doctor) QueryQuestionnaireDefinitions() *QuestionnaireDefinitionQuery {
+	return NewDoctorClient(_m.config).QueryQuestionnaireDefinitions(_m)
+}
+
+// QueryReminderSchedules queries the "reminder_schedules" edge of the Doctor entity.
+func (_m *Doctor) QueryReminderSchedules() *ReminderScheduleQuery {
+	return NewDoctorClient(_m.config).QueryReminderSchedules(_m)
+}
+
+// QueryWebhookSubscriptions queries the "webhook_subscriptions" edge of the Doctor entity.
+func (_m *Doctor) QueryWebhookSubscriptions() *WebhookSubscriptionQuery {
+	return NewDoctorClient(_m.config).QueryWebhookSubscriptions(_m)
+}
+
+// QueryVocabularyTerms queries the "vocabulary_terms" edge of the Doctor entity.
+func (_m *Doctor) QueryVocabularyTerms() *VocabularyTermQuery {
+	return NewDoctorClient(_m.config).QueryVocabularyTerms(_m)
+}
+
 // Update returns a builder for updating this Doctor.
 // Note that you need to call Doctor.Unwrap() before calling this method if this Doctor
 // was returned from a transaction, and the transaction was committed or rolled back.
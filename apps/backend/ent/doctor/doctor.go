@@ -44,6 +44,22 @@ const (
 	EdgeComments = "comments"
 	// EdgeCreatedAnalysisJobs holds the string denoting the created_analysis_jobs edge name in mutations.
 	EdgeCreatedAnalysisJobs = "created_analysis_jobs"
+	// EdgeAssignedGoals holds the string denoting the assigned_goals edge name in mutations.
+	EdgeAssignedGoals = "assigned_goals"
+	// EdgeAssignedExercises holds the string denoting the assigned_exercises edge name in mutations.
+	EdgeAssignedExercises = "assigned_exercises"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
+	// EdgeQuestionnaireAssignments holds the string denoting the questionnaire_assignments edge name in mutations.
+	EdgeQuestionnaireAssignments = "questionnaire_assignments"
+	// EdgeQuestionnaireDefinitions holds the string denoting the questionnaire_definitions edge name in mutations.
+	EdgeQuestionnaireDefinitions = "questionnaire_definitions"
+	// EdgeReminderSchedules holds the string denoting the reminder_schedules edge name in mutations.
+	EdgeReminderSchedules = "reminder_schedules"
+	// EdgeWebhookSubscriptions holds the string denoting the webhook_subscriptions edge name in mutations.
+	EdgeWebhookSubscriptions = "webhook_subscriptions"
+	// EdgeVocabularyTerms holds the string denoting the vocabulary_terms edge name in mutations.
+	EdgeVocabularyTerms = "vocabulary_terms"
 	// Table holds the table name of the doctor in the database.
 	Table = "doctors"
 	// PracticeTable is the table that holds the practice relation/edge.
@@ -95,6 +111,62 @@ const (
 	CreatedAnalysisJobsInverseTable = "analysis_jobs"
 	// CreatedAnalysisJobsColumn is the table column denoting the created_analysis_jobs relation/edge.
 	CreatedAnalysisJobsColumn = "created_by_doctor_id"
+	// AssignedGoalsTable is the table that holds the assigned_goals relation/edge.
+	AssignedGoalsTable = "goals"
+	// AssignedGoalsInverseTable is the table name for the Goal entity.
+	// It exists in this package in order to avoid circular dependency with the "goal" package.
+	AssignedGoalsInverseTable = "goals"
+	// AssignedGoalsColumn is the table column denoting the assigned_goals relation/edge.
+	AssignedGoalsColumn = "doctor_id"
+	// AssignedExercisesTable is the table that holds the assigned_exercises relation/edge.
+	AssignedExercisesTable = "assignments"
+	// AssignedExercisesInverseTable is the table name for the Assignment entity.
+	// It exists in this package in order to avoid circular dependency with the "assignment" package.
+	AssignedExercisesInverseTable = "assignments"
+	// AssignedExercisesColumn is the table column denoting the assigned_exercises relation/edge.
+	AssignedExercisesColumn = "doctor_id"
+	// SessionsTable is the table that holds the sessions relation/edge.
+	SessionsTable = "sessions"
+	// SessionsInverseTable is the table name for the Session entity.
+	// It exists in this package in order to avoid circular dependency with the "session" package.
+	SessionsInverseTable = "sessions"
+	// SessionsColumn is the table column denoting the sessions relation/edge.
+	SessionsColumn = "doctor_id"
+	// QuestionnaireAssignmentsTable is the table that holds the questionnaire_assignments relation/edge.
+	QuestionnaireAssignmentsTable = "questionnaire_assignments"
+	// QuestionnaireAssignmentsInverseTable is the table name for the QuestionnaireAssignment entity.
+	// It exists in this package in order to avoid circular dependency with the "questionnaireassignment" package.
+	QuestionnaireAssignmentsInverseTable = "questionnaire_assignments"
+	// QuestionnaireAssignmentsColumn is the table column denoting the questionnaire_assignments relation/edge.
+	QuestionnaireAssignmentsColumn = "doctor_id"
+	// QuestionnaireDefinitionsTable is the table that holds the questionnaire_definitions relation/edge.
+	QuestionnaireDefinitionsTable = "questionnaire_definitions"
+	// QuestionnaireDefinitionsInverseTable is the table name for the QuestionnaireDefinition entity.
+	// It exists in this package in order to avoid circular dependency with the "questionnairedefinition" package.
+	QuestionnaireDefinitionsInverseTable = "questionnaire_definitions"
+	// QuestionnaireDefinitionsColumn is the table column denoting the questionnaire_definitions relation/edge.
+	QuestionnaireDefinitionsColumn = "created_by_doctor_id"
+	// ReminderSchedulesTable is the table that holds the reminder_schedules relation/edge.
+	ReminderSchedulesTable = "reminder_schedules"
+	// ReminderSchedulesInverseTable is the table name for the ReminderSchedule entity.
+	// It exists in this package in order to avoid circular dependency with the "reminderschedule" package.
+	ReminderSchedulesInverseTable = "reminder_schedules"
+	// ReminderSchedulesColumn is the table column denoting the reminder_schedules relation/edge.
+	ReminderSchedulesColumn = "created_by_doctor_id"
+	// WebhookSubscriptionsTable is the table that holds the webhook_subscriptions relation/edge.
+	WebhookSubscriptionsTable = "webhook_subscriptions"
+	// WebhookSubscriptionsInverseTable is the table name for the WebhookSubscription entity.
+	// It exists in this package in order to avoid circular dependency with the "webhooksubscription" package.
+	WebhookSubscriptionsInverseTable = "webhook_subscriptions"
+	// WebhookSubscriptionsColumn is the table column denoting the webhook_subscriptions relation/edge.
+	WebhookSubscriptionsColumn = "created_by_doctor_id"
+	// VocabularyTermsTable is the table that holds the vocabulary_terms relation/edge.
+	VocabularyTermsTable = "vocabulary_terms"
+	// VocabularyTermsInverseTable is the table name for the VocabularyTerm entity.
+	// It exists in this package in order to avoid circular dependency with the "vocabularyterm" package.
+	VocabularyTermsInverseTable = "vocabulary_terms"
+	// VocabularyTermsColumn is the table column denoting the vocabulary_terms relation/edge.
+	VocabularyTermsColumn = "doctor_id"
 )
 
 // Columns holds all SQL columns for doctor fields.
@@ -295,6 +367,118 @@ func ByCreatedAnalysisJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOpti
 		sqlgraph.OrderByNeighborTerms(s, newCreatedAnalysisJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAssignedGoalsCount orders the results by assigned_goals count.
+func ByAssignedGoalsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAssignedGoalsStep(), opts...)
+	}
+}
+
+// ByAssignedGoals orders the results by assigned_goals terms.
+func ByAssignedGoals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAssignedGoalsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAssignedExercisesCount orders the results by assigned_exercises count.
+func ByAssignedExercisesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAssignedExercisesStep(), opts...)
+	}
+}
+
+// ByAssignedExercises orders the results by assigned_exercises terms.
+func ByAssignedExercises(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAssignedExercisesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySessionsCount orders the results by sessions count.
+func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSessionsStep(), opts...)
+	}
+}
+
+// BySessions orders the results by sessions terms.
+func BySessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByQuestionnaireAssignmentsCount orders the results by questionnaire_assignments count.
+func ByQuestionnaireAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newQuestionnaireAssignmentsStep(), opts...)
+	}
+}
+
+// ByQuestionnaireAssignments orders the results by questionnaire_assignments terms.
+func ByQuestionnaireAssignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuestionnaireAssignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByQuestionnaireDefinitionsCount orders the results by questionnaire_definitions count.
+func ByQuestionnaireDefinitionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newQuestionnaireDefinitionsStep(), opts...)
+	}
+}
+
+// ByQuestionnaireDefinitions orders the results by questionnaire_definitions terms.
+func ByQuestionnaireDefinitions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuestionnaireDefinitionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReminderSchedulesCount orders the results by reminder_schedules count.
+func ByReminderSchedulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReminderSchedulesStep(), opts...)
+	}
+}
+
+// ByReminderSchedules orders the results by reminder_schedules terms.
+func ByReminderSchedules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReminderSchedulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWebhookSubscriptionsCount orders the results by webhook_subscriptions count.
+func ByWebhookSubscriptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebhookSubscriptionsStep(), opts...)
+	}
+}
+
+// ByWebhookSubscriptions orders the results by webhook_subscriptions terms.
+func ByWebhookSubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebhookSubscriptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByVocabularyTermsCount orders the results by vocabulary_terms count.
+func ByVocabularyTermsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVocabularyTermsStep(), opts...)
+	}
+}
+
+// ByVocabularyTerms orders the results by vocabulary_terms terms.
+func ByVocabularyTerms(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVocabularyTermsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPracticeStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -344,3 +528,59 @@ func newCreatedAnalysisJobsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, CreatedAnalysisJobsTable, CreatedAnalysisJobsColumn),
 	)
 }
+func newAssignedGoalsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AssignedGoalsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AssignedGoalsTable, AssignedGoalsColumn),
+	)
+}
+func newAssignedExercisesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AssignedExercisesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AssignedExercisesTable, AssignedExercisesColumn),
+	)
+}
+func newSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+	)
+}
+func newQuestionnaireAssignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuestionnaireAssignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, QuestionnaireAssignmentsTable, QuestionnaireAssignmentsColumn),
+	)
+}
+func newQuestionnaireDefinitionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuestionnaireDefinitionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, QuestionnaireDefinitionsTable, QuestionnaireDefinitionsColumn),
+	)
+}
+func newReminderSchedulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReminderSchedulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReminderSchedulesTable, ReminderSchedulesColumn),
+	)
+}
+func newWebhookSubscriptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebhookSubscriptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebhookSubscriptionsTable, WebhookSubscriptionsColumn),
+	)
+}
+func newVocabularyTermsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VocabularyTermsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VocabularyTermsTable, VocabularyTermsColumn),
+	)
+}
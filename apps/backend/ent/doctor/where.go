@@ -572,6 +572,190 @@ func HasCreatedAnalysisJobsWith(preds ...predicate.AnalysisJob) predicate.Doctor
 	})
 }
 
+// HasAssignedGoals applies the HasEdge predicate on the "assigned_goals" edge.
+func HasAssignedGoals() predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AssignedGoalsTable, AssignedGoalsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssignedGoalsWith applies the HasEdge predicate on the "assigned_goals" edge with a given conditions (other predicates).
+func HasAssignedGoalsWith(preds ...predicate.Goal) predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := newAssignedGoalsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAssignedExercises applies the HasEdge predicate on the "assigned_exercises" edge.
+func HasAssignedExercises() predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AssignedExercisesTable, AssignedExercisesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssignedExercisesWith applies the HasEdge predicate on the "assigned_exercises" edge with a given conditions (other predicates).
+func HasAssignedExercisesWith(preds ...predicate.Assignment) predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := newAssignedExercisesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSessions applies the HasEdge predicate on the "sessions" edge.
+func HasSessions() predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSessionsWith applies the HasEdge predicate on the "sessions" edge with a given conditions (other predicates).
+func HasSessionsWith(preds ...predicate.Session) predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := newSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasQuestionnaireAssignments applies the HasEdge predicate on the "questionnaire_assignments" edge.
+func HasQuestionnaireAssignments() predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, QuestionnaireAssignmentsTable, QuestionnaireAssignmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuestionnaireAssignmentsWith applies the HasEdge predicate on the "questionnaire_assignments" edge with a given conditions (other predicates).
+func HasQuestionnaireAssignmentsWith(preds ...predicate.QuestionnaireAssignment) predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := newQuestionnaireAssignmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasQuestionnaireDefinitions applies the HasEdge predicate on the "questionnaire_definitions" edge.
+func HasQuestionnaireDefinitions() predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, QuestionnaireDefinitionsTable, QuestionnaireDefinitionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuestionnaireDefinitionsWith applies the HasEdge predicate on the "questionnaire_definitions" edge with a given conditions (other predicates).
+func HasQuestionnaireDefinitionsWith(preds ...predicate.QuestionnaireDefinition) predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := newQuestionnaireDefinitionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReminderSchedules applies the HasEdge predicate on the "reminder_schedules" edge.
+func HasReminderSchedules() predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReminderSchedulesTable, ReminderSchedulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReminderSchedulesWith applies the HasEdge predicate on the "reminder_schedules" edge with a given conditions (other predicates).
+func HasReminderSchedulesWith(preds ...predicate.ReminderSchedule) predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := newReminderSchedulesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhookSubscriptions applies the HasEdge predicate on the "webhook_subscriptions" edge.
+func HasWebhookSubscriptions() predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhookSubscriptionsTable, WebhookSubscriptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhookSubscriptionsWith applies the HasEdge predicate on the "webhook_subscriptions" edge with a given conditions (other predicates).
+func HasWebhookSubscriptionsWith(preds ...predicate.WebhookSubscription) predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := newWebhookSubscriptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVocabularyTerms applies the HasEdge predicate on the "vocabulary_terms" edge.
+func HasVocabularyTerms() predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VocabularyTermsTable, VocabularyTermsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVocabularyTermsWith applies the HasEdge predicate on the "vocabulary_terms" edge with a given conditions (other predicates).
+func HasVocabularyTermsWith(preds ...predicate.VocabularyTerm) predicate.Doctor {
+	return predicate.Doctor(func(s *sql.Selector) {
+		step := newVocabularyTermsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Doctor) predicate.Doctor {
 	return predicate.Doctor(sql.AndPredicates(predicates...))
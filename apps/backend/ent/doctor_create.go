@@ -4,17 +4,27 @@ package ent
 
 import (
 	"backend/ent/analysisjob"
+	"backend/ent/assignment"
 	"backend/ent/comment"
 	"backend/ent/doctor"
 	"backend/ent/doctorpatientlink"
 	"backend/ent/entryshare"
+	"backend/ent/goal"
 	"backend/ent/pairingcode"
 	"backend/ent/practice"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/ent/reminderschedule"
+	"backend/ent/session"
+	"backend/ent/vocabularyterm"
+	"backend/ent/webhooksubscription"
 	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -25,6 +35,7 @@ type DoctorCreate struct {
 	config
 	mutation *DoctorMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -210,6 +221,126 @@ func (_c *DoctorCreate) AddCreatedAnalysisJobs(v ...*AnalysisJob) *DoctorCreate
 	return _c.AddCreatedAnalysisJobIDs(ids...)
 }
 
+// AddAssignedGoalIDs adds the "assigned_goals" edge to the Goal entity by IDs.
+func (_c *DoctorCreate) AddAssignedGoalIDs(ids ...uuid.UUID) *DoctorCreate {
+	_c.mutation.AddAssignedGoalIDs(ids...)
+	return _c
+}
+
+// AddAssignedGoals adds the "assigned_goals" edges to the Goal entity.
+func (_c *DoctorCreate) AddAssignedGoals(v ...*Goal) *DoctorCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAssignedGoalIDs(ids...)
+}
+
+// AddAssignedExerciseIDs adds the "assigned_exercises" edge to the Assignment entity by IDs.
+func (_c *DoctorCreate) AddAssignedExerciseIDs(ids ...uuid.UUID) *DoctorCreate {
+	_c.mutation.AddAssignedExerciseIDs(ids...)
+	return _c
+}
+
+// AddAssignedExercises adds the "assigned_exercises" edges to the Assignment entity.
+func (_c *DoctorCreate) AddAssignedExercises(v ...*Assignment) *DoctorCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAssignedExerciseIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_c *DoctorCreate) AddSessionIDs(ids ...uuid.UUID) *DoctorCreate {
+	_c.mutation.AddSessionIDs(ids...)
+	return _c
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_c *DoctorCreate) AddSessions(v ...*Session) *DoctorCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSessionIDs(ids...)
+}
+
+// AddQuestionnaireAssignmentIDs adds the "questionnaire_assignments" edge to the QuestionnaireAssignment entity by IDs.
+func (_c *DoctorCreate) AddQuestionnaireAssignmentIDs(ids ...uuid.UUID) *DoctorCreate {
+	_c.mutation.AddQuestionnaireAssignmentIDs(ids...)
+	return _c
+}
+
+// AddQuestionnaireAssignments adds the "questionnaire_assignments" edges to the QuestionnaireAssignment entity.
+func (_c *DoctorCreate) AddQuestionnaireAssignments(v ...*QuestionnaireAssignment) *DoctorCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddQuestionnaireAssignmentIDs(ids...)
+}
+
+// AddQuestionnaireDefinitionIDs adds the "questionnaire_definitions" edge to the QuestionnaireDefinition entity by IDs.
+func (_c *DoctorCreate) AddQuestionnaireDefinitionIDs(ids ...uuid.UUID) *DoctorCreate {
+	_c.mutation.AddQuestionnaireDefinitionIDs(ids...)
+	return _c
+}
+
+// AddQuestionnaireDefinitions adds the "questionnaire_definitions" edges to the QuestionnaireDefinition entity.
+func (_c *DoctorCreate) AddQuestionnaireDefinitions(v ...*QuestionnaireDefinition) *DoctorCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddQuestionnaireDefinitionIDs(ids...)
+}
+
+// AddReminderScheduleIDs adds the "reminder_schedules" edge to the ReminderSchedule entity by IDs.
+func (_c *DoctorCreate) AddReminderScheduleIDs(ids ...uuid.UUID) *DoctorCreate {
+	_c.mutation.AddReminderScheduleIDs(ids...)
+	return _c
+}
+
+// AddReminderSchedules adds the "reminder_schedules" edges to the ReminderSchedule entity.
+func (_c *DoctorCreate) AddReminderSchedules(v ...*ReminderSchedule) *DoctorCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReminderScheduleIDs(ids...)
+}
+
+// AddWebhookSubscriptionIDs adds the "webhook_subscriptions" edge to the WebhookSubscription entity by IDs.
+func (_c *DoctorCreate) AddWebhookSubscriptionIDs(ids ...uuid.UUID) *DoctorCreate {
+	_c.mutation.AddWebhookSubscriptionIDs(ids...)
+	return _c
+}
+
+// AddWebhookSubscriptions adds the "webhook_subscriptions" edges to the WebhookSubscription entity.
+func (_c *DoctorCreate) AddWebhookSubscriptions(v ...*WebhookSubscription) *DoctorCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWebhookSubscriptionIDs(ids...)
+}
+
+// AddVocabularyTermIDs adds the "vocabulary_terms" edge to the VocabularyTerm entity by IDs.
+func (_c *DoctorCreate) AddVocabularyTermIDs(ids ...uuid.UUID) *DoctorCreate {
+	_c.mutation.AddVocabularyTermIDs(ids...)
+	return _c
+}
+
+// AddVocabularyTerms adds the "vocabulary_terms" edges to the VocabularyTerm entity.
+func (_c *DoctorCreate) AddVocabularyTerms(v ...*VocabularyTerm) *DoctorCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVocabularyTermIDs(ids...)
+}
+
 // Mutation returns the DoctorMutation object of the builder.
 func (_c *DoctorCreate) Mutation() *DoctorMutation {
 	return _c.mutation
@@ -334,6 +465,7 @@ func (_c *DoctorCreate) createSpec() (*Doctor, *sqlgraph.CreateSpec) {
 		_node = &Doctor{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(doctor.Table, sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -475,14 +607,450 @@ func (_c *DoctorCreate) createSpec() (*Doctor, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AssignedGoalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.AssignedGoalsTable,
+			Columns: []string{doctor.AssignedGoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AssignedExercisesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.AssignedExercisesTable,
+			Columns: []string{doctor.AssignedExercisesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.SessionsTable,
+			Columns: []string{doctor.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.QuestionnaireAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.QuestionnaireAssignmentsTable,
+			Columns: []string{doctor.QuestionnaireAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.QuestionnaireDefinitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.QuestionnaireDefinitionsTable,
+			Columns: []string{doctor.QuestionnaireDefinitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReminderSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.ReminderSchedulesTable,
+			Columns: []string{doctor.ReminderSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WebhookSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.WebhookSubscriptionsTable,
+			Columns: []string{doctor.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VocabularyTermsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.VocabularyTermsTable,
+			Columns: []string{doctor.VocabularyTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vocabularyterm.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Doctor.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DoctorUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DoctorCreate) OnConflict(opts ...sql.ConflictOption) *DoctorUpsertOne {
+	_c.conflict = opts
+	return &DoctorUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Doctor.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DoctorCreate) OnConflictColumns(columns ...string) *DoctorUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DoctorUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DoctorUpsertOne is the builder for "upsert"-ing
+	//  one Doctor node.
+	DoctorUpsertOne struct {
+		create *DoctorCreate
+	}
+
+	// DoctorUpsert is the "OnConflict" setter.
+	DoctorUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DoctorUpsert) SetUpdatedAt(v time.Time) *DoctorUpsert {
+	u.Set(doctor.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DoctorUpsert) UpdateUpdatedAt() *DoctorUpsert {
+	u.SetExcluded(doctor.FieldUpdatedAt)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *DoctorUpsert) SetEmail(v string) *DoctorUpsert {
+	u.Set(doctor.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *DoctorUpsert) UpdateEmail() *DoctorUpsert {
+	u.SetExcluded(doctor.FieldEmail)
+	return u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *DoctorUpsert) SetDisplayName(v string) *DoctorUpsert {
+	u.Set(doctor.FieldDisplayName, v)
+	return u
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *DoctorUpsert) UpdateDisplayName() *DoctorUpsert {
+	u.SetExcluded(doctor.FieldDisplayName)
+	return u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *DoctorUpsert) SetPasswordHash(v string) *DoctorUpsert {
+	u.Set(doctor.FieldPasswordHash, v)
+	return u
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *DoctorUpsert) UpdatePasswordHash() *DoctorUpsert {
+	u.SetExcluded(doctor.FieldPasswordHash)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *DoctorUpsert) SetRole(v doctor.Role) *DoctorUpsert {
+	u.Set(doctor.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *DoctorUpsert) UpdateRole() *DoctorUpsert {
+	u.SetExcluded(doctor.FieldRole)
+	return u
+}
+
+// SetPracticeID sets the "practice_id" field.
+func (u *DoctorUpsert) SetPracticeID(v uuid.UUID) *DoctorUpsert {
+	u.Set(doctor.FieldPracticeID, v)
+	return u
+}
+
+// UpdatePracticeID sets the "practice_id" field to the value that was provided on create.
+func (u *DoctorUpsert) UpdatePracticeID() *DoctorUpsert {
+	u.SetExcluded(doctor.FieldPracticeID)
+	return u
+}
+
+// ClearPracticeID clears the value of the "practice_id" field.
+func (u *DoctorUpsert) ClearPracticeID() *DoctorUpsert {
+	u.SetNull(doctor.FieldPracticeID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Doctor.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(doctor.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DoctorUpsertOne) UpdateNewValues() *DoctorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(doctor.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(doctor.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Doctor.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DoctorUpsertOne) Ignore() *DoctorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DoctorUpsertOne) DoNothing() *DoctorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DoctorCreate.OnConflict
+// documentation for more info.
+func (u *DoctorUpsertOne) Update(set func(*DoctorUpsert)) *DoctorUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DoctorUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DoctorUpsertOne) SetUpdatedAt(v time.Time) *DoctorUpsertOne {
+	return u.Update(func(s *DoctorUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DoctorUpsertOne) UpdateUpdatedAt() *DoctorUpsertOne {
+	return u.Update(func(s *DoctorUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *DoctorUpsertOne) SetEmail(v string) *DoctorUpsertOne {
+	return u.Update(func(s *DoctorUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *DoctorUpsertOne) UpdateEmail() *DoctorUpsertOne {
+	return u.Update(func(s *DoctorUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *DoctorUpsertOne) SetDisplayName(v string) *DoctorUpsertOne {
+	return u.Update(func(s *DoctorUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *DoctorUpsertOne) UpdateDisplayName() *DoctorUpsertOne {
+	return u.Update(func(s *DoctorUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *DoctorUpsertOne) SetPasswordHash(v string) *DoctorUpsertOne {
+	return u.Update(func(s *DoctorUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *DoctorUpsertOne) UpdatePasswordHash() *DoctorUpsertOne {
+	return u.Update(func(s *DoctorUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *DoctorUpsertOne) SetRole(v doctor.Role) *DoctorUpsertOne {
+	return u.Update(func(s *DoctorUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *DoctorUpsertOne) UpdateRole() *DoctorUpsertOne {
+	return u.Update(func(s *DoctorUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetPracticeID sets the "practice_id" field.
+func (u *DoctorUpsertOne) SetPracticeID(v uuid.UUID) *DoctorUpsertOne {
+	return u.Update(func(s *DoctorUpsert) {
+		s.SetPracticeID(v)
+	})
+}
+
+// UpdatePracticeID sets the "practice_id" field to the value that was provided on create.
+func (u *DoctorUpsertOne) UpdatePracticeID() *DoctorUpsertOne {
+	return u.Update(func(s *DoctorUpsert) {
+		s.UpdatePracticeID()
+	})
+}
+
+// ClearPracticeID clears the value of the "practice_id" field.
+func (u *DoctorUpsertOne) ClearPracticeID() *DoctorUpsertOne {
+	return u.Update(func(s *DoctorUpsert) {
+		s.ClearPracticeID()
+	})
+}
+
+// Exec executes the query.
+func (u *DoctorUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DoctorCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DoctorUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DoctorUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DoctorUpsertOne.ID is not supported by MySQL driver. Use DoctorUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DoctorUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // DoctorCreateBulk is the builder for creating many Doctor entities in bulk.
 type DoctorCreateBulk struct {
 	config
 	err      error
 	builders []*DoctorCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Doctor entities in the database.
@@ -512,6 +1080,7 @@ func (_c *DoctorCreateBulk) Save(ctx context.Context) ([]*Doctor, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -561,3 +1130,211 @@ func (_c *DoctorCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Doctor.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DoctorUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DoctorCreateBulk) OnConflict(opts ...sql.ConflictOption) *DoctorUpsertBulk {
+	_c.conflict = opts
+	return &DoctorUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Doctor.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DoctorCreateBulk) OnConflictColumns(columns ...string) *DoctorUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DoctorUpsertBulk{
+		create: _c,
+	}
+}
+
+// DoctorUpsertBulk is the builder for "upsert"-ing
+// a bulk of Doctor nodes.
+type DoctorUpsertBulk struct {
+	create *DoctorCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Doctor.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(doctor.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DoctorUpsertBulk) UpdateNewValues() *DoctorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(doctor.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(doctor.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Doctor.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DoctorUpsertBulk) Ignore() *DoctorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DoctorUpsertBulk) DoNothing() *DoctorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DoctorCreateBulk.OnConflict
+// documentation for more info.
+func (u *DoctorUpsertBulk) Update(set func(*DoctorUpsert)) *DoctorUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DoctorUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DoctorUpsertBulk) SetUpdatedAt(v time.Time) *DoctorUpsertBulk {
+	return u.Update(func(s *DoctorUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DoctorUpsertBulk) UpdateUpdatedAt() *DoctorUpsertBulk {
+	return u.Update(func(s *DoctorUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *DoctorUpsertBulk) SetEmail(v string) *DoctorUpsertBulk {
+	return u.Update(func(s *DoctorUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *DoctorUpsertBulk) UpdateEmail() *DoctorUpsertBulk {
+	return u.Update(func(s *DoctorUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *DoctorUpsertBulk) SetDisplayName(v string) *DoctorUpsertBulk {
+	return u.Update(func(s *DoctorUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *DoctorUpsertBulk) UpdateDisplayName() *DoctorUpsertBulk {
+	return u.Update(func(s *DoctorUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *DoctorUpsertBulk) SetPasswordHash(v string) *DoctorUpsertBulk {
+	return u.Update(func(s *DoctorUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *DoctorUpsertBulk) UpdatePasswordHash() *DoctorUpsertBulk {
+	return u.Update(func(s *DoctorUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *DoctorUpsertBulk) SetRole(v doctor.Role) *DoctorUpsertBulk {
+	return u.Update(func(s *DoctorUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *DoctorUpsertBulk) UpdateRole() *DoctorUpsertBulk {
+	return u.Update(func(s *DoctorUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// SetPracticeID sets the "practice_id" field.
+func (u *DoctorUpsertBulk) SetPracticeID(v uuid.UUID) *DoctorUpsertBulk {
+	return u.Update(func(s *DoctorUpsert) {
+		s.SetPracticeID(v)
+	})
+}
+
+// UpdatePracticeID sets the "practice_id" field to the value that was provided on create.
+func (u *DoctorUpsertBulk) UpdatePracticeID() *DoctorUpsertBulk {
+	return u.Update(func(s *DoctorUpsert) {
+		s.UpdatePracticeID()
+	})
+}
+
+// ClearPracticeID clears the value of the "practice_id" field.
+func (u *DoctorUpsertBulk) ClearPracticeID() *DoctorUpsertBulk {
+	return u.Update(func(s *DoctorUpsert) {
+		s.ClearPracticeID()
+	})
+}
+
+// Exec executes the query.
+func (u *DoctorUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DoctorCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DoctorCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DoctorUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -4,13 +4,21 @@ package ent
 
 import (
 	"backend/ent/analysisjob"
+	"backend/ent/assignment"
 	"backend/ent/comment"
 	"backend/ent/doctor"
 	"backend/ent/doctorpatientlink"
 	"backend/ent/entryshare"
+	"backend/ent/goal"
 	"backend/ent/pairingcode"
 	"backend/ent/practice"
 	"backend/ent/predicate"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/ent/reminderschedule"
+	"backend/ent/session"
+	"backend/ent/vocabularyterm"
+	"backend/ent/webhooksubscription"
 	"context"
 	"database/sql/driver"
 	"fmt"
@@ -26,17 +34,25 @@ import (
 // DoctorQuery is the builder for querying Doctor entities.
 type DoctorQuery struct {
 	config
-	ctx                      *QueryContext
-	order                    []doctor.OrderOption
-	inters                   []Interceptor
-	predicates               []predicate.Doctor
-	withPractice             *PracticeQuery
-	withPatientLinks         *DoctorPatientLinkQuery
-	withPairingCodes         *PairingCodeQuery
-	withApprovedPatientLinks *DoctorPatientLinkQuery
-	withEntryShares          *EntryShareQuery
-	withComments             *CommentQuery
-	withCreatedAnalysisJobs  *AnalysisJobQuery
+	ctx                          *QueryContext
+	order                        []doctor.OrderOption
+	inters                       []Interceptor
+	predicates                   []predicate.Doctor
+	withPractice                 *PracticeQuery
+	withPatientLinks             *DoctorPatientLinkQuery
+	withPairingCodes             *PairingCodeQuery
+	withApprovedPatientLinks     *DoctorPatientLinkQuery
+	withEntryShares              *EntryShareQuery
+	withComments                 *CommentQuery
+	withCreatedAnalysisJobs      *AnalysisJobQuery
+	withAssignedGoals            *GoalQuery
+	withAssignedExercises        *AssignmentQuery
+	withSessions                 *SessionQuery
+	withQuestionnaireAssignments *QuestionnaireAssignmentQuery
+	withQuestionnaireDefinitions *QuestionnaireDefinitionQuery
+	withReminderSchedules        *ReminderScheduleQuery
+	withWebhookSubscriptions     *WebhookSubscriptionQuery
+	withVocabularyTerms          *VocabularyTermQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -227,6 +243,182 @@ func (_q *DoctorQuery) QueryCreatedAnalysisJobs() *AnalysisJobQuery {
 	return query
 }
 
+// QueryAssignedGoals chains the current query on the "assigned_goals" edge.
+func (_q *DoctorQuery) QueryAssignedGoals() *GoalQuery {
+	query := (&GoalClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, selector),
+			sqlgraph.To(goal.Table, goal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.AssignedGoalsTable, doctor.AssignedGoalsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAssignedExercises chains the current query on the "assigned_exercises" edge.
+func (_q *DoctorQuery) QueryAssignedExercises() *AssignmentQuery {
+	query := (&AssignmentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, selector),
+			sqlgraph.To(assignment.Table, assignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.AssignedExercisesTable, doctor.AssignedExercisesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySessions chains the current query on the "sessions" edge.
+func (_q *DoctorQuery) QuerySessions() *SessionQuery {
+	query := (&SessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, selector),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.SessionsTable, doctor.SessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryQuestionnaireAssignments chains the current query on the "questionnaire_assignments" edge.
+func (_q *DoctorQuery) QueryQuestionnaireAssignments() *QuestionnaireAssignmentQuery {
+	query := (&QuestionnaireAssignmentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, selector),
+			sqlgraph.To(questionnaireassignment.Table, questionnaireassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.QuestionnaireAssignmentsTable, doctor.QuestionnaireAssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryQuestionnaireDefinitions chains the current query on the "questionnaire_definitions" edge.
+func (_q *DoctorQuery) QueryQuestionnaireDefinitions() *QuestionnaireDefinitionQuery {
+	query := (&QuestionnaireDefinitionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, selector),
+			sqlgraph.To(questionnairedefinition.Table, questionnairedefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.QuestionnaireDefinitionsTable, doctor.QuestionnaireDefinitionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReminderSchedules chains the current query on the "reminder_schedules" edge.
+func (_q *DoctorQuery) QueryReminderSchedules() *ReminderScheduleQuery {
+	query := (&ReminderScheduleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, selector),
+			sqlgraph.To(reminderschedule.Table, reminderschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.ReminderSchedulesTable, doctor.ReminderSchedulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWebhookSubscriptions chains the current query on the "webhook_subscriptions" edge.
+func (_q *DoctorQuery) QueryWebhookSubscriptions() *WebhookSubscriptionQuery {
+	query := (&WebhookSubscriptionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, selector),
+			sqlgraph.To(webhooksubscription.Table, webhooksubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.WebhookSubscriptionsTable, doctor.WebhookSubscriptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVocabularyTerms chains the current query on the "vocabulary_terms" edge.
+func (_q *DoctorQuery) QueryVocabularyTerms() *VocabularyTermQuery {
+	query := (&VocabularyTermClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(doctor.Table, doctor.FieldID, selector),
+			sqlgraph.To(vocabularyterm.Table, vocabularyterm.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, doctor.VocabularyTermsTable, doctor.VocabularyTermsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Doctor entity from the query.
 // Returns a *NotFoundError when no Doctor was found.
 func (_q *DoctorQuery) First(ctx context.Context) (*Doctor, error) {
@@ -414,18 +606,26 @@ func (_q *DoctorQuery) Clone() *DoctorQuery {
 		return nil
 	}
 	return &DoctorQuery{
-		config:                   _q.config,
-		ctx:                      _q.ctx.Clone(),
-		order:                    append([]doctor.OrderOption{}, _q.order...),
-		inters:                   append([]Interceptor{}, _q.inters...),
-		predicates:               append([]predicate.Doctor{}, _q.predicates...),
-		withPractice:             _q.withPractice.Clone(),
-		withPatientLinks:         _q.withPatientLinks.Clone(),
-		withPairingCodes:         _q.withPairingCodes.Clone(),
-		withApprovedPatientLinks: _q.withApprovedPatientLinks.Clone(),
-		withEntryShares:          _q.withEntryShares.Clone(),
-		withComments:             _q.withComments.Clone(),
-		withCreatedAnalysisJobs:  _q.withCreatedAnalysisJobs.Clone(),
+		config:                       _q.config,
+		ctx:                          _q.ctx.Clone(),
+		order:                        append([]doctor.OrderOption{}, _q.order...),
+		inters:                       append([]Interceptor{}, _q.inters...),
+		predicates:                   append([]predicate.Doctor{}, _q.predicates...),
+		withPractice:                 _q.withPractice.Clone(),
+		withPatientLinks:             _q.withPatientLinks.Clone(),
+		withPairingCodes:             _q.withPairingCodes.Clone(),
+		withApprovedPatientLinks:     _q.withApprovedPatientLinks.Clone(),
+		withEntryShares:              _q.withEntryShares.Clone(),
+		withComments:                 _q.withComments.Clone(),
+		withCreatedAnalysisJobs:      _q.withCreatedAnalysisJobs.Clone(),
+		withAssignedGoals:            _q.withAssignedGoals.Clone(),
+		withAssignedExercises:        _q.withAssignedExercises.Clone(),
+		withSessions:                 _q.withSessions.Clone(),
+		withQuestionnaireAssignments: _q.withQuestionnaireAssignments.Clone(),
+		withQuestionnaireDefinitions: _q.withQuestionnaireDefinitions.Clone(),
+		withReminderSchedules:        _q.withReminderSchedules.Clone(),
+		withWebhookSubscriptions:     _q.withWebhookSubscriptions.Clone(),
+		withVocabularyTerms:          _q.withVocabularyTerms.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -509,6 +709,94 @@ func (_q *DoctorQuery) WithCreatedAnalysisJobs(opts ...func(*AnalysisJobQuery))
 	return _q
 }
 
+// WithAssignedGoals tells the query-builder to eager-load the nodes that are connected to
+// the "assigned_goals" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DoctorQuery) WithAssignedGoals(opts ...func(*GoalQuery)) *DoctorQuery {
+	query := (&GoalClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAssignedGoals = query
+	return _q
+}
+
+// WithAssignedExercises tells the query-builder to eager-load the nodes that are connected to
+// the "assigned_exercises" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DoctorQuery) WithAssignedExercises(opts ...func(*AssignmentQuery)) *DoctorQuery {
+	query := (&AssignmentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAssignedExercises = query
+	return _q
+}
+
+// WithSessions tells the query-builder to eager-load the nodes that are connected to
+// the "sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DoctorQuery) WithSessions(opts ...func(*SessionQuery)) *DoctorQuery {
+	query := (&SessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSessions = query
+	return _q
+}
+
+// WithQuestionnaireAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "questionnaire_assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DoctorQuery) WithQuestionnaireAssignments(opts ...func(*QuestionnaireAssignmentQuery)) *DoctorQuery {
+	query := (&QuestionnaireAssignmentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withQuestionnaireAssignments = query
+	return _q
+}
+
+// WithQuestionnaireDefinitions tells the query-builder to eager-load the nodes that are connected to
+// the "questionnaire_definitions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DoctorQuery) WithQuestionnaireDefinitions(opts ...func(*QuestionnaireDefinitionQuery)) *DoctorQuery {
+	query := (&QuestionnaireDefinitionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withQuestionnaireDefinitions = query
+	return _q
+}
+
+// WithReminderSchedules tells the query-builder to eager-load the nodes that are connected to
+// the "reminder_schedules" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DoctorQuery) WithReminderSchedules(opts ...func(*ReminderScheduleQuery)) *DoctorQuery {
+	query := (&ReminderScheduleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReminderSchedules = query
+	return _q
+}
+
+// WithWebhookSubscriptions tells the query-builder to eager-load the nodes that are connected to
+// the "webhook_subscriptions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DoctorQuery) WithWebhookSubscriptions(opts ...func(*WebhookSubscriptionQuery)) *DoctorQuery {
+	query := (&WebhookSubscriptionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWebhookSubscriptions = query
+	return _q
+}
+
+// WithVocabularyTerms tells the query-builder to eager-load the nodes that are connected to
+// the "vocabulary_terms" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DoctorQuery) WithVocabularyTerms(opts ...func(*VocabularyTermQuery)) *DoctorQuery {
+	query := (&VocabularyTermClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVocabularyTerms = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -587,7 +875,7 @@ func (_q *DoctorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Docto
 	var (
 		nodes       = []*Doctor{}
 		_spec       = _q.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [15]bool{
 			_q.withPractice != nil,
 			_q.withPatientLinks != nil,
 			_q.withPairingCodes != nil,
@@ -595,6 +883,14 @@ func (_q *DoctorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Docto
 			_q.withEntryShares != nil,
 			_q.withComments != nil,
 			_q.withCreatedAnalysisJobs != nil,
+			_q.withAssignedGoals != nil,
+			_q.withAssignedExercises != nil,
+			_q.withSessions != nil,
+			_q.withQuestionnaireAssignments != nil,
+			_q.withQuestionnaireDefinitions != nil,
+			_q.withReminderSchedules != nil,
+			_q.withWebhookSubscriptions != nil,
+			_q.withVocabularyTerms != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -665,6 +961,68 @@ func (_q *DoctorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Docto
 			return nil, err
 		}
 	}
+	if query := _q.withAssignedGoals; query != nil {
+		if err := _q.loadAssignedGoals(ctx, query, nodes,
+			func(n *Doctor) { n.Edges.AssignedGoals = []*Goal{} },
+			func(n *Doctor, e *Goal) { n.Edges.AssignedGoals = append(n.Edges.AssignedGoals, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAssignedExercises; query != nil {
+		if err := _q.loadAssignedExercises(ctx, query, nodes,
+			func(n *Doctor) { n.Edges.AssignedExercises = []*Assignment{} },
+			func(n *Doctor, e *Assignment) { n.Edges.AssignedExercises = append(n.Edges.AssignedExercises, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSessions; query != nil {
+		if err := _q.loadSessions(ctx, query, nodes,
+			func(n *Doctor) { n.Edges.Sessions = []*Session{} },
+			func(n *Doctor, e *Session) { n.Edges.Sessions = append(n.Edges.Sessions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withQuestionnaireAssignments; query != nil {
+		if err := _q.loadQuestionnaireAssignments(ctx, query, nodes,
+			func(n *Doctor) { n.Edges.QuestionnaireAssignments = []*QuestionnaireAssignment{} },
+			func(n *Doctor, e *QuestionnaireAssignment) {
+				n.Edges.QuestionnaireAssignments = append(n.Edges.QuestionnaireAssignments, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withQuestionnaireDefinitions; query != nil {
+		if err := _q.loadQuestionnaireDefinitions(ctx, query, nodes,
+			func(n *Doctor) { n.Edges.QuestionnaireDefinitions = []*QuestionnaireDefinition{} },
+			func(n *Doctor, e *QuestionnaireDefinition) {
+				n.Edges.QuestionnaireDefinitions = append(n.Edges.QuestionnaireDefinitions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReminderSchedules; query != nil {
+		if err := _q.loadReminderSchedules(ctx, query, nodes,
+			func(n *Doctor) { n.Edges.ReminderSchedules = []*ReminderSchedule{} },
+			func(n *Doctor, e *ReminderSchedule) { n.Edges.ReminderSchedules = append(n.Edges.ReminderSchedules, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWebhookSubscriptions; query != nil {
+		if err := _q.loadWebhookSubscriptions(ctx, query, nodes,
+			func(n *Doctor) { n.Edges.WebhookSubscriptions = []*WebhookSubscription{} },
+			func(n *Doctor, e *WebhookSubscription) {
+				n.Edges.WebhookSubscriptions = append(n.Edges.WebhookSubscriptions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withVocabularyTerms; query != nil {
+		if err := _q.loadVocabularyTerms(ctx, query, nodes,
+			func(n *Doctor) { n.Edges.VocabularyTerms = []*VocabularyTerm{} },
+			func(n *Doctor, e *VocabularyTerm) { n.Edges.VocabularyTerms = append(n.Edges.VocabularyTerms, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -883,6 +1241,258 @@ func (_q *DoctorQuery) loadCreatedAnalysisJobs(ctx context.Context, query *Analy
 	}
 	return nil
 }
+func (_q *DoctorQuery) loadAssignedGoals(ctx context.Context, query *GoalQuery, nodes []*Doctor, init func(*Doctor), assign func(*Doctor, *Goal)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Doctor)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(goal.FieldDoctorID)
+	}
+	query.Where(predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(doctor.AssignedGoalsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DoctorID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "doctor_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DoctorQuery) loadAssignedExercises(ctx context.Context, query *AssignmentQuery, nodes []*Doctor, init func(*Doctor), assign func(*Doctor, *Assignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Doctor)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(assignment.FieldDoctorID)
+	}
+	query.Where(predicate.Assignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(doctor.AssignedExercisesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DoctorID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "doctor_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DoctorQuery) loadSessions(ctx context.Context, query *SessionQuery, nodes []*Doctor, init func(*Doctor), assign func(*Doctor, *Session)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Doctor)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(session.FieldDoctorID)
+	}
+	query.Where(predicate.Session(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(doctor.SessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DoctorID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "doctor_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DoctorQuery) loadQuestionnaireAssignments(ctx context.Context, query *QuestionnaireAssignmentQuery, nodes []*Doctor, init func(*Doctor), assign func(*Doctor, *QuestionnaireAssignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Doctor)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(questionnaireassignment.FieldDoctorID)
+	}
+	query.Where(predicate.QuestionnaireAssignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(doctor.QuestionnaireAssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DoctorID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "doctor_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DoctorQuery) loadQuestionnaireDefinitions(ctx context.Context, query *QuestionnaireDefinitionQuery, nodes []*Doctor, init func(*Doctor), assign func(*Doctor, *QuestionnaireDefinition)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Doctor)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(questionnairedefinition.FieldCreatedByDoctorID)
+	}
+	query.Where(predicate.QuestionnaireDefinition(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(doctor.QuestionnaireDefinitionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CreatedByDoctorID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "created_by_doctor_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "created_by_doctor_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DoctorQuery) loadReminderSchedules(ctx context.Context, query *ReminderScheduleQuery, nodes []*Doctor, init func(*Doctor), assign func(*Doctor, *ReminderSchedule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Doctor)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(reminderschedule.FieldCreatedByDoctorID)
+	}
+	query.Where(predicate.ReminderSchedule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(doctor.ReminderSchedulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CreatedByDoctorID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "created_by_doctor_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "created_by_doctor_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DoctorQuery) loadWebhookSubscriptions(ctx context.Context, query *WebhookSubscriptionQuery, nodes []*Doctor, init func(*Doctor), assign func(*Doctor, *WebhookSubscription)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Doctor)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhooksubscription.FieldCreatedByDoctorID)
+	}
+	query.Where(predicate.WebhookSubscription(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(doctor.WebhookSubscriptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CreatedByDoctorID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "created_by_doctor_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "created_by_doctor_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DoctorQuery) loadVocabularyTerms(ctx context.Context, query *VocabularyTermQuery, nodes []*Doctor, init func(*Doctor), assign func(*Doctor, *VocabularyTerm)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Doctor)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(vocabularyterm.FieldDoctorID)
+	}
+	query.Where(predicate.VocabularyTerm(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(doctor.VocabularyTermsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DoctorID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "doctor_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "doctor_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *DoctorQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -4,13 +4,21 @@ package ent
 
 import (
 	"backend/ent/analysisjob"
+	"backend/ent/assignment"
 	"backend/ent/comment"
 	"backend/ent/doctor"
 	"backend/ent/doctorpatientlink"
 	"backend/ent/entryshare"
+	"backend/ent/goal"
 	"backend/ent/pairingcode"
 	"backend/ent/practice"
 	"backend/ent/predicate"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/ent/reminderschedule"
+	"backend/ent/session"
+	"backend/ent/vocabularyterm"
+	"backend/ent/webhooksubscription"
 	"context"
 	"errors"
 	"fmt"
@@ -212,6 +220,126 @@ func (_u *DoctorUpdate) AddCreatedAnalysisJobs(v ...*AnalysisJob) *DoctorUpdate
 	return _u.AddCreatedAnalysisJobIDs(ids...)
 }
 
+// AddAssignedGoalIDs adds the "assigned_goals" edge to the Goal entity by IDs.
+func (_u *DoctorUpdate) AddAssignedGoalIDs(ids ...uuid.UUID) *DoctorUpdate {
+	_u.mutation.AddAssignedGoalIDs(ids...)
+	return _u
+}
+
+// AddAssignedGoals adds the "assigned_goals" edges to the Goal entity.
+func (_u *DoctorUpdate) AddAssignedGoals(v ...*Goal) *DoctorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssignedGoalIDs(ids...)
+}
+
+// AddAssignedExerciseIDs adds the "assigned_exercises" edge to the Assignment entity by IDs.
+func (_u *DoctorUpdate) AddAssignedExerciseIDs(ids ...uuid.UUID) *DoctorUpdate {
+	_u.mutation.AddAssignedExerciseIDs(ids...)
+	return _u
+}
+
+// AddAssignedExercises adds the "assigned_exercises" edges to the Assignment entity.
+func (_u *DoctorUpdate) AddAssignedExercises(v ...*Assignment) *DoctorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssignedExerciseIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_u *DoctorUpdate) AddSessionIDs(ids ...uuid.UUID) *DoctorUpdate {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_u *DoctorUpdate) AddSessions(v ...*Session) *DoctorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// AddQuestionnaireAssignmentIDs adds the "questionnaire_assignments" edge to the QuestionnaireAssignment entity by IDs.
+func (_u *DoctorUpdate) AddQuestionnaireAssignmentIDs(ids ...uuid.UUID) *DoctorUpdate {
+	_u.mutation.AddQuestionnaireAssignmentIDs(ids...)
+	return _u
+}
+
+// AddQuestionnaireAssignments adds the "questionnaire_assignments" edges to the QuestionnaireAssignment entity.
+func (_u *DoctorUpdate) AddQuestionnaireAssignments(v ...*QuestionnaireAssignment) *DoctorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuestionnaireAssignmentIDs(ids...)
+}
+
+// AddQuestionnaireDefinitionIDs adds the "questionnaire_definitions" edge to the QuestionnaireDefinition entity by IDs.
+func (_u *DoctorUpdate) AddQuestionnaireDefinitionIDs(ids ...uuid.UUID) *DoctorUpdate {
+	_u.mutation.AddQuestionnaireDefinitionIDs(ids...)
+	return _u
+}
+
+// AddQuestionnaireDefinitions adds the "questionnaire_definitions" edges to the QuestionnaireDefinition entity.
+func (_u *DoctorUpdate) AddQuestionnaireDefinitions(v ...*QuestionnaireDefinition) *DoctorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuestionnaireDefinitionIDs(ids...)
+}
+
+// AddReminderScheduleIDs adds the "reminder_schedules" edge to the ReminderSchedule entity by IDs.
+func (_u *DoctorUpdate) AddReminderScheduleIDs(ids ...uuid.UUID) *DoctorUpdate {
+	_u.mutation.AddReminderScheduleIDs(ids...)
+	return _u
+}
+
+// AddReminderSchedules adds the "reminder_schedules" edges to the ReminderSchedule entity.
+func (_u *DoctorUpdate) AddReminderSchedules(v ...*ReminderSchedule) *DoctorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReminderScheduleIDs(ids...)
+}
+
+// AddWebhookSubscriptionIDs adds the "webhook_subscriptions" edge to the WebhookSubscription entity by IDs.
+func (_u *DoctorUpdate) AddWebhookSubscriptionIDs(ids ...uuid.UUID) *DoctorUpdate {
+	_u.mutation.AddWebhookSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddWebhookSubscriptions adds the "webhook_subscriptions" edges to the WebhookSubscription entity.
+func (_u *DoctorUpdate) AddWebhookSubscriptions(v ...*WebhookSubscription) *DoctorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookSubscriptionIDs(ids...)
+}
+
+// AddVocabularyTermIDs adds the "vocabulary_terms" edge to the VocabularyTerm entity by IDs.
+func (_u *DoctorUpdate) AddVocabularyTermIDs(ids ...uuid.UUID) *DoctorUpdate {
+	_u.mutation.AddVocabularyTermIDs(ids...)
+	return _u
+}
+
+// AddVocabularyTerms adds the "vocabulary_terms" edges to the VocabularyTerm entity.
+func (_u *DoctorUpdate) AddVocabularyTerms(v ...*VocabularyTerm) *DoctorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVocabularyTermIDs(ids...)
+}
+
 // Mutation returns the DoctorMutation object of the builder.
 func (_u *DoctorUpdate) Mutation() *DoctorMutation {
 	return _u.mutation
@@ -349,6 +477,174 @@ func (_u *DoctorUpdate) RemoveCreatedAnalysisJobs(v ...*AnalysisJob) *DoctorUpda
 	return _u.RemoveCreatedAnalysisJobIDs(ids...)
 }
 
+// ClearAssignedGoals clears all "assigned_goals" edges to the Goal entity.
+func (_u *DoctorUpdate) ClearAssignedGoals() *DoctorUpdate {
+	_u.mutation.ClearAssignedGoals()
+	return _u
+}
+
+// RemoveAssignedGoalIDs removes the "assigned_goals" edge to Goal entities by IDs.
+func (_u *DoctorUpdate) RemoveAssignedGoalIDs(ids ...uuid.UUID) *DoctorUpdate {
+	_u.mutation.RemoveAssignedGoalIDs(ids...)
+	return _u
+}
+
+// RemoveAssignedGoals removes "assigned_goals" edges to Goal entities.
+func (_u *DoctorUpdate) RemoveAssignedGoals(v ...*Goal) *DoctorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssignedGoalIDs(ids...)
+}
+
+// ClearAssignedExercises clears all "assigned_exercises" edges to the Assignment entity.
+func (_u *DoctorUpdate) ClearAssignedExercises() *DoctorUpdate {
+	_u.mutation.ClearAssignedExercises()
+	return _u
+}
+
+// RemoveAssignedExerciseIDs removes the "assigned_exercises" edge to Assignment entities by IDs.
+func (_u *DoctorUpdate) RemoveAssignedExerciseIDs(ids ...uuid.UUID) *DoctorUpdate {
+	_u.mutation.RemoveAssignedExerciseIDs(ids...)
+	return _u
+}
+
+// RemoveAssignedExercises removes "assigned_exercises" edges to Assignment entities.
+func (_u *DoctorUpdate) RemoveAssignedExercises(v ...*Assignment) *DoctorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssignedExerciseIDs(ids...)
+}
+
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (_u *DoctorUpdate) ClearSessions() *DoctorUpdate {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (_u *DoctorUpdate) RemoveSessionIDs(ids ...uuid.UUID) *DoctorUpdate {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (_u *DoctorUpdate) RemoveSessions(v ...*Session) *DoctorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
+// ClearQuestionnaireAssignments clears all "questionnaire_assignments" edges to the QuestionnaireAssignment entity.
+func (_u *DoctorUpdate) ClearQuestionnaireAssignments() *DoctorUpdate {
+	_u.mutation.ClearQuestionnaireAssignments()
+	return _u
+}
+
+// RemoveQuestionnaireAssignmentIDs removes the "questionnaire_assignments" edge to QuestionnaireAssignment entities by IDs.
+func (_u *DoctorUpdate) RemoveQuestionnaireAssignmentIDs(ids ...uuid.UUID) *DoctorUpdate {
+	_u.mutation.RemoveQuestionnaireAssignmentIDs(ids...)
+	return _u
+}
+
+// RemoveQuestionnaireAssignments removes "questionnaire_assignments" edges to QuestionnaireAssignment entities.
+func (_u *DoctorUpdate) RemoveQuestionnaireAssignments(v ...*QuestionnaireAssignment) *DoctorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuestionnaireAssignmentIDs(ids...)
+}
+
+// ClearQuestionnaireDefinitions clears all "questionnaire_definitions" edges to the QuestionnaireDefinition entity.
+func (_u *DoctorUpdate) ClearQuestionnaireDefinitions() *DoctorUpdate {
+	_u.mutation.ClearQuestionnaireDefinitions()
+	return _u
+}
+
+// RemoveQuestionnaireDefinitionIDs removes the "questionnaire_definitions" edge to QuestionnaireDefinition entities by IDs.
+func (_u *DoctorUpdate) RemoveQuestionnaireDefinitionIDs(ids ...uuid.UUID) *DoctorUpdate {
+	_u.mutation.RemoveQuestionnaireDefinitionIDs(ids...)
+	return _u
+}
+
+// RemoveQuestionnaireDefinitions removes "questionnaire_definitions" edges to QuestionnaireDefinition entities.
+func (_u *DoctorUpdate) RemoveQuestionnaireDefinitions(v ...*QuestionnaireDefinition) *DoctorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuestionnaireDefinitionIDs(ids...)
+}
+
+// ClearReminderSchedules clears all "reminder_schedules" edges to the ReminderSchedule entity.
+func (_u *DoctorUpdate) ClearReminderSchedules() *DoctorUpdate {
+	_u.mutation.ClearReminderSchedules()
+	return _u
+}
+
+// RemoveReminderScheduleIDs removes the "reminder_schedules" edge to ReminderSchedule entities by IDs.
+func (_u *DoctorUpdate) RemoveReminderScheduleIDs(ids ...uuid.UUID) *DoctorUpdate {
+	_u.mutation.RemoveReminderScheduleIDs(ids...)
+	return _u
+}
+
+// RemoveReminderSchedules removes "reminder_schedules" edges to ReminderSchedule entities.
+func (_u *DoctorUpdate) RemoveReminderSchedules(v ...*ReminderSchedule) *DoctorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReminderScheduleIDs(ids...)
+}
+
+// ClearWebhookSubscriptions clears all "webhook_subscriptions" edges to the WebhookSubscription entity.
+func (_u *DoctorUpdate) ClearWebhookSubscriptions() *DoctorUpdate {
+	_u.mutation.ClearWebhookSubscriptions()
+	return _u
+}
+
+// RemoveWebhookSubscriptionIDs removes the "webhook_subscriptions" edge to WebhookSubscription entities by IDs.
+func (_u *DoctorUpdate) RemoveWebhookSubscriptionIDs(ids ...uuid.UUID) *DoctorUpdate {
+	_u.mutation.RemoveWebhookSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveWebhookSubscriptions removes "webhook_subscriptions" edges to WebhookSubscription entities.
+func (_u *DoctorUpdate) RemoveWebhookSubscriptions(v ...*WebhookSubscription) *DoctorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookSubscriptionIDs(ids...)
+}
+
+// ClearVocabularyTerms clears all "vocabulary_terms" edges to the VocabularyTerm entity.
+func (_u *DoctorUpdate) ClearVocabularyTerms() *DoctorUpdate {
+	_u.mutation.ClearVocabularyTerms()
+	return _u
+}
+
+// RemoveVocabularyTermIDs removes the "vocabulary_terms" edge to VocabularyTerm entities by IDs.
+func (_u *DoctorUpdate) RemoveVocabularyTermIDs(ids ...uuid.UUID) *DoctorUpdate {
+	_u.mutation.RemoveVocabularyTermIDs(ids...)
+	return _u
+}
+
+// RemoveVocabularyTerms removes "vocabulary_terms" edges to VocabularyTerm entities.
+func (_u *DoctorUpdate) RemoveVocabularyTerms(v ...*VocabularyTerm) *DoctorUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVocabularyTermIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DoctorUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -736,480 +1032,1488 @@ func (_u *DoctorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{doctor.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.AssignedGoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.AssignedGoalsTable,
+			Columns: []string{doctor.AssignedGoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// DoctorUpdateOne is the builder for updating a single Doctor entity.
-type DoctorUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *DoctorMutation
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *DoctorUpdateOne) SetUpdatedAt(v time.Time) *DoctorUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
-// SetEmail sets the "email" field.
-func (_u *DoctorUpdateOne) SetEmail(v string) *DoctorUpdateOne {
-	_u.mutation.SetEmail(v)
-	return _u
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (_u *DoctorUpdateOne) SetNillableEmail(v *string) *DoctorUpdateOne {
-	if v != nil {
-		_u.SetEmail(*v)
+	if nodes := _u.mutation.RemovedAssignedGoalsIDs(); len(nodes) > 0 && !_u.mutation.AssignedGoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.AssignedGoalsTable,
+			Columns: []string{doctor.AssignedGoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetDisplayName sets the "display_name" field.
-func (_u *DoctorUpdateOne) SetDisplayName(v string) *DoctorUpdateOne {
-	_u.mutation.SetDisplayName(v)
-	return _u
-}
-
-// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
-func (_u *DoctorUpdateOne) SetNillableDisplayName(v *string) *DoctorUpdateOne {
-	if v != nil {
-		_u.SetDisplayName(*v)
+	if nodes := _u.mutation.AssignedGoalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.AssignedGoalsTable,
+			Columns: []string{doctor.AssignedGoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetPasswordHash sets the "password_hash" field.
-func (_u *DoctorUpdateOne) SetPasswordHash(v string) *DoctorUpdateOne {
-	_u.mutation.SetPasswordHash(v)
-	return _u
-}
-
-// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
-func (_u *DoctorUpdateOne) SetNillablePasswordHash(v *string) *DoctorUpdateOne {
-	if v != nil {
-		_u.SetPasswordHash(*v)
+	if _u.mutation.AssignedExercisesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.AssignedExercisesTable,
+			Columns: []string{doctor.AssignedExercisesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetRole sets the "role" field.
-func (_u *DoctorUpdateOne) SetRole(v doctor.Role) *DoctorUpdateOne {
-	_u.mutation.SetRole(v)
-	return _u
-}
-
-// SetNillableRole sets the "role" field if the given value is not nil.
-func (_u *DoctorUpdateOne) SetNillableRole(v *doctor.Role) *DoctorUpdateOne {
-	if v != nil {
-		_u.SetRole(*v)
+	if nodes := _u.mutation.RemovedAssignedExercisesIDs(); len(nodes) > 0 && !_u.mutation.AssignedExercisesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.AssignedExercisesTable,
+			Columns: []string{doctor.AssignedExercisesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetPracticeID sets the "practice_id" field.
-func (_u *DoctorUpdateOne) SetPracticeID(v uuid.UUID) *DoctorUpdateOne {
-	_u.mutation.SetPracticeID(v)
-	return _u
-}
-
-// SetNillablePracticeID sets the "practice_id" field if the given value is not nil.
-func (_u *DoctorUpdateOne) SetNillablePracticeID(v *uuid.UUID) *DoctorUpdateOne {
-	if v != nil {
-		_u.SetPracticeID(*v)
+	if nodes := _u.mutation.AssignedExercisesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.AssignedExercisesTable,
+			Columns: []string{doctor.AssignedExercisesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// ClearPracticeID clears the value of the "practice_id" field.
-func (_u *DoctorUpdateOne) ClearPracticeID() *DoctorUpdateOne {
-	_u.mutation.ClearPracticeID()
-	return _u
-}
-
-// SetPractice sets the "practice" edge to the Practice entity.
-func (_u *DoctorUpdateOne) SetPractice(v *Practice) *DoctorUpdateOne {
-	return _u.SetPracticeID(v.ID)
-}
-
-// AddPatientLinkIDs adds the "patient_links" edge to the DoctorPatientLink entity by IDs.
-func (_u *DoctorUpdateOne) AddPatientLinkIDs(ids ...uuid.UUID) *DoctorUpdateOne {
-	_u.mutation.AddPatientLinkIDs(ids...)
-	return _u
-}
-
-// AddPatientLinks adds the "patient_links" edges to the DoctorPatientLink entity.
-func (_u *DoctorUpdateOne) AddPatientLinks(v ...*DoctorPatientLink) *DoctorUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.SessionsTable,
+			Columns: []string{doctor.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddPatientLinkIDs(ids...)
-}
-
-// AddPairingCodeIDs adds the "pairing_codes" edge to the PairingCode entity by IDs.
-func (_u *DoctorUpdateOne) AddPairingCodeIDs(ids ...uuid.UUID) *DoctorUpdateOne {
-	_u.mutation.AddPairingCodeIDs(ids...)
-	return _u
-}
-
-// AddPairingCodes adds the "pairing_codes" edges to the PairingCode entity.
-func (_u *DoctorUpdateOne) AddPairingCodes(v ...*PairingCode) *DoctorUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.SessionsTable,
+			Columns: []string{doctor.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddPairingCodeIDs(ids...)
-}
-
-// AddApprovedPatientLinkIDs adds the "approved_patient_links" edge to the DoctorPatientLink entity by IDs.
-func (_u *DoctorUpdateOne) AddApprovedPatientLinkIDs(ids ...uuid.UUID) *DoctorUpdateOne {
-	_u.mutation.AddApprovedPatientLinkIDs(ids...)
-	return _u
-}
-
-// AddApprovedPatientLinks adds the "approved_patient_links" edges to the DoctorPatientLink entity.
-func (_u *DoctorUpdateOne) AddApprovedPatientLinks(v ...*DoctorPatientLink) *DoctorUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.SessionsTable,
+			Columns: []string{doctor.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddApprovedPatientLinkIDs(ids...)
-}
-
-// AddEntryShareIDs adds the "entry_shares" edge to the EntryShare entity by IDs.
-func (_u *DoctorUpdateOne) AddEntryShareIDs(ids ...uuid.UUID) *DoctorUpdateOne {
-	_u.mutation.AddEntryShareIDs(ids...)
-	return _u
-}
-
-// AddEntryShares adds the "entry_shares" edges to the EntryShare entity.
-func (_u *DoctorUpdateOne) AddEntryShares(v ...*EntryShare) *DoctorUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.QuestionnaireAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.QuestionnaireAssignmentsTable,
+			Columns: []string{doctor.QuestionnaireAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddEntryShareIDs(ids...)
-}
-
-// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
-func (_u *DoctorUpdateOne) AddCommentIDs(ids ...uuid.UUID) *DoctorUpdateOne {
-	_u.mutation.AddCommentIDs(ids...)
-	return _u
-}
-
-// AddComments adds the "comments" edges to the Comment entity.
-func (_u *DoctorUpdateOne) AddComments(v ...*Comment) *DoctorUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedQuestionnaireAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.QuestionnaireAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.QuestionnaireAssignmentsTable,
+			Columns: []string{doctor.QuestionnaireAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddCommentIDs(ids...)
-}
-
-// AddCreatedAnalysisJobIDs adds the "created_analysis_jobs" edge to the AnalysisJob entity by IDs.
-func (_u *DoctorUpdateOne) AddCreatedAnalysisJobIDs(ids ...uuid.UUID) *DoctorUpdateOne {
-	_u.mutation.AddCreatedAnalysisJobIDs(ids...)
-	return _u
-}
-
-// AddCreatedAnalysisJobs adds the "created_analysis_jobs" edges to the AnalysisJob entity.
-func (_u *DoctorUpdateOne) AddCreatedAnalysisJobs(v ...*AnalysisJob) *DoctorUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.QuestionnaireAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.QuestionnaireAssignmentsTable,
+			Columns: []string{doctor.QuestionnaireAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddCreatedAnalysisJobIDs(ids...)
-}
-
-// Mutation returns the DoctorMutation object of the builder.
-func (_u *DoctorUpdateOne) Mutation() *DoctorMutation {
-	return _u.mutation
-}
-
-// ClearPractice clears the "practice" edge to the Practice entity.
-func (_u *DoctorUpdateOne) ClearPractice() *DoctorUpdateOne {
-	_u.mutation.ClearPractice()
-	return _u
-}
-
-// ClearPatientLinks clears all "patient_links" edges to the DoctorPatientLink entity.
-func (_u *DoctorUpdateOne) ClearPatientLinks() *DoctorUpdateOne {
-	_u.mutation.ClearPatientLinks()
-	return _u
-}
-
-// RemovePatientLinkIDs removes the "patient_links" edge to DoctorPatientLink entities by IDs.
-func (_u *DoctorUpdateOne) RemovePatientLinkIDs(ids ...uuid.UUID) *DoctorUpdateOne {
-	_u.mutation.RemovePatientLinkIDs(ids...)
-	return _u
-}
-
-// RemovePatientLinks removes "patient_links" edges to DoctorPatientLink entities.
-func (_u *DoctorUpdateOne) RemovePatientLinks(v ...*DoctorPatientLink) *DoctorUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.QuestionnaireDefinitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.QuestionnaireDefinitionsTable,
+			Columns: []string{doctor.QuestionnaireDefinitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemovePatientLinkIDs(ids...)
-}
-
-// ClearPairingCodes clears all "pairing_codes" edges to the PairingCode entity.
-func (_u *DoctorUpdateOne) ClearPairingCodes() *DoctorUpdateOne {
-	_u.mutation.ClearPairingCodes()
-	return _u
+	if nodes := _u.mutation.RemovedQuestionnaireDefinitionsIDs(); len(nodes) > 0 && !_u.mutation.QuestionnaireDefinitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.QuestionnaireDefinitionsTable,
+			Columns: []string{doctor.QuestionnaireDefinitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuestionnaireDefinitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.QuestionnaireDefinitionsTable,
+			Columns: []string{doctor.QuestionnaireDefinitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReminderSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.ReminderSchedulesTable,
+			Columns: []string{doctor.ReminderSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReminderSchedulesIDs(); len(nodes) > 0 && !_u.mutation.ReminderSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.ReminderSchedulesTable,
+			Columns: []string{doctor.ReminderSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReminderSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.ReminderSchedulesTable,
+			Columns: []string{doctor.ReminderSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhookSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.WebhookSubscriptionsTable,
+			Columns: []string{doctor.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhookSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.WebhookSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.WebhookSubscriptionsTable,
+			Columns: []string{doctor.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhookSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.WebhookSubscriptionsTable,
+			Columns: []string{doctor.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VocabularyTermsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.VocabularyTermsTable,
+			Columns: []string{doctor.VocabularyTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vocabularyterm.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVocabularyTermsIDs(); len(nodes) > 0 && !_u.mutation.VocabularyTermsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.VocabularyTermsTable,
+			Columns: []string{doctor.VocabularyTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vocabularyterm.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VocabularyTermsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.VocabularyTermsTable,
+			Columns: []string{doctor.VocabularyTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vocabularyterm.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{doctor.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
 }
 
-// RemovePairingCodeIDs removes the "pairing_codes" edge to PairingCode entities by IDs.
-func (_u *DoctorUpdateOne) RemovePairingCodeIDs(ids ...uuid.UUID) *DoctorUpdateOne {
-	_u.mutation.RemovePairingCodeIDs(ids...)
+// DoctorUpdateOne is the builder for updating a single Doctor entity.
+type DoctorUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DoctorMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DoctorUpdateOne) SetUpdatedAt(v time.Time) *DoctorUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
-// RemovePairingCodes removes "pairing_codes" edges to PairingCode entities.
-func (_u *DoctorUpdateOne) RemovePairingCodes(v ...*PairingCode) *DoctorUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.RemovePairingCodeIDs(ids...)
+// SetEmail sets the "email" field.
+func (_u *DoctorUpdateOne) SetEmail(v string) *DoctorUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
 }
 
-// ClearApprovedPatientLinks clears all "approved_patient_links" edges to the DoctorPatientLink entity.
-func (_u *DoctorUpdateOne) ClearApprovedPatientLinks() *DoctorUpdateOne {
-	_u.mutation.ClearApprovedPatientLinks()
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *DoctorUpdateOne) SetNillableEmail(v *string) *DoctorUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
 	return _u
 }
 
-// RemoveApprovedPatientLinkIDs removes the "approved_patient_links" edge to DoctorPatientLink entities by IDs.
-func (_u *DoctorUpdateOne) RemoveApprovedPatientLinkIDs(ids ...uuid.UUID) *DoctorUpdateOne {
-	_u.mutation.RemoveApprovedPatientLinkIDs(ids...)
+// SetDisplayName sets the "display_name" field.
+func (_u *DoctorUpdateOne) SetDisplayName(v string) *DoctorUpdateOne {
+	_u.mutation.SetDisplayName(v)
 	return _u
 }
 
-// RemoveApprovedPatientLinks removes "approved_patient_links" edges to DoctorPatientLink entities.
-func (_u *DoctorUpdateOne) RemoveApprovedPatientLinks(v ...*DoctorPatientLink) *DoctorUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *DoctorUpdateOne) SetNillableDisplayName(v *string) *DoctorUpdateOne {
+	if v != nil {
+		_u.SetDisplayName(*v)
 	}
-	return _u.RemoveApprovedPatientLinkIDs(ids...)
+	return _u
 }
 
-// ClearEntryShares clears all "entry_shares" edges to the EntryShare entity.
-func (_u *DoctorUpdateOne) ClearEntryShares() *DoctorUpdateOne {
-	_u.mutation.ClearEntryShares()
+// SetPasswordHash sets the "password_hash" field.
+func (_u *DoctorUpdateOne) SetPasswordHash(v string) *DoctorUpdateOne {
+	_u.mutation.SetPasswordHash(v)
 	return _u
 }
 
-// RemoveEntryShareIDs removes the "entry_shares" edge to EntryShare entities by IDs.
-func (_u *DoctorUpdateOne) RemoveEntryShareIDs(ids ...uuid.UUID) *DoctorUpdateOne {
-	_u.mutation.RemoveEntryShareIDs(ids...)
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (_u *DoctorUpdateOne) SetNillablePasswordHash(v *string) *DoctorUpdateOne {
+	if v != nil {
+		_u.SetPasswordHash(*v)
+	}
 	return _u
 }
 
-// RemoveEntryShares removes "entry_shares" edges to EntryShare entities.
-func (_u *DoctorUpdateOne) RemoveEntryShares(v ...*EntryShare) *DoctorUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.RemoveEntryShareIDs(ids...)
+// SetRole sets the "role" field.
+func (_u *DoctorUpdateOne) SetRole(v doctor.Role) *DoctorUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
 }
 
-// ClearComments clears all "comments" edges to the Comment entity.
-func (_u *DoctorUpdateOne) ClearComments() *DoctorUpdateOne {
-	_u.mutation.ClearComments()
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *DoctorUpdateOne) SetNillableRole(v *doctor.Role) *DoctorUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
 	return _u
 }
 
-// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
-func (_u *DoctorUpdateOne) RemoveCommentIDs(ids ...uuid.UUID) *DoctorUpdateOne {
-	_u.mutation.RemoveCommentIDs(ids...)
+// SetPracticeID sets the "practice_id" field.
+func (_u *DoctorUpdateOne) SetPracticeID(v uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.SetPracticeID(v)
 	return _u
 }
 
-// RemoveComments removes "comments" edges to Comment entities.
-func (_u *DoctorUpdateOne) RemoveComments(v ...*Comment) *DoctorUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillablePracticeID sets the "practice_id" field if the given value is not nil.
+func (_u *DoctorUpdateOne) SetNillablePracticeID(v *uuid.UUID) *DoctorUpdateOne {
+	if v != nil {
+		_u.SetPracticeID(*v)
 	}
-	return _u.RemoveCommentIDs(ids...)
+	return _u
 }
 
-// ClearCreatedAnalysisJobs clears all "created_analysis_jobs" edges to the AnalysisJob entity.
-func (_u *DoctorUpdateOne) ClearCreatedAnalysisJobs() *DoctorUpdateOne {
-	_u.mutation.ClearCreatedAnalysisJobs()
+// ClearPracticeID clears the value of the "practice_id" field.
+func (_u *DoctorUpdateOne) ClearPracticeID() *DoctorUpdateOne {
+	_u.mutation.ClearPracticeID()
 	return _u
 }
 
-// RemoveCreatedAnalysisJobIDs removes the "created_analysis_jobs" edge to AnalysisJob entities by IDs.
-func (_u *DoctorUpdateOne) RemoveCreatedAnalysisJobIDs(ids ...uuid.UUID) *DoctorUpdateOne {
-	_u.mutation.RemoveCreatedAnalysisJobIDs(ids...)
+// SetPractice sets the "practice" edge to the Practice entity.
+func (_u *DoctorUpdateOne) SetPractice(v *Practice) *DoctorUpdateOne {
+	return _u.SetPracticeID(v.ID)
+}
+
+// AddPatientLinkIDs adds the "patient_links" edge to the DoctorPatientLink entity by IDs.
+func (_u *DoctorUpdateOne) AddPatientLinkIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.AddPatientLinkIDs(ids...)
 	return _u
 }
 
-// RemoveCreatedAnalysisJobs removes "created_analysis_jobs" edges to AnalysisJob entities.
-func (_u *DoctorUpdateOne) RemoveCreatedAnalysisJobs(v ...*AnalysisJob) *DoctorUpdateOne {
+// AddPatientLinks adds the "patient_links" edges to the DoctorPatientLink entity.
+func (_u *DoctorUpdateOne) AddPatientLinks(v ...*DoctorPatientLink) *DoctorUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveCreatedAnalysisJobIDs(ids...)
+	return _u.AddPatientLinkIDs(ids...)
 }
 
-// Where appends a list predicates to the DoctorUpdate builder.
-func (_u *DoctorUpdateOne) Where(ps ...predicate.Doctor) *DoctorUpdateOne {
-	_u.mutation.Where(ps...)
+// AddPairingCodeIDs adds the "pairing_codes" edge to the PairingCode entity by IDs.
+func (_u *DoctorUpdateOne) AddPairingCodeIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.AddPairingCodeIDs(ids...)
 	return _u
 }
 
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (_u *DoctorUpdateOne) Select(field string, fields ...string) *DoctorUpdateOne {
-	_u.fields = append([]string{field}, fields...)
+// AddPairingCodes adds the "pairing_codes" edges to the PairingCode entity.
+func (_u *DoctorUpdateOne) AddPairingCodes(v ...*PairingCode) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPairingCodeIDs(ids...)
+}
+
+// AddApprovedPatientLinkIDs adds the "approved_patient_links" edge to the DoctorPatientLink entity by IDs.
+func (_u *DoctorUpdateOne) AddApprovedPatientLinkIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.AddApprovedPatientLinkIDs(ids...)
 	return _u
 }
 
-// Save executes the query and returns the updated Doctor entity.
-func (_u *DoctorUpdateOne) Save(ctx context.Context) (*Doctor, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// AddApprovedPatientLinks adds the "approved_patient_links" edges to the DoctorPatientLink entity.
+func (_u *DoctorUpdateOne) AddApprovedPatientLinks(v ...*DoctorPatientLink) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddApprovedPatientLinkIDs(ids...)
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *DoctorUpdateOne) SaveX(ctx context.Context) *Doctor {
-	node, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// AddEntryShareIDs adds the "entry_shares" edge to the EntryShare entity by IDs.
+func (_u *DoctorUpdateOne) AddEntryShareIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.AddEntryShareIDs(ids...)
+	return _u
+}
+
+// AddEntryShares adds the "entry_shares" edges to the EntryShare entity.
+func (_u *DoctorUpdateOne) AddEntryShares(v ...*EntryShare) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return node
+	return _u.AddEntryShareIDs(ids...)
 }
 
-// Exec executes the query on the entity.
-func (_u *DoctorUpdateOne) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (_u *DoctorUpdateOne) AddCommentIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.AddCommentIDs(ids...)
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *DoctorUpdateOne) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// AddComments adds the "comments" edges to the Comment entity.
+func (_u *DoctorUpdateOne) AddComments(v ...*Comment) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.AddCommentIDs(ids...)
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *DoctorUpdateOne) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := doctor.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
+// AddCreatedAnalysisJobIDs adds the "created_analysis_jobs" edge to the AnalysisJob entity by IDs.
+func (_u *DoctorUpdateOne) AddCreatedAnalysisJobIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.AddCreatedAnalysisJobIDs(ids...)
+	return _u
+}
+
+// AddCreatedAnalysisJobs adds the "created_analysis_jobs" edges to the AnalysisJob entity.
+func (_u *DoctorUpdateOne) AddCreatedAnalysisJobs(v ...*AnalysisJob) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.AddCreatedAnalysisJobIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *DoctorUpdateOne) check() error {
-	if v, ok := _u.mutation.Email(); ok {
-		if err := doctor.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Doctor.email": %w`, err)}
+// AddAssignedGoalIDs adds the "assigned_goals" edge to the Goal entity by IDs.
+func (_u *DoctorUpdateOne) AddAssignedGoalIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.AddAssignedGoalIDs(ids...)
+	return _u
+}
+
+// AddAssignedGoals adds the "assigned_goals" edges to the Goal entity.
+func (_u *DoctorUpdateOne) AddAssignedGoals(v ...*Goal) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssignedGoalIDs(ids...)
+}
+
+// AddAssignedExerciseIDs adds the "assigned_exercises" edge to the Assignment entity by IDs.
+func (_u *DoctorUpdateOne) AddAssignedExerciseIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.AddAssignedExerciseIDs(ids...)
+	return _u
+}
+
+// AddAssignedExercises adds the "assigned_exercises" edges to the Assignment entity.
+func (_u *DoctorUpdateOne) AddAssignedExercises(v ...*Assignment) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssignedExerciseIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_u *DoctorUpdateOne) AddSessionIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_u *DoctorUpdateOne) AddSessions(v ...*Session) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// AddQuestionnaireAssignmentIDs adds the "questionnaire_assignments" edge to the QuestionnaireAssignment entity by IDs.
+func (_u *DoctorUpdateOne) AddQuestionnaireAssignmentIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.AddQuestionnaireAssignmentIDs(ids...)
+	return _u
+}
+
+// AddQuestionnaireAssignments adds the "questionnaire_assignments" edges to the QuestionnaireAssignment entity.
+func (_u *DoctorUpdateOne) AddQuestionnaireAssignments(v ...*QuestionnaireAssignment) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuestionnaireAssignmentIDs(ids...)
+}
+
+// AddQuestionnaireDefinitionIDs adds the "questionnaire_definitions" edge to the QuestionnaireDefinition entity by IDs.
+func (_u *DoctorUpdateOne) AddQuestionnaireDefinitionIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.AddQuestionnaireDefinitionIDs(ids...)
+	return _u
+}
+
+// AddQuestionnaireDefinitions adds the "questionnaire_definitions" edges to the QuestionnaireDefinition entity.
+func (_u *DoctorUpdateOne) AddQuestionnaireDefinitions(v ...*QuestionnaireDefinition) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuestionnaireDefinitionIDs(ids...)
+}
+
+// AddReminderScheduleIDs adds the "reminder_schedules" edge to the ReminderSchedule entity by IDs.
+func (_u *DoctorUpdateOne) AddReminderScheduleIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.AddReminderScheduleIDs(ids...)
+	return _u
+}
+
+// AddReminderSchedules adds the "reminder_schedules" edges to the ReminderSchedule entity.
+func (_u *DoctorUpdateOne) AddReminderSchedules(v ...*ReminderSchedule) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReminderScheduleIDs(ids...)
+}
+
+// AddWebhookSubscriptionIDs adds the "webhook_subscriptions" edge to the WebhookSubscription entity by IDs.
+func (_u *DoctorUpdateOne) AddWebhookSubscriptionIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.AddWebhookSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddWebhookSubscriptions adds the "webhook_subscriptions" edges to the WebhookSubscription entity.
+func (_u *DoctorUpdateOne) AddWebhookSubscriptions(v ...*WebhookSubscription) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookSubscriptionIDs(ids...)
+}
+
+// AddVocabularyTermIDs adds the "vocabulary_terms" edge to the VocabularyTerm entity by IDs.
+func (_u *DoctorUpdateOne) AddVocabularyTermIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.AddVocabularyTermIDs(ids...)
+	return _u
+}
+
+// AddVocabularyTerms adds the "vocabulary_terms" edges to the VocabularyTerm entity.
+func (_u *DoctorUpdateOne) AddVocabularyTerms(v ...*VocabularyTerm) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVocabularyTermIDs(ids...)
+}
+
+// Mutation returns the DoctorMutation object of the builder.
+func (_u *DoctorUpdateOne) Mutation() *DoctorMutation {
+	return _u.mutation
+}
+
+// ClearPractice clears the "practice" edge to the Practice entity.
+func (_u *DoctorUpdateOne) ClearPractice() *DoctorUpdateOne {
+	_u.mutation.ClearPractice()
+	return _u
+}
+
+// ClearPatientLinks clears all "patient_links" edges to the DoctorPatientLink entity.
+func (_u *DoctorUpdateOne) ClearPatientLinks() *DoctorUpdateOne {
+	_u.mutation.ClearPatientLinks()
+	return _u
+}
+
+// RemovePatientLinkIDs removes the "patient_links" edge to DoctorPatientLink entities by IDs.
+func (_u *DoctorUpdateOne) RemovePatientLinkIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.RemovePatientLinkIDs(ids...)
+	return _u
+}
+
+// RemovePatientLinks removes "patient_links" edges to DoctorPatientLink entities.
+func (_u *DoctorUpdateOne) RemovePatientLinks(v ...*DoctorPatientLink) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePatientLinkIDs(ids...)
+}
+
+// ClearPairingCodes clears all "pairing_codes" edges to the PairingCode entity.
+func (_u *DoctorUpdateOne) ClearPairingCodes() *DoctorUpdateOne {
+	_u.mutation.ClearPairingCodes()
+	return _u
+}
+
+// RemovePairingCodeIDs removes the "pairing_codes" edge to PairingCode entities by IDs.
+func (_u *DoctorUpdateOne) RemovePairingCodeIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.RemovePairingCodeIDs(ids...)
+	return _u
+}
+
+// RemovePairingCodes removes "pairing_codes" edges to PairingCode entities.
+func (_u *DoctorUpdateOne) RemovePairingCodes(v ...*PairingCode) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePairingCodeIDs(ids...)
+}
+
+// ClearApprovedPatientLinks clears all "approved_patient_links" edges to the DoctorPatientLink entity.
+func (_u *DoctorUpdateOne) ClearApprovedPatientLinks() *DoctorUpdateOne {
+	_u.mutation.ClearApprovedPatientLinks()
+	return _u
+}
+
+// RemoveApprovedPatientLinkIDs removes the "approved_patient_links" edge to DoctorPatientLink entities by IDs.
+func (_u *DoctorUpdateOne) RemoveApprovedPatientLinkIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.RemoveApprovedPatientLinkIDs(ids...)
+	return _u
+}
+
+// RemoveApprovedPatientLinks removes "approved_patient_links" edges to DoctorPatientLink entities.
+func (_u *DoctorUpdateOne) RemoveApprovedPatientLinks(v ...*DoctorPatientLink) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveApprovedPatientLinkIDs(ids...)
+}
+
+// ClearEntryShares clears all "entry_shares" edges to the EntryShare entity.
+func (_u *DoctorUpdateOne) ClearEntryShares() *DoctorUpdateOne {
+	_u.mutation.ClearEntryShares()
+	return _u
+}
+
+// RemoveEntryShareIDs removes the "entry_shares" edge to EntryShare entities by IDs.
+func (_u *DoctorUpdateOne) RemoveEntryShareIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.RemoveEntryShareIDs(ids...)
+	return _u
+}
+
+// RemoveEntryShares removes "entry_shares" edges to EntryShare entities.
+func (_u *DoctorUpdateOne) RemoveEntryShares(v ...*EntryShare) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEntryShareIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (_u *DoctorUpdateOne) ClearComments() *DoctorUpdateOne {
+	_u.mutation.ClearComments()
+	return _u
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (_u *DoctorUpdateOne) RemoveCommentIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.RemoveCommentIDs(ids...)
+	return _u
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (_u *DoctorUpdateOne) RemoveComments(v ...*Comment) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCommentIDs(ids...)
+}
+
+// ClearCreatedAnalysisJobs clears all "created_analysis_jobs" edges to the AnalysisJob entity.
+func (_u *DoctorUpdateOne) ClearCreatedAnalysisJobs() *DoctorUpdateOne {
+	_u.mutation.ClearCreatedAnalysisJobs()
+	return _u
+}
+
+// RemoveCreatedAnalysisJobIDs removes the "created_analysis_jobs" edge to AnalysisJob entities by IDs.
+func (_u *DoctorUpdateOne) RemoveCreatedAnalysisJobIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.RemoveCreatedAnalysisJobIDs(ids...)
+	return _u
+}
+
+// RemoveCreatedAnalysisJobs removes "created_analysis_jobs" edges to AnalysisJob entities.
+func (_u *DoctorUpdateOne) RemoveCreatedAnalysisJobs(v ...*AnalysisJob) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCreatedAnalysisJobIDs(ids...)
+}
+
+// ClearAssignedGoals clears all "assigned_goals" edges to the Goal entity.
+func (_u *DoctorUpdateOne) ClearAssignedGoals() *DoctorUpdateOne {
+	_u.mutation.ClearAssignedGoals()
+	return _u
+}
+
+// RemoveAssignedGoalIDs removes the "assigned_goals" edge to Goal entities by IDs.
+func (_u *DoctorUpdateOne) RemoveAssignedGoalIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.RemoveAssignedGoalIDs(ids...)
+	return _u
+}
+
+// RemoveAssignedGoals removes "assigned_goals" edges to Goal entities.
+func (_u *DoctorUpdateOne) RemoveAssignedGoals(v ...*Goal) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssignedGoalIDs(ids...)
+}
+
+// ClearAssignedExercises clears all "assigned_exercises" edges to the Assignment entity.
+func (_u *DoctorUpdateOne) ClearAssignedExercises() *DoctorUpdateOne {
+	_u.mutation.ClearAssignedExercises()
+	return _u
+}
+
+// RemoveAssignedExerciseIDs removes the "assigned_exercises" edge to Assignment entities by IDs.
+func (_u *DoctorUpdateOne) RemoveAssignedExerciseIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.RemoveAssignedExerciseIDs(ids...)
+	return _u
+}
+
+// RemoveAssignedExercises removes "assigned_exercises" edges to Assignment entities.
+func (_u *DoctorUpdateOne) RemoveAssignedExercises(v ...*Assignment) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssignedExerciseIDs(ids...)
+}
+
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (_u *DoctorUpdateOne) ClearSessions() *DoctorUpdateOne {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (_u *DoctorUpdateOne) RemoveSessionIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (_u *DoctorUpdateOne) RemoveSessions(v ...*Session) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
+// ClearQuestionnaireAssignments clears all "questionnaire_assignments" edges to the QuestionnaireAssignment entity.
+func (_u *DoctorUpdateOne) ClearQuestionnaireAssignments() *DoctorUpdateOne {
+	_u.mutation.ClearQuestionnaireAssignments()
+	return _u
+}
+
+// RemoveQuestionnaireAssignmentIDs removes the "questionnaire_assignments" edge to QuestionnaireAssignment entities by IDs.
+func (_u *DoctorUpdateOne) RemoveQuestionnaireAssignmentIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.RemoveQuestionnaireAssignmentIDs(ids...)
+	return _u
+}
+
+// RemoveQuestionnaireAssignments removes "questionnaire_assignments" edges to QuestionnaireAssignment entities.
+func (_u *DoctorUpdateOne) RemoveQuestionnaireAssignments(v ...*QuestionnaireAssignment) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuestionnaireAssignmentIDs(ids...)
+}
+
+// ClearQuestionnaireDefinitions clears all "questionnaire_definitions" edges to the QuestionnaireDefinition entity.
+func (_u *DoctorUpdateOne) ClearQuestionnaireDefinitions() *DoctorUpdateOne {
+	_u.mutation.ClearQuestionnaireDefinitions()
+	return _u
+}
+
+// RemoveQuestionnaireDefinitionIDs removes the "questionnaire_definitions" edge to QuestionnaireDefinition entities by IDs.
+func (_u *DoctorUpdateOne) RemoveQuestionnaireDefinitionIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.RemoveQuestionnaireDefinitionIDs(ids...)
+	return _u
+}
+
+// RemoveQuestionnaireDefinitions removes "questionnaire_definitions" edges to QuestionnaireDefinition entities.
+func (_u *DoctorUpdateOne) RemoveQuestionnaireDefinitions(v ...*QuestionnaireDefinition) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuestionnaireDefinitionIDs(ids...)
+}
+
+// ClearReminderSchedules clears all "reminder_schedules" edges to the ReminderSchedule entity.
+func (_u *DoctorUpdateOne) ClearReminderSchedules() *DoctorUpdateOne {
+	_u.mutation.ClearReminderSchedules()
+	return _u
+}
+
+// RemoveReminderScheduleIDs removes the "reminder_schedules" edge to ReminderSchedule entities by IDs.
+func (_u *DoctorUpdateOne) RemoveReminderScheduleIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.RemoveReminderScheduleIDs(ids...)
+	return _u
+}
+
+// RemoveReminderSchedules removes "reminder_schedules" edges to ReminderSchedule entities.
+func (_u *DoctorUpdateOne) RemoveReminderSchedules(v ...*ReminderSchedule) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReminderScheduleIDs(ids...)
+}
+
+// ClearWebhookSubscriptions clears all "webhook_subscriptions" edges to the WebhookSubscription entity.
+func (_u *DoctorUpdateOne) ClearWebhookSubscriptions() *DoctorUpdateOne {
+	_u.mutation.ClearWebhookSubscriptions()
+	return _u
+}
+
+// RemoveWebhookSubscriptionIDs removes the "webhook_subscriptions" edge to WebhookSubscription entities by IDs.
+func (_u *DoctorUpdateOne) RemoveWebhookSubscriptionIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.RemoveWebhookSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveWebhookSubscriptions removes "webhook_subscriptions" edges to WebhookSubscription entities.
+func (_u *DoctorUpdateOne) RemoveWebhookSubscriptions(v ...*WebhookSubscription) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookSubscriptionIDs(ids...)
+}
+
+// ClearVocabularyTerms clears all "vocabulary_terms" edges to the VocabularyTerm entity.
+func (_u *DoctorUpdateOne) ClearVocabularyTerms() *DoctorUpdateOne {
+	_u.mutation.ClearVocabularyTerms()
+	return _u
+}
+
+// RemoveVocabularyTermIDs removes the "vocabulary_terms" edge to VocabularyTerm entities by IDs.
+func (_u *DoctorUpdateOne) RemoveVocabularyTermIDs(ids ...uuid.UUID) *DoctorUpdateOne {
+	_u.mutation.RemoveVocabularyTermIDs(ids...)
+	return _u
+}
+
+// RemoveVocabularyTerms removes "vocabulary_terms" edges to VocabularyTerm entities.
+func (_u *DoctorUpdateOne) RemoveVocabularyTerms(v ...*VocabularyTerm) *DoctorUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVocabularyTermIDs(ids...)
+}
+
+// Where appends a list predicates to the DoctorUpdate builder.
+func (_u *DoctorUpdateOne) Where(ps ...predicate.Doctor) *DoctorUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DoctorUpdateOne) Select(field string, fields ...string) *DoctorUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Doctor entity.
+func (_u *DoctorUpdateOne) Save(ctx context.Context) (*Doctor, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DoctorUpdateOne) SaveX(ctx context.Context) *Doctor {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DoctorUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DoctorUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DoctorUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := doctor.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DoctorUpdateOne) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := doctor.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Doctor.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DisplayName(); ok {
+		if err := doctor.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "Doctor.display_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PasswordHash(); ok {
+		if err := doctor.PasswordHashValidator(v); err != nil {
+			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "Doctor.password_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := doctor.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Doctor.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DoctorUpdateOne) sqlSave(ctx context.Context) (_node *Doctor, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(doctor.Table, doctor.Columns, sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Doctor.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, doctor.FieldID)
+		for _, f := range fields {
+			if !doctor.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != doctor.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(doctor.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(doctor.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DisplayName(); ok {
+		_spec.SetField(doctor.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PasswordHash(); ok {
+		_spec.SetField(doctor.FieldPasswordHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(doctor.FieldRole, field.TypeEnum, value)
+	}
+	if _u.mutation.PracticeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   doctor.PracticeTable,
+			Columns: []string{doctor.PracticeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(practice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PracticeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   doctor.PracticeTable,
+			Columns: []string{doctor.PracticeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(practice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PatientLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.PatientLinksTable,
+			Columns: []string{doctor.PatientLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctorpatientlink.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPatientLinksIDs(); len(nodes) > 0 && !_u.mutation.PatientLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.PatientLinksTable,
+			Columns: []string{doctor.PatientLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctorpatientlink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PatientLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.PatientLinksTable,
+			Columns: []string{doctor.PatientLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctorpatientlink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PairingCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.PairingCodesTable,
+			Columns: []string{doctor.PairingCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pairingcode.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPairingCodesIDs(); len(nodes) > 0 && !_u.mutation.PairingCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.PairingCodesTable,
+			Columns: []string{doctor.PairingCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pairingcode.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PairingCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.PairingCodesTable,
+			Columns: []string{doctor.PairingCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pairingcode.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ApprovedPatientLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.ApprovedPatientLinksTable,
+			Columns: []string{doctor.ApprovedPatientLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctorpatientlink.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedApprovedPatientLinksIDs(); len(nodes) > 0 && !_u.mutation.ApprovedPatientLinksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.ApprovedPatientLinksTable,
+			Columns: []string{doctor.ApprovedPatientLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctorpatientlink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ApprovedPatientLinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.ApprovedPatientLinksTable,
+			Columns: []string{doctor.ApprovedPatientLinksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctorpatientlink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EntrySharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.EntrySharesTable,
+			Columns: []string{doctor.EntrySharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entryshare.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEntrySharesIDs(); len(nodes) > 0 && !_u.mutation.EntrySharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.EntrySharesTable,
+			Columns: []string{doctor.EntrySharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entryshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EntrySharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.EntrySharesTable,
+			Columns: []string{doctor.EntrySharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entryshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if v, ok := _u.mutation.DisplayName(); ok {
-		if err := doctor.DisplayNameValidator(v); err != nil {
-			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "Doctor.display_name": %w`, err)}
+	if _u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.CommentsTable,
+			Columns: []string{doctor.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if v, ok := _u.mutation.PasswordHash(); ok {
-		if err := doctor.PasswordHashValidator(v); err != nil {
-			return &ValidationError{Name: "password_hash", err: fmt.Errorf(`ent: validator failed for field "Doctor.password_hash": %w`, err)}
+	if nodes := _u.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !_u.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.CommentsTable,
+			Columns: []string{doctor.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
 		}
-	}
-	if v, ok := _u.mutation.Role(); ok {
-		if err := doctor.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Doctor.role": %w`, err)}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return nil
-}
-
-func (_u *DoctorUpdateOne) sqlSave(ctx context.Context) (_node *Doctor, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(doctor.Table, doctor.Columns, sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID))
-	id, ok := _u.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Doctor.id" for update`)}
+	if nodes := _u.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.CommentsTable,
+			Columns: []string{doctor.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := _u.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, doctor.FieldID)
-		for _, f := range fields {
-			if !doctor.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != doctor.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if _u.mutation.CreatedAnalysisJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.CreatedAnalysisJobsTable,
+			Columns: []string{doctor.CreatedAnalysisJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(analysisjob.FieldID, field.TypeUUID),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if nodes := _u.mutation.RemovedCreatedAnalysisJobsIDs(); len(nodes) > 0 && !_u.mutation.CreatedAnalysisJobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.CreatedAnalysisJobsTable,
+			Columns: []string{doctor.CreatedAnalysisJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(analysisjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(doctor.FieldUpdatedAt, field.TypeTime, value)
+	if nodes := _u.mutation.CreatedAnalysisJobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.CreatedAnalysisJobsTable,
+			Columns: []string{doctor.CreatedAnalysisJobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(analysisjob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.Email(); ok {
-		_spec.SetField(doctor.FieldEmail, field.TypeString, value)
+	if _u.mutation.AssignedGoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.AssignedGoalsTable,
+			Columns: []string{doctor.AssignedGoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.DisplayName(); ok {
-		_spec.SetField(doctor.FieldDisplayName, field.TypeString, value)
+	if nodes := _u.mutation.RemovedAssignedGoalsIDs(); len(nodes) > 0 && !_u.mutation.AssignedGoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.AssignedGoalsTable,
+			Columns: []string{doctor.AssignedGoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.PasswordHash(); ok {
-		_spec.SetField(doctor.FieldPasswordHash, field.TypeString, value)
+	if nodes := _u.mutation.AssignedGoalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.AssignedGoalsTable,
+			Columns: []string{doctor.AssignedGoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.Role(); ok {
-		_spec.SetField(doctor.FieldRole, field.TypeEnum, value)
+	if _u.mutation.AssignedExercisesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.AssignedExercisesTable,
+			Columns: []string{doctor.AssignedExercisesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.PracticeCleared() {
+	if nodes := _u.mutation.RemovedAssignedExercisesIDs(); len(nodes) > 0 && !_u.mutation.AssignedExercisesCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   doctor.PracticeTable,
-			Columns: []string{doctor.PracticeColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.AssignedExercisesTable,
+			Columns: []string{doctor.AssignedExercisesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(practice.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PracticeIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.AssignedExercisesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   doctor.PracticeTable,
-			Columns: []string{doctor.PracticeColumn},
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   doctor.AssignedExercisesTable,
+			Columns: []string{doctor.AssignedExercisesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(practice.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1217,28 +2521,28 @@ func (_u *DoctorUpdateOne) sqlSave(ctx context.Context) (_node *Doctor, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PatientLinksCleared() {
+	if _u.mutation.SessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.PatientLinksTable,
-			Columns: []string{doctor.PatientLinksColumn},
+			Table:   doctor.SessionsTable,
+			Columns: []string{doctor.SessionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(doctorpatientlink.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPatientLinksIDs(); len(nodes) > 0 && !_u.mutation.PatientLinksCleared() {
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.PatientLinksTable,
-			Columns: []string{doctor.PatientLinksColumn},
+			Table:   doctor.SessionsTable,
+			Columns: []string{doctor.SessionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(doctorpatientlink.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1246,15 +2550,15 @@ func (_u *DoctorUpdateOne) sqlSave(ctx context.Context) (_node *Doctor, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PatientLinksIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.PatientLinksTable,
-			Columns: []string{doctor.PatientLinksColumn},
+			Table:   doctor.SessionsTable,
+			Columns: []string{doctor.SessionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(doctorpatientlink.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1262,28 +2566,28 @@ func (_u *DoctorUpdateOne) sqlSave(ctx context.Context) (_node *Doctor, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.PairingCodesCleared() {
+	if _u.mutation.QuestionnaireAssignmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.PairingCodesTable,
-			Columns: []string{doctor.PairingCodesColumn},
+			Table:   doctor.QuestionnaireAssignmentsTable,
+			Columns: []string{doctor.QuestionnaireAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(pairingcode.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPairingCodesIDs(); len(nodes) > 0 && !_u.mutation.PairingCodesCleared() {
+	if nodes := _u.mutation.RemovedQuestionnaireAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.QuestionnaireAssignmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.PairingCodesTable,
-			Columns: []string{doctor.PairingCodesColumn},
+			Table:   doctor.QuestionnaireAssignmentsTable,
+			Columns: []string{doctor.QuestionnaireAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(pairingcode.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1291,15 +2595,15 @@ func (_u *DoctorUpdateOne) sqlSave(ctx context.Context) (_node *Doctor, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PairingCodesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.QuestionnaireAssignmentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.PairingCodesTable,
-			Columns: []string{doctor.PairingCodesColumn},
+			Table:   doctor.QuestionnaireAssignmentsTable,
+			Columns: []string{doctor.QuestionnaireAssignmentsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(pairingcode.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1307,28 +2611,28 @@ func (_u *DoctorUpdateOne) sqlSave(ctx context.Context) (_node *Doctor, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ApprovedPatientLinksCleared() {
+	if _u.mutation.QuestionnaireDefinitionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.ApprovedPatientLinksTable,
-			Columns: []string{doctor.ApprovedPatientLinksColumn},
+			Table:   doctor.QuestionnaireDefinitionsTable,
+			Columns: []string{doctor.QuestionnaireDefinitionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(doctorpatientlink.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedApprovedPatientLinksIDs(); len(nodes) > 0 && !_u.mutation.ApprovedPatientLinksCleared() {
+	if nodes := _u.mutation.RemovedQuestionnaireDefinitionsIDs(); len(nodes) > 0 && !_u.mutation.QuestionnaireDefinitionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.ApprovedPatientLinksTable,
-			Columns: []string{doctor.ApprovedPatientLinksColumn},
+			Table:   doctor.QuestionnaireDefinitionsTable,
+			Columns: []string{doctor.QuestionnaireDefinitionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(doctorpatientlink.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1336,15 +2640,15 @@ func (_u *DoctorUpdateOne) sqlSave(ctx context.Context) (_node *Doctor, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ApprovedPatientLinksIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.QuestionnaireDefinitionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.ApprovedPatientLinksTable,
-			Columns: []string{doctor.ApprovedPatientLinksColumn},
+			Table:   doctor.QuestionnaireDefinitionsTable,
+			Columns: []string{doctor.QuestionnaireDefinitionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(doctorpatientlink.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1352,28 +2656,28 @@ func (_u *DoctorUpdateOne) sqlSave(ctx context.Context) (_node *Doctor, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.EntrySharesCleared() {
+	if _u.mutation.ReminderSchedulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.EntrySharesTable,
-			Columns: []string{doctor.EntrySharesColumn},
+			Table:   doctor.ReminderSchedulesTable,
+			Columns: []string{doctor.ReminderSchedulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(entryshare.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedEntrySharesIDs(); len(nodes) > 0 && !_u.mutation.EntrySharesCleared() {
+	if nodes := _u.mutation.RemovedReminderSchedulesIDs(); len(nodes) > 0 && !_u.mutation.ReminderSchedulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.EntrySharesTable,
-			Columns: []string{doctor.EntrySharesColumn},
+			Table:   doctor.ReminderSchedulesTable,
+			Columns: []string{doctor.ReminderSchedulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(entryshare.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1381,15 +2685,15 @@ func (_u *DoctorUpdateOne) sqlSave(ctx context.Context) (_node *Doctor, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.EntrySharesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ReminderSchedulesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.EntrySharesTable,
-			Columns: []string{doctor.EntrySharesColumn},
+			Table:   doctor.ReminderSchedulesTable,
+			Columns: []string{doctor.ReminderSchedulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(entryshare.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1397,28 +2701,28 @@ func (_u *DoctorUpdateOne) sqlSave(ctx context.Context) (_node *Doctor, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CommentsCleared() {
+	if _u.mutation.WebhookSubscriptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.CommentsTable,
-			Columns: []string{doctor.CommentsColumn},
+			Table:   doctor.WebhookSubscriptionsTable,
+			Columns: []string{doctor.WebhookSubscriptionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !_u.mutation.CommentsCleared() {
+	if nodes := _u.mutation.RemovedWebhookSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.WebhookSubscriptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.CommentsTable,
-			Columns: []string{doctor.CommentsColumn},
+			Table:   doctor.WebhookSubscriptionsTable,
+			Columns: []string{doctor.WebhookSubscriptionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1426,15 +2730,15 @@ func (_u *DoctorUpdateOne) sqlSave(ctx context.Context) (_node *Doctor, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CommentsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.WebhookSubscriptionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.CommentsTable,
-			Columns: []string{doctor.CommentsColumn},
+			Table:   doctor.WebhookSubscriptionsTable,
+			Columns: []string{doctor.WebhookSubscriptionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1442,28 +2746,28 @@ func (_u *DoctorUpdateOne) sqlSave(ctx context.Context) (_node *Doctor, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.CreatedAnalysisJobsCleared() {
+	if _u.mutation.VocabularyTermsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.CreatedAnalysisJobsTable,
-			Columns: []string{doctor.CreatedAnalysisJobsColumn},
+			Table:   doctor.VocabularyTermsTable,
+			Columns: []string{doctor.VocabularyTermsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(analysisjob.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(vocabularyterm.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedCreatedAnalysisJobsIDs(); len(nodes) > 0 && !_u.mutation.CreatedAnalysisJobsCleared() {
+	if nodes := _u.mutation.RemovedVocabularyTermsIDs(); len(nodes) > 0 && !_u.mutation.VocabularyTermsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.CreatedAnalysisJobsTable,
-			Columns: []string{doctor.CreatedAnalysisJobsColumn},
+			Table:   doctor.VocabularyTermsTable,
+			Columns: []string{doctor.VocabularyTermsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(analysisjob.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(vocabularyterm.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1471,15 +2775,15 @@ func (_u *DoctorUpdateOne) sqlSave(ctx context.Context) (_node *Doctor, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.CreatedAnalysisJobsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.VocabularyTermsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   doctor.CreatedAnalysisJobsTable,
-			Columns: []string{doctor.CreatedAnalysisJobsColumn},
+			Table:   doctor.VocabularyTermsTable,
+			Columns: []string{doctor.VocabularyTermsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(analysisjob.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(vocabularyterm.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -21,6 +23,7 @@ type DoctorPatientLinkCreate struct {
 	config
 	mutation *DoctorPatientLinkMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -281,6 +284,7 @@ func (_c *DoctorPatientLinkCreate) createSpec() (*DoctorPatientLink, *sqlgraph.C
 		_node = &DoctorPatientLink{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(doctorpatientlink.Table, sqlgraph.NewFieldSpec(doctorpatientlink.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -359,11 +363,358 @@ func (_c *DoctorPatientLinkCreate) createSpec() (*DoctorPatientLink, *sqlgraph.C
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DoctorPatientLink.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DoctorPatientLinkUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DoctorPatientLinkCreate) OnConflict(opts ...sql.ConflictOption) *DoctorPatientLinkUpsertOne {
+	_c.conflict = opts
+	return &DoctorPatientLinkUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DoctorPatientLink.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DoctorPatientLinkCreate) OnConflictColumns(columns ...string) *DoctorPatientLinkUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DoctorPatientLinkUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DoctorPatientLinkUpsertOne is the builder for "upsert"-ing
+	//  one DoctorPatientLink node.
+	DoctorPatientLinkUpsertOne struct {
+		create *DoctorPatientLinkCreate
+	}
+
+	// DoctorPatientLinkUpsert is the "OnConflict" setter.
+	DoctorPatientLinkUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DoctorPatientLinkUpsert) SetUpdatedAt(v time.Time) *DoctorPatientLinkUpsert {
+	u.Set(doctorpatientlink.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsert) UpdateUpdatedAt() *DoctorPatientLinkUpsert {
+	u.SetExcluded(doctorpatientlink.FieldUpdatedAt)
+	return u
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *DoctorPatientLinkUpsert) SetDoctorID(v uuid.UUID) *DoctorPatientLinkUpsert {
+	u.Set(doctorpatientlink.FieldDoctorID, v)
+	return u
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsert) UpdateDoctorID() *DoctorPatientLinkUpsert {
+	u.SetExcluded(doctorpatientlink.FieldDoctorID)
+	return u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *DoctorPatientLinkUpsert) SetPatientID(v uuid.UUID) *DoctorPatientLinkUpsert {
+	u.Set(doctorpatientlink.FieldPatientID, v)
+	return u
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsert) UpdatePatientID() *DoctorPatientLinkUpsert {
+	u.SetExcluded(doctorpatientlink.FieldPatientID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *DoctorPatientLinkUpsert) SetStatus(v doctorpatientlink.Status) *DoctorPatientLinkUpsert {
+	u.Set(doctorpatientlink.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsert) UpdateStatus() *DoctorPatientLinkUpsert {
+	u.SetExcluded(doctorpatientlink.FieldStatus)
+	return u
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (u *DoctorPatientLinkUpsert) SetRequestedAt(v time.Time) *DoctorPatientLinkUpsert {
+	u.Set(doctorpatientlink.FieldRequestedAt, v)
+	return u
+}
+
+// UpdateRequestedAt sets the "requested_at" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsert) UpdateRequestedAt() *DoctorPatientLinkUpsert {
+	u.SetExcluded(doctorpatientlink.FieldRequestedAt)
+	return u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *DoctorPatientLinkUpsert) SetApprovedAt(v time.Time) *DoctorPatientLinkUpsert {
+	u.Set(doctorpatientlink.FieldApprovedAt, v)
+	return u
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsert) UpdateApprovedAt() *DoctorPatientLinkUpsert {
+	u.SetExcluded(doctorpatientlink.FieldApprovedAt)
+	return u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *DoctorPatientLinkUpsert) ClearApprovedAt() *DoctorPatientLinkUpsert {
+	u.SetNull(doctorpatientlink.FieldApprovedAt)
+	return u
+}
+
+// SetApprovedByDoctorID sets the "approved_by_doctor_id" field.
+func (u *DoctorPatientLinkUpsert) SetApprovedByDoctorID(v uuid.UUID) *DoctorPatientLinkUpsert {
+	u.Set(doctorpatientlink.FieldApprovedByDoctorID, v)
+	return u
+}
+
+// UpdateApprovedByDoctorID sets the "approved_by_doctor_id" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsert) UpdateApprovedByDoctorID() *DoctorPatientLinkUpsert {
+	u.SetExcluded(doctorpatientlink.FieldApprovedByDoctorID)
+	return u
+}
+
+// ClearApprovedByDoctorID clears the value of the "approved_by_doctor_id" field.
+func (u *DoctorPatientLinkUpsert) ClearApprovedByDoctorID() *DoctorPatientLinkUpsert {
+	u.SetNull(doctorpatientlink.FieldApprovedByDoctorID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DoctorPatientLink.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(doctorpatientlink.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DoctorPatientLinkUpsertOne) UpdateNewValues() *DoctorPatientLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(doctorpatientlink.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(doctorpatientlink.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DoctorPatientLink.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DoctorPatientLinkUpsertOne) Ignore() *DoctorPatientLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DoctorPatientLinkUpsertOne) DoNothing() *DoctorPatientLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DoctorPatientLinkCreate.OnConflict
+// documentation for more info.
+func (u *DoctorPatientLinkUpsertOne) Update(set func(*DoctorPatientLinkUpsert)) *DoctorPatientLinkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DoctorPatientLinkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DoctorPatientLinkUpsertOne) SetUpdatedAt(v time.Time) *DoctorPatientLinkUpsertOne {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsertOne) UpdateUpdatedAt() *DoctorPatientLinkUpsertOne {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *DoctorPatientLinkUpsertOne) SetDoctorID(v uuid.UUID) *DoctorPatientLinkUpsertOne {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.SetDoctorID(v)
+	})
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsertOne) UpdateDoctorID() *DoctorPatientLinkUpsertOne {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.UpdateDoctorID()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *DoctorPatientLinkUpsertOne) SetPatientID(v uuid.UUID) *DoctorPatientLinkUpsertOne {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsertOne) UpdatePatientID() *DoctorPatientLinkUpsertOne {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DoctorPatientLinkUpsertOne) SetStatus(v doctorpatientlink.Status) *DoctorPatientLinkUpsertOne {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsertOne) UpdateStatus() *DoctorPatientLinkUpsertOne {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (u *DoctorPatientLinkUpsertOne) SetRequestedAt(v time.Time) *DoctorPatientLinkUpsertOne {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.SetRequestedAt(v)
+	})
+}
+
+// UpdateRequestedAt sets the "requested_at" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsertOne) UpdateRequestedAt() *DoctorPatientLinkUpsertOne {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.UpdateRequestedAt()
+	})
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *DoctorPatientLinkUpsertOne) SetApprovedAt(v time.Time) *DoctorPatientLinkUpsertOne {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.SetApprovedAt(v)
+	})
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsertOne) UpdateApprovedAt() *DoctorPatientLinkUpsertOne {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.UpdateApprovedAt()
+	})
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *DoctorPatientLinkUpsertOne) ClearApprovedAt() *DoctorPatientLinkUpsertOne {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.ClearApprovedAt()
+	})
+}
+
+// SetApprovedByDoctorID sets the "approved_by_doctor_id" field.
+func (u *DoctorPatientLinkUpsertOne) SetApprovedByDoctorID(v uuid.UUID) *DoctorPatientLinkUpsertOne {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.SetApprovedByDoctorID(v)
+	})
+}
+
+// UpdateApprovedByDoctorID sets the "approved_by_doctor_id" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsertOne) UpdateApprovedByDoctorID() *DoctorPatientLinkUpsertOne {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.UpdateApprovedByDoctorID()
+	})
+}
+
+// ClearApprovedByDoctorID clears the value of the "approved_by_doctor_id" field.
+func (u *DoctorPatientLinkUpsertOne) ClearApprovedByDoctorID() *DoctorPatientLinkUpsertOne {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.ClearApprovedByDoctorID()
+	})
+}
+
+// Exec executes the query.
+func (u *DoctorPatientLinkUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DoctorPatientLinkCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DoctorPatientLinkUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DoctorPatientLinkUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DoctorPatientLinkUpsertOne.ID is not supported by MySQL driver. Use DoctorPatientLinkUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DoctorPatientLinkUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // DoctorPatientLinkCreateBulk is the builder for creating many DoctorPatientLink entities in bulk.
 type DoctorPatientLinkCreateBulk struct {
 	config
 	err      error
 	builders []*DoctorPatientLinkCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the DoctorPatientLink entities in the database.
@@ -393,6 +744,7 @@ func (_c *DoctorPatientLinkCreateBulk) Save(ctx context.Context) ([]*DoctorPatie
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -442,3 +794,232 @@ func (_c *DoctorPatientLinkCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DoctorPatientLink.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DoctorPatientLinkUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DoctorPatientLinkCreateBulk) OnConflict(opts ...sql.ConflictOption) *DoctorPatientLinkUpsertBulk {
+	_c.conflict = opts
+	return &DoctorPatientLinkUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DoctorPatientLink.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DoctorPatientLinkCreateBulk) OnConflictColumns(columns ...string) *DoctorPatientLinkUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DoctorPatientLinkUpsertBulk{
+		create: _c,
+	}
+}
+
+// DoctorPatientLinkUpsertBulk is the builder for "upsert"-ing
+// a bulk of DoctorPatientLink nodes.
+type DoctorPatientLinkUpsertBulk struct {
+	create *DoctorPatientLinkCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DoctorPatientLink.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(doctorpatientlink.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DoctorPatientLinkUpsertBulk) UpdateNewValues() *DoctorPatientLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(doctorpatientlink.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(doctorpatientlink.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DoctorPatientLink.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DoctorPatientLinkUpsertBulk) Ignore() *DoctorPatientLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DoctorPatientLinkUpsertBulk) DoNothing() *DoctorPatientLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DoctorPatientLinkCreateBulk.OnConflict
+// documentation for more info.
+func (u *DoctorPatientLinkUpsertBulk) Update(set func(*DoctorPatientLinkUpsert)) *DoctorPatientLinkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DoctorPatientLinkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DoctorPatientLinkUpsertBulk) SetUpdatedAt(v time.Time) *DoctorPatientLinkUpsertBulk {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsertBulk) UpdateUpdatedAt() *DoctorPatientLinkUpsertBulk {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *DoctorPatientLinkUpsertBulk) SetDoctorID(v uuid.UUID) *DoctorPatientLinkUpsertBulk {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.SetDoctorID(v)
+	})
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsertBulk) UpdateDoctorID() *DoctorPatientLinkUpsertBulk {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.UpdateDoctorID()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *DoctorPatientLinkUpsertBulk) SetPatientID(v uuid.UUID) *DoctorPatientLinkUpsertBulk {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsertBulk) UpdatePatientID() *DoctorPatientLinkUpsertBulk {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DoctorPatientLinkUpsertBulk) SetStatus(v doctorpatientlink.Status) *DoctorPatientLinkUpsertBulk {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsertBulk) UpdateStatus() *DoctorPatientLinkUpsertBulk {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (u *DoctorPatientLinkUpsertBulk) SetRequestedAt(v time.Time) *DoctorPatientLinkUpsertBulk {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.SetRequestedAt(v)
+	})
+}
+
+// UpdateRequestedAt sets the "requested_at" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsertBulk) UpdateRequestedAt() *DoctorPatientLinkUpsertBulk {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.UpdateRequestedAt()
+	})
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *DoctorPatientLinkUpsertBulk) SetApprovedAt(v time.Time) *DoctorPatientLinkUpsertBulk {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.SetApprovedAt(v)
+	})
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsertBulk) UpdateApprovedAt() *DoctorPatientLinkUpsertBulk {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.UpdateApprovedAt()
+	})
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *DoctorPatientLinkUpsertBulk) ClearApprovedAt() *DoctorPatientLinkUpsertBulk {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.ClearApprovedAt()
+	})
+}
+
+// SetApprovedByDoctorID sets the "approved_by_doctor_id" field.
+func (u *DoctorPatientLinkUpsertBulk) SetApprovedByDoctorID(v uuid.UUID) *DoctorPatientLinkUpsertBulk {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.SetApprovedByDoctorID(v)
+	})
+}
+
+// UpdateApprovedByDoctorID sets the "approved_by_doctor_id" field to the value that was provided on create.
+func (u *DoctorPatientLinkUpsertBulk) UpdateApprovedByDoctorID() *DoctorPatientLinkUpsertBulk {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.UpdateApprovedByDoctorID()
+	})
+}
+
+// ClearApprovedByDoctorID clears the value of the "approved_by_doctor_id" field.
+func (u *DoctorPatientLinkUpsertBulk) ClearApprovedByDoctorID() *DoctorPatientLinkUpsertBulk {
+	return u.Update(func(s *DoctorPatientLinkUpsert) {
+		s.ClearApprovedByDoctorID()
+	})
+}
+
+// Exec executes the query.
+func (u *DoctorPatientLinkUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DoctorPatientLinkCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DoctorPatientLinkCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DoctorPatientLinkUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
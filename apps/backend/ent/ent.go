@@ -4,14 +4,29 @@ package ent
 
 import (
 	"backend/ent/analysisjob"
+	"backend/ent/assignment"
 	"backend/ent/comment"
 	"backend/ent/doctor"
 	"backend/ent/doctorpatientlink"
 	"backend/ent/entry"
 	"backend/ent/entryshare"
+	"backend/ent/exerciselog"
+	"backend/ent/goal"
+	"backend/ent/idempotencykey"
+	"backend/ent/outboxevent"
 	"backend/ent/pairingcode"
 	"backend/ent/patient"
 	"backend/ent/practice"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/ent/questionnaireresponse"
+	"backend/ent/ratelimitbucket"
+	"backend/ent/reminderdelivery"
+	"backend/ent/reminderschedule"
+	"backend/ent/session"
+	"backend/ent/vocabularyterm"
+	"backend/ent/webhookdelivery"
+	"backend/ent/webhooksubscription"
 	"context"
 	"errors"
 	"fmt"
@@ -81,15 +96,30 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			analysisjob.Table:       analysisjob.ValidColumn,
-			comment.Table:           comment.ValidColumn,
-			doctor.Table:            doctor.ValidColumn,
-			doctorpatientlink.Table: doctorpatientlink.ValidColumn,
-			entry.Table:             entry.ValidColumn,
-			entryshare.Table:        entryshare.ValidColumn,
-			pairingcode.Table:       pairingcode.ValidColumn,
-			patient.Table:           patient.ValidColumn,
-			practice.Table:          practice.ValidColumn,
+			analysisjob.Table:             analysisjob.ValidColumn,
+			assignment.Table:              assignment.ValidColumn,
+			comment.Table:                 comment.ValidColumn,
+			doctor.Table:                  doctor.ValidColumn,
+			doctorpatientlink.Table:       doctorpatientlink.ValidColumn,
+			entry.Table:                   entry.ValidColumn,
+			entryshare.Table:              entryshare.ValidColumn,
+			exerciselog.Table:             exerciselog.ValidColumn,
+			goal.Table:                    goal.ValidColumn,
+			idempotencykey.Table:          idempotencykey.ValidColumn,
+			outboxevent.Table:             outboxevent.ValidColumn,
+			pairingcode.Table:             pairingcode.ValidColumn,
+			patient.Table:                 patient.ValidColumn,
+			practice.Table:                practice.ValidColumn,
+			questionnaireassignment.Table: questionnaireassignment.ValidColumn,
+			questionnairedefinition.Table: questionnairedefinition.ValidColumn,
+			questionnaireresponse.Table:   questionnaireresponse.ValidColumn,
+			ratelimitbucket.Table:         ratelimitbucket.ValidColumn,
+			reminderdelivery.Table:        reminderdelivery.ValidColumn,
+			reminderschedule.Table:        reminderschedule.ValidColumn,
+			session.Table:                 session.ValidColumn,
+			vocabularyterm.Table:          vocabularyterm.ValidColumn,
+			webhookdelivery.Table:         webhookdelivery.ValidColumn,
+			webhooksubscription.Table:     webhooksubscription.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -59,9 +59,13 @@ type EntryEdges struct {
 	Comments []*Comment `json:"comments,omitempty"`
 	// AnalysisJobs holds the value of the analysis_jobs edge.
 	AnalysisJobs []*AnalysisJob `json:"analysis_jobs,omitempty"`
+	// ExerciseLogs holds the value of the exercise_logs edge.
+	ExerciseLogs []*ExerciseLog `json:"exercise_logs,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [6]bool
 }
 
 // PatientOrErr returns the Patient value or an error if the edge
@@ -102,6 +106,24 @@ func (e EntryEdges) AnalysisJobsOrErr() ([]*AnalysisJob, error) {
 	return nil, &NotLoadedError{edge: "analysis_jobs"}
 }
 
+// ExerciseLogsOrErr returns the ExerciseLogs value or an error if the edge
+// was not loaded in eager-loading.
+func (e EntryEdges) ExerciseLogsOrErr() ([]*ExerciseLog, error) {
+	if e.loadedTypes[4] {
+		return e.ExerciseLogs, nil
+	}
+	return nil, &NotLoadedError{edge: "exercise_logs"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e EntryEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[5] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Entry) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -248,6 +270,16 @@ func (_m *Entry) QueryAnalysisJobs() *AnalysisJobQuery {
 	return NewEntryClient(_m.config).QueryAnalysisJobs(_m)
 }
 
+// QueryExerciseLogs queries the "exercise_logs" edge of the Entry entity.
+func (_m *Entry) QueryExerciseLogs() *ExerciseLogQuery {
+	return NewEntryClient(_m.config).QueryExerciseLogs(_m)
+}
+
+// QuerySessions queries the "sessions" edge of the Entry entity.
+func (_m *Entry) QuerySessions() *SessionQuery {
+	return NewEntryClient(_m.config).QuerySessions(_m)
+}
+
 // Update returns a builder for updating this Entry.
 // Note that you need to call Entry.Unwrap() before calling this method if this Entry
 // was returned from a transaction, and the transaction was committed or rolled back.
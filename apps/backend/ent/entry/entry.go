@@ -45,6 +45,10 @@ const (
 	EdgeComments = "comments"
 	// EdgeAnalysisJobs holds the string denoting the analysis_jobs edge name in mutations.
 	EdgeAnalysisJobs = "analysis_jobs"
+	// EdgeExerciseLogs holds the string denoting the exercise_logs edge name in mutations.
+	EdgeExerciseLogs = "exercise_logs"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
 	// Table holds the table name of the entry in the database.
 	Table = "entries"
 	// PatientTable is the table that holds the patient relation/edge.
@@ -75,6 +79,18 @@ const (
 	AnalysisJobsInverseTable = "analysis_jobs"
 	// AnalysisJobsColumn is the table column denoting the analysis_jobs relation/edge.
 	AnalysisJobsColumn = "entry_id"
+	// ExerciseLogsTable is the table that holds the exercise_logs relation/edge.
+	ExerciseLogsTable = "exercise_logs"
+	// ExerciseLogsInverseTable is the table name for the ExerciseLog entity.
+	// It exists in this package in order to avoid circular dependency with the "exerciselog" package.
+	ExerciseLogsInverseTable = "exercise_logs"
+	// ExerciseLogsColumn is the table column denoting the exercise_logs relation/edge.
+	ExerciseLogsColumn = "entry_id"
+	// SessionsTable is the table that holds the sessions relation/edge. The primary key declared below.
+	SessionsTable = "session_entries"
+	// SessionsInverseTable is the table name for the Session entity.
+	// It exists in this package in order to avoid circular dependency with the "session" package.
+	SessionsInverseTable = "sessions"
 )
 
 // Columns holds all SQL columns for entry fields.
@@ -93,6 +109,12 @@ var Columns = []string{
 	FieldTags,
 }
 
+var (
+	// SessionsPrimaryKey and SessionsColumn2 are the table columns denoting the
+	// primary key for the sessions relation (M2M).
+	SessionsPrimaryKey = []string{"session_id", "entry_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -207,6 +229,34 @@ func ByAnalysisJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAnalysisJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByExerciseLogsCount orders the results by exercise_logs count.
+func ByExerciseLogsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newExerciseLogsStep(), opts...)
+	}
+}
+
+// ByExerciseLogs orders the results by exercise_logs terms.
+func ByExerciseLogs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExerciseLogsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySessionsCount orders the results by sessions count.
+func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSessionsStep(), opts...)
+	}
+}
+
+// BySessions orders the results by sessions terms.
+func BySessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPatientStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -235,3 +285,17 @@ func newAnalysisJobsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, AnalysisJobsTable, AnalysisJobsColumn),
 	)
 }
+func newExerciseLogsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ExerciseLogsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ExerciseLogsTable, ExerciseLogsColumn),
+	)
+}
+func newSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, SessionsTable, SessionsPrimaryKey...),
+	)
+}
@@ -563,6 +563,52 @@ func HasAnalysisJobsWith(preds ...predicate.AnalysisJob) predicate.Entry {
 	})
 }
 
+// HasExerciseLogs applies the HasEdge predicate on the "exercise_logs" edge.
+func HasExerciseLogs() predicate.Entry {
+	return predicate.Entry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ExerciseLogsTable, ExerciseLogsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExerciseLogsWith applies the HasEdge predicate on the "exercise_logs" edge with a given conditions (other predicates).
+func HasExerciseLogsWith(preds ...predicate.ExerciseLog) predicate.Entry {
+	return predicate.Entry(func(s *sql.Selector) {
+		step := newExerciseLogsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSessions applies the HasEdge predicate on the "sessions" edge.
+func HasSessions() predicate.Entry {
+	return predicate.Entry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, SessionsTable, SessionsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSessionsWith applies the HasEdge predicate on the "sessions" edge with a given conditions (other predicates).
+func HasSessionsWith(preds ...predicate.Session) predicate.Entry {
+	return predicate.Entry(func(s *sql.Selector) {
+		step := newSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Entry) predicate.Entry {
 	return predicate.Entry(sql.AndPredicates(predicates...))
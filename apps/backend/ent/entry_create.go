@@ -7,13 +7,17 @@ import (
 	"backend/ent/comment"
 	"backend/ent/entry"
 	"backend/ent/entryshare"
+	"backend/ent/exerciselog"
 	"backend/ent/patient"
 	"backend/ent/schema"
+	"backend/ent/session"
 	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -24,6 +28,7 @@ type EntryCreate struct {
 	config
 	mutation *EntryMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -204,6 +209,36 @@ func (_c *EntryCreate) AddAnalysisJobs(v ...*AnalysisJob) *EntryCreate {
 	return _c.AddAnalysisJobIDs(ids...)
 }
 
+// AddExerciseLogIDs adds the "exercise_logs" edge to the ExerciseLog entity by IDs.
+func (_c *EntryCreate) AddExerciseLogIDs(ids ...uuid.UUID) *EntryCreate {
+	_c.mutation.AddExerciseLogIDs(ids...)
+	return _c
+}
+
+// AddExerciseLogs adds the "exercise_logs" edges to the ExerciseLog entity.
+func (_c *EntryCreate) AddExerciseLogs(v ...*ExerciseLog) *EntryCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddExerciseLogIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_c *EntryCreate) AddSessionIDs(ids ...uuid.UUID) *EntryCreate {
+	_c.mutation.AddSessionIDs(ids...)
+	return _c
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_c *EntryCreate) AddSessions(v ...*Session) *EntryCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSessionIDs(ids...)
+}
+
 // Mutation returns the EntryMutation object of the builder.
 func (_c *EntryCreate) Mutation() *EntryMutation {
 	return _c.mutation
@@ -305,6 +340,7 @@ func (_c *EntryCreate) createSpec() (*Entry, *sqlgraph.CreateSpec) {
 		_node = &Entry{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(entry.Table, sqlgraph.NewFieldSpec(entry.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -414,14 +450,549 @@ func (_c *EntryCreate) createSpec() (*Entry, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ExerciseLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   entry.ExerciseLogsTable,
+			Columns: []string{entry.ExerciseLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exerciselog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   entry.SessionsTable,
+			Columns: entry.SessionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Entry.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EntryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EntryCreate) OnConflict(opts ...sql.ConflictOption) *EntryUpsertOne {
+	_c.conflict = opts
+	return &EntryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Entry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EntryCreate) OnConflictColumns(columns ...string) *EntryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EntryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EntryUpsertOne is the builder for "upsert"-ing
+	//  one Entry node.
+	EntryUpsertOne struct {
+		create *EntryCreate
+	}
+
+	// EntryUpsert is the "OnConflict" setter.
+	EntryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EntryUpsert) SetUpdatedAt(v time.Time) *EntryUpsert {
+	u.Set(entry.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EntryUpsert) UpdateUpdatedAt() *EntryUpsert {
+	u.SetExcluded(entry.FieldUpdatedAt)
+	return u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *EntryUpsert) SetPatientID(v uuid.UUID) *EntryUpsert {
+	u.Set(entry.FieldPatientID, v)
+	return u
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *EntryUpsert) UpdatePatientID() *EntryUpsert {
+	u.SetExcluded(entry.FieldPatientID)
+	return u
+}
+
+// SetHappenedAt sets the "happened_at" field.
+func (u *EntryUpsert) SetHappenedAt(v time.Time) *EntryUpsert {
+	u.Set(entry.FieldHappenedAt, v)
+	return u
+}
+
+// UpdateHappenedAt sets the "happened_at" field to the value that was provided on create.
+func (u *EntryUpsert) UpdateHappenedAt() *EntryUpsert {
+	u.SetExcluded(entry.FieldHappenedAt)
+	return u
+}
+
+// SetSituation sets the "situation" field.
+func (u *EntryUpsert) SetSituation(v string) *EntryUpsert {
+	u.Set(entry.FieldSituation, v)
+	return u
+}
+
+// UpdateSituation sets the "situation" field to the value that was provided on create.
+func (u *EntryUpsert) UpdateSituation() *EntryUpsert {
+	u.SetExcluded(entry.FieldSituation)
+	return u
+}
+
+// ClearSituation clears the value of the "situation" field.
+func (u *EntryUpsert) ClearSituation() *EntryUpsert {
+	u.SetNull(entry.FieldSituation)
+	return u
+}
+
+// SetEmotions sets the "emotions" field.
+func (u *EntryUpsert) SetEmotions(v []schema.Emotion) *EntryUpsert {
+	u.Set(entry.FieldEmotions, v)
+	return u
+}
+
+// UpdateEmotions sets the "emotions" field to the value that was provided on create.
+func (u *EntryUpsert) UpdateEmotions() *EntryUpsert {
+	u.SetExcluded(entry.FieldEmotions)
+	return u
+}
+
+// ClearEmotions clears the value of the "emotions" field.
+func (u *EntryUpsert) ClearEmotions() *EntryUpsert {
+	u.SetNull(entry.FieldEmotions)
+	return u
+}
+
+// SetTriggers sets the "triggers" field.
+func (u *EntryUpsert) SetTriggers(v []string) *EntryUpsert {
+	u.Set(entry.FieldTriggers, v)
+	return u
+}
+
+// UpdateTriggers sets the "triggers" field to the value that was provided on create.
+func (u *EntryUpsert) UpdateTriggers() *EntryUpsert {
+	u.SetExcluded(entry.FieldTriggers)
+	return u
+}
+
+// ClearTriggers clears the value of the "triggers" field.
+func (u *EntryUpsert) ClearTriggers() *EntryUpsert {
+	u.SetNull(entry.FieldTriggers)
+	return u
+}
+
+// SetTechniques sets the "techniques" field.
+func (u *EntryUpsert) SetTechniques(v []string) *EntryUpsert {
+	u.Set(entry.FieldTechniques, v)
+	return u
+}
+
+// UpdateTechniques sets the "techniques" field to the value that was provided on create.
+func (u *EntryUpsert) UpdateTechniques() *EntryUpsert {
+	u.SetExcluded(entry.FieldTechniques)
+	return u
+}
+
+// ClearTechniques clears the value of the "techniques" field.
+func (u *EntryUpsert) ClearTechniques() *EntryUpsert {
+	u.SetNull(entry.FieldTechniques)
+	return u
+}
+
+// SetStutterFrequency sets the "stutter_frequency" field.
+func (u *EntryUpsert) SetStutterFrequency(v int) *EntryUpsert {
+	u.Set(entry.FieldStutterFrequency, v)
+	return u
+}
+
+// UpdateStutterFrequency sets the "stutter_frequency" field to the value that was provided on create.
+func (u *EntryUpsert) UpdateStutterFrequency() *EntryUpsert {
+	u.SetExcluded(entry.FieldStutterFrequency)
+	return u
+}
+
+// AddStutterFrequency adds v to the "stutter_frequency" field.
+func (u *EntryUpsert) AddStutterFrequency(v int) *EntryUpsert {
+	u.Add(entry.FieldStutterFrequency, v)
+	return u
+}
+
+// ClearStutterFrequency clears the value of the "stutter_frequency" field.
+func (u *EntryUpsert) ClearStutterFrequency() *EntryUpsert {
+	u.SetNull(entry.FieldStutterFrequency)
+	return u
+}
+
+// SetNotes sets the "notes" field.
+func (u *EntryUpsert) SetNotes(v string) *EntryUpsert {
+	u.Set(entry.FieldNotes, v)
+	return u
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *EntryUpsert) UpdateNotes() *EntryUpsert {
+	u.SetExcluded(entry.FieldNotes)
+	return u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *EntryUpsert) ClearNotes() *EntryUpsert {
+	u.SetNull(entry.FieldNotes)
+	return u
+}
+
+// SetTags sets the "tags" field.
+func (u *EntryUpsert) SetTags(v []string) *EntryUpsert {
+	u.Set(entry.FieldTags, v)
+	return u
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *EntryUpsert) UpdateTags() *EntryUpsert {
+	u.SetExcluded(entry.FieldTags)
+	return u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *EntryUpsert) ClearTags() *EntryUpsert {
+	u.SetNull(entry.FieldTags)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Entry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(entry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EntryUpsertOne) UpdateNewValues() *EntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(entry.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(entry.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Entry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EntryUpsertOne) Ignore() *EntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EntryUpsertOne) DoNothing() *EntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EntryCreate.OnConflict
+// documentation for more info.
+func (u *EntryUpsertOne) Update(set func(*EntryUpsert)) *EntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EntryUpsertOne) SetUpdatedAt(v time.Time) *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EntryUpsertOne) UpdateUpdatedAt() *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *EntryUpsertOne) SetPatientID(v uuid.UUID) *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *EntryUpsertOne) UpdatePatientID() *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetHappenedAt sets the "happened_at" field.
+func (u *EntryUpsertOne) SetHappenedAt(v time.Time) *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetHappenedAt(v)
+	})
+}
+
+// UpdateHappenedAt sets the "happened_at" field to the value that was provided on create.
+func (u *EntryUpsertOne) UpdateHappenedAt() *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateHappenedAt()
+	})
+}
+
+// SetSituation sets the "situation" field.
+func (u *EntryUpsertOne) SetSituation(v string) *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetSituation(v)
+	})
+}
+
+// UpdateSituation sets the "situation" field to the value that was provided on create.
+func (u *EntryUpsertOne) UpdateSituation() *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateSituation()
+	})
+}
+
+// ClearSituation clears the value of the "situation" field.
+func (u *EntryUpsertOne) ClearSituation() *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.ClearSituation()
+	})
+}
+
+// SetEmotions sets the "emotions" field.
+func (u *EntryUpsertOne) SetEmotions(v []schema.Emotion) *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetEmotions(v)
+	})
+}
+
+// UpdateEmotions sets the "emotions" field to the value that was provided on create.
+func (u *EntryUpsertOne) UpdateEmotions() *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateEmotions()
+	})
+}
+
+// ClearEmotions clears the value of the "emotions" field.
+func (u *EntryUpsertOne) ClearEmotions() *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.ClearEmotions()
+	})
+}
+
+// SetTriggers sets the "triggers" field.
+func (u *EntryUpsertOne) SetTriggers(v []string) *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetTriggers(v)
+	})
+}
+
+// UpdateTriggers sets the "triggers" field to the value that was provided on create.
+func (u *EntryUpsertOne) UpdateTriggers() *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateTriggers()
+	})
+}
+
+// ClearTriggers clears the value of the "triggers" field.
+func (u *EntryUpsertOne) ClearTriggers() *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.ClearTriggers()
+	})
+}
+
+// SetTechniques sets the "techniques" field.
+func (u *EntryUpsertOne) SetTechniques(v []string) *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetTechniques(v)
+	})
+}
+
+// UpdateTechniques sets the "techniques" field to the value that was provided on create.
+func (u *EntryUpsertOne) UpdateTechniques() *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateTechniques()
+	})
+}
+
+// ClearTechniques clears the value of the "techniques" field.
+func (u *EntryUpsertOne) ClearTechniques() *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.ClearTechniques()
+	})
+}
+
+// SetStutterFrequency sets the "stutter_frequency" field.
+func (u *EntryUpsertOne) SetStutterFrequency(v int) *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetStutterFrequency(v)
+	})
+}
+
+// AddStutterFrequency adds v to the "stutter_frequency" field.
+func (u *EntryUpsertOne) AddStutterFrequency(v int) *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.AddStutterFrequency(v)
+	})
+}
+
+// UpdateStutterFrequency sets the "stutter_frequency" field to the value that was provided on create.
+func (u *EntryUpsertOne) UpdateStutterFrequency() *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateStutterFrequency()
+	})
+}
+
+// ClearStutterFrequency clears the value of the "stutter_frequency" field.
+func (u *EntryUpsertOne) ClearStutterFrequency() *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.ClearStutterFrequency()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *EntryUpsertOne) SetNotes(v string) *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *EntryUpsertOne) UpdateNotes() *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *EntryUpsertOne) ClearNotes() *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *EntryUpsertOne) SetTags(v []string) *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *EntryUpsertOne) UpdateTags() *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *EntryUpsertOne) ClearTags() *EntryUpsertOne {
+	return u.Update(func(s *EntryUpsert) {
+		s.ClearTags()
+	})
+}
+
+// Exec executes the query.
+func (u *EntryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EntryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EntryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EntryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EntryUpsertOne.ID is not supported by MySQL driver. Use EntryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EntryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // EntryCreateBulk is the builder for creating many Entry entities in bulk.
 type EntryCreateBulk struct {
 	config
 	err      error
 	builders []*EntryCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Entry entities in the database.
@@ -451,6 +1022,7 @@ func (_c *EntryCreateBulk) Save(ctx context.Context) ([]*Entry, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -500,3 +1072,316 @@ func (_c *EntryCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Entry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EntryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EntryCreateBulk) OnConflict(opts ...sql.ConflictOption) *EntryUpsertBulk {
+	_c.conflict = opts
+	return &EntryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Entry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EntryCreateBulk) OnConflictColumns(columns ...string) *EntryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EntryUpsertBulk{
+		create: _c,
+	}
+}
+
+// EntryUpsertBulk is the builder for "upsert"-ing
+// a bulk of Entry nodes.
+type EntryUpsertBulk struct {
+	create *EntryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Entry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(entry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EntryUpsertBulk) UpdateNewValues() *EntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(entry.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(entry.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Entry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EntryUpsertBulk) Ignore() *EntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EntryUpsertBulk) DoNothing() *EntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EntryCreateBulk.OnConflict
+// documentation for more info.
+func (u *EntryUpsertBulk) Update(set func(*EntryUpsert)) *EntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EntryUpsertBulk) SetUpdatedAt(v time.Time) *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EntryUpsertBulk) UpdateUpdatedAt() *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *EntryUpsertBulk) SetPatientID(v uuid.UUID) *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *EntryUpsertBulk) UpdatePatientID() *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetHappenedAt sets the "happened_at" field.
+func (u *EntryUpsertBulk) SetHappenedAt(v time.Time) *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetHappenedAt(v)
+	})
+}
+
+// UpdateHappenedAt sets the "happened_at" field to the value that was provided on create.
+func (u *EntryUpsertBulk) UpdateHappenedAt() *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateHappenedAt()
+	})
+}
+
+// SetSituation sets the "situation" field.
+func (u *EntryUpsertBulk) SetSituation(v string) *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetSituation(v)
+	})
+}
+
+// UpdateSituation sets the "situation" field to the value that was provided on create.
+func (u *EntryUpsertBulk) UpdateSituation() *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateSituation()
+	})
+}
+
+// ClearSituation clears the value of the "situation" field.
+func (u *EntryUpsertBulk) ClearSituation() *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.ClearSituation()
+	})
+}
+
+// SetEmotions sets the "emotions" field.
+func (u *EntryUpsertBulk) SetEmotions(v []schema.Emotion) *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetEmotions(v)
+	})
+}
+
+// UpdateEmotions sets the "emotions" field to the value that was provided on create.
+func (u *EntryUpsertBulk) UpdateEmotions() *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateEmotions()
+	})
+}
+
+// ClearEmotions clears the value of the "emotions" field.
+func (u *EntryUpsertBulk) ClearEmotions() *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.ClearEmotions()
+	})
+}
+
+// SetTriggers sets the "triggers" field.
+func (u *EntryUpsertBulk) SetTriggers(v []string) *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetTriggers(v)
+	})
+}
+
+// UpdateTriggers sets the "triggers" field to the value that was provided on create.
+func (u *EntryUpsertBulk) UpdateTriggers() *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateTriggers()
+	})
+}
+
+// ClearTriggers clears the value of the "triggers" field.
+func (u *EntryUpsertBulk) ClearTriggers() *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.ClearTriggers()
+	})
+}
+
+// SetTechniques sets the "techniques" field.
+func (u *EntryUpsertBulk) SetTechniques(v []string) *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetTechniques(v)
+	})
+}
+
+// UpdateTechniques sets the "techniques" field to the value that was provided on create.
+func (u *EntryUpsertBulk) UpdateTechniques() *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateTechniques()
+	})
+}
+
+// ClearTechniques clears the value of the "techniques" field.
+func (u *EntryUpsertBulk) ClearTechniques() *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.ClearTechniques()
+	})
+}
+
+// SetStutterFrequency sets the "stutter_frequency" field.
+func (u *EntryUpsertBulk) SetStutterFrequency(v int) *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetStutterFrequency(v)
+	})
+}
+
+// AddStutterFrequency adds v to the "stutter_frequency" field.
+func (u *EntryUpsertBulk) AddStutterFrequency(v int) *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.AddStutterFrequency(v)
+	})
+}
+
+// UpdateStutterFrequency sets the "stutter_frequency" field to the value that was provided on create.
+func (u *EntryUpsertBulk) UpdateStutterFrequency() *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateStutterFrequency()
+	})
+}
+
+// ClearStutterFrequency clears the value of the "stutter_frequency" field.
+func (u *EntryUpsertBulk) ClearStutterFrequency() *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.ClearStutterFrequency()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *EntryUpsertBulk) SetNotes(v string) *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *EntryUpsertBulk) UpdateNotes() *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *EntryUpsertBulk) ClearNotes() *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *EntryUpsertBulk) SetTags(v []string) *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *EntryUpsertBulk) UpdateTags() *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *EntryUpsertBulk) ClearTags() *EntryUpsertBulk {
+	return u.Update(func(s *EntryUpsert) {
+		s.ClearTags()
+	})
+}
+
+// Exec executes the query.
+func (u *EntryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EntryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EntryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EntryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"backend/ent/comment"
 	"backend/ent/entry"
 	"backend/ent/entryshare"
+	"backend/ent/exerciselog"
 	"backend/ent/patient"
 	"backend/ent/predicate"
+	"backend/ent/session"
 	"context"
 	"database/sql/driver"
 	"fmt"
@@ -32,6 +34,8 @@ type EntryQuery struct {
 	withShares       *EntryShareQuery
 	withComments     *CommentQuery
 	withAnalysisJobs *AnalysisJobQuery
+	withExerciseLogs *ExerciseLogQuery
+	withSessions     *SessionQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -156,6 +160,50 @@ func (_q *EntryQuery) QueryAnalysisJobs() *AnalysisJobQuery {
 	return query
 }
 
+// QueryExerciseLogs chains the current query on the "exercise_logs" edge.
+func (_q *EntryQuery) QueryExerciseLogs() *ExerciseLogQuery {
+	query := (&ExerciseLogClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(entry.Table, entry.FieldID, selector),
+			sqlgraph.To(exerciselog.Table, exerciselog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, entry.ExerciseLogsTable, entry.ExerciseLogsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySessions chains the current query on the "sessions" edge.
+func (_q *EntryQuery) QuerySessions() *SessionQuery {
+	query := (&SessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(entry.Table, entry.FieldID, selector),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, entry.SessionsTable, entry.SessionsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Entry entity from the query.
 // Returns a *NotFoundError when no Entry was found.
 func (_q *EntryQuery) First(ctx context.Context) (*Entry, error) {
@@ -352,6 +400,8 @@ func (_q *EntryQuery) Clone() *EntryQuery {
 		withShares:       _q.withShares.Clone(),
 		withComments:     _q.withComments.Clone(),
 		withAnalysisJobs: _q.withAnalysisJobs.Clone(),
+		withExerciseLogs: _q.withExerciseLogs.Clone(),
+		withSessions:     _q.withSessions.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -402,6 +452,28 @@ func (_q *EntryQuery) WithAnalysisJobs(opts ...func(*AnalysisJobQuery)) *EntryQu
 	return _q
 }
 
+// WithExerciseLogs tells the query-builder to eager-load the nodes that are connected to
+// the "exercise_logs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EntryQuery) WithExerciseLogs(opts ...func(*ExerciseLogQuery)) *EntryQuery {
+	query := (&ExerciseLogClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withExerciseLogs = query
+	return _q
+}
+
+// WithSessions tells the query-builder to eager-load the nodes that are connected to
+// the "sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EntryQuery) WithSessions(opts ...func(*SessionQuery)) *EntryQuery {
+	query := (&SessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSessions = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -480,11 +552,13 @@ func (_q *EntryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Entry,
 	var (
 		nodes       = []*Entry{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [6]bool{
 			_q.withPatient != nil,
 			_q.withShares != nil,
 			_q.withComments != nil,
 			_q.withAnalysisJobs != nil,
+			_q.withExerciseLogs != nil,
+			_q.withSessions != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -532,6 +606,20 @@ func (_q *EntryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Entry,
 			return nil, err
 		}
 	}
+	if query := _q.withExerciseLogs; query != nil {
+		if err := _q.loadExerciseLogs(ctx, query, nodes,
+			func(n *Entry) { n.Edges.ExerciseLogs = []*ExerciseLog{} },
+			func(n *Entry, e *ExerciseLog) { n.Edges.ExerciseLogs = append(n.Edges.ExerciseLogs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSessions; query != nil {
+		if err := _q.loadSessions(ctx, query, nodes,
+			func(n *Entry) { n.Edges.Sessions = []*Session{} },
+			func(n *Entry, e *Session) { n.Edges.Sessions = append(n.Edges.Sessions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -657,6 +745,100 @@ func (_q *EntryQuery) loadAnalysisJobs(ctx context.Context, query *AnalysisJobQu
 	}
 	return nil
 }
+func (_q *EntryQuery) loadExerciseLogs(ctx context.Context, query *ExerciseLogQuery, nodes []*Entry, init func(*Entry), assign func(*Entry, *ExerciseLog)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Entry)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(exerciselog.FieldEntryID)
+	}
+	query.Where(predicate.ExerciseLog(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(entry.ExerciseLogsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EntryID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "entry_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "entry_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *EntryQuery) loadSessions(ctx context.Context, query *SessionQuery, nodes []*Entry, init func(*Entry), assign func(*Entry, *Session)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Entry)
+	nids := make(map[uuid.UUID]map[*Entry]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(entry.SessionsTable)
+		s.Join(joinT).On(s.C(session.FieldID), joinT.C(entry.SessionsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(entry.SessionsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(entry.SessionsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Entry]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Session](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "sessions" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (_q *EntryQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
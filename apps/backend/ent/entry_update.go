@@ -7,9 +7,11 @@ import (
 	"backend/ent/comment"
 	"backend/ent/entry"
 	"backend/ent/entryshare"
+	"backend/ent/exerciselog"
 	"backend/ent/patient"
 	"backend/ent/predicate"
 	"backend/ent/schema"
+	"backend/ent/session"
 	"context"
 	"errors"
 	"fmt"
@@ -258,6 +260,36 @@ func (_u *EntryUpdate) AddAnalysisJobs(v ...*AnalysisJob) *EntryUpdate {
 	return _u.AddAnalysisJobIDs(ids...)
 }
 
+// AddExerciseLogIDs adds the "exercise_logs" edge to the ExerciseLog entity by IDs.
+func (_u *EntryUpdate) AddExerciseLogIDs(ids ...uuid.UUID) *EntryUpdate {
+	_u.mutation.AddExerciseLogIDs(ids...)
+	return _u
+}
+
+// AddExerciseLogs adds the "exercise_logs" edges to the ExerciseLog entity.
+func (_u *EntryUpdate) AddExerciseLogs(v ...*ExerciseLog) *EntryUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExerciseLogIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_u *EntryUpdate) AddSessionIDs(ids ...uuid.UUID) *EntryUpdate {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_u *EntryUpdate) AddSessions(v ...*Session) *EntryUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
 // Mutation returns the EntryMutation object of the builder.
 func (_u *EntryUpdate) Mutation() *EntryMutation {
 	return _u.mutation
@@ -332,6 +364,48 @@ func (_u *EntryUpdate) RemoveAnalysisJobs(v ...*AnalysisJob) *EntryUpdate {
 	return _u.RemoveAnalysisJobIDs(ids...)
 }
 
+// ClearExerciseLogs clears all "exercise_logs" edges to the ExerciseLog entity.
+func (_u *EntryUpdate) ClearExerciseLogs() *EntryUpdate {
+	_u.mutation.ClearExerciseLogs()
+	return _u
+}
+
+// RemoveExerciseLogIDs removes the "exercise_logs" edge to ExerciseLog entities by IDs.
+func (_u *EntryUpdate) RemoveExerciseLogIDs(ids ...uuid.UUID) *EntryUpdate {
+	_u.mutation.RemoveExerciseLogIDs(ids...)
+	return _u
+}
+
+// RemoveExerciseLogs removes "exercise_logs" edges to ExerciseLog entities.
+func (_u *EntryUpdate) RemoveExerciseLogs(v ...*ExerciseLog) *EntryUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExerciseLogIDs(ids...)
+}
+
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (_u *EntryUpdate) ClearSessions() *EntryUpdate {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (_u *EntryUpdate) RemoveSessionIDs(ids ...uuid.UUID) *EntryUpdate {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (_u *EntryUpdate) RemoveSessions(v ...*Session) *EntryUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *EntryUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -623,6 +697,96 @@ func (_u *EntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ExerciseLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   entry.ExerciseLogsTable,
+			Columns: []string{entry.ExerciseLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exerciselog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExerciseLogsIDs(); len(nodes) > 0 && !_u.mutation.ExerciseLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   entry.ExerciseLogsTable,
+			Columns: []string{entry.ExerciseLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exerciselog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExerciseLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   entry.ExerciseLogsTable,
+			Columns: []string{entry.ExerciseLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exerciselog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   entry.SessionsTable,
+			Columns: entry.SessionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   entry.SessionsTable,
+			Columns: entry.SessionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   entry.SessionsTable,
+			Columns: entry.SessionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{entry.Label}
@@ -866,6 +1030,36 @@ func (_u *EntryUpdateOne) AddAnalysisJobs(v ...*AnalysisJob) *EntryUpdateOne {
 	return _u.AddAnalysisJobIDs(ids...)
 }
 
+// AddExerciseLogIDs adds the "exercise_logs" edge to the ExerciseLog entity by IDs.
+func (_u *EntryUpdateOne) AddExerciseLogIDs(ids ...uuid.UUID) *EntryUpdateOne {
+	_u.mutation.AddExerciseLogIDs(ids...)
+	return _u
+}
+
+// AddExerciseLogs adds the "exercise_logs" edges to the ExerciseLog entity.
+func (_u *EntryUpdateOne) AddExerciseLogs(v ...*ExerciseLog) *EntryUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddExerciseLogIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_u *EntryUpdateOne) AddSessionIDs(ids ...uuid.UUID) *EntryUpdateOne {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_u *EntryUpdateOne) AddSessions(v ...*Session) *EntryUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
 // Mutation returns the EntryMutation object of the builder.
 func (_u *EntryUpdateOne) Mutation() *EntryMutation {
 	return _u.mutation
@@ -940,6 +1134,48 @@ func (_u *EntryUpdateOne) RemoveAnalysisJobs(v ...*AnalysisJob) *EntryUpdateOne
 	return _u.RemoveAnalysisJobIDs(ids...)
 }
 
+// ClearExerciseLogs clears all "exercise_logs" edges to the ExerciseLog entity.
+func (_u *EntryUpdateOne) ClearExerciseLogs() *EntryUpdateOne {
+	_u.mutation.ClearExerciseLogs()
+	return _u
+}
+
+// RemoveExerciseLogIDs removes the "exercise_logs" edge to ExerciseLog entities by IDs.
+func (_u *EntryUpdateOne) RemoveExerciseLogIDs(ids ...uuid.UUID) *EntryUpdateOne {
+	_u.mutation.RemoveExerciseLogIDs(ids...)
+	return _u
+}
+
+// RemoveExerciseLogs removes "exercise_logs" edges to ExerciseLog entities.
+func (_u *EntryUpdateOne) RemoveExerciseLogs(v ...*ExerciseLog) *EntryUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveExerciseLogIDs(ids...)
+}
+
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (_u *EntryUpdateOne) ClearSessions() *EntryUpdateOne {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (_u *EntryUpdateOne) RemoveSessionIDs(ids ...uuid.UUID) *EntryUpdateOne {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (_u *EntryUpdateOne) RemoveSessions(v ...*Session) *EntryUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
 // Where appends a list predicates to the EntryUpdate builder.
 func (_u *EntryUpdateOne) Where(ps ...predicate.Entry) *EntryUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1261,6 +1497,96 @@ func (_u *EntryUpdateOne) sqlSave(ctx context.Context) (_node *Entry, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ExerciseLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   entry.ExerciseLogsTable,
+			Columns: []string{entry.ExerciseLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exerciselog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedExerciseLogsIDs(); len(nodes) > 0 && !_u.mutation.ExerciseLogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   entry.ExerciseLogsTable,
+			Columns: []string{entry.ExerciseLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exerciselog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExerciseLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   entry.ExerciseLogsTable,
+			Columns: []string{entry.ExerciseLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(exerciselog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   entry.SessionsTable,
+			Columns: entry.SessionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   entry.SessionsTable,
+			Columns: entry.SessionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   entry.SessionsTable,
+			Columns: entry.SessionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Entry{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -12,6 +12,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -22,6 +24,7 @@ type EntryShareCreate struct {
 	config
 	mutation *EntryShareMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -240,6 +243,7 @@ func (_c *EntryShareCreate) createSpec() (*EntryShare, *sqlgraph.CreateSpec) {
 		_node = &EntryShare{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(entryshare.Table, sqlgraph.NewFieldSpec(entryshare.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -314,11 +318,319 @@ func (_c *EntryShareCreate) createSpec() (*EntryShare, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EntryShare.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EntryShareUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EntryShareCreate) OnConflict(opts ...sql.ConflictOption) *EntryShareUpsertOne {
+	_c.conflict = opts
+	return &EntryShareUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EntryShare.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EntryShareCreate) OnConflictColumns(columns ...string) *EntryShareUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EntryShareUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EntryShareUpsertOne is the builder for "upsert"-ing
+	//  one EntryShare node.
+	EntryShareUpsertOne struct {
+		create *EntryShareCreate
+	}
+
+	// EntryShareUpsert is the "OnConflict" setter.
+	EntryShareUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EntryShareUpsert) SetUpdatedAt(v time.Time) *EntryShareUpsert {
+	u.Set(entryshare.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EntryShareUpsert) UpdateUpdatedAt() *EntryShareUpsert {
+	u.SetExcluded(entryshare.FieldUpdatedAt)
+	return u
+}
+
+// SetEntryID sets the "entry_id" field.
+func (u *EntryShareUpsert) SetEntryID(v uuid.UUID) *EntryShareUpsert {
+	u.Set(entryshare.FieldEntryID, v)
+	return u
+}
+
+// UpdateEntryID sets the "entry_id" field to the value that was provided on create.
+func (u *EntryShareUpsert) UpdateEntryID() *EntryShareUpsert {
+	u.SetExcluded(entryshare.FieldEntryID)
+	return u
+}
+
+// SetSharedByPatientID sets the "shared_by_patient_id" field.
+func (u *EntryShareUpsert) SetSharedByPatientID(v uuid.UUID) *EntryShareUpsert {
+	u.Set(entryshare.FieldSharedByPatientID, v)
+	return u
+}
+
+// UpdateSharedByPatientID sets the "shared_by_patient_id" field to the value that was provided on create.
+func (u *EntryShareUpsert) UpdateSharedByPatientID() *EntryShareUpsert {
+	u.SetExcluded(entryshare.FieldSharedByPatientID)
+	return u
+}
+
+// SetSharedWithDoctorID sets the "shared_with_doctor_id" field.
+func (u *EntryShareUpsert) SetSharedWithDoctorID(v uuid.UUID) *EntryShareUpsert {
+	u.Set(entryshare.FieldSharedWithDoctorID, v)
+	return u
+}
+
+// UpdateSharedWithDoctorID sets the "shared_with_doctor_id" field to the value that was provided on create.
+func (u *EntryShareUpsert) UpdateSharedWithDoctorID() *EntryShareUpsert {
+	u.SetExcluded(entryshare.FieldSharedWithDoctorID)
+	return u
+}
+
+// SetSharedAt sets the "shared_at" field.
+func (u *EntryShareUpsert) SetSharedAt(v time.Time) *EntryShareUpsert {
+	u.Set(entryshare.FieldSharedAt, v)
+	return u
+}
+
+// UpdateSharedAt sets the "shared_at" field to the value that was provided on create.
+func (u *EntryShareUpsert) UpdateSharedAt() *EntryShareUpsert {
+	u.SetExcluded(entryshare.FieldSharedAt)
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *EntryShareUpsert) SetRevokedAt(v time.Time) *EntryShareUpsert {
+	u.Set(entryshare.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *EntryShareUpsert) UpdateRevokedAt() *EntryShareUpsert {
+	u.SetExcluded(entryshare.FieldRevokedAt)
+	return u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *EntryShareUpsert) ClearRevokedAt() *EntryShareUpsert {
+	u.SetNull(entryshare.FieldRevokedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EntryShare.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(entryshare.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EntryShareUpsertOne) UpdateNewValues() *EntryShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(entryshare.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(entryshare.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EntryShare.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EntryShareUpsertOne) Ignore() *EntryShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EntryShareUpsertOne) DoNothing() *EntryShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EntryShareCreate.OnConflict
+// documentation for more info.
+func (u *EntryShareUpsertOne) Update(set func(*EntryShareUpsert)) *EntryShareUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EntryShareUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EntryShareUpsertOne) SetUpdatedAt(v time.Time) *EntryShareUpsertOne {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EntryShareUpsertOne) UpdateUpdatedAt() *EntryShareUpsertOne {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEntryID sets the "entry_id" field.
+func (u *EntryShareUpsertOne) SetEntryID(v uuid.UUID) *EntryShareUpsertOne {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.SetEntryID(v)
+	})
+}
+
+// UpdateEntryID sets the "entry_id" field to the value that was provided on create.
+func (u *EntryShareUpsertOne) UpdateEntryID() *EntryShareUpsertOne {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.UpdateEntryID()
+	})
+}
+
+// SetSharedByPatientID sets the "shared_by_patient_id" field.
+func (u *EntryShareUpsertOne) SetSharedByPatientID(v uuid.UUID) *EntryShareUpsertOne {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.SetSharedByPatientID(v)
+	})
+}
+
+// UpdateSharedByPatientID sets the "shared_by_patient_id" field to the value that was provided on create.
+func (u *EntryShareUpsertOne) UpdateSharedByPatientID() *EntryShareUpsertOne {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.UpdateSharedByPatientID()
+	})
+}
+
+// SetSharedWithDoctorID sets the "shared_with_doctor_id" field.
+func (u *EntryShareUpsertOne) SetSharedWithDoctorID(v uuid.UUID) *EntryShareUpsertOne {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.SetSharedWithDoctorID(v)
+	})
+}
+
+// UpdateSharedWithDoctorID sets the "shared_with_doctor_id" field to the value that was provided on create.
+func (u *EntryShareUpsertOne) UpdateSharedWithDoctorID() *EntryShareUpsertOne {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.UpdateSharedWithDoctorID()
+	})
+}
+
+// SetSharedAt sets the "shared_at" field.
+func (u *EntryShareUpsertOne) SetSharedAt(v time.Time) *EntryShareUpsertOne {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.SetSharedAt(v)
+	})
+}
+
+// UpdateSharedAt sets the "shared_at" field to the value that was provided on create.
+func (u *EntryShareUpsertOne) UpdateSharedAt() *EntryShareUpsertOne {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.UpdateSharedAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *EntryShareUpsertOne) SetRevokedAt(v time.Time) *EntryShareUpsertOne {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *EntryShareUpsertOne) UpdateRevokedAt() *EntryShareUpsertOne {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *EntryShareUpsertOne) ClearRevokedAt() *EntryShareUpsertOne {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EntryShareUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EntryShareCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EntryShareUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EntryShareUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EntryShareUpsertOne.ID is not supported by MySQL driver. Use EntryShareUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EntryShareUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // EntryShareCreateBulk is the builder for creating many EntryShare entities in bulk.
 type EntryShareCreateBulk struct {
 	config
 	err      error
 	builders []*EntryShareCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the EntryShare entities in the database.
@@ -348,6 +660,7 @@ func (_c *EntryShareCreateBulk) Save(ctx context.Context) ([]*EntryShare, error)
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -397,3 +710,211 @@ func (_c *EntryShareCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EntryShare.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EntryShareUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EntryShareCreateBulk) OnConflict(opts ...sql.ConflictOption) *EntryShareUpsertBulk {
+	_c.conflict = opts
+	return &EntryShareUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EntryShare.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EntryShareCreateBulk) OnConflictColumns(columns ...string) *EntryShareUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EntryShareUpsertBulk{
+		create: _c,
+	}
+}
+
+// EntryShareUpsertBulk is the builder for "upsert"-ing
+// a bulk of EntryShare nodes.
+type EntryShareUpsertBulk struct {
+	create *EntryShareCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EntryShare.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(entryshare.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EntryShareUpsertBulk) UpdateNewValues() *EntryShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(entryshare.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(entryshare.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EntryShare.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EntryShareUpsertBulk) Ignore() *EntryShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EntryShareUpsertBulk) DoNothing() *EntryShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EntryShareCreateBulk.OnConflict
+// documentation for more info.
+func (u *EntryShareUpsertBulk) Update(set func(*EntryShareUpsert)) *EntryShareUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EntryShareUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *EntryShareUpsertBulk) SetUpdatedAt(v time.Time) *EntryShareUpsertBulk {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *EntryShareUpsertBulk) UpdateUpdatedAt() *EntryShareUpsertBulk {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetEntryID sets the "entry_id" field.
+func (u *EntryShareUpsertBulk) SetEntryID(v uuid.UUID) *EntryShareUpsertBulk {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.SetEntryID(v)
+	})
+}
+
+// UpdateEntryID sets the "entry_id" field to the value that was provided on create.
+func (u *EntryShareUpsertBulk) UpdateEntryID() *EntryShareUpsertBulk {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.UpdateEntryID()
+	})
+}
+
+// SetSharedByPatientID sets the "shared_by_patient_id" field.
+func (u *EntryShareUpsertBulk) SetSharedByPatientID(v uuid.UUID) *EntryShareUpsertBulk {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.SetSharedByPatientID(v)
+	})
+}
+
+// UpdateSharedByPatientID sets the "shared_by_patient_id" field to the value that was provided on create.
+func (u *EntryShareUpsertBulk) UpdateSharedByPatientID() *EntryShareUpsertBulk {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.UpdateSharedByPatientID()
+	})
+}
+
+// SetSharedWithDoctorID sets the "shared_with_doctor_id" field.
+func (u *EntryShareUpsertBulk) SetSharedWithDoctorID(v uuid.UUID) *EntryShareUpsertBulk {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.SetSharedWithDoctorID(v)
+	})
+}
+
+// UpdateSharedWithDoctorID sets the "shared_with_doctor_id" field to the value that was provided on create.
+func (u *EntryShareUpsertBulk) UpdateSharedWithDoctorID() *EntryShareUpsertBulk {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.UpdateSharedWithDoctorID()
+	})
+}
+
+// SetSharedAt sets the "shared_at" field.
+func (u *EntryShareUpsertBulk) SetSharedAt(v time.Time) *EntryShareUpsertBulk {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.SetSharedAt(v)
+	})
+}
+
+// UpdateSharedAt sets the "shared_at" field to the value that was provided on create.
+func (u *EntryShareUpsertBulk) UpdateSharedAt() *EntryShareUpsertBulk {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.UpdateSharedAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *EntryShareUpsertBulk) SetRevokedAt(v time.Time) *EntryShareUpsertBulk {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *EntryShareUpsertBulk) UpdateRevokedAt() *EntryShareUpsertBulk {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *EntryShareUpsertBulk) ClearRevokedAt() *EntryShareUpsertBulk {
+	return u.Update(func(s *EntryShareUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EntryShareUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EntryShareCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EntryShareCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EntryShareUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
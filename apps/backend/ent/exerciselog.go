@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/assignment"
+	"backend/ent/entry"
+	"backend/ent/exerciselog"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ExerciseLog is the model entity for the ExerciseLog schema.
+type ExerciseLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// AssignmentID holds the value of the "assignment_id" field.
+	AssignmentID uuid.UUID `json:"assignment_id,omitempty"`
+	// EntryID holds the value of the "entry_id" field.
+	EntryID *uuid.UUID `json:"entry_id,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt time.Time `json:"completed_at,omitempty"`
+	// DurationMinutes holds the value of the "duration_minutes" field.
+	DurationMinutes *int `json:"duration_minutes,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes *string `json:"notes,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ExerciseLogQuery when eager-loading is set.
+	Edges        ExerciseLogEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ExerciseLogEdges holds the relations/edges for other nodes in the graph.
+type ExerciseLogEdges struct {
+	// Assignment holds the value of the assignment edge.
+	Assignment *Assignment `json:"assignment,omitempty"`
+	// Entry holds the value of the entry edge.
+	Entry *Entry `json:"entry,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AssignmentOrErr returns the Assignment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExerciseLogEdges) AssignmentOrErr() (*Assignment, error) {
+	if e.Assignment != nil {
+		return e.Assignment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: assignment.Label}
+	}
+	return nil, &NotLoadedError{edge: "assignment"}
+}
+
+// EntryOrErr returns the Entry value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExerciseLogEdges) EntryOrErr() (*Entry, error) {
+	if e.Entry != nil {
+		return e.Entry, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: entry.Label}
+	}
+	return nil, &NotLoadedError{edge: "entry"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExerciseLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case exerciselog.FieldEntryID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case exerciselog.FieldDurationMinutes:
+			values[i] = new(sql.NullInt64)
+		case exerciselog.FieldNotes:
+			values[i] = new(sql.NullString)
+		case exerciselog.FieldCreatedAt, exerciselog.FieldUpdatedAt, exerciselog.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		case exerciselog.FieldID, exerciselog.FieldAssignmentID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExerciseLog fields.
+func (_m *ExerciseLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case exerciselog.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case exerciselog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case exerciselog.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case exerciselog.FieldAssignmentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field assignment_id", values[i])
+			} else if value != nil {
+				_m.AssignmentID = *value
+			}
+		case exerciselog.FieldEntryID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field entry_id", values[i])
+			} else if value.Valid {
+				_m.EntryID = new(uuid.UUID)
+				*_m.EntryID = *value.S.(*uuid.UUID)
+			}
+		case exerciselog.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = value.Time
+			}
+		case exerciselog.FieldDurationMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_minutes", values[i])
+			} else if value.Valid {
+				_m.DurationMinutes = new(int)
+				*_m.DurationMinutes = int(value.Int64)
+			}
+		case exerciselog.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = new(string)
+				*_m.Notes = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExerciseLog.
+// This includes values selected through modifiers, order, etc.
+func (_m *ExerciseLog) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAssignment queries the "assignment" edge of the ExerciseLog entity.
+func (_m *ExerciseLog) QueryAssignment() *AssignmentQuery {
+	return NewExerciseLogClient(_m.config).QueryAssignment(_m)
+}
+
+// QueryEntry queries the "entry" edge of the ExerciseLog entity.
+func (_m *ExerciseLog) QueryEntry() *EntryQuery {
+	return NewExerciseLogClient(_m.config).QueryEntry(_m)
+}
+
+// Update returns a builder for updating this ExerciseLog.
+// Note that you need to call ExerciseLog.Unwrap() before calling this method if this ExerciseLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ExerciseLog) Update() *ExerciseLogUpdateOne {
+	return NewExerciseLogClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ExerciseLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ExerciseLog) Unwrap() *ExerciseLog {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExerciseLog is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ExerciseLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExerciseLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("assignment_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AssignmentID))
+	builder.WriteString(", ")
+	if v := _m.EntryID; v != nil {
+		builder.WriteString("entry_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("completed_at=")
+	builder.WriteString(_m.CompletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DurationMinutes; v != nil {
+		builder.WriteString("duration_minutes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Notes; v != nil {
+		builder.WriteString("notes=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ExerciseLogs is a parsable slice of ExerciseLog.
+type ExerciseLogs []*ExerciseLog
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exerciselog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the exerciselog type in the database.
+	Label = "exercise_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldAssignmentID holds the string denoting the assignment_id field in the database.
+	FieldAssignmentID = "assignment_id"
+	// FieldEntryID holds the string denoting the entry_id field in the database.
+	FieldEntryID = "entry_id"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// FieldDurationMinutes holds the string denoting the duration_minutes field in the database.
+	FieldDurationMinutes = "duration_minutes"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// EdgeAssignment holds the string denoting the assignment edge name in mutations.
+	EdgeAssignment = "assignment"
+	// EdgeEntry holds the string denoting the entry edge name in mutations.
+	EdgeEntry = "entry"
+	// Table holds the table name of the exerciselog in the database.
+	Table = "exercise_logs"
+	// AssignmentTable is the table that holds the assignment relation/edge.
+	AssignmentTable = "exercise_logs"
+	// AssignmentInverseTable is the table name for the Assignment entity.
+	// It exists in this package in order to avoid circular dependency with the "assignment" package.
+	AssignmentInverseTable = "assignments"
+	// AssignmentColumn is the table column denoting the assignment relation/edge.
+	AssignmentColumn = "assignment_id"
+	// EntryTable is the table that holds the entry relation/edge.
+	EntryTable = "exercise_logs"
+	// EntryInverseTable is the table name for the Entry entity.
+	// It exists in this package in order to avoid circular dependency with the "entry" package.
+	EntryInverseTable = "entries"
+	// EntryColumn is the table column denoting the entry relation/edge.
+	EntryColumn = "entry_id"
+)
+
+// Columns holds all SQL columns for exerciselog fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldAssignmentID,
+	FieldEntryID,
+	FieldCompletedAt,
+	FieldDurationMinutes,
+	FieldNotes,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCompletedAt holds the default value on creation for the "completed_at" field.
+	DefaultCompletedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ExerciseLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByAssignmentID orders the results by the assignment_id field.
+func ByAssignmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignmentID, opts...).ToFunc()
+}
+
+// ByEntryID orders the results by the entry_id field.
+func ByEntryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntryID, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
+
+// ByDurationMinutes orders the results by the duration_minutes field.
+func ByDurationMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationMinutes, opts...).ToFunc()
+}
+
+// ByNotes orders the results by the notes field.
+func ByNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotes, opts...).ToFunc()
+}
+
+// ByAssignmentField orders the results by assignment field.
+func ByAssignmentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAssignmentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEntryField orders the results by entry field.
+func ByEntryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEntryStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAssignmentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AssignmentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AssignmentTable, AssignmentColumn),
+	)
+}
+func newEntryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EntryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, EntryTable, EntryColumn),
+	)
+}
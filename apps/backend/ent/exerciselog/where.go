@@ -0,0 +1,448 @@
+// Code generated by ent, DO NOT EDIT.
+
+package exerciselog
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// AssignmentID applies equality check predicate on the "assignment_id" field. It's identical to AssignmentIDEQ.
+func AssignmentID(v uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldEQ(FieldAssignmentID, v))
+}
+
+// EntryID applies equality check predicate on the "entry_id" field. It's identical to EntryIDEQ.
+func EntryID(v uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldEQ(FieldEntryID, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// DurationMinutes applies equality check predicate on the "duration_minutes" field. It's identical to DurationMinutesEQ.
+func DurationMinutes(v int) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldEQ(FieldDurationMinutes, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldEQ(FieldNotes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// AssignmentIDEQ applies the EQ predicate on the "assignment_id" field.
+func AssignmentIDEQ(v uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldEQ(FieldAssignmentID, v))
+}
+
+// AssignmentIDNEQ applies the NEQ predicate on the "assignment_id" field.
+func AssignmentIDNEQ(v uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNEQ(FieldAssignmentID, v))
+}
+
+// AssignmentIDIn applies the In predicate on the "assignment_id" field.
+func AssignmentIDIn(vs ...uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldIn(FieldAssignmentID, vs...))
+}
+
+// AssignmentIDNotIn applies the NotIn predicate on the "assignment_id" field.
+func AssignmentIDNotIn(vs ...uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNotIn(FieldAssignmentID, vs...))
+}
+
+// EntryIDEQ applies the EQ predicate on the "entry_id" field.
+func EntryIDEQ(v uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldEQ(FieldEntryID, v))
+}
+
+// EntryIDNEQ applies the NEQ predicate on the "entry_id" field.
+func EntryIDNEQ(v uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNEQ(FieldEntryID, v))
+}
+
+// EntryIDIn applies the In predicate on the "entry_id" field.
+func EntryIDIn(vs ...uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldIn(FieldEntryID, vs...))
+}
+
+// EntryIDNotIn applies the NotIn predicate on the "entry_id" field.
+func EntryIDNotIn(vs ...uuid.UUID) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNotIn(FieldEntryID, vs...))
+}
+
+// EntryIDIsNil applies the IsNil predicate on the "entry_id" field.
+func EntryIDIsNil() predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldIsNull(FieldEntryID))
+}
+
+// EntryIDNotNil applies the NotNil predicate on the "entry_id" field.
+func EntryIDNotNil() predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNotNull(FieldEntryID))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// DurationMinutesEQ applies the EQ predicate on the "duration_minutes" field.
+func DurationMinutesEQ(v int) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldEQ(FieldDurationMinutes, v))
+}
+
+// DurationMinutesNEQ applies the NEQ predicate on the "duration_minutes" field.
+func DurationMinutesNEQ(v int) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNEQ(FieldDurationMinutes, v))
+}
+
+// DurationMinutesIn applies the In predicate on the "duration_minutes" field.
+func DurationMinutesIn(vs ...int) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldIn(FieldDurationMinutes, vs...))
+}
+
+// DurationMinutesNotIn applies the NotIn predicate on the "duration_minutes" field.
+func DurationMinutesNotIn(vs ...int) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNotIn(FieldDurationMinutes, vs...))
+}
+
+// DurationMinutesGT applies the GT predicate on the "duration_minutes" field.
+func DurationMinutesGT(v int) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldGT(FieldDurationMinutes, v))
+}
+
+// DurationMinutesGTE applies the GTE predicate on the "duration_minutes" field.
+func DurationMinutesGTE(v int) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldGTE(FieldDurationMinutes, v))
+}
+
+// DurationMinutesLT applies the LT predicate on the "duration_minutes" field.
+func DurationMinutesLT(v int) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldLT(FieldDurationMinutes, v))
+}
+
+// DurationMinutesLTE applies the LTE predicate on the "duration_minutes" field.
+func DurationMinutesLTE(v int) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldLTE(FieldDurationMinutes, v))
+}
+
+// DurationMinutesIsNil applies the IsNil predicate on the "duration_minutes" field.
+func DurationMinutesIsNil() predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldIsNull(FieldDurationMinutes))
+}
+
+// DurationMinutesNotNil applies the NotNil predicate on the "duration_minutes" field.
+func DurationMinutesNotNil() predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNotNull(FieldDurationMinutes))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldIsNull(FieldNotes))
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldNotNull(FieldNotes))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// HasAssignment applies the HasEdge predicate on the "assignment" edge.
+func HasAssignment() predicate.ExerciseLog {
+	return predicate.ExerciseLog(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AssignmentTable, AssignmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssignmentWith applies the HasEdge predicate on the "assignment" edge with a given conditions (other predicates).
+func HasAssignmentWith(preds ...predicate.Assignment) predicate.ExerciseLog {
+	return predicate.ExerciseLog(func(s *sql.Selector) {
+		step := newAssignmentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEntry applies the HasEdge predicate on the "entry" edge.
+func HasEntry() predicate.ExerciseLog {
+	return predicate.ExerciseLog(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EntryTable, EntryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEntryWith applies the HasEdge predicate on the "entry" edge with a given conditions (other predicates).
+func HasEntryWith(preds ...predicate.Entry) predicate.ExerciseLog {
+	return predicate.ExerciseLog(func(s *sql.Selector) {
+		step := newEntryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExerciseLog) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExerciseLog) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExerciseLog) predicate.ExerciseLog {
+	return predicate.ExerciseLog(sql.NotPredicates(p))
+}
@@ -0,0 +1,965 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/assignment"
+	"backend/ent/entry"
+	"backend/ent/exerciselog"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ExerciseLogCreate is the builder for creating a ExerciseLog entity.
+type ExerciseLogCreate struct {
+	config
+	mutation *ExerciseLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ExerciseLogCreate) SetCreatedAt(v time.Time) *ExerciseLogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ExerciseLogCreate) SetNillableCreatedAt(v *time.Time) *ExerciseLogCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ExerciseLogCreate) SetUpdatedAt(v time.Time) *ExerciseLogCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ExerciseLogCreate) SetNillableUpdatedAt(v *time.Time) *ExerciseLogCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetAssignmentID sets the "assignment_id" field.
+func (_c *ExerciseLogCreate) SetAssignmentID(v uuid.UUID) *ExerciseLogCreate {
+	_c.mutation.SetAssignmentID(v)
+	return _c
+}
+
+// SetEntryID sets the "entry_id" field.
+func (_c *ExerciseLogCreate) SetEntryID(v uuid.UUID) *ExerciseLogCreate {
+	_c.mutation.SetEntryID(v)
+	return _c
+}
+
+// SetNillableEntryID sets the "entry_id" field if the given value is not nil.
+func (_c *ExerciseLogCreate) SetNillableEntryID(v *uuid.UUID) *ExerciseLogCreate {
+	if v != nil {
+		_c.SetEntryID(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *ExerciseLogCreate) SetCompletedAt(v time.Time) *ExerciseLogCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *ExerciseLogCreate) SetNillableCompletedAt(v *time.Time) *ExerciseLogCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (_c *ExerciseLogCreate) SetDurationMinutes(v int) *ExerciseLogCreate {
+	_c.mutation.SetDurationMinutes(v)
+	return _c
+}
+
+// SetNillableDurationMinutes sets the "duration_minutes" field if the given value is not nil.
+func (_c *ExerciseLogCreate) SetNillableDurationMinutes(v *int) *ExerciseLogCreate {
+	if v != nil {
+		_c.SetDurationMinutes(*v)
+	}
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *ExerciseLogCreate) SetNotes(v string) *ExerciseLogCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *ExerciseLogCreate) SetNillableNotes(v *string) *ExerciseLogCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ExerciseLogCreate) SetID(v uuid.UUID) *ExerciseLogCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ExerciseLogCreate) SetNillableID(v *uuid.UUID) *ExerciseLogCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAssignment sets the "assignment" edge to the Assignment entity.
+func (_c *ExerciseLogCreate) SetAssignment(v *Assignment) *ExerciseLogCreate {
+	return _c.SetAssignmentID(v.ID)
+}
+
+// SetEntry sets the "entry" edge to the Entry entity.
+func (_c *ExerciseLogCreate) SetEntry(v *Entry) *ExerciseLogCreate {
+	return _c.SetEntryID(v.ID)
+}
+
+// Mutation returns the ExerciseLogMutation object of the builder.
+func (_c *ExerciseLogCreate) Mutation() *ExerciseLogMutation {
+	return _c.mutation
+}
+
+// Save creates the ExerciseLog in the database.
+func (_c *ExerciseLogCreate) Save(ctx context.Context) (*ExerciseLog, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ExerciseLogCreate) SaveX(ctx context.Context) *ExerciseLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExerciseLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExerciseLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ExerciseLogCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := exerciselog.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := exerciselog.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.CompletedAt(); !ok {
+		v := exerciselog.DefaultCompletedAt()
+		_c.mutation.SetCompletedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := exerciselog.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ExerciseLogCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ExerciseLog.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ExerciseLog.updated_at"`)}
+	}
+	if _, ok := _c.mutation.AssignmentID(); !ok {
+		return &ValidationError{Name: "assignment_id", err: errors.New(`ent: missing required field "ExerciseLog.assignment_id"`)}
+	}
+	if _, ok := _c.mutation.CompletedAt(); !ok {
+		return &ValidationError{Name: "completed_at", err: errors.New(`ent: missing required field "ExerciseLog.completed_at"`)}
+	}
+	if len(_c.mutation.AssignmentIDs()) == 0 {
+		return &ValidationError{Name: "assignment", err: errors.New(`ent: missing required edge "ExerciseLog.assignment"`)}
+	}
+	return nil
+}
+
+func (_c *ExerciseLogCreate) sqlSave(ctx context.Context) (*ExerciseLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ExerciseLogCreate) createSpec() (*ExerciseLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExerciseLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(exerciselog.Table, sqlgraph.NewFieldSpec(exerciselog.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(exerciselog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(exerciselog.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(exerciselog.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = value
+	}
+	if value, ok := _c.mutation.DurationMinutes(); ok {
+		_spec.SetField(exerciselog.FieldDurationMinutes, field.TypeInt, value)
+		_node.DurationMinutes = &value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(exerciselog.FieldNotes, field.TypeString, value)
+		_node.Notes = &value
+	}
+	if nodes := _c.mutation.AssignmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exerciselog.AssignmentTable,
+			Columns: []string{exerciselog.AssignmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AssignmentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exerciselog.EntryTable,
+			Columns: []string{exerciselog.EntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EntryID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExerciseLog.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExerciseLogUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExerciseLogCreate) OnConflict(opts ...sql.ConflictOption) *ExerciseLogUpsertOne {
+	_c.conflict = opts
+	return &ExerciseLogUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExerciseLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExerciseLogCreate) OnConflictColumns(columns ...string) *ExerciseLogUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExerciseLogUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ExerciseLogUpsertOne is the builder for "upsert"-ing
+	//  one ExerciseLog node.
+	ExerciseLogUpsertOne struct {
+		create *ExerciseLogCreate
+	}
+
+	// ExerciseLogUpsert is the "OnConflict" setter.
+	ExerciseLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExerciseLogUpsert) SetUpdatedAt(v time.Time) *ExerciseLogUpsert {
+	u.Set(exerciselog.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExerciseLogUpsert) UpdateUpdatedAt() *ExerciseLogUpsert {
+	u.SetExcluded(exerciselog.FieldUpdatedAt)
+	return u
+}
+
+// SetAssignmentID sets the "assignment_id" field.
+func (u *ExerciseLogUpsert) SetAssignmentID(v uuid.UUID) *ExerciseLogUpsert {
+	u.Set(exerciselog.FieldAssignmentID, v)
+	return u
+}
+
+// UpdateAssignmentID sets the "assignment_id" field to the value that was provided on create.
+func (u *ExerciseLogUpsert) UpdateAssignmentID() *ExerciseLogUpsert {
+	u.SetExcluded(exerciselog.FieldAssignmentID)
+	return u
+}
+
+// SetEntryID sets the "entry_id" field.
+func (u *ExerciseLogUpsert) SetEntryID(v uuid.UUID) *ExerciseLogUpsert {
+	u.Set(exerciselog.FieldEntryID, v)
+	return u
+}
+
+// UpdateEntryID sets the "entry_id" field to the value that was provided on create.
+func (u *ExerciseLogUpsert) UpdateEntryID() *ExerciseLogUpsert {
+	u.SetExcluded(exerciselog.FieldEntryID)
+	return u
+}
+
+// ClearEntryID clears the value of the "entry_id" field.
+func (u *ExerciseLogUpsert) ClearEntryID() *ExerciseLogUpsert {
+	u.SetNull(exerciselog.FieldEntryID)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ExerciseLogUpsert) SetCompletedAt(v time.Time) *ExerciseLogUpsert {
+	u.Set(exerciselog.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ExerciseLogUpsert) UpdateCompletedAt() *ExerciseLogUpsert {
+	u.SetExcluded(exerciselog.FieldCompletedAt)
+	return u
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (u *ExerciseLogUpsert) SetDurationMinutes(v int) *ExerciseLogUpsert {
+	u.Set(exerciselog.FieldDurationMinutes, v)
+	return u
+}
+
+// UpdateDurationMinutes sets the "duration_minutes" field to the value that was provided on create.
+func (u *ExerciseLogUpsert) UpdateDurationMinutes() *ExerciseLogUpsert {
+	u.SetExcluded(exerciselog.FieldDurationMinutes)
+	return u
+}
+
+// AddDurationMinutes adds v to the "duration_minutes" field.
+func (u *ExerciseLogUpsert) AddDurationMinutes(v int) *ExerciseLogUpsert {
+	u.Add(exerciselog.FieldDurationMinutes, v)
+	return u
+}
+
+// ClearDurationMinutes clears the value of the "duration_minutes" field.
+func (u *ExerciseLogUpsert) ClearDurationMinutes() *ExerciseLogUpsert {
+	u.SetNull(exerciselog.FieldDurationMinutes)
+	return u
+}
+
+// SetNotes sets the "notes" field.
+func (u *ExerciseLogUpsert) SetNotes(v string) *ExerciseLogUpsert {
+	u.Set(exerciselog.FieldNotes, v)
+	return u
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *ExerciseLogUpsert) UpdateNotes() *ExerciseLogUpsert {
+	u.SetExcluded(exerciselog.FieldNotes)
+	return u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *ExerciseLogUpsert) ClearNotes() *ExerciseLogUpsert {
+	u.SetNull(exerciselog.FieldNotes)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ExerciseLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(exerciselog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExerciseLogUpsertOne) UpdateNewValues() *ExerciseLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(exerciselog.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(exerciselog.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExerciseLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ExerciseLogUpsertOne) Ignore() *ExerciseLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExerciseLogUpsertOne) DoNothing() *ExerciseLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExerciseLogCreate.OnConflict
+// documentation for more info.
+func (u *ExerciseLogUpsertOne) Update(set func(*ExerciseLogUpsert)) *ExerciseLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExerciseLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExerciseLogUpsertOne) SetUpdatedAt(v time.Time) *ExerciseLogUpsertOne {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExerciseLogUpsertOne) UpdateUpdatedAt() *ExerciseLogUpsertOne {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAssignmentID sets the "assignment_id" field.
+func (u *ExerciseLogUpsertOne) SetAssignmentID(v uuid.UUID) *ExerciseLogUpsertOne {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.SetAssignmentID(v)
+	})
+}
+
+// UpdateAssignmentID sets the "assignment_id" field to the value that was provided on create.
+func (u *ExerciseLogUpsertOne) UpdateAssignmentID() *ExerciseLogUpsertOne {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.UpdateAssignmentID()
+	})
+}
+
+// SetEntryID sets the "entry_id" field.
+func (u *ExerciseLogUpsertOne) SetEntryID(v uuid.UUID) *ExerciseLogUpsertOne {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.SetEntryID(v)
+	})
+}
+
+// UpdateEntryID sets the "entry_id" field to the value that was provided on create.
+func (u *ExerciseLogUpsertOne) UpdateEntryID() *ExerciseLogUpsertOne {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.UpdateEntryID()
+	})
+}
+
+// ClearEntryID clears the value of the "entry_id" field.
+func (u *ExerciseLogUpsertOne) ClearEntryID() *ExerciseLogUpsertOne {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.ClearEntryID()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ExerciseLogUpsertOne) SetCompletedAt(v time.Time) *ExerciseLogUpsertOne {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ExerciseLogUpsertOne) UpdateCompletedAt() *ExerciseLogUpsertOne {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (u *ExerciseLogUpsertOne) SetDurationMinutes(v int) *ExerciseLogUpsertOne {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.SetDurationMinutes(v)
+	})
+}
+
+// AddDurationMinutes adds v to the "duration_minutes" field.
+func (u *ExerciseLogUpsertOne) AddDurationMinutes(v int) *ExerciseLogUpsertOne {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.AddDurationMinutes(v)
+	})
+}
+
+// UpdateDurationMinutes sets the "duration_minutes" field to the value that was provided on create.
+func (u *ExerciseLogUpsertOne) UpdateDurationMinutes() *ExerciseLogUpsertOne {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.UpdateDurationMinutes()
+	})
+}
+
+// ClearDurationMinutes clears the value of the "duration_minutes" field.
+func (u *ExerciseLogUpsertOne) ClearDurationMinutes() *ExerciseLogUpsertOne {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.ClearDurationMinutes()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *ExerciseLogUpsertOne) SetNotes(v string) *ExerciseLogUpsertOne {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *ExerciseLogUpsertOne) UpdateNotes() *ExerciseLogUpsertOne {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *ExerciseLogUpsertOne) ClearNotes() *ExerciseLogUpsertOne {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// Exec executes the query.
+func (u *ExerciseLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExerciseLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExerciseLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ExerciseLogUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ExerciseLogUpsertOne.ID is not supported by MySQL driver. Use ExerciseLogUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ExerciseLogUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ExerciseLogCreateBulk is the builder for creating many ExerciseLog entities in bulk.
+type ExerciseLogCreateBulk struct {
+	config
+	err      error
+	builders []*ExerciseLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ExerciseLog entities in the database.
+func (_c *ExerciseLogCreateBulk) Save(ctx context.Context) ([]*ExerciseLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ExerciseLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExerciseLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ExerciseLogCreateBulk) SaveX(ctx context.Context) []*ExerciseLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ExerciseLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ExerciseLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ExerciseLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ExerciseLogUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ExerciseLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *ExerciseLogUpsertBulk {
+	_c.conflict = opts
+	return &ExerciseLogUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ExerciseLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ExerciseLogCreateBulk) OnConflictColumns(columns ...string) *ExerciseLogUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ExerciseLogUpsertBulk{
+		create: _c,
+	}
+}
+
+// ExerciseLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of ExerciseLog nodes.
+type ExerciseLogUpsertBulk struct {
+	create *ExerciseLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ExerciseLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(exerciselog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ExerciseLogUpsertBulk) UpdateNewValues() *ExerciseLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(exerciselog.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(exerciselog.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ExerciseLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ExerciseLogUpsertBulk) Ignore() *ExerciseLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ExerciseLogUpsertBulk) DoNothing() *ExerciseLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ExerciseLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *ExerciseLogUpsertBulk) Update(set func(*ExerciseLogUpsert)) *ExerciseLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ExerciseLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ExerciseLogUpsertBulk) SetUpdatedAt(v time.Time) *ExerciseLogUpsertBulk {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ExerciseLogUpsertBulk) UpdateUpdatedAt() *ExerciseLogUpsertBulk {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetAssignmentID sets the "assignment_id" field.
+func (u *ExerciseLogUpsertBulk) SetAssignmentID(v uuid.UUID) *ExerciseLogUpsertBulk {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.SetAssignmentID(v)
+	})
+}
+
+// UpdateAssignmentID sets the "assignment_id" field to the value that was provided on create.
+func (u *ExerciseLogUpsertBulk) UpdateAssignmentID() *ExerciseLogUpsertBulk {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.UpdateAssignmentID()
+	})
+}
+
+// SetEntryID sets the "entry_id" field.
+func (u *ExerciseLogUpsertBulk) SetEntryID(v uuid.UUID) *ExerciseLogUpsertBulk {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.SetEntryID(v)
+	})
+}
+
+// UpdateEntryID sets the "entry_id" field to the value that was provided on create.
+func (u *ExerciseLogUpsertBulk) UpdateEntryID() *ExerciseLogUpsertBulk {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.UpdateEntryID()
+	})
+}
+
+// ClearEntryID clears the value of the "entry_id" field.
+func (u *ExerciseLogUpsertBulk) ClearEntryID() *ExerciseLogUpsertBulk {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.ClearEntryID()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *ExerciseLogUpsertBulk) SetCompletedAt(v time.Time) *ExerciseLogUpsertBulk {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *ExerciseLogUpsertBulk) UpdateCompletedAt() *ExerciseLogUpsertBulk {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (u *ExerciseLogUpsertBulk) SetDurationMinutes(v int) *ExerciseLogUpsertBulk {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.SetDurationMinutes(v)
+	})
+}
+
+// AddDurationMinutes adds v to the "duration_minutes" field.
+func (u *ExerciseLogUpsertBulk) AddDurationMinutes(v int) *ExerciseLogUpsertBulk {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.AddDurationMinutes(v)
+	})
+}
+
+// UpdateDurationMinutes sets the "duration_minutes" field to the value that was provided on create.
+func (u *ExerciseLogUpsertBulk) UpdateDurationMinutes() *ExerciseLogUpsertBulk {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.UpdateDurationMinutes()
+	})
+}
+
+// ClearDurationMinutes clears the value of the "duration_minutes" field.
+func (u *ExerciseLogUpsertBulk) ClearDurationMinutes() *ExerciseLogUpsertBulk {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.ClearDurationMinutes()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *ExerciseLogUpsertBulk) SetNotes(v string) *ExerciseLogUpsertBulk {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *ExerciseLogUpsertBulk) UpdateNotes() *ExerciseLogUpsertBulk {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *ExerciseLogUpsertBulk) ClearNotes() *ExerciseLogUpsertBulk {
+	return u.Update(func(s *ExerciseLogUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// Exec executes the query.
+func (u *ExerciseLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ExerciseLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ExerciseLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ExerciseLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
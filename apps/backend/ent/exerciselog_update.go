@@ -0,0 +1,623 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/assignment"
+	"backend/ent/entry"
+	"backend/ent/exerciselog"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ExerciseLogUpdate is the builder for updating ExerciseLog entities.
+type ExerciseLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExerciseLogMutation
+}
+
+// Where appends a list predicates to the ExerciseLogUpdate builder.
+func (_u *ExerciseLogUpdate) Where(ps ...predicate.ExerciseLog) *ExerciseLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ExerciseLogUpdate) SetUpdatedAt(v time.Time) *ExerciseLogUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAssignmentID sets the "assignment_id" field.
+func (_u *ExerciseLogUpdate) SetAssignmentID(v uuid.UUID) *ExerciseLogUpdate {
+	_u.mutation.SetAssignmentID(v)
+	return _u
+}
+
+// SetNillableAssignmentID sets the "assignment_id" field if the given value is not nil.
+func (_u *ExerciseLogUpdate) SetNillableAssignmentID(v *uuid.UUID) *ExerciseLogUpdate {
+	if v != nil {
+		_u.SetAssignmentID(*v)
+	}
+	return _u
+}
+
+// SetEntryID sets the "entry_id" field.
+func (_u *ExerciseLogUpdate) SetEntryID(v uuid.UUID) *ExerciseLogUpdate {
+	_u.mutation.SetEntryID(v)
+	return _u
+}
+
+// SetNillableEntryID sets the "entry_id" field if the given value is not nil.
+func (_u *ExerciseLogUpdate) SetNillableEntryID(v *uuid.UUID) *ExerciseLogUpdate {
+	if v != nil {
+		_u.SetEntryID(*v)
+	}
+	return _u
+}
+
+// ClearEntryID clears the value of the "entry_id" field.
+func (_u *ExerciseLogUpdate) ClearEntryID() *ExerciseLogUpdate {
+	_u.mutation.ClearEntryID()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ExerciseLogUpdate) SetCompletedAt(v time.Time) *ExerciseLogUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ExerciseLogUpdate) SetNillableCompletedAt(v *time.Time) *ExerciseLogUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (_u *ExerciseLogUpdate) SetDurationMinutes(v int) *ExerciseLogUpdate {
+	_u.mutation.ResetDurationMinutes()
+	_u.mutation.SetDurationMinutes(v)
+	return _u
+}
+
+// SetNillableDurationMinutes sets the "duration_minutes" field if the given value is not nil.
+func (_u *ExerciseLogUpdate) SetNillableDurationMinutes(v *int) *ExerciseLogUpdate {
+	if v != nil {
+		_u.SetDurationMinutes(*v)
+	}
+	return _u
+}
+
+// AddDurationMinutes adds value to the "duration_minutes" field.
+func (_u *ExerciseLogUpdate) AddDurationMinutes(v int) *ExerciseLogUpdate {
+	_u.mutation.AddDurationMinutes(v)
+	return _u
+}
+
+// ClearDurationMinutes clears the value of the "duration_minutes" field.
+func (_u *ExerciseLogUpdate) ClearDurationMinutes() *ExerciseLogUpdate {
+	_u.mutation.ClearDurationMinutes()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *ExerciseLogUpdate) SetNotes(v string) *ExerciseLogUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *ExerciseLogUpdate) SetNillableNotes(v *string) *ExerciseLogUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *ExerciseLogUpdate) ClearNotes() *ExerciseLogUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetAssignment sets the "assignment" edge to the Assignment entity.
+func (_u *ExerciseLogUpdate) SetAssignment(v *Assignment) *ExerciseLogUpdate {
+	return _u.SetAssignmentID(v.ID)
+}
+
+// SetEntry sets the "entry" edge to the Entry entity.
+func (_u *ExerciseLogUpdate) SetEntry(v *Entry) *ExerciseLogUpdate {
+	return _u.SetEntryID(v.ID)
+}
+
+// Mutation returns the ExerciseLogMutation object of the builder.
+func (_u *ExerciseLogUpdate) Mutation() *ExerciseLogMutation {
+	return _u.mutation
+}
+
+// ClearAssignment clears the "assignment" edge to the Assignment entity.
+func (_u *ExerciseLogUpdate) ClearAssignment() *ExerciseLogUpdate {
+	_u.mutation.ClearAssignment()
+	return _u
+}
+
+// ClearEntry clears the "entry" edge to the Entry entity.
+func (_u *ExerciseLogUpdate) ClearEntry() *ExerciseLogUpdate {
+	_u.mutation.ClearEntry()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ExerciseLogUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExerciseLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ExerciseLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExerciseLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExerciseLogUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := exerciselog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExerciseLogUpdate) check() error {
+	if _u.mutation.AssignmentCleared() && len(_u.mutation.AssignmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExerciseLog.assignment"`)
+	}
+	return nil
+}
+
+func (_u *ExerciseLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exerciselog.Table, exerciselog.Columns, sqlgraph.NewFieldSpec(exerciselog.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(exerciselog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(exerciselog.FieldCompletedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DurationMinutes(); ok {
+		_spec.SetField(exerciselog.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMinutes(); ok {
+		_spec.AddField(exerciselog.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.DurationMinutesCleared() {
+		_spec.ClearField(exerciselog.FieldDurationMinutes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(exerciselog.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(exerciselog.FieldNotes, field.TypeString)
+	}
+	if _u.mutation.AssignmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exerciselog.AssignmentTable,
+			Columns: []string{exerciselog.AssignmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssignmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exerciselog.AssignmentTable,
+			Columns: []string{exerciselog.AssignmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EntryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exerciselog.EntryTable,
+			Columns: []string{exerciselog.EntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exerciselog.EntryTable,
+			Columns: []string{exerciselog.EntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exerciselog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ExerciseLogUpdateOne is the builder for updating a single ExerciseLog entity.
+type ExerciseLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExerciseLogMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ExerciseLogUpdateOne) SetUpdatedAt(v time.Time) *ExerciseLogUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAssignmentID sets the "assignment_id" field.
+func (_u *ExerciseLogUpdateOne) SetAssignmentID(v uuid.UUID) *ExerciseLogUpdateOne {
+	_u.mutation.SetAssignmentID(v)
+	return _u
+}
+
+// SetNillableAssignmentID sets the "assignment_id" field if the given value is not nil.
+func (_u *ExerciseLogUpdateOne) SetNillableAssignmentID(v *uuid.UUID) *ExerciseLogUpdateOne {
+	if v != nil {
+		_u.SetAssignmentID(*v)
+	}
+	return _u
+}
+
+// SetEntryID sets the "entry_id" field.
+func (_u *ExerciseLogUpdateOne) SetEntryID(v uuid.UUID) *ExerciseLogUpdateOne {
+	_u.mutation.SetEntryID(v)
+	return _u
+}
+
+// SetNillableEntryID sets the "entry_id" field if the given value is not nil.
+func (_u *ExerciseLogUpdateOne) SetNillableEntryID(v *uuid.UUID) *ExerciseLogUpdateOne {
+	if v != nil {
+		_u.SetEntryID(*v)
+	}
+	return _u
+}
+
+// ClearEntryID clears the value of the "entry_id" field.
+func (_u *ExerciseLogUpdateOne) ClearEntryID() *ExerciseLogUpdateOne {
+	_u.mutation.ClearEntryID()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *ExerciseLogUpdateOne) SetCompletedAt(v time.Time) *ExerciseLogUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *ExerciseLogUpdateOne) SetNillableCompletedAt(v *time.Time) *ExerciseLogUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (_u *ExerciseLogUpdateOne) SetDurationMinutes(v int) *ExerciseLogUpdateOne {
+	_u.mutation.ResetDurationMinutes()
+	_u.mutation.SetDurationMinutes(v)
+	return _u
+}
+
+// SetNillableDurationMinutes sets the "duration_minutes" field if the given value is not nil.
+func (_u *ExerciseLogUpdateOne) SetNillableDurationMinutes(v *int) *ExerciseLogUpdateOne {
+	if v != nil {
+		_u.SetDurationMinutes(*v)
+	}
+	return _u
+}
+
+// AddDurationMinutes adds value to the "duration_minutes" field.
+func (_u *ExerciseLogUpdateOne) AddDurationMinutes(v int) *ExerciseLogUpdateOne {
+	_u.mutation.AddDurationMinutes(v)
+	return _u
+}
+
+// ClearDurationMinutes clears the value of the "duration_minutes" field.
+func (_u *ExerciseLogUpdateOne) ClearDurationMinutes() *ExerciseLogUpdateOne {
+	_u.mutation.ClearDurationMinutes()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *ExerciseLogUpdateOne) SetNotes(v string) *ExerciseLogUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *ExerciseLogUpdateOne) SetNillableNotes(v *string) *ExerciseLogUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *ExerciseLogUpdateOne) ClearNotes() *ExerciseLogUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetAssignment sets the "assignment" edge to the Assignment entity.
+func (_u *ExerciseLogUpdateOne) SetAssignment(v *Assignment) *ExerciseLogUpdateOne {
+	return _u.SetAssignmentID(v.ID)
+}
+
+// SetEntry sets the "entry" edge to the Entry entity.
+func (_u *ExerciseLogUpdateOne) SetEntry(v *Entry) *ExerciseLogUpdateOne {
+	return _u.SetEntryID(v.ID)
+}
+
+// Mutation returns the ExerciseLogMutation object of the builder.
+func (_u *ExerciseLogUpdateOne) Mutation() *ExerciseLogMutation {
+	return _u.mutation
+}
+
+// ClearAssignment clears the "assignment" edge to the Assignment entity.
+func (_u *ExerciseLogUpdateOne) ClearAssignment() *ExerciseLogUpdateOne {
+	_u.mutation.ClearAssignment()
+	return _u
+}
+
+// ClearEntry clears the "entry" edge to the Entry entity.
+func (_u *ExerciseLogUpdateOne) ClearEntry() *ExerciseLogUpdateOne {
+	_u.mutation.ClearEntry()
+	return _u
+}
+
+// Where appends a list predicates to the ExerciseLogUpdate builder.
+func (_u *ExerciseLogUpdateOne) Where(ps ...predicate.ExerciseLog) *ExerciseLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ExerciseLogUpdateOne) Select(field string, fields ...string) *ExerciseLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ExerciseLog entity.
+func (_u *ExerciseLogUpdateOne) Save(ctx context.Context) (*ExerciseLog, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ExerciseLogUpdateOne) SaveX(ctx context.Context) *ExerciseLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ExerciseLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ExerciseLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ExerciseLogUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := exerciselog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ExerciseLogUpdateOne) check() error {
+	if _u.mutation.AssignmentCleared() && len(_u.mutation.AssignmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ExerciseLog.assignment"`)
+	}
+	return nil
+}
+
+func (_u *ExerciseLogUpdateOne) sqlSave(ctx context.Context) (_node *ExerciseLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(exerciselog.Table, exerciselog.Columns, sqlgraph.NewFieldSpec(exerciselog.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExerciseLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, exerciselog.FieldID)
+		for _, f := range fields {
+			if !exerciselog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != exerciselog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(exerciselog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(exerciselog.FieldCompletedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DurationMinutes(); ok {
+		_spec.SetField(exerciselog.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMinutes(); ok {
+		_spec.AddField(exerciselog.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.DurationMinutesCleared() {
+		_spec.ClearField(exerciselog.FieldDurationMinutes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(exerciselog.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(exerciselog.FieldNotes, field.TypeString)
+	}
+	if _u.mutation.AssignmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exerciselog.AssignmentTable,
+			Columns: []string{exerciselog.AssignmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssignmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exerciselog.AssignmentTable,
+			Columns: []string{exerciselog.AssignmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EntryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exerciselog.EntryTable,
+			Columns: []string{exerciselog.EntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EntryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   exerciselog.EntryTable,
+			Columns: []string{exerciselog.EntryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ExerciseLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{exerciselog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
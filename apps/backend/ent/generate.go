@@ -1,3 +1,3 @@
 package ent
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ./schema --feature sql/versioned-migration
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ./schema --feature sql/versioned-migration,sql/execquery,sql/upsert
@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/goal"
+	"backend/ent/patient"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Goal is the model entity for the Goal schema.
+type Goal struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// PatientID holds the value of the "patient_id" field.
+	PatientID uuid.UUID `json:"patient_id,omitempty"`
+	// DoctorID holds the value of the "doctor_id" field.
+	DoctorID uuid.UUID `json:"doctor_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// Techniques holds the value of the "techniques" field.
+	Techniques []string `json:"techniques,omitempty"`
+	// Triggers holds the value of the "triggers" field.
+	Triggers []string `json:"triggers,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags []string `json:"tags,omitempty"`
+	// TargetCount holds the value of the "target_count" field.
+	TargetCount int `json:"target_count,omitempty"`
+	// Period holds the value of the "period" field.
+	Period goal.Period `json:"period,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	EndsAt *time.Time `json:"ends_at,omitempty"`
+	// Status holds the value of the "status" field.
+	Status goal.Status `json:"status,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the GoalQuery when eager-loading is set.
+	Edges        GoalEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// GoalEdges holds the relations/edges for other nodes in the graph.
+type GoalEdges struct {
+	// Patient holds the value of the patient edge.
+	Patient *Patient `json:"patient,omitempty"`
+	// Doctor holds the value of the doctor edge.
+	Doctor *Doctor `json:"doctor,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PatientOrErr returns the Patient value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoalEdges) PatientOrErr() (*Patient, error) {
+	if e.Patient != nil {
+		return e.Patient, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: patient.Label}
+	}
+	return nil, &NotLoadedError{edge: "patient"}
+}
+
+// DoctorOrErr returns the Doctor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GoalEdges) DoctorOrErr() (*Doctor, error) {
+	if e.Doctor != nil {
+		return e.Doctor, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: doctor.Label}
+	}
+	return nil, &NotLoadedError{edge: "doctor"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Goal) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case goal.FieldTechniques, goal.FieldTriggers, goal.FieldTags:
+			values[i] = new([]byte)
+		case goal.FieldTargetCount:
+			values[i] = new(sql.NullInt64)
+		case goal.FieldTitle, goal.FieldDescription, goal.FieldPeriod, goal.FieldStatus:
+			values[i] = new(sql.NullString)
+		case goal.FieldCreatedAt, goal.FieldUpdatedAt, goal.FieldStartsAt, goal.FieldEndsAt:
+			values[i] = new(sql.NullTime)
+		case goal.FieldID, goal.FieldPatientID, goal.FieldDoctorID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Goal fields.
+func (_m *Goal) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case goal.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case goal.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case goal.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case goal.FieldPatientID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field patient_id", values[i])
+			} else if value != nil {
+				_m.PatientID = *value
+			}
+		case goal.FieldDoctorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field doctor_id", values[i])
+			} else if value != nil {
+				_m.DoctorID = *value
+			}
+		case goal.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case goal.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		case goal.FieldTechniques:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field techniques", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Techniques); err != nil {
+					return fmt.Errorf("unmarshal field techniques: %w", err)
+				}
+			}
+		case goal.FieldTriggers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field triggers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Triggers); err != nil {
+					return fmt.Errorf("unmarshal field triggers: %w", err)
+				}
+			}
+		case goal.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case goal.FieldTargetCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_count", values[i])
+			} else if value.Valid {
+				_m.TargetCount = int(value.Int64)
+			}
+		case goal.FieldPeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field period", values[i])
+			} else if value.Valid {
+				_m.Period = goal.Period(value.String)
+			}
+		case goal.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				_m.StartsAt = value.Time
+			}
+		case goal.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				_m.EndsAt = new(time.Time)
+				*_m.EndsAt = value.Time
+			}
+		case goal.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = goal.Status(value.String)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Goal.
+// This includes values selected through modifiers, order, etc.
+func (_m *Goal) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPatient queries the "patient" edge of the Goal entity.
+func (_m *Goal) QueryPatient() *PatientQuery {
+	return NewGoalClient(_m.config).QueryPatient(_m)
+}
+
+// QueryDoctor queries the "doctor" edge of the Goal entity.
+func (_m *Goal) QueryDoctor() *DoctorQuery {
+	return NewGoalClient(_m.config).QueryDoctor(_m)
+}
+
+// Update returns a builder for updating this Goal.
+// Note that you need to call Goal.Unwrap() before calling this method if this Goal
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Goal) Update() *GoalUpdateOne {
+	return NewGoalClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Goal entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Goal) Unwrap() *Goal {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Goal is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Goal) String() string {
+	var builder strings.Builder
+	builder.WriteString("Goal(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("patient_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PatientID))
+	builder.WriteString(", ")
+	builder.WriteString("doctor_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DoctorID))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("techniques=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Techniques))
+	builder.WriteString(", ")
+	builder.WriteString("triggers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Triggers))
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
+	builder.WriteString(", ")
+	builder.WriteString("target_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetCount))
+	builder.WriteString(", ")
+	builder.WriteString("period=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Period))
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(_m.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.EndsAt; v != nil {
+		builder.WriteString("ends_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Goals is a parsable slice of Goal.
+type Goals []*Goal
@@ -0,0 +1,259 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goal
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the goal type in the database.
+	Label = "goal"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldPatientID holds the string denoting the patient_id field in the database.
+	FieldPatientID = "patient_id"
+	// FieldDoctorID holds the string denoting the doctor_id field in the database.
+	FieldDoctorID = "doctor_id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldTechniques holds the string denoting the techniques field in the database.
+	FieldTechniques = "techniques"
+	// FieldTriggers holds the string denoting the triggers field in the database.
+	FieldTriggers = "triggers"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
+	// FieldTargetCount holds the string denoting the target_count field in the database.
+	FieldTargetCount = "target_count"
+	// FieldPeriod holds the string denoting the period field in the database.
+	FieldPeriod = "period"
+	// FieldStartsAt holds the string denoting the starts_at field in the database.
+	FieldStartsAt = "starts_at"
+	// FieldEndsAt holds the string denoting the ends_at field in the database.
+	FieldEndsAt = "ends_at"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// EdgePatient holds the string denoting the patient edge name in mutations.
+	EdgePatient = "patient"
+	// EdgeDoctor holds the string denoting the doctor edge name in mutations.
+	EdgeDoctor = "doctor"
+	// Table holds the table name of the goal in the database.
+	Table = "goals"
+	// PatientTable is the table that holds the patient relation/edge.
+	PatientTable = "goals"
+	// PatientInverseTable is the table name for the Patient entity.
+	// It exists in this package in order to avoid circular dependency with the "patient" package.
+	PatientInverseTable = "patients"
+	// PatientColumn is the table column denoting the patient relation/edge.
+	PatientColumn = "patient_id"
+	// DoctorTable is the table that holds the doctor relation/edge.
+	DoctorTable = "goals"
+	// DoctorInverseTable is the table name for the Doctor entity.
+	// It exists in this package in order to avoid circular dependency with the "doctor" package.
+	DoctorInverseTable = "doctors"
+	// DoctorColumn is the table column denoting the doctor relation/edge.
+	DoctorColumn = "doctor_id"
+)
+
+// Columns holds all SQL columns for goal fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldPatientID,
+	FieldDoctorID,
+	FieldTitle,
+	FieldDescription,
+	FieldTechniques,
+	FieldTriggers,
+	FieldTags,
+	FieldTargetCount,
+	FieldPeriod,
+	FieldStartsAt,
+	FieldEndsAt,
+	FieldStatus,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+	// TargetCountValidator is a validator for the "target_count" field. It is called by the builders before save.
+	TargetCountValidator func(int) error
+	// DefaultStartsAt holds the default value on creation for the "starts_at" field.
+	DefaultStartsAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Period defines the type for the "period" enum field.
+type Period string
+
+// PeriodWeek is the default value of the Period enum.
+const DefaultPeriod = PeriodWeek
+
+// Period values.
+const (
+	PeriodDay   Period = "Day"
+	PeriodWeek  Period = "Week"
+	PeriodMonth Period = "Month"
+	PeriodTotal Period = "Total"
+)
+
+func (pe Period) String() string {
+	return string(pe)
+}
+
+// PeriodValidator is a validator for the "period" field enum values. It is called by the builders before save.
+func PeriodValidator(pe Period) error {
+	switch pe {
+	case PeriodDay, PeriodWeek, PeriodMonth, PeriodTotal:
+		return nil
+	default:
+		return fmt.Errorf("goal: invalid enum value for period field: %q", pe)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusActive is the default value of the Status enum.
+const DefaultStatus = StatusActive
+
+// Status values.
+const (
+	StatusActive    Status = "Active"
+	StatusCompleted Status = "Completed"
+	StatusCancelled Status = "Cancelled"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusActive, StatusCompleted, StatusCancelled:
+		return nil
+	default:
+		return fmt.Errorf("goal: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Goal queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByPatientID orders the results by the patient_id field.
+func ByPatientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPatientID, opts...).ToFunc()
+}
+
+// ByDoctorID orders the results by the doctor_id field.
+func ByDoctorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDoctorID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByTargetCount orders the results by the target_count field.
+func ByTargetCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetCount, opts...).ToFunc()
+}
+
+// ByPeriod orders the results by the period field.
+func ByPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriod, opts...).ToFunc()
+}
+
+// ByStartsAt orders the results by the starts_at field.
+func ByStartsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartsAt, opts...).ToFunc()
+}
+
+// ByEndsAt orders the results by the ends_at field.
+func ByEndsAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndsAt, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByPatientField orders the results by patient field.
+func ByPatientField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPatientStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDoctorField orders the results by doctor field.
+func ByDoctorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDoctorStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPatientStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PatientInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PatientTable, PatientColumn),
+	)
+}
+func newDoctorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DoctorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DoctorTable, DoctorColumn),
+	)
+}
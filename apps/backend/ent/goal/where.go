@@ -0,0 +1,623 @@
+// Code generated by ent, DO NOT EDIT.
+
+package goal
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PatientID applies equality check predicate on the "patient_id" field. It's identical to PatientIDEQ.
+func PatientID(v uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldPatientID, v))
+}
+
+// DoctorID applies equality check predicate on the "doctor_id" field. It's identical to DoctorIDEQ.
+func DoctorID(v uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldDoctorID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldDescription, v))
+}
+
+// TargetCount applies equality check predicate on the "target_count" field. It's identical to TargetCountEQ.
+func TargetCount(v int) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldTargetCount, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// EndsAt applies equality check predicate on the "ends_at" field. It's identical to EndsAtEQ.
+func EndsAt(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// PatientIDEQ applies the EQ predicate on the "patient_id" field.
+func PatientIDEQ(v uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldPatientID, v))
+}
+
+// PatientIDNEQ applies the NEQ predicate on the "patient_id" field.
+func PatientIDNEQ(v uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldPatientID, v))
+}
+
+// PatientIDIn applies the In predicate on the "patient_id" field.
+func PatientIDIn(vs ...uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldPatientID, vs...))
+}
+
+// PatientIDNotIn applies the NotIn predicate on the "patient_id" field.
+func PatientIDNotIn(vs ...uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldPatientID, vs...))
+}
+
+// DoctorIDEQ applies the EQ predicate on the "doctor_id" field.
+func DoctorIDEQ(v uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldDoctorID, v))
+}
+
+// DoctorIDNEQ applies the NEQ predicate on the "doctor_id" field.
+func DoctorIDNEQ(v uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldDoctorID, v))
+}
+
+// DoctorIDIn applies the In predicate on the "doctor_id" field.
+func DoctorIDIn(vs ...uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldDoctorID, vs...))
+}
+
+// DoctorIDNotIn applies the NotIn predicate on the "doctor_id" field.
+func DoctorIDNotIn(vs ...uuid.UUID) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldDoctorID, vs...))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Goal {
+	return predicate.Goal(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Goal {
+	return predicate.Goal(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Goal {
+	return predicate.Goal(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// TechniquesIsNil applies the IsNil predicate on the "techniques" field.
+func TechniquesIsNil() predicate.Goal {
+	return predicate.Goal(sql.FieldIsNull(FieldTechniques))
+}
+
+// TechniquesNotNil applies the NotNil predicate on the "techniques" field.
+func TechniquesNotNil() predicate.Goal {
+	return predicate.Goal(sql.FieldNotNull(FieldTechniques))
+}
+
+// TriggersIsNil applies the IsNil predicate on the "triggers" field.
+func TriggersIsNil() predicate.Goal {
+	return predicate.Goal(sql.FieldIsNull(FieldTriggers))
+}
+
+// TriggersNotNil applies the NotNil predicate on the "triggers" field.
+func TriggersNotNil() predicate.Goal {
+	return predicate.Goal(sql.FieldNotNull(FieldTriggers))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.Goal {
+	return predicate.Goal(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.Goal {
+	return predicate.Goal(sql.FieldNotNull(FieldTags))
+}
+
+// TargetCountEQ applies the EQ predicate on the "target_count" field.
+func TargetCountEQ(v int) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldTargetCount, v))
+}
+
+// TargetCountNEQ applies the NEQ predicate on the "target_count" field.
+func TargetCountNEQ(v int) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldTargetCount, v))
+}
+
+// TargetCountIn applies the In predicate on the "target_count" field.
+func TargetCountIn(vs ...int) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldTargetCount, vs...))
+}
+
+// TargetCountNotIn applies the NotIn predicate on the "target_count" field.
+func TargetCountNotIn(vs ...int) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldTargetCount, vs...))
+}
+
+// TargetCountGT applies the GT predicate on the "target_count" field.
+func TargetCountGT(v int) predicate.Goal {
+	return predicate.Goal(sql.FieldGT(FieldTargetCount, v))
+}
+
+// TargetCountGTE applies the GTE predicate on the "target_count" field.
+func TargetCountGTE(v int) predicate.Goal {
+	return predicate.Goal(sql.FieldGTE(FieldTargetCount, v))
+}
+
+// TargetCountLT applies the LT predicate on the "target_count" field.
+func TargetCountLT(v int) predicate.Goal {
+	return predicate.Goal(sql.FieldLT(FieldTargetCount, v))
+}
+
+// TargetCountLTE applies the LTE predicate on the "target_count" field.
+func TargetCountLTE(v int) predicate.Goal {
+	return predicate.Goal(sql.FieldLTE(FieldTargetCount, v))
+}
+
+// PeriodEQ applies the EQ predicate on the "period" field.
+func PeriodEQ(v Period) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldPeriod, v))
+}
+
+// PeriodNEQ applies the NEQ predicate on the "period" field.
+func PeriodNEQ(v Period) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldPeriod, v))
+}
+
+// PeriodIn applies the In predicate on the "period" field.
+func PeriodIn(vs ...Period) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldPeriod, vs...))
+}
+
+// PeriodNotIn applies the NotIn predicate on the "period" field.
+func PeriodNotIn(vs ...Period) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldPeriod, vs...))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// EndsAtEQ applies the EQ predicate on the "ends_at" field.
+func EndsAtEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldEndsAt, v))
+}
+
+// EndsAtNEQ applies the NEQ predicate on the "ends_at" field.
+func EndsAtNEQ(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldEndsAt, v))
+}
+
+// EndsAtIn applies the In predicate on the "ends_at" field.
+func EndsAtIn(vs ...time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldEndsAt, vs...))
+}
+
+// EndsAtNotIn applies the NotIn predicate on the "ends_at" field.
+func EndsAtNotIn(vs ...time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldEndsAt, vs...))
+}
+
+// EndsAtGT applies the GT predicate on the "ends_at" field.
+func EndsAtGT(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldGT(FieldEndsAt, v))
+}
+
+// EndsAtGTE applies the GTE predicate on the "ends_at" field.
+func EndsAtGTE(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldGTE(FieldEndsAt, v))
+}
+
+// EndsAtLT applies the LT predicate on the "ends_at" field.
+func EndsAtLT(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldLT(FieldEndsAt, v))
+}
+
+// EndsAtLTE applies the LTE predicate on the "ends_at" field.
+func EndsAtLTE(v time.Time) predicate.Goal {
+	return predicate.Goal(sql.FieldLTE(FieldEndsAt, v))
+}
+
+// EndsAtIsNil applies the IsNil predicate on the "ends_at" field.
+func EndsAtIsNil() predicate.Goal {
+	return predicate.Goal(sql.FieldIsNull(FieldEndsAt))
+}
+
+// EndsAtNotNil applies the NotNil predicate on the "ends_at" field.
+func EndsAtNotNil() predicate.Goal {
+	return predicate.Goal(sql.FieldNotNull(FieldEndsAt))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Goal {
+	return predicate.Goal(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Goal {
+	return predicate.Goal(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Goal {
+	return predicate.Goal(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Goal {
+	return predicate.Goal(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// HasPatient applies the HasEdge predicate on the "patient" edge.
+func HasPatient() predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PatientTable, PatientColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPatientWith applies the HasEdge predicate on the "patient" edge with a given conditions (other predicates).
+func HasPatientWith(preds ...predicate.Patient) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := newPatientStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDoctor applies the HasEdge predicate on the "doctor" edge.
+func HasDoctor() predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DoctorTable, DoctorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDoctorWith applies the HasEdge predicate on the "doctor" edge with a given conditions (other predicates).
+func HasDoctorWith(preds ...predicate.Doctor) predicate.Goal {
+	return predicate.Goal(func(s *sql.Selector) {
+		step := newDoctorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Goal) predicate.Goal {
+	return predicate.Goal(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Goal) predicate.Goal {
+	return predicate.Goal(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Goal) predicate.Goal {
+	return predicate.Goal(sql.NotPredicates(p))
+}
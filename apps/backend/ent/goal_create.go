@@ -0,0 +1,1409 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/goal"
+	"backend/ent/patient"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GoalCreate is the builder for creating a Goal entity.
+type GoalCreate struct {
+	config
+	mutation *GoalMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GoalCreate) SetCreatedAt(v time.Time) *GoalCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableCreatedAt(v *time.Time) *GoalCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *GoalCreate) SetUpdatedAt(v time.Time) *GoalCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableUpdatedAt(v *time.Time) *GoalCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_c *GoalCreate) SetPatientID(v uuid.UUID) *GoalCreate {
+	_c.mutation.SetPatientID(v)
+	return _c
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (_c *GoalCreate) SetDoctorID(v uuid.UUID) *GoalCreate {
+	_c.mutation.SetDoctorID(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *GoalCreate) SetTitle(v string) *GoalCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *GoalCreate) SetDescription(v string) *GoalCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableDescription(v *string) *GoalCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetTechniques sets the "techniques" field.
+func (_c *GoalCreate) SetTechniques(v []string) *GoalCreate {
+	_c.mutation.SetTechniques(v)
+	return _c
+}
+
+// SetTriggers sets the "triggers" field.
+func (_c *GoalCreate) SetTriggers(v []string) *GoalCreate {
+	_c.mutation.SetTriggers(v)
+	return _c
+}
+
+// SetTags sets the "tags" field.
+func (_c *GoalCreate) SetTags(v []string) *GoalCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetTargetCount sets the "target_count" field.
+func (_c *GoalCreate) SetTargetCount(v int) *GoalCreate {
+	_c.mutation.SetTargetCount(v)
+	return _c
+}
+
+// SetPeriod sets the "period" field.
+func (_c *GoalCreate) SetPeriod(v goal.Period) *GoalCreate {
+	_c.mutation.SetPeriod(v)
+	return _c
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_c *GoalCreate) SetNillablePeriod(v *goal.Period) *GoalCreate {
+	if v != nil {
+		_c.SetPeriod(*v)
+	}
+	return _c
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_c *GoalCreate) SetStartsAt(v time.Time) *GoalCreate {
+	_c.mutation.SetStartsAt(v)
+	return _c
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableStartsAt(v *time.Time) *GoalCreate {
+	if v != nil {
+		_c.SetStartsAt(*v)
+	}
+	return _c
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_c *GoalCreate) SetEndsAt(v time.Time) *GoalCreate {
+	_c.mutation.SetEndsAt(v)
+	return _c
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableEndsAt(v *time.Time) *GoalCreate {
+	if v != nil {
+		_c.SetEndsAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *GoalCreate) SetStatus(v goal.Status) *GoalCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableStatus(v *goal.Status) *GoalCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GoalCreate) SetID(v uuid.UUID) *GoalCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *GoalCreate) SetNillableID(v *uuid.UUID) *GoalCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_c *GoalCreate) SetPatient(v *Patient) *GoalCreate {
+	return _c.SetPatientID(v.ID)
+}
+
+// SetDoctor sets the "doctor" edge to the Doctor entity.
+func (_c *GoalCreate) SetDoctor(v *Doctor) *GoalCreate {
+	return _c.SetDoctorID(v.ID)
+}
+
+// Mutation returns the GoalMutation object of the builder.
+func (_c *GoalCreate) Mutation() *GoalMutation {
+	return _c.mutation
+}
+
+// Save creates the Goal in the database.
+func (_c *GoalCreate) Save(ctx context.Context) (*Goal, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GoalCreate) SaveX(ctx context.Context) *Goal {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoalCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoalCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GoalCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := goal.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := goal.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Period(); !ok {
+		v := goal.DefaultPeriod
+		_c.mutation.SetPeriod(v)
+	}
+	if _, ok := _c.mutation.StartsAt(); !ok {
+		v := goal.DefaultStartsAt()
+		_c.mutation.SetStartsAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := goal.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := goal.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GoalCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Goal.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Goal.updated_at"`)}
+	}
+	if _, ok := _c.mutation.PatientID(); !ok {
+		return &ValidationError{Name: "patient_id", err: errors.New(`ent: missing required field "Goal.patient_id"`)}
+	}
+	if _, ok := _c.mutation.DoctorID(); !ok {
+		return &ValidationError{Name: "doctor_id", err: errors.New(`ent: missing required field "Goal.doctor_id"`)}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Goal.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := goal.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Goal.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TargetCount(); !ok {
+		return &ValidationError{Name: "target_count", err: errors.New(`ent: missing required field "Goal.target_count"`)}
+	}
+	if v, ok := _c.mutation.TargetCount(); ok {
+		if err := goal.TargetCountValidator(v); err != nil {
+			return &ValidationError{Name: "target_count", err: fmt.Errorf(`ent: validator failed for field "Goal.target_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Period(); !ok {
+		return &ValidationError{Name: "period", err: errors.New(`ent: missing required field "Goal.period"`)}
+	}
+	if v, ok := _c.mutation.Period(); ok {
+		if err := goal.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`ent: validator failed for field "Goal.period": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "Goal.starts_at"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Goal.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := goal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Goal.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.PatientIDs()) == 0 {
+		return &ValidationError{Name: "patient", err: errors.New(`ent: missing required edge "Goal.patient"`)}
+	}
+	if len(_c.mutation.DoctorIDs()) == 0 {
+		return &ValidationError{Name: "doctor", err: errors.New(`ent: missing required edge "Goal.doctor"`)}
+	}
+	return nil
+}
+
+func (_c *GoalCreate) sqlSave(ctx context.Context) (*Goal, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GoalCreate) createSpec() (*Goal, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Goal{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(goal.Table, sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(goal.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(goal.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(goal.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(goal.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.Techniques(); ok {
+		_spec.SetField(goal.FieldTechniques, field.TypeJSON, value)
+		_node.Techniques = value
+	}
+	if value, ok := _c.mutation.Triggers(); ok {
+		_spec.SetField(goal.FieldTriggers, field.TypeJSON, value)
+		_node.Triggers = value
+	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(goal.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.TargetCount(); ok {
+		_spec.SetField(goal.FieldTargetCount, field.TypeInt, value)
+		_node.TargetCount = value
+	}
+	if value, ok := _c.mutation.Period(); ok {
+		_spec.SetField(goal.FieldPeriod, field.TypeEnum, value)
+		_node.Period = value
+	}
+	if value, ok := _c.mutation.StartsAt(); ok {
+		_spec.SetField(goal.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = value
+	}
+	if value, ok := _c.mutation.EndsAt(); ok {
+		_spec.SetField(goal.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(goal.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if nodes := _c.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goal.PatientTable,
+			Columns: []string{goal.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PatientID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goal.DoctorTable,
+			Columns: []string{goal.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DoctorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Goal.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoalUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoalCreate) OnConflict(opts ...sql.ConflictOption) *GoalUpsertOne {
+	_c.conflict = opts
+	return &GoalUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoalCreate) OnConflictColumns(columns ...string) *GoalUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoalUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GoalUpsertOne is the builder for "upsert"-ing
+	//  one Goal node.
+	GoalUpsertOne struct {
+		create *GoalCreate
+	}
+
+	// GoalUpsert is the "OnConflict" setter.
+	GoalUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoalUpsert) SetUpdatedAt(v time.Time) *GoalUpsert {
+	u.Set(goal.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateUpdatedAt() *GoalUpsert {
+	u.SetExcluded(goal.FieldUpdatedAt)
+	return u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *GoalUpsert) SetPatientID(v uuid.UUID) *GoalUpsert {
+	u.Set(goal.FieldPatientID, v)
+	return u
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *GoalUpsert) UpdatePatientID() *GoalUpsert {
+	u.SetExcluded(goal.FieldPatientID)
+	return u
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *GoalUpsert) SetDoctorID(v uuid.UUID) *GoalUpsert {
+	u.Set(goal.FieldDoctorID, v)
+	return u
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateDoctorID() *GoalUpsert {
+	u.SetExcluded(goal.FieldDoctorID)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *GoalUpsert) SetTitle(v string) *GoalUpsert {
+	u.Set(goal.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateTitle() *GoalUpsert {
+	u.SetExcluded(goal.FieldTitle)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *GoalUpsert) SetDescription(v string) *GoalUpsert {
+	u.Set(goal.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateDescription() *GoalUpsert {
+	u.SetExcluded(goal.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GoalUpsert) ClearDescription() *GoalUpsert {
+	u.SetNull(goal.FieldDescription)
+	return u
+}
+
+// SetTechniques sets the "techniques" field.
+func (u *GoalUpsert) SetTechniques(v []string) *GoalUpsert {
+	u.Set(goal.FieldTechniques, v)
+	return u
+}
+
+// UpdateTechniques sets the "techniques" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateTechniques() *GoalUpsert {
+	u.SetExcluded(goal.FieldTechniques)
+	return u
+}
+
+// ClearTechniques clears the value of the "techniques" field.
+func (u *GoalUpsert) ClearTechniques() *GoalUpsert {
+	u.SetNull(goal.FieldTechniques)
+	return u
+}
+
+// SetTriggers sets the "triggers" field.
+func (u *GoalUpsert) SetTriggers(v []string) *GoalUpsert {
+	u.Set(goal.FieldTriggers, v)
+	return u
+}
+
+// UpdateTriggers sets the "triggers" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateTriggers() *GoalUpsert {
+	u.SetExcluded(goal.FieldTriggers)
+	return u
+}
+
+// ClearTriggers clears the value of the "triggers" field.
+func (u *GoalUpsert) ClearTriggers() *GoalUpsert {
+	u.SetNull(goal.FieldTriggers)
+	return u
+}
+
+// SetTags sets the "tags" field.
+func (u *GoalUpsert) SetTags(v []string) *GoalUpsert {
+	u.Set(goal.FieldTags, v)
+	return u
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateTags() *GoalUpsert {
+	u.SetExcluded(goal.FieldTags)
+	return u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *GoalUpsert) ClearTags() *GoalUpsert {
+	u.SetNull(goal.FieldTags)
+	return u
+}
+
+// SetTargetCount sets the "target_count" field.
+func (u *GoalUpsert) SetTargetCount(v int) *GoalUpsert {
+	u.Set(goal.FieldTargetCount, v)
+	return u
+}
+
+// UpdateTargetCount sets the "target_count" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateTargetCount() *GoalUpsert {
+	u.SetExcluded(goal.FieldTargetCount)
+	return u
+}
+
+// AddTargetCount adds v to the "target_count" field.
+func (u *GoalUpsert) AddTargetCount(v int) *GoalUpsert {
+	u.Add(goal.FieldTargetCount, v)
+	return u
+}
+
+// SetPeriod sets the "period" field.
+func (u *GoalUpsert) SetPeriod(v goal.Period) *GoalUpsert {
+	u.Set(goal.FieldPeriod, v)
+	return u
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *GoalUpsert) UpdatePeriod() *GoalUpsert {
+	u.SetExcluded(goal.FieldPeriod)
+	return u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *GoalUpsert) SetStartsAt(v time.Time) *GoalUpsert {
+	u.Set(goal.FieldStartsAt, v)
+	return u
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateStartsAt() *GoalUpsert {
+	u.SetExcluded(goal.FieldStartsAt)
+	return u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *GoalUpsert) SetEndsAt(v time.Time) *GoalUpsert {
+	u.Set(goal.FieldEndsAt, v)
+	return u
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateEndsAt() *GoalUpsert {
+	u.SetExcluded(goal.FieldEndsAt)
+	return u
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (u *GoalUpsert) ClearEndsAt() *GoalUpsert {
+	u.SetNull(goal.FieldEndsAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *GoalUpsert) SetStatus(v goal.Status) *GoalUpsert {
+	u.Set(goal.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GoalUpsert) UpdateStatus() *GoalUpsert {
+	u.SetExcluded(goal.FieldStatus)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(goal.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoalUpsertOne) UpdateNewValues() *GoalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(goal.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(goal.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GoalUpsertOne) Ignore() *GoalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoalUpsertOne) DoNothing() *GoalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoalCreate.OnConflict
+// documentation for more info.
+func (u *GoalUpsertOne) Update(set func(*GoalUpsert)) *GoalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoalUpsertOne) SetUpdatedAt(v time.Time) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateUpdatedAt() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *GoalUpsertOne) SetPatientID(v uuid.UUID) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdatePatientID() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *GoalUpsertOne) SetDoctorID(v uuid.UUID) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetDoctorID(v)
+	})
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateDoctorID() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateDoctorID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *GoalUpsertOne) SetTitle(v string) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateTitle() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *GoalUpsertOne) SetDescription(v string) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateDescription() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GoalUpsertOne) ClearDescription() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetTechniques sets the "techniques" field.
+func (u *GoalUpsertOne) SetTechniques(v []string) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTechniques(v)
+	})
+}
+
+// UpdateTechniques sets the "techniques" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateTechniques() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTechniques()
+	})
+}
+
+// ClearTechniques clears the value of the "techniques" field.
+func (u *GoalUpsertOne) ClearTechniques() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearTechniques()
+	})
+}
+
+// SetTriggers sets the "triggers" field.
+func (u *GoalUpsertOne) SetTriggers(v []string) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTriggers(v)
+	})
+}
+
+// UpdateTriggers sets the "triggers" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateTriggers() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTriggers()
+	})
+}
+
+// ClearTriggers clears the value of the "triggers" field.
+func (u *GoalUpsertOne) ClearTriggers() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearTriggers()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *GoalUpsertOne) SetTags(v []string) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateTags() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *GoalUpsertOne) ClearTags() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetTargetCount sets the "target_count" field.
+func (u *GoalUpsertOne) SetTargetCount(v int) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTargetCount(v)
+	})
+}
+
+// AddTargetCount adds v to the "target_count" field.
+func (u *GoalUpsertOne) AddTargetCount(v int) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.AddTargetCount(v)
+	})
+}
+
+// UpdateTargetCount sets the "target_count" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateTargetCount() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTargetCount()
+	})
+}
+
+// SetPeriod sets the "period" field.
+func (u *GoalUpsertOne) SetPeriod(v goal.Period) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetPeriod(v)
+	})
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdatePeriod() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdatePeriod()
+	})
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *GoalUpsertOne) SetStartsAt(v time.Time) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateStartsAt() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *GoalUpsertOne) SetEndsAt(v time.Time) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetEndsAt(v)
+	})
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateEndsAt() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateEndsAt()
+	})
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (u *GoalUpsertOne) ClearEndsAt() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearEndsAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *GoalUpsertOne) SetStatus(v goal.Status) *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GoalUpsertOne) UpdateStatus() *GoalUpsertOne {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *GoalUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoalCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoalUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GoalUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: GoalUpsertOne.ID is not supported by MySQL driver. Use GoalUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GoalUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GoalCreateBulk is the builder for creating many Goal entities in bulk.
+type GoalCreateBulk struct {
+	config
+	err      error
+	builders []*GoalCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Goal entities in the database.
+func (_c *GoalCreateBulk) Save(ctx context.Context) ([]*Goal, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Goal, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GoalMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GoalCreateBulk) SaveX(ctx context.Context) []*Goal {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GoalCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GoalCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Goal.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GoalUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GoalCreateBulk) OnConflict(opts ...sql.ConflictOption) *GoalUpsertBulk {
+	_c.conflict = opts
+	return &GoalUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GoalCreateBulk) OnConflictColumns(columns ...string) *GoalUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GoalUpsertBulk{
+		create: _c,
+	}
+}
+
+// GoalUpsertBulk is the builder for "upsert"-ing
+// a bulk of Goal nodes.
+type GoalUpsertBulk struct {
+	create *GoalCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(goal.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *GoalUpsertBulk) UpdateNewValues() *GoalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(goal.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(goal.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Goal.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GoalUpsertBulk) Ignore() *GoalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GoalUpsertBulk) DoNothing() *GoalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GoalCreateBulk.OnConflict
+// documentation for more info.
+func (u *GoalUpsertBulk) Update(set func(*GoalUpsert)) *GoalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GoalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GoalUpsertBulk) SetUpdatedAt(v time.Time) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateUpdatedAt() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *GoalUpsertBulk) SetPatientID(v uuid.UUID) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdatePatientID() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *GoalUpsertBulk) SetDoctorID(v uuid.UUID) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetDoctorID(v)
+	})
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateDoctorID() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateDoctorID()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *GoalUpsertBulk) SetTitle(v string) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateTitle() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *GoalUpsertBulk) SetDescription(v string) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateDescription() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GoalUpsertBulk) ClearDescription() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetTechniques sets the "techniques" field.
+func (u *GoalUpsertBulk) SetTechniques(v []string) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTechniques(v)
+	})
+}
+
+// UpdateTechniques sets the "techniques" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateTechniques() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTechniques()
+	})
+}
+
+// ClearTechniques clears the value of the "techniques" field.
+func (u *GoalUpsertBulk) ClearTechniques() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearTechniques()
+	})
+}
+
+// SetTriggers sets the "triggers" field.
+func (u *GoalUpsertBulk) SetTriggers(v []string) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTriggers(v)
+	})
+}
+
+// UpdateTriggers sets the "triggers" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateTriggers() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTriggers()
+	})
+}
+
+// ClearTriggers clears the value of the "triggers" field.
+func (u *GoalUpsertBulk) ClearTriggers() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearTriggers()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *GoalUpsertBulk) SetTags(v []string) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateTags() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// ClearTags clears the value of the "tags" field.
+func (u *GoalUpsertBulk) ClearTags() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearTags()
+	})
+}
+
+// SetTargetCount sets the "target_count" field.
+func (u *GoalUpsertBulk) SetTargetCount(v int) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetTargetCount(v)
+	})
+}
+
+// AddTargetCount adds v to the "target_count" field.
+func (u *GoalUpsertBulk) AddTargetCount(v int) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.AddTargetCount(v)
+	})
+}
+
+// UpdateTargetCount sets the "target_count" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateTargetCount() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateTargetCount()
+	})
+}
+
+// SetPeriod sets the "period" field.
+func (u *GoalUpsertBulk) SetPeriod(v goal.Period) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetPeriod(v)
+	})
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdatePeriod() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdatePeriod()
+	})
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *GoalUpsertBulk) SetStartsAt(v time.Time) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateStartsAt() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (u *GoalUpsertBulk) SetEndsAt(v time.Time) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetEndsAt(v)
+	})
+}
+
+// UpdateEndsAt sets the "ends_at" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateEndsAt() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateEndsAt()
+	})
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (u *GoalUpsertBulk) ClearEndsAt() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.ClearEndsAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *GoalUpsertBulk) SetStatus(v goal.Status) *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *GoalUpsertBulk) UpdateStatus() *GoalUpsertBulk {
+	return u.Update(func(s *GoalUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// Exec executes the query.
+func (u *GoalUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GoalCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GoalCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GoalUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,968 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/goal"
+	"backend/ent/patient"
+	"backend/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// GoalUpdate is the builder for updating Goal entities.
+type GoalUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GoalMutation
+}
+
+// Where appends a list predicates to the GoalUpdate builder.
+func (_u *GoalUpdate) Where(ps ...predicate.Goal) *GoalUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GoalUpdate) SetUpdatedAt(v time.Time) *GoalUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *GoalUpdate) SetPatientID(v uuid.UUID) *GoalUpdate {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillablePatientID(v *uuid.UUID) *GoalUpdate {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (_u *GoalUpdate) SetDoctorID(v uuid.UUID) *GoalUpdate {
+	_u.mutation.SetDoctorID(v)
+	return _u
+}
+
+// SetNillableDoctorID sets the "doctor_id" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableDoctorID(v *uuid.UUID) *GoalUpdate {
+	if v != nil {
+		_u.SetDoctorID(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *GoalUpdate) SetTitle(v string) *GoalUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableTitle(v *string) *GoalUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *GoalUpdate) SetDescription(v string) *GoalUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableDescription(v *string) *GoalUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *GoalUpdate) ClearDescription() *GoalUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetTechniques sets the "techniques" field.
+func (_u *GoalUpdate) SetTechniques(v []string) *GoalUpdate {
+	_u.mutation.SetTechniques(v)
+	return _u
+}
+
+// AppendTechniques appends value to the "techniques" field.
+func (_u *GoalUpdate) AppendTechniques(v []string) *GoalUpdate {
+	_u.mutation.AppendTechniques(v)
+	return _u
+}
+
+// ClearTechniques clears the value of the "techniques" field.
+func (_u *GoalUpdate) ClearTechniques() *GoalUpdate {
+	_u.mutation.ClearTechniques()
+	return _u
+}
+
+// SetTriggers sets the "triggers" field.
+func (_u *GoalUpdate) SetTriggers(v []string) *GoalUpdate {
+	_u.mutation.SetTriggers(v)
+	return _u
+}
+
+// AppendTriggers appends value to the "triggers" field.
+func (_u *GoalUpdate) AppendTriggers(v []string) *GoalUpdate {
+	_u.mutation.AppendTriggers(v)
+	return _u
+}
+
+// ClearTriggers clears the value of the "triggers" field.
+func (_u *GoalUpdate) ClearTriggers() *GoalUpdate {
+	_u.mutation.ClearTriggers()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *GoalUpdate) SetTags(v []string) *GoalUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *GoalUpdate) AppendTags(v []string) *GoalUpdate {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *GoalUpdate) ClearTags() *GoalUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetTargetCount sets the "target_count" field.
+func (_u *GoalUpdate) SetTargetCount(v int) *GoalUpdate {
+	_u.mutation.ResetTargetCount()
+	_u.mutation.SetTargetCount(v)
+	return _u
+}
+
+// SetNillableTargetCount sets the "target_count" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableTargetCount(v *int) *GoalUpdate {
+	if v != nil {
+		_u.SetTargetCount(*v)
+	}
+	return _u
+}
+
+// AddTargetCount adds value to the "target_count" field.
+func (_u *GoalUpdate) AddTargetCount(v int) *GoalUpdate {
+	_u.mutation.AddTargetCount(v)
+	return _u
+}
+
+// SetPeriod sets the "period" field.
+func (_u *GoalUpdate) SetPeriod(v goal.Period) *GoalUpdate {
+	_u.mutation.SetPeriod(v)
+	return _u
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillablePeriod(v *goal.Period) *GoalUpdate {
+	if v != nil {
+		_u.SetPeriod(*v)
+	}
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *GoalUpdate) SetStartsAt(v time.Time) *GoalUpdate {
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableStartsAt(v *time.Time) *GoalUpdate {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_u *GoalUpdate) SetEndsAt(v time.Time) *GoalUpdate {
+	_u.mutation.SetEndsAt(v)
+	return _u
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableEndsAt(v *time.Time) *GoalUpdate {
+	if v != nil {
+		_u.SetEndsAt(*v)
+	}
+	return _u
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (_u *GoalUpdate) ClearEndsAt() *GoalUpdate {
+	_u.mutation.ClearEndsAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *GoalUpdate) SetStatus(v goal.Status) *GoalUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GoalUpdate) SetNillableStatus(v *goal.Status) *GoalUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_u *GoalUpdate) SetPatient(v *Patient) *GoalUpdate {
+	return _u.SetPatientID(v.ID)
+}
+
+// SetDoctor sets the "doctor" edge to the Doctor entity.
+func (_u *GoalUpdate) SetDoctor(v *Doctor) *GoalUpdate {
+	return _u.SetDoctorID(v.ID)
+}
+
+// Mutation returns the GoalMutation object of the builder.
+func (_u *GoalUpdate) Mutation() *GoalMutation {
+	return _u.mutation
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (_u *GoalUpdate) ClearPatient() *GoalUpdate {
+	_u.mutation.ClearPatient()
+	return _u
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (_u *GoalUpdate) ClearDoctor() *GoalUpdate {
+	_u.mutation.ClearDoctor()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GoalUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoalUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GoalUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoalUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GoalUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := goal.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoalUpdate) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := goal.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Goal.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetCount(); ok {
+		if err := goal.TargetCountValidator(v); err != nil {
+			return &ValidationError{Name: "target_count", err: fmt.Errorf(`ent: validator failed for field "Goal.target_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Period(); ok {
+		if err := goal.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`ent: validator failed for field "Goal.period": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := goal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Goal.status": %w`, err)}
+		}
+	}
+	if _u.mutation.PatientCleared() && len(_u.mutation.PatientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Goal.patient"`)
+	}
+	if _u.mutation.DoctorCleared() && len(_u.mutation.DoctorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Goal.doctor"`)
+	}
+	return nil
+}
+
+func (_u *GoalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goal.Table, goal.Columns, sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(goal.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(goal.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(goal.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(goal.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Techniques(); ok {
+		_spec.SetField(goal.FieldTechniques, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTechniques(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, goal.FieldTechniques, value)
+		})
+	}
+	if _u.mutation.TechniquesCleared() {
+		_spec.ClearField(goal.FieldTechniques, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Triggers(); ok {
+		_spec.SetField(goal.FieldTriggers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTriggers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, goal.FieldTriggers, value)
+		})
+	}
+	if _u.mutation.TriggersCleared() {
+		_spec.ClearField(goal.FieldTriggers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(goal.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, goal.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(goal.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TargetCount(); ok {
+		_spec.SetField(goal.FieldTargetCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTargetCount(); ok {
+		_spec.AddField(goal.FieldTargetCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Period(); ok {
+		_spec.SetField(goal.FieldPeriod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(goal.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndsAt(); ok {
+		_spec.SetField(goal.FieldEndsAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndsAtCleared() {
+		_spec.ClearField(goal.FieldEndsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(goal.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.PatientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goal.PatientTable,
+			Columns: []string{goal.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goal.PatientTable,
+			Columns: []string{goal.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DoctorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goal.DoctorTable,
+			Columns: []string{goal.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goal.DoctorTable,
+			Columns: []string{goal.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GoalUpdateOne is the builder for updating a single Goal entity.
+type GoalUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GoalMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GoalUpdateOne) SetUpdatedAt(v time.Time) *GoalUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *GoalUpdateOne) SetPatientID(v uuid.UUID) *GoalUpdateOne {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillablePatientID(v *uuid.UUID) *GoalUpdateOne {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (_u *GoalUpdateOne) SetDoctorID(v uuid.UUID) *GoalUpdateOne {
+	_u.mutation.SetDoctorID(v)
+	return _u
+}
+
+// SetNillableDoctorID sets the "doctor_id" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableDoctorID(v *uuid.UUID) *GoalUpdateOne {
+	if v != nil {
+		_u.SetDoctorID(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *GoalUpdateOne) SetTitle(v string) *GoalUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableTitle(v *string) *GoalUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *GoalUpdateOne) SetDescription(v string) *GoalUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableDescription(v *string) *GoalUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *GoalUpdateOne) ClearDescription() *GoalUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetTechniques sets the "techniques" field.
+func (_u *GoalUpdateOne) SetTechniques(v []string) *GoalUpdateOne {
+	_u.mutation.SetTechniques(v)
+	return _u
+}
+
+// AppendTechniques appends value to the "techniques" field.
+func (_u *GoalUpdateOne) AppendTechniques(v []string) *GoalUpdateOne {
+	_u.mutation.AppendTechniques(v)
+	return _u
+}
+
+// ClearTechniques clears the value of the "techniques" field.
+func (_u *GoalUpdateOne) ClearTechniques() *GoalUpdateOne {
+	_u.mutation.ClearTechniques()
+	return _u
+}
+
+// SetTriggers sets the "triggers" field.
+func (_u *GoalUpdateOne) SetTriggers(v []string) *GoalUpdateOne {
+	_u.mutation.SetTriggers(v)
+	return _u
+}
+
+// AppendTriggers appends value to the "triggers" field.
+func (_u *GoalUpdateOne) AppendTriggers(v []string) *GoalUpdateOne {
+	_u.mutation.AppendTriggers(v)
+	return _u
+}
+
+// ClearTriggers clears the value of the "triggers" field.
+func (_u *GoalUpdateOne) ClearTriggers() *GoalUpdateOne {
+	_u.mutation.ClearTriggers()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *GoalUpdateOne) SetTags(v []string) *GoalUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *GoalUpdateOne) AppendTags(v []string) *GoalUpdateOne {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *GoalUpdateOne) ClearTags() *GoalUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetTargetCount sets the "target_count" field.
+func (_u *GoalUpdateOne) SetTargetCount(v int) *GoalUpdateOne {
+	_u.mutation.ResetTargetCount()
+	_u.mutation.SetTargetCount(v)
+	return _u
+}
+
+// SetNillableTargetCount sets the "target_count" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableTargetCount(v *int) *GoalUpdateOne {
+	if v != nil {
+		_u.SetTargetCount(*v)
+	}
+	return _u
+}
+
+// AddTargetCount adds value to the "target_count" field.
+func (_u *GoalUpdateOne) AddTargetCount(v int) *GoalUpdateOne {
+	_u.mutation.AddTargetCount(v)
+	return _u
+}
+
+// SetPeriod sets the "period" field.
+func (_u *GoalUpdateOne) SetPeriod(v goal.Period) *GoalUpdateOne {
+	_u.mutation.SetPeriod(v)
+	return _u
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillablePeriod(v *goal.Period) *GoalUpdateOne {
+	if v != nil {
+		_u.SetPeriod(*v)
+	}
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *GoalUpdateOne) SetStartsAt(v time.Time) *GoalUpdateOne {
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableStartsAt(v *time.Time) *GoalUpdateOne {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_u *GoalUpdateOne) SetEndsAt(v time.Time) *GoalUpdateOne {
+	_u.mutation.SetEndsAt(v)
+	return _u
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableEndsAt(v *time.Time) *GoalUpdateOne {
+	if v != nil {
+		_u.SetEndsAt(*v)
+	}
+	return _u
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (_u *GoalUpdateOne) ClearEndsAt() *GoalUpdateOne {
+	_u.mutation.ClearEndsAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *GoalUpdateOne) SetStatus(v goal.Status) *GoalUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *GoalUpdateOne) SetNillableStatus(v *goal.Status) *GoalUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_u *GoalUpdateOne) SetPatient(v *Patient) *GoalUpdateOne {
+	return _u.SetPatientID(v.ID)
+}
+
+// SetDoctor sets the "doctor" edge to the Doctor entity.
+func (_u *GoalUpdateOne) SetDoctor(v *Doctor) *GoalUpdateOne {
+	return _u.SetDoctorID(v.ID)
+}
+
+// Mutation returns the GoalMutation object of the builder.
+func (_u *GoalUpdateOne) Mutation() *GoalMutation {
+	return _u.mutation
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (_u *GoalUpdateOne) ClearPatient() *GoalUpdateOne {
+	_u.mutation.ClearPatient()
+	return _u
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (_u *GoalUpdateOne) ClearDoctor() *GoalUpdateOne {
+	_u.mutation.ClearDoctor()
+	return _u
+}
+
+// Where appends a list predicates to the GoalUpdate builder.
+func (_u *GoalUpdateOne) Where(ps ...predicate.Goal) *GoalUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GoalUpdateOne) Select(field string, fields ...string) *GoalUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Goal entity.
+func (_u *GoalUpdateOne) Save(ctx context.Context) (*Goal, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GoalUpdateOne) SaveX(ctx context.Context) *Goal {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GoalUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GoalUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GoalUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := goal.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GoalUpdateOne) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := goal.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Goal.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetCount(); ok {
+		if err := goal.TargetCountValidator(v); err != nil {
+			return &ValidationError{Name: "target_count", err: fmt.Errorf(`ent: validator failed for field "Goal.target_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Period(); ok {
+		if err := goal.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`ent: validator failed for field "Goal.period": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := goal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Goal.status": %w`, err)}
+		}
+	}
+	if _u.mutation.PatientCleared() && len(_u.mutation.PatientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Goal.patient"`)
+	}
+	if _u.mutation.DoctorCleared() && len(_u.mutation.DoctorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Goal.doctor"`)
+	}
+	return nil
+}
+
+func (_u *GoalUpdateOne) sqlSave(ctx context.Context) (_node *Goal, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(goal.Table, goal.Columns, sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Goal.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, goal.FieldID)
+		for _, f := range fields {
+			if !goal.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != goal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(goal.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(goal.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(goal.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(goal.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Techniques(); ok {
+		_spec.SetField(goal.FieldTechniques, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTechniques(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, goal.FieldTechniques, value)
+		})
+	}
+	if _u.mutation.TechniquesCleared() {
+		_spec.ClearField(goal.FieldTechniques, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Triggers(); ok {
+		_spec.SetField(goal.FieldTriggers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTriggers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, goal.FieldTriggers, value)
+		})
+	}
+	if _u.mutation.TriggersCleared() {
+		_spec.ClearField(goal.FieldTriggers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(goal.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, goal.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(goal.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TargetCount(); ok {
+		_spec.SetField(goal.FieldTargetCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTargetCount(); ok {
+		_spec.AddField(goal.FieldTargetCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Period(); ok {
+		_spec.SetField(goal.FieldPeriod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(goal.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndsAt(); ok {
+		_spec.SetField(goal.FieldEndsAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndsAtCleared() {
+		_spec.ClearField(goal.FieldEndsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(goal.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.PatientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goal.PatientTable,
+			Columns: []string{goal.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goal.PatientTable,
+			Columns: []string{goal.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DoctorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goal.DoctorTable,
+			Columns: []string{goal.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   goal.DoctorTable,
+			Columns: []string{goal.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Goal{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{goal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,3 @@
+-- Create index "entry_search" to table: "entries"
+-- Expression index backing full-text search; the expression must stay in sync with entrySearchVector in internal/server/search_queries.go.
+CREATE INDEX "entry_search" ON "public"."entries" USING GIN ((setweight(to_tsvector('simple'::regconfig, coalesce(situation, '')), 'A') || setweight(jsonb_to_tsvector('simple'::regconfig, coalesce(triggers, '[]'::jsonb) || coalesce(techniques, '[]'::jsonb) || coalesce(tags, '[]'::jsonb), '["string"]'::jsonb), 'B') || setweight(to_tsvector('simple'::regconfig, coalesce(notes, '')), 'C')));
@@ -0,0 +1,48 @@
+-- Create "vocabulary_terms" table
+CREATE TABLE "public"."vocabulary_terms" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "kind" character varying NOT NULL,
+  "key" character varying(64) NOT NULL,
+  "labels" jsonb NOT NULL,
+  "synonyms" jsonb NULL,
+  "active" boolean NOT NULL DEFAULT true,
+  "doctor_id" uuid NULL,
+  "practice_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "vocabulary_terms_doctors_vocabulary_terms" FOREIGN KEY ("doctor_id") REFERENCES "public"."doctors" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "vocabulary_terms_practices_vocabulary_terms" FOREIGN KEY ("practice_id") REFERENCES "public"."practices" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "vocabularyterm_doctor_id" to table: "vocabulary_terms"
+CREATE INDEX "vocabularyterm_doctor_id" ON "public"."vocabulary_terms" ("doctor_id");
+-- Create index "vocabularyterm_kind_key" to table: "vocabulary_terms"
+CREATE INDEX "vocabularyterm_kind_key" ON "public"."vocabulary_terms" ("kind", "key");
+-- Create index "vocabularyterm_practice_id" to table: "vocabulary_terms"
+CREATE INDEX "vocabularyterm_practice_id" ON "public"."vocabulary_terms" ("practice_id");
+-- Seed the global vocabulary
+INSERT INTO "public"."vocabulary_terms" ("id", "created_at", "updated_at", "kind", "key", "labels", "synonyms") VALUES
+  (gen_random_uuid(), now(), now(), 'Emotion', 'anxious', '{"en": "Anxious", "de": "Ängstlich"}', '["anxiety", "nervous", "worried", "angst", "nervös"]'),
+  (gen_random_uuid(), now(), now(), 'Emotion', 'frustrated', '{"en": "Frustrated", "de": "Frustriert"}', '["frustration", "annoyed", "genervt"]'),
+  (gen_random_uuid(), now(), now(), 'Emotion', 'embarrassed', '{"en": "Embarrassed", "de": "Verlegen"}', '["ashamed", "shame", "peinlich", "beschämt"]'),
+  (gen_random_uuid(), now(), now(), 'Emotion', 'tense', '{"en": "Tense", "de": "Angespannt"}', '["stressed", "tension", "gestresst"]'),
+  (gen_random_uuid(), now(), now(), 'Emotion', 'calm', '{"en": "Calm", "de": "Ruhig"}', '["relaxed", "entspannt", "gelassen"]'),
+  (gen_random_uuid(), now(), now(), 'Emotion', 'confident', '{"en": "Confident", "de": "Selbstsicher"}', '["self-confident", "sicher", "selbstbewusst"]'),
+  (gen_random_uuid(), now(), now(), 'Emotion', 'proud', '{"en": "Proud", "de": "Stolz"}', '[]'),
+  (gen_random_uuid(), now(), now(), 'Emotion', 'sad', '{"en": "Sad", "de": "Traurig"}', '["down", "niedergeschlagen"]'),
+  (gen_random_uuid(), now(), now(), 'Trigger', 'phone-call', '{"en": "Phone call", "de": "Telefonat"}', '["phone calls", "phone", "telephone", "calling", "telefon", "telefonieren", "anruf"]'),
+  (gen_random_uuid(), now(), now(), 'Trigger', 'presentation', '{"en": "Presentation", "de": "Präsentation"}', '["presentations", "public speaking", "talk", "vortrag", "referat"]'),
+  (gen_random_uuid(), now(), now(), 'Trigger', 'meeting', '{"en": "Meeting", "de": "Besprechung"}', '["meetings", "work meeting"]'),
+  (gen_random_uuid(), now(), now(), 'Trigger', 'saying-my-name', '{"en": "Saying my name", "de": "Eigenen Namen sagen"}', '["my name", "introducing myself", "introduction", "name", "vorstellen"]'),
+  (gen_random_uuid(), now(), now(), 'Trigger', 'ordering', '{"en": "Ordering food or drinks", "de": "Bestellen"}', '["ordering food", "restaurant", "ordering at a restaurant", "bestellung"]'),
+  (gen_random_uuid(), now(), now(), 'Trigger', 'strangers', '{"en": "Talking to strangers", "de": "Gespräch mit Fremden"}', '["stranger", "fremde"]'),
+  (gen_random_uuid(), now(), now(), 'Trigger', 'time-pressure', '{"en": "Time pressure", "de": "Zeitdruck"}', '["hurry", "rushed", "eile"]'),
+  (gen_random_uuid(), now(), now(), 'Trigger', 'fatigue', '{"en": "Tiredness", "de": "Müdigkeit"}', '["tired", "fatigue", "exhausted", "müde"]'),
+  (gen_random_uuid(), now(), now(), 'Technique', 'easy-onset', '{"en": "Easy onset", "de": "Weicher Stimmeinsatz"}', '["easy onsets", "gentle onset", "soft onset", "weicher einsatz"]'),
+  (gen_random_uuid(), now(), now(), 'Technique', 'light-contact', '{"en": "Light articulatory contact", "de": "Weiche Artikulation"}', '["light contact", "light contacts", "soft contacts"]'),
+  (gen_random_uuid(), now(), now(), 'Technique', 'pausing', '{"en": "Pausing", "de": "Pausen"}', '["pauses", "pause", "phrasing"]'),
+  (gen_random_uuid(), now(), now(), 'Technique', 'prolonged-speech', '{"en": "Prolonged speech", "de": "Gedehntes Sprechen"}', '["prolongation", "stretching", "fluency shaping", "dehnung"]'),
+  (gen_random_uuid(), now(), now(), 'Technique', 'voluntary-stuttering', '{"en": "Voluntary stuttering", "de": "Pseudostottern"}', '["voluntary stutter", "pseudostuttering", "pseudo-stuttering"]'),
+  (gen_random_uuid(), now(), now(), 'Technique', 'cancellation', '{"en": "Cancellation", "de": "Nachkorrektur"}', '["cancellations"]'),
+  (gen_random_uuid(), now(), now(), 'Technique', 'pull-out', '{"en": "Pull-out", "de": "Auflösung"}', '["pullout", "pull out", "pull-outs"]'),
+  (gen_random_uuid(), now(), now(), 'Technique', 'breathing', '{"en": "Breathing", "de": "Atmung"}', '["breathing exercise", "diaphragmatic breathing", "atemübung"]');
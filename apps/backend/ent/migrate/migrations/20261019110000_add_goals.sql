@@ -0,0 +1,25 @@
+-- Create "goals" table
+CREATE TABLE "public"."goals" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "title" character varying(200) NOT NULL,
+  "description" character varying NULL,
+  "techniques" jsonb NULL,
+  "triggers" jsonb NULL,
+  "tags" jsonb NULL,
+  "target_count" bigint NOT NULL,
+  "period" character varying NOT NULL DEFAULT 'Week',
+  "starts_at" timestamptz NOT NULL,
+  "ends_at" timestamptz NULL,
+  "status" character varying NOT NULL DEFAULT 'Active',
+  "doctor_id" uuid NOT NULL,
+  "patient_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "goals_doctors_assigned_goals" FOREIGN KEY ("doctor_id") REFERENCES "public"."doctors" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "goals_patients_goals" FOREIGN KEY ("patient_id") REFERENCES "public"."patients" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "goal_doctor_id" to table: "goals"
+CREATE INDEX "goal_doctor_id" ON "public"."goals" ("doctor_id");
+-- Create index "goal_patient_id_status" to table: "goals"
+CREATE INDEX "goal_patient_id_status" ON "public"."goals" ("patient_id", "status");
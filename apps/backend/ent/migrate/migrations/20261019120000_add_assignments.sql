@@ -0,0 +1,38 @@
+-- Create "assignments" table
+CREATE TABLE "public"."assignments" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "title" character varying(200) NOT NULL,
+  "instructions" character varying NULL,
+  "due_at" timestamptz NULL,
+  "recurrence" character varying NOT NULL DEFAULT 'None',
+  "attachment_key" character varying NULL,
+  "attachment_content_type" character varying NULL,
+  "status" character varying NOT NULL DEFAULT 'Active',
+  "doctor_id" uuid NOT NULL,
+  "patient_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "assignments_doctors_assigned_exercises" FOREIGN KEY ("doctor_id") REFERENCES "public"."doctors" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "assignments_patients_assignments" FOREIGN KEY ("patient_id") REFERENCES "public"."patients" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "assignment_doctor_id" to table: "assignments"
+CREATE INDEX "assignment_doctor_id" ON "public"."assignments" ("doctor_id");
+-- Create index "assignment_patient_id_status" to table: "assignments"
+CREATE INDEX "assignment_patient_id_status" ON "public"."assignments" ("patient_id", "status");
+-- Create "exercise_logs" table
+CREATE TABLE "public"."exercise_logs" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "completed_at" timestamptz NOT NULL,
+  "duration_minutes" bigint NULL,
+  "notes" character varying NULL,
+  "assignment_id" uuid NOT NULL,
+  "entry_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "exercise_logs_assignments_logs" FOREIGN KEY ("assignment_id") REFERENCES "public"."assignments" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "exercise_logs_entries_exercise_logs" FOREIGN KEY ("entry_id") REFERENCES "public"."entries" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create index "exerciselog_assignment_id_completed_at" to table: "exercise_logs"
+CREATE INDEX "exerciselog_assignment_id_completed_at" ON "public"."exercise_logs" ("assignment_id", "completed_at");
@@ -0,0 +1,27 @@
+-- Create "sessions" table
+CREATE TABLE "public"."sessions" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "held_at" timestamptz NOT NULL,
+  "duration_minutes" bigint NULL,
+  "clinician_notes" character varying NULL,
+  "patient_summary" character varying NULL,
+  "doctor_id" uuid NOT NULL,
+  "patient_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "sessions_doctors_sessions" FOREIGN KEY ("doctor_id") REFERENCES "public"."doctors" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "sessions_patients_sessions" FOREIGN KEY ("patient_id") REFERENCES "public"."patients" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "session_doctor_id_held_at" to table: "sessions"
+CREATE INDEX "session_doctor_id_held_at" ON "public"."sessions" ("doctor_id", "held_at");
+-- Create index "session_patient_id_held_at" to table: "sessions"
+CREATE INDEX "session_patient_id_held_at" ON "public"."sessions" ("patient_id", "held_at");
+-- Create "session_entries" table
+CREATE TABLE "public"."session_entries" (
+  "session_id" uuid NOT NULL,
+  "entry_id" uuid NOT NULL,
+  PRIMARY KEY ("session_id", "entry_id"),
+  CONSTRAINT "session_entries_entry_id" FOREIGN KEY ("entry_id") REFERENCES "public"."entries" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "session_entries_session_id" FOREIGN KEY ("session_id") REFERENCES "public"."sessions" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
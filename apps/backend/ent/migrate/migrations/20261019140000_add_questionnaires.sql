@@ -0,0 +1,57 @@
+-- Create "questionnaire_definitions" table
+CREATE TABLE "public"."questionnaire_definitions" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "key" character varying(64) NOT NULL,
+  "version" bigint NOT NULL,
+  "title" character varying(200) NOT NULL,
+  "description" character varying NULL,
+  "definition" jsonb NOT NULL,
+  "active" boolean NOT NULL DEFAULT true,
+  "created_by_doctor_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "questionnaire_definitions_doctors_questionnaire_definitions" FOREIGN KEY ("created_by_doctor_id") REFERENCES "public"."doctors" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create index "questionnairedefinition_key_version" to table: "questionnaire_definitions"
+CREATE UNIQUE INDEX "questionnairedefinition_key_version" ON "public"."questionnaire_definitions" ("key", "version");
+-- Create "questionnaire_assignments" table
+CREATE TABLE "public"."questionnaire_assignments" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "due_at" timestamptz NULL,
+  "status" character varying NOT NULL DEFAULT 'Pending',
+  "completed_at" timestamptz NULL,
+  "doctor_id" uuid NOT NULL,
+  "patient_id" uuid NOT NULL,
+  "definition_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "questionnaire_assignments_doctors_questionnaire_assignments" FOREIGN KEY ("doctor_id") REFERENCES "public"."doctors" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "questionnaire_assignments_patients_questionnaire_assignments" FOREIGN KEY ("patient_id") REFERENCES "public"."patients" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "questionnaire_assignments_questionnaire_definitions_assignments" FOREIGN KEY ("definition_id") REFERENCES "public"."questionnaire_definitions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "questionnaireassignment_doctor_id" to table: "questionnaire_assignments"
+CREATE INDEX "questionnaireassignment_doctor_id" ON "public"."questionnaire_assignments" ("doctor_id");
+-- Create index "questionnaireassignment_patient_id_status" to table: "questionnaire_assignments"
+CREATE INDEX "questionnaireassignment_patient_id_status" ON "public"."questionnaire_assignments" ("patient_id", "status");
+-- Create "questionnaire_responses" table
+CREATE TABLE "public"."questionnaire_responses" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "answers" jsonb NOT NULL,
+  "scores" jsonb NOT NULL,
+  "submitted_at" timestamptz NOT NULL,
+  "patient_id" uuid NOT NULL,
+  "assignment_id" uuid NOT NULL,
+  "definition_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "questionnaire_responses_patients_questionnaire_responses" FOREIGN KEY ("patient_id") REFERENCES "public"."patients" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "questionnaire_responses_questionnaire_assignments_response" FOREIGN KEY ("assignment_id") REFERENCES "public"."questionnaire_assignments" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "questionnaire_responses_questionnaire_definitions_responses" FOREIGN KEY ("definition_id") REFERENCES "public"."questionnaire_definitions" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "questionnaire_responses_assignment_id_key" to table: "questionnaire_responses"
+CREATE UNIQUE INDEX "questionnaire_responses_assignment_id_key" ON "public"."questionnaire_responses" ("assignment_id");
+-- Create index "questionnaireresponse_patient_id_submitted_at" to table: "questionnaire_responses"
+CREATE INDEX "questionnaireresponse_patient_id_submitted_at" ON "public"."questionnaire_responses" ("patient_id", "submitted_at");
@@ -0,0 +1,46 @@
+-- Create "reminder_schedules" table
+CREATE TABLE "public"."reminder_schedules" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "kind" character varying NOT NULL,
+  "channel" character varying NOT NULL,
+  "timezone" character varying(64) NOT NULL,
+  "time_of_day" character varying(5) NOT NULL,
+  "weekdays" jsonb NULL,
+  "inactivity_days" bigint NULL,
+  "message" character varying(500) NULL,
+  "enabled" boolean NOT NULL DEFAULT true,
+  "next_run_at" timestamptz NULL,
+  "last_sent_at" timestamptz NULL,
+  "created_by_doctor_id" uuid NULL,
+  "patient_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "reminder_schedules_doctors_reminder_schedules" FOREIGN KEY ("created_by_doctor_id") REFERENCES "public"."doctors" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "reminder_schedules_patients_reminder_schedules" FOREIGN KEY ("patient_id") REFERENCES "public"."patients" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "reminderschedule_enabled_next_run_at" to table: "reminder_schedules"
+CREATE INDEX "reminderschedule_enabled_next_run_at" ON "public"."reminder_schedules" ("enabled", "next_run_at");
+-- Create index "reminderschedule_patient_id" to table: "reminder_schedules"
+CREATE INDEX "reminderschedule_patient_id" ON "public"."reminder_schedules" ("patient_id");
+-- Create "reminder_deliveries" table
+CREATE TABLE "public"."reminder_deliveries" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "kind" character varying NOT NULL,
+  "channel" character varying NOT NULL,
+  "scheduled_for" timestamptz NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'Pending',
+  "sent_at" timestamptz NULL,
+  "error" character varying NULL,
+  "patient_id" uuid NOT NULL,
+  "schedule_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "reminder_deliveries_patients_reminder_deliveries" FOREIGN KEY ("patient_id") REFERENCES "public"."patients" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "reminder_deliveries_reminder_schedules_deliveries" FOREIGN KEY ("schedule_id") REFERENCES "public"."reminder_schedules" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "reminderdelivery_patient_id_scheduled_for" to table: "reminder_deliveries"
+CREATE INDEX "reminderdelivery_patient_id_scheduled_for" ON "public"."reminder_deliveries" ("patient_id", "scheduled_for");
+-- Create index "reminderdelivery_schedule_id_scheduled_for" to table: "reminder_deliveries"
+CREATE UNIQUE INDEX "reminderdelivery_schedule_id_scheduled_for" ON "public"."reminder_deliveries" ("schedule_id", "scheduled_for");
@@ -0,0 +1,40 @@
+-- Create "webhook_subscriptions" table
+CREATE TABLE "public"."webhook_subscriptions" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "url" character varying(2048) NOT NULL,
+  "secret" character varying NOT NULL,
+  "events" jsonb NOT NULL,
+  "description" character varying(200) NULL,
+  "active" boolean NOT NULL DEFAULT true,
+  "created_by_doctor_id" uuid NULL,
+  "practice_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "webhook_subscriptions_doctors_webhook_subscriptions" FOREIGN KEY ("created_by_doctor_id") REFERENCES "public"."doctors" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "webhook_subscriptions_practices_webhook_subscriptions" FOREIGN KEY ("practice_id") REFERENCES "public"."practices" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "webhooksubscription_practice_id" to table: "webhook_subscriptions"
+CREATE INDEX "webhooksubscription_practice_id" ON "public"."webhook_subscriptions" ("practice_id");
+-- Create "webhook_deliveries" table
+CREATE TABLE "public"."webhook_deliveries" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "event_id" uuid NOT NULL,
+  "event_type" character varying NOT NULL,
+  "payload" jsonb NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'Pending',
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "next_attempt_at" timestamptz NULL,
+  "last_attempt_at" timestamptz NULL,
+  "response_status" bigint NULL,
+  "last_error" character varying NULL,
+  "subscription_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "webhook_deliveries_webhook_subscriptions_deliveries" FOREIGN KEY ("subscription_id") REFERENCES "public"."webhook_subscriptions" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_status_next_attempt_at" ON "public"."webhook_deliveries" ("status", "next_attempt_at");
+-- Create index "webhookdelivery_subscription_id_created_at" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_subscription_id_created_at" ON "public"."webhook_deliveries" ("subscription_id", "created_at");
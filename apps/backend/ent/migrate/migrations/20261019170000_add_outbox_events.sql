@@ -0,0 +1,17 @@
+-- Create "outbox_events" table
+CREATE TABLE "public"."outbox_events" (
+  "id" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "event_type" character varying NOT NULL,
+  "payload" jsonb NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'Pending',
+  "delivered_to" jsonb NULL,
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "next_attempt_at" timestamptz NOT NULL,
+  "published_at" timestamptz NULL,
+  "last_error" character varying NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "outboxevent_status_next_attempt_at" to table: "outbox_events"
+CREATE INDEX "outboxevent_status_next_attempt_at" ON "public"."outbox_events" ("status", "next_attempt_at");
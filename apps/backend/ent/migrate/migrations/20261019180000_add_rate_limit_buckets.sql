@@ -0,0 +1,10 @@
+-- Create "rate_limit_buckets" table
+CREATE TABLE "public"."rate_limit_buckets" (
+  "id" character varying NOT NULL,
+  "tokens" double precision NOT NULL,
+  "allowed" boolean NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "ratelimitbucket_updated_at" to table: "rate_limit_buckets"
+CREATE INDEX "ratelimitbucket_updated_at" ON "public"."rate_limit_buckets" ("updated_at");
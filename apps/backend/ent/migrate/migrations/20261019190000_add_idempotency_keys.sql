@@ -0,0 +1,14 @@
+-- Create "idempotency_keys" table
+CREATE TABLE "public"."idempotency_keys" (
+  "id" character varying NOT NULL,
+  "fingerprint" character varying NOT NULL,
+  "status" bigint NOT NULL DEFAULT 0,
+  "content_type" character varying NOT NULL DEFAULT '',
+  "body" bytea NULL,
+  "locked_until" timestamptz NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idempotencykey_expires_at" to table: "idempotency_keys"
+CREATE INDEX "idempotencykey_expires_at" ON "public"."idempotency_keys" ("expires_at");
@@ -0,0 +1,12 @@
+-- Modify "webhook_deliveries" table
+ALTER TABLE "public"."webhook_deliveries" ADD COLUMN "redelivery_of" uuid NULL;
+-- Every delivery after the first of an event per subscription is a redelivery or a duplicate; point it at the first one.
+UPDATE "public"."webhook_deliveries" AS d SET "redelivery_of" = f."id"
+FROM (
+  SELECT DISTINCT ON ("subscription_id", "event_id") "id", "subscription_id", "event_id"
+  FROM "public"."webhook_deliveries"
+  ORDER BY "subscription_id", "event_id", "created_at", "id"
+) AS f
+WHERE d."subscription_id" = f."subscription_id" AND d."event_id" = f."event_id" AND d."id" <> f."id";
+-- Create index "webhookdelivery_subscription_id_event_id" to table: "webhook_deliveries"
+CREATE UNIQUE INDEX "webhookdelivery_subscription_id_event_id" ON "public"."webhook_deliveries" ("subscription_id", "event_id") WHERE (redelivery_of IS NULL);
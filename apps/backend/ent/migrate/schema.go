@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -69,6 +70,53 @@ var (
 			},
 		},
 	}
+	// AssignmentsColumns holds the columns for the "assignments" table.
+	AssignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "title", Type: field.TypeString, Size: 200},
+		{Name: "instructions", Type: field.TypeString, Nullable: true},
+		{Name: "due_at", Type: field.TypeTime, Nullable: true},
+		{Name: "recurrence", Type: field.TypeEnum, Enums: []string{"None", "Daily", "Weekly"}, Default: "None"},
+		{Name: "attachment_key", Type: field.TypeString, Nullable: true},
+		{Name: "attachment_content_type", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"Active", "Archived"}, Default: "Active"},
+		{Name: "doctor_id", Type: field.TypeUUID},
+		{Name: "patient_id", Type: field.TypeUUID},
+	}
+	// AssignmentsTable holds the schema information for the "assignments" table.
+	AssignmentsTable = &schema.Table{
+		Name:       "assignments",
+		Columns:    AssignmentsColumns,
+		PrimaryKey: []*schema.Column{AssignmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "assignments_doctors_assigned_exercises",
+				Columns:    []*schema.Column{AssignmentsColumns[10]},
+				RefColumns: []*schema.Column{DoctorsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "assignments_patients_assignments",
+				Columns:    []*schema.Column{AssignmentsColumns[11]},
+				RefColumns: []*schema.Column{PatientsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "assignment_patient_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{AssignmentsColumns[11], AssignmentsColumns[9]},
+			},
+			{
+				Name:    "assignment_doctor_id",
+				Unique:  false,
+				Columns: []*schema.Column{AssignmentsColumns[10]},
+			},
+		},
+	}
 	// CommentsColumns holds the columns for the "comments" table.
 	CommentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -301,6 +349,145 @@ var (
 			},
 		},
 	}
+	// ExerciseLogsColumns holds the columns for the "exercise_logs" table.
+	ExerciseLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "completed_at", Type: field.TypeTime},
+		{Name: "duration_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "notes", Type: field.TypeString, Nullable: true},
+		{Name: "assignment_id", Type: field.TypeUUID},
+		{Name: "entry_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// ExerciseLogsTable holds the schema information for the "exercise_logs" table.
+	ExerciseLogsTable = &schema.Table{
+		Name:       "exercise_logs",
+		Columns:    ExerciseLogsColumns,
+		PrimaryKey: []*schema.Column{ExerciseLogsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "exercise_logs_assignments_logs",
+				Columns:    []*schema.Column{ExerciseLogsColumns[6]},
+				RefColumns: []*schema.Column{AssignmentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "exercise_logs_entries_exercise_logs",
+				Columns:    []*schema.Column{ExerciseLogsColumns[7]},
+				RefColumns: []*schema.Column{EntriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "exerciselog_assignment_id_completed_at",
+				Unique:  false,
+				Columns: []*schema.Column{ExerciseLogsColumns[6], ExerciseLogsColumns[3]},
+			},
+		},
+	}
+	// GoalsColumns holds the columns for the "goals" table.
+	GoalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "title", Type: field.TypeString, Size: 200},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "techniques", Type: field.TypeJSON, Nullable: true},
+		{Name: "triggers", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "target_count", Type: field.TypeInt},
+		{Name: "period", Type: field.TypeEnum, Enums: []string{"Day", "Week", "Month", "Total"}, Default: "Week"},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"Active", "Completed", "Cancelled"}, Default: "Active"},
+		{Name: "doctor_id", Type: field.TypeUUID},
+		{Name: "patient_id", Type: field.TypeUUID},
+	}
+	// GoalsTable holds the schema information for the "goals" table.
+	GoalsTable = &schema.Table{
+		Name:       "goals",
+		Columns:    GoalsColumns,
+		PrimaryKey: []*schema.Column{GoalsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "goals_doctors_assigned_goals",
+				Columns:    []*schema.Column{GoalsColumns[13]},
+				RefColumns: []*schema.Column{DoctorsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "goals_patients_goals",
+				Columns:    []*schema.Column{GoalsColumns[14]},
+				RefColumns: []*schema.Column{PatientsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "goal_patient_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{GoalsColumns[14], GoalsColumns[12]},
+			},
+			{
+				Name:    "goal_doctor_id",
+				Unique:  false,
+				Columns: []*schema.Column{GoalsColumns[13]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "fingerprint", Type: field.TypeString},
+		{Name: "status", Type: field.TypeInt, Default: 0},
+		{Name: "content_type", Type: field.TypeString, Default: ""},
+		{Name: "body", Type: field.TypeBytes, Nullable: true},
+		{Name: "locked_until", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[6]},
+			},
+		},
+	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"Pending", "Published", "Failed"}, Default: "Pending"},
+		{Name: "delivered_to", Type: field.TypeJSON, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[5], OutboxEventsColumns[8]},
+			},
+		},
+	}
 	// PairingCodesColumns holds the columns for the "pairing_codes" table.
 	PairingCodesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -399,17 +586,488 @@ var (
 		Columns:    PracticesColumns,
 		PrimaryKey: []*schema.Column{PracticesColumns[0]},
 	}
+	// QuestionnaireAssignmentsColumns holds the columns for the "questionnaire_assignments" table.
+	QuestionnaireAssignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "due_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"Pending", "Completed", "Cancelled"}, Default: "Pending"},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "doctor_id", Type: field.TypeUUID},
+		{Name: "patient_id", Type: field.TypeUUID},
+		{Name: "definition_id", Type: field.TypeUUID},
+	}
+	// QuestionnaireAssignmentsTable holds the schema information for the "questionnaire_assignments" table.
+	QuestionnaireAssignmentsTable = &schema.Table{
+		Name:       "questionnaire_assignments",
+		Columns:    QuestionnaireAssignmentsColumns,
+		PrimaryKey: []*schema.Column{QuestionnaireAssignmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "questionnaire_assignments_doctors_questionnaire_assignments",
+				Columns:    []*schema.Column{QuestionnaireAssignmentsColumns[6]},
+				RefColumns: []*schema.Column{DoctorsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "questionnaire_assignments_patients_questionnaire_assignments",
+				Columns:    []*schema.Column{QuestionnaireAssignmentsColumns[7]},
+				RefColumns: []*schema.Column{PatientsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "questionnaire_assignments_questionnaire_definitions_assignments",
+				Columns:    []*schema.Column{QuestionnaireAssignmentsColumns[8]},
+				RefColumns: []*schema.Column{QuestionnaireDefinitionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "questionnaireassignment_patient_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{QuestionnaireAssignmentsColumns[7], QuestionnaireAssignmentsColumns[4]},
+			},
+			{
+				Name:    "questionnaireassignment_doctor_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuestionnaireAssignmentsColumns[6]},
+			},
+		},
+	}
+	// QuestionnaireDefinitionsColumns holds the columns for the "questionnaire_definitions" table.
+	QuestionnaireDefinitionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "key", Type: field.TypeString, Size: 64},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "title", Type: field.TypeString, Size: 200},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "definition", Type: field.TypeJSON},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_by_doctor_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// QuestionnaireDefinitionsTable holds the schema information for the "questionnaire_definitions" table.
+	QuestionnaireDefinitionsTable = &schema.Table{
+		Name:       "questionnaire_definitions",
+		Columns:    QuestionnaireDefinitionsColumns,
+		PrimaryKey: []*schema.Column{QuestionnaireDefinitionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "questionnaire_definitions_doctors_questionnaire_definitions",
+				Columns:    []*schema.Column{QuestionnaireDefinitionsColumns[9]},
+				RefColumns: []*schema.Column{DoctorsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "questionnairedefinition_key_version",
+				Unique:  true,
+				Columns: []*schema.Column{QuestionnaireDefinitionsColumns[3], QuestionnaireDefinitionsColumns[4]},
+			},
+		},
+	}
+	// QuestionnaireResponsesColumns holds the columns for the "questionnaire_responses" table.
+	QuestionnaireResponsesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "answers", Type: field.TypeJSON},
+		{Name: "scores", Type: field.TypeJSON},
+		{Name: "submitted_at", Type: field.TypeTime},
+		{Name: "patient_id", Type: field.TypeUUID},
+		{Name: "assignment_id", Type: field.TypeUUID, Unique: true},
+		{Name: "definition_id", Type: field.TypeUUID},
+	}
+	// QuestionnaireResponsesTable holds the schema information for the "questionnaire_responses" table.
+	QuestionnaireResponsesTable = &schema.Table{
+		Name:       "questionnaire_responses",
+		Columns:    QuestionnaireResponsesColumns,
+		PrimaryKey: []*schema.Column{QuestionnaireResponsesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "questionnaire_responses_patients_questionnaire_responses",
+				Columns:    []*schema.Column{QuestionnaireResponsesColumns[6]},
+				RefColumns: []*schema.Column{PatientsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "questionnaire_responses_questionnaire_assignments_response",
+				Columns:    []*schema.Column{QuestionnaireResponsesColumns[7]},
+				RefColumns: []*schema.Column{QuestionnaireAssignmentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "questionnaire_responses_questionnaire_definitions_responses",
+				Columns:    []*schema.Column{QuestionnaireResponsesColumns[8]},
+				RefColumns: []*schema.Column{QuestionnaireDefinitionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "questionnaireresponse_patient_id_submitted_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuestionnaireResponsesColumns[6], QuestionnaireResponsesColumns[5]},
+			},
+		},
+	}
+	// RateLimitBucketsColumns holds the columns for the "rate_limit_buckets" table.
+	RateLimitBucketsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tokens", Type: field.TypeFloat64},
+		{Name: "allowed", Type: field.TypeBool},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RateLimitBucketsTable holds the schema information for the "rate_limit_buckets" table.
+	RateLimitBucketsTable = &schema.Table{
+		Name:       "rate_limit_buckets",
+		Columns:    RateLimitBucketsColumns,
+		PrimaryKey: []*schema.Column{RateLimitBucketsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ratelimitbucket_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{RateLimitBucketsColumns[3]},
+			},
+		},
+	}
+	// ReminderDeliveriesColumns holds the columns for the "reminder_deliveries" table.
+	ReminderDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"Journal", "Inactivity"}},
+		{Name: "channel", Type: field.TypeEnum, Enums: []string{"Push", "Email", "Log"}},
+		{Name: "scheduled_for", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"Pending", "Sent", "Failed"}, Default: "Pending"},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "patient_id", Type: field.TypeUUID},
+		{Name: "schedule_id", Type: field.TypeUUID},
+	}
+	// ReminderDeliveriesTable holds the schema information for the "reminder_deliveries" table.
+	ReminderDeliveriesTable = &schema.Table{
+		Name:       "reminder_deliveries",
+		Columns:    ReminderDeliveriesColumns,
+		PrimaryKey: []*schema.Column{ReminderDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reminder_deliveries_patients_reminder_deliveries",
+				Columns:    []*schema.Column{ReminderDeliveriesColumns[9]},
+				RefColumns: []*schema.Column{PatientsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "reminder_deliveries_reminder_schedules_deliveries",
+				Columns:    []*schema.Column{ReminderDeliveriesColumns[10]},
+				RefColumns: []*schema.Column{ReminderSchedulesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reminderdelivery_schedule_id_scheduled_for",
+				Unique:  true,
+				Columns: []*schema.Column{ReminderDeliveriesColumns[10], ReminderDeliveriesColumns[5]},
+			},
+			{
+				Name:    "reminderdelivery_patient_id_scheduled_for",
+				Unique:  false,
+				Columns: []*schema.Column{ReminderDeliveriesColumns[9], ReminderDeliveriesColumns[5]},
+			},
+		},
+	}
+	// ReminderSchedulesColumns holds the columns for the "reminder_schedules" table.
+	ReminderSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"Journal", "Inactivity"}},
+		{Name: "channel", Type: field.TypeEnum, Enums: []string{"Push", "Email", "Log"}},
+		{Name: "timezone", Type: field.TypeString, Size: 64},
+		{Name: "time_of_day", Type: field.TypeString, Size: 5},
+		{Name: "weekdays", Type: field.TypeJSON, Nullable: true},
+		{Name: "inactivity_days", Type: field.TypeInt, Nullable: true},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "next_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by_doctor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "patient_id", Type: field.TypeUUID},
+	}
+	// ReminderSchedulesTable holds the schema information for the "reminder_schedules" table.
+	ReminderSchedulesTable = &schema.Table{
+		Name:       "reminder_schedules",
+		Columns:    ReminderSchedulesColumns,
+		PrimaryKey: []*schema.Column{ReminderSchedulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reminder_schedules_doctors_reminder_schedules",
+				Columns:    []*schema.Column{ReminderSchedulesColumns[13]},
+				RefColumns: []*schema.Column{DoctorsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "reminder_schedules_patients_reminder_schedules",
+				Columns:    []*schema.Column{ReminderSchedulesColumns[14]},
+				RefColumns: []*schema.Column{PatientsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reminderschedule_patient_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReminderSchedulesColumns[14]},
+			},
+			{
+				Name:    "reminderschedule_enabled_next_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReminderSchedulesColumns[10], ReminderSchedulesColumns[11]},
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "held_at", Type: field.TypeTime},
+		{Name: "duration_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "clinician_notes", Type: field.TypeString, Nullable: true},
+		{Name: "patient_summary", Type: field.TypeString, Nullable: true},
+		{Name: "doctor_id", Type: field.TypeUUID},
+		{Name: "patient_id", Type: field.TypeUUID},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_doctors_sessions",
+				Columns:    []*schema.Column{SessionsColumns[7]},
+				RefColumns: []*schema.Column{DoctorsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "sessions_patients_sessions",
+				Columns:    []*schema.Column{SessionsColumns[8]},
+				RefColumns: []*schema.Column{PatientsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_patient_id_held_at",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[8], SessionsColumns[3]},
+			},
+			{
+				Name:    "session_doctor_id_held_at",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[7], SessionsColumns[3]},
+			},
+		},
+	}
+	// VocabularyTermsColumns holds the columns for the "vocabulary_terms" table.
+	VocabularyTermsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"Emotion", "Trigger", "Technique"}},
+		{Name: "key", Type: field.TypeString, Size: 64},
+		{Name: "labels", Type: field.TypeJSON},
+		{Name: "synonyms", Type: field.TypeJSON, Nullable: true},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "doctor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "practice_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// VocabularyTermsTable holds the schema information for the "vocabulary_terms" table.
+	VocabularyTermsTable = &schema.Table{
+		Name:       "vocabulary_terms",
+		Columns:    VocabularyTermsColumns,
+		PrimaryKey: []*schema.Column{VocabularyTermsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "vocabulary_terms_doctors_vocabulary_terms",
+				Columns:    []*schema.Column{VocabularyTermsColumns[8]},
+				RefColumns: []*schema.Column{DoctorsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "vocabulary_terms_practices_vocabulary_terms",
+				Columns:    []*schema.Column{VocabularyTermsColumns[9]},
+				RefColumns: []*schema.Column{PracticesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vocabularyterm_kind_key",
+				Unique:  false,
+				Columns: []*schema.Column{VocabularyTermsColumns[3], VocabularyTermsColumns[4]},
+			},
+			{
+				Name:    "vocabularyterm_practice_id",
+				Unique:  false,
+				Columns: []*schema.Column{VocabularyTermsColumns[9]},
+			},
+			{
+				Name:    "vocabularyterm_doctor_id",
+				Unique:  false,
+				Columns: []*schema.Column{VocabularyTermsColumns[8]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event_id", Type: field.TypeUUID},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"Pending", "Succeeded", "Failed"}, Default: "Pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "redelivery_of", Type: field.TypeUUID, Nullable: true},
+		{Name: "subscription_id", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhook_subscriptions_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[13]},
+				RefColumns: []*schema.Column{WebhookSubscriptionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[6], WebhookDeliveriesColumns[8]},
+			},
+			{
+				Name:    "webhookdelivery_subscription_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[13], WebhookDeliveriesColumns[1]},
+			},
+			{
+				Name:    "webhookdelivery_subscription_id_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[13], WebhookDeliveriesColumns[3]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "redelivery_of IS NULL",
+				},
+			},
+		},
+	}
+	// WebhookSubscriptionsColumns holds the columns for the "webhook_subscriptions" table.
+	WebhookSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "url", Type: field.TypeString, Size: 2048},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 200},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_by_doctor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "practice_id", Type: field.TypeUUID},
+	}
+	// WebhookSubscriptionsTable holds the schema information for the "webhook_subscriptions" table.
+	WebhookSubscriptionsTable = &schema.Table{
+		Name:       "webhook_subscriptions",
+		Columns:    WebhookSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{WebhookSubscriptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_subscriptions_doctors_webhook_subscriptions",
+				Columns:    []*schema.Column{WebhookSubscriptionsColumns[8]},
+				RefColumns: []*schema.Column{DoctorsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "webhook_subscriptions_practices_webhook_subscriptions",
+				Columns:    []*schema.Column{WebhookSubscriptionsColumns[9]},
+				RefColumns: []*schema.Column{PracticesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhooksubscription_practice_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookSubscriptionsColumns[9]},
+			},
+		},
+	}
+	// SessionEntriesColumns holds the columns for the "session_entries" table.
+	SessionEntriesColumns = []*schema.Column{
+		{Name: "session_id", Type: field.TypeUUID},
+		{Name: "entry_id", Type: field.TypeUUID},
+	}
+	// SessionEntriesTable holds the schema information for the "session_entries" table.
+	SessionEntriesTable = &schema.Table{
+		Name:       "session_entries",
+		Columns:    SessionEntriesColumns,
+		PrimaryKey: []*schema.Column{SessionEntriesColumns[0], SessionEntriesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "session_entries_session_id",
+				Columns:    []*schema.Column{SessionEntriesColumns[0]},
+				RefColumns: []*schema.Column{SessionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "session_entries_entry_id",
+				Columns:    []*schema.Column{SessionEntriesColumns[1]},
+				RefColumns: []*schema.Column{EntriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AnalysisJobsTable,
+		AssignmentsTable,
 		CommentsTable,
 		DoctorsTable,
 		DoctorPatientLinksTable,
 		EntriesTable,
 		EntrySharesTable,
+		ExerciseLogsTable,
+		GoalsTable,
+		IdempotencyKeysTable,
+		OutboxEventsTable,
 		PairingCodesTable,
 		PatientsTable,
 		PracticesTable,
+		QuestionnaireAssignmentsTable,
+		QuestionnaireDefinitionsTable,
+		QuestionnaireResponsesTable,
+		RateLimitBucketsTable,
+		ReminderDeliveriesTable,
+		ReminderSchedulesTable,
+		SessionsTable,
+		VocabularyTermsTable,
+		WebhookDeliveriesTable,
+		WebhookSubscriptionsTable,
+		SessionEntriesTable,
 	}
 )
 
@@ -417,6 +1075,8 @@ func init() {
 	AnalysisJobsTable.ForeignKeys[0].RefTable = DoctorsTable
 	AnalysisJobsTable.ForeignKeys[1].RefTable = EntriesTable
 	AnalysisJobsTable.ForeignKeys[2].RefTable = PatientsTable
+	AssignmentsTable.ForeignKeys[0].RefTable = DoctorsTable
+	AssignmentsTable.ForeignKeys[1].RefTable = PatientsTable
 	CommentsTable.ForeignKeys[0].RefTable = DoctorsTable
 	CommentsTable.ForeignKeys[1].RefTable = EntriesTable
 	DoctorsTable.ForeignKeys[0].RefTable = PracticesTable
@@ -427,6 +1087,30 @@ func init() {
 	EntrySharesTable.ForeignKeys[0].RefTable = DoctorsTable
 	EntrySharesTable.ForeignKeys[1].RefTable = EntriesTable
 	EntrySharesTable.ForeignKeys[2].RefTable = PatientsTable
+	ExerciseLogsTable.ForeignKeys[0].RefTable = AssignmentsTable
+	ExerciseLogsTable.ForeignKeys[1].RefTable = EntriesTable
+	GoalsTable.ForeignKeys[0].RefTable = DoctorsTable
+	GoalsTable.ForeignKeys[1].RefTable = PatientsTable
 	PairingCodesTable.ForeignKeys[0].RefTable = DoctorsTable
 	PairingCodesTable.ForeignKeys[1].RefTable = PatientsTable
+	QuestionnaireAssignmentsTable.ForeignKeys[0].RefTable = DoctorsTable
+	QuestionnaireAssignmentsTable.ForeignKeys[1].RefTable = PatientsTable
+	QuestionnaireAssignmentsTable.ForeignKeys[2].RefTable = QuestionnaireDefinitionsTable
+	QuestionnaireDefinitionsTable.ForeignKeys[0].RefTable = DoctorsTable
+	QuestionnaireResponsesTable.ForeignKeys[0].RefTable = PatientsTable
+	QuestionnaireResponsesTable.ForeignKeys[1].RefTable = QuestionnaireAssignmentsTable
+	QuestionnaireResponsesTable.ForeignKeys[2].RefTable = QuestionnaireDefinitionsTable
+	ReminderDeliveriesTable.ForeignKeys[0].RefTable = PatientsTable
+	ReminderDeliveriesTable.ForeignKeys[1].RefTable = ReminderSchedulesTable
+	ReminderSchedulesTable.ForeignKeys[0].RefTable = DoctorsTable
+	ReminderSchedulesTable.ForeignKeys[1].RefTable = PatientsTable
+	SessionsTable.ForeignKeys[0].RefTable = DoctorsTable
+	SessionsTable.ForeignKeys[1].RefTable = PatientsTable
+	VocabularyTermsTable.ForeignKeys[0].RefTable = DoctorsTable
+	VocabularyTermsTable.ForeignKeys[1].RefTable = PracticesTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhookSubscriptionsTable
+	WebhookSubscriptionsTable.ForeignKeys[0].RefTable = DoctorsTable
+	WebhookSubscriptionsTable.ForeignKeys[1].RefTable = PracticesTable
+	SessionEntriesTable.ForeignKeys[0].RefTable = SessionsTable
+	SessionEntriesTable.ForeignKeys[1].RefTable = EntriesTable
 }
@@ -4,17 +4,34 @@ package ent
 
 import (
 	"backend/ent/analysisjob"
+	"backend/ent/assignment"
 	"backend/ent/comment"
 	"backend/ent/doctor"
 	"backend/ent/doctorpatientlink"
 	"backend/ent/entry"
 	"backend/ent/entryshare"
+	"backend/ent/exerciselog"
+	"backend/ent/goal"
+	"backend/ent/idempotencykey"
+	"backend/ent/outboxevent"
 	"backend/ent/pairingcode"
 	"backend/ent/patient"
 	"backend/ent/practice"
 	"backend/ent/predicate"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/ent/questionnaireresponse"
+	"backend/ent/ratelimitbucket"
+	"backend/ent/reminderdelivery"
+	"backend/ent/reminderschedule"
 	"backend/ent/schema"
+	"backend/ent/session"
+	"backend/ent/vocabularyterm"
+	"backend/ent/webhookdelivery"
+	"backend/ent/webhooksubscription"
+	"backend/internal/questionnaire"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sync"
@@ -34,15 +51,30 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAnalysisJob       = "AnalysisJob"
-	TypeComment           = "Comment"
-	TypeDoctor            = "Doctor"
-	TypeDoctorPatientLink = "DoctorPatientLink"
-	TypeEntry             = "Entry"
-	TypeEntryShare        = "EntryShare"
-	TypePairingCode       = "PairingCode"
-	TypePatient           = "Patient"
-	TypePractice          = "Practice"
+	TypeAnalysisJob             = "AnalysisJob"
+	TypeAssignment              = "Assignment"
+	TypeComment                 = "Comment"
+	TypeDoctor                  = "Doctor"
+	TypeDoctorPatientLink       = "DoctorPatientLink"
+	TypeEntry                   = "Entry"
+	TypeEntryShare              = "EntryShare"
+	TypeExerciseLog             = "ExerciseLog"
+	TypeGoal                    = "Goal"
+	TypeIdempotencyKey          = "IdempotencyKey"
+	TypeOutboxEvent             = "OutboxEvent"
+	TypePairingCode             = "PairingCode"
+	TypePatient                 = "Patient"
+	TypePractice                = "Practice"
+	TypeQuestionnaireAssignment = "QuestionnaireAssignment"
+	TypeQuestionnaireDefinition = "QuestionnaireDefinition"
+	TypeQuestionnaireResponse   = "QuestionnaireResponse"
+	TypeRateLimitBucket         = "RateLimitBucket"
+	TypeReminderDelivery        = "ReminderDelivery"
+	TypeReminderSchedule        = "ReminderSchedule"
+	TypeSession                 = "Session"
+	TypeVocabularyTerm          = "VocabularyTerm"
+	TypeWebhookDelivery         = "WebhookDelivery"
+	TypeWebhookSubscription     = "WebhookSubscription"
 )
 
 // AnalysisJobMutation represents an operation that mutates the AnalysisJob nodes in the graph.
@@ -1398,35 +1430,45 @@ func (m *AnalysisJobMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown AnalysisJob edge %s", name)
 }
 
-// CommentMutation represents an operation that mutates the Comment nodes in the graph.
-type CommentMutation struct {
+// AssignmentMutation represents an operation that mutates the Assignment nodes in the graph.
+type AssignmentMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	body          *string
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	entry         *uuid.UUID
-	clearedentry  bool
-	author        *uuid.UUID
-	clearedauthor bool
-	done          bool
-	oldValue      func(context.Context) (*Comment, error)
-	predicates    []predicate.Comment
-}
-
-var _ ent.Mutation = (*CommentMutation)(nil)
-
-// commentOption allows management of the mutation configuration using functional options.
-type commentOption func(*CommentMutation)
-
-// newCommentMutation creates new mutation for the Comment entity.
-func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation {
-	m := &CommentMutation{
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	created_at              *time.Time
+	updated_at              *time.Time
+	title                   *string
+	instructions            *string
+	due_at                  *time.Time
+	recurrence              *assignment.Recurrence
+	attachment_key          *string
+	attachment_content_type *string
+	status                  *assignment.Status
+	clearedFields           map[string]struct{}
+	patient                 *uuid.UUID
+	clearedpatient          bool
+	doctor                  *uuid.UUID
+	cleareddoctor           bool
+	logs                    map[uuid.UUID]struct{}
+	removedlogs             map[uuid.UUID]struct{}
+	clearedlogs             bool
+	done                    bool
+	oldValue                func(context.Context) (*Assignment, error)
+	predicates              []predicate.Assignment
+}
+
+var _ ent.Mutation = (*AssignmentMutation)(nil)
+
+// assignmentOption allows management of the mutation configuration using functional options.
+type assignmentOption func(*AssignmentMutation)
+
+// newAssignmentMutation creates new mutation for the Assignment entity.
+func newAssignmentMutation(c config, op Op, opts ...assignmentOption) *AssignmentMutation {
+	m := &AssignmentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeComment,
+		typ:           TypeAssignment,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1435,20 +1477,20 @@ func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation
 	return m
 }
 
-// withCommentID sets the ID field of the mutation.
-func withCommentID(id uuid.UUID) commentOption {
-	return func(m *CommentMutation) {
+// withAssignmentID sets the ID field of the mutation.
+func withAssignmentID(id uuid.UUID) assignmentOption {
+	return func(m *AssignmentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Comment
+			value *Assignment
 		)
-		m.oldValue = func(ctx context.Context) (*Comment, error) {
+		m.oldValue = func(ctx context.Context) (*Assignment, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Comment.Get(ctx, id)
+					value, err = m.Client().Assignment.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1457,10 +1499,10 @@ func withCommentID(id uuid.UUID) commentOption {
 	}
 }
 
-// withComment sets the old Comment of the mutation.
-func withComment(node *Comment) commentOption {
-	return func(m *CommentMutation) {
-		m.oldValue = func(context.Context) (*Comment, error) {
+// withAssignment sets the old Assignment of the mutation.
+func withAssignment(node *Assignment) assignmentOption {
+	return func(m *AssignmentMutation) {
+		m.oldValue = func(context.Context) (*Assignment, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1469,7 +1511,7 @@ func withComment(node *Comment) commentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CommentMutation) Client() *Client {
+func (m AssignmentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1477,7 +1519,7 @@ func (m CommentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CommentMutation) Tx() (*Tx, error) {
+func (m AssignmentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1487,14 +1529,14 @@ func (m CommentMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Comment entities.
-func (m *CommentMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Assignment entities.
+func (m *AssignmentMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CommentMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AssignmentMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1505,7 +1547,7 @@ func (m *CommentMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CommentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AssignmentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1514,269 +1556,15765 @@ func (m *CommentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Comment.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Assignment.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEntryID sets the "entry_id" field.
-func (m *CommentMutation) SetEntryID(u uuid.UUID) {
-	m.entry = &u
+// SetCreatedAt sets the "created_at" field.
+func (m *AssignmentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// EntryID returns the value of the "entry_id" field in the mutation.
-func (m *CommentMutation) EntryID() (r uuid.UUID, exists bool) {
-	v := m.entry
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AssignmentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEntryID returns the old "entry_id" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Assignment entity.
+// If the Assignment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldEntryID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *AssignmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEntryID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEntryID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEntryID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.EntryID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetEntryID resets all changes to the "entry_id" field.
-func (m *CommentMutation) ResetEntryID() {
-	m.entry = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AssignmentMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetAuthorDoctorID sets the "author_doctor_id" field.
-func (m *CommentMutation) SetAuthorDoctorID(u uuid.UUID) {
-	m.author = &u
+// SetUpdatedAt sets the "updated_at" field.
+func (m *AssignmentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// AuthorDoctorID returns the value of the "author_doctor_id" field in the mutation.
-func (m *CommentMutation) AuthorDoctorID() (r uuid.UUID, exists bool) {
-	v := m.author
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *AssignmentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthorDoctorID returns the old "author_doctor_id" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Assignment entity.
+// If the Assignment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldAuthorDoctorID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *AssignmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthorDoctorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthorDoctorID requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthorDoctorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.AuthorDoctorID, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetAuthorDoctorID resets all changes to the "author_doctor_id" field.
-func (m *CommentMutation) ResetAuthorDoctorID() {
-	m.author = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *AssignmentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetBody sets the "body" field.
-func (m *CommentMutation) SetBody(s string) {
-	m.body = &s
+// SetPatientID sets the "patient_id" field.
+func (m *AssignmentMutation) SetPatientID(u uuid.UUID) {
+	m.patient = &u
 }
 
-// Body returns the value of the "body" field in the mutation.
-func (m *CommentMutation) Body() (r string, exists bool) {
-	v := m.body
+// PatientID returns the value of the "patient_id" field in the mutation.
+func (m *AssignmentMutation) PatientID() (r uuid.UUID, exists bool) {
+	v := m.patient
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBody returns the old "body" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldPatientID returns the old "patient_id" field's value of the Assignment entity.
+// If the Assignment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldBody(ctx context.Context) (v string, err error) {
+func (m *AssignmentMutation) OldPatientID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+		return v, errors.New("OldPatientID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBody requires an ID field in the mutation")
+		return v, errors.New("OldPatientID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+		return v, fmt.Errorf("querying old value for OldPatientID: %w", err)
 	}
-	return oldValue.Body, nil
+	return oldValue.PatientID, nil
 }
 
-// ResetBody resets all changes to the "body" field.
-func (m *CommentMutation) ResetBody() {
-	m.body = nil
+// ResetPatientID resets all changes to the "patient_id" field.
+func (m *AssignmentMutation) ResetPatientID() {
+	m.patient = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *CommentMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetDoctorID sets the "doctor_id" field.
+func (m *AssignmentMutation) SetDoctorID(u uuid.UUID) {
+	m.doctor = &u
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CommentMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// DoctorID returns the value of the "doctor_id" field in the mutation.
+func (m *AssignmentMutation) DoctorID() (r uuid.UUID, exists bool) {
+	v := m.doctor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldDoctorID returns the old "doctor_id" field's value of the Assignment entity.
+// If the Assignment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AssignmentMutation) OldDoctorID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDoctorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDoctorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDoctorID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.DoctorID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CommentMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetDoctorID resets all changes to the "doctor_id" field.
+func (m *AssignmentMutation) ResetDoctorID() {
+	m.doctor = nil
 }
 
-// ClearEntry clears the "entry" edge to the Entry entity.
-func (m *CommentMutation) ClearEntry() {
-	m.clearedentry = true
-	m.clearedFields[comment.FieldEntryID] = struct{}{}
+// SetTitle sets the "title" field.
+func (m *AssignmentMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// EntryCleared reports if the "entry" edge to the Entry entity was cleared.
-func (m *CommentMutation) EntryCleared() bool {
-	return m.clearedentry
+// Title returns the value of the "title" field in the mutation.
+func (m *AssignmentMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// EntryIDs returns the "entry" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EntryID instead. It exists only for internal usage by the builders.
-func (m *CommentMutation) EntryIDs() (ids []uuid.UUID) {
-	if id := m.entry; id != nil {
-		ids = append(ids, *id)
+// OldTitle returns the old "title" field's value of the Assignment entity.
+// If the Assignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AssignmentMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
 }
 
-// ResetEntry resets all changes to the "entry" edge.
-func (m *CommentMutation) ResetEntry() {
-	m.entry = nil
-	m.clearedentry = false
+// ResetTitle resets all changes to the "title" field.
+func (m *AssignmentMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetAuthorID sets the "author" edge to the Doctor entity by id.
-func (m *CommentMutation) SetAuthorID(id uuid.UUID) {
-	m.author = &id
+// SetInstructions sets the "instructions" field.
+func (m *AssignmentMutation) SetInstructions(s string) {
+	m.instructions = &s
 }
 
-// ClearAuthor clears the "author" edge to the Doctor entity.
-func (m *CommentMutation) ClearAuthor() {
-	m.clearedauthor = true
-	m.clearedFields[comment.FieldAuthorDoctorID] = struct{}{}
+// Instructions returns the value of the "instructions" field in the mutation.
+func (m *AssignmentMutation) Instructions() (r string, exists bool) {
+	v := m.instructions
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AuthorCleared reports if the "author" edge to the Doctor entity was cleared.
-func (m *CommentMutation) AuthorCleared() bool {
-	return m.clearedauthor
+// OldInstructions returns the old "instructions" field's value of the Assignment entity.
+// If the Assignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AssignmentMutation) OldInstructions(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstructions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstructions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstructions: %w", err)
+	}
+	return oldValue.Instructions, nil
 }
 
-// AuthorID returns the "author" edge ID in the mutation.
-func (m *CommentMutation) AuthorID() (id uuid.UUID, exists bool) {
-	if m.author != nil {
-		return *m.author, true
-	}
-	return
+// ClearInstructions clears the value of the "instructions" field.
+func (m *AssignmentMutation) ClearInstructions() {
+	m.instructions = nil
+	m.clearedFields[assignment.FieldInstructions] = struct{}{}
 }
 
-// AuthorIDs returns the "author" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AuthorID instead. It exists only for internal usage by the builders.
-func (m *CommentMutation) AuthorIDs() (ids []uuid.UUID) {
-	if id := m.author; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// InstructionsCleared returns if the "instructions" field was cleared in this mutation.
+func (m *AssignmentMutation) InstructionsCleared() bool {
+	_, ok := m.clearedFields[assignment.FieldInstructions]
+	return ok
 }
 
-// ResetAuthor resets all changes to the "author" edge.
-func (m *CommentMutation) ResetAuthor() {
-	m.author = nil
-	m.clearedauthor = false
+// ResetInstructions resets all changes to the "instructions" field.
+func (m *AssignmentMutation) ResetInstructions() {
+	m.instructions = nil
+	delete(m.clearedFields, assignment.FieldInstructions)
 }
 
-// Where appends a list predicates to the CommentMutation builder.
-func (m *CommentMutation) Where(ps ...predicate.Comment) {
-	m.predicates = append(m.predicates, ps...)
+// SetDueAt sets the "due_at" field.
+func (m *AssignmentMutation) SetDueAt(t time.Time) {
+	m.due_at = &t
 }
 
-// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Comment, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// DueAt returns the value of the "due_at" field in the mutation.
+func (m *AssignmentMutation) DueAt() (r time.Time, exists bool) {
+	v := m.due_at
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *CommentMutation) Op() Op {
-	return m.op
+// OldDueAt returns the old "due_at" field's value of the Assignment entity.
+// If the Assignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AssignmentMutation) OldDueAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueAt: %w", err)
+	}
+	return oldValue.DueAt, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *CommentMutation) SetOp(op Op) {
-	m.op = op
+// ClearDueAt clears the value of the "due_at" field.
+func (m *AssignmentMutation) ClearDueAt() {
+	m.due_at = nil
+	m.clearedFields[assignment.FieldDueAt] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Comment).
-func (m *CommentMutation) Type() string {
-	return m.typ
+// DueAtCleared returns if the "due_at" field was cleared in this mutation.
+func (m *AssignmentMutation) DueAtCleared() bool {
+	_, ok := m.clearedFields[assignment.FieldDueAt]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CommentMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.entry != nil {
-		fields = append(fields, comment.FieldEntryID)
-	}
+// ResetDueAt resets all changes to the "due_at" field.
+func (m *AssignmentMutation) ResetDueAt() {
+	m.due_at = nil
+	delete(m.clearedFields, assignment.FieldDueAt)
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (m *AssignmentMutation) SetRecurrence(a assignment.Recurrence) {
+	m.recurrence = &a
+}
+
+// Recurrence returns the value of the "recurrence" field in the mutation.
+func (m *AssignmentMutation) Recurrence() (r assignment.Recurrence, exists bool) {
+	v := m.recurrence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecurrence returns the old "recurrence" field's value of the Assignment entity.
+// If the Assignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AssignmentMutation) OldRecurrence(ctx context.Context) (v assignment.Recurrence, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecurrence is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecurrence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecurrence: %w", err)
+	}
+	return oldValue.Recurrence, nil
+}
+
+// ResetRecurrence resets all changes to the "recurrence" field.
+func (m *AssignmentMutation) ResetRecurrence() {
+	m.recurrence = nil
+}
+
+// SetAttachmentKey sets the "attachment_key" field.
+func (m *AssignmentMutation) SetAttachmentKey(s string) {
+	m.attachment_key = &s
+}
+
+// AttachmentKey returns the value of the "attachment_key" field in the mutation.
+func (m *AssignmentMutation) AttachmentKey() (r string, exists bool) {
+	v := m.attachment_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttachmentKey returns the old "attachment_key" field's value of the Assignment entity.
+// If the Assignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AssignmentMutation) OldAttachmentKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttachmentKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttachmentKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttachmentKey: %w", err)
+	}
+	return oldValue.AttachmentKey, nil
+}
+
+// ClearAttachmentKey clears the value of the "attachment_key" field.
+func (m *AssignmentMutation) ClearAttachmentKey() {
+	m.attachment_key = nil
+	m.clearedFields[assignment.FieldAttachmentKey] = struct{}{}
+}
+
+// AttachmentKeyCleared returns if the "attachment_key" field was cleared in this mutation.
+func (m *AssignmentMutation) AttachmentKeyCleared() bool {
+	_, ok := m.clearedFields[assignment.FieldAttachmentKey]
+	return ok
+}
+
+// ResetAttachmentKey resets all changes to the "attachment_key" field.
+func (m *AssignmentMutation) ResetAttachmentKey() {
+	m.attachment_key = nil
+	delete(m.clearedFields, assignment.FieldAttachmentKey)
+}
+
+// SetAttachmentContentType sets the "attachment_content_type" field.
+func (m *AssignmentMutation) SetAttachmentContentType(s string) {
+	m.attachment_content_type = &s
+}
+
+// AttachmentContentType returns the value of the "attachment_content_type" field in the mutation.
+func (m *AssignmentMutation) AttachmentContentType() (r string, exists bool) {
+	v := m.attachment_content_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttachmentContentType returns the old "attachment_content_type" field's value of the Assignment entity.
+// If the Assignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AssignmentMutation) OldAttachmentContentType(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttachmentContentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttachmentContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttachmentContentType: %w", err)
+	}
+	return oldValue.AttachmentContentType, nil
+}
+
+// ClearAttachmentContentType clears the value of the "attachment_content_type" field.
+func (m *AssignmentMutation) ClearAttachmentContentType() {
+	m.attachment_content_type = nil
+	m.clearedFields[assignment.FieldAttachmentContentType] = struct{}{}
+}
+
+// AttachmentContentTypeCleared returns if the "attachment_content_type" field was cleared in this mutation.
+func (m *AssignmentMutation) AttachmentContentTypeCleared() bool {
+	_, ok := m.clearedFields[assignment.FieldAttachmentContentType]
+	return ok
+}
+
+// ResetAttachmentContentType resets all changes to the "attachment_content_type" field.
+func (m *AssignmentMutation) ResetAttachmentContentType() {
+	m.attachment_content_type = nil
+	delete(m.clearedFields, assignment.FieldAttachmentContentType)
+}
+
+// SetStatus sets the "status" field.
+func (m *AssignmentMutation) SetStatus(a assignment.Status) {
+	m.status = &a
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *AssignmentMutation) Status() (r assignment.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Assignment entity.
+// If the Assignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AssignmentMutation) OldStatus(ctx context.Context) (v assignment.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *AssignmentMutation) ResetStatus() {
+	m.status = nil
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (m *AssignmentMutation) ClearPatient() {
+	m.clearedpatient = true
+	m.clearedFields[assignment.FieldPatientID] = struct{}{}
+}
+
+// PatientCleared reports if the "patient" edge to the Patient entity was cleared.
+func (m *AssignmentMutation) PatientCleared() bool {
+	return m.clearedpatient
+}
+
+// PatientIDs returns the "patient" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PatientID instead. It exists only for internal usage by the builders.
+func (m *AssignmentMutation) PatientIDs() (ids []uuid.UUID) {
+	if id := m.patient; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPatient resets all changes to the "patient" edge.
+func (m *AssignmentMutation) ResetPatient() {
+	m.patient = nil
+	m.clearedpatient = false
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (m *AssignmentMutation) ClearDoctor() {
+	m.cleareddoctor = true
+	m.clearedFields[assignment.FieldDoctorID] = struct{}{}
+}
+
+// DoctorCleared reports if the "doctor" edge to the Doctor entity was cleared.
+func (m *AssignmentMutation) DoctorCleared() bool {
+	return m.cleareddoctor
+}
+
+// DoctorIDs returns the "doctor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DoctorID instead. It exists only for internal usage by the builders.
+func (m *AssignmentMutation) DoctorIDs() (ids []uuid.UUID) {
+	if id := m.doctor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDoctor resets all changes to the "doctor" edge.
+func (m *AssignmentMutation) ResetDoctor() {
+	m.doctor = nil
+	m.cleareddoctor = false
+}
+
+// AddLogIDs adds the "logs" edge to the ExerciseLog entity by ids.
+func (m *AssignmentMutation) AddLogIDs(ids ...uuid.UUID) {
+	if m.logs == nil {
+		m.logs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.logs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLogs clears the "logs" edge to the ExerciseLog entity.
+func (m *AssignmentMutation) ClearLogs() {
+	m.clearedlogs = true
+}
+
+// LogsCleared reports if the "logs" edge to the ExerciseLog entity was cleared.
+func (m *AssignmentMutation) LogsCleared() bool {
+	return m.clearedlogs
+}
+
+// RemoveLogIDs removes the "logs" edge to the ExerciseLog entity by IDs.
+func (m *AssignmentMutation) RemoveLogIDs(ids ...uuid.UUID) {
+	if m.removedlogs == nil {
+		m.removedlogs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.logs, ids[i])
+		m.removedlogs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLogs returns the removed IDs of the "logs" edge to the ExerciseLog entity.
+func (m *AssignmentMutation) RemovedLogsIDs() (ids []uuid.UUID) {
+	for id := range m.removedlogs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LogsIDs returns the "logs" edge IDs in the mutation.
+func (m *AssignmentMutation) LogsIDs() (ids []uuid.UUID) {
+	for id := range m.logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLogs resets all changes to the "logs" edge.
+func (m *AssignmentMutation) ResetLogs() {
+	m.logs = nil
+	m.clearedlogs = false
+	m.removedlogs = nil
+}
+
+// Where appends a list predicates to the AssignmentMutation builder.
+func (m *AssignmentMutation) Where(ps ...predicate.Assignment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AssignmentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AssignmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Assignment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AssignmentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AssignmentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Assignment).
+func (m *AssignmentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AssignmentMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.created_at != nil {
+		fields = append(fields, assignment.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, assignment.FieldUpdatedAt)
+	}
+	if m.patient != nil {
+		fields = append(fields, assignment.FieldPatientID)
+	}
+	if m.doctor != nil {
+		fields = append(fields, assignment.FieldDoctorID)
+	}
+	if m.title != nil {
+		fields = append(fields, assignment.FieldTitle)
+	}
+	if m.instructions != nil {
+		fields = append(fields, assignment.FieldInstructions)
+	}
+	if m.due_at != nil {
+		fields = append(fields, assignment.FieldDueAt)
+	}
+	if m.recurrence != nil {
+		fields = append(fields, assignment.FieldRecurrence)
+	}
+	if m.attachment_key != nil {
+		fields = append(fields, assignment.FieldAttachmentKey)
+	}
+	if m.attachment_content_type != nil {
+		fields = append(fields, assignment.FieldAttachmentContentType)
+	}
+	if m.status != nil {
+		fields = append(fields, assignment.FieldStatus)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AssignmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case assignment.FieldCreatedAt:
+		return m.CreatedAt()
+	case assignment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case assignment.FieldPatientID:
+		return m.PatientID()
+	case assignment.FieldDoctorID:
+		return m.DoctorID()
+	case assignment.FieldTitle:
+		return m.Title()
+	case assignment.FieldInstructions:
+		return m.Instructions()
+	case assignment.FieldDueAt:
+		return m.DueAt()
+	case assignment.FieldRecurrence:
+		return m.Recurrence()
+	case assignment.FieldAttachmentKey:
+		return m.AttachmentKey()
+	case assignment.FieldAttachmentContentType:
+		return m.AttachmentContentType()
+	case assignment.FieldStatus:
+		return m.Status()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AssignmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case assignment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case assignment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case assignment.FieldPatientID:
+		return m.OldPatientID(ctx)
+	case assignment.FieldDoctorID:
+		return m.OldDoctorID(ctx)
+	case assignment.FieldTitle:
+		return m.OldTitle(ctx)
+	case assignment.FieldInstructions:
+		return m.OldInstructions(ctx)
+	case assignment.FieldDueAt:
+		return m.OldDueAt(ctx)
+	case assignment.FieldRecurrence:
+		return m.OldRecurrence(ctx)
+	case assignment.FieldAttachmentKey:
+		return m.OldAttachmentKey(ctx)
+	case assignment.FieldAttachmentContentType:
+		return m.OldAttachmentContentType(ctx)
+	case assignment.FieldStatus:
+		return m.OldStatus(ctx)
+	}
+	return nil, fmt.Errorf("unknown Assignment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AssignmentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case assignment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case assignment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case assignment.FieldPatientID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPatientID(v)
+		return nil
+	case assignment.FieldDoctorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDoctorID(v)
+		return nil
+	case assignment.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case assignment.FieldInstructions:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstructions(v)
+		return nil
+	case assignment.FieldDueAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueAt(v)
+		return nil
+	case assignment.FieldRecurrence:
+		v, ok := value.(assignment.Recurrence)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecurrence(v)
+		return nil
+	case assignment.FieldAttachmentKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttachmentKey(v)
+		return nil
+	case assignment.FieldAttachmentContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttachmentContentType(v)
+		return nil
+	case assignment.FieldStatus:
+		v, ok := value.(assignment.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Assignment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AssignmentMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AssignmentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AssignmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Assignment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AssignmentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(assignment.FieldInstructions) {
+		fields = append(fields, assignment.FieldInstructions)
+	}
+	if m.FieldCleared(assignment.FieldDueAt) {
+		fields = append(fields, assignment.FieldDueAt)
+	}
+	if m.FieldCleared(assignment.FieldAttachmentKey) {
+		fields = append(fields, assignment.FieldAttachmentKey)
+	}
+	if m.FieldCleared(assignment.FieldAttachmentContentType) {
+		fields = append(fields, assignment.FieldAttachmentContentType)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AssignmentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AssignmentMutation) ClearField(name string) error {
+	switch name {
+	case assignment.FieldInstructions:
+		m.ClearInstructions()
+		return nil
+	case assignment.FieldDueAt:
+		m.ClearDueAt()
+		return nil
+	case assignment.FieldAttachmentKey:
+		m.ClearAttachmentKey()
+		return nil
+	case assignment.FieldAttachmentContentType:
+		m.ClearAttachmentContentType()
+		return nil
+	}
+	return fmt.Errorf("unknown Assignment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AssignmentMutation) ResetField(name string) error {
+	switch name {
+	case assignment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case assignment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case assignment.FieldPatientID:
+		m.ResetPatientID()
+		return nil
+	case assignment.FieldDoctorID:
+		m.ResetDoctorID()
+		return nil
+	case assignment.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case assignment.FieldInstructions:
+		m.ResetInstructions()
+		return nil
+	case assignment.FieldDueAt:
+		m.ResetDueAt()
+		return nil
+	case assignment.FieldRecurrence:
+		m.ResetRecurrence()
+		return nil
+	case assignment.FieldAttachmentKey:
+		m.ResetAttachmentKey()
+		return nil
+	case assignment.FieldAttachmentContentType:
+		m.ResetAttachmentContentType()
+		return nil
+	case assignment.FieldStatus:
+		m.ResetStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown Assignment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AssignmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.patient != nil {
+		edges = append(edges, assignment.EdgePatient)
+	}
+	if m.doctor != nil {
+		edges = append(edges, assignment.EdgeDoctor)
+	}
+	if m.logs != nil {
+		edges = append(edges, assignment.EdgeLogs)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AssignmentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case assignment.EdgePatient:
+		if id := m.patient; id != nil {
+			return []ent.Value{*id}
+		}
+	case assignment.EdgeDoctor:
+		if id := m.doctor; id != nil {
+			return []ent.Value{*id}
+		}
+	case assignment.EdgeLogs:
+		ids := make([]ent.Value, 0, len(m.logs))
+		for id := range m.logs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AssignmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedlogs != nil {
+		edges = append(edges, assignment.EdgeLogs)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AssignmentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case assignment.EdgeLogs:
+		ids := make([]ent.Value, 0, len(m.removedlogs))
+		for id := range m.removedlogs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AssignmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedpatient {
+		edges = append(edges, assignment.EdgePatient)
+	}
+	if m.cleareddoctor {
+		edges = append(edges, assignment.EdgeDoctor)
+	}
+	if m.clearedlogs {
+		edges = append(edges, assignment.EdgeLogs)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AssignmentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case assignment.EdgePatient:
+		return m.clearedpatient
+	case assignment.EdgeDoctor:
+		return m.cleareddoctor
+	case assignment.EdgeLogs:
+		return m.clearedlogs
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AssignmentMutation) ClearEdge(name string) error {
+	switch name {
+	case assignment.EdgePatient:
+		m.ClearPatient()
+		return nil
+	case assignment.EdgeDoctor:
+		m.ClearDoctor()
+		return nil
+	}
+	return fmt.Errorf("unknown Assignment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AssignmentMutation) ResetEdge(name string) error {
+	switch name {
+	case assignment.EdgePatient:
+		m.ResetPatient()
+		return nil
+	case assignment.EdgeDoctor:
+		m.ResetDoctor()
+		return nil
+	case assignment.EdgeLogs:
+		m.ResetLogs()
+		return nil
+	}
+	return fmt.Errorf("unknown Assignment edge %s", name)
+}
+
+// CommentMutation represents an operation that mutates the Comment nodes in the graph.
+type CommentMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	body          *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	entry         *uuid.UUID
+	clearedentry  bool
+	author        *uuid.UUID
+	clearedauthor bool
+	done          bool
+	oldValue      func(context.Context) (*Comment, error)
+	predicates    []predicate.Comment
+}
+
+var _ ent.Mutation = (*CommentMutation)(nil)
+
+// commentOption allows management of the mutation configuration using functional options.
+type commentOption func(*CommentMutation)
+
+// newCommentMutation creates new mutation for the Comment entity.
+func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation {
+	m := &CommentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeComment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCommentID sets the ID field of the mutation.
+func withCommentID(id uuid.UUID) commentOption {
+	return func(m *CommentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Comment
+		)
+		m.oldValue = func(ctx context.Context) (*Comment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Comment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withComment sets the old Comment of the mutation.
+func withComment(node *Comment) commentOption {
+	return func(m *CommentMutation) {
+		m.oldValue = func(context.Context) (*Comment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CommentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CommentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Comment entities.
+func (m *CommentMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CommentMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CommentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Comment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEntryID sets the "entry_id" field.
+func (m *CommentMutation) SetEntryID(u uuid.UUID) {
+	m.entry = &u
+}
+
+// EntryID returns the value of the "entry_id" field in the mutation.
+func (m *CommentMutation) EntryID() (r uuid.UUID, exists bool) {
+	v := m.entry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntryID returns the old "entry_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldEntryID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntryID: %w", err)
+	}
+	return oldValue.EntryID, nil
+}
+
+// ResetEntryID resets all changes to the "entry_id" field.
+func (m *CommentMutation) ResetEntryID() {
+	m.entry = nil
+}
+
+// SetAuthorDoctorID sets the "author_doctor_id" field.
+func (m *CommentMutation) SetAuthorDoctorID(u uuid.UUID) {
+	m.author = &u
+}
+
+// AuthorDoctorID returns the value of the "author_doctor_id" field in the mutation.
+func (m *CommentMutation) AuthorDoctorID() (r uuid.UUID, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorDoctorID returns the old "author_doctor_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldAuthorDoctorID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorDoctorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorDoctorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorDoctorID: %w", err)
+	}
+	return oldValue.AuthorDoctorID, nil
+}
+
+// ResetAuthorDoctorID resets all changes to the "author_doctor_id" field.
+func (m *CommentMutation) ResetAuthorDoctorID() {
+	m.author = nil
+}
+
+// SetBody sets the "body" field.
+func (m *CommentMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *CommentMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *CommentMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CommentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CommentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CommentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearEntry clears the "entry" edge to the Entry entity.
+func (m *CommentMutation) ClearEntry() {
+	m.clearedentry = true
+	m.clearedFields[comment.FieldEntryID] = struct{}{}
+}
+
+// EntryCleared reports if the "entry" edge to the Entry entity was cleared.
+func (m *CommentMutation) EntryCleared() bool {
+	return m.clearedentry
+}
+
+// EntryIDs returns the "entry" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EntryID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) EntryIDs() (ids []uuid.UUID) {
+	if id := m.entry; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEntry resets all changes to the "entry" edge.
+func (m *CommentMutation) ResetEntry() {
+	m.entry = nil
+	m.clearedentry = false
+}
+
+// SetAuthorID sets the "author" edge to the Doctor entity by id.
+func (m *CommentMutation) SetAuthorID(id uuid.UUID) {
+	m.author = &id
+}
+
+// ClearAuthor clears the "author" edge to the Doctor entity.
+func (m *CommentMutation) ClearAuthor() {
+	m.clearedauthor = true
+	m.clearedFields[comment.FieldAuthorDoctorID] = struct{}{}
+}
+
+// AuthorCleared reports if the "author" edge to the Doctor entity was cleared.
+func (m *CommentMutation) AuthorCleared() bool {
+	return m.clearedauthor
+}
+
+// AuthorID returns the "author" edge ID in the mutation.
+func (m *CommentMutation) AuthorID() (id uuid.UUID, exists bool) {
+	if m.author != nil {
+		return *m.author, true
+	}
+	return
+}
+
+// AuthorIDs returns the "author" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AuthorID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) AuthorIDs() (ids []uuid.UUID) {
+	if id := m.author; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAuthor resets all changes to the "author" edge.
+func (m *CommentMutation) ResetAuthor() {
+	m.author = nil
+	m.clearedauthor = false
+}
+
+// Where appends a list predicates to the CommentMutation builder.
+func (m *CommentMutation) Where(ps ...predicate.Comment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Comment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CommentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CommentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Comment).
+func (m *CommentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CommentMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.entry != nil {
+		fields = append(fields, comment.FieldEntryID)
+	}
 	if m.author != nil {
 		fields = append(fields, comment.FieldAuthorDoctorID)
 	}
-	if m.body != nil {
-		fields = append(fields, comment.FieldBody)
+	if m.body != nil {
+		fields = append(fields, comment.FieldBody)
+	}
+	if m.created_at != nil {
+		fields = append(fields, comment.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CommentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case comment.FieldEntryID:
+		return m.EntryID()
+	case comment.FieldAuthorDoctorID:
+		return m.AuthorDoctorID()
+	case comment.FieldBody:
+		return m.Body()
+	case comment.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case comment.FieldEntryID:
+		return m.OldEntryID(ctx)
+	case comment.FieldAuthorDoctorID:
+		return m.OldAuthorDoctorID(ctx)
+	case comment.FieldBody:
+		return m.OldBody(ctx)
+	case comment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Comment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case comment.FieldEntryID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntryID(v)
+		return nil
+	case comment.FieldAuthorDoctorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorDoctorID(v)
+		return nil
+	case comment.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case comment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Comment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CommentMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Comment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CommentMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CommentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CommentMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Comment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CommentMutation) ResetField(name string) error {
+	switch name {
+	case comment.FieldEntryID:
+		m.ResetEntryID()
+		return nil
+	case comment.FieldAuthorDoctorID:
+		m.ResetAuthorDoctorID()
+		return nil
+	case comment.FieldBody:
+		m.ResetBody()
+		return nil
+	case comment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CommentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.entry != nil {
+		edges = append(edges, comment.EdgeEntry)
+	}
+	if m.author != nil {
+		edges = append(edges, comment.EdgeAuthor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CommentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case comment.EdgeEntry:
+		if id := m.entry; id != nil {
+			return []ent.Value{*id}
+		}
+	case comment.EdgeAuthor:
+		if id := m.author; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CommentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CommentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedentry {
+		edges = append(edges, comment.EdgeEntry)
+	}
+	if m.clearedauthor {
+		edges = append(edges, comment.EdgeAuthor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CommentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case comment.EdgeEntry:
+		return m.clearedentry
+	case comment.EdgeAuthor:
+		return m.clearedauthor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CommentMutation) ClearEdge(name string) error {
+	switch name {
+	case comment.EdgeEntry:
+		m.ClearEntry()
+		return nil
+	case comment.EdgeAuthor:
+		m.ClearAuthor()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CommentMutation) ResetEdge(name string) error {
+	switch name {
+	case comment.EdgeEntry:
+		m.ResetEntry()
+		return nil
+	case comment.EdgeAuthor:
+		m.ResetAuthor()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment edge %s", name)
+}
+
+// DoctorMutation represents an operation that mutates the Doctor nodes in the graph.
+type DoctorMutation struct {
+	config
+	op                               Op
+	typ                              string
+	id                               *uuid.UUID
+	created_at                       *time.Time
+	updated_at                       *time.Time
+	email                            *string
+	display_name                     *string
+	password_hash                    *string
+	role                             *doctor.Role
+	clearedFields                    map[string]struct{}
+	practice                         *uuid.UUID
+	clearedpractice                  bool
+	patient_links                    map[uuid.UUID]struct{}
+	removedpatient_links             map[uuid.UUID]struct{}
+	clearedpatient_links             bool
+	pairing_codes                    map[uuid.UUID]struct{}
+	removedpairing_codes             map[uuid.UUID]struct{}
+	clearedpairing_codes             bool
+	approved_patient_links           map[uuid.UUID]struct{}
+	removedapproved_patient_links    map[uuid.UUID]struct{}
+	clearedapproved_patient_links    bool
+	entry_shares                     map[uuid.UUID]struct{}
+	removedentry_shares              map[uuid.UUID]struct{}
+	clearedentry_shares              bool
+	comments                         map[uuid.UUID]struct{}
+	removedcomments                  map[uuid.UUID]struct{}
+	clearedcomments                  bool
+	created_analysis_jobs            map[uuid.UUID]struct{}
+	removedcreated_analysis_jobs     map[uuid.UUID]struct{}
+	clearedcreated_analysis_jobs     bool
+	assigned_goals                   map[uuid.UUID]struct{}
+	removedassigned_goals            map[uuid.UUID]struct{}
+	clearedassigned_goals            bool
+	assigned_exercises               map[uuid.UUID]struct{}
+	removedassigned_exercises        map[uuid.UUID]struct{}
+	clearedassigned_exercises        bool
+	sessions                         map[uuid.UUID]struct{}
+	removedsessions                  map[uuid.UUID]struct{}
+	clearedsessions                  bool
+	questionnaire_assignments        map[uuid.UUID]struct{}
+	removedquestionnaire_assignments map[uuid.UUID]struct{}
+	clearedquestionnaire_assignments bool
+	questionnaire_definitions        map[uuid.UUID]struct{}
+	removedquestionnaire_definitions map[uuid.UUID]struct{}
+	clearedquestionnaire_definitions bool
+	reminder_schedules               map[uuid.UUID]struct{}
+	removedreminder_schedules        map[uuid.UUID]struct{}
+	clearedreminder_schedules        bool
+	webhook_subscriptions            map[uuid.UUID]struct{}
+	removedwebhook_subscriptions     map[uuid.UUID]struct{}
+	clearedwebhook_subscriptions     bool
+	vocabulary_terms                 map[uuid.UUID]struct{}
+	removedvocabulary_terms          map[uuid.UUID]struct{}
+	clearedvocabulary_terms          bool
+	done                             bool
+	oldValue                         func(context.Context) (*Doctor, error)
+	predicates                       []predicate.Doctor
+}
+
+var _ ent.Mutation = (*DoctorMutation)(nil)
+
+// doctorOption allows management of the mutation configuration using functional options.
+type doctorOption func(*DoctorMutation)
+
+// newDoctorMutation creates new mutation for the Doctor entity.
+func newDoctorMutation(c config, op Op, opts ...doctorOption) *DoctorMutation {
+	m := &DoctorMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDoctor,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDoctorID sets the ID field of the mutation.
+func withDoctorID(id uuid.UUID) doctorOption {
+	return func(m *DoctorMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Doctor
+		)
+		m.oldValue = func(ctx context.Context) (*Doctor, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Doctor.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDoctor sets the old Doctor of the mutation.
+func withDoctor(node *Doctor) doctorOption {
+	return func(m *DoctorMutation) {
+		m.oldValue = func(context.Context) (*Doctor, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DoctorMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DoctorMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Doctor entities.
+func (m *DoctorMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DoctorMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DoctorMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Doctor.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DoctorMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DoctorMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Doctor entity.
+// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DoctorMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DoctorMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DoctorMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Doctor entity.
+// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DoctorMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *DoctorMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *DoctorMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Doctor entity.
+// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *DoctorMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *DoctorMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *DoctorMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the Doctor entity.
+// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *DoctorMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (m *DoctorMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
+}
+
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *DoctorMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHash returns the old "password_hash" field's value of the Doctor entity.
+// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
+}
+
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *DoctorMutation) ResetPasswordHash() {
+	m.password_hash = nil
+}
+
+// SetRole sets the "role" field.
+func (m *DoctorMutation) SetRole(d doctor.Role) {
+	m.role = &d
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *DoctorMutation) Role() (r doctor.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the Doctor entity.
+// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorMutation) OldRole(ctx context.Context) (v doctor.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *DoctorMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetPracticeID sets the "practice_id" field.
+func (m *DoctorMutation) SetPracticeID(u uuid.UUID) {
+	m.practice = &u
+}
+
+// PracticeID returns the value of the "practice_id" field in the mutation.
+func (m *DoctorMutation) PracticeID() (r uuid.UUID, exists bool) {
+	v := m.practice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPracticeID returns the old "practice_id" field's value of the Doctor entity.
+// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorMutation) OldPracticeID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPracticeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPracticeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPracticeID: %w", err)
+	}
+	return oldValue.PracticeID, nil
+}
+
+// ClearPracticeID clears the value of the "practice_id" field.
+func (m *DoctorMutation) ClearPracticeID() {
+	m.practice = nil
+	m.clearedFields[doctor.FieldPracticeID] = struct{}{}
+}
+
+// PracticeIDCleared returns if the "practice_id" field was cleared in this mutation.
+func (m *DoctorMutation) PracticeIDCleared() bool {
+	_, ok := m.clearedFields[doctor.FieldPracticeID]
+	return ok
+}
+
+// ResetPracticeID resets all changes to the "practice_id" field.
+func (m *DoctorMutation) ResetPracticeID() {
+	m.practice = nil
+	delete(m.clearedFields, doctor.FieldPracticeID)
+}
+
+// ClearPractice clears the "practice" edge to the Practice entity.
+func (m *DoctorMutation) ClearPractice() {
+	m.clearedpractice = true
+	m.clearedFields[doctor.FieldPracticeID] = struct{}{}
+}
+
+// PracticeCleared reports if the "practice" edge to the Practice entity was cleared.
+func (m *DoctorMutation) PracticeCleared() bool {
+	return m.PracticeIDCleared() || m.clearedpractice
+}
+
+// PracticeIDs returns the "practice" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PracticeID instead. It exists only for internal usage by the builders.
+func (m *DoctorMutation) PracticeIDs() (ids []uuid.UUID) {
+	if id := m.practice; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPractice resets all changes to the "practice" edge.
+func (m *DoctorMutation) ResetPractice() {
+	m.practice = nil
+	m.clearedpractice = false
+}
+
+// AddPatientLinkIDs adds the "patient_links" edge to the DoctorPatientLink entity by ids.
+func (m *DoctorMutation) AddPatientLinkIDs(ids ...uuid.UUID) {
+	if m.patient_links == nil {
+		m.patient_links = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.patient_links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPatientLinks clears the "patient_links" edge to the DoctorPatientLink entity.
+func (m *DoctorMutation) ClearPatientLinks() {
+	m.clearedpatient_links = true
+}
+
+// PatientLinksCleared reports if the "patient_links" edge to the DoctorPatientLink entity was cleared.
+func (m *DoctorMutation) PatientLinksCleared() bool {
+	return m.clearedpatient_links
+}
+
+// RemovePatientLinkIDs removes the "patient_links" edge to the DoctorPatientLink entity by IDs.
+func (m *DoctorMutation) RemovePatientLinkIDs(ids ...uuid.UUID) {
+	if m.removedpatient_links == nil {
+		m.removedpatient_links = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.patient_links, ids[i])
+		m.removedpatient_links[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPatientLinks returns the removed IDs of the "patient_links" edge to the DoctorPatientLink entity.
+func (m *DoctorMutation) RemovedPatientLinksIDs() (ids []uuid.UUID) {
+	for id := range m.removedpatient_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PatientLinksIDs returns the "patient_links" edge IDs in the mutation.
+func (m *DoctorMutation) PatientLinksIDs() (ids []uuid.UUID) {
+	for id := range m.patient_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPatientLinks resets all changes to the "patient_links" edge.
+func (m *DoctorMutation) ResetPatientLinks() {
+	m.patient_links = nil
+	m.clearedpatient_links = false
+	m.removedpatient_links = nil
+}
+
+// AddPairingCodeIDs adds the "pairing_codes" edge to the PairingCode entity by ids.
+func (m *DoctorMutation) AddPairingCodeIDs(ids ...uuid.UUID) {
+	if m.pairing_codes == nil {
+		m.pairing_codes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.pairing_codes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPairingCodes clears the "pairing_codes" edge to the PairingCode entity.
+func (m *DoctorMutation) ClearPairingCodes() {
+	m.clearedpairing_codes = true
+}
+
+// PairingCodesCleared reports if the "pairing_codes" edge to the PairingCode entity was cleared.
+func (m *DoctorMutation) PairingCodesCleared() bool {
+	return m.clearedpairing_codes
+}
+
+// RemovePairingCodeIDs removes the "pairing_codes" edge to the PairingCode entity by IDs.
+func (m *DoctorMutation) RemovePairingCodeIDs(ids ...uuid.UUID) {
+	if m.removedpairing_codes == nil {
+		m.removedpairing_codes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.pairing_codes, ids[i])
+		m.removedpairing_codes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPairingCodes returns the removed IDs of the "pairing_codes" edge to the PairingCode entity.
+func (m *DoctorMutation) RemovedPairingCodesIDs() (ids []uuid.UUID) {
+	for id := range m.removedpairing_codes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PairingCodesIDs returns the "pairing_codes" edge IDs in the mutation.
+func (m *DoctorMutation) PairingCodesIDs() (ids []uuid.UUID) {
+	for id := range m.pairing_codes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPairingCodes resets all changes to the "pairing_codes" edge.
+func (m *DoctorMutation) ResetPairingCodes() {
+	m.pairing_codes = nil
+	m.clearedpairing_codes = false
+	m.removedpairing_codes = nil
+}
+
+// AddApprovedPatientLinkIDs adds the "approved_patient_links" edge to the DoctorPatientLink entity by ids.
+func (m *DoctorMutation) AddApprovedPatientLinkIDs(ids ...uuid.UUID) {
+	if m.approved_patient_links == nil {
+		m.approved_patient_links = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.approved_patient_links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearApprovedPatientLinks clears the "approved_patient_links" edge to the DoctorPatientLink entity.
+func (m *DoctorMutation) ClearApprovedPatientLinks() {
+	m.clearedapproved_patient_links = true
+}
+
+// ApprovedPatientLinksCleared reports if the "approved_patient_links" edge to the DoctorPatientLink entity was cleared.
+func (m *DoctorMutation) ApprovedPatientLinksCleared() bool {
+	return m.clearedapproved_patient_links
+}
+
+// RemoveApprovedPatientLinkIDs removes the "approved_patient_links" edge to the DoctorPatientLink entity by IDs.
+func (m *DoctorMutation) RemoveApprovedPatientLinkIDs(ids ...uuid.UUID) {
+	if m.removedapproved_patient_links == nil {
+		m.removedapproved_patient_links = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.approved_patient_links, ids[i])
+		m.removedapproved_patient_links[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedApprovedPatientLinks returns the removed IDs of the "approved_patient_links" edge to the DoctorPatientLink entity.
+func (m *DoctorMutation) RemovedApprovedPatientLinksIDs() (ids []uuid.UUID) {
+	for id := range m.removedapproved_patient_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ApprovedPatientLinksIDs returns the "approved_patient_links" edge IDs in the mutation.
+func (m *DoctorMutation) ApprovedPatientLinksIDs() (ids []uuid.UUID) {
+	for id := range m.approved_patient_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetApprovedPatientLinks resets all changes to the "approved_patient_links" edge.
+func (m *DoctorMutation) ResetApprovedPatientLinks() {
+	m.approved_patient_links = nil
+	m.clearedapproved_patient_links = false
+	m.removedapproved_patient_links = nil
+}
+
+// AddEntryShareIDs adds the "entry_shares" edge to the EntryShare entity by ids.
+func (m *DoctorMutation) AddEntryShareIDs(ids ...uuid.UUID) {
+	if m.entry_shares == nil {
+		m.entry_shares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.entry_shares[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEntryShares clears the "entry_shares" edge to the EntryShare entity.
+func (m *DoctorMutation) ClearEntryShares() {
+	m.clearedentry_shares = true
+}
+
+// EntrySharesCleared reports if the "entry_shares" edge to the EntryShare entity was cleared.
+func (m *DoctorMutation) EntrySharesCleared() bool {
+	return m.clearedentry_shares
+}
+
+// RemoveEntryShareIDs removes the "entry_shares" edge to the EntryShare entity by IDs.
+func (m *DoctorMutation) RemoveEntryShareIDs(ids ...uuid.UUID) {
+	if m.removedentry_shares == nil {
+		m.removedentry_shares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.entry_shares, ids[i])
+		m.removedentry_shares[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEntryShares returns the removed IDs of the "entry_shares" edge to the EntryShare entity.
+func (m *DoctorMutation) RemovedEntrySharesIDs() (ids []uuid.UUID) {
+	for id := range m.removedentry_shares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EntrySharesIDs returns the "entry_shares" edge IDs in the mutation.
+func (m *DoctorMutation) EntrySharesIDs() (ids []uuid.UUID) {
+	for id := range m.entry_shares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEntryShares resets all changes to the "entry_shares" edge.
+func (m *DoctorMutation) ResetEntryShares() {
+	m.entry_shares = nil
+	m.clearedentry_shares = false
+	m.removedentry_shares = nil
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *DoctorMutation) AddCommentIDs(ids ...uuid.UUID) {
+	if m.comments == nil {
+		m.comments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *DoctorMutation) ClearComments() {
+	m.clearedcomments = true
+}
+
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *DoctorMutation) CommentsCleared() bool {
+	return m.clearedcomments
+}
+
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *DoctorMutation) RemoveCommentIDs(ids ...uuid.UUID) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *DoctorMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *DoctorMutation) CommentsIDs() (ids []uuid.UUID) {
+	for id := range m.comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetComments resets all changes to the "comments" edge.
+func (m *DoctorMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// AddCreatedAnalysisJobIDs adds the "created_analysis_jobs" edge to the AnalysisJob entity by ids.
+func (m *DoctorMutation) AddCreatedAnalysisJobIDs(ids ...uuid.UUID) {
+	if m.created_analysis_jobs == nil {
+		m.created_analysis_jobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.created_analysis_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCreatedAnalysisJobs clears the "created_analysis_jobs" edge to the AnalysisJob entity.
+func (m *DoctorMutation) ClearCreatedAnalysisJobs() {
+	m.clearedcreated_analysis_jobs = true
+}
+
+// CreatedAnalysisJobsCleared reports if the "created_analysis_jobs" edge to the AnalysisJob entity was cleared.
+func (m *DoctorMutation) CreatedAnalysisJobsCleared() bool {
+	return m.clearedcreated_analysis_jobs
+}
+
+// RemoveCreatedAnalysisJobIDs removes the "created_analysis_jobs" edge to the AnalysisJob entity by IDs.
+func (m *DoctorMutation) RemoveCreatedAnalysisJobIDs(ids ...uuid.UUID) {
+	if m.removedcreated_analysis_jobs == nil {
+		m.removedcreated_analysis_jobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.created_analysis_jobs, ids[i])
+		m.removedcreated_analysis_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCreatedAnalysisJobs returns the removed IDs of the "created_analysis_jobs" edge to the AnalysisJob entity.
+func (m *DoctorMutation) RemovedCreatedAnalysisJobsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcreated_analysis_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CreatedAnalysisJobsIDs returns the "created_analysis_jobs" edge IDs in the mutation.
+func (m *DoctorMutation) CreatedAnalysisJobsIDs() (ids []uuid.UUID) {
+	for id := range m.created_analysis_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCreatedAnalysisJobs resets all changes to the "created_analysis_jobs" edge.
+func (m *DoctorMutation) ResetCreatedAnalysisJobs() {
+	m.created_analysis_jobs = nil
+	m.clearedcreated_analysis_jobs = false
+	m.removedcreated_analysis_jobs = nil
+}
+
+// AddAssignedGoalIDs adds the "assigned_goals" edge to the Goal entity by ids.
+func (m *DoctorMutation) AddAssignedGoalIDs(ids ...uuid.UUID) {
+	if m.assigned_goals == nil {
+		m.assigned_goals = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.assigned_goals[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAssignedGoals clears the "assigned_goals" edge to the Goal entity.
+func (m *DoctorMutation) ClearAssignedGoals() {
+	m.clearedassigned_goals = true
+}
+
+// AssignedGoalsCleared reports if the "assigned_goals" edge to the Goal entity was cleared.
+func (m *DoctorMutation) AssignedGoalsCleared() bool {
+	return m.clearedassigned_goals
+}
+
+// RemoveAssignedGoalIDs removes the "assigned_goals" edge to the Goal entity by IDs.
+func (m *DoctorMutation) RemoveAssignedGoalIDs(ids ...uuid.UUID) {
+	if m.removedassigned_goals == nil {
+		m.removedassigned_goals = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.assigned_goals, ids[i])
+		m.removedassigned_goals[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAssignedGoals returns the removed IDs of the "assigned_goals" edge to the Goal entity.
+func (m *DoctorMutation) RemovedAssignedGoalsIDs() (ids []uuid.UUID) {
+	for id := range m.removedassigned_goals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AssignedGoalsIDs returns the "assigned_goals" edge IDs in the mutation.
+func (m *DoctorMutation) AssignedGoalsIDs() (ids []uuid.UUID) {
+	for id := range m.assigned_goals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAssignedGoals resets all changes to the "assigned_goals" edge.
+func (m *DoctorMutation) ResetAssignedGoals() {
+	m.assigned_goals = nil
+	m.clearedassigned_goals = false
+	m.removedassigned_goals = nil
+}
+
+// AddAssignedExerciseIDs adds the "assigned_exercises" edge to the Assignment entity by ids.
+func (m *DoctorMutation) AddAssignedExerciseIDs(ids ...uuid.UUID) {
+	if m.assigned_exercises == nil {
+		m.assigned_exercises = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.assigned_exercises[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAssignedExercises clears the "assigned_exercises" edge to the Assignment entity.
+func (m *DoctorMutation) ClearAssignedExercises() {
+	m.clearedassigned_exercises = true
+}
+
+// AssignedExercisesCleared reports if the "assigned_exercises" edge to the Assignment entity was cleared.
+func (m *DoctorMutation) AssignedExercisesCleared() bool {
+	return m.clearedassigned_exercises
+}
+
+// RemoveAssignedExerciseIDs removes the "assigned_exercises" edge to the Assignment entity by IDs.
+func (m *DoctorMutation) RemoveAssignedExerciseIDs(ids ...uuid.UUID) {
+	if m.removedassigned_exercises == nil {
+		m.removedassigned_exercises = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.assigned_exercises, ids[i])
+		m.removedassigned_exercises[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAssignedExercises returns the removed IDs of the "assigned_exercises" edge to the Assignment entity.
+func (m *DoctorMutation) RemovedAssignedExercisesIDs() (ids []uuid.UUID) {
+	for id := range m.removedassigned_exercises {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AssignedExercisesIDs returns the "assigned_exercises" edge IDs in the mutation.
+func (m *DoctorMutation) AssignedExercisesIDs() (ids []uuid.UUID) {
+	for id := range m.assigned_exercises {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAssignedExercises resets all changes to the "assigned_exercises" edge.
+func (m *DoctorMutation) ResetAssignedExercises() {
+	m.assigned_exercises = nil
+	m.clearedassigned_exercises = false
+	m.removedassigned_exercises = nil
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
+func (m *DoctorMutation) AddSessionIDs(ids ...uuid.UUID) {
+	if m.sessions == nil {
+		m.sessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *DoctorMutation) ClearSessions() {
+	m.clearedsessions = true
+}
+
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *DoctorMutation) SessionsCleared() bool {
+	return m.clearedsessions
+}
+
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *DoctorMutation) RemoveSessionIDs(ids ...uuid.UUID) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *DoctorMutation) RemovedSessionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *DoctorMutation) SessionsIDs() (ids []uuid.UUID) {
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *DoctorMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
+}
+
+// AddQuestionnaireAssignmentIDs adds the "questionnaire_assignments" edge to the QuestionnaireAssignment entity by ids.
+func (m *DoctorMutation) AddQuestionnaireAssignmentIDs(ids ...uuid.UUID) {
+	if m.questionnaire_assignments == nil {
+		m.questionnaire_assignments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.questionnaire_assignments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuestionnaireAssignments clears the "questionnaire_assignments" edge to the QuestionnaireAssignment entity.
+func (m *DoctorMutation) ClearQuestionnaireAssignments() {
+	m.clearedquestionnaire_assignments = true
+}
+
+// QuestionnaireAssignmentsCleared reports if the "questionnaire_assignments" edge to the QuestionnaireAssignment entity was cleared.
+func (m *DoctorMutation) QuestionnaireAssignmentsCleared() bool {
+	return m.clearedquestionnaire_assignments
+}
+
+// RemoveQuestionnaireAssignmentIDs removes the "questionnaire_assignments" edge to the QuestionnaireAssignment entity by IDs.
+func (m *DoctorMutation) RemoveQuestionnaireAssignmentIDs(ids ...uuid.UUID) {
+	if m.removedquestionnaire_assignments == nil {
+		m.removedquestionnaire_assignments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.questionnaire_assignments, ids[i])
+		m.removedquestionnaire_assignments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuestionnaireAssignments returns the removed IDs of the "questionnaire_assignments" edge to the QuestionnaireAssignment entity.
+func (m *DoctorMutation) RemovedQuestionnaireAssignmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedquestionnaire_assignments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuestionnaireAssignmentsIDs returns the "questionnaire_assignments" edge IDs in the mutation.
+func (m *DoctorMutation) QuestionnaireAssignmentsIDs() (ids []uuid.UUID) {
+	for id := range m.questionnaire_assignments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuestionnaireAssignments resets all changes to the "questionnaire_assignments" edge.
+func (m *DoctorMutation) ResetQuestionnaireAssignments() {
+	m.questionnaire_assignments = nil
+	m.clearedquestionnaire_assignments = false
+	m.removedquestionnaire_assignments = nil
+}
+
+// AddQuestionnaireDefinitionIDs adds the "questionnaire_definitions" edge to the QuestionnaireDefinition entity by ids.
+func (m *DoctorMutation) AddQuestionnaireDefinitionIDs(ids ...uuid.UUID) {
+	if m.questionnaire_definitions == nil {
+		m.questionnaire_definitions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.questionnaire_definitions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuestionnaireDefinitions clears the "questionnaire_definitions" edge to the QuestionnaireDefinition entity.
+func (m *DoctorMutation) ClearQuestionnaireDefinitions() {
+	m.clearedquestionnaire_definitions = true
+}
+
+// QuestionnaireDefinitionsCleared reports if the "questionnaire_definitions" edge to the QuestionnaireDefinition entity was cleared.
+func (m *DoctorMutation) QuestionnaireDefinitionsCleared() bool {
+	return m.clearedquestionnaire_definitions
+}
+
+// RemoveQuestionnaireDefinitionIDs removes the "questionnaire_definitions" edge to the QuestionnaireDefinition entity by IDs.
+func (m *DoctorMutation) RemoveQuestionnaireDefinitionIDs(ids ...uuid.UUID) {
+	if m.removedquestionnaire_definitions == nil {
+		m.removedquestionnaire_definitions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.questionnaire_definitions, ids[i])
+		m.removedquestionnaire_definitions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuestionnaireDefinitions returns the removed IDs of the "questionnaire_definitions" edge to the QuestionnaireDefinition entity.
+func (m *DoctorMutation) RemovedQuestionnaireDefinitionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedquestionnaire_definitions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuestionnaireDefinitionsIDs returns the "questionnaire_definitions" edge IDs in the mutation.
+func (m *DoctorMutation) QuestionnaireDefinitionsIDs() (ids []uuid.UUID) {
+	for id := range m.questionnaire_definitions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuestionnaireDefinitions resets all changes to the "questionnaire_definitions" edge.
+func (m *DoctorMutation) ResetQuestionnaireDefinitions() {
+	m.questionnaire_definitions = nil
+	m.clearedquestionnaire_definitions = false
+	m.removedquestionnaire_definitions = nil
+}
+
+// AddReminderScheduleIDs adds the "reminder_schedules" edge to the ReminderSchedule entity by ids.
+func (m *DoctorMutation) AddReminderScheduleIDs(ids ...uuid.UUID) {
+	if m.reminder_schedules == nil {
+		m.reminder_schedules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reminder_schedules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReminderSchedules clears the "reminder_schedules" edge to the ReminderSchedule entity.
+func (m *DoctorMutation) ClearReminderSchedules() {
+	m.clearedreminder_schedules = true
+}
+
+// ReminderSchedulesCleared reports if the "reminder_schedules" edge to the ReminderSchedule entity was cleared.
+func (m *DoctorMutation) ReminderSchedulesCleared() bool {
+	return m.clearedreminder_schedules
+}
+
+// RemoveReminderScheduleIDs removes the "reminder_schedules" edge to the ReminderSchedule entity by IDs.
+func (m *DoctorMutation) RemoveReminderScheduleIDs(ids ...uuid.UUID) {
+	if m.removedreminder_schedules == nil {
+		m.removedreminder_schedules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reminder_schedules, ids[i])
+		m.removedreminder_schedules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReminderSchedules returns the removed IDs of the "reminder_schedules" edge to the ReminderSchedule entity.
+func (m *DoctorMutation) RemovedReminderSchedulesIDs() (ids []uuid.UUID) {
+	for id := range m.removedreminder_schedules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReminderSchedulesIDs returns the "reminder_schedules" edge IDs in the mutation.
+func (m *DoctorMutation) ReminderSchedulesIDs() (ids []uuid.UUID) {
+	for id := range m.reminder_schedules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReminderSchedules resets all changes to the "reminder_schedules" edge.
+func (m *DoctorMutation) ResetReminderSchedules() {
+	m.reminder_schedules = nil
+	m.clearedreminder_schedules = false
+	m.removedreminder_schedules = nil
+}
+
+// AddWebhookSubscriptionIDs adds the "webhook_subscriptions" edge to the WebhookSubscription entity by ids.
+func (m *DoctorMutation) AddWebhookSubscriptionIDs(ids ...uuid.UUID) {
+	if m.webhook_subscriptions == nil {
+		m.webhook_subscriptions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.webhook_subscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWebhookSubscriptions clears the "webhook_subscriptions" edge to the WebhookSubscription entity.
+func (m *DoctorMutation) ClearWebhookSubscriptions() {
+	m.clearedwebhook_subscriptions = true
+}
+
+// WebhookSubscriptionsCleared reports if the "webhook_subscriptions" edge to the WebhookSubscription entity was cleared.
+func (m *DoctorMutation) WebhookSubscriptionsCleared() bool {
+	return m.clearedwebhook_subscriptions
+}
+
+// RemoveWebhookSubscriptionIDs removes the "webhook_subscriptions" edge to the WebhookSubscription entity by IDs.
+func (m *DoctorMutation) RemoveWebhookSubscriptionIDs(ids ...uuid.UUID) {
+	if m.removedwebhook_subscriptions == nil {
+		m.removedwebhook_subscriptions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.webhook_subscriptions, ids[i])
+		m.removedwebhook_subscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWebhookSubscriptions returns the removed IDs of the "webhook_subscriptions" edge to the WebhookSubscription entity.
+func (m *DoctorMutation) RemovedWebhookSubscriptionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedwebhook_subscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WebhookSubscriptionsIDs returns the "webhook_subscriptions" edge IDs in the mutation.
+func (m *DoctorMutation) WebhookSubscriptionsIDs() (ids []uuid.UUID) {
+	for id := range m.webhook_subscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWebhookSubscriptions resets all changes to the "webhook_subscriptions" edge.
+func (m *DoctorMutation) ResetWebhookSubscriptions() {
+	m.webhook_subscriptions = nil
+	m.clearedwebhook_subscriptions = false
+	m.removedwebhook_subscriptions = nil
+}
+
+// AddVocabularyTermIDs adds the "vocabulary_terms" edge to the VocabularyTerm entity by ids.
+func (m *DoctorMutation) AddVocabularyTermIDs(ids ...uuid.UUID) {
+	if m.vocabulary_terms == nil {
+		m.vocabulary_terms = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.vocabulary_terms[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVocabularyTerms clears the "vocabulary_terms" edge to the VocabularyTerm entity.
+func (m *DoctorMutation) ClearVocabularyTerms() {
+	m.clearedvocabulary_terms = true
+}
+
+// VocabularyTermsCleared reports if the "vocabulary_terms" edge to the VocabularyTerm entity was cleared.
+func (m *DoctorMutation) VocabularyTermsCleared() bool {
+	return m.clearedvocabulary_terms
+}
+
+// RemoveVocabularyTermIDs removes the "vocabulary_terms" edge to the VocabularyTerm entity by IDs.
+func (m *DoctorMutation) RemoveVocabularyTermIDs(ids ...uuid.UUID) {
+	if m.removedvocabulary_terms == nil {
+		m.removedvocabulary_terms = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.vocabulary_terms, ids[i])
+		m.removedvocabulary_terms[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVocabularyTerms returns the removed IDs of the "vocabulary_terms" edge to the VocabularyTerm entity.
+func (m *DoctorMutation) RemovedVocabularyTermsIDs() (ids []uuid.UUID) {
+	for id := range m.removedvocabulary_terms {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VocabularyTermsIDs returns the "vocabulary_terms" edge IDs in the mutation.
+func (m *DoctorMutation) VocabularyTermsIDs() (ids []uuid.UUID) {
+	for id := range m.vocabulary_terms {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVocabularyTerms resets all changes to the "vocabulary_terms" edge.
+func (m *DoctorMutation) ResetVocabularyTerms() {
+	m.vocabulary_terms = nil
+	m.clearedvocabulary_terms = false
+	m.removedvocabulary_terms = nil
+}
+
+// Where appends a list predicates to the DoctorMutation builder.
+func (m *DoctorMutation) Where(ps ...predicate.Doctor) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DoctorMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DoctorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Doctor, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DoctorMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DoctorMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Doctor).
+func (m *DoctorMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DoctorMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, doctor.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, doctor.FieldUpdatedAt)
+	}
+	if m.email != nil {
+		fields = append(fields, doctor.FieldEmail)
+	}
+	if m.display_name != nil {
+		fields = append(fields, doctor.FieldDisplayName)
+	}
+	if m.password_hash != nil {
+		fields = append(fields, doctor.FieldPasswordHash)
+	}
+	if m.role != nil {
+		fields = append(fields, doctor.FieldRole)
+	}
+	if m.practice != nil {
+		fields = append(fields, doctor.FieldPracticeID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DoctorMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case doctor.FieldCreatedAt:
+		return m.CreatedAt()
+	case doctor.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case doctor.FieldEmail:
+		return m.Email()
+	case doctor.FieldDisplayName:
+		return m.DisplayName()
+	case doctor.FieldPasswordHash:
+		return m.PasswordHash()
+	case doctor.FieldRole:
+		return m.Role()
+	case doctor.FieldPracticeID:
+		return m.PracticeID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DoctorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case doctor.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case doctor.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case doctor.FieldEmail:
+		return m.OldEmail(ctx)
+	case doctor.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case doctor.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case doctor.FieldRole:
+		return m.OldRole(ctx)
+	case doctor.FieldPracticeID:
+		return m.OldPracticeID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Doctor field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DoctorMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case doctor.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case doctor.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case doctor.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case doctor.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case doctor.FieldPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHash(v)
+		return nil
+	case doctor.FieldRole:
+		v, ok := value.(doctor.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case doctor.FieldPracticeID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPracticeID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Doctor field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DoctorMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DoctorMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DoctorMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Doctor numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DoctorMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(doctor.FieldPracticeID) {
+		fields = append(fields, doctor.FieldPracticeID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DoctorMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DoctorMutation) ClearField(name string) error {
+	switch name {
+	case doctor.FieldPracticeID:
+		m.ClearPracticeID()
+		return nil
+	}
+	return fmt.Errorf("unknown Doctor nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DoctorMutation) ResetField(name string) error {
+	switch name {
+	case doctor.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case doctor.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case doctor.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case doctor.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case doctor.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case doctor.FieldRole:
+		m.ResetRole()
+		return nil
+	case doctor.FieldPracticeID:
+		m.ResetPracticeID()
+		return nil
+	}
+	return fmt.Errorf("unknown Doctor field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DoctorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 15)
+	if m.practice != nil {
+		edges = append(edges, doctor.EdgePractice)
+	}
+	if m.patient_links != nil {
+		edges = append(edges, doctor.EdgePatientLinks)
+	}
+	if m.pairing_codes != nil {
+		edges = append(edges, doctor.EdgePairingCodes)
+	}
+	if m.approved_patient_links != nil {
+		edges = append(edges, doctor.EdgeApprovedPatientLinks)
+	}
+	if m.entry_shares != nil {
+		edges = append(edges, doctor.EdgeEntryShares)
+	}
+	if m.comments != nil {
+		edges = append(edges, doctor.EdgeComments)
+	}
+	if m.created_analysis_jobs != nil {
+		edges = append(edges, doctor.EdgeCreatedAnalysisJobs)
+	}
+	if m.assigned_goals != nil {
+		edges = append(edges, doctor.EdgeAssignedGoals)
+	}
+	if m.assigned_exercises != nil {
+		edges = append(edges, doctor.EdgeAssignedExercises)
+	}
+	if m.sessions != nil {
+		edges = append(edges, doctor.EdgeSessions)
+	}
+	if m.questionnaire_assignments != nil {
+		edges = append(edges, doctor.EdgeQuestionnaireAssignments)
+	}
+	if m.questionnaire_definitions != nil {
+		edges = append(edges, doctor.EdgeQuestionnaireDefinitions)
+	}
+	if m.reminder_schedules != nil {
+		edges = append(edges, doctor.EdgeReminderSchedules)
+	}
+	if m.webhook_subscriptions != nil {
+		edges = append(edges, doctor.EdgeWebhookSubscriptions)
+	}
+	if m.vocabulary_terms != nil {
+		edges = append(edges, doctor.EdgeVocabularyTerms)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DoctorMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case doctor.EdgePractice:
+		if id := m.practice; id != nil {
+			return []ent.Value{*id}
+		}
+	case doctor.EdgePatientLinks:
+		ids := make([]ent.Value, 0, len(m.patient_links))
+		for id := range m.patient_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgePairingCodes:
+		ids := make([]ent.Value, 0, len(m.pairing_codes))
+		for id := range m.pairing_codes {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeApprovedPatientLinks:
+		ids := make([]ent.Value, 0, len(m.approved_patient_links))
+		for id := range m.approved_patient_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeEntryShares:
+		ids := make([]ent.Value, 0, len(m.entry_shares))
+		for id := range m.entry_shares {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeCreatedAnalysisJobs:
+		ids := make([]ent.Value, 0, len(m.created_analysis_jobs))
+		for id := range m.created_analysis_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeAssignedGoals:
+		ids := make([]ent.Value, 0, len(m.assigned_goals))
+		for id := range m.assigned_goals {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeAssignedExercises:
+		ids := make([]ent.Value, 0, len(m.assigned_exercises))
+		for id := range m.assigned_exercises {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeQuestionnaireAssignments:
+		ids := make([]ent.Value, 0, len(m.questionnaire_assignments))
+		for id := range m.questionnaire_assignments {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeQuestionnaireDefinitions:
+		ids := make([]ent.Value, 0, len(m.questionnaire_definitions))
+		for id := range m.questionnaire_definitions {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeReminderSchedules:
+		ids := make([]ent.Value, 0, len(m.reminder_schedules))
+		for id := range m.reminder_schedules {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeWebhookSubscriptions:
+		ids := make([]ent.Value, 0, len(m.webhook_subscriptions))
+		for id := range m.webhook_subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeVocabularyTerms:
+		ids := make([]ent.Value, 0, len(m.vocabulary_terms))
+		for id := range m.vocabulary_terms {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DoctorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 15)
+	if m.removedpatient_links != nil {
+		edges = append(edges, doctor.EdgePatientLinks)
+	}
+	if m.removedpairing_codes != nil {
+		edges = append(edges, doctor.EdgePairingCodes)
+	}
+	if m.removedapproved_patient_links != nil {
+		edges = append(edges, doctor.EdgeApprovedPatientLinks)
+	}
+	if m.removedentry_shares != nil {
+		edges = append(edges, doctor.EdgeEntryShares)
+	}
+	if m.removedcomments != nil {
+		edges = append(edges, doctor.EdgeComments)
+	}
+	if m.removedcreated_analysis_jobs != nil {
+		edges = append(edges, doctor.EdgeCreatedAnalysisJobs)
+	}
+	if m.removedassigned_goals != nil {
+		edges = append(edges, doctor.EdgeAssignedGoals)
+	}
+	if m.removedassigned_exercises != nil {
+		edges = append(edges, doctor.EdgeAssignedExercises)
+	}
+	if m.removedsessions != nil {
+		edges = append(edges, doctor.EdgeSessions)
+	}
+	if m.removedquestionnaire_assignments != nil {
+		edges = append(edges, doctor.EdgeQuestionnaireAssignments)
+	}
+	if m.removedquestionnaire_definitions != nil {
+		edges = append(edges, doctor.EdgeQuestionnaireDefinitions)
+	}
+	if m.removedreminder_schedules != nil {
+		edges = append(edges, doctor.EdgeReminderSchedules)
+	}
+	if m.removedwebhook_subscriptions != nil {
+		edges = append(edges, doctor.EdgeWebhookSubscriptions)
+	}
+	if m.removedvocabulary_terms != nil {
+		edges = append(edges, doctor.EdgeVocabularyTerms)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DoctorMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case doctor.EdgePatientLinks:
+		ids := make([]ent.Value, 0, len(m.removedpatient_links))
+		for id := range m.removedpatient_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgePairingCodes:
+		ids := make([]ent.Value, 0, len(m.removedpairing_codes))
+		for id := range m.removedpairing_codes {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeApprovedPatientLinks:
+		ids := make([]ent.Value, 0, len(m.removedapproved_patient_links))
+		for id := range m.removedapproved_patient_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeEntryShares:
+		ids := make([]ent.Value, 0, len(m.removedentry_shares))
+		for id := range m.removedentry_shares {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeCreatedAnalysisJobs:
+		ids := make([]ent.Value, 0, len(m.removedcreated_analysis_jobs))
+		for id := range m.removedcreated_analysis_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeAssignedGoals:
+		ids := make([]ent.Value, 0, len(m.removedassigned_goals))
+		for id := range m.removedassigned_goals {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeAssignedExercises:
+		ids := make([]ent.Value, 0, len(m.removedassigned_exercises))
+		for id := range m.removedassigned_exercises {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeQuestionnaireAssignments:
+		ids := make([]ent.Value, 0, len(m.removedquestionnaire_assignments))
+		for id := range m.removedquestionnaire_assignments {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeQuestionnaireDefinitions:
+		ids := make([]ent.Value, 0, len(m.removedquestionnaire_definitions))
+		for id := range m.removedquestionnaire_definitions {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeReminderSchedules:
+		ids := make([]ent.Value, 0, len(m.removedreminder_schedules))
+		for id := range m.removedreminder_schedules {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeWebhookSubscriptions:
+		ids := make([]ent.Value, 0, len(m.removedwebhook_subscriptions))
+		for id := range m.removedwebhook_subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case doctor.EdgeVocabularyTerms:
+		ids := make([]ent.Value, 0, len(m.removedvocabulary_terms))
+		for id := range m.removedvocabulary_terms {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DoctorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 15)
+	if m.clearedpractice {
+		edges = append(edges, doctor.EdgePractice)
+	}
+	if m.clearedpatient_links {
+		edges = append(edges, doctor.EdgePatientLinks)
+	}
+	if m.clearedpairing_codes {
+		edges = append(edges, doctor.EdgePairingCodes)
+	}
+	if m.clearedapproved_patient_links {
+		edges = append(edges, doctor.EdgeApprovedPatientLinks)
+	}
+	if m.clearedentry_shares {
+		edges = append(edges, doctor.EdgeEntryShares)
+	}
+	if m.clearedcomments {
+		edges = append(edges, doctor.EdgeComments)
+	}
+	if m.clearedcreated_analysis_jobs {
+		edges = append(edges, doctor.EdgeCreatedAnalysisJobs)
+	}
+	if m.clearedassigned_goals {
+		edges = append(edges, doctor.EdgeAssignedGoals)
+	}
+	if m.clearedassigned_exercises {
+		edges = append(edges, doctor.EdgeAssignedExercises)
+	}
+	if m.clearedsessions {
+		edges = append(edges, doctor.EdgeSessions)
+	}
+	if m.clearedquestionnaire_assignments {
+		edges = append(edges, doctor.EdgeQuestionnaireAssignments)
+	}
+	if m.clearedquestionnaire_definitions {
+		edges = append(edges, doctor.EdgeQuestionnaireDefinitions)
+	}
+	if m.clearedreminder_schedules {
+		edges = append(edges, doctor.EdgeReminderSchedules)
+	}
+	if m.clearedwebhook_subscriptions {
+		edges = append(edges, doctor.EdgeWebhookSubscriptions)
+	}
+	if m.clearedvocabulary_terms {
+		edges = append(edges, doctor.EdgeVocabularyTerms)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DoctorMutation) EdgeCleared(name string) bool {
+	switch name {
+	case doctor.EdgePractice:
+		return m.clearedpractice
+	case doctor.EdgePatientLinks:
+		return m.clearedpatient_links
+	case doctor.EdgePairingCodes:
+		return m.clearedpairing_codes
+	case doctor.EdgeApprovedPatientLinks:
+		return m.clearedapproved_patient_links
+	case doctor.EdgeEntryShares:
+		return m.clearedentry_shares
+	case doctor.EdgeComments:
+		return m.clearedcomments
+	case doctor.EdgeCreatedAnalysisJobs:
+		return m.clearedcreated_analysis_jobs
+	case doctor.EdgeAssignedGoals:
+		return m.clearedassigned_goals
+	case doctor.EdgeAssignedExercises:
+		return m.clearedassigned_exercises
+	case doctor.EdgeSessions:
+		return m.clearedsessions
+	case doctor.EdgeQuestionnaireAssignments:
+		return m.clearedquestionnaire_assignments
+	case doctor.EdgeQuestionnaireDefinitions:
+		return m.clearedquestionnaire_definitions
+	case doctor.EdgeReminderSchedules:
+		return m.clearedreminder_schedules
+	case doctor.EdgeWebhookSubscriptions:
+		return m.clearedwebhook_subscriptions
+	case doctor.EdgeVocabularyTerms:
+		return m.clearedvocabulary_terms
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DoctorMutation) ClearEdge(name string) error {
+	switch name {
+	case doctor.EdgePractice:
+		m.ClearPractice()
+		return nil
+	}
+	return fmt.Errorf("unknown Doctor unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DoctorMutation) ResetEdge(name string) error {
+	switch name {
+	case doctor.EdgePractice:
+		m.ResetPractice()
+		return nil
+	case doctor.EdgePatientLinks:
+		m.ResetPatientLinks()
+		return nil
+	case doctor.EdgePairingCodes:
+		m.ResetPairingCodes()
+		return nil
+	case doctor.EdgeApprovedPatientLinks:
+		m.ResetApprovedPatientLinks()
+		return nil
+	case doctor.EdgeEntryShares:
+		m.ResetEntryShares()
+		return nil
+	case doctor.EdgeComments:
+		m.ResetComments()
+		return nil
+	case doctor.EdgeCreatedAnalysisJobs:
+		m.ResetCreatedAnalysisJobs()
+		return nil
+	case doctor.EdgeAssignedGoals:
+		m.ResetAssignedGoals()
+		return nil
+	case doctor.EdgeAssignedExercises:
+		m.ResetAssignedExercises()
+		return nil
+	case doctor.EdgeSessions:
+		m.ResetSessions()
+		return nil
+	case doctor.EdgeQuestionnaireAssignments:
+		m.ResetQuestionnaireAssignments()
+		return nil
+	case doctor.EdgeQuestionnaireDefinitions:
+		m.ResetQuestionnaireDefinitions()
+		return nil
+	case doctor.EdgeReminderSchedules:
+		m.ResetReminderSchedules()
+		return nil
+	case doctor.EdgeWebhookSubscriptions:
+		m.ResetWebhookSubscriptions()
+		return nil
+	case doctor.EdgeVocabularyTerms:
+		m.ResetVocabularyTerms()
+		return nil
+	}
+	return fmt.Errorf("unknown Doctor edge %s", name)
+}
+
+// DoctorPatientLinkMutation represents an operation that mutates the DoctorPatientLink nodes in the graph.
+type DoctorPatientLinkMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	created_at         *time.Time
+	updated_at         *time.Time
+	status             *doctorpatientlink.Status
+	requested_at       *time.Time
+	approved_at        *time.Time
+	clearedFields      map[string]struct{}
+	doctor             *uuid.UUID
+	cleareddoctor      bool
+	patient            *uuid.UUID
+	clearedpatient     bool
+	approved_by        *uuid.UUID
+	clearedapproved_by bool
+	done               bool
+	oldValue           func(context.Context) (*DoctorPatientLink, error)
+	predicates         []predicate.DoctorPatientLink
+}
+
+var _ ent.Mutation = (*DoctorPatientLinkMutation)(nil)
+
+// doctorpatientlinkOption allows management of the mutation configuration using functional options.
+type doctorpatientlinkOption func(*DoctorPatientLinkMutation)
+
+// newDoctorPatientLinkMutation creates new mutation for the DoctorPatientLink entity.
+func newDoctorPatientLinkMutation(c config, op Op, opts ...doctorpatientlinkOption) *DoctorPatientLinkMutation {
+	m := &DoctorPatientLinkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDoctorPatientLink,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDoctorPatientLinkID sets the ID field of the mutation.
+func withDoctorPatientLinkID(id uuid.UUID) doctorpatientlinkOption {
+	return func(m *DoctorPatientLinkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DoctorPatientLink
+		)
+		m.oldValue = func(ctx context.Context) (*DoctorPatientLink, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DoctorPatientLink.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDoctorPatientLink sets the old DoctorPatientLink of the mutation.
+func withDoctorPatientLink(node *DoctorPatientLink) doctorpatientlinkOption {
+	return func(m *DoctorPatientLinkMutation) {
+		m.oldValue = func(context.Context) (*DoctorPatientLink, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DoctorPatientLinkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DoctorPatientLinkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DoctorPatientLink entities.
+func (m *DoctorPatientLinkMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DoctorPatientLinkMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DoctorPatientLinkMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DoctorPatientLink.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DoctorPatientLinkMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DoctorPatientLinkMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DoctorPatientLink entity.
+// If the DoctorPatientLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorPatientLinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DoctorPatientLinkMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DoctorPatientLinkMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DoctorPatientLinkMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DoctorPatientLink entity.
+// If the DoctorPatientLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorPatientLinkMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DoctorPatientLinkMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (m *DoctorPatientLinkMutation) SetDoctorID(u uuid.UUID) {
+	m.doctor = &u
+}
+
+// DoctorID returns the value of the "doctor_id" field in the mutation.
+func (m *DoctorPatientLinkMutation) DoctorID() (r uuid.UUID, exists bool) {
+	v := m.doctor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDoctorID returns the old "doctor_id" field's value of the DoctorPatientLink entity.
+// If the DoctorPatientLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorPatientLinkMutation) OldDoctorID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDoctorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDoctorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDoctorID: %w", err)
+	}
+	return oldValue.DoctorID, nil
+}
+
+// ResetDoctorID resets all changes to the "doctor_id" field.
+func (m *DoctorPatientLinkMutation) ResetDoctorID() {
+	m.doctor = nil
+}
+
+// SetPatientID sets the "patient_id" field.
+func (m *DoctorPatientLinkMutation) SetPatientID(u uuid.UUID) {
+	m.patient = &u
+}
+
+// PatientID returns the value of the "patient_id" field in the mutation.
+func (m *DoctorPatientLinkMutation) PatientID() (r uuid.UUID, exists bool) {
+	v := m.patient
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPatientID returns the old "patient_id" field's value of the DoctorPatientLink entity.
+// If the DoctorPatientLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorPatientLinkMutation) OldPatientID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPatientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPatientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPatientID: %w", err)
+	}
+	return oldValue.PatientID, nil
+}
+
+// ResetPatientID resets all changes to the "patient_id" field.
+func (m *DoctorPatientLinkMutation) ResetPatientID() {
+	m.patient = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *DoctorPatientLinkMutation) SetStatus(d doctorpatientlink.Status) {
+	m.status = &d
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *DoctorPatientLinkMutation) Status() (r doctorpatientlink.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the DoctorPatientLink entity.
+// If the DoctorPatientLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorPatientLinkMutation) OldStatus(ctx context.Context) (v doctorpatientlink.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *DoctorPatientLinkMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRequestedAt sets the "requested_at" field.
+func (m *DoctorPatientLinkMutation) SetRequestedAt(t time.Time) {
+	m.requested_at = &t
+}
+
+// RequestedAt returns the value of the "requested_at" field in the mutation.
+func (m *DoctorPatientLinkMutation) RequestedAt() (r time.Time, exists bool) {
+	v := m.requested_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestedAt returns the old "requested_at" field's value of the DoctorPatientLink entity.
+// If the DoctorPatientLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorPatientLinkMutation) OldRequestedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestedAt: %w", err)
+	}
+	return oldValue.RequestedAt, nil
+}
+
+// ResetRequestedAt resets all changes to the "requested_at" field.
+func (m *DoctorPatientLinkMutation) ResetRequestedAt() {
+	m.requested_at = nil
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (m *DoctorPatientLinkMutation) SetApprovedAt(t time.Time) {
+	m.approved_at = &t
+}
+
+// ApprovedAt returns the value of the "approved_at" field in the mutation.
+func (m *DoctorPatientLinkMutation) ApprovedAt() (r time.Time, exists bool) {
+	v := m.approved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovedAt returns the old "approved_at" field's value of the DoctorPatientLink entity.
+// If the DoctorPatientLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorPatientLinkMutation) OldApprovedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprovedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprovedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovedAt: %w", err)
+	}
+	return oldValue.ApprovedAt, nil
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (m *DoctorPatientLinkMutation) ClearApprovedAt() {
+	m.approved_at = nil
+	m.clearedFields[doctorpatientlink.FieldApprovedAt] = struct{}{}
+}
+
+// ApprovedAtCleared returns if the "approved_at" field was cleared in this mutation.
+func (m *DoctorPatientLinkMutation) ApprovedAtCleared() bool {
+	_, ok := m.clearedFields[doctorpatientlink.FieldApprovedAt]
+	return ok
+}
+
+// ResetApprovedAt resets all changes to the "approved_at" field.
+func (m *DoctorPatientLinkMutation) ResetApprovedAt() {
+	m.approved_at = nil
+	delete(m.clearedFields, doctorpatientlink.FieldApprovedAt)
+}
+
+// SetApprovedByDoctorID sets the "approved_by_doctor_id" field.
+func (m *DoctorPatientLinkMutation) SetApprovedByDoctorID(u uuid.UUID) {
+	m.approved_by = &u
+}
+
+// ApprovedByDoctorID returns the value of the "approved_by_doctor_id" field in the mutation.
+func (m *DoctorPatientLinkMutation) ApprovedByDoctorID() (r uuid.UUID, exists bool) {
+	v := m.approved_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovedByDoctorID returns the old "approved_by_doctor_id" field's value of the DoctorPatientLink entity.
+// If the DoctorPatientLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DoctorPatientLinkMutation) OldApprovedByDoctorID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprovedByDoctorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprovedByDoctorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovedByDoctorID: %w", err)
+	}
+	return oldValue.ApprovedByDoctorID, nil
+}
+
+// ClearApprovedByDoctorID clears the value of the "approved_by_doctor_id" field.
+func (m *DoctorPatientLinkMutation) ClearApprovedByDoctorID() {
+	m.approved_by = nil
+	m.clearedFields[doctorpatientlink.FieldApprovedByDoctorID] = struct{}{}
+}
+
+// ApprovedByDoctorIDCleared returns if the "approved_by_doctor_id" field was cleared in this mutation.
+func (m *DoctorPatientLinkMutation) ApprovedByDoctorIDCleared() bool {
+	_, ok := m.clearedFields[doctorpatientlink.FieldApprovedByDoctorID]
+	return ok
+}
+
+// ResetApprovedByDoctorID resets all changes to the "approved_by_doctor_id" field.
+func (m *DoctorPatientLinkMutation) ResetApprovedByDoctorID() {
+	m.approved_by = nil
+	delete(m.clearedFields, doctorpatientlink.FieldApprovedByDoctorID)
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (m *DoctorPatientLinkMutation) ClearDoctor() {
+	m.cleareddoctor = true
+	m.clearedFields[doctorpatientlink.FieldDoctorID] = struct{}{}
+}
+
+// DoctorCleared reports if the "doctor" edge to the Doctor entity was cleared.
+func (m *DoctorPatientLinkMutation) DoctorCleared() bool {
+	return m.cleareddoctor
+}
+
+// DoctorIDs returns the "doctor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DoctorID instead. It exists only for internal usage by the builders.
+func (m *DoctorPatientLinkMutation) DoctorIDs() (ids []uuid.UUID) {
+	if id := m.doctor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDoctor resets all changes to the "doctor" edge.
+func (m *DoctorPatientLinkMutation) ResetDoctor() {
+	m.doctor = nil
+	m.cleareddoctor = false
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (m *DoctorPatientLinkMutation) ClearPatient() {
+	m.clearedpatient = true
+	m.clearedFields[doctorpatientlink.FieldPatientID] = struct{}{}
+}
+
+// PatientCleared reports if the "patient" edge to the Patient entity was cleared.
+func (m *DoctorPatientLinkMutation) PatientCleared() bool {
+	return m.clearedpatient
+}
+
+// PatientIDs returns the "patient" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PatientID instead. It exists only for internal usage by the builders.
+func (m *DoctorPatientLinkMutation) PatientIDs() (ids []uuid.UUID) {
+	if id := m.patient; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPatient resets all changes to the "patient" edge.
+func (m *DoctorPatientLinkMutation) ResetPatient() {
+	m.patient = nil
+	m.clearedpatient = false
+}
+
+// SetApprovedByID sets the "approved_by" edge to the Doctor entity by id.
+func (m *DoctorPatientLinkMutation) SetApprovedByID(id uuid.UUID) {
+	m.approved_by = &id
+}
+
+// ClearApprovedBy clears the "approved_by" edge to the Doctor entity.
+func (m *DoctorPatientLinkMutation) ClearApprovedBy() {
+	m.clearedapproved_by = true
+	m.clearedFields[doctorpatientlink.FieldApprovedByDoctorID] = struct{}{}
+}
+
+// ApprovedByCleared reports if the "approved_by" edge to the Doctor entity was cleared.
+func (m *DoctorPatientLinkMutation) ApprovedByCleared() bool {
+	return m.ApprovedByDoctorIDCleared() || m.clearedapproved_by
+}
+
+// ApprovedByID returns the "approved_by" edge ID in the mutation.
+func (m *DoctorPatientLinkMutation) ApprovedByID() (id uuid.UUID, exists bool) {
+	if m.approved_by != nil {
+		return *m.approved_by, true
+	}
+	return
+}
+
+// ApprovedByIDs returns the "approved_by" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ApprovedByID instead. It exists only for internal usage by the builders.
+func (m *DoctorPatientLinkMutation) ApprovedByIDs() (ids []uuid.UUID) {
+	if id := m.approved_by; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetApprovedBy resets all changes to the "approved_by" edge.
+func (m *DoctorPatientLinkMutation) ResetApprovedBy() {
+	m.approved_by = nil
+	m.clearedapproved_by = false
+}
+
+// Where appends a list predicates to the DoctorPatientLinkMutation builder.
+func (m *DoctorPatientLinkMutation) Where(ps ...predicate.DoctorPatientLink) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DoctorPatientLinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DoctorPatientLinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DoctorPatientLink, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DoctorPatientLinkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DoctorPatientLinkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DoctorPatientLink).
+func (m *DoctorPatientLinkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DoctorPatientLinkMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, doctorpatientlink.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, doctorpatientlink.FieldUpdatedAt)
+	}
+	if m.doctor != nil {
+		fields = append(fields, doctorpatientlink.FieldDoctorID)
+	}
+	if m.patient != nil {
+		fields = append(fields, doctorpatientlink.FieldPatientID)
+	}
+	if m.status != nil {
+		fields = append(fields, doctorpatientlink.FieldStatus)
+	}
+	if m.requested_at != nil {
+		fields = append(fields, doctorpatientlink.FieldRequestedAt)
+	}
+	if m.approved_at != nil {
+		fields = append(fields, doctorpatientlink.FieldApprovedAt)
+	}
+	if m.approved_by != nil {
+		fields = append(fields, doctorpatientlink.FieldApprovedByDoctorID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DoctorPatientLinkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case doctorpatientlink.FieldCreatedAt:
+		return m.CreatedAt()
+	case doctorpatientlink.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case doctorpatientlink.FieldDoctorID:
+		return m.DoctorID()
+	case doctorpatientlink.FieldPatientID:
+		return m.PatientID()
+	case doctorpatientlink.FieldStatus:
+		return m.Status()
+	case doctorpatientlink.FieldRequestedAt:
+		return m.RequestedAt()
+	case doctorpatientlink.FieldApprovedAt:
+		return m.ApprovedAt()
+	case doctorpatientlink.FieldApprovedByDoctorID:
+		return m.ApprovedByDoctorID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DoctorPatientLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case doctorpatientlink.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case doctorpatientlink.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case doctorpatientlink.FieldDoctorID:
+		return m.OldDoctorID(ctx)
+	case doctorpatientlink.FieldPatientID:
+		return m.OldPatientID(ctx)
+	case doctorpatientlink.FieldStatus:
+		return m.OldStatus(ctx)
+	case doctorpatientlink.FieldRequestedAt:
+		return m.OldRequestedAt(ctx)
+	case doctorpatientlink.FieldApprovedAt:
+		return m.OldApprovedAt(ctx)
+	case doctorpatientlink.FieldApprovedByDoctorID:
+		return m.OldApprovedByDoctorID(ctx)
+	}
+	return nil, fmt.Errorf("unknown DoctorPatientLink field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DoctorPatientLinkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case doctorpatientlink.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case doctorpatientlink.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case doctorpatientlink.FieldDoctorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDoctorID(v)
+		return nil
+	case doctorpatientlink.FieldPatientID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPatientID(v)
+		return nil
+	case doctorpatientlink.FieldStatus:
+		v, ok := value.(doctorpatientlink.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case doctorpatientlink.FieldRequestedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestedAt(v)
+		return nil
+	case doctorpatientlink.FieldApprovedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovedAt(v)
+		return nil
+	case doctorpatientlink.FieldApprovedByDoctorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovedByDoctorID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DoctorPatientLink field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DoctorPatientLinkMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DoctorPatientLinkMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DoctorPatientLinkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DoctorPatientLink numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DoctorPatientLinkMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(doctorpatientlink.FieldApprovedAt) {
+		fields = append(fields, doctorpatientlink.FieldApprovedAt)
+	}
+	if m.FieldCleared(doctorpatientlink.FieldApprovedByDoctorID) {
+		fields = append(fields, doctorpatientlink.FieldApprovedByDoctorID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DoctorPatientLinkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DoctorPatientLinkMutation) ClearField(name string) error {
+	switch name {
+	case doctorpatientlink.FieldApprovedAt:
+		m.ClearApprovedAt()
+		return nil
+	case doctorpatientlink.FieldApprovedByDoctorID:
+		m.ClearApprovedByDoctorID()
+		return nil
+	}
+	return fmt.Errorf("unknown DoctorPatientLink nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DoctorPatientLinkMutation) ResetField(name string) error {
+	switch name {
+	case doctorpatientlink.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case doctorpatientlink.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case doctorpatientlink.FieldDoctorID:
+		m.ResetDoctorID()
+		return nil
+	case doctorpatientlink.FieldPatientID:
+		m.ResetPatientID()
+		return nil
+	case doctorpatientlink.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case doctorpatientlink.FieldRequestedAt:
+		m.ResetRequestedAt()
+		return nil
+	case doctorpatientlink.FieldApprovedAt:
+		m.ResetApprovedAt()
+		return nil
+	case doctorpatientlink.FieldApprovedByDoctorID:
+		m.ResetApprovedByDoctorID()
+		return nil
+	}
+	return fmt.Errorf("unknown DoctorPatientLink field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DoctorPatientLinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.doctor != nil {
+		edges = append(edges, doctorpatientlink.EdgeDoctor)
+	}
+	if m.patient != nil {
+		edges = append(edges, doctorpatientlink.EdgePatient)
+	}
+	if m.approved_by != nil {
+		edges = append(edges, doctorpatientlink.EdgeApprovedBy)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DoctorPatientLinkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case doctorpatientlink.EdgeDoctor:
+		if id := m.doctor; id != nil {
+			return []ent.Value{*id}
+		}
+	case doctorpatientlink.EdgePatient:
+		if id := m.patient; id != nil {
+			return []ent.Value{*id}
+		}
+	case doctorpatientlink.EdgeApprovedBy:
+		if id := m.approved_by; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DoctorPatientLinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DoctorPatientLinkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DoctorPatientLinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareddoctor {
+		edges = append(edges, doctorpatientlink.EdgeDoctor)
+	}
+	if m.clearedpatient {
+		edges = append(edges, doctorpatientlink.EdgePatient)
+	}
+	if m.clearedapproved_by {
+		edges = append(edges, doctorpatientlink.EdgeApprovedBy)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DoctorPatientLinkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case doctorpatientlink.EdgeDoctor:
+		return m.cleareddoctor
+	case doctorpatientlink.EdgePatient:
+		return m.clearedpatient
+	case doctorpatientlink.EdgeApprovedBy:
+		return m.clearedapproved_by
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DoctorPatientLinkMutation) ClearEdge(name string) error {
+	switch name {
+	case doctorpatientlink.EdgeDoctor:
+		m.ClearDoctor()
+		return nil
+	case doctorpatientlink.EdgePatient:
+		m.ClearPatient()
+		return nil
+	case doctorpatientlink.EdgeApprovedBy:
+		m.ClearApprovedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown DoctorPatientLink unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DoctorPatientLinkMutation) ResetEdge(name string) error {
+	switch name {
+	case doctorpatientlink.EdgeDoctor:
+		m.ResetDoctor()
+		return nil
+	case doctorpatientlink.EdgePatient:
+		m.ResetPatient()
+		return nil
+	case doctorpatientlink.EdgeApprovedBy:
+		m.ResetApprovedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown DoctorPatientLink edge %s", name)
+}
+
+// EntryMutation represents an operation that mutates the Entry nodes in the graph.
+type EntryMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	created_at           *time.Time
+	updated_at           *time.Time
+	happened_at          *time.Time
+	situation            *string
+	emotions             *[]schema.Emotion
+	appendemotions       []schema.Emotion
+	triggers             *[]string
+	appendtriggers       []string
+	techniques           *[]string
+	appendtechniques     []string
+	stutter_frequency    *int
+	addstutter_frequency *int
+	notes                *string
+	tags                 *[]string
+	appendtags           []string
+	clearedFields        map[string]struct{}
+	patient              *uuid.UUID
+	clearedpatient       bool
+	shares               map[uuid.UUID]struct{}
+	removedshares        map[uuid.UUID]struct{}
+	clearedshares        bool
+	comments             map[uuid.UUID]struct{}
+	removedcomments      map[uuid.UUID]struct{}
+	clearedcomments      bool
+	analysis_jobs        map[uuid.UUID]struct{}
+	removedanalysis_jobs map[uuid.UUID]struct{}
+	clearedanalysis_jobs bool
+	exercise_logs        map[uuid.UUID]struct{}
+	removedexercise_logs map[uuid.UUID]struct{}
+	clearedexercise_logs bool
+	sessions             map[uuid.UUID]struct{}
+	removedsessions      map[uuid.UUID]struct{}
+	clearedsessions      bool
+	done                 bool
+	oldValue             func(context.Context) (*Entry, error)
+	predicates           []predicate.Entry
+}
+
+var _ ent.Mutation = (*EntryMutation)(nil)
+
+// entryOption allows management of the mutation configuration using functional options.
+type entryOption func(*EntryMutation)
+
+// newEntryMutation creates new mutation for the Entry entity.
+func newEntryMutation(c config, op Op, opts ...entryOption) *EntryMutation {
+	m := &EntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEntryID sets the ID field of the mutation.
+func withEntryID(id uuid.UUID) entryOption {
+	return func(m *EntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Entry
+		)
+		m.oldValue = func(ctx context.Context) (*Entry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Entry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEntry sets the old Entry of the mutation.
+func withEntry(node *Entry) entryOption {
+	return func(m *EntryMutation) {
+		m.oldValue = func(context.Context) (*Entry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Entry entities.
+func (m *EntryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EntryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EntryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Entry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Entry entity.
+// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EntryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EntryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EntryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Entry entity.
+// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EntryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetPatientID sets the "patient_id" field.
+func (m *EntryMutation) SetPatientID(u uuid.UUID) {
+	m.patient = &u
+}
+
+// PatientID returns the value of the "patient_id" field in the mutation.
+func (m *EntryMutation) PatientID() (r uuid.UUID, exists bool) {
+	v := m.patient
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPatientID returns the old "patient_id" field's value of the Entry entity.
+// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryMutation) OldPatientID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPatientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPatientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPatientID: %w", err)
+	}
+	return oldValue.PatientID, nil
+}
+
+// ResetPatientID resets all changes to the "patient_id" field.
+func (m *EntryMutation) ResetPatientID() {
+	m.patient = nil
+}
+
+// SetHappenedAt sets the "happened_at" field.
+func (m *EntryMutation) SetHappenedAt(t time.Time) {
+	m.happened_at = &t
+}
+
+// HappenedAt returns the value of the "happened_at" field in the mutation.
+func (m *EntryMutation) HappenedAt() (r time.Time, exists bool) {
+	v := m.happened_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHappenedAt returns the old "happened_at" field's value of the Entry entity.
+// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryMutation) OldHappenedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHappenedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHappenedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHappenedAt: %w", err)
+	}
+	return oldValue.HappenedAt, nil
+}
+
+// ResetHappenedAt resets all changes to the "happened_at" field.
+func (m *EntryMutation) ResetHappenedAt() {
+	m.happened_at = nil
+}
+
+// SetSituation sets the "situation" field.
+func (m *EntryMutation) SetSituation(s string) {
+	m.situation = &s
+}
+
+// Situation returns the value of the "situation" field in the mutation.
+func (m *EntryMutation) Situation() (r string, exists bool) {
+	v := m.situation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSituation returns the old "situation" field's value of the Entry entity.
+// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryMutation) OldSituation(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSituation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSituation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSituation: %w", err)
+	}
+	return oldValue.Situation, nil
+}
+
+// ClearSituation clears the value of the "situation" field.
+func (m *EntryMutation) ClearSituation() {
+	m.situation = nil
+	m.clearedFields[entry.FieldSituation] = struct{}{}
+}
+
+// SituationCleared returns if the "situation" field was cleared in this mutation.
+func (m *EntryMutation) SituationCleared() bool {
+	_, ok := m.clearedFields[entry.FieldSituation]
+	return ok
+}
+
+// ResetSituation resets all changes to the "situation" field.
+func (m *EntryMutation) ResetSituation() {
+	m.situation = nil
+	delete(m.clearedFields, entry.FieldSituation)
+}
+
+// SetEmotions sets the "emotions" field.
+func (m *EntryMutation) SetEmotions(s []schema.Emotion) {
+	m.emotions = &s
+	m.appendemotions = nil
+}
+
+// Emotions returns the value of the "emotions" field in the mutation.
+func (m *EntryMutation) Emotions() (r []schema.Emotion, exists bool) {
+	v := m.emotions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmotions returns the old "emotions" field's value of the Entry entity.
+// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryMutation) OldEmotions(ctx context.Context) (v []schema.Emotion, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmotions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmotions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmotions: %w", err)
+	}
+	return oldValue.Emotions, nil
+}
+
+// AppendEmotions adds s to the "emotions" field.
+func (m *EntryMutation) AppendEmotions(s []schema.Emotion) {
+	m.appendemotions = append(m.appendemotions, s...)
+}
+
+// AppendedEmotions returns the list of values that were appended to the "emotions" field in this mutation.
+func (m *EntryMutation) AppendedEmotions() ([]schema.Emotion, bool) {
+	if len(m.appendemotions) == 0 {
+		return nil, false
+	}
+	return m.appendemotions, true
+}
+
+// ClearEmotions clears the value of the "emotions" field.
+func (m *EntryMutation) ClearEmotions() {
+	m.emotions = nil
+	m.appendemotions = nil
+	m.clearedFields[entry.FieldEmotions] = struct{}{}
+}
+
+// EmotionsCleared returns if the "emotions" field was cleared in this mutation.
+func (m *EntryMutation) EmotionsCleared() bool {
+	_, ok := m.clearedFields[entry.FieldEmotions]
+	return ok
+}
+
+// ResetEmotions resets all changes to the "emotions" field.
+func (m *EntryMutation) ResetEmotions() {
+	m.emotions = nil
+	m.appendemotions = nil
+	delete(m.clearedFields, entry.FieldEmotions)
+}
+
+// SetTriggers sets the "triggers" field.
+func (m *EntryMutation) SetTriggers(s []string) {
+	m.triggers = &s
+	m.appendtriggers = nil
+}
+
+// Triggers returns the value of the "triggers" field in the mutation.
+func (m *EntryMutation) Triggers() (r []string, exists bool) {
+	v := m.triggers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTriggers returns the old "triggers" field's value of the Entry entity.
+// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryMutation) OldTriggers(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTriggers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTriggers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTriggers: %w", err)
+	}
+	return oldValue.Triggers, nil
+}
+
+// AppendTriggers adds s to the "triggers" field.
+func (m *EntryMutation) AppendTriggers(s []string) {
+	m.appendtriggers = append(m.appendtriggers, s...)
+}
+
+// AppendedTriggers returns the list of values that were appended to the "triggers" field in this mutation.
+func (m *EntryMutation) AppendedTriggers() ([]string, bool) {
+	if len(m.appendtriggers) == 0 {
+		return nil, false
+	}
+	return m.appendtriggers, true
+}
+
+// ClearTriggers clears the value of the "triggers" field.
+func (m *EntryMutation) ClearTriggers() {
+	m.triggers = nil
+	m.appendtriggers = nil
+	m.clearedFields[entry.FieldTriggers] = struct{}{}
+}
+
+// TriggersCleared returns if the "triggers" field was cleared in this mutation.
+func (m *EntryMutation) TriggersCleared() bool {
+	_, ok := m.clearedFields[entry.FieldTriggers]
+	return ok
+}
+
+// ResetTriggers resets all changes to the "triggers" field.
+func (m *EntryMutation) ResetTriggers() {
+	m.triggers = nil
+	m.appendtriggers = nil
+	delete(m.clearedFields, entry.FieldTriggers)
+}
+
+// SetTechniques sets the "techniques" field.
+func (m *EntryMutation) SetTechniques(s []string) {
+	m.techniques = &s
+	m.appendtechniques = nil
+}
+
+// Techniques returns the value of the "techniques" field in the mutation.
+func (m *EntryMutation) Techniques() (r []string, exists bool) {
+	v := m.techniques
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTechniques returns the old "techniques" field's value of the Entry entity.
+// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryMutation) OldTechniques(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTechniques is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTechniques requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTechniques: %w", err)
+	}
+	return oldValue.Techniques, nil
+}
+
+// AppendTechniques adds s to the "techniques" field.
+func (m *EntryMutation) AppendTechniques(s []string) {
+	m.appendtechniques = append(m.appendtechniques, s...)
+}
+
+// AppendedTechniques returns the list of values that were appended to the "techniques" field in this mutation.
+func (m *EntryMutation) AppendedTechniques() ([]string, bool) {
+	if len(m.appendtechniques) == 0 {
+		return nil, false
+	}
+	return m.appendtechniques, true
+}
+
+// ClearTechniques clears the value of the "techniques" field.
+func (m *EntryMutation) ClearTechniques() {
+	m.techniques = nil
+	m.appendtechniques = nil
+	m.clearedFields[entry.FieldTechniques] = struct{}{}
+}
+
+// TechniquesCleared returns if the "techniques" field was cleared in this mutation.
+func (m *EntryMutation) TechniquesCleared() bool {
+	_, ok := m.clearedFields[entry.FieldTechniques]
+	return ok
+}
+
+// ResetTechniques resets all changes to the "techniques" field.
+func (m *EntryMutation) ResetTechniques() {
+	m.techniques = nil
+	m.appendtechniques = nil
+	delete(m.clearedFields, entry.FieldTechniques)
+}
+
+// SetStutterFrequency sets the "stutter_frequency" field.
+func (m *EntryMutation) SetStutterFrequency(i int) {
+	m.stutter_frequency = &i
+	m.addstutter_frequency = nil
+}
+
+// StutterFrequency returns the value of the "stutter_frequency" field in the mutation.
+func (m *EntryMutation) StutterFrequency() (r int, exists bool) {
+	v := m.stutter_frequency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStutterFrequency returns the old "stutter_frequency" field's value of the Entry entity.
+// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryMutation) OldStutterFrequency(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStutterFrequency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStutterFrequency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStutterFrequency: %w", err)
+	}
+	return oldValue.StutterFrequency, nil
+}
+
+// AddStutterFrequency adds i to the "stutter_frequency" field.
+func (m *EntryMutation) AddStutterFrequency(i int) {
+	if m.addstutter_frequency != nil {
+		*m.addstutter_frequency += i
+	} else {
+		m.addstutter_frequency = &i
+	}
+}
+
+// AddedStutterFrequency returns the value that was added to the "stutter_frequency" field in this mutation.
+func (m *EntryMutation) AddedStutterFrequency() (r int, exists bool) {
+	v := m.addstutter_frequency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStutterFrequency clears the value of the "stutter_frequency" field.
+func (m *EntryMutation) ClearStutterFrequency() {
+	m.stutter_frequency = nil
+	m.addstutter_frequency = nil
+	m.clearedFields[entry.FieldStutterFrequency] = struct{}{}
+}
+
+// StutterFrequencyCleared returns if the "stutter_frequency" field was cleared in this mutation.
+func (m *EntryMutation) StutterFrequencyCleared() bool {
+	_, ok := m.clearedFields[entry.FieldStutterFrequency]
+	return ok
+}
+
+// ResetStutterFrequency resets all changes to the "stutter_frequency" field.
+func (m *EntryMutation) ResetStutterFrequency() {
+	m.stutter_frequency = nil
+	m.addstutter_frequency = nil
+	delete(m.clearedFields, entry.FieldStutterFrequency)
+}
+
+// SetNotes sets the "notes" field.
+func (m *EntryMutation) SetNotes(s string) {
+	m.notes = &s
+}
+
+// Notes returns the value of the "notes" field in the mutation.
+func (m *EntryMutation) Notes() (r string, exists bool) {
+	v := m.notes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotes returns the old "notes" field's value of the Entry entity.
+// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryMutation) OldNotes(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+	}
+	return oldValue.Notes, nil
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (m *EntryMutation) ClearNotes() {
+	m.notes = nil
+	m.clearedFields[entry.FieldNotes] = struct{}{}
+}
+
+// NotesCleared returns if the "notes" field was cleared in this mutation.
+func (m *EntryMutation) NotesCleared() bool {
+	_, ok := m.clearedFields[entry.FieldNotes]
+	return ok
+}
+
+// ResetNotes resets all changes to the "notes" field.
+func (m *EntryMutation) ResetNotes() {
+	m.notes = nil
+	delete(m.clearedFields, entry.FieldNotes)
+}
+
+// SetTags sets the "tags" field.
+func (m *EntryMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *EntryMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the Entry entity.
+// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *EntryMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *EntryMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *EntryMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[entry.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *EntryMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[entry.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *EntryMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, entry.FieldTags)
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (m *EntryMutation) ClearPatient() {
+	m.clearedpatient = true
+	m.clearedFields[entry.FieldPatientID] = struct{}{}
+}
+
+// PatientCleared reports if the "patient" edge to the Patient entity was cleared.
+func (m *EntryMutation) PatientCleared() bool {
+	return m.clearedpatient
+}
+
+// PatientIDs returns the "patient" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PatientID instead. It exists only for internal usage by the builders.
+func (m *EntryMutation) PatientIDs() (ids []uuid.UUID) {
+	if id := m.patient; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPatient resets all changes to the "patient" edge.
+func (m *EntryMutation) ResetPatient() {
+	m.patient = nil
+	m.clearedpatient = false
+}
+
+// AddShareIDs adds the "shares" edge to the EntryShare entity by ids.
+func (m *EntryMutation) AddShareIDs(ids ...uuid.UUID) {
+	if m.shares == nil {
+		m.shares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.shares[ids[i]] = struct{}{}
+	}
+}
+
+// ClearShares clears the "shares" edge to the EntryShare entity.
+func (m *EntryMutation) ClearShares() {
+	m.clearedshares = true
+}
+
+// SharesCleared reports if the "shares" edge to the EntryShare entity was cleared.
+func (m *EntryMutation) SharesCleared() bool {
+	return m.clearedshares
+}
+
+// RemoveShareIDs removes the "shares" edge to the EntryShare entity by IDs.
+func (m *EntryMutation) RemoveShareIDs(ids ...uuid.UUID) {
+	if m.removedshares == nil {
+		m.removedshares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.shares, ids[i])
+		m.removedshares[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShares returns the removed IDs of the "shares" edge to the EntryShare entity.
+func (m *EntryMutation) RemovedSharesIDs() (ids []uuid.UUID) {
+	for id := range m.removedshares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SharesIDs returns the "shares" edge IDs in the mutation.
+func (m *EntryMutation) SharesIDs() (ids []uuid.UUID) {
+	for id := range m.shares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShares resets all changes to the "shares" edge.
+func (m *EntryMutation) ResetShares() {
+	m.shares = nil
+	m.clearedshares = false
+	m.removedshares = nil
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *EntryMutation) AddCommentIDs(ids ...uuid.UUID) {
+	if m.comments == nil {
+		m.comments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *EntryMutation) ClearComments() {
+	m.clearedcomments = true
+}
+
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *EntryMutation) CommentsCleared() bool {
+	return m.clearedcomments
+}
+
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *EntryMutation) RemoveCommentIDs(ids ...uuid.UUID) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *EntryMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *EntryMutation) CommentsIDs() (ids []uuid.UUID) {
+	for id := range m.comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetComments resets all changes to the "comments" edge.
+func (m *EntryMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// AddAnalysisJobIDs adds the "analysis_jobs" edge to the AnalysisJob entity by ids.
+func (m *EntryMutation) AddAnalysisJobIDs(ids ...uuid.UUID) {
+	if m.analysis_jobs == nil {
+		m.analysis_jobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.analysis_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnalysisJobs clears the "analysis_jobs" edge to the AnalysisJob entity.
+func (m *EntryMutation) ClearAnalysisJobs() {
+	m.clearedanalysis_jobs = true
+}
+
+// AnalysisJobsCleared reports if the "analysis_jobs" edge to the AnalysisJob entity was cleared.
+func (m *EntryMutation) AnalysisJobsCleared() bool {
+	return m.clearedanalysis_jobs
+}
+
+// RemoveAnalysisJobIDs removes the "analysis_jobs" edge to the AnalysisJob entity by IDs.
+func (m *EntryMutation) RemoveAnalysisJobIDs(ids ...uuid.UUID) {
+	if m.removedanalysis_jobs == nil {
+		m.removedanalysis_jobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.analysis_jobs, ids[i])
+		m.removedanalysis_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnalysisJobs returns the removed IDs of the "analysis_jobs" edge to the AnalysisJob entity.
+func (m *EntryMutation) RemovedAnalysisJobsIDs() (ids []uuid.UUID) {
+	for id := range m.removedanalysis_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnalysisJobsIDs returns the "analysis_jobs" edge IDs in the mutation.
+func (m *EntryMutation) AnalysisJobsIDs() (ids []uuid.UUID) {
+	for id := range m.analysis_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnalysisJobs resets all changes to the "analysis_jobs" edge.
+func (m *EntryMutation) ResetAnalysisJobs() {
+	m.analysis_jobs = nil
+	m.clearedanalysis_jobs = false
+	m.removedanalysis_jobs = nil
+}
+
+// AddExerciseLogIDs adds the "exercise_logs" edge to the ExerciseLog entity by ids.
+func (m *EntryMutation) AddExerciseLogIDs(ids ...uuid.UUID) {
+	if m.exercise_logs == nil {
+		m.exercise_logs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.exercise_logs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearExerciseLogs clears the "exercise_logs" edge to the ExerciseLog entity.
+func (m *EntryMutation) ClearExerciseLogs() {
+	m.clearedexercise_logs = true
+}
+
+// ExerciseLogsCleared reports if the "exercise_logs" edge to the ExerciseLog entity was cleared.
+func (m *EntryMutation) ExerciseLogsCleared() bool {
+	return m.clearedexercise_logs
+}
+
+// RemoveExerciseLogIDs removes the "exercise_logs" edge to the ExerciseLog entity by IDs.
+func (m *EntryMutation) RemoveExerciseLogIDs(ids ...uuid.UUID) {
+	if m.removedexercise_logs == nil {
+		m.removedexercise_logs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.exercise_logs, ids[i])
+		m.removedexercise_logs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedExerciseLogs returns the removed IDs of the "exercise_logs" edge to the ExerciseLog entity.
+func (m *EntryMutation) RemovedExerciseLogsIDs() (ids []uuid.UUID) {
+	for id := range m.removedexercise_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ExerciseLogsIDs returns the "exercise_logs" edge IDs in the mutation.
+func (m *EntryMutation) ExerciseLogsIDs() (ids []uuid.UUID) {
+	for id := range m.exercise_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetExerciseLogs resets all changes to the "exercise_logs" edge.
+func (m *EntryMutation) ResetExerciseLogs() {
+	m.exercise_logs = nil
+	m.clearedexercise_logs = false
+	m.removedexercise_logs = nil
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
+func (m *EntryMutation) AddSessionIDs(ids ...uuid.UUID) {
+	if m.sessions == nil {
+		m.sessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *EntryMutation) ClearSessions() {
+	m.clearedsessions = true
+}
+
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *EntryMutation) SessionsCleared() bool {
+	return m.clearedsessions
+}
+
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *EntryMutation) RemoveSessionIDs(ids ...uuid.UUID) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *EntryMutation) RemovedSessionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *EntryMutation) SessionsIDs() (ids []uuid.UUID) {
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *EntryMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
+}
+
+// Where appends a list predicates to the EntryMutation builder.
+func (m *EntryMutation) Where(ps ...predicate.Entry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Entry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Entry).
+func (m *EntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EntryMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.created_at != nil {
+		fields = append(fields, entry.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, entry.FieldUpdatedAt)
+	}
+	if m.patient != nil {
+		fields = append(fields, entry.FieldPatientID)
+	}
+	if m.happened_at != nil {
+		fields = append(fields, entry.FieldHappenedAt)
+	}
+	if m.situation != nil {
+		fields = append(fields, entry.FieldSituation)
+	}
+	if m.emotions != nil {
+		fields = append(fields, entry.FieldEmotions)
+	}
+	if m.triggers != nil {
+		fields = append(fields, entry.FieldTriggers)
+	}
+	if m.techniques != nil {
+		fields = append(fields, entry.FieldTechniques)
+	}
+	if m.stutter_frequency != nil {
+		fields = append(fields, entry.FieldStutterFrequency)
+	}
+	if m.notes != nil {
+		fields = append(fields, entry.FieldNotes)
+	}
+	if m.tags != nil {
+		fields = append(fields, entry.FieldTags)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case entry.FieldCreatedAt:
+		return m.CreatedAt()
+	case entry.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case entry.FieldPatientID:
+		return m.PatientID()
+	case entry.FieldHappenedAt:
+		return m.HappenedAt()
+	case entry.FieldSituation:
+		return m.Situation()
+	case entry.FieldEmotions:
+		return m.Emotions()
+	case entry.FieldTriggers:
+		return m.Triggers()
+	case entry.FieldTechniques:
+		return m.Techniques()
+	case entry.FieldStutterFrequency:
+		return m.StutterFrequency()
+	case entry.FieldNotes:
+		return m.Notes()
+	case entry.FieldTags:
+		return m.Tags()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case entry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case entry.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case entry.FieldPatientID:
+		return m.OldPatientID(ctx)
+	case entry.FieldHappenedAt:
+		return m.OldHappenedAt(ctx)
+	case entry.FieldSituation:
+		return m.OldSituation(ctx)
+	case entry.FieldEmotions:
+		return m.OldEmotions(ctx)
+	case entry.FieldTriggers:
+		return m.OldTriggers(ctx)
+	case entry.FieldTechniques:
+		return m.OldTechniques(ctx)
+	case entry.FieldStutterFrequency:
+		return m.OldStutterFrequency(ctx)
+	case entry.FieldNotes:
+		return m.OldNotes(ctx)
+	case entry.FieldTags:
+		return m.OldTags(ctx)
+	}
+	return nil, fmt.Errorf("unknown Entry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case entry.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case entry.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case entry.FieldPatientID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPatientID(v)
+		return nil
+	case entry.FieldHappenedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHappenedAt(v)
+		return nil
+	case entry.FieldSituation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSituation(v)
+		return nil
+	case entry.FieldEmotions:
+		v, ok := value.([]schema.Emotion)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmotions(v)
+		return nil
+	case entry.FieldTriggers:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTriggers(v)
+		return nil
+	case entry.FieldTechniques:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTechniques(v)
+		return nil
+	case entry.FieldStutterFrequency:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStutterFrequency(v)
+		return nil
+	case entry.FieldNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotes(v)
+		return nil
+	case entry.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Entry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addstutter_frequency != nil {
+		fields = append(fields, entry.FieldStutterFrequency)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case entry.FieldStutterFrequency:
+		return m.AddedStutterFrequency()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case entry.FieldStutterFrequency:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStutterFrequency(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Entry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(entry.FieldSituation) {
+		fields = append(fields, entry.FieldSituation)
+	}
+	if m.FieldCleared(entry.FieldEmotions) {
+		fields = append(fields, entry.FieldEmotions)
+	}
+	if m.FieldCleared(entry.FieldTriggers) {
+		fields = append(fields, entry.FieldTriggers)
+	}
+	if m.FieldCleared(entry.FieldTechniques) {
+		fields = append(fields, entry.FieldTechniques)
+	}
+	if m.FieldCleared(entry.FieldStutterFrequency) {
+		fields = append(fields, entry.FieldStutterFrequency)
+	}
+	if m.FieldCleared(entry.FieldNotes) {
+		fields = append(fields, entry.FieldNotes)
+	}
+	if m.FieldCleared(entry.FieldTags) {
+		fields = append(fields, entry.FieldTags)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EntryMutation) ClearField(name string) error {
+	switch name {
+	case entry.FieldSituation:
+		m.ClearSituation()
+		return nil
+	case entry.FieldEmotions:
+		m.ClearEmotions()
+		return nil
+	case entry.FieldTriggers:
+		m.ClearTriggers()
+		return nil
+	case entry.FieldTechniques:
+		m.ClearTechniques()
+		return nil
+	case entry.FieldStutterFrequency:
+		m.ClearStutterFrequency()
+		return nil
+	case entry.FieldNotes:
+		m.ClearNotes()
+		return nil
+	case entry.FieldTags:
+		m.ClearTags()
+		return nil
+	}
+	return fmt.Errorf("unknown Entry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EntryMutation) ResetField(name string) error {
+	switch name {
+	case entry.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case entry.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case entry.FieldPatientID:
+		m.ResetPatientID()
+		return nil
+	case entry.FieldHappenedAt:
+		m.ResetHappenedAt()
+		return nil
+	case entry.FieldSituation:
+		m.ResetSituation()
+		return nil
+	case entry.FieldEmotions:
+		m.ResetEmotions()
+		return nil
+	case entry.FieldTriggers:
+		m.ResetTriggers()
+		return nil
+	case entry.FieldTechniques:
+		m.ResetTechniques()
+		return nil
+	case entry.FieldStutterFrequency:
+		m.ResetStutterFrequency()
+		return nil
+	case entry.FieldNotes:
+		m.ResetNotes()
+		return nil
+	case entry.FieldTags:
+		m.ResetTags()
+		return nil
+	}
+	return fmt.Errorf("unknown Entry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.patient != nil {
+		edges = append(edges, entry.EdgePatient)
+	}
+	if m.shares != nil {
+		edges = append(edges, entry.EdgeShares)
+	}
+	if m.comments != nil {
+		edges = append(edges, entry.EdgeComments)
+	}
+	if m.analysis_jobs != nil {
+		edges = append(edges, entry.EdgeAnalysisJobs)
+	}
+	if m.exercise_logs != nil {
+		edges = append(edges, entry.EdgeExerciseLogs)
+	}
+	if m.sessions != nil {
+		edges = append(edges, entry.EdgeSessions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EntryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case entry.EdgePatient:
+		if id := m.patient; id != nil {
+			return []ent.Value{*id}
+		}
+	case entry.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.shares))
+		for id := range m.shares {
+			ids = append(ids, id)
+		}
+		return ids
+	case entry.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case entry.EdgeAnalysisJobs:
+		ids := make([]ent.Value, 0, len(m.analysis_jobs))
+		for id := range m.analysis_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case entry.EdgeExerciseLogs:
+		ids := make([]ent.Value, 0, len(m.exercise_logs))
+		for id := range m.exercise_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case entry.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedshares != nil {
+		edges = append(edges, entry.EdgeShares)
+	}
+	if m.removedcomments != nil {
+		edges = append(edges, entry.EdgeComments)
+	}
+	if m.removedanalysis_jobs != nil {
+		edges = append(edges, entry.EdgeAnalysisJobs)
+	}
+	if m.removedexercise_logs != nil {
+		edges = append(edges, entry.EdgeExerciseLogs)
+	}
+	if m.removedsessions != nil {
+		edges = append(edges, entry.EdgeSessions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EntryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case entry.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.removedshares))
+		for id := range m.removedshares {
+			ids = append(ids, id)
+		}
+		return ids
+	case entry.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case entry.EdgeAnalysisJobs:
+		ids := make([]ent.Value, 0, len(m.removedanalysis_jobs))
+		for id := range m.removedanalysis_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case entry.EdgeExerciseLogs:
+		ids := make([]ent.Value, 0, len(m.removedexercise_logs))
+		for id := range m.removedexercise_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case entry.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedpatient {
+		edges = append(edges, entry.EdgePatient)
+	}
+	if m.clearedshares {
+		edges = append(edges, entry.EdgeShares)
+	}
+	if m.clearedcomments {
+		edges = append(edges, entry.EdgeComments)
+	}
+	if m.clearedanalysis_jobs {
+		edges = append(edges, entry.EdgeAnalysisJobs)
+	}
+	if m.clearedexercise_logs {
+		edges = append(edges, entry.EdgeExerciseLogs)
+	}
+	if m.clearedsessions {
+		edges = append(edges, entry.EdgeSessions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EntryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case entry.EdgePatient:
+		return m.clearedpatient
+	case entry.EdgeShares:
+		return m.clearedshares
+	case entry.EdgeComments:
+		return m.clearedcomments
+	case entry.EdgeAnalysisJobs:
+		return m.clearedanalysis_jobs
+	case entry.EdgeExerciseLogs:
+		return m.clearedexercise_logs
+	case entry.EdgeSessions:
+		return m.clearedsessions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EntryMutation) ClearEdge(name string) error {
+	switch name {
+	case entry.EdgePatient:
+		m.ClearPatient()
+		return nil
+	}
+	return fmt.Errorf("unknown Entry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EntryMutation) ResetEdge(name string) error {
+	switch name {
+	case entry.EdgePatient:
+		m.ResetPatient()
+		return nil
+	case entry.EdgeShares:
+		m.ResetShares()
+		return nil
+	case entry.EdgeComments:
+		m.ResetComments()
+		return nil
+	case entry.EdgeAnalysisJobs:
+		m.ResetAnalysisJobs()
+		return nil
+	case entry.EdgeExerciseLogs:
+		m.ResetExerciseLogs()
+		return nil
+	case entry.EdgeSessions:
+		m.ResetSessions()
+		return nil
+	}
+	return fmt.Errorf("unknown Entry edge %s", name)
+}
+
+// EntryShareMutation represents an operation that mutates the EntryShare nodes in the graph.
+type EntryShareMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	created_at                *time.Time
+	updated_at                *time.Time
+	shared_at                 *time.Time
+	revoked_at                *time.Time
+	clearedFields             map[string]struct{}
+	entry                     *uuid.UUID
+	clearedentry              bool
+	shared_by_patient         *uuid.UUID
+	clearedshared_by_patient  bool
+	shared_with_doctor        *uuid.UUID
+	clearedshared_with_doctor bool
+	done                      bool
+	oldValue                  func(context.Context) (*EntryShare, error)
+	predicates                []predicate.EntryShare
+}
+
+var _ ent.Mutation = (*EntryShareMutation)(nil)
+
+// entryshareOption allows management of the mutation configuration using functional options.
+type entryshareOption func(*EntryShareMutation)
+
+// newEntryShareMutation creates new mutation for the EntryShare entity.
+func newEntryShareMutation(c config, op Op, opts ...entryshareOption) *EntryShareMutation {
+	m := &EntryShareMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEntryShare,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEntryShareID sets the ID field of the mutation.
+func withEntryShareID(id uuid.UUID) entryshareOption {
+	return func(m *EntryShareMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EntryShare
+		)
+		m.oldValue = func(ctx context.Context) (*EntryShare, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EntryShare.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEntryShare sets the old EntryShare of the mutation.
+func withEntryShare(node *EntryShare) entryshareOption {
+	return func(m *EntryShareMutation) {
+		m.oldValue = func(context.Context) (*EntryShare, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EntryShareMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EntryShareMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EntryShare entities.
+func (m *EntryShareMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EntryShareMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EntryShareMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EntryShare.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EntryShareMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EntryShareMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EntryShare entity.
+// If the EntryShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryShareMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EntryShareMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EntryShareMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EntryShareMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the EntryShare entity.
+// If the EntryShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryShareMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EntryShareMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEntryID sets the "entry_id" field.
+func (m *EntryShareMutation) SetEntryID(u uuid.UUID) {
+	m.entry = &u
+}
+
+// EntryID returns the value of the "entry_id" field in the mutation.
+func (m *EntryShareMutation) EntryID() (r uuid.UUID, exists bool) {
+	v := m.entry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntryID returns the old "entry_id" field's value of the EntryShare entity.
+// If the EntryShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryShareMutation) OldEntryID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntryID: %w", err)
+	}
+	return oldValue.EntryID, nil
+}
+
+// ResetEntryID resets all changes to the "entry_id" field.
+func (m *EntryShareMutation) ResetEntryID() {
+	m.entry = nil
+}
+
+// SetSharedByPatientID sets the "shared_by_patient_id" field.
+func (m *EntryShareMutation) SetSharedByPatientID(u uuid.UUID) {
+	m.shared_by_patient = &u
+}
+
+// SharedByPatientID returns the value of the "shared_by_patient_id" field in the mutation.
+func (m *EntryShareMutation) SharedByPatientID() (r uuid.UUID, exists bool) {
+	v := m.shared_by_patient
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSharedByPatientID returns the old "shared_by_patient_id" field's value of the EntryShare entity.
+// If the EntryShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryShareMutation) OldSharedByPatientID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSharedByPatientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSharedByPatientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSharedByPatientID: %w", err)
+	}
+	return oldValue.SharedByPatientID, nil
+}
+
+// ResetSharedByPatientID resets all changes to the "shared_by_patient_id" field.
+func (m *EntryShareMutation) ResetSharedByPatientID() {
+	m.shared_by_patient = nil
+}
+
+// SetSharedWithDoctorID sets the "shared_with_doctor_id" field.
+func (m *EntryShareMutation) SetSharedWithDoctorID(u uuid.UUID) {
+	m.shared_with_doctor = &u
+}
+
+// SharedWithDoctorID returns the value of the "shared_with_doctor_id" field in the mutation.
+func (m *EntryShareMutation) SharedWithDoctorID() (r uuid.UUID, exists bool) {
+	v := m.shared_with_doctor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSharedWithDoctorID returns the old "shared_with_doctor_id" field's value of the EntryShare entity.
+// If the EntryShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryShareMutation) OldSharedWithDoctorID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSharedWithDoctorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSharedWithDoctorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSharedWithDoctorID: %w", err)
+	}
+	return oldValue.SharedWithDoctorID, nil
+}
+
+// ResetSharedWithDoctorID resets all changes to the "shared_with_doctor_id" field.
+func (m *EntryShareMutation) ResetSharedWithDoctorID() {
+	m.shared_with_doctor = nil
+}
+
+// SetSharedAt sets the "shared_at" field.
+func (m *EntryShareMutation) SetSharedAt(t time.Time) {
+	m.shared_at = &t
+}
+
+// SharedAt returns the value of the "shared_at" field in the mutation.
+func (m *EntryShareMutation) SharedAt() (r time.Time, exists bool) {
+	v := m.shared_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSharedAt returns the old "shared_at" field's value of the EntryShare entity.
+// If the EntryShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryShareMutation) OldSharedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSharedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSharedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSharedAt: %w", err)
+	}
+	return oldValue.SharedAt, nil
+}
+
+// ResetSharedAt resets all changes to the "shared_at" field.
+func (m *EntryShareMutation) ResetSharedAt() {
+	m.shared_at = nil
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *EntryShareMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *EntryShareMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the EntryShare entity.
+// If the EntryShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EntryShareMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *EntryShareMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[entryshare.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *EntryShareMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[entryshare.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *EntryShareMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, entryshare.FieldRevokedAt)
+}
+
+// ClearEntry clears the "entry" edge to the Entry entity.
+func (m *EntryShareMutation) ClearEntry() {
+	m.clearedentry = true
+	m.clearedFields[entryshare.FieldEntryID] = struct{}{}
+}
+
+// EntryCleared reports if the "entry" edge to the Entry entity was cleared.
+func (m *EntryShareMutation) EntryCleared() bool {
+	return m.clearedentry
+}
+
+// EntryIDs returns the "entry" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EntryID instead. It exists only for internal usage by the builders.
+func (m *EntryShareMutation) EntryIDs() (ids []uuid.UUID) {
+	if id := m.entry; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEntry resets all changes to the "entry" edge.
+func (m *EntryShareMutation) ResetEntry() {
+	m.entry = nil
+	m.clearedentry = false
+}
+
+// ClearSharedByPatient clears the "shared_by_patient" edge to the Patient entity.
+func (m *EntryShareMutation) ClearSharedByPatient() {
+	m.clearedshared_by_patient = true
+	m.clearedFields[entryshare.FieldSharedByPatientID] = struct{}{}
+}
+
+// SharedByPatientCleared reports if the "shared_by_patient" edge to the Patient entity was cleared.
+func (m *EntryShareMutation) SharedByPatientCleared() bool {
+	return m.clearedshared_by_patient
+}
+
+// SharedByPatientIDs returns the "shared_by_patient" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SharedByPatientID instead. It exists only for internal usage by the builders.
+func (m *EntryShareMutation) SharedByPatientIDs() (ids []uuid.UUID) {
+	if id := m.shared_by_patient; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSharedByPatient resets all changes to the "shared_by_patient" edge.
+func (m *EntryShareMutation) ResetSharedByPatient() {
+	m.shared_by_patient = nil
+	m.clearedshared_by_patient = false
+}
+
+// ClearSharedWithDoctor clears the "shared_with_doctor" edge to the Doctor entity.
+func (m *EntryShareMutation) ClearSharedWithDoctor() {
+	m.clearedshared_with_doctor = true
+	m.clearedFields[entryshare.FieldSharedWithDoctorID] = struct{}{}
+}
+
+// SharedWithDoctorCleared reports if the "shared_with_doctor" edge to the Doctor entity was cleared.
+func (m *EntryShareMutation) SharedWithDoctorCleared() bool {
+	return m.clearedshared_with_doctor
+}
+
+// SharedWithDoctorIDs returns the "shared_with_doctor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SharedWithDoctorID instead. It exists only for internal usage by the builders.
+func (m *EntryShareMutation) SharedWithDoctorIDs() (ids []uuid.UUID) {
+	if id := m.shared_with_doctor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSharedWithDoctor resets all changes to the "shared_with_doctor" edge.
+func (m *EntryShareMutation) ResetSharedWithDoctor() {
+	m.shared_with_doctor = nil
+	m.clearedshared_with_doctor = false
+}
+
+// Where appends a list predicates to the EntryShareMutation builder.
+func (m *EntryShareMutation) Where(ps ...predicate.EntryShare) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EntryShareMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EntryShareMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EntryShare, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EntryShareMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EntryShareMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EntryShare).
+func (m *EntryShareMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EntryShareMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, entryshare.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, entryshare.FieldUpdatedAt)
+	}
+	if m.entry != nil {
+		fields = append(fields, entryshare.FieldEntryID)
+	}
+	if m.shared_by_patient != nil {
+		fields = append(fields, entryshare.FieldSharedByPatientID)
+	}
+	if m.shared_with_doctor != nil {
+		fields = append(fields, entryshare.FieldSharedWithDoctorID)
+	}
+	if m.shared_at != nil {
+		fields = append(fields, entryshare.FieldSharedAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, entryshare.FieldRevokedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EntryShareMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case entryshare.FieldCreatedAt:
+		return m.CreatedAt()
+	case entryshare.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case entryshare.FieldEntryID:
+		return m.EntryID()
+	case entryshare.FieldSharedByPatientID:
+		return m.SharedByPatientID()
+	case entryshare.FieldSharedWithDoctorID:
+		return m.SharedWithDoctorID()
+	case entryshare.FieldSharedAt:
+		return m.SharedAt()
+	case entryshare.FieldRevokedAt:
+		return m.RevokedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EntryShareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case entryshare.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case entryshare.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case entryshare.FieldEntryID:
+		return m.OldEntryID(ctx)
+	case entryshare.FieldSharedByPatientID:
+		return m.OldSharedByPatientID(ctx)
+	case entryshare.FieldSharedWithDoctorID:
+		return m.OldSharedWithDoctorID(ctx)
+	case entryshare.FieldSharedAt:
+		return m.OldSharedAt(ctx)
+	case entryshare.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EntryShare field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntryShareMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case entryshare.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case entryshare.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case entryshare.FieldEntryID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntryID(v)
+		return nil
+	case entryshare.FieldSharedByPatientID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSharedByPatientID(v)
+		return nil
+	case entryshare.FieldSharedWithDoctorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSharedWithDoctorID(v)
+		return nil
+	case entryshare.FieldSharedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSharedAt(v)
+		return nil
+	case entryshare.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EntryShare field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EntryShareMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EntryShareMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EntryShareMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EntryShare numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EntryShareMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(entryshare.FieldRevokedAt) {
+		fields = append(fields, entryshare.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EntryShareMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EntryShareMutation) ClearField(name string) error {
+	switch name {
+	case entryshare.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EntryShare nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EntryShareMutation) ResetField(name string) error {
+	switch name {
+	case entryshare.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case entryshare.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case entryshare.FieldEntryID:
+		m.ResetEntryID()
+		return nil
+	case entryshare.FieldSharedByPatientID:
+		m.ResetSharedByPatientID()
+		return nil
+	case entryshare.FieldSharedWithDoctorID:
+		m.ResetSharedWithDoctorID()
+		return nil
+	case entryshare.FieldSharedAt:
+		m.ResetSharedAt()
+		return nil
+	case entryshare.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EntryShare field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EntryShareMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.entry != nil {
+		edges = append(edges, entryshare.EdgeEntry)
+	}
+	if m.shared_by_patient != nil {
+		edges = append(edges, entryshare.EdgeSharedByPatient)
+	}
+	if m.shared_with_doctor != nil {
+		edges = append(edges, entryshare.EdgeSharedWithDoctor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EntryShareMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case entryshare.EdgeEntry:
+		if id := m.entry; id != nil {
+			return []ent.Value{*id}
+		}
+	case entryshare.EdgeSharedByPatient:
+		if id := m.shared_by_patient; id != nil {
+			return []ent.Value{*id}
+		}
+	case entryshare.EdgeSharedWithDoctor:
+		if id := m.shared_with_doctor; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EntryShareMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EntryShareMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EntryShareMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedentry {
+		edges = append(edges, entryshare.EdgeEntry)
+	}
+	if m.clearedshared_by_patient {
+		edges = append(edges, entryshare.EdgeSharedByPatient)
+	}
+	if m.clearedshared_with_doctor {
+		edges = append(edges, entryshare.EdgeSharedWithDoctor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EntryShareMutation) EdgeCleared(name string) bool {
+	switch name {
+	case entryshare.EdgeEntry:
+		return m.clearedentry
+	case entryshare.EdgeSharedByPatient:
+		return m.clearedshared_by_patient
+	case entryshare.EdgeSharedWithDoctor:
+		return m.clearedshared_with_doctor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EntryShareMutation) ClearEdge(name string) error {
+	switch name {
+	case entryshare.EdgeEntry:
+		m.ClearEntry()
+		return nil
+	case entryshare.EdgeSharedByPatient:
+		m.ClearSharedByPatient()
+		return nil
+	case entryshare.EdgeSharedWithDoctor:
+		m.ClearSharedWithDoctor()
+		return nil
+	}
+	return fmt.Errorf("unknown EntryShare unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EntryShareMutation) ResetEdge(name string) error {
+	switch name {
+	case entryshare.EdgeEntry:
+		m.ResetEntry()
+		return nil
+	case entryshare.EdgeSharedByPatient:
+		m.ResetSharedByPatient()
+		return nil
+	case entryshare.EdgeSharedWithDoctor:
+		m.ResetSharedWithDoctor()
+		return nil
+	}
+	return fmt.Errorf("unknown EntryShare edge %s", name)
+}
+
+// ExerciseLogMutation represents an operation that mutates the ExerciseLog nodes in the graph.
+type ExerciseLogMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	created_at          *time.Time
+	updated_at          *time.Time
+	completed_at        *time.Time
+	duration_minutes    *int
+	addduration_minutes *int
+	notes               *string
+	clearedFields       map[string]struct{}
+	assignment          *uuid.UUID
+	clearedassignment   bool
+	entry               *uuid.UUID
+	clearedentry        bool
+	done                bool
+	oldValue            func(context.Context) (*ExerciseLog, error)
+	predicates          []predicate.ExerciseLog
+}
+
+var _ ent.Mutation = (*ExerciseLogMutation)(nil)
+
+// exerciselogOption allows management of the mutation configuration using functional options.
+type exerciselogOption func(*ExerciseLogMutation)
+
+// newExerciseLogMutation creates new mutation for the ExerciseLog entity.
+func newExerciseLogMutation(c config, op Op, opts ...exerciselogOption) *ExerciseLogMutation {
+	m := &ExerciseLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExerciseLog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExerciseLogID sets the ID field of the mutation.
+func withExerciseLogID(id uuid.UUID) exerciselogOption {
+	return func(m *ExerciseLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExerciseLog
+		)
+		m.oldValue = func(ctx context.Context) (*ExerciseLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExerciseLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExerciseLog sets the old ExerciseLog of the mutation.
+func withExerciseLog(node *ExerciseLog) exerciselogOption {
+	return func(m *ExerciseLogMutation) {
+		m.oldValue = func(context.Context) (*ExerciseLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExerciseLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExerciseLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ExerciseLog entities.
+func (m *ExerciseLogMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExerciseLogMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExerciseLogMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExerciseLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ExerciseLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ExerciseLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ExerciseLog entity.
+// If the ExerciseLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExerciseLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ExerciseLogMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ExerciseLogMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ExerciseLogMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ExerciseLog entity.
+// If the ExerciseLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExerciseLogMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ExerciseLogMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetAssignmentID sets the "assignment_id" field.
+func (m *ExerciseLogMutation) SetAssignmentID(u uuid.UUID) {
+	m.assignment = &u
+}
+
+// AssignmentID returns the value of the "assignment_id" field in the mutation.
+func (m *ExerciseLogMutation) AssignmentID() (r uuid.UUID, exists bool) {
+	v := m.assignment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignmentID returns the old "assignment_id" field's value of the ExerciseLog entity.
+// If the ExerciseLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExerciseLogMutation) OldAssignmentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignmentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignmentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignmentID: %w", err)
+	}
+	return oldValue.AssignmentID, nil
+}
+
+// ResetAssignmentID resets all changes to the "assignment_id" field.
+func (m *ExerciseLogMutation) ResetAssignmentID() {
+	m.assignment = nil
+}
+
+// SetEntryID sets the "entry_id" field.
+func (m *ExerciseLogMutation) SetEntryID(u uuid.UUID) {
+	m.entry = &u
+}
+
+// EntryID returns the value of the "entry_id" field in the mutation.
+func (m *ExerciseLogMutation) EntryID() (r uuid.UUID, exists bool) {
+	v := m.entry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntryID returns the old "entry_id" field's value of the ExerciseLog entity.
+// If the ExerciseLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExerciseLogMutation) OldEntryID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntryID: %w", err)
+	}
+	return oldValue.EntryID, nil
+}
+
+// ClearEntryID clears the value of the "entry_id" field.
+func (m *ExerciseLogMutation) ClearEntryID() {
+	m.entry = nil
+	m.clearedFields[exerciselog.FieldEntryID] = struct{}{}
+}
+
+// EntryIDCleared returns if the "entry_id" field was cleared in this mutation.
+func (m *ExerciseLogMutation) EntryIDCleared() bool {
+	_, ok := m.clearedFields[exerciselog.FieldEntryID]
+	return ok
+}
+
+// ResetEntryID resets all changes to the "entry_id" field.
+func (m *ExerciseLogMutation) ResetEntryID() {
+	m.entry = nil
+	delete(m.clearedFields, exerciselog.FieldEntryID)
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *ExerciseLogMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *ExerciseLogMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the ExerciseLog entity.
+// If the ExerciseLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExerciseLogMutation) OldCompletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *ExerciseLogMutation) ResetCompletedAt() {
+	m.completed_at = nil
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (m *ExerciseLogMutation) SetDurationMinutes(i int) {
+	m.duration_minutes = &i
+	m.addduration_minutes = nil
+}
+
+// DurationMinutes returns the value of the "duration_minutes" field in the mutation.
+func (m *ExerciseLogMutation) DurationMinutes() (r int, exists bool) {
+	v := m.duration_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationMinutes returns the old "duration_minutes" field's value of the ExerciseLog entity.
+// If the ExerciseLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExerciseLogMutation) OldDurationMinutes(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMinutes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMinutes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMinutes: %w", err)
+	}
+	return oldValue.DurationMinutes, nil
+}
+
+// AddDurationMinutes adds i to the "duration_minutes" field.
+func (m *ExerciseLogMutation) AddDurationMinutes(i int) {
+	if m.addduration_minutes != nil {
+		*m.addduration_minutes += i
+	} else {
+		m.addduration_minutes = &i
+	}
+}
+
+// AddedDurationMinutes returns the value that was added to the "duration_minutes" field in this mutation.
+func (m *ExerciseLogMutation) AddedDurationMinutes() (r int, exists bool) {
+	v := m.addduration_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDurationMinutes clears the value of the "duration_minutes" field.
+func (m *ExerciseLogMutation) ClearDurationMinutes() {
+	m.duration_minutes = nil
+	m.addduration_minutes = nil
+	m.clearedFields[exerciselog.FieldDurationMinutes] = struct{}{}
+}
+
+// DurationMinutesCleared returns if the "duration_minutes" field was cleared in this mutation.
+func (m *ExerciseLogMutation) DurationMinutesCleared() bool {
+	_, ok := m.clearedFields[exerciselog.FieldDurationMinutes]
+	return ok
+}
+
+// ResetDurationMinutes resets all changes to the "duration_minutes" field.
+func (m *ExerciseLogMutation) ResetDurationMinutes() {
+	m.duration_minutes = nil
+	m.addduration_minutes = nil
+	delete(m.clearedFields, exerciselog.FieldDurationMinutes)
+}
+
+// SetNotes sets the "notes" field.
+func (m *ExerciseLogMutation) SetNotes(s string) {
+	m.notes = &s
+}
+
+// Notes returns the value of the "notes" field in the mutation.
+func (m *ExerciseLogMutation) Notes() (r string, exists bool) {
+	v := m.notes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotes returns the old "notes" field's value of the ExerciseLog entity.
+// If the ExerciseLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExerciseLogMutation) OldNotes(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+	}
+	return oldValue.Notes, nil
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (m *ExerciseLogMutation) ClearNotes() {
+	m.notes = nil
+	m.clearedFields[exerciselog.FieldNotes] = struct{}{}
+}
+
+// NotesCleared returns if the "notes" field was cleared in this mutation.
+func (m *ExerciseLogMutation) NotesCleared() bool {
+	_, ok := m.clearedFields[exerciselog.FieldNotes]
+	return ok
+}
+
+// ResetNotes resets all changes to the "notes" field.
+func (m *ExerciseLogMutation) ResetNotes() {
+	m.notes = nil
+	delete(m.clearedFields, exerciselog.FieldNotes)
+}
+
+// ClearAssignment clears the "assignment" edge to the Assignment entity.
+func (m *ExerciseLogMutation) ClearAssignment() {
+	m.clearedassignment = true
+	m.clearedFields[exerciselog.FieldAssignmentID] = struct{}{}
+}
+
+// AssignmentCleared reports if the "assignment" edge to the Assignment entity was cleared.
+func (m *ExerciseLogMutation) AssignmentCleared() bool {
+	return m.clearedassignment
+}
+
+// AssignmentIDs returns the "assignment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AssignmentID instead. It exists only for internal usage by the builders.
+func (m *ExerciseLogMutation) AssignmentIDs() (ids []uuid.UUID) {
+	if id := m.assignment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAssignment resets all changes to the "assignment" edge.
+func (m *ExerciseLogMutation) ResetAssignment() {
+	m.assignment = nil
+	m.clearedassignment = false
+}
+
+// ClearEntry clears the "entry" edge to the Entry entity.
+func (m *ExerciseLogMutation) ClearEntry() {
+	m.clearedentry = true
+	m.clearedFields[exerciselog.FieldEntryID] = struct{}{}
+}
+
+// EntryCleared reports if the "entry" edge to the Entry entity was cleared.
+func (m *ExerciseLogMutation) EntryCleared() bool {
+	return m.EntryIDCleared() || m.clearedentry
+}
+
+// EntryIDs returns the "entry" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EntryID instead. It exists only for internal usage by the builders.
+func (m *ExerciseLogMutation) EntryIDs() (ids []uuid.UUID) {
+	if id := m.entry; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEntry resets all changes to the "entry" edge.
+func (m *ExerciseLogMutation) ResetEntry() {
+	m.entry = nil
+	m.clearedentry = false
+}
+
+// Where appends a list predicates to the ExerciseLogMutation builder.
+func (m *ExerciseLogMutation) Where(ps ...predicate.ExerciseLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExerciseLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExerciseLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExerciseLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExerciseLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExerciseLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExerciseLog).
+func (m *ExerciseLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExerciseLogMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, exerciselog.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, exerciselog.FieldUpdatedAt)
+	}
+	if m.assignment != nil {
+		fields = append(fields, exerciselog.FieldAssignmentID)
+	}
+	if m.entry != nil {
+		fields = append(fields, exerciselog.FieldEntryID)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, exerciselog.FieldCompletedAt)
+	}
+	if m.duration_minutes != nil {
+		fields = append(fields, exerciselog.FieldDurationMinutes)
+	}
+	if m.notes != nil {
+		fields = append(fields, exerciselog.FieldNotes)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExerciseLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case exerciselog.FieldCreatedAt:
+		return m.CreatedAt()
+	case exerciselog.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case exerciselog.FieldAssignmentID:
+		return m.AssignmentID()
+	case exerciselog.FieldEntryID:
+		return m.EntryID()
+	case exerciselog.FieldCompletedAt:
+		return m.CompletedAt()
+	case exerciselog.FieldDurationMinutes:
+		return m.DurationMinutes()
+	case exerciselog.FieldNotes:
+		return m.Notes()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExerciseLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case exerciselog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case exerciselog.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case exerciselog.FieldAssignmentID:
+		return m.OldAssignmentID(ctx)
+	case exerciselog.FieldEntryID:
+		return m.OldEntryID(ctx)
+	case exerciselog.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case exerciselog.FieldDurationMinutes:
+		return m.OldDurationMinutes(ctx)
+	case exerciselog.FieldNotes:
+		return m.OldNotes(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExerciseLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExerciseLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case exerciselog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case exerciselog.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case exerciselog.FieldAssignmentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignmentID(v)
+		return nil
+	case exerciselog.FieldEntryID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntryID(v)
+		return nil
+	case exerciselog.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case exerciselog.FieldDurationMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMinutes(v)
+		return nil
+	case exerciselog.FieldNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExerciseLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExerciseLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_minutes != nil {
+		fields = append(fields, exerciselog.FieldDurationMinutes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExerciseLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case exerciselog.FieldDurationMinutes:
+		return m.AddedDurationMinutes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExerciseLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case exerciselog.FieldDurationMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMinutes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExerciseLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExerciseLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(exerciselog.FieldEntryID) {
+		fields = append(fields, exerciselog.FieldEntryID)
+	}
+	if m.FieldCleared(exerciselog.FieldDurationMinutes) {
+		fields = append(fields, exerciselog.FieldDurationMinutes)
+	}
+	if m.FieldCleared(exerciselog.FieldNotes) {
+		fields = append(fields, exerciselog.FieldNotes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExerciseLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExerciseLogMutation) ClearField(name string) error {
+	switch name {
+	case exerciselog.FieldEntryID:
+		m.ClearEntryID()
+		return nil
+	case exerciselog.FieldDurationMinutes:
+		m.ClearDurationMinutes()
+		return nil
+	case exerciselog.FieldNotes:
+		m.ClearNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown ExerciseLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExerciseLogMutation) ResetField(name string) error {
+	switch name {
+	case exerciselog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case exerciselog.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case exerciselog.FieldAssignmentID:
+		m.ResetAssignmentID()
+		return nil
+	case exerciselog.FieldEntryID:
+		m.ResetEntryID()
+		return nil
+	case exerciselog.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case exerciselog.FieldDurationMinutes:
+		m.ResetDurationMinutes()
+		return nil
+	case exerciselog.FieldNotes:
+		m.ResetNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown ExerciseLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExerciseLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.assignment != nil {
+		edges = append(edges, exerciselog.EdgeAssignment)
+	}
+	if m.entry != nil {
+		edges = append(edges, exerciselog.EdgeEntry)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExerciseLogMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case exerciselog.EdgeAssignment:
+		if id := m.assignment; id != nil {
+			return []ent.Value{*id}
+		}
+	case exerciselog.EdgeEntry:
+		if id := m.entry; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExerciseLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExerciseLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExerciseLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedassignment {
+		edges = append(edges, exerciselog.EdgeAssignment)
+	}
+	if m.clearedentry {
+		edges = append(edges, exerciselog.EdgeEntry)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExerciseLogMutation) EdgeCleared(name string) bool {
+	switch name {
+	case exerciselog.EdgeAssignment:
+		return m.clearedassignment
+	case exerciselog.EdgeEntry:
+		return m.clearedentry
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExerciseLogMutation) ClearEdge(name string) error {
+	switch name {
+	case exerciselog.EdgeAssignment:
+		m.ClearAssignment()
+		return nil
+	case exerciselog.EdgeEntry:
+		m.ClearEntry()
+		return nil
+	}
+	return fmt.Errorf("unknown ExerciseLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExerciseLogMutation) ResetEdge(name string) error {
+	switch name {
+	case exerciselog.EdgeAssignment:
+		m.ResetAssignment()
+		return nil
+	case exerciselog.EdgeEntry:
+		m.ResetEntry()
+		return nil
+	}
+	return fmt.Errorf("unknown ExerciseLog edge %s", name)
+}
+
+// GoalMutation represents an operation that mutates the Goal nodes in the graph.
+type GoalMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	created_at       *time.Time
+	updated_at       *time.Time
+	title            *string
+	description      *string
+	techniques       *[]string
+	appendtechniques []string
+	triggers         *[]string
+	appendtriggers   []string
+	tags             *[]string
+	appendtags       []string
+	target_count     *int
+	addtarget_count  *int
+	period           *goal.Period
+	starts_at        *time.Time
+	ends_at          *time.Time
+	status           *goal.Status
+	clearedFields    map[string]struct{}
+	patient          *uuid.UUID
+	clearedpatient   bool
+	doctor           *uuid.UUID
+	cleareddoctor    bool
+	done             bool
+	oldValue         func(context.Context) (*Goal, error)
+	predicates       []predicate.Goal
+}
+
+var _ ent.Mutation = (*GoalMutation)(nil)
+
+// goalOption allows management of the mutation configuration using functional options.
+type goalOption func(*GoalMutation)
+
+// newGoalMutation creates new mutation for the Goal entity.
+func newGoalMutation(c config, op Op, opts ...goalOption) *GoalMutation {
+	m := &GoalMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGoal,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGoalID sets the ID field of the mutation.
+func withGoalID(id uuid.UUID) goalOption {
+	return func(m *GoalMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Goal
+		)
+		m.oldValue = func(ctx context.Context) (*Goal, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Goal.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGoal sets the old Goal of the mutation.
+func withGoal(node *Goal) goalOption {
+	return func(m *GoalMutation) {
+		m.oldValue = func(context.Context) (*Goal, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GoalMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GoalMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Goal entities.
+func (m *GoalMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GoalMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GoalMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Goal.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GoalMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GoalMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GoalMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GoalMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GoalMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GoalMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetPatientID sets the "patient_id" field.
+func (m *GoalMutation) SetPatientID(u uuid.UUID) {
+	m.patient = &u
+}
+
+// PatientID returns the value of the "patient_id" field in the mutation.
+func (m *GoalMutation) PatientID() (r uuid.UUID, exists bool) {
+	v := m.patient
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPatientID returns the old "patient_id" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldPatientID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPatientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPatientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPatientID: %w", err)
+	}
+	return oldValue.PatientID, nil
+}
+
+// ResetPatientID resets all changes to the "patient_id" field.
+func (m *GoalMutation) ResetPatientID() {
+	m.patient = nil
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (m *GoalMutation) SetDoctorID(u uuid.UUID) {
+	m.doctor = &u
+}
+
+// DoctorID returns the value of the "doctor_id" field in the mutation.
+func (m *GoalMutation) DoctorID() (r uuid.UUID, exists bool) {
+	v := m.doctor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDoctorID returns the old "doctor_id" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldDoctorID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDoctorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDoctorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDoctorID: %w", err)
+	}
+	return oldValue.DoctorID, nil
+}
+
+// ResetDoctorID resets all changes to the "doctor_id" field.
+func (m *GoalMutation) ResetDoctorID() {
+	m.doctor = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *GoalMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *GoalMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *GoalMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *GoalMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *GoalMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *GoalMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[goal.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *GoalMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[goal.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *GoalMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, goal.FieldDescription)
+}
+
+// SetTechniques sets the "techniques" field.
+func (m *GoalMutation) SetTechniques(s []string) {
+	m.techniques = &s
+	m.appendtechniques = nil
+}
+
+// Techniques returns the value of the "techniques" field in the mutation.
+func (m *GoalMutation) Techniques() (r []string, exists bool) {
+	v := m.techniques
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTechniques returns the old "techniques" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldTechniques(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTechniques is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTechniques requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTechniques: %w", err)
+	}
+	return oldValue.Techniques, nil
+}
+
+// AppendTechniques adds s to the "techniques" field.
+func (m *GoalMutation) AppendTechniques(s []string) {
+	m.appendtechniques = append(m.appendtechniques, s...)
+}
+
+// AppendedTechniques returns the list of values that were appended to the "techniques" field in this mutation.
+func (m *GoalMutation) AppendedTechniques() ([]string, bool) {
+	if len(m.appendtechniques) == 0 {
+		return nil, false
+	}
+	return m.appendtechniques, true
+}
+
+// ClearTechniques clears the value of the "techniques" field.
+func (m *GoalMutation) ClearTechniques() {
+	m.techniques = nil
+	m.appendtechniques = nil
+	m.clearedFields[goal.FieldTechniques] = struct{}{}
+}
+
+// TechniquesCleared returns if the "techniques" field was cleared in this mutation.
+func (m *GoalMutation) TechniquesCleared() bool {
+	_, ok := m.clearedFields[goal.FieldTechniques]
+	return ok
+}
+
+// ResetTechniques resets all changes to the "techniques" field.
+func (m *GoalMutation) ResetTechniques() {
+	m.techniques = nil
+	m.appendtechniques = nil
+	delete(m.clearedFields, goal.FieldTechniques)
+}
+
+// SetTriggers sets the "triggers" field.
+func (m *GoalMutation) SetTriggers(s []string) {
+	m.triggers = &s
+	m.appendtriggers = nil
+}
+
+// Triggers returns the value of the "triggers" field in the mutation.
+func (m *GoalMutation) Triggers() (r []string, exists bool) {
+	v := m.triggers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTriggers returns the old "triggers" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldTriggers(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTriggers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTriggers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTriggers: %w", err)
+	}
+	return oldValue.Triggers, nil
+}
+
+// AppendTriggers adds s to the "triggers" field.
+func (m *GoalMutation) AppendTriggers(s []string) {
+	m.appendtriggers = append(m.appendtriggers, s...)
+}
+
+// AppendedTriggers returns the list of values that were appended to the "triggers" field in this mutation.
+func (m *GoalMutation) AppendedTriggers() ([]string, bool) {
+	if len(m.appendtriggers) == 0 {
+		return nil, false
+	}
+	return m.appendtriggers, true
+}
+
+// ClearTriggers clears the value of the "triggers" field.
+func (m *GoalMutation) ClearTriggers() {
+	m.triggers = nil
+	m.appendtriggers = nil
+	m.clearedFields[goal.FieldTriggers] = struct{}{}
+}
+
+// TriggersCleared returns if the "triggers" field was cleared in this mutation.
+func (m *GoalMutation) TriggersCleared() bool {
+	_, ok := m.clearedFields[goal.FieldTriggers]
+	return ok
+}
+
+// ResetTriggers resets all changes to the "triggers" field.
+func (m *GoalMutation) ResetTriggers() {
+	m.triggers = nil
+	m.appendtriggers = nil
+	delete(m.clearedFields, goal.FieldTriggers)
+}
+
+// SetTags sets the "tags" field.
+func (m *GoalMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *GoalMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *GoalMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *GoalMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *GoalMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[goal.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *GoalMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[goal.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *GoalMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, goal.FieldTags)
+}
+
+// SetTargetCount sets the "target_count" field.
+func (m *GoalMutation) SetTargetCount(i int) {
+	m.target_count = &i
+	m.addtarget_count = nil
+}
+
+// TargetCount returns the value of the "target_count" field in the mutation.
+func (m *GoalMutation) TargetCount() (r int, exists bool) {
+	v := m.target_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetCount returns the old "target_count" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldTargetCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetCount: %w", err)
+	}
+	return oldValue.TargetCount, nil
+}
+
+// AddTargetCount adds i to the "target_count" field.
+func (m *GoalMutation) AddTargetCount(i int) {
+	if m.addtarget_count != nil {
+		*m.addtarget_count += i
+	} else {
+		m.addtarget_count = &i
+	}
+}
+
+// AddedTargetCount returns the value that was added to the "target_count" field in this mutation.
+func (m *GoalMutation) AddedTargetCount() (r int, exists bool) {
+	v := m.addtarget_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTargetCount resets all changes to the "target_count" field.
+func (m *GoalMutation) ResetTargetCount() {
+	m.target_count = nil
+	m.addtarget_count = nil
+}
+
+// SetPeriod sets the "period" field.
+func (m *GoalMutation) SetPeriod(_go goal.Period) {
+	m.period = &_go
+}
+
+// Period returns the value of the "period" field in the mutation.
+func (m *GoalMutation) Period() (r goal.Period, exists bool) {
+	v := m.period
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeriod returns the old "period" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldPeriod(ctx context.Context) (v goal.Period, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeriod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeriod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeriod: %w", err)
+	}
+	return oldValue.Period, nil
+}
+
+// ResetPeriod resets all changes to the "period" field.
+func (m *GoalMutation) ResetPeriod() {
+	m.period = nil
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *GoalMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *GoalMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartsAt returns the old "starts_at" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
+}
+
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *GoalMutation) ResetStartsAt() {
+	m.starts_at = nil
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (m *GoalMutation) SetEndsAt(t time.Time) {
+	m.ends_at = &t
+}
+
+// EndsAt returns the value of the "ends_at" field in the mutation.
+func (m *GoalMutation) EndsAt() (r time.Time, exists bool) {
+	v := m.ends_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndsAt returns the old "ends_at" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldEndsAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndsAt: %w", err)
+	}
+	return oldValue.EndsAt, nil
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (m *GoalMutation) ClearEndsAt() {
+	m.ends_at = nil
+	m.clearedFields[goal.FieldEndsAt] = struct{}{}
+}
+
+// EndsAtCleared returns if the "ends_at" field was cleared in this mutation.
+func (m *GoalMutation) EndsAtCleared() bool {
+	_, ok := m.clearedFields[goal.FieldEndsAt]
+	return ok
+}
+
+// ResetEndsAt resets all changes to the "ends_at" field.
+func (m *GoalMutation) ResetEndsAt() {
+	m.ends_at = nil
+	delete(m.clearedFields, goal.FieldEndsAt)
+}
+
+// SetStatus sets the "status" field.
+func (m *GoalMutation) SetStatus(_go goal.Status) {
+	m.status = &_go
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *GoalMutation) Status() (r goal.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldStatus(ctx context.Context) (v goal.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *GoalMutation) ResetStatus() {
+	m.status = nil
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (m *GoalMutation) ClearPatient() {
+	m.clearedpatient = true
+	m.clearedFields[goal.FieldPatientID] = struct{}{}
+}
+
+// PatientCleared reports if the "patient" edge to the Patient entity was cleared.
+func (m *GoalMutation) PatientCleared() bool {
+	return m.clearedpatient
+}
+
+// PatientIDs returns the "patient" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PatientID instead. It exists only for internal usage by the builders.
+func (m *GoalMutation) PatientIDs() (ids []uuid.UUID) {
+	if id := m.patient; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPatient resets all changes to the "patient" edge.
+func (m *GoalMutation) ResetPatient() {
+	m.patient = nil
+	m.clearedpatient = false
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (m *GoalMutation) ClearDoctor() {
+	m.cleareddoctor = true
+	m.clearedFields[goal.FieldDoctorID] = struct{}{}
+}
+
+// DoctorCleared reports if the "doctor" edge to the Doctor entity was cleared.
+func (m *GoalMutation) DoctorCleared() bool {
+	return m.cleareddoctor
+}
+
+// DoctorIDs returns the "doctor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DoctorID instead. It exists only for internal usage by the builders.
+func (m *GoalMutation) DoctorIDs() (ids []uuid.UUID) {
+	if id := m.doctor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDoctor resets all changes to the "doctor" edge.
+func (m *GoalMutation) ResetDoctor() {
+	m.doctor = nil
+	m.cleareddoctor = false
+}
+
+// Where appends a list predicates to the GoalMutation builder.
+func (m *GoalMutation) Where(ps ...predicate.Goal) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GoalMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GoalMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Goal, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GoalMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GoalMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Goal).
+func (m *GoalMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GoalMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.created_at != nil {
+		fields = append(fields, goal.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, goal.FieldUpdatedAt)
+	}
+	if m.patient != nil {
+		fields = append(fields, goal.FieldPatientID)
+	}
+	if m.doctor != nil {
+		fields = append(fields, goal.FieldDoctorID)
+	}
+	if m.title != nil {
+		fields = append(fields, goal.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, goal.FieldDescription)
+	}
+	if m.techniques != nil {
+		fields = append(fields, goal.FieldTechniques)
+	}
+	if m.triggers != nil {
+		fields = append(fields, goal.FieldTriggers)
+	}
+	if m.tags != nil {
+		fields = append(fields, goal.FieldTags)
+	}
+	if m.target_count != nil {
+		fields = append(fields, goal.FieldTargetCount)
+	}
+	if m.period != nil {
+		fields = append(fields, goal.FieldPeriod)
+	}
+	if m.starts_at != nil {
+		fields = append(fields, goal.FieldStartsAt)
+	}
+	if m.ends_at != nil {
+		fields = append(fields, goal.FieldEndsAt)
+	}
+	if m.status != nil {
+		fields = append(fields, goal.FieldStatus)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GoalMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case goal.FieldCreatedAt:
+		return m.CreatedAt()
+	case goal.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case goal.FieldPatientID:
+		return m.PatientID()
+	case goal.FieldDoctorID:
+		return m.DoctorID()
+	case goal.FieldTitle:
+		return m.Title()
+	case goal.FieldDescription:
+		return m.Description()
+	case goal.FieldTechniques:
+		return m.Techniques()
+	case goal.FieldTriggers:
+		return m.Triggers()
+	case goal.FieldTags:
+		return m.Tags()
+	case goal.FieldTargetCount:
+		return m.TargetCount()
+	case goal.FieldPeriod:
+		return m.Period()
+	case goal.FieldStartsAt:
+		return m.StartsAt()
+	case goal.FieldEndsAt:
+		return m.EndsAt()
+	case goal.FieldStatus:
+		return m.Status()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GoalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case goal.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case goal.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case goal.FieldPatientID:
+		return m.OldPatientID(ctx)
+	case goal.FieldDoctorID:
+		return m.OldDoctorID(ctx)
+	case goal.FieldTitle:
+		return m.OldTitle(ctx)
+	case goal.FieldDescription:
+		return m.OldDescription(ctx)
+	case goal.FieldTechniques:
+		return m.OldTechniques(ctx)
+	case goal.FieldTriggers:
+		return m.OldTriggers(ctx)
+	case goal.FieldTags:
+		return m.OldTags(ctx)
+	case goal.FieldTargetCount:
+		return m.OldTargetCount(ctx)
+	case goal.FieldPeriod:
+		return m.OldPeriod(ctx)
+	case goal.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case goal.FieldEndsAt:
+		return m.OldEndsAt(ctx)
+	case goal.FieldStatus:
+		return m.OldStatus(ctx)
+	}
+	return nil, fmt.Errorf("unknown Goal field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoalMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case goal.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case goal.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case goal.FieldPatientID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPatientID(v)
+		return nil
+	case goal.FieldDoctorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDoctorID(v)
+		return nil
+	case goal.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case goal.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case goal.FieldTechniques:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTechniques(v)
+		return nil
+	case goal.FieldTriggers:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTriggers(v)
+		return nil
+	case goal.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case goal.FieldTargetCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetCount(v)
+		return nil
+	case goal.FieldPeriod:
+		v, ok := value.(goal.Period)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriod(v)
+		return nil
+	case goal.FieldStartsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartsAt(v)
+		return nil
+	case goal.FieldEndsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndsAt(v)
+		return nil
+	case goal.FieldStatus:
+		v, ok := value.(goal.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Goal field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GoalMutation) AddedFields() []string {
+	var fields []string
+	if m.addtarget_count != nil {
+		fields = append(fields, goal.FieldTargetCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GoalMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case goal.FieldTargetCount:
+		return m.AddedTargetCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoalMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case goal.FieldTargetCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Goal numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GoalMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(goal.FieldDescription) {
+		fields = append(fields, goal.FieldDescription)
+	}
+	if m.FieldCleared(goal.FieldTechniques) {
+		fields = append(fields, goal.FieldTechniques)
+	}
+	if m.FieldCleared(goal.FieldTriggers) {
+		fields = append(fields, goal.FieldTriggers)
+	}
+	if m.FieldCleared(goal.FieldTags) {
+		fields = append(fields, goal.FieldTags)
+	}
+	if m.FieldCleared(goal.FieldEndsAt) {
+		fields = append(fields, goal.FieldEndsAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GoalMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GoalMutation) ClearField(name string) error {
+	switch name {
+	case goal.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case goal.FieldTechniques:
+		m.ClearTechniques()
+		return nil
+	case goal.FieldTriggers:
+		m.ClearTriggers()
+		return nil
+	case goal.FieldTags:
+		m.ClearTags()
+		return nil
+	case goal.FieldEndsAt:
+		m.ClearEndsAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Goal nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GoalMutation) ResetField(name string) error {
+	switch name {
+	case goal.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case goal.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case goal.FieldPatientID:
+		m.ResetPatientID()
+		return nil
+	case goal.FieldDoctorID:
+		m.ResetDoctorID()
+		return nil
+	case goal.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case goal.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case goal.FieldTechniques:
+		m.ResetTechniques()
+		return nil
+	case goal.FieldTriggers:
+		m.ResetTriggers()
+		return nil
+	case goal.FieldTags:
+		m.ResetTags()
+		return nil
+	case goal.FieldTargetCount:
+		m.ResetTargetCount()
+		return nil
+	case goal.FieldPeriod:
+		m.ResetPeriod()
+		return nil
+	case goal.FieldStartsAt:
+		m.ResetStartsAt()
+		return nil
+	case goal.FieldEndsAt:
+		m.ResetEndsAt()
+		return nil
+	case goal.FieldStatus:
+		m.ResetStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown Goal field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GoalMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.patient != nil {
+		edges = append(edges, goal.EdgePatient)
+	}
+	if m.doctor != nil {
+		edges = append(edges, goal.EdgeDoctor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GoalMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case goal.EdgePatient:
+		if id := m.patient; id != nil {
+			return []ent.Value{*id}
+		}
+	case goal.EdgeDoctor:
+		if id := m.doctor; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GoalMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GoalMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GoalMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedpatient {
+		edges = append(edges, goal.EdgePatient)
+	}
+	if m.cleareddoctor {
+		edges = append(edges, goal.EdgeDoctor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GoalMutation) EdgeCleared(name string) bool {
+	switch name {
+	case goal.EdgePatient:
+		return m.clearedpatient
+	case goal.EdgeDoctor:
+		return m.cleareddoctor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GoalMutation) ClearEdge(name string) error {
+	switch name {
+	case goal.EdgePatient:
+		m.ClearPatient()
+		return nil
+	case goal.EdgeDoctor:
+		m.ClearDoctor()
+		return nil
+	}
+	return fmt.Errorf("unknown Goal unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GoalMutation) ResetEdge(name string) error {
+	switch name {
+	case goal.EdgePatient:
+		m.ResetPatient()
+		return nil
+	case goal.EdgeDoctor:
+		m.ResetDoctor()
+		return nil
+	}
+	return fmt.Errorf("unknown Goal edge %s", name)
+}
+
+// IdempotencyKeyMutation represents an operation that mutates the IdempotencyKey nodes in the graph.
+type IdempotencyKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	fingerprint   *string
+	status        *int
+	addstatus     *int
+	content_type  *string
+	body          *[]byte
+	locked_until  *time.Time
+	expires_at    *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*IdempotencyKey, error)
+	predicates    []predicate.IdempotencyKey
+}
+
+var _ ent.Mutation = (*IdempotencyKeyMutation)(nil)
+
+// idempotencykeyOption allows management of the mutation configuration using functional options.
+type idempotencykeyOption func(*IdempotencyKeyMutation)
+
+// newIdempotencyKeyMutation creates new mutation for the IdempotencyKey entity.
+func newIdempotencyKeyMutation(c config, op Op, opts ...idempotencykeyOption) *IdempotencyKeyMutation {
+	m := &IdempotencyKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIdempotencyKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIdempotencyKeyID sets the ID field of the mutation.
+func withIdempotencyKeyID(id string) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IdempotencyKey
+		)
+		m.oldValue = func(ctx context.Context) (*IdempotencyKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IdempotencyKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIdempotencyKey sets the old IdempotencyKey of the mutation.
+func withIdempotencyKey(node *IdempotencyKey) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		m.oldValue = func(context.Context) (*IdempotencyKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IdempotencyKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IdempotencyKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IdempotencyKey entities.
+func (m *IdempotencyKeyMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IdempotencyKeyMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IdempotencyKeyMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IdempotencyKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (m *IdempotencyKeyMutation) SetFingerprint(s string) {
+	m.fingerprint = &s
+}
+
+// Fingerprint returns the value of the "fingerprint" field in the mutation.
+func (m *IdempotencyKeyMutation) Fingerprint() (r string, exists bool) {
+	v := m.fingerprint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFingerprint returns the old "fingerprint" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldFingerprint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFingerprint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFingerprint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFingerprint: %w", err)
+	}
+	return oldValue.Fingerprint, nil
+}
+
+// ResetFingerprint resets all changes to the "fingerprint" field.
+func (m *IdempotencyKeyMutation) ResetFingerprint() {
+	m.fingerprint = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *IdempotencyKeyMutation) SetStatus(i int) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *IdempotencyKeyMutation) Status() (r int, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldStatus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *IdempotencyKeyMutation) AddStatus(i int) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *IdempotencyKeyMutation) AddedStatus() (r int, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *IdempotencyKeyMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetContentType sets the "content_type" field.
+func (m *IdempotencyKeyMutation) SetContentType(s string) {
+	m.content_type = &s
+}
+
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *IdempotencyKeyMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentType returns the old "content_type" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldContentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+	}
+	return oldValue.ContentType, nil
+}
+
+// ResetContentType resets all changes to the "content_type" field.
+func (m *IdempotencyKeyMutation) ResetContentType() {
+	m.content_type = nil
+}
+
+// SetBody sets the "body" field.
+func (m *IdempotencyKeyMutation) SetBody(b []byte) {
+	m.body = &b
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *IdempotencyKeyMutation) Body() (r []byte, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldBody(ctx context.Context) (v *[]byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ClearBody clears the value of the "body" field.
+func (m *IdempotencyKeyMutation) ClearBody() {
+	m.body = nil
+	m.clearedFields[idempotencykey.FieldBody] = struct{}{}
+}
+
+// BodyCleared returns if the "body" field was cleared in this mutation.
+func (m *IdempotencyKeyMutation) BodyCleared() bool {
+	_, ok := m.clearedFields[idempotencykey.FieldBody]
+	return ok
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *IdempotencyKeyMutation) ResetBody() {
+	m.body = nil
+	delete(m.clearedFields, idempotencykey.FieldBody)
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *IdempotencyKeyMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *IdempotencyKeyMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldLockedUntil(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *IdempotencyKeyMutation) ResetLockedUntil() {
+	m.locked_until = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *IdempotencyKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *IdempotencyKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *IdempotencyKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IdempotencyKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IdempotencyKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IdempotencyKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the IdempotencyKeyMutation builder.
+func (m *IdempotencyKeyMutation) Where(ps ...predicate.IdempotencyKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IdempotencyKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IdempotencyKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IdempotencyKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IdempotencyKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IdempotencyKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IdempotencyKey).
+func (m *IdempotencyKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdempotencyKeyMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.fingerprint != nil {
+		fields = append(fields, idempotencykey.FieldFingerprint)
+	}
+	if m.status != nil {
+		fields = append(fields, idempotencykey.FieldStatus)
+	}
+	if m.content_type != nil {
+		fields = append(fields, idempotencykey.FieldContentType)
+	}
+	if m.body != nil {
+		fields = append(fields, idempotencykey.FieldBody)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, idempotencykey.FieldLockedUntil)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, idempotencykey.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, idempotencykey.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdempotencyKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case idempotencykey.FieldFingerprint:
+		return m.Fingerprint()
+	case idempotencykey.FieldStatus:
+		return m.Status()
+	case idempotencykey.FieldContentType:
+		return m.ContentType()
+	case idempotencykey.FieldBody:
+		return m.Body()
+	case idempotencykey.FieldLockedUntil:
+		return m.LockedUntil()
+	case idempotencykey.FieldExpiresAt:
+		return m.ExpiresAt()
+	case idempotencykey.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdempotencyKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case idempotencykey.FieldFingerprint:
+		return m.OldFingerprint(ctx)
+	case idempotencykey.FieldStatus:
+		return m.OldStatus(ctx)
+	case idempotencykey.FieldContentType:
+		return m.OldContentType(ctx)
+	case idempotencykey.FieldBody:
+		return m.OldBody(ctx)
+	case idempotencykey.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case idempotencykey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case idempotencykey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case idempotencykey.FieldFingerprint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFingerprint(v)
+		return nil
+	case idempotencykey.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case idempotencykey.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	case idempotencykey.FieldBody:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case idempotencykey.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case idempotencykey.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case idempotencykey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdempotencyKeyMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus != nil {
+		fields = append(fields, idempotencykey.FieldStatus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdempotencyKeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case idempotencykey.FieldStatus:
+		return m.AddedStatus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case idempotencykey.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdempotencyKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(idempotencykey.FieldBody) {
+		fields = append(fields, idempotencykey.FieldBody)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdempotencyKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearField(name string) error {
+	switch name {
+	case idempotencykey.FieldBody:
+		m.ClearBody()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetField(name string) error {
+	switch name {
+	case idempotencykey.FieldFingerprint:
+		m.ResetFingerprint()
+		return nil
+	case idempotencykey.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case idempotencykey.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case idempotencykey.FieldBody:
+		m.ResetBody()
+		return nil
+	case idempotencykey.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case idempotencykey.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case idempotencykey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdempotencyKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdempotencyKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdempotencyKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdempotencyKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdempotencyKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdempotencyKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey edge %s", name)
+}
+
+// OutboxEventMutation represents an operation that mutates the OutboxEvent nodes in the graph.
+type OutboxEventMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	created_at         *time.Time
+	updated_at         *time.Time
+	event_type         *string
+	payload            *json.RawMessage
+	appendpayload      json.RawMessage
+	status             *outboxevent.Status
+	delivered_to       *[]string
+	appenddelivered_to []string
+	attempts           *int
+	addattempts        *int
+	next_attempt_at    *time.Time
+	published_at       *time.Time
+	last_error         *string
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*OutboxEvent, error)
+	predicates         []predicate.OutboxEvent
+}
+
+var _ ent.Mutation = (*OutboxEventMutation)(nil)
+
+// outboxeventOption allows management of the mutation configuration using functional options.
+type outboxeventOption func(*OutboxEventMutation)
+
+// newOutboxEventMutation creates new mutation for the OutboxEvent entity.
+func newOutboxEventMutation(c config, op Op, opts ...outboxeventOption) *OutboxEventMutation {
+	m := &OutboxEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOutboxEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOutboxEventID sets the ID field of the mutation.
+func withOutboxEventID(id uuid.UUID) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OutboxEvent
+		)
+		m.oldValue = func(ctx context.Context) (*OutboxEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OutboxEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOutboxEvent sets the old OutboxEvent of the mutation.
+func withOutboxEvent(node *OutboxEvent) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		m.oldValue = func(context.Context) (*OutboxEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OutboxEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OutboxEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OutboxEvent entities.
+func (m *OutboxEventMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OutboxEventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OutboxEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OutboxEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OutboxEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OutboxEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OutboxEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *OutboxEventMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *OutboxEventMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *OutboxEventMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *OutboxEventMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *OutboxEventMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *OutboxEventMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *OutboxEventMutation) SetPayload(jm json.RawMessage) {
+	m.payload = &jm
+	m.appendpayload = nil
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *OutboxEventMutation) Payload() (r json.RawMessage, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldPayload(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// AppendPayload adds jm to the "payload" field.
+func (m *OutboxEventMutation) AppendPayload(jm json.RawMessage) {
+	m.appendpayload = append(m.appendpayload, jm...)
+}
+
+// AppendedPayload returns the list of values that were appended to the "payload" field in this mutation.
+func (m *OutboxEventMutation) AppendedPayload() (json.RawMessage, bool) {
+	if len(m.appendpayload) == 0 {
+		return nil, false
+	}
+	return m.appendpayload, true
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *OutboxEventMutation) ResetPayload() {
+	m.payload = nil
+	m.appendpayload = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *OutboxEventMutation) SetStatus(o outboxevent.Status) {
+	m.status = &o
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *OutboxEventMutation) Status() (r outboxevent.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldStatus(ctx context.Context) (v outboxevent.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *OutboxEventMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetDeliveredTo sets the "delivered_to" field.
+func (m *OutboxEventMutation) SetDeliveredTo(s []string) {
+	m.delivered_to = &s
+	m.appenddelivered_to = nil
+}
+
+// DeliveredTo returns the value of the "delivered_to" field in the mutation.
+func (m *OutboxEventMutation) DeliveredTo() (r []string, exists bool) {
+	v := m.delivered_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveredTo returns the old "delivered_to" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldDeliveredTo(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredTo: %w", err)
+	}
+	return oldValue.DeliveredTo, nil
+}
+
+// AppendDeliveredTo adds s to the "delivered_to" field.
+func (m *OutboxEventMutation) AppendDeliveredTo(s []string) {
+	m.appenddelivered_to = append(m.appenddelivered_to, s...)
+}
+
+// AppendedDeliveredTo returns the list of values that were appended to the "delivered_to" field in this mutation.
+func (m *OutboxEventMutation) AppendedDeliveredTo() ([]string, bool) {
+	if len(m.appenddelivered_to) == 0 {
+		return nil, false
+	}
+	return m.appenddelivered_to, true
+}
+
+// ClearDeliveredTo clears the value of the "delivered_to" field.
+func (m *OutboxEventMutation) ClearDeliveredTo() {
+	m.delivered_to = nil
+	m.appenddelivered_to = nil
+	m.clearedFields[outboxevent.FieldDeliveredTo] = struct{}{}
+}
+
+// DeliveredToCleared returns if the "delivered_to" field was cleared in this mutation.
+func (m *OutboxEventMutation) DeliveredToCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldDeliveredTo]
+	return ok
+}
+
+// ResetDeliveredTo resets all changes to the "delivered_to" field.
+func (m *OutboxEventMutation) ResetDeliveredTo() {
+	m.delivered_to = nil
+	m.appenddelivered_to = nil
+	delete(m.clearedFields, outboxevent.FieldDeliveredTo)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *OutboxEventMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *OutboxEventMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *OutboxEventMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *OutboxEventMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *OutboxEventMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *OutboxEventMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *OutboxEventMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *OutboxEventMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (m *OutboxEventMutation) SetPublishedAt(t time.Time) {
+	m.published_at = &t
+}
+
+// PublishedAt returns the value of the "published_at" field in the mutation.
+func (m *OutboxEventMutation) PublishedAt() (r time.Time, exists bool) {
+	v := m.published_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishedAt returns the old "published_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldPublishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedAt: %w", err)
+	}
+	return oldValue.PublishedAt, nil
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (m *OutboxEventMutation) ClearPublishedAt() {
+	m.published_at = nil
+	m.clearedFields[outboxevent.FieldPublishedAt] = struct{}{}
+}
+
+// PublishedAtCleared returns if the "published_at" field was cleared in this mutation.
+func (m *OutboxEventMutation) PublishedAtCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldPublishedAt]
+	return ok
+}
+
+// ResetPublishedAt resets all changes to the "published_at" field.
+func (m *OutboxEventMutation) ResetPublishedAt() {
+	m.published_at = nil
+	delete(m.clearedFields, outboxevent.FieldPublishedAt)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *OutboxEventMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *OutboxEventMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldLastError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *OutboxEventMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[outboxevent.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *OutboxEventMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *OutboxEventMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, outboxevent.FieldLastError)
+}
+
+// Where appends a list predicates to the OutboxEventMutation builder.
+func (m *OutboxEventMutation) Where(ps ...predicate.OutboxEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OutboxEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OutboxEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OutboxEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OutboxEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OutboxEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OutboxEvent).
+func (m *OutboxEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OutboxEventMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.created_at != nil {
+		fields = append(fields, outboxevent.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, outboxevent.FieldUpdatedAt)
+	}
+	if m.event_type != nil {
+		fields = append(fields, outboxevent.FieldEventType)
+	}
+	if m.payload != nil {
+		fields = append(fields, outboxevent.FieldPayload)
+	}
+	if m.status != nil {
+		fields = append(fields, outboxevent.FieldStatus)
+	}
+	if m.delivered_to != nil {
+		fields = append(fields, outboxevent.FieldDeliveredTo)
+	}
+	if m.attempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, outboxevent.FieldNextAttemptAt)
+	}
+	if m.published_at != nil {
+		fields = append(fields, outboxevent.FieldPublishedAt)
+	}
+	if m.last_error != nil {
+		fields = append(fields, outboxevent.FieldLastError)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OutboxEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldCreatedAt:
+		return m.CreatedAt()
+	case outboxevent.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case outboxevent.FieldEventType:
+		return m.EventType()
+	case outboxevent.FieldPayload:
+		return m.Payload()
+	case outboxevent.FieldStatus:
+		return m.Status()
+	case outboxevent.FieldDeliveredTo:
+		return m.DeliveredTo()
+	case outboxevent.FieldAttempts:
+		return m.Attempts()
+	case outboxevent.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case outboxevent.FieldPublishedAt:
+		return m.PublishedAt()
+	case outboxevent.FieldLastError:
+		return m.LastError()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OutboxEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case outboxevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case outboxevent.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case outboxevent.FieldEventType:
+		return m.OldEventType(ctx)
+	case outboxevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case outboxevent.FieldStatus:
+		return m.OldStatus(ctx)
+	case outboxevent.FieldDeliveredTo:
+		return m.OldDeliveredTo(ctx)
+	case outboxevent.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case outboxevent.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case outboxevent.FieldPublishedAt:
+		return m.OldPublishedAt(ctx)
+	case outboxevent.FieldLastError:
+		return m.OldLastError(ctx)
+	}
+	return nil, fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case outboxevent.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case outboxevent.FieldEventType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case outboxevent.FieldPayload:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case outboxevent.FieldStatus:
+		v, ok := value.(outboxevent.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case outboxevent.FieldDeliveredTo:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredTo(v)
+		return nil
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case outboxevent.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case outboxevent.FieldPublishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedAt(v)
+		return nil
+	case outboxevent.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OutboxEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OutboxEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OutboxEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(outboxevent.FieldDeliveredTo) {
+		fields = append(fields, outboxevent.FieldDeliveredTo)
+	}
+	if m.FieldCleared(outboxevent.FieldPublishedAt) {
+		fields = append(fields, outboxevent.FieldPublishedAt)
+	}
+	if m.FieldCleared(outboxevent.FieldLastError) {
+		fields = append(fields, outboxevent.FieldLastError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OutboxEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ClearField(name string) error {
+	switch name {
+	case outboxevent.FieldDeliveredTo:
+		m.ClearDeliveredTo()
+		return nil
+	case outboxevent.FieldPublishedAt:
+		m.ClearPublishedAt()
+		return nil
+	case outboxevent.FieldLastError:
+		m.ClearLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ResetField(name string) error {
+	switch name {
+	case outboxevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case outboxevent.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case outboxevent.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case outboxevent.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case outboxevent.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case outboxevent.FieldDeliveredTo:
+		m.ResetDeliveredTo()
+		return nil
+	case outboxevent.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case outboxevent.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case outboxevent.FieldPublishedAt:
+		m.ResetPublishedAt()
+		return nil
+	case outboxevent.FieldLastError:
+		m.ResetLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OutboxEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OutboxEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OutboxEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OutboxEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OutboxEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OutboxEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OutboxEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OutboxEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent edge %s", name)
+}
+
+// PairingCodeMutation represents an operation that mutates the PairingCode nodes in the graph.
+type PairingCodeMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *uuid.UUID
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	code                       *string
+	expires_at                 *time.Time
+	consumed_at                *time.Time
+	clearedFields              map[string]struct{}
+	doctor                     *uuid.UUID
+	cleareddoctor              bool
+	consumed_by_patient        *uuid.UUID
+	clearedconsumed_by_patient bool
+	done                       bool
+	oldValue                   func(context.Context) (*PairingCode, error)
+	predicates                 []predicate.PairingCode
+}
+
+var _ ent.Mutation = (*PairingCodeMutation)(nil)
+
+// pairingcodeOption allows management of the mutation configuration using functional options.
+type pairingcodeOption func(*PairingCodeMutation)
+
+// newPairingCodeMutation creates new mutation for the PairingCode entity.
+func newPairingCodeMutation(c config, op Op, opts ...pairingcodeOption) *PairingCodeMutation {
+	m := &PairingCodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePairingCode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPairingCodeID sets the ID field of the mutation.
+func withPairingCodeID(id uuid.UUID) pairingcodeOption {
+	return func(m *PairingCodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PairingCode
+		)
+		m.oldValue = func(ctx context.Context) (*PairingCode, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PairingCode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPairingCode sets the old PairingCode of the mutation.
+func withPairingCode(node *PairingCode) pairingcodeOption {
+	return func(m *PairingCodeMutation) {
+		m.oldValue = func(context.Context) (*PairingCode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PairingCodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PairingCodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PairingCode entities.
+func (m *PairingCodeMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PairingCodeMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PairingCodeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PairingCode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PairingCodeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PairingCodeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PairingCode entity.
+// If the PairingCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PairingCodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PairingCodeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PairingCodeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PairingCodeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PairingCode entity.
+// If the PairingCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PairingCodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PairingCodeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCode sets the "code" field.
+func (m *PairingCodeMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *PairingCodeMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the PairingCode entity.
+// If the PairingCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PairingCodeMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *PairingCodeMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (m *PairingCodeMutation) SetDoctorID(u uuid.UUID) {
+	m.doctor = &u
+}
+
+// DoctorID returns the value of the "doctor_id" field in the mutation.
+func (m *PairingCodeMutation) DoctorID() (r uuid.UUID, exists bool) {
+	v := m.doctor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDoctorID returns the old "doctor_id" field's value of the PairingCode entity.
+// If the PairingCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PairingCodeMutation) OldDoctorID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDoctorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDoctorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDoctorID: %w", err)
+	}
+	return oldValue.DoctorID, nil
+}
+
+// ResetDoctorID resets all changes to the "doctor_id" field.
+func (m *PairingCodeMutation) ResetDoctorID() {
+	m.doctor = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *PairingCodeMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *PairingCodeMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the PairingCode entity.
+// If the PairingCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PairingCodeMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *PairingCodeMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetConsumedAt sets the "consumed_at" field.
+func (m *PairingCodeMutation) SetConsumedAt(t time.Time) {
+	m.consumed_at = &t
+}
+
+// ConsumedAt returns the value of the "consumed_at" field in the mutation.
+func (m *PairingCodeMutation) ConsumedAt() (r time.Time, exists bool) {
+	v := m.consumed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsumedAt returns the old "consumed_at" field's value of the PairingCode entity.
+// If the PairingCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PairingCodeMutation) OldConsumedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsumedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsumedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsumedAt: %w", err)
+	}
+	return oldValue.ConsumedAt, nil
+}
+
+// ClearConsumedAt clears the value of the "consumed_at" field.
+func (m *PairingCodeMutation) ClearConsumedAt() {
+	m.consumed_at = nil
+	m.clearedFields[pairingcode.FieldConsumedAt] = struct{}{}
+}
+
+// ConsumedAtCleared returns if the "consumed_at" field was cleared in this mutation.
+func (m *PairingCodeMutation) ConsumedAtCleared() bool {
+	_, ok := m.clearedFields[pairingcode.FieldConsumedAt]
+	return ok
+}
+
+// ResetConsumedAt resets all changes to the "consumed_at" field.
+func (m *PairingCodeMutation) ResetConsumedAt() {
+	m.consumed_at = nil
+	delete(m.clearedFields, pairingcode.FieldConsumedAt)
+}
+
+// SetConsumedByPatientID sets the "consumed_by_patient_id" field.
+func (m *PairingCodeMutation) SetConsumedByPatientID(u uuid.UUID) {
+	m.consumed_by_patient = &u
+}
+
+// ConsumedByPatientID returns the value of the "consumed_by_patient_id" field in the mutation.
+func (m *PairingCodeMutation) ConsumedByPatientID() (r uuid.UUID, exists bool) {
+	v := m.consumed_by_patient
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConsumedByPatientID returns the old "consumed_by_patient_id" field's value of the PairingCode entity.
+// If the PairingCode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PairingCodeMutation) OldConsumedByPatientID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsumedByPatientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsumedByPatientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsumedByPatientID: %w", err)
+	}
+	return oldValue.ConsumedByPatientID, nil
+}
+
+// ClearConsumedByPatientID clears the value of the "consumed_by_patient_id" field.
+func (m *PairingCodeMutation) ClearConsumedByPatientID() {
+	m.consumed_by_patient = nil
+	m.clearedFields[pairingcode.FieldConsumedByPatientID] = struct{}{}
+}
+
+// ConsumedByPatientIDCleared returns if the "consumed_by_patient_id" field was cleared in this mutation.
+func (m *PairingCodeMutation) ConsumedByPatientIDCleared() bool {
+	_, ok := m.clearedFields[pairingcode.FieldConsumedByPatientID]
+	return ok
+}
+
+// ResetConsumedByPatientID resets all changes to the "consumed_by_patient_id" field.
+func (m *PairingCodeMutation) ResetConsumedByPatientID() {
+	m.consumed_by_patient = nil
+	delete(m.clearedFields, pairingcode.FieldConsumedByPatientID)
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (m *PairingCodeMutation) ClearDoctor() {
+	m.cleareddoctor = true
+	m.clearedFields[pairingcode.FieldDoctorID] = struct{}{}
+}
+
+// DoctorCleared reports if the "doctor" edge to the Doctor entity was cleared.
+func (m *PairingCodeMutation) DoctorCleared() bool {
+	return m.cleareddoctor
+}
+
+// DoctorIDs returns the "doctor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DoctorID instead. It exists only for internal usage by the builders.
+func (m *PairingCodeMutation) DoctorIDs() (ids []uuid.UUID) {
+	if id := m.doctor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDoctor resets all changes to the "doctor" edge.
+func (m *PairingCodeMutation) ResetDoctor() {
+	m.doctor = nil
+	m.cleareddoctor = false
+}
+
+// ClearConsumedByPatient clears the "consumed_by_patient" edge to the Patient entity.
+func (m *PairingCodeMutation) ClearConsumedByPatient() {
+	m.clearedconsumed_by_patient = true
+	m.clearedFields[pairingcode.FieldConsumedByPatientID] = struct{}{}
+}
+
+// ConsumedByPatientCleared reports if the "consumed_by_patient" edge to the Patient entity was cleared.
+func (m *PairingCodeMutation) ConsumedByPatientCleared() bool {
+	return m.ConsumedByPatientIDCleared() || m.clearedconsumed_by_patient
+}
+
+// ConsumedByPatientIDs returns the "consumed_by_patient" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ConsumedByPatientID instead. It exists only for internal usage by the builders.
+func (m *PairingCodeMutation) ConsumedByPatientIDs() (ids []uuid.UUID) {
+	if id := m.consumed_by_patient; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetConsumedByPatient resets all changes to the "consumed_by_patient" edge.
+func (m *PairingCodeMutation) ResetConsumedByPatient() {
+	m.consumed_by_patient = nil
+	m.clearedconsumed_by_patient = false
+}
+
+// Where appends a list predicates to the PairingCodeMutation builder.
+func (m *PairingCodeMutation) Where(ps ...predicate.PairingCode) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PairingCodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PairingCodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PairingCode, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PairingCodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PairingCodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PairingCode).
+func (m *PairingCodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PairingCodeMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_at != nil {
+		fields = append(fields, pairingcode.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, pairingcode.FieldUpdatedAt)
+	}
+	if m.code != nil {
+		fields = append(fields, pairingcode.FieldCode)
+	}
+	if m.doctor != nil {
+		fields = append(fields, pairingcode.FieldDoctorID)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, pairingcode.FieldExpiresAt)
+	}
+	if m.consumed_at != nil {
+		fields = append(fields, pairingcode.FieldConsumedAt)
+	}
+	if m.consumed_by_patient != nil {
+		fields = append(fields, pairingcode.FieldConsumedByPatientID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PairingCodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pairingcode.FieldCreatedAt:
+		return m.CreatedAt()
+	case pairingcode.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case pairingcode.FieldCode:
+		return m.Code()
+	case pairingcode.FieldDoctorID:
+		return m.DoctorID()
+	case pairingcode.FieldExpiresAt:
+		return m.ExpiresAt()
+	case pairingcode.FieldConsumedAt:
+		return m.ConsumedAt()
+	case pairingcode.FieldConsumedByPatientID:
+		return m.ConsumedByPatientID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PairingCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pairingcode.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pairingcode.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case pairingcode.FieldCode:
+		return m.OldCode(ctx)
+	case pairingcode.FieldDoctorID:
+		return m.OldDoctorID(ctx)
+	case pairingcode.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case pairingcode.FieldConsumedAt:
+		return m.OldConsumedAt(ctx)
+	case pairingcode.FieldConsumedByPatientID:
+		return m.OldConsumedByPatientID(ctx)
+	}
+	return nil, fmt.Errorf("unknown PairingCode field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PairingCodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pairingcode.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pairingcode.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case pairingcode.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case pairingcode.FieldDoctorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDoctorID(v)
+		return nil
+	case pairingcode.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case pairingcode.FieldConsumedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsumedAt(v)
+		return nil
+	case pairingcode.FieldConsumedByPatientID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsumedByPatientID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PairingCode field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PairingCodeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PairingCodeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PairingCodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PairingCode numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PairingCodeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pairingcode.FieldConsumedAt) {
+		fields = append(fields, pairingcode.FieldConsumedAt)
+	}
+	if m.FieldCleared(pairingcode.FieldConsumedByPatientID) {
+		fields = append(fields, pairingcode.FieldConsumedByPatientID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PairingCodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PairingCodeMutation) ClearField(name string) error {
+	switch name {
+	case pairingcode.FieldConsumedAt:
+		m.ClearConsumedAt()
+		return nil
+	case pairingcode.FieldConsumedByPatientID:
+		m.ClearConsumedByPatientID()
+		return nil
+	}
+	return fmt.Errorf("unknown PairingCode nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PairingCodeMutation) ResetField(name string) error {
+	switch name {
+	case pairingcode.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pairingcode.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case pairingcode.FieldCode:
+		m.ResetCode()
+		return nil
+	case pairingcode.FieldDoctorID:
+		m.ResetDoctorID()
+		return nil
+	case pairingcode.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case pairingcode.FieldConsumedAt:
+		m.ResetConsumedAt()
+		return nil
+	case pairingcode.FieldConsumedByPatientID:
+		m.ResetConsumedByPatientID()
+		return nil
+	}
+	return fmt.Errorf("unknown PairingCode field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PairingCodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.doctor != nil {
+		edges = append(edges, pairingcode.EdgeDoctor)
+	}
+	if m.consumed_by_patient != nil {
+		edges = append(edges, pairingcode.EdgeConsumedByPatient)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PairingCodeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pairingcode.EdgeDoctor:
+		if id := m.doctor; id != nil {
+			return []ent.Value{*id}
+		}
+	case pairingcode.EdgeConsumedByPatient:
+		if id := m.consumed_by_patient; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PairingCodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PairingCodeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PairingCodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareddoctor {
+		edges = append(edges, pairingcode.EdgeDoctor)
+	}
+	if m.clearedconsumed_by_patient {
+		edges = append(edges, pairingcode.EdgeConsumedByPatient)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PairingCodeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pairingcode.EdgeDoctor:
+		return m.cleareddoctor
+	case pairingcode.EdgeConsumedByPatient:
+		return m.clearedconsumed_by_patient
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PairingCodeMutation) ClearEdge(name string) error {
+	switch name {
+	case pairingcode.EdgeDoctor:
+		m.ClearDoctor()
+		return nil
+	case pairingcode.EdgeConsumedByPatient:
+		m.ClearConsumedByPatient()
+		return nil
+	}
+	return fmt.Errorf("unknown PairingCode unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PairingCodeMutation) ResetEdge(name string) error {
+	switch name {
+	case pairingcode.EdgeDoctor:
+		m.ResetDoctor()
+		return nil
+	case pairingcode.EdgeConsumedByPatient:
+		m.ResetConsumedByPatient()
+		return nil
+	}
+	return fmt.Errorf("unknown PairingCode edge %s", name)
+}
+
+// PatientMutation represents an operation that mutates the Patient nodes in the graph.
+type PatientMutation struct {
+	config
+	op                               Op
+	typ                              string
+	id                               *uuid.UUID
+	created_at                       *time.Time
+	updated_at                       *time.Time
+	display_name                     *string
+	birth_date                       *time.Time
+	status                           *patient.Status
+	email                            *string
+	password_hash                    *string
+	patient_code                     *string
+	last_entry_at                    *time.Time
+	clearedFields                    map[string]struct{}
+	doctor_links                     map[uuid.UUID]struct{}
+	removeddoctor_links              map[uuid.UUID]struct{}
+	cleareddoctor_links              bool
+	consumed_pairing_codes           map[uuid.UUID]struct{}
+	removedconsumed_pairing_codes    map[uuid.UUID]struct{}
+	clearedconsumed_pairing_codes    bool
+	entries                          map[uuid.UUID]struct{}
+	removedentries                   map[uuid.UUID]struct{}
+	clearedentries                   bool
+	analysis_jobs                    map[uuid.UUID]struct{}
+	removedanalysis_jobs             map[uuid.UUID]struct{}
+	clearedanalysis_jobs             bool
+	entry_shares                     map[uuid.UUID]struct{}
+	removedentry_shares              map[uuid.UUID]struct{}
+	clearedentry_shares              bool
+	goals                            map[uuid.UUID]struct{}
+	removedgoals                     map[uuid.UUID]struct{}
+	clearedgoals                     bool
+	assignments                      map[uuid.UUID]struct{}
+	removedassignments               map[uuid.UUID]struct{}
+	clearedassignments               bool
+	sessions                         map[uuid.UUID]struct{}
+	removedsessions                  map[uuid.UUID]struct{}
+	clearedsessions                  bool
+	questionnaire_assignments        map[uuid.UUID]struct{}
+	removedquestionnaire_assignments map[uuid.UUID]struct{}
+	clearedquestionnaire_assignments bool
+	questionnaire_responses          map[uuid.UUID]struct{}
+	removedquestionnaire_responses   map[uuid.UUID]struct{}
+	clearedquestionnaire_responses   bool
+	reminder_schedules               map[uuid.UUID]struct{}
+	removedreminder_schedules        map[uuid.UUID]struct{}
+	clearedreminder_schedules        bool
+	reminder_deliveries              map[uuid.UUID]struct{}
+	removedreminder_deliveries       map[uuid.UUID]struct{}
+	clearedreminder_deliveries       bool
+	done                             bool
+	oldValue                         func(context.Context) (*Patient, error)
+	predicates                       []predicate.Patient
+}
+
+var _ ent.Mutation = (*PatientMutation)(nil)
+
+// patientOption allows management of the mutation configuration using functional options.
+type patientOption func(*PatientMutation)
+
+// newPatientMutation creates new mutation for the Patient entity.
+func newPatientMutation(c config, op Op, opts ...patientOption) *PatientMutation {
+	m := &PatientMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePatient,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPatientID sets the ID field of the mutation.
+func withPatientID(id uuid.UUID) patientOption {
+	return func(m *PatientMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Patient
+		)
+		m.oldValue = func(ctx context.Context) (*Patient, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Patient.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPatient sets the old Patient of the mutation.
+func withPatient(node *Patient) patientOption {
+	return func(m *PatientMutation) {
+		m.oldValue = func(context.Context) (*Patient, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PatientMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PatientMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Patient entities.
+func (m *PatientMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PatientMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PatientMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Patient.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PatientMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PatientMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Patient entity.
+// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PatientMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PatientMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PatientMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PatientMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Patient entity.
+// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PatientMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PatientMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *PatientMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *PatientMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the Patient entity.
+// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PatientMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *PatientMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetBirthDate sets the "birth_date" field.
+func (m *PatientMutation) SetBirthDate(t time.Time) {
+	m.birth_date = &t
+}
+
+// BirthDate returns the value of the "birth_date" field in the mutation.
+func (m *PatientMutation) BirthDate() (r time.Time, exists bool) {
+	v := m.birth_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBirthDate returns the old "birth_date" field's value of the Patient entity.
+// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PatientMutation) OldBirthDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBirthDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBirthDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBirthDate: %w", err)
+	}
+	return oldValue.BirthDate, nil
+}
+
+// ClearBirthDate clears the value of the "birth_date" field.
+func (m *PatientMutation) ClearBirthDate() {
+	m.birth_date = nil
+	m.clearedFields[patient.FieldBirthDate] = struct{}{}
+}
+
+// BirthDateCleared returns if the "birth_date" field was cleared in this mutation.
+func (m *PatientMutation) BirthDateCleared() bool {
+	_, ok := m.clearedFields[patient.FieldBirthDate]
+	return ok
+}
+
+// ResetBirthDate resets all changes to the "birth_date" field.
+func (m *PatientMutation) ResetBirthDate() {
+	m.birth_date = nil
+	delete(m.clearedFields, patient.FieldBirthDate)
+}
+
+// SetStatus sets the "status" field.
+func (m *PatientMutation) SetStatus(pa patient.Status) {
+	m.status = &pa
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PatientMutation) Status() (r patient.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Patient entity.
+// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PatientMutation) OldStatus(ctx context.Context) (v patient.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PatientMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *PatientMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *PatientMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Patient entity.
+// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PatientMutation) OldEmail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *PatientMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[patient.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *PatientMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[patient.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *PatientMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, patient.FieldEmail)
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (m *PatientMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
+}
+
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *PatientMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHash returns the old "password_hash" field's value of the Patient entity.
+// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PatientMutation) OldPasswordHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (m *PatientMutation) ClearPasswordHash() {
+	m.password_hash = nil
+	m.clearedFields[patient.FieldPasswordHash] = struct{}{}
+}
+
+// PasswordHashCleared returns if the "password_hash" field was cleared in this mutation.
+func (m *PatientMutation) PasswordHashCleared() bool {
+	_, ok := m.clearedFields[patient.FieldPasswordHash]
+	return ok
+}
+
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *PatientMutation) ResetPasswordHash() {
+	m.password_hash = nil
+	delete(m.clearedFields, patient.FieldPasswordHash)
+}
+
+// SetPatientCode sets the "patient_code" field.
+func (m *PatientMutation) SetPatientCode(s string) {
+	m.patient_code = &s
+}
+
+// PatientCode returns the value of the "patient_code" field in the mutation.
+func (m *PatientMutation) PatientCode() (r string, exists bool) {
+	v := m.patient_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPatientCode returns the old "patient_code" field's value of the Patient entity.
+// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PatientMutation) OldPatientCode(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPatientCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPatientCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPatientCode: %w", err)
+	}
+	return oldValue.PatientCode, nil
+}
+
+// ClearPatientCode clears the value of the "patient_code" field.
+func (m *PatientMutation) ClearPatientCode() {
+	m.patient_code = nil
+	m.clearedFields[patient.FieldPatientCode] = struct{}{}
+}
+
+// PatientCodeCleared returns if the "patient_code" field was cleared in this mutation.
+func (m *PatientMutation) PatientCodeCleared() bool {
+	_, ok := m.clearedFields[patient.FieldPatientCode]
+	return ok
+}
+
+// ResetPatientCode resets all changes to the "patient_code" field.
+func (m *PatientMutation) ResetPatientCode() {
+	m.patient_code = nil
+	delete(m.clearedFields, patient.FieldPatientCode)
+}
+
+// SetLastEntryAt sets the "last_entry_at" field.
+func (m *PatientMutation) SetLastEntryAt(t time.Time) {
+	m.last_entry_at = &t
+}
+
+// LastEntryAt returns the value of the "last_entry_at" field in the mutation.
+func (m *PatientMutation) LastEntryAt() (r time.Time, exists bool) {
+	v := m.last_entry_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastEntryAt returns the old "last_entry_at" field's value of the Patient entity.
+// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PatientMutation) OldLastEntryAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastEntryAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastEntryAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastEntryAt: %w", err)
+	}
+	return oldValue.LastEntryAt, nil
+}
+
+// ClearLastEntryAt clears the value of the "last_entry_at" field.
+func (m *PatientMutation) ClearLastEntryAt() {
+	m.last_entry_at = nil
+	m.clearedFields[patient.FieldLastEntryAt] = struct{}{}
+}
+
+// LastEntryAtCleared returns if the "last_entry_at" field was cleared in this mutation.
+func (m *PatientMutation) LastEntryAtCleared() bool {
+	_, ok := m.clearedFields[patient.FieldLastEntryAt]
+	return ok
+}
+
+// ResetLastEntryAt resets all changes to the "last_entry_at" field.
+func (m *PatientMutation) ResetLastEntryAt() {
+	m.last_entry_at = nil
+	delete(m.clearedFields, patient.FieldLastEntryAt)
+}
+
+// AddDoctorLinkIDs adds the "doctor_links" edge to the DoctorPatientLink entity by ids.
+func (m *PatientMutation) AddDoctorLinkIDs(ids ...uuid.UUID) {
+	if m.doctor_links == nil {
+		m.doctor_links = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.doctor_links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDoctorLinks clears the "doctor_links" edge to the DoctorPatientLink entity.
+func (m *PatientMutation) ClearDoctorLinks() {
+	m.cleareddoctor_links = true
+}
+
+// DoctorLinksCleared reports if the "doctor_links" edge to the DoctorPatientLink entity was cleared.
+func (m *PatientMutation) DoctorLinksCleared() bool {
+	return m.cleareddoctor_links
+}
+
+// RemoveDoctorLinkIDs removes the "doctor_links" edge to the DoctorPatientLink entity by IDs.
+func (m *PatientMutation) RemoveDoctorLinkIDs(ids ...uuid.UUID) {
+	if m.removeddoctor_links == nil {
+		m.removeddoctor_links = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.doctor_links, ids[i])
+		m.removeddoctor_links[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDoctorLinks returns the removed IDs of the "doctor_links" edge to the DoctorPatientLink entity.
+func (m *PatientMutation) RemovedDoctorLinksIDs() (ids []uuid.UUID) {
+	for id := range m.removeddoctor_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DoctorLinksIDs returns the "doctor_links" edge IDs in the mutation.
+func (m *PatientMutation) DoctorLinksIDs() (ids []uuid.UUID) {
+	for id := range m.doctor_links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDoctorLinks resets all changes to the "doctor_links" edge.
+func (m *PatientMutation) ResetDoctorLinks() {
+	m.doctor_links = nil
+	m.cleareddoctor_links = false
+	m.removeddoctor_links = nil
+}
+
+// AddConsumedPairingCodeIDs adds the "consumed_pairing_codes" edge to the PairingCode entity by ids.
+func (m *PatientMutation) AddConsumedPairingCodeIDs(ids ...uuid.UUID) {
+	if m.consumed_pairing_codes == nil {
+		m.consumed_pairing_codes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.consumed_pairing_codes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearConsumedPairingCodes clears the "consumed_pairing_codes" edge to the PairingCode entity.
+func (m *PatientMutation) ClearConsumedPairingCodes() {
+	m.clearedconsumed_pairing_codes = true
+}
+
+// ConsumedPairingCodesCleared reports if the "consumed_pairing_codes" edge to the PairingCode entity was cleared.
+func (m *PatientMutation) ConsumedPairingCodesCleared() bool {
+	return m.clearedconsumed_pairing_codes
+}
+
+// RemoveConsumedPairingCodeIDs removes the "consumed_pairing_codes" edge to the PairingCode entity by IDs.
+func (m *PatientMutation) RemoveConsumedPairingCodeIDs(ids ...uuid.UUID) {
+	if m.removedconsumed_pairing_codes == nil {
+		m.removedconsumed_pairing_codes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.consumed_pairing_codes, ids[i])
+		m.removedconsumed_pairing_codes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedConsumedPairingCodes returns the removed IDs of the "consumed_pairing_codes" edge to the PairingCode entity.
+func (m *PatientMutation) RemovedConsumedPairingCodesIDs() (ids []uuid.UUID) {
+	for id := range m.removedconsumed_pairing_codes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ConsumedPairingCodesIDs returns the "consumed_pairing_codes" edge IDs in the mutation.
+func (m *PatientMutation) ConsumedPairingCodesIDs() (ids []uuid.UUID) {
+	for id := range m.consumed_pairing_codes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetConsumedPairingCodes resets all changes to the "consumed_pairing_codes" edge.
+func (m *PatientMutation) ResetConsumedPairingCodes() {
+	m.consumed_pairing_codes = nil
+	m.clearedconsumed_pairing_codes = false
+	m.removedconsumed_pairing_codes = nil
+}
+
+// AddEntryIDs adds the "entries" edge to the Entry entity by ids.
+func (m *PatientMutation) AddEntryIDs(ids ...uuid.UUID) {
+	if m.entries == nil {
+		m.entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEntries clears the "entries" edge to the Entry entity.
+func (m *PatientMutation) ClearEntries() {
+	m.clearedentries = true
+}
+
+// EntriesCleared reports if the "entries" edge to the Entry entity was cleared.
+func (m *PatientMutation) EntriesCleared() bool {
+	return m.clearedentries
+}
+
+// RemoveEntryIDs removes the "entries" edge to the Entry entity by IDs.
+func (m *PatientMutation) RemoveEntryIDs(ids ...uuid.UUID) {
+	if m.removedentries == nil {
+		m.removedentries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.entries, ids[i])
+		m.removedentries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEntries returns the removed IDs of the "entries" edge to the Entry entity.
+func (m *PatientMutation) RemovedEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedentries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EntriesIDs returns the "entries" edge IDs in the mutation.
+func (m *PatientMutation) EntriesIDs() (ids []uuid.UUID) {
+	for id := range m.entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEntries resets all changes to the "entries" edge.
+func (m *PatientMutation) ResetEntries() {
+	m.entries = nil
+	m.clearedentries = false
+	m.removedentries = nil
+}
+
+// AddAnalysisJobIDs adds the "analysis_jobs" edge to the AnalysisJob entity by ids.
+func (m *PatientMutation) AddAnalysisJobIDs(ids ...uuid.UUID) {
+	if m.analysis_jobs == nil {
+		m.analysis_jobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.analysis_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnalysisJobs clears the "analysis_jobs" edge to the AnalysisJob entity.
+func (m *PatientMutation) ClearAnalysisJobs() {
+	m.clearedanalysis_jobs = true
+}
+
+// AnalysisJobsCleared reports if the "analysis_jobs" edge to the AnalysisJob entity was cleared.
+func (m *PatientMutation) AnalysisJobsCleared() bool {
+	return m.clearedanalysis_jobs
+}
+
+// RemoveAnalysisJobIDs removes the "analysis_jobs" edge to the AnalysisJob entity by IDs.
+func (m *PatientMutation) RemoveAnalysisJobIDs(ids ...uuid.UUID) {
+	if m.removedanalysis_jobs == nil {
+		m.removedanalysis_jobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.analysis_jobs, ids[i])
+		m.removedanalysis_jobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnalysisJobs returns the removed IDs of the "analysis_jobs" edge to the AnalysisJob entity.
+func (m *PatientMutation) RemovedAnalysisJobsIDs() (ids []uuid.UUID) {
+	for id := range m.removedanalysis_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnalysisJobsIDs returns the "analysis_jobs" edge IDs in the mutation.
+func (m *PatientMutation) AnalysisJobsIDs() (ids []uuid.UUID) {
+	for id := range m.analysis_jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnalysisJobs resets all changes to the "analysis_jobs" edge.
+func (m *PatientMutation) ResetAnalysisJobs() {
+	m.analysis_jobs = nil
+	m.clearedanalysis_jobs = false
+	m.removedanalysis_jobs = nil
+}
+
+// AddEntryShareIDs adds the "entry_shares" edge to the EntryShare entity by ids.
+func (m *PatientMutation) AddEntryShareIDs(ids ...uuid.UUID) {
+	if m.entry_shares == nil {
+		m.entry_shares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.entry_shares[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEntryShares clears the "entry_shares" edge to the EntryShare entity.
+func (m *PatientMutation) ClearEntryShares() {
+	m.clearedentry_shares = true
+}
+
+// EntrySharesCleared reports if the "entry_shares" edge to the EntryShare entity was cleared.
+func (m *PatientMutation) EntrySharesCleared() bool {
+	return m.clearedentry_shares
+}
+
+// RemoveEntryShareIDs removes the "entry_shares" edge to the EntryShare entity by IDs.
+func (m *PatientMutation) RemoveEntryShareIDs(ids ...uuid.UUID) {
+	if m.removedentry_shares == nil {
+		m.removedentry_shares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.entry_shares, ids[i])
+		m.removedentry_shares[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEntryShares returns the removed IDs of the "entry_shares" edge to the EntryShare entity.
+func (m *PatientMutation) RemovedEntrySharesIDs() (ids []uuid.UUID) {
+	for id := range m.removedentry_shares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EntrySharesIDs returns the "entry_shares" edge IDs in the mutation.
+func (m *PatientMutation) EntrySharesIDs() (ids []uuid.UUID) {
+	for id := range m.entry_shares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEntryShares resets all changes to the "entry_shares" edge.
+func (m *PatientMutation) ResetEntryShares() {
+	m.entry_shares = nil
+	m.clearedentry_shares = false
+	m.removedentry_shares = nil
+}
+
+// AddGoalIDs adds the "goals" edge to the Goal entity by ids.
+func (m *PatientMutation) AddGoalIDs(ids ...uuid.UUID) {
+	if m.goals == nil {
+		m.goals = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.goals[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGoals clears the "goals" edge to the Goal entity.
+func (m *PatientMutation) ClearGoals() {
+	m.clearedgoals = true
+}
+
+// GoalsCleared reports if the "goals" edge to the Goal entity was cleared.
+func (m *PatientMutation) GoalsCleared() bool {
+	return m.clearedgoals
+}
+
+// RemoveGoalIDs removes the "goals" edge to the Goal entity by IDs.
+func (m *PatientMutation) RemoveGoalIDs(ids ...uuid.UUID) {
+	if m.removedgoals == nil {
+		m.removedgoals = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.goals, ids[i])
+		m.removedgoals[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGoals returns the removed IDs of the "goals" edge to the Goal entity.
+func (m *PatientMutation) RemovedGoalsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgoals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GoalsIDs returns the "goals" edge IDs in the mutation.
+func (m *PatientMutation) GoalsIDs() (ids []uuid.UUID) {
+	for id := range m.goals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGoals resets all changes to the "goals" edge.
+func (m *PatientMutation) ResetGoals() {
+	m.goals = nil
+	m.clearedgoals = false
+	m.removedgoals = nil
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the Assignment entity by ids.
+func (m *PatientMutation) AddAssignmentIDs(ids ...uuid.UUID) {
+	if m.assignments == nil {
+		m.assignments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.assignments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAssignments clears the "assignments" edge to the Assignment entity.
+func (m *PatientMutation) ClearAssignments() {
+	m.clearedassignments = true
+}
+
+// AssignmentsCleared reports if the "assignments" edge to the Assignment entity was cleared.
+func (m *PatientMutation) AssignmentsCleared() bool {
+	return m.clearedassignments
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to the Assignment entity by IDs.
+func (m *PatientMutation) RemoveAssignmentIDs(ids ...uuid.UUID) {
+	if m.removedassignments == nil {
+		m.removedassignments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.assignments, ids[i])
+		m.removedassignments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAssignments returns the removed IDs of the "assignments" edge to the Assignment entity.
+func (m *PatientMutation) RemovedAssignmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedassignments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AssignmentsIDs returns the "assignments" edge IDs in the mutation.
+func (m *PatientMutation) AssignmentsIDs() (ids []uuid.UUID) {
+	for id := range m.assignments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAssignments resets all changes to the "assignments" edge.
+func (m *PatientMutation) ResetAssignments() {
+	m.assignments = nil
+	m.clearedassignments = false
+	m.removedassignments = nil
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
+func (m *PatientMutation) AddSessionIDs(ids ...uuid.UUID) {
+	if m.sessions == nil {
+		m.sessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *PatientMutation) ClearSessions() {
+	m.clearedsessions = true
+}
+
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *PatientMutation) SessionsCleared() bool {
+	return m.clearedsessions
+}
+
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *PatientMutation) RemoveSessionIDs(ids ...uuid.UUID) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *PatientMutation) RemovedSessionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *PatientMutation) SessionsIDs() (ids []uuid.UUID) {
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *PatientMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
+}
+
+// AddQuestionnaireAssignmentIDs adds the "questionnaire_assignments" edge to the QuestionnaireAssignment entity by ids.
+func (m *PatientMutation) AddQuestionnaireAssignmentIDs(ids ...uuid.UUID) {
+	if m.questionnaire_assignments == nil {
+		m.questionnaire_assignments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.questionnaire_assignments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuestionnaireAssignments clears the "questionnaire_assignments" edge to the QuestionnaireAssignment entity.
+func (m *PatientMutation) ClearQuestionnaireAssignments() {
+	m.clearedquestionnaire_assignments = true
+}
+
+// QuestionnaireAssignmentsCleared reports if the "questionnaire_assignments" edge to the QuestionnaireAssignment entity was cleared.
+func (m *PatientMutation) QuestionnaireAssignmentsCleared() bool {
+	return m.clearedquestionnaire_assignments
+}
+
+// RemoveQuestionnaireAssignmentIDs removes the "questionnaire_assignments" edge to the QuestionnaireAssignment entity by IDs.
+func (m *PatientMutation) RemoveQuestionnaireAssignmentIDs(ids ...uuid.UUID) {
+	if m.removedquestionnaire_assignments == nil {
+		m.removedquestionnaire_assignments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.questionnaire_assignments, ids[i])
+		m.removedquestionnaire_assignments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuestionnaireAssignments returns the removed IDs of the "questionnaire_assignments" edge to the QuestionnaireAssignment entity.
+func (m *PatientMutation) RemovedQuestionnaireAssignmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedquestionnaire_assignments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuestionnaireAssignmentsIDs returns the "questionnaire_assignments" edge IDs in the mutation.
+func (m *PatientMutation) QuestionnaireAssignmentsIDs() (ids []uuid.UUID) {
+	for id := range m.questionnaire_assignments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuestionnaireAssignments resets all changes to the "questionnaire_assignments" edge.
+func (m *PatientMutation) ResetQuestionnaireAssignments() {
+	m.questionnaire_assignments = nil
+	m.clearedquestionnaire_assignments = false
+	m.removedquestionnaire_assignments = nil
+}
+
+// AddQuestionnaireResponseIDs adds the "questionnaire_responses" edge to the QuestionnaireResponse entity by ids.
+func (m *PatientMutation) AddQuestionnaireResponseIDs(ids ...uuid.UUID) {
+	if m.questionnaire_responses == nil {
+		m.questionnaire_responses = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.questionnaire_responses[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuestionnaireResponses clears the "questionnaire_responses" edge to the QuestionnaireResponse entity.
+func (m *PatientMutation) ClearQuestionnaireResponses() {
+	m.clearedquestionnaire_responses = true
+}
+
+// QuestionnaireResponsesCleared reports if the "questionnaire_responses" edge to the QuestionnaireResponse entity was cleared.
+func (m *PatientMutation) QuestionnaireResponsesCleared() bool {
+	return m.clearedquestionnaire_responses
+}
+
+// RemoveQuestionnaireResponseIDs removes the "questionnaire_responses" edge to the QuestionnaireResponse entity by IDs.
+func (m *PatientMutation) RemoveQuestionnaireResponseIDs(ids ...uuid.UUID) {
+	if m.removedquestionnaire_responses == nil {
+		m.removedquestionnaire_responses = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.questionnaire_responses, ids[i])
+		m.removedquestionnaire_responses[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuestionnaireResponses returns the removed IDs of the "questionnaire_responses" edge to the QuestionnaireResponse entity.
+func (m *PatientMutation) RemovedQuestionnaireResponsesIDs() (ids []uuid.UUID) {
+	for id := range m.removedquestionnaire_responses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuestionnaireResponsesIDs returns the "questionnaire_responses" edge IDs in the mutation.
+func (m *PatientMutation) QuestionnaireResponsesIDs() (ids []uuid.UUID) {
+	for id := range m.questionnaire_responses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuestionnaireResponses resets all changes to the "questionnaire_responses" edge.
+func (m *PatientMutation) ResetQuestionnaireResponses() {
+	m.questionnaire_responses = nil
+	m.clearedquestionnaire_responses = false
+	m.removedquestionnaire_responses = nil
+}
+
+// AddReminderScheduleIDs adds the "reminder_schedules" edge to the ReminderSchedule entity by ids.
+func (m *PatientMutation) AddReminderScheduleIDs(ids ...uuid.UUID) {
+	if m.reminder_schedules == nil {
+		m.reminder_schedules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reminder_schedules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReminderSchedules clears the "reminder_schedules" edge to the ReminderSchedule entity.
+func (m *PatientMutation) ClearReminderSchedules() {
+	m.clearedreminder_schedules = true
+}
+
+// ReminderSchedulesCleared reports if the "reminder_schedules" edge to the ReminderSchedule entity was cleared.
+func (m *PatientMutation) ReminderSchedulesCleared() bool {
+	return m.clearedreminder_schedules
+}
+
+// RemoveReminderScheduleIDs removes the "reminder_schedules" edge to the ReminderSchedule entity by IDs.
+func (m *PatientMutation) RemoveReminderScheduleIDs(ids ...uuid.UUID) {
+	if m.removedreminder_schedules == nil {
+		m.removedreminder_schedules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reminder_schedules, ids[i])
+		m.removedreminder_schedules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReminderSchedules returns the removed IDs of the "reminder_schedules" edge to the ReminderSchedule entity.
+func (m *PatientMutation) RemovedReminderSchedulesIDs() (ids []uuid.UUID) {
+	for id := range m.removedreminder_schedules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReminderSchedulesIDs returns the "reminder_schedules" edge IDs in the mutation.
+func (m *PatientMutation) ReminderSchedulesIDs() (ids []uuid.UUID) {
+	for id := range m.reminder_schedules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReminderSchedules resets all changes to the "reminder_schedules" edge.
+func (m *PatientMutation) ResetReminderSchedules() {
+	m.reminder_schedules = nil
+	m.clearedreminder_schedules = false
+	m.removedreminder_schedules = nil
+}
+
+// AddReminderDeliveryIDs adds the "reminder_deliveries" edge to the ReminderDelivery entity by ids.
+func (m *PatientMutation) AddReminderDeliveryIDs(ids ...uuid.UUID) {
+	if m.reminder_deliveries == nil {
+		m.reminder_deliveries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reminder_deliveries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReminderDeliveries clears the "reminder_deliveries" edge to the ReminderDelivery entity.
+func (m *PatientMutation) ClearReminderDeliveries() {
+	m.clearedreminder_deliveries = true
+}
+
+// ReminderDeliveriesCleared reports if the "reminder_deliveries" edge to the ReminderDelivery entity was cleared.
+func (m *PatientMutation) ReminderDeliveriesCleared() bool {
+	return m.clearedreminder_deliveries
+}
+
+// RemoveReminderDeliveryIDs removes the "reminder_deliveries" edge to the ReminderDelivery entity by IDs.
+func (m *PatientMutation) RemoveReminderDeliveryIDs(ids ...uuid.UUID) {
+	if m.removedreminder_deliveries == nil {
+		m.removedreminder_deliveries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reminder_deliveries, ids[i])
+		m.removedreminder_deliveries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReminderDeliveries returns the removed IDs of the "reminder_deliveries" edge to the ReminderDelivery entity.
+func (m *PatientMutation) RemovedReminderDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedreminder_deliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReminderDeliveriesIDs returns the "reminder_deliveries" edge IDs in the mutation.
+func (m *PatientMutation) ReminderDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.reminder_deliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReminderDeliveries resets all changes to the "reminder_deliveries" edge.
+func (m *PatientMutation) ResetReminderDeliveries() {
+	m.reminder_deliveries = nil
+	m.clearedreminder_deliveries = false
+	m.removedreminder_deliveries = nil
+}
+
+// Where appends a list predicates to the PatientMutation builder.
+func (m *PatientMutation) Where(ps ...predicate.Patient) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PatientMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PatientMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Patient, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PatientMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PatientMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Patient).
+func (m *PatientMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PatientMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, patient.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, patient.FieldUpdatedAt)
+	}
+	if m.display_name != nil {
+		fields = append(fields, patient.FieldDisplayName)
+	}
+	if m.birth_date != nil {
+		fields = append(fields, patient.FieldBirthDate)
+	}
+	if m.status != nil {
+		fields = append(fields, patient.FieldStatus)
+	}
+	if m.email != nil {
+		fields = append(fields, patient.FieldEmail)
+	}
+	if m.password_hash != nil {
+		fields = append(fields, patient.FieldPasswordHash)
+	}
+	if m.patient_code != nil {
+		fields = append(fields, patient.FieldPatientCode)
+	}
+	if m.last_entry_at != nil {
+		fields = append(fields, patient.FieldLastEntryAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PatientMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case patient.FieldCreatedAt:
+		return m.CreatedAt()
+	case patient.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case patient.FieldDisplayName:
+		return m.DisplayName()
+	case patient.FieldBirthDate:
+		return m.BirthDate()
+	case patient.FieldStatus:
+		return m.Status()
+	case patient.FieldEmail:
+		return m.Email()
+	case patient.FieldPasswordHash:
+		return m.PasswordHash()
+	case patient.FieldPatientCode:
+		return m.PatientCode()
+	case patient.FieldLastEntryAt:
+		return m.LastEntryAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PatientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case patient.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case patient.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case patient.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case patient.FieldBirthDate:
+		return m.OldBirthDate(ctx)
+	case patient.FieldStatus:
+		return m.OldStatus(ctx)
+	case patient.FieldEmail:
+		return m.OldEmail(ctx)
+	case patient.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case patient.FieldPatientCode:
+		return m.OldPatientCode(ctx)
+	case patient.FieldLastEntryAt:
+		return m.OldLastEntryAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Patient field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PatientMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case patient.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case patient.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case patient.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case patient.FieldBirthDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBirthDate(v)
+		return nil
+	case patient.FieldStatus:
+		v, ok := value.(patient.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case patient.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case patient.FieldPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHash(v)
+		return nil
+	case patient.FieldPatientCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPatientCode(v)
+		return nil
+	case patient.FieldLastEntryAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastEntryAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Patient field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PatientMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PatientMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PatientMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Patient numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PatientMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(patient.FieldBirthDate) {
+		fields = append(fields, patient.FieldBirthDate)
+	}
+	if m.FieldCleared(patient.FieldEmail) {
+		fields = append(fields, patient.FieldEmail)
+	}
+	if m.FieldCleared(patient.FieldPasswordHash) {
+		fields = append(fields, patient.FieldPasswordHash)
+	}
+	if m.FieldCleared(patient.FieldPatientCode) {
+		fields = append(fields, patient.FieldPatientCode)
+	}
+	if m.FieldCleared(patient.FieldLastEntryAt) {
+		fields = append(fields, patient.FieldLastEntryAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PatientMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PatientMutation) ClearField(name string) error {
+	switch name {
+	case patient.FieldBirthDate:
+		m.ClearBirthDate()
+		return nil
+	case patient.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case patient.FieldPasswordHash:
+		m.ClearPasswordHash()
+		return nil
+	case patient.FieldPatientCode:
+		m.ClearPatientCode()
+		return nil
+	case patient.FieldLastEntryAt:
+		m.ClearLastEntryAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Patient nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PatientMutation) ResetField(name string) error {
+	switch name {
+	case patient.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case patient.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case patient.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case patient.FieldBirthDate:
+		m.ResetBirthDate()
+		return nil
+	case patient.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case patient.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case patient.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case patient.FieldPatientCode:
+		m.ResetPatientCode()
+		return nil
+	case patient.FieldLastEntryAt:
+		m.ResetLastEntryAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Patient field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PatientMutation) AddedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.doctor_links != nil {
+		edges = append(edges, patient.EdgeDoctorLinks)
+	}
+	if m.consumed_pairing_codes != nil {
+		edges = append(edges, patient.EdgeConsumedPairingCodes)
+	}
+	if m.entries != nil {
+		edges = append(edges, patient.EdgeEntries)
+	}
+	if m.analysis_jobs != nil {
+		edges = append(edges, patient.EdgeAnalysisJobs)
+	}
+	if m.entry_shares != nil {
+		edges = append(edges, patient.EdgeEntryShares)
+	}
+	if m.goals != nil {
+		edges = append(edges, patient.EdgeGoals)
+	}
+	if m.assignments != nil {
+		edges = append(edges, patient.EdgeAssignments)
+	}
+	if m.sessions != nil {
+		edges = append(edges, patient.EdgeSessions)
+	}
+	if m.questionnaire_assignments != nil {
+		edges = append(edges, patient.EdgeQuestionnaireAssignments)
+	}
+	if m.questionnaire_responses != nil {
+		edges = append(edges, patient.EdgeQuestionnaireResponses)
+	}
+	if m.reminder_schedules != nil {
+		edges = append(edges, patient.EdgeReminderSchedules)
+	}
+	if m.reminder_deliveries != nil {
+		edges = append(edges, patient.EdgeReminderDeliveries)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PatientMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case patient.EdgeDoctorLinks:
+		ids := make([]ent.Value, 0, len(m.doctor_links))
+		for id := range m.doctor_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeConsumedPairingCodes:
+		ids := make([]ent.Value, 0, len(m.consumed_pairing_codes))
+		for id := range m.consumed_pairing_codes {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeEntries:
+		ids := make([]ent.Value, 0, len(m.entries))
+		for id := range m.entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeAnalysisJobs:
+		ids := make([]ent.Value, 0, len(m.analysis_jobs))
+		for id := range m.analysis_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeEntryShares:
+		ids := make([]ent.Value, 0, len(m.entry_shares))
+		for id := range m.entry_shares {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeGoals:
+		ids := make([]ent.Value, 0, len(m.goals))
+		for id := range m.goals {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeAssignments:
+		ids := make([]ent.Value, 0, len(m.assignments))
+		for id := range m.assignments {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeQuestionnaireAssignments:
+		ids := make([]ent.Value, 0, len(m.questionnaire_assignments))
+		for id := range m.questionnaire_assignments {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeQuestionnaireResponses:
+		ids := make([]ent.Value, 0, len(m.questionnaire_responses))
+		for id := range m.questionnaire_responses {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeReminderSchedules:
+		ids := make([]ent.Value, 0, len(m.reminder_schedules))
+		for id := range m.reminder_schedules {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeReminderDeliveries:
+		ids := make([]ent.Value, 0, len(m.reminder_deliveries))
+		for id := range m.reminder_deliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PatientMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.removeddoctor_links != nil {
+		edges = append(edges, patient.EdgeDoctorLinks)
+	}
+	if m.removedconsumed_pairing_codes != nil {
+		edges = append(edges, patient.EdgeConsumedPairingCodes)
+	}
+	if m.removedentries != nil {
+		edges = append(edges, patient.EdgeEntries)
+	}
+	if m.removedanalysis_jobs != nil {
+		edges = append(edges, patient.EdgeAnalysisJobs)
+	}
+	if m.removedentry_shares != nil {
+		edges = append(edges, patient.EdgeEntryShares)
+	}
+	if m.removedgoals != nil {
+		edges = append(edges, patient.EdgeGoals)
+	}
+	if m.removedassignments != nil {
+		edges = append(edges, patient.EdgeAssignments)
+	}
+	if m.removedsessions != nil {
+		edges = append(edges, patient.EdgeSessions)
+	}
+	if m.removedquestionnaire_assignments != nil {
+		edges = append(edges, patient.EdgeQuestionnaireAssignments)
+	}
+	if m.removedquestionnaire_responses != nil {
+		edges = append(edges, patient.EdgeQuestionnaireResponses)
+	}
+	if m.removedreminder_schedules != nil {
+		edges = append(edges, patient.EdgeReminderSchedules)
+	}
+	if m.removedreminder_deliveries != nil {
+		edges = append(edges, patient.EdgeReminderDeliveries)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PatientMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case patient.EdgeDoctorLinks:
+		ids := make([]ent.Value, 0, len(m.removeddoctor_links))
+		for id := range m.removeddoctor_links {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeConsumedPairingCodes:
+		ids := make([]ent.Value, 0, len(m.removedconsumed_pairing_codes))
+		for id := range m.removedconsumed_pairing_codes {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeEntries:
+		ids := make([]ent.Value, 0, len(m.removedentries))
+		for id := range m.removedentries {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeAnalysisJobs:
+		ids := make([]ent.Value, 0, len(m.removedanalysis_jobs))
+		for id := range m.removedanalysis_jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeEntryShares:
+		ids := make([]ent.Value, 0, len(m.removedentry_shares))
+		for id := range m.removedentry_shares {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeGoals:
+		ids := make([]ent.Value, 0, len(m.removedgoals))
+		for id := range m.removedgoals {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeAssignments:
+		ids := make([]ent.Value, 0, len(m.removedassignments))
+		for id := range m.removedassignments {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeQuestionnaireAssignments:
+		ids := make([]ent.Value, 0, len(m.removedquestionnaire_assignments))
+		for id := range m.removedquestionnaire_assignments {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeQuestionnaireResponses:
+		ids := make([]ent.Value, 0, len(m.removedquestionnaire_responses))
+		for id := range m.removedquestionnaire_responses {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeReminderSchedules:
+		ids := make([]ent.Value, 0, len(m.removedreminder_schedules))
+		for id := range m.removedreminder_schedules {
+			ids = append(ids, id)
+		}
+		return ids
+	case patient.EdgeReminderDeliveries:
+		ids := make([]ent.Value, 0, len(m.removedreminder_deliveries))
+		for id := range m.removedreminder_deliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PatientMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.cleareddoctor_links {
+		edges = append(edges, patient.EdgeDoctorLinks)
+	}
+	if m.clearedconsumed_pairing_codes {
+		edges = append(edges, patient.EdgeConsumedPairingCodes)
+	}
+	if m.clearedentries {
+		edges = append(edges, patient.EdgeEntries)
+	}
+	if m.clearedanalysis_jobs {
+		edges = append(edges, patient.EdgeAnalysisJobs)
+	}
+	if m.clearedentry_shares {
+		edges = append(edges, patient.EdgeEntryShares)
+	}
+	if m.clearedgoals {
+		edges = append(edges, patient.EdgeGoals)
+	}
+	if m.clearedassignments {
+		edges = append(edges, patient.EdgeAssignments)
+	}
+	if m.clearedsessions {
+		edges = append(edges, patient.EdgeSessions)
+	}
+	if m.clearedquestionnaire_assignments {
+		edges = append(edges, patient.EdgeQuestionnaireAssignments)
+	}
+	if m.clearedquestionnaire_responses {
+		edges = append(edges, patient.EdgeQuestionnaireResponses)
+	}
+	if m.clearedreminder_schedules {
+		edges = append(edges, patient.EdgeReminderSchedules)
+	}
+	if m.clearedreminder_deliveries {
+		edges = append(edges, patient.EdgeReminderDeliveries)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PatientMutation) EdgeCleared(name string) bool {
+	switch name {
+	case patient.EdgeDoctorLinks:
+		return m.cleareddoctor_links
+	case patient.EdgeConsumedPairingCodes:
+		return m.clearedconsumed_pairing_codes
+	case patient.EdgeEntries:
+		return m.clearedentries
+	case patient.EdgeAnalysisJobs:
+		return m.clearedanalysis_jobs
+	case patient.EdgeEntryShares:
+		return m.clearedentry_shares
+	case patient.EdgeGoals:
+		return m.clearedgoals
+	case patient.EdgeAssignments:
+		return m.clearedassignments
+	case patient.EdgeSessions:
+		return m.clearedsessions
+	case patient.EdgeQuestionnaireAssignments:
+		return m.clearedquestionnaire_assignments
+	case patient.EdgeQuestionnaireResponses:
+		return m.clearedquestionnaire_responses
+	case patient.EdgeReminderSchedules:
+		return m.clearedreminder_schedules
+	case patient.EdgeReminderDeliveries:
+		return m.clearedreminder_deliveries
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PatientMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Patient unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PatientMutation) ResetEdge(name string) error {
+	switch name {
+	case patient.EdgeDoctorLinks:
+		m.ResetDoctorLinks()
+		return nil
+	case patient.EdgeConsumedPairingCodes:
+		m.ResetConsumedPairingCodes()
+		return nil
+	case patient.EdgeEntries:
+		m.ResetEntries()
+		return nil
+	case patient.EdgeAnalysisJobs:
+		m.ResetAnalysisJobs()
+		return nil
+	case patient.EdgeEntryShares:
+		m.ResetEntryShares()
+		return nil
+	case patient.EdgeGoals:
+		m.ResetGoals()
+		return nil
+	case patient.EdgeAssignments:
+		m.ResetAssignments()
+		return nil
+	case patient.EdgeSessions:
+		m.ResetSessions()
+		return nil
+	case patient.EdgeQuestionnaireAssignments:
+		m.ResetQuestionnaireAssignments()
+		return nil
+	case patient.EdgeQuestionnaireResponses:
+		m.ResetQuestionnaireResponses()
+		return nil
+	case patient.EdgeReminderSchedules:
+		m.ResetReminderSchedules()
+		return nil
+	case patient.EdgeReminderDeliveries:
+		m.ResetReminderDeliveries()
+		return nil
+	}
+	return fmt.Errorf("unknown Patient edge %s", name)
+}
+
+// PracticeMutation represents an operation that mutates the Practice nodes in the graph.
+type PracticeMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *uuid.UUID
+	created_at                   *time.Time
+	updated_at                   *time.Time
+	name                         *string
+	address                      *string
+	clearedFields                map[string]struct{}
+	doctors                      map[uuid.UUID]struct{}
+	removeddoctors               map[uuid.UUID]struct{}
+	cleareddoctors               bool
+	vocabulary_terms             map[uuid.UUID]struct{}
+	removedvocabulary_terms      map[uuid.UUID]struct{}
+	clearedvocabulary_terms      bool
+	webhook_subscriptions        map[uuid.UUID]struct{}
+	removedwebhook_subscriptions map[uuid.UUID]struct{}
+	clearedwebhook_subscriptions bool
+	done                         bool
+	oldValue                     func(context.Context) (*Practice, error)
+	predicates                   []predicate.Practice
+}
+
+var _ ent.Mutation = (*PracticeMutation)(nil)
+
+// practiceOption allows management of the mutation configuration using functional options.
+type practiceOption func(*PracticeMutation)
+
+// newPracticeMutation creates new mutation for the Practice entity.
+func newPracticeMutation(c config, op Op, opts ...practiceOption) *PracticeMutation {
+	m := &PracticeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePractice,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPracticeID sets the ID field of the mutation.
+func withPracticeID(id uuid.UUID) practiceOption {
+	return func(m *PracticeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Practice
+		)
+		m.oldValue = func(ctx context.Context) (*Practice, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Practice.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPractice sets the old Practice of the mutation.
+func withPractice(node *Practice) practiceOption {
+	return func(m *PracticeMutation) {
+		m.oldValue = func(context.Context) (*Practice, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PracticeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PracticeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Practice entities.
+func (m *PracticeMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PracticeMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PracticeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Practice.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PracticeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PracticeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Practice entity.
+// If the Practice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PracticeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PracticeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PracticeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PracticeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Practice entity.
+// If the Practice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PracticeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PracticeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *PracticeMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PracticeMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Practice entity.
+// If the Practice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PracticeMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PracticeMutation) ResetName() {
+	m.name = nil
+}
+
+// SetAddress sets the "address" field.
+func (m *PracticeMutation) SetAddress(s string) {
+	m.address = &s
+}
+
+// Address returns the value of the "address" field in the mutation.
+func (m *PracticeMutation) Address() (r string, exists bool) {
+	v := m.address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddress returns the old "address" field's value of the Practice entity.
+// If the Practice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PracticeMutation) OldAddress(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+	}
+	return oldValue.Address, nil
+}
+
+// ClearAddress clears the value of the "address" field.
+func (m *PracticeMutation) ClearAddress() {
+	m.address = nil
+	m.clearedFields[practice.FieldAddress] = struct{}{}
+}
+
+// AddressCleared returns if the "address" field was cleared in this mutation.
+func (m *PracticeMutation) AddressCleared() bool {
+	_, ok := m.clearedFields[practice.FieldAddress]
+	return ok
+}
+
+// ResetAddress resets all changes to the "address" field.
+func (m *PracticeMutation) ResetAddress() {
+	m.address = nil
+	delete(m.clearedFields, practice.FieldAddress)
+}
+
+// AddDoctorIDs adds the "doctors" edge to the Doctor entity by ids.
+func (m *PracticeMutation) AddDoctorIDs(ids ...uuid.UUID) {
+	if m.doctors == nil {
+		m.doctors = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.doctors[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDoctors clears the "doctors" edge to the Doctor entity.
+func (m *PracticeMutation) ClearDoctors() {
+	m.cleareddoctors = true
+}
+
+// DoctorsCleared reports if the "doctors" edge to the Doctor entity was cleared.
+func (m *PracticeMutation) DoctorsCleared() bool {
+	return m.cleareddoctors
+}
+
+// RemoveDoctorIDs removes the "doctors" edge to the Doctor entity by IDs.
+func (m *PracticeMutation) RemoveDoctorIDs(ids ...uuid.UUID) {
+	if m.removeddoctors == nil {
+		m.removeddoctors = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.doctors, ids[i])
+		m.removeddoctors[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDoctors returns the removed IDs of the "doctors" edge to the Doctor entity.
+func (m *PracticeMutation) RemovedDoctorsIDs() (ids []uuid.UUID) {
+	for id := range m.removeddoctors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DoctorsIDs returns the "doctors" edge IDs in the mutation.
+func (m *PracticeMutation) DoctorsIDs() (ids []uuid.UUID) {
+	for id := range m.doctors {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDoctors resets all changes to the "doctors" edge.
+func (m *PracticeMutation) ResetDoctors() {
+	m.doctors = nil
+	m.cleareddoctors = false
+	m.removeddoctors = nil
+}
+
+// AddVocabularyTermIDs adds the "vocabulary_terms" edge to the VocabularyTerm entity by ids.
+func (m *PracticeMutation) AddVocabularyTermIDs(ids ...uuid.UUID) {
+	if m.vocabulary_terms == nil {
+		m.vocabulary_terms = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.vocabulary_terms[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVocabularyTerms clears the "vocabulary_terms" edge to the VocabularyTerm entity.
+func (m *PracticeMutation) ClearVocabularyTerms() {
+	m.clearedvocabulary_terms = true
+}
+
+// VocabularyTermsCleared reports if the "vocabulary_terms" edge to the VocabularyTerm entity was cleared.
+func (m *PracticeMutation) VocabularyTermsCleared() bool {
+	return m.clearedvocabulary_terms
+}
+
+// RemoveVocabularyTermIDs removes the "vocabulary_terms" edge to the VocabularyTerm entity by IDs.
+func (m *PracticeMutation) RemoveVocabularyTermIDs(ids ...uuid.UUID) {
+	if m.removedvocabulary_terms == nil {
+		m.removedvocabulary_terms = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.vocabulary_terms, ids[i])
+		m.removedvocabulary_terms[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVocabularyTerms returns the removed IDs of the "vocabulary_terms" edge to the VocabularyTerm entity.
+func (m *PracticeMutation) RemovedVocabularyTermsIDs() (ids []uuid.UUID) {
+	for id := range m.removedvocabulary_terms {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VocabularyTermsIDs returns the "vocabulary_terms" edge IDs in the mutation.
+func (m *PracticeMutation) VocabularyTermsIDs() (ids []uuid.UUID) {
+	for id := range m.vocabulary_terms {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVocabularyTerms resets all changes to the "vocabulary_terms" edge.
+func (m *PracticeMutation) ResetVocabularyTerms() {
+	m.vocabulary_terms = nil
+	m.clearedvocabulary_terms = false
+	m.removedvocabulary_terms = nil
+}
+
+// AddWebhookSubscriptionIDs adds the "webhook_subscriptions" edge to the WebhookSubscription entity by ids.
+func (m *PracticeMutation) AddWebhookSubscriptionIDs(ids ...uuid.UUID) {
+	if m.webhook_subscriptions == nil {
+		m.webhook_subscriptions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.webhook_subscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWebhookSubscriptions clears the "webhook_subscriptions" edge to the WebhookSubscription entity.
+func (m *PracticeMutation) ClearWebhookSubscriptions() {
+	m.clearedwebhook_subscriptions = true
+}
+
+// WebhookSubscriptionsCleared reports if the "webhook_subscriptions" edge to the WebhookSubscription entity was cleared.
+func (m *PracticeMutation) WebhookSubscriptionsCleared() bool {
+	return m.clearedwebhook_subscriptions
+}
+
+// RemoveWebhookSubscriptionIDs removes the "webhook_subscriptions" edge to the WebhookSubscription entity by IDs.
+func (m *PracticeMutation) RemoveWebhookSubscriptionIDs(ids ...uuid.UUID) {
+	if m.removedwebhook_subscriptions == nil {
+		m.removedwebhook_subscriptions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.webhook_subscriptions, ids[i])
+		m.removedwebhook_subscriptions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWebhookSubscriptions returns the removed IDs of the "webhook_subscriptions" edge to the WebhookSubscription entity.
+func (m *PracticeMutation) RemovedWebhookSubscriptionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedwebhook_subscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WebhookSubscriptionsIDs returns the "webhook_subscriptions" edge IDs in the mutation.
+func (m *PracticeMutation) WebhookSubscriptionsIDs() (ids []uuid.UUID) {
+	for id := range m.webhook_subscriptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWebhookSubscriptions resets all changes to the "webhook_subscriptions" edge.
+func (m *PracticeMutation) ResetWebhookSubscriptions() {
+	m.webhook_subscriptions = nil
+	m.clearedwebhook_subscriptions = false
+	m.removedwebhook_subscriptions = nil
+}
+
+// Where appends a list predicates to the PracticeMutation builder.
+func (m *PracticeMutation) Where(ps ...predicate.Practice) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PracticeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PracticeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Practice, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PracticeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PracticeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Practice).
+func (m *PracticeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PracticeMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.created_at != nil {
+		fields = append(fields, practice.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, practice.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, practice.FieldName)
+	}
+	if m.address != nil {
+		fields = append(fields, practice.FieldAddress)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PracticeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case practice.FieldCreatedAt:
+		return m.CreatedAt()
+	case practice.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case practice.FieldName:
+		return m.Name()
+	case practice.FieldAddress:
+		return m.Address()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PracticeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case practice.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case practice.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case practice.FieldName:
+		return m.OldName(ctx)
+	case practice.FieldAddress:
+		return m.OldAddress(ctx)
+	}
+	return nil, fmt.Errorf("unknown Practice field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PracticeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case practice.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case practice.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case practice.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case practice.FieldAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Practice field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PracticeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PracticeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PracticeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Practice numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PracticeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(practice.FieldAddress) {
+		fields = append(fields, practice.FieldAddress)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PracticeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PracticeMutation) ClearField(name string) error {
+	switch name {
+	case practice.FieldAddress:
+		m.ClearAddress()
+		return nil
+	}
+	return fmt.Errorf("unknown Practice nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PracticeMutation) ResetField(name string) error {
+	switch name {
+	case practice.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case practice.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case practice.FieldName:
+		m.ResetName()
+		return nil
+	case practice.FieldAddress:
+		m.ResetAddress()
+		return nil
+	}
+	return fmt.Errorf("unknown Practice field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PracticeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.doctors != nil {
+		edges = append(edges, practice.EdgeDoctors)
+	}
+	if m.vocabulary_terms != nil {
+		edges = append(edges, practice.EdgeVocabularyTerms)
+	}
+	if m.webhook_subscriptions != nil {
+		edges = append(edges, practice.EdgeWebhookSubscriptions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PracticeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case practice.EdgeDoctors:
+		ids := make([]ent.Value, 0, len(m.doctors))
+		for id := range m.doctors {
+			ids = append(ids, id)
+		}
+		return ids
+	case practice.EdgeVocabularyTerms:
+		ids := make([]ent.Value, 0, len(m.vocabulary_terms))
+		for id := range m.vocabulary_terms {
+			ids = append(ids, id)
+		}
+		return ids
+	case practice.EdgeWebhookSubscriptions:
+		ids := make([]ent.Value, 0, len(m.webhook_subscriptions))
+		for id := range m.webhook_subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PracticeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removeddoctors != nil {
+		edges = append(edges, practice.EdgeDoctors)
+	}
+	if m.removedvocabulary_terms != nil {
+		edges = append(edges, practice.EdgeVocabularyTerms)
+	}
+	if m.removedwebhook_subscriptions != nil {
+		edges = append(edges, practice.EdgeWebhookSubscriptions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PracticeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case practice.EdgeDoctors:
+		ids := make([]ent.Value, 0, len(m.removeddoctors))
+		for id := range m.removeddoctors {
+			ids = append(ids, id)
+		}
+		return ids
+	case practice.EdgeVocabularyTerms:
+		ids := make([]ent.Value, 0, len(m.removedvocabulary_terms))
+		for id := range m.removedvocabulary_terms {
+			ids = append(ids, id)
+		}
+		return ids
+	case practice.EdgeWebhookSubscriptions:
+		ids := make([]ent.Value, 0, len(m.removedwebhook_subscriptions))
+		for id := range m.removedwebhook_subscriptions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PracticeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareddoctors {
+		edges = append(edges, practice.EdgeDoctors)
+	}
+	if m.clearedvocabulary_terms {
+		edges = append(edges, practice.EdgeVocabularyTerms)
+	}
+	if m.clearedwebhook_subscriptions {
+		edges = append(edges, practice.EdgeWebhookSubscriptions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PracticeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case practice.EdgeDoctors:
+		return m.cleareddoctors
+	case practice.EdgeVocabularyTerms:
+		return m.clearedvocabulary_terms
+	case practice.EdgeWebhookSubscriptions:
+		return m.clearedwebhook_subscriptions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PracticeMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Practice unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PracticeMutation) ResetEdge(name string) error {
+	switch name {
+	case practice.EdgeDoctors:
+		m.ResetDoctors()
+		return nil
+	case practice.EdgeVocabularyTerms:
+		m.ResetVocabularyTerms()
+		return nil
+	case practice.EdgeWebhookSubscriptions:
+		m.ResetWebhookSubscriptions()
+		return nil
+	}
+	return fmt.Errorf("unknown Practice edge %s", name)
+}
+
+// QuestionnaireAssignmentMutation represents an operation that mutates the QuestionnaireAssignment nodes in the graph.
+type QuestionnaireAssignmentMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	created_at        *time.Time
+	updated_at        *time.Time
+	due_at            *time.Time
+	status            *questionnaireassignment.Status
+	completed_at      *time.Time
+	clearedFields     map[string]struct{}
+	patient           *uuid.UUID
+	clearedpatient    bool
+	doctor            *uuid.UUID
+	cleareddoctor     bool
+	definition        *uuid.UUID
+	cleareddefinition bool
+	response          *uuid.UUID
+	clearedresponse   bool
+	done              bool
+	oldValue          func(context.Context) (*QuestionnaireAssignment, error)
+	predicates        []predicate.QuestionnaireAssignment
+}
+
+var _ ent.Mutation = (*QuestionnaireAssignmentMutation)(nil)
+
+// questionnaireassignmentOption allows management of the mutation configuration using functional options.
+type questionnaireassignmentOption func(*QuestionnaireAssignmentMutation)
+
+// newQuestionnaireAssignmentMutation creates new mutation for the QuestionnaireAssignment entity.
+func newQuestionnaireAssignmentMutation(c config, op Op, opts ...questionnaireassignmentOption) *QuestionnaireAssignmentMutation {
+	m := &QuestionnaireAssignmentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuestionnaireAssignment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuestionnaireAssignmentID sets the ID field of the mutation.
+func withQuestionnaireAssignmentID(id uuid.UUID) questionnaireassignmentOption {
+	return func(m *QuestionnaireAssignmentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QuestionnaireAssignment
+		)
+		m.oldValue = func(ctx context.Context) (*QuestionnaireAssignment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QuestionnaireAssignment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuestionnaireAssignment sets the old QuestionnaireAssignment of the mutation.
+func withQuestionnaireAssignment(node *QuestionnaireAssignment) questionnaireassignmentOption {
+	return func(m *QuestionnaireAssignmentMutation) {
+		m.oldValue = func(context.Context) (*QuestionnaireAssignment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuestionnaireAssignmentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuestionnaireAssignmentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of QuestionnaireAssignment entities.
+func (m *QuestionnaireAssignmentMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuestionnaireAssignmentMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuestionnaireAssignmentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().QuestionnaireAssignment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QuestionnaireAssignmentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QuestionnaireAssignmentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the QuestionnaireAssignment entity.
+// If the QuestionnaireAssignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireAssignmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QuestionnaireAssignmentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *QuestionnaireAssignmentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *QuestionnaireAssignmentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the QuestionnaireAssignment entity.
+// If the QuestionnaireAssignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireAssignmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *QuestionnaireAssignmentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetPatientID sets the "patient_id" field.
+func (m *QuestionnaireAssignmentMutation) SetPatientID(u uuid.UUID) {
+	m.patient = &u
+}
+
+// PatientID returns the value of the "patient_id" field in the mutation.
+func (m *QuestionnaireAssignmentMutation) PatientID() (r uuid.UUID, exists bool) {
+	v := m.patient
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPatientID returns the old "patient_id" field's value of the QuestionnaireAssignment entity.
+// If the QuestionnaireAssignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireAssignmentMutation) OldPatientID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPatientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPatientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPatientID: %w", err)
+	}
+	return oldValue.PatientID, nil
+}
+
+// ResetPatientID resets all changes to the "patient_id" field.
+func (m *QuestionnaireAssignmentMutation) ResetPatientID() {
+	m.patient = nil
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (m *QuestionnaireAssignmentMutation) SetDoctorID(u uuid.UUID) {
+	m.doctor = &u
+}
+
+// DoctorID returns the value of the "doctor_id" field in the mutation.
+func (m *QuestionnaireAssignmentMutation) DoctorID() (r uuid.UUID, exists bool) {
+	v := m.doctor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDoctorID returns the old "doctor_id" field's value of the QuestionnaireAssignment entity.
+// If the QuestionnaireAssignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireAssignmentMutation) OldDoctorID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDoctorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDoctorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDoctorID: %w", err)
+	}
+	return oldValue.DoctorID, nil
+}
+
+// ResetDoctorID resets all changes to the "doctor_id" field.
+func (m *QuestionnaireAssignmentMutation) ResetDoctorID() {
+	m.doctor = nil
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (m *QuestionnaireAssignmentMutation) SetDefinitionID(u uuid.UUID) {
+	m.definition = &u
+}
+
+// DefinitionID returns the value of the "definition_id" field in the mutation.
+func (m *QuestionnaireAssignmentMutation) DefinitionID() (r uuid.UUID, exists bool) {
+	v := m.definition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefinitionID returns the old "definition_id" field's value of the QuestionnaireAssignment entity.
+// If the QuestionnaireAssignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireAssignmentMutation) OldDefinitionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefinitionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefinitionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefinitionID: %w", err)
+	}
+	return oldValue.DefinitionID, nil
+}
+
+// ResetDefinitionID resets all changes to the "definition_id" field.
+func (m *QuestionnaireAssignmentMutation) ResetDefinitionID() {
+	m.definition = nil
+}
+
+// SetDueAt sets the "due_at" field.
+func (m *QuestionnaireAssignmentMutation) SetDueAt(t time.Time) {
+	m.due_at = &t
+}
+
+// DueAt returns the value of the "due_at" field in the mutation.
+func (m *QuestionnaireAssignmentMutation) DueAt() (r time.Time, exists bool) {
+	v := m.due_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDueAt returns the old "due_at" field's value of the QuestionnaireAssignment entity.
+// If the QuestionnaireAssignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireAssignmentMutation) OldDueAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueAt: %w", err)
+	}
+	return oldValue.DueAt, nil
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (m *QuestionnaireAssignmentMutation) ClearDueAt() {
+	m.due_at = nil
+	m.clearedFields[questionnaireassignment.FieldDueAt] = struct{}{}
+}
+
+// DueAtCleared returns if the "due_at" field was cleared in this mutation.
+func (m *QuestionnaireAssignmentMutation) DueAtCleared() bool {
+	_, ok := m.clearedFields[questionnaireassignment.FieldDueAt]
+	return ok
+}
+
+// ResetDueAt resets all changes to the "due_at" field.
+func (m *QuestionnaireAssignmentMutation) ResetDueAt() {
+	m.due_at = nil
+	delete(m.clearedFields, questionnaireassignment.FieldDueAt)
+}
+
+// SetStatus sets the "status" field.
+func (m *QuestionnaireAssignmentMutation) SetStatus(q questionnaireassignment.Status) {
+	m.status = &q
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *QuestionnaireAssignmentMutation) Status() (r questionnaireassignment.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the QuestionnaireAssignment entity.
+// If the QuestionnaireAssignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireAssignmentMutation) OldStatus(ctx context.Context) (v questionnaireassignment.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *QuestionnaireAssignmentMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *QuestionnaireAssignmentMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *QuestionnaireAssignmentMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the QuestionnaireAssignment entity.
+// If the QuestionnaireAssignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireAssignmentMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *QuestionnaireAssignmentMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[questionnaireassignment.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *QuestionnaireAssignmentMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[questionnaireassignment.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *QuestionnaireAssignmentMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, questionnaireassignment.FieldCompletedAt)
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (m *QuestionnaireAssignmentMutation) ClearPatient() {
+	m.clearedpatient = true
+	m.clearedFields[questionnaireassignment.FieldPatientID] = struct{}{}
+}
+
+// PatientCleared reports if the "patient" edge to the Patient entity was cleared.
+func (m *QuestionnaireAssignmentMutation) PatientCleared() bool {
+	return m.clearedpatient
+}
+
+// PatientIDs returns the "patient" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PatientID instead. It exists only for internal usage by the builders.
+func (m *QuestionnaireAssignmentMutation) PatientIDs() (ids []uuid.UUID) {
+	if id := m.patient; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPatient resets all changes to the "patient" edge.
+func (m *QuestionnaireAssignmentMutation) ResetPatient() {
+	m.patient = nil
+	m.clearedpatient = false
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (m *QuestionnaireAssignmentMutation) ClearDoctor() {
+	m.cleareddoctor = true
+	m.clearedFields[questionnaireassignment.FieldDoctorID] = struct{}{}
+}
+
+// DoctorCleared reports if the "doctor" edge to the Doctor entity was cleared.
+func (m *QuestionnaireAssignmentMutation) DoctorCleared() bool {
+	return m.cleareddoctor
+}
+
+// DoctorIDs returns the "doctor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DoctorID instead. It exists only for internal usage by the builders.
+func (m *QuestionnaireAssignmentMutation) DoctorIDs() (ids []uuid.UUID) {
+	if id := m.doctor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDoctor resets all changes to the "doctor" edge.
+func (m *QuestionnaireAssignmentMutation) ResetDoctor() {
+	m.doctor = nil
+	m.cleareddoctor = false
+}
+
+// ClearDefinition clears the "definition" edge to the QuestionnaireDefinition entity.
+func (m *QuestionnaireAssignmentMutation) ClearDefinition() {
+	m.cleareddefinition = true
+	m.clearedFields[questionnaireassignment.FieldDefinitionID] = struct{}{}
+}
+
+// DefinitionCleared reports if the "definition" edge to the QuestionnaireDefinition entity was cleared.
+func (m *QuestionnaireAssignmentMutation) DefinitionCleared() bool {
+	return m.cleareddefinition
+}
+
+// DefinitionIDs returns the "definition" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DefinitionID instead. It exists only for internal usage by the builders.
+func (m *QuestionnaireAssignmentMutation) DefinitionIDs() (ids []uuid.UUID) {
+	if id := m.definition; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDefinition resets all changes to the "definition" edge.
+func (m *QuestionnaireAssignmentMutation) ResetDefinition() {
+	m.definition = nil
+	m.cleareddefinition = false
+}
+
+// SetResponseID sets the "response" edge to the QuestionnaireResponse entity by id.
+func (m *QuestionnaireAssignmentMutation) SetResponseID(id uuid.UUID) {
+	m.response = &id
+}
+
+// ClearResponse clears the "response" edge to the QuestionnaireResponse entity.
+func (m *QuestionnaireAssignmentMutation) ClearResponse() {
+	m.clearedresponse = true
+}
+
+// ResponseCleared reports if the "response" edge to the QuestionnaireResponse entity was cleared.
+func (m *QuestionnaireAssignmentMutation) ResponseCleared() bool {
+	return m.clearedresponse
+}
+
+// ResponseID returns the "response" edge ID in the mutation.
+func (m *QuestionnaireAssignmentMutation) ResponseID() (id uuid.UUID, exists bool) {
+	if m.response != nil {
+		return *m.response, true
+	}
+	return
+}
+
+// ResponseIDs returns the "response" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ResponseID instead. It exists only for internal usage by the builders.
+func (m *QuestionnaireAssignmentMutation) ResponseIDs() (ids []uuid.UUID) {
+	if id := m.response; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetResponse resets all changes to the "response" edge.
+func (m *QuestionnaireAssignmentMutation) ResetResponse() {
+	m.response = nil
+	m.clearedresponse = false
+}
+
+// Where appends a list predicates to the QuestionnaireAssignmentMutation builder.
+func (m *QuestionnaireAssignmentMutation) Where(ps ...predicate.QuestionnaireAssignment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuestionnaireAssignmentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuestionnaireAssignmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QuestionnaireAssignment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuestionnaireAssignmentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuestionnaireAssignmentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (QuestionnaireAssignment).
+func (m *QuestionnaireAssignmentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuestionnaireAssignmentMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, questionnaireassignment.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, questionnaireassignment.FieldUpdatedAt)
+	}
+	if m.patient != nil {
+		fields = append(fields, questionnaireassignment.FieldPatientID)
+	}
+	if m.doctor != nil {
+		fields = append(fields, questionnaireassignment.FieldDoctorID)
+	}
+	if m.definition != nil {
+		fields = append(fields, questionnaireassignment.FieldDefinitionID)
+	}
+	if m.due_at != nil {
+		fields = append(fields, questionnaireassignment.FieldDueAt)
+	}
+	if m.status != nil {
+		fields = append(fields, questionnaireassignment.FieldStatus)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, questionnaireassignment.FieldCompletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuestionnaireAssignmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case questionnaireassignment.FieldCreatedAt:
+		return m.CreatedAt()
+	case questionnaireassignment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case questionnaireassignment.FieldPatientID:
+		return m.PatientID()
+	case questionnaireassignment.FieldDoctorID:
+		return m.DoctorID()
+	case questionnaireassignment.FieldDefinitionID:
+		return m.DefinitionID()
+	case questionnaireassignment.FieldDueAt:
+		return m.DueAt()
+	case questionnaireassignment.FieldStatus:
+		return m.Status()
+	case questionnaireassignment.FieldCompletedAt:
+		return m.CompletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuestionnaireAssignmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case questionnaireassignment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case questionnaireassignment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case questionnaireassignment.FieldPatientID:
+		return m.OldPatientID(ctx)
+	case questionnaireassignment.FieldDoctorID:
+		return m.OldDoctorID(ctx)
+	case questionnaireassignment.FieldDefinitionID:
+		return m.OldDefinitionID(ctx)
+	case questionnaireassignment.FieldDueAt:
+		return m.OldDueAt(ctx)
+	case questionnaireassignment.FieldStatus:
+		return m.OldStatus(ctx)
+	case questionnaireassignment.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown QuestionnaireAssignment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionnaireAssignmentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case questionnaireassignment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case questionnaireassignment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case questionnaireassignment.FieldPatientID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPatientID(v)
+		return nil
+	case questionnaireassignment.FieldDoctorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDoctorID(v)
+		return nil
+	case questionnaireassignment.FieldDefinitionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefinitionID(v)
+		return nil
+	case questionnaireassignment.FieldDueAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueAt(v)
+		return nil
+	case questionnaireassignment.FieldStatus:
+		v, ok := value.(questionnaireassignment.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case questionnaireassignment.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QuestionnaireAssignment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuestionnaireAssignmentMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuestionnaireAssignmentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionnaireAssignmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown QuestionnaireAssignment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuestionnaireAssignmentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(questionnaireassignment.FieldDueAt) {
+		fields = append(fields, questionnaireassignment.FieldDueAt)
+	}
+	if m.FieldCleared(questionnaireassignment.FieldCompletedAt) {
+		fields = append(fields, questionnaireassignment.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuestionnaireAssignmentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuestionnaireAssignmentMutation) ClearField(name string) error {
+	switch name {
+	case questionnaireassignment.FieldDueAt:
+		m.ClearDueAt()
+		return nil
+	case questionnaireassignment.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown QuestionnaireAssignment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuestionnaireAssignmentMutation) ResetField(name string) error {
+	switch name {
+	case questionnaireassignment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case questionnaireassignment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case questionnaireassignment.FieldPatientID:
+		m.ResetPatientID()
+		return nil
+	case questionnaireassignment.FieldDoctorID:
+		m.ResetDoctorID()
+		return nil
+	case questionnaireassignment.FieldDefinitionID:
+		m.ResetDefinitionID()
+		return nil
+	case questionnaireassignment.FieldDueAt:
+		m.ResetDueAt()
+		return nil
+	case questionnaireassignment.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case questionnaireassignment.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown QuestionnaireAssignment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuestionnaireAssignmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.patient != nil {
+		edges = append(edges, questionnaireassignment.EdgePatient)
+	}
+	if m.doctor != nil {
+		edges = append(edges, questionnaireassignment.EdgeDoctor)
+	}
+	if m.definition != nil {
+		edges = append(edges, questionnaireassignment.EdgeDefinition)
+	}
+	if m.response != nil {
+		edges = append(edges, questionnaireassignment.EdgeResponse)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuestionnaireAssignmentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case questionnaireassignment.EdgePatient:
+		if id := m.patient; id != nil {
+			return []ent.Value{*id}
+		}
+	case questionnaireassignment.EdgeDoctor:
+		if id := m.doctor; id != nil {
+			return []ent.Value{*id}
+		}
+	case questionnaireassignment.EdgeDefinition:
+		if id := m.definition; id != nil {
+			return []ent.Value{*id}
+		}
+	case questionnaireassignment.EdgeResponse:
+		if id := m.response; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuestionnaireAssignmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuestionnaireAssignmentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuestionnaireAssignmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedpatient {
+		edges = append(edges, questionnaireassignment.EdgePatient)
+	}
+	if m.cleareddoctor {
+		edges = append(edges, questionnaireassignment.EdgeDoctor)
+	}
+	if m.cleareddefinition {
+		edges = append(edges, questionnaireassignment.EdgeDefinition)
+	}
+	if m.clearedresponse {
+		edges = append(edges, questionnaireassignment.EdgeResponse)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuestionnaireAssignmentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case questionnaireassignment.EdgePatient:
+		return m.clearedpatient
+	case questionnaireassignment.EdgeDoctor:
+		return m.cleareddoctor
+	case questionnaireassignment.EdgeDefinition:
+		return m.cleareddefinition
+	case questionnaireassignment.EdgeResponse:
+		return m.clearedresponse
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuestionnaireAssignmentMutation) ClearEdge(name string) error {
+	switch name {
+	case questionnaireassignment.EdgePatient:
+		m.ClearPatient()
+		return nil
+	case questionnaireassignment.EdgeDoctor:
+		m.ClearDoctor()
+		return nil
+	case questionnaireassignment.EdgeDefinition:
+		m.ClearDefinition()
+		return nil
+	case questionnaireassignment.EdgeResponse:
+		m.ClearResponse()
+		return nil
+	}
+	return fmt.Errorf("unknown QuestionnaireAssignment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuestionnaireAssignmentMutation) ResetEdge(name string) error {
+	switch name {
+	case questionnaireassignment.EdgePatient:
+		m.ResetPatient()
+		return nil
+	case questionnaireassignment.EdgeDoctor:
+		m.ResetDoctor()
+		return nil
+	case questionnaireassignment.EdgeDefinition:
+		m.ResetDefinition()
+		return nil
+	case questionnaireassignment.EdgeResponse:
+		m.ResetResponse()
+		return nil
+	}
+	return fmt.Errorf("unknown QuestionnaireAssignment edge %s", name)
+}
+
+// QuestionnaireDefinitionMutation represents an operation that mutates the QuestionnaireDefinition nodes in the graph.
+type QuestionnaireDefinitionMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	created_at               *time.Time
+	updated_at               *time.Time
+	key                      *string
+	version                  *int
+	addversion               *int
+	title                    *string
+	description              *string
+	definition               *questionnaire.Definition
+	active                   *bool
+	clearedFields            map[string]struct{}
+	created_by_doctor        *uuid.UUID
+	clearedcreated_by_doctor bool
+	assignments              map[uuid.UUID]struct{}
+	removedassignments       map[uuid.UUID]struct{}
+	clearedassignments       bool
+	responses                map[uuid.UUID]struct{}
+	removedresponses         map[uuid.UUID]struct{}
+	clearedresponses         bool
+	done                     bool
+	oldValue                 func(context.Context) (*QuestionnaireDefinition, error)
+	predicates               []predicate.QuestionnaireDefinition
+}
+
+var _ ent.Mutation = (*QuestionnaireDefinitionMutation)(nil)
+
+// questionnairedefinitionOption allows management of the mutation configuration using functional options.
+type questionnairedefinitionOption func(*QuestionnaireDefinitionMutation)
+
+// newQuestionnaireDefinitionMutation creates new mutation for the QuestionnaireDefinition entity.
+func newQuestionnaireDefinitionMutation(c config, op Op, opts ...questionnairedefinitionOption) *QuestionnaireDefinitionMutation {
+	m := &QuestionnaireDefinitionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuestionnaireDefinition,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuestionnaireDefinitionID sets the ID field of the mutation.
+func withQuestionnaireDefinitionID(id uuid.UUID) questionnairedefinitionOption {
+	return func(m *QuestionnaireDefinitionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QuestionnaireDefinition
+		)
+		m.oldValue = func(ctx context.Context) (*QuestionnaireDefinition, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QuestionnaireDefinition.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuestionnaireDefinition sets the old QuestionnaireDefinition of the mutation.
+func withQuestionnaireDefinition(node *QuestionnaireDefinition) questionnairedefinitionOption {
+	return func(m *QuestionnaireDefinitionMutation) {
+		m.oldValue = func(context.Context) (*QuestionnaireDefinition, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuestionnaireDefinitionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuestionnaireDefinitionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of QuestionnaireDefinition entities.
+func (m *QuestionnaireDefinitionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuestionnaireDefinitionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuestionnaireDefinitionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().QuestionnaireDefinition.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QuestionnaireDefinitionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QuestionnaireDefinitionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the QuestionnaireDefinition entity.
+// If the QuestionnaireDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireDefinitionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QuestionnaireDefinitionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *QuestionnaireDefinitionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *QuestionnaireDefinitionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the QuestionnaireDefinition entity.
+// If the QuestionnaireDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireDefinitionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *QuestionnaireDefinitionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetKey sets the "key" field.
+func (m *QuestionnaireDefinitionMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *QuestionnaireDefinitionMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the QuestionnaireDefinition entity.
+// If the QuestionnaireDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireDefinitionMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *QuestionnaireDefinitionMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *QuestionnaireDefinitionMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *QuestionnaireDefinitionMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the QuestionnaireDefinition entity.
+// If the QuestionnaireDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireDefinitionMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *QuestionnaireDefinitionMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *QuestionnaireDefinitionMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *QuestionnaireDefinitionMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *QuestionnaireDefinitionMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *QuestionnaireDefinitionMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the QuestionnaireDefinition entity.
+// If the QuestionnaireDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireDefinitionMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *QuestionnaireDefinitionMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *QuestionnaireDefinitionMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *QuestionnaireDefinitionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the QuestionnaireDefinition entity.
+// If the QuestionnaireDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireDefinitionMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *QuestionnaireDefinitionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[questionnairedefinition.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *QuestionnaireDefinitionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[questionnairedefinition.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *QuestionnaireDefinitionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, questionnairedefinition.FieldDescription)
+}
+
+// SetDefinition sets the "definition" field.
+func (m *QuestionnaireDefinitionMutation) SetDefinition(q questionnaire.Definition) {
+	m.definition = &q
+}
+
+// Definition returns the value of the "definition" field in the mutation.
+func (m *QuestionnaireDefinitionMutation) Definition() (r questionnaire.Definition, exists bool) {
+	v := m.definition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefinition returns the old "definition" field's value of the QuestionnaireDefinition entity.
+// If the QuestionnaireDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireDefinitionMutation) OldDefinition(ctx context.Context) (v questionnaire.Definition, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefinition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefinition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefinition: %w", err)
+	}
+	return oldValue.Definition, nil
+}
+
+// ResetDefinition resets all changes to the "definition" field.
+func (m *QuestionnaireDefinitionMutation) ResetDefinition() {
+	m.definition = nil
+}
+
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (m *QuestionnaireDefinitionMutation) SetCreatedByDoctorID(u uuid.UUID) {
+	m.created_by_doctor = &u
+}
+
+// CreatedByDoctorID returns the value of the "created_by_doctor_id" field in the mutation.
+func (m *QuestionnaireDefinitionMutation) CreatedByDoctorID() (r uuid.UUID, exists bool) {
+	v := m.created_by_doctor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedByDoctorID returns the old "created_by_doctor_id" field's value of the QuestionnaireDefinition entity.
+// If the QuestionnaireDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireDefinitionMutation) OldCreatedByDoctorID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedByDoctorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedByDoctorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedByDoctorID: %w", err)
+	}
+	return oldValue.CreatedByDoctorID, nil
+}
+
+// ClearCreatedByDoctorID clears the value of the "created_by_doctor_id" field.
+func (m *QuestionnaireDefinitionMutation) ClearCreatedByDoctorID() {
+	m.created_by_doctor = nil
+	m.clearedFields[questionnairedefinition.FieldCreatedByDoctorID] = struct{}{}
+}
+
+// CreatedByDoctorIDCleared returns if the "created_by_doctor_id" field was cleared in this mutation.
+func (m *QuestionnaireDefinitionMutation) CreatedByDoctorIDCleared() bool {
+	_, ok := m.clearedFields[questionnairedefinition.FieldCreatedByDoctorID]
+	return ok
+}
+
+// ResetCreatedByDoctorID resets all changes to the "created_by_doctor_id" field.
+func (m *QuestionnaireDefinitionMutation) ResetCreatedByDoctorID() {
+	m.created_by_doctor = nil
+	delete(m.clearedFields, questionnairedefinition.FieldCreatedByDoctorID)
+}
+
+// SetActive sets the "active" field.
+func (m *QuestionnaireDefinitionMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *QuestionnaireDefinitionMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the QuestionnaireDefinition entity.
+// If the QuestionnaireDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireDefinitionMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *QuestionnaireDefinitionMutation) ResetActive() {
+	m.active = nil
+}
+
+// ClearCreatedByDoctor clears the "created_by_doctor" edge to the Doctor entity.
+func (m *QuestionnaireDefinitionMutation) ClearCreatedByDoctor() {
+	m.clearedcreated_by_doctor = true
+	m.clearedFields[questionnairedefinition.FieldCreatedByDoctorID] = struct{}{}
+}
+
+// CreatedByDoctorCleared reports if the "created_by_doctor" edge to the Doctor entity was cleared.
+func (m *QuestionnaireDefinitionMutation) CreatedByDoctorCleared() bool {
+	return m.CreatedByDoctorIDCleared() || m.clearedcreated_by_doctor
+}
+
+// CreatedByDoctorIDs returns the "created_by_doctor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatedByDoctorID instead. It exists only for internal usage by the builders.
+func (m *QuestionnaireDefinitionMutation) CreatedByDoctorIDs() (ids []uuid.UUID) {
+	if id := m.created_by_doctor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCreatedByDoctor resets all changes to the "created_by_doctor" edge.
+func (m *QuestionnaireDefinitionMutation) ResetCreatedByDoctor() {
+	m.created_by_doctor = nil
+	m.clearedcreated_by_doctor = false
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the QuestionnaireAssignment entity by ids.
+func (m *QuestionnaireDefinitionMutation) AddAssignmentIDs(ids ...uuid.UUID) {
+	if m.assignments == nil {
+		m.assignments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.assignments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAssignments clears the "assignments" edge to the QuestionnaireAssignment entity.
+func (m *QuestionnaireDefinitionMutation) ClearAssignments() {
+	m.clearedassignments = true
+}
+
+// AssignmentsCleared reports if the "assignments" edge to the QuestionnaireAssignment entity was cleared.
+func (m *QuestionnaireDefinitionMutation) AssignmentsCleared() bool {
+	return m.clearedassignments
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to the QuestionnaireAssignment entity by IDs.
+func (m *QuestionnaireDefinitionMutation) RemoveAssignmentIDs(ids ...uuid.UUID) {
+	if m.removedassignments == nil {
+		m.removedassignments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.assignments, ids[i])
+		m.removedassignments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAssignments returns the removed IDs of the "assignments" edge to the QuestionnaireAssignment entity.
+func (m *QuestionnaireDefinitionMutation) RemovedAssignmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedassignments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AssignmentsIDs returns the "assignments" edge IDs in the mutation.
+func (m *QuestionnaireDefinitionMutation) AssignmentsIDs() (ids []uuid.UUID) {
+	for id := range m.assignments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAssignments resets all changes to the "assignments" edge.
+func (m *QuestionnaireDefinitionMutation) ResetAssignments() {
+	m.assignments = nil
+	m.clearedassignments = false
+	m.removedassignments = nil
+}
+
+// AddResponseIDs adds the "responses" edge to the QuestionnaireResponse entity by ids.
+func (m *QuestionnaireDefinitionMutation) AddResponseIDs(ids ...uuid.UUID) {
+	if m.responses == nil {
+		m.responses = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.responses[ids[i]] = struct{}{}
+	}
+}
+
+// ClearResponses clears the "responses" edge to the QuestionnaireResponse entity.
+func (m *QuestionnaireDefinitionMutation) ClearResponses() {
+	m.clearedresponses = true
+}
+
+// ResponsesCleared reports if the "responses" edge to the QuestionnaireResponse entity was cleared.
+func (m *QuestionnaireDefinitionMutation) ResponsesCleared() bool {
+	return m.clearedresponses
+}
+
+// RemoveResponseIDs removes the "responses" edge to the QuestionnaireResponse entity by IDs.
+func (m *QuestionnaireDefinitionMutation) RemoveResponseIDs(ids ...uuid.UUID) {
+	if m.removedresponses == nil {
+		m.removedresponses = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.responses, ids[i])
+		m.removedresponses[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedResponses returns the removed IDs of the "responses" edge to the QuestionnaireResponse entity.
+func (m *QuestionnaireDefinitionMutation) RemovedResponsesIDs() (ids []uuid.UUID) {
+	for id := range m.removedresponses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResponsesIDs returns the "responses" edge IDs in the mutation.
+func (m *QuestionnaireDefinitionMutation) ResponsesIDs() (ids []uuid.UUID) {
+	for id := range m.responses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetResponses resets all changes to the "responses" edge.
+func (m *QuestionnaireDefinitionMutation) ResetResponses() {
+	m.responses = nil
+	m.clearedresponses = false
+	m.removedresponses = nil
+}
+
+// Where appends a list predicates to the QuestionnaireDefinitionMutation builder.
+func (m *QuestionnaireDefinitionMutation) Where(ps ...predicate.QuestionnaireDefinition) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuestionnaireDefinitionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuestionnaireDefinitionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QuestionnaireDefinition, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuestionnaireDefinitionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuestionnaireDefinitionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (QuestionnaireDefinition).
+func (m *QuestionnaireDefinitionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuestionnaireDefinitionMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, questionnairedefinition.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, questionnairedefinition.FieldUpdatedAt)
+	}
+	if m.key != nil {
+		fields = append(fields, questionnairedefinition.FieldKey)
+	}
+	if m.version != nil {
+		fields = append(fields, questionnairedefinition.FieldVersion)
+	}
+	if m.title != nil {
+		fields = append(fields, questionnairedefinition.FieldTitle)
 	}
-	if m.created_at != nil {
-		fields = append(fields, comment.FieldCreatedAt)
+	if m.description != nil {
+		fields = append(fields, questionnairedefinition.FieldDescription)
+	}
+	if m.definition != nil {
+		fields = append(fields, questionnairedefinition.FieldDefinition)
+	}
+	if m.created_by_doctor != nil {
+		fields = append(fields, questionnairedefinition.FieldCreatedByDoctorID)
+	}
+	if m.active != nil {
+		fields = append(fields, questionnairedefinition.FieldActive)
 	}
 	return fields
 }
@@ -1784,16 +17322,26 @@ func (m *CommentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CommentMutation) Field(name string) (ent.Value, bool) {
+func (m *QuestionnaireDefinitionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case comment.FieldEntryID:
-		return m.EntryID()
-	case comment.FieldAuthorDoctorID:
-		return m.AuthorDoctorID()
-	case comment.FieldBody:
-		return m.Body()
-	case comment.FieldCreatedAt:
+	case questionnairedefinition.FieldCreatedAt:
 		return m.CreatedAt()
+	case questionnairedefinition.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case questionnairedefinition.FieldKey:
+		return m.Key()
+	case questionnairedefinition.FieldVersion:
+		return m.Version()
+	case questionnairedefinition.FieldTitle:
+		return m.Title()
+	case questionnairedefinition.FieldDescription:
+		return m.Description()
+	case questionnairedefinition.FieldDefinition:
+		return m.Definition()
+	case questionnairedefinition.FieldCreatedByDoctorID:
+		return m.CreatedByDoctorID()
+	case questionnairedefinition.FieldActive:
+		return m.Active()
 	}
 	return nil, false
 }
@@ -1801,259 +17349,371 @@ func (m *CommentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *QuestionnaireDefinitionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case comment.FieldEntryID:
-		return m.OldEntryID(ctx)
-	case comment.FieldAuthorDoctorID:
-		return m.OldAuthorDoctorID(ctx)
-	case comment.FieldBody:
-		return m.OldBody(ctx)
-	case comment.FieldCreatedAt:
+	case questionnairedefinition.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case questionnairedefinition.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case questionnairedefinition.FieldKey:
+		return m.OldKey(ctx)
+	case questionnairedefinition.FieldVersion:
+		return m.OldVersion(ctx)
+	case questionnairedefinition.FieldTitle:
+		return m.OldTitle(ctx)
+	case questionnairedefinition.FieldDescription:
+		return m.OldDescription(ctx)
+	case questionnairedefinition.FieldDefinition:
+		return m.OldDefinition(ctx)
+	case questionnairedefinition.FieldCreatedByDoctorID:
+		return m.OldCreatedByDoctorID(ctx)
+	case questionnairedefinition.FieldActive:
+		return m.OldActive(ctx)
 	}
-	return nil, fmt.Errorf("unknown Comment field %s", name)
+	return nil, fmt.Errorf("unknown QuestionnaireDefinition field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CommentMutation) SetField(name string, value ent.Value) error {
+func (m *QuestionnaireDefinitionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case comment.FieldEntryID:
-		v, ok := value.(uuid.UUID)
+	case questionnairedefinition.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEntryID(v)
+		m.SetCreatedAt(v)
 		return nil
-	case comment.FieldAuthorDoctorID:
-		v, ok := value.(uuid.UUID)
+	case questionnairedefinition.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuthorDoctorID(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case comment.FieldBody:
+	case questionnairedefinition.FieldKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBody(v)
+		m.SetKey(v)
 		return nil
-	case comment.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case questionnairedefinition.FieldVersion:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetVersion(v)
+		return nil
+	case questionnairedefinition.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case questionnairedefinition.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case questionnairedefinition.FieldDefinition:
+		v, ok := value.(questionnaire.Definition)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefinition(v)
+		return nil
+	case questionnairedefinition.FieldCreatedByDoctorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedByDoctorID(v)
+		return nil
+	case questionnairedefinition.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Comment field %s", name)
+	return fmt.Errorf("unknown QuestionnaireDefinition field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CommentMutation) AddedFields() []string {
-	return nil
+func (m *QuestionnaireDefinitionMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, questionnairedefinition.FieldVersion)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *QuestionnaireDefinitionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case questionnairedefinition.FieldVersion:
+		return m.AddedVersion()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CommentMutation) AddField(name string, value ent.Value) error {
+func (m *QuestionnaireDefinitionMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case questionnairedefinition.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Comment numeric field %s", name)
+	return fmt.Errorf("unknown QuestionnaireDefinition numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CommentMutation) ClearedFields() []string {
-	return nil
+func (m *QuestionnaireDefinitionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(questionnairedefinition.FieldDescription) {
+		fields = append(fields, questionnairedefinition.FieldDescription)
+	}
+	if m.FieldCleared(questionnairedefinition.FieldCreatedByDoctorID) {
+		fields = append(fields, questionnairedefinition.FieldCreatedByDoctorID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CommentMutation) FieldCleared(name string) bool {
+func (m *QuestionnaireDefinitionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CommentMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Comment nullable field %s", name)
+func (m *QuestionnaireDefinitionMutation) ClearField(name string) error {
+	switch name {
+	case questionnairedefinition.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case questionnairedefinition.FieldCreatedByDoctorID:
+		m.ClearCreatedByDoctorID()
+		return nil
+	}
+	return fmt.Errorf("unknown QuestionnaireDefinition nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CommentMutation) ResetField(name string) error {
+func (m *QuestionnaireDefinitionMutation) ResetField(name string) error {
 	switch name {
-	case comment.FieldEntryID:
-		m.ResetEntryID()
+	case questionnairedefinition.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case comment.FieldAuthorDoctorID:
-		m.ResetAuthorDoctorID()
+	case questionnairedefinition.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case comment.FieldBody:
-		m.ResetBody()
+	case questionnairedefinition.FieldKey:
+		m.ResetKey()
 		return nil
-	case comment.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case questionnairedefinition.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case questionnairedefinition.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case questionnairedefinition.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case questionnairedefinition.FieldDefinition:
+		m.ResetDefinition()
+		return nil
+	case questionnairedefinition.FieldCreatedByDoctorID:
+		m.ResetCreatedByDoctorID()
+		return nil
+	case questionnairedefinition.FieldActive:
+		m.ResetActive()
 		return nil
 	}
-	return fmt.Errorf("unknown Comment field %s", name)
+	return fmt.Errorf("unknown QuestionnaireDefinition field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CommentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.entry != nil {
-		edges = append(edges, comment.EdgeEntry)
+func (m *QuestionnaireDefinitionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.created_by_doctor != nil {
+		edges = append(edges, questionnairedefinition.EdgeCreatedByDoctor)
 	}
-	if m.author != nil {
-		edges = append(edges, comment.EdgeAuthor)
+	if m.assignments != nil {
+		edges = append(edges, questionnairedefinition.EdgeAssignments)
+	}
+	if m.responses != nil {
+		edges = append(edges, questionnairedefinition.EdgeResponses)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CommentMutation) AddedIDs(name string) []ent.Value {
+func (m *QuestionnaireDefinitionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case comment.EdgeEntry:
-		if id := m.entry; id != nil {
+	case questionnairedefinition.EdgeCreatedByDoctor:
+		if id := m.created_by_doctor; id != nil {
 			return []ent.Value{*id}
 		}
-	case comment.EdgeAuthor:
-		if id := m.author; id != nil {
-			return []ent.Value{*id}
+	case questionnairedefinition.EdgeAssignments:
+		ids := make([]ent.Value, 0, len(m.assignments))
+		for id := range m.assignments {
+			ids = append(ids, id)
+		}
+		return ids
+	case questionnairedefinition.EdgeResponses:
+		ids := make([]ent.Value, 0, len(m.responses))
+		for id := range m.responses {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CommentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *QuestionnaireDefinitionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedassignments != nil {
+		edges = append(edges, questionnairedefinition.EdgeAssignments)
+	}
+	if m.removedresponses != nil {
+		edges = append(edges, questionnairedefinition.EdgeResponses)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
+func (m *QuestionnaireDefinitionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case questionnairedefinition.EdgeAssignments:
+		ids := make([]ent.Value, 0, len(m.removedassignments))
+		for id := range m.removedassignments {
+			ids = append(ids, id)
+		}
+		return ids
+	case questionnairedefinition.EdgeResponses:
+		ids := make([]ent.Value, 0, len(m.removedresponses))
+		for id := range m.removedresponses {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CommentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedentry {
-		edges = append(edges, comment.EdgeEntry)
+func (m *QuestionnaireDefinitionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedcreated_by_doctor {
+		edges = append(edges, questionnairedefinition.EdgeCreatedByDoctor)
 	}
-	if m.clearedauthor {
-		edges = append(edges, comment.EdgeAuthor)
+	if m.clearedassignments {
+		edges = append(edges, questionnairedefinition.EdgeAssignments)
+	}
+	if m.clearedresponses {
+		edges = append(edges, questionnairedefinition.EdgeResponses)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CommentMutation) EdgeCleared(name string) bool {
+func (m *QuestionnaireDefinitionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case comment.EdgeEntry:
-		return m.clearedentry
-	case comment.EdgeAuthor:
-		return m.clearedauthor
+	case questionnairedefinition.EdgeCreatedByDoctor:
+		return m.clearedcreated_by_doctor
+	case questionnairedefinition.EdgeAssignments:
+		return m.clearedassignments
+	case questionnairedefinition.EdgeResponses:
+		return m.clearedresponses
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CommentMutation) ClearEdge(name string) error {
+func (m *QuestionnaireDefinitionMutation) ClearEdge(name string) error {
 	switch name {
-	case comment.EdgeEntry:
-		m.ClearEntry()
-		return nil
-	case comment.EdgeAuthor:
-		m.ClearAuthor()
+	case questionnairedefinition.EdgeCreatedByDoctor:
+		m.ClearCreatedByDoctor()
 		return nil
 	}
-	return fmt.Errorf("unknown Comment unique edge %s", name)
+	return fmt.Errorf("unknown QuestionnaireDefinition unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CommentMutation) ResetEdge(name string) error {
+func (m *QuestionnaireDefinitionMutation) ResetEdge(name string) error {
 	switch name {
-	case comment.EdgeEntry:
-		m.ResetEntry()
+	case questionnairedefinition.EdgeCreatedByDoctor:
+		m.ResetCreatedByDoctor()
 		return nil
-	case comment.EdgeAuthor:
-		m.ResetAuthor()
+	case questionnairedefinition.EdgeAssignments:
+		m.ResetAssignments()
+		return nil
+	case questionnairedefinition.EdgeResponses:
+		m.ResetResponses()
 		return nil
 	}
-	return fmt.Errorf("unknown Comment edge %s", name)
+	return fmt.Errorf("unknown QuestionnaireDefinition edge %s", name)
 }
 
-// DoctorMutation represents an operation that mutates the Doctor nodes in the graph.
-type DoctorMutation struct {
+// QuestionnaireResponseMutation represents an operation that mutates the QuestionnaireResponse nodes in the graph.
+type QuestionnaireResponseMutation struct {
 	config
-	op                            Op
-	typ                           string
-	id                            *uuid.UUID
-	created_at                    *time.Time
-	updated_at                    *time.Time
-	email                         *string
-	display_name                  *string
-	password_hash                 *string
-	role                          *doctor.Role
-	clearedFields                 map[string]struct{}
-	practice                      *uuid.UUID
-	clearedpractice               bool
-	patient_links                 map[uuid.UUID]struct{}
-	removedpatient_links          map[uuid.UUID]struct{}
-	clearedpatient_links          bool
-	pairing_codes                 map[uuid.UUID]struct{}
-	removedpairing_codes          map[uuid.UUID]struct{}
-	clearedpairing_codes          bool
-	approved_patient_links        map[uuid.UUID]struct{}
-	removedapproved_patient_links map[uuid.UUID]struct{}
-	clearedapproved_patient_links bool
-	entry_shares                  map[uuid.UUID]struct{}
-	removedentry_shares           map[uuid.UUID]struct{}
-	clearedentry_shares           bool
-	comments                      map[uuid.UUID]struct{}
-	removedcomments               map[uuid.UUID]struct{}
-	clearedcomments               bool
-	created_analysis_jobs         map[uuid.UUID]struct{}
-	removedcreated_analysis_jobs  map[uuid.UUID]struct{}
-	clearedcreated_analysis_jobs  bool
-	done                          bool
-	oldValue                      func(context.Context) (*Doctor, error)
-	predicates                    []predicate.Doctor
-}
-
-var _ ent.Mutation = (*DoctorMutation)(nil)
-
-// doctorOption allows management of the mutation configuration using functional options.
-type doctorOption func(*DoctorMutation)
-
-// newDoctorMutation creates new mutation for the Doctor entity.
-func newDoctorMutation(c config, op Op, opts ...doctorOption) *DoctorMutation {
-	m := &DoctorMutation{
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	created_at        *time.Time
+	updated_at        *time.Time
+	answers           *map[string]string
+	scores            *[]questionnaire.ScaleScore
+	appendscores      []questionnaire.ScaleScore
+	submitted_at      *time.Time
+	clearedFields     map[string]struct{}
+	patient           *uuid.UUID
+	clearedpatient    bool
+	definition        *uuid.UUID
+	cleareddefinition bool
+	assignment        *uuid.UUID
+	clearedassignment bool
+	done              bool
+	oldValue          func(context.Context) (*QuestionnaireResponse, error)
+	predicates        []predicate.QuestionnaireResponse
+}
+
+var _ ent.Mutation = (*QuestionnaireResponseMutation)(nil)
+
+// questionnaireresponseOption allows management of the mutation configuration using functional options.
+type questionnaireresponseOption func(*QuestionnaireResponseMutation)
+
+// newQuestionnaireResponseMutation creates new mutation for the QuestionnaireResponse entity.
+func newQuestionnaireResponseMutation(c config, op Op, opts ...questionnaireresponseOption) *QuestionnaireResponseMutation {
+	m := &QuestionnaireResponseMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDoctor,
+		typ:           TypeQuestionnaireResponse,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2062,20 +17722,20 @@ func newDoctorMutation(c config, op Op, opts ...doctorOption) *DoctorMutation {
 	return m
 }
 
-// withDoctorID sets the ID field of the mutation.
-func withDoctorID(id uuid.UUID) doctorOption {
-	return func(m *DoctorMutation) {
+// withQuestionnaireResponseID sets the ID field of the mutation.
+func withQuestionnaireResponseID(id uuid.UUID) questionnaireresponseOption {
+	return func(m *QuestionnaireResponseMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Doctor
+			value *QuestionnaireResponse
 		)
-		m.oldValue = func(ctx context.Context) (*Doctor, error) {
+		m.oldValue = func(ctx context.Context) (*QuestionnaireResponse, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Doctor.Get(ctx, id)
+					value, err = m.Client().QuestionnaireResponse.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2084,10 +17744,10 @@ func withDoctorID(id uuid.UUID) doctorOption {
 	}
 }
 
-// withDoctor sets the old Doctor of the mutation.
-func withDoctor(node *Doctor) doctorOption {
-	return func(m *DoctorMutation) {
-		m.oldValue = func(context.Context) (*Doctor, error) {
+// withQuestionnaireResponse sets the old QuestionnaireResponse of the mutation.
+func withQuestionnaireResponse(node *QuestionnaireResponse) questionnaireresponseOption {
+	return func(m *QuestionnaireResponseMutation) {
+		m.oldValue = func(context.Context) (*QuestionnaireResponse, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2096,7 +17756,7 @@ func withDoctor(node *Doctor) doctorOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DoctorMutation) Client() *Client {
+func (m QuestionnaireResponseMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2104,7 +17764,7 @@ func (m DoctorMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DoctorMutation) Tx() (*Tx, error) {
+func (m QuestionnaireResponseMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2114,14 +17774,14 @@ func (m DoctorMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Doctor entities.
-func (m *DoctorMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of QuestionnaireResponse entities.
+func (m *QuestionnaireResponseMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DoctorMutation) ID() (id uuid.UUID, exists bool) {
+func (m *QuestionnaireResponseMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2132,7 +17792,7 @@ func (m *DoctorMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DoctorMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *QuestionnaireResponseMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2141,19 +17801,19 @@ func (m *DoctorMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Doctor.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().QuestionnaireResponse.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *DoctorMutation) SetCreatedAt(t time.Time) {
+func (m *QuestionnaireResponseMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DoctorMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *QuestionnaireResponseMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2161,10 +17821,10 @@ func (m *DoctorMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Doctor entity.
-// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the QuestionnaireResponse entity.
+// If the QuestionnaireResponse object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *QuestionnaireResponseMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2179,17 +17839,17 @@ func (m *DoctorMutation) OldCreatedAt(ctx context.Context) (v time.Time, err err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DoctorMutation) ResetCreatedAt() {
+func (m *QuestionnaireResponseMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *DoctorMutation) SetUpdatedAt(t time.Time) {
+func (m *QuestionnaireResponseMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DoctorMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *QuestionnaireResponseMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2197,10 +17857,10 @@ func (m *DoctorMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Doctor entity.
-// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the QuestionnaireResponse entity.
+// If the QuestionnaireResponse object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *QuestionnaireResponseMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2215,563 +17875,939 @@ func (m *DoctorMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DoctorMutation) ResetUpdatedAt() {
+func (m *QuestionnaireResponseMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *DoctorMutation) SetEmail(s string) {
-	m.email = &s
+// SetPatientID sets the "patient_id" field.
+func (m *QuestionnaireResponseMutation) SetPatientID(u uuid.UUID) {
+	m.patient = &u
+}
+
+// PatientID returns the value of the "patient_id" field in the mutation.
+func (m *QuestionnaireResponseMutation) PatientID() (r uuid.UUID, exists bool) {
+	v := m.patient
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPatientID returns the old "patient_id" field's value of the QuestionnaireResponse entity.
+// If the QuestionnaireResponse object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuestionnaireResponseMutation) OldPatientID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPatientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPatientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPatientID: %w", err)
+	}
+	return oldValue.PatientID, nil
+}
+
+// ResetPatientID resets all changes to the "patient_id" field.
+func (m *QuestionnaireResponseMutation) ResetPatientID() {
+	m.patient = nil
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *DoctorMutation) Email() (r string, exists bool) {
-	v := m.email
+// SetDefinitionID sets the "definition_id" field.
+func (m *QuestionnaireResponseMutation) SetDefinitionID(u uuid.UUID) {
+	m.definition = &u
+}
+
+// DefinitionID returns the value of the "definition_id" field in the mutation.
+func (m *QuestionnaireResponseMutation) DefinitionID() (r uuid.UUID, exists bool) {
+	v := m.definition
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the Doctor entity.
-// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// OldDefinitionID returns the old "definition_id" field's value of the QuestionnaireResponse entity.
+// If the QuestionnaireResponse object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *QuestionnaireResponseMutation) OldDefinitionID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldDefinitionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldDefinitionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldDefinitionID: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.DefinitionID, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *DoctorMutation) ResetEmail() {
-	m.email = nil
+// ResetDefinitionID resets all changes to the "definition_id" field.
+func (m *QuestionnaireResponseMutation) ResetDefinitionID() {
+	m.definition = nil
 }
 
-// SetDisplayName sets the "display_name" field.
-func (m *DoctorMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// SetAssignmentID sets the "assignment_id" field.
+func (m *QuestionnaireResponseMutation) SetAssignmentID(u uuid.UUID) {
+	m.assignment = &u
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *DoctorMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
+// AssignmentID returns the value of the "assignment_id" field in the mutation.
+func (m *QuestionnaireResponseMutation) AssignmentID() (r uuid.UUID, exists bool) {
+	v := m.assignment
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the Doctor entity.
-// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// OldAssignmentID returns the old "assignment_id" field's value of the QuestionnaireResponse entity.
+// If the QuestionnaireResponse object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+func (m *QuestionnaireResponseMutation) OldAssignmentID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+		return v, errors.New("OldAssignmentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+		return v, errors.New("OldAssignmentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+		return v, fmt.Errorf("querying old value for OldAssignmentID: %w", err)
 	}
-	return oldValue.DisplayName, nil
+	return oldValue.AssignmentID, nil
 }
 
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *DoctorMutation) ResetDisplayName() {
-	m.display_name = nil
+// ResetAssignmentID resets all changes to the "assignment_id" field.
+func (m *QuestionnaireResponseMutation) ResetAssignmentID() {
+	m.assignment = nil
 }
 
-// SetPasswordHash sets the "password_hash" field.
-func (m *DoctorMutation) SetPasswordHash(s string) {
-	m.password_hash = &s
+// SetAnswers sets the "answers" field.
+func (m *QuestionnaireResponseMutation) SetAnswers(value map[string]string) {
+	m.answers = &value
 }
 
-// PasswordHash returns the value of the "password_hash" field in the mutation.
-func (m *DoctorMutation) PasswordHash() (r string, exists bool) {
-	v := m.password_hash
+// Answers returns the value of the "answers" field in the mutation.
+func (m *QuestionnaireResponseMutation) Answers() (r map[string]string, exists bool) {
+	v := m.answers
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPasswordHash returns the old "password_hash" field's value of the Doctor entity.
-// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// OldAnswers returns the old "answers" field's value of the QuestionnaireResponse entity.
+// If the QuestionnaireResponse object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+func (m *QuestionnaireResponseMutation) OldAnswers(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldAnswers is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+		return v, errors.New("OldAnswers requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldAnswers: %w", err)
 	}
-	return oldValue.PasswordHash, nil
+	return oldValue.Answers, nil
 }
 
-// ResetPasswordHash resets all changes to the "password_hash" field.
-func (m *DoctorMutation) ResetPasswordHash() {
-	m.password_hash = nil
+// ResetAnswers resets all changes to the "answers" field.
+func (m *QuestionnaireResponseMutation) ResetAnswers() {
+	m.answers = nil
 }
 
-// SetRole sets the "role" field.
-func (m *DoctorMutation) SetRole(d doctor.Role) {
-	m.role = &d
+// SetScores sets the "scores" field.
+func (m *QuestionnaireResponseMutation) SetScores(qs []questionnaire.ScaleScore) {
+	m.scores = &qs
+	m.appendscores = nil
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *DoctorMutation) Role() (r doctor.Role, exists bool) {
-	v := m.role
+// Scores returns the value of the "scores" field in the mutation.
+func (m *QuestionnaireResponseMutation) Scores() (r []questionnaire.ScaleScore, exists bool) {
+	v := m.scores
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the Doctor entity.
-// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// OldScores returns the old "scores" field's value of the QuestionnaireResponse entity.
+// If the QuestionnaireResponse object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorMutation) OldRole(ctx context.Context) (v doctor.Role, err error) {
+func (m *QuestionnaireResponseMutation) OldScores(ctx context.Context) (v []questionnaire.ScaleScore, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldScores is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldScores requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldScores: %w", err)
 	}
-	return oldValue.Role, nil
+	return oldValue.Scores, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *DoctorMutation) ResetRole() {
-	m.role = nil
+// AppendScores adds qs to the "scores" field.
+func (m *QuestionnaireResponseMutation) AppendScores(qs []questionnaire.ScaleScore) {
+	m.appendscores = append(m.appendscores, qs...)
 }
 
-// SetPracticeID sets the "practice_id" field.
-func (m *DoctorMutation) SetPracticeID(u uuid.UUID) {
-	m.practice = &u
+// AppendedScores returns the list of values that were appended to the "scores" field in this mutation.
+func (m *QuestionnaireResponseMutation) AppendedScores() ([]questionnaire.ScaleScore, bool) {
+	if len(m.appendscores) == 0 {
+		return nil, false
+	}
+	return m.appendscores, true
 }
 
-// PracticeID returns the value of the "practice_id" field in the mutation.
-func (m *DoctorMutation) PracticeID() (r uuid.UUID, exists bool) {
-	v := m.practice
+// ResetScores resets all changes to the "scores" field.
+func (m *QuestionnaireResponseMutation) ResetScores() {
+	m.scores = nil
+	m.appendscores = nil
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (m *QuestionnaireResponseMutation) SetSubmittedAt(t time.Time) {
+	m.submitted_at = &t
+}
+
+// SubmittedAt returns the value of the "submitted_at" field in the mutation.
+func (m *QuestionnaireResponseMutation) SubmittedAt() (r time.Time, exists bool) {
+	v := m.submitted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPracticeID returns the old "practice_id" field's value of the Doctor entity.
-// If the Doctor object wasn't provided to the builder, the object is fetched from the database.
+// OldSubmittedAt returns the old "submitted_at" field's value of the QuestionnaireResponse entity.
+// If the QuestionnaireResponse object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorMutation) OldPracticeID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *QuestionnaireResponseMutation) OldSubmittedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPracticeID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubmittedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPracticeID requires an ID field in the mutation")
+		return v, errors.New("OldSubmittedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPracticeID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubmittedAt: %w", err)
 	}
-	return oldValue.PracticeID, nil
+	return oldValue.SubmittedAt, nil
 }
 
-// ClearPracticeID clears the value of the "practice_id" field.
-func (m *DoctorMutation) ClearPracticeID() {
-	m.practice = nil
-	m.clearedFields[doctor.FieldPracticeID] = struct{}{}
+// ResetSubmittedAt resets all changes to the "submitted_at" field.
+func (m *QuestionnaireResponseMutation) ResetSubmittedAt() {
+	m.submitted_at = nil
 }
 
-// PracticeIDCleared returns if the "practice_id" field was cleared in this mutation.
-func (m *DoctorMutation) PracticeIDCleared() bool {
-	_, ok := m.clearedFields[doctor.FieldPracticeID]
-	return ok
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (m *QuestionnaireResponseMutation) ClearPatient() {
+	m.clearedpatient = true
+	m.clearedFields[questionnaireresponse.FieldPatientID] = struct{}{}
 }
 
-// ResetPracticeID resets all changes to the "practice_id" field.
-func (m *DoctorMutation) ResetPracticeID() {
-	m.practice = nil
-	delete(m.clearedFields, doctor.FieldPracticeID)
+// PatientCleared reports if the "patient" edge to the Patient entity was cleared.
+func (m *QuestionnaireResponseMutation) PatientCleared() bool {
+	return m.clearedpatient
 }
 
-// ClearPractice clears the "practice" edge to the Practice entity.
-func (m *DoctorMutation) ClearPractice() {
-	m.clearedpractice = true
-	m.clearedFields[doctor.FieldPracticeID] = struct{}{}
+// PatientIDs returns the "patient" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PatientID instead. It exists only for internal usage by the builders.
+func (m *QuestionnaireResponseMutation) PatientIDs() (ids []uuid.UUID) {
+	if id := m.patient; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// PracticeCleared reports if the "practice" edge to the Practice entity was cleared.
-func (m *DoctorMutation) PracticeCleared() bool {
-	return m.PracticeIDCleared() || m.clearedpractice
+// ResetPatient resets all changes to the "patient" edge.
+func (m *QuestionnaireResponseMutation) ResetPatient() {
+	m.patient = nil
+	m.clearedpatient = false
 }
 
-// PracticeIDs returns the "practice" edge IDs in the mutation.
+// ClearDefinition clears the "definition" edge to the QuestionnaireDefinition entity.
+func (m *QuestionnaireResponseMutation) ClearDefinition() {
+	m.cleareddefinition = true
+	m.clearedFields[questionnaireresponse.FieldDefinitionID] = struct{}{}
+}
+
+// DefinitionCleared reports if the "definition" edge to the QuestionnaireDefinition entity was cleared.
+func (m *QuestionnaireResponseMutation) DefinitionCleared() bool {
+	return m.cleareddefinition
+}
+
+// DefinitionIDs returns the "definition" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PracticeID instead. It exists only for internal usage by the builders.
-func (m *DoctorMutation) PracticeIDs() (ids []uuid.UUID) {
-	if id := m.practice; id != nil {
+// DefinitionID instead. It exists only for internal usage by the builders.
+func (m *QuestionnaireResponseMutation) DefinitionIDs() (ids []uuid.UUID) {
+	if id := m.definition; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPractice resets all changes to the "practice" edge.
-func (m *DoctorMutation) ResetPractice() {
-	m.practice = nil
-	m.clearedpractice = false
+// ResetDefinition resets all changes to the "definition" edge.
+func (m *QuestionnaireResponseMutation) ResetDefinition() {
+	m.definition = nil
+	m.cleareddefinition = false
+}
+
+// ClearAssignment clears the "assignment" edge to the QuestionnaireAssignment entity.
+func (m *QuestionnaireResponseMutation) ClearAssignment() {
+	m.clearedassignment = true
+	m.clearedFields[questionnaireresponse.FieldAssignmentID] = struct{}{}
+}
+
+// AssignmentCleared reports if the "assignment" edge to the QuestionnaireAssignment entity was cleared.
+func (m *QuestionnaireResponseMutation) AssignmentCleared() bool {
+	return m.clearedassignment
+}
+
+// AssignmentIDs returns the "assignment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AssignmentID instead. It exists only for internal usage by the builders.
+func (m *QuestionnaireResponseMutation) AssignmentIDs() (ids []uuid.UUID) {
+	if id := m.assignment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAssignment resets all changes to the "assignment" edge.
+func (m *QuestionnaireResponseMutation) ResetAssignment() {
+	m.assignment = nil
+	m.clearedassignment = false
+}
+
+// Where appends a list predicates to the QuestionnaireResponseMutation builder.
+func (m *QuestionnaireResponseMutation) Where(ps ...predicate.QuestionnaireResponse) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuestionnaireResponseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuestionnaireResponseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QuestionnaireResponse, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuestionnaireResponseMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuestionnaireResponseMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (QuestionnaireResponse).
+func (m *QuestionnaireResponseMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuestionnaireResponseMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, questionnaireresponse.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, questionnaireresponse.FieldUpdatedAt)
+	}
+	if m.patient != nil {
+		fields = append(fields, questionnaireresponse.FieldPatientID)
+	}
+	if m.definition != nil {
+		fields = append(fields, questionnaireresponse.FieldDefinitionID)
+	}
+	if m.assignment != nil {
+		fields = append(fields, questionnaireresponse.FieldAssignmentID)
+	}
+	if m.answers != nil {
+		fields = append(fields, questionnaireresponse.FieldAnswers)
+	}
+	if m.scores != nil {
+		fields = append(fields, questionnaireresponse.FieldScores)
+	}
+	if m.submitted_at != nil {
+		fields = append(fields, questionnaireresponse.FieldSubmittedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuestionnaireResponseMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case questionnaireresponse.FieldCreatedAt:
+		return m.CreatedAt()
+	case questionnaireresponse.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case questionnaireresponse.FieldPatientID:
+		return m.PatientID()
+	case questionnaireresponse.FieldDefinitionID:
+		return m.DefinitionID()
+	case questionnaireresponse.FieldAssignmentID:
+		return m.AssignmentID()
+	case questionnaireresponse.FieldAnswers:
+		return m.Answers()
+	case questionnaireresponse.FieldScores:
+		return m.Scores()
+	case questionnaireresponse.FieldSubmittedAt:
+		return m.SubmittedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuestionnaireResponseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case questionnaireresponse.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case questionnaireresponse.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case questionnaireresponse.FieldPatientID:
+		return m.OldPatientID(ctx)
+	case questionnaireresponse.FieldDefinitionID:
+		return m.OldDefinitionID(ctx)
+	case questionnaireresponse.FieldAssignmentID:
+		return m.OldAssignmentID(ctx)
+	case questionnaireresponse.FieldAnswers:
+		return m.OldAnswers(ctx)
+	case questionnaireresponse.FieldScores:
+		return m.OldScores(ctx)
+	case questionnaireresponse.FieldSubmittedAt:
+		return m.OldSubmittedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown QuestionnaireResponse field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionnaireResponseMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case questionnaireresponse.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case questionnaireresponse.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case questionnaireresponse.FieldPatientID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPatientID(v)
+		return nil
+	case questionnaireresponse.FieldDefinitionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefinitionID(v)
+		return nil
+	case questionnaireresponse.FieldAssignmentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignmentID(v)
+		return nil
+	case questionnaireresponse.FieldAnswers:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswers(v)
+		return nil
+	case questionnaireresponse.FieldScores:
+		v, ok := value.([]questionnaire.ScaleScore)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScores(v)
+		return nil
+	case questionnaireresponse.FieldSubmittedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmittedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QuestionnaireResponse field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuestionnaireResponseMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuestionnaireResponseMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// AddPatientLinkIDs adds the "patient_links" edge to the DoctorPatientLink entity by ids.
-func (m *DoctorMutation) AddPatientLinkIDs(ids ...uuid.UUID) {
-	if m.patient_links == nil {
-		m.patient_links = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.patient_links[ids[i]] = struct{}{}
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuestionnaireResponseMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
+	return fmt.Errorf("unknown QuestionnaireResponse numeric field %s", name)
 }
 
-// ClearPatientLinks clears the "patient_links" edge to the DoctorPatientLink entity.
-func (m *DoctorMutation) ClearPatientLinks() {
-	m.clearedpatient_links = true
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuestionnaireResponseMutation) ClearedFields() []string {
+	return nil
 }
 
-// PatientLinksCleared reports if the "patient_links" edge to the DoctorPatientLink entity was cleared.
-func (m *DoctorMutation) PatientLinksCleared() bool {
-	return m.clearedpatient_links
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuestionnaireResponseMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// RemovePatientLinkIDs removes the "patient_links" edge to the DoctorPatientLink entity by IDs.
-func (m *DoctorMutation) RemovePatientLinkIDs(ids ...uuid.UUID) {
-	if m.removedpatient_links == nil {
-		m.removedpatient_links = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.patient_links, ids[i])
-		m.removedpatient_links[ids[i]] = struct{}{}
-	}
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuestionnaireResponseMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown QuestionnaireResponse nullable field %s", name)
 }
 
-// RemovedPatientLinks returns the removed IDs of the "patient_links" edge to the DoctorPatientLink entity.
-func (m *DoctorMutation) RemovedPatientLinksIDs() (ids []uuid.UUID) {
-	for id := range m.removedpatient_links {
-		ids = append(ids, id)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuestionnaireResponseMutation) ResetField(name string) error {
+	switch name {
+	case questionnaireresponse.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case questionnaireresponse.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case questionnaireresponse.FieldPatientID:
+		m.ResetPatientID()
+		return nil
+	case questionnaireresponse.FieldDefinitionID:
+		m.ResetDefinitionID()
+		return nil
+	case questionnaireresponse.FieldAssignmentID:
+		m.ResetAssignmentID()
+		return nil
+	case questionnaireresponse.FieldAnswers:
+		m.ResetAnswers()
+		return nil
+	case questionnaireresponse.FieldScores:
+		m.ResetScores()
+		return nil
+	case questionnaireresponse.FieldSubmittedAt:
+		m.ResetSubmittedAt()
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown QuestionnaireResponse field %s", name)
 }
 
-// PatientLinksIDs returns the "patient_links" edge IDs in the mutation.
-func (m *DoctorMutation) PatientLinksIDs() (ids []uuid.UUID) {
-	for id := range m.patient_links {
-		ids = append(ids, id)
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuestionnaireResponseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.patient != nil {
+		edges = append(edges, questionnaireresponse.EdgePatient)
 	}
-	return
-}
-
-// ResetPatientLinks resets all changes to the "patient_links" edge.
-func (m *DoctorMutation) ResetPatientLinks() {
-	m.patient_links = nil
-	m.clearedpatient_links = false
-	m.removedpatient_links = nil
+	if m.definition != nil {
+		edges = append(edges, questionnaireresponse.EdgeDefinition)
+	}
+	if m.assignment != nil {
+		edges = append(edges, questionnaireresponse.EdgeAssignment)
+	}
+	return edges
 }
 
-// AddPairingCodeIDs adds the "pairing_codes" edge to the PairingCode entity by ids.
-func (m *DoctorMutation) AddPairingCodeIDs(ids ...uuid.UUID) {
-	if m.pairing_codes == nil {
-		m.pairing_codes = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.pairing_codes[ids[i]] = struct{}{}
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuestionnaireResponseMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case questionnaireresponse.EdgePatient:
+		if id := m.patient; id != nil {
+			return []ent.Value{*id}
+		}
+	case questionnaireresponse.EdgeDefinition:
+		if id := m.definition; id != nil {
+			return []ent.Value{*id}
+		}
+	case questionnaireresponse.EdgeAssignment:
+		if id := m.assignment; id != nil {
+			return []ent.Value{*id}
+		}
 	}
+	return nil
 }
 
-// ClearPairingCodes clears the "pairing_codes" edge to the PairingCode entity.
-func (m *DoctorMutation) ClearPairingCodes() {
-	m.clearedpairing_codes = true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuestionnaireResponseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
 }
 
-// PairingCodesCleared reports if the "pairing_codes" edge to the PairingCode entity was cleared.
-func (m *DoctorMutation) PairingCodesCleared() bool {
-	return m.clearedpairing_codes
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuestionnaireResponseMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// RemovePairingCodeIDs removes the "pairing_codes" edge to the PairingCode entity by IDs.
-func (m *DoctorMutation) RemovePairingCodeIDs(ids ...uuid.UUID) {
-	if m.removedpairing_codes == nil {
-		m.removedpairing_codes = make(map[uuid.UUID]struct{})
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuestionnaireResponseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedpatient {
+		edges = append(edges, questionnaireresponse.EdgePatient)
 	}
-	for i := range ids {
-		delete(m.pairing_codes, ids[i])
-		m.removedpairing_codes[ids[i]] = struct{}{}
+	if m.cleareddefinition {
+		edges = append(edges, questionnaireresponse.EdgeDefinition)
 	}
-}
-
-// RemovedPairingCodes returns the removed IDs of the "pairing_codes" edge to the PairingCode entity.
-func (m *DoctorMutation) RemovedPairingCodesIDs() (ids []uuid.UUID) {
-	for id := range m.removedpairing_codes {
-		ids = append(ids, id)
+	if m.clearedassignment {
+		edges = append(edges, questionnaireresponse.EdgeAssignment)
 	}
-	return
+	return edges
 }
 
-// PairingCodesIDs returns the "pairing_codes" edge IDs in the mutation.
-func (m *DoctorMutation) PairingCodesIDs() (ids []uuid.UUID) {
-	for id := range m.pairing_codes {
-		ids = append(ids, id)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuestionnaireResponseMutation) EdgeCleared(name string) bool {
+	switch name {
+	case questionnaireresponse.EdgePatient:
+		return m.clearedpatient
+	case questionnaireresponse.EdgeDefinition:
+		return m.cleareddefinition
+	case questionnaireresponse.EdgeAssignment:
+		return m.clearedassignment
 	}
-	return
+	return false
 }
 
-// ResetPairingCodes resets all changes to the "pairing_codes" edge.
-func (m *DoctorMutation) ResetPairingCodes() {
-	m.pairing_codes = nil
-	m.clearedpairing_codes = false
-	m.removedpairing_codes = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuestionnaireResponseMutation) ClearEdge(name string) error {
+	switch name {
+	case questionnaireresponse.EdgePatient:
+		m.ClearPatient()
+		return nil
+	case questionnaireresponse.EdgeDefinition:
+		m.ClearDefinition()
+		return nil
+	case questionnaireresponse.EdgeAssignment:
+		m.ClearAssignment()
+		return nil
+	}
+	return fmt.Errorf("unknown QuestionnaireResponse unique edge %s", name)
 }
 
-// AddApprovedPatientLinkIDs adds the "approved_patient_links" edge to the DoctorPatientLink entity by ids.
-func (m *DoctorMutation) AddApprovedPatientLinkIDs(ids ...uuid.UUID) {
-	if m.approved_patient_links == nil {
-		m.approved_patient_links = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.approved_patient_links[ids[i]] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuestionnaireResponseMutation) ResetEdge(name string) error {
+	switch name {
+	case questionnaireresponse.EdgePatient:
+		m.ResetPatient()
+		return nil
+	case questionnaireresponse.EdgeDefinition:
+		m.ResetDefinition()
+		return nil
+	case questionnaireresponse.EdgeAssignment:
+		m.ResetAssignment()
+		return nil
 	}
+	return fmt.Errorf("unknown QuestionnaireResponse edge %s", name)
 }
 
-// ClearApprovedPatientLinks clears the "approved_patient_links" edge to the DoctorPatientLink entity.
-func (m *DoctorMutation) ClearApprovedPatientLinks() {
-	m.clearedapproved_patient_links = true
+// RateLimitBucketMutation represents an operation that mutates the RateLimitBucket nodes in the graph.
+type RateLimitBucketMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	tokens        *float64
+	addtokens     *float64
+	allowed       *bool
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*RateLimitBucket, error)
+	predicates    []predicate.RateLimitBucket
 }
 
-// ApprovedPatientLinksCleared reports if the "approved_patient_links" edge to the DoctorPatientLink entity was cleared.
-func (m *DoctorMutation) ApprovedPatientLinksCleared() bool {
-	return m.clearedapproved_patient_links
-}
+var _ ent.Mutation = (*RateLimitBucketMutation)(nil)
 
-// RemoveApprovedPatientLinkIDs removes the "approved_patient_links" edge to the DoctorPatientLink entity by IDs.
-func (m *DoctorMutation) RemoveApprovedPatientLinkIDs(ids ...uuid.UUID) {
-	if m.removedapproved_patient_links == nil {
-		m.removedapproved_patient_links = make(map[uuid.UUID]struct{})
+// ratelimitbucketOption allows management of the mutation configuration using functional options.
+type ratelimitbucketOption func(*RateLimitBucketMutation)
+
+// newRateLimitBucketMutation creates new mutation for the RateLimitBucket entity.
+func newRateLimitBucketMutation(c config, op Op, opts ...ratelimitbucketOption) *RateLimitBucketMutation {
+	m := &RateLimitBucketMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRateLimitBucket,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		delete(m.approved_patient_links, ids[i])
-		m.removedapproved_patient_links[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedApprovedPatientLinks returns the removed IDs of the "approved_patient_links" edge to the DoctorPatientLink entity.
-func (m *DoctorMutation) RemovedApprovedPatientLinksIDs() (ids []uuid.UUID) {
-	for id := range m.removedapproved_patient_links {
-		ids = append(ids, id)
+// withRateLimitBucketID sets the ID field of the mutation.
+func withRateLimitBucketID(id string) ratelimitbucketOption {
+	return func(m *RateLimitBucketMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RateLimitBucket
+		)
+		m.oldValue = func(ctx context.Context) (*RateLimitBucket, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RateLimitBucket.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// ApprovedPatientLinksIDs returns the "approved_patient_links" edge IDs in the mutation.
-func (m *DoctorMutation) ApprovedPatientLinksIDs() (ids []uuid.UUID) {
-	for id := range m.approved_patient_links {
-		ids = append(ids, id)
+// withRateLimitBucket sets the old RateLimitBucket of the mutation.
+func withRateLimitBucket(node *RateLimitBucket) ratelimitbucketOption {
+	return func(m *RateLimitBucketMutation) {
+		m.oldValue = func(context.Context) (*RateLimitBucket, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetApprovedPatientLinks resets all changes to the "approved_patient_links" edge.
-func (m *DoctorMutation) ResetApprovedPatientLinks() {
-	m.approved_patient_links = nil
-	m.clearedapproved_patient_links = false
-	m.removedapproved_patient_links = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RateLimitBucketMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddEntryShareIDs adds the "entry_shares" edge to the EntryShare entity by ids.
-func (m *DoctorMutation) AddEntryShareIDs(ids ...uuid.UUID) {
-	if m.entry_shares == nil {
-		m.entry_shares = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.entry_shares[ids[i]] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RateLimitBucketMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearEntryShares clears the "entry_shares" edge to the EntryShare entity.
-func (m *DoctorMutation) ClearEntryShares() {
-	m.clearedentry_shares = true
-}
-
-// EntrySharesCleared reports if the "entry_shares" edge to the EntryShare entity was cleared.
-func (m *DoctorMutation) EntrySharesCleared() bool {
-	return m.clearedentry_shares
-}
-
-// RemoveEntryShareIDs removes the "entry_shares" edge to the EntryShare entity by IDs.
-func (m *DoctorMutation) RemoveEntryShareIDs(ids ...uuid.UUID) {
-	if m.removedentry_shares == nil {
-		m.removedentry_shares = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.entry_shares, ids[i])
-		m.removedentry_shares[ids[i]] = struct{}{}
-	}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RateLimitBucket entities.
+func (m *RateLimitBucketMutation) SetID(id string) {
+	m.id = &id
 }
 
-// RemovedEntryShares returns the removed IDs of the "entry_shares" edge to the EntryShare entity.
-func (m *DoctorMutation) RemovedEntrySharesIDs() (ids []uuid.UUID) {
-	for id := range m.removedentry_shares {
-		ids = append(ids, id)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RateLimitBucketMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return
+	return *m.id, true
 }
 
-// EntrySharesIDs returns the "entry_shares" edge IDs in the mutation.
-func (m *DoctorMutation) EntrySharesIDs() (ids []uuid.UUID) {
-	for id := range m.entry_shares {
-		ids = append(ids, id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RateLimitBucketMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RateLimitBucket.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// ResetEntryShares resets all changes to the "entry_shares" edge.
-func (m *DoctorMutation) ResetEntryShares() {
-	m.entry_shares = nil
-	m.clearedentry_shares = false
-	m.removedentry_shares = nil
+// SetTokens sets the "tokens" field.
+func (m *RateLimitBucketMutation) SetTokens(f float64) {
+	m.tokens = &f
+	m.addtokens = nil
 }
 
-// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
-func (m *DoctorMutation) AddCommentIDs(ids ...uuid.UUID) {
-	if m.comments == nil {
-		m.comments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.comments[ids[i]] = struct{}{}
+// Tokens returns the value of the "tokens" field in the mutation.
+func (m *RateLimitBucketMutation) Tokens() (r float64, exists bool) {
+	v := m.tokens
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearComments clears the "comments" edge to the Comment entity.
-func (m *DoctorMutation) ClearComments() {
-	m.clearedcomments = true
+// OldTokens returns the old "tokens" field's value of the RateLimitBucket entity.
+// If the RateLimitBucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RateLimitBucketMutation) OldTokens(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokens: %w", err)
+	}
+	return oldValue.Tokens, nil
 }
 
-// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
-func (m *DoctorMutation) CommentsCleared() bool {
-	return m.clearedcomments
+// AddTokens adds f to the "tokens" field.
+func (m *RateLimitBucketMutation) AddTokens(f float64) {
+	if m.addtokens != nil {
+		*m.addtokens += f
+	} else {
+		m.addtokens = &f
+	}
 }
 
-// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
-func (m *DoctorMutation) RemoveCommentIDs(ids ...uuid.UUID) {
-	if m.removedcomments == nil {
-		m.removedcomments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.comments, ids[i])
-		m.removedcomments[ids[i]] = struct{}{}
+// AddedTokens returns the value that was added to the "tokens" field in this mutation.
+func (m *RateLimitBucketMutation) AddedTokens() (r float64, exists bool) {
+	v := m.addtokens
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
-func (m *DoctorMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedcomments {
-		ids = append(ids, id)
-	}
-	return
+// ResetTokens resets all changes to the "tokens" field.
+func (m *RateLimitBucketMutation) ResetTokens() {
+	m.tokens = nil
+	m.addtokens = nil
 }
 
-// CommentsIDs returns the "comments" edge IDs in the mutation.
-func (m *DoctorMutation) CommentsIDs() (ids []uuid.UUID) {
-	for id := range m.comments {
-		ids = append(ids, id)
-	}
-	return
+// SetAllowed sets the "allowed" field.
+func (m *RateLimitBucketMutation) SetAllowed(b bool) {
+	m.allowed = &b
 }
 
-// ResetComments resets all changes to the "comments" edge.
-func (m *DoctorMutation) ResetComments() {
-	m.comments = nil
-	m.clearedcomments = false
-	m.removedcomments = nil
+// Allowed returns the value of the "allowed" field in the mutation.
+func (m *RateLimitBucketMutation) Allowed() (r bool, exists bool) {
+	v := m.allowed
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddCreatedAnalysisJobIDs adds the "created_analysis_jobs" edge to the AnalysisJob entity by ids.
-func (m *DoctorMutation) AddCreatedAnalysisJobIDs(ids ...uuid.UUID) {
-	if m.created_analysis_jobs == nil {
-		m.created_analysis_jobs = make(map[uuid.UUID]struct{})
+// OldAllowed returns the old "allowed" field's value of the RateLimitBucket entity.
+// If the RateLimitBucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RateLimitBucketMutation) OldAllowed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowed is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.created_analysis_jobs[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowed requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowed: %w", err)
+	}
+	return oldValue.Allowed, nil
 }
 
-// ClearCreatedAnalysisJobs clears the "created_analysis_jobs" edge to the AnalysisJob entity.
-func (m *DoctorMutation) ClearCreatedAnalysisJobs() {
-	m.clearedcreated_analysis_jobs = true
+// ResetAllowed resets all changes to the "allowed" field.
+func (m *RateLimitBucketMutation) ResetAllowed() {
+	m.allowed = nil
 }
 
-// CreatedAnalysisJobsCleared reports if the "created_analysis_jobs" edge to the AnalysisJob entity was cleared.
-func (m *DoctorMutation) CreatedAnalysisJobsCleared() bool {
-	return m.clearedcreated_analysis_jobs
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RateLimitBucketMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RemoveCreatedAnalysisJobIDs removes the "created_analysis_jobs" edge to the AnalysisJob entity by IDs.
-func (m *DoctorMutation) RemoveCreatedAnalysisJobIDs(ids ...uuid.UUID) {
-	if m.removedcreated_analysis_jobs == nil {
-		m.removedcreated_analysis_jobs = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.created_analysis_jobs, ids[i])
-		m.removedcreated_analysis_jobs[ids[i]] = struct{}{}
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RateLimitBucketMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedCreatedAnalysisJobs returns the removed IDs of the "created_analysis_jobs" edge to the AnalysisJob entity.
-func (m *DoctorMutation) RemovedCreatedAnalysisJobsIDs() (ids []uuid.UUID) {
-	for id := range m.removedcreated_analysis_jobs {
-		ids = append(ids, id)
+// OldUpdatedAt returns the old "updated_at" field's value of the RateLimitBucket entity.
+// If the RateLimitBucket object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RateLimitBucketMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// CreatedAnalysisJobsIDs returns the "created_analysis_jobs" edge IDs in the mutation.
-func (m *DoctorMutation) CreatedAnalysisJobsIDs() (ids []uuid.UUID) {
-	for id := range m.created_analysis_jobs {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetCreatedAnalysisJobs resets all changes to the "created_analysis_jobs" edge.
-func (m *DoctorMutation) ResetCreatedAnalysisJobs() {
-	m.created_analysis_jobs = nil
-	m.clearedcreated_analysis_jobs = false
-	m.removedcreated_analysis_jobs = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RateLimitBucketMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the DoctorMutation builder.
-func (m *DoctorMutation) Where(ps ...predicate.Doctor) {
+// Where appends a list predicates to the RateLimitBucketMutation builder.
+func (m *RateLimitBucketMutation) Where(ps ...predicate.RateLimitBucket) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DoctorMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RateLimitBucketMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DoctorMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Doctor, len(ps))
+func (m *RateLimitBucketMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RateLimitBucket, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2779,45 +18815,33 @@ func (m *DoctorMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DoctorMutation) Op() Op {
+func (m *RateLimitBucketMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DoctorMutation) SetOp(op Op) {
+func (m *RateLimitBucketMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Doctor).
-func (m *DoctorMutation) Type() string {
+// Type returns the node type of this mutation (RateLimitBucket).
+func (m *RateLimitBucketMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DoctorMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.created_at != nil {
-		fields = append(fields, doctor.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, doctor.FieldUpdatedAt)
-	}
-	if m.email != nil {
-		fields = append(fields, doctor.FieldEmail)
-	}
-	if m.display_name != nil {
-		fields = append(fields, doctor.FieldDisplayName)
-	}
-	if m.password_hash != nil {
-		fields = append(fields, doctor.FieldPasswordHash)
+func (m *RateLimitBucketMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.tokens != nil {
+		fields = append(fields, ratelimitbucket.FieldTokens)
 	}
-	if m.role != nil {
-		fields = append(fields, doctor.FieldRole)
+	if m.allowed != nil {
+		fields = append(fields, ratelimitbucket.FieldAllowed)
 	}
-	if m.practice != nil {
-		fields = append(fields, doctor.FieldPracticeID)
+	if m.updated_at != nil {
+		fields = append(fields, ratelimitbucket.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2825,22 +18849,14 @@ func (m *DoctorMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DoctorMutation) Field(name string) (ent.Value, bool) {
+func (m *RateLimitBucketMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case doctor.FieldCreatedAt:
-		return m.CreatedAt()
-	case doctor.FieldUpdatedAt:
+	case ratelimitbucket.FieldTokens:
+		return m.Tokens()
+	case ratelimitbucket.FieldAllowed:
+		return m.Allowed()
+	case ratelimitbucket.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case doctor.FieldEmail:
-		return m.Email()
-	case doctor.FieldDisplayName:
-		return m.DisplayName()
-	case doctor.FieldPasswordHash:
-		return m.PasswordHash()
-	case doctor.FieldRole:
-		return m.Role()
-	case doctor.FieldPracticeID:
-		return m.PracticeID()
 	}
 	return nil, false
 }
@@ -2848,429 +18864,204 @@ func (m *DoctorMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DoctorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RateLimitBucketMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case doctor.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case doctor.FieldUpdatedAt:
+	case ratelimitbucket.FieldTokens:
+		return m.OldTokens(ctx)
+	case ratelimitbucket.FieldAllowed:
+		return m.OldAllowed(ctx)
+	case ratelimitbucket.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case doctor.FieldEmail:
-		return m.OldEmail(ctx)
-	case doctor.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case doctor.FieldPasswordHash:
-		return m.OldPasswordHash(ctx)
-	case doctor.FieldRole:
-		return m.OldRole(ctx)
-	case doctor.FieldPracticeID:
-		return m.OldPracticeID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Doctor field %s", name)
+	return nil, fmt.Errorf("unknown RateLimitBucket field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DoctorMutation) SetField(name string, value ent.Value) error {
+func (m *RateLimitBucketMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case doctor.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case doctor.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case doctor.FieldEmail:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmail(v)
-		return nil
-	case doctor.FieldDisplayName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDisplayName(v)
-		return nil
-	case doctor.FieldPasswordHash:
-		v, ok := value.(string)
+	case ratelimitbucket.FieldTokens:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPasswordHash(v)
+		m.SetTokens(v)
 		return nil
-	case doctor.FieldRole:
-		v, ok := value.(doctor.Role)
+	case ratelimitbucket.FieldAllowed:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRole(v)
+		m.SetAllowed(v)
 		return nil
-	case doctor.FieldPracticeID:
-		v, ok := value.(uuid.UUID)
+	case ratelimitbucket.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPracticeID(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Doctor field %s", name)
+	return fmt.Errorf("unknown RateLimitBucket field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DoctorMutation) AddedFields() []string {
-	return nil
+func (m *RateLimitBucketMutation) AddedFields() []string {
+	var fields []string
+	if m.addtokens != nil {
+		fields = append(fields, ratelimitbucket.FieldTokens)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DoctorMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RateLimitBucketMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ratelimitbucket.FieldTokens:
+		return m.AddedTokens()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DoctorMutation) AddField(name string, value ent.Value) error {
+func (m *RateLimitBucketMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case ratelimitbucket.FieldTokens:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTokens(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Doctor numeric field %s", name)
+	return fmt.Errorf("unknown RateLimitBucket numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DoctorMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(doctor.FieldPracticeID) {
-		fields = append(fields, doctor.FieldPracticeID)
-	}
-	return fields
+func (m *RateLimitBucketMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DoctorMutation) FieldCleared(name string) bool {
+func (m *RateLimitBucketMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DoctorMutation) ClearField(name string) error {
-	switch name {
-	case doctor.FieldPracticeID:
-		m.ClearPracticeID()
-		return nil
-	}
-	return fmt.Errorf("unknown Doctor nullable field %s", name)
+func (m *RateLimitBucketMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RateLimitBucket nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DoctorMutation) ResetField(name string) error {
+func (m *RateLimitBucketMutation) ResetField(name string) error {
 	switch name {
-	case doctor.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case doctor.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case ratelimitbucket.FieldTokens:
+		m.ResetTokens()
 		return nil
-	case doctor.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case doctor.FieldDisplayName:
-		m.ResetDisplayName()
-		return nil
-	case doctor.FieldPasswordHash:
-		m.ResetPasswordHash()
+	case ratelimitbucket.FieldAllowed:
+		m.ResetAllowed()
 		return nil
-	case doctor.FieldRole:
-		m.ResetRole()
-		return nil
-	case doctor.FieldPracticeID:
-		m.ResetPracticeID()
+	case ratelimitbucket.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Doctor field %s", name)
+	return fmt.Errorf("unknown RateLimitBucket field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DoctorMutation) AddedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.practice != nil {
-		edges = append(edges, doctor.EdgePractice)
-	}
-	if m.patient_links != nil {
-		edges = append(edges, doctor.EdgePatientLinks)
-	}
-	if m.pairing_codes != nil {
-		edges = append(edges, doctor.EdgePairingCodes)
-	}
-	if m.approved_patient_links != nil {
-		edges = append(edges, doctor.EdgeApprovedPatientLinks)
-	}
-	if m.entry_shares != nil {
-		edges = append(edges, doctor.EdgeEntryShares)
-	}
-	if m.comments != nil {
-		edges = append(edges, doctor.EdgeComments)
-	}
-	if m.created_analysis_jobs != nil {
-		edges = append(edges, doctor.EdgeCreatedAnalysisJobs)
-	}
+func (m *RateLimitBucketMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DoctorMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case doctor.EdgePractice:
-		if id := m.practice; id != nil {
-			return []ent.Value{*id}
-		}
-	case doctor.EdgePatientLinks:
-		ids := make([]ent.Value, 0, len(m.patient_links))
-		for id := range m.patient_links {
-			ids = append(ids, id)
-		}
-		return ids
-	case doctor.EdgePairingCodes:
-		ids := make([]ent.Value, 0, len(m.pairing_codes))
-		for id := range m.pairing_codes {
-			ids = append(ids, id)
-		}
-		return ids
-	case doctor.EdgeApprovedPatientLinks:
-		ids := make([]ent.Value, 0, len(m.approved_patient_links))
-		for id := range m.approved_patient_links {
-			ids = append(ids, id)
-		}
-		return ids
-	case doctor.EdgeEntryShares:
-		ids := make([]ent.Value, 0, len(m.entry_shares))
-		for id := range m.entry_shares {
-			ids = append(ids, id)
-		}
-		return ids
-	case doctor.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.comments))
-		for id := range m.comments {
-			ids = append(ids, id)
-		}
-		return ids
-	case doctor.EdgeCreatedAnalysisJobs:
-		ids := make([]ent.Value, 0, len(m.created_analysis_jobs))
-		for id := range m.created_analysis_jobs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *RateLimitBucketMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DoctorMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.removedpatient_links != nil {
-		edges = append(edges, doctor.EdgePatientLinks)
-	}
-	if m.removedpairing_codes != nil {
-		edges = append(edges, doctor.EdgePairingCodes)
-	}
-	if m.removedapproved_patient_links != nil {
-		edges = append(edges, doctor.EdgeApprovedPatientLinks)
-	}
-	if m.removedentry_shares != nil {
-		edges = append(edges, doctor.EdgeEntryShares)
-	}
-	if m.removedcomments != nil {
-		edges = append(edges, doctor.EdgeComments)
-	}
-	if m.removedcreated_analysis_jobs != nil {
-		edges = append(edges, doctor.EdgeCreatedAnalysisJobs)
-	}
+func (m *RateLimitBucketMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DoctorMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case doctor.EdgePatientLinks:
-		ids := make([]ent.Value, 0, len(m.removedpatient_links))
-		for id := range m.removedpatient_links {
-			ids = append(ids, id)
-		}
-		return ids
-	case doctor.EdgePairingCodes:
-		ids := make([]ent.Value, 0, len(m.removedpairing_codes))
-		for id := range m.removedpairing_codes {
-			ids = append(ids, id)
-		}
-		return ids
-	case doctor.EdgeApprovedPatientLinks:
-		ids := make([]ent.Value, 0, len(m.removedapproved_patient_links))
-		for id := range m.removedapproved_patient_links {
-			ids = append(ids, id)
-		}
-		return ids
-	case doctor.EdgeEntryShares:
-		ids := make([]ent.Value, 0, len(m.removedentry_shares))
-		for id := range m.removedentry_shares {
-			ids = append(ids, id)
-		}
-		return ids
-	case doctor.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.removedcomments))
-		for id := range m.removedcomments {
-			ids = append(ids, id)
-		}
-		return ids
-	case doctor.EdgeCreatedAnalysisJobs:
-		ids := make([]ent.Value, 0, len(m.removedcreated_analysis_jobs))
-		for id := range m.removedcreated_analysis_jobs {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *RateLimitBucketMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DoctorMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.clearedpractice {
-		edges = append(edges, doctor.EdgePractice)
-	}
-	if m.clearedpatient_links {
-		edges = append(edges, doctor.EdgePatientLinks)
-	}
-	if m.clearedpairing_codes {
-		edges = append(edges, doctor.EdgePairingCodes)
-	}
-	if m.clearedapproved_patient_links {
-		edges = append(edges, doctor.EdgeApprovedPatientLinks)
-	}
-	if m.clearedentry_shares {
-		edges = append(edges, doctor.EdgeEntryShares)
-	}
-	if m.clearedcomments {
-		edges = append(edges, doctor.EdgeComments)
-	}
-	if m.clearedcreated_analysis_jobs {
-		edges = append(edges, doctor.EdgeCreatedAnalysisJobs)
-	}
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *DoctorMutation) EdgeCleared(name string) bool {
-	switch name {
-	case doctor.EdgePractice:
-		return m.clearedpractice
-	case doctor.EdgePatientLinks:
-		return m.clearedpatient_links
-	case doctor.EdgePairingCodes:
-		return m.clearedpairing_codes
-	case doctor.EdgeApprovedPatientLinks:
-		return m.clearedapproved_patient_links
-	case doctor.EdgeEntryShares:
-		return m.clearedentry_shares
-	case doctor.EdgeComments:
-		return m.clearedcomments
-	case doctor.EdgeCreatedAnalysisJobs:
-		return m.clearedcreated_analysis_jobs
-	}
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *DoctorMutation) ClearEdge(name string) error {
-	switch name {
-	case doctor.EdgePractice:
-		m.ClearPractice()
-		return nil
-	}
-	return fmt.Errorf("unknown Doctor unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *DoctorMutation) ResetEdge(name string) error {
-	switch name {
-	case doctor.EdgePractice:
-		m.ResetPractice()
-		return nil
-	case doctor.EdgePatientLinks:
-		m.ResetPatientLinks()
-		return nil
-	case doctor.EdgePairingCodes:
-		m.ResetPairingCodes()
-		return nil
-	case doctor.EdgeApprovedPatientLinks:
-		m.ResetApprovedPatientLinks()
-		return nil
-	case doctor.EdgeEntryShares:
-		m.ResetEntryShares()
-		return nil
-	case doctor.EdgeComments:
-		m.ResetComments()
-		return nil
-	case doctor.EdgeCreatedAnalysisJobs:
-		m.ResetCreatedAnalysisJobs()
-		return nil
-	}
-	return fmt.Errorf("unknown Doctor edge %s", name)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RateLimitBucketMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// DoctorPatientLinkMutation represents an operation that mutates the DoctorPatientLink nodes in the graph.
-type DoctorPatientLinkMutation struct {
-	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	created_at         *time.Time
-	updated_at         *time.Time
-	status             *doctorpatientlink.Status
-	requested_at       *time.Time
-	approved_at        *time.Time
-	clearedFields      map[string]struct{}
-	doctor             *uuid.UUID
-	cleareddoctor      bool
-	patient            *uuid.UUID
-	clearedpatient     bool
-	approved_by        *uuid.UUID
-	clearedapproved_by bool
-	done               bool
-	oldValue           func(context.Context) (*DoctorPatientLink, error)
-	predicates         []predicate.DoctorPatientLink
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RateLimitBucketMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-var _ ent.Mutation = (*DoctorPatientLinkMutation)(nil)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RateLimitBucketMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RateLimitBucket unique edge %s", name)
+}
 
-// doctorpatientlinkOption allows management of the mutation configuration using functional options.
-type doctorpatientlinkOption func(*DoctorPatientLinkMutation)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RateLimitBucketMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RateLimitBucket edge %s", name)
+}
 
-// newDoctorPatientLinkMutation creates new mutation for the DoctorPatientLink entity.
-func newDoctorPatientLinkMutation(c config, op Op, opts ...doctorpatientlinkOption) *DoctorPatientLinkMutation {
-	m := &DoctorPatientLinkMutation{
+// ReminderDeliveryMutation represents an operation that mutates the ReminderDelivery nodes in the graph.
+type ReminderDeliveryMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	created_at      *time.Time
+	updated_at      *time.Time
+	kind            *reminderdelivery.Kind
+	channel         *reminderdelivery.Channel
+	scheduled_for   *time.Time
+	status          *reminderdelivery.Status
+	sent_at         *time.Time
+	error           *string
+	clearedFields   map[string]struct{}
+	schedule        *uuid.UUID
+	clearedschedule bool
+	patient         *uuid.UUID
+	clearedpatient  bool
+	done            bool
+	oldValue        func(context.Context) (*ReminderDelivery, error)
+	predicates      []predicate.ReminderDelivery
+}
+
+var _ ent.Mutation = (*ReminderDeliveryMutation)(nil)
+
+// reminderdeliveryOption allows management of the mutation configuration using functional options.
+type reminderdeliveryOption func(*ReminderDeliveryMutation)
+
+// newReminderDeliveryMutation creates new mutation for the ReminderDelivery entity.
+func newReminderDeliveryMutation(c config, op Op, opts ...reminderdeliveryOption) *ReminderDeliveryMutation {
+	m := &ReminderDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDoctorPatientLink,
+		typ:           TypeReminderDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3279,20 +19070,20 @@ func newDoctorPatientLinkMutation(c config, op Op, opts ...doctorpatientlinkOpti
 	return m
 }
 
-// withDoctorPatientLinkID sets the ID field of the mutation.
-func withDoctorPatientLinkID(id uuid.UUID) doctorpatientlinkOption {
-	return func(m *DoctorPatientLinkMutation) {
+// withReminderDeliveryID sets the ID field of the mutation.
+func withReminderDeliveryID(id uuid.UUID) reminderdeliveryOption {
+	return func(m *ReminderDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DoctorPatientLink
+			value *ReminderDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*DoctorPatientLink, error) {
+		m.oldValue = func(ctx context.Context) (*ReminderDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DoctorPatientLink.Get(ctx, id)
+					value, err = m.Client().ReminderDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3301,10 +19092,10 @@ func withDoctorPatientLinkID(id uuid.UUID) doctorpatientlinkOption {
 	}
 }
 
-// withDoctorPatientLink sets the old DoctorPatientLink of the mutation.
-func withDoctorPatientLink(node *DoctorPatientLink) doctorpatientlinkOption {
-	return func(m *DoctorPatientLinkMutation) {
-		m.oldValue = func(context.Context) (*DoctorPatientLink, error) {
+// withReminderDelivery sets the old ReminderDelivery of the mutation.
+func withReminderDelivery(node *ReminderDelivery) reminderdeliveryOption {
+	return func(m *ReminderDeliveryMutation) {
+		m.oldValue = func(context.Context) (*ReminderDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3313,7 +19104,7 @@ func withDoctorPatientLink(node *DoctorPatientLink) doctorpatientlinkOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DoctorPatientLinkMutation) Client() *Client {
+func (m ReminderDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3321,7 +19112,7 @@ func (m DoctorPatientLinkMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DoctorPatientLinkMutation) Tx() (*Tx, error) {
+func (m ReminderDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3331,14 +19122,14 @@ func (m DoctorPatientLinkMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DoctorPatientLink entities.
-func (m *DoctorPatientLinkMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ReminderDelivery entities.
+func (m *ReminderDeliveryMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DoctorPatientLinkMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ReminderDeliveryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3349,7 +19140,7 @@ func (m *DoctorPatientLinkMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DoctorPatientLinkMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ReminderDeliveryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3358,19 +19149,19 @@ func (m *DoctorPatientLinkMutation) IDs(ctx context.Context) ([]uuid.UUID, error
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DoctorPatientLink.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ReminderDelivery.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *DoctorPatientLinkMutation) SetCreatedAt(t time.Time) {
+func (m *ReminderDeliveryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DoctorPatientLinkMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ReminderDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3378,10 +19169,10 @@ func (m *DoctorPatientLinkMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DoctorPatientLink entity.
-// If the DoctorPatientLink object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ReminderDelivery entity.
+// If the ReminderDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorPatientLinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReminderDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3396,17 +19187,17 @@ func (m *DoctorPatientLinkMutation) OldCreatedAt(ctx context.Context) (v time.Ti
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DoctorPatientLinkMutation) ResetCreatedAt() {
+func (m *ReminderDeliveryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *DoctorPatientLinkMutation) SetUpdatedAt(t time.Time) {
+func (m *ReminderDeliveryMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DoctorPatientLinkMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ReminderDeliveryMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3414,10 +19205,10 @@ func (m *DoctorPatientLinkMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the DoctorPatientLink entity.
-// If the DoctorPatientLink object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ReminderDelivery entity.
+// If the ReminderDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorPatientLinkMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReminderDeliveryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3432,53 +19223,53 @@ func (m *DoctorPatientLinkMutation) OldUpdatedAt(ctx context.Context) (v time.Ti
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DoctorPatientLinkMutation) ResetUpdatedAt() {
+func (m *ReminderDeliveryMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetDoctorID sets the "doctor_id" field.
-func (m *DoctorPatientLinkMutation) SetDoctorID(u uuid.UUID) {
-	m.doctor = &u
+// SetScheduleID sets the "schedule_id" field.
+func (m *ReminderDeliveryMutation) SetScheduleID(u uuid.UUID) {
+	m.schedule = &u
 }
 
-// DoctorID returns the value of the "doctor_id" field in the mutation.
-func (m *DoctorPatientLinkMutation) DoctorID() (r uuid.UUID, exists bool) {
-	v := m.doctor
+// ScheduleID returns the value of the "schedule_id" field in the mutation.
+func (m *ReminderDeliveryMutation) ScheduleID() (r uuid.UUID, exists bool) {
+	v := m.schedule
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDoctorID returns the old "doctor_id" field's value of the DoctorPatientLink entity.
-// If the DoctorPatientLink object wasn't provided to the builder, the object is fetched from the database.
+// OldScheduleID returns the old "schedule_id" field's value of the ReminderDelivery entity.
+// If the ReminderDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorPatientLinkMutation) OldDoctorID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ReminderDeliveryMutation) OldScheduleID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDoctorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldScheduleID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDoctorID requires an ID field in the mutation")
+		return v, errors.New("OldScheduleID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDoctorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldScheduleID: %w", err)
 	}
-	return oldValue.DoctorID, nil
+	return oldValue.ScheduleID, nil
 }
 
-// ResetDoctorID resets all changes to the "doctor_id" field.
-func (m *DoctorPatientLinkMutation) ResetDoctorID() {
-	m.doctor = nil
+// ResetScheduleID resets all changes to the "schedule_id" field.
+func (m *ReminderDeliveryMutation) ResetScheduleID() {
+	m.schedule = nil
 }
 
 // SetPatientID sets the "patient_id" field.
-func (m *DoctorPatientLinkMutation) SetPatientID(u uuid.UUID) {
+func (m *ReminderDeliveryMutation) SetPatientID(u uuid.UUID) {
 	m.patient = &u
 }
 
 // PatientID returns the value of the "patient_id" field in the mutation.
-func (m *DoctorPatientLinkMutation) PatientID() (r uuid.UUID, exists bool) {
+func (m *ReminderDeliveryMutation) PatientID() (r uuid.UUID, exists bool) {
 	v := m.patient
 	if v == nil {
 		return
@@ -3486,10 +19277,10 @@ func (m *DoctorPatientLinkMutation) PatientID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldPatientID returns the old "patient_id" field's value of the DoctorPatientLink entity.
-// If the DoctorPatientLink object wasn't provided to the builder, the object is fetched from the database.
+// OldPatientID returns the old "patient_id" field's value of the ReminderDelivery entity.
+// If the ReminderDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorPatientLinkMutation) OldPatientID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ReminderDeliveryMutation) OldPatientID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldPatientID is only allowed on UpdateOne operations")
 	}
@@ -3504,283 +19295,315 @@ func (m *DoctorPatientLinkMutation) OldPatientID(ctx context.Context) (v uuid.UU
 }
 
 // ResetPatientID resets all changes to the "patient_id" field.
-func (m *DoctorPatientLinkMutation) ResetPatientID() {
+func (m *ReminderDeliveryMutation) ResetPatientID() {
 	m.patient = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *DoctorPatientLinkMutation) SetStatus(d doctorpatientlink.Status) {
-	m.status = &d
+// SetKind sets the "kind" field.
+func (m *ReminderDeliveryMutation) SetKind(r reminderdelivery.Kind) {
+	m.kind = &r
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *DoctorPatientLinkMutation) Status() (r doctorpatientlink.Status, exists bool) {
-	v := m.status
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ReminderDeliveryMutation) Kind() (r reminderdelivery.Kind, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the DoctorPatientLink entity.
-// If the DoctorPatientLink object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the ReminderDelivery entity.
+// If the ReminderDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorPatientLinkMutation) OldStatus(ctx context.Context) (v doctorpatientlink.Status, err error) {
+func (m *ReminderDeliveryMutation) OldKind(ctx context.Context) (v reminderdelivery.Kind, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Kind, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *DoctorPatientLinkMutation) ResetStatus() {
-	m.status = nil
+// ResetKind resets all changes to the "kind" field.
+func (m *ReminderDeliveryMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetRequestedAt sets the "requested_at" field.
-func (m *DoctorPatientLinkMutation) SetRequestedAt(t time.Time) {
-	m.requested_at = &t
+// SetChannel sets the "channel" field.
+func (m *ReminderDeliveryMutation) SetChannel(r reminderdelivery.Channel) {
+	m.channel = &r
 }
 
-// RequestedAt returns the value of the "requested_at" field in the mutation.
-func (m *DoctorPatientLinkMutation) RequestedAt() (r time.Time, exists bool) {
-	v := m.requested_at
+// Channel returns the value of the "channel" field in the mutation.
+func (m *ReminderDeliveryMutation) Channel() (r reminderdelivery.Channel, exists bool) {
+	v := m.channel
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRequestedAt returns the old "requested_at" field's value of the DoctorPatientLink entity.
-// If the DoctorPatientLink object wasn't provided to the builder, the object is fetched from the database.
+// OldChannel returns the old "channel" field's value of the ReminderDelivery entity.
+// If the ReminderDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorPatientLinkMutation) OldRequestedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReminderDeliveryMutation) OldChannel(ctx context.Context) (v reminderdelivery.Channel, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRequestedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldChannel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRequestedAt requires an ID field in the mutation")
+		return v, errors.New("OldChannel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRequestedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldChannel: %w", err)
 	}
-	return oldValue.RequestedAt, nil
+	return oldValue.Channel, nil
 }
 
-// ResetRequestedAt resets all changes to the "requested_at" field.
-func (m *DoctorPatientLinkMutation) ResetRequestedAt() {
-	m.requested_at = nil
+// ResetChannel resets all changes to the "channel" field.
+func (m *ReminderDeliveryMutation) ResetChannel() {
+	m.channel = nil
 }
 
-// SetApprovedAt sets the "approved_at" field.
-func (m *DoctorPatientLinkMutation) SetApprovedAt(t time.Time) {
-	m.approved_at = &t
+// SetScheduledFor sets the "scheduled_for" field.
+func (m *ReminderDeliveryMutation) SetScheduledFor(t time.Time) {
+	m.scheduled_for = &t
 }
 
-// ApprovedAt returns the value of the "approved_at" field in the mutation.
-func (m *DoctorPatientLinkMutation) ApprovedAt() (r time.Time, exists bool) {
-	v := m.approved_at
+// ScheduledFor returns the value of the "scheduled_for" field in the mutation.
+func (m *ReminderDeliveryMutation) ScheduledFor() (r time.Time, exists bool) {
+	v := m.scheduled_for
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldApprovedAt returns the old "approved_at" field's value of the DoctorPatientLink entity.
-// If the DoctorPatientLink object wasn't provided to the builder, the object is fetched from the database.
+// OldScheduledFor returns the old "scheduled_for" field's value of the ReminderDelivery entity.
+// If the ReminderDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorPatientLinkMutation) OldApprovedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ReminderDeliveryMutation) OldScheduledFor(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldApprovedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldScheduledFor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldApprovedAt requires an ID field in the mutation")
+		return v, errors.New("OldScheduledFor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldApprovedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldScheduledFor: %w", err)
 	}
-	return oldValue.ApprovedAt, nil
-}
-
-// ClearApprovedAt clears the value of the "approved_at" field.
-func (m *DoctorPatientLinkMutation) ClearApprovedAt() {
-	m.approved_at = nil
-	m.clearedFields[doctorpatientlink.FieldApprovedAt] = struct{}{}
-}
-
-// ApprovedAtCleared returns if the "approved_at" field was cleared in this mutation.
-func (m *DoctorPatientLinkMutation) ApprovedAtCleared() bool {
-	_, ok := m.clearedFields[doctorpatientlink.FieldApprovedAt]
-	return ok
+	return oldValue.ScheduledFor, nil
 }
 
-// ResetApprovedAt resets all changes to the "approved_at" field.
-func (m *DoctorPatientLinkMutation) ResetApprovedAt() {
-	m.approved_at = nil
-	delete(m.clearedFields, doctorpatientlink.FieldApprovedAt)
+// ResetScheduledFor resets all changes to the "scheduled_for" field.
+func (m *ReminderDeliveryMutation) ResetScheduledFor() {
+	m.scheduled_for = nil
 }
 
-// SetApprovedByDoctorID sets the "approved_by_doctor_id" field.
-func (m *DoctorPatientLinkMutation) SetApprovedByDoctorID(u uuid.UUID) {
-	m.approved_by = &u
+// SetStatus sets the "status" field.
+func (m *ReminderDeliveryMutation) SetStatus(r reminderdelivery.Status) {
+	m.status = &r
 }
 
-// ApprovedByDoctorID returns the value of the "approved_by_doctor_id" field in the mutation.
-func (m *DoctorPatientLinkMutation) ApprovedByDoctorID() (r uuid.UUID, exists bool) {
-	v := m.approved_by
+// Status returns the value of the "status" field in the mutation.
+func (m *ReminderDeliveryMutation) Status() (r reminderdelivery.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldApprovedByDoctorID returns the old "approved_by_doctor_id" field's value of the DoctorPatientLink entity.
-// If the DoctorPatientLink object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the ReminderDelivery entity.
+// If the ReminderDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DoctorPatientLinkMutation) OldApprovedByDoctorID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *ReminderDeliveryMutation) OldStatus(ctx context.Context) (v reminderdelivery.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldApprovedByDoctorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldApprovedByDoctorID requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldApprovedByDoctorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.ApprovedByDoctorID, nil
+	return oldValue.Status, nil
 }
 
-// ClearApprovedByDoctorID clears the value of the "approved_by_doctor_id" field.
-func (m *DoctorPatientLinkMutation) ClearApprovedByDoctorID() {
-	m.approved_by = nil
-	m.clearedFields[doctorpatientlink.FieldApprovedByDoctorID] = struct{}{}
+// ResetStatus resets all changes to the "status" field.
+func (m *ReminderDeliveryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// ApprovedByDoctorIDCleared returns if the "approved_by_doctor_id" field was cleared in this mutation.
-func (m *DoctorPatientLinkMutation) ApprovedByDoctorIDCleared() bool {
-	_, ok := m.clearedFields[doctorpatientlink.FieldApprovedByDoctorID]
-	return ok
+// SetSentAt sets the "sent_at" field.
+func (m *ReminderDeliveryMutation) SetSentAt(t time.Time) {
+	m.sent_at = &t
 }
 
-// ResetApprovedByDoctorID resets all changes to the "approved_by_doctor_id" field.
-func (m *DoctorPatientLinkMutation) ResetApprovedByDoctorID() {
-	m.approved_by = nil
-	delete(m.clearedFields, doctorpatientlink.FieldApprovedByDoctorID)
+// SentAt returns the value of the "sent_at" field in the mutation.
+func (m *ReminderDeliveryMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearDoctor clears the "doctor" edge to the Doctor entity.
-func (m *DoctorPatientLinkMutation) ClearDoctor() {
-	m.cleareddoctor = true
-	m.clearedFields[doctorpatientlink.FieldDoctorID] = struct{}{}
+// OldSentAt returns the old "sent_at" field's value of the ReminderDelivery entity.
+// If the ReminderDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReminderDeliveryMutation) OldSentAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
 }
 
-// DoctorCleared reports if the "doctor" edge to the Doctor entity was cleared.
-func (m *DoctorPatientLinkMutation) DoctorCleared() bool {
-	return m.cleareddoctor
+// ClearSentAt clears the value of the "sent_at" field.
+func (m *ReminderDeliveryMutation) ClearSentAt() {
+	m.sent_at = nil
+	m.clearedFields[reminderdelivery.FieldSentAt] = struct{}{}
 }
 
-// DoctorIDs returns the "doctor" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// DoctorID instead. It exists only for internal usage by the builders.
-func (m *DoctorPatientLinkMutation) DoctorIDs() (ids []uuid.UUID) {
-	if id := m.doctor; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SentAtCleared returns if the "sent_at" field was cleared in this mutation.
+func (m *ReminderDeliveryMutation) SentAtCleared() bool {
+	_, ok := m.clearedFields[reminderdelivery.FieldSentAt]
+	return ok
 }
 
-// ResetDoctor resets all changes to the "doctor" edge.
-func (m *DoctorPatientLinkMutation) ResetDoctor() {
-	m.doctor = nil
-	m.cleareddoctor = false
+// ResetSentAt resets all changes to the "sent_at" field.
+func (m *ReminderDeliveryMutation) ResetSentAt() {
+	m.sent_at = nil
+	delete(m.clearedFields, reminderdelivery.FieldSentAt)
 }
 
-// ClearPatient clears the "patient" edge to the Patient entity.
-func (m *DoctorPatientLinkMutation) ClearPatient() {
-	m.clearedpatient = true
-	m.clearedFields[doctorpatientlink.FieldPatientID] = struct{}{}
+// SetError sets the "error" field.
+func (m *ReminderDeliveryMutation) SetError(s string) {
+	m.error = &s
 }
 
-// PatientCleared reports if the "patient" edge to the Patient entity was cleared.
-func (m *DoctorPatientLinkMutation) PatientCleared() bool {
-	return m.clearedpatient
+// Error returns the value of the "error" field in the mutation.
+func (m *ReminderDeliveryMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// PatientIDs returns the "patient" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PatientID instead. It exists only for internal usage by the builders.
-func (m *DoctorPatientLinkMutation) PatientIDs() (ids []uuid.UUID) {
-	if id := m.patient; id != nil {
-		ids = append(ids, *id)
+// OldError returns the old "error" field's value of the ReminderDelivery entity.
+// If the ReminderDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReminderDeliveryMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
 }
 
-// ResetPatient resets all changes to the "patient" edge.
-func (m *DoctorPatientLinkMutation) ResetPatient() {
-	m.patient = nil
-	m.clearedpatient = false
+// ClearError clears the value of the "error" field.
+func (m *ReminderDeliveryMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[reminderdelivery.FieldError] = struct{}{}
 }
 
-// SetApprovedByID sets the "approved_by" edge to the Doctor entity by id.
-func (m *DoctorPatientLinkMutation) SetApprovedByID(id uuid.UUID) {
-	m.approved_by = &id
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *ReminderDeliveryMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[reminderdelivery.FieldError]
+	return ok
 }
 
-// ClearApprovedBy clears the "approved_by" edge to the Doctor entity.
-func (m *DoctorPatientLinkMutation) ClearApprovedBy() {
-	m.clearedapproved_by = true
-	m.clearedFields[doctorpatientlink.FieldApprovedByDoctorID] = struct{}{}
+// ResetError resets all changes to the "error" field.
+func (m *ReminderDeliveryMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, reminderdelivery.FieldError)
 }
 
-// ApprovedByCleared reports if the "approved_by" edge to the Doctor entity was cleared.
-func (m *DoctorPatientLinkMutation) ApprovedByCleared() bool {
-	return m.ApprovedByDoctorIDCleared() || m.clearedapproved_by
+// ClearSchedule clears the "schedule" edge to the ReminderSchedule entity.
+func (m *ReminderDeliveryMutation) ClearSchedule() {
+	m.clearedschedule = true
+	m.clearedFields[reminderdelivery.FieldScheduleID] = struct{}{}
 }
 
-// ApprovedByID returns the "approved_by" edge ID in the mutation.
-func (m *DoctorPatientLinkMutation) ApprovedByID() (id uuid.UUID, exists bool) {
-	if m.approved_by != nil {
-		return *m.approved_by, true
+// ScheduleCleared reports if the "schedule" edge to the ReminderSchedule entity was cleared.
+func (m *ReminderDeliveryMutation) ScheduleCleared() bool {
+	return m.clearedschedule
+}
+
+// ScheduleIDs returns the "schedule" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ScheduleID instead. It exists only for internal usage by the builders.
+func (m *ReminderDeliveryMutation) ScheduleIDs() (ids []uuid.UUID) {
+	if id := m.schedule; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ApprovedByIDs returns the "approved_by" edge IDs in the mutation.
+// ResetSchedule resets all changes to the "schedule" edge.
+func (m *ReminderDeliveryMutation) ResetSchedule() {
+	m.schedule = nil
+	m.clearedschedule = false
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (m *ReminderDeliveryMutation) ClearPatient() {
+	m.clearedpatient = true
+	m.clearedFields[reminderdelivery.FieldPatientID] = struct{}{}
+}
+
+// PatientCleared reports if the "patient" edge to the Patient entity was cleared.
+func (m *ReminderDeliveryMutation) PatientCleared() bool {
+	return m.clearedpatient
+}
+
+// PatientIDs returns the "patient" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ApprovedByID instead. It exists only for internal usage by the builders.
-func (m *DoctorPatientLinkMutation) ApprovedByIDs() (ids []uuid.UUID) {
-	if id := m.approved_by; id != nil {
+// PatientID instead. It exists only for internal usage by the builders.
+func (m *ReminderDeliveryMutation) PatientIDs() (ids []uuid.UUID) {
+	if id := m.patient; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetApprovedBy resets all changes to the "approved_by" edge.
-func (m *DoctorPatientLinkMutation) ResetApprovedBy() {
-	m.approved_by = nil
-	m.clearedapproved_by = false
+// ResetPatient resets all changes to the "patient" edge.
+func (m *ReminderDeliveryMutation) ResetPatient() {
+	m.patient = nil
+	m.clearedpatient = false
 }
 
-// Where appends a list predicates to the DoctorPatientLinkMutation builder.
-func (m *DoctorPatientLinkMutation) Where(ps ...predicate.DoctorPatientLink) {
+// Where appends a list predicates to the ReminderDeliveryMutation builder.
+func (m *ReminderDeliveryMutation) Where(ps ...predicate.ReminderDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DoctorPatientLinkMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ReminderDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DoctorPatientLinkMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DoctorPatientLink, len(ps))
+func (m *ReminderDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReminderDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3788,48 +19611,54 @@ func (m *DoctorPatientLinkMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DoctorPatientLinkMutation) Op() Op {
+func (m *ReminderDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DoctorPatientLinkMutation) SetOp(op Op) {
+func (m *ReminderDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DoctorPatientLink).
-func (m *DoctorPatientLinkMutation) Type() string {
+// Type returns the node type of this mutation (ReminderDelivery).
+func (m *ReminderDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DoctorPatientLinkMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+func (m *ReminderDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.created_at != nil {
-		fields = append(fields, doctorpatientlink.FieldCreatedAt)
+		fields = append(fields, reminderdelivery.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, doctorpatientlink.FieldUpdatedAt)
+		fields = append(fields, reminderdelivery.FieldUpdatedAt)
 	}
-	if m.doctor != nil {
-		fields = append(fields, doctorpatientlink.FieldDoctorID)
+	if m.schedule != nil {
+		fields = append(fields, reminderdelivery.FieldScheduleID)
 	}
 	if m.patient != nil {
-		fields = append(fields, doctorpatientlink.FieldPatientID)
+		fields = append(fields, reminderdelivery.FieldPatientID)
 	}
-	if m.status != nil {
-		fields = append(fields, doctorpatientlink.FieldStatus)
+	if m.kind != nil {
+		fields = append(fields, reminderdelivery.FieldKind)
 	}
-	if m.requested_at != nil {
-		fields = append(fields, doctorpatientlink.FieldRequestedAt)
+	if m.channel != nil {
+		fields = append(fields, reminderdelivery.FieldChannel)
 	}
-	if m.approved_at != nil {
-		fields = append(fields, doctorpatientlink.FieldApprovedAt)
+	if m.scheduled_for != nil {
+		fields = append(fields, reminderdelivery.FieldScheduledFor)
 	}
-	if m.approved_by != nil {
-		fields = append(fields, doctorpatientlink.FieldApprovedByDoctorID)
+	if m.status != nil {
+		fields = append(fields, reminderdelivery.FieldStatus)
+	}
+	if m.sent_at != nil {
+		fields = append(fields, reminderdelivery.FieldSentAt)
+	}
+	if m.error != nil {
+		fields = append(fields, reminderdelivery.FieldError)
 	}
 	return fields
 }
@@ -3837,24 +19666,28 @@ func (m *DoctorPatientLinkMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DoctorPatientLinkMutation) Field(name string) (ent.Value, bool) {
+func (m *ReminderDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case doctorpatientlink.FieldCreatedAt:
+	case reminderdelivery.FieldCreatedAt:
 		return m.CreatedAt()
-	case doctorpatientlink.FieldUpdatedAt:
+	case reminderdelivery.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case doctorpatientlink.FieldDoctorID:
-		return m.DoctorID()
-	case doctorpatientlink.FieldPatientID:
+	case reminderdelivery.FieldScheduleID:
+		return m.ScheduleID()
+	case reminderdelivery.FieldPatientID:
 		return m.PatientID()
-	case doctorpatientlink.FieldStatus:
+	case reminderdelivery.FieldKind:
+		return m.Kind()
+	case reminderdelivery.FieldChannel:
+		return m.Channel()
+	case reminderdelivery.FieldScheduledFor:
+		return m.ScheduledFor()
+	case reminderdelivery.FieldStatus:
 		return m.Status()
-	case doctorpatientlink.FieldRequestedAt:
-		return m.RequestedAt()
-	case doctorpatientlink.FieldApprovedAt:
-		return m.ApprovedAt()
-	case doctorpatientlink.FieldApprovedByDoctorID:
-		return m.ApprovedByDoctorID()
+	case reminderdelivery.FieldSentAt:
+		return m.SentAt()
+	case reminderdelivery.FieldError:
+		return m.Error()
 	}
 	return nil, false
 }
@@ -3862,340 +19695,341 @@ func (m *DoctorPatientLinkMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DoctorPatientLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ReminderDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case doctorpatientlink.FieldCreatedAt:
+	case reminderdelivery.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case doctorpatientlink.FieldUpdatedAt:
+	case reminderdelivery.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case doctorpatientlink.FieldDoctorID:
-		return m.OldDoctorID(ctx)
-	case doctorpatientlink.FieldPatientID:
+	case reminderdelivery.FieldScheduleID:
+		return m.OldScheduleID(ctx)
+	case reminderdelivery.FieldPatientID:
 		return m.OldPatientID(ctx)
-	case doctorpatientlink.FieldStatus:
+	case reminderdelivery.FieldKind:
+		return m.OldKind(ctx)
+	case reminderdelivery.FieldChannel:
+		return m.OldChannel(ctx)
+	case reminderdelivery.FieldScheduledFor:
+		return m.OldScheduledFor(ctx)
+	case reminderdelivery.FieldStatus:
 		return m.OldStatus(ctx)
-	case doctorpatientlink.FieldRequestedAt:
-		return m.OldRequestedAt(ctx)
-	case doctorpatientlink.FieldApprovedAt:
-		return m.OldApprovedAt(ctx)
-	case doctorpatientlink.FieldApprovedByDoctorID:
-		return m.OldApprovedByDoctorID(ctx)
+	case reminderdelivery.FieldSentAt:
+		return m.OldSentAt(ctx)
+	case reminderdelivery.FieldError:
+		return m.OldError(ctx)
 	}
-	return nil, fmt.Errorf("unknown DoctorPatientLink field %s", name)
+	return nil, fmt.Errorf("unknown ReminderDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DoctorPatientLinkMutation) SetField(name string, value ent.Value) error {
+func (m *ReminderDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case doctorpatientlink.FieldCreatedAt:
+	case reminderdelivery.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case doctorpatientlink.FieldUpdatedAt:
+	case reminderdelivery.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case doctorpatientlink.FieldDoctorID:
+	case reminderdelivery.FieldScheduleID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDoctorID(v)
+		m.SetScheduleID(v)
 		return nil
-	case doctorpatientlink.FieldPatientID:
+	case reminderdelivery.FieldPatientID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetPatientID(v)
 		return nil
-	case doctorpatientlink.FieldStatus:
-		v, ok := value.(doctorpatientlink.Status)
+	case reminderdelivery.FieldKind:
+		v, ok := value.(reminderdelivery.Kind)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetKind(v)
 		return nil
-	case doctorpatientlink.FieldRequestedAt:
+	case reminderdelivery.FieldChannel:
+		v, ok := value.(reminderdelivery.Channel)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannel(v)
+		return nil
+	case reminderdelivery.FieldScheduledFor:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRequestedAt(v)
+		m.SetScheduledFor(v)
 		return nil
-	case doctorpatientlink.FieldApprovedAt:
+	case reminderdelivery.FieldStatus:
+		v, ok := value.(reminderdelivery.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case reminderdelivery.FieldSentAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetApprovedAt(v)
+		m.SetSentAt(v)
 		return nil
-	case doctorpatientlink.FieldApprovedByDoctorID:
-		v, ok := value.(uuid.UUID)
+	case reminderdelivery.FieldError:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetApprovedByDoctorID(v)
+		m.SetError(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DoctorPatientLink field %s", name)
+	return fmt.Errorf("unknown ReminderDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DoctorPatientLinkMutation) AddedFields() []string {
+func (m *ReminderDeliveryMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DoctorPatientLinkMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ReminderDeliveryMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DoctorPatientLinkMutation) AddField(name string, value ent.Value) error {
+func (m *ReminderDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown DoctorPatientLink numeric field %s", name)
+	return fmt.Errorf("unknown ReminderDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DoctorPatientLinkMutation) ClearedFields() []string {
+func (m *ReminderDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(doctorpatientlink.FieldApprovedAt) {
-		fields = append(fields, doctorpatientlink.FieldApprovedAt)
+	if m.FieldCleared(reminderdelivery.FieldSentAt) {
+		fields = append(fields, reminderdelivery.FieldSentAt)
 	}
-	if m.FieldCleared(doctorpatientlink.FieldApprovedByDoctorID) {
-		fields = append(fields, doctorpatientlink.FieldApprovedByDoctorID)
+	if m.FieldCleared(reminderdelivery.FieldError) {
+		fields = append(fields, reminderdelivery.FieldError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DoctorPatientLinkMutation) FieldCleared(name string) bool {
+func (m *ReminderDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DoctorPatientLinkMutation) ClearField(name string) error {
+func (m *ReminderDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case doctorpatientlink.FieldApprovedAt:
-		m.ClearApprovedAt()
+	case reminderdelivery.FieldSentAt:
+		m.ClearSentAt()
 		return nil
-	case doctorpatientlink.FieldApprovedByDoctorID:
-		m.ClearApprovedByDoctorID()
+	case reminderdelivery.FieldError:
+		m.ClearError()
 		return nil
 	}
-	return fmt.Errorf("unknown DoctorPatientLink nullable field %s", name)
+	return fmt.Errorf("unknown ReminderDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DoctorPatientLinkMutation) ResetField(name string) error {
+func (m *ReminderDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case doctorpatientlink.FieldCreatedAt:
+	case reminderdelivery.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case doctorpatientlink.FieldUpdatedAt:
+	case reminderdelivery.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case doctorpatientlink.FieldDoctorID:
-		m.ResetDoctorID()
+	case reminderdelivery.FieldScheduleID:
+		m.ResetScheduleID()
 		return nil
-	case doctorpatientlink.FieldPatientID:
+	case reminderdelivery.FieldPatientID:
 		m.ResetPatientID()
 		return nil
-	case doctorpatientlink.FieldStatus:
-		m.ResetStatus()
+	case reminderdelivery.FieldKind:
+		m.ResetKind()
 		return nil
-	case doctorpatientlink.FieldRequestedAt:
-		m.ResetRequestedAt()
+	case reminderdelivery.FieldChannel:
+		m.ResetChannel()
 		return nil
-	case doctorpatientlink.FieldApprovedAt:
-		m.ResetApprovedAt()
+	case reminderdelivery.FieldScheduledFor:
+		m.ResetScheduledFor()
 		return nil
-	case doctorpatientlink.FieldApprovedByDoctorID:
-		m.ResetApprovedByDoctorID()
+	case reminderdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case reminderdelivery.FieldSentAt:
+		m.ResetSentAt()
+		return nil
+	case reminderdelivery.FieldError:
+		m.ResetError()
 		return nil
 	}
-	return fmt.Errorf("unknown DoctorPatientLink field %s", name)
+	return fmt.Errorf("unknown ReminderDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DoctorPatientLinkMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.doctor != nil {
-		edges = append(edges, doctorpatientlink.EdgeDoctor)
+func (m *ReminderDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.schedule != nil {
+		edges = append(edges, reminderdelivery.EdgeSchedule)
 	}
 	if m.patient != nil {
-		edges = append(edges, doctorpatientlink.EdgePatient)
-	}
-	if m.approved_by != nil {
-		edges = append(edges, doctorpatientlink.EdgeApprovedBy)
+		edges = append(edges, reminderdelivery.EdgePatient)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DoctorPatientLinkMutation) AddedIDs(name string) []ent.Value {
+func (m *ReminderDeliveryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case doctorpatientlink.EdgeDoctor:
-		if id := m.doctor; id != nil {
+	case reminderdelivery.EdgeSchedule:
+		if id := m.schedule; id != nil {
 			return []ent.Value{*id}
 		}
-	case doctorpatientlink.EdgePatient:
+	case reminderdelivery.EdgePatient:
 		if id := m.patient; id != nil {
 			return []ent.Value{*id}
 		}
-	case doctorpatientlink.EdgeApprovedBy:
-		if id := m.approved_by; id != nil {
-			return []ent.Value{*id}
-		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DoctorPatientLinkMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *ReminderDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DoctorPatientLinkMutation) RemovedIDs(name string) []ent.Value {
+func (m *ReminderDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DoctorPatientLinkMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.cleareddoctor {
-		edges = append(edges, doctorpatientlink.EdgeDoctor)
+func (m *ReminderDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedschedule {
+		edges = append(edges, reminderdelivery.EdgeSchedule)
 	}
 	if m.clearedpatient {
-		edges = append(edges, doctorpatientlink.EdgePatient)
-	}
-	if m.clearedapproved_by {
-		edges = append(edges, doctorpatientlink.EdgeApprovedBy)
+		edges = append(edges, reminderdelivery.EdgePatient)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DoctorPatientLinkMutation) EdgeCleared(name string) bool {
+func (m *ReminderDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case doctorpatientlink.EdgeDoctor:
-		return m.cleareddoctor
-	case doctorpatientlink.EdgePatient:
+	case reminderdelivery.EdgeSchedule:
+		return m.clearedschedule
+	case reminderdelivery.EdgePatient:
 		return m.clearedpatient
-	case doctorpatientlink.EdgeApprovedBy:
-		return m.clearedapproved_by
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DoctorPatientLinkMutation) ClearEdge(name string) error {
+func (m *ReminderDeliveryMutation) ClearEdge(name string) error {
 	switch name {
-	case doctorpatientlink.EdgeDoctor:
-		m.ClearDoctor()
+	case reminderdelivery.EdgeSchedule:
+		m.ClearSchedule()
 		return nil
-	case doctorpatientlink.EdgePatient:
+	case reminderdelivery.EdgePatient:
 		m.ClearPatient()
 		return nil
-	case doctorpatientlink.EdgeApprovedBy:
-		m.ClearApprovedBy()
-		return nil
 	}
-	return fmt.Errorf("unknown DoctorPatientLink unique edge %s", name)
+	return fmt.Errorf("unknown ReminderDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DoctorPatientLinkMutation) ResetEdge(name string) error {
+func (m *ReminderDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case doctorpatientlink.EdgeDoctor:
-		m.ResetDoctor()
-		return nil
-	case doctorpatientlink.EdgePatient:
-		m.ResetPatient()
-		return nil
-	case doctorpatientlink.EdgeApprovedBy:
-		m.ResetApprovedBy()
+	case reminderdelivery.EdgeSchedule:
+		m.ResetSchedule()
 		return nil
-	}
-	return fmt.Errorf("unknown DoctorPatientLink edge %s", name)
-}
-
-// EntryMutation represents an operation that mutates the Entry nodes in the graph.
-type EntryMutation struct {
-	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	created_at           *time.Time
-	updated_at           *time.Time
-	happened_at          *time.Time
-	situation            *string
-	emotions             *[]schema.Emotion
-	appendemotions       []schema.Emotion
-	triggers             *[]string
-	appendtriggers       []string
-	techniques           *[]string
-	appendtechniques     []string
-	stutter_frequency    *int
-	addstutter_frequency *int
-	notes                *string
-	tags                 *[]string
-	appendtags           []string
-	clearedFields        map[string]struct{}
-	patient              *uuid.UUID
-	clearedpatient       bool
-	shares               map[uuid.UUID]struct{}
-	removedshares        map[uuid.UUID]struct{}
-	clearedshares        bool
-	comments             map[uuid.UUID]struct{}
-	removedcomments      map[uuid.UUID]struct{}
-	clearedcomments      bool
-	analysis_jobs        map[uuid.UUID]struct{}
-	removedanalysis_jobs map[uuid.UUID]struct{}
-	clearedanalysis_jobs bool
-	done                 bool
-	oldValue             func(context.Context) (*Entry, error)
-	predicates           []predicate.Entry
+	case reminderdelivery.EdgePatient:
+		m.ResetPatient()
+		return nil
+	}
+	return fmt.Errorf("unknown ReminderDelivery edge %s", name)
 }
 
-var _ ent.Mutation = (*EntryMutation)(nil)
+// ReminderScheduleMutation represents an operation that mutates the ReminderSchedule nodes in the graph.
+type ReminderScheduleMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	created_at         *time.Time
+	updated_at         *time.Time
+	kind               *reminderschedule.Kind
+	channel            *reminderschedule.Channel
+	timezone           *string
+	time_of_day        *string
+	weekdays           *[]string
+	appendweekdays     []string
+	inactivity_days    *int
+	addinactivity_days *int
+	message            *string
+	enabled            *bool
+	next_run_at        *time.Time
+	last_sent_at       *time.Time
+	clearedFields      map[string]struct{}
+	patient            *uuid.UUID
+	clearedpatient     bool
+	created_by         *uuid.UUID
+	clearedcreated_by  bool
+	deliveries         map[uuid.UUID]struct{}
+	removeddeliveries  map[uuid.UUID]struct{}
+	cleareddeliveries  bool
+	done               bool
+	oldValue           func(context.Context) (*ReminderSchedule, error)
+	predicates         []predicate.ReminderSchedule
+}
 
-// entryOption allows management of the mutation configuration using functional options.
-type entryOption func(*EntryMutation)
+var _ ent.Mutation = (*ReminderScheduleMutation)(nil)
 
-// newEntryMutation creates new mutation for the Entry entity.
-func newEntryMutation(c config, op Op, opts ...entryOption) *EntryMutation {
-	m := &EntryMutation{
+// reminderscheduleOption allows management of the mutation configuration using functional options.
+type reminderscheduleOption func(*ReminderScheduleMutation)
+
+// newReminderScheduleMutation creates new mutation for the ReminderSchedule entity.
+func newReminderScheduleMutation(c config, op Op, opts ...reminderscheduleOption) *ReminderScheduleMutation {
+	m := &ReminderScheduleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEntry,
+		typ:           TypeReminderSchedule,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4204,20 +20038,20 @@ func newEntryMutation(c config, op Op, opts ...entryOption) *EntryMutation {
 	return m
 }
 
-// withEntryID sets the ID field of the mutation.
-func withEntryID(id uuid.UUID) entryOption {
-	return func(m *EntryMutation) {
+// withReminderScheduleID sets the ID field of the mutation.
+func withReminderScheduleID(id uuid.UUID) reminderscheduleOption {
+	return func(m *ReminderScheduleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Entry
+			value *ReminderSchedule
 		)
-		m.oldValue = func(ctx context.Context) (*Entry, error) {
+		m.oldValue = func(ctx context.Context) (*ReminderSchedule, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Entry.Get(ctx, id)
+					value, err = m.Client().ReminderSchedule.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4226,10 +20060,10 @@ func withEntryID(id uuid.UUID) entryOption {
 	}
 }
 
-// withEntry sets the old Entry of the mutation.
-func withEntry(node *Entry) entryOption {
-	return func(m *EntryMutation) {
-		m.oldValue = func(context.Context) (*Entry, error) {
+// withReminderSchedule sets the old ReminderSchedule of the mutation.
+func withReminderSchedule(node *ReminderSchedule) reminderscheduleOption {
+	return func(m *ReminderScheduleMutation) {
+		m.oldValue = func(context.Context) (*ReminderSchedule, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4238,7 +20072,7 @@ func withEntry(node *Entry) entryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EntryMutation) Client() *Client {
+func (m ReminderScheduleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4246,7 +20080,7 @@ func (m EntryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EntryMutation) Tx() (*Tx, error) {
+func (m ReminderScheduleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4256,14 +20090,14 @@ func (m EntryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Entry entities.
-func (m *EntryMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ReminderSchedule entities.
+func (m *ReminderScheduleMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EntryMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ReminderScheduleMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4274,7 +20108,7 @@ func (m *EntryMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EntryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ReminderScheduleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4283,19 +20117,19 @@ func (m *EntryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Entry.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ReminderSchedule.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *EntryMutation) SetCreatedAt(t time.Time) {
+func (m *ReminderScheduleMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EntryMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ReminderScheduleMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4303,10 +20137,10 @@ func (m *EntryMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Entry entity.
-// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ReminderSchedule entity.
+// If the ReminderSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReminderScheduleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4321,17 +20155,17 @@ func (m *EntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EntryMutation) ResetCreatedAt() {
+func (m *ReminderScheduleMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *EntryMutation) SetUpdatedAt(t time.Time) {
+func (m *ReminderScheduleMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EntryMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ReminderScheduleMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -4339,10 +20173,10 @@ func (m *EntryMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Entry entity.
-// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ReminderSchedule entity.
+// If the ReminderSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReminderScheduleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -4357,17 +20191,17 @@ func (m *EntryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EntryMutation) ResetUpdatedAt() {
+func (m *ReminderScheduleMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetPatientID sets the "patient_id" field.
-func (m *EntryMutation) SetPatientID(u uuid.UUID) {
+func (m *ReminderScheduleMutation) SetPatientID(u uuid.UUID) {
 	m.patient = &u
 }
 
 // PatientID returns the value of the "patient_id" field in the mutation.
-func (m *EntryMutation) PatientID() (r uuid.UUID, exists bool) {
+func (m *ReminderScheduleMutation) PatientID() (r uuid.UUID, exists bool) {
 	v := m.patient
 	if v == nil {
 		return
@@ -4375,10 +20209,10 @@ func (m *EntryMutation) PatientID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldPatientID returns the old "patient_id" field's value of the Entry entity.
-// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// OldPatientID returns the old "patient_id" field's value of the ReminderSchedule entity.
+// If the ReminderSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryMutation) OldPatientID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ReminderScheduleMutation) OldPatientID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldPatientID is only allowed on UpdateOne operations")
 	}
@@ -4393,672 +20227,651 @@ func (m *EntryMutation) OldPatientID(ctx context.Context) (v uuid.UUID, err erro
 }
 
 // ResetPatientID resets all changes to the "patient_id" field.
-func (m *EntryMutation) ResetPatientID() {
+func (m *ReminderScheduleMutation) ResetPatientID() {
 	m.patient = nil
 }
 
-// SetHappenedAt sets the "happened_at" field.
-func (m *EntryMutation) SetHappenedAt(t time.Time) {
-	m.happened_at = &t
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (m *ReminderScheduleMutation) SetCreatedByDoctorID(u uuid.UUID) {
+	m.created_by = &u
 }
 
-// HappenedAt returns the value of the "happened_at" field in the mutation.
-func (m *EntryMutation) HappenedAt() (r time.Time, exists bool) {
-	v := m.happened_at
+// CreatedByDoctorID returns the value of the "created_by_doctor_id" field in the mutation.
+func (m *ReminderScheduleMutation) CreatedByDoctorID() (r uuid.UUID, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHappenedAt returns the old "happened_at" field's value of the Entry entity.
-// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedByDoctorID returns the old "created_by_doctor_id" field's value of the ReminderSchedule entity.
+// If the ReminderSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryMutation) OldHappenedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReminderScheduleMutation) OldCreatedByDoctorID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHappenedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedByDoctorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHappenedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedByDoctorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHappenedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedByDoctorID: %w", err)
 	}
-	return oldValue.HappenedAt, nil
+	return oldValue.CreatedByDoctorID, nil
 }
 
-// ResetHappenedAt resets all changes to the "happened_at" field.
-func (m *EntryMutation) ResetHappenedAt() {
-	m.happened_at = nil
+// ClearCreatedByDoctorID clears the value of the "created_by_doctor_id" field.
+func (m *ReminderScheduleMutation) ClearCreatedByDoctorID() {
+	m.created_by = nil
+	m.clearedFields[reminderschedule.FieldCreatedByDoctorID] = struct{}{}
 }
 
-// SetSituation sets the "situation" field.
-func (m *EntryMutation) SetSituation(s string) {
-	m.situation = &s
+// CreatedByDoctorIDCleared returns if the "created_by_doctor_id" field was cleared in this mutation.
+func (m *ReminderScheduleMutation) CreatedByDoctorIDCleared() bool {
+	_, ok := m.clearedFields[reminderschedule.FieldCreatedByDoctorID]
+	return ok
 }
 
-// Situation returns the value of the "situation" field in the mutation.
-func (m *EntryMutation) Situation() (r string, exists bool) {
-	v := m.situation
+// ResetCreatedByDoctorID resets all changes to the "created_by_doctor_id" field.
+func (m *ReminderScheduleMutation) ResetCreatedByDoctorID() {
+	m.created_by = nil
+	delete(m.clearedFields, reminderschedule.FieldCreatedByDoctorID)
+}
+
+// SetKind sets the "kind" field.
+func (m *ReminderScheduleMutation) SetKind(r reminderschedule.Kind) {
+	m.kind = &r
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ReminderScheduleMutation) Kind() (r reminderschedule.Kind, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSituation returns the old "situation" field's value of the Entry entity.
-// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the ReminderSchedule entity.
+// If the ReminderSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryMutation) OldSituation(ctx context.Context) (v *string, err error) {
+func (m *ReminderScheduleMutation) OldKind(ctx context.Context) (v reminderschedule.Kind, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSituation is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSituation requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSituation: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.Situation, nil
-}
-
-// ClearSituation clears the value of the "situation" field.
-func (m *EntryMutation) ClearSituation() {
-	m.situation = nil
-	m.clearedFields[entry.FieldSituation] = struct{}{}
-}
-
-// SituationCleared returns if the "situation" field was cleared in this mutation.
-func (m *EntryMutation) SituationCleared() bool {
-	_, ok := m.clearedFields[entry.FieldSituation]
-	return ok
+	return oldValue.Kind, nil
 }
 
-// ResetSituation resets all changes to the "situation" field.
-func (m *EntryMutation) ResetSituation() {
-	m.situation = nil
-	delete(m.clearedFields, entry.FieldSituation)
+// ResetKind resets all changes to the "kind" field.
+func (m *ReminderScheduleMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetEmotions sets the "emotions" field.
-func (m *EntryMutation) SetEmotions(s []schema.Emotion) {
-	m.emotions = &s
-	m.appendemotions = nil
+// SetChannel sets the "channel" field.
+func (m *ReminderScheduleMutation) SetChannel(r reminderschedule.Channel) {
+	m.channel = &r
 }
 
-// Emotions returns the value of the "emotions" field in the mutation.
-func (m *EntryMutation) Emotions() (r []schema.Emotion, exists bool) {
-	v := m.emotions
+// Channel returns the value of the "channel" field in the mutation.
+func (m *ReminderScheduleMutation) Channel() (r reminderschedule.Channel, exists bool) {
+	v := m.channel
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmotions returns the old "emotions" field's value of the Entry entity.
-// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// OldChannel returns the old "channel" field's value of the ReminderSchedule entity.
+// If the ReminderSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryMutation) OldEmotions(ctx context.Context) (v []schema.Emotion, err error) {
+func (m *ReminderScheduleMutation) OldChannel(ctx context.Context) (v reminderschedule.Channel, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmotions is only allowed on UpdateOne operations")
+		return v, errors.New("OldChannel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmotions requires an ID field in the mutation")
+		return v, errors.New("OldChannel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmotions: %w", err)
-	}
-	return oldValue.Emotions, nil
-}
-
-// AppendEmotions adds s to the "emotions" field.
-func (m *EntryMutation) AppendEmotions(s []schema.Emotion) {
-	m.appendemotions = append(m.appendemotions, s...)
-}
-
-// AppendedEmotions returns the list of values that were appended to the "emotions" field in this mutation.
-func (m *EntryMutation) AppendedEmotions() ([]schema.Emotion, bool) {
-	if len(m.appendemotions) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldChannel: %w", err)
 	}
-	return m.appendemotions, true
-}
-
-// ClearEmotions clears the value of the "emotions" field.
-func (m *EntryMutation) ClearEmotions() {
-	m.emotions = nil
-	m.appendemotions = nil
-	m.clearedFields[entry.FieldEmotions] = struct{}{}
-}
-
-// EmotionsCleared returns if the "emotions" field was cleared in this mutation.
-func (m *EntryMutation) EmotionsCleared() bool {
-	_, ok := m.clearedFields[entry.FieldEmotions]
-	return ok
+	return oldValue.Channel, nil
 }
 
-// ResetEmotions resets all changes to the "emotions" field.
-func (m *EntryMutation) ResetEmotions() {
-	m.emotions = nil
-	m.appendemotions = nil
-	delete(m.clearedFields, entry.FieldEmotions)
+// ResetChannel resets all changes to the "channel" field.
+func (m *ReminderScheduleMutation) ResetChannel() {
+	m.channel = nil
 }
 
-// SetTriggers sets the "triggers" field.
-func (m *EntryMutation) SetTriggers(s []string) {
-	m.triggers = &s
-	m.appendtriggers = nil
+// SetTimezone sets the "timezone" field.
+func (m *ReminderScheduleMutation) SetTimezone(s string) {
+	m.timezone = &s
 }
 
-// Triggers returns the value of the "triggers" field in the mutation.
-func (m *EntryMutation) Triggers() (r []string, exists bool) {
-	v := m.triggers
+// Timezone returns the value of the "timezone" field in the mutation.
+func (m *ReminderScheduleMutation) Timezone() (r string, exists bool) {
+	v := m.timezone
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTriggers returns the old "triggers" field's value of the Entry entity.
-// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// OldTimezone returns the old "timezone" field's value of the ReminderSchedule entity.
+// If the ReminderSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryMutation) OldTriggers(ctx context.Context) (v []string, err error) {
+func (m *ReminderScheduleMutation) OldTimezone(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTriggers is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimezone is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTriggers requires an ID field in the mutation")
+		return v, errors.New("OldTimezone requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTriggers: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
 	}
-	return oldValue.Triggers, nil
+	return oldValue.Timezone, nil
 }
 
-// AppendTriggers adds s to the "triggers" field.
-func (m *EntryMutation) AppendTriggers(s []string) {
-	m.appendtriggers = append(m.appendtriggers, s...)
+// ResetTimezone resets all changes to the "timezone" field.
+func (m *ReminderScheduleMutation) ResetTimezone() {
+	m.timezone = nil
 }
 
-// AppendedTriggers returns the list of values that were appended to the "triggers" field in this mutation.
-func (m *EntryMutation) AppendedTriggers() ([]string, bool) {
-	if len(m.appendtriggers) == 0 {
-		return nil, false
-	}
-	return m.appendtriggers, true
+// SetTimeOfDay sets the "time_of_day" field.
+func (m *ReminderScheduleMutation) SetTimeOfDay(s string) {
+	m.time_of_day = &s
 }
 
-// ClearTriggers clears the value of the "triggers" field.
-func (m *EntryMutation) ClearTriggers() {
-	m.triggers = nil
-	m.appendtriggers = nil
-	m.clearedFields[entry.FieldTriggers] = struct{}{}
+// TimeOfDay returns the value of the "time_of_day" field in the mutation.
+func (m *ReminderScheduleMutation) TimeOfDay() (r string, exists bool) {
+	v := m.time_of_day
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TriggersCleared returns if the "triggers" field was cleared in this mutation.
-func (m *EntryMutation) TriggersCleared() bool {
-	_, ok := m.clearedFields[entry.FieldTriggers]
-	return ok
+// OldTimeOfDay returns the old "time_of_day" field's value of the ReminderSchedule entity.
+// If the ReminderSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReminderScheduleMutation) OldTimeOfDay(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeOfDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeOfDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeOfDay: %w", err)
+	}
+	return oldValue.TimeOfDay, nil
 }
 
-// ResetTriggers resets all changes to the "triggers" field.
-func (m *EntryMutation) ResetTriggers() {
-	m.triggers = nil
-	m.appendtriggers = nil
-	delete(m.clearedFields, entry.FieldTriggers)
+// ResetTimeOfDay resets all changes to the "time_of_day" field.
+func (m *ReminderScheduleMutation) ResetTimeOfDay() {
+	m.time_of_day = nil
 }
 
-// SetTechniques sets the "techniques" field.
-func (m *EntryMutation) SetTechniques(s []string) {
-	m.techniques = &s
-	m.appendtechniques = nil
+// SetWeekdays sets the "weekdays" field.
+func (m *ReminderScheduleMutation) SetWeekdays(s []string) {
+	m.weekdays = &s
+	m.appendweekdays = nil
 }
 
-// Techniques returns the value of the "techniques" field in the mutation.
-func (m *EntryMutation) Techniques() (r []string, exists bool) {
-	v := m.techniques
+// Weekdays returns the value of the "weekdays" field in the mutation.
+func (m *ReminderScheduleMutation) Weekdays() (r []string, exists bool) {
+	v := m.weekdays
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTechniques returns the old "techniques" field's value of the Entry entity.
-// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// OldWeekdays returns the old "weekdays" field's value of the ReminderSchedule entity.
+// If the ReminderSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryMutation) OldTechniques(ctx context.Context) (v []string, err error) {
+func (m *ReminderScheduleMutation) OldWeekdays(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTechniques is only allowed on UpdateOne operations")
+		return v, errors.New("OldWeekdays is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTechniques requires an ID field in the mutation")
+		return v, errors.New("OldWeekdays requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTechniques: %w", err)
+		return v, fmt.Errorf("querying old value for OldWeekdays: %w", err)
 	}
-	return oldValue.Techniques, nil
+	return oldValue.Weekdays, nil
 }
 
-// AppendTechniques adds s to the "techniques" field.
-func (m *EntryMutation) AppendTechniques(s []string) {
-	m.appendtechniques = append(m.appendtechniques, s...)
+// AppendWeekdays adds s to the "weekdays" field.
+func (m *ReminderScheduleMutation) AppendWeekdays(s []string) {
+	m.appendweekdays = append(m.appendweekdays, s...)
 }
 
-// AppendedTechniques returns the list of values that were appended to the "techniques" field in this mutation.
-func (m *EntryMutation) AppendedTechniques() ([]string, bool) {
-	if len(m.appendtechniques) == 0 {
+// AppendedWeekdays returns the list of values that were appended to the "weekdays" field in this mutation.
+func (m *ReminderScheduleMutation) AppendedWeekdays() ([]string, bool) {
+	if len(m.appendweekdays) == 0 {
 		return nil, false
 	}
-	return m.appendtechniques, true
+	return m.appendweekdays, true
 }
 
-// ClearTechniques clears the value of the "techniques" field.
-func (m *EntryMutation) ClearTechniques() {
-	m.techniques = nil
-	m.appendtechniques = nil
-	m.clearedFields[entry.FieldTechniques] = struct{}{}
+// ClearWeekdays clears the value of the "weekdays" field.
+func (m *ReminderScheduleMutation) ClearWeekdays() {
+	m.weekdays = nil
+	m.appendweekdays = nil
+	m.clearedFields[reminderschedule.FieldWeekdays] = struct{}{}
 }
 
-// TechniquesCleared returns if the "techniques" field was cleared in this mutation.
-func (m *EntryMutation) TechniquesCleared() bool {
-	_, ok := m.clearedFields[entry.FieldTechniques]
+// WeekdaysCleared returns if the "weekdays" field was cleared in this mutation.
+func (m *ReminderScheduleMutation) WeekdaysCleared() bool {
+	_, ok := m.clearedFields[reminderschedule.FieldWeekdays]
 	return ok
 }
 
-// ResetTechniques resets all changes to the "techniques" field.
-func (m *EntryMutation) ResetTechniques() {
-	m.techniques = nil
-	m.appendtechniques = nil
-	delete(m.clearedFields, entry.FieldTechniques)
+// ResetWeekdays resets all changes to the "weekdays" field.
+func (m *ReminderScheduleMutation) ResetWeekdays() {
+	m.weekdays = nil
+	m.appendweekdays = nil
+	delete(m.clearedFields, reminderschedule.FieldWeekdays)
 }
-
-// SetStutterFrequency sets the "stutter_frequency" field.
-func (m *EntryMutation) SetStutterFrequency(i int) {
-	m.stutter_frequency = &i
-	m.addstutter_frequency = nil
+
+// SetInactivityDays sets the "inactivity_days" field.
+func (m *ReminderScheduleMutation) SetInactivityDays(i int) {
+	m.inactivity_days = &i
+	m.addinactivity_days = nil
 }
 
-// StutterFrequency returns the value of the "stutter_frequency" field in the mutation.
-func (m *EntryMutation) StutterFrequency() (r int, exists bool) {
-	v := m.stutter_frequency
+// InactivityDays returns the value of the "inactivity_days" field in the mutation.
+func (m *ReminderScheduleMutation) InactivityDays() (r int, exists bool) {
+	v := m.inactivity_days
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStutterFrequency returns the old "stutter_frequency" field's value of the Entry entity.
-// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// OldInactivityDays returns the old "inactivity_days" field's value of the ReminderSchedule entity.
+// If the ReminderSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryMutation) OldStutterFrequency(ctx context.Context) (v *int, err error) {
+func (m *ReminderScheduleMutation) OldInactivityDays(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStutterFrequency is only allowed on UpdateOne operations")
+		return v, errors.New("OldInactivityDays is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStutterFrequency requires an ID field in the mutation")
+		return v, errors.New("OldInactivityDays requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStutterFrequency: %w", err)
+		return v, fmt.Errorf("querying old value for OldInactivityDays: %w", err)
 	}
-	return oldValue.StutterFrequency, nil
+	return oldValue.InactivityDays, nil
 }
 
-// AddStutterFrequency adds i to the "stutter_frequency" field.
-func (m *EntryMutation) AddStutterFrequency(i int) {
-	if m.addstutter_frequency != nil {
-		*m.addstutter_frequency += i
+// AddInactivityDays adds i to the "inactivity_days" field.
+func (m *ReminderScheduleMutation) AddInactivityDays(i int) {
+	if m.addinactivity_days != nil {
+		*m.addinactivity_days += i
 	} else {
-		m.addstutter_frequency = &i
+		m.addinactivity_days = &i
 	}
 }
 
-// AddedStutterFrequency returns the value that was added to the "stutter_frequency" field in this mutation.
-func (m *EntryMutation) AddedStutterFrequency() (r int, exists bool) {
-	v := m.addstutter_frequency
+// AddedInactivityDays returns the value that was added to the "inactivity_days" field in this mutation.
+func (m *ReminderScheduleMutation) AddedInactivityDays() (r int, exists bool) {
+	v := m.addinactivity_days
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearStutterFrequency clears the value of the "stutter_frequency" field.
-func (m *EntryMutation) ClearStutterFrequency() {
-	m.stutter_frequency = nil
-	m.addstutter_frequency = nil
-	m.clearedFields[entry.FieldStutterFrequency] = struct{}{}
+// ClearInactivityDays clears the value of the "inactivity_days" field.
+func (m *ReminderScheduleMutation) ClearInactivityDays() {
+	m.inactivity_days = nil
+	m.addinactivity_days = nil
+	m.clearedFields[reminderschedule.FieldInactivityDays] = struct{}{}
 }
 
-// StutterFrequencyCleared returns if the "stutter_frequency" field was cleared in this mutation.
-func (m *EntryMutation) StutterFrequencyCleared() bool {
-	_, ok := m.clearedFields[entry.FieldStutterFrequency]
+// InactivityDaysCleared returns if the "inactivity_days" field was cleared in this mutation.
+func (m *ReminderScheduleMutation) InactivityDaysCleared() bool {
+	_, ok := m.clearedFields[reminderschedule.FieldInactivityDays]
 	return ok
 }
 
-// ResetStutterFrequency resets all changes to the "stutter_frequency" field.
-func (m *EntryMutation) ResetStutterFrequency() {
-	m.stutter_frequency = nil
-	m.addstutter_frequency = nil
-	delete(m.clearedFields, entry.FieldStutterFrequency)
+// ResetInactivityDays resets all changes to the "inactivity_days" field.
+func (m *ReminderScheduleMutation) ResetInactivityDays() {
+	m.inactivity_days = nil
+	m.addinactivity_days = nil
+	delete(m.clearedFields, reminderschedule.FieldInactivityDays)
 }
 
-// SetNotes sets the "notes" field.
-func (m *EntryMutation) SetNotes(s string) {
-	m.notes = &s
+// SetMessage sets the "message" field.
+func (m *ReminderScheduleMutation) SetMessage(s string) {
+	m.message = &s
 }
 
-// Notes returns the value of the "notes" field in the mutation.
-func (m *EntryMutation) Notes() (r string, exists bool) {
-	v := m.notes
+// Message returns the value of the "message" field in the mutation.
+func (m *ReminderScheduleMutation) Message() (r string, exists bool) {
+	v := m.message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNotes returns the old "notes" field's value of the Entry entity.
-// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// OldMessage returns the old "message" field's value of the ReminderSchedule entity.
+// If the ReminderSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryMutation) OldNotes(ctx context.Context) (v *string, err error) {
+func (m *ReminderScheduleMutation) OldMessage(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNotes requires an ID field in the mutation")
+		return v, errors.New("OldMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
 	}
-	return oldValue.Notes, nil
+	return oldValue.Message, nil
 }
 
-// ClearNotes clears the value of the "notes" field.
-func (m *EntryMutation) ClearNotes() {
-	m.notes = nil
-	m.clearedFields[entry.FieldNotes] = struct{}{}
+// ClearMessage clears the value of the "message" field.
+func (m *ReminderScheduleMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[reminderschedule.FieldMessage] = struct{}{}
 }
 
-// NotesCleared returns if the "notes" field was cleared in this mutation.
-func (m *EntryMutation) NotesCleared() bool {
-	_, ok := m.clearedFields[entry.FieldNotes]
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *ReminderScheduleMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[reminderschedule.FieldMessage]
 	return ok
 }
 
-// ResetNotes resets all changes to the "notes" field.
-func (m *EntryMutation) ResetNotes() {
-	m.notes = nil
-	delete(m.clearedFields, entry.FieldNotes)
+// ResetMessage resets all changes to the "message" field.
+func (m *ReminderScheduleMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, reminderschedule.FieldMessage)
 }
 
-// SetTags sets the "tags" field.
-func (m *EntryMutation) SetTags(s []string) {
-	m.tags = &s
-	m.appendtags = nil
+// SetEnabled sets the "enabled" field.
+func (m *ReminderScheduleMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// Tags returns the value of the "tags" field in the mutation.
-func (m *EntryMutation) Tags() (r []string, exists bool) {
-	v := m.tags
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ReminderScheduleMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTags returns the old "tags" field's value of the Entry entity.
-// If the Entry object wasn't provided to the builder, the object is fetched from the database.
+// OldEnabled returns the old "enabled" field's value of the ReminderSchedule entity.
+// If the ReminderSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryMutation) OldTags(ctx context.Context) (v []string, err error) {
+func (m *ReminderScheduleMutation) OldEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTags requires an ID field in the mutation")
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
 	}
-	return oldValue.Tags, nil
+	return oldValue.Enabled, nil
 }
 
-// AppendTags adds s to the "tags" field.
-func (m *EntryMutation) AppendTags(s []string) {
-	m.appendtags = append(m.appendtags, s...)
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ReminderScheduleMutation) ResetEnabled() {
+	m.enabled = nil
 }
 
-// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
-func (m *EntryMutation) AppendedTags() ([]string, bool) {
-	if len(m.appendtags) == 0 {
-		return nil, false
-	}
-	return m.appendtags, true
+// SetNextRunAt sets the "next_run_at" field.
+func (m *ReminderScheduleMutation) SetNextRunAt(t time.Time) {
+	m.next_run_at = &t
 }
 
-// ClearTags clears the value of the "tags" field.
-func (m *EntryMutation) ClearTags() {
-	m.tags = nil
-	m.appendtags = nil
-	m.clearedFields[entry.FieldTags] = struct{}{}
+// NextRunAt returns the value of the "next_run_at" field in the mutation.
+func (m *ReminderScheduleMutation) NextRunAt() (r time.Time, exists bool) {
+	v := m.next_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TagsCleared returns if the "tags" field was cleared in this mutation.
-func (m *EntryMutation) TagsCleared() bool {
-	_, ok := m.clearedFields[entry.FieldTags]
-	return ok
+// OldNextRunAt returns the old "next_run_at" field's value of the ReminderSchedule entity.
+// If the ReminderSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReminderScheduleMutation) OldNextRunAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRunAt: %w", err)
+	}
+	return oldValue.NextRunAt, nil
 }
 
-// ResetTags resets all changes to the "tags" field.
-func (m *EntryMutation) ResetTags() {
-	m.tags = nil
-	m.appendtags = nil
-	delete(m.clearedFields, entry.FieldTags)
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (m *ReminderScheduleMutation) ClearNextRunAt() {
+	m.next_run_at = nil
+	m.clearedFields[reminderschedule.FieldNextRunAt] = struct{}{}
 }
 
-// ClearPatient clears the "patient" edge to the Patient entity.
-func (m *EntryMutation) ClearPatient() {
-	m.clearedpatient = true
-	m.clearedFields[entry.FieldPatientID] = struct{}{}
+// NextRunAtCleared returns if the "next_run_at" field was cleared in this mutation.
+func (m *ReminderScheduleMutation) NextRunAtCleared() bool {
+	_, ok := m.clearedFields[reminderschedule.FieldNextRunAt]
+	return ok
 }
 
-// PatientCleared reports if the "patient" edge to the Patient entity was cleared.
-func (m *EntryMutation) PatientCleared() bool {
-	return m.clearedpatient
+// ResetNextRunAt resets all changes to the "next_run_at" field.
+func (m *ReminderScheduleMutation) ResetNextRunAt() {
+	m.next_run_at = nil
+	delete(m.clearedFields, reminderschedule.FieldNextRunAt)
 }
 
-// PatientIDs returns the "patient" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PatientID instead. It exists only for internal usage by the builders.
-func (m *EntryMutation) PatientIDs() (ids []uuid.UUID) {
-	if id := m.patient; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetLastSentAt sets the "last_sent_at" field.
+func (m *ReminderScheduleMutation) SetLastSentAt(t time.Time) {
+	m.last_sent_at = &t
 }
 
-// ResetPatient resets all changes to the "patient" edge.
-func (m *EntryMutation) ResetPatient() {
-	m.patient = nil
-	m.clearedpatient = false
+// LastSentAt returns the value of the "last_sent_at" field in the mutation.
+func (m *ReminderScheduleMutation) LastSentAt() (r time.Time, exists bool) {
+	v := m.last_sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddShareIDs adds the "shares" edge to the EntryShare entity by ids.
-func (m *EntryMutation) AddShareIDs(ids ...uuid.UUID) {
-	if m.shares == nil {
-		m.shares = make(map[uuid.UUID]struct{})
+// OldLastSentAt returns the old "last_sent_at" field's value of the ReminderSchedule entity.
+// If the ReminderSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReminderScheduleMutation) OldLastSentAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSentAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.shares[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSentAt: %w", err)
 	}
+	return oldValue.LastSentAt, nil
 }
 
-// ClearShares clears the "shares" edge to the EntryShare entity.
-func (m *EntryMutation) ClearShares() {
-	m.clearedshares = true
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (m *ReminderScheduleMutation) ClearLastSentAt() {
+	m.last_sent_at = nil
+	m.clearedFields[reminderschedule.FieldLastSentAt] = struct{}{}
 }
 
-// SharesCleared reports if the "shares" edge to the EntryShare entity was cleared.
-func (m *EntryMutation) SharesCleared() bool {
-	return m.clearedshares
+// LastSentAtCleared returns if the "last_sent_at" field was cleared in this mutation.
+func (m *ReminderScheduleMutation) LastSentAtCleared() bool {
+	_, ok := m.clearedFields[reminderschedule.FieldLastSentAt]
+	return ok
 }
 
-// RemoveShareIDs removes the "shares" edge to the EntryShare entity by IDs.
-func (m *EntryMutation) RemoveShareIDs(ids ...uuid.UUID) {
-	if m.removedshares == nil {
-		m.removedshares = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.shares, ids[i])
-		m.removedshares[ids[i]] = struct{}{}
-	}
+// ResetLastSentAt resets all changes to the "last_sent_at" field.
+func (m *ReminderScheduleMutation) ResetLastSentAt() {
+	m.last_sent_at = nil
+	delete(m.clearedFields, reminderschedule.FieldLastSentAt)
 }
 
-// RemovedShares returns the removed IDs of the "shares" edge to the EntryShare entity.
-func (m *EntryMutation) RemovedSharesIDs() (ids []uuid.UUID) {
-	for id := range m.removedshares {
-		ids = append(ids, id)
-	}
-	return
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (m *ReminderScheduleMutation) ClearPatient() {
+	m.clearedpatient = true
+	m.clearedFields[reminderschedule.FieldPatientID] = struct{}{}
 }
 
-// SharesIDs returns the "shares" edge IDs in the mutation.
-func (m *EntryMutation) SharesIDs() (ids []uuid.UUID) {
-	for id := range m.shares {
-		ids = append(ids, id)
-	}
-	return
+// PatientCleared reports if the "patient" edge to the Patient entity was cleared.
+func (m *ReminderScheduleMutation) PatientCleared() bool {
+	return m.clearedpatient
 }
 
-// ResetShares resets all changes to the "shares" edge.
-func (m *EntryMutation) ResetShares() {
-	m.shares = nil
-	m.clearedshares = false
-	m.removedshares = nil
+// PatientIDs returns the "patient" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PatientID instead. It exists only for internal usage by the builders.
+func (m *ReminderScheduleMutation) PatientIDs() (ids []uuid.UUID) {
+	if id := m.patient; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
-func (m *EntryMutation) AddCommentIDs(ids ...uuid.UUID) {
-	if m.comments == nil {
-		m.comments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.comments[ids[i]] = struct{}{}
-	}
+// ResetPatient resets all changes to the "patient" edge.
+func (m *ReminderScheduleMutation) ResetPatient() {
+	m.patient = nil
+	m.clearedpatient = false
 }
 
-// ClearComments clears the "comments" edge to the Comment entity.
-func (m *EntryMutation) ClearComments() {
-	m.clearedcomments = true
+// SetCreatedByID sets the "created_by" edge to the Doctor entity by id.
+func (m *ReminderScheduleMutation) SetCreatedByID(id uuid.UUID) {
+	m.created_by = &id
 }
 
-// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
-func (m *EntryMutation) CommentsCleared() bool {
-	return m.clearedcomments
+// ClearCreatedBy clears the "created_by" edge to the Doctor entity.
+func (m *ReminderScheduleMutation) ClearCreatedBy() {
+	m.clearedcreated_by = true
+	m.clearedFields[reminderschedule.FieldCreatedByDoctorID] = struct{}{}
 }
 
-// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
-func (m *EntryMutation) RemoveCommentIDs(ids ...uuid.UUID) {
-	if m.removedcomments == nil {
-		m.removedcomments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.comments, ids[i])
-		m.removedcomments[ids[i]] = struct{}{}
-	}
+// CreatedByCleared reports if the "created_by" edge to the Doctor entity was cleared.
+func (m *ReminderScheduleMutation) CreatedByCleared() bool {
+	return m.CreatedByDoctorIDCleared() || m.clearedcreated_by
 }
 
-// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
-func (m *EntryMutation) RemovedCommentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedcomments {
-		ids = append(ids, id)
+// CreatedByID returns the "created_by" edge ID in the mutation.
+func (m *ReminderScheduleMutation) CreatedByID() (id uuid.UUID, exists bool) {
+	if m.created_by != nil {
+		return *m.created_by, true
 	}
 	return
 }
 
-// CommentsIDs returns the "comments" edge IDs in the mutation.
-func (m *EntryMutation) CommentsIDs() (ids []uuid.UUID) {
-	for id := range m.comments {
-		ids = append(ids, id)
+// CreatedByIDs returns the "created_by" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatedByID instead. It exists only for internal usage by the builders.
+func (m *ReminderScheduleMutation) CreatedByIDs() (ids []uuid.UUID) {
+	if id := m.created_by; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetComments resets all changes to the "comments" edge.
-func (m *EntryMutation) ResetComments() {
-	m.comments = nil
-	m.clearedcomments = false
-	m.removedcomments = nil
+// ResetCreatedBy resets all changes to the "created_by" edge.
+func (m *ReminderScheduleMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.clearedcreated_by = false
 }
 
-// AddAnalysisJobIDs adds the "analysis_jobs" edge to the AnalysisJob entity by ids.
-func (m *EntryMutation) AddAnalysisJobIDs(ids ...uuid.UUID) {
-	if m.analysis_jobs == nil {
-		m.analysis_jobs = make(map[uuid.UUID]struct{})
+// AddDeliveryIDs adds the "deliveries" edge to the ReminderDelivery entity by ids.
+func (m *ReminderScheduleMutation) AddDeliveryIDs(ids ...uuid.UUID) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.analysis_jobs[ids[i]] = struct{}{}
+		m.deliveries[ids[i]] = struct{}{}
 	}
 }
 
-// ClearAnalysisJobs clears the "analysis_jobs" edge to the AnalysisJob entity.
-func (m *EntryMutation) ClearAnalysisJobs() {
-	m.clearedanalysis_jobs = true
+// ClearDeliveries clears the "deliveries" edge to the ReminderDelivery entity.
+func (m *ReminderScheduleMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
 }
 
-// AnalysisJobsCleared reports if the "analysis_jobs" edge to the AnalysisJob entity was cleared.
-func (m *EntryMutation) AnalysisJobsCleared() bool {
-	return m.clearedanalysis_jobs
+// DeliveriesCleared reports if the "deliveries" edge to the ReminderDelivery entity was cleared.
+func (m *ReminderScheduleMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
 }
 
-// RemoveAnalysisJobIDs removes the "analysis_jobs" edge to the AnalysisJob entity by IDs.
-func (m *EntryMutation) RemoveAnalysisJobIDs(ids ...uuid.UUID) {
-	if m.removedanalysis_jobs == nil {
-		m.removedanalysis_jobs = make(map[uuid.UUID]struct{})
+// RemoveDeliveryIDs removes the "deliveries" edge to the ReminderDelivery entity by IDs.
+func (m *ReminderScheduleMutation) RemoveDeliveryIDs(ids ...uuid.UUID) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.analysis_jobs, ids[i])
-		m.removedanalysis_jobs[ids[i]] = struct{}{}
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedAnalysisJobs returns the removed IDs of the "analysis_jobs" edge to the AnalysisJob entity.
-func (m *EntryMutation) RemovedAnalysisJobsIDs() (ids []uuid.UUID) {
-	for id := range m.removedanalysis_jobs {
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the ReminderDelivery entity.
+func (m *ReminderScheduleMutation) RemovedDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.removeddeliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// AnalysisJobsIDs returns the "analysis_jobs" edge IDs in the mutation.
-func (m *EntryMutation) AnalysisJobsIDs() (ids []uuid.UUID) {
-	for id := range m.analysis_jobs {
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *ReminderScheduleMutation) DeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.deliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetAnalysisJobs resets all changes to the "analysis_jobs" edge.
-func (m *EntryMutation) ResetAnalysisJobs() {
-	m.analysis_jobs = nil
-	m.clearedanalysis_jobs = false
-	m.removedanalysis_jobs = nil
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *ReminderScheduleMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
 }
 
-// Where appends a list predicates to the EntryMutation builder.
-func (m *EntryMutation) Where(ps ...predicate.Entry) {
+// Where appends a list predicates to the ReminderScheduleMutation builder.
+func (m *ReminderScheduleMutation) Where(ps ...predicate.ReminderSchedule) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EntryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ReminderScheduleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EntryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Entry, len(ps))
+func (m *ReminderScheduleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReminderSchedule, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5066,57 +20879,66 @@ func (m *EntryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EntryMutation) Op() Op {
+func (m *ReminderScheduleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EntryMutation) SetOp(op Op) {
+func (m *ReminderScheduleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Entry).
-func (m *EntryMutation) Type() string {
+// Type returns the node type of this mutation (ReminderSchedule).
+func (m *ReminderScheduleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EntryMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+func (m *ReminderScheduleMutation) Fields() []string {
+	fields := make([]string, 0, 14)
 	if m.created_at != nil {
-		fields = append(fields, entry.FieldCreatedAt)
+		fields = append(fields, reminderschedule.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, entry.FieldUpdatedAt)
+		fields = append(fields, reminderschedule.FieldUpdatedAt)
 	}
 	if m.patient != nil {
-		fields = append(fields, entry.FieldPatientID)
+		fields = append(fields, reminderschedule.FieldPatientID)
 	}
-	if m.happened_at != nil {
-		fields = append(fields, entry.FieldHappenedAt)
+	if m.created_by != nil {
+		fields = append(fields, reminderschedule.FieldCreatedByDoctorID)
 	}
-	if m.situation != nil {
-		fields = append(fields, entry.FieldSituation)
+	if m.kind != nil {
+		fields = append(fields, reminderschedule.FieldKind)
 	}
-	if m.emotions != nil {
-		fields = append(fields, entry.FieldEmotions)
+	if m.channel != nil {
+		fields = append(fields, reminderschedule.FieldChannel)
 	}
-	if m.triggers != nil {
-		fields = append(fields, entry.FieldTriggers)
+	if m.timezone != nil {
+		fields = append(fields, reminderschedule.FieldTimezone)
 	}
-	if m.techniques != nil {
-		fields = append(fields, entry.FieldTechniques)
+	if m.time_of_day != nil {
+		fields = append(fields, reminderschedule.FieldTimeOfDay)
 	}
-	if m.stutter_frequency != nil {
-		fields = append(fields, entry.FieldStutterFrequency)
+	if m.weekdays != nil {
+		fields = append(fields, reminderschedule.FieldWeekdays)
 	}
-	if m.notes != nil {
-		fields = append(fields, entry.FieldNotes)
+	if m.inactivity_days != nil {
+		fields = append(fields, reminderschedule.FieldInactivityDays)
 	}
-	if m.tags != nil {
-		fields = append(fields, entry.FieldTags)
+	if m.message != nil {
+		fields = append(fields, reminderschedule.FieldMessage)
+	}
+	if m.enabled != nil {
+		fields = append(fields, reminderschedule.FieldEnabled)
+	}
+	if m.next_run_at != nil {
+		fields = append(fields, reminderschedule.FieldNextRunAt)
+	}
+	if m.last_sent_at != nil {
+		fields = append(fields, reminderschedule.FieldLastSentAt)
 	}
 	return fields
 }
@@ -5124,30 +20946,36 @@ func (m *EntryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EntryMutation) Field(name string) (ent.Value, bool) {
+func (m *ReminderScheduleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case entry.FieldCreatedAt:
+	case reminderschedule.FieldCreatedAt:
 		return m.CreatedAt()
-	case entry.FieldUpdatedAt:
+	case reminderschedule.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case entry.FieldPatientID:
+	case reminderschedule.FieldPatientID:
 		return m.PatientID()
-	case entry.FieldHappenedAt:
-		return m.HappenedAt()
-	case entry.FieldSituation:
-		return m.Situation()
-	case entry.FieldEmotions:
-		return m.Emotions()
-	case entry.FieldTriggers:
-		return m.Triggers()
-	case entry.FieldTechniques:
-		return m.Techniques()
-	case entry.FieldStutterFrequency:
-		return m.StutterFrequency()
-	case entry.FieldNotes:
-		return m.Notes()
-	case entry.FieldTags:
-		return m.Tags()
+	case reminderschedule.FieldCreatedByDoctorID:
+		return m.CreatedByDoctorID()
+	case reminderschedule.FieldKind:
+		return m.Kind()
+	case reminderschedule.FieldChannel:
+		return m.Channel()
+	case reminderschedule.FieldTimezone:
+		return m.Timezone()
+	case reminderschedule.FieldTimeOfDay:
+		return m.TimeOfDay()
+	case reminderschedule.FieldWeekdays:
+		return m.Weekdays()
+	case reminderschedule.FieldInactivityDays:
+		return m.InactivityDays()
+	case reminderschedule.FieldMessage:
+		return m.Message()
+	case reminderschedule.FieldEnabled:
+		return m.Enabled()
+	case reminderschedule.FieldNextRunAt:
+		return m.NextRunAt()
+	case reminderschedule.FieldLastSentAt:
+		return m.LastSentAt()
 	}
 	return nil, false
 }
@@ -5155,126 +20983,153 @@ func (m *EntryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ReminderScheduleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case entry.FieldCreatedAt:
+	case reminderschedule.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case entry.FieldUpdatedAt:
+	case reminderschedule.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case entry.FieldPatientID:
+	case reminderschedule.FieldPatientID:
 		return m.OldPatientID(ctx)
-	case entry.FieldHappenedAt:
-		return m.OldHappenedAt(ctx)
-	case entry.FieldSituation:
-		return m.OldSituation(ctx)
-	case entry.FieldEmotions:
-		return m.OldEmotions(ctx)
-	case entry.FieldTriggers:
-		return m.OldTriggers(ctx)
-	case entry.FieldTechniques:
-		return m.OldTechniques(ctx)
-	case entry.FieldStutterFrequency:
-		return m.OldStutterFrequency(ctx)
-	case entry.FieldNotes:
-		return m.OldNotes(ctx)
-	case entry.FieldTags:
-		return m.OldTags(ctx)
-	}
-	return nil, fmt.Errorf("unknown Entry field %s", name)
+	case reminderschedule.FieldCreatedByDoctorID:
+		return m.OldCreatedByDoctorID(ctx)
+	case reminderschedule.FieldKind:
+		return m.OldKind(ctx)
+	case reminderschedule.FieldChannel:
+		return m.OldChannel(ctx)
+	case reminderschedule.FieldTimezone:
+		return m.OldTimezone(ctx)
+	case reminderschedule.FieldTimeOfDay:
+		return m.OldTimeOfDay(ctx)
+	case reminderschedule.FieldWeekdays:
+		return m.OldWeekdays(ctx)
+	case reminderschedule.FieldInactivityDays:
+		return m.OldInactivityDays(ctx)
+	case reminderschedule.FieldMessage:
+		return m.OldMessage(ctx)
+	case reminderschedule.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case reminderschedule.FieldNextRunAt:
+		return m.OldNextRunAt(ctx)
+	case reminderschedule.FieldLastSentAt:
+		return m.OldLastSentAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ReminderSchedule field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EntryMutation) SetField(name string, value ent.Value) error {
+func (m *ReminderScheduleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case entry.FieldCreatedAt:
+	case reminderschedule.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case entry.FieldUpdatedAt:
+	case reminderschedule.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case entry.FieldPatientID:
+	case reminderschedule.FieldPatientID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetPatientID(v)
 		return nil
-	case entry.FieldHappenedAt:
-		v, ok := value.(time.Time)
+	case reminderschedule.FieldCreatedByDoctorID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHappenedAt(v)
+		m.SetCreatedByDoctorID(v)
 		return nil
-	case entry.FieldSituation:
-		v, ok := value.(string)
+	case reminderschedule.FieldKind:
+		v, ok := value.(reminderschedule.Kind)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSituation(v)
+		m.SetKind(v)
 		return nil
-	case entry.FieldEmotions:
-		v, ok := value.([]schema.Emotion)
+	case reminderschedule.FieldChannel:
+		v, ok := value.(reminderschedule.Channel)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmotions(v)
+		m.SetChannel(v)
 		return nil
-	case entry.FieldTriggers:
-		v, ok := value.([]string)
+	case reminderschedule.FieldTimezone:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTriggers(v)
+		m.SetTimezone(v)
 		return nil
-	case entry.FieldTechniques:
+	case reminderschedule.FieldTimeOfDay:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeOfDay(v)
+		return nil
+	case reminderschedule.FieldWeekdays:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTechniques(v)
+		m.SetWeekdays(v)
 		return nil
-	case entry.FieldStutterFrequency:
+	case reminderschedule.FieldInactivityDays:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStutterFrequency(v)
+		m.SetInactivityDays(v)
 		return nil
-	case entry.FieldNotes:
+	case reminderschedule.FieldMessage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNotes(v)
+		m.SetMessage(v)
 		return nil
-	case entry.FieldTags:
-		v, ok := value.([]string)
+	case reminderschedule.FieldEnabled:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTags(v)
+		m.SetEnabled(v)
+		return nil
+	case reminderschedule.FieldNextRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRunAt(v)
+		return nil
+	case reminderschedule.FieldLastSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSentAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Entry field %s", name)
+	return fmt.Errorf("unknown ReminderSchedule field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EntryMutation) AddedFields() []string {
+func (m *ReminderScheduleMutation) AddedFields() []string {
 	var fields []string
-	if m.addstutter_frequency != nil {
-		fields = append(fields, entry.FieldStutterFrequency)
+	if m.addinactivity_days != nil {
+		fields = append(fields, reminderschedule.FieldInactivityDays)
 	}
 	return fields
 }
@@ -5282,10 +21137,10 @@ func (m *EntryMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EntryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ReminderScheduleMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case entry.FieldStutterFrequency:
-		return m.AddedStutterFrequency()
+	case reminderschedule.FieldInactivityDays:
+		return m.AddedInactivityDays()
 	}
 	return nil, false
 }
@@ -5293,165 +21148,157 @@ func (m *EntryMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EntryMutation) AddField(name string, value ent.Value) error {
+func (m *ReminderScheduleMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case entry.FieldStutterFrequency:
+	case reminderschedule.FieldInactivityDays:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStutterFrequency(v)
+		m.AddInactivityDays(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Entry numeric field %s", name)
+	return fmt.Errorf("unknown ReminderSchedule numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EntryMutation) ClearedFields() []string {
+func (m *ReminderScheduleMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(entry.FieldSituation) {
-		fields = append(fields, entry.FieldSituation)
-	}
-	if m.FieldCleared(entry.FieldEmotions) {
-		fields = append(fields, entry.FieldEmotions)
+	if m.FieldCleared(reminderschedule.FieldCreatedByDoctorID) {
+		fields = append(fields, reminderschedule.FieldCreatedByDoctorID)
 	}
-	if m.FieldCleared(entry.FieldTriggers) {
-		fields = append(fields, entry.FieldTriggers)
+	if m.FieldCleared(reminderschedule.FieldWeekdays) {
+		fields = append(fields, reminderschedule.FieldWeekdays)
 	}
-	if m.FieldCleared(entry.FieldTechniques) {
-		fields = append(fields, entry.FieldTechniques)
+	if m.FieldCleared(reminderschedule.FieldInactivityDays) {
+		fields = append(fields, reminderschedule.FieldInactivityDays)
 	}
-	if m.FieldCleared(entry.FieldStutterFrequency) {
-		fields = append(fields, entry.FieldStutterFrequency)
+	if m.FieldCleared(reminderschedule.FieldMessage) {
+		fields = append(fields, reminderschedule.FieldMessage)
 	}
-	if m.FieldCleared(entry.FieldNotes) {
-		fields = append(fields, entry.FieldNotes)
+	if m.FieldCleared(reminderschedule.FieldNextRunAt) {
+		fields = append(fields, reminderschedule.FieldNextRunAt)
 	}
-	if m.FieldCleared(entry.FieldTags) {
-		fields = append(fields, entry.FieldTags)
+	if m.FieldCleared(reminderschedule.FieldLastSentAt) {
+		fields = append(fields, reminderschedule.FieldLastSentAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EntryMutation) FieldCleared(name string) bool {
+func (m *ReminderScheduleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EntryMutation) ClearField(name string) error {
+func (m *ReminderScheduleMutation) ClearField(name string) error {
 	switch name {
-	case entry.FieldSituation:
-		m.ClearSituation()
-		return nil
-	case entry.FieldEmotions:
-		m.ClearEmotions()
+	case reminderschedule.FieldCreatedByDoctorID:
+		m.ClearCreatedByDoctorID()
 		return nil
-	case entry.FieldTriggers:
-		m.ClearTriggers()
+	case reminderschedule.FieldWeekdays:
+		m.ClearWeekdays()
 		return nil
-	case entry.FieldTechniques:
-		m.ClearTechniques()
+	case reminderschedule.FieldInactivityDays:
+		m.ClearInactivityDays()
 		return nil
-	case entry.FieldStutterFrequency:
-		m.ClearStutterFrequency()
+	case reminderschedule.FieldMessage:
+		m.ClearMessage()
 		return nil
-	case entry.FieldNotes:
-		m.ClearNotes()
+	case reminderschedule.FieldNextRunAt:
+		m.ClearNextRunAt()
 		return nil
-	case entry.FieldTags:
-		m.ClearTags()
+	case reminderschedule.FieldLastSentAt:
+		m.ClearLastSentAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Entry nullable field %s", name)
+	return fmt.Errorf("unknown ReminderSchedule nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EntryMutation) ResetField(name string) error {
+func (m *ReminderScheduleMutation) ResetField(name string) error {
 	switch name {
-	case entry.FieldCreatedAt:
+	case reminderschedule.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case entry.FieldUpdatedAt:
+	case reminderschedule.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case entry.FieldPatientID:
+	case reminderschedule.FieldPatientID:
 		m.ResetPatientID()
 		return nil
-	case entry.FieldHappenedAt:
-		m.ResetHappenedAt()
+	case reminderschedule.FieldCreatedByDoctorID:
+		m.ResetCreatedByDoctorID()
 		return nil
-	case entry.FieldSituation:
-		m.ResetSituation()
+	case reminderschedule.FieldKind:
+		m.ResetKind()
 		return nil
-	case entry.FieldEmotions:
-		m.ResetEmotions()
+	case reminderschedule.FieldChannel:
+		m.ResetChannel()
 		return nil
-	case entry.FieldTriggers:
-		m.ResetTriggers()
+	case reminderschedule.FieldTimezone:
+		m.ResetTimezone()
 		return nil
-	case entry.FieldTechniques:
-		m.ResetTechniques()
+	case reminderschedule.FieldTimeOfDay:
+		m.ResetTimeOfDay()
 		return nil
-	case entry.FieldStutterFrequency:
-		m.ResetStutterFrequency()
+	case reminderschedule.FieldWeekdays:
+		m.ResetWeekdays()
 		return nil
-	case entry.FieldNotes:
-		m.ResetNotes()
+	case reminderschedule.FieldInactivityDays:
+		m.ResetInactivityDays()
 		return nil
-	case entry.FieldTags:
-		m.ResetTags()
+	case reminderschedule.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case reminderschedule.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case reminderschedule.FieldNextRunAt:
+		m.ResetNextRunAt()
+		return nil
+	case reminderschedule.FieldLastSentAt:
+		m.ResetLastSentAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Entry field %s", name)
+	return fmt.Errorf("unknown ReminderSchedule field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EntryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
+func (m *ReminderScheduleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
 	if m.patient != nil {
-		edges = append(edges, entry.EdgePatient)
-	}
-	if m.shares != nil {
-		edges = append(edges, entry.EdgeShares)
+		edges = append(edges, reminderschedule.EdgePatient)
 	}
-	if m.comments != nil {
-		edges = append(edges, entry.EdgeComments)
+	if m.created_by != nil {
+		edges = append(edges, reminderschedule.EdgeCreatedBy)
 	}
-	if m.analysis_jobs != nil {
-		edges = append(edges, entry.EdgeAnalysisJobs)
+	if m.deliveries != nil {
+		edges = append(edges, reminderschedule.EdgeDeliveries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EntryMutation) AddedIDs(name string) []ent.Value {
+func (m *ReminderScheduleMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case entry.EdgePatient:
+	case reminderschedule.EdgePatient:
 		if id := m.patient; id != nil {
 			return []ent.Value{*id}
 		}
-	case entry.EdgeShares:
-		ids := make([]ent.Value, 0, len(m.shares))
-		for id := range m.shares {
-			ids = append(ids, id)
-		}
-		return ids
-	case entry.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.comments))
-		for id := range m.comments {
-			ids = append(ids, id)
+	case reminderschedule.EdgeCreatedBy:
+		if id := m.created_by; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case entry.EdgeAnalysisJobs:
-		ids := make([]ent.Value, 0, len(m.analysis_jobs))
-		for id := range m.analysis_jobs {
+	case reminderschedule.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5460,39 +21307,21 @@ func (m *EntryMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EntryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedshares != nil {
-		edges = append(edges, entry.EdgeShares)
-	}
-	if m.removedcomments != nil {
-		edges = append(edges, entry.EdgeComments)
-	}
-	if m.removedanalysis_jobs != nil {
-		edges = append(edges, entry.EdgeAnalysisJobs)
+func (m *ReminderScheduleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removeddeliveries != nil {
+		edges = append(edges, reminderschedule.EdgeDeliveries)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EntryMutation) RemovedIDs(name string) []ent.Value {
+func (m *ReminderScheduleMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case entry.EdgeShares:
-		ids := make([]ent.Value, 0, len(m.removedshares))
-		for id := range m.removedshares {
-			ids = append(ids, id)
-		}
-		return ids
-	case entry.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.removedcomments))
-		for id := range m.removedcomments {
-			ids = append(ids, id)
-		}
-		return ids
-	case entry.EdgeAnalysisJobs:
-		ids := make([]ent.Value, 0, len(m.removedanalysis_jobs))
-		for id := range m.removedanalysis_jobs {
+	case reminderschedule.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5501,103 +21330,102 @@ func (m *EntryMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EntryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
+func (m *ReminderScheduleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
 	if m.clearedpatient {
-		edges = append(edges, entry.EdgePatient)
-	}
-	if m.clearedshares {
-		edges = append(edges, entry.EdgeShares)
+		edges = append(edges, reminderschedule.EdgePatient)
 	}
-	if m.clearedcomments {
-		edges = append(edges, entry.EdgeComments)
+	if m.clearedcreated_by {
+		edges = append(edges, reminderschedule.EdgeCreatedBy)
 	}
-	if m.clearedanalysis_jobs {
-		edges = append(edges, entry.EdgeAnalysisJobs)
+	if m.cleareddeliveries {
+		edges = append(edges, reminderschedule.EdgeDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EntryMutation) EdgeCleared(name string) bool {
+func (m *ReminderScheduleMutation) EdgeCleared(name string) bool {
 	switch name {
-	case entry.EdgePatient:
+	case reminderschedule.EdgePatient:
 		return m.clearedpatient
-	case entry.EdgeShares:
-		return m.clearedshares
-	case entry.EdgeComments:
-		return m.clearedcomments
-	case entry.EdgeAnalysisJobs:
-		return m.clearedanalysis_jobs
+	case reminderschedule.EdgeCreatedBy:
+		return m.clearedcreated_by
+	case reminderschedule.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EntryMutation) ClearEdge(name string) error {
+func (m *ReminderScheduleMutation) ClearEdge(name string) error {
 	switch name {
-	case entry.EdgePatient:
+	case reminderschedule.EdgePatient:
 		m.ClearPatient()
 		return nil
+	case reminderschedule.EdgeCreatedBy:
+		m.ClearCreatedBy()
+		return nil
 	}
-	return fmt.Errorf("unknown Entry unique edge %s", name)
+	return fmt.Errorf("unknown ReminderSchedule unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EntryMutation) ResetEdge(name string) error {
+func (m *ReminderScheduleMutation) ResetEdge(name string) error {
 	switch name {
-	case entry.EdgePatient:
+	case reminderschedule.EdgePatient:
 		m.ResetPatient()
 		return nil
-	case entry.EdgeShares:
-		m.ResetShares()
-		return nil
-	case entry.EdgeComments:
-		m.ResetComments()
+	case reminderschedule.EdgeCreatedBy:
+		m.ResetCreatedBy()
 		return nil
-	case entry.EdgeAnalysisJobs:
-		m.ResetAnalysisJobs()
+	case reminderschedule.EdgeDeliveries:
+		m.ResetDeliveries()
 		return nil
 	}
-	return fmt.Errorf("unknown Entry edge %s", name)
+	return fmt.Errorf("unknown ReminderSchedule edge %s", name)
 }
 
-// EntryShareMutation represents an operation that mutates the EntryShare nodes in the graph.
-type EntryShareMutation struct {
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
 	config
-	op                        Op
-	typ                       string
-	id                        *uuid.UUID
-	created_at                *time.Time
-	updated_at                *time.Time
-	shared_at                 *time.Time
-	revoked_at                *time.Time
-	clearedFields             map[string]struct{}
-	entry                     *uuid.UUID
-	clearedentry              bool
-	shared_by_patient         *uuid.UUID
-	clearedshared_by_patient  bool
-	shared_with_doctor        *uuid.UUID
-	clearedshared_with_doctor bool
-	done                      bool
-	oldValue                  func(context.Context) (*EntryShare, error)
-	predicates                []predicate.EntryShare
-}
-
-var _ ent.Mutation = (*EntryShareMutation)(nil)
-
-// entryshareOption allows management of the mutation configuration using functional options.
-type entryshareOption func(*EntryShareMutation)
-
-// newEntryShareMutation creates new mutation for the EntryShare entity.
-func newEntryShareMutation(c config, op Op, opts ...entryshareOption) *EntryShareMutation {
-	m := &EntryShareMutation{
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	created_at          *time.Time
+	updated_at          *time.Time
+	held_at             *time.Time
+	duration_minutes    *int
+	addduration_minutes *int
+	clinician_notes     *string
+	patient_summary     *string
+	clearedFields       map[string]struct{}
+	patient             *uuid.UUID
+	clearedpatient      bool
+	doctor              *uuid.UUID
+	cleareddoctor       bool
+	entries             map[uuid.UUID]struct{}
+	removedentries      map[uuid.UUID]struct{}
+	clearedentries      bool
+	done                bool
+	oldValue            func(context.Context) (*Session, error)
+	predicates          []predicate.Session
+}
+
+var _ ent.Mutation = (*SessionMutation)(nil)
+
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
+
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEntryShare,
+		typ:           TypeSession,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5606,20 +21434,20 @@ func newEntryShareMutation(c config, op Op, opts ...entryshareOption) *EntryShar
 	return m
 }
 
-// withEntryShareID sets the ID field of the mutation.
-func withEntryShareID(id uuid.UUID) entryshareOption {
-	return func(m *EntryShareMutation) {
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id uuid.UUID) sessionOption {
+	return func(m *SessionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *EntryShare
+			value *Session
 		)
-		m.oldValue = func(ctx context.Context) (*EntryShare, error) {
+		m.oldValue = func(ctx context.Context) (*Session, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().EntryShare.Get(ctx, id)
+					value, err = m.Client().Session.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5628,10 +21456,10 @@ func withEntryShareID(id uuid.UUID) entryshareOption {
 	}
 }
 
-// withEntryShare sets the old EntryShare of the mutation.
-func withEntryShare(node *EntryShare) entryshareOption {
-	return func(m *EntryShareMutation) {
-		m.oldValue = func(context.Context) (*EntryShare, error) {
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5640,7 +21468,7 @@ func withEntryShare(node *EntryShare) entryshareOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EntryShareMutation) Client() *Client {
+func (m SessionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5648,7 +21476,7 @@ func (m EntryShareMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EntryShareMutation) Tx() (*Tx, error) {
+func (m SessionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5658,14 +21486,14 @@ func (m EntryShareMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of EntryShare entities.
-func (m *EntryShareMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Session entities.
+func (m *SessionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EntryShareMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SessionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5676,7 +21504,7 @@ func (m *EntryShareMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EntryShareMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SessionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5685,19 +21513,19 @@ func (m *EntryShareMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().EntryShare.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *EntryShareMutation) SetCreatedAt(t time.Time) {
+func (m *SessionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EntryShareMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5705,10 +21533,10 @@ func (m *EntryShareMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the EntryShare entity.
-// If the EntryShare object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryShareMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5723,17 +21551,17 @@ func (m *EntryShareMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EntryShareMutation) ResetCreatedAt() {
+func (m *SessionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *EntryShareMutation) SetUpdatedAt(t time.Time) {
+func (m *SessionMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EntryShareMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *SessionMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -5741,10 +21569,10 @@ func (m *EntryShareMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the EntryShare entity.
-// If the EntryShare object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryShareMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SessionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -5759,293 +21587,403 @@ func (m *EntryShareMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EntryShareMutation) ResetUpdatedAt() {
+func (m *SessionMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetEntryID sets the "entry_id" field.
-func (m *EntryShareMutation) SetEntryID(u uuid.UUID) {
-	m.entry = &u
+// SetPatientID sets the "patient_id" field.
+func (m *SessionMutation) SetPatientID(u uuid.UUID) {
+	m.patient = &u
 }
 
-// EntryID returns the value of the "entry_id" field in the mutation.
-func (m *EntryShareMutation) EntryID() (r uuid.UUID, exists bool) {
-	v := m.entry
+// PatientID returns the value of the "patient_id" field in the mutation.
+func (m *SessionMutation) PatientID() (r uuid.UUID, exists bool) {
+	v := m.patient
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEntryID returns the old "entry_id" field's value of the EntryShare entity.
-// If the EntryShare object wasn't provided to the builder, the object is fetched from the database.
+// OldPatientID returns the old "patient_id" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryShareMutation) OldEntryID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *SessionMutation) OldPatientID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEntryID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPatientID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEntryID requires an ID field in the mutation")
+		return v, errors.New("OldPatientID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEntryID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPatientID: %w", err)
 	}
-	return oldValue.EntryID, nil
+	return oldValue.PatientID, nil
 }
 
-// ResetEntryID resets all changes to the "entry_id" field.
-func (m *EntryShareMutation) ResetEntryID() {
-	m.entry = nil
+// ResetPatientID resets all changes to the "patient_id" field.
+func (m *SessionMutation) ResetPatientID() {
+	m.patient = nil
 }
 
-// SetSharedByPatientID sets the "shared_by_patient_id" field.
-func (m *EntryShareMutation) SetSharedByPatientID(u uuid.UUID) {
-	m.shared_by_patient = &u
+// SetDoctorID sets the "doctor_id" field.
+func (m *SessionMutation) SetDoctorID(u uuid.UUID) {
+	m.doctor = &u
 }
 
-// SharedByPatientID returns the value of the "shared_by_patient_id" field in the mutation.
-func (m *EntryShareMutation) SharedByPatientID() (r uuid.UUID, exists bool) {
-	v := m.shared_by_patient
+// DoctorID returns the value of the "doctor_id" field in the mutation.
+func (m *SessionMutation) DoctorID() (r uuid.UUID, exists bool) {
+	v := m.doctor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSharedByPatientID returns the old "shared_by_patient_id" field's value of the EntryShare entity.
-// If the EntryShare object wasn't provided to the builder, the object is fetched from the database.
+// OldDoctorID returns the old "doctor_id" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryShareMutation) OldSharedByPatientID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *SessionMutation) OldDoctorID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSharedByPatientID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDoctorID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSharedByPatientID requires an ID field in the mutation")
+		return v, errors.New("OldDoctorID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSharedByPatientID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDoctorID: %w", err)
 	}
-	return oldValue.SharedByPatientID, nil
+	return oldValue.DoctorID, nil
 }
 
-// ResetSharedByPatientID resets all changes to the "shared_by_patient_id" field.
-func (m *EntryShareMutation) ResetSharedByPatientID() {
-	m.shared_by_patient = nil
+// ResetDoctorID resets all changes to the "doctor_id" field.
+func (m *SessionMutation) ResetDoctorID() {
+	m.doctor = nil
 }
 
-// SetSharedWithDoctorID sets the "shared_with_doctor_id" field.
-func (m *EntryShareMutation) SetSharedWithDoctorID(u uuid.UUID) {
-	m.shared_with_doctor = &u
+// SetHeldAt sets the "held_at" field.
+func (m *SessionMutation) SetHeldAt(t time.Time) {
+	m.held_at = &t
 }
 
-// SharedWithDoctorID returns the value of the "shared_with_doctor_id" field in the mutation.
-func (m *EntryShareMutation) SharedWithDoctorID() (r uuid.UUID, exists bool) {
-	v := m.shared_with_doctor
+// HeldAt returns the value of the "held_at" field in the mutation.
+func (m *SessionMutation) HeldAt() (r time.Time, exists bool) {
+	v := m.held_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSharedWithDoctorID returns the old "shared_with_doctor_id" field's value of the EntryShare entity.
-// If the EntryShare object wasn't provided to the builder, the object is fetched from the database.
+// OldHeldAt returns the old "held_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryShareMutation) OldSharedWithDoctorID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *SessionMutation) OldHeldAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSharedWithDoctorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldHeldAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSharedWithDoctorID requires an ID field in the mutation")
+		return v, errors.New("OldHeldAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSharedWithDoctorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldHeldAt: %w", err)
 	}
-	return oldValue.SharedWithDoctorID, nil
+	return oldValue.HeldAt, nil
 }
 
-// ResetSharedWithDoctorID resets all changes to the "shared_with_doctor_id" field.
-func (m *EntryShareMutation) ResetSharedWithDoctorID() {
-	m.shared_with_doctor = nil
+// ResetHeldAt resets all changes to the "held_at" field.
+func (m *SessionMutation) ResetHeldAt() {
+	m.held_at = nil
 }
 
-// SetSharedAt sets the "shared_at" field.
-func (m *EntryShareMutation) SetSharedAt(t time.Time) {
-	m.shared_at = &t
+// SetDurationMinutes sets the "duration_minutes" field.
+func (m *SessionMutation) SetDurationMinutes(i int) {
+	m.duration_minutes = &i
+	m.addduration_minutes = nil
 }
 
-// SharedAt returns the value of the "shared_at" field in the mutation.
-func (m *EntryShareMutation) SharedAt() (r time.Time, exists bool) {
-	v := m.shared_at
+// DurationMinutes returns the value of the "duration_minutes" field in the mutation.
+func (m *SessionMutation) DurationMinutes() (r int, exists bool) {
+	v := m.duration_minutes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSharedAt returns the old "shared_at" field's value of the EntryShare entity.
-// If the EntryShare object wasn't provided to the builder, the object is fetched from the database.
+// OldDurationMinutes returns the old "duration_minutes" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryShareMutation) OldSharedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SessionMutation) OldDurationMinutes(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSharedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDurationMinutes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSharedAt requires an ID field in the mutation")
+		return v, errors.New("OldDurationMinutes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSharedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDurationMinutes: %w", err)
 	}
-	return oldValue.SharedAt, nil
+	return oldValue.DurationMinutes, nil
 }
 
-// ResetSharedAt resets all changes to the "shared_at" field.
-func (m *EntryShareMutation) ResetSharedAt() {
-	m.shared_at = nil
+// AddDurationMinutes adds i to the "duration_minutes" field.
+func (m *SessionMutation) AddDurationMinutes(i int) {
+	if m.addduration_minutes != nil {
+		*m.addduration_minutes += i
+	} else {
+		m.addduration_minutes = &i
+	}
 }
 
-// SetRevokedAt sets the "revoked_at" field.
-func (m *EntryShareMutation) SetRevokedAt(t time.Time) {
-	m.revoked_at = &t
+// AddedDurationMinutes returns the value that was added to the "duration_minutes" field in this mutation.
+func (m *SessionMutation) AddedDurationMinutes() (r int, exists bool) {
+	v := m.addduration_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RevokedAt returns the value of the "revoked_at" field in the mutation.
-func (m *EntryShareMutation) RevokedAt() (r time.Time, exists bool) {
-	v := m.revoked_at
+// ClearDurationMinutes clears the value of the "duration_minutes" field.
+func (m *SessionMutation) ClearDurationMinutes() {
+	m.duration_minutes = nil
+	m.addduration_minutes = nil
+	m.clearedFields[session.FieldDurationMinutes] = struct{}{}
+}
+
+// DurationMinutesCleared returns if the "duration_minutes" field was cleared in this mutation.
+func (m *SessionMutation) DurationMinutesCleared() bool {
+	_, ok := m.clearedFields[session.FieldDurationMinutes]
+	return ok
+}
+
+// ResetDurationMinutes resets all changes to the "duration_minutes" field.
+func (m *SessionMutation) ResetDurationMinutes() {
+	m.duration_minutes = nil
+	m.addduration_minutes = nil
+	delete(m.clearedFields, session.FieldDurationMinutes)
+}
+
+// SetClinicianNotes sets the "clinician_notes" field.
+func (m *SessionMutation) SetClinicianNotes(s string) {
+	m.clinician_notes = &s
+}
+
+// ClinicianNotes returns the value of the "clinician_notes" field in the mutation.
+func (m *SessionMutation) ClinicianNotes() (r string, exists bool) {
+	v := m.clinician_notes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRevokedAt returns the old "revoked_at" field's value of the EntryShare entity.
-// If the EntryShare object wasn't provided to the builder, the object is fetched from the database.
+// OldClinicianNotes returns the old "clinician_notes" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EntryShareMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *SessionMutation) OldClinicianNotes(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldClinicianNotes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+		return v, errors.New("OldClinicianNotes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldClinicianNotes: %w", err)
 	}
-	return oldValue.RevokedAt, nil
+	return oldValue.ClinicianNotes, nil
 }
 
-// ClearRevokedAt clears the value of the "revoked_at" field.
-func (m *EntryShareMutation) ClearRevokedAt() {
-	m.revoked_at = nil
-	m.clearedFields[entryshare.FieldRevokedAt] = struct{}{}
+// ClearClinicianNotes clears the value of the "clinician_notes" field.
+func (m *SessionMutation) ClearClinicianNotes() {
+	m.clinician_notes = nil
+	m.clearedFields[session.FieldClinicianNotes] = struct{}{}
 }
 
-// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
-func (m *EntryShareMutation) RevokedAtCleared() bool {
-	_, ok := m.clearedFields[entryshare.FieldRevokedAt]
+// ClinicianNotesCleared returns if the "clinician_notes" field was cleared in this mutation.
+func (m *SessionMutation) ClinicianNotesCleared() bool {
+	_, ok := m.clearedFields[session.FieldClinicianNotes]
 	return ok
 }
 
-// ResetRevokedAt resets all changes to the "revoked_at" field.
-func (m *EntryShareMutation) ResetRevokedAt() {
-	m.revoked_at = nil
-	delete(m.clearedFields, entryshare.FieldRevokedAt)
+// ResetClinicianNotes resets all changes to the "clinician_notes" field.
+func (m *SessionMutation) ResetClinicianNotes() {
+	m.clinician_notes = nil
+	delete(m.clearedFields, session.FieldClinicianNotes)
 }
 
-// ClearEntry clears the "entry" edge to the Entry entity.
-func (m *EntryShareMutation) ClearEntry() {
-	m.clearedentry = true
-	m.clearedFields[entryshare.FieldEntryID] = struct{}{}
+// SetPatientSummary sets the "patient_summary" field.
+func (m *SessionMutation) SetPatientSummary(s string) {
+	m.patient_summary = &s
 }
 
-// EntryCleared reports if the "entry" edge to the Entry entity was cleared.
-func (m *EntryShareMutation) EntryCleared() bool {
-	return m.clearedentry
+// PatientSummary returns the value of the "patient_summary" field in the mutation.
+func (m *SessionMutation) PatientSummary() (r string, exists bool) {
+	v := m.patient_summary
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// EntryIDs returns the "entry" edge IDs in the mutation.
+// OldPatientSummary returns the old "patient_summary" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldPatientSummary(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPatientSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPatientSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPatientSummary: %w", err)
+	}
+	return oldValue.PatientSummary, nil
+}
+
+// ClearPatientSummary clears the value of the "patient_summary" field.
+func (m *SessionMutation) ClearPatientSummary() {
+	m.patient_summary = nil
+	m.clearedFields[session.FieldPatientSummary] = struct{}{}
+}
+
+// PatientSummaryCleared returns if the "patient_summary" field was cleared in this mutation.
+func (m *SessionMutation) PatientSummaryCleared() bool {
+	_, ok := m.clearedFields[session.FieldPatientSummary]
+	return ok
+}
+
+// ResetPatientSummary resets all changes to the "patient_summary" field.
+func (m *SessionMutation) ResetPatientSummary() {
+	m.patient_summary = nil
+	delete(m.clearedFields, session.FieldPatientSummary)
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (m *SessionMutation) ClearPatient() {
+	m.clearedpatient = true
+	m.clearedFields[session.FieldPatientID] = struct{}{}
+}
+
+// PatientCleared reports if the "patient" edge to the Patient entity was cleared.
+func (m *SessionMutation) PatientCleared() bool {
+	return m.clearedpatient
+}
+
+// PatientIDs returns the "patient" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EntryID instead. It exists only for internal usage by the builders.
-func (m *EntryShareMutation) EntryIDs() (ids []uuid.UUID) {
-	if id := m.entry; id != nil {
+// PatientID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) PatientIDs() (ids []uuid.UUID) {
+	if id := m.patient; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetEntry resets all changes to the "entry" edge.
-func (m *EntryShareMutation) ResetEntry() {
-	m.entry = nil
-	m.clearedentry = false
+// ResetPatient resets all changes to the "patient" edge.
+func (m *SessionMutation) ResetPatient() {
+	m.patient = nil
+	m.clearedpatient = false
 }
 
-// ClearSharedByPatient clears the "shared_by_patient" edge to the Patient entity.
-func (m *EntryShareMutation) ClearSharedByPatient() {
-	m.clearedshared_by_patient = true
-	m.clearedFields[entryshare.FieldSharedByPatientID] = struct{}{}
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (m *SessionMutation) ClearDoctor() {
+	m.cleareddoctor = true
+	m.clearedFields[session.FieldDoctorID] = struct{}{}
 }
 
-// SharedByPatientCleared reports if the "shared_by_patient" edge to the Patient entity was cleared.
-func (m *EntryShareMutation) SharedByPatientCleared() bool {
-	return m.clearedshared_by_patient
+// DoctorCleared reports if the "doctor" edge to the Doctor entity was cleared.
+func (m *SessionMutation) DoctorCleared() bool {
+	return m.cleareddoctor
 }
 
-// SharedByPatientIDs returns the "shared_by_patient" edge IDs in the mutation.
+// DoctorIDs returns the "doctor" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SharedByPatientID instead. It exists only for internal usage by the builders.
-func (m *EntryShareMutation) SharedByPatientIDs() (ids []uuid.UUID) {
-	if id := m.shared_by_patient; id != nil {
+// DoctorID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) DoctorIDs() (ids []uuid.UUID) {
+	if id := m.doctor; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSharedByPatient resets all changes to the "shared_by_patient" edge.
-func (m *EntryShareMutation) ResetSharedByPatient() {
-	m.shared_by_patient = nil
-	m.clearedshared_by_patient = false
+// ResetDoctor resets all changes to the "doctor" edge.
+func (m *SessionMutation) ResetDoctor() {
+	m.doctor = nil
+	m.cleareddoctor = false
 }
 
-// ClearSharedWithDoctor clears the "shared_with_doctor" edge to the Doctor entity.
-func (m *EntryShareMutation) ClearSharedWithDoctor() {
-	m.clearedshared_with_doctor = true
-	m.clearedFields[entryshare.FieldSharedWithDoctorID] = struct{}{}
+// AddEntryIDs adds the "entries" edge to the Entry entity by ids.
+func (m *SessionMutation) AddEntryIDs(ids ...uuid.UUID) {
+	if m.entries == nil {
+		m.entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.entries[ids[i]] = struct{}{}
+	}
 }
 
-// SharedWithDoctorCleared reports if the "shared_with_doctor" edge to the Doctor entity was cleared.
-func (m *EntryShareMutation) SharedWithDoctorCleared() bool {
-	return m.clearedshared_with_doctor
+// ClearEntries clears the "entries" edge to the Entry entity.
+func (m *SessionMutation) ClearEntries() {
+	m.clearedentries = true
 }
 
-// SharedWithDoctorIDs returns the "shared_with_doctor" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SharedWithDoctorID instead. It exists only for internal usage by the builders.
-func (m *EntryShareMutation) SharedWithDoctorIDs() (ids []uuid.UUID) {
-	if id := m.shared_with_doctor; id != nil {
-		ids = append(ids, *id)
+// EntriesCleared reports if the "entries" edge to the Entry entity was cleared.
+func (m *SessionMutation) EntriesCleared() bool {
+	return m.clearedentries
+}
+
+// RemoveEntryIDs removes the "entries" edge to the Entry entity by IDs.
+func (m *SessionMutation) RemoveEntryIDs(ids ...uuid.UUID) {
+	if m.removedentries == nil {
+		m.removedentries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.entries, ids[i])
+		m.removedentries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEntries returns the removed IDs of the "entries" edge to the Entry entity.
+func (m *SessionMutation) RemovedEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedentries {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetSharedWithDoctor resets all changes to the "shared_with_doctor" edge.
-func (m *EntryShareMutation) ResetSharedWithDoctor() {
-	m.shared_with_doctor = nil
-	m.clearedshared_with_doctor = false
+// EntriesIDs returns the "entries" edge IDs in the mutation.
+func (m *SessionMutation) EntriesIDs() (ids []uuid.UUID) {
+	for id := range m.entries {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the EntryShareMutation builder.
-func (m *EntryShareMutation) Where(ps ...predicate.EntryShare) {
+// ResetEntries resets all changes to the "entries" edge.
+func (m *SessionMutation) ResetEntries() {
+	m.entries = nil
+	m.clearedentries = false
+	m.removedentries = nil
+}
+
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EntryShareMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EntryShareMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.EntryShare, len(ps))
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6053,45 +21991,48 @@ func (m *EntryShareMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EntryShareMutation) Op() Op {
+func (m *SessionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EntryShareMutation) SetOp(op Op) {
+func (m *SessionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (EntryShare).
-func (m *EntryShareMutation) Type() string {
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EntryShareMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.created_at != nil {
-		fields = append(fields, entryshare.FieldCreatedAt)
+		fields = append(fields, session.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, entryshare.FieldUpdatedAt)
+		fields = append(fields, session.FieldUpdatedAt)
 	}
-	if m.entry != nil {
-		fields = append(fields, entryshare.FieldEntryID)
+	if m.patient != nil {
+		fields = append(fields, session.FieldPatientID)
 	}
-	if m.shared_by_patient != nil {
-		fields = append(fields, entryshare.FieldSharedByPatientID)
+	if m.doctor != nil {
+		fields = append(fields, session.FieldDoctorID)
 	}
-	if m.shared_with_doctor != nil {
-		fields = append(fields, entryshare.FieldSharedWithDoctorID)
+	if m.held_at != nil {
+		fields = append(fields, session.FieldHeldAt)
 	}
-	if m.shared_at != nil {
-		fields = append(fields, entryshare.FieldSharedAt)
+	if m.duration_minutes != nil {
+		fields = append(fields, session.FieldDurationMinutes)
 	}
-	if m.revoked_at != nil {
-		fields = append(fields, entryshare.FieldRevokedAt)
+	if m.clinician_notes != nil {
+		fields = append(fields, session.FieldClinicianNotes)
+	}
+	if m.patient_summary != nil {
+		fields = append(fields, session.FieldPatientSummary)
 	}
 	return fields
 }
@@ -6099,22 +22040,24 @@ func (m *EntryShareMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EntryShareMutation) Field(name string) (ent.Value, bool) {
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case entryshare.FieldCreatedAt:
+	case session.FieldCreatedAt:
 		return m.CreatedAt()
-	case entryshare.FieldUpdatedAt:
+	case session.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case entryshare.FieldEntryID:
-		return m.EntryID()
-	case entryshare.FieldSharedByPatientID:
-		return m.SharedByPatientID()
-	case entryshare.FieldSharedWithDoctorID:
-		return m.SharedWithDoctorID()
-	case entryshare.FieldSharedAt:
-		return m.SharedAt()
-	case entryshare.FieldRevokedAt:
-		return m.RevokedAt()
+	case session.FieldPatientID:
+		return m.PatientID()
+	case session.FieldDoctorID:
+		return m.DoctorID()
+	case session.FieldHeldAt:
+		return m.HeldAt()
+	case session.FieldDurationMinutes:
+		return m.DurationMinutes()
+	case session.FieldClinicianNotes:
+		return m.ClinicianNotes()
+	case session.FieldPatientSummary:
+		return m.PatientSummary()
 	}
 	return nil, false
 }
@@ -6122,305 +22065,357 @@ func (m *EntryShareMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EntryShareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case entryshare.FieldCreatedAt:
+	case session.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case entryshare.FieldUpdatedAt:
+	case session.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case entryshare.FieldEntryID:
-		return m.OldEntryID(ctx)
-	case entryshare.FieldSharedByPatientID:
-		return m.OldSharedByPatientID(ctx)
-	case entryshare.FieldSharedWithDoctorID:
-		return m.OldSharedWithDoctorID(ctx)
-	case entryshare.FieldSharedAt:
-		return m.OldSharedAt(ctx)
-	case entryshare.FieldRevokedAt:
-		return m.OldRevokedAt(ctx)
+	case session.FieldPatientID:
+		return m.OldPatientID(ctx)
+	case session.FieldDoctorID:
+		return m.OldDoctorID(ctx)
+	case session.FieldHeldAt:
+		return m.OldHeldAt(ctx)
+	case session.FieldDurationMinutes:
+		return m.OldDurationMinutes(ctx)
+	case session.FieldClinicianNotes:
+		return m.OldClinicianNotes(ctx)
+	case session.FieldPatientSummary:
+		return m.OldPatientSummary(ctx)
 	}
-	return nil, fmt.Errorf("unknown EntryShare field %s", name)
+	return nil, fmt.Errorf("unknown Session field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EntryShareMutation) SetField(name string, value ent.Value) error {
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case entryshare.FieldCreatedAt:
+	case session.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case entryshare.FieldUpdatedAt:
+	case session.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case entryshare.FieldEntryID:
+	case session.FieldPatientID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEntryID(v)
+		m.SetPatientID(v)
 		return nil
-	case entryshare.FieldSharedByPatientID:
+	case session.FieldDoctorID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSharedByPatientID(v)
+		m.SetDoctorID(v)
 		return nil
-	case entryshare.FieldSharedWithDoctorID:
-		v, ok := value.(uuid.UUID)
+	case session.FieldHeldAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSharedWithDoctorID(v)
+		m.SetHeldAt(v)
 		return nil
-	case entryshare.FieldSharedAt:
-		v, ok := value.(time.Time)
+	case session.FieldDurationMinutes:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSharedAt(v)
+		m.SetDurationMinutes(v)
 		return nil
-	case entryshare.FieldRevokedAt:
-		v, ok := value.(time.Time)
+	case session.FieldClinicianNotes:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRevokedAt(v)
+		m.SetClinicianNotes(v)
+		return nil
+	case session.FieldPatientSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPatientSummary(v)
 		return nil
 	}
-	return fmt.Errorf("unknown EntryShare field %s", name)
+	return fmt.Errorf("unknown Session field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EntryShareMutation) AddedFields() []string {
-	return nil
+func (m *SessionMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_minutes != nil {
+		fields = append(fields, session.FieldDurationMinutes)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EntryShareMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldDurationMinutes:
+		return m.AddedDurationMinutes()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EntryShareMutation) AddField(name string, value ent.Value) error {
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case session.FieldDurationMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMinutes(v)
+		return nil
 	}
-	return fmt.Errorf("unknown EntryShare numeric field %s", name)
+	return fmt.Errorf("unknown Session numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EntryShareMutation) ClearedFields() []string {
+func (m *SessionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(entryshare.FieldRevokedAt) {
-		fields = append(fields, entryshare.FieldRevokedAt)
+	if m.FieldCleared(session.FieldDurationMinutes) {
+		fields = append(fields, session.FieldDurationMinutes)
+	}
+	if m.FieldCleared(session.FieldClinicianNotes) {
+		fields = append(fields, session.FieldClinicianNotes)
+	}
+	if m.FieldCleared(session.FieldPatientSummary) {
+		fields = append(fields, session.FieldPatientSummary)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EntryShareMutation) FieldCleared(name string) bool {
+func (m *SessionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EntryShareMutation) ClearField(name string) error {
+func (m *SessionMutation) ClearField(name string) error {
 	switch name {
-	case entryshare.FieldRevokedAt:
-		m.ClearRevokedAt()
+	case session.FieldDurationMinutes:
+		m.ClearDurationMinutes()
+		return nil
+	case session.FieldClinicianNotes:
+		m.ClearClinicianNotes()
+		return nil
+	case session.FieldPatientSummary:
+		m.ClearPatientSummary()
 		return nil
 	}
-	return fmt.Errorf("unknown EntryShare nullable field %s", name)
+	return fmt.Errorf("unknown Session nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EntryShareMutation) ResetField(name string) error {
+func (m *SessionMutation) ResetField(name string) error {
 	switch name {
-	case entryshare.FieldCreatedAt:
+	case session.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case entryshare.FieldUpdatedAt:
+	case session.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case entryshare.FieldEntryID:
-		m.ResetEntryID()
+	case session.FieldPatientID:
+		m.ResetPatientID()
 		return nil
-	case entryshare.FieldSharedByPatientID:
-		m.ResetSharedByPatientID()
+	case session.FieldDoctorID:
+		m.ResetDoctorID()
 		return nil
-	case entryshare.FieldSharedWithDoctorID:
-		m.ResetSharedWithDoctorID()
+	case session.FieldHeldAt:
+		m.ResetHeldAt()
 		return nil
-	case entryshare.FieldSharedAt:
-		m.ResetSharedAt()
+	case session.FieldDurationMinutes:
+		m.ResetDurationMinutes()
 		return nil
-	case entryshare.FieldRevokedAt:
-		m.ResetRevokedAt()
+	case session.FieldClinicianNotes:
+		m.ResetClinicianNotes()
+		return nil
+	case session.FieldPatientSummary:
+		m.ResetPatientSummary()
 		return nil
 	}
-	return fmt.Errorf("unknown EntryShare field %s", name)
+	return fmt.Errorf("unknown Session field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EntryShareMutation) AddedEdges() []string {
+func (m *SessionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 3)
-	if m.entry != nil {
-		edges = append(edges, entryshare.EdgeEntry)
+	if m.patient != nil {
+		edges = append(edges, session.EdgePatient)
 	}
-	if m.shared_by_patient != nil {
-		edges = append(edges, entryshare.EdgeSharedByPatient)
+	if m.doctor != nil {
+		edges = append(edges, session.EdgeDoctor)
 	}
-	if m.shared_with_doctor != nil {
-		edges = append(edges, entryshare.EdgeSharedWithDoctor)
+	if m.entries != nil {
+		edges = append(edges, session.EdgeEntries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EntryShareMutation) AddedIDs(name string) []ent.Value {
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case entryshare.EdgeEntry:
-		if id := m.entry; id != nil {
+	case session.EdgePatient:
+		if id := m.patient; id != nil {
 			return []ent.Value{*id}
 		}
-	case entryshare.EdgeSharedByPatient:
-		if id := m.shared_by_patient; id != nil {
+	case session.EdgeDoctor:
+		if id := m.doctor; id != nil {
 			return []ent.Value{*id}
 		}
-	case entryshare.EdgeSharedWithDoctor:
-		if id := m.shared_with_doctor; id != nil {
-			return []ent.Value{*id}
+	case session.EdgeEntries:
+		ids := make([]ent.Value, 0, len(m.entries))
+		for id := range m.entries {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EntryShareMutation) RemovedEdges() []string {
+func (m *SessionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 3)
+	if m.removedentries != nil {
+		edges = append(edges, session.EdgeEntries)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EntryShareMutation) RemovedIDs(name string) []ent.Value {
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgeEntries:
+		ids := make([]ent.Value, 0, len(m.removedentries))
+		for id := range m.removedentries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EntryShareMutation) ClearedEdges() []string {
+func (m *SessionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 3)
-	if m.clearedentry {
-		edges = append(edges, entryshare.EdgeEntry)
+	if m.clearedpatient {
+		edges = append(edges, session.EdgePatient)
 	}
-	if m.clearedshared_by_patient {
-		edges = append(edges, entryshare.EdgeSharedByPatient)
+	if m.cleareddoctor {
+		edges = append(edges, session.EdgeDoctor)
 	}
-	if m.clearedshared_with_doctor {
-		edges = append(edges, entryshare.EdgeSharedWithDoctor)
+	if m.clearedentries {
+		edges = append(edges, session.EdgeEntries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EntryShareMutation) EdgeCleared(name string) bool {
+func (m *SessionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case entryshare.EdgeEntry:
-		return m.clearedentry
-	case entryshare.EdgeSharedByPatient:
-		return m.clearedshared_by_patient
-	case entryshare.EdgeSharedWithDoctor:
-		return m.clearedshared_with_doctor
+	case session.EdgePatient:
+		return m.clearedpatient
+	case session.EdgeDoctor:
+		return m.cleareddoctor
+	case session.EdgeEntries:
+		return m.clearedentries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EntryShareMutation) ClearEdge(name string) error {
+func (m *SessionMutation) ClearEdge(name string) error {
 	switch name {
-	case entryshare.EdgeEntry:
-		m.ClearEntry()
-		return nil
-	case entryshare.EdgeSharedByPatient:
-		m.ClearSharedByPatient()
+	case session.EdgePatient:
+		m.ClearPatient()
 		return nil
-	case entryshare.EdgeSharedWithDoctor:
-		m.ClearSharedWithDoctor()
+	case session.EdgeDoctor:
+		m.ClearDoctor()
 		return nil
 	}
-	return fmt.Errorf("unknown EntryShare unique edge %s", name)
+	return fmt.Errorf("unknown Session unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EntryShareMutation) ResetEdge(name string) error {
+func (m *SessionMutation) ResetEdge(name string) error {
 	switch name {
-	case entryshare.EdgeEntry:
-		m.ResetEntry()
+	case session.EdgePatient:
+		m.ResetPatient()
 		return nil
-	case entryshare.EdgeSharedByPatient:
-		m.ResetSharedByPatient()
+	case session.EdgeDoctor:
+		m.ResetDoctor()
 		return nil
-	case entryshare.EdgeSharedWithDoctor:
-		m.ResetSharedWithDoctor()
+	case session.EdgeEntries:
+		m.ResetEntries()
 		return nil
 	}
-	return fmt.Errorf("unknown EntryShare edge %s", name)
+	return fmt.Errorf("unknown Session edge %s", name)
 }
 
-// PairingCodeMutation represents an operation that mutates the PairingCode nodes in the graph.
-type PairingCodeMutation struct {
+// VocabularyTermMutation represents an operation that mutates the VocabularyTerm nodes in the graph.
+type VocabularyTermMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *uuid.UUID
-	created_at                 *time.Time
-	updated_at                 *time.Time
-	code                       *string
-	expires_at                 *time.Time
-	consumed_at                *time.Time
-	clearedFields              map[string]struct{}
-	doctor                     *uuid.UUID
-	cleareddoctor              bool
-	consumed_by_patient        *uuid.UUID
-	clearedconsumed_by_patient bool
-	done                       bool
-	oldValue                   func(context.Context) (*PairingCode, error)
-	predicates                 []predicate.PairingCode
-}
-
-var _ ent.Mutation = (*PairingCodeMutation)(nil)
-
-// pairingcodeOption allows management of the mutation configuration using functional options.
-type pairingcodeOption func(*PairingCodeMutation)
-
-// newPairingCodeMutation creates new mutation for the PairingCode entity.
-func newPairingCodeMutation(c config, op Op, opts ...pairingcodeOption) *PairingCodeMutation {
-	m := &PairingCodeMutation{
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	created_at      *time.Time
+	updated_at      *time.Time
+	kind            *vocabularyterm.Kind
+	key             *string
+	labels          *map[string]string
+	synonyms        *[]string
+	appendsynonyms  []string
+	active          *bool
+	clearedFields   map[string]struct{}
+	practice        *uuid.UUID
+	clearedpractice bool
+	doctor          *uuid.UUID
+	cleareddoctor   bool
+	done            bool
+	oldValue        func(context.Context) (*VocabularyTerm, error)
+	predicates      []predicate.VocabularyTerm
+}
+
+var _ ent.Mutation = (*VocabularyTermMutation)(nil)
+
+// vocabularytermOption allows management of the mutation configuration using functional options.
+type vocabularytermOption func(*VocabularyTermMutation)
+
+// newVocabularyTermMutation creates new mutation for the VocabularyTerm entity.
+func newVocabularyTermMutation(c config, op Op, opts ...vocabularytermOption) *VocabularyTermMutation {
+	m := &VocabularyTermMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePairingCode,
+		typ:           TypeVocabularyTerm,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6429,20 +22424,20 @@ func newPairingCodeMutation(c config, op Op, opts ...pairingcodeOption) *Pairing
 	return m
 }
 
-// withPairingCodeID sets the ID field of the mutation.
-func withPairingCodeID(id uuid.UUID) pairingcodeOption {
-	return func(m *PairingCodeMutation) {
+// withVocabularyTermID sets the ID field of the mutation.
+func withVocabularyTermID(id uuid.UUID) vocabularytermOption {
+	return func(m *VocabularyTermMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PairingCode
+			value *VocabularyTerm
 		)
-		m.oldValue = func(ctx context.Context) (*PairingCode, error) {
+		m.oldValue = func(ctx context.Context) (*VocabularyTerm, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PairingCode.Get(ctx, id)
+					value, err = m.Client().VocabularyTerm.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6451,10 +22446,10 @@ func withPairingCodeID(id uuid.UUID) pairingcodeOption {
 	}
 }
 
-// withPairingCode sets the old PairingCode of the mutation.
-func withPairingCode(node *PairingCode) pairingcodeOption {
-	return func(m *PairingCodeMutation) {
-		m.oldValue = func(context.Context) (*PairingCode, error) {
+// withVocabularyTerm sets the old VocabularyTerm of the mutation.
+func withVocabularyTerm(node *VocabularyTerm) vocabularytermOption {
+	return func(m *VocabularyTermMutation) {
+		m.oldValue = func(context.Context) (*VocabularyTerm, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6463,7 +22458,7 @@ func withPairingCode(node *PairingCode) pairingcodeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PairingCodeMutation) Client() *Client {
+func (m VocabularyTermMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6471,7 +22466,7 @@ func (m PairingCodeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PairingCodeMutation) Tx() (*Tx, error) {
+func (m VocabularyTermMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6481,14 +22476,14 @@ func (m PairingCodeMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PairingCode entities.
-func (m *PairingCodeMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of VocabularyTerm entities.
+func (m *VocabularyTermMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PairingCodeMutation) ID() (id uuid.UUID, exists bool) {
+func (m *VocabularyTermMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6499,7 +22494,7 @@ func (m *PairingCodeMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PairingCodeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *VocabularyTermMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6508,19 +22503,19 @@ func (m *PairingCodeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PairingCode.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().VocabularyTerm.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PairingCodeMutation) SetCreatedAt(t time.Time) {
+func (m *VocabularyTermMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PairingCodeMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *VocabularyTermMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -6528,10 +22523,10 @@ func (m *PairingCodeMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PairingCode entity.
-// If the PairingCode object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the VocabularyTerm entity.
+// If the VocabularyTerm object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PairingCodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *VocabularyTermMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -6546,17 +22541,17 @@ func (m *PairingCodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PairingCodeMutation) ResetCreatedAt() {
+func (m *VocabularyTermMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *PairingCodeMutation) SetUpdatedAt(t time.Time) {
+func (m *VocabularyTermMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PairingCodeMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *VocabularyTermMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -6564,10 +22559,10 @@ func (m *PairingCodeMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PairingCode entity.
-// If the PairingCode object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the VocabularyTerm entity.
+// If the VocabularyTerm object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PairingCodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *VocabularyTermMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -6582,231 +22577,359 @@ func (m *PairingCodeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PairingCodeMutation) ResetUpdatedAt() {
+func (m *VocabularyTermMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetCode sets the "code" field.
-func (m *PairingCodeMutation) SetCode(s string) {
-	m.code = &s
+// SetKind sets the "kind" field.
+func (m *VocabularyTermMutation) SetKind(v vocabularyterm.Kind) {
+	m.kind = &v
 }
 
-// Code returns the value of the "code" field in the mutation.
-func (m *PairingCodeMutation) Code() (r string, exists bool) {
-	v := m.code
+// Kind returns the value of the "kind" field in the mutation.
+func (m *VocabularyTermMutation) Kind() (r vocabularyterm.Kind, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCode returns the old "code" field's value of the PairingCode entity.
-// If the PairingCode object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the VocabularyTerm entity.
+// If the VocabularyTerm object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PairingCodeMutation) OldCode(ctx context.Context) (v string, err error) {
+func (m *VocabularyTermMutation) OldKind(ctx context.Context) (v vocabularyterm.Kind, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCode requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.Code, nil
+	return oldValue.Kind, nil
 }
 
-// ResetCode resets all changes to the "code" field.
-func (m *PairingCodeMutation) ResetCode() {
-	m.code = nil
+// ResetKind resets all changes to the "kind" field.
+func (m *VocabularyTermMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetDoctorID sets the "doctor_id" field.
-func (m *PairingCodeMutation) SetDoctorID(u uuid.UUID) {
-	m.doctor = &u
+// SetKey sets the "key" field.
+func (m *VocabularyTermMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// DoctorID returns the value of the "doctor_id" field in the mutation.
-func (m *PairingCodeMutation) DoctorID() (r uuid.UUID, exists bool) {
-	v := m.doctor
+// Key returns the value of the "key" field in the mutation.
+func (m *VocabularyTermMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDoctorID returns the old "doctor_id" field's value of the PairingCode entity.
-// If the PairingCode object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the VocabularyTerm entity.
+// If the VocabularyTerm object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PairingCodeMutation) OldDoctorID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *VocabularyTermMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDoctorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDoctorID requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDoctorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.DoctorID, nil
+	return oldValue.Key, nil
 }
 
-// ResetDoctorID resets all changes to the "doctor_id" field.
-func (m *PairingCodeMutation) ResetDoctorID() {
-	m.doctor = nil
+// ResetKey resets all changes to the "key" field.
+func (m *VocabularyTermMutation) ResetKey() {
+	m.key = nil
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *PairingCodeMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// SetLabels sets the "labels" field.
+func (m *VocabularyTermMutation) SetLabels(value map[string]string) {
+	m.labels = &value
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *PairingCodeMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// Labels returns the value of the "labels" field in the mutation.
+func (m *VocabularyTermMutation) Labels() (r map[string]string, exists bool) {
+	v := m.labels
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the PairingCode entity.
-// If the PairingCode object wasn't provided to the builder, the object is fetched from the database.
+// OldLabels returns the old "labels" field's value of the VocabularyTerm entity.
+// If the VocabularyTerm object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PairingCodeMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *VocabularyTermMutation) OldLabels(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldLabels requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.Labels, nil
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *PairingCodeMutation) ResetExpiresAt() {
-	m.expires_at = nil
+// ResetLabels resets all changes to the "labels" field.
+func (m *VocabularyTermMutation) ResetLabels() {
+	m.labels = nil
 }
 
-// SetConsumedAt sets the "consumed_at" field.
-func (m *PairingCodeMutation) SetConsumedAt(t time.Time) {
-	m.consumed_at = &t
+// SetSynonyms sets the "synonyms" field.
+func (m *VocabularyTermMutation) SetSynonyms(s []string) {
+	m.synonyms = &s
+	m.appendsynonyms = nil
 }
 
-// ConsumedAt returns the value of the "consumed_at" field in the mutation.
-func (m *PairingCodeMutation) ConsumedAt() (r time.Time, exists bool) {
-	v := m.consumed_at
+// Synonyms returns the value of the "synonyms" field in the mutation.
+func (m *VocabularyTermMutation) Synonyms() (r []string, exists bool) {
+	v := m.synonyms
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConsumedAt returns the old "consumed_at" field's value of the PairingCode entity.
-// If the PairingCode object wasn't provided to the builder, the object is fetched from the database.
+// OldSynonyms returns the old "synonyms" field's value of the VocabularyTerm entity.
+// If the VocabularyTerm object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PairingCodeMutation) OldConsumedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *VocabularyTermMutation) OldSynonyms(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConsumedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSynonyms is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConsumedAt requires an ID field in the mutation")
+		return v, errors.New("OldSynonyms requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConsumedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSynonyms: %w", err)
 	}
-	return oldValue.ConsumedAt, nil
+	return oldValue.Synonyms, nil
 }
 
-// ClearConsumedAt clears the value of the "consumed_at" field.
-func (m *PairingCodeMutation) ClearConsumedAt() {
-	m.consumed_at = nil
-	m.clearedFields[pairingcode.FieldConsumedAt] = struct{}{}
+// AppendSynonyms adds s to the "synonyms" field.
+func (m *VocabularyTermMutation) AppendSynonyms(s []string) {
+	m.appendsynonyms = append(m.appendsynonyms, s...)
 }
 
-// ConsumedAtCleared returns if the "consumed_at" field was cleared in this mutation.
-func (m *PairingCodeMutation) ConsumedAtCleared() bool {
-	_, ok := m.clearedFields[pairingcode.FieldConsumedAt]
+// AppendedSynonyms returns the list of values that were appended to the "synonyms" field in this mutation.
+func (m *VocabularyTermMutation) AppendedSynonyms() ([]string, bool) {
+	if len(m.appendsynonyms) == 0 {
+		return nil, false
+	}
+	return m.appendsynonyms, true
+}
+
+// ClearSynonyms clears the value of the "synonyms" field.
+func (m *VocabularyTermMutation) ClearSynonyms() {
+	m.synonyms = nil
+	m.appendsynonyms = nil
+	m.clearedFields[vocabularyterm.FieldSynonyms] = struct{}{}
+}
+
+// SynonymsCleared returns if the "synonyms" field was cleared in this mutation.
+func (m *VocabularyTermMutation) SynonymsCleared() bool {
+	_, ok := m.clearedFields[vocabularyterm.FieldSynonyms]
 	return ok
 }
 
-// ResetConsumedAt resets all changes to the "consumed_at" field.
-func (m *PairingCodeMutation) ResetConsumedAt() {
-	m.consumed_at = nil
-	delete(m.clearedFields, pairingcode.FieldConsumedAt)
+// ResetSynonyms resets all changes to the "synonyms" field.
+func (m *VocabularyTermMutation) ResetSynonyms() {
+	m.synonyms = nil
+	m.appendsynonyms = nil
+	delete(m.clearedFields, vocabularyterm.FieldSynonyms)
 }
 
-// SetConsumedByPatientID sets the "consumed_by_patient_id" field.
-func (m *PairingCodeMutation) SetConsumedByPatientID(u uuid.UUID) {
-	m.consumed_by_patient = &u
+// SetPracticeID sets the "practice_id" field.
+func (m *VocabularyTermMutation) SetPracticeID(u uuid.UUID) {
+	m.practice = &u
 }
 
-// ConsumedByPatientID returns the value of the "consumed_by_patient_id" field in the mutation.
-func (m *PairingCodeMutation) ConsumedByPatientID() (r uuid.UUID, exists bool) {
-	v := m.consumed_by_patient
+// PracticeID returns the value of the "practice_id" field in the mutation.
+func (m *VocabularyTermMutation) PracticeID() (r uuid.UUID, exists bool) {
+	v := m.practice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPracticeID returns the old "practice_id" field's value of the VocabularyTerm entity.
+// If the VocabularyTerm object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VocabularyTermMutation) OldPracticeID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPracticeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPracticeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPracticeID: %w", err)
+	}
+	return oldValue.PracticeID, nil
+}
+
+// ClearPracticeID clears the value of the "practice_id" field.
+func (m *VocabularyTermMutation) ClearPracticeID() {
+	m.practice = nil
+	m.clearedFields[vocabularyterm.FieldPracticeID] = struct{}{}
+}
+
+// PracticeIDCleared returns if the "practice_id" field was cleared in this mutation.
+func (m *VocabularyTermMutation) PracticeIDCleared() bool {
+	_, ok := m.clearedFields[vocabularyterm.FieldPracticeID]
+	return ok
+}
+
+// ResetPracticeID resets all changes to the "practice_id" field.
+func (m *VocabularyTermMutation) ResetPracticeID() {
+	m.practice = nil
+	delete(m.clearedFields, vocabularyterm.FieldPracticeID)
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (m *VocabularyTermMutation) SetDoctorID(u uuid.UUID) {
+	m.doctor = &u
+}
+
+// DoctorID returns the value of the "doctor_id" field in the mutation.
+func (m *VocabularyTermMutation) DoctorID() (r uuid.UUID, exists bool) {
+	v := m.doctor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDoctorID returns the old "doctor_id" field's value of the VocabularyTerm entity.
+// If the VocabularyTerm object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VocabularyTermMutation) OldDoctorID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDoctorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDoctorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDoctorID: %w", err)
+	}
+	return oldValue.DoctorID, nil
+}
+
+// ClearDoctorID clears the value of the "doctor_id" field.
+func (m *VocabularyTermMutation) ClearDoctorID() {
+	m.doctor = nil
+	m.clearedFields[vocabularyterm.FieldDoctorID] = struct{}{}
+}
+
+// DoctorIDCleared returns if the "doctor_id" field was cleared in this mutation.
+func (m *VocabularyTermMutation) DoctorIDCleared() bool {
+	_, ok := m.clearedFields[vocabularyterm.FieldDoctorID]
+	return ok
+}
+
+// ResetDoctorID resets all changes to the "doctor_id" field.
+func (m *VocabularyTermMutation) ResetDoctorID() {
+	m.doctor = nil
+	delete(m.clearedFields, vocabularyterm.FieldDoctorID)
+}
+
+// SetActive sets the "active" field.
+func (m *VocabularyTermMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *VocabularyTermMutation) Active() (r bool, exists bool) {
+	v := m.active
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConsumedByPatientID returns the old "consumed_by_patient_id" field's value of the PairingCode entity.
-// If the PairingCode object wasn't provided to the builder, the object is fetched from the database.
+// OldActive returns the old "active" field's value of the VocabularyTerm entity.
+// If the VocabularyTerm object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PairingCodeMutation) OldConsumedByPatientID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *VocabularyTermMutation) OldActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConsumedByPatientID is only allowed on UpdateOne operations")
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConsumedByPatientID requires an ID field in the mutation")
+		return v, errors.New("OldActive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConsumedByPatientID: %w", err)
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
 	}
-	return oldValue.ConsumedByPatientID, nil
+	return oldValue.Active, nil
 }
 
-// ClearConsumedByPatientID clears the value of the "consumed_by_patient_id" field.
-func (m *PairingCodeMutation) ClearConsumedByPatientID() {
-	m.consumed_by_patient = nil
-	m.clearedFields[pairingcode.FieldConsumedByPatientID] = struct{}{}
+// ResetActive resets all changes to the "active" field.
+func (m *VocabularyTermMutation) ResetActive() {
+	m.active = nil
 }
 
-// ConsumedByPatientIDCleared returns if the "consumed_by_patient_id" field was cleared in this mutation.
-func (m *PairingCodeMutation) ConsumedByPatientIDCleared() bool {
-	_, ok := m.clearedFields[pairingcode.FieldConsumedByPatientID]
-	return ok
+// ClearPractice clears the "practice" edge to the Practice entity.
+func (m *VocabularyTermMutation) ClearPractice() {
+	m.clearedpractice = true
+	m.clearedFields[vocabularyterm.FieldPracticeID] = struct{}{}
 }
 
-// ResetConsumedByPatientID resets all changes to the "consumed_by_patient_id" field.
-func (m *PairingCodeMutation) ResetConsumedByPatientID() {
-	m.consumed_by_patient = nil
-	delete(m.clearedFields, pairingcode.FieldConsumedByPatientID)
+// PracticeCleared reports if the "practice" edge to the Practice entity was cleared.
+func (m *VocabularyTermMutation) PracticeCleared() bool {
+	return m.PracticeIDCleared() || m.clearedpractice
+}
+
+// PracticeIDs returns the "practice" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PracticeID instead. It exists only for internal usage by the builders.
+func (m *VocabularyTermMutation) PracticeIDs() (ids []uuid.UUID) {
+	if id := m.practice; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPractice resets all changes to the "practice" edge.
+func (m *VocabularyTermMutation) ResetPractice() {
+	m.practice = nil
+	m.clearedpractice = false
 }
 
 // ClearDoctor clears the "doctor" edge to the Doctor entity.
-func (m *PairingCodeMutation) ClearDoctor() {
+func (m *VocabularyTermMutation) ClearDoctor() {
 	m.cleareddoctor = true
-	m.clearedFields[pairingcode.FieldDoctorID] = struct{}{}
+	m.clearedFields[vocabularyterm.FieldDoctorID] = struct{}{}
 }
 
 // DoctorCleared reports if the "doctor" edge to the Doctor entity was cleared.
-func (m *PairingCodeMutation) DoctorCleared() bool {
-	return m.cleareddoctor
+func (m *VocabularyTermMutation) DoctorCleared() bool {
+	return m.DoctorIDCleared() || m.cleareddoctor
 }
 
 // DoctorIDs returns the "doctor" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // DoctorID instead. It exists only for internal usage by the builders.
-func (m *PairingCodeMutation) DoctorIDs() (ids []uuid.UUID) {
+func (m *VocabularyTermMutation) DoctorIDs() (ids []uuid.UUID) {
 	if id := m.doctor; id != nil {
 		ids = append(ids, *id)
 	}
@@ -6814,47 +22937,20 @@ func (m *PairingCodeMutation) DoctorIDs() (ids []uuid.UUID) {
 }
 
 // ResetDoctor resets all changes to the "doctor" edge.
-func (m *PairingCodeMutation) ResetDoctor() {
+func (m *VocabularyTermMutation) ResetDoctor() {
 	m.doctor = nil
 	m.cleareddoctor = false
 }
 
-// ClearConsumedByPatient clears the "consumed_by_patient" edge to the Patient entity.
-func (m *PairingCodeMutation) ClearConsumedByPatient() {
-	m.clearedconsumed_by_patient = true
-	m.clearedFields[pairingcode.FieldConsumedByPatientID] = struct{}{}
-}
-
-// ConsumedByPatientCleared reports if the "consumed_by_patient" edge to the Patient entity was cleared.
-func (m *PairingCodeMutation) ConsumedByPatientCleared() bool {
-	return m.ConsumedByPatientIDCleared() || m.clearedconsumed_by_patient
-}
-
-// ConsumedByPatientIDs returns the "consumed_by_patient" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ConsumedByPatientID instead. It exists only for internal usage by the builders.
-func (m *PairingCodeMutation) ConsumedByPatientIDs() (ids []uuid.UUID) {
-	if id := m.consumed_by_patient; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetConsumedByPatient resets all changes to the "consumed_by_patient" edge.
-func (m *PairingCodeMutation) ResetConsumedByPatient() {
-	m.consumed_by_patient = nil
-	m.clearedconsumed_by_patient = false
-}
-
-// Where appends a list predicates to the PairingCodeMutation builder.
-func (m *PairingCodeMutation) Where(ps ...predicate.PairingCode) {
+// Where appends a list predicates to the VocabularyTermMutation builder.
+func (m *VocabularyTermMutation) Where(ps ...predicate.VocabularyTerm) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PairingCodeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the VocabularyTermMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PairingCodeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PairingCode, len(ps))
+func (m *VocabularyTermMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VocabularyTerm, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6862,45 +22958,51 @@ func (m *PairingCodeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PairingCodeMutation) Op() Op {
+func (m *VocabularyTermMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PairingCodeMutation) SetOp(op Op) {
+func (m *VocabularyTermMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PairingCode).
-func (m *PairingCodeMutation) Type() string {
+// Type returns the node type of this mutation (VocabularyTerm).
+func (m *VocabularyTermMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PairingCodeMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *VocabularyTermMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.created_at != nil {
-		fields = append(fields, pairingcode.FieldCreatedAt)
+		fields = append(fields, vocabularyterm.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, pairingcode.FieldUpdatedAt)
+		fields = append(fields, vocabularyterm.FieldUpdatedAt)
 	}
-	if m.code != nil {
-		fields = append(fields, pairingcode.FieldCode)
+	if m.kind != nil {
+		fields = append(fields, vocabularyterm.FieldKind)
 	}
-	if m.doctor != nil {
-		fields = append(fields, pairingcode.FieldDoctorID)
+	if m.key != nil {
+		fields = append(fields, vocabularyterm.FieldKey)
 	}
-	if m.expires_at != nil {
-		fields = append(fields, pairingcode.FieldExpiresAt)
+	if m.labels != nil {
+		fields = append(fields, vocabularyterm.FieldLabels)
 	}
-	if m.consumed_at != nil {
-		fields = append(fields, pairingcode.FieldConsumedAt)
+	if m.synonyms != nil {
+		fields = append(fields, vocabularyterm.FieldSynonyms)
 	}
-	if m.consumed_by_patient != nil {
-		fields = append(fields, pairingcode.FieldConsumedByPatientID)
+	if m.practice != nil {
+		fields = append(fields, vocabularyterm.FieldPracticeID)
+	}
+	if m.doctor != nil {
+		fields = append(fields, vocabularyterm.FieldDoctorID)
+	}
+	if m.active != nil {
+		fields = append(fields, vocabularyterm.FieldActive)
 	}
 	return fields
 }
@@ -6908,22 +23010,26 @@ func (m *PairingCodeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PairingCodeMutation) Field(name string) (ent.Value, bool) {
+func (m *VocabularyTermMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case pairingcode.FieldCreatedAt:
+	case vocabularyterm.FieldCreatedAt:
 		return m.CreatedAt()
-	case pairingcode.FieldUpdatedAt:
+	case vocabularyterm.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case pairingcode.FieldCode:
-		return m.Code()
-	case pairingcode.FieldDoctorID:
+	case vocabularyterm.FieldKind:
+		return m.Kind()
+	case vocabularyterm.FieldKey:
+		return m.Key()
+	case vocabularyterm.FieldLabels:
+		return m.Labels()
+	case vocabularyterm.FieldSynonyms:
+		return m.Synonyms()
+	case vocabularyterm.FieldPracticeID:
+		return m.PracticeID()
+	case vocabularyterm.FieldDoctorID:
 		return m.DoctorID()
-	case pairingcode.FieldExpiresAt:
-		return m.ExpiresAt()
-	case pairingcode.FieldConsumedAt:
-		return m.ConsumedAt()
-	case pairingcode.FieldConsumedByPatientID:
-		return m.ConsumedByPatientID()
+	case vocabularyterm.FieldActive:
+		return m.Active()
 	}
 	return nil, false
 }
@@ -6931,191 +23037,221 @@ func (m *PairingCodeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PairingCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VocabularyTermMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case pairingcode.FieldCreatedAt:
+	case vocabularyterm.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case pairingcode.FieldUpdatedAt:
+	case vocabularyterm.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case pairingcode.FieldCode:
-		return m.OldCode(ctx)
-	case pairingcode.FieldDoctorID:
+	case vocabularyterm.FieldKind:
+		return m.OldKind(ctx)
+	case vocabularyterm.FieldKey:
+		return m.OldKey(ctx)
+	case vocabularyterm.FieldLabels:
+		return m.OldLabels(ctx)
+	case vocabularyterm.FieldSynonyms:
+		return m.OldSynonyms(ctx)
+	case vocabularyterm.FieldPracticeID:
+		return m.OldPracticeID(ctx)
+	case vocabularyterm.FieldDoctorID:
 		return m.OldDoctorID(ctx)
-	case pairingcode.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case pairingcode.FieldConsumedAt:
-		return m.OldConsumedAt(ctx)
-	case pairingcode.FieldConsumedByPatientID:
-		return m.OldConsumedByPatientID(ctx)
+	case vocabularyterm.FieldActive:
+		return m.OldActive(ctx)
 	}
-	return nil, fmt.Errorf("unknown PairingCode field %s", name)
+	return nil, fmt.Errorf("unknown VocabularyTerm field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PairingCodeMutation) SetField(name string, value ent.Value) error {
+func (m *VocabularyTermMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case pairingcode.FieldCreatedAt:
+	case vocabularyterm.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case pairingcode.FieldUpdatedAt:
+	case vocabularyterm.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case pairingcode.FieldCode:
+	case vocabularyterm.FieldKind:
+		v, ok := value.(vocabularyterm.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case vocabularyterm.FieldKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCode(v)
+		m.SetKey(v)
 		return nil
-	case pairingcode.FieldDoctorID:
-		v, ok := value.(uuid.UUID)
+	case vocabularyterm.FieldLabels:
+		v, ok := value.(map[string]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDoctorID(v)
+		m.SetLabels(v)
 		return nil
-	case pairingcode.FieldExpiresAt:
-		v, ok := value.(time.Time)
+	case vocabularyterm.FieldSynonyms:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiresAt(v)
+		m.SetSynonyms(v)
 		return nil
-	case pairingcode.FieldConsumedAt:
-		v, ok := value.(time.Time)
+	case vocabularyterm.FieldPracticeID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConsumedAt(v)
+		m.SetPracticeID(v)
 		return nil
-	case pairingcode.FieldConsumedByPatientID:
+	case vocabularyterm.FieldDoctorID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConsumedByPatientID(v)
+		m.SetDoctorID(v)
+		return nil
+	case vocabularyterm.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PairingCode field %s", name)
+	return fmt.Errorf("unknown VocabularyTerm field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PairingCodeMutation) AddedFields() []string {
+func (m *VocabularyTermMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PairingCodeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *VocabularyTermMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PairingCodeMutation) AddField(name string, value ent.Value) error {
+func (m *VocabularyTermMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown PairingCode numeric field %s", name)
+	return fmt.Errorf("unknown VocabularyTerm numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PairingCodeMutation) ClearedFields() []string {
+func (m *VocabularyTermMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(pairingcode.FieldConsumedAt) {
-		fields = append(fields, pairingcode.FieldConsumedAt)
+	if m.FieldCleared(vocabularyterm.FieldSynonyms) {
+		fields = append(fields, vocabularyterm.FieldSynonyms)
 	}
-	if m.FieldCleared(pairingcode.FieldConsumedByPatientID) {
-		fields = append(fields, pairingcode.FieldConsumedByPatientID)
+	if m.FieldCleared(vocabularyterm.FieldPracticeID) {
+		fields = append(fields, vocabularyterm.FieldPracticeID)
+	}
+	if m.FieldCleared(vocabularyterm.FieldDoctorID) {
+		fields = append(fields, vocabularyterm.FieldDoctorID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PairingCodeMutation) FieldCleared(name string) bool {
+func (m *VocabularyTermMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PairingCodeMutation) ClearField(name string) error {
+func (m *VocabularyTermMutation) ClearField(name string) error {
 	switch name {
-	case pairingcode.FieldConsumedAt:
-		m.ClearConsumedAt()
+	case vocabularyterm.FieldSynonyms:
+		m.ClearSynonyms()
 		return nil
-	case pairingcode.FieldConsumedByPatientID:
-		m.ClearConsumedByPatientID()
+	case vocabularyterm.FieldPracticeID:
+		m.ClearPracticeID()
+		return nil
+	case vocabularyterm.FieldDoctorID:
+		m.ClearDoctorID()
 		return nil
 	}
-	return fmt.Errorf("unknown PairingCode nullable field %s", name)
+	return fmt.Errorf("unknown VocabularyTerm nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PairingCodeMutation) ResetField(name string) error {
+func (m *VocabularyTermMutation) ResetField(name string) error {
 	switch name {
-	case pairingcode.FieldCreatedAt:
+	case vocabularyterm.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case pairingcode.FieldUpdatedAt:
+	case vocabularyterm.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case pairingcode.FieldCode:
-		m.ResetCode()
+	case vocabularyterm.FieldKind:
+		m.ResetKind()
 		return nil
-	case pairingcode.FieldDoctorID:
-		m.ResetDoctorID()
+	case vocabularyterm.FieldKey:
+		m.ResetKey()
 		return nil
-	case pairingcode.FieldExpiresAt:
-		m.ResetExpiresAt()
+	case vocabularyterm.FieldLabels:
+		m.ResetLabels()
 		return nil
-	case pairingcode.FieldConsumedAt:
-		m.ResetConsumedAt()
+	case vocabularyterm.FieldSynonyms:
+		m.ResetSynonyms()
 		return nil
-	case pairingcode.FieldConsumedByPatientID:
-		m.ResetConsumedByPatientID()
+	case vocabularyterm.FieldPracticeID:
+		m.ResetPracticeID()
+		return nil
+	case vocabularyterm.FieldDoctorID:
+		m.ResetDoctorID()
+		return nil
+	case vocabularyterm.FieldActive:
+		m.ResetActive()
 		return nil
 	}
-	return fmt.Errorf("unknown PairingCode field %s", name)
+	return fmt.Errorf("unknown VocabularyTerm field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PairingCodeMutation) AddedEdges() []string {
+func (m *VocabularyTermMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.doctor != nil {
-		edges = append(edges, pairingcode.EdgeDoctor)
+	if m.practice != nil {
+		edges = append(edges, vocabularyterm.EdgePractice)
 	}
-	if m.consumed_by_patient != nil {
-		edges = append(edges, pairingcode.EdgeConsumedByPatient)
+	if m.doctor != nil {
+		edges = append(edges, vocabularyterm.EdgeDoctor)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PairingCodeMutation) AddedIDs(name string) []ent.Value {
+func (m *VocabularyTermMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case pairingcode.EdgeDoctor:
-		if id := m.doctor; id != nil {
+	case vocabularyterm.EdgePractice:
+		if id := m.practice; id != nil {
 			return []ent.Value{*id}
 		}
-	case pairingcode.EdgeConsumedByPatient:
-		if id := m.consumed_by_patient; id != nil {
+	case vocabularyterm.EdgeDoctor:
+		if id := m.doctor; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -7123,116 +23259,109 @@ func (m *PairingCodeMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PairingCodeMutation) RemovedEdges() []string {
+func (m *VocabularyTermMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PairingCodeMutation) RemovedIDs(name string) []ent.Value {
+func (m *VocabularyTermMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PairingCodeMutation) ClearedEdges() []string {
+func (m *VocabularyTermMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.cleareddoctor {
-		edges = append(edges, pairingcode.EdgeDoctor)
+	if m.clearedpractice {
+		edges = append(edges, vocabularyterm.EdgePractice)
 	}
-	if m.clearedconsumed_by_patient {
-		edges = append(edges, pairingcode.EdgeConsumedByPatient)
+	if m.cleareddoctor {
+		edges = append(edges, vocabularyterm.EdgeDoctor)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PairingCodeMutation) EdgeCleared(name string) bool {
+func (m *VocabularyTermMutation) EdgeCleared(name string) bool {
 	switch name {
-	case pairingcode.EdgeDoctor:
+	case vocabularyterm.EdgePractice:
+		return m.clearedpractice
+	case vocabularyterm.EdgeDoctor:
 		return m.cleareddoctor
-	case pairingcode.EdgeConsumedByPatient:
-		return m.clearedconsumed_by_patient
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PairingCodeMutation) ClearEdge(name string) error {
+func (m *VocabularyTermMutation) ClearEdge(name string) error {
 	switch name {
-	case pairingcode.EdgeDoctor:
-		m.ClearDoctor()
+	case vocabularyterm.EdgePractice:
+		m.ClearPractice()
 		return nil
-	case pairingcode.EdgeConsumedByPatient:
-		m.ClearConsumedByPatient()
+	case vocabularyterm.EdgeDoctor:
+		m.ClearDoctor()
 		return nil
 	}
-	return fmt.Errorf("unknown PairingCode unique edge %s", name)
+	return fmt.Errorf("unknown VocabularyTerm unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PairingCodeMutation) ResetEdge(name string) error {
+func (m *VocabularyTermMutation) ResetEdge(name string) error {
 	switch name {
-	case pairingcode.EdgeDoctor:
-		m.ResetDoctor()
+	case vocabularyterm.EdgePractice:
+		m.ResetPractice()
 		return nil
-	case pairingcode.EdgeConsumedByPatient:
-		m.ResetConsumedByPatient()
+	case vocabularyterm.EdgeDoctor:
+		m.ResetDoctor()
 		return nil
 	}
-	return fmt.Errorf("unknown PairingCode edge %s", name)
+	return fmt.Errorf("unknown VocabularyTerm edge %s", name)
 }
 
-// PatientMutation represents an operation that mutates the Patient nodes in the graph.
-type PatientMutation struct {
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
 	config
-	op                            Op
-	typ                           string
-	id                            *uuid.UUID
-	created_at                    *time.Time
-	updated_at                    *time.Time
-	display_name                  *string
-	birth_date                    *time.Time
-	status                        *patient.Status
-	email                         *string
-	password_hash                 *string
-	patient_code                  *string
-	last_entry_at                 *time.Time
-	clearedFields                 map[string]struct{}
-	doctor_links                  map[uuid.UUID]struct{}
-	removeddoctor_links           map[uuid.UUID]struct{}
-	cleareddoctor_links           bool
-	consumed_pairing_codes        map[uuid.UUID]struct{}
-	removedconsumed_pairing_codes map[uuid.UUID]struct{}
-	clearedconsumed_pairing_codes bool
-	entries                       map[uuid.UUID]struct{}
-	removedentries                map[uuid.UUID]struct{}
-	clearedentries                bool
-	analysis_jobs                 map[uuid.UUID]struct{}
-	removedanalysis_jobs          map[uuid.UUID]struct{}
-	clearedanalysis_jobs          bool
-	entry_shares                  map[uuid.UUID]struct{}
-	removedentry_shares           map[uuid.UUID]struct{}
-	clearedentry_shares           bool
-	done                          bool
-	oldValue                      func(context.Context) (*Patient, error)
-	predicates                    []predicate.Patient
-}
-
-var _ ent.Mutation = (*PatientMutation)(nil)
-
-// patientOption allows management of the mutation configuration using functional options.
-type patientOption func(*PatientMutation)
-
-// newPatientMutation creates new mutation for the Patient entity.
-func newPatientMutation(c config, op Op, opts ...patientOption) *PatientMutation {
-	m := &PatientMutation{
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	created_at          *time.Time
+	updated_at          *time.Time
+	event_id            *uuid.UUID
+	event_type          *string
+	payload             *json.RawMessage
+	appendpayload       json.RawMessage
+	status              *webhookdelivery.Status
+	attempts            *int
+	addattempts         *int
+	next_attempt_at     *time.Time
+	last_attempt_at     *time.Time
+	response_status     *int
+	addresponse_status  *int
+	last_error          *string
+	redelivery_of       *uuid.UUID
+	clearedFields       map[string]struct{}
+	subscription        *uuid.UUID
+	clearedsubscription bool
+	done                bool
+	oldValue            func(context.Context) (*WebhookDelivery, error)
+	predicates          []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePatient,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7241,20 +23370,20 @@ func newPatientMutation(c config, op Op, opts ...patientOption) *PatientMutation
 	return m
 }
 
-// withPatientID sets the ID field of the mutation.
-func withPatientID(id uuid.UUID) patientOption {
-	return func(m *PatientMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id uuid.UUID) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Patient
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*Patient, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Patient.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7263,10 +23392,10 @@ func withPatientID(id uuid.UUID) patientOption {
 	}
 }
 
-// withPatient sets the old Patient of the mutation.
-func withPatient(node *Patient) patientOption {
-	return func(m *PatientMutation) {
-		m.oldValue = func(context.Context) (*Patient, error) {
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7275,7 +23404,7 @@ func withPatient(node *Patient) patientOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PatientMutation) Client() *Client {
+func (m WebhookDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7283,7 +23412,7 @@ func (m PatientMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PatientMutation) Tx() (*Tx, error) {
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7293,14 +23422,14 @@ func (m PatientMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Patient entities.
-func (m *PatientMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of WebhookDelivery entities.
+func (m *WebhookDeliveryMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PatientMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebhookDeliveryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7311,7 +23440,7 @@ func (m *PatientMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PatientMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7320,19 +23449,19 @@ func (m *PatientMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Patient.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PatientMutation) SetCreatedAt(t time.Time) {
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PatientMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -7340,10 +23469,10 @@ func (m *PatientMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Patient entity.
-// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PatientMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -7358,17 +23487,17 @@ func (m *PatientMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PatientMutation) ResetCreatedAt() {
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *PatientMutation) SetUpdatedAt(t time.Time) {
+func (m *WebhookDeliveryMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PatientMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *WebhookDeliveryMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -7376,10 +23505,10 @@ func (m *PatientMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Patient entity.
-// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PatientMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -7394,606 +23523,563 @@ func (m *PatientMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PatientMutation) ResetUpdatedAt() {
+func (m *WebhookDeliveryMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetDisplayName sets the "display_name" field.
-func (m *PatientMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// SetSubscriptionID sets the "subscription_id" field.
+func (m *WebhookDeliveryMutation) SetSubscriptionID(u uuid.UUID) {
+	m.subscription = &u
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *PatientMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
+// SubscriptionID returns the value of the "subscription_id" field in the mutation.
+func (m *WebhookDeliveryMutation) SubscriptionID() (r uuid.UUID, exists bool) {
+	v := m.subscription
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the Patient entity.
-// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// OldSubscriptionID returns the old "subscription_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PatientMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldSubscriptionID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubscriptionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+		return v, errors.New("OldSubscriptionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubscriptionID: %w", err)
 	}
-	return oldValue.DisplayName, nil
+	return oldValue.SubscriptionID, nil
 }
 
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *PatientMutation) ResetDisplayName() {
-	m.display_name = nil
+// ResetSubscriptionID resets all changes to the "subscription_id" field.
+func (m *WebhookDeliveryMutation) ResetSubscriptionID() {
+	m.subscription = nil
 }
 
-// SetBirthDate sets the "birth_date" field.
-func (m *PatientMutation) SetBirthDate(t time.Time) {
-	m.birth_date = &t
+// SetEventID sets the "event_id" field.
+func (m *WebhookDeliveryMutation) SetEventID(u uuid.UUID) {
+	m.event_id = &u
 }
 
-// BirthDate returns the value of the "birth_date" field in the mutation.
-func (m *PatientMutation) BirthDate() (r time.Time, exists bool) {
-	v := m.birth_date
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EventID() (r uuid.UUID, exists bool) {
+	v := m.event_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBirthDate returns the old "birth_date" field's value of the Patient entity.
-// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// OldEventID returns the old "event_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PatientMutation) OldBirthDate(ctx context.Context) (v *time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldEventID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBirthDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBirthDate requires an ID field in the mutation")
+		return v, errors.New("OldEventID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBirthDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
 	}
-	return oldValue.BirthDate, nil
-}
-
-// ClearBirthDate clears the value of the "birth_date" field.
-func (m *PatientMutation) ClearBirthDate() {
-	m.birth_date = nil
-	m.clearedFields[patient.FieldBirthDate] = struct{}{}
-}
-
-// BirthDateCleared returns if the "birth_date" field was cleared in this mutation.
-func (m *PatientMutation) BirthDateCleared() bool {
-	_, ok := m.clearedFields[patient.FieldBirthDate]
-	return ok
+	return oldValue.EventID, nil
 }
 
-// ResetBirthDate resets all changes to the "birth_date" field.
-func (m *PatientMutation) ResetBirthDate() {
-	m.birth_date = nil
-	delete(m.clearedFields, patient.FieldBirthDate)
+// ResetEventID resets all changes to the "event_id" field.
+func (m *WebhookDeliveryMutation) ResetEventID() {
+	m.event_id = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *PatientMutation) SetStatus(pa patient.Status) {
-	m.status = &pa
+// SetEventType sets the "event_type" field.
+func (m *WebhookDeliveryMutation) SetEventType(s string) {
+	m.event_type = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *PatientMutation) Status() (r patient.Status, exists bool) {
-	v := m.status
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookDeliveryMutation) EventType() (r string, exists bool) {
+	v := m.event_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Patient entity.
-// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// OldEventType returns the old "event_type" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PatientMutation) OldStatus(ctx context.Context) (v patient.Status, err error) {
+func (m *WebhookDeliveryMutation) OldEventType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldEventType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.EventType, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *PatientMutation) ResetStatus() {
-	m.status = nil
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookDeliveryMutation) ResetEventType() {
+	m.event_type = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *PatientMutation) SetEmail(s string) {
-	m.email = &s
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(jm json.RawMessage) {
+	m.payload = &jm
+	m.appendpayload = nil
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *PatientMutation) Email() (r string, exists bool) {
-	v := m.email
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r json.RawMessage, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the Patient entity.
-// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PatientMutation) OldEmail(ctx context.Context) (v *string, err error) {
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v json.RawMessage, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.Payload, nil
 }
 
-// ClearEmail clears the value of the "email" field.
-func (m *PatientMutation) ClearEmail() {
-	m.email = nil
-	m.clearedFields[patient.FieldEmail] = struct{}{}
+// AppendPayload adds jm to the "payload" field.
+func (m *WebhookDeliveryMutation) AppendPayload(jm json.RawMessage) {
+	m.appendpayload = append(m.appendpayload, jm...)
 }
 
-// EmailCleared returns if the "email" field was cleared in this mutation.
-func (m *PatientMutation) EmailCleared() bool {
-	_, ok := m.clearedFields[patient.FieldEmail]
-	return ok
+// AppendedPayload returns the list of values that were appended to the "payload" field in this mutation.
+func (m *WebhookDeliveryMutation) AppendedPayload() (json.RawMessage, bool) {
+	if len(m.appendpayload) == 0 {
+		return nil, false
+	}
+	return m.appendpayload, true
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *PatientMutation) ResetEmail() {
-	m.email = nil
-	delete(m.clearedFields, patient.FieldEmail)
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
+	m.appendpayload = nil
 }
 
-// SetPasswordHash sets the "password_hash" field.
-func (m *PatientMutation) SetPasswordHash(s string) {
-	m.password_hash = &s
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(w webhookdelivery.Status) {
+	m.status = &w
 }
 
-// PasswordHash returns the value of the "password_hash" field in the mutation.
-func (m *PatientMutation) PasswordHash() (r string, exists bool) {
-	v := m.password_hash
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r webhookdelivery.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPasswordHash returns the old "password_hash" field's value of the Patient entity.
-// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PatientMutation) OldPasswordHash(ctx context.Context) (v *string, err error) {
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v webhookdelivery.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.PasswordHash, nil
-}
-
-// ClearPasswordHash clears the value of the "password_hash" field.
-func (m *PatientMutation) ClearPasswordHash() {
-	m.password_hash = nil
-	m.clearedFields[patient.FieldPasswordHash] = struct{}{}
-}
-
-// PasswordHashCleared returns if the "password_hash" field was cleared in this mutation.
-func (m *PatientMutation) PasswordHashCleared() bool {
-	_, ok := m.clearedFields[patient.FieldPasswordHash]
-	return ok
+	return oldValue.Status, nil
 }
 
-// ResetPasswordHash resets all changes to the "password_hash" field.
-func (m *PatientMutation) ResetPasswordHash() {
-	m.password_hash = nil
-	delete(m.clearedFields, patient.FieldPasswordHash)
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetPatientCode sets the "patient_code" field.
-func (m *PatientMutation) SetPatientCode(s string) {
-	m.patient_code = &s
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// PatientCode returns the value of the "patient_code" field in the mutation.
-func (m *PatientMutation) PatientCode() (r string, exists bool) {
-	v := m.patient_code
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPatientCode returns the old "patient_code" field's value of the Patient entity.
-// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PatientMutation) OldPatientCode(ctx context.Context) (v *string, err error) {
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPatientCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPatientCode requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPatientCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.PatientCode, nil
+	return oldValue.Attempts, nil
 }
 
-// ClearPatientCode clears the value of the "patient_code" field.
-func (m *PatientMutation) ClearPatientCode() {
-	m.patient_code = nil
-	m.clearedFields[patient.FieldPatientCode] = struct{}{}
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// PatientCodeCleared returns if the "patient_code" field was cleared in this mutation.
-func (m *PatientMutation) PatientCodeCleared() bool {
-	_, ok := m.clearedFields[patient.FieldPatientCode]
-	return ok
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetPatientCode resets all changes to the "patient_code" field.
-func (m *PatientMutation) ResetPatientCode() {
-	m.patient_code = nil
-	delete(m.clearedFields, patient.FieldPatientCode)
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// SetLastEntryAt sets the "last_entry_at" field.
-func (m *PatientMutation) SetLastEntryAt(t time.Time) {
-	m.last_entry_at = &t
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
 }
 
-// LastEntryAt returns the value of the "last_entry_at" field in the mutation.
-func (m *PatientMutation) LastEntryAt() (r time.Time, exists bool) {
-	v := m.last_entry_at
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastEntryAt returns the old "last_entry_at" field's value of the Patient entity.
-// If the Patient object wasn't provided to the builder, the object is fetched from the database.
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PatientMutation) OldLastEntryAt(ctx context.Context) (v *time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastEntryAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastEntryAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastEntryAt: %w", err)
-	}
-	return oldValue.LastEntryAt, nil
-}
-
-// ClearLastEntryAt clears the value of the "last_entry_at" field.
-func (m *PatientMutation) ClearLastEntryAt() {
-	m.last_entry_at = nil
-	m.clearedFields[patient.FieldLastEntryAt] = struct{}{}
-}
-
-// LastEntryAtCleared returns if the "last_entry_at" field was cleared in this mutation.
-func (m *PatientMutation) LastEntryAtCleared() bool {
-	_, ok := m.clearedFields[patient.FieldLastEntryAt]
-	return ok
-}
-
-// ResetLastEntryAt resets all changes to the "last_entry_at" field.
-func (m *PatientMutation) ResetLastEntryAt() {
-	m.last_entry_at = nil
-	delete(m.clearedFields, patient.FieldLastEntryAt)
-}
-
-// AddDoctorLinkIDs adds the "doctor_links" edge to the DoctorPatientLink entity by ids.
-func (m *PatientMutation) AddDoctorLinkIDs(ids ...uuid.UUID) {
-	if m.doctor_links == nil {
-		m.doctor_links = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.doctor_links[ids[i]] = struct{}{}
-	}
-}
-
-// ClearDoctorLinks clears the "doctor_links" edge to the DoctorPatientLink entity.
-func (m *PatientMutation) ClearDoctorLinks() {
-	m.cleareddoctor_links = true
-}
-
-// DoctorLinksCleared reports if the "doctor_links" edge to the DoctorPatientLink entity was cleared.
-func (m *PatientMutation) DoctorLinksCleared() bool {
-	return m.cleareddoctor_links
-}
-
-// RemoveDoctorLinkIDs removes the "doctor_links" edge to the DoctorPatientLink entity by IDs.
-func (m *PatientMutation) RemoveDoctorLinkIDs(ids ...uuid.UUID) {
-	if m.removeddoctor_links == nil {
-		m.removeddoctor_links = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.doctor_links, ids[i])
-		m.removeddoctor_links[ids[i]] = struct{}{}
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
-}
-
-// RemovedDoctorLinks returns the removed IDs of the "doctor_links" edge to the DoctorPatientLink entity.
-func (m *PatientMutation) RemovedDoctorLinksIDs() (ids []uuid.UUID) {
-	for id := range m.removeddoctor_links {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
 	}
-	return
-}
-
-// DoctorLinksIDs returns the "doctor_links" edge IDs in the mutation.
-func (m *PatientMutation) DoctorLinksIDs() (ids []uuid.UUID) {
-	for id := range m.doctor_links {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
 	}
-	return
+	return oldValue.NextAttemptAt, nil
 }
 
-// ResetDoctorLinks resets all changes to the "doctor_links" edge.
-func (m *PatientMutation) ResetDoctorLinks() {
-	m.doctor_links = nil
-	m.cleareddoctor_links = false
-	m.removeddoctor_links = nil
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ClearNextAttemptAt() {
+	m.next_attempt_at = nil
+	m.clearedFields[webhookdelivery.FieldNextAttemptAt] = struct{}{}
 }
 
-// AddConsumedPairingCodeIDs adds the "consumed_pairing_codes" edge to the PairingCode entity by ids.
-func (m *PatientMutation) AddConsumedPairingCodeIDs(ids ...uuid.UUID) {
-	if m.consumed_pairing_codes == nil {
-		m.consumed_pairing_codes = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.consumed_pairing_codes[ids[i]] = struct{}{}
-	}
+// NextAttemptAtCleared returns if the "next_attempt_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldNextAttemptAt]
+	return ok
 }
 
-// ClearConsumedPairingCodes clears the "consumed_pairing_codes" edge to the PairingCode entity.
-func (m *PatientMutation) ClearConsumedPairingCodes() {
-	m.clearedconsumed_pairing_codes = true
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldNextAttemptAt)
 }
 
-// ConsumedPairingCodesCleared reports if the "consumed_pairing_codes" edge to the PairingCode entity was cleared.
-func (m *PatientMutation) ConsumedPairingCodesCleared() bool {
-	return m.clearedconsumed_pairing_codes
+// SetLastAttemptAt sets the "last_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetLastAttemptAt(t time.Time) {
+	m.last_attempt_at = &t
 }
 
-// RemoveConsumedPairingCodeIDs removes the "consumed_pairing_codes" edge to the PairingCode entity by IDs.
-func (m *PatientMutation) RemoveConsumedPairingCodeIDs(ids ...uuid.UUID) {
-	if m.removedconsumed_pairing_codes == nil {
-		m.removedconsumed_pairing_codes = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.consumed_pairing_codes, ids[i])
-		m.removedconsumed_pairing_codes[ids[i]] = struct{}{}
+// LastAttemptAt returns the value of the "last_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) LastAttemptAt() (r time.Time, exists bool) {
+	v := m.last_attempt_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedConsumedPairingCodes returns the removed IDs of the "consumed_pairing_codes" edge to the PairingCode entity.
-func (m *PatientMutation) RemovedConsumedPairingCodesIDs() (ids []uuid.UUID) {
-	for id := range m.removedconsumed_pairing_codes {
-		ids = append(ids, id)
+// OldLastAttemptAt returns the old "last_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldLastAttemptAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastAttemptAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastAttemptAt: %w", err)
+	}
+	return oldValue.LastAttemptAt, nil
 }
 
-// ConsumedPairingCodesIDs returns the "consumed_pairing_codes" edge IDs in the mutation.
-func (m *PatientMutation) ConsumedPairingCodesIDs() (ids []uuid.UUID) {
-	for id := range m.consumed_pairing_codes {
-		ids = append(ids, id)
-	}
-	return
+// ClearLastAttemptAt clears the value of the "last_attempt_at" field.
+func (m *WebhookDeliveryMutation) ClearLastAttemptAt() {
+	m.last_attempt_at = nil
+	m.clearedFields[webhookdelivery.FieldLastAttemptAt] = struct{}{}
 }
 
-// ResetConsumedPairingCodes resets all changes to the "consumed_pairing_codes" edge.
-func (m *PatientMutation) ResetConsumedPairingCodes() {
-	m.consumed_pairing_codes = nil
-	m.clearedconsumed_pairing_codes = false
-	m.removedconsumed_pairing_codes = nil
+// LastAttemptAtCleared returns if the "last_attempt_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastAttemptAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastAttemptAt]
+	return ok
 }
 
-// AddEntryIDs adds the "entries" edge to the Entry entity by ids.
-func (m *PatientMutation) AddEntryIDs(ids ...uuid.UUID) {
-	if m.entries == nil {
-		m.entries = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.entries[ids[i]] = struct{}{}
-	}
+// ResetLastAttemptAt resets all changes to the "last_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetLastAttemptAt() {
+	m.last_attempt_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastAttemptAt)
 }
 
-// ClearEntries clears the "entries" edge to the Entry entity.
-func (m *PatientMutation) ClearEntries() {
-	m.clearedentries = true
+// SetResponseStatus sets the "response_status" field.
+func (m *WebhookDeliveryMutation) SetResponseStatus(i int) {
+	m.response_status = &i
+	m.addresponse_status = nil
 }
 
-// EntriesCleared reports if the "entries" edge to the Entry entity was cleared.
-func (m *PatientMutation) EntriesCleared() bool {
-	return m.clearedentries
+// ResponseStatus returns the value of the "response_status" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseStatus() (r int, exists bool) {
+	v := m.response_status
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveEntryIDs removes the "entries" edge to the Entry entity by IDs.
-func (m *PatientMutation) RemoveEntryIDs(ids ...uuid.UUID) {
-	if m.removedentries == nil {
-		m.removedentries = make(map[uuid.UUID]struct{})
+// OldResponseStatus returns the old "response_status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldResponseStatus(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseStatus is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.entries, ids[i])
-		m.removedentries[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
 	}
+	return oldValue.ResponseStatus, nil
 }
 
-// RemovedEntries returns the removed IDs of the "entries" edge to the Entry entity.
-func (m *PatientMutation) RemovedEntriesIDs() (ids []uuid.UUID) {
-	for id := range m.removedentries {
-		ids = append(ids, id)
+// AddResponseStatus adds i to the "response_status" field.
+func (m *WebhookDeliveryMutation) AddResponseStatus(i int) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
+	} else {
+		m.addresponse_status = &i
 	}
-	return
 }
 
-// EntriesIDs returns the "entries" edge IDs in the mutation.
-func (m *PatientMutation) EntriesIDs() (ids []uuid.UUID) {
-	for id := range m.entries {
-		ids = append(ids, id)
+// AddedResponseStatus returns the value that was added to the "response_status" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseStatus() (r int, exists bool) {
+	v := m.addresponse_status
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetEntries resets all changes to the "entries" edge.
-func (m *PatientMutation) ResetEntries() {
-	m.entries = nil
-	m.clearedentries = false
-	m.removedentries = nil
+// ClearResponseStatus clears the value of the "response_status" field.
+func (m *WebhookDeliveryMutation) ClearResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	m.clearedFields[webhookdelivery.FieldResponseStatus] = struct{}{}
 }
 
-// AddAnalysisJobIDs adds the "analysis_jobs" edge to the AnalysisJob entity by ids.
-func (m *PatientMutation) AddAnalysisJobIDs(ids ...uuid.UUID) {
-	if m.analysis_jobs == nil {
-		m.analysis_jobs = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.analysis_jobs[ids[i]] = struct{}{}
-	}
+// ResponseStatusCleared returns if the "response_status" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseStatus]
+	return ok
 }
 
-// ClearAnalysisJobs clears the "analysis_jobs" edge to the AnalysisJob entity.
-func (m *PatientMutation) ClearAnalysisJobs() {
-	m.clearedanalysis_jobs = true
+// ResetResponseStatus resets all changes to the "response_status" field.
+func (m *WebhookDeliveryMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseStatus)
 }
 
-// AnalysisJobsCleared reports if the "analysis_jobs" edge to the AnalysisJob entity was cleared.
-func (m *PatientMutation) AnalysisJobsCleared() bool {
-	return m.clearedanalysis_jobs
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
 }
 
-// RemoveAnalysisJobIDs removes the "analysis_jobs" edge to the AnalysisJob entity by IDs.
-func (m *PatientMutation) RemoveAnalysisJobIDs(ids ...uuid.UUID) {
-	if m.removedanalysis_jobs == nil {
-		m.removedanalysis_jobs = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.analysis_jobs, ids[i])
-		m.removedanalysis_jobs[ids[i]] = struct{}{}
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedAnalysisJobs returns the removed IDs of the "analysis_jobs" edge to the AnalysisJob entity.
-func (m *PatientMutation) RemovedAnalysisJobsIDs() (ids []uuid.UUID) {
-	for id := range m.removedanalysis_jobs {
-		ids = append(ids, id)
+// OldLastError returns the old "last_error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldLastError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
 }
 
-// AnalysisJobsIDs returns the "analysis_jobs" edge IDs in the mutation.
-func (m *PatientMutation) AnalysisJobsIDs() (ids []uuid.UUID) {
-	for id := range m.analysis_jobs {
-		ids = append(ids, id)
-	}
-	return
+// ClearLastError clears the value of the "last_error" field.
+func (m *WebhookDeliveryMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[webhookdelivery.FieldLastError] = struct{}{}
 }
 
-// ResetAnalysisJobs resets all changes to the "analysis_jobs" edge.
-func (m *PatientMutation) ResetAnalysisJobs() {
-	m.analysis_jobs = nil
-	m.clearedanalysis_jobs = false
-	m.removedanalysis_jobs = nil
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastError]
+	return ok
 }
 
-// AddEntryShareIDs adds the "entry_shares" edge to the EntryShare entity by ids.
-func (m *PatientMutation) AddEntryShareIDs(ids ...uuid.UUID) {
-	if m.entry_shares == nil {
-		m.entry_shares = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.entry_shares[ids[i]] = struct{}{}
-	}
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastError)
 }
 
-// ClearEntryShares clears the "entry_shares" edge to the EntryShare entity.
-func (m *PatientMutation) ClearEntryShares() {
-	m.clearedentry_shares = true
+// SetRedeliveryOf sets the "redelivery_of" field.
+func (m *WebhookDeliveryMutation) SetRedeliveryOf(u uuid.UUID) {
+	m.redelivery_of = &u
 }
 
-// EntrySharesCleared reports if the "entry_shares" edge to the EntryShare entity was cleared.
-func (m *PatientMutation) EntrySharesCleared() bool {
-	return m.clearedentry_shares
+// RedeliveryOf returns the value of the "redelivery_of" field in the mutation.
+func (m *WebhookDeliveryMutation) RedeliveryOf() (r uuid.UUID, exists bool) {
+	v := m.redelivery_of
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveEntryShareIDs removes the "entry_shares" edge to the EntryShare entity by IDs.
-func (m *PatientMutation) RemoveEntryShareIDs(ids ...uuid.UUID) {
-	if m.removedentry_shares == nil {
-		m.removedentry_shares = make(map[uuid.UUID]struct{})
+// OldRedeliveryOf returns the old "redelivery_of" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldRedeliveryOf(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRedeliveryOf is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.entry_shares, ids[i])
-		m.removedentry_shares[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRedeliveryOf requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRedeliveryOf: %w", err)
 	}
+	return oldValue.RedeliveryOf, nil
 }
 
-// RemovedEntryShares returns the removed IDs of the "entry_shares" edge to the EntryShare entity.
-func (m *PatientMutation) RemovedEntrySharesIDs() (ids []uuid.UUID) {
-	for id := range m.removedentry_shares {
-		ids = append(ids, id)
-	}
-	return
+// ClearRedeliveryOf clears the value of the "redelivery_of" field.
+func (m *WebhookDeliveryMutation) ClearRedeliveryOf() {
+	m.redelivery_of = nil
+	m.clearedFields[webhookdelivery.FieldRedeliveryOf] = struct{}{}
 }
 
-// EntrySharesIDs returns the "entry_shares" edge IDs in the mutation.
-func (m *PatientMutation) EntrySharesIDs() (ids []uuid.UUID) {
-	for id := range m.entry_shares {
-		ids = append(ids, id)
+// RedeliveryOfCleared returns if the "redelivery_of" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) RedeliveryOfCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldRedeliveryOf]
+	return ok
+}
+
+// ResetRedeliveryOf resets all changes to the "redelivery_of" field.
+func (m *WebhookDeliveryMutation) ResetRedeliveryOf() {
+	m.redelivery_of = nil
+	delete(m.clearedFields, webhookdelivery.FieldRedeliveryOf)
+}
+
+// ClearSubscription clears the "subscription" edge to the WebhookSubscription entity.
+func (m *WebhookDeliveryMutation) ClearSubscription() {
+	m.clearedsubscription = true
+	m.clearedFields[webhookdelivery.FieldSubscriptionID] = struct{}{}
+}
+
+// SubscriptionCleared reports if the "subscription" edge to the WebhookSubscription entity was cleared.
+func (m *WebhookDeliveryMutation) SubscriptionCleared() bool {
+	return m.clearedsubscription
+}
+
+// SubscriptionIDs returns the "subscription" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SubscriptionID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) SubscriptionIDs() (ids []uuid.UUID) {
+	if id := m.subscription; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetEntryShares resets all changes to the "entry_shares" edge.
-func (m *PatientMutation) ResetEntryShares() {
-	m.entry_shares = nil
-	m.clearedentry_shares = false
-	m.removedentry_shares = nil
+// ResetSubscription resets all changes to the "subscription" edge.
+func (m *WebhookDeliveryMutation) ResetSubscription() {
+	m.subscription = nil
+	m.clearedsubscription = false
 }
 
-// Where appends a list predicates to the PatientMutation builder.
-func (m *PatientMutation) Where(ps ...predicate.Patient) {
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PatientMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PatientMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Patient, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8001,51 +24087,63 @@ func (m *PatientMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PatientMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PatientMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Patient).
-func (m *PatientMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PatientMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 13)
 	if m.created_at != nil {
-		fields = append(fields, patient.FieldCreatedAt)
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, patient.FieldUpdatedAt)
+		fields = append(fields, webhookdelivery.FieldUpdatedAt)
 	}
-	if m.display_name != nil {
-		fields = append(fields, patient.FieldDisplayName)
+	if m.subscription != nil {
+		fields = append(fields, webhookdelivery.FieldSubscriptionID)
 	}
-	if m.birth_date != nil {
-		fields = append(fields, patient.FieldBirthDate)
+	if m.event_id != nil {
+		fields = append(fields, webhookdelivery.FieldEventID)
+	}
+	if m.event_type != nil {
+		fields = append(fields, webhookdelivery.FieldEventType)
+	}
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
 	}
 	if m.status != nil {
-		fields = append(fields, patient.FieldStatus)
+		fields = append(fields, webhookdelivery.FieldStatus)
 	}
-	if m.email != nil {
-		fields = append(fields, patient.FieldEmail)
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
 	}
-	if m.password_hash != nil {
-		fields = append(fields, patient.FieldPasswordHash)
+	if m.next_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
 	}
-	if m.patient_code != nil {
-		fields = append(fields, patient.FieldPatientCode)
+	if m.last_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldLastAttemptAt)
 	}
-	if m.last_entry_at != nil {
-		fields = append(fields, patient.FieldLastEntryAt)
+	if m.response_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.last_error != nil {
+		fields = append(fields, webhookdelivery.FieldLastError)
+	}
+	if m.redelivery_of != nil {
+		fields = append(fields, webhookdelivery.FieldRedeliveryOf)
 	}
 	return fields
 }
@@ -8053,26 +24151,34 @@ func (m *PatientMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PatientMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case patient.FieldCreatedAt:
+	case webhookdelivery.FieldCreatedAt:
 		return m.CreatedAt()
-	case patient.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case patient.FieldDisplayName:
-		return m.DisplayName()
-	case patient.FieldBirthDate:
-		return m.BirthDate()
-	case patient.FieldStatus:
+	case webhookdelivery.FieldSubscriptionID:
+		return m.SubscriptionID()
+	case webhookdelivery.FieldEventID:
+		return m.EventID()
+	case webhookdelivery.FieldEventType:
+		return m.EventType()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatus:
 		return m.Status()
-	case patient.FieldEmail:
-		return m.Email()
-	case patient.FieldPasswordHash:
-		return m.PasswordHash()
-	case patient.FieldPatientCode:
-		return m.PatientCode()
-	case patient.FieldLastEntryAt:
-		return m.LastEntryAt()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case webhookdelivery.FieldLastAttemptAt:
+		return m.LastAttemptAt()
+	case webhookdelivery.FieldResponseStatus:
+		return m.ResponseStatus()
+	case webhookdelivery.FieldLastError:
+		return m.LastError()
+	case webhookdelivery.FieldRedeliveryOf:
+		return m.RedeliveryOf()
 	}
 	return nil, false
 }
@@ -8080,429 +24186,398 @@ func (m *PatientMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PatientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case patient.FieldCreatedAt:
+	case webhookdelivery.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case patient.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case patient.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case patient.FieldBirthDate:
-		return m.OldBirthDate(ctx)
-	case patient.FieldStatus:
+	case webhookdelivery.FieldSubscriptionID:
+		return m.OldSubscriptionID(ctx)
+	case webhookdelivery.FieldEventID:
+		return m.OldEventID(ctx)
+	case webhookdelivery.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldStatus:
 		return m.OldStatus(ctx)
-	case patient.FieldEmail:
-		return m.OldEmail(ctx)
-	case patient.FieldPasswordHash:
-		return m.OldPasswordHash(ctx)
-	case patient.FieldPatientCode:
-		return m.OldPatientCode(ctx)
-	case patient.FieldLastEntryAt:
-		return m.OldLastEntryAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Patient field %s", name)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case webhookdelivery.FieldLastAttemptAt:
+		return m.OldLastAttemptAt(ctx)
+	case webhookdelivery.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case webhookdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdelivery.FieldRedeliveryOf:
+		return m.OldRedeliveryOf(ctx)
+	}
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PatientMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case patient.FieldCreatedAt:
+	case webhookdelivery.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case patient.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case patient.FieldDisplayName:
+	case webhookdelivery.FieldSubscriptionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubscriptionID(v)
+		return nil
+	case webhookdelivery.FieldEventID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case webhookdelivery.FieldEventType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayName(v)
+		m.SetEventType(v)
 		return nil
-	case patient.FieldBirthDate:
-		v, ok := value.(time.Time)
+	case webhookdelivery.FieldPayload:
+		v, ok := value.(json.RawMessage)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBirthDate(v)
+		m.SetPayload(v)
 		return nil
-	case patient.FieldStatus:
-		v, ok := value.(patient.Status)
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(webhookdelivery.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatus(v)
 		return nil
-	case patient.FieldEmail:
-		v, ok := value.(string)
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetAttempts(v)
 		return nil
-	case patient.FieldPasswordHash:
-		v, ok := value.(string)
+	case webhookdelivery.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPasswordHash(v)
+		m.SetNextAttemptAt(v)
 		return nil
-	case patient.FieldPatientCode:
+	case webhookdelivery.FieldLastAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastAttemptAt(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldLastError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPatientCode(v)
+		m.SetLastError(v)
 		return nil
-	case patient.FieldLastEntryAt:
-		v, ok := value.(time.Time)
+	case webhookdelivery.FieldRedeliveryOf:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastEntryAt(v)
+		m.SetRedeliveryOf(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Patient field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PatientMutation) AddedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addresponse_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PatientMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.AddedResponseStatus()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PatientMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Patient numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PatientMutation) ClearedFields() []string {
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(patient.FieldBirthDate) {
-		fields = append(fields, patient.FieldBirthDate)
+	if m.FieldCleared(webhookdelivery.FieldNextAttemptAt) {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
 	}
-	if m.FieldCleared(patient.FieldEmail) {
-		fields = append(fields, patient.FieldEmail)
+	if m.FieldCleared(webhookdelivery.FieldLastAttemptAt) {
+		fields = append(fields, webhookdelivery.FieldLastAttemptAt)
 	}
-	if m.FieldCleared(patient.FieldPasswordHash) {
-		fields = append(fields, patient.FieldPasswordHash)
+	if m.FieldCleared(webhookdelivery.FieldResponseStatus) {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
 	}
-	if m.FieldCleared(patient.FieldPatientCode) {
-		fields = append(fields, patient.FieldPatientCode)
+	if m.FieldCleared(webhookdelivery.FieldLastError) {
+		fields = append(fields, webhookdelivery.FieldLastError)
 	}
-	if m.FieldCleared(patient.FieldLastEntryAt) {
-		fields = append(fields, patient.FieldLastEntryAt)
+	if m.FieldCleared(webhookdelivery.FieldRedeliveryOf) {
+		fields = append(fields, webhookdelivery.FieldRedeliveryOf)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PatientMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PatientMutation) ClearField(name string) error {
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case patient.FieldBirthDate:
-		m.ClearBirthDate()
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ClearNextAttemptAt()
 		return nil
-	case patient.FieldEmail:
-		m.ClearEmail()
+	case webhookdelivery.FieldLastAttemptAt:
+		m.ClearLastAttemptAt()
 		return nil
-	case patient.FieldPasswordHash:
-		m.ClearPasswordHash()
+	case webhookdelivery.FieldResponseStatus:
+		m.ClearResponseStatus()
 		return nil
-	case patient.FieldPatientCode:
-		m.ClearPatientCode()
+	case webhookdelivery.FieldLastError:
+		m.ClearLastError()
 		return nil
-	case patient.FieldLastEntryAt:
-		m.ClearLastEntryAt()
+	case webhookdelivery.FieldRedeliveryOf:
+		m.ClearRedeliveryOf()
 		return nil
 	}
-	return fmt.Errorf("unknown Patient nullable field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PatientMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case patient.FieldCreatedAt:
+	case webhookdelivery.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case patient.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case patient.FieldDisplayName:
-		m.ResetDisplayName()
-		return nil
-	case patient.FieldBirthDate:
-		m.ResetBirthDate()
-		return nil
-	case patient.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case patient.FieldEmail:
-		m.ResetEmail()
+	case webhookdelivery.FieldSubscriptionID:
+		m.ResetSubscriptionID()
 		return nil
-	case patient.FieldPasswordHash:
-		m.ResetPasswordHash()
+	case webhookdelivery.FieldEventID:
+		m.ResetEventID()
 		return nil
-	case patient.FieldPatientCode:
-		m.ResetPatientCode()
+	case webhookdelivery.FieldEventType:
+		m.ResetEventType()
 		return nil
-	case patient.FieldLastEntryAt:
-		m.ResetLastEntryAt()
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
 		return nil
-	}
-	return fmt.Errorf("unknown Patient field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PatientMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.doctor_links != nil {
-		edges = append(edges, patient.EdgeDoctorLinks)
-	}
-	if m.consumed_pairing_codes != nil {
-		edges = append(edges, patient.EdgeConsumedPairingCodes)
-	}
-	if m.entries != nil {
-		edges = append(edges, patient.EdgeEntries)
-	}
-	if m.analysis_jobs != nil {
-		edges = append(edges, patient.EdgeAnalysisJobs)
-	}
-	if m.entry_shares != nil {
-		edges = append(edges, patient.EdgeEntryShares)
-	}
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *PatientMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case patient.EdgeDoctorLinks:
-		ids := make([]ent.Value, 0, len(m.doctor_links))
-		for id := range m.doctor_links {
-			ids = append(ids, id)
-		}
-		return ids
-	case patient.EdgeConsumedPairingCodes:
-		ids := make([]ent.Value, 0, len(m.consumed_pairing_codes))
-		for id := range m.consumed_pairing_codes {
-			ids = append(ids, id)
-		}
-		return ids
-	case patient.EdgeEntries:
-		ids := make([]ent.Value, 0, len(m.entries))
-		for id := range m.entries {
-			ids = append(ids, id)
-		}
-		return ids
-	case patient.EdgeAnalysisJobs:
-		ids := make([]ent.Value, 0, len(m.analysis_jobs))
-		for id := range m.analysis_jobs {
-			ids = append(ids, id)
-		}
-		return ids
-	case patient.EdgeEntryShares:
-		ids := make([]ent.Value, 0, len(m.entry_shares))
-		for id := range m.entry_shares {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PatientMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removeddoctor_links != nil {
-		edges = append(edges, patient.EdgeDoctorLinks)
-	}
-	if m.removedconsumed_pairing_codes != nil {
-		edges = append(edges, patient.EdgeConsumedPairingCodes)
-	}
-	if m.removedentries != nil {
-		edges = append(edges, patient.EdgeEntries)
-	}
-	if m.removedanalysis_jobs != nil {
-		edges = append(edges, patient.EdgeAnalysisJobs)
-	}
-	if m.removedentry_shares != nil {
-		edges = append(edges, patient.EdgeEntryShares)
-	}
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *PatientMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case patient.EdgeDoctorLinks:
-		ids := make([]ent.Value, 0, len(m.removeddoctor_links))
-		for id := range m.removeddoctor_links {
-			ids = append(ids, id)
-		}
-		return ids
-	case patient.EdgeConsumedPairingCodes:
-		ids := make([]ent.Value, 0, len(m.removedconsumed_pairing_codes))
-		for id := range m.removedconsumed_pairing_codes {
-			ids = append(ids, id)
-		}
-		return ids
-	case patient.EdgeEntries:
-		ids := make([]ent.Value, 0, len(m.removedentries))
-		for id := range m.removedentries {
-			ids = append(ids, id)
-		}
-		return ids
-	case patient.EdgeAnalysisJobs:
-		ids := make([]ent.Value, 0, len(m.removedanalysis_jobs))
-		for id := range m.removedanalysis_jobs {
-			ids = append(ids, id)
-		}
-		return ids
-	case patient.EdgeEntryShares:
-		ids := make([]ent.Value, 0, len(m.removedentry_shares))
-		for id := range m.removedentry_shares {
-			ids = append(ids, id)
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case webhookdelivery.FieldLastAttemptAt:
+		m.ResetLastAttemptAt()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ResetResponseStatus()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case webhookdelivery.FieldRedeliveryOf:
+		m.ResetRedeliveryOf()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.subscription != nil {
+		edges = append(edges, webhookdelivery.EdgeSubscription)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case webhookdelivery.EdgeSubscription:
+		if id := m.subscription; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PatientMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.cleareddoctor_links {
-		edges = append(edges, patient.EdgeDoctorLinks)
-	}
-	if m.clearedconsumed_pairing_codes {
-		edges = append(edges, patient.EdgeConsumedPairingCodes)
-	}
-	if m.clearedentries {
-		edges = append(edges, patient.EdgeEntries)
-	}
-	if m.clearedanalysis_jobs {
-		edges = append(edges, patient.EdgeAnalysisJobs)
-	}
-	if m.clearedentry_shares {
-		edges = append(edges, patient.EdgeEntryShares)
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsubscription {
+		edges = append(edges, webhookdelivery.EdgeSubscription)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PatientMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case patient.EdgeDoctorLinks:
-		return m.cleareddoctor_links
-	case patient.EdgeConsumedPairingCodes:
-		return m.clearedconsumed_pairing_codes
-	case patient.EdgeEntries:
-		return m.clearedentries
-	case patient.EdgeAnalysisJobs:
-		return m.clearedanalysis_jobs
-	case patient.EdgeEntryShares:
-		return m.clearedentry_shares
+	case webhookdelivery.EdgeSubscription:
+		return m.clearedsubscription
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PatientMutation) ClearEdge(name string) error {
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
 	switch name {
+	case webhookdelivery.EdgeSubscription:
+		m.ClearSubscription()
+		return nil
 	}
-	return fmt.Errorf("unknown Patient unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PatientMutation) ResetEdge(name string) error {
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case patient.EdgeDoctorLinks:
-		m.ResetDoctorLinks()
-		return nil
-	case patient.EdgeConsumedPairingCodes:
-		m.ResetConsumedPairingCodes()
-		return nil
-	case patient.EdgeEntries:
-		m.ResetEntries()
-		return nil
-	case patient.EdgeAnalysisJobs:
-		m.ResetAnalysisJobs()
-		return nil
-	case patient.EdgeEntryShares:
-		m.ResetEntryShares()
+	case webhookdelivery.EdgeSubscription:
+		m.ResetSubscription()
 		return nil
 	}
-	return fmt.Errorf("unknown Patient edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
 
-// PracticeMutation represents an operation that mutates the Practice nodes in the graph.
-type PracticeMutation struct {
+// WebhookSubscriptionMutation represents an operation that mutates the WebhookSubscription nodes in the graph.
+type WebhookSubscriptionMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	created_at     *time.Time
-	updated_at     *time.Time
-	name           *string
-	address        *string
-	clearedFields  map[string]struct{}
-	doctors        map[uuid.UUID]struct{}
-	removeddoctors map[uuid.UUID]struct{}
-	cleareddoctors bool
-	done           bool
-	oldValue       func(context.Context) (*Practice, error)
-	predicates     []predicate.Practice
-}
-
-var _ ent.Mutation = (*PracticeMutation)(nil)
-
-// practiceOption allows management of the mutation configuration using functional options.
-type practiceOption func(*PracticeMutation)
-
-// newPracticeMutation creates new mutation for the Practice entity.
-func newPracticeMutation(c config, op Op, opts ...practiceOption) *PracticeMutation {
-	m := &PracticeMutation{
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	created_at        *time.Time
+	updated_at        *time.Time
+	url               *string
+	secret            *string
+	events            *[]string
+	appendevents      []string
+	description       *string
+	active            *bool
+	clearedFields     map[string]struct{}
+	practice          *uuid.UUID
+	clearedpractice   bool
+	created_by        *uuid.UUID
+	clearedcreated_by bool
+	deliveries        map[uuid.UUID]struct{}
+	removeddeliveries map[uuid.UUID]struct{}
+	cleareddeliveries bool
+	done              bool
+	oldValue          func(context.Context) (*WebhookSubscription, error)
+	predicates        []predicate.WebhookSubscription
+}
+
+var _ ent.Mutation = (*WebhookSubscriptionMutation)(nil)
+
+// webhooksubscriptionOption allows management of the mutation configuration using functional options.
+type webhooksubscriptionOption func(*WebhookSubscriptionMutation)
+
+// newWebhookSubscriptionMutation creates new mutation for the WebhookSubscription entity.
+func newWebhookSubscriptionMutation(c config, op Op, opts ...webhooksubscriptionOption) *WebhookSubscriptionMutation {
+	m := &WebhookSubscriptionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePractice,
+		typ:           TypeWebhookSubscription,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8511,20 +24586,20 @@ func newPracticeMutation(c config, op Op, opts ...practiceOption) *PracticeMutat
 	return m
 }
 
-// withPracticeID sets the ID field of the mutation.
-func withPracticeID(id uuid.UUID) practiceOption {
-	return func(m *PracticeMutation) {
+// withWebhookSubscriptionID sets the ID field of the mutation.
+func withWebhookSubscriptionID(id uuid.UUID) webhooksubscriptionOption {
+	return func(m *WebhookSubscriptionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Practice
+			value *WebhookSubscription
 		)
-		m.oldValue = func(ctx context.Context) (*Practice, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookSubscription, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Practice.Get(ctx, id)
+					value, err = m.Client().WebhookSubscription.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8533,10 +24608,10 @@ func withPracticeID(id uuid.UUID) practiceOption {
 	}
 }
 
-// withPractice sets the old Practice of the mutation.
-func withPractice(node *Practice) practiceOption {
-	return func(m *PracticeMutation) {
-		m.oldValue = func(context.Context) (*Practice, error) {
+// withWebhookSubscription sets the old WebhookSubscription of the mutation.
+func withWebhookSubscription(node *WebhookSubscription) webhooksubscriptionOption {
+	return func(m *WebhookSubscriptionMutation) {
+		m.oldValue = func(context.Context) (*WebhookSubscription, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8545,7 +24620,7 @@ func withPractice(node *Practice) practiceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PracticeMutation) Client() *Client {
+func (m WebhookSubscriptionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8553,7 +24628,7 @@ func (m PracticeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PracticeMutation) Tx() (*Tx, error) {
+func (m WebhookSubscriptionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8563,14 +24638,14 @@ func (m PracticeMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Practice entities.
-func (m *PracticeMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of WebhookSubscription entities.
+func (m *WebhookSubscriptionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PracticeMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebhookSubscriptionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8581,7 +24656,7 @@ func (m *PracticeMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PracticeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebhookSubscriptionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8590,19 +24665,19 @@ func (m *PracticeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Practice.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookSubscription.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PracticeMutation) SetCreatedAt(t time.Time) {
+func (m *WebhookSubscriptionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PracticeMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WebhookSubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -8610,10 +24685,10 @@ func (m *PracticeMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Practice entity.
-// If the Practice object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PracticeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookSubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -8628,17 +24703,17 @@ func (m *PracticeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PracticeMutation) ResetCreatedAt() {
+func (m *WebhookSubscriptionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *PracticeMutation) SetUpdatedAt(t time.Time) {
+func (m *WebhookSubscriptionMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PracticeMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *WebhookSubscriptionMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -8646,10 +24721,10 @@ func (m *PracticeMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Practice entity.
-// If the Practice object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PracticeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookSubscriptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -8664,158 +24739,433 @@ func (m *PracticeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PracticeMutation) ResetUpdatedAt() {
+func (m *WebhookSubscriptionMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetName sets the "name" field.
-func (m *PracticeMutation) SetName(s string) {
-	m.name = &s
+// SetPracticeID sets the "practice_id" field.
+func (m *WebhookSubscriptionMutation) SetPracticeID(u uuid.UUID) {
+	m.practice = &u
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PracticeMutation) Name() (r string, exists bool) {
-	v := m.name
+// PracticeID returns the value of the "practice_id" field in the mutation.
+func (m *WebhookSubscriptionMutation) PracticeID() (r uuid.UUID, exists bool) {
+	v := m.practice
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Practice entity.
-// If the Practice object wasn't provided to the builder, the object is fetched from the database.
+// OldPracticeID returns the old "practice_id" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PracticeMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WebhookSubscriptionMutation) OldPracticeID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPracticeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPracticeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPracticeID: %w", err)
+	}
+	return oldValue.PracticeID, nil
+}
+
+// ResetPracticeID resets all changes to the "practice_id" field.
+func (m *WebhookSubscriptionMutation) ResetPracticeID() {
+	m.practice = nil
+}
+
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (m *WebhookSubscriptionMutation) SetCreatedByDoctorID(u uuid.UUID) {
+	m.created_by = &u
+}
+
+// CreatedByDoctorID returns the value of the "created_by_doctor_id" field in the mutation.
+func (m *WebhookSubscriptionMutation) CreatedByDoctorID() (r uuid.UUID, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedByDoctorID returns the old "created_by_doctor_id" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSubscriptionMutation) OldCreatedByDoctorID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedByDoctorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedByDoctorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedByDoctorID: %w", err)
+	}
+	return oldValue.CreatedByDoctorID, nil
+}
+
+// ClearCreatedByDoctorID clears the value of the "created_by_doctor_id" field.
+func (m *WebhookSubscriptionMutation) ClearCreatedByDoctorID() {
+	m.created_by = nil
+	m.clearedFields[webhooksubscription.FieldCreatedByDoctorID] = struct{}{}
+}
+
+// CreatedByDoctorIDCleared returns if the "created_by_doctor_id" field was cleared in this mutation.
+func (m *WebhookSubscriptionMutation) CreatedByDoctorIDCleared() bool {
+	_, ok := m.clearedFields[webhooksubscription.FieldCreatedByDoctorID]
+	return ok
+}
+
+// ResetCreatedByDoctorID resets all changes to the "created_by_doctor_id" field.
+func (m *WebhookSubscriptionMutation) ResetCreatedByDoctorID() {
+	m.created_by = nil
+	delete(m.clearedFields, webhooksubscription.FieldCreatedByDoctorID)
+}
+
+// SetURL sets the "url" field.
+func (m *WebhookSubscriptionMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookSubscriptionMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSubscriptionMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookSubscriptionMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *WebhookSubscriptionMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookSubscriptionMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSubscriptionMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookSubscriptionMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetEvents sets the "events" field.
+func (m *WebhookSubscriptionMutation) SetEvents(s []string) {
+	m.events = &s
+	m.appendevents = nil
+}
+
+// Events returns the value of the "events" field in the mutation.
+func (m *WebhookSubscriptionMutation) Events() (r []string, exists bool) {
+	v := m.events
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvents returns the old "events" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSubscriptionMutation) OldEvents(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+	}
+	return oldValue.Events, nil
+}
+
+// AppendEvents adds s to the "events" field.
+func (m *WebhookSubscriptionMutation) AppendEvents(s []string) {
+	m.appendevents = append(m.appendevents, s...)
+}
+
+// AppendedEvents returns the list of values that were appended to the "events" field in this mutation.
+func (m *WebhookSubscriptionMutation) AppendedEvents() ([]string, bool) {
+	if len(m.appendevents) == 0 {
+		return nil, false
+	}
+	return m.appendevents, true
+}
+
+// ResetEvents resets all changes to the "events" field.
+func (m *WebhookSubscriptionMutation) ResetEvents() {
+	m.events = nil
+	m.appendevents = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *WebhookSubscriptionMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *WebhookSubscriptionMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookSubscriptionMutation) OldDescription(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Description, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PracticeMutation) ResetName() {
-	m.name = nil
+// ClearDescription clears the value of the "description" field.
+func (m *WebhookSubscriptionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[webhooksubscription.FieldDescription] = struct{}{}
 }
 
-// SetAddress sets the "address" field.
-func (m *PracticeMutation) SetAddress(s string) {
-	m.address = &s
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *WebhookSubscriptionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[webhooksubscription.FieldDescription]
+	return ok
 }
 
-// Address returns the value of the "address" field in the mutation.
-func (m *PracticeMutation) Address() (r string, exists bool) {
-	v := m.address
+// ResetDescription resets all changes to the "description" field.
+func (m *WebhookSubscriptionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, webhooksubscription.FieldDescription)
+}
+
+// SetActive sets the "active" field.
+func (m *WebhookSubscriptionMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *WebhookSubscriptionMutation) Active() (r bool, exists bool) {
+	v := m.active
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAddress returns the old "address" field's value of the Practice entity.
-// If the Practice object wasn't provided to the builder, the object is fetched from the database.
+// OldActive returns the old "active" field's value of the WebhookSubscription entity.
+// If the WebhookSubscription object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PracticeMutation) OldAddress(ctx context.Context) (v *string, err error) {
+func (m *WebhookSubscriptionMutation) OldActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAddress requires an ID field in the mutation")
+		return v, errors.New("OldActive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
 	}
-	return oldValue.Address, nil
+	return oldValue.Active, nil
 }
 
-// ClearAddress clears the value of the "address" field.
-func (m *PracticeMutation) ClearAddress() {
-	m.address = nil
-	m.clearedFields[practice.FieldAddress] = struct{}{}
+// ResetActive resets all changes to the "active" field.
+func (m *WebhookSubscriptionMutation) ResetActive() {
+	m.active = nil
 }
 
-// AddressCleared returns if the "address" field was cleared in this mutation.
-func (m *PracticeMutation) AddressCleared() bool {
-	_, ok := m.clearedFields[practice.FieldAddress]
-	return ok
+// ClearPractice clears the "practice" edge to the Practice entity.
+func (m *WebhookSubscriptionMutation) ClearPractice() {
+	m.clearedpractice = true
+	m.clearedFields[webhooksubscription.FieldPracticeID] = struct{}{}
 }
 
-// ResetAddress resets all changes to the "address" field.
-func (m *PracticeMutation) ResetAddress() {
-	m.address = nil
-	delete(m.clearedFields, practice.FieldAddress)
+// PracticeCleared reports if the "practice" edge to the Practice entity was cleared.
+func (m *WebhookSubscriptionMutation) PracticeCleared() bool {
+	return m.clearedpractice
 }
 
-// AddDoctorIDs adds the "doctors" edge to the Doctor entity by ids.
-func (m *PracticeMutation) AddDoctorIDs(ids ...uuid.UUID) {
-	if m.doctors == nil {
-		m.doctors = make(map[uuid.UUID]struct{})
+// PracticeIDs returns the "practice" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PracticeID instead. It exists only for internal usage by the builders.
+func (m *WebhookSubscriptionMutation) PracticeIDs() (ids []uuid.UUID) {
+	if id := m.practice; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPractice resets all changes to the "practice" edge.
+func (m *WebhookSubscriptionMutation) ResetPractice() {
+	m.practice = nil
+	m.clearedpractice = false
+}
+
+// SetCreatedByID sets the "created_by" edge to the Doctor entity by id.
+func (m *WebhookSubscriptionMutation) SetCreatedByID(id uuid.UUID) {
+	m.created_by = &id
+}
+
+// ClearCreatedBy clears the "created_by" edge to the Doctor entity.
+func (m *WebhookSubscriptionMutation) ClearCreatedBy() {
+	m.clearedcreated_by = true
+	m.clearedFields[webhooksubscription.FieldCreatedByDoctorID] = struct{}{}
+}
+
+// CreatedByCleared reports if the "created_by" edge to the Doctor entity was cleared.
+func (m *WebhookSubscriptionMutation) CreatedByCleared() bool {
+	return m.CreatedByDoctorIDCleared() || m.clearedcreated_by
+}
+
+// CreatedByID returns the "created_by" edge ID in the mutation.
+func (m *WebhookSubscriptionMutation) CreatedByID() (id uuid.UUID, exists bool) {
+	if m.created_by != nil {
+		return *m.created_by, true
+	}
+	return
+}
+
+// CreatedByIDs returns the "created_by" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatedByID instead. It exists only for internal usage by the builders.
+func (m *WebhookSubscriptionMutation) CreatedByIDs() (ids []uuid.UUID) {
+	if id := m.created_by; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCreatedBy resets all changes to the "created_by" edge.
+func (m *WebhookSubscriptionMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.clearedcreated_by = false
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *WebhookSubscriptionMutation) AddDeliveryIDs(ids ...uuid.UUID) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.doctors[ids[i]] = struct{}{}
+		m.deliveries[ids[i]] = struct{}{}
 	}
 }
 
-// ClearDoctors clears the "doctors" edge to the Doctor entity.
-func (m *PracticeMutation) ClearDoctors() {
-	m.cleareddoctors = true
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookSubscriptionMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
 }
 
-// DoctorsCleared reports if the "doctors" edge to the Doctor entity was cleared.
-func (m *PracticeMutation) DoctorsCleared() bool {
-	return m.cleareddoctors
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *WebhookSubscriptionMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
 }
 
-// RemoveDoctorIDs removes the "doctors" edge to the Doctor entity by IDs.
-func (m *PracticeMutation) RemoveDoctorIDs(ids ...uuid.UUID) {
-	if m.removeddoctors == nil {
-		m.removeddoctors = make(map[uuid.UUID]struct{})
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *WebhookSubscriptionMutation) RemoveDeliveryIDs(ids ...uuid.UUID) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.doctors, ids[i])
-		m.removeddoctors[ids[i]] = struct{}{}
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedDoctors returns the removed IDs of the "doctors" edge to the Doctor entity.
-func (m *PracticeMutation) RemovedDoctorsIDs() (ids []uuid.UUID) {
-	for id := range m.removeddoctors {
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookSubscriptionMutation) RemovedDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.removeddeliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// DoctorsIDs returns the "doctors" edge IDs in the mutation.
-func (m *PracticeMutation) DoctorsIDs() (ids []uuid.UUID) {
-	for id := range m.doctors {
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WebhookSubscriptionMutation) DeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.deliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetDoctors resets all changes to the "doctors" edge.
-func (m *PracticeMutation) ResetDoctors() {
-	m.doctors = nil
-	m.cleareddoctors = false
-	m.removeddoctors = nil
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WebhookSubscriptionMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
 }
 
-// Where appends a list predicates to the PracticeMutation builder.
-func (m *PracticeMutation) Where(ps ...predicate.Practice) {
+// Where appends a list predicates to the WebhookSubscriptionMutation builder.
+func (m *WebhookSubscriptionMutation) Where(ps ...predicate.WebhookSubscription) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PracticeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookSubscriptionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PracticeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Practice, len(ps))
+func (m *WebhookSubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookSubscription, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8823,36 +25173,51 @@ func (m *PracticeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PracticeMutation) Op() Op {
+func (m *WebhookSubscriptionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PracticeMutation) SetOp(op Op) {
+func (m *WebhookSubscriptionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Practice).
-func (m *PracticeMutation) Type() string {
+// Type returns the node type of this mutation (WebhookSubscription).
+func (m *WebhookSubscriptionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PracticeMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+func (m *WebhookSubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.created_at != nil {
-		fields = append(fields, practice.FieldCreatedAt)
+		fields = append(fields, webhooksubscription.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, practice.FieldUpdatedAt)
+		fields = append(fields, webhooksubscription.FieldUpdatedAt)
 	}
-	if m.name != nil {
-		fields = append(fields, practice.FieldName)
+	if m.practice != nil {
+		fields = append(fields, webhooksubscription.FieldPracticeID)
 	}
-	if m.address != nil {
-		fields = append(fields, practice.FieldAddress)
+	if m.created_by != nil {
+		fields = append(fields, webhooksubscription.FieldCreatedByDoctorID)
+	}
+	if m.url != nil {
+		fields = append(fields, webhooksubscription.FieldURL)
+	}
+	if m.secret != nil {
+		fields = append(fields, webhooksubscription.FieldSecret)
+	}
+	if m.events != nil {
+		fields = append(fields, webhooksubscription.FieldEvents)
+	}
+	if m.description != nil {
+		fields = append(fields, webhooksubscription.FieldDescription)
+	}
+	if m.active != nil {
+		fields = append(fields, webhooksubscription.FieldActive)
 	}
 	return fields
 }
@@ -8860,16 +25225,26 @@ func (m *PracticeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PracticeMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookSubscriptionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case practice.FieldCreatedAt:
+	case webhooksubscription.FieldCreatedAt:
 		return m.CreatedAt()
-	case practice.FieldUpdatedAt:
+	case webhooksubscription.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case practice.FieldName:
-		return m.Name()
-	case practice.FieldAddress:
-		return m.Address()
+	case webhooksubscription.FieldPracticeID:
+		return m.PracticeID()
+	case webhooksubscription.FieldCreatedByDoctorID:
+		return m.CreatedByDoctorID()
+	case webhooksubscription.FieldURL:
+		return m.URL()
+	case webhooksubscription.FieldSecret:
+		return m.Secret()
+	case webhooksubscription.FieldEvents:
+		return m.Events()
+	case webhooksubscription.FieldDescription:
+		return m.Description()
+	case webhooksubscription.FieldActive:
+		return m.Active()
 	}
 	return nil, false
 }
@@ -8877,143 +25252,223 @@ func (m *PracticeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PracticeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookSubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case practice.FieldCreatedAt:
+	case webhooksubscription.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case practice.FieldUpdatedAt:
+	case webhooksubscription.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case practice.FieldName:
-		return m.OldName(ctx)
-	case practice.FieldAddress:
-		return m.OldAddress(ctx)
+	case webhooksubscription.FieldPracticeID:
+		return m.OldPracticeID(ctx)
+	case webhooksubscription.FieldCreatedByDoctorID:
+		return m.OldCreatedByDoctorID(ctx)
+	case webhooksubscription.FieldURL:
+		return m.OldURL(ctx)
+	case webhooksubscription.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhooksubscription.FieldEvents:
+		return m.OldEvents(ctx)
+	case webhooksubscription.FieldDescription:
+		return m.OldDescription(ctx)
+	case webhooksubscription.FieldActive:
+		return m.OldActive(ctx)
 	}
-	return nil, fmt.Errorf("unknown Practice field %s", name)
+	return nil, fmt.Errorf("unknown WebhookSubscription field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PracticeMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookSubscriptionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case practice.FieldCreatedAt:
+	case webhooksubscription.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case practice.FieldUpdatedAt:
+	case webhooksubscription.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case practice.FieldName:
+	case webhooksubscription.FieldPracticeID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPracticeID(v)
+		return nil
+	case webhooksubscription.FieldCreatedByDoctorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedByDoctorID(v)
+		return nil
+	case webhooksubscription.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetURL(v)
 		return nil
-	case practice.FieldAddress:
+	case webhooksubscription.FieldSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAddress(v)
+		m.SetSecret(v)
+		return nil
+	case webhooksubscription.FieldEvents:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvents(v)
+		return nil
+	case webhooksubscription.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case webhooksubscription.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Practice field %s", name)
+	return fmt.Errorf("unknown WebhookSubscription field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PracticeMutation) AddedFields() []string {
+func (m *WebhookSubscriptionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PracticeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookSubscriptionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PracticeMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookSubscriptionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Practice numeric field %s", name)
+	return fmt.Errorf("unknown WebhookSubscription numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PracticeMutation) ClearedFields() []string {
+func (m *WebhookSubscriptionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(practice.FieldAddress) {
-		fields = append(fields, practice.FieldAddress)
+	if m.FieldCleared(webhooksubscription.FieldCreatedByDoctorID) {
+		fields = append(fields, webhooksubscription.FieldCreatedByDoctorID)
+	}
+	if m.FieldCleared(webhooksubscription.FieldDescription) {
+		fields = append(fields, webhooksubscription.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PracticeMutation) FieldCleared(name string) bool {
+func (m *WebhookSubscriptionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PracticeMutation) ClearField(name string) error {
+func (m *WebhookSubscriptionMutation) ClearField(name string) error {
 	switch name {
-	case practice.FieldAddress:
-		m.ClearAddress()
+	case webhooksubscription.FieldCreatedByDoctorID:
+		m.ClearCreatedByDoctorID()
+		return nil
+	case webhooksubscription.FieldDescription:
+		m.ClearDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown Practice nullable field %s", name)
+	return fmt.Errorf("unknown WebhookSubscription nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PracticeMutation) ResetField(name string) error {
+func (m *WebhookSubscriptionMutation) ResetField(name string) error {
 	switch name {
-	case practice.FieldCreatedAt:
+	case webhooksubscription.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case practice.FieldUpdatedAt:
+	case webhooksubscription.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case practice.FieldName:
-		m.ResetName()
+	case webhooksubscription.FieldPracticeID:
+		m.ResetPracticeID()
 		return nil
-	case practice.FieldAddress:
-		m.ResetAddress()
+	case webhooksubscription.FieldCreatedByDoctorID:
+		m.ResetCreatedByDoctorID()
+		return nil
+	case webhooksubscription.FieldURL:
+		m.ResetURL()
+		return nil
+	case webhooksubscription.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case webhooksubscription.FieldEvents:
+		m.ResetEvents()
+		return nil
+	case webhooksubscription.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case webhooksubscription.FieldActive:
+		m.ResetActive()
 		return nil
 	}
-	return fmt.Errorf("unknown Practice field %s", name)
+	return fmt.Errorf("unknown WebhookSubscription field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PracticeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.doctors != nil {
-		edges = append(edges, practice.EdgeDoctors)
+func (m *WebhookSubscriptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.practice != nil {
+		edges = append(edges, webhooksubscription.EdgePractice)
+	}
+	if m.created_by != nil {
+		edges = append(edges, webhooksubscription.EdgeCreatedBy)
+	}
+	if m.deliveries != nil {
+		edges = append(edges, webhooksubscription.EdgeDeliveries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PracticeMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookSubscriptionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case practice.EdgeDoctors:
-		ids := make([]ent.Value, 0, len(m.doctors))
-		for id := range m.doctors {
+	case webhooksubscription.EdgePractice:
+		if id := m.practice; id != nil {
+			return []ent.Value{*id}
+		}
+	case webhooksubscription.EdgeCreatedBy:
+		if id := m.created_by; id != nil {
+			return []ent.Value{*id}
+		}
+	case webhooksubscription.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -9022,21 +25477,21 @@ func (m *PracticeMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PracticeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removeddoctors != nil {
-		edges = append(edges, practice.EdgeDoctors)
+func (m *WebhookSubscriptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhooksubscription.EdgeDeliveries)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PracticeMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookSubscriptionMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case practice.EdgeDoctors:
-		ids := make([]ent.Value, 0, len(m.removeddoctors))
-		for id := range m.removeddoctors {
+	case webhooksubscription.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -9045,39 +25500,61 @@ func (m *PracticeMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PracticeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareddoctors {
-		edges = append(edges, practice.EdgeDoctors)
+func (m *WebhookSubscriptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedpractice {
+		edges = append(edges, webhooksubscription.EdgePractice)
+	}
+	if m.clearedcreated_by {
+		edges = append(edges, webhooksubscription.EdgeCreatedBy)
+	}
+	if m.cleareddeliveries {
+		edges = append(edges, webhooksubscription.EdgeDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PracticeMutation) EdgeCleared(name string) bool {
+func (m *WebhookSubscriptionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case practice.EdgeDoctors:
-		return m.cleareddoctors
+	case webhooksubscription.EdgePractice:
+		return m.clearedpractice
+	case webhooksubscription.EdgeCreatedBy:
+		return m.clearedcreated_by
+	case webhooksubscription.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PracticeMutation) ClearEdge(name string) error {
+func (m *WebhookSubscriptionMutation) ClearEdge(name string) error {
 	switch name {
+	case webhooksubscription.EdgePractice:
+		m.ClearPractice()
+		return nil
+	case webhooksubscription.EdgeCreatedBy:
+		m.ClearCreatedBy()
+		return nil
 	}
-	return fmt.Errorf("unknown Practice unique edge %s", name)
+	return fmt.Errorf("unknown WebhookSubscription unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PracticeMutation) ResetEdge(name string) error {
+func (m *WebhookSubscriptionMutation) ResetEdge(name string) error {
 	switch name {
-	case practice.EdgeDoctors:
-		m.ResetDoctors()
+	case webhooksubscription.EdgePractice:
+		m.ResetPractice()
+		return nil
+	case webhooksubscription.EdgeCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case webhooksubscription.EdgeDeliveries:
+		m.ResetDeliveries()
 		return nil
 	}
-	return fmt.Errorf("unknown Practice edge %s", name)
+	return fmt.Errorf("unknown WebhookSubscription edge %s", name)
 }
@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -21,6 +23,7 @@ type PairingCodeCreate struct {
 	config
 	mutation *PairingCodeMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -226,6 +229,7 @@ func (_c *PairingCodeCreate) createSpec() (*PairingCode, *sqlgraph.CreateSpec) {
 		_node = &PairingCode{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(pairingcode.Table, sqlgraph.NewFieldSpec(pairingcode.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -287,11 +291,332 @@ func (_c *PairingCodeCreate) createSpec() (*PairingCode, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PairingCode.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PairingCodeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PairingCodeCreate) OnConflict(opts ...sql.ConflictOption) *PairingCodeUpsertOne {
+	_c.conflict = opts
+	return &PairingCodeUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PairingCode.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PairingCodeCreate) OnConflictColumns(columns ...string) *PairingCodeUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PairingCodeUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PairingCodeUpsertOne is the builder for "upsert"-ing
+	//  one PairingCode node.
+	PairingCodeUpsertOne struct {
+		create *PairingCodeCreate
+	}
+
+	// PairingCodeUpsert is the "OnConflict" setter.
+	PairingCodeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PairingCodeUpsert) SetUpdatedAt(v time.Time) *PairingCodeUpsert {
+	u.Set(pairingcode.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PairingCodeUpsert) UpdateUpdatedAt() *PairingCodeUpsert {
+	u.SetExcluded(pairingcode.FieldUpdatedAt)
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *PairingCodeUpsert) SetCode(v string) *PairingCodeUpsert {
+	u.Set(pairingcode.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *PairingCodeUpsert) UpdateCode() *PairingCodeUpsert {
+	u.SetExcluded(pairingcode.FieldCode)
+	return u
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *PairingCodeUpsert) SetDoctorID(v uuid.UUID) *PairingCodeUpsert {
+	u.Set(pairingcode.FieldDoctorID, v)
+	return u
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *PairingCodeUpsert) UpdateDoctorID() *PairingCodeUpsert {
+	u.SetExcluded(pairingcode.FieldDoctorID)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PairingCodeUpsert) SetExpiresAt(v time.Time) *PairingCodeUpsert {
+	u.Set(pairingcode.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PairingCodeUpsert) UpdateExpiresAt() *PairingCodeUpsert {
+	u.SetExcluded(pairingcode.FieldExpiresAt)
+	return u
+}
+
+// SetConsumedAt sets the "consumed_at" field.
+func (u *PairingCodeUpsert) SetConsumedAt(v time.Time) *PairingCodeUpsert {
+	u.Set(pairingcode.FieldConsumedAt, v)
+	return u
+}
+
+// UpdateConsumedAt sets the "consumed_at" field to the value that was provided on create.
+func (u *PairingCodeUpsert) UpdateConsumedAt() *PairingCodeUpsert {
+	u.SetExcluded(pairingcode.FieldConsumedAt)
+	return u
+}
+
+// ClearConsumedAt clears the value of the "consumed_at" field.
+func (u *PairingCodeUpsert) ClearConsumedAt() *PairingCodeUpsert {
+	u.SetNull(pairingcode.FieldConsumedAt)
+	return u
+}
+
+// SetConsumedByPatientID sets the "consumed_by_patient_id" field.
+func (u *PairingCodeUpsert) SetConsumedByPatientID(v uuid.UUID) *PairingCodeUpsert {
+	u.Set(pairingcode.FieldConsumedByPatientID, v)
+	return u
+}
+
+// UpdateConsumedByPatientID sets the "consumed_by_patient_id" field to the value that was provided on create.
+func (u *PairingCodeUpsert) UpdateConsumedByPatientID() *PairingCodeUpsert {
+	u.SetExcluded(pairingcode.FieldConsumedByPatientID)
+	return u
+}
+
+// ClearConsumedByPatientID clears the value of the "consumed_by_patient_id" field.
+func (u *PairingCodeUpsert) ClearConsumedByPatientID() *PairingCodeUpsert {
+	u.SetNull(pairingcode.FieldConsumedByPatientID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PairingCode.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pairingcode.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PairingCodeUpsertOne) UpdateNewValues() *PairingCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(pairingcode.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(pairingcode.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PairingCode.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PairingCodeUpsertOne) Ignore() *PairingCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PairingCodeUpsertOne) DoNothing() *PairingCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PairingCodeCreate.OnConflict
+// documentation for more info.
+func (u *PairingCodeUpsertOne) Update(set func(*PairingCodeUpsert)) *PairingCodeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PairingCodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PairingCodeUpsertOne) SetUpdatedAt(v time.Time) *PairingCodeUpsertOne {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PairingCodeUpsertOne) UpdateUpdatedAt() *PairingCodeUpsertOne {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *PairingCodeUpsertOne) SetCode(v string) *PairingCodeUpsertOne {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *PairingCodeUpsertOne) UpdateCode() *PairingCodeUpsertOne {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *PairingCodeUpsertOne) SetDoctorID(v uuid.UUID) *PairingCodeUpsertOne {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.SetDoctorID(v)
+	})
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *PairingCodeUpsertOne) UpdateDoctorID() *PairingCodeUpsertOne {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.UpdateDoctorID()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PairingCodeUpsertOne) SetExpiresAt(v time.Time) *PairingCodeUpsertOne {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PairingCodeUpsertOne) UpdateExpiresAt() *PairingCodeUpsertOne {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetConsumedAt sets the "consumed_at" field.
+func (u *PairingCodeUpsertOne) SetConsumedAt(v time.Time) *PairingCodeUpsertOne {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.SetConsumedAt(v)
+	})
+}
+
+// UpdateConsumedAt sets the "consumed_at" field to the value that was provided on create.
+func (u *PairingCodeUpsertOne) UpdateConsumedAt() *PairingCodeUpsertOne {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.UpdateConsumedAt()
+	})
+}
+
+// ClearConsumedAt clears the value of the "consumed_at" field.
+func (u *PairingCodeUpsertOne) ClearConsumedAt() *PairingCodeUpsertOne {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.ClearConsumedAt()
+	})
+}
+
+// SetConsumedByPatientID sets the "consumed_by_patient_id" field.
+func (u *PairingCodeUpsertOne) SetConsumedByPatientID(v uuid.UUID) *PairingCodeUpsertOne {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.SetConsumedByPatientID(v)
+	})
+}
+
+// UpdateConsumedByPatientID sets the "consumed_by_patient_id" field to the value that was provided on create.
+func (u *PairingCodeUpsertOne) UpdateConsumedByPatientID() *PairingCodeUpsertOne {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.UpdateConsumedByPatientID()
+	})
+}
+
+// ClearConsumedByPatientID clears the value of the "consumed_by_patient_id" field.
+func (u *PairingCodeUpsertOne) ClearConsumedByPatientID() *PairingCodeUpsertOne {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.ClearConsumedByPatientID()
+	})
+}
+
+// Exec executes the query.
+func (u *PairingCodeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PairingCodeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PairingCodeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PairingCodeUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PairingCodeUpsertOne.ID is not supported by MySQL driver. Use PairingCodeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PairingCodeUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PairingCodeCreateBulk is the builder for creating many PairingCode entities in bulk.
 type PairingCodeCreateBulk struct {
 	config
 	err      error
 	builders []*PairingCodeCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the PairingCode entities in the database.
@@ -321,6 +646,7 @@ func (_c *PairingCodeCreateBulk) Save(ctx context.Context) ([]*PairingCode, erro
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -370,3 +696,218 @@ func (_c *PairingCodeCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PairingCode.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PairingCodeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PairingCodeCreateBulk) OnConflict(opts ...sql.ConflictOption) *PairingCodeUpsertBulk {
+	_c.conflict = opts
+	return &PairingCodeUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PairingCode.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PairingCodeCreateBulk) OnConflictColumns(columns ...string) *PairingCodeUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PairingCodeUpsertBulk{
+		create: _c,
+	}
+}
+
+// PairingCodeUpsertBulk is the builder for "upsert"-ing
+// a bulk of PairingCode nodes.
+type PairingCodeUpsertBulk struct {
+	create *PairingCodeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PairingCode.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(pairingcode.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PairingCodeUpsertBulk) UpdateNewValues() *PairingCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(pairingcode.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(pairingcode.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PairingCode.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PairingCodeUpsertBulk) Ignore() *PairingCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PairingCodeUpsertBulk) DoNothing() *PairingCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PairingCodeCreateBulk.OnConflict
+// documentation for more info.
+func (u *PairingCodeUpsertBulk) Update(set func(*PairingCodeUpsert)) *PairingCodeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PairingCodeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PairingCodeUpsertBulk) SetUpdatedAt(v time.Time) *PairingCodeUpsertBulk {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PairingCodeUpsertBulk) UpdateUpdatedAt() *PairingCodeUpsertBulk {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *PairingCodeUpsertBulk) SetCode(v string) *PairingCodeUpsertBulk {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *PairingCodeUpsertBulk) UpdateCode() *PairingCodeUpsertBulk {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *PairingCodeUpsertBulk) SetDoctorID(v uuid.UUID) *PairingCodeUpsertBulk {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.SetDoctorID(v)
+	})
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *PairingCodeUpsertBulk) UpdateDoctorID() *PairingCodeUpsertBulk {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.UpdateDoctorID()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PairingCodeUpsertBulk) SetExpiresAt(v time.Time) *PairingCodeUpsertBulk {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PairingCodeUpsertBulk) UpdateExpiresAt() *PairingCodeUpsertBulk {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetConsumedAt sets the "consumed_at" field.
+func (u *PairingCodeUpsertBulk) SetConsumedAt(v time.Time) *PairingCodeUpsertBulk {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.SetConsumedAt(v)
+	})
+}
+
+// UpdateConsumedAt sets the "consumed_at" field to the value that was provided on create.
+func (u *PairingCodeUpsertBulk) UpdateConsumedAt() *PairingCodeUpsertBulk {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.UpdateConsumedAt()
+	})
+}
+
+// ClearConsumedAt clears the value of the "consumed_at" field.
+func (u *PairingCodeUpsertBulk) ClearConsumedAt() *PairingCodeUpsertBulk {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.ClearConsumedAt()
+	})
+}
+
+// SetConsumedByPatientID sets the "consumed_by_patient_id" field.
+func (u *PairingCodeUpsertBulk) SetConsumedByPatientID(v uuid.UUID) *PairingCodeUpsertBulk {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.SetConsumedByPatientID(v)
+	})
+}
+
+// UpdateConsumedByPatientID sets the "consumed_by_patient_id" field to the value that was provided on create.
+func (u *PairingCodeUpsertBulk) UpdateConsumedByPatientID() *PairingCodeUpsertBulk {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.UpdateConsumedByPatientID()
+	})
+}
+
+// ClearConsumedByPatientID clears the value of the "consumed_by_patient_id" field.
+func (u *PairingCodeUpsertBulk) ClearConsumedByPatientID() *PairingCodeUpsertBulk {
+	return u.Update(func(s *PairingCodeUpsert) {
+		s.ClearConsumedByPatientID()
+	})
+}
+
+// Exec executes the query.
+func (u *PairingCodeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PairingCodeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PairingCodeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PairingCodeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -54,9 +54,23 @@ type PatientEdges struct {
 	AnalysisJobs []*AnalysisJob `json:"analysis_jobs,omitempty"`
 	// EntryShares holds the value of the entry_shares edge.
 	EntryShares []*EntryShare `json:"entry_shares,omitempty"`
+	// Goals holds the value of the goals edge.
+	Goals []*Goal `json:"goals,omitempty"`
+	// Assignments holds the value of the assignments edge.
+	Assignments []*Assignment `json:"assignments,omitempty"`
+	// Sessions holds the value of the sessions edge.
+	Sessions []*Session `json:"sessions,omitempty"`
+	// QuestionnaireAssignments holds the value of the questionnaire_assignments edge.
+	QuestionnaireAssignments []*QuestionnaireAssignment `json:"questionnaire_assignments,omitempty"`
+	// QuestionnaireResponses holds the value of the questionnaire_responses edge.
+	QuestionnaireResponses []*QuestionnaireResponse `json:"questionnaire_responses,omitempty"`
+	// ReminderSchedules holds the value of the reminder_schedules edge.
+	ReminderSchedules []*ReminderSchedule `json:"reminder_schedules,omitempty"`
+	// ReminderDeliveries holds the value of the reminder_deliveries edge.
+	ReminderDeliveries []*ReminderDelivery `json:"reminder_deliveries,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [12]bool
 }
 
 // DoctorLinksOrErr returns the DoctorLinks value or an error if the edge
@@ -104,6 +118,69 @@ func (e PatientEdges) EntrySharesOrErr() ([]*EntryShare, error) {
 	return nil, &NotLoadedError{edge: "entry_shares"}
 }
 
+// GoalsOrErr returns the Goals value or an error if the edge
+// was not loaded in eager-loading.
+func (e PatientEdges) GoalsOrErr() ([]*Goal, error) {
+	if e.loadedTypes[5] {
+		return e.Goals, nil
+	}
+	return nil, &NotLoadedError{edge: "goals"}
+}
+
+// AssignmentsOrErr returns the Assignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e PatientEdges) AssignmentsOrErr() ([]*Assignment, error) {
+	if e.loadedTypes[6] {
+		return e.Assignments, nil
+	}
+	return nil, &NotLoadedError{edge: "assignments"}
+}
+
+// SessionsOrErr returns the Sessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PatientEdges) SessionsOrErr() ([]*Session, error) {
+	if e.loadedTypes[7] {
+		return e.Sessions, nil
+	}
+	return nil, &NotLoadedError{edge: "sessions"}
+}
+
+// QuestionnaireAssignmentsOrErr returns the QuestionnaireAssignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e PatientEdges) QuestionnaireAssignmentsOrErr() ([]*QuestionnaireAssignment, error) {
+	if e.loadedTypes[8] {
+		return e.QuestionnaireAssignments, nil
+	}
+	return nil, &NotLoadedError{edge: "questionnaire_assignments"}
+}
+
+// QuestionnaireResponsesOrErr returns the QuestionnaireResponses value or an error if the edge
+// was not loaded in eager-loading.
+func (e PatientEdges) QuestionnaireResponsesOrErr() ([]*QuestionnaireResponse, error) {
+	if e.loadedTypes[9] {
+		return e.QuestionnaireResponses, nil
+	}
+	return nil, &NotLoadedError{edge: "questionnaire_responses"}
+}
+
+// ReminderSchedulesOrErr returns the ReminderSchedules value or an error if the edge
+// was not loaded in eager-loading.
+func (e PatientEdges) ReminderSchedulesOrErr() ([]*ReminderSchedule, error) {
+	if e.loadedTypes[10] {
+		return e.ReminderSchedules, nil
+	}
+	return nil, &NotLoadedError{edge: "reminder_schedules"}
+}
+
+// ReminderDeliveriesOrErr returns the ReminderDeliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e PatientEdges) ReminderDeliveriesOrErr() ([]*ReminderDelivery, error) {
+	if e.loadedTypes[11] {
+		return e.ReminderDeliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "reminder_deliveries"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Patient) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -233,6 +310,41 @@ func (_m *Patient) QueryEntryShares() *EntryShareQuery {
 	return NewPatientClient(_m.config).QueryEntryShares(_m)
 }
 
+// QueryGoals queries the "goals" edge of the Patient entity.
+func (_m *Patient) QueryGoals() *GoalQuery {
+	return NewPatientClient(_m.config).QueryGoals(_m)
+}
+
+// QueryAssignments queries the "assignments" edge of the Patient entity.
+func (_m *Patient) QueryAssignments() *AssignmentQuery {
+	return NewPatientClient(_m.config).QueryAssignments(_m)
+}
+
+// QuerySessions queries the "sessions" edge of the Patient entity.
+func (_m *Patient) QuerySessions() *SessionQuery {
+	return NewPatientClient(_m.config).QuerySessions(_m)
+}
+
+// QueryQuestionnaireAssignments queries the "questionnaire_assignments" edge of the Patient entity.
+func (_m *Patient) QueryQuestionnaireAssignments() *QuestionnaireAssignmentQuery {
+	return NewPatientClient(_m.config).QueryQuestionnaireAssignments(_m)
+}
+
+// QueryQuestionnaireResponses queries the "questionnaire_responses" edge of the Patient entity.
+func (_m *Patient) QueryQuestionnaireResponses() *QuestionnaireResponseQuery {
+	return NewPatientClient(_m.config).QueryQuestionnaireResponses(_m)
+}
+
+// QueryReminderSchedules queries the "reminder_schedules" edge of the Patient entity.
+func (_m *Patient) QueryReminderSchedules() *ReminderScheduleQuery {
+	return NewPatientClient(_m.config).QueryReminderSchedules(_m)
+}
+
+// QueryReminderDeliveries queries the "reminder_deliveries" edge of the Patient entity.
+func (_m *Patient) QueryReminderDeliveries() *ReminderDeliveryQuery {
+	return NewPatientClient(_m.config).QueryReminderDeliveries(_m)
+}
+
 // Update returns a builder for updating this Patient.
 // Note that you need to call Patient.Unwrap() before calling this method if this Patient
 // was returned from a transaction, and the transaction was committed or rolled back.
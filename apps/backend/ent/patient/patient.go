@@ -44,6 +44,20 @@ const (
 	EdgeAnalysisJobs = "analysis_jobs"
 	// EdgeEntryShares holds the string denoting the entry_shares edge name in mutations.
 	EdgeEntryShares = "entry_shares"
+	// EdgeGoals holds the string denoting the goals edge name in mutations.
+	EdgeGoals = "goals"
+	// EdgeAssignments holds the string denoting the assignments edge name in mutations.
+	EdgeAssignments = "assignments"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
+	// EdgeQuestionnaireAssignments holds the string denoting the questionnaire_assignments edge name in mutations.
+	EdgeQuestionnaireAssignments = "questionnaire_assignments"
+	// EdgeQuestionnaireResponses holds the string denoting the questionnaire_responses edge name in mutations.
+	EdgeQuestionnaireResponses = "questionnaire_responses"
+	// EdgeReminderSchedules holds the string denoting the reminder_schedules edge name in mutations.
+	EdgeReminderSchedules = "reminder_schedules"
+	// EdgeReminderDeliveries holds the string denoting the reminder_deliveries edge name in mutations.
+	EdgeReminderDeliveries = "reminder_deliveries"
 	// Table holds the table name of the patient in the database.
 	Table = "patients"
 	// DoctorLinksTable is the table that holds the doctor_links relation/edge.
@@ -81,6 +95,55 @@ const (
 	EntrySharesInverseTable = "entry_shares"
 	// EntrySharesColumn is the table column denoting the entry_shares relation/edge.
 	EntrySharesColumn = "shared_by_patient_id"
+	// GoalsTable is the table that holds the goals relation/edge.
+	GoalsTable = "goals"
+	// GoalsInverseTable is the table name for the Goal entity.
+	// It exists in this package in order to avoid circular dependency with the "goal" package.
+	GoalsInverseTable = "goals"
+	// GoalsColumn is the table column denoting the goals relation/edge.
+	GoalsColumn = "patient_id"
+	// AssignmentsTable is the table that holds the assignments relation/edge.
+	AssignmentsTable = "assignments"
+	// AssignmentsInverseTable is the table name for the Assignment entity.
+	// It exists in this package in order to avoid circular dependency with the "assignment" package.
+	AssignmentsInverseTable = "assignments"
+	// AssignmentsColumn is the table column denoting the assignments relation/edge.
+	AssignmentsColumn = "patient_id"
+	// SessionsTable is the table that holds the sessions relation/edge.
+	SessionsTable = "sessions"
+	// SessionsInverseTable is the table name for the Session entity.
+	// It exists in this package in order to avoid circular dependency with the "session" package.
+	SessionsInverseTable = "sessions"
+	// SessionsColumn is the table column denoting the sessions relation/edge.
+	SessionsColumn = "patient_id"
+	// QuestionnaireAssignmentsTable is the table that holds the questionnaire_assignments relation/edge.
+	QuestionnaireAssignmentsTable = "questionnaire_assignments"
+	// QuestionnaireAssignmentsInverseTable is the table name for the QuestionnaireAssignment entity.
+	// It exists in this package in order to avoid circular dependency with the "questionnaireassignment" package.
+	QuestionnaireAssignmentsInverseTable = "questionnaire_assignments"
+	// QuestionnaireAssignmentsColumn is the table column denoting the questionnaire_assignments relation/edge.
+	QuestionnaireAssignmentsColumn = "patient_id"
+	// QuestionnaireResponsesTable is the table that holds the questionnaire_responses relation/edge.
+	QuestionnaireResponsesTable = "questionnaire_responses"
+	// QuestionnaireResponsesInverseTable is the table name for the QuestionnaireResponse entity.
+	// It exists in this package in order to avoid circular dependency with the "questionnaireresponse" package.
+	QuestionnaireResponsesInverseTable = "questionnaire_responses"
+	// QuestionnaireResponsesColumn is the table column denoting the questionnaire_responses relation/edge.
+	QuestionnaireResponsesColumn = "patient_id"
+	// ReminderSchedulesTable is the table that holds the reminder_schedules relation/edge.
+	ReminderSchedulesTable = "reminder_schedules"
+	// ReminderSchedulesInverseTable is the table name for the ReminderSchedule entity.
+	// It exists in this package in order to avoid circular dependency with the "reminderschedule" package.
+	ReminderSchedulesInverseTable = "reminder_schedules"
+	// ReminderSchedulesColumn is the table column denoting the reminder_schedules relation/edge.
+	ReminderSchedulesColumn = "patient_id"
+	// ReminderDeliveriesTable is the table that holds the reminder_deliveries relation/edge.
+	ReminderDeliveriesTable = "reminder_deliveries"
+	// ReminderDeliveriesInverseTable is the table name for the ReminderDelivery entity.
+	// It exists in this package in order to avoid circular dependency with the "reminderdelivery" package.
+	ReminderDeliveriesInverseTable = "reminder_deliveries"
+	// ReminderDeliveriesColumn is the table column denoting the reminder_deliveries relation/edge.
+	ReminderDeliveriesColumn = "patient_id"
 )
 
 // Columns holds all SQL columns for patient fields.
@@ -270,6 +333,104 @@ func ByEntryShares(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newEntrySharesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByGoalsCount orders the results by goals count.
+func ByGoalsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGoalsStep(), opts...)
+	}
+}
+
+// ByGoals orders the results by goals terms.
+func ByGoals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGoalsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAssignmentsCount orders the results by assignments count.
+func ByAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAssignmentsStep(), opts...)
+	}
+}
+
+// ByAssignments orders the results by assignments terms.
+func ByAssignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAssignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySessionsCount orders the results by sessions count.
+func BySessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSessionsStep(), opts...)
+	}
+}
+
+// BySessions orders the results by sessions terms.
+func BySessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByQuestionnaireAssignmentsCount orders the results by questionnaire_assignments count.
+func ByQuestionnaireAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newQuestionnaireAssignmentsStep(), opts...)
+	}
+}
+
+// ByQuestionnaireAssignments orders the results by questionnaire_assignments terms.
+func ByQuestionnaireAssignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuestionnaireAssignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByQuestionnaireResponsesCount orders the results by questionnaire_responses count.
+func ByQuestionnaireResponsesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newQuestionnaireResponsesStep(), opts...)
+	}
+}
+
+// ByQuestionnaireResponses orders the results by questionnaire_responses terms.
+func ByQuestionnaireResponses(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuestionnaireResponsesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReminderSchedulesCount orders the results by reminder_schedules count.
+func ByReminderSchedulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReminderSchedulesStep(), opts...)
+	}
+}
+
+// ByReminderSchedules orders the results by reminder_schedules terms.
+func ByReminderSchedules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReminderSchedulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReminderDeliveriesCount orders the results by reminder_deliveries count.
+func ByReminderDeliveriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReminderDeliveriesStep(), opts...)
+	}
+}
+
+// ByReminderDeliveries orders the results by reminder_deliveries terms.
+func ByReminderDeliveries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReminderDeliveriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newDoctorLinksStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -305,3 +466,52 @@ func newEntrySharesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, EntrySharesTable, EntrySharesColumn),
 	)
 }
+func newGoalsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GoalsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GoalsTable, GoalsColumn),
+	)
+}
+func newAssignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AssignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AssignmentsTable, AssignmentsColumn),
+	)
+}
+func newSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+	)
+}
+func newQuestionnaireAssignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuestionnaireAssignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, QuestionnaireAssignmentsTable, QuestionnaireAssignmentsColumn),
+	)
+}
+func newQuestionnaireResponsesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuestionnaireResponsesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, QuestionnaireResponsesTable, QuestionnaireResponsesColumn),
+	)
+}
+func newReminderSchedulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReminderSchedulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReminderSchedulesTable, ReminderSchedulesColumn),
+	)
+}
+func newReminderDeliveriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReminderDeliveriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReminderDeliveriesTable, ReminderDeliveriesColumn),
+	)
+}
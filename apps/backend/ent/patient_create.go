@@ -4,16 +4,25 @@ package ent
 
 import (
 	"backend/ent/analysisjob"
+	"backend/ent/assignment"
 	"backend/ent/doctorpatientlink"
 	"backend/ent/entry"
 	"backend/ent/entryshare"
+	"backend/ent/goal"
 	"backend/ent/pairingcode"
 	"backend/ent/patient"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnaireresponse"
+	"backend/ent/reminderdelivery"
+	"backend/ent/reminderschedule"
+	"backend/ent/session"
 	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -24,6 +33,7 @@ type PatientCreate struct {
 	config
 	mutation *PatientMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -233,6 +243,111 @@ func (_c *PatientCreate) AddEntryShares(v ...*EntryShare) *PatientCreate {
 	return _c.AddEntryShareIDs(ids...)
 }
 
+// AddGoalIDs adds the "goals" edge to the Goal entity by IDs.
+func (_c *PatientCreate) AddGoalIDs(ids ...uuid.UUID) *PatientCreate {
+	_c.mutation.AddGoalIDs(ids...)
+	return _c
+}
+
+// AddGoals adds the "goals" edges to the Goal entity.
+func (_c *PatientCreate) AddGoals(v ...*Goal) *PatientCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddGoalIDs(ids...)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the Assignment entity by IDs.
+func (_c *PatientCreate) AddAssignmentIDs(ids ...uuid.UUID) *PatientCreate {
+	_c.mutation.AddAssignmentIDs(ids...)
+	return _c
+}
+
+// AddAssignments adds the "assignments" edges to the Assignment entity.
+func (_c *PatientCreate) AddAssignments(v ...*Assignment) *PatientCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAssignmentIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_c *PatientCreate) AddSessionIDs(ids ...uuid.UUID) *PatientCreate {
+	_c.mutation.AddSessionIDs(ids...)
+	return _c
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_c *PatientCreate) AddSessions(v ...*Session) *PatientCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSessionIDs(ids...)
+}
+
+// AddQuestionnaireAssignmentIDs adds the "questionnaire_assignments" edge to the QuestionnaireAssignment entity by IDs.
+func (_c *PatientCreate) AddQuestionnaireAssignmentIDs(ids ...uuid.UUID) *PatientCreate {
+	_c.mutation.AddQuestionnaireAssignmentIDs(ids...)
+	return _c
+}
+
+// AddQuestionnaireAssignments adds the "questionnaire_assignments" edges to the QuestionnaireAssignment entity.
+func (_c *PatientCreate) AddQuestionnaireAssignments(v ...*QuestionnaireAssignment) *PatientCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddQuestionnaireAssignmentIDs(ids...)
+}
+
+// AddQuestionnaireResponseIDs adds the "questionnaire_responses" edge to the QuestionnaireResponse entity by IDs.
+func (_c *PatientCreate) AddQuestionnaireResponseIDs(ids ...uuid.UUID) *PatientCreate {
+	_c.mutation.AddQuestionnaireResponseIDs(ids...)
+	return _c
+}
+
+// AddQuestionnaireResponses adds the "questionnaire_responses" edges to the QuestionnaireResponse entity.
+func (_c *PatientCreate) AddQuestionnaireResponses(v ...*QuestionnaireResponse) *PatientCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddQuestionnaireResponseIDs(ids...)
+}
+
+// AddReminderScheduleIDs adds the "reminder_schedules" edge to the ReminderSchedule entity by IDs.
+func (_c *PatientCreate) AddReminderScheduleIDs(ids ...uuid.UUID) *PatientCreate {
+	_c.mutation.AddReminderScheduleIDs(ids...)
+	return _c
+}
+
+// AddReminderSchedules adds the "reminder_schedules" edges to the ReminderSchedule entity.
+func (_c *PatientCreate) AddReminderSchedules(v ...*ReminderSchedule) *PatientCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReminderScheduleIDs(ids...)
+}
+
+// AddReminderDeliveryIDs adds the "reminder_deliveries" edge to the ReminderDelivery entity by IDs.
+func (_c *PatientCreate) AddReminderDeliveryIDs(ids ...uuid.UUID) *PatientCreate {
+	_c.mutation.AddReminderDeliveryIDs(ids...)
+	return _c
+}
+
+// AddReminderDeliveries adds the "reminder_deliveries" edges to the ReminderDelivery entity.
+func (_c *PatientCreate) AddReminderDeliveries(v ...*ReminderDelivery) *PatientCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReminderDeliveryIDs(ids...)
+}
+
 // Mutation returns the PatientMutation object of the builder.
 func (_c *PatientCreate) Mutation() *PatientMutation {
 	return _c.mutation
@@ -341,6 +456,7 @@ func (_c *PatientCreate) createSpec() (*Patient, *sqlgraph.CreateSpec) {
 		_node = &Patient{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(patient.Table, sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -461,14 +577,538 @@ func (_c *PatientCreate) createSpec() (*Patient, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.GoalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.GoalsTable,
+			Columns: []string{patient.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.AssignmentsTable,
+			Columns: []string{patient.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.SessionsTable,
+			Columns: []string{patient.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.QuestionnaireAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.QuestionnaireAssignmentsTable,
+			Columns: []string{patient.QuestionnaireAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.QuestionnaireResponsesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.QuestionnaireResponsesTable,
+			Columns: []string{patient.QuestionnaireResponsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReminderSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.ReminderSchedulesTable,
+			Columns: []string{patient.ReminderSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReminderDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.ReminderDeliveriesTable,
+			Columns: []string{patient.ReminderDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Patient.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PatientUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PatientCreate) OnConflict(opts ...sql.ConflictOption) *PatientUpsertOne {
+	_c.conflict = opts
+	return &PatientUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Patient.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PatientCreate) OnConflictColumns(columns ...string) *PatientUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PatientUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PatientUpsertOne is the builder for "upsert"-ing
+	//  one Patient node.
+	PatientUpsertOne struct {
+		create *PatientCreate
+	}
+
+	// PatientUpsert is the "OnConflict" setter.
+	PatientUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PatientUpsert) SetUpdatedAt(v time.Time) *PatientUpsert {
+	u.Set(patient.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PatientUpsert) UpdateUpdatedAt() *PatientUpsert {
+	u.SetExcluded(patient.FieldUpdatedAt)
+	return u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *PatientUpsert) SetDisplayName(v string) *PatientUpsert {
+	u.Set(patient.FieldDisplayName, v)
+	return u
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *PatientUpsert) UpdateDisplayName() *PatientUpsert {
+	u.SetExcluded(patient.FieldDisplayName)
+	return u
+}
+
+// SetBirthDate sets the "birth_date" field.
+func (u *PatientUpsert) SetBirthDate(v time.Time) *PatientUpsert {
+	u.Set(patient.FieldBirthDate, v)
+	return u
+}
+
+// UpdateBirthDate sets the "birth_date" field to the value that was provided on create.
+func (u *PatientUpsert) UpdateBirthDate() *PatientUpsert {
+	u.SetExcluded(patient.FieldBirthDate)
+	return u
+}
+
+// ClearBirthDate clears the value of the "birth_date" field.
+func (u *PatientUpsert) ClearBirthDate() *PatientUpsert {
+	u.SetNull(patient.FieldBirthDate)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PatientUpsert) SetStatus(v patient.Status) *PatientUpsert {
+	u.Set(patient.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PatientUpsert) UpdateStatus() *PatientUpsert {
+	u.SetExcluded(patient.FieldStatus)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *PatientUpsert) SetEmail(v string) *PatientUpsert {
+	u.Set(patient.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *PatientUpsert) UpdateEmail() *PatientUpsert {
+	u.SetExcluded(patient.FieldEmail)
+	return u
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *PatientUpsert) ClearEmail() *PatientUpsert {
+	u.SetNull(patient.FieldEmail)
+	return u
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *PatientUpsert) SetPasswordHash(v string) *PatientUpsert {
+	u.Set(patient.FieldPasswordHash, v)
+	return u
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *PatientUpsert) UpdatePasswordHash() *PatientUpsert {
+	u.SetExcluded(patient.FieldPasswordHash)
+	return u
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (u *PatientUpsert) ClearPasswordHash() *PatientUpsert {
+	u.SetNull(patient.FieldPasswordHash)
+	return u
+}
+
+// SetPatientCode sets the "patient_code" field.
+func (u *PatientUpsert) SetPatientCode(v string) *PatientUpsert {
+	u.Set(patient.FieldPatientCode, v)
+	return u
+}
+
+// UpdatePatientCode sets the "patient_code" field to the value that was provided on create.
+func (u *PatientUpsert) UpdatePatientCode() *PatientUpsert {
+	u.SetExcluded(patient.FieldPatientCode)
+	return u
+}
+
+// ClearPatientCode clears the value of the "patient_code" field.
+func (u *PatientUpsert) ClearPatientCode() *PatientUpsert {
+	u.SetNull(patient.FieldPatientCode)
+	return u
+}
+
+// SetLastEntryAt sets the "last_entry_at" field.
+func (u *PatientUpsert) SetLastEntryAt(v time.Time) *PatientUpsert {
+	u.Set(patient.FieldLastEntryAt, v)
+	return u
+}
+
+// UpdateLastEntryAt sets the "last_entry_at" field to the value that was provided on create.
+func (u *PatientUpsert) UpdateLastEntryAt() *PatientUpsert {
+	u.SetExcluded(patient.FieldLastEntryAt)
+	return u
+}
+
+// ClearLastEntryAt clears the value of the "last_entry_at" field.
+func (u *PatientUpsert) ClearLastEntryAt() *PatientUpsert {
+	u.SetNull(patient.FieldLastEntryAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Patient.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(patient.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PatientUpsertOne) UpdateNewValues() *PatientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(patient.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(patient.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Patient.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PatientUpsertOne) Ignore() *PatientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PatientUpsertOne) DoNothing() *PatientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PatientCreate.OnConflict
+// documentation for more info.
+func (u *PatientUpsertOne) Update(set func(*PatientUpsert)) *PatientUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PatientUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PatientUpsertOne) SetUpdatedAt(v time.Time) *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PatientUpsertOne) UpdateUpdatedAt() *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *PatientUpsertOne) SetDisplayName(v string) *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *PatientUpsertOne) UpdateDisplayName() *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// SetBirthDate sets the "birth_date" field.
+func (u *PatientUpsertOne) SetBirthDate(v time.Time) *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.SetBirthDate(v)
+	})
+}
+
+// UpdateBirthDate sets the "birth_date" field to the value that was provided on create.
+func (u *PatientUpsertOne) UpdateBirthDate() *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.UpdateBirthDate()
+	})
+}
+
+// ClearBirthDate clears the value of the "birth_date" field.
+func (u *PatientUpsertOne) ClearBirthDate() *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.ClearBirthDate()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PatientUpsertOne) SetStatus(v patient.Status) *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PatientUpsertOne) UpdateStatus() *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *PatientUpsertOne) SetEmail(v string) *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *PatientUpsertOne) UpdateEmail() *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *PatientUpsertOne) ClearEmail() *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *PatientUpsertOne) SetPasswordHash(v string) *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *PatientUpsertOne) UpdatePasswordHash() *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (u *PatientUpsertOne) ClearPasswordHash() *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.ClearPasswordHash()
+	})
+}
+
+// SetPatientCode sets the "patient_code" field.
+func (u *PatientUpsertOne) SetPatientCode(v string) *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.SetPatientCode(v)
+	})
+}
+
+// UpdatePatientCode sets the "patient_code" field to the value that was provided on create.
+func (u *PatientUpsertOne) UpdatePatientCode() *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.UpdatePatientCode()
+	})
+}
+
+// ClearPatientCode clears the value of the "patient_code" field.
+func (u *PatientUpsertOne) ClearPatientCode() *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.ClearPatientCode()
+	})
+}
+
+// SetLastEntryAt sets the "last_entry_at" field.
+func (u *PatientUpsertOne) SetLastEntryAt(v time.Time) *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.SetLastEntryAt(v)
+	})
+}
+
+// UpdateLastEntryAt sets the "last_entry_at" field to the value that was provided on create.
+func (u *PatientUpsertOne) UpdateLastEntryAt() *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.UpdateLastEntryAt()
+	})
+}
+
+// ClearLastEntryAt clears the value of the "last_entry_at" field.
+func (u *PatientUpsertOne) ClearLastEntryAt() *PatientUpsertOne {
+	return u.Update(func(s *PatientUpsert) {
+		s.ClearLastEntryAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PatientUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PatientCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PatientUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PatientUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PatientUpsertOne.ID is not supported by MySQL driver. Use PatientUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PatientUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PatientCreateBulk is the builder for creating many Patient entities in bulk.
 type PatientCreateBulk struct {
 	config
 	err      error
 	builders []*PatientCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Patient entities in the database.
@@ -498,6 +1138,7 @@ func (_c *PatientCreateBulk) Save(ctx context.Context) ([]*Patient, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -547,3 +1188,267 @@ func (_c *PatientCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Patient.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PatientUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PatientCreateBulk) OnConflict(opts ...sql.ConflictOption) *PatientUpsertBulk {
+	_c.conflict = opts
+	return &PatientUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Patient.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PatientCreateBulk) OnConflictColumns(columns ...string) *PatientUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PatientUpsertBulk{
+		create: _c,
+	}
+}
+
+// PatientUpsertBulk is the builder for "upsert"-ing
+// a bulk of Patient nodes.
+type PatientUpsertBulk struct {
+	create *PatientCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Patient.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(patient.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PatientUpsertBulk) UpdateNewValues() *PatientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(patient.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(patient.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Patient.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PatientUpsertBulk) Ignore() *PatientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PatientUpsertBulk) DoNothing() *PatientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PatientCreateBulk.OnConflict
+// documentation for more info.
+func (u *PatientUpsertBulk) Update(set func(*PatientUpsert)) *PatientUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PatientUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PatientUpsertBulk) SetUpdatedAt(v time.Time) *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PatientUpsertBulk) UpdateUpdatedAt() *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetDisplayName sets the "display_name" field.
+func (u *PatientUpsertBulk) SetDisplayName(v string) *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.SetDisplayName(v)
+	})
+}
+
+// UpdateDisplayName sets the "display_name" field to the value that was provided on create.
+func (u *PatientUpsertBulk) UpdateDisplayName() *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.UpdateDisplayName()
+	})
+}
+
+// SetBirthDate sets the "birth_date" field.
+func (u *PatientUpsertBulk) SetBirthDate(v time.Time) *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.SetBirthDate(v)
+	})
+}
+
+// UpdateBirthDate sets the "birth_date" field to the value that was provided on create.
+func (u *PatientUpsertBulk) UpdateBirthDate() *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.UpdateBirthDate()
+	})
+}
+
+// ClearBirthDate clears the value of the "birth_date" field.
+func (u *PatientUpsertBulk) ClearBirthDate() *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.ClearBirthDate()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PatientUpsertBulk) SetStatus(v patient.Status) *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PatientUpsertBulk) UpdateStatus() *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *PatientUpsertBulk) SetEmail(v string) *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *PatientUpsertBulk) UpdateEmail() *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// ClearEmail clears the value of the "email" field.
+func (u *PatientUpsertBulk) ClearEmail() *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.ClearEmail()
+	})
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (u *PatientUpsertBulk) SetPasswordHash(v string) *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.SetPasswordHash(v)
+	})
+}
+
+// UpdatePasswordHash sets the "password_hash" field to the value that was provided on create.
+func (u *PatientUpsertBulk) UpdatePasswordHash() *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.UpdatePasswordHash()
+	})
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (u *PatientUpsertBulk) ClearPasswordHash() *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.ClearPasswordHash()
+	})
+}
+
+// SetPatientCode sets the "patient_code" field.
+func (u *PatientUpsertBulk) SetPatientCode(v string) *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.SetPatientCode(v)
+	})
+}
+
+// UpdatePatientCode sets the "patient_code" field to the value that was provided on create.
+func (u *PatientUpsertBulk) UpdatePatientCode() *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.UpdatePatientCode()
+	})
+}
+
+// ClearPatientCode clears the value of the "patient_code" field.
+func (u *PatientUpsertBulk) ClearPatientCode() *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.ClearPatientCode()
+	})
+}
+
+// SetLastEntryAt sets the "last_entry_at" field.
+func (u *PatientUpsertBulk) SetLastEntryAt(v time.Time) *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.SetLastEntryAt(v)
+	})
+}
+
+// UpdateLastEntryAt sets the "last_entry_at" field to the value that was provided on create.
+func (u *PatientUpsertBulk) UpdateLastEntryAt() *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.UpdateLastEntryAt()
+	})
+}
+
+// ClearLastEntryAt clears the value of the "last_entry_at" field.
+func (u *PatientUpsertBulk) ClearLastEntryAt() *PatientUpsertBulk {
+	return u.Update(func(s *PatientUpsert) {
+		s.ClearLastEntryAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PatientUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PatientCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PatientCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PatientUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -4,12 +4,19 @@ package ent
 
 import (
 	"backend/ent/analysisjob"
+	"backend/ent/assignment"
 	"backend/ent/doctorpatientlink"
 	"backend/ent/entry"
 	"backend/ent/entryshare"
+	"backend/ent/goal"
 	"backend/ent/pairingcode"
 	"backend/ent/patient"
 	"backend/ent/predicate"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnaireresponse"
+	"backend/ent/reminderdelivery"
+	"backend/ent/reminderschedule"
+	"backend/ent/session"
 	"context"
 	"database/sql/driver"
 	"fmt"
@@ -25,15 +32,22 @@ import (
 // PatientQuery is the builder for querying Patient entities.
 type PatientQuery struct {
 	config
-	ctx                      *QueryContext
-	order                    []patient.OrderOption
-	inters                   []Interceptor
-	predicates               []predicate.Patient
-	withDoctorLinks          *DoctorPatientLinkQuery
-	withConsumedPairingCodes *PairingCodeQuery
-	withEntries              *EntryQuery
-	withAnalysisJobs         *AnalysisJobQuery
-	withEntryShares          *EntryShareQuery
+	ctx                          *QueryContext
+	order                        []patient.OrderOption
+	inters                       []Interceptor
+	predicates                   []predicate.Patient
+	withDoctorLinks              *DoctorPatientLinkQuery
+	withConsumedPairingCodes     *PairingCodeQuery
+	withEntries                  *EntryQuery
+	withAnalysisJobs             *AnalysisJobQuery
+	withEntryShares              *EntryShareQuery
+	withGoals                    *GoalQuery
+	withAssignments              *AssignmentQuery
+	withSessions                 *SessionQuery
+	withQuestionnaireAssignments *QuestionnaireAssignmentQuery
+	withQuestionnaireResponses   *QuestionnaireResponseQuery
+	withReminderSchedules        *ReminderScheduleQuery
+	withReminderDeliveries       *ReminderDeliveryQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -180,6 +194,160 @@ func (_q *PatientQuery) QueryEntryShares() *EntryShareQuery {
 	return query
 }
 
+// QueryGoals chains the current query on the "goals" edge.
+func (_q *PatientQuery) QueryGoals() *GoalQuery {
+	query := (&GoalClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, selector),
+			sqlgraph.To(goal.Table, goal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.GoalsTable, patient.GoalsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAssignments chains the current query on the "assignments" edge.
+func (_q *PatientQuery) QueryAssignments() *AssignmentQuery {
+	query := (&AssignmentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, selector),
+			sqlgraph.To(assignment.Table, assignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.AssignmentsTable, patient.AssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySessions chains the current query on the "sessions" edge.
+func (_q *PatientQuery) QuerySessions() *SessionQuery {
+	query := (&SessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, selector),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.SessionsTable, patient.SessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryQuestionnaireAssignments chains the current query on the "questionnaire_assignments" edge.
+func (_q *PatientQuery) QueryQuestionnaireAssignments() *QuestionnaireAssignmentQuery {
+	query := (&QuestionnaireAssignmentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, selector),
+			sqlgraph.To(questionnaireassignment.Table, questionnaireassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.QuestionnaireAssignmentsTable, patient.QuestionnaireAssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryQuestionnaireResponses chains the current query on the "questionnaire_responses" edge.
+func (_q *PatientQuery) QueryQuestionnaireResponses() *QuestionnaireResponseQuery {
+	query := (&QuestionnaireResponseClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, selector),
+			sqlgraph.To(questionnaireresponse.Table, questionnaireresponse.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.QuestionnaireResponsesTable, patient.QuestionnaireResponsesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReminderSchedules chains the current query on the "reminder_schedules" edge.
+func (_q *PatientQuery) QueryReminderSchedules() *ReminderScheduleQuery {
+	query := (&ReminderScheduleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, selector),
+			sqlgraph.To(reminderschedule.Table, reminderschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.ReminderSchedulesTable, patient.ReminderSchedulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReminderDeliveries chains the current query on the "reminder_deliveries" edge.
+func (_q *PatientQuery) QueryReminderDeliveries() *ReminderDeliveryQuery {
+	query := (&ReminderDeliveryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(patient.Table, patient.FieldID, selector),
+			sqlgraph.To(reminderdelivery.Table, reminderdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, patient.ReminderDeliveriesTable, patient.ReminderDeliveriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Patient entity from the query.
 // Returns a *NotFoundError when no Patient was found.
 func (_q *PatientQuery) First(ctx context.Context) (*Patient, error) {
@@ -367,16 +535,23 @@ func (_q *PatientQuery) Clone() *PatientQuery {
 		return nil
 	}
 	return &PatientQuery{
-		config:                   _q.config,
-		ctx:                      _q.ctx.Clone(),
-		order:                    append([]patient.OrderOption{}, _q.order...),
-		inters:                   append([]Interceptor{}, _q.inters...),
-		predicates:               append([]predicate.Patient{}, _q.predicates...),
-		withDoctorLinks:          _q.withDoctorLinks.Clone(),
-		withConsumedPairingCodes: _q.withConsumedPairingCodes.Clone(),
-		withEntries:              _q.withEntries.Clone(),
-		withAnalysisJobs:         _q.withAnalysisJobs.Clone(),
-		withEntryShares:          _q.withEntryShares.Clone(),
+		config:                       _q.config,
+		ctx:                          _q.ctx.Clone(),
+		order:                        append([]patient.OrderOption{}, _q.order...),
+		inters:                       append([]Interceptor{}, _q.inters...),
+		predicates:                   append([]predicate.Patient{}, _q.predicates...),
+		withDoctorLinks:              _q.withDoctorLinks.Clone(),
+		withConsumedPairingCodes:     _q.withConsumedPairingCodes.Clone(),
+		withEntries:                  _q.withEntries.Clone(),
+		withAnalysisJobs:             _q.withAnalysisJobs.Clone(),
+		withEntryShares:              _q.withEntryShares.Clone(),
+		withGoals:                    _q.withGoals.Clone(),
+		withAssignments:              _q.withAssignments.Clone(),
+		withSessions:                 _q.withSessions.Clone(),
+		withQuestionnaireAssignments: _q.withQuestionnaireAssignments.Clone(),
+		withQuestionnaireResponses:   _q.withQuestionnaireResponses.Clone(),
+		withReminderSchedules:        _q.withReminderSchedules.Clone(),
+		withReminderDeliveries:       _q.withReminderDeliveries.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -438,6 +613,83 @@ func (_q *PatientQuery) WithEntryShares(opts ...func(*EntryShareQuery)) *Patient
 	return _q
 }
 
+// WithGoals tells the query-builder to eager-load the nodes that are connected to
+// the "goals" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PatientQuery) WithGoals(opts ...func(*GoalQuery)) *PatientQuery {
+	query := (&GoalClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGoals = query
+	return _q
+}
+
+// WithAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PatientQuery) WithAssignments(opts ...func(*AssignmentQuery)) *PatientQuery {
+	query := (&AssignmentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAssignments = query
+	return _q
+}
+
+// WithSessions tells the query-builder to eager-load the nodes that are connected to
+// the "sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PatientQuery) WithSessions(opts ...func(*SessionQuery)) *PatientQuery {
+	query := (&SessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSessions = query
+	return _q
+}
+
+// WithQuestionnaireAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "questionnaire_assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PatientQuery) WithQuestionnaireAssignments(opts ...func(*QuestionnaireAssignmentQuery)) *PatientQuery {
+	query := (&QuestionnaireAssignmentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withQuestionnaireAssignments = query
+	return _q
+}
+
+// WithQuestionnaireResponses tells the query-builder to eager-load the nodes that are connected to
+// the "questionnaire_responses" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PatientQuery) WithQuestionnaireResponses(opts ...func(*QuestionnaireResponseQuery)) *PatientQuery {
+	query := (&QuestionnaireResponseClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withQuestionnaireResponses = query
+	return _q
+}
+
+// WithReminderSchedules tells the query-builder to eager-load the nodes that are connected to
+// the "reminder_schedules" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PatientQuery) WithReminderSchedules(opts ...func(*ReminderScheduleQuery)) *PatientQuery {
+	query := (&ReminderScheduleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReminderSchedules = query
+	return _q
+}
+
+// WithReminderDeliveries tells the query-builder to eager-load the nodes that are connected to
+// the "reminder_deliveries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PatientQuery) WithReminderDeliveries(opts ...func(*ReminderDeliveryQuery)) *PatientQuery {
+	query := (&ReminderDeliveryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReminderDeliveries = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -516,12 +768,19 @@ func (_q *PatientQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pati
 	var (
 		nodes       = []*Patient{}
 		_spec       = _q.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [12]bool{
 			_q.withDoctorLinks != nil,
 			_q.withConsumedPairingCodes != nil,
 			_q.withEntries != nil,
 			_q.withAnalysisJobs != nil,
 			_q.withEntryShares != nil,
+			_q.withGoals != nil,
+			_q.withAssignments != nil,
+			_q.withSessions != nil,
+			_q.withQuestionnaireAssignments != nil,
+			_q.withQuestionnaireResponses != nil,
+			_q.withReminderSchedules != nil,
+			_q.withReminderDeliveries != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -579,6 +838,63 @@ func (_q *PatientQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pati
 			return nil, err
 		}
 	}
+	if query := _q.withGoals; query != nil {
+		if err := _q.loadGoals(ctx, query, nodes,
+			func(n *Patient) { n.Edges.Goals = []*Goal{} },
+			func(n *Patient, e *Goal) { n.Edges.Goals = append(n.Edges.Goals, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAssignments; query != nil {
+		if err := _q.loadAssignments(ctx, query, nodes,
+			func(n *Patient) { n.Edges.Assignments = []*Assignment{} },
+			func(n *Patient, e *Assignment) { n.Edges.Assignments = append(n.Edges.Assignments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSessions; query != nil {
+		if err := _q.loadSessions(ctx, query, nodes,
+			func(n *Patient) { n.Edges.Sessions = []*Session{} },
+			func(n *Patient, e *Session) { n.Edges.Sessions = append(n.Edges.Sessions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withQuestionnaireAssignments; query != nil {
+		if err := _q.loadQuestionnaireAssignments(ctx, query, nodes,
+			func(n *Patient) { n.Edges.QuestionnaireAssignments = []*QuestionnaireAssignment{} },
+			func(n *Patient, e *QuestionnaireAssignment) {
+				n.Edges.QuestionnaireAssignments = append(n.Edges.QuestionnaireAssignments, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withQuestionnaireResponses; query != nil {
+		if err := _q.loadQuestionnaireResponses(ctx, query, nodes,
+			func(n *Patient) { n.Edges.QuestionnaireResponses = []*QuestionnaireResponse{} },
+			func(n *Patient, e *QuestionnaireResponse) {
+				n.Edges.QuestionnaireResponses = append(n.Edges.QuestionnaireResponses, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReminderSchedules; query != nil {
+		if err := _q.loadReminderSchedules(ctx, query, nodes,
+			func(n *Patient) { n.Edges.ReminderSchedules = []*ReminderSchedule{} },
+			func(n *Patient, e *ReminderSchedule) {
+				n.Edges.ReminderSchedules = append(n.Edges.ReminderSchedules, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReminderDeliveries; query != nil {
+		if err := _q.loadReminderDeliveries(ctx, query, nodes,
+			func(n *Patient) { n.Edges.ReminderDeliveries = []*ReminderDelivery{} },
+			func(n *Patient, e *ReminderDelivery) {
+				n.Edges.ReminderDeliveries = append(n.Edges.ReminderDeliveries, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -735,6 +1051,216 @@ func (_q *PatientQuery) loadEntryShares(ctx context.Context, query *EntryShareQu
 	}
 	return nil
 }
+func (_q *PatientQuery) loadGoals(ctx context.Context, query *GoalQuery, nodes []*Patient, init func(*Patient), assign func(*Patient, *Goal)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Patient)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(goal.FieldPatientID)
+	}
+	query.Where(predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(patient.GoalsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PatientID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "patient_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PatientQuery) loadAssignments(ctx context.Context, query *AssignmentQuery, nodes []*Patient, init func(*Patient), assign func(*Patient, *Assignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Patient)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(assignment.FieldPatientID)
+	}
+	query.Where(predicate.Assignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(patient.AssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PatientID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "patient_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PatientQuery) loadSessions(ctx context.Context, query *SessionQuery, nodes []*Patient, init func(*Patient), assign func(*Patient, *Session)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Patient)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(session.FieldPatientID)
+	}
+	query.Where(predicate.Session(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(patient.SessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PatientID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "patient_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PatientQuery) loadQuestionnaireAssignments(ctx context.Context, query *QuestionnaireAssignmentQuery, nodes []*Patient, init func(*Patient), assign func(*Patient, *QuestionnaireAssignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Patient)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(questionnaireassignment.FieldPatientID)
+	}
+	query.Where(predicate.QuestionnaireAssignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(patient.QuestionnaireAssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PatientID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "patient_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PatientQuery) loadQuestionnaireResponses(ctx context.Context, query *QuestionnaireResponseQuery, nodes []*Patient, init func(*Patient), assign func(*Patient, *QuestionnaireResponse)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Patient)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(questionnaireresponse.FieldPatientID)
+	}
+	query.Where(predicate.QuestionnaireResponse(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(patient.QuestionnaireResponsesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PatientID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "patient_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PatientQuery) loadReminderSchedules(ctx context.Context, query *ReminderScheduleQuery, nodes []*Patient, init func(*Patient), assign func(*Patient, *ReminderSchedule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Patient)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(reminderschedule.FieldPatientID)
+	}
+	query.Where(predicate.ReminderSchedule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(patient.ReminderSchedulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PatientID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "patient_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PatientQuery) loadReminderDeliveries(ctx context.Context, query *ReminderDeliveryQuery, nodes []*Patient, init func(*Patient), assign func(*Patient, *ReminderDelivery)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Patient)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(reminderdelivery.FieldPatientID)
+	}
+	query.Where(predicate.ReminderDelivery(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(patient.ReminderDeliveriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PatientID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "patient_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *PatientQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -4,12 +4,19 @@ package ent
 
 import (
 	"backend/ent/analysisjob"
+	"backend/ent/assignment"
 	"backend/ent/doctorpatientlink"
 	"backend/ent/entry"
 	"backend/ent/entryshare"
+	"backend/ent/goal"
 	"backend/ent/pairingcode"
 	"backend/ent/patient"
 	"backend/ent/predicate"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnaireresponse"
+	"backend/ent/reminderdelivery"
+	"backend/ent/reminderschedule"
+	"backend/ent/session"
 	"context"
 	"errors"
 	"fmt"
@@ -235,6 +242,111 @@ func (_u *PatientUpdate) AddEntryShares(v ...*EntryShare) *PatientUpdate {
 	return _u.AddEntryShareIDs(ids...)
 }
 
+// AddGoalIDs adds the "goals" edge to the Goal entity by IDs.
+func (_u *PatientUpdate) AddGoalIDs(ids ...uuid.UUID) *PatientUpdate {
+	_u.mutation.AddGoalIDs(ids...)
+	return _u
+}
+
+// AddGoals adds the "goals" edges to the Goal entity.
+func (_u *PatientUpdate) AddGoals(v ...*Goal) *PatientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGoalIDs(ids...)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the Assignment entity by IDs.
+func (_u *PatientUpdate) AddAssignmentIDs(ids ...uuid.UUID) *PatientUpdate {
+	_u.mutation.AddAssignmentIDs(ids...)
+	return _u
+}
+
+// AddAssignments adds the "assignments" edges to the Assignment entity.
+func (_u *PatientUpdate) AddAssignments(v ...*Assignment) *PatientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssignmentIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_u *PatientUpdate) AddSessionIDs(ids ...uuid.UUID) *PatientUpdate {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_u *PatientUpdate) AddSessions(v ...*Session) *PatientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// AddQuestionnaireAssignmentIDs adds the "questionnaire_assignments" edge to the QuestionnaireAssignment entity by IDs.
+func (_u *PatientUpdate) AddQuestionnaireAssignmentIDs(ids ...uuid.UUID) *PatientUpdate {
+	_u.mutation.AddQuestionnaireAssignmentIDs(ids...)
+	return _u
+}
+
+// AddQuestionnaireAssignments adds the "questionnaire_assignments" edges to the QuestionnaireAssignment entity.
+func (_u *PatientUpdate) AddQuestionnaireAssignments(v ...*QuestionnaireAssignment) *PatientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuestionnaireAssignmentIDs(ids...)
+}
+
+// AddQuestionnaireResponseIDs adds the "questionnaire_responses" edge to the QuestionnaireResponse entity by IDs.
+func (_u *PatientUpdate) AddQuestionnaireResponseIDs(ids ...uuid.UUID) *PatientUpdate {
+	_u.mutation.AddQuestionnaireResponseIDs(ids...)
+	return _u
+}
+
+// AddQuestionnaireResponses adds the "questionnaire_responses" edges to the QuestionnaireResponse entity.
+func (_u *PatientUpdate) AddQuestionnaireResponses(v ...*QuestionnaireResponse) *PatientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuestionnaireResponseIDs(ids...)
+}
+
+// AddReminderScheduleIDs adds the "reminder_schedules" edge to the ReminderSchedule entity by IDs.
+func (_u *PatientUpdate) AddReminderScheduleIDs(ids ...uuid.UUID) *PatientUpdate {
+	_u.mutation.AddReminderScheduleIDs(ids...)
+	return _u
+}
+
+// AddReminderSchedules adds the "reminder_schedules" edges to the ReminderSchedule entity.
+func (_u *PatientUpdate) AddReminderSchedules(v ...*ReminderSchedule) *PatientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReminderScheduleIDs(ids...)
+}
+
+// AddReminderDeliveryIDs adds the "reminder_deliveries" edge to the ReminderDelivery entity by IDs.
+func (_u *PatientUpdate) AddReminderDeliveryIDs(ids ...uuid.UUID) *PatientUpdate {
+	_u.mutation.AddReminderDeliveryIDs(ids...)
+	return _u
+}
+
+// AddReminderDeliveries adds the "reminder_deliveries" edges to the ReminderDelivery entity.
+func (_u *PatientUpdate) AddReminderDeliveries(v ...*ReminderDelivery) *PatientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReminderDeliveryIDs(ids...)
+}
+
 // Mutation returns the PatientMutation object of the builder.
 func (_u *PatientUpdate) Mutation() *PatientMutation {
 	return _u.mutation
@@ -345,6 +457,153 @@ func (_u *PatientUpdate) RemoveEntryShares(v ...*EntryShare) *PatientUpdate {
 	return _u.RemoveEntryShareIDs(ids...)
 }
 
+// ClearGoals clears all "goals" edges to the Goal entity.
+func (_u *PatientUpdate) ClearGoals() *PatientUpdate {
+	_u.mutation.ClearGoals()
+	return _u
+}
+
+// RemoveGoalIDs removes the "goals" edge to Goal entities by IDs.
+func (_u *PatientUpdate) RemoveGoalIDs(ids ...uuid.UUID) *PatientUpdate {
+	_u.mutation.RemoveGoalIDs(ids...)
+	return _u
+}
+
+// RemoveGoals removes "goals" edges to Goal entities.
+func (_u *PatientUpdate) RemoveGoals(v ...*Goal) *PatientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGoalIDs(ids...)
+}
+
+// ClearAssignments clears all "assignments" edges to the Assignment entity.
+func (_u *PatientUpdate) ClearAssignments() *PatientUpdate {
+	_u.mutation.ClearAssignments()
+	return _u
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to Assignment entities by IDs.
+func (_u *PatientUpdate) RemoveAssignmentIDs(ids ...uuid.UUID) *PatientUpdate {
+	_u.mutation.RemoveAssignmentIDs(ids...)
+	return _u
+}
+
+// RemoveAssignments removes "assignments" edges to Assignment entities.
+func (_u *PatientUpdate) RemoveAssignments(v ...*Assignment) *PatientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssignmentIDs(ids...)
+}
+
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (_u *PatientUpdate) ClearSessions() *PatientUpdate {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (_u *PatientUpdate) RemoveSessionIDs(ids ...uuid.UUID) *PatientUpdate {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (_u *PatientUpdate) RemoveSessions(v ...*Session) *PatientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
+// ClearQuestionnaireAssignments clears all "questionnaire_assignments" edges to the QuestionnaireAssignment entity.
+func (_u *PatientUpdate) ClearQuestionnaireAssignments() *PatientUpdate {
+	_u.mutation.ClearQuestionnaireAssignments()
+	return _u
+}
+
+// RemoveQuestionnaireAssignmentIDs removes the "questionnaire_assignments" edge to QuestionnaireAssignment entities by IDs.
+func (_u *PatientUpdate) RemoveQuestionnaireAssignmentIDs(ids ...uuid.UUID) *PatientUpdate {
+	_u.mutation.RemoveQuestionnaireAssignmentIDs(ids...)
+	return _u
+}
+
+// RemoveQuestionnaireAssignments removes "questionnaire_assignments" edges to QuestionnaireAssignment entities.
+func (_u *PatientUpdate) RemoveQuestionnaireAssignments(v ...*QuestionnaireAssignment) *PatientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuestionnaireAssignmentIDs(ids...)
+}
+
+// ClearQuestionnaireResponses clears all "questionnaire_responses" edges to the QuestionnaireResponse entity.
+func (_u *PatientUpdate) ClearQuestionnaireResponses() *PatientUpdate {
+	_u.mutation.ClearQuestionnaireResponses()
+	return _u
+}
+
+// RemoveQuestionnaireResponseIDs removes the "questionnaire_responses" edge to QuestionnaireResponse entities by IDs.
+func (_u *PatientUpdate) RemoveQuestionnaireResponseIDs(ids ...uuid.UUID) *PatientUpdate {
+	_u.mutation.RemoveQuestionnaireResponseIDs(ids...)
+	return _u
+}
+
+// RemoveQuestionnaireResponses removes "questionnaire_responses" edges to QuestionnaireResponse entities.
+func (_u *PatientUpdate) RemoveQuestionnaireResponses(v ...*QuestionnaireResponse) *PatientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuestionnaireResponseIDs(ids...)
+}
+
+// ClearReminderSchedules clears all "reminder_schedules" edges to the ReminderSchedule entity.
+func (_u *PatientUpdate) ClearReminderSchedules() *PatientUpdate {
+	_u.mutation.ClearReminderSchedules()
+	return _u
+}
+
+// RemoveReminderScheduleIDs removes the "reminder_schedules" edge to ReminderSchedule entities by IDs.
+func (_u *PatientUpdate) RemoveReminderScheduleIDs(ids ...uuid.UUID) *PatientUpdate {
+	_u.mutation.RemoveReminderScheduleIDs(ids...)
+	return _u
+}
+
+// RemoveReminderSchedules removes "reminder_schedules" edges to ReminderSchedule entities.
+func (_u *PatientUpdate) RemoveReminderSchedules(v ...*ReminderSchedule) *PatientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReminderScheduleIDs(ids...)
+}
+
+// ClearReminderDeliveries clears all "reminder_deliveries" edges to the ReminderDelivery entity.
+func (_u *PatientUpdate) ClearReminderDeliveries() *PatientUpdate {
+	_u.mutation.ClearReminderDeliveries()
+	return _u
+}
+
+// RemoveReminderDeliveryIDs removes the "reminder_deliveries" edge to ReminderDelivery entities by IDs.
+func (_u *PatientUpdate) RemoveReminderDeliveryIDs(ids ...uuid.UUID) *PatientUpdate {
+	_u.mutation.RemoveReminderDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveReminderDeliveries removes "reminder_deliveries" edges to ReminderDelivery entities.
+func (_u *PatientUpdate) RemoveReminderDeliveries(v ...*ReminderDelivery) *PatientUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReminderDeliveryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PatientUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -676,86 +935,401 @@ func (_u *PatientUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{patient.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.GoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.GoalsTable,
+			Columns: []string{patient.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// PatientUpdateOne is the builder for updating a single Patient entity.
-type PatientUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *PatientMutation
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *PatientUpdateOne) SetUpdatedAt(v time.Time) *PatientUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
-// SetDisplayName sets the "display_name" field.
-func (_u *PatientUpdateOne) SetDisplayName(v string) *PatientUpdateOne {
-	_u.mutation.SetDisplayName(v)
-	return _u
-}
-
-// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
-func (_u *PatientUpdateOne) SetNillableDisplayName(v *string) *PatientUpdateOne {
-	if v != nil {
-		_u.SetDisplayName(*v)
+	if nodes := _u.mutation.RemovedGoalsIDs(); len(nodes) > 0 && !_u.mutation.GoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.GoalsTable,
+			Columns: []string{patient.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetBirthDate sets the "birth_date" field.
-func (_u *PatientUpdateOne) SetBirthDate(v time.Time) *PatientUpdateOne {
-	_u.mutation.SetBirthDate(v)
-	return _u
-}
-
-// SetNillableBirthDate sets the "birth_date" field if the given value is not nil.
-func (_u *PatientUpdateOne) SetNillableBirthDate(v *time.Time) *PatientUpdateOne {
-	if v != nil {
-		_u.SetBirthDate(*v)
+	if nodes := _u.mutation.GoalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.GoalsTable,
+			Columns: []string{patient.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// ClearBirthDate clears the value of the "birth_date" field.
-func (_u *PatientUpdateOne) ClearBirthDate() *PatientUpdateOne {
-	_u.mutation.ClearBirthDate()
-	return _u
-}
-
-// SetStatus sets the "status" field.
-func (_u *PatientUpdateOne) SetStatus(v patient.Status) *PatientUpdateOne {
-	_u.mutation.SetStatus(v)
-	return _u
-}
-
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *PatientUpdateOne) SetNillableStatus(v *patient.Status) *PatientUpdateOne {
-	if v != nil {
-		_u.SetStatus(*v)
+	if _u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.AssignmentsTable,
+			Columns: []string{patient.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
+	if nodes := _u.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.AssignmentsTable,
+			Columns: []string{patient.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.AssignmentsTable,
+			Columns: []string{patient.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.SessionsTable,
+			Columns: []string{patient.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.SessionsTable,
+			Columns: []string{patient.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.SessionsTable,
+			Columns: []string{patient.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.QuestionnaireAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.QuestionnaireAssignmentsTable,
+			Columns: []string{patient.QuestionnaireAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedQuestionnaireAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.QuestionnaireAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.QuestionnaireAssignmentsTable,
+			Columns: []string{patient.QuestionnaireAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuestionnaireAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.QuestionnaireAssignmentsTable,
+			Columns: []string{patient.QuestionnaireAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.QuestionnaireResponsesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.QuestionnaireResponsesTable,
+			Columns: []string{patient.QuestionnaireResponsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedQuestionnaireResponsesIDs(); len(nodes) > 0 && !_u.mutation.QuestionnaireResponsesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.QuestionnaireResponsesTable,
+			Columns: []string{patient.QuestionnaireResponsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuestionnaireResponsesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.QuestionnaireResponsesTable,
+			Columns: []string{patient.QuestionnaireResponsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReminderSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.ReminderSchedulesTable,
+			Columns: []string{patient.ReminderSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReminderSchedulesIDs(); len(nodes) > 0 && !_u.mutation.ReminderSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.ReminderSchedulesTable,
+			Columns: []string{patient.ReminderSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReminderSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.ReminderSchedulesTable,
+			Columns: []string{patient.ReminderSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReminderDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.ReminderDeliveriesTable,
+			Columns: []string{patient.ReminderDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReminderDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.ReminderDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.ReminderDeliveriesTable,
+			Columns: []string{patient.ReminderDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReminderDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.ReminderDeliveriesTable,
+			Columns: []string{patient.ReminderDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{patient.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PatientUpdateOne is the builder for updating a single Patient entity.
+type PatientUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PatientMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PatientUpdateOne) SetUpdatedAt(v time.Time) *PatientUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDisplayName sets the "display_name" field.
+func (_u *PatientUpdateOne) SetDisplayName(v string) *PatientUpdateOne {
+	_u.mutation.SetDisplayName(v)
+	return _u
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (_u *PatientUpdateOne) SetNillableDisplayName(v *string) *PatientUpdateOne {
+	if v != nil {
+		_u.SetDisplayName(*v)
+	}
+	return _u
+}
+
+// SetBirthDate sets the "birth_date" field.
+func (_u *PatientUpdateOne) SetBirthDate(v time.Time) *PatientUpdateOne {
+	_u.mutation.SetBirthDate(v)
+	return _u
+}
+
+// SetNillableBirthDate sets the "birth_date" field if the given value is not nil.
+func (_u *PatientUpdateOne) SetNillableBirthDate(v *time.Time) *PatientUpdateOne {
+	if v != nil {
+		_u.SetBirthDate(*v)
+	}
+	return _u
+}
+
+// ClearBirthDate clears the value of the "birth_date" field.
+func (_u *PatientUpdateOne) ClearBirthDate() *PatientUpdateOne {
+	_u.mutation.ClearBirthDate()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PatientUpdateOne) SetStatus(v patient.Status) *PatientUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PatientUpdateOne) SetNillableStatus(v *patient.Status) *PatientUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *PatientUpdateOne) SetEmail(v string) *PatientUpdateOne {
+	_u.mutation.SetEmail(v)
 	return _u
 }
 
-// SetEmail sets the "email" field.
-func (_u *PatientUpdateOne) SetEmail(v string) *PatientUpdateOne {
-	_u.mutation.SetEmail(v)
-	return _u
-}
-
 // SetNillableEmail sets the "email" field if the given value is not nil.
 func (_u *PatientUpdateOne) SetNillableEmail(v *string) *PatientUpdateOne {
 	if v != nil {
@@ -897,6 +1471,111 @@ func (_u *PatientUpdateOne) AddEntryShares(v ...*EntryShare) *PatientUpdateOne {
 	return _u.AddEntryShareIDs(ids...)
 }
 
+// AddGoalIDs adds the "goals" edge to the Goal entity by IDs.
+func (_u *PatientUpdateOne) AddGoalIDs(ids ...uuid.UUID) *PatientUpdateOne {
+	_u.mutation.AddGoalIDs(ids...)
+	return _u
+}
+
+// AddGoals adds the "goals" edges to the Goal entity.
+func (_u *PatientUpdateOne) AddGoals(v ...*Goal) *PatientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGoalIDs(ids...)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the Assignment entity by IDs.
+func (_u *PatientUpdateOne) AddAssignmentIDs(ids ...uuid.UUID) *PatientUpdateOne {
+	_u.mutation.AddAssignmentIDs(ids...)
+	return _u
+}
+
+// AddAssignments adds the "assignments" edges to the Assignment entity.
+func (_u *PatientUpdateOne) AddAssignments(v ...*Assignment) *PatientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssignmentIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (_u *PatientUpdateOne) AddSessionIDs(ids ...uuid.UUID) *PatientUpdateOne {
+	_u.mutation.AddSessionIDs(ids...)
+	return _u
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (_u *PatientUpdateOne) AddSessions(v ...*Session) *PatientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSessionIDs(ids...)
+}
+
+// AddQuestionnaireAssignmentIDs adds the "questionnaire_assignments" edge to the QuestionnaireAssignment entity by IDs.
+func (_u *PatientUpdateOne) AddQuestionnaireAssignmentIDs(ids ...uuid.UUID) *PatientUpdateOne {
+	_u.mutation.AddQuestionnaireAssignmentIDs(ids...)
+	return _u
+}
+
+// AddQuestionnaireAssignments adds the "questionnaire_assignments" edges to the QuestionnaireAssignment entity.
+func (_u *PatientUpdateOne) AddQuestionnaireAssignments(v ...*QuestionnaireAssignment) *PatientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuestionnaireAssignmentIDs(ids...)
+}
+
+// AddQuestionnaireResponseIDs adds the "questionnaire_responses" edge to the QuestionnaireResponse entity by IDs.
+func (_u *PatientUpdateOne) AddQuestionnaireResponseIDs(ids ...uuid.UUID) *PatientUpdateOne {
+	_u.mutation.AddQuestionnaireResponseIDs(ids...)
+	return _u
+}
+
+// AddQuestionnaireResponses adds the "questionnaire_responses" edges to the QuestionnaireResponse entity.
+func (_u *PatientUpdateOne) AddQuestionnaireResponses(v ...*QuestionnaireResponse) *PatientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuestionnaireResponseIDs(ids...)
+}
+
+// AddReminderScheduleIDs adds the "reminder_schedules" edge to the ReminderSchedule entity by IDs.
+func (_u *PatientUpdateOne) AddReminderScheduleIDs(ids ...uuid.UUID) *PatientUpdateOne {
+	_u.mutation.AddReminderScheduleIDs(ids...)
+	return _u
+}
+
+// AddReminderSchedules adds the "reminder_schedules" edges to the ReminderSchedule entity.
+func (_u *PatientUpdateOne) AddReminderSchedules(v ...*ReminderSchedule) *PatientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReminderScheduleIDs(ids...)
+}
+
+// AddReminderDeliveryIDs adds the "reminder_deliveries" edge to the ReminderDelivery entity by IDs.
+func (_u *PatientUpdateOne) AddReminderDeliveryIDs(ids ...uuid.UUID) *PatientUpdateOne {
+	_u.mutation.AddReminderDeliveryIDs(ids...)
+	return _u
+}
+
+// AddReminderDeliveries adds the "reminder_deliveries" edges to the ReminderDelivery entity.
+func (_u *PatientUpdateOne) AddReminderDeliveries(v ...*ReminderDelivery) *PatientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReminderDeliveryIDs(ids...)
+}
+
 // Mutation returns the PatientMutation object of the builder.
 func (_u *PatientUpdateOne) Mutation() *PatientMutation {
 	return _u.mutation
@@ -950,61 +1629,208 @@ func (_u *PatientUpdateOne) ClearEntries() *PatientUpdateOne {
 	return _u
 }
 
-// RemoveEntryIDs removes the "entries" edge to Entry entities by IDs.
-func (_u *PatientUpdateOne) RemoveEntryIDs(ids ...uuid.UUID) *PatientUpdateOne {
-	_u.mutation.RemoveEntryIDs(ids...)
+// RemoveEntryIDs removes the "entries" edge to Entry entities by IDs.
+func (_u *PatientUpdateOne) RemoveEntryIDs(ids ...uuid.UUID) *PatientUpdateOne {
+	_u.mutation.RemoveEntryIDs(ids...)
+	return _u
+}
+
+// RemoveEntries removes "entries" edges to Entry entities.
+func (_u *PatientUpdateOne) RemoveEntries(v ...*Entry) *PatientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEntryIDs(ids...)
+}
+
+// ClearAnalysisJobs clears all "analysis_jobs" edges to the AnalysisJob entity.
+func (_u *PatientUpdateOne) ClearAnalysisJobs() *PatientUpdateOne {
+	_u.mutation.ClearAnalysisJobs()
+	return _u
+}
+
+// RemoveAnalysisJobIDs removes the "analysis_jobs" edge to AnalysisJob entities by IDs.
+func (_u *PatientUpdateOne) RemoveAnalysisJobIDs(ids ...uuid.UUID) *PatientUpdateOne {
+	_u.mutation.RemoveAnalysisJobIDs(ids...)
+	return _u
+}
+
+// RemoveAnalysisJobs removes "analysis_jobs" edges to AnalysisJob entities.
+func (_u *PatientUpdateOne) RemoveAnalysisJobs(v ...*AnalysisJob) *PatientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnalysisJobIDs(ids...)
+}
+
+// ClearEntryShares clears all "entry_shares" edges to the EntryShare entity.
+func (_u *PatientUpdateOne) ClearEntryShares() *PatientUpdateOne {
+	_u.mutation.ClearEntryShares()
+	return _u
+}
+
+// RemoveEntryShareIDs removes the "entry_shares" edge to EntryShare entities by IDs.
+func (_u *PatientUpdateOne) RemoveEntryShareIDs(ids ...uuid.UUID) *PatientUpdateOne {
+	_u.mutation.RemoveEntryShareIDs(ids...)
+	return _u
+}
+
+// RemoveEntryShares removes "entry_shares" edges to EntryShare entities.
+func (_u *PatientUpdateOne) RemoveEntryShares(v ...*EntryShare) *PatientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEntryShareIDs(ids...)
+}
+
+// ClearGoals clears all "goals" edges to the Goal entity.
+func (_u *PatientUpdateOne) ClearGoals() *PatientUpdateOne {
+	_u.mutation.ClearGoals()
+	return _u
+}
+
+// RemoveGoalIDs removes the "goals" edge to Goal entities by IDs.
+func (_u *PatientUpdateOne) RemoveGoalIDs(ids ...uuid.UUID) *PatientUpdateOne {
+	_u.mutation.RemoveGoalIDs(ids...)
+	return _u
+}
+
+// RemoveGoals removes "goals" edges to Goal entities.
+func (_u *PatientUpdateOne) RemoveGoals(v ...*Goal) *PatientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGoalIDs(ids...)
+}
+
+// ClearAssignments clears all "assignments" edges to the Assignment entity.
+func (_u *PatientUpdateOne) ClearAssignments() *PatientUpdateOne {
+	_u.mutation.ClearAssignments()
+	return _u
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to Assignment entities by IDs.
+func (_u *PatientUpdateOne) RemoveAssignmentIDs(ids ...uuid.UUID) *PatientUpdateOne {
+	_u.mutation.RemoveAssignmentIDs(ids...)
+	return _u
+}
+
+// RemoveAssignments removes "assignments" edges to Assignment entities.
+func (_u *PatientUpdateOne) RemoveAssignments(v ...*Assignment) *PatientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssignmentIDs(ids...)
+}
+
+// ClearSessions clears all "sessions" edges to the Session entity.
+func (_u *PatientUpdateOne) ClearSessions() *PatientUpdateOne {
+	_u.mutation.ClearSessions()
+	return _u
+}
+
+// RemoveSessionIDs removes the "sessions" edge to Session entities by IDs.
+func (_u *PatientUpdateOne) RemoveSessionIDs(ids ...uuid.UUID) *PatientUpdateOne {
+	_u.mutation.RemoveSessionIDs(ids...)
+	return _u
+}
+
+// RemoveSessions removes "sessions" edges to Session entities.
+func (_u *PatientUpdateOne) RemoveSessions(v ...*Session) *PatientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSessionIDs(ids...)
+}
+
+// ClearQuestionnaireAssignments clears all "questionnaire_assignments" edges to the QuestionnaireAssignment entity.
+func (_u *PatientUpdateOne) ClearQuestionnaireAssignments() *PatientUpdateOne {
+	_u.mutation.ClearQuestionnaireAssignments()
+	return _u
+}
+
+// RemoveQuestionnaireAssignmentIDs removes the "questionnaire_assignments" edge to QuestionnaireAssignment entities by IDs.
+func (_u *PatientUpdateOne) RemoveQuestionnaireAssignmentIDs(ids ...uuid.UUID) *PatientUpdateOne {
+	_u.mutation.RemoveQuestionnaireAssignmentIDs(ids...)
+	return _u
+}
+
+// RemoveQuestionnaireAssignments removes "questionnaire_assignments" edges to QuestionnaireAssignment entities.
+func (_u *PatientUpdateOne) RemoveQuestionnaireAssignments(v ...*QuestionnaireAssignment) *PatientUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuestionnaireAssignmentIDs(ids...)
+}
+
+// ClearQuestionnaireResponses clears all "questionnaire_responses" edges to the QuestionnaireResponse entity.
+func (_u *PatientUpdateOne) ClearQuestionnaireResponses() *PatientUpdateOne {
+	_u.mutation.ClearQuestionnaireResponses()
+	return _u
+}
+
+// RemoveQuestionnaireResponseIDs removes the "questionnaire_responses" edge to QuestionnaireResponse entities by IDs.
+func (_u *PatientUpdateOne) RemoveQuestionnaireResponseIDs(ids ...uuid.UUID) *PatientUpdateOne {
+	_u.mutation.RemoveQuestionnaireResponseIDs(ids...)
 	return _u
 }
 
-// RemoveEntries removes "entries" edges to Entry entities.
-func (_u *PatientUpdateOne) RemoveEntries(v ...*Entry) *PatientUpdateOne {
+// RemoveQuestionnaireResponses removes "questionnaire_responses" edges to QuestionnaireResponse entities.
+func (_u *PatientUpdateOne) RemoveQuestionnaireResponses(v ...*QuestionnaireResponse) *PatientUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveEntryIDs(ids...)
+	return _u.RemoveQuestionnaireResponseIDs(ids...)
 }
 
-// ClearAnalysisJobs clears all "analysis_jobs" edges to the AnalysisJob entity.
-func (_u *PatientUpdateOne) ClearAnalysisJobs() *PatientUpdateOne {
-	_u.mutation.ClearAnalysisJobs()
+// ClearReminderSchedules clears all "reminder_schedules" edges to the ReminderSchedule entity.
+func (_u *PatientUpdateOne) ClearReminderSchedules() *PatientUpdateOne {
+	_u.mutation.ClearReminderSchedules()
 	return _u
 }
 
-// RemoveAnalysisJobIDs removes the "analysis_jobs" edge to AnalysisJob entities by IDs.
-func (_u *PatientUpdateOne) RemoveAnalysisJobIDs(ids ...uuid.UUID) *PatientUpdateOne {
-	_u.mutation.RemoveAnalysisJobIDs(ids...)
+// RemoveReminderScheduleIDs removes the "reminder_schedules" edge to ReminderSchedule entities by IDs.
+func (_u *PatientUpdateOne) RemoveReminderScheduleIDs(ids ...uuid.UUID) *PatientUpdateOne {
+	_u.mutation.RemoveReminderScheduleIDs(ids...)
 	return _u
 }
 
-// RemoveAnalysisJobs removes "analysis_jobs" edges to AnalysisJob entities.
-func (_u *PatientUpdateOne) RemoveAnalysisJobs(v ...*AnalysisJob) *PatientUpdateOne {
+// RemoveReminderSchedules removes "reminder_schedules" edges to ReminderSchedule entities.
+func (_u *PatientUpdateOne) RemoveReminderSchedules(v ...*ReminderSchedule) *PatientUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveAnalysisJobIDs(ids...)
+	return _u.RemoveReminderScheduleIDs(ids...)
 }
 
-// ClearEntryShares clears all "entry_shares" edges to the EntryShare entity.
-func (_u *PatientUpdateOne) ClearEntryShares() *PatientUpdateOne {
-	_u.mutation.ClearEntryShares()
+// ClearReminderDeliveries clears all "reminder_deliveries" edges to the ReminderDelivery entity.
+func (_u *PatientUpdateOne) ClearReminderDeliveries() *PatientUpdateOne {
+	_u.mutation.ClearReminderDeliveries()
 	return _u
 }
 
-// RemoveEntryShareIDs removes the "entry_shares" edge to EntryShare entities by IDs.
-func (_u *PatientUpdateOne) RemoveEntryShareIDs(ids ...uuid.UUID) *PatientUpdateOne {
-	_u.mutation.RemoveEntryShareIDs(ids...)
+// RemoveReminderDeliveryIDs removes the "reminder_deliveries" edge to ReminderDelivery entities by IDs.
+func (_u *PatientUpdateOne) RemoveReminderDeliveryIDs(ids ...uuid.UUID) *PatientUpdateOne {
+	_u.mutation.RemoveReminderDeliveryIDs(ids...)
 	return _u
 }
 
-// RemoveEntryShares removes "entry_shares" edges to EntryShare entities.
-func (_u *PatientUpdateOne) RemoveEntryShares(v ...*EntryShare) *PatientUpdateOne {
+// RemoveReminderDeliveries removes "reminder_deliveries" edges to ReminderDelivery entities.
+func (_u *PatientUpdateOne) RemoveReminderDeliveries(v ...*ReminderDelivery) *PatientUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveEntryShareIDs(ids...)
+	return _u.RemoveReminderDeliveryIDs(ids...)
 }
 
 // Where appends a list predicates to the PatientUpdate builder.
@@ -1368,6 +2194,321 @@ func (_u *PatientUpdateOne) sqlSave(ctx context.Context) (_node *Patient, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.GoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.GoalsTable,
+			Columns: []string{patient.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGoalsIDs(); len(nodes) > 0 && !_u.mutation.GoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.GoalsTable,
+			Columns: []string{patient.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GoalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.GoalsTable,
+			Columns: []string{patient.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.AssignmentsTable,
+			Columns: []string{patient.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.AssignmentsTable,
+			Columns: []string{patient.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.AssignmentsTable,
+			Columns: []string{patient.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.SessionsTable,
+			Columns: []string{patient.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSessionsIDs(); len(nodes) > 0 && !_u.mutation.SessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.SessionsTable,
+			Columns: []string{patient.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.SessionsTable,
+			Columns: []string{patient.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.QuestionnaireAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.QuestionnaireAssignmentsTable,
+			Columns: []string{patient.QuestionnaireAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedQuestionnaireAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.QuestionnaireAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.QuestionnaireAssignmentsTable,
+			Columns: []string{patient.QuestionnaireAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuestionnaireAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.QuestionnaireAssignmentsTable,
+			Columns: []string{patient.QuestionnaireAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.QuestionnaireResponsesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.QuestionnaireResponsesTable,
+			Columns: []string{patient.QuestionnaireResponsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedQuestionnaireResponsesIDs(); len(nodes) > 0 && !_u.mutation.QuestionnaireResponsesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.QuestionnaireResponsesTable,
+			Columns: []string{patient.QuestionnaireResponsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuestionnaireResponsesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.QuestionnaireResponsesTable,
+			Columns: []string{patient.QuestionnaireResponsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReminderSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.ReminderSchedulesTable,
+			Columns: []string{patient.ReminderSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReminderSchedulesIDs(); len(nodes) > 0 && !_u.mutation.ReminderSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.ReminderSchedulesTable,
+			Columns: []string{patient.ReminderSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReminderSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.ReminderSchedulesTable,
+			Columns: []string{patient.ReminderSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReminderDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.ReminderDeliveriesTable,
+			Columns: []string{patient.ReminderDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReminderDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.ReminderDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.ReminderDeliveriesTable,
+			Columns: []string{patient.ReminderDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReminderDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   patient.ReminderDeliveriesTable,
+			Columns: []string{patient.ReminderDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Patient{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -36,9 +36,13 @@ type Practice struct {
 type PracticeEdges struct {
 	// Doctors holds the value of the doctors edge.
 	Doctors []*Doctor `json:"doctors,omitempty"`
+	// VocabularyTerms holds the value of the vocabulary_terms edge.
+	VocabularyTerms []*VocabularyTerm `json:"vocabulary_terms,omitempty"`
+	// WebhookSubscriptions holds the value of the webhook_subscriptions edge.
+	WebhookSubscriptions []*WebhookSubscription `json:"webhook_subscriptions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // DoctorsOrErr returns the Doctors value or an error if the edge
@@ -50,6 +54,24 @@ func (e PracticeEdges) DoctorsOrErr() ([]*Doctor, error) {
 	return nil, &NotLoadedError{edge: "doctors"}
 }
 
+// VocabularyTermsOrErr returns the VocabularyTerms value or an error if the edge
+// was not loaded in eager-loading.
+func (e PracticeEdges) VocabularyTermsOrErr() ([]*VocabularyTerm, error) {
+	if e.loadedTypes[1] {
+		return e.VocabularyTerms, nil
+	}
+	return nil, &NotLoadedError{edge: "vocabulary_terms"}
+}
+
+// WebhookSubscriptionsOrErr returns the WebhookSubscriptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PracticeEdges) WebhookSubscriptionsOrErr() ([]*WebhookSubscription, error) {
+	if e.loadedTypes[2] {
+		return e.WebhookSubscriptions, nil
+	}
+	return nil, &NotLoadedError{edge: "webhook_subscriptions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Practice) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -125,6 +147,16 @@ func (_m *Practice) QueryDoctors() *DoctorQuery {
 	return NewPracticeClient(_m.config).QueryDoctors(_m)
 }
 
+// QueryVocabularyTerms queries the "vocabulary_terms" edge of the Practice entity.
+func (_m *Practice) QueryVocabularyTerms() *VocabularyTermQuery {
+	return NewPracticeClient(_m.config).QueryVocabularyTerms(_m)
+}
+
+// QueryWebhookSubscriptions queries the "webhook_subscriptions" edge of the Practice entity.
+func (_m *Practice) QueryWebhookSubscriptions() *WebhookSubscriptionQuery {
+	return NewPracticeClient(_m.config).QueryWebhookSubscriptions(_m)
+}
+
 // Update returns a builder for updating this Practice.
 // Note that you need to call Practice.Unwrap() before calling this method if this Practice
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -25,6 +25,10 @@ const (
 	FieldAddress = "address"
 	// EdgeDoctors holds the string denoting the doctors edge name in mutations.
 	EdgeDoctors = "doctors"
+	// EdgeVocabularyTerms holds the string denoting the vocabulary_terms edge name in mutations.
+	EdgeVocabularyTerms = "vocabulary_terms"
+	// EdgeWebhookSubscriptions holds the string denoting the webhook_subscriptions edge name in mutations.
+	EdgeWebhookSubscriptions = "webhook_subscriptions"
 	// Table holds the table name of the practice in the database.
 	Table = "practices"
 	// DoctorsTable is the table that holds the doctors relation/edge.
@@ -34,6 +38,20 @@ const (
 	DoctorsInverseTable = "doctors"
 	// DoctorsColumn is the table column denoting the doctors relation/edge.
 	DoctorsColumn = "practice_id"
+	// VocabularyTermsTable is the table that holds the vocabulary_terms relation/edge.
+	VocabularyTermsTable = "vocabulary_terms"
+	// VocabularyTermsInverseTable is the table name for the VocabularyTerm entity.
+	// It exists in this package in order to avoid circular dependency with the "vocabularyterm" package.
+	VocabularyTermsInverseTable = "vocabulary_terms"
+	// VocabularyTermsColumn is the table column denoting the vocabulary_terms relation/edge.
+	VocabularyTermsColumn = "practice_id"
+	// WebhookSubscriptionsTable is the table that holds the webhook_subscriptions relation/edge.
+	WebhookSubscriptionsTable = "webhook_subscriptions"
+	// WebhookSubscriptionsInverseTable is the table name for the WebhookSubscription entity.
+	// It exists in this package in order to avoid circular dependency with the "webhooksubscription" package.
+	WebhookSubscriptionsInverseTable = "webhook_subscriptions"
+	// WebhookSubscriptionsColumn is the table column denoting the webhook_subscriptions relation/edge.
+	WebhookSubscriptionsColumn = "practice_id"
 )
 
 // Columns holds all SQL columns for practice fields.
@@ -109,6 +127,34 @@ func ByDoctors(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newDoctorsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByVocabularyTermsCount orders the results by vocabulary_terms count.
+func ByVocabularyTermsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVocabularyTermsStep(), opts...)
+	}
+}
+
+// ByVocabularyTerms orders the results by vocabulary_terms terms.
+func ByVocabularyTerms(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVocabularyTermsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWebhookSubscriptionsCount orders the results by webhook_subscriptions count.
+func ByWebhookSubscriptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebhookSubscriptionsStep(), opts...)
+	}
+}
+
+// ByWebhookSubscriptions orders the results by webhook_subscriptions terms.
+func ByWebhookSubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebhookSubscriptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newDoctorsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -116,3 +162,17 @@ func newDoctorsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, DoctorsTable, DoctorsColumn),
 	)
 }
+func newVocabularyTermsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VocabularyTermsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VocabularyTermsTable, VocabularyTermsColumn),
+	)
+}
+func newWebhookSubscriptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebhookSubscriptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebhookSubscriptionsTable, WebhookSubscriptionsColumn),
+	)
+}
@@ -319,6 +319,52 @@ func HasDoctorsWith(preds ...predicate.Doctor) predicate.Practice {
 	})
 }
 
+// HasVocabularyTerms applies the HasEdge predicate on the "vocabulary_terms" edge.
+func HasVocabularyTerms() predicate.Practice {
+	return predicate.Practice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VocabularyTermsTable, VocabularyTermsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVocabularyTermsWith applies the HasEdge predicate on the "vocabulary_terms" edge with a given conditions (other predicates).
+func HasVocabularyTermsWith(preds ...predicate.VocabularyTerm) predicate.Practice {
+	return predicate.Practice(func(s *sql.Selector) {
+		step := newVocabularyTermsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhookSubscriptions applies the HasEdge predicate on the "webhook_subscriptions" edge.
+func HasWebhookSubscriptions() predicate.Practice {
+	return predicate.Practice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhookSubscriptionsTable, WebhookSubscriptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhookSubscriptionsWith applies the HasEdge predicate on the "webhook_subscriptions" edge with a given conditions (other predicates).
+func HasWebhookSubscriptionsWith(preds ...predicate.WebhookSubscription) predicate.Practice {
+	return predicate.Practice(func(s *sql.Selector) {
+		step := newWebhookSubscriptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Practice) predicate.Practice {
 	return predicate.Practice(sql.AndPredicates(predicates...))
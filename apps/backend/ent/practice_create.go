@@ -5,11 +5,15 @@ package ent
 import (
 	"backend/ent/doctor"
 	"backend/ent/practice"
+	"backend/ent/vocabularyterm"
+	"backend/ent/webhooksubscription"
 	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -20,6 +24,7 @@ type PracticeCreate struct {
 	config
 	mutation *PracticeMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -99,6 +104,36 @@ func (_c *PracticeCreate) AddDoctors(v ...*Doctor) *PracticeCreate {
 	return _c.AddDoctorIDs(ids...)
 }
 
+// AddVocabularyTermIDs adds the "vocabulary_terms" edge to the VocabularyTerm entity by IDs.
+func (_c *PracticeCreate) AddVocabularyTermIDs(ids ...uuid.UUID) *PracticeCreate {
+	_c.mutation.AddVocabularyTermIDs(ids...)
+	return _c
+}
+
+// AddVocabularyTerms adds the "vocabulary_terms" edges to the VocabularyTerm entity.
+func (_c *PracticeCreate) AddVocabularyTerms(v ...*VocabularyTerm) *PracticeCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVocabularyTermIDs(ids...)
+}
+
+// AddWebhookSubscriptionIDs adds the "webhook_subscriptions" edge to the WebhookSubscription entity by IDs.
+func (_c *PracticeCreate) AddWebhookSubscriptionIDs(ids ...uuid.UUID) *PracticeCreate {
+	_c.mutation.AddWebhookSubscriptionIDs(ids...)
+	return _c
+}
+
+// AddWebhookSubscriptions adds the "webhook_subscriptions" edges to the WebhookSubscription entity.
+func (_c *PracticeCreate) AddWebhookSubscriptions(v ...*WebhookSubscription) *PracticeCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWebhookSubscriptionIDs(ids...)
+}
+
 // Mutation returns the PracticeMutation object of the builder.
 func (_c *PracticeCreate) Mutation() *PracticeMutation {
 	return _c.mutation
@@ -195,6 +230,7 @@ func (_c *PracticeCreate) createSpec() (*Practice, *sqlgraph.CreateSpec) {
 		_node = &Practice{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(practice.Table, sqlgraph.NewFieldSpec(practice.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = _c.conflict
 	if id, ok := _c.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -231,14 +267,276 @@ func (_c *PracticeCreate) createSpec() (*Practice, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.VocabularyTermsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   practice.VocabularyTermsTable,
+			Columns: []string{practice.VocabularyTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vocabularyterm.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WebhookSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   practice.WebhookSubscriptionsTable,
+			Columns: []string{practice.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Practice.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PracticeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PracticeCreate) OnConflict(opts ...sql.ConflictOption) *PracticeUpsertOne {
+	_c.conflict = opts
+	return &PracticeUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Practice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PracticeCreate) OnConflictColumns(columns ...string) *PracticeUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PracticeUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PracticeUpsertOne is the builder for "upsert"-ing
+	//  one Practice node.
+	PracticeUpsertOne struct {
+		create *PracticeCreate
+	}
+
+	// PracticeUpsert is the "OnConflict" setter.
+	PracticeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PracticeUpsert) SetUpdatedAt(v time.Time) *PracticeUpsert {
+	u.Set(practice.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PracticeUpsert) UpdateUpdatedAt() *PracticeUpsert {
+	u.SetExcluded(practice.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PracticeUpsert) SetName(v string) *PracticeUpsert {
+	u.Set(practice.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PracticeUpsert) UpdateName() *PracticeUpsert {
+	u.SetExcluded(practice.FieldName)
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *PracticeUpsert) SetAddress(v string) *PracticeUpsert {
+	u.Set(practice.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *PracticeUpsert) UpdateAddress() *PracticeUpsert {
+	u.SetExcluded(practice.FieldAddress)
+	return u
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *PracticeUpsert) ClearAddress() *PracticeUpsert {
+	u.SetNull(practice.FieldAddress)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Practice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(practice.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PracticeUpsertOne) UpdateNewValues() *PracticeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(practice.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(practice.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Practice.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PracticeUpsertOne) Ignore() *PracticeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PracticeUpsertOne) DoNothing() *PracticeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PracticeCreate.OnConflict
+// documentation for more info.
+func (u *PracticeUpsertOne) Update(set func(*PracticeUpsert)) *PracticeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PracticeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PracticeUpsertOne) SetUpdatedAt(v time.Time) *PracticeUpsertOne {
+	return u.Update(func(s *PracticeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PracticeUpsertOne) UpdateUpdatedAt() *PracticeUpsertOne {
+	return u.Update(func(s *PracticeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PracticeUpsertOne) SetName(v string) *PracticeUpsertOne {
+	return u.Update(func(s *PracticeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PracticeUpsertOne) UpdateName() *PracticeUpsertOne {
+	return u.Update(func(s *PracticeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *PracticeUpsertOne) SetAddress(v string) *PracticeUpsertOne {
+	return u.Update(func(s *PracticeUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *PracticeUpsertOne) UpdateAddress() *PracticeUpsertOne {
+	return u.Update(func(s *PracticeUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *PracticeUpsertOne) ClearAddress() *PracticeUpsertOne {
+	return u.Update(func(s *PracticeUpsert) {
+		s.ClearAddress()
+	})
+}
+
+// Exec executes the query.
+func (u *PracticeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PracticeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PracticeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PracticeUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PracticeUpsertOne.ID is not supported by MySQL driver. Use PracticeUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PracticeUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // PracticeCreateBulk is the builder for creating many Practice entities in bulk.
 type PracticeCreateBulk struct {
 	config
 	err      error
 	builders []*PracticeCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Practice entities in the database.
@@ -268,6 +566,7 @@ func (_c *PracticeCreateBulk) Save(ctx context.Context) ([]*Practice, error) {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -317,3 +616,169 @@ func (_c *PracticeCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Practice.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PracticeUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PracticeCreateBulk) OnConflict(opts ...sql.ConflictOption) *PracticeUpsertBulk {
+	_c.conflict = opts
+	return &PracticeUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Practice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PracticeCreateBulk) OnConflictColumns(columns ...string) *PracticeUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PracticeUpsertBulk{
+		create: _c,
+	}
+}
+
+// PracticeUpsertBulk is the builder for "upsert"-ing
+// a bulk of Practice nodes.
+type PracticeUpsertBulk struct {
+	create *PracticeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Practice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(practice.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PracticeUpsertBulk) UpdateNewValues() *PracticeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(practice.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(practice.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Practice.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PracticeUpsertBulk) Ignore() *PracticeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PracticeUpsertBulk) DoNothing() *PracticeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PracticeCreateBulk.OnConflict
+// documentation for more info.
+func (u *PracticeUpsertBulk) Update(set func(*PracticeUpsert)) *PracticeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PracticeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PracticeUpsertBulk) SetUpdatedAt(v time.Time) *PracticeUpsertBulk {
+	return u.Update(func(s *PracticeUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PracticeUpsertBulk) UpdateUpdatedAt() *PracticeUpsertBulk {
+	return u.Update(func(s *PracticeUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PracticeUpsertBulk) SetName(v string) *PracticeUpsertBulk {
+	return u.Update(func(s *PracticeUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PracticeUpsertBulk) UpdateName() *PracticeUpsertBulk {
+	return u.Update(func(s *PracticeUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *PracticeUpsertBulk) SetAddress(v string) *PracticeUpsertBulk {
+	return u.Update(func(s *PracticeUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *PracticeUpsertBulk) UpdateAddress() *PracticeUpsertBulk {
+	return u.Update(func(s *PracticeUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *PracticeUpsertBulk) ClearAddress() *PracticeUpsertBulk {
+	return u.Update(func(s *PracticeUpsert) {
+		s.ClearAddress()
+	})
+}
+
+// Exec executes the query.
+func (u *PracticeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PracticeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PracticeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PracticeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
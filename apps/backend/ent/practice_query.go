@@ -6,6 +6,8 @@ import (
 	"backend/ent/doctor"
 	"backend/ent/practice"
 	"backend/ent/predicate"
+	"backend/ent/vocabularyterm"
+	"backend/ent/webhooksubscription"
 	"context"
 	"database/sql/driver"
 	"fmt"
@@ -21,11 +23,13 @@ import (
 // PracticeQuery is the builder for querying Practice entities.
 type PracticeQuery struct {
 	config
-	ctx         *QueryContext
-	order       []practice.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Practice
-	withDoctors *DoctorQuery
+	ctx                      *QueryContext
+	order                    []practice.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.Practice
+	withDoctors              *DoctorQuery
+	withVocabularyTerms      *VocabularyTermQuery
+	withWebhookSubscriptions *WebhookSubscriptionQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +88,50 @@ func (_q *PracticeQuery) QueryDoctors() *DoctorQuery {
 	return query
 }
 
+// QueryVocabularyTerms chains the current query on the "vocabulary_terms" edge.
+func (_q *PracticeQuery) QueryVocabularyTerms() *VocabularyTermQuery {
+	query := (&VocabularyTermClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(practice.Table, practice.FieldID, selector),
+			sqlgraph.To(vocabularyterm.Table, vocabularyterm.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, practice.VocabularyTermsTable, practice.VocabularyTermsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWebhookSubscriptions chains the current query on the "webhook_subscriptions" edge.
+func (_q *PracticeQuery) QueryWebhookSubscriptions() *WebhookSubscriptionQuery {
+	query := (&WebhookSubscriptionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(practice.Table, practice.FieldID, selector),
+			sqlgraph.To(webhooksubscription.Table, webhooksubscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, practice.WebhookSubscriptionsTable, practice.WebhookSubscriptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Practice entity from the query.
 // Returns a *NotFoundError when no Practice was found.
 func (_q *PracticeQuery) First(ctx context.Context) (*Practice, error) {
@@ -271,12 +319,14 @@ func (_q *PracticeQuery) Clone() *PracticeQuery {
 		return nil
 	}
 	return &PracticeQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]practice.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.Practice{}, _q.predicates...),
-		withDoctors: _q.withDoctors.Clone(),
+		config:                   _q.config,
+		ctx:                      _q.ctx.Clone(),
+		order:                    append([]practice.OrderOption{}, _q.order...),
+		inters:                   append([]Interceptor{}, _q.inters...),
+		predicates:               append([]predicate.Practice{}, _q.predicates...),
+		withDoctors:              _q.withDoctors.Clone(),
+		withVocabularyTerms:      _q.withVocabularyTerms.Clone(),
+		withWebhookSubscriptions: _q.withWebhookSubscriptions.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -294,6 +344,28 @@ func (_q *PracticeQuery) WithDoctors(opts ...func(*DoctorQuery)) *PracticeQuery
 	return _q
 }
 
+// WithVocabularyTerms tells the query-builder to eager-load the nodes that are connected to
+// the "vocabulary_terms" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PracticeQuery) WithVocabularyTerms(opts ...func(*VocabularyTermQuery)) *PracticeQuery {
+	query := (&VocabularyTermClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVocabularyTerms = query
+	return _q
+}
+
+// WithWebhookSubscriptions tells the query-builder to eager-load the nodes that are connected to
+// the "webhook_subscriptions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PracticeQuery) WithWebhookSubscriptions(opts ...func(*WebhookSubscriptionQuery)) *PracticeQuery {
+	query := (&WebhookSubscriptionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWebhookSubscriptions = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -372,8 +444,10 @@ func (_q *PracticeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pra
 	var (
 		nodes       = []*Practice{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			_q.withDoctors != nil,
+			_q.withVocabularyTerms != nil,
+			_q.withWebhookSubscriptions != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -401,6 +475,22 @@ func (_q *PracticeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Pra
 			return nil, err
 		}
 	}
+	if query := _q.withVocabularyTerms; query != nil {
+		if err := _q.loadVocabularyTerms(ctx, query, nodes,
+			func(n *Practice) { n.Edges.VocabularyTerms = []*VocabularyTerm{} },
+			func(n *Practice, e *VocabularyTerm) { n.Edges.VocabularyTerms = append(n.Edges.VocabularyTerms, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWebhookSubscriptions; query != nil {
+		if err := _q.loadWebhookSubscriptions(ctx, query, nodes,
+			func(n *Practice) { n.Edges.WebhookSubscriptions = []*WebhookSubscription{} },
+			func(n *Practice, e *WebhookSubscription) {
+				n.Edges.WebhookSubscriptions = append(n.Edges.WebhookSubscriptions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -437,6 +527,69 @@ func (_q *PracticeQuery) loadDoctors(ctx context.Context, query *DoctorQuery, no
 	}
 	return nil
 }
+func (_q *PracticeQuery) loadVocabularyTerms(ctx context.Context, query *VocabularyTermQuery, nodes []*Practice, init func(*Practice), assign func(*Practice, *VocabularyTerm)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Practice)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(vocabularyterm.FieldPracticeID)
+	}
+	query.Where(predicate.VocabularyTerm(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(practice.VocabularyTermsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PracticeID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "practice_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "practice_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PracticeQuery) loadWebhookSubscriptions(ctx context.Context, query *WebhookSubscriptionQuery, nodes []*Practice, init func(*Practice), assign func(*Practice, *WebhookSubscription)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Practice)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhooksubscription.FieldPracticeID)
+	}
+	query.Where(predicate.WebhookSubscription(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(practice.WebhookSubscriptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PracticeID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "practice_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *PracticeQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
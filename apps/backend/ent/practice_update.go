@@ -6,6 +6,8 @@ import (
 	"backend/ent/doctor"
 	"backend/ent/practice"
 	"backend/ent/predicate"
+	"backend/ent/vocabularyterm"
+	"backend/ent/webhooksubscription"
 	"context"
 	"errors"
 	"fmt"
@@ -85,6 +87,36 @@ func (_u *PracticeUpdate) AddDoctors(v ...*Doctor) *PracticeUpdate {
 	return _u.AddDoctorIDs(ids...)
 }
 
+// AddVocabularyTermIDs adds the "vocabulary_terms" edge to the VocabularyTerm entity by IDs.
+func (_u *PracticeUpdate) AddVocabularyTermIDs(ids ...uuid.UUID) *PracticeUpdate {
+	_u.mutation.AddVocabularyTermIDs(ids...)
+	return _u
+}
+
+// AddVocabularyTerms adds the "vocabulary_terms" edges to the VocabularyTerm entity.
+func (_u *PracticeUpdate) AddVocabularyTerms(v ...*VocabularyTerm) *PracticeUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVocabularyTermIDs(ids...)
+}
+
+// AddWebhookSubscriptionIDs adds the "webhook_subscriptions" edge to the WebhookSubscription entity by IDs.
+func (_u *PracticeUpdate) AddWebhookSubscriptionIDs(ids ...uuid.UUID) *PracticeUpdate {
+	_u.mutation.AddWebhookSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddWebhookSubscriptions adds the "webhook_subscriptions" edges to the WebhookSubscription entity.
+func (_u *PracticeUpdate) AddWebhookSubscriptions(v ...*WebhookSubscription) *PracticeUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookSubscriptionIDs(ids...)
+}
+
 // Mutation returns the PracticeMutation object of the builder.
 func (_u *PracticeUpdate) Mutation() *PracticeMutation {
 	return _u.mutation
@@ -111,6 +143,48 @@ func (_u *PracticeUpdate) RemoveDoctors(v ...*Doctor) *PracticeUpdate {
 	return _u.RemoveDoctorIDs(ids...)
 }
 
+// ClearVocabularyTerms clears all "vocabulary_terms" edges to the VocabularyTerm entity.
+func (_u *PracticeUpdate) ClearVocabularyTerms() *PracticeUpdate {
+	_u.mutation.ClearVocabularyTerms()
+	return _u
+}
+
+// RemoveVocabularyTermIDs removes the "vocabulary_terms" edge to VocabularyTerm entities by IDs.
+func (_u *PracticeUpdate) RemoveVocabularyTermIDs(ids ...uuid.UUID) *PracticeUpdate {
+	_u.mutation.RemoveVocabularyTermIDs(ids...)
+	return _u
+}
+
+// RemoveVocabularyTerms removes "vocabulary_terms" edges to VocabularyTerm entities.
+func (_u *PracticeUpdate) RemoveVocabularyTerms(v ...*VocabularyTerm) *PracticeUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVocabularyTermIDs(ids...)
+}
+
+// ClearWebhookSubscriptions clears all "webhook_subscriptions" edges to the WebhookSubscription entity.
+func (_u *PracticeUpdate) ClearWebhookSubscriptions() *PracticeUpdate {
+	_u.mutation.ClearWebhookSubscriptions()
+	return _u
+}
+
+// RemoveWebhookSubscriptionIDs removes the "webhook_subscriptions" edge to WebhookSubscription entities by IDs.
+func (_u *PracticeUpdate) RemoveWebhookSubscriptionIDs(ids ...uuid.UUID) *PracticeUpdate {
+	_u.mutation.RemoveWebhookSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveWebhookSubscriptions removes "webhook_subscriptions" edges to WebhookSubscription entities.
+func (_u *PracticeUpdate) RemoveWebhookSubscriptions(v ...*WebhookSubscription) *PracticeUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookSubscriptionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PracticeUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -226,6 +300,96 @@ func (_u *PracticeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.VocabularyTermsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   practice.VocabularyTermsTable,
+			Columns: []string{practice.VocabularyTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vocabularyterm.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVocabularyTermsIDs(); len(nodes) > 0 && !_u.mutation.VocabularyTermsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   practice.VocabularyTermsTable,
+			Columns: []string{practice.VocabularyTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vocabularyterm.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VocabularyTermsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   practice.VocabularyTermsTable,
+			Columns: []string{practice.VocabularyTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vocabularyterm.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhookSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   practice.WebhookSubscriptionsTable,
+			Columns: []string{practice.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhookSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.WebhookSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   practice.WebhookSubscriptionsTable,
+			Columns: []string{practice.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhookSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   practice.WebhookSubscriptionsTable,
+			Columns: []string{practice.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{practice.Label}
@@ -301,6 +465,36 @@ func (_u *PracticeUpdateOne) AddDoctors(v ...*Doctor) *PracticeUpdateOne {
 	return _u.AddDoctorIDs(ids...)
 }
 
+// AddVocabularyTermIDs adds the "vocabulary_terms" edge to the VocabularyTerm entity by IDs.
+func (_u *PracticeUpdateOne) AddVocabularyTermIDs(ids ...uuid.UUID) *PracticeUpdateOne {
+	_u.mutation.AddVocabularyTermIDs(ids...)
+	return _u
+}
+
+// AddVocabularyTerms adds the "vocabulary_terms" edges to the VocabularyTerm entity.
+func (_u *PracticeUpdateOne) AddVocabularyTerms(v ...*VocabularyTerm) *PracticeUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVocabularyTermIDs(ids...)
+}
+
+// AddWebhookSubscriptionIDs adds the "webhook_subscriptions" edge to the WebhookSubscription entity by IDs.
+func (_u *PracticeUpdateOne) AddWebhookSubscriptionIDs(ids ...uuid.UUID) *PracticeUpdateOne {
+	_u.mutation.AddWebhookSubscriptionIDs(ids...)
+	return _u
+}
+
+// AddWebhookSubscriptions adds the "webhook_subscriptions" edges to the WebhookSubscription entity.
+func (_u *PracticeUpdateOne) AddWebhookSubscriptions(v ...*WebhookSubscription) *PracticeUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookSubscriptionIDs(ids...)
+}
+
 // Mutation returns the PracticeMutation object of the builder.
 func (_u *PracticeUpdateOne) Mutation() *PracticeMutation {
 	return _u.mutation
@@ -327,6 +521,48 @@ func (_u *PracticeUpdateOne) RemoveDoctors(v ...*Doctor) *PracticeUpdateOne {
 	return _u.RemoveDoctorIDs(ids...)
 }
 
+// ClearVocabularyTerms clears all "vocabulary_terms" edges to the VocabularyTerm entity.
+func (_u *PracticeUpdateOne) ClearVocabularyTerms() *PracticeUpdateOne {
+	_u.mutation.ClearVocabularyTerms()
+	return _u
+}
+
+// RemoveVocabularyTermIDs removes the "vocabulary_terms" edge to VocabularyTerm entities by IDs.
+func (_u *PracticeUpdateOne) RemoveVocabularyTermIDs(ids ...uuid.UUID) *PracticeUpdateOne {
+	_u.mutation.RemoveVocabularyTermIDs(ids...)
+	return _u
+}
+
+// RemoveVocabularyTerms removes "vocabulary_terms" edges to VocabularyTerm entities.
+func (_u *PracticeUpdateOne) RemoveVocabularyTerms(v ...*VocabularyTerm) *PracticeUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVocabularyTermIDs(ids...)
+}
+
+// ClearWebhookSubscriptions clears all "webhook_subscriptions" edges to the WebhookSubscription entity.
+func (_u *PracticeUpdateOne) ClearWebhookSubscriptions() *PracticeUpdateOne {
+	_u.mutation.ClearWebhookSubscriptions()
+	return _u
+}
+
+// RemoveWebhookSubscriptionIDs removes the "webhook_subscriptions" edge to WebhookSubscription entities by IDs.
+func (_u *PracticeUpdateOne) RemoveWebhookSubscriptionIDs(ids ...uuid.UUID) *PracticeUpdateOne {
+	_u.mutation.RemoveWebhookSubscriptionIDs(ids...)
+	return _u
+}
+
+// RemoveWebhookSubscriptions removes "webhook_subscriptions" edges to WebhookSubscription entities.
+func (_u *PracticeUpdateOne) RemoveWebhookSubscriptions(v ...*WebhookSubscription) *PracticeUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookSubscriptionIDs(ids...)
+}
+
 // Where appends a list predicates to the PracticeUpdate builder.
 func (_u *PracticeUpdateOne) Where(ps ...predicate.Practice) *PracticeUpdateOne {
 	_u.mutation.Where(ps...)
@@ -472,6 +708,96 @@ func (_u *PracticeUpdateOne) sqlSave(ctx context.Context) (_node *Practice, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.VocabularyTermsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   practice.VocabularyTermsTable,
+			Columns: []string{practice.VocabularyTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vocabularyterm.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVocabularyTermsIDs(); len(nodes) > 0 && !_u.mutation.VocabularyTermsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   practice.VocabularyTermsTable,
+			Columns: []string{practice.VocabularyTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vocabularyterm.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VocabularyTermsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   practice.VocabularyTermsTable,
+			Columns: []string{practice.VocabularyTermsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vocabularyterm.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhookSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   practice.WebhookSubscriptionsTable,
+			Columns: []string{practice.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhookSubscriptionsIDs(); len(nodes) > 0 && !_u.mutation.WebhookSubscriptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   practice.WebhookSubscriptionsTable,
+			Columns: []string{practice.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhookSubscriptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   practice.WebhookSubscriptionsTable,
+			Columns: []string{practice.WebhookSubscriptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhooksubscription.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Practice{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -9,6 +9,9 @@ import (
 // AnalysisJob is the predicate function for analysisjob builders.
 type AnalysisJob func(*sql.Selector)
 
+// Assignment is the predicate function for assignment builders.
+type Assignment func(*sql.Selector)
+
 // Comment is the predicate function for comment builders.
 type Comment func(*sql.Selector)
 
@@ -24,6 +27,18 @@ type Entry func(*sql.Selector)
 // EntryShare is the predicate function for entryshare builders.
 type EntryShare func(*sql.Selector)
 
+// ExerciseLog is the predicate function for exerciselog builders.
+type ExerciseLog func(*sql.Selector)
+
+// Goal is the predicate function for goal builders.
+type Goal func(*sql.Selector)
+
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
 // PairingCode is the predicate function for pairingcode builders.
 type PairingCode func(*sql.Selector)
 
@@ -32,3 +47,33 @@ type Patient func(*sql.Selector)
 
 // Practice is the predicate function for practice builders.
 type Practice func(*sql.Selector)
+
+// QuestionnaireAssignment is the predicate function for questionnaireassignment builders.
+type QuestionnaireAssignment func(*sql.Selector)
+
+// QuestionnaireDefinition is the predicate function for questionnairedefinition builders.
+type QuestionnaireDefinition func(*sql.Selector)
+
+// QuestionnaireResponse is the predicate function for questionnaireresponse builders.
+type QuestionnaireResponse func(*sql.Selector)
+
+// RateLimitBucket is the predicate function for ratelimitbucket builders.
+type RateLimitBucket func(*sql.Selector)
+
+// ReminderDelivery is the predicate function for reminderdelivery builders.
+type ReminderDelivery func(*sql.Selector)
+
+// ReminderSchedule is the predicate function for reminderschedule builders.
+type ReminderSchedule func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
+// VocabularyTerm is the predicate function for vocabularyterm builders.
+type VocabularyTerm func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookSubscription is the predicate function for webhooksubscription builders.
+type WebhookSubscription func(*sql.Selector)
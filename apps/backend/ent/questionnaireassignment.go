@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/patient"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/ent/questionnaireresponse"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// QuestionnaireAssignment is the model entity for the QuestionnaireAssignment schema.
+type QuestionnaireAssignment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// PatientID holds the value of the "patient_id" field.
+	PatientID uuid.UUID `json:"patient_id,omitempty"`
+	// DoctorID holds the value of the "doctor_id" field.
+	DoctorID uuid.UUID `json:"doctor_id,omitempty"`
+	// DefinitionID holds the value of the "definition_id" field.
+	DefinitionID uuid.UUID `json:"definition_id,omitempty"`
+	// DueAt holds the value of the "due_at" field.
+	DueAt *time.Time `json:"due_at,omitempty"`
+	// Status holds the value of the "status" field.
+	Status questionnaireassignment.Status `json:"status,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QuestionnaireAssignmentQuery when eager-loading is set.
+	Edges        QuestionnaireAssignmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// QuestionnaireAssignmentEdges holds the relations/edges for other nodes in the graph.
+type QuestionnaireAssignmentEdges struct {
+	// Patient holds the value of the patient edge.
+	Patient *Patient `json:"patient,omitempty"`
+	// Doctor holds the value of the doctor edge.
+	Doctor *Doctor `json:"doctor,omitempty"`
+	// Definition holds the value of the definition edge.
+	Definition *QuestionnaireDefinition `json:"definition,omitempty"`
+	// Response holds the value of the response edge.
+	Response *QuestionnaireResponse `json:"response,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// PatientOrErr returns the Patient value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionnaireAssignmentEdges) PatientOrErr() (*Patient, error) {
+	if e.Patient != nil {
+		return e.Patient, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: patient.Label}
+	}
+	return nil, &NotLoadedError{edge: "patient"}
+}
+
+// DoctorOrErr returns the Doctor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionnaireAssignmentEdges) DoctorOrErr() (*Doctor, error) {
+	if e.Doctor != nil {
+		return e.Doctor, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: doctor.Label}
+	}
+	return nil, &NotLoadedError{edge: "doctor"}
+}
+
+// DefinitionOrErr returns the Definition value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionnaireAssignmentEdges) DefinitionOrErr() (*QuestionnaireDefinition, error) {
+	if e.Definition != nil {
+		return e.Definition, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: questionnairedefinition.Label}
+	}
+	return nil, &NotLoadedError{edge: "definition"}
+}
+
+// ResponseOrErr returns the Response value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionnaireAssignmentEdges) ResponseOrErr() (*QuestionnaireResponse, error) {
+	if e.Response != nil {
+		return e.Response, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: questionnaireresponse.Label}
+	}
+	return nil, &NotLoadedError{edge: "response"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QuestionnaireAssignment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case questionnaireassignment.FieldStatus:
+			values[i] = new(sql.NullString)
+		case questionnaireassignment.FieldCreatedAt, questionnaireassignment.FieldUpdatedAt, questionnaireassignment.FieldDueAt, questionnaireassignment.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		case questionnaireassignment.FieldID, questionnaireassignment.FieldPatientID, questionnaireassignment.FieldDoctorID, questionnaireassignment.FieldDefinitionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QuestionnaireAssignment fields.
+func (_m *QuestionnaireAssignment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case questionnaireassignment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case questionnaireassignment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case questionnaireassignment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case questionnaireassignment.FieldPatientID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field patient_id", values[i])
+			} else if value != nil {
+				_m.PatientID = *value
+			}
+		case questionnaireassignment.FieldDoctorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field doctor_id", values[i])
+			} else if value != nil {
+				_m.DoctorID = *value
+			}
+		case questionnaireassignment.FieldDefinitionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field definition_id", values[i])
+			} else if value != nil {
+				_m.DefinitionID = *value
+			}
+		case questionnaireassignment.FieldDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_at", values[i])
+			} else if value.Valid {
+				_m.DueAt = new(time.Time)
+				*_m.DueAt = value.Time
+			}
+		case questionnaireassignment.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = questionnaireassignment.Status(value.String)
+			}
+		case questionnaireassignment.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QuestionnaireAssignment.
+// This includes values selected through modifiers, order, etc.
+func (_m *QuestionnaireAssignment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPatient queries the "patient" edge of the QuestionnaireAssignment entity.
+func (_m *QuestionnaireAssignment) QueryPatient() *PatientQuery {
+	return NewQuestionnaireAssignmentClient(_m.config).QueryPatient(_m)
+}
+
+// QueryDoctor queries the "doctor" edge of the QuestionnaireAssignment entity.
+func (_m *QuestionnaireAssignment) QueryDoctor() *DoctorQuery {
+	return NewQuestionnaireAssignmentClient(_m.config).QueryDoctor(_m)
+}
+
+// QueryDefinition queries the "definition" edge of the QuestionnaireAssignment entity.
+func (_m *QuestionnaireAssignment) QueryDefinition() *QuestionnaireDefinitionQuery {
+	return NewQuestionnaireAssignmentClient(_m.config).QueryDefinition(_m)
+}
+
+// QueryResponse queries the "response" edge of the QuestionnaireAssignment entity.
+func (_m *QuestionnaireAssignment) QueryResponse() *QuestionnaireResponseQuery {
+	return NewQuestionnaireAssignmentClient(_m.config).QueryResponse(_m)
+}
+
+// Update returns a builder for updating this QuestionnaireAssignment.
+// Note that you need to call QuestionnaireAssignment.Unwrap() before calling this method if this QuestionnaireAssignment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *QuestionnaireAssignment) Update() *QuestionnaireAssignmentUpdateOne {
+	return NewQuestionnaireAssignmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the QuestionnaireAssignment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *QuestionnaireAssignment) Unwrap() *QuestionnaireAssignment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QuestionnaireAssignment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *QuestionnaireAssignment) String() string {
+	var builder strings.Builder
+	builder.WriteString("QuestionnaireAssignment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("patient_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PatientID))
+	builder.WriteString(", ")
+	builder.WriteString("doctor_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DoctorID))
+	builder.WriteString(", ")
+	builder.WriteString("definition_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefinitionID))
+	builder.WriteString(", ")
+	if v := _m.DueAt; v != nil {
+		builder.WriteString("due_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuestionnaireAssignments is a parsable slice of QuestionnaireAssignment.
+type QuestionnaireAssignments []*QuestionnaireAssignment
@@ -0,0 +1,459 @@
+// Code generated by ent, DO NOT EDIT.
+
+package questionnaireassignment
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PatientID applies equality check predicate on the "patient_id" field. It's identical to PatientIDEQ.
+func PatientID(v uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldEQ(FieldPatientID, v))
+}
+
+// DoctorID applies equality check predicate on the "doctor_id" field. It's identical to DoctorIDEQ.
+func DoctorID(v uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldEQ(FieldDoctorID, v))
+}
+
+// DefinitionID applies equality check predicate on the "definition_id" field. It's identical to DefinitionIDEQ.
+func DefinitionID(v uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldEQ(FieldDefinitionID, v))
+}
+
+// DueAt applies equality check predicate on the "due_at" field. It's identical to DueAtEQ.
+func DueAt(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldEQ(FieldDueAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// PatientIDEQ applies the EQ predicate on the "patient_id" field.
+func PatientIDEQ(v uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldEQ(FieldPatientID, v))
+}
+
+// PatientIDNEQ applies the NEQ predicate on the "patient_id" field.
+func PatientIDNEQ(v uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNEQ(FieldPatientID, v))
+}
+
+// PatientIDIn applies the In predicate on the "patient_id" field.
+func PatientIDIn(vs ...uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldIn(FieldPatientID, vs...))
+}
+
+// PatientIDNotIn applies the NotIn predicate on the "patient_id" field.
+func PatientIDNotIn(vs ...uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNotIn(FieldPatientID, vs...))
+}
+
+// DoctorIDEQ applies the EQ predicate on the "doctor_id" field.
+func DoctorIDEQ(v uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldEQ(FieldDoctorID, v))
+}
+
+// DoctorIDNEQ applies the NEQ predicate on the "doctor_id" field.
+func DoctorIDNEQ(v uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNEQ(FieldDoctorID, v))
+}
+
+// DoctorIDIn applies the In predicate on the "doctor_id" field.
+func DoctorIDIn(vs ...uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldIn(FieldDoctorID, vs...))
+}
+
+// DoctorIDNotIn applies the NotIn predicate on the "doctor_id" field.
+func DoctorIDNotIn(vs ...uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNotIn(FieldDoctorID, vs...))
+}
+
+// DefinitionIDEQ applies the EQ predicate on the "definition_id" field.
+func DefinitionIDEQ(v uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldEQ(FieldDefinitionID, v))
+}
+
+// DefinitionIDNEQ applies the NEQ predicate on the "definition_id" field.
+func DefinitionIDNEQ(v uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNEQ(FieldDefinitionID, v))
+}
+
+// DefinitionIDIn applies the In predicate on the "definition_id" field.
+func DefinitionIDIn(vs ...uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldIn(FieldDefinitionID, vs...))
+}
+
+// DefinitionIDNotIn applies the NotIn predicate on the "definition_id" field.
+func DefinitionIDNotIn(vs ...uuid.UUID) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNotIn(FieldDefinitionID, vs...))
+}
+
+// DueAtEQ applies the EQ predicate on the "due_at" field.
+func DueAtEQ(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldEQ(FieldDueAt, v))
+}
+
+// DueAtNEQ applies the NEQ predicate on the "due_at" field.
+func DueAtNEQ(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNEQ(FieldDueAt, v))
+}
+
+// DueAtIn applies the In predicate on the "due_at" field.
+func DueAtIn(vs ...time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldIn(FieldDueAt, vs...))
+}
+
+// DueAtNotIn applies the NotIn predicate on the "due_at" field.
+func DueAtNotIn(vs ...time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNotIn(FieldDueAt, vs...))
+}
+
+// DueAtGT applies the GT predicate on the "due_at" field.
+func DueAtGT(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldGT(FieldDueAt, v))
+}
+
+// DueAtGTE applies the GTE predicate on the "due_at" field.
+func DueAtGTE(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldGTE(FieldDueAt, v))
+}
+
+// DueAtLT applies the LT predicate on the "due_at" field.
+func DueAtLT(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldLT(FieldDueAt, v))
+}
+
+// DueAtLTE applies the LTE predicate on the "due_at" field.
+func DueAtLTE(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldLTE(FieldDueAt, v))
+}
+
+// DueAtIsNil applies the IsNil predicate on the "due_at" field.
+func DueAtIsNil() predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldIsNull(FieldDueAt))
+}
+
+// DueAtNotNil applies the NotNil predicate on the "due_at" field.
+func DueAtNotNil() predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNotNull(FieldDueAt))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// HasPatient applies the HasEdge predicate on the "patient" edge.
+func HasPatient() predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PatientTable, PatientColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPatientWith applies the HasEdge predicate on the "patient" edge with a given conditions (other predicates).
+func HasPatientWith(preds ...predicate.Patient) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(func(s *sql.Selector) {
+		step := newPatientStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDoctor applies the HasEdge predicate on the "doctor" edge.
+func HasDoctor() predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DoctorTable, DoctorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDoctorWith applies the HasEdge predicate on the "doctor" edge with a given conditions (other predicates).
+func HasDoctorWith(preds ...predicate.Doctor) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(func(s *sql.Selector) {
+		step := newDoctorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDefinition applies the HasEdge predicate on the "definition" edge.
+func HasDefinition() predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DefinitionTable, DefinitionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDefinitionWith applies the HasEdge predicate on the "definition" edge with a given conditions (other predicates).
+func HasDefinitionWith(preds ...predicate.QuestionnaireDefinition) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(func(s *sql.Selector) {
+		step := newDefinitionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasResponse applies the HasEdge predicate on the "response" edge.
+func HasResponse() predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ResponseTable, ResponseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResponseWith applies the HasEdge predicate on the "response" edge with a given conditions (other predicates).
+func HasResponseWith(preds ...predicate.QuestionnaireResponse) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(func(s *sql.Selector) {
+		step := newResponseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QuestionnaireAssignment) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QuestionnaireAssignment) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QuestionnaireAssignment) predicate.QuestionnaireAssignment {
+	return predicate.QuestionnaireAssignment(sql.NotPredicates(p))
+}
@@ -0,0 +1,1039 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/patient"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/ent/questionnaireresponse"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QuestionnaireAssignmentCreate is the builder for creating a QuestionnaireAssignment entity.
+type QuestionnaireAssignmentCreate struct {
+	config
+	mutation *QuestionnaireAssignmentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *QuestionnaireAssignmentCreate) SetCreatedAt(v time.Time) *QuestionnaireAssignmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *QuestionnaireAssignmentCreate) SetNillableCreatedAt(v *time.Time) *QuestionnaireAssignmentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *QuestionnaireAssignmentCreate) SetUpdatedAt(v time.Time) *QuestionnaireAssignmentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *QuestionnaireAssignmentCreate) SetNillableUpdatedAt(v *time.Time) *QuestionnaireAssignmentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_c *QuestionnaireAssignmentCreate) SetPatientID(v uuid.UUID) *QuestionnaireAssignmentCreate {
+	_c.mutation.SetPatientID(v)
+	return _c
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (_c *QuestionnaireAssignmentCreate) SetDoctorID(v uuid.UUID) *QuestionnaireAssignmentCreate {
+	_c.mutation.SetDoctorID(v)
+	return _c
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (_c *QuestionnaireAssignmentCreate) SetDefinitionID(v uuid.UUID) *QuestionnaireAssignmentCreate {
+	_c.mutation.SetDefinitionID(v)
+	return _c
+}
+
+// SetDueAt sets the "due_at" field.
+func (_c *QuestionnaireAssignmentCreate) SetDueAt(v time.Time) *QuestionnaireAssignmentCreate {
+	_c.mutation.SetDueAt(v)
+	return _c
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_c *QuestionnaireAssignmentCreate) SetNillableDueAt(v *time.Time) *QuestionnaireAssignmentCreate {
+	if v != nil {
+		_c.SetDueAt(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *QuestionnaireAssignmentCreate) SetStatus(v questionnaireassignment.Status) *QuestionnaireAssignmentCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *QuestionnaireAssignmentCreate) SetNillableStatus(v *questionnaireassignment.Status) *QuestionnaireAssignmentCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *QuestionnaireAssignmentCreate) SetCompletedAt(v time.Time) *QuestionnaireAssignmentCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *QuestionnaireAssignmentCreate) SetNillableCompletedAt(v *time.Time) *QuestionnaireAssignmentCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *QuestionnaireAssignmentCreate) SetID(v uuid.UUID) *QuestionnaireAssignmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *QuestionnaireAssignmentCreate) SetNillableID(v *uuid.UUID) *QuestionnaireAssignmentCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_c *QuestionnaireAssignmentCreate) SetPatient(v *Patient) *QuestionnaireAssignmentCreate {
+	return _c.SetPatientID(v.ID)
+}
+
+// SetDoctor sets the "doctor" edge to the Doctor entity.
+func (_c *QuestionnaireAssignmentCreate) SetDoctor(v *Doctor) *QuestionnaireAssignmentCreate {
+	return _c.SetDoctorID(v.ID)
+}
+
+// SetDefinition sets the "definition" edge to the QuestionnaireDefinition entity.
+func (_c *QuestionnaireAssignmentCreate) SetDefinition(v *QuestionnaireDefinition) *QuestionnaireAssignmentCreate {
+	return _c.SetDefinitionID(v.ID)
+}
+
+// SetResponseID sets the "response" edge to the QuestionnaireResponse entity by ID.
+func (_c *QuestionnaireAssignmentCreate) SetResponseID(id uuid.UUID) *QuestionnaireAssignmentCreate {
+	_c.mutation.SetResponseID(id)
+	return _c
+}
+
+// SetNillableResponseID sets the "response" edge to the QuestionnaireResponse entity by ID if the given value is not nil.
+func (_c *QuestionnaireAssignmentCreate) SetNillableResponseID(id *uuid.UUID) *QuestionnaireAssignmentCreate {
+	if id != nil {
+		_c = _c.SetResponseID(*id)
+	}
+	return _c
+}
+
+// SetResponse sets the "response" edge to the QuestionnaireResponse entity.
+func (_c *QuestionnaireAssignmentCreate) SetResponse(v *QuestionnaireResponse) *QuestionnaireAssignmentCreate {
+	return _c.SetResponseID(v.ID)
+}
+
+// Mutation returns the QuestionnaireAssignmentMutation object of the builder.
+func (_c *QuestionnaireAssignmentCreate) Mutation() *QuestionnaireAssignmentMutation {
+	return _c.mutation
+}
+
+// Save creates the QuestionnaireAssignment in the database.
+func (_c *QuestionnaireAssignmentCreate) Save(ctx context.Context) (*QuestionnaireAssignment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *QuestionnaireAssignmentCreate) SaveX(ctx context.Context) *QuestionnaireAssignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuestionnaireAssignmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuestionnaireAssignmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *QuestionnaireAssignmentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := questionnaireassignment.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := questionnaireassignment.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := questionnaireassignment.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := questionnaireassignment.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *QuestionnaireAssignmentCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "QuestionnaireAssignment.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "QuestionnaireAssignment.updated_at"`)}
+	}
+	if _, ok := _c.mutation.PatientID(); !ok {
+		return &ValidationError{Name: "patient_id", err: errors.New(`ent: missing required field "QuestionnaireAssignment.patient_id"`)}
+	}
+	if _, ok := _c.mutation.DoctorID(); !ok {
+		return &ValidationError{Name: "doctor_id", err: errors.New(`ent: missing required field "QuestionnaireAssignment.doctor_id"`)}
+	}
+	if _, ok := _c.mutation.DefinitionID(); !ok {
+		return &ValidationError{Name: "definition_id", err: errors.New(`ent: missing required field "QuestionnaireAssignment.definition_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "QuestionnaireAssignment.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := questionnaireassignment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QuestionnaireAssignment.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.PatientIDs()) == 0 {
+		return &ValidationError{Name: "patient", err: errors.New(`ent: missing required edge "QuestionnaireAssignment.patient"`)}
+	}
+	if len(_c.mutation.DoctorIDs()) == 0 {
+		return &ValidationError{Name: "doctor", err: errors.New(`ent: missing required edge "QuestionnaireAssignment.doctor"`)}
+	}
+	if len(_c.mutation.DefinitionIDs()) == 0 {
+		return &ValidationError{Name: "definition", err: errors.New(`ent: missing required edge "QuestionnaireAssignment.definition"`)}
+	}
+	return nil
+}
+
+func (_c *QuestionnaireAssignmentCreate) sqlSave(ctx context.Context) (*QuestionnaireAssignment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *QuestionnaireAssignmentCreate) createSpec() (*QuestionnaireAssignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QuestionnaireAssignment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(questionnaireassignment.Table, sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(questionnaireassignment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionnaireassignment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DueAt(); ok {
+		_spec.SetField(questionnaireassignment.FieldDueAt, field.TypeTime, value)
+		_node.DueAt = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(questionnaireassignment.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(questionnaireassignment.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if nodes := _c.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireassignment.PatientTable,
+			Columns: []string{questionnaireassignment.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PatientID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireassignment.DoctorTable,
+			Columns: []string{questionnaireassignment.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DoctorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DefinitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireassignment.DefinitionTable,
+			Columns: []string{questionnaireassignment.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DefinitionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ResponseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   questionnaireassignment.ResponseTable,
+			Columns: []string{questionnaireassignment.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.QuestionnaireAssignment.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionnaireAssignmentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *QuestionnaireAssignmentCreate) OnConflict(opts ...sql.ConflictOption) *QuestionnaireAssignmentUpsertOne {
+	_c.conflict = opts
+	return &QuestionnaireAssignmentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.QuestionnaireAssignment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *QuestionnaireAssignmentCreate) OnConflictColumns(columns ...string) *QuestionnaireAssignmentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &QuestionnaireAssignmentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// QuestionnaireAssignmentUpsertOne is the builder for "upsert"-ing
+	//  one QuestionnaireAssignment node.
+	QuestionnaireAssignmentUpsertOne struct {
+		create *QuestionnaireAssignmentCreate
+	}
+
+	// QuestionnaireAssignmentUpsert is the "OnConflict" setter.
+	QuestionnaireAssignmentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestionnaireAssignmentUpsert) SetUpdatedAt(v time.Time) *QuestionnaireAssignmentUpsert {
+	u.Set(questionnaireassignment.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsert) UpdateUpdatedAt() *QuestionnaireAssignmentUpsert {
+	u.SetExcluded(questionnaireassignment.FieldUpdatedAt)
+	return u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *QuestionnaireAssignmentUpsert) SetPatientID(v uuid.UUID) *QuestionnaireAssignmentUpsert {
+	u.Set(questionnaireassignment.FieldPatientID, v)
+	return u
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsert) UpdatePatientID() *QuestionnaireAssignmentUpsert {
+	u.SetExcluded(questionnaireassignment.FieldPatientID)
+	return u
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *QuestionnaireAssignmentUpsert) SetDoctorID(v uuid.UUID) *QuestionnaireAssignmentUpsert {
+	u.Set(questionnaireassignment.FieldDoctorID, v)
+	return u
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsert) UpdateDoctorID() *QuestionnaireAssignmentUpsert {
+	u.SetExcluded(questionnaireassignment.FieldDoctorID)
+	return u
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (u *QuestionnaireAssignmentUpsert) SetDefinitionID(v uuid.UUID) *QuestionnaireAssignmentUpsert {
+	u.Set(questionnaireassignment.FieldDefinitionID, v)
+	return u
+}
+
+// UpdateDefinitionID sets the "definition_id" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsert) UpdateDefinitionID() *QuestionnaireAssignmentUpsert {
+	u.SetExcluded(questionnaireassignment.FieldDefinitionID)
+	return u
+}
+
+// SetDueAt sets the "due_at" field.
+func (u *QuestionnaireAssignmentUpsert) SetDueAt(v time.Time) *QuestionnaireAssignmentUpsert {
+	u.Set(questionnaireassignment.FieldDueAt, v)
+	return u
+}
+
+// UpdateDueAt sets the "due_at" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsert) UpdateDueAt() *QuestionnaireAssignmentUpsert {
+	u.SetExcluded(questionnaireassignment.FieldDueAt)
+	return u
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (u *QuestionnaireAssignmentUpsert) ClearDueAt() *QuestionnaireAssignmentUpsert {
+	u.SetNull(questionnaireassignment.FieldDueAt)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *QuestionnaireAssignmentUpsert) SetStatus(v questionnaireassignment.Status) *QuestionnaireAssignmentUpsert {
+	u.Set(questionnaireassignment.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsert) UpdateStatus() *QuestionnaireAssignmentUpsert {
+	u.SetExcluded(questionnaireassignment.FieldStatus)
+	return u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *QuestionnaireAssignmentUpsert) SetCompletedAt(v time.Time) *QuestionnaireAssignmentUpsert {
+	u.Set(questionnaireassignment.FieldCompletedAt, v)
+	return u
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsert) UpdateCompletedAt() *QuestionnaireAssignmentUpsert {
+	u.SetExcluded(questionnaireassignment.FieldCompletedAt)
+	return u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *QuestionnaireAssignmentUpsert) ClearCompletedAt() *QuestionnaireAssignmentUpsert {
+	u.SetNull(questionnaireassignment.FieldCompletedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.QuestionnaireAssignment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(questionnaireassignment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuestionnaireAssignmentUpsertOne) UpdateNewValues() *QuestionnaireAssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(questionnaireassignment.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(questionnaireassignment.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.QuestionnaireAssignment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *QuestionnaireAssignmentUpsertOne) Ignore() *QuestionnaireAssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionnaireAssignmentUpsertOne) DoNothing() *QuestionnaireAssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionnaireAssignmentCreate.OnConflict
+// documentation for more info.
+func (u *QuestionnaireAssignmentUpsertOne) Update(set func(*QuestionnaireAssignmentUpsert)) *QuestionnaireAssignmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionnaireAssignmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestionnaireAssignmentUpsertOne) SetUpdatedAt(v time.Time) *QuestionnaireAssignmentUpsertOne {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsertOne) UpdateUpdatedAt() *QuestionnaireAssignmentUpsertOne {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *QuestionnaireAssignmentUpsertOne) SetPatientID(v uuid.UUID) *QuestionnaireAssignmentUpsertOne {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsertOne) UpdatePatientID() *QuestionnaireAssignmentUpsertOne {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *QuestionnaireAssignmentUpsertOne) SetDoctorID(v uuid.UUID) *QuestionnaireAssignmentUpsertOne {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.SetDoctorID(v)
+	})
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsertOne) UpdateDoctorID() *QuestionnaireAssignmentUpsertOne {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.UpdateDoctorID()
+	})
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (u *QuestionnaireAssignmentUpsertOne) SetDefinitionID(v uuid.UUID) *QuestionnaireAssignmentUpsertOne {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.SetDefinitionID(v)
+	})
+}
+
+// UpdateDefinitionID sets the "definition_id" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsertOne) UpdateDefinitionID() *QuestionnaireAssignmentUpsertOne {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.UpdateDefinitionID()
+	})
+}
+
+// SetDueAt sets the "due_at" field.
+func (u *QuestionnaireAssignmentUpsertOne) SetDueAt(v time.Time) *QuestionnaireAssignmentUpsertOne {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.SetDueAt(v)
+	})
+}
+
+// UpdateDueAt sets the "due_at" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsertOne) UpdateDueAt() *QuestionnaireAssignmentUpsertOne {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.UpdateDueAt()
+	})
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (u *QuestionnaireAssignmentUpsertOne) ClearDueAt() *QuestionnaireAssignmentUpsertOne {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.ClearDueAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *QuestionnaireAssignmentUpsertOne) SetStatus(v questionnaireassignment.Status) *QuestionnaireAssignmentUpsertOne {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsertOne) UpdateStatus() *QuestionnaireAssignmentUpsertOne {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *QuestionnaireAssignmentUpsertOne) SetCompletedAt(v time.Time) *QuestionnaireAssignmentUpsertOne {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsertOne) UpdateCompletedAt() *QuestionnaireAssignmentUpsertOne {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *QuestionnaireAssignmentUpsertOne) ClearCompletedAt() *QuestionnaireAssignmentUpsertOne {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionnaireAssignmentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionnaireAssignmentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionnaireAssignmentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *QuestionnaireAssignmentUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: QuestionnaireAssignmentUpsertOne.ID is not supported by MySQL driver. Use QuestionnaireAssignmentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *QuestionnaireAssignmentUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// QuestionnaireAssignmentCreateBulk is the builder for creating many QuestionnaireAssignment entities in bulk.
+type QuestionnaireAssignmentCreateBulk struct {
+	config
+	err      error
+	builders []*QuestionnaireAssignmentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the QuestionnaireAssignment entities in the database.
+func (_c *QuestionnaireAssignmentCreateBulk) Save(ctx context.Context) ([]*QuestionnaireAssignment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*QuestionnaireAssignment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuestionnaireAssignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *QuestionnaireAssignmentCreateBulk) SaveX(ctx context.Context) []*QuestionnaireAssignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuestionnaireAssignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuestionnaireAssignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.QuestionnaireAssignment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionnaireAssignmentUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *QuestionnaireAssignmentCreateBulk) OnConflict(opts ...sql.ConflictOption) *QuestionnaireAssignmentUpsertBulk {
+	_c.conflict = opts
+	return &QuestionnaireAssignmentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.QuestionnaireAssignment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *QuestionnaireAssignmentCreateBulk) OnConflictColumns(columns ...string) *QuestionnaireAssignmentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &QuestionnaireAssignmentUpsertBulk{
+		create: _c,
+	}
+}
+
+// QuestionnaireAssignmentUpsertBulk is the builder for "upsert"-ing
+// a bulk of QuestionnaireAssignment nodes.
+type QuestionnaireAssignmentUpsertBulk struct {
+	create *QuestionnaireAssignmentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.QuestionnaireAssignment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(questionnaireassignment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuestionnaireAssignmentUpsertBulk) UpdateNewValues() *QuestionnaireAssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(questionnaireassignment.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(questionnaireassignment.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.QuestionnaireAssignment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *QuestionnaireAssignmentUpsertBulk) Ignore() *QuestionnaireAssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionnaireAssignmentUpsertBulk) DoNothing() *QuestionnaireAssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionnaireAssignmentCreateBulk.OnConflict
+// documentation for more info.
+func (u *QuestionnaireAssignmentUpsertBulk) Update(set func(*QuestionnaireAssignmentUpsert)) *QuestionnaireAssignmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionnaireAssignmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestionnaireAssignmentUpsertBulk) SetUpdatedAt(v time.Time) *QuestionnaireAssignmentUpsertBulk {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsertBulk) UpdateUpdatedAt() *QuestionnaireAssignmentUpsertBulk {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *QuestionnaireAssignmentUpsertBulk) SetPatientID(v uuid.UUID) *QuestionnaireAssignmentUpsertBulk {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsertBulk) UpdatePatientID() *QuestionnaireAssignmentUpsertBulk {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *QuestionnaireAssignmentUpsertBulk) SetDoctorID(v uuid.UUID) *QuestionnaireAssignmentUpsertBulk {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.SetDoctorID(v)
+	})
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsertBulk) UpdateDoctorID() *QuestionnaireAssignmentUpsertBulk {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.UpdateDoctorID()
+	})
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (u *QuestionnaireAssignmentUpsertBulk) SetDefinitionID(v uuid.UUID) *QuestionnaireAssignmentUpsertBulk {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.SetDefinitionID(v)
+	})
+}
+
+// UpdateDefinitionID sets the "definition_id" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsertBulk) UpdateDefinitionID() *QuestionnaireAssignmentUpsertBulk {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.UpdateDefinitionID()
+	})
+}
+
+// SetDueAt sets the "due_at" field.
+func (u *QuestionnaireAssignmentUpsertBulk) SetDueAt(v time.Time) *QuestionnaireAssignmentUpsertBulk {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.SetDueAt(v)
+	})
+}
+
+// UpdateDueAt sets the "due_at" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsertBulk) UpdateDueAt() *QuestionnaireAssignmentUpsertBulk {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.UpdateDueAt()
+	})
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (u *QuestionnaireAssignmentUpsertBulk) ClearDueAt() *QuestionnaireAssignmentUpsertBulk {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.ClearDueAt()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *QuestionnaireAssignmentUpsertBulk) SetStatus(v questionnaireassignment.Status) *QuestionnaireAssignmentUpsertBulk {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsertBulk) UpdateStatus() *QuestionnaireAssignmentUpsertBulk {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (u *QuestionnaireAssignmentUpsertBulk) SetCompletedAt(v time.Time) *QuestionnaireAssignmentUpsertBulk {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.SetCompletedAt(v)
+	})
+}
+
+// UpdateCompletedAt sets the "completed_at" field to the value that was provided on create.
+func (u *QuestionnaireAssignmentUpsertBulk) UpdateCompletedAt() *QuestionnaireAssignmentUpsertBulk {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.UpdateCompletedAt()
+	})
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (u *QuestionnaireAssignmentUpsertBulk) ClearCompletedAt() *QuestionnaireAssignmentUpsertBulk {
+	return u.Update(func(s *QuestionnaireAssignmentUpsert) {
+		s.ClearCompletedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionnaireAssignmentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the QuestionnaireAssignmentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionnaireAssignmentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionnaireAssignmentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/predicate"
+	"backend/ent/questionnaireassignment"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QuestionnaireAssignmentDelete is the builder for deleting a QuestionnaireAssignment entity.
+type QuestionnaireAssignmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *QuestionnaireAssignmentMutation
+}
+
+// Where appends a list predicates to the QuestionnaireAssignmentDelete builder.
+func (_d *QuestionnaireAssignmentDelete) Where(ps ...predicate.QuestionnaireAssignment) *QuestionnaireAssignmentDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *QuestionnaireAssignmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *QuestionnaireAssignmentDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *QuestionnaireAssignmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(questionnaireassignment.Table, sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// QuestionnaireAssignmentDeleteOne is the builder for deleting a single QuestionnaireAssignment entity.
+type QuestionnaireAssignmentDeleteOne struct {
+	_d *QuestionnaireAssignmentDelete
+}
+
+// Where appends a list predicates to the QuestionnaireAssignmentDelete builder.
+func (_d *QuestionnaireAssignmentDeleteOne) Where(ps ...predicate.QuestionnaireAssignment) *QuestionnaireAssignmentDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *QuestionnaireAssignmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{questionnaireassignment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *QuestionnaireAssignmentDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
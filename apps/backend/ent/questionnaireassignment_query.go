@@ -0,0 +1,828 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/patient"
+	"backend/ent/predicate"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/ent/questionnaireresponse"
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QuestionnaireAssignmentQuery is the builder for querying QuestionnaireAssignment entities.
+type QuestionnaireAssignmentQuery struct {
+	config
+	ctx            *QueryContext
+	order          []questionnaireassignment.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.QuestionnaireAssignment
+	withPatient    *PatientQuery
+	withDoctor     *DoctorQuery
+	withDefinition *QuestionnaireDefinitionQuery
+	withResponse   *QuestionnaireResponseQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QuestionnaireAssignmentQuery builder.
+func (_q *QuestionnaireAssignmentQuery) Where(ps ...predicate.QuestionnaireAssignment) *QuestionnaireAssignmentQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *QuestionnaireAssignmentQuery) Limit(limit int) *QuestionnaireAssignmentQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *QuestionnaireAssignmentQuery) Offset(offset int) *QuestionnaireAssignmentQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *QuestionnaireAssignmentQuery) Unique(unique bool) *QuestionnaireAssignmentQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *QuestionnaireAssignmentQuery) Order(o ...questionnaireassignment.OrderOption) *QuestionnaireAssignmentQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryPatient chains the current query on the "patient" edge.
+func (_q *QuestionnaireAssignmentQuery) QueryPatient() *PatientQuery {
+	query := (&PatientClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnaireassignment.Table, questionnaireassignment.FieldID, selector),
+			sqlgraph.To(patient.Table, patient.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questionnaireassignment.PatientTable, questionnaireassignment.PatientColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDoctor chains the current query on the "doctor" edge.
+func (_q *QuestionnaireAssignmentQuery) QueryDoctor() *DoctorQuery {
+	query := (&DoctorClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnaireassignment.Table, questionnaireassignment.FieldID, selector),
+			sqlgraph.To(doctor.Table, doctor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questionnaireassignment.DoctorTable, questionnaireassignment.DoctorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDefinition chains the current query on the "definition" edge.
+func (_q *QuestionnaireAssignmentQuery) QueryDefinition() *QuestionnaireDefinitionQuery {
+	query := (&QuestionnaireDefinitionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnaireassignment.Table, questionnaireassignment.FieldID, selector),
+			sqlgraph.To(questionnairedefinition.Table, questionnairedefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questionnaireassignment.DefinitionTable, questionnaireassignment.DefinitionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryResponse chains the current query on the "response" edge.
+func (_q *QuestionnaireAssignmentQuery) QueryResponse() *QuestionnaireResponseQuery {
+	query := (&QuestionnaireResponseClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnaireassignment.Table, questionnaireassignment.FieldID, selector),
+			sqlgraph.To(questionnaireresponse.Table, questionnaireresponse.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, questionnaireassignment.ResponseTable, questionnaireassignment.ResponseColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first QuestionnaireAssignment entity from the query.
+// Returns a *NotFoundError when no QuestionnaireAssignment was found.
+func (_q *QuestionnaireAssignmentQuery) First(ctx context.Context) (*QuestionnaireAssignment, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{questionnaireassignment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *QuestionnaireAssignmentQuery) FirstX(ctx context.Context) *QuestionnaireAssignment {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first QuestionnaireAssignment ID from the query.
+// Returns a *NotFoundError when no QuestionnaireAssignment ID was found.
+func (_q *QuestionnaireAssignmentQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{questionnaireassignment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *QuestionnaireAssignmentQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single QuestionnaireAssignment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one QuestionnaireAssignment entity is found.
+// Returns a *NotFoundError when no QuestionnaireAssignment entities are found.
+func (_q *QuestionnaireAssignmentQuery) Only(ctx context.Context) (*QuestionnaireAssignment, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{questionnaireassignment.Label}
+	default:
+		return nil, &NotSingularError{questionnaireassignment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *QuestionnaireAssignmentQuery) OnlyX(ctx context.Context) *QuestionnaireAssignment {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only QuestionnaireAssignment ID in the query.
+// Returns a *NotSingularError when more than one QuestionnaireAssignment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *QuestionnaireAssignmentQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{questionnaireassignment.Label}
+	default:
+		err = &NotSingularError{questionnaireassignment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *QuestionnaireAssignmentQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QuestionnaireAssignments.
+func (_q *QuestionnaireAssignmentQuery) All(ctx context.Context) ([]*QuestionnaireAssignment, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*QuestionnaireAssignment, *QuestionnaireAssignmentQuery]()
+	return withInterceptors[[]*QuestionnaireAssignment](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *QuestionnaireAssignmentQuery) AllX(ctx context.Context) []*QuestionnaireAssignment {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of QuestionnaireAssignment IDs.
+func (_q *QuestionnaireAssignmentQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(questionnaireassignment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *QuestionnaireAssignmentQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *QuestionnaireAssignmentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*QuestionnaireAssignmentQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *QuestionnaireAssignmentQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *QuestionnaireAssignmentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *QuestionnaireAssignmentQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QuestionnaireAssignmentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *QuestionnaireAssignmentQuery) Clone() *QuestionnaireAssignmentQuery {
+	if _q == nil {
+		return nil
+	}
+	return &QuestionnaireAssignmentQuery{
+		config:         _q.config,
+		ctx:            _q.ctx.Clone(),
+		order:          append([]questionnaireassignment.OrderOption{}, _q.order...),
+		inters:         append([]Interceptor{}, _q.inters...),
+		predicates:     append([]predicate.QuestionnaireAssignment{}, _q.predicates...),
+		withPatient:    _q.withPatient.Clone(),
+		withDoctor:     _q.withDoctor.Clone(),
+		withDefinition: _q.withDefinition.Clone(),
+		withResponse:   _q.withResponse.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithPatient tells the query-builder to eager-load the nodes that are connected to
+// the "patient" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionnaireAssignmentQuery) WithPatient(opts ...func(*PatientQuery)) *QuestionnaireAssignmentQuery {
+	query := (&PatientClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPatient = query
+	return _q
+}
+
+// WithDoctor tells the query-builder to eager-load the nodes that are connected to
+// the "doctor" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionnaireAssignmentQuery) WithDoctor(opts ...func(*DoctorQuery)) *QuestionnaireAssignmentQuery {
+	query := (&DoctorClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDoctor = query
+	return _q
+}
+
+// WithDefinition tells the query-builder to eager-load the nodes that are connected to
+// the "definition" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionnaireAssignmentQuery) WithDefinition(opts ...func(*QuestionnaireDefinitionQuery)) *QuestionnaireAssignmentQuery {
+	query := (&QuestionnaireDefinitionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDefinition = query
+	return _q
+}
+
+// WithResponse tells the query-builder to eager-load the nodes that are connected to
+// the "response" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionnaireAssignmentQuery) WithResponse(opts ...func(*QuestionnaireResponseQuery)) *QuestionnaireAssignmentQuery {
+	query := (&QuestionnaireResponseClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withResponse = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.QuestionnaireAssignment.Query().
+//		GroupBy(questionnaireassignment.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *QuestionnaireAssignmentQuery) GroupBy(field string, fields ...string) *QuestionnaireAssignmentGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &QuestionnaireAssignmentGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = questionnaireassignment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.QuestionnaireAssignment.Query().
+//		Select(questionnaireassignment.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (_q *QuestionnaireAssignmentQuery) Select(fields ...string) *QuestionnaireAssignmentSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &QuestionnaireAssignmentSelect{QuestionnaireAssignmentQuery: _q}
+	sbuild.label = questionnaireassignment.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a QuestionnaireAssignmentSelect configured with the given aggregations.
+func (_q *QuestionnaireAssignmentQuery) Aggregate(fns ...AggregateFunc) *QuestionnaireAssignmentSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *QuestionnaireAssignmentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !questionnaireassignment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *QuestionnaireAssignmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*QuestionnaireAssignment, error) {
+	var (
+		nodes       = []*QuestionnaireAssignment{}
+		_spec       = _q.querySpec()
+		loadedTypes = [4]bool{
+			_q.withPatient != nil,
+			_q.withDoctor != nil,
+			_q.withDefinition != nil,
+			_q.withResponse != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*QuestionnaireAssignment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &QuestionnaireAssignment{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withPatient; query != nil {
+		if err := _q.loadPatient(ctx, query, nodes, nil,
+			func(n *QuestionnaireAssignment, e *Patient) { n.Edges.Patient = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDoctor; query != nil {
+		if err := _q.loadDoctor(ctx, query, nodes, nil,
+			func(n *QuestionnaireAssignment, e *Doctor) { n.Edges.Doctor = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDefinition; query != nil {
+		if err := _q.loadDefinition(ctx, query, nodes, nil,
+			func(n *QuestionnaireAssignment, e *QuestionnaireDefinition) { n.Edges.Definition = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withResponse; query != nil {
+		if err := _q.loadResponse(ctx, query, nodes, nil,
+			func(n *QuestionnaireAssignment, e *QuestionnaireResponse) { n.Edges.Response = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *QuestionnaireAssignmentQuery) loadPatient(ctx context.Context, query *PatientQuery, nodes []*QuestionnaireAssignment, init func(*QuestionnaireAssignment), assign func(*QuestionnaireAssignment, *Patient)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*QuestionnaireAssignment)
+	for i := range nodes {
+		fk := nodes[i].PatientID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(patient.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "patient_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *QuestionnaireAssignmentQuery) loadDoctor(ctx context.Context, query *DoctorQuery, nodes []*QuestionnaireAssignment, init func(*QuestionnaireAssignment), assign func(*QuestionnaireAssignment, *Doctor)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*QuestionnaireAssignment)
+	for i := range nodes {
+		fk := nodes[i].DoctorID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(doctor.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "doctor_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *QuestionnaireAssignmentQuery) loadDefinition(ctx context.Context, query *QuestionnaireDefinitionQuery, nodes []*QuestionnaireAssignment, init func(*QuestionnaireAssignment), assign func(*QuestionnaireAssignment, *QuestionnaireDefinition)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*QuestionnaireAssignment)
+	for i := range nodes {
+		fk := nodes[i].DefinitionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(questionnairedefinition.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "definition_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *QuestionnaireAssignmentQuery) loadResponse(ctx context.Context, query *QuestionnaireResponseQuery, nodes []*QuestionnaireAssignment, init func(*QuestionnaireAssignment), assign func(*QuestionnaireAssignment, *QuestionnaireResponse)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*QuestionnaireAssignment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(questionnaireresponse.FieldAssignmentID)
+	}
+	query.Where(predicate.QuestionnaireResponse(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(questionnaireassignment.ResponseColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AssignmentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "assignment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *QuestionnaireAssignmentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *QuestionnaireAssignmentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(questionnaireassignment.Table, questionnaireassignment.Columns, sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, questionnaireassignment.FieldID)
+		for i := range fields {
+			if fields[i] != questionnaireassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withPatient != nil {
+			_spec.Node.AddColumnOnce(questionnaireassignment.FieldPatientID)
+		}
+		if _q.withDoctor != nil {
+			_spec.Node.AddColumnOnce(questionnaireassignment.FieldDoctorID)
+		}
+		if _q.withDefinition != nil {
+			_spec.Node.AddColumnOnce(questionnaireassignment.FieldDefinitionID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *QuestionnaireAssignmentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(questionnaireassignment.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = questionnaireassignment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// QuestionnaireAssignmentGroupBy is the group-by builder for QuestionnaireAssignment entities.
+type QuestionnaireAssignmentGroupBy struct {
+	selector
+	build *QuestionnaireAssignmentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *QuestionnaireAssignmentGroupBy) Aggregate(fns ...AggregateFunc) *QuestionnaireAssignmentGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *QuestionnaireAssignmentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuestionnaireAssignmentQuery, *QuestionnaireAssignmentGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *QuestionnaireAssignmentGroupBy) sqlScan(ctx context.Context, root *QuestionnaireAssignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// QuestionnaireAssignmentSelect is the builder for selecting fields of QuestionnaireAssignment entities.
+type QuestionnaireAssignmentSelect struct {
+	*QuestionnaireAssignmentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *QuestionnaireAssignmentSelect) Aggregate(fns ...AggregateFunc) *QuestionnaireAssignmentSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *QuestionnaireAssignmentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuestionnaireAssignmentQuery, *QuestionnaireAssignmentSelect](ctx, _s.QuestionnaireAssignmentQuery, _s, _s.inters, v)
+}
+
+func (_s *QuestionnaireAssignmentSelect) sqlScan(ctx context.Context, root *QuestionnaireAssignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
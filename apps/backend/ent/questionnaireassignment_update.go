@@ -0,0 +1,831 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/patient"
+	"backend/ent/predicate"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/ent/questionnaireresponse"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QuestionnaireAssignmentUpdate is the builder for updating QuestionnaireAssignment entities.
+type QuestionnaireAssignmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuestionnaireAssignmentMutation
+}
+
+// Where appends a list predicates to the QuestionnaireAssignmentUpdate builder.
+func (_u *QuestionnaireAssignmentUpdate) Where(ps ...predicate.QuestionnaireAssignment) *QuestionnaireAssignmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QuestionnaireAssignmentUpdate) SetUpdatedAt(v time.Time) *QuestionnaireAssignmentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *QuestionnaireAssignmentUpdate) SetPatientID(v uuid.UUID) *QuestionnaireAssignmentUpdate {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *QuestionnaireAssignmentUpdate) SetNillablePatientID(v *uuid.UUID) *QuestionnaireAssignmentUpdate {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (_u *QuestionnaireAssignmentUpdate) SetDoctorID(v uuid.UUID) *QuestionnaireAssignmentUpdate {
+	_u.mutation.SetDoctorID(v)
+	return _u
+}
+
+// SetNillableDoctorID sets the "doctor_id" field if the given value is not nil.
+func (_u *QuestionnaireAssignmentUpdate) SetNillableDoctorID(v *uuid.UUID) *QuestionnaireAssignmentUpdate {
+	if v != nil {
+		_u.SetDoctorID(*v)
+	}
+	return _u
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (_u *QuestionnaireAssignmentUpdate) SetDefinitionID(v uuid.UUID) *QuestionnaireAssignmentUpdate {
+	_u.mutation.SetDefinitionID(v)
+	return _u
+}
+
+// SetNillableDefinitionID sets the "definition_id" field if the given value is not nil.
+func (_u *QuestionnaireAssignmentUpdate) SetNillableDefinitionID(v *uuid.UUID) *QuestionnaireAssignmentUpdate {
+	if v != nil {
+		_u.SetDefinitionID(*v)
+	}
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *QuestionnaireAssignmentUpdate) SetDueAt(v time.Time) *QuestionnaireAssignmentUpdate {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *QuestionnaireAssignmentUpdate) SetNillableDueAt(v *time.Time) *QuestionnaireAssignmentUpdate {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (_u *QuestionnaireAssignmentUpdate) ClearDueAt() *QuestionnaireAssignmentUpdate {
+	_u.mutation.ClearDueAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *QuestionnaireAssignmentUpdate) SetStatus(v questionnaireassignment.Status) *QuestionnaireAssignmentUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *QuestionnaireAssignmentUpdate) SetNillableStatus(v *questionnaireassignment.Status) *QuestionnaireAssignmentUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *QuestionnaireAssignmentUpdate) SetCompletedAt(v time.Time) *QuestionnaireAssignmentUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *QuestionnaireAssignmentUpdate) SetNillableCompletedAt(v *time.Time) *QuestionnaireAssignmentUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *QuestionnaireAssignmentUpdate) ClearCompletedAt() *QuestionnaireAssignmentUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_u *QuestionnaireAssignmentUpdate) SetPatient(v *Patient) *QuestionnaireAssignmentUpdate {
+	return _u.SetPatientID(v.ID)
+}
+
+// SetDoctor sets the "doctor" edge to the Doctor entity.
+func (_u *QuestionnaireAssignmentUpdate) SetDoctor(v *Doctor) *QuestionnaireAssignmentUpdate {
+	return _u.SetDoctorID(v.ID)
+}
+
+// SetDefinition sets the "definition" edge to the QuestionnaireDefinition entity.
+func (_u *QuestionnaireAssignmentUpdate) SetDefinition(v *QuestionnaireDefinition) *QuestionnaireAssignmentUpdate {
+	return _u.SetDefinitionID(v.ID)
+}
+
+// SetResponseID sets the "response" edge to the QuestionnaireResponse entity by ID.
+func (_u *QuestionnaireAssignmentUpdate) SetResponseID(id uuid.UUID) *QuestionnaireAssignmentUpdate {
+	_u.mutation.SetResponseID(id)
+	return _u
+}
+
+// SetNillableResponseID sets the "response" edge to the QuestionnaireResponse entity by ID if the given value is not nil.
+func (_u *QuestionnaireAssignmentUpdate) SetNillableResponseID(id *uuid.UUID) *QuestionnaireAssignmentUpdate {
+	if id != nil {
+		_u = _u.SetResponseID(*id)
+	}
+	return _u
+}
+
+// SetResponse sets the "response" edge to the QuestionnaireResponse entity.
+func (_u *QuestionnaireAssignmentUpdate) SetResponse(v *QuestionnaireResponse) *QuestionnaireAssignmentUpdate {
+	return _u.SetResponseID(v.ID)
+}
+
+// Mutation returns the QuestionnaireAssignmentMutation object of the builder.
+func (_u *QuestionnaireAssignmentUpdate) Mutation() *QuestionnaireAssignmentMutation {
+	return _u.mutation
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (_u *QuestionnaireAssignmentUpdate) ClearPatient() *QuestionnaireAssignmentUpdate {
+	_u.mutation.ClearPatient()
+	return _u
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (_u *QuestionnaireAssignmentUpdate) ClearDoctor() *QuestionnaireAssignmentUpdate {
+	_u.mutation.ClearDoctor()
+	return _u
+}
+
+// ClearDefinition clears the "definition" edge to the QuestionnaireDefinition entity.
+func (_u *QuestionnaireAssignmentUpdate) ClearDefinition() *QuestionnaireAssignmentUpdate {
+	_u.mutation.ClearDefinition()
+	return _u
+}
+
+// ClearResponse clears the "response" edge to the QuestionnaireResponse entity.
+func (_u *QuestionnaireAssignmentUpdate) ClearResponse() *QuestionnaireAssignmentUpdate {
+	_u.mutation.ClearResponse()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *QuestionnaireAssignmentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuestionnaireAssignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *QuestionnaireAssignmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuestionnaireAssignmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *QuestionnaireAssignmentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := questionnaireassignment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuestionnaireAssignmentUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := questionnaireassignment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QuestionnaireAssignment.status": %w`, err)}
+		}
+	}
+	if _u.mutation.PatientCleared() && len(_u.mutation.PatientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuestionnaireAssignment.patient"`)
+	}
+	if _u.mutation.DoctorCleared() && len(_u.mutation.DoctorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuestionnaireAssignment.doctor"`)
+	}
+	if _u.mutation.DefinitionCleared() && len(_u.mutation.DefinitionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuestionnaireAssignment.definition"`)
+	}
+	return nil
+}
+
+func (_u *QuestionnaireAssignmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(questionnaireassignment.Table, questionnaireassignment.Columns, sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionnaireassignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(questionnaireassignment.FieldDueAt, field.TypeTime, value)
+	}
+	if _u.mutation.DueAtCleared() {
+		_spec.ClearField(questionnaireassignment.FieldDueAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(questionnaireassignment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(questionnaireassignment.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(questionnaireassignment.FieldCompletedAt, field.TypeTime)
+	}
+	if _u.mutation.PatientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireassignment.PatientTable,
+			Columns: []string{questionnaireassignment.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireassignment.PatientTable,
+			Columns: []string{questionnaireassignment.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DoctorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireassignment.DoctorTable,
+			Columns: []string{questionnaireassignment.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireassignment.DoctorTable,
+			Columns: []string{questionnaireassignment.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DefinitionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireassignment.DefinitionTable,
+			Columns: []string{questionnaireassignment.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DefinitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireassignment.DefinitionTable,
+			Columns: []string{questionnaireassignment.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ResponseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   questionnaireassignment.ResponseTable,
+			Columns: []string{questionnaireassignment.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ResponseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   questionnaireassignment.ResponseTable,
+			Columns: []string{questionnaireassignment.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{questionnaireassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// QuestionnaireAssignmentUpdateOne is the builder for updating a single QuestionnaireAssignment entity.
+type QuestionnaireAssignmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuestionnaireAssignmentMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QuestionnaireAssignmentUpdateOne) SetUpdatedAt(v time.Time) *QuestionnaireAssignmentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *QuestionnaireAssignmentUpdateOne) SetPatientID(v uuid.UUID) *QuestionnaireAssignmentUpdateOne {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *QuestionnaireAssignmentUpdateOne) SetNillablePatientID(v *uuid.UUID) *QuestionnaireAssignmentUpdateOne {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (_u *QuestionnaireAssignmentUpdateOne) SetDoctorID(v uuid.UUID) *QuestionnaireAssignmentUpdateOne {
+	_u.mutation.SetDoctorID(v)
+	return _u
+}
+
+// SetNillableDoctorID sets the "doctor_id" field if the given value is not nil.
+func (_u *QuestionnaireAssignmentUpdateOne) SetNillableDoctorID(v *uuid.UUID) *QuestionnaireAssignmentUpdateOne {
+	if v != nil {
+		_u.SetDoctorID(*v)
+	}
+	return _u
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (_u *QuestionnaireAssignmentUpdateOne) SetDefinitionID(v uuid.UUID) *QuestionnaireAssignmentUpdateOne {
+	_u.mutation.SetDefinitionID(v)
+	return _u
+}
+
+// SetNillableDefinitionID sets the "definition_id" field if the given value is not nil.
+func (_u *QuestionnaireAssignmentUpdateOne) SetNillableDefinitionID(v *uuid.UUID) *QuestionnaireAssignmentUpdateOne {
+	if v != nil {
+		_u.SetDefinitionID(*v)
+	}
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *QuestionnaireAssignmentUpdateOne) SetDueAt(v time.Time) *QuestionnaireAssignmentUpdateOne {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *QuestionnaireAssignmentUpdateOne) SetNillableDueAt(v *time.Time) *QuestionnaireAssignmentUpdateOne {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (_u *QuestionnaireAssignmentUpdateOne) ClearDueAt() *QuestionnaireAssignmentUpdateOne {
+	_u.mutation.ClearDueAt()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *QuestionnaireAssignmentUpdateOne) SetStatus(v questionnaireassignment.Status) *QuestionnaireAssignmentUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *QuestionnaireAssignmentUpdateOne) SetNillableStatus(v *questionnaireassignment.Status) *QuestionnaireAssignmentUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *QuestionnaireAssignmentUpdateOne) SetCompletedAt(v time.Time) *QuestionnaireAssignmentUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *QuestionnaireAssignmentUpdateOne) SetNillableCompletedAt(v *time.Time) *QuestionnaireAssignmentUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *QuestionnaireAssignmentUpdateOne) ClearCompletedAt() *QuestionnaireAssignmentUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_u *QuestionnaireAssignmentUpdateOne) SetPatient(v *Patient) *QuestionnaireAssignmentUpdateOne {
+	return _u.SetPatientID(v.ID)
+}
+
+// SetDoctor sets the "doctor" edge to the Doctor entity.
+func (_u *QuestionnaireAssignmentUpdateOne) SetDoctor(v *Doctor) *QuestionnaireAssignmentUpdateOne {
+	return _u.SetDoctorID(v.ID)
+}
+
+// SetDefinition sets the "definition" edge to the QuestionnaireDefinition entity.
+func (_u *QuestionnaireAssignmentUpdateOne) SetDefinition(v *QuestionnaireDefinition) *QuestionnaireAssignmentUpdateOne {
+	return _u.SetDefinitionID(v.ID)
+}
+
+// SetResponseID sets the "response" edge to the QuestionnaireResponse entity by ID.
+func (_u *QuestionnaireAssignmentUpdateOne) SetResponseID(id uuid.UUID) *QuestionnaireAssignmentUpdateOne {
+	_u.mutation.SetResponseID(id)
+	return _u
+}
+
+// SetNillableResponseID sets the "response" edge to the QuestionnaireResponse entity by ID if the given value is not nil.
+func (_u *QuestionnaireAssignmentUpdateOne) SetNillableResponseID(id *uuid.UUID) *QuestionnaireAssignmentUpdateOne {
+	if id != nil {
+		_u = _u.SetResponseID(*id)
+	}
+	return _u
+}
+
+// SetResponse sets the "response" edge to the QuestionnaireResponse entity.
+func (_u *QuestionnaireAssignmentUpdateOne) SetResponse(v *QuestionnaireResponse) *QuestionnaireAssignmentUpdateOne {
+	return _u.SetResponseID(v.ID)
+}
+
+// Mutation returns the QuestionnaireAssignmentMutation object of the builder.
+func (_u *QuestionnaireAssignmentUpdateOne) Mutation() *QuestionnaireAssignmentMutation {
+	return _u.mutation
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (_u *QuestionnaireAssignmentUpdateOne) ClearPatient() *QuestionnaireAssignmentUpdateOne {
+	_u.mutation.ClearPatient()
+	return _u
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (_u *QuestionnaireAssignmentUpdateOne) ClearDoctor() *QuestionnaireAssignmentUpdateOne {
+	_u.mutation.ClearDoctor()
+	return _u
+}
+
+// ClearDefinition clears the "definition" edge to the QuestionnaireDefinition entity.
+func (_u *QuestionnaireAssignmentUpdateOne) ClearDefinition() *QuestionnaireAssignmentUpdateOne {
+	_u.mutation.ClearDefinition()
+	return _u
+}
+
+// ClearResponse clears the "response" edge to the QuestionnaireResponse entity.
+func (_u *QuestionnaireAssignmentUpdateOne) ClearResponse() *QuestionnaireAssignmentUpdateOne {
+	_u.mutation.ClearResponse()
+	return _u
+}
+
+// Where appends a list predicates to the QuestionnaireAssignmentUpdate builder.
+func (_u *QuestionnaireAssignmentUpdateOne) Where(ps ...predicate.QuestionnaireAssignment) *QuestionnaireAssignmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *QuestionnaireAssignmentUpdateOne) Select(field string, fields ...string) *QuestionnaireAssignmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated QuestionnaireAssignment entity.
+func (_u *QuestionnaireAssignmentUpdateOne) Save(ctx context.Context) (*QuestionnaireAssignment, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuestionnaireAssignmentUpdateOne) SaveX(ctx context.Context) *QuestionnaireAssignment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *QuestionnaireAssignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuestionnaireAssignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *QuestionnaireAssignmentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := questionnaireassignment.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuestionnaireAssignmentUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := questionnaireassignment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QuestionnaireAssignment.status": %w`, err)}
+		}
+	}
+	if _u.mutation.PatientCleared() && len(_u.mutation.PatientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuestionnaireAssignment.patient"`)
+	}
+	if _u.mutation.DoctorCleared() && len(_u.mutation.DoctorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuestionnaireAssignment.doctor"`)
+	}
+	if _u.mutation.DefinitionCleared() && len(_u.mutation.DefinitionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuestionnaireAssignment.definition"`)
+	}
+	return nil
+}
+
+func (_u *QuestionnaireAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *QuestionnaireAssignment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(questionnaireassignment.Table, questionnaireassignment.Columns, sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QuestionnaireAssignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, questionnaireassignment.FieldID)
+		for _, f := range fields {
+			if !questionnaireassignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != questionnaireassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionnaireassignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(questionnaireassignment.FieldDueAt, field.TypeTime, value)
+	}
+	if _u.mutation.DueAtCleared() {
+		_spec.ClearField(questionnaireassignment.FieldDueAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(questionnaireassignment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(questionnaireassignment.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(questionnaireassignment.FieldCompletedAt, field.TypeTime)
+	}
+	if _u.mutation.PatientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireassignment.PatientTable,
+			Columns: []string{questionnaireassignment.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireassignment.PatientTable,
+			Columns: []string{questionnaireassignment.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DoctorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireassignment.DoctorTable,
+			Columns: []string{questionnaireassignment.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireassignment.DoctorTable,
+			Columns: []string{questionnaireassignment.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DefinitionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireassignment.DefinitionTable,
+			Columns: []string{questionnaireassignment.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DefinitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireassignment.DefinitionTable,
+			Columns: []string{questionnaireassignment.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ResponseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   questionnaireassignment.ResponseTable,
+			Columns: []string{questionnaireassignment.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ResponseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   questionnaireassignment.ResponseTable,
+			Columns: []string{questionnaireassignment.ResponseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &QuestionnaireAssignment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{questionnaireassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/questionnairedefinition"
+	"backend/internal/questionnaire"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// QuestionnaireDefinition is the model entity for the QuestionnaireDefinition schema.
+type QuestionnaireDefinition struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// Definition holds the value of the "definition" field.
+	Definition questionnaire.Definition `json:"definition,omitempty"`
+	// CreatedByDoctorID holds the value of the "created_by_doctor_id" field.
+	CreatedByDoctorID *uuid.UUID `json:"created_by_doctor_id,omitempty"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QuestionnaireDefinitionQuery when eager-loading is set.
+	Edges        QuestionnaireDefinitionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// QuestionnaireDefinitionEdges holds the relations/edges for other nodes in the graph.
+type QuestionnaireDefinitionEdges struct {
+	// CreatedByDoctor holds the value of the created_by_doctor edge.
+	CreatedByDoctor *Doctor `json:"created_by_doctor,omitempty"`
+	// Assignments holds the value of the assignments edge.
+	Assignments []*QuestionnaireAssignment `json:"assignments,omitempty"`
+	// Responses holds the value of the responses edge.
+	Responses []*QuestionnaireResponse `json:"responses,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// CreatedByDoctorOrErr returns the CreatedByDoctor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionnaireDefinitionEdges) CreatedByDoctorOrErr() (*Doctor, error) {
+	if e.CreatedByDoctor != nil {
+		return e.CreatedByDoctor, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: doctor.Label}
+	}
+	return nil, &NotLoadedError{edge: "created_by_doctor"}
+}
+
+// AssignmentsOrErr returns the Assignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuestionnaireDefinitionEdges) AssignmentsOrErr() ([]*QuestionnaireAssignment, error) {
+	if e.loadedTypes[1] {
+		return e.Assignments, nil
+	}
+	return nil, &NotLoadedError{edge: "assignments"}
+}
+
+// ResponsesOrErr returns the Responses value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuestionnaireDefinitionEdges) ResponsesOrErr() ([]*QuestionnaireResponse, error) {
+	if e.loadedTypes[2] {
+		return e.Responses, nil
+	}
+	return nil, &NotLoadedError{edge: "responses"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QuestionnaireDefinition) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case questionnairedefinition.FieldCreatedByDoctorID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case questionnairedefinition.FieldDefinition:
+			values[i] = new([]byte)
+		case questionnairedefinition.FieldActive:
+			values[i] = new(sql.NullBool)
+		case questionnairedefinition.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case questionnairedefinition.FieldKey, questionnairedefinition.FieldTitle, questionnairedefinition.FieldDescription:
+			values[i] = new(sql.NullString)
+		case questionnairedefinition.FieldCreatedAt, questionnairedefinition.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case questionnairedefinition.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QuestionnaireDefinition fields.
+func (_m *QuestionnaireDefinition) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case questionnairedefinition.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case questionnairedefinition.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case questionnairedefinition.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case questionnairedefinition.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				_m.Key = value.String
+			}
+		case questionnairedefinition.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case questionnairedefinition.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case questionnairedefinition.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		case questionnairedefinition.FieldDefinition:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field definition", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Definition); err != nil {
+					return fmt.Errorf("unmarshal field definition: %w", err)
+				}
+			}
+		case questionnairedefinition.FieldCreatedByDoctorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by_doctor_id", values[i])
+			} else if value.Valid {
+				_m.CreatedByDoctorID = new(uuid.UUID)
+				*_m.CreatedByDoctorID = *value.S.(*uuid.UUID)
+			}
+		case questionnairedefinition.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				_m.Active = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QuestionnaireDefinition.
+// This includes values selected through modifiers, order, etc.
+func (_m *QuestionnaireDefinition) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCreatedByDoctor queries the "created_by_doctor" edge of the QuestionnaireDefinition entity.
+func (_m *QuestionnaireDefinition) QueryCreatedByDoctor() *DoctorQuery {
+	return NewQuestionnaireDefinitionClient(_m.config).QueryCreatedByDoctor(_m)
+}
+
+// QueryAssignments queries the "assignments" edge of the QuestionnaireDefinition entity.
+func (_m *QuestionnaireDefinition) QueryAssignments() *QuestionnaireAssignmentQuery {
+	return NewQuestionnaireDefinitionClient(_m.config).QueryAssignments(_m)
+}
+
+// QueryResponses queries the "responses" edge of the QuestionnaireDefinition entity.
+func (_m *QuestionnaireDefinition) QueryResponses() *QuestionnaireResponseQuery {
+	return NewQuestionnaireDefinitionClient(_m.config).QueryResponses(_m)
+}
+
+// Update returns a builder for updating this QuestionnaireDefinition.
+// Note that you need to call QuestionnaireDefinition.Unwrap() before calling this method if this QuestionnaireDefinition
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *QuestionnaireDefinition) Update() *QuestionnaireDefinitionUpdateOne {
+	return NewQuestionnaireDefinitionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the QuestionnaireDefinition entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *QuestionnaireDefinition) Unwrap() *QuestionnaireDefinition {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QuestionnaireDefinition is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *QuestionnaireDefinition) String() string {
+	var builder strings.Builder
+	builder.WriteString("QuestionnaireDefinition(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(_m.Key)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("definition=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Definition))
+	builder.WriteString(", ")
+	if v := _m.CreatedByDoctorID; v != nil {
+		builder.WriteString("created_by_doctor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Active))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuestionnaireDefinitions is a parsable slice of QuestionnaireDefinition.
+type QuestionnaireDefinitions []*QuestionnaireDefinition
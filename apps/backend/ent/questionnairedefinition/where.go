@@ -0,0 +1,546 @@
+// Code generated by ent, DO NOT EDIT.
+
+package questionnairedefinition
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldKey, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldVersion, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldDescription, v))
+}
+
+// CreatedByDoctorID applies equality check predicate on the "created_by_doctor_id" field. It's identical to CreatedByDoctorIDEQ.
+func CreatedByDoctorID(v uuid.UUID) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldCreatedByDoctorID, v))
+}
+
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldActive, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldContainsFold(FieldKey, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldLTE(FieldVersion, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// CreatedByDoctorIDEQ applies the EQ predicate on the "created_by_doctor_id" field.
+func CreatedByDoctorIDEQ(v uuid.UUID) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldCreatedByDoctorID, v))
+}
+
+// CreatedByDoctorIDNEQ applies the NEQ predicate on the "created_by_doctor_id" field.
+func CreatedByDoctorIDNEQ(v uuid.UUID) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNEQ(FieldCreatedByDoctorID, v))
+}
+
+// CreatedByDoctorIDIn applies the In predicate on the "created_by_doctor_id" field.
+func CreatedByDoctorIDIn(vs ...uuid.UUID) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldIn(FieldCreatedByDoctorID, vs...))
+}
+
+// CreatedByDoctorIDNotIn applies the NotIn predicate on the "created_by_doctor_id" field.
+func CreatedByDoctorIDNotIn(vs ...uuid.UUID) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNotIn(FieldCreatedByDoctorID, vs...))
+}
+
+// CreatedByDoctorIDIsNil applies the IsNil predicate on the "created_by_doctor_id" field.
+func CreatedByDoctorIDIsNil() predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldIsNull(FieldCreatedByDoctorID))
+}
+
+// CreatedByDoctorIDNotNil applies the NotNil predicate on the "created_by_doctor_id" field.
+func CreatedByDoctorIDNotNil() predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNotNull(FieldCreatedByDoctorID))
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldEQ(FieldActive, v))
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.FieldNEQ(FieldActive, v))
+}
+
+// HasCreatedByDoctor applies the HasEdge predicate on the "created_by_doctor" edge.
+func HasCreatedByDoctor() predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CreatedByDoctorTable, CreatedByDoctorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreatedByDoctorWith applies the HasEdge predicate on the "created_by_doctor" edge with a given conditions (other predicates).
+func HasCreatedByDoctorWith(preds ...predicate.Doctor) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(func(s *sql.Selector) {
+		step := newCreatedByDoctorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAssignments applies the HasEdge predicate on the "assignments" edge.
+func HasAssignments() predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AssignmentsTable, AssignmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssignmentsWith applies the HasEdge predicate on the "assignments" edge with a given conditions (other predicates).
+func HasAssignmentsWith(preds ...predicate.QuestionnaireAssignment) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(func(s *sql.Selector) {
+		step := newAssignmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasResponses applies the HasEdge predicate on the "responses" edge.
+func HasResponses() predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ResponsesTable, ResponsesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResponsesWith applies the HasEdge predicate on the "responses" edge with a given conditions (other predicates).
+func HasResponsesWith(preds ...predicate.QuestionnaireResponse) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(func(s *sql.Selector) {
+		step := newResponsesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QuestionnaireDefinition) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QuestionnaireDefinition) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QuestionnaireDefinition) predicate.QuestionnaireDefinition {
+	return predicate.QuestionnaireDefinition(sql.NotPredicates(p))
+}
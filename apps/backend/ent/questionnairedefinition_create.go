@@ -0,0 +1,1109 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/ent/questionnaireresponse"
+	"backend/internal/questionnaire"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QuestionnaireDefinitionCreate is the builder for creating a QuestionnaireDefinition entity.
+type QuestionnaireDefinitionCreate struct {
+	config
+	mutation *QuestionnaireDefinitionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *QuestionnaireDefinitionCreate) SetCreatedAt(v time.Time) *QuestionnaireDefinitionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *QuestionnaireDefinitionCreate) SetNillableCreatedAt(v *time.Time) *QuestionnaireDefinitionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *QuestionnaireDefinitionCreate) SetUpdatedAt(v time.Time) *QuestionnaireDefinitionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *QuestionnaireDefinitionCreate) SetNillableUpdatedAt(v *time.Time) *QuestionnaireDefinitionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetKey sets the "key" field.
+func (_c *QuestionnaireDefinitionCreate) SetKey(v string) *QuestionnaireDefinitionCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *QuestionnaireDefinitionCreate) SetVersion(v int) *QuestionnaireDefinitionCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *QuestionnaireDefinitionCreate) SetTitle(v string) *QuestionnaireDefinitionCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *QuestionnaireDefinitionCreate) SetDescription(v string) *QuestionnaireDefinitionCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *QuestionnaireDefinitionCreate) SetNillableDescription(v *string) *QuestionnaireDefinitionCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetDefinition sets the "definition" field.
+func (_c *QuestionnaireDefinitionCreate) SetDefinition(v questionnaire.Definition) *QuestionnaireDefinitionCreate {
+	_c.mutation.SetDefinition(v)
+	return _c
+}
+
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (_c *QuestionnaireDefinitionCreate) SetCreatedByDoctorID(v uuid.UUID) *QuestionnaireDefinitionCreate {
+	_c.mutation.SetCreatedByDoctorID(v)
+	return _c
+}
+
+// SetNillableCreatedByDoctorID sets the "created_by_doctor_id" field if the given value is not nil.
+func (_c *QuestionnaireDefinitionCreate) SetNillableCreatedByDoctorID(v *uuid.UUID) *QuestionnaireDefinitionCreate {
+	if v != nil {
+		_c.SetCreatedByDoctorID(*v)
+	}
+	return _c
+}
+
+// SetActive sets the "active" field.
+func (_c *QuestionnaireDefinitionCreate) SetActive(v bool) *QuestionnaireDefinitionCreate {
+	_c.mutation.SetActive(v)
+	return _c
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_c *QuestionnaireDefinitionCreate) SetNillableActive(v *bool) *QuestionnaireDefinitionCreate {
+	if v != nil {
+		_c.SetActive(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *QuestionnaireDefinitionCreate) SetID(v uuid.UUID) *QuestionnaireDefinitionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *QuestionnaireDefinitionCreate) SetNillableID(v *uuid.UUID) *QuestionnaireDefinitionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetCreatedByDoctor sets the "created_by_doctor" edge to the Doctor entity.
+func (_c *QuestionnaireDefinitionCreate) SetCreatedByDoctor(v *Doctor) *QuestionnaireDefinitionCreate {
+	return _c.SetCreatedByDoctorID(v.ID)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the QuestionnaireAssignment entity by IDs.
+func (_c *QuestionnaireDefinitionCreate) AddAssignmentIDs(ids ...uuid.UUID) *QuestionnaireDefinitionCreate {
+	_c.mutation.AddAssignmentIDs(ids...)
+	return _c
+}
+
+// AddAssignments adds the "assignments" edges to the QuestionnaireAssignment entity.
+func (_c *QuestionnaireDefinitionCreate) AddAssignments(v ...*QuestionnaireAssignment) *QuestionnaireDefinitionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAssignmentIDs(ids...)
+}
+
+// AddResponseIDs adds the "responses" edge to the QuestionnaireResponse entity by IDs.
+func (_c *QuestionnaireDefinitionCreate) AddResponseIDs(ids ...uuid.UUID) *QuestionnaireDefinitionCreate {
+	_c.mutation.AddResponseIDs(ids...)
+	return _c
+}
+
+// AddResponses adds the "responses" edges to the QuestionnaireResponse entity.
+func (_c *QuestionnaireDefinitionCreate) AddResponses(v ...*QuestionnaireResponse) *QuestionnaireDefinitionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddResponseIDs(ids...)
+}
+
+// Mutation returns the QuestionnaireDefinitionMutation object of the builder.
+func (_c *QuestionnaireDefinitionCreate) Mutation() *QuestionnaireDefinitionMutation {
+	return _c.mutation
+}
+
+// Save creates the QuestionnaireDefinition in the database.
+func (_c *QuestionnaireDefinitionCreate) Save(ctx context.Context) (*QuestionnaireDefinition, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *QuestionnaireDefinitionCreate) SaveX(ctx context.Context) *QuestionnaireDefinition {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuestionnaireDefinitionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuestionnaireDefinitionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *QuestionnaireDefinitionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := questionnairedefinition.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := questionnairedefinition.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		v := questionnairedefinition.DefaultActive
+		_c.mutation.SetActive(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := questionnairedefinition.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *QuestionnaireDefinitionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "QuestionnaireDefinition.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "QuestionnaireDefinition.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "QuestionnaireDefinition.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := questionnairedefinition.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "QuestionnaireDefinition.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "QuestionnaireDefinition.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := questionnairedefinition.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "QuestionnaireDefinition.version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "QuestionnaireDefinition.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := questionnairedefinition.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "QuestionnaireDefinition.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Definition(); !ok {
+		return &ValidationError{Name: "definition", err: errors.New(`ent: missing required field "QuestionnaireDefinition.definition"`)}
+	}
+	if v, ok := _c.mutation.Definition(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "definition", err: fmt.Errorf(`ent: validator failed for field "QuestionnaireDefinition.definition": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "QuestionnaireDefinition.active"`)}
+	}
+	return nil
+}
+
+func (_c *QuestionnaireDefinitionCreate) sqlSave(ctx context.Context) (*QuestionnaireDefinition, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *QuestionnaireDefinitionCreate) createSpec() (*QuestionnaireDefinition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QuestionnaireDefinition{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(questionnairedefinition.Table, sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(questionnairedefinition.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionnairedefinition.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(questionnairedefinition.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(questionnairedefinition.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(questionnairedefinition.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(questionnairedefinition.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.Definition(); ok {
+		_spec.SetField(questionnairedefinition.FieldDefinition, field.TypeJSON, value)
+		_node.Definition = value
+	}
+	if value, ok := _c.mutation.Active(); ok {
+		_spec.SetField(questionnairedefinition.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if nodes := _c.mutation.CreatedByDoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnairedefinition.CreatedByDoctorTable,
+			Columns: []string{questionnairedefinition.CreatedByDoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreatedByDoctorID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnairedefinition.AssignmentsTable,
+			Columns: []string{questionnairedefinition.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ResponsesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnairedefinition.ResponsesTable,
+			Columns: []string{questionnairedefinition.ResponsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.QuestionnaireDefinition.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionnaireDefinitionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *QuestionnaireDefinitionCreate) OnConflict(opts ...sql.ConflictOption) *QuestionnaireDefinitionUpsertOne {
+	_c.conflict = opts
+	return &QuestionnaireDefinitionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.QuestionnaireDefinition.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *QuestionnaireDefinitionCreate) OnConflictColumns(columns ...string) *QuestionnaireDefinitionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &QuestionnaireDefinitionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// QuestionnaireDefinitionUpsertOne is the builder for "upsert"-ing
+	//  one QuestionnaireDefinition node.
+	QuestionnaireDefinitionUpsertOne struct {
+		create *QuestionnaireDefinitionCreate
+	}
+
+	// QuestionnaireDefinitionUpsert is the "OnConflict" setter.
+	QuestionnaireDefinitionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestionnaireDefinitionUpsert) SetUpdatedAt(v time.Time) *QuestionnaireDefinitionUpsert {
+	u.Set(questionnairedefinition.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsert) UpdateUpdatedAt() *QuestionnaireDefinitionUpsert {
+	u.SetExcluded(questionnairedefinition.FieldUpdatedAt)
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *QuestionnaireDefinitionUpsert) SetKey(v string) *QuestionnaireDefinitionUpsert {
+	u.Set(questionnairedefinition.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsert) UpdateKey() *QuestionnaireDefinitionUpsert {
+	u.SetExcluded(questionnairedefinition.FieldKey)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *QuestionnaireDefinitionUpsert) SetVersion(v int) *QuestionnaireDefinitionUpsert {
+	u.Set(questionnairedefinition.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsert) UpdateVersion() *QuestionnaireDefinitionUpsert {
+	u.SetExcluded(questionnairedefinition.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *QuestionnaireDefinitionUpsert) AddVersion(v int) *QuestionnaireDefinitionUpsert {
+	u.Add(questionnairedefinition.FieldVersion, v)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *QuestionnaireDefinitionUpsert) SetTitle(v string) *QuestionnaireDefinitionUpsert {
+	u.Set(questionnairedefinition.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsert) UpdateTitle() *QuestionnaireDefinitionUpsert {
+	u.SetExcluded(questionnairedefinition.FieldTitle)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *QuestionnaireDefinitionUpsert) SetDescription(v string) *QuestionnaireDefinitionUpsert {
+	u.Set(questionnairedefinition.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsert) UpdateDescription() *QuestionnaireDefinitionUpsert {
+	u.SetExcluded(questionnairedefinition.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *QuestionnaireDefinitionUpsert) ClearDescription() *QuestionnaireDefinitionUpsert {
+	u.SetNull(questionnairedefinition.FieldDescription)
+	return u
+}
+
+// SetDefinition sets the "definition" field.
+func (u *QuestionnaireDefinitionUpsert) SetDefinition(v questionnaire.Definition) *QuestionnaireDefinitionUpsert {
+	u.Set(questionnairedefinition.FieldDefinition, v)
+	return u
+}
+
+// UpdateDefinition sets the "definition" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsert) UpdateDefinition() *QuestionnaireDefinitionUpsert {
+	u.SetExcluded(questionnairedefinition.FieldDefinition)
+	return u
+}
+
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (u *QuestionnaireDefinitionUpsert) SetCreatedByDoctorID(v uuid.UUID) *QuestionnaireDefinitionUpsert {
+	u.Set(questionnairedefinition.FieldCreatedByDoctorID, v)
+	return u
+}
+
+// UpdateCreatedByDoctorID sets the "created_by_doctor_id" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsert) UpdateCreatedByDoctorID() *QuestionnaireDefinitionUpsert {
+	u.SetExcluded(questionnairedefinition.FieldCreatedByDoctorID)
+	return u
+}
+
+// ClearCreatedByDoctorID clears the value of the "created_by_doctor_id" field.
+func (u *QuestionnaireDefinitionUpsert) ClearCreatedByDoctorID() *QuestionnaireDefinitionUpsert {
+	u.SetNull(questionnairedefinition.FieldCreatedByDoctorID)
+	return u
+}
+
+// SetActive sets the "active" field.
+func (u *QuestionnaireDefinitionUpsert) SetActive(v bool) *QuestionnaireDefinitionUpsert {
+	u.Set(questionnairedefinition.FieldActive, v)
+	return u
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsert) UpdateActive() *QuestionnaireDefinitionUpsert {
+	u.SetExcluded(questionnairedefinition.FieldActive)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.QuestionnaireDefinition.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(questionnairedefinition.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuestionnaireDefinitionUpsertOne) UpdateNewValues() *QuestionnaireDefinitionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(questionnairedefinition.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(questionnairedefinition.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.QuestionnaireDefinition.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *QuestionnaireDefinitionUpsertOne) Ignore() *QuestionnaireDefinitionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionnaireDefinitionUpsertOne) DoNothing() *QuestionnaireDefinitionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionnaireDefinitionCreate.OnConflict
+// documentation for more info.
+func (u *QuestionnaireDefinitionUpsertOne) Update(set func(*QuestionnaireDefinitionUpsert)) *QuestionnaireDefinitionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionnaireDefinitionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestionnaireDefinitionUpsertOne) SetUpdatedAt(v time.Time) *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsertOne) UpdateUpdatedAt() *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *QuestionnaireDefinitionUpsertOne) SetKey(v string) *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsertOne) UpdateKey() *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *QuestionnaireDefinitionUpsertOne) SetVersion(v int) *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *QuestionnaireDefinitionUpsertOne) AddVersion(v int) *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsertOne) UpdateVersion() *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *QuestionnaireDefinitionUpsertOne) SetTitle(v string) *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsertOne) UpdateTitle() *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *QuestionnaireDefinitionUpsertOne) SetDescription(v string) *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsertOne) UpdateDescription() *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *QuestionnaireDefinitionUpsertOne) ClearDescription() *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetDefinition sets the "definition" field.
+func (u *QuestionnaireDefinitionUpsertOne) SetDefinition(v questionnaire.Definition) *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.SetDefinition(v)
+	})
+}
+
+// UpdateDefinition sets the "definition" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsertOne) UpdateDefinition() *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.UpdateDefinition()
+	})
+}
+
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (u *QuestionnaireDefinitionUpsertOne) SetCreatedByDoctorID(v uuid.UUID) *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.SetCreatedByDoctorID(v)
+	})
+}
+
+// UpdateCreatedByDoctorID sets the "created_by_doctor_id" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsertOne) UpdateCreatedByDoctorID() *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.UpdateCreatedByDoctorID()
+	})
+}
+
+// ClearCreatedByDoctorID clears the value of the "created_by_doctor_id" field.
+func (u *QuestionnaireDefinitionUpsertOne) ClearCreatedByDoctorID() *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.ClearCreatedByDoctorID()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *QuestionnaireDefinitionUpsertOne) SetActive(v bool) *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsertOne) UpdateActive() *QuestionnaireDefinitionUpsertOne {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionnaireDefinitionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionnaireDefinitionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionnaireDefinitionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *QuestionnaireDefinitionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: QuestionnaireDefinitionUpsertOne.ID is not supported by MySQL driver. Use QuestionnaireDefinitionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *QuestionnaireDefinitionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// QuestionnaireDefinitionCreateBulk is the builder for creating many QuestionnaireDefinition entities in bulk.
+type QuestionnaireDefinitionCreateBulk struct {
+	config
+	err      error
+	builders []*QuestionnaireDefinitionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the QuestionnaireDefinition entities in the database.
+func (_c *QuestionnaireDefinitionCreateBulk) Save(ctx context.Context) ([]*QuestionnaireDefinition, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*QuestionnaireDefinition, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuestionnaireDefinitionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *QuestionnaireDefinitionCreateBulk) SaveX(ctx context.Context) []*QuestionnaireDefinition {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuestionnaireDefinitionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuestionnaireDefinitionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.QuestionnaireDefinition.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionnaireDefinitionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *QuestionnaireDefinitionCreateBulk) OnConflict(opts ...sql.ConflictOption) *QuestionnaireDefinitionUpsertBulk {
+	_c.conflict = opts
+	return &QuestionnaireDefinitionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.QuestionnaireDefinition.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *QuestionnaireDefinitionCreateBulk) OnConflictColumns(columns ...string) *QuestionnaireDefinitionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &QuestionnaireDefinitionUpsertBulk{
+		create: _c,
+	}
+}
+
+// QuestionnaireDefinitionUpsertBulk is the builder for "upsert"-ing
+// a bulk of QuestionnaireDefinition nodes.
+type QuestionnaireDefinitionUpsertBulk struct {
+	create *QuestionnaireDefinitionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.QuestionnaireDefinition.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(questionnairedefinition.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuestionnaireDefinitionUpsertBulk) UpdateNewValues() *QuestionnaireDefinitionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(questionnairedefinition.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(questionnairedefinition.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.QuestionnaireDefinition.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *QuestionnaireDefinitionUpsertBulk) Ignore() *QuestionnaireDefinitionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionnaireDefinitionUpsertBulk) DoNothing() *QuestionnaireDefinitionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionnaireDefinitionCreateBulk.OnConflict
+// documentation for more info.
+func (u *QuestionnaireDefinitionUpsertBulk) Update(set func(*QuestionnaireDefinitionUpsert)) *QuestionnaireDefinitionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionnaireDefinitionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestionnaireDefinitionUpsertBulk) SetUpdatedAt(v time.Time) *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsertBulk) UpdateUpdatedAt() *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *QuestionnaireDefinitionUpsertBulk) SetKey(v string) *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsertBulk) UpdateKey() *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *QuestionnaireDefinitionUpsertBulk) SetVersion(v int) *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *QuestionnaireDefinitionUpsertBulk) AddVersion(v int) *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsertBulk) UpdateVersion() *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *QuestionnaireDefinitionUpsertBulk) SetTitle(v string) *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsertBulk) UpdateTitle() *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *QuestionnaireDefinitionUpsertBulk) SetDescription(v string) *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsertBulk) UpdateDescription() *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *QuestionnaireDefinitionUpsertBulk) ClearDescription() *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetDefinition sets the "definition" field.
+func (u *QuestionnaireDefinitionUpsertBulk) SetDefinition(v questionnaire.Definition) *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.SetDefinition(v)
+	})
+}
+
+// UpdateDefinition sets the "definition" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsertBulk) UpdateDefinition() *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.UpdateDefinition()
+	})
+}
+
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (u *QuestionnaireDefinitionUpsertBulk) SetCreatedByDoctorID(v uuid.UUID) *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.SetCreatedByDoctorID(v)
+	})
+}
+
+// UpdateCreatedByDoctorID sets the "created_by_doctor_id" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsertBulk) UpdateCreatedByDoctorID() *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.UpdateCreatedByDoctorID()
+	})
+}
+
+// ClearCreatedByDoctorID clears the value of the "created_by_doctor_id" field.
+func (u *QuestionnaireDefinitionUpsertBulk) ClearCreatedByDoctorID() *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.ClearCreatedByDoctorID()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *QuestionnaireDefinitionUpsertBulk) SetActive(v bool) *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *QuestionnaireDefinitionUpsertBulk) UpdateActive() *QuestionnaireDefinitionUpsertBulk {
+	return u.Update(func(s *QuestionnaireDefinitionUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionnaireDefinitionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the QuestionnaireDefinitionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionnaireDefinitionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionnaireDefinitionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
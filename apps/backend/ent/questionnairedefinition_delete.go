@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/predicate"
+	"backend/ent/questionnairedefinition"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// QuestionnaireDefinitionDelete is the builder for deleting a QuestionnaireDefinition entity.
+type QuestionnaireDefinitionDelete struct {
+	config
+	hooks    []Hook
+	mutation *QuestionnaireDefinitionMutation
+}
+
+// Where appends a list predicates to the QuestionnaireDefinitionDelete builder.
+func (_d *QuestionnaireDefinitionDelete) Where(ps ...predicate.QuestionnaireDefinition) *QuestionnaireDefinitionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *QuestionnaireDefinitionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *QuestionnaireDefinitionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *QuestionnaireDefinitionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(questionnairedefinition.Table, sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// QuestionnaireDefinitionDeleteOne is the builder for deleting a single QuestionnaireDefinition entity.
+type QuestionnaireDefinitionDeleteOne struct {
+	_d *QuestionnaireDefinitionDelete
+}
+
+// Where appends a list predicates to the QuestionnaireDefinitionDelete builder.
+func (_d *QuestionnaireDefinitionDeleteOne) Where(ps ...predicate.QuestionnaireDefinition) *QuestionnaireDefinitionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *QuestionnaireDefinitionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{questionnairedefinition.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *QuestionnaireDefinitionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
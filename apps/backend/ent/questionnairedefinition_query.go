@@ -0,0 +1,763 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/predicate"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/ent/questionnaireresponse"
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QuestionnaireDefinitionQuery is the builder for querying QuestionnaireDefinition entities.
+type QuestionnaireDefinitionQuery struct {
+	config
+	ctx                 *QueryContext
+	order               []questionnairedefinition.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.QuestionnaireDefinition
+	withCreatedByDoctor *DoctorQuery
+	withAssignments     *QuestionnaireAssignmentQuery
+	withResponses       *QuestionnaireResponseQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QuestionnaireDefinitionQuery builder.
+func (_q *QuestionnaireDefinitionQuery) Where(ps ...predicate.QuestionnaireDefinition) *QuestionnaireDefinitionQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *QuestionnaireDefinitionQuery) Limit(limit int) *QuestionnaireDefinitionQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *QuestionnaireDefinitionQuery) Offset(offset int) *QuestionnaireDefinitionQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *QuestionnaireDefinitionQuery) Unique(unique bool) *QuestionnaireDefinitionQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *QuestionnaireDefinitionQuery) Order(o ...questionnairedefinition.OrderOption) *QuestionnaireDefinitionQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryCreatedByDoctor chains the current query on the "created_by_doctor" edge.
+func (_q *QuestionnaireDefinitionQuery) QueryCreatedByDoctor() *DoctorQuery {
+	query := (&DoctorClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnairedefinition.Table, questionnairedefinition.FieldID, selector),
+			sqlgraph.To(doctor.Table, doctor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, questionnairedefinition.CreatedByDoctorTable, questionnairedefinition.CreatedByDoctorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAssignments chains the current query on the "assignments" edge.
+func (_q *QuestionnaireDefinitionQuery) QueryAssignments() *QuestionnaireAssignmentQuery {
+	query := (&QuestionnaireAssignmentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnairedefinition.Table, questionnairedefinition.FieldID, selector),
+			sqlgraph.To(questionnaireassignment.Table, questionnaireassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, questionnairedefinition.AssignmentsTable, questionnairedefinition.AssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryResponses chains the current query on the "responses" edge.
+func (_q *QuestionnaireDefinitionQuery) QueryResponses() *QuestionnaireResponseQuery {
+	query := (&QuestionnaireResponseClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(questionnairedefinition.Table, questionnairedefinition.FieldID, selector),
+			sqlgraph.To(questionnaireresponse.Table, questionnaireresponse.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, questionnairedefinition.ResponsesTable, questionnairedefinition.ResponsesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first QuestionnaireDefinition entity from the query.
+// Returns a *NotFoundError when no QuestionnaireDefinition was found.
+func (_q *QuestionnaireDefinitionQuery) First(ctx context.Context) (*QuestionnaireDefinition, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{questionnairedefinition.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *QuestionnaireDefinitionQuery) FirstX(ctx context.Context) *QuestionnaireDefinition {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first QuestionnaireDefinition ID from the query.
+// Returns a *NotFoundError when no QuestionnaireDefinition ID was found.
+func (_q *QuestionnaireDefinitionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{questionnairedefinition.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *QuestionnaireDefinitionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single QuestionnaireDefinition entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one QuestionnaireDefinition entity is found.
+// Returns a *NotFoundError when no QuestionnaireDefinition entities are found.
+func (_q *QuestionnaireDefinitionQuery) Only(ctx context.Context) (*QuestionnaireDefinition, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{questionnairedefinition.Label}
+	default:
+		return nil, &NotSingularError{questionnairedefinition.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *QuestionnaireDefinitionQuery) OnlyX(ctx context.Context) *QuestionnaireDefinition {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only QuestionnaireDefinition ID in the query.
+// Returns a *NotSingularError when more than one QuestionnaireDefinition ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *QuestionnaireDefinitionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{questionnairedefinition.Label}
+	default:
+		err = &NotSingularError{questionnairedefinition.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *QuestionnaireDefinitionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QuestionnaireDefinitions.
+func (_q *QuestionnaireDefinitionQuery) All(ctx context.Context) ([]*QuestionnaireDefinition, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*QuestionnaireDefinition, *QuestionnaireDefinitionQuery]()
+	return withInterceptors[[]*QuestionnaireDefinition](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *QuestionnaireDefinitionQuery) AllX(ctx context.Context) []*QuestionnaireDefinition {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of QuestionnaireDefinition IDs.
+func (_q *QuestionnaireDefinitionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(questionnairedefinition.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *QuestionnaireDefinitionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *QuestionnaireDefinitionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*QuestionnaireDefinitionQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *QuestionnaireDefinitionQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *QuestionnaireDefinitionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *QuestionnaireDefinitionQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QuestionnaireDefinitionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *QuestionnaireDefinitionQuery) Clone() *QuestionnaireDefinitionQuery {
+	if _q == nil {
+		return nil
+	}
+	return &QuestionnaireDefinitionQuery{
+		config:              _q.config,
+		ctx:                 _q.ctx.Clone(),
+		order:               append([]questionnairedefinition.OrderOption{}, _q.order...),
+		inters:              append([]Interceptor{}, _q.inters...),
+		predicates:          append([]predicate.QuestionnaireDefinition{}, _q.predicates...),
+		withCreatedByDoctor: _q.withCreatedByDoctor.Clone(),
+		withAssignments:     _q.withAssignments.Clone(),
+		withResponses:       _q.withResponses.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithCreatedByDoctor tells the query-builder to eager-load the nodes that are connected to
+// the "created_by_doctor" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionnaireDefinitionQuery) WithCreatedByDoctor(opts ...func(*DoctorQuery)) *QuestionnaireDefinitionQuery {
+	query := (&DoctorClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCreatedByDoctor = query
+	return _q
+}
+
+// WithAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionnaireDefinitionQuery) WithAssignments(opts ...func(*QuestionnaireAssignmentQuery)) *QuestionnaireDefinitionQuery {
+	query := (&QuestionnaireAssignmentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAssignments = query
+	return _q
+}
+
+// WithResponses tells the query-builder to eager-load the nodes that are connected to
+// the "responses" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuestionnaireDefinitionQuery) WithResponses(opts ...func(*QuestionnaireResponseQuery)) *QuestionnaireDefinitionQuery {
+	query := (&QuestionnaireResponseClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withResponses = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.QuestionnaireDefinition.Query().
+//		GroupBy(questionnairedefinition.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *QuestionnaireDefinitionQuery) GroupBy(field string, fields ...string) *QuestionnaireDefinitionGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &QuestionnaireDefinitionGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = questionnairedefinition.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.QuestionnaireDefinition.Query().
+//		Select(questionnairedefinition.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (_q *QuestionnaireDefinitionQuery) Select(fields ...string) *QuestionnaireDefinitionSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &QuestionnaireDefinitionSelect{QuestionnaireDefinitionQuery: _q}
+	sbuild.label = questionnairedefinition.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a QuestionnaireDefinitionSelect configured with the given aggregations.
+func (_q *QuestionnaireDefinitionQuery) Aggregate(fns ...AggregateFunc) *QuestionnaireDefinitionSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *QuestionnaireDefinitionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !questionnairedefinition.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *QuestionnaireDefinitionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*QuestionnaireDefinition, error) {
+	var (
+		nodes       = []*QuestionnaireDefinition{}
+		_spec       = _q.querySpec()
+		loadedTypes = [3]bool{
+			_q.withCreatedByDoctor != nil,
+			_q.withAssignments != nil,
+			_q.withResponses != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*QuestionnaireDefinition).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &QuestionnaireDefinition{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withCreatedByDoctor; query != nil {
+		if err := _q.loadCreatedByDoctor(ctx, query, nodes, nil,
+			func(n *QuestionnaireDefinition, e *Doctor) { n.Edges.CreatedByDoctor = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAssignments; query != nil {
+		if err := _q.loadAssignments(ctx, query, nodes,
+			func(n *QuestionnaireDefinition) { n.Edges.Assignments = []*QuestionnaireAssignment{} },
+			func(n *QuestionnaireDefinition, e *QuestionnaireAssignment) {
+				n.Edges.Assignments = append(n.Edges.Assignments, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withResponses; query != nil {
+		if err := _q.loadResponses(ctx, query, nodes,
+			func(n *QuestionnaireDefinition) { n.Edges.Responses = []*QuestionnaireResponse{} },
+			func(n *QuestionnaireDefinition, e *QuestionnaireResponse) {
+				n.Edges.Responses = append(n.Edges.Responses, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *QuestionnaireDefinitionQuery) loadCreatedByDoctor(ctx context.Context, query *DoctorQuery, nodes []*QuestionnaireDefinition, init func(*QuestionnaireDefinition), assign func(*QuestionnaireDefinition, *Doctor)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*QuestionnaireDefinition)
+	for i := range nodes {
+		if nodes[i].CreatedByDoctorID == nil {
+			continue
+		}
+		fk := *nodes[i].CreatedByDoctorID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(doctor.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "created_by_doctor_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *QuestionnaireDefinitionQuery) loadAssignments(ctx context.Context, query *QuestionnaireAssignmentQuery, nodes []*QuestionnaireDefinition, init func(*QuestionnaireDefinition), assign func(*QuestionnaireDefinition, *QuestionnaireAssignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*QuestionnaireDefinition)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(questionnaireassignment.FieldDefinitionID)
+	}
+	query.Where(predicate.QuestionnaireAssignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(questionnairedefinition.AssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DefinitionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "definition_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *QuestionnaireDefinitionQuery) loadResponses(ctx context.Context, query *QuestionnaireResponseQuery, nodes []*QuestionnaireDefinition, init func(*QuestionnaireDefinition), assign func(*QuestionnaireDefinition, *QuestionnaireResponse)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*QuestionnaireDefinition)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(questionnaireresponse.FieldDefinitionID)
+	}
+	query.Where(predicate.QuestionnaireResponse(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(questionnairedefinition.ResponsesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DefinitionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "definition_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *QuestionnaireDefinitionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *QuestionnaireDefinitionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(questionnairedefinition.Table, questionnairedefinition.Columns, sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, questionnairedefinition.FieldID)
+		for i := range fields {
+			if fields[i] != questionnairedefinition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withCreatedByDoctor != nil {
+			_spec.Node.AddColumnOnce(questionnairedefinition.FieldCreatedByDoctorID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *QuestionnaireDefinitionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(questionnairedefinition.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = questionnairedefinition.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// QuestionnaireDefinitionGroupBy is the group-by builder for QuestionnaireDefinition entities.
+type QuestionnaireDefinitionGroupBy struct {
+	selector
+	build *QuestionnaireDefinitionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *QuestionnaireDefinitionGroupBy) Aggregate(fns ...AggregateFunc) *QuestionnaireDefinitionGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *QuestionnaireDefinitionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuestionnaireDefinitionQuery, *QuestionnaireDefinitionGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *QuestionnaireDefinitionGroupBy) sqlScan(ctx context.Context, root *QuestionnaireDefinitionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// QuestionnaireDefinitionSelect is the builder for selecting fields of QuestionnaireDefinition entities.
+type QuestionnaireDefinitionSelect struct {
+	*QuestionnaireDefinitionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *QuestionnaireDefinitionSelect) Aggregate(fns ...AggregateFunc) *QuestionnaireDefinitionSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *QuestionnaireDefinitionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuestionnaireDefinitionQuery, *QuestionnaireDefinitionSelect](ctx, _s.QuestionnaireDefinitionQuery, _s, _s.inters, v)
+}
+
+func (_s *QuestionnaireDefinitionSelect) sqlScan(ctx context.Context, root *QuestionnaireDefinitionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,959 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/predicate"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/ent/questionnaireresponse"
+	"backend/internal/questionnaire"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QuestionnaireDefinitionUpdate is the builder for updating QuestionnaireDefinition entities.
+type QuestionnaireDefinitionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuestionnaireDefinitionMutation
+}
+
+// Where appends a list predicates to the QuestionnaireDefinitionUpdate builder.
+func (_u *QuestionnaireDefinitionUpdate) Where(ps ...predicate.QuestionnaireDefinition) *QuestionnaireDefinitionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QuestionnaireDefinitionUpdate) SetUpdatedAt(v time.Time) *QuestionnaireDefinitionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *QuestionnaireDefinitionUpdate) SetKey(v string) *QuestionnaireDefinitionUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *QuestionnaireDefinitionUpdate) SetNillableKey(v *string) *QuestionnaireDefinitionUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *QuestionnaireDefinitionUpdate) SetVersion(v int) *QuestionnaireDefinitionUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *QuestionnaireDefinitionUpdate) SetNillableVersion(v *int) *QuestionnaireDefinitionUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *QuestionnaireDefinitionUpdate) AddVersion(v int) *QuestionnaireDefinitionUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *QuestionnaireDefinitionUpdate) SetTitle(v string) *QuestionnaireDefinitionUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *QuestionnaireDefinitionUpdate) SetNillableTitle(v *string) *QuestionnaireDefinitionUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *QuestionnaireDefinitionUpdate) SetDescription(v string) *QuestionnaireDefinitionUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *QuestionnaireDefinitionUpdate) SetNillableDescription(v *string) *QuestionnaireDefinitionUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *QuestionnaireDefinitionUpdate) ClearDescription() *QuestionnaireDefinitionUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetDefinition sets the "definition" field.
+func (_u *QuestionnaireDefinitionUpdate) SetDefinition(v questionnaire.Definition) *QuestionnaireDefinitionUpdate {
+	_u.mutation.SetDefinition(v)
+	return _u
+}
+
+// SetNillableDefinition sets the "definition" field if the given value is not nil.
+func (_u *QuestionnaireDefinitionUpdate) SetNillableDefinition(v *questionnaire.Definition) *QuestionnaireDefinitionUpdate {
+	if v != nil {
+		_u.SetDefinition(*v)
+	}
+	return _u
+}
+
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (_u *QuestionnaireDefinitionUpdate) SetCreatedByDoctorID(v uuid.UUID) *QuestionnaireDefinitionUpdate {
+	_u.mutation.SetCreatedByDoctorID(v)
+	return _u
+}
+
+// SetNillableCreatedByDoctorID sets the "created_by_doctor_id" field if the given value is not nil.
+func (_u *QuestionnaireDefinitionUpdate) SetNillableCreatedByDoctorID(v *uuid.UUID) *QuestionnaireDefinitionUpdate {
+	if v != nil {
+		_u.SetCreatedByDoctorID(*v)
+	}
+	return _u
+}
+
+// ClearCreatedByDoctorID clears the value of the "created_by_doctor_id" field.
+func (_u *QuestionnaireDefinitionUpdate) ClearCreatedByDoctorID() *QuestionnaireDefinitionUpdate {
+	_u.mutation.ClearCreatedByDoctorID()
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *QuestionnaireDefinitionUpdate) SetActive(v bool) *QuestionnaireDefinitionUpdate {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *QuestionnaireDefinitionUpdate) SetNillableActive(v *bool) *QuestionnaireDefinitionUpdate {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetCreatedByDoctor sets the "created_by_doctor" edge to the Doctor entity.
+func (_u *QuestionnaireDefinitionUpdate) SetCreatedByDoctor(v *Doctor) *QuestionnaireDefinitionUpdate {
+	return _u.SetCreatedByDoctorID(v.ID)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the QuestionnaireAssignment entity by IDs.
+func (_u *QuestionnaireDefinitionUpdate) AddAssignmentIDs(ids ...uuid.UUID) *QuestionnaireDefinitionUpdate {
+	_u.mutation.AddAssignmentIDs(ids...)
+	return _u
+}
+
+// AddAssignments adds the "assignments" edges to the QuestionnaireAssignment entity.
+func (_u *QuestionnaireDefinitionUpdate) AddAssignments(v ...*QuestionnaireAssignment) *QuestionnaireDefinitionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssignmentIDs(ids...)
+}
+
+// AddResponseIDs adds the "responses" edge to the QuestionnaireResponse entity by IDs.
+func (_u *QuestionnaireDefinitionUpdate) AddResponseIDs(ids ...uuid.UUID) *QuestionnaireDefinitionUpdate {
+	_u.mutation.AddResponseIDs(ids...)
+	return _u
+}
+
+// AddResponses adds the "responses" edges to the QuestionnaireResponse entity.
+func (_u *QuestionnaireDefinitionUpdate) AddResponses(v ...*QuestionnaireResponse) *QuestionnaireDefinitionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddResponseIDs(ids...)
+}
+
+// Mutation returns the QuestionnaireDefinitionMutation object of the builder.
+func (_u *QuestionnaireDefinitionUpdate) Mutation() *QuestionnaireDefinitionMutation {
+	return _u.mutation
+}
+
+// ClearCreatedByDoctor clears the "created_by_doctor" edge to the Doctor entity.
+func (_u *QuestionnaireDefinitionUpdate) ClearCreatedByDoctor() *QuestionnaireDefinitionUpdate {
+	_u.mutation.ClearCreatedByDoctor()
+	return _u
+}
+
+// ClearAssignments clears all "assignments" edges to the QuestionnaireAssignment entity.
+func (_u *QuestionnaireDefinitionUpdate) ClearAssignments() *QuestionnaireDefinitionUpdate {
+	_u.mutation.ClearAssignments()
+	return _u
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to QuestionnaireAssignment entities by IDs.
+func (_u *QuestionnaireDefinitionUpdate) RemoveAssignmentIDs(ids ...uuid.UUID) *QuestionnaireDefinitionUpdate {
+	_u.mutation.RemoveAssignmentIDs(ids...)
+	return _u
+}
+
+// RemoveAssignments removes "assignments" edges to QuestionnaireAssignment entities.
+func (_u *QuestionnaireDefinitionUpdate) RemoveAssignments(v ...*QuestionnaireAssignment) *QuestionnaireDefinitionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssignmentIDs(ids...)
+}
+
+// ClearResponses clears all "responses" edges to the QuestionnaireResponse entity.
+func (_u *QuestionnaireDefinitionUpdate) ClearResponses() *QuestionnaireDefinitionUpdate {
+	_u.mutation.ClearResponses()
+	return _u
+}
+
+// RemoveResponseIDs removes the "responses" edge to QuestionnaireResponse entities by IDs.
+func (_u *QuestionnaireDefinitionUpdate) RemoveResponseIDs(ids ...uuid.UUID) *QuestionnaireDefinitionUpdate {
+	_u.mutation.RemoveResponseIDs(ids...)
+	return _u
+}
+
+// RemoveResponses removes "responses" edges to QuestionnaireResponse entities.
+func (_u *QuestionnaireDefinitionUpdate) RemoveResponses(v ...*QuestionnaireResponse) *QuestionnaireDefinitionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveResponseIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *QuestionnaireDefinitionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuestionnaireDefinitionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *QuestionnaireDefinitionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuestionnaireDefinitionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *QuestionnaireDefinitionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := questionnairedefinition.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuestionnaireDefinitionUpdate) check() error {
+	if v, ok := _u.mutation.Key(); ok {
+		if err := questionnairedefinition.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "QuestionnaireDefinition.key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := questionnairedefinition.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "QuestionnaireDefinition.version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := questionnairedefinition.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "QuestionnaireDefinition.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Definition(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "definition", err: fmt.Errorf(`ent: validator failed for field "QuestionnaireDefinition.definition": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *QuestionnaireDefinitionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(questionnairedefinition.Table, questionnairedefinition.Columns, sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionnairedefinition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(questionnairedefinition.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(questionnairedefinition.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(questionnairedefinition.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(questionnairedefinition.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(questionnairedefinition.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(questionnairedefinition.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Definition(); ok {
+		_spec.SetField(questionnairedefinition.FieldDefinition, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(questionnairedefinition.FieldActive, field.TypeBool, value)
+	}
+	if _u.mutation.CreatedByDoctorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnairedefinition.CreatedByDoctorTable,
+			Columns: []string{questionnairedefinition.CreatedByDoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatedByDoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnairedefinition.CreatedByDoctorTable,
+			Columns: []string{questionnairedefinition.CreatedByDoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnairedefinition.AssignmentsTable,
+			Columns: []string{questionnairedefinition.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnairedefinition.AssignmentsTable,
+			Columns: []string{questionnairedefinition.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnairedefinition.AssignmentsTable,
+			Columns: []string{questionnairedefinition.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ResponsesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnairedefinition.ResponsesTable,
+			Columns: []string{questionnairedefinition.ResponsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedResponsesIDs(); len(nodes) > 0 && !_u.mutation.ResponsesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnairedefinition.ResponsesTable,
+			Columns: []string{questionnairedefinition.ResponsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ResponsesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnairedefinition.ResponsesTable,
+			Columns: []string{questionnairedefinition.ResponsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{questionnairedefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// QuestionnaireDefinitionUpdateOne is the builder for updating a single QuestionnaireDefinition entity.
+type QuestionnaireDefinitionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuestionnaireDefinitionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QuestionnaireDefinitionUpdateOne) SetUpdatedAt(v time.Time) *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *QuestionnaireDefinitionUpdateOne) SetKey(v string) *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *QuestionnaireDefinitionUpdateOne) SetNillableKey(v *string) *QuestionnaireDefinitionUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *QuestionnaireDefinitionUpdateOne) SetVersion(v int) *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *QuestionnaireDefinitionUpdateOne) SetNillableVersion(v *int) *QuestionnaireDefinitionUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *QuestionnaireDefinitionUpdateOne) AddVersion(v int) *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *QuestionnaireDefinitionUpdateOne) SetTitle(v string) *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *QuestionnaireDefinitionUpdateOne) SetNillableTitle(v *string) *QuestionnaireDefinitionUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *QuestionnaireDefinitionUpdateOne) SetDescription(v string) *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *QuestionnaireDefinitionUpdateOne) SetNillableDescription(v *string) *QuestionnaireDefinitionUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *QuestionnaireDefinitionUpdateOne) ClearDescription() *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetDefinition sets the "definition" field.
+func (_u *QuestionnaireDefinitionUpdateOne) SetDefinition(v questionnaire.Definition) *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.SetDefinition(v)
+	return _u
+}
+
+// SetNillableDefinition sets the "definition" field if the given value is not nil.
+func (_u *QuestionnaireDefinitionUpdateOne) SetNillableDefinition(v *questionnaire.Definition) *QuestionnaireDefinitionUpdateOne {
+	if v != nil {
+		_u.SetDefinition(*v)
+	}
+	return _u
+}
+
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (_u *QuestionnaireDefinitionUpdateOne) SetCreatedByDoctorID(v uuid.UUID) *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.SetCreatedByDoctorID(v)
+	return _u
+}
+
+// SetNillableCreatedByDoctorID sets the "created_by_doctor_id" field if the given value is not nil.
+func (_u *QuestionnaireDefinitionUpdateOne) SetNillableCreatedByDoctorID(v *uuid.UUID) *QuestionnaireDefinitionUpdateOne {
+	if v != nil {
+		_u.SetCreatedByDoctorID(*v)
+	}
+	return _u
+}
+
+// ClearCreatedByDoctorID clears the value of the "created_by_doctor_id" field.
+func (_u *QuestionnaireDefinitionUpdateOne) ClearCreatedByDoctorID() *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.ClearCreatedByDoctorID()
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *QuestionnaireDefinitionUpdateOne) SetActive(v bool) *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *QuestionnaireDefinitionUpdateOne) SetNillableActive(v *bool) *QuestionnaireDefinitionUpdateOne {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetCreatedByDoctor sets the "created_by_doctor" edge to the Doctor entity.
+func (_u *QuestionnaireDefinitionUpdateOne) SetCreatedByDoctor(v *Doctor) *QuestionnaireDefinitionUpdateOne {
+	return _u.SetCreatedByDoctorID(v.ID)
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the QuestionnaireAssignment entity by IDs.
+func (_u *QuestionnaireDefinitionUpdateOne) AddAssignmentIDs(ids ...uuid.UUID) *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.AddAssignmentIDs(ids...)
+	return _u
+}
+
+// AddAssignments adds the "assignments" edges to the QuestionnaireAssignment entity.
+func (_u *QuestionnaireDefinitionUpdateOne) AddAssignments(v ...*QuestionnaireAssignment) *QuestionnaireDefinitionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssignmentIDs(ids...)
+}
+
+// AddResponseIDs adds the "responses" edge to the QuestionnaireResponse entity by IDs.
+func (_u *QuestionnaireDefinitionUpdateOne) AddResponseIDs(ids ...uuid.UUID) *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.AddResponseIDs(ids...)
+	return _u
+}
+
+// AddResponses adds the "responses" edges to the QuestionnaireResponse entity.
+func (_u *QuestionnaireDefinitionUpdateOne) AddResponses(v ...*QuestionnaireResponse) *QuestionnaireDefinitionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddResponseIDs(ids...)
+}
+
+// Mutation returns the QuestionnaireDefinitionMutation object of the builder.
+func (_u *QuestionnaireDefinitionUpdateOne) Mutation() *QuestionnaireDefinitionMutation {
+	return _u.mutation
+}
+
+// ClearCreatedByDoctor clears the "created_by_doctor" edge to the Doctor entity.
+func (_u *QuestionnaireDefinitionUpdateOne) ClearCreatedByDoctor() *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.ClearCreatedByDoctor()
+	return _u
+}
+
+// ClearAssignments clears all "assignments" edges to the QuestionnaireAssignment entity.
+func (_u *QuestionnaireDefinitionUpdateOne) ClearAssignments() *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.ClearAssignments()
+	return _u
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to QuestionnaireAssignment entities by IDs.
+func (_u *QuestionnaireDefinitionUpdateOne) RemoveAssignmentIDs(ids ...uuid.UUID) *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.RemoveAssignmentIDs(ids...)
+	return _u
+}
+
+// RemoveAssignments removes "assignments" edges to QuestionnaireAssignment entities.
+func (_u *QuestionnaireDefinitionUpdateOne) RemoveAssignments(v ...*QuestionnaireAssignment) *QuestionnaireDefinitionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssignmentIDs(ids...)
+}
+
+// ClearResponses clears all "responses" edges to the QuestionnaireResponse entity.
+func (_u *QuestionnaireDefinitionUpdateOne) ClearResponses() *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.ClearResponses()
+	return _u
+}
+
+// RemoveResponseIDs removes the "responses" edge to QuestionnaireResponse entities by IDs.
+func (_u *QuestionnaireDefinitionUpdateOne) RemoveResponseIDs(ids ...uuid.UUID) *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.RemoveResponseIDs(ids...)
+	return _u
+}
+
+// RemoveResponses removes "responses" edges to QuestionnaireResponse entities.
+func (_u *QuestionnaireDefinitionUpdateOne) RemoveResponses(v ...*QuestionnaireResponse) *QuestionnaireDefinitionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveResponseIDs(ids...)
+}
+
+// Where appends a list predicates to the QuestionnaireDefinitionUpdate builder.
+func (_u *QuestionnaireDefinitionUpdateOne) Where(ps ...predicate.QuestionnaireDefinition) *QuestionnaireDefinitionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *QuestionnaireDefinitionUpdateOne) Select(field string, fields ...string) *QuestionnaireDefinitionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated QuestionnaireDefinition entity.
+func (_u *QuestionnaireDefinitionUpdateOne) Save(ctx context.Context) (*QuestionnaireDefinition, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuestionnaireDefinitionUpdateOne) SaveX(ctx context.Context) *QuestionnaireDefinition {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *QuestionnaireDefinitionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuestionnaireDefinitionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *QuestionnaireDefinitionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := questionnairedefinition.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuestionnaireDefinitionUpdateOne) check() error {
+	if v, ok := _u.mutation.Key(); ok {
+		if err := questionnairedefinition.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "QuestionnaireDefinition.key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := questionnairedefinition.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "QuestionnaireDefinition.version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := questionnairedefinition.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "QuestionnaireDefinition.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Definition(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "definition", err: fmt.Errorf(`ent: validator failed for field "QuestionnaireDefinition.definition": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *QuestionnaireDefinitionUpdateOne) sqlSave(ctx context.Context) (_node *QuestionnaireDefinition, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(questionnairedefinition.Table, questionnairedefinition.Columns, sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QuestionnaireDefinition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, questionnairedefinition.FieldID)
+		for _, f := range fields {
+			if !questionnairedefinition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != questionnairedefinition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionnairedefinition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(questionnairedefinition.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(questionnairedefinition.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(questionnairedefinition.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(questionnairedefinition.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(questionnairedefinition.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(questionnairedefinition.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Definition(); ok {
+		_spec.SetField(questionnairedefinition.FieldDefinition, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(questionnairedefinition.FieldActive, field.TypeBool, value)
+	}
+	if _u.mutation.CreatedByDoctorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnairedefinition.CreatedByDoctorTable,
+			Columns: []string{questionnairedefinition.CreatedByDoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatedByDoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnairedefinition.CreatedByDoctorTable,
+			Columns: []string{questionnairedefinition.CreatedByDoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnairedefinition.AssignmentsTable,
+			Columns: []string{questionnairedefinition.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnairedefinition.AssignmentsTable,
+			Columns: []string{questionnairedefinition.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnairedefinition.AssignmentsTable,
+			Columns: []string{questionnairedefinition.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ResponsesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnairedefinition.ResponsesTable,
+			Columns: []string{questionnairedefinition.ResponsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedResponsesIDs(); len(nodes) > 0 && !_u.mutation.ResponsesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnairedefinition.ResponsesTable,
+			Columns: []string{questionnairedefinition.ResponsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ResponsesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   questionnairedefinition.ResponsesTable,
+			Columns: []string{questionnairedefinition.ResponsesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &QuestionnaireDefinition{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{questionnairedefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/patient"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/ent/questionnaireresponse"
+	"backend/internal/questionnaire"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// QuestionnaireResponse is the model entity for the QuestionnaireResponse schema.
+type QuestionnaireResponse struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// PatientID holds the value of the "patient_id" field.
+	PatientID uuid.UUID `json:"patient_id,omitempty"`
+	// DefinitionID holds the value of the "definition_id" field.
+	DefinitionID uuid.UUID `json:"definition_id,omitempty"`
+	// AssignmentID holds the value of the "assignment_id" field.
+	AssignmentID uuid.UUID `json:"assignment_id,omitempty"`
+	// Answers holds the value of the "answers" field.
+	Answers map[string]string `json:"answers,omitempty"`
+	// Scores holds the value of the "scores" field.
+	Scores []questionnaire.ScaleScore `json:"scores,omitempty"`
+	// SubmittedAt holds the value of the "submitted_at" field.
+	SubmittedAt time.Time `json:"submitted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QuestionnaireResponseQuery when eager-loading is set.
+	Edges        QuestionnaireResponseEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// QuestionnaireResponseEdges holds the relations/edges for other nodes in the graph.
+type QuestionnaireResponseEdges struct {
+	// Patient holds the value of the patient edge.
+	Patient *Patient `json:"patient,omitempty"`
+	// Definition holds the value of the definition edge.
+	Definition *QuestionnaireDefinition `json:"definition,omitempty"`
+	// Assignment holds the value of the assignment edge.
+	Assignment *QuestionnaireAssignment `json:"assignment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// PatientOrErr returns the Patient value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionnaireResponseEdges) PatientOrErr() (*Patient, error) {
+	if e.Patient != nil {
+		return e.Patient, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: patient.Label}
+	}
+	return nil, &NotLoadedError{edge: "patient"}
+}
+
+// DefinitionOrErr returns the Definition value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionnaireResponseEdges) DefinitionOrErr() (*QuestionnaireDefinition, error) {
+	if e.Definition != nil {
+		return e.Definition, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: questionnairedefinition.Label}
+	}
+	return nil, &NotLoadedError{edge: "definition"}
+}
+
+// AssignmentOrErr returns the Assignment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuestionnaireResponseEdges) AssignmentOrErr() (*QuestionnaireAssignment, error) {
+	if e.Assignment != nil {
+		return e.Assignment, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: questionnaireassignment.Label}
+	}
+	return nil, &NotLoadedError{edge: "assignment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QuestionnaireResponse) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case questionnaireresponse.FieldAnswers, questionnaireresponse.FieldScores:
+			values[i] = new([]byte)
+		case questionnaireresponse.FieldCreatedAt, questionnaireresponse.FieldUpdatedAt, questionnaireresponse.FieldSubmittedAt:
+			values[i] = new(sql.NullTime)
+		case questionnaireresponse.FieldID, questionnaireresponse.FieldPatientID, questionnaireresponse.FieldDefinitionID, questionnaireresponse.FieldAssignmentID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QuestionnaireResponse fields.
+func (_m *QuestionnaireResponse) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case questionnaireresponse.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case questionnaireresponse.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case questionnaireresponse.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case questionnaireresponse.FieldPatientID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field patient_id", values[i])
+			} else if value != nil {
+				_m.PatientID = *value
+			}
+		case questionnaireresponse.FieldDefinitionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field definition_id", values[i])
+			} else if value != nil {
+				_m.DefinitionID = *value
+			}
+		case questionnaireresponse.FieldAssignmentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field assignment_id", values[i])
+			} else if value != nil {
+				_m.AssignmentID = *value
+			}
+		case questionnaireresponse.FieldAnswers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field answers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Answers); err != nil {
+					return fmt.Errorf("unmarshal field answers: %w", err)
+				}
+			}
+		case questionnaireresponse.FieldScores:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scores", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Scores); err != nil {
+					return fmt.Errorf("unmarshal field scores: %w", err)
+				}
+			}
+		case questionnaireresponse.FieldSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_at", values[i])
+			} else if value.Valid {
+				_m.SubmittedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QuestionnaireResponse.
+// This includes values selected through modifiers, order, etc.
+func (_m *QuestionnaireResponse) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPatient queries the "patient" edge of the QuestionnaireResponse entity.
+func (_m *QuestionnaireResponse) QueryPatient() *PatientQuery {
+	return NewQuestionnaireResponseClient(_m.config).QueryPatient(_m)
+}
+
+// QueryDefinition queries the "definition" edge of the QuestionnaireResponse entity.
+func (_m *QuestionnaireResponse) QueryDefinition() *QuestionnaireDefinitionQuery {
+	return NewQuestionnaireResponseClient(_m.config).QueryDefinition(_m)
+}
+
+// QueryAssignment queries the "assignment" edge of the QuestionnaireResponse entity.
+func (_m *QuestionnaireResponse) QueryAssignment() *QuestionnaireAssignmentQuery {
+	return NewQuestionnaireResponseClient(_m.config).QueryAssignment(_m)
+}
+
+// Update returns a builder for updating this QuestionnaireResponse.
+// Note that you need to call QuestionnaireResponse.Unwrap() before calling this method if this QuestionnaireResponse
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *QuestionnaireResponse) Update() *QuestionnaireResponseUpdateOne {
+	return NewQuestionnaireResponseClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the QuestionnaireResponse entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *QuestionnaireResponse) Unwrap() *QuestionnaireResponse {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QuestionnaireResponse is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *QuestionnaireResponse) String() string {
+	var builder strings.Builder
+	builder.WriteString("QuestionnaireResponse(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("patient_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PatientID))
+	builder.WriteString(", ")
+	builder.WriteString("definition_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefinitionID))
+	builder.WriteString(", ")
+	builder.WriteString("assignment_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AssignmentID))
+	builder.WriteString(", ")
+	builder.WriteString("answers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Answers))
+	builder.WriteString(", ")
+	builder.WriteString("scores=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Scores))
+	builder.WriteString(", ")
+	builder.WriteString("submitted_at=")
+	builder.WriteString(_m.SubmittedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuestionnaireResponses is a parsable slice of QuestionnaireResponse.
+type QuestionnaireResponses []*QuestionnaireResponse
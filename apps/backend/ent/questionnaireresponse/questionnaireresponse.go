@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package questionnaireresponse
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the questionnaireresponse type in the database.
+	Label = "questionnaire_response"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldPatientID holds the string denoting the patient_id field in the database.
+	FieldPatientID = "patient_id"
+	// FieldDefinitionID holds the string denoting the definition_id field in the database.
+	FieldDefinitionID = "definition_id"
+	// FieldAssignmentID holds the string denoting the assignment_id field in the database.
+	FieldAssignmentID = "assignment_id"
+	// FieldAnswers holds the string denoting the answers field in the database.
+	FieldAnswers = "answers"
+	// FieldScores holds the string denoting the scores field in the database.
+	FieldScores = "scores"
+	// FieldSubmittedAt holds the string denoting the submitted_at field in the database.
+	FieldSubmittedAt = "submitted_at"
+	// EdgePatient holds the string denoting the patient edge name in mutations.
+	EdgePatient = "patient"
+	// EdgeDefinition holds the string denoting the definition edge name in mutations.
+	EdgeDefinition = "definition"
+	// EdgeAssignment holds the string denoting the assignment edge name in mutations.
+	EdgeAssignment = "assignment"
+	// Table holds the table name of the questionnaireresponse in the database.
+	Table = "questionnaire_responses"
+	// PatientTable is the table that holds the patient relation/edge.
+	PatientTable = "questionnaire_responses"
+	// PatientInverseTable is the table name for the Patient entity.
+	// It exists in this package in order to avoid circular dependency with the "patient" package.
+	PatientInverseTable = "patients"
+	// PatientColumn is the table column denoting the patient relation/edge.
+	PatientColumn = "patient_id"
+	// DefinitionTable is the table that holds the definition relation/edge.
+	DefinitionTable = "questionnaire_responses"
+	// DefinitionInverseTable is the table name for the QuestionnaireDefinition entity.
+	// It exists in this package in order to avoid circular dependency with the "questionnairedefinition" package.
+	DefinitionInverseTable = "questionnaire_definitions"
+	// DefinitionColumn is the table column denoting the definition relation/edge.
+	DefinitionColumn = "definition_id"
+	// AssignmentTable is the table that holds the assignment relation/edge.
+	AssignmentTable = "questionnaire_responses"
+	// AssignmentInverseTable is the table name for the QuestionnaireAssignment entity.
+	// It exists in this package in order to avoid circular dependency with the "questionnaireassignment" package.
+	AssignmentInverseTable = "questionnaire_assignments"
+	// AssignmentColumn is the table column denoting the assignment relation/edge.
+	AssignmentColumn = "assignment_id"
+)
+
+// Columns holds all SQL columns for questionnaireresponse fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldPatientID,
+	FieldDefinitionID,
+	FieldAssignmentID,
+	FieldAnswers,
+	FieldScores,
+	FieldSubmittedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultSubmittedAt holds the default value on creation for the "submitted_at" field.
+	DefaultSubmittedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the QuestionnaireResponse queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByPatientID orders the results by the patient_id field.
+func ByPatientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPatientID, opts...).ToFunc()
+}
+
+// ByDefinitionID orders the results by the definition_id field.
+func ByDefinitionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefinitionID, opts...).ToFunc()
+}
+
+// ByAssignmentID orders the results by the assignment_id field.
+func ByAssignmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignmentID, opts...).ToFunc()
+}
+
+// BySubmittedAt orders the results by the submitted_at field.
+func BySubmittedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmittedAt, opts...).ToFunc()
+}
+
+// ByPatientField orders the results by patient field.
+func ByPatientField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPatientStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDefinitionField orders the results by definition field.
+func ByDefinitionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDefinitionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAssignmentField orders the results by assignment field.
+func ByAssignmentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAssignmentStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPatientStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PatientInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PatientTable, PatientColumn),
+	)
+}
+func newDefinitionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DefinitionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DefinitionTable, DefinitionColumn),
+	)
+}
+func newAssignmentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AssignmentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, AssignmentTable, AssignmentColumn),
+	)
+}
@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package questionnaireresponse
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PatientID applies equality check predicate on the "patient_id" field. It's identical to PatientIDEQ.
+func PatientID(v uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldEQ(FieldPatientID, v))
+}
+
+// DefinitionID applies equality check predicate on the "definition_id" field. It's identical to DefinitionIDEQ.
+func DefinitionID(v uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldEQ(FieldDefinitionID, v))
+}
+
+// AssignmentID applies equality check predicate on the "assignment_id" field. It's identical to AssignmentIDEQ.
+func AssignmentID(v uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldEQ(FieldAssignmentID, v))
+}
+
+// SubmittedAt applies equality check predicate on the "submitted_at" field. It's identical to SubmittedAtEQ.
+func SubmittedAt(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// PatientIDEQ applies the EQ predicate on the "patient_id" field.
+func PatientIDEQ(v uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldEQ(FieldPatientID, v))
+}
+
+// PatientIDNEQ applies the NEQ predicate on the "patient_id" field.
+func PatientIDNEQ(v uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldNEQ(FieldPatientID, v))
+}
+
+// PatientIDIn applies the In predicate on the "patient_id" field.
+func PatientIDIn(vs ...uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldIn(FieldPatientID, vs...))
+}
+
+// PatientIDNotIn applies the NotIn predicate on the "patient_id" field.
+func PatientIDNotIn(vs ...uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldNotIn(FieldPatientID, vs...))
+}
+
+// DefinitionIDEQ applies the EQ predicate on the "definition_id" field.
+func DefinitionIDEQ(v uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldEQ(FieldDefinitionID, v))
+}
+
+// DefinitionIDNEQ applies the NEQ predicate on the "definition_id" field.
+func DefinitionIDNEQ(v uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldNEQ(FieldDefinitionID, v))
+}
+
+// DefinitionIDIn applies the In predicate on the "definition_id" field.
+func DefinitionIDIn(vs ...uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldIn(FieldDefinitionID, vs...))
+}
+
+// DefinitionIDNotIn applies the NotIn predicate on the "definition_id" field.
+func DefinitionIDNotIn(vs ...uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldNotIn(FieldDefinitionID, vs...))
+}
+
+// AssignmentIDEQ applies the EQ predicate on the "assignment_id" field.
+func AssignmentIDEQ(v uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldEQ(FieldAssignmentID, v))
+}
+
+// AssignmentIDNEQ applies the NEQ predicate on the "assignment_id" field.
+func AssignmentIDNEQ(v uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldNEQ(FieldAssignmentID, v))
+}
+
+// AssignmentIDIn applies the In predicate on the "assignment_id" field.
+func AssignmentIDIn(vs ...uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldIn(FieldAssignmentID, vs...))
+}
+
+// AssignmentIDNotIn applies the NotIn predicate on the "assignment_id" field.
+func AssignmentIDNotIn(vs ...uuid.UUID) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldNotIn(FieldAssignmentID, vs...))
+}
+
+// SubmittedAtEQ applies the EQ predicate on the "submitted_at" field.
+func SubmittedAtEQ(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtNEQ applies the NEQ predicate on the "submitted_at" field.
+func SubmittedAtNEQ(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldNEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIn applies the In predicate on the "submitted_at" field.
+func SubmittedAtIn(vs ...time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtNotIn applies the NotIn predicate on the "submitted_at" field.
+func SubmittedAtNotIn(vs ...time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldNotIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtGT applies the GT predicate on the "submitted_at" field.
+func SubmittedAtGT(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldGT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtGTE applies the GTE predicate on the "submitted_at" field.
+func SubmittedAtGTE(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldGTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLT applies the LT predicate on the "submitted_at" field.
+func SubmittedAtLT(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldLT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLTE applies the LTE predicate on the "submitted_at" field.
+func SubmittedAtLTE(v time.Time) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.FieldLTE(FieldSubmittedAt, v))
+}
+
+// HasPatient applies the HasEdge predicate on the "patient" edge.
+func HasPatient() predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PatientTable, PatientColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPatientWith applies the HasEdge predicate on the "patient" edge with a given conditions (other predicates).
+func HasPatientWith(preds ...predicate.Patient) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(func(s *sql.Selector) {
+		step := newPatientStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDefinition applies the HasEdge predicate on the "definition" edge.
+func HasDefinition() predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DefinitionTable, DefinitionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDefinitionWith applies the HasEdge predicate on the "definition" edge with a given conditions (other predicates).
+func HasDefinitionWith(preds ...predicate.QuestionnaireDefinition) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(func(s *sql.Selector) {
+		step := newDefinitionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAssignment applies the HasEdge predicate on the "assignment" edge.
+func HasAssignment() predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, AssignmentTable, AssignmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssignmentWith applies the HasEdge predicate on the "assignment" edge with a given conditions (other predicates).
+func HasAssignmentWith(preds ...predicate.QuestionnaireAssignment) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(func(s *sql.Selector) {
+		step := newAssignmentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QuestionnaireResponse) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QuestionnaireResponse) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QuestionnaireResponse) predicate.QuestionnaireResponse {
+	return predicate.QuestionnaireResponse(sql.NotPredicates(p))
+}
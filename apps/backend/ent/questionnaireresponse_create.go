@@ -0,0 +1,949 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/patient"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/ent/questionnaireresponse"
+	"backend/internal/questionnaire"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QuestionnaireResponseCreate is the builder for creating a QuestionnaireResponse entity.
+type QuestionnaireResponseCreate struct {
+	config
+	mutation *QuestionnaireResponseMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *QuestionnaireResponseCreate) SetCreatedAt(v time.Time) *QuestionnaireResponseCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *QuestionnaireResponseCreate) SetNillableCreatedAt(v *time.Time) *QuestionnaireResponseCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *QuestionnaireResponseCreate) SetUpdatedAt(v time.Time) *QuestionnaireResponseCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *QuestionnaireResponseCreate) SetNillableUpdatedAt(v *time.Time) *QuestionnaireResponseCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_c *QuestionnaireResponseCreate) SetPatientID(v uuid.UUID) *QuestionnaireResponseCreate {
+	_c.mutation.SetPatientID(v)
+	return _c
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (_c *QuestionnaireResponseCreate) SetDefinitionID(v uuid.UUID) *QuestionnaireResponseCreate {
+	_c.mutation.SetDefinitionID(v)
+	return _c
+}
+
+// SetAssignmentID sets the "assignment_id" field.
+func (_c *QuestionnaireResponseCreate) SetAssignmentID(v uuid.UUID) *QuestionnaireResponseCreate {
+	_c.mutation.SetAssignmentID(v)
+	return _c
+}
+
+// SetAnswers sets the "answers" field.
+func (_c *QuestionnaireResponseCreate) SetAnswers(v map[string]string) *QuestionnaireResponseCreate {
+	_c.mutation.SetAnswers(v)
+	return _c
+}
+
+// SetScores sets the "scores" field.
+func (_c *QuestionnaireResponseCreate) SetScores(v []questionnaire.ScaleScore) *QuestionnaireResponseCreate {
+	_c.mutation.SetScores(v)
+	return _c
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_c *QuestionnaireResponseCreate) SetSubmittedAt(v time.Time) *QuestionnaireResponseCreate {
+	_c.mutation.SetSubmittedAt(v)
+	return _c
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_c *QuestionnaireResponseCreate) SetNillableSubmittedAt(v *time.Time) *QuestionnaireResponseCreate {
+	if v != nil {
+		_c.SetSubmittedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *QuestionnaireResponseCreate) SetID(v uuid.UUID) *QuestionnaireResponseCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *QuestionnaireResponseCreate) SetNillableID(v *uuid.UUID) *QuestionnaireResponseCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_c *QuestionnaireResponseCreate) SetPatient(v *Patient) *QuestionnaireResponseCreate {
+	return _c.SetPatientID(v.ID)
+}
+
+// SetDefinition sets the "definition" edge to the QuestionnaireDefinition entity.
+func (_c *QuestionnaireResponseCreate) SetDefinition(v *QuestionnaireDefinition) *QuestionnaireResponseCreate {
+	return _c.SetDefinitionID(v.ID)
+}
+
+// SetAssignment sets the "assignment" edge to the QuestionnaireAssignment entity.
+func (_c *QuestionnaireResponseCreate) SetAssignment(v *QuestionnaireAssignment) *QuestionnaireResponseCreate {
+	return _c.SetAssignmentID(v.ID)
+}
+
+// Mutation returns the QuestionnaireResponseMutation object of the builder.
+func (_c *QuestionnaireResponseCreate) Mutation() *QuestionnaireResponseMutation {
+	return _c.mutation
+}
+
+// Save creates the QuestionnaireResponse in the database.
+func (_c *QuestionnaireResponseCreate) Save(ctx context.Context) (*QuestionnaireResponse, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *QuestionnaireResponseCreate) SaveX(ctx context.Context) *QuestionnaireResponse {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuestionnaireResponseCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuestionnaireResponseCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *QuestionnaireResponseCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := questionnaireresponse.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := questionnaireresponse.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.SubmittedAt(); !ok {
+		v := questionnaireresponse.DefaultSubmittedAt()
+		_c.mutation.SetSubmittedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := questionnaireresponse.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *QuestionnaireResponseCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "QuestionnaireResponse.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "QuestionnaireResponse.updated_at"`)}
+	}
+	if _, ok := _c.mutation.PatientID(); !ok {
+		return &ValidationError{Name: "patient_id", err: errors.New(`ent: missing required field "QuestionnaireResponse.patient_id"`)}
+	}
+	if _, ok := _c.mutation.DefinitionID(); !ok {
+		return &ValidationError{Name: "definition_id", err: errors.New(`ent: missing required field "QuestionnaireResponse.definition_id"`)}
+	}
+	if _, ok := _c.mutation.AssignmentID(); !ok {
+		return &ValidationError{Name: "assignment_id", err: errors.New(`ent: missing required field "QuestionnaireResponse.assignment_id"`)}
+	}
+	if _, ok := _c.mutation.Answers(); !ok {
+		return &ValidationError{Name: "answers", err: errors.New(`ent: missing required field "QuestionnaireResponse.answers"`)}
+	}
+	if _, ok := _c.mutation.Scores(); !ok {
+		return &ValidationError{Name: "scores", err: errors.New(`ent: missing required field "QuestionnaireResponse.scores"`)}
+	}
+	if _, ok := _c.mutation.SubmittedAt(); !ok {
+		return &ValidationError{Name: "submitted_at", err: errors.New(`ent: missing required field "QuestionnaireResponse.submitted_at"`)}
+	}
+	if len(_c.mutation.PatientIDs()) == 0 {
+		return &ValidationError{Name: "patient", err: errors.New(`ent: missing required edge "QuestionnaireResponse.patient"`)}
+	}
+	if len(_c.mutation.DefinitionIDs()) == 0 {
+		return &ValidationError{Name: "definition", err: errors.New(`ent: missing required edge "QuestionnaireResponse.definition"`)}
+	}
+	if len(_c.mutation.AssignmentIDs()) == 0 {
+		return &ValidationError{Name: "assignment", err: errors.New(`ent: missing required edge "QuestionnaireResponse.assignment"`)}
+	}
+	return nil
+}
+
+func (_c *QuestionnaireResponseCreate) sqlSave(ctx context.Context) (*QuestionnaireResponse, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *QuestionnaireResponseCreate) createSpec() (*QuestionnaireResponse, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QuestionnaireResponse{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(questionnaireresponse.Table, sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(questionnaireresponse.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionnaireresponse.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Answers(); ok {
+		_spec.SetField(questionnaireresponse.FieldAnswers, field.TypeJSON, value)
+		_node.Answers = value
+	}
+	if value, ok := _c.mutation.Scores(); ok {
+		_spec.SetField(questionnaireresponse.FieldScores, field.TypeJSON, value)
+		_node.Scores = value
+	}
+	if value, ok := _c.mutation.SubmittedAt(); ok {
+		_spec.SetField(questionnaireresponse.FieldSubmittedAt, field.TypeTime, value)
+		_node.SubmittedAt = value
+	}
+	if nodes := _c.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireresponse.PatientTable,
+			Columns: []string{questionnaireresponse.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PatientID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DefinitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireresponse.DefinitionTable,
+			Columns: []string{questionnaireresponse.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DefinitionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AssignmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   questionnaireresponse.AssignmentTable,
+			Columns: []string{questionnaireresponse.AssignmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AssignmentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.QuestionnaireResponse.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionnaireResponseUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *QuestionnaireResponseCreate) OnConflict(opts ...sql.ConflictOption) *QuestionnaireResponseUpsertOne {
+	_c.conflict = opts
+	return &QuestionnaireResponseUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.QuestionnaireResponse.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *QuestionnaireResponseCreate) OnConflictColumns(columns ...string) *QuestionnaireResponseUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &QuestionnaireResponseUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// QuestionnaireResponseUpsertOne is the builder for "upsert"-ing
+	//  one QuestionnaireResponse node.
+	QuestionnaireResponseUpsertOne struct {
+		create *QuestionnaireResponseCreate
+	}
+
+	// QuestionnaireResponseUpsert is the "OnConflict" setter.
+	QuestionnaireResponseUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestionnaireResponseUpsert) SetUpdatedAt(v time.Time) *QuestionnaireResponseUpsert {
+	u.Set(questionnaireresponse.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsert) UpdateUpdatedAt() *QuestionnaireResponseUpsert {
+	u.SetExcluded(questionnaireresponse.FieldUpdatedAt)
+	return u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *QuestionnaireResponseUpsert) SetPatientID(v uuid.UUID) *QuestionnaireResponseUpsert {
+	u.Set(questionnaireresponse.FieldPatientID, v)
+	return u
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsert) UpdatePatientID() *QuestionnaireResponseUpsert {
+	u.SetExcluded(questionnaireresponse.FieldPatientID)
+	return u
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (u *QuestionnaireResponseUpsert) SetDefinitionID(v uuid.UUID) *QuestionnaireResponseUpsert {
+	u.Set(questionnaireresponse.FieldDefinitionID, v)
+	return u
+}
+
+// UpdateDefinitionID sets the "definition_id" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsert) UpdateDefinitionID() *QuestionnaireResponseUpsert {
+	u.SetExcluded(questionnaireresponse.FieldDefinitionID)
+	return u
+}
+
+// SetAssignmentID sets the "assignment_id" field.
+func (u *QuestionnaireResponseUpsert) SetAssignmentID(v uuid.UUID) *QuestionnaireResponseUpsert {
+	u.Set(questionnaireresponse.FieldAssignmentID, v)
+	return u
+}
+
+// UpdateAssignmentID sets the "assignment_id" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsert) UpdateAssignmentID() *QuestionnaireResponseUpsert {
+	u.SetExcluded(questionnaireresponse.FieldAssignmentID)
+	return u
+}
+
+// SetAnswers sets the "answers" field.
+func (u *QuestionnaireResponseUpsert) SetAnswers(v map[string]string) *QuestionnaireResponseUpsert {
+	u.Set(questionnaireresponse.FieldAnswers, v)
+	return u
+}
+
+// UpdateAnswers sets the "answers" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsert) UpdateAnswers() *QuestionnaireResponseUpsert {
+	u.SetExcluded(questionnaireresponse.FieldAnswers)
+	return u
+}
+
+// SetScores sets the "scores" field.
+func (u *QuestionnaireResponseUpsert) SetScores(v []questionnaire.ScaleScore) *QuestionnaireResponseUpsert {
+	u.Set(questionnaireresponse.FieldScores, v)
+	return u
+}
+
+// UpdateScores sets the "scores" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsert) UpdateScores() *QuestionnaireResponseUpsert {
+	u.SetExcluded(questionnaireresponse.FieldScores)
+	return u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *QuestionnaireResponseUpsert) SetSubmittedAt(v time.Time) *QuestionnaireResponseUpsert {
+	u.Set(questionnaireresponse.FieldSubmittedAt, v)
+	return u
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsert) UpdateSubmittedAt() *QuestionnaireResponseUpsert {
+	u.SetExcluded(questionnaireresponse.FieldSubmittedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.QuestionnaireResponse.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(questionnaireresponse.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuestionnaireResponseUpsertOne) UpdateNewValues() *QuestionnaireResponseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(questionnaireresponse.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(questionnaireresponse.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.QuestionnaireResponse.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *QuestionnaireResponseUpsertOne) Ignore() *QuestionnaireResponseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionnaireResponseUpsertOne) DoNothing() *QuestionnaireResponseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionnaireResponseCreate.OnConflict
+// documentation for more info.
+func (u *QuestionnaireResponseUpsertOne) Update(set func(*QuestionnaireResponseUpsert)) *QuestionnaireResponseUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionnaireResponseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestionnaireResponseUpsertOne) SetUpdatedAt(v time.Time) *QuestionnaireResponseUpsertOne {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsertOne) UpdateUpdatedAt() *QuestionnaireResponseUpsertOne {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *QuestionnaireResponseUpsertOne) SetPatientID(v uuid.UUID) *QuestionnaireResponseUpsertOne {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsertOne) UpdatePatientID() *QuestionnaireResponseUpsertOne {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (u *QuestionnaireResponseUpsertOne) SetDefinitionID(v uuid.UUID) *QuestionnaireResponseUpsertOne {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.SetDefinitionID(v)
+	})
+}
+
+// UpdateDefinitionID sets the "definition_id" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsertOne) UpdateDefinitionID() *QuestionnaireResponseUpsertOne {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.UpdateDefinitionID()
+	})
+}
+
+// SetAssignmentID sets the "assignment_id" field.
+func (u *QuestionnaireResponseUpsertOne) SetAssignmentID(v uuid.UUID) *QuestionnaireResponseUpsertOne {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.SetAssignmentID(v)
+	})
+}
+
+// UpdateAssignmentID sets the "assignment_id" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsertOne) UpdateAssignmentID() *QuestionnaireResponseUpsertOne {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.UpdateAssignmentID()
+	})
+}
+
+// SetAnswers sets the "answers" field.
+func (u *QuestionnaireResponseUpsertOne) SetAnswers(v map[string]string) *QuestionnaireResponseUpsertOne {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.SetAnswers(v)
+	})
+}
+
+// UpdateAnswers sets the "answers" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsertOne) UpdateAnswers() *QuestionnaireResponseUpsertOne {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.UpdateAnswers()
+	})
+}
+
+// SetScores sets the "scores" field.
+func (u *QuestionnaireResponseUpsertOne) SetScores(v []questionnaire.ScaleScore) *QuestionnaireResponseUpsertOne {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.SetScores(v)
+	})
+}
+
+// UpdateScores sets the "scores" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsertOne) UpdateScores() *QuestionnaireResponseUpsertOne {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.UpdateScores()
+	})
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *QuestionnaireResponseUpsertOne) SetSubmittedAt(v time.Time) *QuestionnaireResponseUpsertOne {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.SetSubmittedAt(v)
+	})
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsertOne) UpdateSubmittedAt() *QuestionnaireResponseUpsertOne {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.UpdateSubmittedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionnaireResponseUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionnaireResponseCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionnaireResponseUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *QuestionnaireResponseUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: QuestionnaireResponseUpsertOne.ID is not supported by MySQL driver. Use QuestionnaireResponseUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *QuestionnaireResponseUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// QuestionnaireResponseCreateBulk is the builder for creating many QuestionnaireResponse entities in bulk.
+type QuestionnaireResponseCreateBulk struct {
+	config
+	err      error
+	builders []*QuestionnaireResponseCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the QuestionnaireResponse entities in the database.
+func (_c *QuestionnaireResponseCreateBulk) Save(ctx context.Context) ([]*QuestionnaireResponse, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*QuestionnaireResponse, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuestionnaireResponseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *QuestionnaireResponseCreateBulk) SaveX(ctx context.Context) []*QuestionnaireResponse {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuestionnaireResponseCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuestionnaireResponseCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.QuestionnaireResponse.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.QuestionnaireResponseUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *QuestionnaireResponseCreateBulk) OnConflict(opts ...sql.ConflictOption) *QuestionnaireResponseUpsertBulk {
+	_c.conflict = opts
+	return &QuestionnaireResponseUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.QuestionnaireResponse.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *QuestionnaireResponseCreateBulk) OnConflictColumns(columns ...string) *QuestionnaireResponseUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &QuestionnaireResponseUpsertBulk{
+		create: _c,
+	}
+}
+
+// QuestionnaireResponseUpsertBulk is the builder for "upsert"-ing
+// a bulk of QuestionnaireResponse nodes.
+type QuestionnaireResponseUpsertBulk struct {
+	create *QuestionnaireResponseCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.QuestionnaireResponse.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(questionnaireresponse.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *QuestionnaireResponseUpsertBulk) UpdateNewValues() *QuestionnaireResponseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(questionnaireresponse.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(questionnaireresponse.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.QuestionnaireResponse.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *QuestionnaireResponseUpsertBulk) Ignore() *QuestionnaireResponseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *QuestionnaireResponseUpsertBulk) DoNothing() *QuestionnaireResponseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the QuestionnaireResponseCreateBulk.OnConflict
+// documentation for more info.
+func (u *QuestionnaireResponseUpsertBulk) Update(set func(*QuestionnaireResponseUpsert)) *QuestionnaireResponseUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&QuestionnaireResponseUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *QuestionnaireResponseUpsertBulk) SetUpdatedAt(v time.Time) *QuestionnaireResponseUpsertBulk {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsertBulk) UpdateUpdatedAt() *QuestionnaireResponseUpsertBulk {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *QuestionnaireResponseUpsertBulk) SetPatientID(v uuid.UUID) *QuestionnaireResponseUpsertBulk {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsertBulk) UpdatePatientID() *QuestionnaireResponseUpsertBulk {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (u *QuestionnaireResponseUpsertBulk) SetDefinitionID(v uuid.UUID) *QuestionnaireResponseUpsertBulk {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.SetDefinitionID(v)
+	})
+}
+
+// UpdateDefinitionID sets the "definition_id" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsertBulk) UpdateDefinitionID() *QuestionnaireResponseUpsertBulk {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.UpdateDefinitionID()
+	})
+}
+
+// SetAssignmentID sets the "assignment_id" field.
+func (u *QuestionnaireResponseUpsertBulk) SetAssignmentID(v uuid.UUID) *QuestionnaireResponseUpsertBulk {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.SetAssignmentID(v)
+	})
+}
+
+// UpdateAssignmentID sets the "assignment_id" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsertBulk) UpdateAssignmentID() *QuestionnaireResponseUpsertBulk {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.UpdateAssignmentID()
+	})
+}
+
+// SetAnswers sets the "answers" field.
+func (u *QuestionnaireResponseUpsertBulk) SetAnswers(v map[string]string) *QuestionnaireResponseUpsertBulk {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.SetAnswers(v)
+	})
+}
+
+// UpdateAnswers sets the "answers" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsertBulk) UpdateAnswers() *QuestionnaireResponseUpsertBulk {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.UpdateAnswers()
+	})
+}
+
+// SetScores sets the "scores" field.
+func (u *QuestionnaireResponseUpsertBulk) SetScores(v []questionnaire.ScaleScore) *QuestionnaireResponseUpsertBulk {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.SetScores(v)
+	})
+}
+
+// UpdateScores sets the "scores" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsertBulk) UpdateScores() *QuestionnaireResponseUpsertBulk {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.UpdateScores()
+	})
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (u *QuestionnaireResponseUpsertBulk) SetSubmittedAt(v time.Time) *QuestionnaireResponseUpsertBulk {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.SetSubmittedAt(v)
+	})
+}
+
+// UpdateSubmittedAt sets the "submitted_at" field to the value that was provided on create.
+func (u *QuestionnaireResponseUpsertBulk) UpdateSubmittedAt() *QuestionnaireResponseUpsertBulk {
+	return u.Update(func(s *QuestionnaireResponseUpsert) {
+		s.UpdateSubmittedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *QuestionnaireResponseUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the QuestionnaireResponseCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for QuestionnaireResponseCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *QuestionnaireResponseUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,668 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/patient"
+	"backend/ent/predicate"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/ent/questionnaireresponse"
+	"backend/internal/questionnaire"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// QuestionnaireResponseUpdate is the builder for updating QuestionnaireResponse entities.
+type QuestionnaireResponseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *QuestionnaireResponseMutation
+}
+
+// Where appends a list predicates to the QuestionnaireResponseUpdate builder.
+func (_u *QuestionnaireResponseUpdate) Where(ps ...predicate.QuestionnaireResponse) *QuestionnaireResponseUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QuestionnaireResponseUpdate) SetUpdatedAt(v time.Time) *QuestionnaireResponseUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *QuestionnaireResponseUpdate) SetPatientID(v uuid.UUID) *QuestionnaireResponseUpdate {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *QuestionnaireResponseUpdate) SetNillablePatientID(v *uuid.UUID) *QuestionnaireResponseUpdate {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (_u *QuestionnaireResponseUpdate) SetDefinitionID(v uuid.UUID) *QuestionnaireResponseUpdate {
+	_u.mutation.SetDefinitionID(v)
+	return _u
+}
+
+// SetNillableDefinitionID sets the "definition_id" field if the given value is not nil.
+func (_u *QuestionnaireResponseUpdate) SetNillableDefinitionID(v *uuid.UUID) *QuestionnaireResponseUpdate {
+	if v != nil {
+		_u.SetDefinitionID(*v)
+	}
+	return _u
+}
+
+// SetAssignmentID sets the "assignment_id" field.
+func (_u *QuestionnaireResponseUpdate) SetAssignmentID(v uuid.UUID) *QuestionnaireResponseUpdate {
+	_u.mutation.SetAssignmentID(v)
+	return _u
+}
+
+// SetNillableAssignmentID sets the "assignment_id" field if the given value is not nil.
+func (_u *QuestionnaireResponseUpdate) SetNillableAssignmentID(v *uuid.UUID) *QuestionnaireResponseUpdate {
+	if v != nil {
+		_u.SetAssignmentID(*v)
+	}
+	return _u
+}
+
+// SetAnswers sets the "answers" field.
+func (_u *QuestionnaireResponseUpdate) SetAnswers(v map[string]string) *QuestionnaireResponseUpdate {
+	_u.mutation.SetAnswers(v)
+	return _u
+}
+
+// SetScores sets the "scores" field.
+func (_u *QuestionnaireResponseUpdate) SetScores(v []questionnaire.ScaleScore) *QuestionnaireResponseUpdate {
+	_u.mutation.SetScores(v)
+	return _u
+}
+
+// AppendScores appends value to the "scores" field.
+func (_u *QuestionnaireResponseUpdate) AppendScores(v []questionnaire.ScaleScore) *QuestionnaireResponseUpdate {
+	_u.mutation.AppendScores(v)
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *QuestionnaireResponseUpdate) SetSubmittedAt(v time.Time) *QuestionnaireResponseUpdate {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *QuestionnaireResponseUpdate) SetNillableSubmittedAt(v *time.Time) *QuestionnaireResponseUpdate {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_u *QuestionnaireResponseUpdate) SetPatient(v *Patient) *QuestionnaireResponseUpdate {
+	return _u.SetPatientID(v.ID)
+}
+
+// SetDefinition sets the "definition" edge to the QuestionnaireDefinition entity.
+func (_u *QuestionnaireResponseUpdate) SetDefinition(v *QuestionnaireDefinition) *QuestionnaireResponseUpdate {
+	return _u.SetDefinitionID(v.ID)
+}
+
+// SetAssignment sets the "assignment" edge to the QuestionnaireAssignment entity.
+func (_u *QuestionnaireResponseUpdate) SetAssignment(v *QuestionnaireAssignment) *QuestionnaireResponseUpdate {
+	return _u.SetAssignmentID(v.ID)
+}
+
+// Mutation returns the QuestionnaireResponseMutation object of the builder.
+func (_u *QuestionnaireResponseUpdate) Mutation() *QuestionnaireResponseMutation {
+	return _u.mutation
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (_u *QuestionnaireResponseUpdate) ClearPatient() *QuestionnaireResponseUpdate {
+	_u.mutation.ClearPatient()
+	return _u
+}
+
+// ClearDefinition clears the "definition" edge to the QuestionnaireDefinition entity.
+func (_u *QuestionnaireResponseUpdate) ClearDefinition() *QuestionnaireResponseUpdate {
+	_u.mutation.ClearDefinition()
+	return _u
+}
+
+// ClearAssignment clears the "assignment" edge to the QuestionnaireAssignment entity.
+func (_u *QuestionnaireResponseUpdate) ClearAssignment() *QuestionnaireResponseUpdate {
+	_u.mutation.ClearAssignment()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *QuestionnaireResponseUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuestionnaireResponseUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *QuestionnaireResponseUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuestionnaireResponseUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *QuestionnaireResponseUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := questionnaireresponse.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuestionnaireResponseUpdate) check() error {
+	if _u.mutation.PatientCleared() && len(_u.mutation.PatientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuestionnaireResponse.patient"`)
+	}
+	if _u.mutation.DefinitionCleared() && len(_u.mutation.DefinitionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuestionnaireResponse.definition"`)
+	}
+	if _u.mutation.AssignmentCleared() && len(_u.mutation.AssignmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuestionnaireResponse.assignment"`)
+	}
+	return nil
+}
+
+func (_u *QuestionnaireResponseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(questionnaireresponse.Table, questionnaireresponse.Columns, sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionnaireresponse.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Answers(); ok {
+		_spec.SetField(questionnaireresponse.FieldAnswers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Scores(); ok {
+		_spec.SetField(questionnaireresponse.FieldScores, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScores(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, questionnaireresponse.FieldScores, value)
+		})
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(questionnaireresponse.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PatientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireresponse.PatientTable,
+			Columns: []string{questionnaireresponse.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireresponse.PatientTable,
+			Columns: []string{questionnaireresponse.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DefinitionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireresponse.DefinitionTable,
+			Columns: []string{questionnaireresponse.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DefinitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireresponse.DefinitionTable,
+			Columns: []string{questionnaireresponse.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AssignmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   questionnaireresponse.AssignmentTable,
+			Columns: []string{questionnaireresponse.AssignmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssignmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   questionnaireresponse.AssignmentTable,
+			Columns: []string{questionnaireresponse.AssignmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{questionnaireresponse.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// QuestionnaireResponseUpdateOne is the builder for updating a single QuestionnaireResponse entity.
+type QuestionnaireResponseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *QuestionnaireResponseMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QuestionnaireResponseUpdateOne) SetUpdatedAt(v time.Time) *QuestionnaireResponseUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *QuestionnaireResponseUpdateOne) SetPatientID(v uuid.UUID) *QuestionnaireResponseUpdateOne {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *QuestionnaireResponseUpdateOne) SetNillablePatientID(v *uuid.UUID) *QuestionnaireResponseUpdateOne {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetDefinitionID sets the "definition_id" field.
+func (_u *QuestionnaireResponseUpdateOne) SetDefinitionID(v uuid.UUID) *QuestionnaireResponseUpdateOne {
+	_u.mutation.SetDefinitionID(v)
+	return _u
+}
+
+// SetNillableDefinitionID sets the "definition_id" field if the given value is not nil.
+func (_u *QuestionnaireResponseUpdateOne) SetNillableDefinitionID(v *uuid.UUID) *QuestionnaireResponseUpdateOne {
+	if v != nil {
+		_u.SetDefinitionID(*v)
+	}
+	return _u
+}
+
+// SetAssignmentID sets the "assignment_id" field.
+func (_u *QuestionnaireResponseUpdateOne) SetAssignmentID(v uuid.UUID) *QuestionnaireResponseUpdateOne {
+	_u.mutation.SetAssignmentID(v)
+	return _u
+}
+
+// SetNillableAssignmentID sets the "assignment_id" field if the given value is not nil.
+func (_u *QuestionnaireResponseUpdateOne) SetNillableAssignmentID(v *uuid.UUID) *QuestionnaireResponseUpdateOne {
+	if v != nil {
+		_u.SetAssignmentID(*v)
+	}
+	return _u
+}
+
+// SetAnswers sets the "answers" field.
+func (_u *QuestionnaireResponseUpdateOne) SetAnswers(v map[string]string) *QuestionnaireResponseUpdateOne {
+	_u.mutation.SetAnswers(v)
+	return _u
+}
+
+// SetScores sets the "scores" field.
+func (_u *QuestionnaireResponseUpdateOne) SetScores(v []questionnaire.ScaleScore) *QuestionnaireResponseUpdateOne {
+	_u.mutation.SetScores(v)
+	return _u
+}
+
+// AppendScores appends value to the "scores" field.
+func (_u *QuestionnaireResponseUpdateOne) AppendScores(v []questionnaire.ScaleScore) *QuestionnaireResponseUpdateOne {
+	_u.mutation.AppendScores(v)
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *QuestionnaireResponseUpdateOne) SetSubmittedAt(v time.Time) *QuestionnaireResponseUpdateOne {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *QuestionnaireResponseUpdateOne) SetNillableSubmittedAt(v *time.Time) *QuestionnaireResponseUpdateOne {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_u *QuestionnaireResponseUpdateOne) SetPatient(v *Patient) *QuestionnaireResponseUpdateOne {
+	return _u.SetPatientID(v.ID)
+}
+
+// SetDefinition sets the "definition" edge to the QuestionnaireDefinition entity.
+func (_u *QuestionnaireResponseUpdateOne) SetDefinition(v *QuestionnaireDefinition) *QuestionnaireResponseUpdateOne {
+	return _u.SetDefinitionID(v.ID)
+}
+
+// SetAssignment sets the "assignment" edge to the QuestionnaireAssignment entity.
+func (_u *QuestionnaireResponseUpdateOne) SetAssignment(v *QuestionnaireAssignment) *QuestionnaireResponseUpdateOne {
+	return _u.SetAssignmentID(v.ID)
+}
+
+// Mutation returns the QuestionnaireResponseMutation object of the builder.
+func (_u *QuestionnaireResponseUpdateOne) Mutation() *QuestionnaireResponseMutation {
+	return _u.mutation
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (_u *QuestionnaireResponseUpdateOne) ClearPatient() *QuestionnaireResponseUpdateOne {
+	_u.mutation.ClearPatient()
+	return _u
+}
+
+// ClearDefinition clears the "definition" edge to the QuestionnaireDefinition entity.
+func (_u *QuestionnaireResponseUpdateOne) ClearDefinition() *QuestionnaireResponseUpdateOne {
+	_u.mutation.ClearDefinition()
+	return _u
+}
+
+// ClearAssignment clears the "assignment" edge to the QuestionnaireAssignment entity.
+func (_u *QuestionnaireResponseUpdateOne) ClearAssignment() *QuestionnaireResponseUpdateOne {
+	_u.mutation.ClearAssignment()
+	return _u
+}
+
+// Where appends a list predicates to the QuestionnaireResponseUpdate builder.
+func (_u *QuestionnaireResponseUpdateOne) Where(ps ...predicate.QuestionnaireResponse) *QuestionnaireResponseUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *QuestionnaireResponseUpdateOne) Select(field string, fields ...string) *QuestionnaireResponseUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated QuestionnaireResponse entity.
+func (_u *QuestionnaireResponseUpdateOne) Save(ctx context.Context) (*QuestionnaireResponse, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuestionnaireResponseUpdateOne) SaveX(ctx context.Context) *QuestionnaireResponse {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *QuestionnaireResponseUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuestionnaireResponseUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *QuestionnaireResponseUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := questionnaireresponse.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuestionnaireResponseUpdateOne) check() error {
+	if _u.mutation.PatientCleared() && len(_u.mutation.PatientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuestionnaireResponse.patient"`)
+	}
+	if _u.mutation.DefinitionCleared() && len(_u.mutation.DefinitionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuestionnaireResponse.definition"`)
+	}
+	if _u.mutation.AssignmentCleared() && len(_u.mutation.AssignmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuestionnaireResponse.assignment"`)
+	}
+	return nil
+}
+
+func (_u *QuestionnaireResponseUpdateOne) sqlSave(ctx context.Context) (_node *QuestionnaireResponse, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(questionnaireresponse.Table, questionnaireresponse.Columns, sqlgraph.NewFieldSpec(questionnaireresponse.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QuestionnaireResponse.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, questionnaireresponse.FieldID)
+		for _, f := range fields {
+			if !questionnaireresponse.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != questionnaireresponse.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(questionnaireresponse.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Answers(); ok {
+		_spec.SetField(questionnaireresponse.FieldAnswers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Scores(); ok {
+		_spec.SetField(questionnaireresponse.FieldScores, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScores(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, questionnaireresponse.FieldScores, value)
+		})
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(questionnaireresponse.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PatientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireresponse.PatientTable,
+			Columns: []string{questionnaireresponse.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireresponse.PatientTable,
+			Columns: []string{questionnaireresponse.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DefinitionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireresponse.DefinitionTable,
+			Columns: []string{questionnaireresponse.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DefinitionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   questionnaireresponse.DefinitionTable,
+			Columns: []string{questionnaireresponse.DefinitionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnairedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AssignmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   questionnaireresponse.AssignmentTable,
+			Columns: []string{questionnaireresponse.AssignmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssignmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   questionnaireresponse.AssignmentTable,
+			Columns: []string{questionnaireresponse.AssignmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(questionnaireassignment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &QuestionnaireResponse{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{questionnaireresponse.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
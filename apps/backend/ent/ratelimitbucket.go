@@ -0,0 +1,130 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/ratelimitbucket"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// RateLimitBucket is the model entity for the RateLimitBucket schema.
+type RateLimitBucket struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Tokens holds the value of the "tokens" field.
+	Tokens float64 `json:"tokens,omitempty"`
+	// Allowed holds the value of the "allowed" field.
+	Allowed bool `json:"allowed,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RateLimitBucket) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ratelimitbucket.FieldAllowed:
+			values[i] = new(sql.NullBool)
+		case ratelimitbucket.FieldTokens:
+			values[i] = new(sql.NullFloat64)
+		case ratelimitbucket.FieldID:
+			values[i] = new(sql.NullString)
+		case ratelimitbucket.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RateLimitBucket fields.
+func (_m *RateLimitBucket) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ratelimitbucket.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case ratelimitbucket.FieldTokens:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field tokens", values[i])
+			} else if value.Valid {
+				_m.Tokens = value.Float64
+			}
+		case ratelimitbucket.FieldAllowed:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed", values[i])
+			} else if value.Valid {
+				_m.Allowed = value.Bool
+			}
+		case ratelimitbucket.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RateLimitBucket.
+// This includes values selected through modifiers, order, etc.
+func (_m *RateLimitBucket) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RateLimitBucket.
+// Note that you need to call RateLimitBucket.Unwrap() before calling this method if this RateLimitBucket
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RateLimitBucket) Update() *RateLimitBucketUpdateOne {
+	return NewRateLimitBucketClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RateLimitBucket entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RateLimitBucket) Unwrap() *RateLimitBucket {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RateLimitBucket is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RateLimitBucket) String() string {
+	var builder strings.Builder
+	builder.WriteString("RateLimitBucket(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tokens=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tokens))
+	builder.WriteString(", ")
+	builder.WriteString("allowed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Allowed))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RateLimitBuckets is a parsable slice of RateLimitBucket.
+type RateLimitBuckets []*RateLimitBucket
@@ -0,0 +1,621 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/ratelimitbucket"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RateLimitBucketCreate is the builder for creating a RateLimitBucket entity.
+type RateLimitBucketCreate struct {
+	config
+	mutation *RateLimitBucketMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTokens sets the "tokens" field.
+func (_c *RateLimitBucketCreate) SetTokens(v float64) *RateLimitBucketCreate {
+	_c.mutation.SetTokens(v)
+	return _c
+}
+
+// SetAllowed sets the "allowed" field.
+func (_c *RateLimitBucketCreate) SetAllowed(v bool) *RateLimitBucketCreate {
+	_c.mutation.SetAllowed(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RateLimitBucketCreate) SetUpdatedAt(v time.Time) *RateLimitBucketCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RateLimitBucketCreate) SetID(v string) *RateLimitBucketCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the RateLimitBucketMutation object of the builder.
+func (_c *RateLimitBucketCreate) Mutation() *RateLimitBucketMutation {
+	return _c.mutation
+}
+
+// Save creates the RateLimitBucket in the database.
+func (_c *RateLimitBucketCreate) Save(ctx context.Context) (*RateLimitBucket, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RateLimitBucketCreate) SaveX(ctx context.Context) *RateLimitBucket {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RateLimitBucketCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RateLimitBucketCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RateLimitBucketCreate) check() error {
+	if _, ok := _c.mutation.Tokens(); !ok {
+		return &ValidationError{Name: "tokens", err: errors.New(`ent: missing required field "RateLimitBucket.tokens"`)}
+	}
+	if _, ok := _c.mutation.Allowed(); !ok {
+		return &ValidationError{Name: "allowed", err: errors.New(`ent: missing required field "RateLimitBucket.allowed"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RateLimitBucket.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := ratelimitbucket.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "RateLimitBucket.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *RateLimitBucketCreate) sqlSave(ctx context.Context) (*RateLimitBucket, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RateLimitBucket.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RateLimitBucketCreate) createSpec() (*RateLimitBucket, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RateLimitBucket{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ratelimitbucket.Table, sqlgraph.NewFieldSpec(ratelimitbucket.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Tokens(); ok {
+		_spec.SetField(ratelimitbucket.FieldTokens, field.TypeFloat64, value)
+		_node.Tokens = value
+	}
+	if value, ok := _c.mutation.Allowed(); ok {
+		_spec.SetField(ratelimitbucket.FieldAllowed, field.TypeBool, value)
+		_node.Allowed = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ratelimitbucket.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RateLimitBucket.Create().
+//		SetTokens(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RateLimitBucketUpsert) {
+//			SetTokens(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RateLimitBucketCreate) OnConflict(opts ...sql.ConflictOption) *RateLimitBucketUpsertOne {
+	_c.conflict = opts
+	return &RateLimitBucketUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RateLimitBucket.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RateLimitBucketCreate) OnConflictColumns(columns ...string) *RateLimitBucketUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RateLimitBucketUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RateLimitBucketUpsertOne is the builder for "upsert"-ing
+	//  one RateLimitBucket node.
+	RateLimitBucketUpsertOne struct {
+		create *RateLimitBucketCreate
+	}
+
+	// RateLimitBucketUpsert is the "OnConflict" setter.
+	RateLimitBucketUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTokens sets the "tokens" field.
+func (u *RateLimitBucketUpsert) SetTokens(v float64) *RateLimitBucketUpsert {
+	u.Set(ratelimitbucket.FieldTokens, v)
+	return u
+}
+
+// UpdateTokens sets the "tokens" field to the value that was provided on create.
+func (u *RateLimitBucketUpsert) UpdateTokens() *RateLimitBucketUpsert {
+	u.SetExcluded(ratelimitbucket.FieldTokens)
+	return u
+}
+
+// AddTokens adds v to the "tokens" field.
+func (u *RateLimitBucketUpsert) AddTokens(v float64) *RateLimitBucketUpsert {
+	u.Add(ratelimitbucket.FieldTokens, v)
+	return u
+}
+
+// SetAllowed sets the "allowed" field.
+func (u *RateLimitBucketUpsert) SetAllowed(v bool) *RateLimitBucketUpsert {
+	u.Set(ratelimitbucket.FieldAllowed, v)
+	return u
+}
+
+// UpdateAllowed sets the "allowed" field to the value that was provided on create.
+func (u *RateLimitBucketUpsert) UpdateAllowed() *RateLimitBucketUpsert {
+	u.SetExcluded(ratelimitbucket.FieldAllowed)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RateLimitBucketUpsert) SetUpdatedAt(v time.Time) *RateLimitBucketUpsert {
+	u.Set(ratelimitbucket.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RateLimitBucketUpsert) UpdateUpdatedAt() *RateLimitBucketUpsert {
+	u.SetExcluded(ratelimitbucket.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.RateLimitBucket.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ratelimitbucket.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RateLimitBucketUpsertOne) UpdateNewValues() *RateLimitBucketUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(ratelimitbucket.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RateLimitBucket.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RateLimitBucketUpsertOne) Ignore() *RateLimitBucketUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RateLimitBucketUpsertOne) DoNothing() *RateLimitBucketUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RateLimitBucketCreate.OnConflict
+// documentation for more info.
+func (u *RateLimitBucketUpsertOne) Update(set func(*RateLimitBucketUpsert)) *RateLimitBucketUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RateLimitBucketUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTokens sets the "tokens" field.
+func (u *RateLimitBucketUpsertOne) SetTokens(v float64) *RateLimitBucketUpsertOne {
+	return u.Update(func(s *RateLimitBucketUpsert) {
+		s.SetTokens(v)
+	})
+}
+
+// AddTokens adds v to the "tokens" field.
+func (u *RateLimitBucketUpsertOne) AddTokens(v float64) *RateLimitBucketUpsertOne {
+	return u.Update(func(s *RateLimitBucketUpsert) {
+		s.AddTokens(v)
+	})
+}
+
+// UpdateTokens sets the "tokens" field to the value that was provided on create.
+func (u *RateLimitBucketUpsertOne) UpdateTokens() *RateLimitBucketUpsertOne {
+	return u.Update(func(s *RateLimitBucketUpsert) {
+		s.UpdateTokens()
+	})
+}
+
+// SetAllowed sets the "allowed" field.
+func (u *RateLimitBucketUpsertOne) SetAllowed(v bool) *RateLimitBucketUpsertOne {
+	return u.Update(func(s *RateLimitBucketUpsert) {
+		s.SetAllowed(v)
+	})
+}
+
+// UpdateAllowed sets the "allowed" field to the value that was provided on create.
+func (u *RateLimitBucketUpsertOne) UpdateAllowed() *RateLimitBucketUpsertOne {
+	return u.Update(func(s *RateLimitBucketUpsert) {
+		s.UpdateAllowed()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RateLimitBucketUpsertOne) SetUpdatedAt(v time.Time) *RateLimitBucketUpsertOne {
+	return u.Update(func(s *RateLimitBucketUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RateLimitBucketUpsertOne) UpdateUpdatedAt() *RateLimitBucketUpsertOne {
+	return u.Update(func(s *RateLimitBucketUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RateLimitBucketUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RateLimitBucketCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RateLimitBucketUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RateLimitBucketUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: RateLimitBucketUpsertOne.ID is not supported by MySQL driver. Use RateLimitBucketUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RateLimitBucketUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RateLimitBucketCreateBulk is the builder for creating many RateLimitBucket entities in bulk.
+type RateLimitBucketCreateBulk struct {
+	config
+	err      error
+	builders []*RateLimitBucketCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RateLimitBucket entities in the database.
+func (_c *RateLimitBucketCreateBulk) Save(ctx context.Context) ([]*RateLimitBucket, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RateLimitBucket, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RateLimitBucketMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RateLimitBucketCreateBulk) SaveX(ctx context.Context) []*RateLimitBucket {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RateLimitBucketCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RateLimitBucketCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RateLimitBucket.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RateLimitBucketUpsert) {
+//			SetTokens(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RateLimitBucketCreateBulk) OnConflict(opts ...sql.ConflictOption) *RateLimitBucketUpsertBulk {
+	_c.conflict = opts
+	return &RateLimitBucketUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RateLimitBucket.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RateLimitBucketCreateBulk) OnConflictColumns(columns ...string) *RateLimitBucketUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RateLimitBucketUpsertBulk{
+		create: _c,
+	}
+}
+
+// RateLimitBucketUpsertBulk is the builder for "upsert"-ing
+// a bulk of RateLimitBucket nodes.
+type RateLimitBucketUpsertBulk struct {
+	create *RateLimitBucketCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RateLimitBucket.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(ratelimitbucket.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *RateLimitBucketUpsertBulk) UpdateNewValues() *RateLimitBucketUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(ratelimitbucket.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RateLimitBucket.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RateLimitBucketUpsertBulk) Ignore() *RateLimitBucketUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RateLimitBucketUpsertBulk) DoNothing() *RateLimitBucketUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RateLimitBucketCreateBulk.OnConflict
+// documentation for more info.
+func (u *RateLimitBucketUpsertBulk) Update(set func(*RateLimitBucketUpsert)) *RateLimitBucketUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RateLimitBucketUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTokens sets the "tokens" field.
+func (u *RateLimitBucketUpsertBulk) SetTokens(v float64) *RateLimitBucketUpsertBulk {
+	return u.Update(func(s *RateLimitBucketUpsert) {
+		s.SetTokens(v)
+	})
+}
+
+// AddTokens adds v to the "tokens" field.
+func (u *RateLimitBucketUpsertBulk) AddTokens(v float64) *RateLimitBucketUpsertBulk {
+	return u.Update(func(s *RateLimitBucketUpsert) {
+		s.AddTokens(v)
+	})
+}
+
+// UpdateTokens sets the "tokens" field to the value that was provided on create.
+func (u *RateLimitBucketUpsertBulk) UpdateTokens() *RateLimitBucketUpsertBulk {
+	return u.Update(func(s *RateLimitBucketUpsert) {
+		s.UpdateTokens()
+	})
+}
+
+// SetAllowed sets the "allowed" field.
+func (u *RateLimitBucketUpsertBulk) SetAllowed(v bool) *RateLimitBucketUpsertBulk {
+	return u.Update(func(s *RateLimitBucketUpsert) {
+		s.SetAllowed(v)
+	})
+}
+
+// UpdateAllowed sets the "allowed" field to the value that was provided on create.
+func (u *RateLimitBucketUpsertBulk) UpdateAllowed() *RateLimitBucketUpsertBulk {
+	return u.Update(func(s *RateLimitBucketUpsert) {
+		s.UpdateAllowed()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RateLimitBucketUpsertBulk) SetUpdatedAt(v time.Time) *RateLimitBucketUpsertBulk {
+	return u.Update(func(s *RateLimitBucketUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RateLimitBucketUpsertBulk) UpdateUpdatedAt() *RateLimitBucketUpsertBulk {
+	return u.Update(func(s *RateLimitBucketUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *RateLimitBucketUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RateLimitBucketCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RateLimitBucketCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RateLimitBucketUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
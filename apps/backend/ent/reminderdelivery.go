@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/patient"
+	"backend/ent/reminderdelivery"
+	"backend/ent/reminderschedule"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ReminderDelivery is the model entity for the ReminderDelivery schema.
+type ReminderDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ScheduleID holds the value of the "schedule_id" field.
+	ScheduleID uuid.UUID `json:"schedule_id,omitempty"`
+	// PatientID holds the value of the "patient_id" field.
+	PatientID uuid.UUID `json:"patient_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind reminderdelivery.Kind `json:"kind,omitempty"`
+	// Channel holds the value of the "channel" field.
+	Channel reminderdelivery.Channel `json:"channel,omitempty"`
+	// ScheduledFor holds the value of the "scheduled_for" field.
+	ScheduledFor time.Time `json:"scheduled_for,omitempty"`
+	// Status holds the value of the "status" field.
+	Status reminderdelivery.Status `json:"status,omitempty"`
+	// SentAt holds the value of the "sent_at" field.
+	SentAt *time.Time `json:"sent_at,omitempty"`
+	// Error holds the value of the "error" field.
+	Error *string `json:"error,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReminderDeliveryQuery when eager-loading is set.
+	Edges        ReminderDeliveryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReminderDeliveryEdges holds the relations/edges for other nodes in the graph.
+type ReminderDeliveryEdges struct {
+	// Schedule holds the value of the schedule edge.
+	Schedule *ReminderSchedule `json:"schedule,omitempty"`
+	// Patient holds the value of the patient edge.
+	Patient *Patient `json:"patient,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ScheduleOrErr returns the Schedule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReminderDeliveryEdges) ScheduleOrErr() (*ReminderSchedule, error) {
+	if e.Schedule != nil {
+		return e.Schedule, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: reminderschedule.Label}
+	}
+	return nil, &NotLoadedError{edge: "schedule"}
+}
+
+// PatientOrErr returns the Patient value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReminderDeliveryEdges) PatientOrErr() (*Patient, error) {
+	if e.Patient != nil {
+		return e.Patient, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: patient.Label}
+	}
+	return nil, &NotLoadedError{edge: "patient"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReminderDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reminderdelivery.FieldKind, reminderdelivery.FieldChannel, reminderdelivery.FieldStatus, reminderdelivery.FieldError:
+			values[i] = new(sql.NullString)
+		case reminderdelivery.FieldCreatedAt, reminderdelivery.FieldUpdatedAt, reminderdelivery.FieldScheduledFor, reminderdelivery.FieldSentAt:
+			values[i] = new(sql.NullTime)
+		case reminderdelivery.FieldID, reminderdelivery.FieldScheduleID, reminderdelivery.FieldPatientID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReminderDelivery fields.
+func (_m *ReminderDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reminderdelivery.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case reminderdelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case reminderdelivery.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case reminderdelivery.FieldScheduleID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_id", values[i])
+			} else if value != nil {
+				_m.ScheduleID = *value
+			}
+		case reminderdelivery.FieldPatientID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field patient_id", values[i])
+			} else if value != nil {
+				_m.PatientID = *value
+			}
+		case reminderdelivery.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = reminderdelivery.Kind(value.String)
+			}
+		case reminderdelivery.FieldChannel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channel", values[i])
+			} else if value.Valid {
+				_m.Channel = reminderdelivery.Channel(value.String)
+			}
+		case reminderdelivery.FieldScheduledFor:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_for", values[i])
+			} else if value.Valid {
+				_m.ScheduledFor = value.Time
+			}
+		case reminderdelivery.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = reminderdelivery.Status(value.String)
+			}
+		case reminderdelivery.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				_m.SentAt = new(time.Time)
+				*_m.SentAt = value.Time
+			}
+		case reminderdelivery.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = new(string)
+				*_m.Error = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReminderDelivery.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReminderDelivery) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QuerySchedule queries the "schedule" edge of the ReminderDelivery entity.
+func (_m *ReminderDelivery) QuerySchedule() *ReminderScheduleQuery {
+	return NewReminderDeliveryClient(_m.config).QuerySchedule(_m)
+}
+
+// QueryPatient queries the "patient" edge of the ReminderDelivery entity.
+func (_m *ReminderDelivery) QueryPatient() *PatientQuery {
+	return NewReminderDeliveryClient(_m.config).QueryPatient(_m)
+}
+
+// Update returns a builder for updating this ReminderDelivery.
+// Note that you need to call ReminderDelivery.Unwrap() before calling this method if this ReminderDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReminderDelivery) Update() *ReminderDeliveryUpdateOne {
+	return NewReminderDeliveryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReminderDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReminderDelivery) Unwrap() *ReminderDelivery {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReminderDelivery is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReminderDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReminderDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("schedule_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScheduleID))
+	builder.WriteString(", ")
+	builder.WriteString("patient_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PatientID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("channel=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Channel))
+	builder.WriteString(", ")
+	builder.WriteString("scheduled_for=")
+	builder.WriteString(_m.ScheduledFor.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.SentAt; v != nil {
+		builder.WriteString("sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Error; v != nil {
+		builder.WriteString("error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReminderDeliveries is a parsable slice of ReminderDelivery.
+type ReminderDeliveries []*ReminderDelivery
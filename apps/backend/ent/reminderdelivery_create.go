@@ -0,0 +1,1097 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/patient"
+	"backend/ent/reminderdelivery"
+	"backend/ent/reminderschedule"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ReminderDeliveryCreate is the builder for creating a ReminderDelivery entity.
+type ReminderDeliveryCreate struct {
+	config
+	mutation *ReminderDeliveryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReminderDeliveryCreate) SetCreatedAt(v time.Time) *ReminderDeliveryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReminderDeliveryCreate) SetNillableCreatedAt(v *time.Time) *ReminderDeliveryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ReminderDeliveryCreate) SetUpdatedAt(v time.Time) *ReminderDeliveryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ReminderDeliveryCreate) SetNillableUpdatedAt(v *time.Time) *ReminderDeliveryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_c *ReminderDeliveryCreate) SetScheduleID(v uuid.UUID) *ReminderDeliveryCreate {
+	_c.mutation.SetScheduleID(v)
+	return _c
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_c *ReminderDeliveryCreate) SetPatientID(v uuid.UUID) *ReminderDeliveryCreate {
+	_c.mutation.SetPatientID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *ReminderDeliveryCreate) SetKind(v reminderdelivery.Kind) *ReminderDeliveryCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetChannel sets the "channel" field.
+func (_c *ReminderDeliveryCreate) SetChannel(v reminderdelivery.Channel) *ReminderDeliveryCreate {
+	_c.mutation.SetChannel(v)
+	return _c
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (_c *ReminderDeliveryCreate) SetScheduledFor(v time.Time) *ReminderDeliveryCreate {
+	_c.mutation.SetScheduledFor(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ReminderDeliveryCreate) SetStatus(v reminderdelivery.Status) *ReminderDeliveryCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ReminderDeliveryCreate) SetNillableStatus(v *reminderdelivery.Status) *ReminderDeliveryCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *ReminderDeliveryCreate) SetSentAt(v time.Time) *ReminderDeliveryCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_c *ReminderDeliveryCreate) SetNillableSentAt(v *time.Time) *ReminderDeliveryCreate {
+	if v != nil {
+		_c.SetSentAt(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *ReminderDeliveryCreate) SetError(v string) *ReminderDeliveryCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *ReminderDeliveryCreate) SetNillableError(v *string) *ReminderDeliveryCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReminderDeliveryCreate) SetID(v uuid.UUID) *ReminderDeliveryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ReminderDeliveryCreate) SetNillableID(v *uuid.UUID) *ReminderDeliveryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetSchedule sets the "schedule" edge to the ReminderSchedule entity.
+func (_c *ReminderDeliveryCreate) SetSchedule(v *ReminderSchedule) *ReminderDeliveryCreate {
+	return _c.SetScheduleID(v.ID)
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_c *ReminderDeliveryCreate) SetPatient(v *Patient) *ReminderDeliveryCreate {
+	return _c.SetPatientID(v.ID)
+}
+
+// Mutation returns the ReminderDeliveryMutation object of the builder.
+func (_c *ReminderDeliveryCreate) Mutation() *ReminderDeliveryMutation {
+	return _c.mutation
+}
+
+// Save creates the ReminderDelivery in the database.
+func (_c *ReminderDeliveryCreate) Save(ctx context.Context) (*ReminderDelivery, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReminderDeliveryCreate) SaveX(ctx context.Context) *ReminderDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReminderDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReminderDeliveryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReminderDeliveryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := reminderdelivery.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := reminderdelivery.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := reminderdelivery.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := reminderdelivery.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReminderDeliveryCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReminderDelivery.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReminderDelivery.updated_at"`)}
+	}
+	if _, ok := _c.mutation.ScheduleID(); !ok {
+		return &ValidationError{Name: "schedule_id", err: errors.New(`ent: missing required field "ReminderDelivery.schedule_id"`)}
+	}
+	if _, ok := _c.mutation.PatientID(); !ok {
+		return &ValidationError{Name: "patient_id", err: errors.New(`ent: missing required field "ReminderDelivery.patient_id"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ReminderDelivery.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := reminderdelivery.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ReminderDelivery.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Channel(); !ok {
+		return &ValidationError{Name: "channel", err: errors.New(`ent: missing required field "ReminderDelivery.channel"`)}
+	}
+	if v, ok := _c.mutation.Channel(); ok {
+		if err := reminderdelivery.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "ReminderDelivery.channel": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ScheduledFor(); !ok {
+		return &ValidationError{Name: "scheduled_for", err: errors.New(`ent: missing required field "ReminderDelivery.scheduled_for"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ReminderDelivery.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := reminderdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReminderDelivery.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ScheduleIDs()) == 0 {
+		return &ValidationError{Name: "schedule", err: errors.New(`ent: missing required edge "ReminderDelivery.schedule"`)}
+	}
+	if len(_c.mutation.PatientIDs()) == 0 {
+		return &ValidationError{Name: "patient", err: errors.New(`ent: missing required edge "ReminderDelivery.patient"`)}
+	}
+	return nil
+}
+
+func (_c *ReminderDeliveryCreate) sqlSave(ctx context.Context) (*ReminderDelivery, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReminderDeliveryCreate) createSpec() (*ReminderDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReminderDelivery{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(reminderdelivery.Table, sqlgraph.NewFieldSpec(reminderdelivery.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(reminderdelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(reminderdelivery.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(reminderdelivery.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Channel(); ok {
+		_spec.SetField(reminderdelivery.FieldChannel, field.TypeEnum, value)
+		_node.Channel = value
+	}
+	if value, ok := _c.mutation.ScheduledFor(); ok {
+		_spec.SetField(reminderdelivery.FieldScheduledFor, field.TypeTime, value)
+		_node.ScheduledFor = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(reminderdelivery.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(reminderdelivery.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(reminderdelivery.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if nodes := _c.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderdelivery.ScheduleTable,
+			Columns: []string{reminderdelivery.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ScheduleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderdelivery.PatientTable,
+			Columns: []string{reminderdelivery.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PatientID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReminderDelivery.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReminderDeliveryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReminderDeliveryCreate) OnConflict(opts ...sql.ConflictOption) *ReminderDeliveryUpsertOne {
+	_c.conflict = opts
+	return &ReminderDeliveryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReminderDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReminderDeliveryCreate) OnConflictColumns(columns ...string) *ReminderDeliveryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReminderDeliveryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReminderDeliveryUpsertOne is the builder for "upsert"-ing
+	//  one ReminderDelivery node.
+	ReminderDeliveryUpsertOne struct {
+		create *ReminderDeliveryCreate
+	}
+
+	// ReminderDeliveryUpsert is the "OnConflict" setter.
+	ReminderDeliveryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReminderDeliveryUpsert) SetUpdatedAt(v time.Time) *ReminderDeliveryUpsert {
+	u.Set(reminderdelivery.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsert) UpdateUpdatedAt() *ReminderDeliveryUpsert {
+	u.SetExcluded(reminderdelivery.FieldUpdatedAt)
+	return u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (u *ReminderDeliveryUpsert) SetScheduleID(v uuid.UUID) *ReminderDeliveryUpsert {
+	u.Set(reminderdelivery.FieldScheduleID, v)
+	return u
+}
+
+// UpdateScheduleID sets the "schedule_id" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsert) UpdateScheduleID() *ReminderDeliveryUpsert {
+	u.SetExcluded(reminderdelivery.FieldScheduleID)
+	return u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *ReminderDeliveryUpsert) SetPatientID(v uuid.UUID) *ReminderDeliveryUpsert {
+	u.Set(reminderdelivery.FieldPatientID, v)
+	return u
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsert) UpdatePatientID() *ReminderDeliveryUpsert {
+	u.SetExcluded(reminderdelivery.FieldPatientID)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *ReminderDeliveryUpsert) SetKind(v reminderdelivery.Kind) *ReminderDeliveryUpsert {
+	u.Set(reminderdelivery.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsert) UpdateKind() *ReminderDeliveryUpsert {
+	u.SetExcluded(reminderdelivery.FieldKind)
+	return u
+}
+
+// SetChannel sets the "channel" field.
+func (u *ReminderDeliveryUpsert) SetChannel(v reminderdelivery.Channel) *ReminderDeliveryUpsert {
+	u.Set(reminderdelivery.FieldChannel, v)
+	return u
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsert) UpdateChannel() *ReminderDeliveryUpsert {
+	u.SetExcluded(reminderdelivery.FieldChannel)
+	return u
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (u *ReminderDeliveryUpsert) SetScheduledFor(v time.Time) *ReminderDeliveryUpsert {
+	u.Set(reminderdelivery.FieldScheduledFor, v)
+	return u
+}
+
+// UpdateScheduledFor sets the "scheduled_for" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsert) UpdateScheduledFor() *ReminderDeliveryUpsert {
+	u.SetExcluded(reminderdelivery.FieldScheduledFor)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReminderDeliveryUpsert) SetStatus(v reminderdelivery.Status) *ReminderDeliveryUpsert {
+	u.Set(reminderdelivery.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsert) UpdateStatus() *ReminderDeliveryUpsert {
+	u.SetExcluded(reminderdelivery.FieldStatus)
+	return u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *ReminderDeliveryUpsert) SetSentAt(v time.Time) *ReminderDeliveryUpsert {
+	u.Set(reminderdelivery.FieldSentAt, v)
+	return u
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsert) UpdateSentAt() *ReminderDeliveryUpsert {
+	u.SetExcluded(reminderdelivery.FieldSentAt)
+	return u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (u *ReminderDeliveryUpsert) ClearSentAt() *ReminderDeliveryUpsert {
+	u.SetNull(reminderdelivery.FieldSentAt)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *ReminderDeliveryUpsert) SetError(v string) *ReminderDeliveryUpsert {
+	u.Set(reminderdelivery.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsert) UpdateError() *ReminderDeliveryUpsert {
+	u.SetExcluded(reminderdelivery.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ReminderDeliveryUpsert) ClearError() *ReminderDeliveryUpsert {
+	u.SetNull(reminderdelivery.FieldError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReminderDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reminderdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReminderDeliveryUpsertOne) UpdateNewValues() *ReminderDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reminderdelivery.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(reminderdelivery.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReminderDelivery.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReminderDeliveryUpsertOne) Ignore() *ReminderDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReminderDeliveryUpsertOne) DoNothing() *ReminderDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReminderDeliveryCreate.OnConflict
+// documentation for more info.
+func (u *ReminderDeliveryUpsertOne) Update(set func(*ReminderDeliveryUpsert)) *ReminderDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReminderDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReminderDeliveryUpsertOne) SetUpdatedAt(v time.Time) *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertOne) UpdateUpdatedAt() *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (u *ReminderDeliveryUpsertOne) SetScheduleID(v uuid.UUID) *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetScheduleID(v)
+	})
+}
+
+// UpdateScheduleID sets the "schedule_id" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertOne) UpdateScheduleID() *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdateScheduleID()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *ReminderDeliveryUpsertOne) SetPatientID(v uuid.UUID) *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertOne) UpdatePatientID() *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *ReminderDeliveryUpsertOne) SetKind(v reminderdelivery.Kind) *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertOne) UpdateKind() *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetChannel sets the "channel" field.
+func (u *ReminderDeliveryUpsertOne) SetChannel(v reminderdelivery.Channel) *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetChannel(v)
+	})
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertOne) UpdateChannel() *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdateChannel()
+	})
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (u *ReminderDeliveryUpsertOne) SetScheduledFor(v time.Time) *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetScheduledFor(v)
+	})
+}
+
+// UpdateScheduledFor sets the "scheduled_for" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertOne) UpdateScheduledFor() *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdateScheduledFor()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReminderDeliveryUpsertOne) SetStatus(v reminderdelivery.Status) *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertOne) UpdateStatus() *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *ReminderDeliveryUpsertOne) SetSentAt(v time.Time) *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetSentAt(v)
+	})
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertOne) UpdateSentAt() *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdateSentAt()
+	})
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (u *ReminderDeliveryUpsertOne) ClearSentAt() *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.ClearSentAt()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ReminderDeliveryUpsertOne) SetError(v string) *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertOne) UpdateError() *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ReminderDeliveryUpsertOne) ClearError() *ReminderDeliveryUpsertOne {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *ReminderDeliveryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReminderDeliveryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReminderDeliveryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReminderDeliveryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReminderDeliveryUpsertOne.ID is not supported by MySQL driver. Use ReminderDeliveryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReminderDeliveryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReminderDeliveryCreateBulk is the builder for creating many ReminderDelivery entities in bulk.
+type ReminderDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*ReminderDeliveryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReminderDelivery entities in the database.
+func (_c *ReminderDeliveryCreateBulk) Save(ctx context.Context) ([]*ReminderDelivery, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReminderDelivery, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReminderDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReminderDeliveryCreateBulk) SaveX(ctx context.Context) []*ReminderDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReminderDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReminderDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReminderDelivery.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReminderDeliveryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReminderDeliveryCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReminderDeliveryUpsertBulk {
+	_c.conflict = opts
+	return &ReminderDeliveryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReminderDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReminderDeliveryCreateBulk) OnConflictColumns(columns ...string) *ReminderDeliveryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReminderDeliveryUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReminderDeliveryUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReminderDelivery nodes.
+type ReminderDeliveryUpsertBulk struct {
+	create *ReminderDeliveryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReminderDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reminderdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReminderDeliveryUpsertBulk) UpdateNewValues() *ReminderDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reminderdelivery.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(reminderdelivery.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReminderDelivery.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReminderDeliveryUpsertBulk) Ignore() *ReminderDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReminderDeliveryUpsertBulk) DoNothing() *ReminderDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReminderDeliveryCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReminderDeliveryUpsertBulk) Update(set func(*ReminderDeliveryUpsert)) *ReminderDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReminderDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReminderDeliveryUpsertBulk) SetUpdatedAt(v time.Time) *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertBulk) UpdateUpdatedAt() *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (u *ReminderDeliveryUpsertBulk) SetScheduleID(v uuid.UUID) *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetScheduleID(v)
+	})
+}
+
+// UpdateScheduleID sets the "schedule_id" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertBulk) UpdateScheduleID() *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdateScheduleID()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *ReminderDeliveryUpsertBulk) SetPatientID(v uuid.UUID) *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertBulk) UpdatePatientID() *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *ReminderDeliveryUpsertBulk) SetKind(v reminderdelivery.Kind) *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertBulk) UpdateKind() *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetChannel sets the "channel" field.
+func (u *ReminderDeliveryUpsertBulk) SetChannel(v reminderdelivery.Channel) *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetChannel(v)
+	})
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertBulk) UpdateChannel() *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdateChannel()
+	})
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (u *ReminderDeliveryUpsertBulk) SetScheduledFor(v time.Time) *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetScheduledFor(v)
+	})
+}
+
+// UpdateScheduledFor sets the "scheduled_for" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertBulk) UpdateScheduledFor() *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdateScheduledFor()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReminderDeliveryUpsertBulk) SetStatus(v reminderdelivery.Status) *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertBulk) UpdateStatus() *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *ReminderDeliveryUpsertBulk) SetSentAt(v time.Time) *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetSentAt(v)
+	})
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertBulk) UpdateSentAt() *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdateSentAt()
+	})
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (u *ReminderDeliveryUpsertBulk) ClearSentAt() *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.ClearSentAt()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ReminderDeliveryUpsertBulk) SetError(v string) *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ReminderDeliveryUpsertBulk) UpdateError() *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ReminderDeliveryUpsertBulk) ClearError() *ReminderDeliveryUpsertBulk {
+	return u.Update(func(s *ReminderDeliveryUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *ReminderDeliveryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReminderDeliveryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReminderDeliveryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReminderDeliveryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
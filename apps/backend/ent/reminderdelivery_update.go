@@ -0,0 +1,729 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/patient"
+	"backend/ent/predicate"
+	"backend/ent/reminderdelivery"
+	"backend/ent/reminderschedule"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ReminderDeliveryUpdate is the builder for updating ReminderDelivery entities.
+type ReminderDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReminderDeliveryMutation
+}
+
+// Where appends a list predicates to the ReminderDeliveryUpdate builder.
+func (_u *ReminderDeliveryUpdate) Where(ps ...predicate.ReminderDelivery) *ReminderDeliveryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReminderDeliveryUpdate) SetUpdatedAt(v time.Time) *ReminderDeliveryUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_u *ReminderDeliveryUpdate) SetScheduleID(v uuid.UUID) *ReminderDeliveryUpdate {
+	_u.mutation.SetScheduleID(v)
+	return _u
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (_u *ReminderDeliveryUpdate) SetNillableScheduleID(v *uuid.UUID) *ReminderDeliveryUpdate {
+	if v != nil {
+		_u.SetScheduleID(*v)
+	}
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *ReminderDeliveryUpdate) SetPatientID(v uuid.UUID) *ReminderDeliveryUpdate {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *ReminderDeliveryUpdate) SetNillablePatientID(v *uuid.UUID) *ReminderDeliveryUpdate {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ReminderDeliveryUpdate) SetKind(v reminderdelivery.Kind) *ReminderDeliveryUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ReminderDeliveryUpdate) SetNillableKind(v *reminderdelivery.Kind) *ReminderDeliveryUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *ReminderDeliveryUpdate) SetChannel(v reminderdelivery.Channel) *ReminderDeliveryUpdate {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *ReminderDeliveryUpdate) SetNillableChannel(v *reminderdelivery.Channel) *ReminderDeliveryUpdate {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (_u *ReminderDeliveryUpdate) SetScheduledFor(v time.Time) *ReminderDeliveryUpdate {
+	_u.mutation.SetScheduledFor(v)
+	return _u
+}
+
+// SetNillableScheduledFor sets the "scheduled_for" field if the given value is not nil.
+func (_u *ReminderDeliveryUpdate) SetNillableScheduledFor(v *time.Time) *ReminderDeliveryUpdate {
+	if v != nil {
+		_u.SetScheduledFor(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReminderDeliveryUpdate) SetStatus(v reminderdelivery.Status) *ReminderDeliveryUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReminderDeliveryUpdate) SetNillableStatus(v *reminderdelivery.Status) *ReminderDeliveryUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *ReminderDeliveryUpdate) SetSentAt(v time.Time) *ReminderDeliveryUpdate {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *ReminderDeliveryUpdate) SetNillableSentAt(v *time.Time) *ReminderDeliveryUpdate {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *ReminderDeliveryUpdate) ClearSentAt() *ReminderDeliveryUpdate {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ReminderDeliveryUpdate) SetError(v string) *ReminderDeliveryUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ReminderDeliveryUpdate) SetNillableError(v *string) *ReminderDeliveryUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *ReminderDeliveryUpdate) ClearError() *ReminderDeliveryUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetSchedule sets the "schedule" edge to the ReminderSchedule entity.
+func (_u *ReminderDeliveryUpdate) SetSchedule(v *ReminderSchedule) *ReminderDeliveryUpdate {
+	return _u.SetScheduleID(v.ID)
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_u *ReminderDeliveryUpdate) SetPatient(v *Patient) *ReminderDeliveryUpdate {
+	return _u.SetPatientID(v.ID)
+}
+
+// Mutation returns the ReminderDeliveryMutation object of the builder.
+func (_u *ReminderDeliveryUpdate) Mutation() *ReminderDeliveryMutation {
+	return _u.mutation
+}
+
+// ClearSchedule clears the "schedule" edge to the ReminderSchedule entity.
+func (_u *ReminderDeliveryUpdate) ClearSchedule() *ReminderDeliveryUpdate {
+	_u.mutation.ClearSchedule()
+	return _u
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (_u *ReminderDeliveryUpdate) ClearPatient() *ReminderDeliveryUpdate {
+	_u.mutation.ClearPatient()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReminderDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReminderDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReminderDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReminderDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReminderDeliveryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := reminderdelivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReminderDeliveryUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := reminderdelivery.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ReminderDelivery.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := reminderdelivery.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "ReminderDelivery.channel": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := reminderdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReminderDelivery.status": %w`, err)}
+		}
+	}
+	if _u.mutation.ScheduleCleared() && len(_u.mutation.ScheduleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReminderDelivery.schedule"`)
+	}
+	if _u.mutation.PatientCleared() && len(_u.mutation.PatientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReminderDelivery.patient"`)
+	}
+	return nil
+}
+
+func (_u *ReminderDeliveryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reminderdelivery.Table, reminderdelivery.Columns, sqlgraph.NewFieldSpec(reminderdelivery.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(reminderdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(reminderdelivery.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(reminderdelivery.FieldChannel, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ScheduledFor(); ok {
+		_spec.SetField(reminderdelivery.FieldScheduledFor, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(reminderdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(reminderdelivery.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(reminderdelivery.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(reminderdelivery.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(reminderdelivery.FieldError, field.TypeString)
+	}
+	if _u.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderdelivery.ScheduleTable,
+			Columns: []string{reminderdelivery.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderdelivery.ScheduleTable,
+			Columns: []string{reminderdelivery.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PatientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderdelivery.PatientTable,
+			Columns: []string{reminderdelivery.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderdelivery.PatientTable,
+			Columns: []string{reminderdelivery.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reminderdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReminderDeliveryUpdateOne is the builder for updating a single ReminderDelivery entity.
+type ReminderDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReminderDeliveryMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReminderDeliveryUpdateOne) SetUpdatedAt(v time.Time) *ReminderDeliveryUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetScheduleID sets the "schedule_id" field.
+func (_u *ReminderDeliveryUpdateOne) SetScheduleID(v uuid.UUID) *ReminderDeliveryUpdateOne {
+	_u.mutation.SetScheduleID(v)
+	return _u
+}
+
+// SetNillableScheduleID sets the "schedule_id" field if the given value is not nil.
+func (_u *ReminderDeliveryUpdateOne) SetNillableScheduleID(v *uuid.UUID) *ReminderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetScheduleID(*v)
+	}
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *ReminderDeliveryUpdateOne) SetPatientID(v uuid.UUID) *ReminderDeliveryUpdateOne {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *ReminderDeliveryUpdateOne) SetNillablePatientID(v *uuid.UUID) *ReminderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ReminderDeliveryUpdateOne) SetKind(v reminderdelivery.Kind) *ReminderDeliveryUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ReminderDeliveryUpdateOne) SetNillableKind(v *reminderdelivery.Kind) *ReminderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *ReminderDeliveryUpdateOne) SetChannel(v reminderdelivery.Channel) *ReminderDeliveryUpdateOne {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *ReminderDeliveryUpdateOne) SetNillableChannel(v *reminderdelivery.Channel) *ReminderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetScheduledFor sets the "scheduled_for" field.
+func (_u *ReminderDeliveryUpdateOne) SetScheduledFor(v time.Time) *ReminderDeliveryUpdateOne {
+	_u.mutation.SetScheduledFor(v)
+	return _u
+}
+
+// SetNillableScheduledFor sets the "scheduled_for" field if the given value is not nil.
+func (_u *ReminderDeliveryUpdateOne) SetNillableScheduledFor(v *time.Time) *ReminderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetScheduledFor(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReminderDeliveryUpdateOne) SetStatus(v reminderdelivery.Status) *ReminderDeliveryUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReminderDeliveryUpdateOne) SetNillableStatus(v *reminderdelivery.Status) *ReminderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *ReminderDeliveryUpdateOne) SetSentAt(v time.Time) *ReminderDeliveryUpdateOne {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *ReminderDeliveryUpdateOne) SetNillableSentAt(v *time.Time) *ReminderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *ReminderDeliveryUpdateOne) ClearSentAt() *ReminderDeliveryUpdateOne {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ReminderDeliveryUpdateOne) SetError(v string) *ReminderDeliveryUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ReminderDeliveryUpdateOne) SetNillableError(v *string) *ReminderDeliveryUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *ReminderDeliveryUpdateOne) ClearError() *ReminderDeliveryUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetSchedule sets the "schedule" edge to the ReminderSchedule entity.
+func (_u *ReminderDeliveryUpdateOne) SetSchedule(v *ReminderSchedule) *ReminderDeliveryUpdateOne {
+	return _u.SetScheduleID(v.ID)
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_u *ReminderDeliveryUpdateOne) SetPatient(v *Patient) *ReminderDeliveryUpdateOne {
+	return _u.SetPatientID(v.ID)
+}
+
+// Mutation returns the ReminderDeliveryMutation object of the builder.
+func (_u *ReminderDeliveryUpdateOne) Mutation() *ReminderDeliveryMutation {
+	return _u.mutation
+}
+
+// ClearSchedule clears the "schedule" edge to the ReminderSchedule entity.
+func (_u *ReminderDeliveryUpdateOne) ClearSchedule() *ReminderDeliveryUpdateOne {
+	_u.mutation.ClearSchedule()
+	return _u
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (_u *ReminderDeliveryUpdateOne) ClearPatient() *ReminderDeliveryUpdateOne {
+	_u.mutation.ClearPatient()
+	return _u
+}
+
+// Where appends a list predicates to the ReminderDeliveryUpdate builder.
+func (_u *ReminderDeliveryUpdateOne) Where(ps ...predicate.ReminderDelivery) *ReminderDeliveryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReminderDeliveryUpdateOne) Select(field string, fields ...string) *ReminderDeliveryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReminderDelivery entity.
+func (_u *ReminderDeliveryUpdateOne) Save(ctx context.Context) (*ReminderDelivery, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReminderDeliveryUpdateOne) SaveX(ctx context.Context) *ReminderDelivery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReminderDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReminderDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReminderDeliveryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := reminderdelivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReminderDeliveryUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := reminderdelivery.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ReminderDelivery.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := reminderdelivery.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "ReminderDelivery.channel": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := reminderdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReminderDelivery.status": %w`, err)}
+		}
+	}
+	if _u.mutation.ScheduleCleared() && len(_u.mutation.ScheduleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReminderDelivery.schedule"`)
+	}
+	if _u.mutation.PatientCleared() && len(_u.mutation.PatientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReminderDelivery.patient"`)
+	}
+	return nil
+}
+
+func (_u *ReminderDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *ReminderDelivery, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reminderdelivery.Table, reminderdelivery.Columns, sqlgraph.NewFieldSpec(reminderdelivery.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReminderDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reminderdelivery.FieldID)
+		for _, f := range fields {
+			if !reminderdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reminderdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(reminderdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(reminderdelivery.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(reminderdelivery.FieldChannel, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ScheduledFor(); ok {
+		_spec.SetField(reminderdelivery.FieldScheduledFor, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(reminderdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(reminderdelivery.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(reminderdelivery.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(reminderdelivery.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(reminderdelivery.FieldError, field.TypeString)
+	}
+	if _u.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderdelivery.ScheduleTable,
+			Columns: []string{reminderdelivery.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderdelivery.ScheduleTable,
+			Columns: []string{reminderdelivery.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PatientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderdelivery.PatientTable,
+			Columns: []string{reminderdelivery.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderdelivery.PatientTable,
+			Columns: []string{reminderdelivery.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReminderDelivery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reminderdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
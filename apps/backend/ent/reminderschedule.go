@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/patient"
+	"backend/ent/reminderschedule"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ReminderSchedule is the model entity for the ReminderSchedule schema.
+type ReminderSchedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// PatientID holds the value of the "patient_id" field.
+	PatientID uuid.UUID `json:"patient_id,omitempty"`
+	// CreatedByDoctorID holds the value of the "created_by_doctor_id" field.
+	CreatedByDoctorID *uuid.UUID `json:"created_by_doctor_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind reminderschedule.Kind `json:"kind,omitempty"`
+	// Channel holds the value of the "channel" field.
+	Channel reminderschedule.Channel `json:"channel,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone,omitempty"`
+	// TimeOfDay holds the value of the "time_of_day" field.
+	TimeOfDay string `json:"time_of_day,omitempty"`
+	// Weekdays holds the value of the "weekdays" field.
+	Weekdays []string `json:"weekdays,omitempty"`
+	// InactivityDays holds the value of the "inactivity_days" field.
+	InactivityDays *int `json:"inactivity_days,omitempty"`
+	// Message holds the value of the "message" field.
+	Message *string `json:"message,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// NextRunAt holds the value of the "next_run_at" field.
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+	// LastSentAt holds the value of the "last_sent_at" field.
+	LastSentAt *time.Time `json:"last_sent_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReminderScheduleQuery when eager-loading is set.
+	Edges        ReminderScheduleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReminderScheduleEdges holds the relations/edges for other nodes in the graph.
+type ReminderScheduleEdges struct {
+	// Patient holds the value of the patient edge.
+	Patient *Patient `json:"patient,omitempty"`
+	// CreatedBy holds the value of the created_by edge.
+	CreatedBy *Doctor `json:"created_by,omitempty"`
+	// Deliveries holds the value of the deliveries edge.
+	Deliveries []*ReminderDelivery `json:"deliveries,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// PatientOrErr returns the Patient value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReminderScheduleEdges) PatientOrErr() (*Patient, error) {
+	if e.Patient != nil {
+		return e.Patient, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: patient.Label}
+	}
+	return nil, &NotLoadedError{edge: "patient"}
+}
+
+// CreatedByOrErr returns the CreatedBy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReminderScheduleEdges) CreatedByOrErr() (*Doctor, error) {
+	if e.CreatedBy != nil {
+		return e.CreatedBy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: doctor.Label}
+	}
+	return nil, &NotLoadedError{edge: "created_by"}
+}
+
+// DeliveriesOrErr returns the Deliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e ReminderScheduleEdges) DeliveriesOrErr() ([]*ReminderDelivery, error) {
+	if e.loadedTypes[2] {
+		return e.Deliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "deliveries"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReminderSchedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reminderschedule.FieldCreatedByDoctorID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case reminderschedule.FieldWeekdays:
+			values[i] = new([]byte)
+		case reminderschedule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case reminderschedule.FieldInactivityDays:
+			values[i] = new(sql.NullInt64)
+		case reminderschedule.FieldKind, reminderschedule.FieldChannel, reminderschedule.FieldTimezone, reminderschedule.FieldTimeOfDay, reminderschedule.FieldMessage:
+			values[i] = new(sql.NullString)
+		case reminderschedule.FieldCreatedAt, reminderschedule.FieldUpdatedAt, reminderschedule.FieldNextRunAt, reminderschedule.FieldLastSentAt:
+			values[i] = new(sql.NullTime)
+		case reminderschedule.FieldID, reminderschedule.FieldPatientID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReminderSchedule fields.
+func (_m *ReminderSchedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reminderschedule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case reminderschedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case reminderschedule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case reminderschedule.FieldPatientID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field patient_id", values[i])
+			} else if value != nil {
+				_m.PatientID = *value
+			}
+		case reminderschedule.FieldCreatedByDoctorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by_doctor_id", values[i])
+			} else if value.Valid {
+				_m.CreatedByDoctorID = new(uuid.UUID)
+				*_m.CreatedByDoctorID = *value.S.(*uuid.UUID)
+			}
+		case reminderschedule.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = reminderschedule.Kind(value.String)
+			}
+		case reminderschedule.FieldChannel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channel", values[i])
+			} else if value.Valid {
+				_m.Channel = reminderschedule.Channel(value.String)
+			}
+		case reminderschedule.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				_m.Timezone = value.String
+			}
+		case reminderschedule.FieldTimeOfDay:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field time_of_day", values[i])
+			} else if value.Valid {
+				_m.TimeOfDay = value.String
+			}
+		case reminderschedule.FieldWeekdays:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field weekdays", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Weekdays); err != nil {
+					return fmt.Errorf("unmarshal field weekdays: %w", err)
+				}
+			}
+		case reminderschedule.FieldInactivityDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field inactivity_days", values[i])
+			} else if value.Valid {
+				_m.InactivityDays = new(int)
+				*_m.InactivityDays = int(value.Int64)
+			}
+		case reminderschedule.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = new(string)
+				*_m.Message = value.String
+			}
+		case reminderschedule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case reminderschedule.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				_m.NextRunAt = new(time.Time)
+				*_m.NextRunAt = value.Time
+			}
+		case reminderschedule.FieldLastSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sent_at", values[i])
+			} else if value.Valid {
+				_m.LastSentAt = new(time.Time)
+				*_m.LastSentAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReminderSchedule.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReminderSchedule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPatient queries the "patient" edge of the ReminderSchedule entity.
+func (_m *ReminderSchedule) QueryPatient() *PatientQuery {
+	return NewReminderScheduleClient(_m.config).QueryPatient(_m)
+}
+
+// QueryCreatedBy queries the "created_by" edge of the ReminderSchedule entity.
+func (_m *ReminderSchedule) QueryCreatedBy() *DoctorQuery {
+	return NewReminderScheduleClient(_m.config).QueryCreatedBy(_m)
+}
+
+// QueryDeliveries queries the "deliveries" edge of the ReminderSchedule entity.
+func (_m *ReminderSchedule) QueryDeliveries() *ReminderDeliveryQuery {
+	return NewReminderScheduleClient(_m.config).QueryDeliveries(_m)
+}
+
+// Update returns a builder for updating this ReminderSchedule.
+// Note that you need to call ReminderSchedule.Unwrap() before calling this method if this ReminderSchedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReminderSchedule) Update() *ReminderScheduleUpdateOne {
+	return NewReminderScheduleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReminderSchedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReminderSchedule) Unwrap() *ReminderSchedule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReminderSchedule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReminderSchedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReminderSchedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("patient_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PatientID))
+	builder.WriteString(", ")
+	if v := _m.CreatedByDoctorID; v != nil {
+		builder.WriteString("created_by_doctor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("channel=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Channel))
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(_m.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("time_of_day=")
+	builder.WriteString(_m.TimeOfDay)
+	builder.WriteString(", ")
+	builder.WriteString("weekdays=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Weekdays))
+	builder.WriteString(", ")
+	if v := _m.InactivityDays; v != nil {
+		builder.WriteString("inactivity_days=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Message; v != nil {
+		builder.WriteString("message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	if v := _m.NextRunAt; v != nil {
+		builder.WriteString("next_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastSentAt; v != nil {
+		builder.WriteString("last_sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReminderSchedules is a parsable slice of ReminderSchedule.
+type ReminderSchedules []*ReminderSchedule
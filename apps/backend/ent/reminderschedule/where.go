@@ -0,0 +1,741 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reminderschedule
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PatientID applies equality check predicate on the "patient_id" field. It's identical to PatientIDEQ.
+func PatientID(v uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldPatientID, v))
+}
+
+// CreatedByDoctorID applies equality check predicate on the "created_by_doctor_id" field. It's identical to CreatedByDoctorIDEQ.
+func CreatedByDoctorID(v uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldCreatedByDoctorID, v))
+}
+
+// Timezone applies equality check predicate on the "timezone" field. It's identical to TimezoneEQ.
+func Timezone(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimeOfDay applies equality check predicate on the "time_of_day" field. It's identical to TimeOfDayEQ.
+func TimeOfDay(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldTimeOfDay, v))
+}
+
+// InactivityDays applies equality check predicate on the "inactivity_days" field. It's identical to InactivityDaysEQ.
+func InactivityDays(v int) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldInactivityDays, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldMessage, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// NextRunAt applies equality check predicate on the "next_run_at" field. It's identical to NextRunAtEQ.
+func NextRunAt(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// LastSentAt applies equality check predicate on the "last_sent_at" field. It's identical to LastSentAtEQ.
+func LastSentAt(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldLastSentAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// PatientIDEQ applies the EQ predicate on the "patient_id" field.
+func PatientIDEQ(v uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldPatientID, v))
+}
+
+// PatientIDNEQ applies the NEQ predicate on the "patient_id" field.
+func PatientIDNEQ(v uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNEQ(FieldPatientID, v))
+}
+
+// PatientIDIn applies the In predicate on the "patient_id" field.
+func PatientIDIn(vs ...uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIn(FieldPatientID, vs...))
+}
+
+// PatientIDNotIn applies the NotIn predicate on the "patient_id" field.
+func PatientIDNotIn(vs ...uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotIn(FieldPatientID, vs...))
+}
+
+// CreatedByDoctorIDEQ applies the EQ predicate on the "created_by_doctor_id" field.
+func CreatedByDoctorIDEQ(v uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldCreatedByDoctorID, v))
+}
+
+// CreatedByDoctorIDNEQ applies the NEQ predicate on the "created_by_doctor_id" field.
+func CreatedByDoctorIDNEQ(v uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNEQ(FieldCreatedByDoctorID, v))
+}
+
+// CreatedByDoctorIDIn applies the In predicate on the "created_by_doctor_id" field.
+func CreatedByDoctorIDIn(vs ...uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIn(FieldCreatedByDoctorID, vs...))
+}
+
+// CreatedByDoctorIDNotIn applies the NotIn predicate on the "created_by_doctor_id" field.
+func CreatedByDoctorIDNotIn(vs ...uuid.UUID) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotIn(FieldCreatedByDoctorID, vs...))
+}
+
+// CreatedByDoctorIDIsNil applies the IsNil predicate on the "created_by_doctor_id" field.
+func CreatedByDoctorIDIsNil() predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIsNull(FieldCreatedByDoctorID))
+}
+
+// CreatedByDoctorIDNotNil applies the NotNil predicate on the "created_by_doctor_id" field.
+func CreatedByDoctorIDNotNil() predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotNull(FieldCreatedByDoctorID))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// ChannelEQ applies the EQ predicate on the "channel" field.
+func ChannelEQ(v Channel) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldChannel, v))
+}
+
+// ChannelNEQ applies the NEQ predicate on the "channel" field.
+func ChannelNEQ(v Channel) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNEQ(FieldChannel, v))
+}
+
+// ChannelIn applies the In predicate on the "channel" field.
+func ChannelIn(vs ...Channel) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIn(FieldChannel, vs...))
+}
+
+// ChannelNotIn applies the NotIn predicate on the "channel" field.
+func ChannelNotIn(vs ...Channel) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotIn(FieldChannel, vs...))
+}
+
+// TimezoneEQ applies the EQ predicate on the "timezone" field.
+func TimezoneEQ(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldTimezone, v))
+}
+
+// TimezoneNEQ applies the NEQ predicate on the "timezone" field.
+func TimezoneNEQ(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNEQ(FieldTimezone, v))
+}
+
+// TimezoneIn applies the In predicate on the "timezone" field.
+func TimezoneIn(vs ...string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIn(FieldTimezone, vs...))
+}
+
+// TimezoneNotIn applies the NotIn predicate on the "timezone" field.
+func TimezoneNotIn(vs ...string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotIn(FieldTimezone, vs...))
+}
+
+// TimezoneGT applies the GT predicate on the "timezone" field.
+func TimezoneGT(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGT(FieldTimezone, v))
+}
+
+// TimezoneGTE applies the GTE predicate on the "timezone" field.
+func TimezoneGTE(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGTE(FieldTimezone, v))
+}
+
+// TimezoneLT applies the LT predicate on the "timezone" field.
+func TimezoneLT(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLT(FieldTimezone, v))
+}
+
+// TimezoneLTE applies the LTE predicate on the "timezone" field.
+func TimezoneLTE(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLTE(FieldTimezone, v))
+}
+
+// TimezoneContains applies the Contains predicate on the "timezone" field.
+func TimezoneContains(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldContains(FieldTimezone, v))
+}
+
+// TimezoneHasPrefix applies the HasPrefix predicate on the "timezone" field.
+func TimezoneHasPrefix(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldHasPrefix(FieldTimezone, v))
+}
+
+// TimezoneHasSuffix applies the HasSuffix predicate on the "timezone" field.
+func TimezoneHasSuffix(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldHasSuffix(FieldTimezone, v))
+}
+
+// TimezoneEqualFold applies the EqualFold predicate on the "timezone" field.
+func TimezoneEqualFold(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEqualFold(FieldTimezone, v))
+}
+
+// TimezoneContainsFold applies the ContainsFold predicate on the "timezone" field.
+func TimezoneContainsFold(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldContainsFold(FieldTimezone, v))
+}
+
+// TimeOfDayEQ applies the EQ predicate on the "time_of_day" field.
+func TimeOfDayEQ(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldTimeOfDay, v))
+}
+
+// TimeOfDayNEQ applies the NEQ predicate on the "time_of_day" field.
+func TimeOfDayNEQ(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNEQ(FieldTimeOfDay, v))
+}
+
+// TimeOfDayIn applies the In predicate on the "time_of_day" field.
+func TimeOfDayIn(vs ...string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIn(FieldTimeOfDay, vs...))
+}
+
+// TimeOfDayNotIn applies the NotIn predicate on the "time_of_day" field.
+func TimeOfDayNotIn(vs ...string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotIn(FieldTimeOfDay, vs...))
+}
+
+// TimeOfDayGT applies the GT predicate on the "time_of_day" field.
+func TimeOfDayGT(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGT(FieldTimeOfDay, v))
+}
+
+// TimeOfDayGTE applies the GTE predicate on the "time_of_day" field.
+func TimeOfDayGTE(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGTE(FieldTimeOfDay, v))
+}
+
+// TimeOfDayLT applies the LT predicate on the "time_of_day" field.
+func TimeOfDayLT(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLT(FieldTimeOfDay, v))
+}
+
+// TimeOfDayLTE applies the LTE predicate on the "time_of_day" field.
+func TimeOfDayLTE(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLTE(FieldTimeOfDay, v))
+}
+
+// TimeOfDayContains applies the Contains predicate on the "time_of_day" field.
+func TimeOfDayContains(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldContains(FieldTimeOfDay, v))
+}
+
+// TimeOfDayHasPrefix applies the HasPrefix predicate on the "time_of_day" field.
+func TimeOfDayHasPrefix(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldHasPrefix(FieldTimeOfDay, v))
+}
+
+// TimeOfDayHasSuffix applies the HasSuffix predicate on the "time_of_day" field.
+func TimeOfDayHasSuffix(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldHasSuffix(FieldTimeOfDay, v))
+}
+
+// TimeOfDayEqualFold applies the EqualFold predicate on the "time_of_day" field.
+func TimeOfDayEqualFold(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEqualFold(FieldTimeOfDay, v))
+}
+
+// TimeOfDayContainsFold applies the ContainsFold predicate on the "time_of_day" field.
+func TimeOfDayContainsFold(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldContainsFold(FieldTimeOfDay, v))
+}
+
+// WeekdaysIsNil applies the IsNil predicate on the "weekdays" field.
+func WeekdaysIsNil() predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIsNull(FieldWeekdays))
+}
+
+// WeekdaysNotNil applies the NotNil predicate on the "weekdays" field.
+func WeekdaysNotNil() predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotNull(FieldWeekdays))
+}
+
+// InactivityDaysEQ applies the EQ predicate on the "inactivity_days" field.
+func InactivityDaysEQ(v int) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldInactivityDays, v))
+}
+
+// InactivityDaysNEQ applies the NEQ predicate on the "inactivity_days" field.
+func InactivityDaysNEQ(v int) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNEQ(FieldInactivityDays, v))
+}
+
+// InactivityDaysIn applies the In predicate on the "inactivity_days" field.
+func InactivityDaysIn(vs ...int) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIn(FieldInactivityDays, vs...))
+}
+
+// InactivityDaysNotIn applies the NotIn predicate on the "inactivity_days" field.
+func InactivityDaysNotIn(vs ...int) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotIn(FieldInactivityDays, vs...))
+}
+
+// InactivityDaysGT applies the GT predicate on the "inactivity_days" field.
+func InactivityDaysGT(v int) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGT(FieldInactivityDays, v))
+}
+
+// InactivityDaysGTE applies the GTE predicate on the "inactivity_days" field.
+func InactivityDaysGTE(v int) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGTE(FieldInactivityDays, v))
+}
+
+// InactivityDaysLT applies the LT predicate on the "inactivity_days" field.
+func InactivityDaysLT(v int) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLT(FieldInactivityDays, v))
+}
+
+// InactivityDaysLTE applies the LTE predicate on the "inactivity_days" field.
+func InactivityDaysLTE(v int) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLTE(FieldInactivityDays, v))
+}
+
+// InactivityDaysIsNil applies the IsNil predicate on the "inactivity_days" field.
+func InactivityDaysIsNil() predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIsNull(FieldInactivityDays))
+}
+
+// InactivityDaysNotNil applies the NotNil predicate on the "inactivity_days" field.
+func InactivityDaysNotNil() predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotNull(FieldInactivityDays))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// NextRunAtEQ applies the EQ predicate on the "next_run_at" field.
+func NextRunAtEQ(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtNEQ applies the NEQ predicate on the "next_run_at" field.
+func NextRunAtNEQ(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNEQ(FieldNextRunAt, v))
+}
+
+// NextRunAtIn applies the In predicate on the "next_run_at" field.
+func NextRunAtIn(vs ...time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtNotIn applies the NotIn predicate on the "next_run_at" field.
+func NextRunAtNotIn(vs ...time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotIn(FieldNextRunAt, vs...))
+}
+
+// NextRunAtGT applies the GT predicate on the "next_run_at" field.
+func NextRunAtGT(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGT(FieldNextRunAt, v))
+}
+
+// NextRunAtGTE applies the GTE predicate on the "next_run_at" field.
+func NextRunAtGTE(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGTE(FieldNextRunAt, v))
+}
+
+// NextRunAtLT applies the LT predicate on the "next_run_at" field.
+func NextRunAtLT(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLT(FieldNextRunAt, v))
+}
+
+// NextRunAtLTE applies the LTE predicate on the "next_run_at" field.
+func NextRunAtLTE(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLTE(FieldNextRunAt, v))
+}
+
+// NextRunAtIsNil applies the IsNil predicate on the "next_run_at" field.
+func NextRunAtIsNil() predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIsNull(FieldNextRunAt))
+}
+
+// NextRunAtNotNil applies the NotNil predicate on the "next_run_at" field.
+func NextRunAtNotNil() predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotNull(FieldNextRunAt))
+}
+
+// LastSentAtEQ applies the EQ predicate on the "last_sent_at" field.
+func LastSentAtEQ(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldEQ(FieldLastSentAt, v))
+}
+
+// LastSentAtNEQ applies the NEQ predicate on the "last_sent_at" field.
+func LastSentAtNEQ(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNEQ(FieldLastSentAt, v))
+}
+
+// LastSentAtIn applies the In predicate on the "last_sent_at" field.
+func LastSentAtIn(vs ...time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIn(FieldLastSentAt, vs...))
+}
+
+// LastSentAtNotIn applies the NotIn predicate on the "last_sent_at" field.
+func LastSentAtNotIn(vs ...time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotIn(FieldLastSentAt, vs...))
+}
+
+// LastSentAtGT applies the GT predicate on the "last_sent_at" field.
+func LastSentAtGT(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGT(FieldLastSentAt, v))
+}
+
+// LastSentAtGTE applies the GTE predicate on the "last_sent_at" field.
+func LastSentAtGTE(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldGTE(FieldLastSentAt, v))
+}
+
+// LastSentAtLT applies the LT predicate on the "last_sent_at" field.
+func LastSentAtLT(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLT(FieldLastSentAt, v))
+}
+
+// LastSentAtLTE applies the LTE predicate on the "last_sent_at" field.
+func LastSentAtLTE(v time.Time) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldLTE(FieldLastSentAt, v))
+}
+
+// LastSentAtIsNil applies the IsNil predicate on the "last_sent_at" field.
+func LastSentAtIsNil() predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldIsNull(FieldLastSentAt))
+}
+
+// LastSentAtNotNil applies the NotNil predicate on the "last_sent_at" field.
+func LastSentAtNotNil() predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.FieldNotNull(FieldLastSentAt))
+}
+
+// HasPatient applies the HasEdge predicate on the "patient" edge.
+func HasPatient() predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PatientTable, PatientColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPatientWith applies the HasEdge predicate on the "patient" edge with a given conditions (other predicates).
+func HasPatientWith(preds ...predicate.Patient) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(func(s *sql.Selector) {
+		step := newPatientStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCreatedBy applies the HasEdge predicate on the "created_by" edge.
+func HasCreatedBy() predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CreatedByTable, CreatedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCreatedByWith applies the HasEdge predicate on the "created_by" edge with a given conditions (other predicates).
+func HasCreatedByWith(preds ...predicate.Doctor) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(func(s *sql.Selector) {
+		step := newCreatedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDeliveries applies the HasEdge predicate on the "deliveries" edge.
+func HasDeliveries() predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeliveriesTable, DeliveriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeliveriesWith applies the HasEdge predicate on the "deliveries" edge with a given conditions (other predicates).
+func HasDeliveriesWith(preds ...predicate.ReminderDelivery) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(func(s *sql.Selector) {
+		step := newDeliveriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReminderSchedule) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReminderSchedule) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReminderSchedule) predicate.ReminderSchedule {
+	return predicate.ReminderSchedule(sql.NotPredicates(p))
+}
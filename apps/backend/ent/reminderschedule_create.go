@@ -0,0 +1,1479 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/patient"
+	"backend/ent/reminderdelivery"
+	"backend/ent/reminderschedule"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ReminderScheduleCreate is the builder for creating a ReminderSchedule entity.
+type ReminderScheduleCreate struct {
+	config
+	mutation *ReminderScheduleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReminderScheduleCreate) SetCreatedAt(v time.Time) *ReminderScheduleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReminderScheduleCreate) SetNillableCreatedAt(v *time.Time) *ReminderScheduleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ReminderScheduleCreate) SetUpdatedAt(v time.Time) *ReminderScheduleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ReminderScheduleCreate) SetNillableUpdatedAt(v *time.Time) *ReminderScheduleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_c *ReminderScheduleCreate) SetPatientID(v uuid.UUID) *ReminderScheduleCreate {
+	_c.mutation.SetPatientID(v)
+	return _c
+}
+
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (_c *ReminderScheduleCreate) SetCreatedByDoctorID(v uuid.UUID) *ReminderScheduleCreate {
+	_c.mutation.SetCreatedByDoctorID(v)
+	return _c
+}
+
+// SetNillableCreatedByDoctorID sets the "created_by_doctor_id" field if the given value is not nil.
+func (_c *ReminderScheduleCreate) SetNillableCreatedByDoctorID(v *uuid.UUID) *ReminderScheduleCreate {
+	if v != nil {
+		_c.SetCreatedByDoctorID(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *ReminderScheduleCreate) SetKind(v reminderschedule.Kind) *ReminderScheduleCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetChannel sets the "channel" field.
+func (_c *ReminderScheduleCreate) SetChannel(v reminderschedule.Channel) *ReminderScheduleCreate {
+	_c.mutation.SetChannel(v)
+	return _c
+}
+
+// SetTimezone sets the "timezone" field.
+func (_c *ReminderScheduleCreate) SetTimezone(v string) *ReminderScheduleCreate {
+	_c.mutation.SetTimezone(v)
+	return _c
+}
+
+// SetTimeOfDay sets the "time_of_day" field.
+func (_c *ReminderScheduleCreate) SetTimeOfDay(v string) *ReminderScheduleCreate {
+	_c.mutation.SetTimeOfDay(v)
+	return _c
+}
+
+// SetWeekdays sets the "weekdays" field.
+func (_c *ReminderScheduleCreate) SetWeekdays(v []string) *ReminderScheduleCreate {
+	_c.mutation.SetWeekdays(v)
+	return _c
+}
+
+// SetInactivityDays sets the "inactivity_days" field.
+func (_c *ReminderScheduleCreate) SetInactivityDays(v int) *ReminderScheduleCreate {
+	_c.mutation.SetInactivityDays(v)
+	return _c
+}
+
+// SetNillableInactivityDays sets the "inactivity_days" field if the given value is not nil.
+func (_c *ReminderScheduleCreate) SetNillableInactivityDays(v *int) *ReminderScheduleCreate {
+	if v != nil {
+		_c.SetInactivityDays(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *ReminderScheduleCreate) SetMessage(v string) *ReminderScheduleCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *ReminderScheduleCreate) SetNillableMessage(v *string) *ReminderScheduleCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *ReminderScheduleCreate) SetEnabled(v bool) *ReminderScheduleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *ReminderScheduleCreate) SetNillableEnabled(v *bool) *ReminderScheduleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_c *ReminderScheduleCreate) SetNextRunAt(v time.Time) *ReminderScheduleCreate {
+	_c.mutation.SetNextRunAt(v)
+	return _c
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_c *ReminderScheduleCreate) SetNillableNextRunAt(v *time.Time) *ReminderScheduleCreate {
+	if v != nil {
+		_c.SetNextRunAt(*v)
+	}
+	return _c
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (_c *ReminderScheduleCreate) SetLastSentAt(v time.Time) *ReminderScheduleCreate {
+	_c.mutation.SetLastSentAt(v)
+	return _c
+}
+
+// SetNillableLastSentAt sets the "last_sent_at" field if the given value is not nil.
+func (_c *ReminderScheduleCreate) SetNillableLastSentAt(v *time.Time) *ReminderScheduleCreate {
+	if v != nil {
+		_c.SetLastSentAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReminderScheduleCreate) SetID(v uuid.UUID) *ReminderScheduleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ReminderScheduleCreate) SetNillableID(v *uuid.UUID) *ReminderScheduleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_c *ReminderScheduleCreate) SetPatient(v *Patient) *ReminderScheduleCreate {
+	return _c.SetPatientID(v.ID)
+}
+
+// SetCreatedByID sets the "created_by" edge to the Doctor entity by ID.
+func (_c *ReminderScheduleCreate) SetCreatedByID(id uuid.UUID) *ReminderScheduleCreate {
+	_c.mutation.SetCreatedByID(id)
+	return _c
+}
+
+// SetNillableCreatedByID sets the "created_by" edge to the Doctor entity by ID if the given value is not nil.
+func (_c *ReminderScheduleCreate) SetNillableCreatedByID(id *uuid.UUID) *ReminderScheduleCreate {
+	if id != nil {
+		_c = _c.SetCreatedByID(*id)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" edge to the Doctor entity.
+func (_c *ReminderScheduleCreate) SetCreatedBy(v *Doctor) *ReminderScheduleCreate {
+	return _c.SetCreatedByID(v.ID)
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the ReminderDelivery entity by IDs.
+func (_c *ReminderScheduleCreate) AddDeliveryIDs(ids ...uuid.UUID) *ReminderScheduleCreate {
+	_c.mutation.AddDeliveryIDs(ids...)
+	return _c
+}
+
+// AddDeliveries adds the "deliveries" edges to the ReminderDelivery entity.
+func (_c *ReminderScheduleCreate) AddDeliveries(v ...*ReminderDelivery) *ReminderScheduleCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the ReminderScheduleMutation object of the builder.
+func (_c *ReminderScheduleCreate) Mutation() *ReminderScheduleMutation {
+	return _c.mutation
+}
+
+// Save creates the ReminderSchedule in the database.
+func (_c *ReminderScheduleCreate) Save(ctx context.Context) (*ReminderSchedule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReminderScheduleCreate) SaveX(ctx context.Context) *ReminderSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReminderScheduleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReminderScheduleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReminderScheduleCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := reminderschedule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := reminderschedule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := reminderschedule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := reminderschedule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReminderScheduleCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReminderSchedule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReminderSchedule.updated_at"`)}
+	}
+	if _, ok := _c.mutation.PatientID(); !ok {
+		return &ValidationError{Name: "patient_id", err: errors.New(`ent: missing required field "ReminderSchedule.patient_id"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ReminderSchedule.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := reminderschedule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Channel(); !ok {
+		return &ValidationError{Name: "channel", err: errors.New(`ent: missing required field "ReminderSchedule.channel"`)}
+	}
+	if v, ok := _c.mutation.Channel(); ok {
+		if err := reminderschedule.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.channel": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "ReminderSchedule.timezone"`)}
+	}
+	if v, ok := _c.mutation.Timezone(); ok {
+		if err := reminderschedule.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.timezone": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TimeOfDay(); !ok {
+		return &ValidationError{Name: "time_of_day", err: errors.New(`ent: missing required field "ReminderSchedule.time_of_day"`)}
+	}
+	if v, ok := _c.mutation.TimeOfDay(); ok {
+		if err := reminderschedule.TimeOfDayValidator(v); err != nil {
+			return &ValidationError{Name: "time_of_day", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.time_of_day": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.InactivityDays(); ok {
+		if err := reminderschedule.InactivityDaysValidator(v); err != nil {
+			return &ValidationError{Name: "inactivity_days", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.inactivity_days": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Message(); ok {
+		if err := reminderschedule.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.message": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "ReminderSchedule.enabled"`)}
+	}
+	if len(_c.mutation.PatientIDs()) == 0 {
+		return &ValidationError{Name: "patient", err: errors.New(`ent: missing required edge "ReminderSchedule.patient"`)}
+	}
+	return nil
+}
+
+func (_c *ReminderScheduleCreate) sqlSave(ctx context.Context) (*ReminderSchedule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReminderScheduleCreate) createSpec() (*ReminderSchedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReminderSchedule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(reminderschedule.Table, sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(reminderschedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(reminderschedule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(reminderschedule.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Channel(); ok {
+		_spec.SetField(reminderschedule.FieldChannel, field.TypeEnum, value)
+		_node.Channel = value
+	}
+	if value, ok := _c.mutation.Timezone(); ok {
+		_spec.SetField(reminderschedule.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := _c.mutation.TimeOfDay(); ok {
+		_spec.SetField(reminderschedule.FieldTimeOfDay, field.TypeString, value)
+		_node.TimeOfDay = value
+	}
+	if value, ok := _c.mutation.Weekdays(); ok {
+		_spec.SetField(reminderschedule.FieldWeekdays, field.TypeJSON, value)
+		_node.Weekdays = value
+	}
+	if value, ok := _c.mutation.InactivityDays(); ok {
+		_spec.SetField(reminderschedule.FieldInactivityDays, field.TypeInt, value)
+		_node.InactivityDays = &value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(reminderschedule.FieldMessage, field.TypeString, value)
+		_node.Message = &value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(reminderschedule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.NextRunAt(); ok {
+		_spec.SetField(reminderschedule.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = &value
+	}
+	if value, ok := _c.mutation.LastSentAt(); ok {
+		_spec.SetField(reminderschedule.FieldLastSentAt, field.TypeTime, value)
+		_node.LastSentAt = &value
+	}
+	if nodes := _c.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderschedule.PatientTable,
+			Columns: []string{reminderschedule.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PatientID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderschedule.CreatedByTable,
+			Columns: []string{reminderschedule.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CreatedByDoctorID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reminderschedule.DeliveriesTable,
+			Columns: []string{reminderschedule.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReminderSchedule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReminderScheduleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReminderScheduleCreate) OnConflict(opts ...sql.ConflictOption) *ReminderScheduleUpsertOne {
+	_c.conflict = opts
+	return &ReminderScheduleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReminderSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReminderScheduleCreate) OnConflictColumns(columns ...string) *ReminderScheduleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReminderScheduleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReminderScheduleUpsertOne is the builder for "upsert"-ing
+	//  one ReminderSchedule node.
+	ReminderScheduleUpsertOne struct {
+		create *ReminderScheduleCreate
+	}
+
+	// ReminderScheduleUpsert is the "OnConflict" setter.
+	ReminderScheduleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReminderScheduleUpsert) SetUpdatedAt(v time.Time) *ReminderScheduleUpsert {
+	u.Set(reminderschedule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReminderScheduleUpsert) UpdateUpdatedAt() *ReminderScheduleUpsert {
+	u.SetExcluded(reminderschedule.FieldUpdatedAt)
+	return u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *ReminderScheduleUpsert) SetPatientID(v uuid.UUID) *ReminderScheduleUpsert {
+	u.Set(reminderschedule.FieldPatientID, v)
+	return u
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *ReminderScheduleUpsert) UpdatePatientID() *ReminderScheduleUpsert {
+	u.SetExcluded(reminderschedule.FieldPatientID)
+	return u
+}
+
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (u *ReminderScheduleUpsert) SetCreatedByDoctorID(v uuid.UUID) *ReminderScheduleUpsert {
+	u.Set(reminderschedule.FieldCreatedByDoctorID, v)
+	return u
+}
+
+// UpdateCreatedByDoctorID sets the "created_by_doctor_id" field to the value that was provided on create.
+func (u *ReminderScheduleUpsert) UpdateCreatedByDoctorID() *ReminderScheduleUpsert {
+	u.SetExcluded(reminderschedule.FieldCreatedByDoctorID)
+	return u
+}
+
+// ClearCreatedByDoctorID clears the value of the "created_by_doctor_id" field.
+func (u *ReminderScheduleUpsert) ClearCreatedByDoctorID() *ReminderScheduleUpsert {
+	u.SetNull(reminderschedule.FieldCreatedByDoctorID)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *ReminderScheduleUpsert) SetKind(v reminderschedule.Kind) *ReminderScheduleUpsert {
+	u.Set(reminderschedule.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ReminderScheduleUpsert) UpdateKind() *ReminderScheduleUpsert {
+	u.SetExcluded(reminderschedule.FieldKind)
+	return u
+}
+
+// SetChannel sets the "channel" field.
+func (u *ReminderScheduleUpsert) SetChannel(v reminderschedule.Channel) *ReminderScheduleUpsert {
+	u.Set(reminderschedule.FieldChannel, v)
+	return u
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *ReminderScheduleUpsert) UpdateChannel() *ReminderScheduleUpsert {
+	u.SetExcluded(reminderschedule.FieldChannel)
+	return u
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *ReminderScheduleUpsert) SetTimezone(v string) *ReminderScheduleUpsert {
+	u.Set(reminderschedule.FieldTimezone, v)
+	return u
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *ReminderScheduleUpsert) UpdateTimezone() *ReminderScheduleUpsert {
+	u.SetExcluded(reminderschedule.FieldTimezone)
+	return u
+}
+
+// SetTimeOfDay sets the "time_of_day" field.
+func (u *ReminderScheduleUpsert) SetTimeOfDay(v string) *ReminderScheduleUpsert {
+	u.Set(reminderschedule.FieldTimeOfDay, v)
+	return u
+}
+
+// UpdateTimeOfDay sets the "time_of_day" field to the value that was provided on create.
+func (u *ReminderScheduleUpsert) UpdateTimeOfDay() *ReminderScheduleUpsert {
+	u.SetExcluded(reminderschedule.FieldTimeOfDay)
+	return u
+}
+
+// SetWeekdays sets the "weekdays" field.
+func (u *ReminderScheduleUpsert) SetWeekdays(v []string) *ReminderScheduleUpsert {
+	u.Set(reminderschedule.FieldWeekdays, v)
+	return u
+}
+
+// UpdateWeekdays sets the "weekdays" field to the value that was provided on create.
+func (u *ReminderScheduleUpsert) UpdateWeekdays() *ReminderScheduleUpsert {
+	u.SetExcluded(reminderschedule.FieldWeekdays)
+	return u
+}
+
+// ClearWeekdays clears the value of the "weekdays" field.
+func (u *ReminderScheduleUpsert) ClearWeekdays() *ReminderScheduleUpsert {
+	u.SetNull(reminderschedule.FieldWeekdays)
+	return u
+}
+
+// SetInactivityDays sets the "inactivity_days" field.
+func (u *ReminderScheduleUpsert) SetInactivityDays(v int) *ReminderScheduleUpsert {
+	u.Set(reminderschedule.FieldInactivityDays, v)
+	return u
+}
+
+// UpdateInactivityDays sets the "inactivity_days" field to the value that was provided on create.
+func (u *ReminderScheduleUpsert) UpdateInactivityDays() *ReminderScheduleUpsert {
+	u.SetExcluded(reminderschedule.FieldInactivityDays)
+	return u
+}
+
+// AddInactivityDays adds v to the "inactivity_days" field.
+func (u *ReminderScheduleUpsert) AddInactivityDays(v int) *ReminderScheduleUpsert {
+	u.Add(reminderschedule.FieldInactivityDays, v)
+	return u
+}
+
+// ClearInactivityDays clears the value of the "inactivity_days" field.
+func (u *ReminderScheduleUpsert) ClearInactivityDays() *ReminderScheduleUpsert {
+	u.SetNull(reminderschedule.FieldInactivityDays)
+	return u
+}
+
+// SetMessage sets the "message" field.
+func (u *ReminderScheduleUpsert) SetMessage(v string) *ReminderScheduleUpsert {
+	u.Set(reminderschedule.FieldMessage, v)
+	return u
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ReminderScheduleUpsert) UpdateMessage() *ReminderScheduleUpsert {
+	u.SetExcluded(reminderschedule.FieldMessage)
+	return u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ReminderScheduleUpsert) ClearMessage() *ReminderScheduleUpsert {
+	u.SetNull(reminderschedule.FieldMessage)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ReminderScheduleUpsert) SetEnabled(v bool) *ReminderScheduleUpsert {
+	u.Set(reminderschedule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ReminderScheduleUpsert) UpdateEnabled() *ReminderScheduleUpsert {
+	u.SetExcluded(reminderschedule.FieldEnabled)
+	return u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ReminderScheduleUpsert) SetNextRunAt(v time.Time) *ReminderScheduleUpsert {
+	u.Set(reminderschedule.FieldNextRunAt, v)
+	return u
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ReminderScheduleUpsert) UpdateNextRunAt() *ReminderScheduleUpsert {
+	u.SetExcluded(reminderschedule.FieldNextRunAt)
+	return u
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ReminderScheduleUpsert) ClearNextRunAt() *ReminderScheduleUpsert {
+	u.SetNull(reminderschedule.FieldNextRunAt)
+	return u
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (u *ReminderScheduleUpsert) SetLastSentAt(v time.Time) *ReminderScheduleUpsert {
+	u.Set(reminderschedule.FieldLastSentAt, v)
+	return u
+}
+
+// UpdateLastSentAt sets the "last_sent_at" field to the value that was provided on create.
+func (u *ReminderScheduleUpsert) UpdateLastSentAt() *ReminderScheduleUpsert {
+	u.SetExcluded(reminderschedule.FieldLastSentAt)
+	return u
+}
+
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (u *ReminderScheduleUpsert) ClearLastSentAt() *ReminderScheduleUpsert {
+	u.SetNull(reminderschedule.FieldLastSentAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ReminderSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reminderschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReminderScheduleUpsertOne) UpdateNewValues() *ReminderScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(reminderschedule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(reminderschedule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReminderSchedule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReminderScheduleUpsertOne) Ignore() *ReminderScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReminderScheduleUpsertOne) DoNothing() *ReminderScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReminderScheduleCreate.OnConflict
+// documentation for more info.
+func (u *ReminderScheduleUpsertOne) Update(set func(*ReminderScheduleUpsert)) *ReminderScheduleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReminderScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReminderScheduleUpsertOne) SetUpdatedAt(v time.Time) *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertOne) UpdateUpdatedAt() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *ReminderScheduleUpsertOne) SetPatientID(v uuid.UUID) *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertOne) UpdatePatientID() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (u *ReminderScheduleUpsertOne) SetCreatedByDoctorID(v uuid.UUID) *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetCreatedByDoctorID(v)
+	})
+}
+
+// UpdateCreatedByDoctorID sets the "created_by_doctor_id" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertOne) UpdateCreatedByDoctorID() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateCreatedByDoctorID()
+	})
+}
+
+// ClearCreatedByDoctorID clears the value of the "created_by_doctor_id" field.
+func (u *ReminderScheduleUpsertOne) ClearCreatedByDoctorID() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.ClearCreatedByDoctorID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *ReminderScheduleUpsertOne) SetKind(v reminderschedule.Kind) *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertOne) UpdateKind() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetChannel sets the "channel" field.
+func (u *ReminderScheduleUpsertOne) SetChannel(v reminderschedule.Channel) *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetChannel(v)
+	})
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertOne) UpdateChannel() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateChannel()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *ReminderScheduleUpsertOne) SetTimezone(v string) *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertOne) UpdateTimezone() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetTimeOfDay sets the "time_of_day" field.
+func (u *ReminderScheduleUpsertOne) SetTimeOfDay(v string) *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetTimeOfDay(v)
+	})
+}
+
+// UpdateTimeOfDay sets the "time_of_day" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertOne) UpdateTimeOfDay() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateTimeOfDay()
+	})
+}
+
+// SetWeekdays sets the "weekdays" field.
+func (u *ReminderScheduleUpsertOne) SetWeekdays(v []string) *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetWeekdays(v)
+	})
+}
+
+// UpdateWeekdays sets the "weekdays" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertOne) UpdateWeekdays() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateWeekdays()
+	})
+}
+
+// ClearWeekdays clears the value of the "weekdays" field.
+func (u *ReminderScheduleUpsertOne) ClearWeekdays() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.ClearWeekdays()
+	})
+}
+
+// SetInactivityDays sets the "inactivity_days" field.
+func (u *ReminderScheduleUpsertOne) SetInactivityDays(v int) *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetInactivityDays(v)
+	})
+}
+
+// AddInactivityDays adds v to the "inactivity_days" field.
+func (u *ReminderScheduleUpsertOne) AddInactivityDays(v int) *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.AddInactivityDays(v)
+	})
+}
+
+// UpdateInactivityDays sets the "inactivity_days" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertOne) UpdateInactivityDays() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateInactivityDays()
+	})
+}
+
+// ClearInactivityDays clears the value of the "inactivity_days" field.
+func (u *ReminderScheduleUpsertOne) ClearInactivityDays() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.ClearInactivityDays()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *ReminderScheduleUpsertOne) SetMessage(v string) *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertOne) UpdateMessage() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ReminderScheduleUpsertOne) ClearMessage() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ReminderScheduleUpsertOne) SetEnabled(v bool) *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertOne) UpdateEnabled() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ReminderScheduleUpsertOne) SetNextRunAt(v time.Time) *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertOne) UpdateNextRunAt() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ReminderScheduleUpsertOne) ClearNextRunAt() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (u *ReminderScheduleUpsertOne) SetLastSentAt(v time.Time) *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetLastSentAt(v)
+	})
+}
+
+// UpdateLastSentAt sets the "last_sent_at" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertOne) UpdateLastSentAt() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateLastSentAt()
+	})
+}
+
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (u *ReminderScheduleUpsertOne) ClearLastSentAt() *ReminderScheduleUpsertOne {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.ClearLastSentAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReminderScheduleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReminderScheduleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReminderScheduleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReminderScheduleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: ReminderScheduleUpsertOne.ID is not supported by MySQL driver. Use ReminderScheduleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReminderScheduleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReminderScheduleCreateBulk is the builder for creating many ReminderSchedule entities in bulk.
+type ReminderScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*ReminderScheduleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReminderSchedule entities in the database.
+func (_c *ReminderScheduleCreateBulk) Save(ctx context.Context) ([]*ReminderSchedule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReminderSchedule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReminderScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReminderScheduleCreateBulk) SaveX(ctx context.Context) []*ReminderSchedule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReminderScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReminderScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReminderSchedule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReminderScheduleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReminderScheduleCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReminderScheduleUpsertBulk {
+	_c.conflict = opts
+	return &ReminderScheduleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReminderSchedule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReminderScheduleCreateBulk) OnConflictColumns(columns ...string) *ReminderScheduleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReminderScheduleUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReminderScheduleUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReminderSchedule nodes.
+type ReminderScheduleUpsertBulk struct {
+	create *ReminderScheduleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReminderSchedule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(reminderschedule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ReminderScheduleUpsertBulk) UpdateNewValues() *ReminderScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(reminderschedule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(reminderschedule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReminderSchedule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReminderScheduleUpsertBulk) Ignore() *ReminderScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReminderScheduleUpsertBulk) DoNothing() *ReminderScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReminderScheduleCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReminderScheduleUpsertBulk) Update(set func(*ReminderScheduleUpsert)) *ReminderScheduleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReminderScheduleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReminderScheduleUpsertBulk) SetUpdatedAt(v time.Time) *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertBulk) UpdateUpdatedAt() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetPatientID sets the "patient_id" field.
+func (u *ReminderScheduleUpsertBulk) SetPatientID(v uuid.UUID) *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetPatientID(v)
+	})
+}
+
+// UpdatePatientID sets the "patient_id" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertBulk) UpdatePatientID() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdatePatientID()
+	})
+}
+
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (u *ReminderScheduleUpsertBulk) SetCreatedByDoctorID(v uuid.UUID) *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetCreatedByDoctorID(v)
+	})
+}
+
+// UpdateCreatedByDoctorID sets the "created_by_doctor_id" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertBulk) UpdateCreatedByDoctorID() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateCreatedByDoctorID()
+	})
+}
+
+// ClearCreatedByDoctorID clears the value of the "created_by_doctor_id" field.
+func (u *ReminderScheduleUpsertBulk) ClearCreatedByDoctorID() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.ClearCreatedByDoctorID()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *ReminderScheduleUpsertBulk) SetKind(v reminderschedule.Kind) *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertBulk) UpdateKind() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetChannel sets the "channel" field.
+func (u *ReminderScheduleUpsertBulk) SetChannel(v reminderschedule.Channel) *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetChannel(v)
+	})
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertBulk) UpdateChannel() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateChannel()
+	})
+}
+
+// SetTimezone sets the "timezone" field.
+func (u *ReminderScheduleUpsertBulk) SetTimezone(v string) *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetTimezone(v)
+	})
+}
+
+// UpdateTimezone sets the "timezone" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertBulk) UpdateTimezone() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateTimezone()
+	})
+}
+
+// SetTimeOfDay sets the "time_of_day" field.
+func (u *ReminderScheduleUpsertBulk) SetTimeOfDay(v string) *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetTimeOfDay(v)
+	})
+}
+
+// UpdateTimeOfDay sets the "time_of_day" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertBulk) UpdateTimeOfDay() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateTimeOfDay()
+	})
+}
+
+// SetWeekdays sets the "weekdays" field.
+func (u *ReminderScheduleUpsertBulk) SetWeekdays(v []string) *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetWeekdays(v)
+	})
+}
+
+// UpdateWeekdays sets the "weekdays" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertBulk) UpdateWeekdays() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateWeekdays()
+	})
+}
+
+// ClearWeekdays clears the value of the "weekdays" field.
+func (u *ReminderScheduleUpsertBulk) ClearWeekdays() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.ClearWeekdays()
+	})
+}
+
+// SetInactivityDays sets the "inactivity_days" field.
+func (u *ReminderScheduleUpsertBulk) SetInactivityDays(v int) *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetInactivityDays(v)
+	})
+}
+
+// AddInactivityDays adds v to the "inactivity_days" field.
+func (u *ReminderScheduleUpsertBulk) AddInactivityDays(v int) *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.AddInactivityDays(v)
+	})
+}
+
+// UpdateInactivityDays sets the "inactivity_days" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertBulk) UpdateInactivityDays() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateInactivityDays()
+	})
+}
+
+// ClearInactivityDays clears the value of the "inactivity_days" field.
+func (u *ReminderScheduleUpsertBulk) ClearInactivityDays() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.ClearInactivityDays()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *ReminderScheduleUpsertBulk) SetMessage(v string) *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertBulk) UpdateMessage() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ReminderScheduleUpsertBulk) ClearMessage() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *ReminderScheduleUpsertBulk) SetEnabled(v bool) *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertBulk) UpdateEnabled() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (u *ReminderScheduleUpsertBulk) SetNextRunAt(v time.Time) *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetNextRunAt(v)
+	})
+}
+
+// UpdateNextRunAt sets the "next_run_at" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertBulk) UpdateNextRunAt() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateNextRunAt()
+	})
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (u *ReminderScheduleUpsertBulk) ClearNextRunAt() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.ClearNextRunAt()
+	})
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (u *ReminderScheduleUpsertBulk) SetLastSentAt(v time.Time) *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.SetLastSentAt(v)
+	})
+}
+
+// UpdateLastSentAt sets the "last_sent_at" field to the value that was provided on create.
+func (u *ReminderScheduleUpsertBulk) UpdateLastSentAt() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.UpdateLastSentAt()
+	})
+}
+
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (u *ReminderScheduleUpsertBulk) ClearLastSentAt() *ReminderScheduleUpsertBulk {
+	return u.Update(func(s *ReminderScheduleUpsert) {
+		s.ClearLastSentAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReminderScheduleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReminderScheduleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReminderScheduleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReminderScheduleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/patient"
+	"backend/ent/predicate"
+	"backend/ent/reminderdelivery"
+	"backend/ent/reminderschedule"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ReminderScheduleUpdate is the builder for updating ReminderSchedule entities.
+type ReminderScheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReminderScheduleMutation
+}
+
+// Where appends a list predicates to the ReminderScheduleUpdate builder.
+func (_u *ReminderScheduleUpdate) Where(ps ...predicate.ReminderSchedule) *ReminderScheduleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReminderScheduleUpdate) SetUpdatedAt(v time.Time) *ReminderScheduleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *ReminderScheduleUpdate) SetPatientID(v uuid.UUID) *ReminderScheduleUpdate {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *ReminderScheduleUpdate) SetNillablePatientID(v *uuid.UUID) *ReminderScheduleUpdate {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (_u *ReminderScheduleUpdate) SetCreatedByDoctorID(v uuid.UUID) *ReminderScheduleUpdate {
+	_u.mutation.SetCreatedByDoctorID(v)
+	return _u
+}
+
+// SetNillableCreatedByDoctorID sets the "created_by_doctor_id" field if the given value is not nil.
+func (_u *ReminderScheduleUpdate) SetNillableCreatedByDoctorID(v *uuid.UUID) *ReminderScheduleUpdate {
+	if v != nil {
+		_u.SetCreatedByDoctorID(*v)
+	}
+	return _u
+}
+
+// ClearCreatedByDoctorID clears the value of the "created_by_doctor_id" field.
+func (_u *ReminderScheduleUpdate) ClearCreatedByDoctorID() *ReminderScheduleUpdate {
+	_u.mutation.ClearCreatedByDoctorID()
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ReminderScheduleUpdate) SetKind(v reminderschedule.Kind) *ReminderScheduleUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ReminderScheduleUpdate) SetNillableKind(v *reminderschedule.Kind) *ReminderScheduleUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *ReminderScheduleUpdate) SetChannel(v reminderschedule.Channel) *ReminderScheduleUpdate {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *ReminderScheduleUpdate) SetNillableChannel(v *reminderschedule.Channel) *ReminderScheduleUpdate {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *ReminderScheduleUpdate) SetTimezone(v string) *ReminderScheduleUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *ReminderScheduleUpdate) SetNillableTimezone(v *string) *ReminderScheduleUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetTimeOfDay sets the "time_of_day" field.
+func (_u *ReminderScheduleUpdate) SetTimeOfDay(v string) *ReminderScheduleUpdate {
+	_u.mutation.SetTimeOfDay(v)
+	return _u
+}
+
+// SetNillableTimeOfDay sets the "time_of_day" field if the given value is not nil.
+func (_u *ReminderScheduleUpdate) SetNillableTimeOfDay(v *string) *ReminderScheduleUpdate {
+	if v != nil {
+		_u.SetTimeOfDay(*v)
+	}
+	return _u
+}
+
+// SetWeekdays sets the "weekdays" field.
+func (_u *ReminderScheduleUpdate) SetWeekdays(v []string) *ReminderScheduleUpdate {
+	_u.mutation.SetWeekdays(v)
+	return _u
+}
+
+// AppendWeekdays appends value to the "weekdays" field.
+func (_u *ReminderScheduleUpdate) AppendWeekdays(v []string) *ReminderScheduleUpdate {
+	_u.mutation.AppendWeekdays(v)
+	return _u
+}
+
+// ClearWeekdays clears the value of the "weekdays" field.
+func (_u *ReminderScheduleUpdate) ClearWeekdays() *ReminderScheduleUpdate {
+	_u.mutation.ClearWeekdays()
+	return _u
+}
+
+// SetInactivityDays sets the "inactivity_days" field.
+func (_u *ReminderScheduleUpdate) SetInactivityDays(v int) *ReminderScheduleUpdate {
+	_u.mutation.ResetInactivityDays()
+	_u.mutation.SetInactivityDays(v)
+	return _u
+}
+
+// SetNillableInactivityDays sets the "inactivity_days" field if the given value is not nil.
+func (_u *ReminderScheduleUpdate) SetNillableInactivityDays(v *int) *ReminderScheduleUpdate {
+	if v != nil {
+		_u.SetInactivityDays(*v)
+	}
+	return _u
+}
+
+// AddInactivityDays adds value to the "inactivity_days" field.
+func (_u *ReminderScheduleUpdate) AddInactivityDays(v int) *ReminderScheduleUpdate {
+	_u.mutation.AddInactivityDays(v)
+	return _u
+}
+
+// ClearInactivityDays clears the value of the "inactivity_days" field.
+func (_u *ReminderScheduleUpdate) ClearInactivityDays() *ReminderScheduleUpdate {
+	_u.mutation.ClearInactivityDays()
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ReminderScheduleUpdate) SetMessage(v string) *ReminderScheduleUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ReminderScheduleUpdate) SetNillableMessage(v *string) *ReminderScheduleUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ReminderScheduleUpdate) ClearMessage() *ReminderScheduleUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ReminderScheduleUpdate) SetEnabled(v bool) *ReminderScheduleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ReminderScheduleUpdate) SetNillableEnabled(v *bool) *ReminderScheduleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *ReminderScheduleUpdate) SetNextRunAt(v time.Time) *ReminderScheduleUpdate {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *ReminderScheduleUpdate) SetNillableNextRunAt(v *time.Time) *ReminderScheduleUpdate {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (_u *ReminderScheduleUpdate) ClearNextRunAt() *ReminderScheduleUpdate {
+	_u.mutation.ClearNextRunAt()
+	return _u
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (_u *ReminderScheduleUpdate) SetLastSentAt(v time.Time) *ReminderScheduleUpdate {
+	_u.mutation.SetLastSentAt(v)
+	return _u
+}
+
+// SetNillableLastSentAt sets the "last_sent_at" field if the given value is not nil.
+func (_u *ReminderScheduleUpdate) SetNillableLastSentAt(v *time.Time) *ReminderScheduleUpdate {
+	if v != nil {
+		_u.SetLastSentAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (_u *ReminderScheduleUpdate) ClearLastSentAt() *ReminderScheduleUpdate {
+	_u.mutation.ClearLastSentAt()
+	return _u
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_u *ReminderScheduleUpdate) SetPatient(v *Patient) *ReminderScheduleUpdate {
+	return _u.SetPatientID(v.ID)
+}
+
+// SetCreatedByID sets the "created_by" edge to the Doctor entity by ID.
+func (_u *ReminderScheduleUpdate) SetCreatedByID(id uuid.UUID) *ReminderScheduleUpdate {
+	_u.mutation.SetCreatedByID(id)
+	return _u
+}
+
+// SetNillableCreatedByID sets the "created_by" edge to the Doctor entity by ID if the given value is not nil.
+func (_u *ReminderScheduleUpdate) SetNillableCreatedByID(id *uuid.UUID) *ReminderScheduleUpdate {
+	if id != nil {
+		_u = _u.SetCreatedByID(*id)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" edge to the Doctor entity.
+func (_u *ReminderScheduleUpdate) SetCreatedBy(v *Doctor) *ReminderScheduleUpdate {
+	return _u.SetCreatedByID(v.ID)
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the ReminderDelivery entity by IDs.
+func (_u *ReminderScheduleUpdate) AddDeliveryIDs(ids ...uuid.UUID) *ReminderScheduleUpdate {
+	_u.mutation.AddDeliveryIDs(ids...)
+	return _u
+}
+
+// AddDeliveries adds the "deliveries" edges to the ReminderDelivery entity.
+func (_u *ReminderScheduleUpdate) AddDeliveries(v ...*ReminderDelivery) *ReminderScheduleUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the ReminderScheduleMutation object of the builder.
+func (_u *ReminderScheduleUpdate) Mutation() *ReminderScheduleMutation {
+	return _u.mutation
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (_u *ReminderScheduleUpdate) ClearPatient() *ReminderScheduleUpdate {
+	_u.mutation.ClearPatient()
+	return _u
+}
+
+// ClearCreatedBy clears the "created_by" edge to the Doctor entity.
+func (_u *ReminderScheduleUpdate) ClearCreatedBy() *ReminderScheduleUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// ClearDeliveries clears all "deliveries" edges to the ReminderDelivery entity.
+func (_u *ReminderScheduleUpdate) ClearDeliveries() *ReminderScheduleUpdate {
+	_u.mutation.ClearDeliveries()
+	return _u
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to ReminderDelivery entities by IDs.
+func (_u *ReminderScheduleUpdate) RemoveDeliveryIDs(ids ...uuid.UUID) *ReminderScheduleUpdate {
+	_u.mutation.RemoveDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveDeliveries removes "deliveries" edges to ReminderDelivery entities.
+func (_u *ReminderScheduleUpdate) RemoveDeliveries(v ...*ReminderDelivery) *ReminderScheduleUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeliveryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReminderScheduleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReminderScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReminderScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReminderScheduleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReminderScheduleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := reminderschedule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReminderScheduleUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := reminderschedule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := reminderschedule.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.channel": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := reminderschedule.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TimeOfDay(); ok {
+		if err := reminderschedule.TimeOfDayValidator(v); err != nil {
+			return &ValidationError{Name: "time_of_day", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.time_of_day": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InactivityDays(); ok {
+		if err := reminderschedule.InactivityDaysValidator(v); err != nil {
+			return &ValidationError{Name: "inactivity_days", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.inactivity_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Message(); ok {
+		if err := reminderschedule.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.message": %w`, err)}
+		}
+	}
+	if _u.mutation.PatientCleared() && len(_u.mutation.PatientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReminderSchedule.patient"`)
+	}
+	return nil
+}
+
+func (_u *ReminderScheduleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reminderschedule.Table, reminderschedule.Columns, sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(reminderschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(reminderschedule.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(reminderschedule.FieldChannel, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(reminderschedule.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TimeOfDay(); ok {
+		_spec.SetField(reminderschedule.FieldTimeOfDay, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Weekdays(); ok {
+		_spec.SetField(reminderschedule.FieldWeekdays, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWeekdays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reminderschedule.FieldWeekdays, value)
+		})
+	}
+	if _u.mutation.WeekdaysCleared() {
+		_spec.ClearField(reminderschedule.FieldWeekdays, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InactivityDays(); ok {
+		_spec.SetField(reminderschedule.FieldInactivityDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInactivityDays(); ok {
+		_spec.AddField(reminderschedule.FieldInactivityDays, field.TypeInt, value)
+	}
+	if _u.mutation.InactivityDaysCleared() {
+		_spec.ClearField(reminderschedule.FieldInactivityDays, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(reminderschedule.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(reminderschedule.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(reminderschedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(reminderschedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextRunAtCleared() {
+		_spec.ClearField(reminderschedule.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSentAt(); ok {
+		_spec.SetField(reminderschedule.FieldLastSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSentAtCleared() {
+		_spec.ClearField(reminderschedule.FieldLastSentAt, field.TypeTime)
+	}
+	if _u.mutation.PatientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderschedule.PatientTable,
+			Columns: []string{reminderschedule.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderschedule.PatientTable,
+			Columns: []string{reminderschedule.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderschedule.CreatedByTable,
+			Columns: []string{reminderschedule.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderschedule.CreatedByTable,
+			Columns: []string{reminderschedule.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reminderschedule.DeliveriesTable,
+			Columns: []string{reminderschedule.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reminderschedule.DeliveriesTable,
+			Columns: []string{reminderschedule.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reminderschedule.DeliveriesTable,
+			Columns: []string{reminderschedule.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reminderschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReminderScheduleUpdateOne is the builder for updating a single ReminderSchedule entity.
+type ReminderScheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReminderScheduleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReminderScheduleUpdateOne) SetUpdatedAt(v time.Time) *ReminderScheduleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *ReminderScheduleUpdateOne) SetPatientID(v uuid.UUID) *ReminderScheduleUpdateOne {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *ReminderScheduleUpdateOne) SetNillablePatientID(v *uuid.UUID) *ReminderScheduleUpdateOne {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetCreatedByDoctorID sets the "created_by_doctor_id" field.
+func (_u *ReminderScheduleUpdateOne) SetCreatedByDoctorID(v uuid.UUID) *ReminderScheduleUpdateOne {
+	_u.mutation.SetCreatedByDoctorID(v)
+	return _u
+}
+
+// SetNillableCreatedByDoctorID sets the "created_by_doctor_id" field if the given value is not nil.
+func (_u *ReminderScheduleUpdateOne) SetNillableCreatedByDoctorID(v *uuid.UUID) *ReminderScheduleUpdateOne {
+	if v != nil {
+		_u.SetCreatedByDoctorID(*v)
+	}
+	return _u
+}
+
+// ClearCreatedByDoctorID clears the value of the "created_by_doctor_id" field.
+func (_u *ReminderScheduleUpdateOne) ClearCreatedByDoctorID() *ReminderScheduleUpdateOne {
+	_u.mutation.ClearCreatedByDoctorID()
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ReminderScheduleUpdateOne) SetKind(v reminderschedule.Kind) *ReminderScheduleUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ReminderScheduleUpdateOne) SetNillableKind(v *reminderschedule.Kind) *ReminderScheduleUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *ReminderScheduleUpdateOne) SetChannel(v reminderschedule.Channel) *ReminderScheduleUpdateOne {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *ReminderScheduleUpdateOne) SetNillableChannel(v *reminderschedule.Channel) *ReminderScheduleUpdateOne {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *ReminderScheduleUpdateOne) SetTimezone(v string) *ReminderScheduleUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *ReminderScheduleUpdateOne) SetNillableTimezone(v *string) *ReminderScheduleUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetTimeOfDay sets the "time_of_day" field.
+func (_u *ReminderScheduleUpdateOne) SetTimeOfDay(v string) *ReminderScheduleUpdateOne {
+	_u.mutation.SetTimeOfDay(v)
+	return _u
+}
+
+// SetNillableTimeOfDay sets the "time_of_day" field if the given value is not nil.
+func (_u *ReminderScheduleUpdateOne) SetNillableTimeOfDay(v *string) *ReminderScheduleUpdateOne {
+	if v != nil {
+		_u.SetTimeOfDay(*v)
+	}
+	return _u
+}
+
+// SetWeekdays sets the "weekdays" field.
+func (_u *ReminderScheduleUpdateOne) SetWeekdays(v []string) *ReminderScheduleUpdateOne {
+	_u.mutation.SetWeekdays(v)
+	return _u
+}
+
+// AppendWeekdays appends value to the "weekdays" field.
+func (_u *ReminderScheduleUpdateOne) AppendWeekdays(v []string) *ReminderScheduleUpdateOne {
+	_u.mutation.AppendWeekdays(v)
+	return _u
+}
+
+// ClearWeekdays clears the value of the "weekdays" field.
+func (_u *ReminderScheduleUpdateOne) ClearWeekdays() *ReminderScheduleUpdateOne {
+	_u.mutation.ClearWeekdays()
+	return _u
+}
+
+// SetInactivityDays sets the "inactivity_days" field.
+func (_u *ReminderScheduleUpdateOne) SetInactivityDays(v int) *ReminderScheduleUpdateOne {
+	_u.mutation.ResetInactivityDays()
+	_u.mutation.SetInactivityDays(v)
+	return _u
+}
+
+// SetNillableInactivityDays sets the "inactivity_days" field if the given value is not nil.
+func (_u *ReminderScheduleUpdateOne) SetNillableInactivityDays(v *int) *ReminderScheduleUpdateOne {
+	if v != nil {
+		_u.SetInactivityDays(*v)
+	}
+	return _u
+}
+
+// AddInactivityDays adds value to the "inactivity_days" field.
+func (_u *ReminderScheduleUpdateOne) AddInactivityDays(v int) *ReminderScheduleUpdateOne {
+	_u.mutation.AddInactivityDays(v)
+	return _u
+}
+
+// ClearInactivityDays clears the value of the "inactivity_days" field.
+func (_u *ReminderScheduleUpdateOne) ClearInactivityDays() *ReminderScheduleUpdateOne {
+	_u.mutation.ClearInactivityDays()
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *ReminderScheduleUpdateOne) SetMessage(v string) *ReminderScheduleUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *ReminderScheduleUpdateOne) SetNillableMessage(v *string) *ReminderScheduleUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *ReminderScheduleUpdateOne) ClearMessage() *ReminderScheduleUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *ReminderScheduleUpdateOne) SetEnabled(v bool) *ReminderScheduleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *ReminderScheduleUpdateOne) SetNillableEnabled(v *bool) *ReminderScheduleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (_u *ReminderScheduleUpdateOne) SetNextRunAt(v time.Time) *ReminderScheduleUpdateOne {
+	_u.mutation.SetNextRunAt(v)
+	return _u
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (_u *ReminderScheduleUpdateOne) SetNillableNextRunAt(v *time.Time) *ReminderScheduleUpdateOne {
+	if v != nil {
+		_u.SetNextRunAt(*v)
+	}
+	return _u
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (_u *ReminderScheduleUpdateOne) ClearNextRunAt() *ReminderScheduleUpdateOne {
+	_u.mutation.ClearNextRunAt()
+	return _u
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (_u *ReminderScheduleUpdateOne) SetLastSentAt(v time.Time) *ReminderScheduleUpdateOne {
+	_u.mutation.SetLastSentAt(v)
+	return _u
+}
+
+// SetNillableLastSentAt sets the "last_sent_at" field if the given value is not nil.
+func (_u *ReminderScheduleUpdateOne) SetNillableLastSentAt(v *time.Time) *ReminderScheduleUpdateOne {
+	if v != nil {
+		_u.SetLastSentAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (_u *ReminderScheduleUpdateOne) ClearLastSentAt() *ReminderScheduleUpdateOne {
+	_u.mutation.ClearLastSentAt()
+	return _u
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_u *ReminderScheduleUpdateOne) SetPatient(v *Patient) *ReminderScheduleUpdateOne {
+	return _u.SetPatientID(v.ID)
+}
+
+// SetCreatedByID sets the "created_by" edge to the Doctor entity by ID.
+func (_u *ReminderScheduleUpdateOne) SetCreatedByID(id uuid.UUID) *ReminderScheduleUpdateOne {
+	_u.mutation.SetCreatedByID(id)
+	return _u
+}
+
+// SetNillableCreatedByID sets the "created_by" edge to the Doctor entity by ID if the given value is not nil.
+func (_u *ReminderScheduleUpdateOne) SetNillableCreatedByID(id *uuid.UUID) *ReminderScheduleUpdateOne {
+	if id != nil {
+		_u = _u.SetCreatedByID(*id)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" edge to the Doctor entity.
+func (_u *ReminderScheduleUpdateOne) SetCreatedBy(v *Doctor) *ReminderScheduleUpdateOne {
+	return _u.SetCreatedByID(v.ID)
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the ReminderDelivery entity by IDs.
+func (_u *ReminderScheduleUpdateOne) AddDeliveryIDs(ids ...uuid.UUID) *ReminderScheduleUpdateOne {
+	_u.mutation.AddDeliveryIDs(ids...)
+	return _u
+}
+
+// AddDeliveries adds the "deliveries" edges to the ReminderDelivery entity.
+func (_u *ReminderScheduleUpdateOne) AddDeliveries(v ...*ReminderDelivery) *ReminderScheduleUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the ReminderScheduleMutation object of the builder.
+func (_u *ReminderScheduleUpdateOne) Mutation() *ReminderScheduleMutation {
+	return _u.mutation
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (_u *ReminderScheduleUpdateOne) ClearPatient() *ReminderScheduleUpdateOne {
+	_u.mutation.ClearPatient()
+	return _u
+}
+
+// ClearCreatedBy clears the "created_by" edge to the Doctor entity.
+func (_u *ReminderScheduleUpdateOne) ClearCreatedBy() *ReminderScheduleUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// ClearDeliveries clears all "deliveries" edges to the ReminderDelivery entity.
+func (_u *ReminderScheduleUpdateOne) ClearDeliveries() *ReminderScheduleUpdateOne {
+	_u.mutation.ClearDeliveries()
+	return _u
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to ReminderDelivery entities by IDs.
+func (_u *ReminderScheduleUpdateOne) RemoveDeliveryIDs(ids ...uuid.UUID) *ReminderScheduleUpdateOne {
+	_u.mutation.RemoveDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveDeliveries removes "deliveries" edges to ReminderDelivery entities.
+func (_u *ReminderScheduleUpdateOne) RemoveDeliveries(v ...*ReminderDelivery) *ReminderScheduleUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeliveryIDs(ids...)
+}
+
+// Where appends a list predicates to the ReminderScheduleUpdate builder.
+func (_u *ReminderScheduleUpdateOne) Where(ps ...predicate.ReminderSchedule) *ReminderScheduleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReminderScheduleUpdateOne) Select(field string, fields ...string) *ReminderScheduleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReminderSchedule entity.
+func (_u *ReminderScheduleUpdateOne) Save(ctx context.Context) (*ReminderSchedule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReminderScheduleUpdateOne) SaveX(ctx context.Context) *ReminderSchedule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReminderScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReminderScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReminderScheduleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := reminderschedule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReminderScheduleUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := reminderschedule.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := reminderschedule.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.channel": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := reminderschedule.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TimeOfDay(); ok {
+		if err := reminderschedule.TimeOfDayValidator(v); err != nil {
+			return &ValidationError{Name: "time_of_day", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.time_of_day": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.InactivityDays(); ok {
+		if err := reminderschedule.InactivityDaysValidator(v); err != nil {
+			return &ValidationError{Name: "inactivity_days", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.inactivity_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Message(); ok {
+		if err := reminderschedule.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "ReminderSchedule.message": %w`, err)}
+		}
+	}
+	if _u.mutation.PatientCleared() && len(_u.mutation.PatientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReminderSchedule.patient"`)
+	}
+	return nil
+}
+
+func (_u *ReminderScheduleUpdateOne) sqlSave(ctx context.Context) (_node *ReminderSchedule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reminderschedule.Table, reminderschedule.Columns, sqlgraph.NewFieldSpec(reminderschedule.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReminderSchedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reminderschedule.FieldID)
+		for _, f := range fields {
+			if !reminderschedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reminderschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(reminderschedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(reminderschedule.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(reminderschedule.FieldChannel, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(reminderschedule.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TimeOfDay(); ok {
+		_spec.SetField(reminderschedule.FieldTimeOfDay, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Weekdays(); ok {
+		_spec.SetField(reminderschedule.FieldWeekdays, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWeekdays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reminderschedule.FieldWeekdays, value)
+		})
+	}
+	if _u.mutation.WeekdaysCleared() {
+		_spec.ClearField(reminderschedule.FieldWeekdays, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InactivityDays(); ok {
+		_spec.SetField(reminderschedule.FieldInactivityDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInactivityDays(); ok {
+		_spec.AddField(reminderschedule.FieldInactivityDays, field.TypeInt, value)
+	}
+	if _u.mutation.InactivityDaysCleared() {
+		_spec.ClearField(reminderschedule.FieldInactivityDays, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(reminderschedule.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(reminderschedule.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(reminderschedule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NextRunAt(); ok {
+		_spec.SetField(reminderschedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextRunAtCleared() {
+		_spec.ClearField(reminderschedule.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSentAt(); ok {
+		_spec.SetField(reminderschedule.FieldLastSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSentAtCleared() {
+		_spec.ClearField(reminderschedule.FieldLastSentAt, field.TypeTime)
+	}
+	if _u.mutation.PatientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderschedule.PatientTable,
+			Columns: []string{reminderschedule.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderschedule.PatientTable,
+			Columns: []string{reminderschedule.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CreatedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderschedule.CreatedByTable,
+			Columns: []string{reminderschedule.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CreatedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminderschedule.CreatedByTable,
+			Columns: []string{reminderschedule.CreatedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reminderschedule.DeliveriesTable,
+			Columns: []string{reminderschedule.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reminderschedule.DeliveriesTable,
+			Columns: []string{reminderschedule.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reminderschedule.DeliveriesTable,
+			Columns: []string{reminderschedule.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminderdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReminderSchedule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reminderschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -4,15 +4,30 @@ package ent
 
 import (
 	"backend/ent/analysisjob"
+	"backend/ent/assignment"
 	"backend/ent/comment"
 	"backend/ent/doctor"
 	"backend/ent/doctorpatientlink"
 	"backend/ent/entry"
 	"backend/ent/entryshare"
+	"backend/ent/exerciselog"
+	"backend/ent/goal"
+	"backend/ent/idempotencykey"
+	"backend/ent/outboxevent"
 	"backend/ent/pairingcode"
 	"backend/ent/patient"
 	"backend/ent/practice"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/ent/questionnaireresponse"
+	"backend/ent/ratelimitbucket"
+	"backend/ent/reminderdelivery"
+	"backend/ent/reminderschedule"
 	"backend/ent/schema"
+	"backend/ent/session"
+	"backend/ent/vocabularyterm"
+	"backend/ent/webhookdelivery"
+	"backend/ent/webhooksubscription"
 	"time"
 
 	"github.com/google/uuid"
@@ -51,6 +66,45 @@ func init() {
 	analysisjobDescID := analysisjobMixinFields0[0].Descriptor()
 	// analysisjob.DefaultID holds the default value on creation for the id field.
 	analysisjob.DefaultID = analysisjobDescID.Default.(func() uuid.UUID)
+	assignmentMixin := schema.Assignment{}.Mixin()
+	assignmentMixinFields0 := assignmentMixin[0].Fields()
+	_ = assignmentMixinFields0
+	assignmentMixinFields1 := assignmentMixin[1].Fields()
+	_ = assignmentMixinFields1
+	assignmentFields := schema.Assignment{}.Fields()
+	_ = assignmentFields
+	// assignmentDescCreatedAt is the schema descriptor for created_at field.
+	assignmentDescCreatedAt := assignmentMixinFields1[0].Descriptor()
+	// assignment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	assignment.DefaultCreatedAt = assignmentDescCreatedAt.Default.(func() time.Time)
+	// assignmentDescUpdatedAt is the schema descriptor for updated_at field.
+	assignmentDescUpdatedAt := assignmentMixinFields1[1].Descriptor()
+	// assignment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	assignment.DefaultUpdatedAt = assignmentDescUpdatedAt.Default.(func() time.Time)
+	// assignment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	assignment.UpdateDefaultUpdatedAt = assignmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// assignmentDescTitle is the schema descriptor for title field.
+	assignmentDescTitle := assignmentFields[2].Descriptor()
+	// assignment.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	assignment.TitleValidator = func() func(string) error {
+		validators := assignmentDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// assignmentDescID is the schema descriptor for id field.
+	assignmentDescID := assignmentMixinFields0[0].Descriptor()
+	// assignment.DefaultID holds the default value on creation for the id field.
+	assignment.DefaultID = assignmentDescID.Default.(func() uuid.UUID)
 	commentMixin := schema.Comment{}.Mixin()
 	commentMixinFields0 := commentMixin[0].Fields()
 	_ = commentMixinFields0
@@ -176,6 +230,123 @@ func init() {
 	entryshareDescID := entryshareMixinFields0[0].Descriptor()
 	// entryshare.DefaultID holds the default value on creation for the id field.
 	entryshare.DefaultID = entryshareDescID.Default.(func() uuid.UUID)
+	exerciselogMixin := schema.ExerciseLog{}.Mixin()
+	exerciselogMixinFields0 := exerciselogMixin[0].Fields()
+	_ = exerciselogMixinFields0
+	exerciselogMixinFields1 := exerciselogMixin[1].Fields()
+	_ = exerciselogMixinFields1
+	exerciselogFields := schema.ExerciseLog{}.Fields()
+	_ = exerciselogFields
+	// exerciselogDescCreatedAt is the schema descriptor for created_at field.
+	exerciselogDescCreatedAt := exerciselogMixinFields1[0].Descriptor()
+	// exerciselog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	exerciselog.DefaultCreatedAt = exerciselogDescCreatedAt.Default.(func() time.Time)
+	// exerciselogDescUpdatedAt is the schema descriptor for updated_at field.
+	exerciselogDescUpdatedAt := exerciselogMixinFields1[1].Descriptor()
+	// exerciselog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	exerciselog.DefaultUpdatedAt = exerciselogDescUpdatedAt.Default.(func() time.Time)
+	// exerciselog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	exerciselog.UpdateDefaultUpdatedAt = exerciselogDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// exerciselogDescCompletedAt is the schema descriptor for completed_at field.
+	exerciselogDescCompletedAt := exerciselogFields[2].Descriptor()
+	// exerciselog.DefaultCompletedAt holds the default value on creation for the completed_at field.
+	exerciselog.DefaultCompletedAt = exerciselogDescCompletedAt.Default.(func() time.Time)
+	// exerciselogDescID is the schema descriptor for id field.
+	exerciselogDescID := exerciselogMixinFields0[0].Descriptor()
+	// exerciselog.DefaultID holds the default value on creation for the id field.
+	exerciselog.DefaultID = exerciselogDescID.Default.(func() uuid.UUID)
+	goalMixin := schema.Goal{}.Mixin()
+	goalMixinFields0 := goalMixin[0].Fields()
+	_ = goalMixinFields0
+	goalMixinFields1 := goalMixin[1].Fields()
+	_ = goalMixinFields1
+	goalFields := schema.Goal{}.Fields()
+	_ = goalFields
+	// goalDescCreatedAt is the schema descriptor for created_at field.
+	goalDescCreatedAt := goalMixinFields1[0].Descriptor()
+	// goal.DefaultCreatedAt holds the default value on creation for the created_at field.
+	goal.DefaultCreatedAt = goalDescCreatedAt.Default.(func() time.Time)
+	// goalDescUpdatedAt is the schema descriptor for updated_at field.
+	goalDescUpdatedAt := goalMixinFields1[1].Descriptor()
+	// goal.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	goal.DefaultUpdatedAt = goalDescUpdatedAt.Default.(func() time.Time)
+	// goal.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	goal.UpdateDefaultUpdatedAt = goalDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// goalDescTitle is the schema descriptor for title field.
+	goalDescTitle := goalFields[2].Descriptor()
+	// goal.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	goal.TitleValidator = func() func(string) error {
+		validators := goalDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// goalDescTargetCount is the schema descriptor for target_count field.
+	goalDescTargetCount := goalFields[7].Descriptor()
+	// goal.TargetCountValidator is a validator for the "target_count" field. It is called by the builders before save.
+	goal.TargetCountValidator = goalDescTargetCount.Validators[0].(func(int) error)
+	// goalDescStartsAt is the schema descriptor for starts_at field.
+	goalDescStartsAt := goalFields[9].Descriptor()
+	// goal.DefaultStartsAt holds the default value on creation for the starts_at field.
+	goal.DefaultStartsAt = goalDescStartsAt.Default.(func() time.Time)
+	// goalDescID is the schema descriptor for id field.
+	goalDescID := goalMixinFields0[0].Descriptor()
+	// goal.DefaultID holds the default value on creation for the id field.
+	goal.DefaultID = goalDescID.Default.(func() uuid.UUID)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescStatus is the schema descriptor for status field.
+	idempotencykeyDescStatus := idempotencykeyFields[2].Descriptor()
+	// idempotencykey.DefaultStatus holds the default value on creation for the status field.
+	idempotencykey.DefaultStatus = idempotencykeyDescStatus.Default.(int)
+	// idempotencykeyDescContentType is the schema descriptor for content_type field.
+	idempotencykeyDescContentType := idempotencykeyFields[3].Descriptor()
+	// idempotencykey.DefaultContentType holds the default value on creation for the content_type field.
+	idempotencykey.DefaultContentType = idempotencykeyDescContentType.Default.(string)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	idempotencykey.IDValidator = idempotencykeyDescID.Validators[0].(func(string) error)
+	outboxeventMixin := schema.OutboxEvent{}.Mixin()
+	outboxeventMixinFields0 := outboxeventMixin[0].Fields()
+	_ = outboxeventMixinFields0
+	outboxeventMixinFields1 := outboxeventMixin[1].Fields()
+	_ = outboxeventMixinFields1
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventMixinFields1[0].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
+	// outboxeventDescUpdatedAt is the schema descriptor for updated_at field.
+	outboxeventDescUpdatedAt := outboxeventMixinFields1[1].Descriptor()
+	// outboxevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	outboxevent.DefaultUpdatedAt = outboxeventDescUpdatedAt.Default.(func() time.Time)
+	// outboxevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	outboxevent.UpdateDefaultUpdatedAt = outboxeventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// outboxeventDescEventType is the schema descriptor for event_type field.
+	outboxeventDescEventType := outboxeventFields[0].Descriptor()
+	// outboxevent.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	outboxevent.EventTypeValidator = outboxeventDescEventType.Validators[0].(func(string) error)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[4].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
+	// outboxevent.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	outboxevent.AttemptsValidator = outboxeventDescAttempts.Validators[0].(func(int) error)
+	// outboxeventDescID is the schema descriptor for id field.
+	outboxeventDescID := outboxeventMixinFields0[0].Descriptor()
+	// outboxevent.DefaultID holds the default value on creation for the id field.
+	outboxevent.DefaultID = outboxeventDescID.Default.(func() uuid.UUID)
 	pairingcodeMixin := schema.PairingCode{}.Mixin()
 	pairingcodeMixinFields0 := pairingcodeMixin[0].Fields()
 	_ = pairingcodeMixinFields0
@@ -269,4 +440,357 @@ func init() {
 	practiceDescID := practiceMixinFields0[0].Descriptor()
 	// practice.DefaultID holds the default value on creation for the id field.
 	practice.DefaultID = practiceDescID.Default.(func() uuid.UUID)
+	questionnaireassignmentMixin := schema.QuestionnaireAssignment{}.Mixin()
+	questionnaireassignmentMixinFields0 := questionnaireassignmentMixin[0].Fields()
+	_ = questionnaireassignmentMixinFields0
+	questionnaireassignmentMixinFields1 := questionnaireassignmentMixin[1].Fields()
+	_ = questionnaireassignmentMixinFields1
+	questionnaireassignmentFields := schema.QuestionnaireAssignment{}.Fields()
+	_ = questionnaireassignmentFields
+	// questionnaireassignmentDescCreatedAt is the schema descriptor for created_at field.
+	questionnaireassignmentDescCreatedAt := questionnaireassignmentMixinFields1[0].Descriptor()
+	// questionnaireassignment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	questionnaireassignment.DefaultCreatedAt = questionnaireassignmentDescCreatedAt.Default.(func() time.Time)
+	// questionnaireassignmentDescUpdatedAt is the schema descriptor for updated_at field.
+	questionnaireassignmentDescUpdatedAt := questionnaireassignmentMixinFields1[1].Descriptor()
+	// questionnaireassignment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	questionnaireassignment.DefaultUpdatedAt = questionnaireassignmentDescUpdatedAt.Default.(func() time.Time)
+	// questionnaireassignment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	questionnaireassignment.UpdateDefaultUpdatedAt = questionnaireassignmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// questionnaireassignmentDescID is the schema descriptor for id field.
+	questionnaireassignmentDescID := questionnaireassignmentMixinFields0[0].Descriptor()
+	// questionnaireassignment.DefaultID holds the default value on creation for the id field.
+	questionnaireassignment.DefaultID = questionnaireassignmentDescID.Default.(func() uuid.UUID)
+	questionnairedefinitionMixin := schema.QuestionnaireDefinition{}.Mixin()
+	questionnairedefinitionMixinFields0 := questionnairedefinitionMixin[0].Fields()
+	_ = questionnairedefinitionMixinFields0
+	questionnairedefinitionMixinFields1 := questionnairedefinitionMixin[1].Fields()
+	_ = questionnairedefinitionMixinFields1
+	questionnairedefinitionFields := schema.QuestionnaireDefinition{}.Fields()
+	_ = questionnairedefinitionFields
+	// questionnairedefinitionDescCreatedAt is the schema descriptor for created_at field.
+	questionnairedefinitionDescCreatedAt := questionnairedefinitionMixinFields1[0].Descriptor()
+	// questionnairedefinition.DefaultCreatedAt holds the default value on creation for the created_at field.
+	questionnairedefinition.DefaultCreatedAt = questionnairedefinitionDescCreatedAt.Default.(func() time.Time)
+	// questionnairedefinitionDescUpdatedAt is the schema descriptor for updated_at field.
+	questionnairedefinitionDescUpdatedAt := questionnairedefinitionMixinFields1[1].Descriptor()
+	// questionnairedefinition.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	questionnairedefinition.DefaultUpdatedAt = questionnairedefinitionDescUpdatedAt.Default.(func() time.Time)
+	// questionnairedefinition.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	questionnairedefinition.UpdateDefaultUpdatedAt = questionnairedefinitionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// questionnairedefinitionDescKey is the schema descriptor for key field.
+	questionnairedefinitionDescKey := questionnairedefinitionFields[0].Descriptor()
+	// questionnairedefinition.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	questionnairedefinition.KeyValidator = func() func(string) error {
+		validators := questionnairedefinitionDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// questionnairedefinitionDescVersion is the schema descriptor for version field.
+	questionnairedefinitionDescVersion := questionnairedefinitionFields[1].Descriptor()
+	// questionnairedefinition.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	questionnairedefinition.VersionValidator = questionnairedefinitionDescVersion.Validators[0].(func(int) error)
+	// questionnairedefinitionDescTitle is the schema descriptor for title field.
+	questionnairedefinitionDescTitle := questionnairedefinitionFields[2].Descriptor()
+	// questionnairedefinition.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	questionnairedefinition.TitleValidator = func() func(string) error {
+		validators := questionnairedefinitionDescTitle.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(title string) error {
+			for _, fn := range fns {
+				if err := fn(title); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// questionnairedefinitionDescActive is the schema descriptor for active field.
+	questionnairedefinitionDescActive := questionnairedefinitionFields[6].Descriptor()
+	// questionnairedefinition.DefaultActive holds the default value on creation for the active field.
+	questionnairedefinition.DefaultActive = questionnairedefinitionDescActive.Default.(bool)
+	// questionnairedefinitionDescID is the schema descriptor for id field.
+	questionnairedefinitionDescID := questionnairedefinitionMixinFields0[0].Descriptor()
+	// questionnairedefinition.DefaultID holds the default value on creation for the id field.
+	questionnairedefinition.DefaultID = questionnairedefinitionDescID.Default.(func() uuid.UUID)
+	questionnaireresponseMixin := schema.QuestionnaireResponse{}.Mixin()
+	questionnaireresponseMixinFields0 := questionnaireresponseMixin[0].Fields()
+	_ = questionnaireresponseMixinFields0
+	questionnaireresponseMixinFields1 := questionnaireresponseMixin[1].Fields()
+	_ = questionnaireresponseMixinFields1
+	questionnaireresponseFields := schema.QuestionnaireResponse{}.Fields()
+	_ = questionnaireresponseFields
+	// questionnaireresponseDescCreatedAt is the schema descriptor for created_at field.
+	questionnaireresponseDescCreatedAt := questionnaireresponseMixinFields1[0].Descriptor()
+	// questionnaireresponse.DefaultCreatedAt holds the default value on creation for the created_at field.
+	questionnaireresponse.DefaultCreatedAt = questionnaireresponseDescCreatedAt.Default.(func() time.Time)
+	// questionnaireresponseDescUpdatedAt is the schema descriptor for updated_at field.
+	questionnaireresponseDescUpdatedAt := questionnaireresponseMixinFields1[1].Descriptor()
+	// questionnaireresponse.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	questionnaireresponse.DefaultUpdatedAt = questionnaireresponseDescUpdatedAt.Default.(func() time.Time)
+	// questionnaireresponse.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	questionnaireresponse.UpdateDefaultUpdatedAt = questionnaireresponseDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// questionnaireresponseDescSubmittedAt is the schema descriptor for submitted_at field.
+	questionnaireresponseDescSubmittedAt := questionnaireresponseFields[5].Descriptor()
+	// questionnaireresponse.DefaultSubmittedAt holds the default value on creation for the submitted_at field.
+	questionnaireresponse.DefaultSubmittedAt = questionnaireresponseDescSubmittedAt.Default.(func() time.Time)
+	// questionnaireresponseDescID is the schema descriptor for id field.
+	questionnaireresponseDescID := questionnaireresponseMixinFields0[0].Descriptor()
+	// questionnaireresponse.DefaultID holds the default value on creation for the id field.
+	questionnaireresponse.DefaultID = questionnaireresponseDescID.Default.(func() uuid.UUID)
+	ratelimitbucketFields := schema.RateLimitBucket{}.Fields()
+	_ = ratelimitbucketFields
+	// ratelimitbucketDescID is the schema descriptor for id field.
+	ratelimitbucketDescID := ratelimitbucketFields[0].Descriptor()
+	// ratelimitbucket.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	ratelimitbucket.IDValidator = ratelimitbucketDescID.Validators[0].(func(string) error)
+	reminderdeliveryMixin := schema.ReminderDelivery{}.Mixin()
+	reminderdeliveryMixinFields0 := reminderdeliveryMixin[0].Fields()
+	_ = reminderdeliveryMixinFields0
+	reminderdeliveryMixinFields1 := reminderdeliveryMixin[1].Fields()
+	_ = reminderdeliveryMixinFields1
+	reminderdeliveryFields := schema.ReminderDelivery{}.Fields()
+	_ = reminderdeliveryFields
+	// reminderdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	reminderdeliveryDescCreatedAt := reminderdeliveryMixinFields1[0].Descriptor()
+	// reminderdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reminderdelivery.DefaultCreatedAt = reminderdeliveryDescCreatedAt.Default.(func() time.Time)
+	// reminderdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	reminderdeliveryDescUpdatedAt := reminderdeliveryMixinFields1[1].Descriptor()
+	// reminderdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reminderdelivery.DefaultUpdatedAt = reminderdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// reminderdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reminderdelivery.UpdateDefaultUpdatedAt = reminderdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// reminderdeliveryDescID is the schema descriptor for id field.
+	reminderdeliveryDescID := reminderdeliveryMixinFields0[0].Descriptor()
+	// reminderdelivery.DefaultID holds the default value on creation for the id field.
+	reminderdelivery.DefaultID = reminderdeliveryDescID.Default.(func() uuid.UUID)
+	reminderscheduleMixin := schema.ReminderSchedule{}.Mixin()
+	reminderscheduleMixinFields0 := reminderscheduleMixin[0].Fields()
+	_ = reminderscheduleMixinFields0
+	reminderscheduleMixinFields1 := reminderscheduleMixin[1].Fields()
+	_ = reminderscheduleMixinFields1
+	reminderscheduleFields := schema.ReminderSchedule{}.Fields()
+	_ = reminderscheduleFields
+	// reminderscheduleDescCreatedAt is the schema descriptor for created_at field.
+	reminderscheduleDescCreatedAt := reminderscheduleMixinFields1[0].Descriptor()
+	// reminderschedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reminderschedule.DefaultCreatedAt = reminderscheduleDescCreatedAt.Default.(func() time.Time)
+	// reminderscheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	reminderscheduleDescUpdatedAt := reminderscheduleMixinFields1[1].Descriptor()
+	// reminderschedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reminderschedule.DefaultUpdatedAt = reminderscheduleDescUpdatedAt.Default.(func() time.Time)
+	// reminderschedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reminderschedule.UpdateDefaultUpdatedAt = reminderscheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// reminderscheduleDescTimezone is the schema descriptor for timezone field.
+	reminderscheduleDescTimezone := reminderscheduleFields[4].Descriptor()
+	// reminderschedule.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	reminderschedule.TimezoneValidator = func() func(string) error {
+		validators := reminderscheduleDescTimezone.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(timezone string) error {
+			for _, fn := range fns {
+				if err := fn(timezone); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// reminderscheduleDescTimeOfDay is the schema descriptor for time_of_day field.
+	reminderscheduleDescTimeOfDay := reminderscheduleFields[5].Descriptor()
+	// reminderschedule.TimeOfDayValidator is a validator for the "time_of_day" field. It is called by the builders before save.
+	reminderschedule.TimeOfDayValidator = func() func(string) error {
+		validators := reminderscheduleDescTimeOfDay.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(time_of_day string) error {
+			for _, fn := range fns {
+				if err := fn(time_of_day); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// reminderscheduleDescInactivityDays is the schema descriptor for inactivity_days field.
+	reminderscheduleDescInactivityDays := reminderscheduleFields[7].Descriptor()
+	// reminderschedule.InactivityDaysValidator is a validator for the "inactivity_days" field. It is called by the builders before save.
+	reminderschedule.InactivityDaysValidator = reminderscheduleDescInactivityDays.Validators[0].(func(int) error)
+	// reminderscheduleDescMessage is the schema descriptor for message field.
+	reminderscheduleDescMessage := reminderscheduleFields[8].Descriptor()
+	// reminderschedule.MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	reminderschedule.MessageValidator = reminderscheduleDescMessage.Validators[0].(func(string) error)
+	// reminderscheduleDescEnabled is the schema descriptor for enabled field.
+	reminderscheduleDescEnabled := reminderscheduleFields[9].Descriptor()
+	// reminderschedule.DefaultEnabled holds the default value on creation for the enabled field.
+	reminderschedule.DefaultEnabled = reminderscheduleDescEnabled.Default.(bool)
+	// reminderscheduleDescID is the schema descriptor for id field.
+	reminderscheduleDescID := reminderscheduleMixinFields0[0].Descriptor()
+	// reminderschedule.DefaultID holds the default value on creation for the id field.
+	reminderschedule.DefaultID = reminderscheduleDescID.Default.(func() uuid.UUID)
+	sessionMixin := schema.Session{}.Mixin()
+	sessionMixinFields0 := sessionMixin[0].Fields()
+	_ = sessionMixinFields0
+	sessionMixinFields1 := sessionMixin[1].Fields()
+	_ = sessionMixinFields1
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionMixinFields1[0].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescUpdatedAt is the schema descriptor for updated_at field.
+	sessionDescUpdatedAt := sessionMixinFields1[1].Descriptor()
+	// session.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	session.DefaultUpdatedAt = sessionDescUpdatedAt.Default.(func() time.Time)
+	// session.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	session.UpdateDefaultUpdatedAt = sessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// sessionDescID is the schema descriptor for id field.
+	sessionDescID := sessionMixinFields0[0].Descriptor()
+	// session.DefaultID holds the default value on creation for the id field.
+	session.DefaultID = sessionDescID.Default.(func() uuid.UUID)
+	vocabularytermMixin := schema.VocabularyTerm{}.Mixin()
+	vocabularytermMixinFields0 := vocabularytermMixin[0].Fields()
+	_ = vocabularytermMixinFields0
+	vocabularytermMixinFields1 := vocabularytermMixin[1].Fields()
+	_ = vocabularytermMixinFields1
+	vocabularytermFields := schema.VocabularyTerm{}.Fields()
+	_ = vocabularytermFields
+	// vocabularytermDescCreatedAt is the schema descriptor for created_at field.
+	vocabularytermDescCreatedAt := vocabularytermMixinFields1[0].Descriptor()
+	// vocabularyterm.DefaultCreatedAt holds the default value on creation for the created_at field.
+	vocabularyterm.DefaultCreatedAt = vocabularytermDescCreatedAt.Default.(func() time.Time)
+	// vocabularytermDescUpdatedAt is the schema descriptor for updated_at field.
+	vocabularytermDescUpdatedAt := vocabularytermMixinFields1[1].Descriptor()
+	// vocabularyterm.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	vocabularyterm.DefaultUpdatedAt = vocabularytermDescUpdatedAt.Default.(func() time.Time)
+	// vocabularyterm.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	vocabularyterm.UpdateDefaultUpdatedAt = vocabularytermDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// vocabularytermDescKey is the schema descriptor for key field.
+	vocabularytermDescKey := vocabularytermFields[1].Descriptor()
+	// vocabularyterm.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	vocabularyterm.KeyValidator = func() func(string) error {
+		validators := vocabularytermDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// vocabularytermDescActive is the schema descriptor for active field.
+	vocabularytermDescActive := vocabularytermFields[6].Descriptor()
+	// vocabularyterm.DefaultActive holds the default value on creation for the active field.
+	vocabularyterm.DefaultActive = vocabularytermDescActive.Default.(bool)
+	// vocabularytermDescID is the schema descriptor for id field.
+	vocabularytermDescID := vocabularytermMixinFields0[0].Descriptor()
+	// vocabularyterm.DefaultID holds the default value on creation for the id field.
+	vocabularyterm.DefaultID = vocabularytermDescID.Default.(func() uuid.UUID)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryMixinFields1 := webhookdeliveryMixin[1].Fields()
+	_ = webhookdeliveryMixinFields1
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryMixinFields1[0].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryMixinFields1[1].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescEventType is the schema descriptor for event_type field.
+	webhookdeliveryDescEventType := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	webhookdelivery.EventTypeValidator = webhookdeliveryDescEventType.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdelivery.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	webhookdelivery.AttemptsValidator = webhookdeliveryDescAttempts.Validators[0].(func(int) error)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() uuid.UUID)
+	webhooksubscriptionMixin := schema.WebhookSubscription{}.Mixin()
+	webhooksubscriptionMixinFields0 := webhooksubscriptionMixin[0].Fields()
+	_ = webhooksubscriptionMixinFields0
+	webhooksubscriptionMixinFields1 := webhooksubscriptionMixin[1].Fields()
+	_ = webhooksubscriptionMixinFields1
+	webhooksubscriptionFields := schema.WebhookSubscription{}.Fields()
+	_ = webhooksubscriptionFields
+	// webhooksubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	webhooksubscriptionDescCreatedAt := webhooksubscriptionMixinFields1[0].Descriptor()
+	// webhooksubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhooksubscription.DefaultCreatedAt = webhooksubscriptionDescCreatedAt.Default.(func() time.Time)
+	// webhooksubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	webhooksubscriptionDescUpdatedAt := webhooksubscriptionMixinFields1[1].Descriptor()
+	// webhooksubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhooksubscription.DefaultUpdatedAt = webhooksubscriptionDescUpdatedAt.Default.(func() time.Time)
+	// webhooksubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhooksubscription.UpdateDefaultUpdatedAt = webhooksubscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhooksubscriptionDescURL is the schema descriptor for url field.
+	webhooksubscriptionDescURL := webhooksubscriptionFields[2].Descriptor()
+	// webhooksubscription.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhooksubscription.URLValidator = func() func(string) error {
+		validators := webhooksubscriptionDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhooksubscriptionDescSecret is the schema descriptor for secret field.
+	webhooksubscriptionDescSecret := webhooksubscriptionFields[3].Descriptor()
+	// webhooksubscription.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhooksubscription.SecretValidator = webhooksubscriptionDescSecret.Validators[0].(func(string) error)
+	// webhooksubscriptionDescDescription is the schema descriptor for description field.
+	webhooksubscriptionDescDescription := webhooksubscriptionFields[5].Descriptor()
+	// webhooksubscription.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	webhooksubscription.DescriptionValidator = webhooksubscriptionDescDescription.Validators[0].(func(string) error)
+	// webhooksubscriptionDescActive is the schema descriptor for active field.
+	webhooksubscriptionDescActive := webhooksubscriptionFields[6].Descriptor()
+	// webhooksubscription.DefaultActive holds the default value on creation for the active field.
+	webhooksubscription.DefaultActive = webhooksubscriptionDescActive.Default.(bool)
+	// webhooksubscriptionDescID is the schema descriptor for id field.
+	webhooksubscriptionDescID := webhooksubscriptionMixinFields0[0].Descriptor()
+	// webhooksubscription.DefaultID holds the default value on creation for the id field.
+	webhooksubscription.DefaultID = webhooksubscriptionDescID.Default.(func() uuid.UUID)
 }
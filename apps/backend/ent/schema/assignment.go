@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Assignment is a practice exercise a doctor sets between sessions. Recurring
+// assignments expect one completion per day or week until they are due.
+type Assignment struct {
+	ent.Schema
+}
+
+func (Assignment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDMixin{},
+		TimeMixin{},
+	}
+}
+
+func (Assignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("patient_id", uuid.UUID{}),
+		field.UUID("doctor_id", uuid.UUID{}),
+
+		field.String("title").NotEmpty().MaxLen(200),
+		field.String("instructions").Optional().Nillable(),
+
+		field.Time("due_at").Optional().Nillable(),
+		field.Enum("recurrence").Values("None", "Daily", "Weekly").Default("None"),
+
+		// Object key of an optional audio example in object storage.
+		field.String("attachment_key").Optional().Nillable(),
+		field.String("attachment_content_type").Optional().Nillable(),
+
+		field.Enum("status").Values("Active", "Archived").Default("Active"),
+	}
+}
+
+func (Assignment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("patient_id", "status"),
+		index.Fields("doctor_id"),
+	}
+}
+
+func (Assignment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("patient", Patient.Type).
+			Ref("assignments").
+			Field("patient_id").
+			Unique().
+			Required(),
+
+		edge.From("doctor", Doctor.Type).
+			Ref("assigned_exercises").
+			Field("doctor_id").
+			Unique().
+			Required(),
+
+		edge.To("logs", ExerciseLog.Type),
+	}
+}
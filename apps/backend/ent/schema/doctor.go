@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -54,5 +55,15 @@ func (Doctor) Edges() []ent.Edge {
 		edge.To("entry_shares", EntryShare.Type),
 		edge.To("comments", Comment.Type),
 		edge.To("created_analysis_jobs", AnalysisJob.Type),
+		edge.To("assigned_goals", Goal.Type),
+		edge.To("assigned_exercises", Assignment.Type),
+		edge.To("sessions", Session.Type),
+		edge.To("questionnaire_assignments", QuestionnaireAssignment.Type),
+		edge.To("questionnaire_definitions", QuestionnaireDefinition.Type),
+		edge.To("reminder_schedules", ReminderSchedule.Type),
+		edge.To("webhook_subscriptions", WebhookSubscription.Type),
+		// Scoped terms must not outlive their owner and turn global.
+		edge.To("vocabulary_terms", VocabularyTerm.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
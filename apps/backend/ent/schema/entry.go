@@ -50,6 +50,9 @@ func (Entry) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("patient_id", "happened_at"),
 		index.Fields("happened_at"),
+		// The full-text "entry_search" GIN index is an expression index ent
+		// cannot declare; it is created by a hand-written migration and
+		// excluded from diffs in atlas.hcl.
 	}
 }
 
@@ -64,5 +67,8 @@ func (Entry) Edges() []ent.Edge {
 		edge.To("shares", EntryShare.Type),
 		edge.To("comments", Comment.Type),
 		edge.To("analysis_jobs", AnalysisJob.Type),
+		edge.To("exercise_logs", ExerciseLog.Type),
+		edge.From("sessions", Session.Type).
+			Ref("entries"),
 	}
 }
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ExerciseLog records that a patient completed an assignment, optionally
+// linked to the journal entry describing how it went.
+type ExerciseLog struct {
+	ent.Schema
+}
+
+func (ExerciseLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDMixin{},
+		TimeMixin{},
+	}
+}
+
+func (ExerciseLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("assignment_id", uuid.UUID{}),
+		field.UUID("entry_id", uuid.UUID{}).Optional().Nillable(),
+
+		field.Time("completed_at").Default(time.Now),
+		field.Int("duration_minutes").Optional().Nillable(),
+		field.String("notes").Optional().Nillable(),
+	}
+}
+
+func (ExerciseLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("assignment_id", "completed_at"),
+	}
+}
+
+func (ExerciseLog) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("assignment", Assignment.Type).
+			Ref("logs").
+			Field("assignment_id").
+			Unique().
+			Required(),
+
+		edge.From("entry", Entry.Type).
+			Ref("exercise_logs").
+			Field("entry_id").
+			Unique(),
+	}
+}
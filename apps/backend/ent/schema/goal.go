@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Goal is a treatment goal a doctor sets for a linked patient, e.g. "use easy
+// onset in 3 phone calls per week". Progress is computed from matching entries.
+type Goal struct {
+	ent.Schema
+}
+
+func (Goal) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDMixin{},
+		TimeMixin{},
+	}
+}
+
+func (Goal) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("patient_id", uuid.UUID{}),
+		field.UUID("doctor_id", uuid.UUID{}),
+
+		field.String("title").NotEmpty().MaxLen(200),
+		field.String("description").Optional().Nillable(),
+
+		// An entry counts towards the goal when it contains any of the listed
+		// values of every non-empty list (vocabulary keys for techniques/triggers).
+		field.JSON("techniques", []string{}).Optional(),
+		field.JSON("triggers", []string{}).Optional(),
+		field.JSON("tags", []string{}).Optional(),
+
+		field.Int("target_count").Positive(),
+		field.Enum("period").Values("Day", "Week", "Month", "Total").Default("Week"),
+
+		field.Time("starts_at").Default(time.Now),
+		field.Time("ends_at").Optional().Nillable(),
+
+		field.Enum("status").Values("Active", "Completed", "Cancelled").Default("Active"),
+	}
+}
+
+func (Goal) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("patient_id", "status"),
+		index.Fields("doctor_id"),
+	}
+}
+
+func (Goal) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("patient", Patient.Type).
+			Ref("goals").
+			Field("patient_id").
+			Unique().
+			Required(),
+
+		edge.From("doctor", Doctor.Type).
+			Ref("assigned_goals").
+			Field("doctor_id").
+			Unique().
+			Required(),
+	}
+}
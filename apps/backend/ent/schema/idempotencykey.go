@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IdempotencyKey remembers the response to a request sent with an
+// Idempotency-Key header so retries can be replayed. The id scopes the key to
+// its caller; rows are claimed with a single upsert and pruned once expired.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Immutable(),
+		// fingerprint hashes the method, path and body of the first request.
+		field.String("fingerprint"),
+		// status is the stored response status, or 0 while the first request
+		// is still being handled.
+		field.Int("status").Default(0),
+		field.String("content_type").Default(""),
+		field.Bytes("body").Optional().Nillable(),
+		// locked_until lets a retry take over from a request that died
+		// before completing.
+		field.Time("locked_until"),
+		field.Time("expires_at"),
+		field.Time("created_at"),
+	}
+}
+
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OutboxEvent is a domain event written in the same transaction as the change
+// it describes. The relay polls pending rows by next_attempt_at and records
+// which subscribers accepted each one in delivered_to.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+func (OutboxEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDMixin{},
+		TimeMixin{},
+	}
+}
+
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("event_type").NotEmpty(),
+		field.JSON("payload", json.RawMessage{}),
+
+		field.Enum("status").Values("Pending", "Published", "Failed").Default("Pending"),
+		field.Strings("delivered_to").Optional(),
+		field.Int("attempts").Default(0).NonNegative(),
+		field.Time("next_attempt_at"),
+		field.Time("published_at").Optional().Nillable(),
+		field.String("last_error").Optional().Nillable(),
+	}
+}
+
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+	}
+}
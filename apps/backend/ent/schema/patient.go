@@ -38,7 +38,7 @@ func (Patient) Fields() []ent.Field {
 		// optional code for "doctor code / patient code" flows
 		field.String("patient_code").Optional().Nillable(),
 
-		// for future: last activity, etc.
+		// Bumped when the patient journals; drives inactivity reminders.
 		field.Time("last_entry_at").Optional().Nillable().Default(nil).UpdateDefault(func() time.Time { return time.Now() }),
 	}
 }
@@ -58,5 +58,12 @@ func (Patient) Edges() []ent.Edge {
 		edge.To("entries", Entry.Type),
 		edge.To("analysis_jobs", AnalysisJob.Type),
 		edge.To("entry_shares", EntryShare.Type),
+		edge.To("goals", Goal.Type),
+		edge.To("assignments", Assignment.Type),
+		edge.To("sessions", Session.Type),
+		edge.To("questionnaire_assignments", QuestionnaireAssignment.Type),
+		edge.To("questionnaire_responses", QuestionnaireResponse.Type),
+		edge.To("reminder_schedules", ReminderSchedule.Type),
+		edge.To("reminder_deliveries", ReminderDelivery.Type),
 	}
 }
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -27,5 +28,10 @@ func (Practice) Fields() []ent.Field {
 func (Practice) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("doctors", Doctor.Type),
+		// Scoped terms must not outlive their owner and turn global.
+		edge.To("vocabulary_terms", VocabularyTerm.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("webhook_subscriptions", WebhookSubscription.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// QuestionnaireAssignment asks a linked patient to fill in one questionnaire
+// version; it is completed by exactly one response.
+type QuestionnaireAssignment struct {
+	ent.Schema
+}
+
+func (QuestionnaireAssignment) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDMixin{},
+		TimeMixin{},
+	}
+}
+
+func (QuestionnaireAssignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("patient_id", uuid.UUID{}),
+		field.UUID("doctor_id", uuid.UUID{}),
+		field.UUID("definition_id", uuid.UUID{}),
+
+		field.Time("due_at").Optional().Nillable(),
+		field.Enum("status").Values("Pending", "Completed", "Cancelled").Default("Pending"),
+		field.Time("completed_at").Optional().Nillable(),
+	}
+}
+
+func (QuestionnaireAssignment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("patient_id", "status"),
+		index.Fields("doctor_id"),
+	}
+}
+
+func (QuestionnaireAssignment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("patient", Patient.Type).
+			Ref("questionnaire_assignments").
+			Field("patient_id").
+			Unique().
+			Required(),
+
+		edge.From("doctor", Doctor.Type).
+			Ref("questionnaire_assignments").
+			Field("doctor_id").
+			Unique().
+			Required(),
+
+		edge.From("definition", QuestionnaireDefinition.Type).
+			Ref("assignments").
+			Field("definition_id").
+			Unique().
+			Required(),
+
+		edge.To("response", QuestionnaireResponse.Type).
+			Unique(),
+	}
+}
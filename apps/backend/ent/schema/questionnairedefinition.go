@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"backend/internal/questionnaire"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// QuestionnaireDefinition is one version of a standardised instrument such as
+// the S-24. Versions are immutable once published so that stored responses
+// keep their meaning; changes are published as a new version of the same key.
+type QuestionnaireDefinition struct {
+	ent.Schema
+}
+
+func (QuestionnaireDefinition) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDMixin{},
+		TimeMixin{},
+	}
+}
+
+func (QuestionnaireDefinition) Fields() []ent.Field {
+	return []ent.Field{
+		// key identifies the instrument across versions, e.g. "s-24".
+		field.String("key").NotEmpty().MaxLen(64),
+		field.Int("version").Positive(),
+
+		field.String("title").NotEmpty().MaxLen(200),
+		field.String("description").Optional().Nillable(),
+
+		field.JSON("definition", questionnaire.Definition{}),
+
+		field.UUID("created_by_doctor_id", uuid.UUID{}).Optional().Nillable(),
+		field.Bool("active").Default(true),
+	}
+}
+
+func (QuestionnaireDefinition) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key", "version").Unique(),
+	}
+}
+
+func (QuestionnaireDefinition) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("created_by_doctor", Doctor.Type).
+			Ref("questionnaire_definitions").
+			Field("created_by_doctor_id").
+			Unique(),
+
+		edge.To("assignments", QuestionnaireAssignment.Type),
+		edge.To("responses", QuestionnaireResponse.Type),
+	}
+}
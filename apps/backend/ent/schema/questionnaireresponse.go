@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"backend/internal/questionnaire"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// QuestionnaireResponse is a patient's submitted answers together with the
+// scale scores computed on the server at submission time.
+type QuestionnaireResponse struct {
+	ent.Schema
+}
+
+func (QuestionnaireResponse) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDMixin{},
+		TimeMixin{},
+	}
+}
+
+func (QuestionnaireResponse) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("patient_id", uuid.UUID{}),
+		field.UUID("definition_id", uuid.UUID{}),
+		field.UUID("assignment_id", uuid.UUID{}),
+
+		// answers maps item IDs to the chosen option value.
+		field.JSON("answers", map[string]string{}),
+		field.JSON("scores", []questionnaire.ScaleScore{}),
+
+		field.Time("submitted_at").Default(time.Now),
+	}
+}
+
+func (QuestionnaireResponse) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("patient_id", "submitted_at"),
+	}
+}
+
+func (QuestionnaireResponse) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("patient", Patient.Type).
+			Ref("questionnaire_responses").
+			Field("patient_id").
+			Unique().
+			Required(),
+
+		edge.From("definition", QuestionnaireDefinition.Type).
+			Ref("responses").
+			Field("definition_id").
+			Unique().
+			Required(),
+
+		edge.From("assignment", QuestionnaireAssignment.Type).
+			Ref("response").
+			Field("assignment_id").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RateLimitBucket is a token bucket shared by every API instance. The id is
+// the policy name and client key; buckets are taken with a single upsert and
+// pruned once idle.
+type RateLimitBucket struct {
+	ent.Schema
+}
+
+func (RateLimitBucket) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Immutable(),
+		field.Float("tokens"),
+		// allowed records whether the last take succeeded.
+		field.Bool("allowed"),
+		field.Time("updated_at"),
+	}
+}
+
+func (RateLimitBucket) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("updated_at"),
+	}
+}
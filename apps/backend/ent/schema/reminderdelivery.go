@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ReminderDelivery records one attempt to deliver a scheduled reminder. The
+// unique (schedule_id, scheduled_for) pair keeps a run from being sent twice.
+type ReminderDelivery struct {
+	ent.Schema
+}
+
+func (ReminderDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDMixin{},
+		TimeMixin{},
+	}
+}
+
+func (ReminderDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("schedule_id", uuid.UUID{}),
+		field.UUID("patient_id", uuid.UUID{}),
+
+		field.Enum("kind").Values("Journal", "Inactivity"),
+		field.Enum("channel").Values("Push", "Email", "Log"),
+		field.Time("scheduled_for"),
+
+		field.Enum("status").Values("Pending", "Sent", "Failed").Default("Pending"),
+		field.Time("sent_at").Optional().Nillable(),
+		field.String("error").Optional().Nillable(),
+	}
+}
+
+func (ReminderDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("schedule_id", "scheduled_for").Unique(),
+		index.Fields("patient_id", "scheduled_for"),
+	}
+}
+
+func (ReminderDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("schedule", ReminderSchedule.Type).
+			Ref("deliveries").
+			Field("schedule_id").
+			Unique().
+			Required(),
+
+		edge.From("patient", Patient.Type).
+			Ref("reminder_deliveries").
+			Field("patient_id").
+			Unique().
+			Required(),
+	}
+}
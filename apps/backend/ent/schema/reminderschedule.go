@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ReminderSchedule nudges a patient to journal at a wall-clock time in their
+// timezone. Inactivity schedules only fire when last_entry_at is older than
+// inactivity_days. next_run_at is precomputed so the scheduler can poll it.
+type ReminderSchedule struct {
+	ent.Schema
+}
+
+func (ReminderSchedule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDMixin{},
+		TimeMixin{},
+	}
+}
+
+func (ReminderSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("patient_id", uuid.UUID{}),
+		// Set when a doctor configured the reminder; nil for the patient's own.
+		field.UUID("created_by_doctor_id", uuid.UUID{}).Optional().Nillable(),
+
+		field.Enum("kind").Values("Journal", "Inactivity"),
+		field.Enum("channel").Values("Push", "Email", "Log"),
+
+		field.String("timezone").NotEmpty().MaxLen(64),
+		// "HH:MM" in timezone.
+		field.String("time_of_day").NotEmpty().MaxLen(5),
+		// Three-letter day names; empty means every day.
+		field.Strings("weekdays").Optional(),
+		field.Int("inactivity_days").Optional().Nillable().Positive(),
+		field.String("message").Optional().Nillable().MaxLen(500),
+
+		field.Bool("enabled").Default(true),
+		// Nil while disabled.
+		field.Time("next_run_at").Optional().Nillable(),
+		field.Time("last_sent_at").Optional().Nillable(),
+	}
+}
+
+func (ReminderSchedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("patient_id"),
+		index.Fields("enabled", "next_run_at"),
+	}
+}
+
+func (ReminderSchedule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("patient", Patient.Type).
+			Ref("reminder_schedules").
+			Field("patient_id").
+			Unique().
+			Required(),
+
+		edge.From("created_by", Doctor.Type).
+			Ref("reminder_schedules").
+			Field("created_by_doctor_id").
+			Unique(),
+
+		// Delivery history goes with its schedule.
+		edge.To("deliveries", ReminderDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
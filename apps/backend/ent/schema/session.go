@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Session is an appointment a doctor held with a linked patient. Clinician
+// notes stay private to the doctor; the summary is shown to the patient.
+type Session struct {
+	ent.Schema
+}
+
+func (Session) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDMixin{},
+		TimeMixin{},
+	}
+}
+
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("patient_id", uuid.UUID{}),
+		field.UUID("doctor_id", uuid.UUID{}),
+
+		field.Time("held_at"),
+		field.Int("duration_minutes").Optional().Nillable(),
+
+		field.String("clinician_notes").Optional().Nillable(),
+		field.String("patient_summary").Optional().Nillable(),
+	}
+}
+
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("patient_id", "held_at"),
+		index.Fields("doctor_id", "held_at"),
+	}
+}
+
+func (Session) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("patient", Patient.Type).
+			Ref("sessions").
+			Field("patient_id").
+			Unique().
+			Required(),
+
+		edge.From("doctor", Doctor.Type).
+			Ref("sessions").
+			Field("doctor_id").
+			Unique().
+			Required(),
+
+		// Entries discussed during the session.
+		edge.To("entries", Entry.Type),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// VocabularyTerm is a curated emotion, trigger or technique. Terms without a
+// practice or doctor are global; scoped terms extend the list for the patients
+// of that practice or doctor.
+type VocabularyTerm struct {
+	ent.Schema
+}
+
+func (VocabularyTerm) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDMixin{},
+		TimeMixin{},
+	}
+}
+
+func (VocabularyTerm) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("kind").Values("Emotion", "Trigger", "Technique"),
+
+		// key is the canonical value stored on entries, e.g. "phone-call".
+		field.String("key").NotEmpty().MaxLen(64),
+
+		// labels maps a locale ("en", "de") to the display label.
+		field.JSON("labels", map[string]string{}),
+		field.JSON("synonyms", []string{}).Optional(),
+
+		field.UUID("practice_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("doctor_id", uuid.UUID{}).Optional().Nillable(),
+
+		field.Bool("active").Default(true),
+	}
+}
+
+func (VocabularyTerm) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "key"),
+		index.Fields("practice_id"),
+		index.Fields("doctor_id"),
+	}
+}
+
+func (VocabularyTerm) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("practice", Practice.Type).
+			Ref("vocabulary_terms").
+			Field("practice_id").
+			Unique(),
+
+		edge.From("doctor", Doctor.Type).
+			Ref("vocabulary_terms").
+			Field("doctor_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WebhookDelivery is one event queued for a subscription. The worker polls
+// pending rows by next_attempt_at and pushes it forward on every attempt, so
+// the table doubles as the retry queue and the delivery log.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDMixin{},
+		TimeMixin{},
+	}
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("subscription_id", uuid.UUID{}),
+		// Stable across redeliveries so receivers can deduplicate.
+		field.UUID("event_id", uuid.UUID{}),
+		field.String("event_type").NotEmpty(),
+		// The request body; it is signed when sent.
+		field.JSON("payload", json.RawMessage{}),
+
+		field.Enum("status").Values("Pending", "Succeeded", "Failed").Default("Pending"),
+		field.Int("attempts").Default(0).NonNegative(),
+		field.Time("next_attempt_at").Optional().Nillable(),
+		field.Time("last_attempt_at").Optional().Nillable(),
+		field.Int("response_status").Optional().Nillable(),
+		field.String("last_error").Optional().Nillable(),
+
+		// redelivery_of is the delivery a manual redelivery copies; only the
+		// first delivery of an event is unique per subscription.
+		field.UUID("redelivery_of", uuid.UUID{}).Optional().Nillable(),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("subscription_id", "created_at"),
+		index.Fields("subscription_id", "event_id").
+			Unique().
+			Annotations(entsql.IndexWhere("redelivery_of IS NULL")),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("subscription", WebhookSubscription.Type).
+			Ref("deliveries").
+			Field("subscription_id").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WebhookSubscription sends a practice's chosen events to an integration's
+// endpoint, signed with secret.
+type WebhookSubscription struct {
+	ent.Schema
+}
+
+func (WebhookSubscription) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		UUIDMixin{},
+		TimeMixin{},
+	}
+}
+
+func (WebhookSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("practice_id", uuid.UUID{}),
+		field.UUID("created_by_doctor_id", uuid.UUID{}).Optional().Nillable(),
+
+		field.String("url").NotEmpty().MaxLen(2048),
+		field.String("secret").NotEmpty().Sensitive().Comment("HMAC key shared with the receiver"),
+		// Event types, e.g. "link.approved".
+		field.Strings("events"),
+		field.String("description").Optional().Nillable().MaxLen(200),
+		field.Bool("active").Default(true),
+	}
+}
+
+func (WebhookSubscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("practice_id"),
+	}
+}
+
+func (WebhookSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("practice", Practice.Type).
+			Ref("webhook_subscriptions").
+			Field("practice_id").
+			Unique().
+			Required(),
+
+		edge.From("created_by", Doctor.Type).
+			Ref("webhook_subscriptions").
+			Field("created_by_doctor_id").
+			Unique(),
+
+		// The delivery log goes with its subscription.
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
@@ -0,0 +1,541 @@
+// Code generated by ent, DO NOT EDIT.
+
+package session
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// PatientID applies equality check predicate on the "patient_id" field. It's identical to PatientIDEQ.
+func PatientID(v uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldPatientID, v))
+}
+
+// DoctorID applies equality check predicate on the "doctor_id" field. It's identical to DoctorIDEQ.
+func DoctorID(v uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldDoctorID, v))
+}
+
+// HeldAt applies equality check predicate on the "held_at" field. It's identical to HeldAtEQ.
+func HeldAt(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldHeldAt, v))
+}
+
+// DurationMinutes applies equality check predicate on the "duration_minutes" field. It's identical to DurationMinutesEQ.
+func DurationMinutes(v int) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldDurationMinutes, v))
+}
+
+// ClinicianNotes applies equality check predicate on the "clinician_notes" field. It's identical to ClinicianNotesEQ.
+func ClinicianNotes(v string) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldClinicianNotes, v))
+}
+
+// PatientSummary applies equality check predicate on the "patient_summary" field. It's identical to PatientSummaryEQ.
+func PatientSummary(v string) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldPatientSummary, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// PatientIDEQ applies the EQ predicate on the "patient_id" field.
+func PatientIDEQ(v uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldPatientID, v))
+}
+
+// PatientIDNEQ applies the NEQ predicate on the "patient_id" field.
+func PatientIDNEQ(v uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldPatientID, v))
+}
+
+// PatientIDIn applies the In predicate on the "patient_id" field.
+func PatientIDIn(vs ...uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldPatientID, vs...))
+}
+
+// PatientIDNotIn applies the NotIn predicate on the "patient_id" field.
+func PatientIDNotIn(vs ...uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldPatientID, vs...))
+}
+
+// DoctorIDEQ applies the EQ predicate on the "doctor_id" field.
+func DoctorIDEQ(v uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldDoctorID, v))
+}
+
+// DoctorIDNEQ applies the NEQ predicate on the "doctor_id" field.
+func DoctorIDNEQ(v uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldDoctorID, v))
+}
+
+// DoctorIDIn applies the In predicate on the "doctor_id" field.
+func DoctorIDIn(vs ...uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldDoctorID, vs...))
+}
+
+// DoctorIDNotIn applies the NotIn predicate on the "doctor_id" field.
+func DoctorIDNotIn(vs ...uuid.UUID) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldDoctorID, vs...))
+}
+
+// HeldAtEQ applies the EQ predicate on the "held_at" field.
+func HeldAtEQ(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldHeldAt, v))
+}
+
+// HeldAtNEQ applies the NEQ predicate on the "held_at" field.
+func HeldAtNEQ(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldHeldAt, v))
+}
+
+// HeldAtIn applies the In predicate on the "held_at" field.
+func HeldAtIn(vs ...time.Time) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldHeldAt, vs...))
+}
+
+// HeldAtNotIn applies the NotIn predicate on the "held_at" field.
+func HeldAtNotIn(vs ...time.Time) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldHeldAt, vs...))
+}
+
+// HeldAtGT applies the GT predicate on the "held_at" field.
+func HeldAtGT(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldGT(FieldHeldAt, v))
+}
+
+// HeldAtGTE applies the GTE predicate on the "held_at" field.
+func HeldAtGTE(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldGTE(FieldHeldAt, v))
+}
+
+// HeldAtLT applies the LT predicate on the "held_at" field.
+func HeldAtLT(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldLT(FieldHeldAt, v))
+}
+
+// HeldAtLTE applies the LTE predicate on the "held_at" field.
+func HeldAtLTE(v time.Time) predicate.Session {
+	return predicate.Session(sql.FieldLTE(FieldHeldAt, v))
+}
+
+// DurationMinutesEQ applies the EQ predicate on the "duration_minutes" field.
+func DurationMinutesEQ(v int) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldDurationMinutes, v))
+}
+
+// DurationMinutesNEQ applies the NEQ predicate on the "duration_minutes" field.
+func DurationMinutesNEQ(v int) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldDurationMinutes, v))
+}
+
+// DurationMinutesIn applies the In predicate on the "duration_minutes" field.
+func DurationMinutesIn(vs ...int) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldDurationMinutes, vs...))
+}
+
+// DurationMinutesNotIn applies the NotIn predicate on the "duration_minutes" field.
+func DurationMinutesNotIn(vs ...int) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldDurationMinutes, vs...))
+}
+
+// DurationMinutesGT applies the GT predicate on the "duration_minutes" field.
+func DurationMinutesGT(v int) predicate.Session {
+	return predicate.Session(sql.FieldGT(FieldDurationMinutes, v))
+}
+
+// DurationMinutesGTE applies the GTE predicate on the "duration_minutes" field.
+func DurationMinutesGTE(v int) predicate.Session {
+	return predicate.Session(sql.FieldGTE(FieldDurationMinutes, v))
+}
+
+// DurationMinutesLT applies the LT predicate on the "duration_minutes" field.
+func DurationMinutesLT(v int) predicate.Session {
+	return predicate.Session(sql.FieldLT(FieldDurationMinutes, v))
+}
+
+// DurationMinutesLTE applies the LTE predicate on the "duration_minutes" field.
+func DurationMinutesLTE(v int) predicate.Session {
+	return predicate.Session(sql.FieldLTE(FieldDurationMinutes, v))
+}
+
+// DurationMinutesIsNil applies the IsNil predicate on the "duration_minutes" field.
+func DurationMinutesIsNil() predicate.Session {
+	return predicate.Session(sql.FieldIsNull(FieldDurationMinutes))
+}
+
+// DurationMinutesNotNil applies the NotNil predicate on the "duration_minutes" field.
+func DurationMinutesNotNil() predicate.Session {
+	return predicate.Session(sql.FieldNotNull(FieldDurationMinutes))
+}
+
+// ClinicianNotesEQ applies the EQ predicate on the "clinician_notes" field.
+func ClinicianNotesEQ(v string) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldClinicianNotes, v))
+}
+
+// ClinicianNotesNEQ applies the NEQ predicate on the "clinician_notes" field.
+func ClinicianNotesNEQ(v string) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldClinicianNotes, v))
+}
+
+// ClinicianNotesIn applies the In predicate on the "clinician_notes" field.
+func ClinicianNotesIn(vs ...string) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldClinicianNotes, vs...))
+}
+
+// ClinicianNotesNotIn applies the NotIn predicate on the "clinician_notes" field.
+func ClinicianNotesNotIn(vs ...string) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldClinicianNotes, vs...))
+}
+
+// ClinicianNotesGT applies the GT predicate on the "clinician_notes" field.
+func ClinicianNotesGT(v string) predicate.Session {
+	return predicate.Session(sql.FieldGT(FieldClinicianNotes, v))
+}
+
+// ClinicianNotesGTE applies the GTE predicate on the "clinician_notes" field.
+func ClinicianNotesGTE(v string) predicate.Session {
+	return predicate.Session(sql.FieldGTE(FieldClinicianNotes, v))
+}
+
+// ClinicianNotesLT applies the LT predicate on the "clinician_notes" field.
+func ClinicianNotesLT(v string) predicate.Session {
+	return predicate.Session(sql.FieldLT(FieldClinicianNotes, v))
+}
+
+// ClinicianNotesLTE applies the LTE predicate on the "clinician_notes" field.
+func ClinicianNotesLTE(v string) predicate.Session {
+	return predicate.Session(sql.FieldLTE(FieldClinicianNotes, v))
+}
+
+// ClinicianNotesContains applies the Contains predicate on the "clinician_notes" field.
+func ClinicianNotesContains(v string) predicate.Session {
+	return predicate.Session(sql.FieldContains(FieldClinicianNotes, v))
+}
+
+// ClinicianNotesHasPrefix applies the HasPrefix predicate on the "clinician_notes" field.
+func ClinicianNotesHasPrefix(v string) predicate.Session {
+	return predicate.Session(sql.FieldHasPrefix(FieldClinicianNotes, v))
+}
+
+// ClinicianNotesHasSuffix applies the HasSuffix predicate on the "clinician_notes" field.
+func ClinicianNotesHasSuffix(v string) predicate.Session {
+	return predicate.Session(sql.FieldHasSuffix(FieldClinicianNotes, v))
+}
+
+// ClinicianNotesIsNil applies the IsNil predicate on the "clinician_notes" field.
+func ClinicianNotesIsNil() predicate.Session {
+	return predicate.Session(sql.FieldIsNull(FieldClinicianNotes))
+}
+
+// ClinicianNotesNotNil applies the NotNil predicate on the "clinician_notes" field.
+func ClinicianNotesNotNil() predicate.Session {
+	return predicate.Session(sql.FieldNotNull(FieldClinicianNotes))
+}
+
+// ClinicianNotesEqualFold applies the EqualFold predicate on the "clinician_notes" field.
+func ClinicianNotesEqualFold(v string) predicate.Session {
+	return predicate.Session(sql.FieldEqualFold(FieldClinicianNotes, v))
+}
+
+// ClinicianNotesContainsFold applies the ContainsFold predicate on the "clinician_notes" field.
+func ClinicianNotesContainsFold(v string) predicate.Session {
+	return predicate.Session(sql.FieldContainsFold(FieldClinicianNotes, v))
+}
+
+// PatientSummaryEQ applies the EQ predicate on the "patient_summary" field.
+func PatientSummaryEQ(v string) predicate.Session {
+	return predicate.Session(sql.FieldEQ(FieldPatientSummary, v))
+}
+
+// PatientSummaryNEQ applies the NEQ predicate on the "patient_summary" field.
+func PatientSummaryNEQ(v string) predicate.Session {
+	return predicate.Session(sql.FieldNEQ(FieldPatientSummary, v))
+}
+
+// PatientSummaryIn applies the In predicate on the "patient_summary" field.
+func PatientSummaryIn(vs ...string) predicate.Session {
+	return predicate.Session(sql.FieldIn(FieldPatientSummary, vs...))
+}
+
+// PatientSummaryNotIn applies the NotIn predicate on the "patient_summary" field.
+func PatientSummaryNotIn(vs ...string) predicate.Session {
+	return predicate.Session(sql.FieldNotIn(FieldPatientSummary, vs...))
+}
+
+// PatientSummaryGT applies the GT predicate on the "patient_summary" field.
+func PatientSummaryGT(v string) predicate.Session {
+	return predicate.Session(sql.FieldGT(FieldPatientSummary, v))
+}
+
+// PatientSummaryGTE applies the GTE predicate on the "patient_summary" field.
+func PatientSummaryGTE(v string) predicate.Session {
+	return predicate.Session(sql.FieldGTE(FieldPatientSummary, v))
+}
+
+// PatientSummaryLT applies the LT predicate on the "patient_summary" field.
+func PatientSummaryLT(v string) predicate.Session {
+	return predicate.Session(sql.FieldLT(FieldPatientSummary, v))
+}
+
+// PatientSummaryLTE applies the LTE predicate on the "patient_summary" field.
+func PatientSummaryLTE(v string) predicate.Session {
+	return predicate.Session(sql.FieldLTE(FieldPatientSummary, v))
+}
+
+// PatientSummaryContains applies the Contains predicate on the "patient_summary" field.
+func PatientSummaryContains(v string) predicate.Session {
+	return predicate.Session(sql.FieldContains(FieldPatientSummary, v))
+}
+
+// PatientSummaryHasPrefix applies the HasPrefix predicate on the "patient_summary" field.
+func PatientSummaryHasPrefix(v string) predicate.Session {
+	return predicate.Session(sql.FieldHasPrefix(FieldPatientSummary, v))
+}
+
+// PatientSummaryHasSuffix applies the HasSuffix predicate on the "patient_summary" field.
+func PatientSummaryHasSuffix(v string) predicate.Session {
+	return predicate.Session(sql.FieldHasSuffix(FieldPatientSummary, v))
+}
+
+// PatientSummaryIsNil applies the IsNil predicate on the "patient_summary" field.
+func PatientSummaryIsNil() predicate.Session {
+	return predicate.Session(sql.FieldIsNull(FieldPatientSummary))
+}
+
+// PatientSummaryNotNil applies the NotNil predicate on the "patient_summary" field.
+func PatientSummaryNotNil() predicate.Session {
+	return predicate.Session(sql.FieldNotNull(FieldPatientSummary))
+}
+
+// PatientSummaryEqualFold applies the EqualFold predicate on the "patient_summary" field.
+func PatientSummaryEqualFold(v string) predicate.Session {
+	return predicate.Session(sql.FieldEqualFold(FieldPatientSummary, v))
+}
+
+// PatientSummaryContainsFold applies the ContainsFold predicate on the "patient_summary" field.
+func PatientSummaryContainsFold(v string) predicate.Session {
+	return predicate.Session(sql.FieldContainsFold(FieldPatientSummary, v))
+}
+
+// HasPatient applies the HasEdge predicate on the "patient" edge.
+func HasPatient() predicate.Session {
+	return predicate.Session(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PatientTable, PatientColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPatientWith applies the HasEdge predicate on the "patient" edge with a given conditions (other predicates).
+func HasPatientWith(preds ...predicate.Patient) predicate.Session {
+	return predicate.Session(func(s *sql.Selector) {
+		step := newPatientStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDoctor applies the HasEdge predicate on the "doctor" edge.
+func HasDoctor() predicate.Session {
+	return predicate.Session(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DoctorTable, DoctorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDoctorWith applies the HasEdge predicate on the "doctor" edge with a given conditions (other predicates).
+func HasDoctorWith(preds ...predicate.Doctor) predicate.Session {
+	return predicate.Session(func(s *sql.Selector) {
+		step := newDoctorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEntries applies the HasEdge predicate on the "entries" edge.
+func HasEntries() predicate.Session {
+	return predicate.Session(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, EntriesTable, EntriesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEntriesWith applies the HasEdge predicate on the "entries" edge with a given conditions (other predicates).
+func HasEntriesWith(preds ...predicate.Entry) predicate.Session {
+	return predicate.Session(func(s *sql.Selector) {
+		step := newEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Session) predicate.Session {
+	return predicate.Session(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Session) predicate.Session {
+	return predicate.Session(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Session) predicate.Session {
+	return predicate.Session(sql.NotPredicates(p))
+}
@@ -0,0 +1,832 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/entry"
+	"backend/ent/patient"
+	"backend/ent/predicate"
+	"backend/ent/session"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SessionUpdate is the builder for updating Session entities.
+type SessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SessionMutation
+}
+
+// Where appends a list predicates to the SessionUpdate builder.
+func (_u *SessionUpdate) Where(ps ...predicate.Session) *SessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SessionUpdate) SetUpdatedAt(v time.Time) *SessionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *SessionUpdate) SetPatientID(v uuid.UUID) *SessionUpdate {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *SessionUpdate) SetNillablePatientID(v *uuid.UUID) *SessionUpdate {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (_u *SessionUpdate) SetDoctorID(v uuid.UUID) *SessionUpdate {
+	_u.mutation.SetDoctorID(v)
+	return _u
+}
+
+// SetNillableDoctorID sets the "doctor_id" field if the given value is not nil.
+func (_u *SessionUpdate) SetNillableDoctorID(v *uuid.UUID) *SessionUpdate {
+	if v != nil {
+		_u.SetDoctorID(*v)
+	}
+	return _u
+}
+
+// SetHeldAt sets the "held_at" field.
+func (_u *SessionUpdate) SetHeldAt(v time.Time) *SessionUpdate {
+	_u.mutation.SetHeldAt(v)
+	return _u
+}
+
+// SetNillableHeldAt sets the "held_at" field if the given value is not nil.
+func (_u *SessionUpdate) SetNillableHeldAt(v *time.Time) *SessionUpdate {
+	if v != nil {
+		_u.SetHeldAt(*v)
+	}
+	return _u
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (_u *SessionUpdate) SetDurationMinutes(v int) *SessionUpdate {
+	_u.mutation.ResetDurationMinutes()
+	_u.mutation.SetDurationMinutes(v)
+	return _u
+}
+
+// SetNillableDurationMinutes sets the "duration_minutes" field if the given value is not nil.
+func (_u *SessionUpdate) SetNillableDurationMinutes(v *int) *SessionUpdate {
+	if v != nil {
+		_u.SetDurationMinutes(*v)
+	}
+	return _u
+}
+
+// AddDurationMinutes adds value to the "duration_minutes" field.
+func (_u *SessionUpdate) AddDurationMinutes(v int) *SessionUpdate {
+	_u.mutation.AddDurationMinutes(v)
+	return _u
+}
+
+// ClearDurationMinutes clears the value of the "duration_minutes" field.
+func (_u *SessionUpdate) ClearDurationMinutes() *SessionUpdate {
+	_u.mutation.ClearDurationMinutes()
+	return _u
+}
+
+// SetClinicianNotes sets the "clinician_notes" field.
+func (_u *SessionUpdate) SetClinicianNotes(v string) *SessionUpdate {
+	_u.mutation.SetClinicianNotes(v)
+	return _u
+}
+
+// SetNillableClinicianNotes sets the "clinician_notes" field if the given value is not nil.
+func (_u *SessionUpdate) SetNillableClinicianNotes(v *string) *SessionUpdate {
+	if v != nil {
+		_u.SetClinicianNotes(*v)
+	}
+	return _u
+}
+
+// ClearClinicianNotes clears the value of the "clinician_notes" field.
+func (_u *SessionUpdate) ClearClinicianNotes() *SessionUpdate {
+	_u.mutation.ClearClinicianNotes()
+	return _u
+}
+
+// SetPatientSummary sets the "patient_summary" field.
+func (_u *SessionUpdate) SetPatientSummary(v string) *SessionUpdate {
+	_u.mutation.SetPatientSummary(v)
+	return _u
+}
+
+// SetNillablePatientSummary sets the "patient_summary" field if the given value is not nil.
+func (_u *SessionUpdate) SetNillablePatientSummary(v *string) *SessionUpdate {
+	if v != nil {
+		_u.SetPatientSummary(*v)
+	}
+	return _u
+}
+
+// ClearPatientSummary clears the value of the "patient_summary" field.
+func (_u *SessionUpdate) ClearPatientSummary() *SessionUpdate {
+	_u.mutation.ClearPatientSummary()
+	return _u
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_u *SessionUpdate) SetPatient(v *Patient) *SessionUpdate {
+	return _u.SetPatientID(v.ID)
+}
+
+// SetDoctor sets the "doctor" edge to the Doctor entity.
+func (_u *SessionUpdate) SetDoctor(v *Doctor) *SessionUpdate {
+	return _u.SetDoctorID(v.ID)
+}
+
+// AddEntryIDs adds the "entries" edge to the Entry entity by IDs.
+func (_u *SessionUpdate) AddEntryIDs(ids ...uuid.UUID) *SessionUpdate {
+	_u.mutation.AddEntryIDs(ids...)
+	return _u
+}
+
+// AddEntries adds the "entries" edges to the Entry entity.
+func (_u *SessionUpdate) AddEntries(v ...*Entry) *SessionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEntryIDs(ids...)
+}
+
+// Mutation returns the SessionMutation object of the builder.
+func (_u *SessionUpdate) Mutation() *SessionMutation {
+	return _u.mutation
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (_u *SessionUpdate) ClearPatient() *SessionUpdate {
+	_u.mutation.ClearPatient()
+	return _u
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (_u *SessionUpdate) ClearDoctor() *SessionUpdate {
+	_u.mutation.ClearDoctor()
+	return _u
+}
+
+// ClearEntries clears all "entries" edges to the Entry entity.
+func (_u *SessionUpdate) ClearEntries() *SessionUpdate {
+	_u.mutation.ClearEntries()
+	return _u
+}
+
+// RemoveEntryIDs removes the "entries" edge to Entry entities by IDs.
+func (_u *SessionUpdate) RemoveEntryIDs(ids ...uuid.UUID) *SessionUpdate {
+	_u.mutation.RemoveEntryIDs(ids...)
+	return _u
+}
+
+// RemoveEntries removes "entries" edges to Entry entities.
+func (_u *SessionUpdate) RemoveEntries(v ...*Entry) *SessionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEntryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SessionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SessionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := session.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SessionUpdate) check() error {
+	if _u.mutation.PatientCleared() && len(_u.mutation.PatientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Session.patient"`)
+	}
+	if _u.mutation.DoctorCleared() && len(_u.mutation.DoctorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Session.doctor"`)
+	}
+	return nil
+}
+
+func (_u *SessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(session.Table, session.Columns, sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(session.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.HeldAt(); ok {
+		_spec.SetField(session.FieldHeldAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DurationMinutes(); ok {
+		_spec.SetField(session.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMinutes(); ok {
+		_spec.AddField(session.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.DurationMinutesCleared() {
+		_spec.ClearField(session.FieldDurationMinutes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ClinicianNotes(); ok {
+		_spec.SetField(session.FieldClinicianNotes, field.TypeString, value)
+	}
+	if _u.mutation.ClinicianNotesCleared() {
+		_spec.ClearField(session.FieldClinicianNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.PatientSummary(); ok {
+		_spec.SetField(session.FieldPatientSummary, field.TypeString, value)
+	}
+	if _u.mutation.PatientSummaryCleared() {
+		_spec.ClearField(session.FieldPatientSummary, field.TypeString)
+	}
+	if _u.mutation.PatientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.PatientTable,
+			Columns: []string{session.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.PatientTable,
+			Columns: []string{session.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DoctorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.DoctorTable,
+			Columns: []string{session.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.DoctorTable,
+			Columns: []string{session.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   session.EntriesTable,
+			Columns: session.EntriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEntriesIDs(); len(nodes) > 0 && !_u.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   session.EntriesTable,
+			Columns: session.EntriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   session.EntriesTable,
+			Columns: session.EntriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{session.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SessionUpdateOne is the builder for updating a single Session entity.
+type SessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SessionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SessionUpdateOne) SetUpdatedAt(v time.Time) *SessionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPatientID sets the "patient_id" field.
+func (_u *SessionUpdateOne) SetPatientID(v uuid.UUID) *SessionUpdateOne {
+	_u.mutation.SetPatientID(v)
+	return _u
+}
+
+// SetNillablePatientID sets the "patient_id" field if the given value is not nil.
+func (_u *SessionUpdateOne) SetNillablePatientID(v *uuid.UUID) *SessionUpdateOne {
+	if v != nil {
+		_u.SetPatientID(*v)
+	}
+	return _u
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (_u *SessionUpdateOne) SetDoctorID(v uuid.UUID) *SessionUpdateOne {
+	_u.mutation.SetDoctorID(v)
+	return _u
+}
+
+// SetNillableDoctorID sets the "doctor_id" field if the given value is not nil.
+func (_u *SessionUpdateOne) SetNillableDoctorID(v *uuid.UUID) *SessionUpdateOne {
+	if v != nil {
+		_u.SetDoctorID(*v)
+	}
+	return _u
+}
+
+// SetHeldAt sets the "held_at" field.
+func (_u *SessionUpdateOne) SetHeldAt(v time.Time) *SessionUpdateOne {
+	_u.mutation.SetHeldAt(v)
+	return _u
+}
+
+// SetNillableHeldAt sets the "held_at" field if the given value is not nil.
+func (_u *SessionUpdateOne) SetNillableHeldAt(v *time.Time) *SessionUpdateOne {
+	if v != nil {
+		_u.SetHeldAt(*v)
+	}
+	return _u
+}
+
+// SetDurationMinutes sets the "duration_minutes" field.
+func (_u *SessionUpdateOne) SetDurationMinutes(v int) *SessionUpdateOne {
+	_u.mutation.ResetDurationMinutes()
+	_u.mutation.SetDurationMinutes(v)
+	return _u
+}
+
+// SetNillableDurationMinutes sets the "duration_minutes" field if the given value is not nil.
+func (_u *SessionUpdateOne) SetNillableDurationMinutes(v *int) *SessionUpdateOne {
+	if v != nil {
+		_u.SetDurationMinutes(*v)
+	}
+	return _u
+}
+
+// AddDurationMinutes adds value to the "duration_minutes" field.
+func (_u *SessionUpdateOne) AddDurationMinutes(v int) *SessionUpdateOne {
+	_u.mutation.AddDurationMinutes(v)
+	return _u
+}
+
+// ClearDurationMinutes clears the value of the "duration_minutes" field.
+func (_u *SessionUpdateOne) ClearDurationMinutes() *SessionUpdateOne {
+	_u.mutation.ClearDurationMinutes()
+	return _u
+}
+
+// SetClinicianNotes sets the "clinician_notes" field.
+func (_u *SessionUpdateOne) SetClinicianNotes(v string) *SessionUpdateOne {
+	_u.mutation.SetClinicianNotes(v)
+	return _u
+}
+
+// SetNillableClinicianNotes sets the "clinician_notes" field if the given value is not nil.
+func (_u *SessionUpdateOne) SetNillableClinicianNotes(v *string) *SessionUpdateOne {
+	if v != nil {
+		_u.SetClinicianNotes(*v)
+	}
+	return _u
+}
+
+// ClearClinicianNotes clears the value of the "clinician_notes" field.
+func (_u *SessionUpdateOne) ClearClinicianNotes() *SessionUpdateOne {
+	_u.mutation.ClearClinicianNotes()
+	return _u
+}
+
+// SetPatientSummary sets the "patient_summary" field.
+func (_u *SessionUpdateOne) SetPatientSummary(v string) *SessionUpdateOne {
+	_u.mutation.SetPatientSummary(v)
+	return _u
+}
+
+// SetNillablePatientSummary sets the "patient_summary" field if the given value is not nil.
+func (_u *SessionUpdateOne) SetNillablePatientSummary(v *string) *SessionUpdateOne {
+	if v != nil {
+		_u.SetPatientSummary(*v)
+	}
+	return _u
+}
+
+// ClearPatientSummary clears the value of the "patient_summary" field.
+func (_u *SessionUpdateOne) ClearPatientSummary() *SessionUpdateOne {
+	_u.mutation.ClearPatientSummary()
+	return _u
+}
+
+// SetPatient sets the "patient" edge to the Patient entity.
+func (_u *SessionUpdateOne) SetPatient(v *Patient) *SessionUpdateOne {
+	return _u.SetPatientID(v.ID)
+}
+
+// SetDoctor sets the "doctor" edge to the Doctor entity.
+func (_u *SessionUpdateOne) SetDoctor(v *Doctor) *SessionUpdateOne {
+	return _u.SetDoctorID(v.ID)
+}
+
+// AddEntryIDs adds the "entries" edge to the Entry entity by IDs.
+func (_u *SessionUpdateOne) AddEntryIDs(ids ...uuid.UUID) *SessionUpdateOne {
+	_u.mutation.AddEntryIDs(ids...)
+	return _u
+}
+
+// AddEntries adds the "entries" edges to the Entry entity.
+func (_u *SessionUpdateOne) AddEntries(v ...*Entry) *SessionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEntryIDs(ids...)
+}
+
+// Mutation returns the SessionMutation object of the builder.
+func (_u *SessionUpdateOne) Mutation() *SessionMutation {
+	return _u.mutation
+}
+
+// ClearPatient clears the "patient" edge to the Patient entity.
+func (_u *SessionUpdateOne) ClearPatient() *SessionUpdateOne {
+	_u.mutation.ClearPatient()
+	return _u
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (_u *SessionUpdateOne) ClearDoctor() *SessionUpdateOne {
+	_u.mutation.ClearDoctor()
+	return _u
+}
+
+// ClearEntries clears all "entries" edges to the Entry entity.
+func (_u *SessionUpdateOne) ClearEntries() *SessionUpdateOne {
+	_u.mutation.ClearEntries()
+	return _u
+}
+
+// RemoveEntryIDs removes the "entries" edge to Entry entities by IDs.
+func (_u *SessionUpdateOne) RemoveEntryIDs(ids ...uuid.UUID) *SessionUpdateOne {
+	_u.mutation.RemoveEntryIDs(ids...)
+	return _u
+}
+
+// RemoveEntries removes "entries" edges to Entry entities.
+func (_u *SessionUpdateOne) RemoveEntries(v ...*Entry) *SessionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEntryIDs(ids...)
+}
+
+// Where appends a list predicates to the SessionUpdate builder.
+func (_u *SessionUpdateOne) Where(ps ...predicate.Session) *SessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SessionUpdateOne) Select(field string, fields ...string) *SessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Session entity.
+func (_u *SessionUpdateOne) Save(ctx context.Context) (*Session, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SessionUpdateOne) SaveX(ctx context.Context) *Session {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SessionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := session.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SessionUpdateOne) check() error {
+	if _u.mutation.PatientCleared() && len(_u.mutation.PatientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Session.patient"`)
+	}
+	if _u.mutation.DoctorCleared() && len(_u.mutation.DoctorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Session.doctor"`)
+	}
+	return nil
+}
+
+func (_u *SessionUpdateOne) sqlSave(ctx context.Context) (_node *Session, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(session.Table, session.Columns, sqlgraph.NewFieldSpec(session.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Session.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, session.FieldID)
+		for _, f := range fields {
+			if !session.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != session.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(session.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.HeldAt(); ok {
+		_spec.SetField(session.FieldHeldAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DurationMinutes(); ok {
+		_spec.SetField(session.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMinutes(); ok {
+		_spec.AddField(session.FieldDurationMinutes, field.TypeInt, value)
+	}
+	if _u.mutation.DurationMinutesCleared() {
+		_spec.ClearField(session.FieldDurationMinutes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ClinicianNotes(); ok {
+		_spec.SetField(session.FieldClinicianNotes, field.TypeString, value)
+	}
+	if _u.mutation.ClinicianNotesCleared() {
+		_spec.ClearField(session.FieldClinicianNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.PatientSummary(); ok {
+		_spec.SetField(session.FieldPatientSummary, field.TypeString, value)
+	}
+	if _u.mutation.PatientSummaryCleared() {
+		_spec.ClearField(session.FieldPatientSummary, field.TypeString)
+	}
+	if _u.mutation.PatientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.PatientTable,
+			Columns: []string{session.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PatientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.PatientTable,
+			Columns: []string{session.PatientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(patient.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DoctorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.DoctorTable,
+			Columns: []string{session.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   session.DoctorTable,
+			Columns: []string{session.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   session.EntriesTable,
+			Columns: session.EntriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEntriesIDs(); len(nodes) > 0 && !_u.mutation.EntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   session.EntriesTable,
+			Columns: session.EntriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   session.EntriesTable,
+			Columns: session.EntriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(entry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Session{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{session.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -14,6 +16,8 @@ type Tx struct {
 	config
 	// AnalysisJob is the client for interacting with the AnalysisJob builders.
 	AnalysisJob *AnalysisJobClient
+	// Assignment is the client for interacting with the Assignment builders.
+	Assignment *AssignmentClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
 	// Doctor is the client for interacting with the Doctor builders.
@@ -24,12 +28,40 @@ type Tx struct {
 	Entry *EntryClient
 	// EntryShare is the client for interacting with the EntryShare builders.
 	EntryShare *EntryShareClient
+	// ExerciseLog is the client for interacting with the ExerciseLog builders.
+	ExerciseLog *ExerciseLogClient
+	// Goal is the client for interacting with the Goal builders.
+	Goal *GoalClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
 	// PairingCode is the client for interacting with the PairingCode builders.
 	PairingCode *PairingCodeClient
 	// Patient is the client for interacting with the Patient builders.
 	Patient *PatientClient
 	// Practice is the client for interacting with the Practice builders.
 	Practice *PracticeClient
+	// QuestionnaireAssignment is the client for interacting with the QuestionnaireAssignment builders.
+	QuestionnaireAssignment *QuestionnaireAssignmentClient
+	// QuestionnaireDefinition is the client for interacting with the QuestionnaireDefinition builders.
+	QuestionnaireDefinition *QuestionnaireDefinitionClient
+	// QuestionnaireResponse is the client for interacting with the QuestionnaireResponse builders.
+	QuestionnaireResponse *QuestionnaireResponseClient
+	// RateLimitBucket is the client for interacting with the RateLimitBucket builders.
+	RateLimitBucket *RateLimitBucketClient
+	// ReminderDelivery is the client for interacting with the ReminderDelivery builders.
+	ReminderDelivery *ReminderDeliveryClient
+	// ReminderSchedule is the client for interacting with the ReminderSchedule builders.
+	ReminderSchedule *ReminderScheduleClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// VocabularyTerm is the client for interacting with the VocabularyTerm builders.
+	VocabularyTerm *VocabularyTermClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookSubscription is the client for interacting with the WebhookSubscription builders.
+	WebhookSubscription *WebhookSubscriptionClient
 
 	// lazily loaded.
 	client     *Client
@@ -162,14 +194,29 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.AnalysisJob = NewAnalysisJobClient(tx.config)
+	tx.Assignment = NewAssignmentClient(tx.config)
 	tx.Comment = NewCommentClient(tx.config)
 	tx.Doctor = NewDoctorClient(tx.config)
 	tx.DoctorPatientLink = NewDoctorPatientLinkClient(tx.config)
 	tx.Entry = NewEntryClient(tx.config)
 	tx.EntryShare = NewEntryShareClient(tx.config)
+	tx.ExerciseLog = NewExerciseLogClient(tx.config)
+	tx.Goal = NewGoalClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
+	tx.OutboxEvent = NewOutboxEventClient(tx.config)
 	tx.PairingCode = NewPairingCodeClient(tx.config)
 	tx.Patient = NewPatientClient(tx.config)
 	tx.Practice = NewPracticeClient(tx.config)
+	tx.QuestionnaireAssignment = NewQuestionnaireAssignmentClient(tx.config)
+	tx.QuestionnaireDefinition = NewQuestionnaireDefinitionClient(tx.config)
+	tx.QuestionnaireResponse = NewQuestionnaireResponseClient(tx.config)
+	tx.RateLimitBucket = NewRateLimitBucketClient(tx.config)
+	tx.ReminderDelivery = NewReminderDeliveryClient(tx.config)
+	tx.ReminderSchedule = NewReminderScheduleClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
+	tx.VocabularyTerm = NewVocabularyTermClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookSubscription = NewWebhookSubscriptionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -232,3 +279,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
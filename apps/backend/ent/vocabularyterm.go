@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/practice"
+	"backend/ent/vocabularyterm"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// VocabularyTerm is the model entity for the VocabularyTerm schema.
+type VocabularyTerm struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind vocabularyterm.Kind `json:"kind,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `json:"labels,omitempty"`
+	// Synonyms holds the value of the "synonyms" field.
+	Synonyms []string `json:"synonyms,omitempty"`
+	// PracticeID holds the value of the "practice_id" field.
+	PracticeID *uuid.UUID `json:"practice_id,omitempty"`
+	// DoctorID holds the value of the "doctor_id" field.
+	DoctorID *uuid.UUID `json:"doctor_id,omitempty"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the VocabularyTermQuery when eager-loading is set.
+	Edges        VocabularyTermEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// VocabularyTermEdges holds the relations/edges for other nodes in the graph.
+type VocabularyTermEdges struct {
+	// Practice holds the value of the practice edge.
+	Practice *Practice `json:"practice,omitempty"`
+	// Doctor holds the value of the doctor edge.
+	Doctor *Doctor `json:"doctor,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PracticeOrErr returns the Practice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VocabularyTermEdges) PracticeOrErr() (*Practice, error) {
+	if e.Practice != nil {
+		return e.Practice, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: practice.Label}
+	}
+	return nil, &NotLoadedError{edge: "practice"}
+}
+
+// DoctorOrErr returns the Doctor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VocabularyTermEdges) DoctorOrErr() (*Doctor, error) {
+	if e.Doctor != nil {
+		return e.Doctor, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: doctor.Label}
+	}
+	return nil, &NotLoadedError{edge: "doctor"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VocabularyTerm) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vocabularyterm.FieldPracticeID, vocabularyterm.FieldDoctorID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case vocabularyterm.FieldLabels, vocabularyterm.FieldSynonyms:
+			values[i] = new([]byte)
+		case vocabularyterm.FieldActive:
+			values[i] = new(sql.NullBool)
+		case vocabularyterm.FieldKind, vocabularyterm.FieldKey:
+			values[i] = new(sql.NullString)
+		case vocabularyterm.FieldCreatedAt, vocabularyterm.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case vocabularyterm.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VocabularyTerm fields.
+func (_m *VocabularyTerm) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vocabularyterm.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case vocabularyterm.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case vocabularyterm.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case vocabularyterm.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = vocabularyterm.Kind(value.String)
+			}
+		case vocabularyterm.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				_m.Key = value.String
+			}
+		case vocabularyterm.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case vocabularyterm.FieldSynonyms:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field synonyms", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Synonyms); err != nil {
+					return fmt.Errorf("unmarshal field synonyms: %w", err)
+				}
+			}
+		case vocabularyterm.FieldPracticeID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field practice_id", values[i])
+			} else if value.Valid {
+				_m.PracticeID = new(uuid.UUID)
+				*_m.PracticeID = *value.S.(*uuid.UUID)
+			}
+		case vocabularyterm.FieldDoctorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field doctor_id", values[i])
+			} else if value.Valid {
+				_m.DoctorID = new(uuid.UUID)
+				*_m.DoctorID = *value.S.(*uuid.UUID)
+			}
+		case vocabularyterm.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				_m.Active = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VocabularyTerm.
+// This includes values selected through modifiers, order, etc.
+func (_m *VocabularyTerm) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPractice queries the "practice" edge of the VocabularyTerm entity.
+func (_m *VocabularyTerm) QueryPractice() *PracticeQuery {
+	return NewVocabularyTermClient(_m.config).QueryPractice(_m)
+}
+
+// QueryDoctor queries the "doctor" edge of the VocabularyTerm entity.
+func (_m *VocabularyTerm) QueryDoctor() *DoctorQuery {
+	return NewVocabularyTermClient(_m.config).QueryDoctor(_m)
+}
+
+// Update returns a builder for updating this VocabularyTerm.
+// Note that you need to call VocabularyTerm.Unwrap() before calling this method if this VocabularyTerm
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *VocabularyTerm) Update() *VocabularyTermUpdateOne {
+	return NewVocabularyTermClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the VocabularyTerm entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *VocabularyTerm) Unwrap() *VocabularyTerm {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VocabularyTerm is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *VocabularyTerm) String() string {
+	var builder strings.Builder
+	builder.WriteString("VocabularyTerm(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(_m.Key)
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Labels))
+	builder.WriteString(", ")
+	builder.WriteString("synonyms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Synonyms))
+	builder.WriteString(", ")
+	if v := _m.PracticeID; v != nil {
+		builder.WriteString("practice_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DoctorID; v != nil {
+		builder.WriteString("doctor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Active))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VocabularyTerms is a parsable slice of VocabularyTerm.
+type VocabularyTerms []*VocabularyTerm
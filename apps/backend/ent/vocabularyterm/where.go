@@ -0,0 +1,393 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vocabularyterm
+
+import (
+	"backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldEQ(FieldKey, v))
+}
+
+// PracticeID applies equality check predicate on the "practice_id" field. It's identical to PracticeIDEQ.
+func PracticeID(v uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldEQ(FieldPracticeID, v))
+}
+
+// DoctorID applies equality check predicate on the "doctor_id" field. It's identical to DoctorIDEQ.
+func DoctorID(v uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldEQ(FieldDoctorID, v))
+}
+
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldEQ(FieldActive, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldContainsFold(FieldKey, v))
+}
+
+// SynonymsIsNil applies the IsNil predicate on the "synonyms" field.
+func SynonymsIsNil() predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldIsNull(FieldSynonyms))
+}
+
+// SynonymsNotNil applies the NotNil predicate on the "synonyms" field.
+func SynonymsNotNil() predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNotNull(FieldSynonyms))
+}
+
+// PracticeIDEQ applies the EQ predicate on the "practice_id" field.
+func PracticeIDEQ(v uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldEQ(FieldPracticeID, v))
+}
+
+// PracticeIDNEQ applies the NEQ predicate on the "practice_id" field.
+func PracticeIDNEQ(v uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNEQ(FieldPracticeID, v))
+}
+
+// PracticeIDIn applies the In predicate on the "practice_id" field.
+func PracticeIDIn(vs ...uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldIn(FieldPracticeID, vs...))
+}
+
+// PracticeIDNotIn applies the NotIn predicate on the "practice_id" field.
+func PracticeIDNotIn(vs ...uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNotIn(FieldPracticeID, vs...))
+}
+
+// PracticeIDIsNil applies the IsNil predicate on the "practice_id" field.
+func PracticeIDIsNil() predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldIsNull(FieldPracticeID))
+}
+
+// PracticeIDNotNil applies the NotNil predicate on the "practice_id" field.
+func PracticeIDNotNil() predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNotNull(FieldPracticeID))
+}
+
+// DoctorIDEQ applies the EQ predicate on the "doctor_id" field.
+func DoctorIDEQ(v uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldEQ(FieldDoctorID, v))
+}
+
+// DoctorIDNEQ applies the NEQ predicate on the "doctor_id" field.
+func DoctorIDNEQ(v uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNEQ(FieldDoctorID, v))
+}
+
+// DoctorIDIn applies the In predicate on the "doctor_id" field.
+func DoctorIDIn(vs ...uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldIn(FieldDoctorID, vs...))
+}
+
+// DoctorIDNotIn applies the NotIn predicate on the "doctor_id" field.
+func DoctorIDNotIn(vs ...uuid.UUID) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNotIn(FieldDoctorID, vs...))
+}
+
+// DoctorIDIsNil applies the IsNil predicate on the "doctor_id" field.
+func DoctorIDIsNil() predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldIsNull(FieldDoctorID))
+}
+
+// DoctorIDNotNil applies the NotNil predicate on the "doctor_id" field.
+func DoctorIDNotNil() predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNotNull(FieldDoctorID))
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldEQ(FieldActive, v))
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.FieldNEQ(FieldActive, v))
+}
+
+// HasPractice applies the HasEdge predicate on the "practice" edge.
+func HasPractice() predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PracticeTable, PracticeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPracticeWith applies the HasEdge predicate on the "practice" edge with a given conditions (other predicates).
+func HasPracticeWith(preds ...predicate.Practice) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(func(s *sql.Selector) {
+		step := newPracticeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDoctor applies the HasEdge predicate on the "doctor" edge.
+func HasDoctor() predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DoctorTable, DoctorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDoctorWith applies the HasEdge predicate on the "doctor" edge with a given conditions (other predicates).
+func HasDoctorWith(preds ...predicate.Doctor) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(func(s *sql.Selector) {
+		step := newDoctorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VocabularyTerm) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VocabularyTerm) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VocabularyTerm) predicate.VocabularyTerm {
+	return predicate.VocabularyTerm(sql.NotPredicates(p))
+}
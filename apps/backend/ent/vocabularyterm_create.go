@@ -0,0 +1,1050 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/practice"
+	"backend/ent/vocabularyterm"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// VocabularyTermCreate is the builder for creating a VocabularyTerm entity.
+type VocabularyTermCreate struct {
+	config
+	mutation *VocabularyTermMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VocabularyTermCreate) SetCreatedAt(v time.Time) *VocabularyTermCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VocabularyTermCreate) SetNillableCreatedAt(v *time.Time) *VocabularyTermCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *VocabularyTermCreate) SetUpdatedAt(v time.Time) *VocabularyTermCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *VocabularyTermCreate) SetNillableUpdatedAt(v *time.Time) *VocabularyTermCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *VocabularyTermCreate) SetKind(v vocabularyterm.Kind) *VocabularyTermCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetKey sets the "key" field.
+func (_c *VocabularyTermCreate) SetKey(v string) *VocabularyTermCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetLabels sets the "labels" field.
+func (_c *VocabularyTermCreate) SetLabels(v map[string]string) *VocabularyTermCreate {
+	_c.mutation.SetLabels(v)
+	return _c
+}
+
+// SetSynonyms sets the "synonyms" field.
+func (_c *VocabularyTermCreate) SetSynonyms(v []string) *VocabularyTermCreate {
+	_c.mutation.SetSynonyms(v)
+	return _c
+}
+
+// SetPracticeID sets the "practice_id" field.
+func (_c *VocabularyTermCreate) SetPracticeID(v uuid.UUID) *VocabularyTermCreate {
+	_c.mutation.SetPracticeID(v)
+	return _c
+}
+
+// SetNillablePracticeID sets the "practice_id" field if the given value is not nil.
+func (_c *VocabularyTermCreate) SetNillablePracticeID(v *uuid.UUID) *VocabularyTermCreate {
+	if v != nil {
+		_c.SetPracticeID(*v)
+	}
+	return _c
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (_c *VocabularyTermCreate) SetDoctorID(v uuid.UUID) *VocabularyTermCreate {
+	_c.mutation.SetDoctorID(v)
+	return _c
+}
+
+// SetNillableDoctorID sets the "doctor_id" field if the given value is not nil.
+func (_c *VocabularyTermCreate) SetNillableDoctorID(v *uuid.UUID) *VocabularyTermCreate {
+	if v != nil {
+		_c.SetDoctorID(*v)
+	}
+	return _c
+}
+
+// SetActive sets the "active" field.
+func (_c *VocabularyTermCreate) SetActive(v bool) *VocabularyTermCreate {
+	_c.mutation.SetActive(v)
+	return _c
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_c *VocabularyTermCreate) SetNillableActive(v *bool) *VocabularyTermCreate {
+	if v != nil {
+		_c.SetActive(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *VocabularyTermCreate) SetID(v uuid.UUID) *VocabularyTermCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *VocabularyTermCreate) SetNillableID(v *uuid.UUID) *VocabularyTermCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPractice sets the "practice" edge to the Practice entity.
+func (_c *VocabularyTermCreate) SetPractice(v *Practice) *VocabularyTermCreate {
+	return _c.SetPracticeID(v.ID)
+}
+
+// SetDoctor sets the "doctor" edge to the Doctor entity.
+func (_c *VocabularyTermCreate) SetDoctor(v *Doctor) *VocabularyTermCreate {
+	return _c.SetDoctorID(v.ID)
+}
+
+// Mutation returns the VocabularyTermMutation object of the builder.
+func (_c *VocabularyTermCreate) Mutation() *VocabularyTermMutation {
+	return _c.mutation
+}
+
+// Save creates the VocabularyTerm in the database.
+func (_c *VocabularyTermCreate) Save(ctx context.Context) (*VocabularyTerm, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VocabularyTermCreate) SaveX(ctx context.Context) *VocabularyTerm {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VocabularyTermCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VocabularyTermCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VocabularyTermCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := vocabularyterm.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := vocabularyterm.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		v := vocabularyterm.DefaultActive
+		_c.mutation.SetActive(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := vocabularyterm.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VocabularyTermCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VocabularyTerm.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "VocabularyTerm.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "VocabularyTerm.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := vocabularyterm.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "VocabularyTerm.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "VocabularyTerm.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := vocabularyterm.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "VocabularyTerm.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Labels(); !ok {
+		return &ValidationError{Name: "labels", err: errors.New(`ent: missing required field "VocabularyTerm.labels"`)}
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "VocabularyTerm.active"`)}
+	}
+	return nil
+}
+
+func (_c *VocabularyTermCreate) sqlSave(ctx context.Context) (*VocabularyTerm, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VocabularyTermCreate) createSpec() (*VocabularyTerm, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VocabularyTerm{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(vocabularyterm.Table, sqlgraph.NewFieldSpec(vocabularyterm.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(vocabularyterm.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(vocabularyterm.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(vocabularyterm.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(vocabularyterm.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.Labels(); ok {
+		_spec.SetField(vocabularyterm.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := _c.mutation.Synonyms(); ok {
+		_spec.SetField(vocabularyterm.FieldSynonyms, field.TypeJSON, value)
+		_node.Synonyms = value
+	}
+	if value, ok := _c.mutation.Active(); ok {
+		_spec.SetField(vocabularyterm.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if nodes := _c.mutation.PracticeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vocabularyterm.PracticeTable,
+			Columns: []string{vocabularyterm.PracticeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(practice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PracticeID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vocabularyterm.DoctorTable,
+			Columns: []string{vocabularyterm.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DoctorID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VocabularyTerm.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VocabularyTermUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *VocabularyTermCreate) OnConflict(opts ...sql.ConflictOption) *VocabularyTermUpsertOne {
+	_c.conflict = opts
+	return &VocabularyTermUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VocabularyTerm.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *VocabularyTermCreate) OnConflictColumns(columns ...string) *VocabularyTermUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &VocabularyTermUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// VocabularyTermUpsertOne is the builder for "upsert"-ing
+	//  one VocabularyTerm node.
+	VocabularyTermUpsertOne struct {
+		create *VocabularyTermCreate
+	}
+
+	// VocabularyTermUpsert is the "OnConflict" setter.
+	VocabularyTermUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VocabularyTermUpsert) SetUpdatedAt(v time.Time) *VocabularyTermUpsert {
+	u.Set(vocabularyterm.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VocabularyTermUpsert) UpdateUpdatedAt() *VocabularyTermUpsert {
+	u.SetExcluded(vocabularyterm.FieldUpdatedAt)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *VocabularyTermUpsert) SetKind(v vocabularyterm.Kind) *VocabularyTermUpsert {
+	u.Set(vocabularyterm.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *VocabularyTermUpsert) UpdateKind() *VocabularyTermUpsert {
+	u.SetExcluded(vocabularyterm.FieldKind)
+	return u
+}
+
+// SetKey sets the "key" field.
+func (u *VocabularyTermUpsert) SetKey(v string) *VocabularyTermUpsert {
+	u.Set(vocabularyterm.FieldKey, v)
+	return u
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *VocabularyTermUpsert) UpdateKey() *VocabularyTermUpsert {
+	u.SetExcluded(vocabularyterm.FieldKey)
+	return u
+}
+
+// SetLabels sets the "labels" field.
+func (u *VocabularyTermUpsert) SetLabels(v map[string]string) *VocabularyTermUpsert {
+	u.Set(vocabularyterm.FieldLabels, v)
+	return u
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *VocabularyTermUpsert) UpdateLabels() *VocabularyTermUpsert {
+	u.SetExcluded(vocabularyterm.FieldLabels)
+	return u
+}
+
+// SetSynonyms sets the "synonyms" field.
+func (u *VocabularyTermUpsert) SetSynonyms(v []string) *VocabularyTermUpsert {
+	u.Set(vocabularyterm.FieldSynonyms, v)
+	return u
+}
+
+// UpdateSynonyms sets the "synonyms" field to the value that was provided on create.
+func (u *VocabularyTermUpsert) UpdateSynonyms() *VocabularyTermUpsert {
+	u.SetExcluded(vocabularyterm.FieldSynonyms)
+	return u
+}
+
+// ClearSynonyms clears the value of the "synonyms" field.
+func (u *VocabularyTermUpsert) ClearSynonyms() *VocabularyTermUpsert {
+	u.SetNull(vocabularyterm.FieldSynonyms)
+	return u
+}
+
+// SetPracticeID sets the "practice_id" field.
+func (u *VocabularyTermUpsert) SetPracticeID(v uuid.UUID) *VocabularyTermUpsert {
+	u.Set(vocabularyterm.FieldPracticeID, v)
+	return u
+}
+
+// UpdatePracticeID sets the "practice_id" field to the value that was provided on create.
+func (u *VocabularyTermUpsert) UpdatePracticeID() *VocabularyTermUpsert {
+	u.SetExcluded(vocabularyterm.FieldPracticeID)
+	return u
+}
+
+// ClearPracticeID clears the value of the "practice_id" field.
+func (u *VocabularyTermUpsert) ClearPracticeID() *VocabularyTermUpsert {
+	u.SetNull(vocabularyterm.FieldPracticeID)
+	return u
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *VocabularyTermUpsert) SetDoctorID(v uuid.UUID) *VocabularyTermUpsert {
+	u.Set(vocabularyterm.FieldDoctorID, v)
+	return u
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *VocabularyTermUpsert) UpdateDoctorID() *VocabularyTermUpsert {
+	u.SetExcluded(vocabularyterm.FieldDoctorID)
+	return u
+}
+
+// ClearDoctorID clears the value of the "doctor_id" field.
+func (u *VocabularyTermUpsert) ClearDoctorID() *VocabularyTermUpsert {
+	u.SetNull(vocabularyterm.FieldDoctorID)
+	return u
+}
+
+// SetActive sets the "active" field.
+func (u *VocabularyTermUpsert) SetActive(v bool) *VocabularyTermUpsert {
+	u.Set(vocabularyterm.FieldActive, v)
+	return u
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *VocabularyTermUpsert) UpdateActive() *VocabularyTermUpsert {
+	u.SetExcluded(vocabularyterm.FieldActive)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.VocabularyTerm.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(vocabularyterm.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VocabularyTermUpsertOne) UpdateNewValues() *VocabularyTermUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(vocabularyterm.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(vocabularyterm.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VocabularyTerm.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *VocabularyTermUpsertOne) Ignore() *VocabularyTermUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VocabularyTermUpsertOne) DoNothing() *VocabularyTermUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VocabularyTermCreate.OnConflict
+// documentation for more info.
+func (u *VocabularyTermUpsertOne) Update(set func(*VocabularyTermUpsert)) *VocabularyTermUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VocabularyTermUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VocabularyTermUpsertOne) SetUpdatedAt(v time.Time) *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VocabularyTermUpsertOne) UpdateUpdatedAt() *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *VocabularyTermUpsertOne) SetKind(v vocabularyterm.Kind) *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *VocabularyTermUpsertOne) UpdateKind() *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *VocabularyTermUpsertOne) SetKey(v string) *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *VocabularyTermUpsertOne) UpdateKey() *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *VocabularyTermUpsertOne) SetLabels(v map[string]string) *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *VocabularyTermUpsertOne) UpdateLabels() *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// SetSynonyms sets the "synonyms" field.
+func (u *VocabularyTermUpsertOne) SetSynonyms(v []string) *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.SetSynonyms(v)
+	})
+}
+
+// UpdateSynonyms sets the "synonyms" field to the value that was provided on create.
+func (u *VocabularyTermUpsertOne) UpdateSynonyms() *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.UpdateSynonyms()
+	})
+}
+
+// ClearSynonyms clears the value of the "synonyms" field.
+func (u *VocabularyTermUpsertOne) ClearSynonyms() *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.ClearSynonyms()
+	})
+}
+
+// SetPracticeID sets the "practice_id" field.
+func (u *VocabularyTermUpsertOne) SetPracticeID(v uuid.UUID) *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.SetPracticeID(v)
+	})
+}
+
+// UpdatePracticeID sets the "practice_id" field to the value that was provided on create.
+func (u *VocabularyTermUpsertOne) UpdatePracticeID() *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.UpdatePracticeID()
+	})
+}
+
+// ClearPracticeID clears the value of the "practice_id" field.
+func (u *VocabularyTermUpsertOne) ClearPracticeID() *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.ClearPracticeID()
+	})
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *VocabularyTermUpsertOne) SetDoctorID(v uuid.UUID) *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.SetDoctorID(v)
+	})
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *VocabularyTermUpsertOne) UpdateDoctorID() *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.UpdateDoctorID()
+	})
+}
+
+// ClearDoctorID clears the value of the "doctor_id" field.
+func (u *VocabularyTermUpsertOne) ClearDoctorID() *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.ClearDoctorID()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *VocabularyTermUpsertOne) SetActive(v bool) *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *VocabularyTermUpsertOne) UpdateActive() *VocabularyTermUpsertOne {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// Exec executes the query.
+func (u *VocabularyTermUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VocabularyTermCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VocabularyTermUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *VocabularyTermUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: VocabularyTermUpsertOne.ID is not supported by MySQL driver. Use VocabularyTermUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *VocabularyTermUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// VocabularyTermCreateBulk is the builder for creating many VocabularyTerm entities in bulk.
+type VocabularyTermCreateBulk struct {
+	config
+	err      error
+	builders []*VocabularyTermCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the VocabularyTerm entities in the database.
+func (_c *VocabularyTermCreateBulk) Save(ctx context.Context) ([]*VocabularyTerm, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*VocabularyTerm, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VocabularyTermMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VocabularyTermCreateBulk) SaveX(ctx context.Context) []*VocabularyTerm {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VocabularyTermCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VocabularyTermCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.VocabularyTerm.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.VocabularyTermUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *VocabularyTermCreateBulk) OnConflict(opts ...sql.ConflictOption) *VocabularyTermUpsertBulk {
+	_c.conflict = opts
+	return &VocabularyTermUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.VocabularyTerm.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *VocabularyTermCreateBulk) OnConflictColumns(columns ...string) *VocabularyTermUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &VocabularyTermUpsertBulk{
+		create: _c,
+	}
+}
+
+// VocabularyTermUpsertBulk is the builder for "upsert"-ing
+// a bulk of VocabularyTerm nodes.
+type VocabularyTermUpsertBulk struct {
+	create *VocabularyTermCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.VocabularyTerm.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(vocabularyterm.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *VocabularyTermUpsertBulk) UpdateNewValues() *VocabularyTermUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(vocabularyterm.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(vocabularyterm.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.VocabularyTerm.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *VocabularyTermUpsertBulk) Ignore() *VocabularyTermUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *VocabularyTermUpsertBulk) DoNothing() *VocabularyTermUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the VocabularyTermCreateBulk.OnConflict
+// documentation for more info.
+func (u *VocabularyTermUpsertBulk) Update(set func(*VocabularyTermUpsert)) *VocabularyTermUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&VocabularyTermUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *VocabularyTermUpsertBulk) SetUpdatedAt(v time.Time) *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *VocabularyTermUpsertBulk) UpdateUpdatedAt() *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *VocabularyTermUpsertBulk) SetKind(v vocabularyterm.Kind) *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *VocabularyTermUpsertBulk) UpdateKind() *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetKey sets the "key" field.
+func (u *VocabularyTermUpsertBulk) SetKey(v string) *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.SetKey(v)
+	})
+}
+
+// UpdateKey sets the "key" field to the value that was provided on create.
+func (u *VocabularyTermUpsertBulk) UpdateKey() *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.UpdateKey()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *VocabularyTermUpsertBulk) SetLabels(v map[string]string) *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *VocabularyTermUpsertBulk) UpdateLabels() *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// SetSynonyms sets the "synonyms" field.
+func (u *VocabularyTermUpsertBulk) SetSynonyms(v []string) *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.SetSynonyms(v)
+	})
+}
+
+// UpdateSynonyms sets the "synonyms" field to the value that was provided on create.
+func (u *VocabularyTermUpsertBulk) UpdateSynonyms() *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.UpdateSynonyms()
+	})
+}
+
+// ClearSynonyms clears the value of the "synonyms" field.
+func (u *VocabularyTermUpsertBulk) ClearSynonyms() *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.ClearSynonyms()
+	})
+}
+
+// SetPracticeID sets the "practice_id" field.
+func (u *VocabularyTermUpsertBulk) SetPracticeID(v uuid.UUID) *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.SetPracticeID(v)
+	})
+}
+
+// UpdatePracticeID sets the "practice_id" field to the value that was provided on create.
+func (u *VocabularyTermUpsertBulk) UpdatePracticeID() *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.UpdatePracticeID()
+	})
+}
+
+// ClearPracticeID clears the value of the "practice_id" field.
+func (u *VocabularyTermUpsertBulk) ClearPracticeID() *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.ClearPracticeID()
+	})
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (u *VocabularyTermUpsertBulk) SetDoctorID(v uuid.UUID) *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.SetDoctorID(v)
+	})
+}
+
+// UpdateDoctorID sets the "doctor_id" field to the value that was provided on create.
+func (u *VocabularyTermUpsertBulk) UpdateDoctorID() *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.UpdateDoctorID()
+	})
+}
+
+// ClearDoctorID clears the value of the "doctor_id" field.
+func (u *VocabularyTermUpsertBulk) ClearDoctorID() *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.ClearDoctorID()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *VocabularyTermUpsertBulk) SetActive(v bool) *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *VocabularyTermUpsertBulk) UpdateActive() *VocabularyTermUpsertBulk {
+	return u.Update(func(s *VocabularyTermUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// Exec executes the query.
+func (u *VocabularyTermUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the VocabularyTermCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for VocabularyTermCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *VocabularyTermUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,670 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"backend/ent/doctor"
+	"backend/ent/practice"
+	"backend/ent/predicate"
+	"backend/ent/vocabularyterm"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// VocabularyTermUpdate is the builder for updating VocabularyTerm entities.
+type VocabularyTermUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VocabularyTermMutation
+}
+
+// Where appends a list predicates to the VocabularyTermUpdate builder.
+func (_u *VocabularyTermUpdate) Where(ps ...predicate.VocabularyTerm) *VocabularyTermUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VocabularyTermUpdate) SetUpdatedAt(v time.Time) *VocabularyTermUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *VocabularyTermUpdate) SetKind(v vocabularyterm.Kind) *VocabularyTermUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *VocabularyTermUpdate) SetNillableKind(v *vocabularyterm.Kind) *VocabularyTermUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *VocabularyTermUpdate) SetKey(v string) *VocabularyTermUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *VocabularyTermUpdate) SetNillableKey(v *string) *VocabularyTermUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *VocabularyTermUpdate) SetLabels(v map[string]string) *VocabularyTermUpdate {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// SetSynonyms sets the "synonyms" field.
+func (_u *VocabularyTermUpdate) SetSynonyms(v []string) *VocabularyTermUpdate {
+	_u.mutation.SetSynonyms(v)
+	return _u
+}
+
+// AppendSynonyms appends value to the "synonyms" field.
+func (_u *VocabularyTermUpdate) AppendSynonyms(v []string) *VocabularyTermUpdate {
+	_u.mutation.AppendSynonyms(v)
+	return _u
+}
+
+// ClearSynonyms clears the value of the "synonyms" field.
+func (_u *VocabularyTermUpdate) ClearSynonyms() *VocabularyTermUpdate {
+	_u.mutation.ClearSynonyms()
+	return _u
+}
+
+// SetPracticeID sets the "practice_id" field.
+func (_u *VocabularyTermUpdate) SetPracticeID(v uuid.UUID) *VocabularyTermUpdate {
+	_u.mutation.SetPracticeID(v)
+	return _u
+}
+
+// SetNillablePracticeID sets the "practice_id" field if the given value is not nil.
+func (_u *VocabularyTermUpdate) SetNillablePracticeID(v *uuid.UUID) *VocabularyTermUpdate {
+	if v != nil {
+		_u.SetPracticeID(*v)
+	}
+	return _u
+}
+
+// ClearPracticeID clears the value of the "practice_id" field.
+func (_u *VocabularyTermUpdate) ClearPracticeID() *VocabularyTermUpdate {
+	_u.mutation.ClearPracticeID()
+	return _u
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (_u *VocabularyTermUpdate) SetDoctorID(v uuid.UUID) *VocabularyTermUpdate {
+	_u.mutation.SetDoctorID(v)
+	return _u
+}
+
+// SetNillableDoctorID sets the "doctor_id" field if the given value is not nil.
+func (_u *VocabularyTermUpdate) SetNillableDoctorID(v *uuid.UUID) *VocabularyTermUpdate {
+	if v != nil {
+		_u.SetDoctorID(*v)
+	}
+	return _u
+}
+
+// ClearDoctorID clears the value of the "doctor_id" field.
+func (_u *VocabularyTermUpdate) ClearDoctorID() *VocabularyTermUpdate {
+	_u.mutation.ClearDoctorID()
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *VocabularyTermUpdate) SetActive(v bool) *VocabularyTermUpdate {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *VocabularyTermUpdate) SetNillableActive(v *bool) *VocabularyTermUpdate {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetPractice sets the "practice" edge to the Practice entity.
+func (_u *VocabularyTermUpdate) SetPractice(v *Practice) *VocabularyTermUpdate {
+	return _u.SetPracticeID(v.ID)
+}
+
+// SetDoctor sets the "doctor" edge to the Doctor entity.
+func (_u *VocabularyTermUpdate) SetDoctor(v *Doctor) *VocabularyTermUpdate {
+	return _u.SetDoctorID(v.ID)
+}
+
+// Mutation returns the VocabularyTermMutation object of the builder.
+func (_u *VocabularyTermUpdate) Mutation() *VocabularyTermMutation {
+	return _u.mutation
+}
+
+// ClearPractice clears the "practice" edge to the Practice entity.
+func (_u *VocabularyTermUpdate) ClearPractice() *VocabularyTermUpdate {
+	_u.mutation.ClearPractice()
+	return _u
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (_u *VocabularyTermUpdate) ClearDoctor() *VocabularyTermUpdate {
+	_u.mutation.ClearDoctor()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VocabularyTermUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VocabularyTermUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VocabularyTermUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VocabularyTermUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VocabularyTermUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := vocabularyterm.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VocabularyTermUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := vocabularyterm.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "VocabularyTerm.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Key(); ok {
+		if err := vocabularyterm.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "VocabularyTerm.key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *VocabularyTermUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vocabularyterm.Table, vocabularyterm.Columns, sqlgraph.NewFieldSpec(vocabularyterm.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(vocabularyterm.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(vocabularyterm.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(vocabularyterm.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(vocabularyterm.FieldLabels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Synonyms(); ok {
+		_spec.SetField(vocabularyterm.FieldSynonyms, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSynonyms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vocabularyterm.FieldSynonyms, value)
+		})
+	}
+	if _u.mutation.SynonymsCleared() {
+		_spec.ClearField(vocabularyterm.FieldSynonyms, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(vocabularyterm.FieldActive, field.TypeBool, value)
+	}
+	if _u.mutation.PracticeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vocabularyterm.PracticeTable,
+			Columns: []string{vocabularyterm.PracticeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(practice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PracticeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vocabularyterm.PracticeTable,
+			Columns: []string{vocabularyterm.PracticeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(practice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DoctorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vocabularyterm.DoctorTable,
+			Columns: []string{vocabularyterm.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vocabularyterm.DoctorTable,
+			Columns: []string{vocabularyterm.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vocabularyterm.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VocabularyTermUpdateOne is the builder for updating a single VocabularyTerm entity.
+type VocabularyTermUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VocabularyTermMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VocabularyTermUpdateOne) SetUpdatedAt(v time.Time) *VocabularyTermUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *VocabularyTermUpdateOne) SetKind(v vocabularyterm.Kind) *VocabularyTermUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *VocabularyTermUpdateOne) SetNillableKind(v *vocabularyterm.Kind) *VocabularyTermUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *VocabularyTermUpdateOne) SetKey(v string) *VocabularyTermUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *VocabularyTermUpdateOne) SetNillableKey(v *string) *VocabularyTermUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetLabels sets the "labels" field.
+func (_u *VocabularyTermUpdateOne) SetLabels(v map[string]string) *VocabularyTermUpdateOne {
+	_u.mutation.SetLabels(v)
+	return _u
+}
+
+// SetSynonyms sets the "synonyms" field.
+func (_u *VocabularyTermUpdateOne) SetSynonyms(v []string) *VocabularyTermUpdateOne {
+	_u.mutation.SetSynonyms(v)
+	return _u
+}
+
+// AppendSynonyms appends value to the "synonyms" field.
+func (_u *VocabularyTermUpdateOne) AppendSynonyms(v []string) *VocabularyTermUpdateOne {
+	_u.mutation.AppendSynonyms(v)
+	return _u
+}
+
+// ClearSynonyms clears the value of the "synonyms" field.
+func (_u *VocabularyTermUpdateOne) ClearSynonyms() *VocabularyTermUpdateOne {
+	_u.mutation.ClearSynonyms()
+	return _u
+}
+
+// SetPracticeID sets the "practice_id" field.
+func (_u *VocabularyTermUpdateOne) SetPracticeID(v uuid.UUID) *VocabularyTermUpdateOne {
+	_u.mutation.SetPracticeID(v)
+	return _u
+}
+
+// SetNillablePracticeID sets the "practice_id" field if the given value is not nil.
+func (_u *VocabularyTermUpdateOne) SetNillablePracticeID(v *uuid.UUID) *VocabularyTermUpdateOne {
+	if v != nil {
+		_u.SetPracticeID(*v)
+	}
+	return _u
+}
+
+// ClearPracticeID clears the value of the "practice_id" field.
+func (_u *VocabularyTermUpdateOne) ClearPracticeID() *VocabularyTermUpdateOne {
+	_u.mutation.ClearPracticeID()
+	return _u
+}
+
+// SetDoctorID sets the "doctor_id" field.
+func (_u *VocabularyTermUpdateOne) SetDoctorID(v uuid.UUID) *VocabularyTermUpdateOne {
+	_u.mutation.SetDoctorID(v)
+	return _u
+}
+
+// SetNillableDoctorID sets the "doctor_id" field if the given value is not nil.
+func (_u *VocabularyTermUpdateOne) SetNillableDoctorID(v *uuid.UUID) *VocabularyTermUpdateOne {
+	if v != nil {
+		_u.SetDoctorID(*v)
+	}
+	return _u
+}
+
+// ClearDoctorID clears the value of the "doctor_id" field.
+func (_u *VocabularyTermUpdateOne) ClearDoctorID() *VocabularyTermUpdateOne {
+	_u.mutation.ClearDoctorID()
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *VocabularyTermUpdateOne) SetActive(v bool) *VocabularyTermUpdateOne {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *VocabularyTermUpdateOne) SetNillableActive(v *bool) *VocabularyTermUpdateOne {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetPractice sets the "practice" edge to the Practice entity.
+func (_u *VocabularyTermUpdateOne) SetPractice(v *Practice) *VocabularyTermUpdateOne {
+	return _u.SetPracticeID(v.ID)
+}
+
+// SetDoctor sets the "doctor" edge to the Doctor entity.
+func (_u *VocabularyTermUpdateOne) SetDoctor(v *Doctor) *VocabularyTermUpdateOne {
+	return _u.SetDoctorID(v.ID)
+}
+
+// Mutation returns the VocabularyTermMutation object of the builder.
+func (_u *VocabularyTermUpdateOne) Mutation() *VocabularyTermMutation {
+	return _u.mutation
+}
+
+// ClearPractice clears the "practice" edge to the Practice entity.
+func (_u *VocabularyTermUpdateOne) ClearPractice() *VocabularyTermUpdateOne {
+	_u.mutation.ClearPractice()
+	return _u
+}
+
+// ClearDoctor clears the "doctor" edge to the Doctor entity.
+func (_u *VocabularyTermUpdateOne) ClearDoctor() *VocabularyTermUpdateOne {
+	_u.mutation.ClearDoctor()
+	return _u
+}
+
+// Where appends a list predicates to the VocabularyTermUpdate builder.
+func (_u *VocabularyTermUpdateOne) Where(ps ...predicate.VocabularyTerm) *VocabularyTermUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VocabularyTermUpdateOne) Select(field string, fields ...string) *VocabularyTermUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated VocabularyTerm entity.
+func (_u *VocabularyTermUpdateOne) Save(ctx context.Context) (*VocabularyTerm, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VocabularyTermUpdateOne) SaveX(ctx context.Context) *VocabularyTerm {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VocabularyTermUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VocabularyTermUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VocabularyTermUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := vocabularyterm.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VocabularyTermUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := vocabularyterm.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "VocabularyTerm.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Key(); ok {
+		if err := vocabularyterm.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "VocabularyTerm.key": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *VocabularyTermUpdateOne) sqlSave(ctx context.Context) (_node *VocabularyTerm, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(vocabularyterm.Table, vocabularyterm.Columns, sqlgraph.NewFieldSpec(vocabularyterm.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VocabularyTerm.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vocabularyterm.FieldID)
+		for _, f := range fields {
+			if !vocabularyterm.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vocabularyterm.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(vocabularyterm.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(vocabularyterm.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(vocabularyterm.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Labels(); ok {
+		_spec.SetField(vocabularyterm.FieldLabels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Synonyms(); ok {
+		_spec.SetField(vocabularyterm.FieldSynonyms, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSynonyms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vocabularyterm.FieldSynonyms, value)
+		})
+	}
+	if _u.mutation.SynonymsCleared() {
+		_spec.ClearField(vocabularyterm.FieldSynonyms, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(vocabularyterm.FieldActive, field.TypeBool, value)
+	}
+	if _u.mutation.PracticeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vocabularyterm.PracticeTable,
+			Columns: []string{vocabularyterm.PracticeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(practice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PracticeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vocabularyterm.PracticeTable,
+			Columns: []string{vocabularyterm.PracticeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(practice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DoctorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vocabularyterm.DoctorTable,
+			Columns: []string{vocabularyterm.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DoctorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vocabularyterm.DoctorTable,
+			Columns: []string{vocabularyterm.DoctorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(doctor.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &VocabularyTerm{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vocabularyterm.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
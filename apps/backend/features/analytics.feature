@@ -14,3 +14,36 @@ Feature: Patient analytics
     And the response JSON field "distributions.techniques.breathing" should be "1"
     And the response JSON field "trend.0.avgStutterFrequency" should be "4"
     And the response JSON field "trend.1.avgStutterFrequency" should be "6"
+
+  Scenario: Doctor views the caseload dashboard
+    Given the API is running
+    And I register a doctor with email "dashboarddoc@example.com" password "SuperSecret1" displayName "Dashboard Doc"
+    And patient "Pat One" has analytics entries:
+      | happenedAt | stutterFrequency | emotions | triggers      | techniques  |
+      | today-3d   | 3                | calm     | phone         | breathing   |
+      | today-2d   | 5                | anxious  | phone,meeting | breathing   |
+      | today-1d   | 7                | anxious  | phone         | slow speech |
+    When I call GET "/dashboard"
+    Then the response status should be 200
+    And the response JSON field "patients.total" should be "1"
+    And the response JSON field "patients.active" should be "1"
+    And the response JSON field "adherence.patients.0.entries" should be "3"
+    And the response JSON field "worsening.0.ratings" should be "3"
+    And the response JSON field "topTriggers.0.name" should be "phone"
+    And the response JSON field "topTriggers.0.count" should be "3"
+
+  Scenario: Patient views their own insights
+    Given the API is running
+    And I register a patient with email "insights@example.com" password "SuperSecret1" displayName "Insight Pat" and analytics entries:
+      | happenedAt | stutterFrequency | emotions | triggers | techniques  |
+      | today-2d   | 6                | anxious  | phone    | breathing   |
+      | today-1d   | 5                | calm     | phone    | breathing   |
+      | today      | 4                | calm     | meeting  | slow speech |
+    When I call GET "/patient/insights?range=30&bucket=week"
+    Then the response status should be 200
+    And the response JSON field "bucket" should be "week"
+    And the response JSON field "streak.current" should be "3"
+    And the response JSON field "streak.longest" should be "3"
+    And the response JSON field "topTriggers.0.name" should be "phone"
+    And the response JSON field "topTechniques.0.name" should be "breathing"
+    And the response JSON field "trendDirection" should be "improving"
@@ -11,3 +11,208 @@ Feature: Patient entries timeline
     Then the response status should be 200
     And the response JSON field "entries.0.situation" should be "At school"
     And the response JSON field "entries.1.situation" should be "At clinic visit"
+
+  Scenario: Doctor exports patient entries as a FHIR bundle
+    Given the API is running
+    And I register a doctor with email "fhirdoc@example.com" password "SuperSecret1" displayName "FHIR Doc"
+    And patient "Pat One" has entries:
+      | happenedAt                 | situation        | notes            |
+      | 2025-01-01T10:00:00Z       | At school        | morning entry    |
+    When I call GET "/patients/{patOneID}/entries?format=fhir"
+    Then the response status should be 200
+    And the response JSON field "resourceType" should be "Bundle"
+    And the response JSON field "entry.0.resource.resourceType" should be "Patient"
+    And the response JSON field "entry.1.resource.resourceType" should be "QuestionnaireResponse"
+
+  Scenario: Doctor pages through patient entries newest first
+    Given the API is running
+    And I register a doctor with email "pagingdoc@example.com" password "SuperSecret1" displayName "Paging Doc"
+    And patient "Pat One" has entries:
+      | happenedAt                 | situation        | notes            |
+      | 2025-01-01T10:00:00Z       | At school        | morning entry    |
+      | 2025-01-02T12:00:00Z       | At clinic visit  | follow-up entry  |
+    When I call GET "/patients/{patOneID}/entries?limit=1&sort=-happenedAt"
+    Then the response status should be 200
+    And the response JSON field "entries.0.situation" should be "At clinic visit"
+    When I call GET "/patients/{patOneID}/entries?cursor=garbage"
+    Then the response status should be 400
+
+  Scenario: Doctor searches a patient's entries
+    Given the API is running
+    And I register a doctor with email "searchdoc@example.com" password "SuperSecret1" displayName "Search Doc"
+    And patient "Pat One" has entries:
+      | happenedAt                 | situation        | notes                        |
+      | 2025-01-01T10:00:00Z       | At school        | presentation went fine       |
+      | 2025-01-02T12:00:00Z       | Phone call       | blocked on my name on the phone call |
+    When I call GET "/patients/{patOneID}/entries/search?q=%22phone%20call%22"
+    Then the response status should be 200
+    And the response JSON field "hits.0.entry.situation" should be "Phone call"
+    When I call GET "/patients/{patOneID}/entries/search"
+    Then the response status should be 400
+
+  Scenario: Doctor fetches the localised trigger vocabulary
+    Given the API is running
+    And I register a doctor with email "vocabdoc@example.com" password "SuperSecret1" displayName "Vocab Doc"
+    When I call GET "/vocabulary?kind=trigger&locale=de"
+    Then the response status should be 200
+    And the response JSON field "locale" should be "de"
+    And the response JSON field "terms.0.key" should be "meeting"
+    And the response JSON field "terms.0.label" should be "Besprechung"
+    And the response JSON field "terms.0.scope" should be "global"
+
+  Scenario: Doctor sets a goal and sees progress from matching entries
+    Given the API is running
+    And I register a doctor with email "goaldoc@example.com" password "SuperSecret1" displayName "Goal Doc"
+    And patient "Pat One" has entries:
+      | happenedAt                 | situation        | notes            |
+      | 2025-01-01T10:00:00Z       | At school        | morning entry    |
+      | 2025-01-02T12:00:00Z       | At clinic visit  | follow-up entry  |
+    When I call POST "/patients/{patOneID}/goals" with JSON:
+      """
+      {"title": "Journal twice", "targetCount": 2, "period": "Total", "startsAt": "2025-01-01T00:00:00Z"}
+      """
+    Then the response status should be 201
+    And the response JSON field "progress.count" should be "2"
+    And the response JSON field "progress.status" should be "achieved"
+    When I call GET "/patients/{patOneID}/goals"
+    Then the response status should be 200
+    And the response JSON field "goals.0.title" should be "Journal twice"
+
+  Scenario: Doctor assigns homework and sees the completion overview
+    Given the API is running
+    And I register a doctor with email "homeworkdoc@example.com" password "SuperSecret1" displayName "Homework Doc"
+    And patient "Pat One" has entries:
+      | happenedAt                 | situation        | notes            |
+      | 2025-01-01T10:00:00Z       | At school        | morning entry    |
+    When I call POST "/patients/{patOneID}/assignments" with JSON:
+      """
+      {"title": "Read aloud for 10 minutes", "instructions": "Use easy onset", "recurrence": "Daily"}
+      """
+    Then the response status should be 201
+    And the response JSON field "recurrence" should be "Daily"
+    And the response JSON field "completion.periodsExpected" should be "1"
+    And the response JSON field "completion.status" should be "on_track"
+    When I call GET "/patients/{patOneID}/assignments"
+    Then the response status should be 200
+    And the response JSON field "active" should be "1"
+    And the response JSON field "assignments.0.title" should be "Read aloud for 10 minutes"
+    And the response JSON field "assignments.0.hasAttachment" should be "false"
+
+  Scenario: Doctor records a session that shows up in the patient timeline
+    Given the API is running
+    And I register a doctor with email "sessiondoc@example.com" password "SuperSecret1" displayName "Session Doc"
+    And patient "Pat One" has entries:
+      | happenedAt                 | situation        | notes            |
+      | 2025-01-01T10:00:00Z       | At school        | morning entry    |
+    When I call POST "/patients/{patOneID}/sessions" with JSON:
+      """
+      {"heldAt": "2025-01-03T09:00:00Z", "durationMinutes": 45, "clinicianNotes": "Avoidance around names", "patientSummary": "Practised easy onset"}
+      """
+    Then the response status should be 201
+    And the response JSON field "clinicianNotes" should be "Avoidance around names"
+    When I call GET "/patients/{patOneID}/timeline?types=entry,session"
+    Then the response status should be 200
+    And the response JSON field "events.0.type" should be "session"
+    And the response JSON field "events.0.session.patientSummary" should be "Practised easy onset"
+    And the response JSON field "events.1.type" should be "entry"
+
+  Scenario: Doctor pages through the unified patient timeline
+    Given the API is running
+    And I register a doctor with email "timelinedoc@example.com" password "SuperSecret1" displayName "Timeline Doc"
+    And patient "Pat One" has entries:
+      | happenedAt                 | situation        | notes            |
+      | 2025-01-01T10:00:00Z       | At school        | morning entry    |
+      | 2025-01-02T12:00:00Z       | Phone call       | follow-up entry  |
+    When I call POST "/patients/{patOneID}/goals" with JSON:
+      """
+      {"title": "Journal twice", "targetCount": 2, "period": "Total", "startsAt": "2025-01-01T00:00:00Z"}
+      """
+    Then the response status should be 201
+    When I call GET "/patients/{patOneID}/timeline?limit=1"
+    Then the response status should be 200
+    And the response JSON field "events.0.type" should be "goal_created"
+    And the response JSON field "events.0.goal.title" should be "Journal twice"
+    When I call GET "/patients/{patOneID}/timeline?types=entry&sort=at&limit=1"
+    Then the response status should be 200
+    And the response JSON field "events.0.entry.situation" should be "At school"
+    When I call GET "/patients/{patOneID}/timeline?types=bogus"
+    Then the response status should be 400
+
+  Scenario: Doctor publishes a questionnaire and assigns it to a patient
+    Given the API is running
+    And I register a doctor with email "questionnairedoc@example.com" password "SuperSecret1" displayName "Questionnaire Doc"
+    And patient "Pat One" has entries:
+      | happenedAt                 | situation        | notes            |
+      | 2025-01-01T10:00:00Z       | At school        | morning entry    |
+    When I call POST "/questionnaires" with JSON:
+      """
+      {"key": "Speech Attitudes", "title": "Speech attitudes", "definition": {
+        "items": [
+          {"id": "q1", "text": "I avoid phone calls", "options": [{"value": "yes", "label": "Yes", "score": 1}, {"value": "no", "label": "No", "score": 0}]},
+          {"id": "q2", "text": "I enjoy speaking in groups", "options": [{"value": "yes", "label": "Yes", "score": 0}, {"value": "no", "label": "No", "score": 1}]}
+        ],
+        "scales": [{"key": "total", "title": "Total", "items": ["q1", "q2"], "method": "sum", "bands": [{"max": 1, "label": "Low"}, {"max": 2, "label": "High"}]}]
+      }}
+      """
+    Then the response status should be 201
+    And the response JSON field "key" should be "speech-attitudes"
+    And the response JSON field "version" should be "1"
+    When I call POST "/patients/{patOneID}/questionnaires" with JSON:
+      """
+      {"key": "speech-attitudes"}
+      """
+    Then the response status should be 201
+    And the response JSON field "status" should be "Pending"
+    And the response JSON field "questionnaire.items" should be "2"
+    When I call GET "/patients/{patOneID}/questionnaires/scores"
+    Then the response status should be 200
+    And the response JSON field "series" should be "[]"
+
+  Scenario: Doctor cannot publish a version of another doctor's questionnaire
+    Given the API is running
+    And I register a doctor with email "keyowner@example.com" password "SuperSecret1" displayName "Key Owner"
+    When I call POST "/questionnaires" with JSON:
+      """
+      {"key": "owned-scale", "title": "Owned scale", "definition": {
+        "items": [{"id": "q1", "text": "I avoid phone calls", "options": [{"value": "yes", "label": "Yes", "score": 1}, {"value": "no", "label": "No", "score": 0}]}],
+        "scales": [{"key": "total", "title": "Total", "items": ["q1"], "method": "sum"}]
+      }}
+      """
+    Then the response status should be 201
+    Given I register a doctor with email "otherdoc@example.com" password "SuperSecret1" displayName "Other Doc"
+    When I call POST "/questionnaires" with JSON:
+      """
+      {"key": "owned-scale", "title": "Replaced scale", "definition": {
+        "items": [{"id": "q1", "text": "I like phone calls", "options": [{"value": "yes", "label": "Yes", "score": 0}, {"value": "no", "label": "No", "score": 1}]}],
+        "scales": [{"key": "total", "title": "Total", "items": ["q1"], "method": "sum"}]
+      }}
+      """
+    Then the response status should be 403
+
+  Scenario: Doctor sets up an inactivity reminder in the patient's timezone
+    Given the API is running
+    And I register a doctor with email "reminderdoc@example.com" password "SuperSecret1" displayName "Reminder Doc"
+    And patient "Pat One" has entries:
+      | happenedAt                 | situation        | notes            |
+      | 2025-01-01T10:00:00Z       | At school        | morning entry    |
+    When I call POST "/patients/{patOneID}/reminders" with JSON:
+      """
+      {"kind": "Inactivity", "channel": "Log", "timezone": "Europe/Berlin", "timeOfDay": "19:30", "weekdays": ["Mon", "thu"], "inactivityDays": 3}
+      """
+    Then the response status should be 201
+    And the response JSON field "timezone" should be "Europe/Berlin"
+    And the response JSON field "weekdays.0" should be "mon"
+    And the response JSON field "enabled" should be "true"
+    When I call POST "/patients/{patOneID}/reminders" with JSON:
+      """
+      {"kind": "Journal", "channel": "Push", "timezone": "Europe/Berlin", "timeOfDay": "19:30"}
+      """
+    Then the response status should be 400
+    When I call POST "/patients/{patOneID}/reminders" with JSON:
+      """
+      {"kind": "Journal", "channel": "Log", "timezone": "Mars/Olympus", "timeOfDay": "19:30"}
+      """
+    Then the response status should be 400
+    When I call GET "/patients/{patOneID}/reminders"
+    Then the response status should be 200
+    And the response JSON field "reminders.0.inactivityDays" should be "3"
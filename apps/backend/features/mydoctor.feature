@@ -21,6 +21,7 @@ Feature: Patient doctor view
     When I call patient GET "/patient/mydoctor"
     Then the response status should be 404
     And the response JSON field "error" should be "no doctor assigned"
+    And the response JSON field "code" should be "no_doctor_assigned"
 
   Scenario: Pending link does not count as assigned
     Given the API is running
@@ -31,3 +32,4 @@ Feature: Patient doctor view
     And I call patient GET "/patient/mydoctor"
     Then the response status should be 404
     And the response JSON field "error" should be "no doctor assigned"
+    And the response JSON field "code" should be "no_doctor_assigned"
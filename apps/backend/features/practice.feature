@@ -10,3 +10,43 @@ Feature: Practice onboarding
     And the response JSON field "practice.name" should be "Owner Practice"
     And the current doctor is assigned to the created practice
     And the current doctor role is "Owner"
+
+  Scenario: Practice owner subscribes a webhook
+    Given the API is running
+    And I register a doctor with email "hooks@example.com" password "SuperSecret1" displayName "Hooks"
+    And I create a practice:
+      | name | Hook Practice |
+    When I call POST "/practice/webhooks" with JSON:
+      """
+      {"url": "https://ehr.example.com/hooks", "events": ["link.approved", "entry.shared"]}
+      """
+    Then the response status should be 201
+    And the response JSON field "events.1" should be "entry.shared"
+    And the response JSON field "secret" should not be empty
+    When I call GET "/practice/webhooks"
+    Then the response status should be 200
+    And the response JSON field "webhooks.0.url" should be "https://ehr.example.com/hooks"
+    When I call GET "/practice/webhooks/{webhookID}/deliveries"
+    Then the response status should be 200
+
+  Scenario: Webhooks reject unknown events and plain http
+    Given the API is running
+    And I register a doctor with email "badhooks@example.com" password "SuperSecret1" displayName "Bad Hooks"
+    And I create a practice:
+      | name | Bad Hook Practice |
+    When I call POST "/practice/webhooks" with JSON:
+      """
+      {"url": "https://ehr.example.com/hooks", "events": ["entry.deleted"]}
+      """
+    Then the response status should be 400
+    When I call POST "/practice/webhooks" with JSON:
+      """
+      {"url": "http://ehr.example.com/hooks", "events": ["link.approved"]}
+      """
+    Then the response status should be 400
+
+  Scenario: Doctors without a practice cannot manage webhooks
+    Given the API is running
+    And I register a doctor with email "solo@example.com" password "SuperSecret1" displayName "Solo"
+    When I call GET "/practice/webhooks"
+    Then the response status should be 403
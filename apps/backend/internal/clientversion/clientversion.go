@@ -0,0 +1,128 @@
+// Package clientversion checks the app version clients send in X-App-Version
+// against the oldest supported and the latest released version.
+package clientversion
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+// Header carries the app's semantic version, e.g. "1.4.2".
+const Header = "X-App-Version"
+
+// Unknown labels clients that sent no version or one that does not parse.
+const Unknown = "unknown"
+
+// Newer labels versions above the latest release, which no real client runs.
+const Newer = "newer"
+
+type Status int
+
+const (
+	// StatusUnknown is for clients that did not send a valid version; the
+	// web app and scripts fall here and are never asked to upgrade.
+	StatusUnknown Status = iota
+	StatusCurrent
+	// StatusOutdated clients still work but should offer an update.
+	StatusOutdated
+	// StatusUnsupported clients are below the minimum and are refused.
+	StatusUnsupported
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusCurrent:
+		return "current"
+	case StatusOutdated:
+		return "outdated"
+	case StatusUnsupported:
+		return "unsupported"
+	default:
+		return Unknown
+	}
+}
+
+// Policy holds canonical versions ("v1.4.2"); an empty bound is not enforced.
+// The zero Policy treats every valid version as current.
+type Policy struct {
+	Minimum string
+	Latest  string
+}
+
+// LoadPolicy reads MIN_APP_VERSION and LATEST_APP_VERSION.
+func LoadPolicy() (Policy, error) {
+	var p Policy
+	for _, v := range []struct {
+		key string
+		dst *string
+	}{
+		{"MIN_APP_VERSION", &p.Minimum},
+		{"LATEST_APP_VERSION", &p.Latest},
+	} {
+		raw := strings.TrimSpace(os.Getenv(v.key))
+		if raw == "" {
+			continue
+		}
+		canonical, ok := Canonical(raw)
+		if !ok {
+			return Policy{}, fmt.Errorf("%s must be a semantic version, got %q", v.key, raw)
+		}
+		*v.dst = canonical
+	}
+	if p.Minimum != "" && p.Latest != "" && semver.Compare(p.Minimum, p.Latest) > 0 {
+		return Policy{}, fmt.Errorf("MIN_APP_VERSION %s is newer than LATEST_APP_VERSION %s", p.Minimum, p.Latest)
+	}
+	return p, nil
+}
+
+// Canonical parses raw ("1.4", "v1.4.2", "1.4.2-beta.1") into the canonical
+// "vMAJOR.MINOR.PATCH[-pre]" form; build metadata is dropped.
+func Canonical(raw string) (string, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", false
+	}
+	if !strings.HasPrefix(raw, "v") {
+		raw = "v" + raw
+	}
+	if !semver.IsValid(raw) {
+		return "", false
+	}
+	return semver.Canonical(raw), true
+}
+
+// Label returns a metric label for a canonical version that clients cannot
+// grow at will: "1.4" for minors of the latest release's major, "0.x" for
+// older majors, Newer above the latest release and Unknown when no version
+// was sent or no latest release is configured.
+func (p Policy) Label(canonical string) string {
+	if canonical == "" || p.Latest == "" {
+		return Unknown
+	}
+	if semver.Compare(canonical, p.Latest) > 0 {
+		return Newer
+	}
+	if semver.Major(canonical) != semver.Major(p.Latest) {
+		return strings.TrimPrefix(semver.Major(canonical), "v") + ".x"
+	}
+	return strings.TrimPrefix(semver.MajorMinor(canonical), "v")
+}
+
+// Check parses the header value raw and reports its canonical form and status.
+func (p Policy) Check(raw string) (string, Status) {
+	v, ok := Canonical(raw)
+	if !ok {
+		return "", StatusUnknown
+	}
+	switch {
+	case p.Minimum != "" && semver.Compare(v, p.Minimum) < 0:
+		return v, StatusUnsupported
+	case p.Latest != "" && semver.Compare(v, p.Latest) < 0:
+		return v, StatusOutdated
+	default:
+		return v, StatusCurrent
+	}
+}
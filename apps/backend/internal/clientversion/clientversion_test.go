@@ -0,0 +1,78 @@
+package clientversion
+
+import "testing"
+
+func TestCheck(t *testing.T) {
+	p := Policy{Minimum: "v1.2.0", Latest: "v1.5.0"}
+	cases := []struct {
+		raw    string
+		want   string
+		status Status
+	}{
+		{"", "", StatusUnknown},
+		{"not-a-version", "", StatusUnknown},
+		{"1.1.9", "v1.1.9", StatusUnsupported},
+		{"1.2", "v1.2.0", StatusOutdated},
+		{"v1.4.7", "v1.4.7", StatusOutdated},
+		{"1.5.0-beta.1", "v1.5.0-beta.1", StatusOutdated},
+		{"1.5.0", "v1.5.0", StatusCurrent},
+		{"2.0.0+build.7", "v2.0.0", StatusCurrent},
+	}
+	for _, tc := range cases {
+		got, status := p.Check(tc.raw)
+		if got != tc.want || status != tc.status {
+			t.Fatalf("Check(%q) = %q %s; want %q %s", tc.raw, got, status, tc.want, tc.status)
+		}
+	}
+}
+
+func TestZeroPolicyTreatsVersionsAsCurrent(t *testing.T) {
+	if _, status := (Policy{}).Check("0.0.1"); status != StatusCurrent {
+		t.Fatalf("expected current, got %s", status)
+	}
+}
+
+func TestLoadPolicy(t *testing.T) {
+	t.Setenv("MIN_APP_VERSION", "1.2")
+	t.Setenv("LATEST_APP_VERSION", "1.5.0")
+	p, err := LoadPolicy()
+	if err != nil {
+		t.Fatalf("LoadPolicy: %v", err)
+	}
+	if p.Minimum != "v1.2.0" || p.Latest != "v1.5.0" {
+		t.Fatalf("unexpected policy %+v", p)
+	}
+
+	t.Setenv("MIN_APP_VERSION", "2.0.0")
+	if _, err := LoadPolicy(); err == nil {
+		t.Fatal("expected an error when the minimum is newer than the latest version")
+	}
+
+	t.Setenv("MIN_APP_VERSION", "latest")
+	if _, err := LoadPolicy(); err == nil {
+		t.Fatal("expected an error for an invalid version")
+	}
+}
+
+func TestLabel(t *testing.T) {
+	p := Policy{Minimum: "v1.2.0", Latest: "v2.3.0"}
+	cases := map[string]string{
+		"":              Unknown,
+		"v2.3.0":        "2.3",
+		"v2.1.7":        "2.1",
+		"v2.3.0-beta.1": "2.3",
+		"v1.9.0":        "1.x",
+		"v0.1.0":        "0.x",
+		"v2.3.1":        Newer,
+		"v2.99999.0":    Newer,
+		"v999.0.0":      Newer,
+	}
+	for v, want := range cases {
+		if got := p.Label(v); got != want {
+			t.Fatalf("Label(%q) = %q; want %q", v, got, want)
+		}
+	}
+	if got := (Policy{}).Label("v1.4.2"); got != Unknown {
+		t.Fatalf("expected %q without a latest release, got %q", Unknown, got)
+	}
+}
@@ -9,10 +9,12 @@ import (
 	"time"
 
 	"backend/ent"
+	"backend/internal/tracing"
 
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/charmbracelet/log"
+	"github.com/jackc/pgx/v5"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	_ "github.com/joho/godotenv/autoload"
 )
@@ -59,7 +61,7 @@ func NewWithConfig(ctx context.Context, cfg Config, logger *log.Logger) (*Client
 		return nil, fmt.Errorf("ping database: %w", err)
 	}
 
-	drv := entsql.OpenDB(dialect.Postgres, sqlDB)
+	drv := tracing.Driver(entsql.OpenDB(dialect.Postgres, sqlDB))
 	entClient := ent.NewClient(ent.Driver(drv))
 
 	client := &Client{
@@ -97,6 +99,30 @@ func (c *Client) Ping(ctx context.Context) error {
 	return c.sqlDB.PingContext(pingCtx)
 }
 
+// Stats reports connection pool statistics.
+func (c *Client) Stats() sql.DBStats {
+	return c.sqlDB.Stats()
+}
+
+// Listen opens a dedicated connection subscribed to a LISTEN/NOTIFY channel.
+// Pooled connections cannot hold a LISTEN, so the caller owns and closes it.
+func (c *Client) Listen(ctx context.Context, channel string) (*pgx.Conn, error) {
+	dsn, err := c.cfg.DSN()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open listen connection: %w", err)
+	}
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+		_ = conn.Close(ctx)
+		return nil, fmt.Errorf("listen on %s: %w", channel, err)
+	}
+	return conn, nil
+}
+
 // Close closes the Ent and SQL connections.
 func (c *Client) Close() error {
 	var errs []error
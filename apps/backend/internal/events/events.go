@@ -0,0 +1,105 @@
+// Package events fans domain events out to connected doctors. The outbox relay
+// publishes to a Bus; streaming endpoints subscribe per doctor. LocalBus serves a single
+// process, PGBus carries events across replicas with Postgres LISTEN/NOTIFY.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event types published to doctors.
+const (
+	TypeLinkRequested = "link.requested"
+	TypeLinkApproved  = "link.approved"
+	TypeLinkRevoked   = "link.revoked"
+	TypeEntriesSynced = "entries.synced"
+)
+
+// subscriberBuffer is how many events a slow subscriber may lag behind before
+// further events are dropped for it.
+const subscriberBuffer = 32
+
+// Event is addressed to one doctor. Data is the type-specific JSON payload;
+// keep it small (IDs and counts) since NOTIFY payloads are limited to 8000 bytes.
+type Event struct {
+	ID       uuid.UUID       `json:"id"`
+	Type     string          `json:"type"`
+	DoctorID uuid.UUID       `json:"doctorId"`
+	At       time.Time       `json:"at"`
+	Data     json.RawMessage `json:"data,omitempty"`
+}
+
+// New builds an event for doctorID with data marshalled as its payload.
+func New(eventType string, doctorID uuid.UUID, data any) (Event, error) {
+	ev := Event{ID: uuid.New(), Type: eventType, DoctorID: doctorID, At: time.Now().UTC()}
+	if data != nil {
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return Event{}, err
+		}
+		ev.Data = raw
+	}
+	return ev, nil
+}
+
+// Bus delivers published events to the subscribers of the addressed doctor.
+// Publishing never blocks on slow subscribers.
+type Bus interface {
+	Publish(ctx context.Context, ev Event) error
+	// Subscribe returns a channel of events for doctorID and a function that
+	// ends the subscription and closes the channel.
+	Subscribe(doctorID uuid.UUID) (<-chan Event, func())
+}
+
+// LocalBus is an in-process Bus.
+type LocalBus struct {
+	mu   sync.RWMutex
+	subs map[uuid.UUID]map[chan Event]struct{}
+}
+
+func NewLocalBus() *LocalBus {
+	return &LocalBus{subs: map[uuid.UUID]map[chan Event]struct{}{}}
+}
+
+func (b *LocalBus) Publish(_ context.Context, ev Event) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for ch := range b.subs[ev.DoctorID] {
+		select {
+		case ch <- ev:
+		default:
+			// The subscriber is not keeping up; it can resync via the REST API.
+		}
+	}
+	return nil
+}
+
+func (b *LocalBus) Subscribe(doctorID uuid.UUID) (<-chan Event, func()) {
+	ch := make(chan Event, subscriberBuffer)
+
+	b.mu.Lock()
+	if b.subs[doctorID] == nil {
+		b.subs[doctorID] = map[chan Event]struct{}{}
+	}
+	b.subs[doctorID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subs[doctorID], ch)
+			if len(b.subs[doctorID]) == 0 {
+				delete(b.subs, doctorID)
+			}
+			b.mu.Unlock()
+			close(ch)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestLocalBusDeliversToAddressedDoctor(t *testing.T) {
+	bus := NewLocalBus()
+	doctorA, doctorB := uuid.New(), uuid.New()
+
+	chA, stopA := bus.Subscribe(doctorA)
+	defer stopA()
+	chB, stopB := bus.Subscribe(doctorB)
+	defer stopB()
+
+	ev, err := New(TypeLinkApproved, doctorA, map[string]string{"linkId": "x"})
+	if err != nil {
+		t.Fatalf("new event: %v", err)
+	}
+	if err := bus.Publish(context.Background(), ev); err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+
+	select {
+	case got := <-chA:
+		if got.ID != ev.ID || string(got.Data) != `{"linkId":"x"}` {
+			t.Fatalf("unexpected event %+v", got)
+		}
+	default:
+		t.Fatalf("expected doctor A to receive the event")
+	}
+	select {
+	case got := <-chB:
+		t.Fatalf("doctor B should not receive %+v", got)
+	default:
+	}
+}
+
+func TestLocalBusDropsForSlowSubscribers(t *testing.T) {
+	bus := NewLocalBus()
+	doctor := uuid.New()
+	ch, stop := bus.Subscribe(doctor)
+
+	for i := 0; i < subscriberBuffer+5; i++ {
+		ev, _ := New(TypeEntriesSynced, doctor, nil)
+		if err := bus.Publish(context.Background(), ev); err != nil {
+			t.Fatalf("publish: %v", err)
+		}
+	}
+	if len(ch) != subscriberBuffer {
+		t.Fatalf("expected %d buffered events, got %d", subscriberBuffer, len(ch))
+	}
+
+	stop()
+	stop()
+	for range ch {
+	}
+}
@@ -0,0 +1,114 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// Channel is the Postgres NOTIFY channel events travel on.
+	Channel = "doctor_events"
+
+	maxNotifyPayload = 8000
+	minListenBackoff = time.Second
+	maxListenBackoff = 30 * time.Second
+	// listenStable is how long a connection must last before the next
+	// disconnect is treated as a fresh failure rather than a flapping one.
+	listenStable = time.Minute
+)
+
+// Execer runs the NOTIFY; *ent.Client and *sql.DB satisfy it.
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// Listener opens a dedicated connection that is already listening on channel.
+type Listener interface {
+	Listen(ctx context.Context, channel string) (*pgx.Conn, error)
+}
+
+// PGBus publishes with pg_notify and delivers what it hears on the channel to
+// local subscribers, so every replica sees every event, its own included.
+type PGBus struct {
+	db       Execer
+	listener Listener
+	local    *LocalBus
+}
+
+func NewPGBus(db Execer, listener Listener) *PGBus {
+	return &PGBus{db: db, listener: listener, local: NewLocalBus()}
+}
+
+func (b *PGBus) Publish(ctx context.Context, ev Event) error {
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	if len(payload) > maxNotifyPayload {
+		return fmt.Errorf("event %s payload is %d bytes, limit is %d", ev.Type, len(payload), maxNotifyPayload)
+	}
+	_, err = b.db.ExecContext(ctx, "SELECT pg_notify($1, $2)", Channel, string(payload))
+	return err
+}
+
+func (b *PGBus) Subscribe(doctorID uuid.UUID) (<-chan Event, func()) {
+	return b.local.Subscribe(doctorID)
+}
+
+// Run listens until ctx is cancelled, reconnecting with backoff. The backoff
+// starts over once a connection has delivered a notification or stayed up for
+// a while. Events published while the listener is down are lost; clients
+// resync via REST.
+func (b *PGBus) Run(ctx context.Context) {
+	backoff := minListenBackoff
+	for {
+		started := time.Now()
+		heard, err := b.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if heard || time.Since(started) >= listenStable {
+			backoff = minListenBackoff
+		}
+		log.Warn("event listener disconnected; reconnecting", "err", err, "in", backoff)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxListenBackoff)
+	}
+}
+
+// listen delivers notifications until the connection fails. heard reports
+// whether any notification arrived.
+func (b *PGBus) listen(ctx context.Context) (heard bool, err error) {
+	conn, err := b.listener.Listen(ctx, Channel)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close(context.Background())
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return heard, err
+		}
+		heard = true
+
+		var ev Event
+		if err := json.Unmarshal([]byte(n.Payload), &ev); err != nil {
+			log.Warn("dropping malformed event notification", "err", err)
+			continue
+		}
+		_ = b.local.Publish(ctx, ev)
+	}
+}
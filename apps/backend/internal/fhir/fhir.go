@@ -0,0 +1,145 @@
+// Package fhir holds the subset of FHIR R4 resources used to export journal data
+// to electronic health record systems.
+//
+// Only the elements the export populates are modelled; see https://hl7.org/fhir/R4/.
+package fhir
+
+import "time"
+
+// ContentType is the FHIR JSON media type.
+const ContentType = "application/fhir+json"
+
+// Code systems and canonical URLs for Eloquia-specific concepts.
+const (
+	SystemPatientCode    = "urn:eloquia:patient-code"
+	SystemObservation    = "urn:eloquia:observation"
+	SystemEmotion        = "urn:eloquia:emotion"
+	JournalQuestionnaire = "urn:eloquia:questionnaire:journal-entry"
+
+	systemObservationCategory = "http://terminology.hl7.org/CodeSystem/observation-category"
+)
+
+type Bundle struct {
+	ResourceType string    `json:"resourceType"`
+	ID           string    `json:"id,omitempty"`
+	Type         string    `json:"type"`
+	Timestamp    time.Time `json:"timestamp"`
+	// Total is only allowed on searchset and history bundles.
+	Total *int          `json:"total,omitempty"`
+	Entry []BundleEntry `json:"entry"`
+}
+
+type BundleEntry struct {
+	FullURL  string `json:"fullUrl"`
+	Resource any    `json:"resource"`
+}
+
+// NewCollection starts an R4 "collection" bundle.
+func NewCollection(id string, timestamp time.Time) *Bundle {
+	return &Bundle{
+		ResourceType: "Bundle",
+		ID:           id,
+		Type:         "collection",
+		Timestamp:    timestamp,
+		Entry:        []BundleEntry{},
+	}
+}
+
+// Add appends a resource addressed by its urn:uuid full URL.
+func (b *Bundle) Add(id string, resource any) {
+	b.Entry = append(b.Entry, BundleEntry{FullURL: URN(id), Resource: resource})
+}
+
+// URN returns the urn:uuid form used for fullUrl and intra-bundle references.
+func URN(id string) string {
+	return "urn:uuid:" + id
+}
+
+type Patient struct {
+	ResourceType string         `json:"resourceType"`
+	ID           string         `json:"id"`
+	Identifier   []Identifier   `json:"identifier,omitempty"`
+	Active       bool           `json:"active"`
+	Name         []HumanName    `json:"name,omitempty"`
+	Telecom      []ContactPoint `json:"telecom,omitempty"`
+	BirthDate    string         `json:"birthDate,omitempty"`
+}
+
+type Observation struct {
+	ResourceType      string            `json:"resourceType"`
+	ID                string            `json:"id"`
+	Status            string            `json:"status"`
+	Category          []CodeableConcept `json:"category,omitempty"`
+	Code              CodeableConcept   `json:"code"`
+	Subject           Reference         `json:"subject"`
+	EffectiveDateTime time.Time         `json:"effectiveDateTime"`
+	ValueInteger      *int              `json:"valueInteger,omitempty"`
+	DerivedFrom       []Reference       `json:"derivedFrom,omitempty"`
+}
+
+type QuestionnaireResponse struct {
+	ResourceType  string                      `json:"resourceType"`
+	ID            string                      `json:"id"`
+	Questionnaire string                      `json:"questionnaire"`
+	Status        string                      `json:"status"`
+	Subject       Reference                   `json:"subject"`
+	Authored      time.Time                   `json:"authored"`
+	Item          []QuestionnaireResponseItem `json:"item"`
+}
+
+type QuestionnaireResponseItem struct {
+	LinkID string                        `json:"linkId"`
+	Text   string                        `json:"text,omitempty"`
+	Answer []QuestionnaireResponseAnswer `json:"answer,omitempty"`
+}
+
+type QuestionnaireResponseAnswer struct {
+	ValueString  *string                     `json:"valueString,omitempty"`
+	ValueInteger *int                        `json:"valueInteger,omitempty"`
+	ValueCoding  *Coding                     `json:"valueCoding,omitempty"`
+	Item         []QuestionnaireResponseItem `json:"item,omitempty"`
+}
+
+type Identifier struct {
+	System string `json:"system"`
+	Value  string `json:"value"`
+}
+
+type HumanName struct {
+	Text string `json:"text"`
+}
+
+type ContactPoint struct {
+	System string `json:"system"`
+	Value  string `json:"value"`
+}
+
+type CodeableConcept struct {
+	Coding []Coding `json:"coding,omitempty"`
+	Text   string   `json:"text,omitempty"`
+}
+
+type Coding struct {
+	System  string `json:"system,omitempty"`
+	Code    string `json:"code"`
+	Display string `json:"display,omitempty"`
+}
+
+type Reference struct {
+	Reference string `json:"reference"`
+}
+
+// SurveyCategory is the standard observation category for questionnaire-derived values.
+func SurveyCategory() CodeableConcept {
+	return CodeableConcept{Coding: []Coding{{System: systemObservationCategory, Code: "survey", Display: "Survey"}}}
+}
+
+// StringAnswer wraps a string answer.
+func StringAnswer(v string) QuestionnaireResponseAnswer {
+	return QuestionnaireResponseAnswer{ValueString: &v}
+}
+
+// IntegerAnswer wraps an integer answer.
+func IntegerAnswer(v int) QuestionnaireResponseAnswer {
+	return QuestionnaireResponseAnswer{ValueInteger: &v}
+}
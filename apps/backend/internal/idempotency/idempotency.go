@@ -0,0 +1,83 @@
+// Package idempotency lets clients retry mutating requests safely: the first
+// request sent with an Idempotency-Key is handled and its response stored,
+// and retries with the same key get that response back. It has an in-memory
+// store for single instances and a Postgres store shared by replicas.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+const (
+	Header = "Idempotency-Key"
+	// ReplayedHeader marks responses served from the store.
+	ReplayedHeader = "Idempotent-Replayed"
+	// MaxKeyLength bounds client keys; UUIDs and ULIDs fit comfortably.
+	MaxKeyLength = 255
+)
+
+// ValidKey reports whether key is 1 to MaxKeyLength visible ASCII characters.
+func ValidKey(key string) bool {
+	if key == "" || len(key) > MaxKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < '!' || key[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// Fingerprint identifies a request by method, path and body, so a key reused
+// for a different request can be told apart from a retry.
+func Fingerprint(method, path string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(path))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Response is what is replayed to retries.
+type Response struct {
+	Status      int
+	ContentType string
+	Body        []byte
+}
+
+type State int
+
+const (
+	// StateStarted means the caller claimed the key and must Complete or
+	// Release it.
+	StateStarted State = iota
+	// StateInProgress means another request holds the key.
+	StateInProgress
+	// StateMismatch means the key was used for a different request.
+	StateMismatch
+	// StateCompleted means Claim.Response should be replayed.
+	StateCompleted
+)
+
+type Claim struct {
+	State    State
+	Response *Response
+}
+
+// Store records keys and their responses.
+type Store interface {
+	// Begin claims key for a request with fingerprint, unless a live record
+	// exists. Records expire after ttl; an unfinished claim can be taken over
+	// after lock.
+	Begin(ctx context.Context, key, fingerprint string, ttl, lock time.Duration, now time.Time) (Claim, error)
+	// Complete stores the response for a key claimed with Begin.
+	Complete(ctx context.Context, key string, resp Response) error
+	// Release drops an unfinished claim so the request can be retried.
+	Release(ctx context.Context, key string) error
+}
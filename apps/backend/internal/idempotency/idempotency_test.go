@@ -0,0 +1,142 @@
+package idempotency
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidKey(t *testing.T) {
+	for _, key := range []string{"a", "0b6f5f0e-5a3c-4b6e-9d1a-1f0f3c2b7e11", strings.Repeat("k", MaxKeyLength)} {
+		if !ValidKey(key) {
+			t.Fatalf("expected %q to be valid", key)
+		}
+	}
+	for _, key := range []string{"", "has space", "tab\there", "ümlaut", strings.Repeat("k", MaxKeyLength+1)} {
+		if ValidKey(key) {
+			t.Fatalf("expected %q to be invalid", key)
+		}
+	}
+}
+
+func TestFingerprintSeparatesParts(t *testing.T) {
+	a := Fingerprint("POST", "/links/invite", []byte(`{"a":1}`))
+	if a != Fingerprint("POST", "/links/invite", []byte(`{"a":1}`)) {
+		t.Fatal("expected equal requests to share a fingerprint")
+	}
+	if a == Fingerprint("POST", "/links/invite", []byte(`{"a":2}`)) {
+		t.Fatal("expected different bodies to differ")
+	}
+	if Fingerprint("POST", "/a", []byte("b")) == Fingerprint("POST", "/ab", nil) {
+		t.Fatal("expected path and body to be delimited")
+	}
+}
+
+func TestMemoryStoreLifecycle(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	m := NewMemoryStore()
+	begin := func(fp string, at time.Time) State {
+		t.Helper()
+		c, err := m.Begin(ctx, "k", fp, time.Hour, time.Minute, at)
+		if err != nil {
+			t.Fatalf("Begin: %v", err)
+		}
+		return c.State
+	}
+
+	if got := begin("fp", now); got != StateStarted {
+		t.Fatalf("first request: got state %d", got)
+	}
+	if got := begin("fp", now.Add(time.Second)); got != StateInProgress {
+		t.Fatalf("concurrent retry: got state %d", got)
+	}
+	if got := begin("other", now.Add(time.Second)); got != StateMismatch {
+		t.Fatalf("different request: got state %d", got)
+	}
+
+	if err := m.Complete(ctx, "k", Response{Status: 201, ContentType: "application/json", Body: []byte("{}")}); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	c, _ := m.Begin(ctx, "k", "fp", time.Hour, time.Minute, now.Add(2*time.Minute))
+	if c.State != StateCompleted || c.Response.Status != 201 || string(c.Response.Body) != "{}" {
+		t.Fatalf("retry after completion: got %+v", c)
+	}
+
+	if got := begin("other", now.Add(2*time.Hour)); got != StateStarted {
+		t.Fatalf("after expiry: got state %d", got)
+	}
+}
+
+func TestMemoryStoreTakesOverStaleAndReleasedClaims(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	m := NewMemoryStore()
+
+	_, _ = m.Begin(ctx, "k", "fp", time.Hour, time.Minute, now)
+	c, _ := m.Begin(ctx, "k", "fp", time.Hour, time.Minute, now.Add(2*time.Minute))
+	if c.State != StateStarted {
+		t.Fatalf("expected a stale claim to be taken over; got state %d", c.State)
+	}
+
+	_ = m.Release(ctx, "k")
+	c, _ = m.Begin(ctx, "k", "other", time.Hour, time.Minute, now.Add(3*time.Minute))
+	if c.State != StateStarted {
+		t.Fatalf("expected a released key to be claimable; got state %d", c.State)
+	}
+}
+
+func TestMemoryStoreEvictsOldestWhenFull(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	m := NewMemoryStore()
+	m.maxRecords = 2
+	begin := func(key string) State {
+		t.Helper()
+		c, err := m.Begin(ctx, key, "fp", time.Hour, time.Minute, now)
+		if err != nil {
+			t.Fatalf("Begin: %v", err)
+		}
+		return c.State
+	}
+
+	begin("a")
+	begin("b")
+	begin("c")
+	if len(m.records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(m.records))
+	}
+	if got := begin("b"); got != StateInProgress {
+		t.Fatalf("expected b to be kept; got state %d", got)
+	}
+	if got := begin("a"); got != StateStarted {
+		t.Fatalf("expected a to be evicted; got state %d", got)
+	}
+
+	m.maxBytes = m.bytes + 10
+	_ = m.Complete(ctx, "a", Response{Status: 200, Body: make([]byte, 64)})
+	if len(m.records) != 1 || m.records["a"] == nil {
+		t.Fatalf("expected only the completed record to remain, got %d records", len(m.records))
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Setenv("IDEMPOTENCY_BACKEND", "")
+	t.Setenv("IDEMPOTENCY_TTL", "")
+	cfg, err := LoadConfig()
+	if err != nil || cfg.Backend != BackendMemory || cfg.TTL != defaultTTL {
+		t.Fatalf("defaults: got %+v, %v", cfg, err)
+	}
+
+	t.Setenv("IDEMPOTENCY_TTL", "-1h")
+	if _, err := LoadConfig(); err == nil {
+		t.Fatal("expected an error for a negative TTL")
+	}
+
+	t.Setenv("IDEMPOTENCY_TTL", "")
+	t.Setenv("IDEMPOTENCY_BACKEND", "redis")
+	if _, err := LoadConfig(); err == nil {
+		t.Fatal("expected an error for an unknown backend")
+	}
+}
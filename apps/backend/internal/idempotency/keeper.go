@@ -0,0 +1,88 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// Backends accepted in IDEMPOTENCY_BACKEND.
+const (
+	BackendMemory   = "memory"
+	BackendPostgres = "postgres"
+	BackendOff      = "off"
+)
+
+const (
+	defaultTTL = 24 * time.Hour
+	// lockTimeout outlives the server's write timeout, so a claim is only
+	// taken over once its request can no longer answer.
+	lockTimeout = time.Minute
+)
+
+type Config struct {
+	Backend string
+	// TTL is how long responses are replayed.
+	TTL time.Duration
+}
+
+// LoadConfig reads IDEMPOTENCY_BACKEND (memory, postgres or off; default
+// memory) and IDEMPOTENCY_TTL (default 24h).
+func LoadConfig() (Config, error) {
+	cfg := Config{
+		Backend: strings.ToLower(strings.TrimSpace(os.Getenv("IDEMPOTENCY_BACKEND"))),
+		TTL:     defaultTTL,
+	}
+	switch cfg.Backend {
+	case "":
+		cfg.Backend = BackendMemory
+	case BackendMemory, BackendPostgres, BackendOff:
+	default:
+		return Config{}, fmt.Errorf("IDEMPOTENCY_BACKEND must be %s, %s or %s", BackendMemory, BackendPostgres, BackendOff)
+	}
+
+	if raw := strings.TrimSpace(os.Getenv("IDEMPOTENCY_TTL")); raw != "" {
+		ttl, err := time.ParseDuration(raw)
+		if err != nil || ttl <= 0 {
+			return Config{}, fmt.Errorf("IDEMPOTENCY_TTL must be a positive duration, got %q", raw)
+		}
+		cfg.TTL = ttl
+	}
+	return cfg, nil
+}
+
+// Keeper claims keys in a Store. A nil *Keeper ignores Idempotency-Key.
+type Keeper struct {
+	Store Store
+	TTL   time.Duration
+	// Now defaults to time.Now.
+	Now func() time.Time
+}
+
+func NewKeeper(store Store, cfg Config) *Keeper {
+	return &Keeper{Store: store, TTL: cfg.TTL}
+}
+
+// Begin claims key for a request with fingerprint. ok is false when the
+// keeper is nil.
+func (k *Keeper) Begin(ctx context.Context, key, fingerprint string) (c Claim, ok bool, err error) {
+	if k == nil {
+		return Claim{}, false, nil
+	}
+	now := time.Now
+	if k.Now != nil {
+		now = k.Now
+	}
+	c, err = k.Store.Begin(ctx, key, fingerprint, k.TTL, lockTimeout, now().UTC())
+	return c, true, err
+}
+
+func (k *Keeper) Complete(ctx context.Context, key string, resp Response) error {
+	return k.Store.Complete(ctx, key, resp)
+}
+
+func (k *Keeper) Release(ctx context.Context, key string) error {
+	return k.Store.Release(ctx, key)
+}
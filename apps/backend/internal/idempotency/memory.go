@@ -0,0 +1,144 @@
+package idempotency
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// memorySweepInterval is how often expired records are dropped.
+	memorySweepInterval = time.Minute
+	// memoryMaxRecords and memoryMaxBytes bound the store; past either the
+	// oldest records are evicted, so their retries run again.
+	memoryMaxRecords = 10_000
+	memoryMaxBytes   = 64 << 20
+)
+
+type memoryRecord struct {
+	key         string
+	fingerprint string
+	response    *Response
+	lockedUntil time.Time
+	expiresAt   time.Time
+	// size counts the key, fingerprint and stored response.
+	size int
+	elem *list.Element
+}
+
+// MemoryStore keeps records in process memory. Retries that reach another
+// replica are not recognised, so it suits single-instance deployments and
+// tests.
+type MemoryStore struct {
+	mu        sync.Mutex
+	records   map[string]*memoryRecord
+	order     *list.List // oldest claim first
+	bytes     int
+	lastSweep time.Time
+
+	maxRecords int
+	maxBytes   int
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		records:    make(map[string]*memoryRecord),
+		order:      list.New(),
+		maxRecords: memoryMaxRecords,
+		maxBytes:   memoryMaxBytes,
+	}
+}
+
+func (m *MemoryStore) Begin(_ context.Context, key, fingerprint string, ttl, lock time.Duration, now time.Time) (Claim, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sweep(now)
+
+	rec, ok := m.records[key]
+	if ok && now.Before(rec.expiresAt) && (rec.response != nil || now.Before(rec.lockedUntil)) {
+		return claimFor(rec.fingerprint, rec.response, fingerprint), nil
+	}
+	if ok {
+		m.remove(rec)
+	}
+	rec = &memoryRecord{
+		key:         key,
+		fingerprint: fingerprint,
+		lockedUntil: now.Add(lock),
+		expiresAt:   now.Add(ttl),
+		size:        len(key) + len(fingerprint),
+	}
+	rec.elem = m.order.PushBack(rec)
+	m.records[key] = rec
+	m.bytes += rec.size
+	m.evict(rec)
+	return Claim{State: StateStarted}, nil
+}
+
+func (m *MemoryStore) Complete(_ context.Context, key string, resp Response) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if rec, ok := m.records[key]; ok && rec.response == nil {
+		rec.response = &resp
+		n := len(resp.ContentType) + len(resp.Body)
+		rec.size += n
+		m.bytes += n
+		m.evict(rec)
+	}
+	return nil
+}
+
+func (m *MemoryStore) Release(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if rec, ok := m.records[key]; ok && rec.response == nil {
+		m.remove(rec)
+	}
+	return nil
+}
+
+func (m *MemoryStore) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < memorySweepInterval {
+		return
+	}
+	m.lastSweep = now
+	for _, rec := range m.records {
+		if !now.Before(rec.expiresAt) {
+			m.remove(rec)
+		}
+	}
+}
+
+// evict drops the oldest records until the store fits its bounds again. keep
+// is the record just written; it is never evicted.
+func (m *MemoryStore) evict(keep *memoryRecord) {
+	for len(m.records) > m.maxRecords || m.bytes > m.maxBytes {
+		oldest := m.order.Front().Value.(*memoryRecord)
+		if oldest == keep {
+			return
+		}
+		m.remove(oldest)
+	}
+}
+
+func (m *MemoryStore) remove(rec *memoryRecord) {
+	m.order.Remove(rec.elem)
+	delete(m.records, rec.key)
+	m.bytes -= rec.size
+}
+
+// claimFor compares a live record with the fingerprint of a new request.
+func claimFor(stored string, resp *Response, fingerprint string) Claim {
+	switch {
+	case stored != fingerprint:
+		return Claim{State: StateMismatch}
+	case resp == nil:
+		return Claim{State: StateInProgress}
+	default:
+		return Claim{State: StateCompleted, Response: resp}
+	}
+}
@@ -0,0 +1,91 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/ent"
+	"backend/ent/idempotencykey"
+)
+
+// beginQuery claims a key in one statement: it inserts a new record or takes
+// over an expired one or an unfinished one whose lock ran out, and returns a
+// row only when the caller now holds the claim.
+const beginQuery = `
+INSERT INTO idempotency_keys AS k (id, fingerprint, status, content_type, body, locked_until, expires_at, created_at)
+VALUES ($1, $2, 0, '', NULL, now() + make_interval(secs => $3::float8), now() + make_interval(secs => $4::float8), now())
+ON CONFLICT (id) DO UPDATE SET
+	fingerprint = EXCLUDED.fingerprint,
+	status = 0,
+	content_type = '',
+	body = NULL,
+	locked_until = EXCLUDED.locked_until,
+	expires_at = EXCLUDED.expires_at,
+	created_at = EXCLUDED.created_at
+WHERE k.expires_at <= now() OR (k.status = 0 AND k.locked_until <= now())
+RETURNING k.id`
+
+// PostgresStore keeps records in the idempotency_keys table so retries are
+// recognised on every replica. Records follow the database clock, so the now
+// passed to Begin is ignored.
+type PostgresStore struct {
+	client *ent.Client
+}
+
+func NewPostgresStore(client *ent.Client) *PostgresStore {
+	return &PostgresStore{client: client}
+}
+
+func (s *PostgresStore) Begin(ctx context.Context, key, fingerprint string, ttl, lock time.Duration, _ time.Time) (Claim, error) {
+	rows, err := s.client.QueryContext(ctx, beginQuery, key, fingerprint, lock.Seconds(), ttl.Seconds())
+	if err != nil {
+		return Claim{}, fmt.Errorf("claim idempotency key: %w", err)
+	}
+	claimed := rows.Next()
+	err = rows.Err()
+	_ = rows.Close()
+	if err != nil {
+		return Claim{}, fmt.Errorf("claim idempotency key: %w", err)
+	}
+	if claimed {
+		return Claim{State: StateStarted}, nil
+	}
+
+	rec, err := s.client.IdempotencyKey.Get(ctx, key)
+	if err != nil {
+		return Claim{}, fmt.Errorf("load idempotency key: %w", err)
+	}
+	var resp *Response
+	if rec.Status != 0 {
+		resp = &Response{Status: rec.Status, ContentType: rec.ContentType}
+		if rec.Body != nil {
+			resp.Body = *rec.Body
+		}
+	}
+	return claimFor(rec.Fingerprint, resp, fingerprint), nil
+}
+
+func (s *PostgresStore) Complete(ctx context.Context, key string, resp Response) error {
+	_, err := s.client.IdempotencyKey.Update().
+		Where(idempotencykey.IDEQ(key)).
+		SetStatus(resp.Status).
+		SetContentType(resp.ContentType).
+		SetBody(resp.Body).
+		Save(ctx)
+	return err
+}
+
+func (s *PostgresStore) Release(ctx context.Context, key string) error {
+	_, err := s.client.IdempotencyKey.Delete().
+		Where(idempotencykey.IDEQ(key), idempotencykey.StatusEQ(0)).
+		Exec(ctx)
+	return err
+}
+
+// Prune deletes records that expired before before.
+func (s *PostgresStore) Prune(ctx context.Context, before time.Time) (int, error) {
+	return s.client.IdempotencyKey.Delete().
+		Where(idempotencykey.ExpiresAtLT(before)).
+		Exec(ctx)
+}
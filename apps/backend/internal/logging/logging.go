@@ -0,0 +1,74 @@
+// Package logging builds the process logger and carries request-scoped
+// loggers through contexts. Everything written goes through a Scrubber, so
+// patient content such as journal notes never reaches the logs.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/log"
+)
+
+const (
+	envFormat = "LOG_FORMAT"
+	envLevel  = "LOG_LEVEL"
+
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// New builds a logger writing to w. LOG_FORMAT selects text (default, for
+// terminals) or json (for production log shippers); LOG_LEVEL defaults to
+// debug for text and info for json.
+func New(w io.Writer) (*log.Logger, error) {
+	format, err := Format()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := log.Options{ReportTimestamp: true, Level: log.DebugLevel}
+	if format == FormatJSON {
+		opts.Formatter = log.JSONFormatter
+		opts.Level = log.InfoLevel
+	}
+
+	if raw := strings.TrimSpace(os.Getenv(envLevel)); raw != "" {
+		level, err := log.ParseLevel(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", envLevel, err)
+		}
+		opts.Level = level
+	}
+
+	return log.NewWithOptions(NewScrubber(w, format), opts), nil
+}
+
+// Format returns the configured LOG_FORMAT.
+func Format() (string, error) {
+	format := strings.ToLower(strings.TrimSpace(os.Getenv(envFormat)))
+	switch format {
+	case "":
+		return FormatText, nil
+	case FormatText, FormatJSON:
+		return format, nil
+	default:
+		return "", fmt.Errorf("%s must be %s or %s", envFormat, FormatText, FormatJSON)
+	}
+}
+
+type requestIDKey struct{}
+
+// WithRequestID stores the request ID in ctx.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request ID stored in ctx, or "".
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
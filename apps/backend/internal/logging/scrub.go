@@ -0,0 +1,153 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces the value of every sensitive field.
+const Redacted = "[REDACTED]"
+
+// sensitiveKeys are matched case-insensitively against log keys, at any
+// depth for JSON output.
+var sensitiveKeys = []string{
+	"notes", "note", "situation",
+	"password", "secret", "token", "authorization", "cookie",
+	"email", "displayname", "display_name",
+}
+
+var (
+	ansi = `(?:\x1b\[[0-9;]*m)*`
+	keys = `(?i:` + strings.Join(sensitiveKeys, "|") + `)`
+
+	// A multi-line text value: the key, then one "  │ " line per value line.
+	multilineValue = regexp.MustCompile(`(\n  ` + ansi + keys + ansi + `=` + ansi + `)\n(?:` + ansi + `  │ [^\n]*\n?)+`)
+	// key=value or key="quoted value", possibly wrapped in color codes.
+	inlineValue = regexp.MustCompile(`((?:^|[ \t])` + ansi + keys + ansi + `=)("(?:[^"\\]|\\.)*"|[^ \t\n]*)`)
+)
+
+// Scrubber redacts sensitive fields from formatted log entries before they
+// are written. The logger writes each entry with one Write call.
+type Scrubber struct {
+	w      io.Writer
+	format string
+}
+
+func NewScrubber(w io.Writer, format string) *Scrubber {
+	return &Scrubber{w: w, format: format}
+}
+
+func (s *Scrubber) Write(p []byte) (int, error) {
+	var out []byte
+	if s.format == FormatJSON {
+		out = ScrubJSON(p)
+	} else {
+		out = ScrubText(p)
+	}
+	if _, err := s.w.Write(out); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// ScrubText redacts sensitive values in text or logfmt entries.
+func ScrubText(p []byte) []byte {
+	p = multilineValue.ReplaceAll(p, []byte("${1}"+Redacted+"\n"))
+	return inlineValue.ReplaceAll(p, []byte("${1}"+Redacted))
+}
+
+// ScrubJSON redacts sensitive fields of newline-separated JSON objects,
+// keeping the field order. Lines that are not JSON objects pass unchanged.
+func ScrubJSON(p []byte) []byte {
+	var out bytes.Buffer
+	for _, line := range bytes.SplitAfter(p, []byte("\n")) {
+		trimmed := bytes.TrimRight(line, "\n")
+		if len(trimmed) == 0 {
+			out.Write(line)
+			continue
+		}
+		scrubbed, err := scrubValue(trimmed)
+		if err != nil {
+			out.Write(line)
+			continue
+		}
+		out.Write(scrubbed)
+		out.Write(line[len(trimmed):])
+	}
+	return out.Bytes()
+}
+
+// scrubValue rewrites objects and arrays recursively; other values are
+// returned as they are.
+func scrubValue(raw []byte) ([]byte, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || (raw[0] != '{' && raw[0] != '[') {
+		return raw, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	open, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	isObject := open == json.Delim('{')
+	out.WriteByte(raw[0])
+	for first := true; dec.More(); first = false {
+		if !first {
+			out.WriteByte(',')
+		}
+		redact := false
+		if isObject {
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			key, _ := tok.(string)
+			name, err := json.Marshal(key)
+			if err != nil {
+				return nil, err
+			}
+			out.Write(name)
+			out.WriteByte(':')
+			redact = isSensitive(key)
+		}
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		if redact {
+			out.WriteString(`"` + Redacted + `"`)
+			continue
+		}
+		scrubbed, err := scrubValue(value)
+		if err != nil {
+			return nil, err
+		}
+		out.Write(scrubbed)
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	if isObject {
+		out.WriteByte('}')
+	} else {
+		out.WriteByte(']')
+	}
+	return out.Bytes(), nil
+}
+
+func isSensitive(key string) bool {
+	for _, k := range sensitiveKeys {
+		if strings.EqualFold(key, k) {
+			return true
+		}
+	}
+	return false
+}
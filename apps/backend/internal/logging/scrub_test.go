@@ -0,0 +1,57 @@
+package logging
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/log"
+)
+
+func TestScrubJSONRedactsNestedFieldsInOrder(t *testing.T) {
+	in := []byte(`{"time":"t","level":"info","msg":"saved","notes":"felt anxious","entry":{"id":"1","Situation":"phone call"},"tags":[{"email":"a@b.c"}]}` + "\n")
+	got := string(ScrubJSON(in))
+	want := `{"time":"t","level":"info","msg":"saved","notes":"[REDACTED]","entry":{"id":"1","Situation":"[REDACTED]"},"tags":[{"email":"[REDACTED]"}]}` + "\n"
+	if got != want {
+		t.Fatalf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestScrubTextRedactsInlineAndMultilineValues(t *testing.T) {
+	var buf bytes.Buffer
+	logger := log.NewWithOptions(NewScrubber(&buf, FormatText), log.Options{})
+	logger.Info("saved", "entry_id", "42", "notes", "felt anxious today", "email", "a@b.c")
+	logger.Info("saved", "notes", "line one\nline two", "count", 2)
+
+	out := buf.String()
+	for _, leaked := range []string{"anxious", "a@b.c", "line one", "line two"} {
+		if strings.Contains(out, leaked) {
+			t.Fatalf("output leaks %q:\n%s", leaked, out)
+		}
+	}
+	for _, kept := range []string{"entry_id=42", "notes=" + Redacted, "email=" + Redacted, "count=2"} {
+		if !strings.Contains(out, kept) {
+			t.Fatalf("output misses %q:\n%s", kept, out)
+		}
+	}
+}
+
+func TestNewRejectsUnknownFormat(t *testing.T) {
+	t.Setenv(envFormat, "xml")
+	if _, err := New(&bytes.Buffer{}); err == nil {
+		t.Fatalf("expected an error for LOG_FORMAT=xml")
+	}
+}
+
+func TestNewJSONLoggerScrubs(t *testing.T) {
+	t.Setenv(envFormat, FormatJSON)
+	var buf bytes.Buffer
+	logger, err := New(&buf)
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	logger.Info("request", "request_id", "abc", "password", "hunter2")
+	if strings.Contains(buf.String(), "hunter2") || !strings.Contains(buf.String(), `"request_id":"abc"`) {
+		t.Fatalf("unexpected output %s", buf.String())
+	}
+}
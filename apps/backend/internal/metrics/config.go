@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// ErrNotConfigured is returned by LoadConfig when neither a token nor a bind
+// address restricts access, in which case /metrics is not served.
+var ErrNotConfigured = errors.New("metrics not configured")
+
+type Config struct {
+	// Addr serves /metrics on a separate listener, e.g. "127.0.0.1:9090",
+	// reachable only where that address is.
+	Addr string
+	// Token requires "Authorization: Bearer <token>" on /metrics.
+	Token string
+}
+
+func LoadConfig() (Config, error) {
+	cfg := Config{
+		Addr:  strings.TrimSpace(os.Getenv("METRICS_ADDR")),
+		Token: strings.TrimSpace(os.Getenv("METRICS_TOKEN")),
+	}
+	if cfg.Addr == "" && cfg.Token == "" {
+		return cfg, ErrNotConfigured
+	}
+	return cfg, nil
+}
+
+// RequireToken rejects requests without the configured bearer token. An
+// empty token lets every request through.
+func RequireToken(token string, next http.Handler) http.Handler {
+	if token == "" {
+		return next
+	}
+	want := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got := []byte(r.Header.Get("Authorization"))
+		if subtle.ConstantTimeCompare(got, want) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="metrics"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
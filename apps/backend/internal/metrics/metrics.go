@@ -0,0 +1,174 @@
+// Package metrics collects Prometheus metrics for HTTP traffic, the database
+// pool and domain activity. A nil *Metrics is valid and records nothing, so
+// servers built without one (as in tests) need no special casing.
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "eloquia"
+
+// Pairing code redemption results.
+const (
+	PairingRedeemed = "redeemed"
+	PairingInvalid  = "invalid"
+	PairingNotFound = "not_found"
+	PairingError    = "error"
+)
+
+// Link approval sources.
+const (
+	LinkApprovedByDoctor = "doctor"
+	LinkApprovedByCode   = "pairing_code"
+)
+
+// UnmatchedRoute labels requests that matched no route, keeping the route
+// label bounded.
+const UnmatchedRoute = "unmatched"
+
+// OtherMethod labels requests with a non-standard method; net/http accepts
+// any token, so the method label would otherwise be unbounded.
+const OtherMethod = "other"
+
+// StatsSource reports connection pool statistics; database.Client implements it.
+type StatsSource interface {
+	Stats() sql.DBStats
+}
+
+// StateCounter returns the number of records in each state.
+type StateCounter func(ctx context.Context) (map[string]int, error)
+
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+
+	entriesSynced prometheus.Counter
+	linksApproved *prometheus.CounterVec
+	pairingCodes  *prometheus.CounterVec
+	appVersions   *prometheus.CounterVec
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests by method, route pattern and status code.",
+		}, []string{"method", "route", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by method and route pattern.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		entriesSynced: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "entries_synced_total",
+			Help:      "Journal entries uploaded through sync.",
+		}),
+		linksApproved: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "links_approved_total",
+			Help:      "Doctor-patient links approved, by how they were approved.",
+		}, []string{"source"}),
+		pairingCodes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "pairing_code_redemptions_total",
+			Help:      "Pairing code redemption attempts by result.",
+		}, []string{"result"}),
+		appVersions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "app_requests_total",
+			Help:      "API requests by bucketed app version and upgrade status.",
+		}, []string{"version", "status"}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests, m.duration, m.entriesSynced, m.linksApproved, m.pairingCodes, m.appVersions,
+	)
+	return m
+}
+
+// Handler serves the registry in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// ObserveRequest records one HTTP request under its chi route pattern.
+func (m *Metrics) ObserveRequest(method, route string, status int, elapsed time.Duration) {
+	if m == nil {
+		return
+	}
+	if route == "" {
+		route = UnmatchedRoute
+	}
+	method = methodLabel(method)
+	m.requests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	m.duration.WithLabelValues(method, route).Observe(elapsed.Seconds())
+}
+
+func methodLabel(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace:
+		return method
+	}
+	return OtherMethod
+}
+
+func (m *Metrics) EntriesSynced(n int) {
+	if m == nil || n <= 0 {
+		return
+	}
+	m.entriesSynced.Add(float64(n))
+}
+
+func (m *Metrics) LinkApproved(source string) {
+	if m == nil {
+		return
+	}
+	m.linksApproved.WithLabelValues(source).Inc()
+}
+
+func (m *Metrics) PairingCodeRedemption(result string) {
+	if m == nil {
+		return
+	}
+	m.pairingCodes.WithLabelValues(result).Inc()
+}
+
+// AppRequest records one API request from an app version. version must come
+// from a bounded set, such as clientversion.Policy.Label.
+func (m *Metrics) AppRequest(version, status string) {
+	if m == nil {
+		return
+	}
+	m.appVersions.WithLabelValues(version, status).Inc()
+}
+
+// RegisterDBStats exports the pool statistics of src on every scrape.
+func (m *Metrics) RegisterDBStats(src StatsSource) {
+	m.registry.MustRegister(newDBStatsCollector(src))
+}
+
+// RegisterStates exports a gauge per state, counted by fn on every scrape.
+// A failing count is reported to Prometheus as a scrape error.
+func (m *Metrics) RegisterStates(name, help string, fn StateCounter) {
+	m.registry.MustRegister(&stateCollector{
+		desc: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", name), help, []string{"state"}, nil),
+		fn:   fn,
+	})
+}
@@ -0,0 +1,100 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeStats struct{}
+
+func (fakeStats) Stats() sql.DBStats {
+	return sql.DBStats{MaxOpenConnections: 10, OpenConnections: 3, InUse: 1, Idle: 2}
+}
+
+func scrape(t *testing.T, h http.Handler, auth string) (int, string) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	if auth != "" {
+		req.Header.Set("Authorization", auth)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	body, _ := io.ReadAll(w.Result().Body)
+	return w.Code, string(body)
+}
+
+func TestHandlerExportsRecordedMetrics(t *testing.T) {
+	m := New()
+	m.RegisterDBStats(fakeStats{})
+	m.RegisterStates("analysis_jobs", "Analysis jobs by state.", func(context.Context) (map[string]int, error) {
+		return map[string]int{"Queued": 2, "Done": 5}, nil
+	})
+	m.ObserveRequest(http.MethodGet, "/patients/{id}/entries", http.StatusOK, 20*time.Millisecond)
+	m.ObserveRequest(http.MethodGet, "", http.StatusNotFound, time.Millisecond)
+	m.ObserveRequest("XYZZY", "", http.StatusMethodNotAllowed, time.Millisecond)
+	m.EntriesSynced(3)
+	m.LinkApproved(LinkApprovedByCode)
+	m.PairingCodeRedemption(PairingNotFound)
+
+	code, body := scrape(t, m.Handler(), "")
+	if code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", code)
+	}
+	for _, want := range []string{
+		`eloquia_http_requests_total{method="GET",route="/patients/{id}/entries",status="200"} 1`,
+		`eloquia_http_requests_total{method="GET",route="unmatched",status="404"} 1`,
+		`eloquia_http_requests_total{method="other",route="unmatched",status="405"} 1`,
+		`eloquia_http_request_duration_seconds_count{method="GET",route="/patients/{id}/entries"} 1`,
+		`eloquia_entries_synced_total 3`,
+		`eloquia_links_approved_total{source="pairing_code"} 1`,
+		`eloquia_pairing_code_redemptions_total{result="not_found"} 1`,
+		`eloquia_db_open_connections 3`,
+		`eloquia_analysis_jobs{state="Done"} 5`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("missing %q", want)
+		}
+	}
+}
+
+func TestNilMetricsRecordNothing(t *testing.T) {
+	var m *Metrics
+	m.ObserveRequest(http.MethodGet, "/", http.StatusOK, time.Millisecond)
+	m.EntriesSynced(1)
+	m.LinkApproved(LinkApprovedByDoctor)
+	m.PairingCodeRedemption(PairingRedeemed)
+}
+
+func TestRequireToken(t *testing.T) {
+	h := RequireToken("s3cret", New().Handler())
+
+	if code, _ := scrape(t, h, ""); code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without token, got %d", code)
+	}
+	if code, _ := scrape(t, h, "Bearer wrong"); code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 with wrong token, got %d", code)
+	}
+	if code, _ := scrape(t, h, "Bearer s3cret"); code != http.StatusOK {
+		t.Fatalf("expected 200 with token, got %d", code)
+	}
+}
+
+func TestLoadConfigRequiresRestriction(t *testing.T) {
+	t.Setenv("METRICS_ADDR", "")
+	t.Setenv("METRICS_TOKEN", "")
+	if _, err := LoadConfig(); err != ErrNotConfigured {
+		t.Fatalf("expected ErrNotConfigured, got %v", err)
+	}
+
+	t.Setenv("METRICS_ADDR", "127.0.0.1:9090")
+	cfg, err := LoadConfig()
+	if err != nil || cfg.Addr != "127.0.0.1:9090" {
+		t.Fatalf("unexpected config %+v, %v", cfg, err)
+	}
+}
@@ -0,0 +1,122 @@
+// Package outbox relays domain events that handlers record in the same
+// transaction as the change they describe. A relay reads the recorded
+// messages back and hands them to in-process subscribers, retrying until
+// every subscriber has accepted them: delivery is at-least-once, so
+// subscribers must tolerate duplicates.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Domain event types.
+const (
+	TypeLinkRequested   = "link.requested"
+	TypeLinkApproved    = "link.approved"
+	TypeLinkRevoked     = "link.revoked"
+	TypeEntriesSynced   = "entries.synced"
+	TypeEntryShared     = "entry.shared"
+	TypePatientInactive = "patient.inactive"
+)
+
+const (
+	// MaxAttempts is how often a message is relayed before it is parked as
+	// failed for inspection.
+	MaxAttempts = 20
+
+	baseBackoff = 5 * time.Second
+	maxBackoff  = 10 * time.Minute
+)
+
+// Message is one recorded domain event. Its ID is stable across retries, so
+// subscribers can use it to deduplicate.
+type Message struct {
+	ID        uuid.UUID
+	Type      string
+	Payload   json.RawMessage
+	CreatedAt time.Time
+}
+
+// Decode unmarshals the payload into v.
+func (m Message) Decode(v any) error {
+	return json.Unmarshal(m.Payload, v)
+}
+
+// Handler processes one message. An error makes the relay offer the message
+// to that subscriber again later.
+type Handler func(ctx context.Context, m Message) error
+
+type subscriber struct {
+	name    string
+	types   []string
+	handler Handler
+}
+
+func (s subscriber) wants(eventType string) bool {
+	return len(s.types) == 0 || slices.Contains(s.types, eventType)
+}
+
+// Registry holds the in-process subscribers. It is safe for concurrent use.
+type Registry struct {
+	mu   sync.RWMutex
+	subs []subscriber
+}
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Subscribe registers h under a unique, stable name for the given event types,
+// or for every type when none are given. The name is stored with each message
+// to remember which subscribers already accepted it.
+func (r *Registry) Subscribe(name string, h Handler, types ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subs = append(r.subs, subscriber{name: name, types: types, handler: h})
+}
+
+// Deliver offers m to every interested subscriber not listed in done and
+// returns done extended by those that accepted it. The error joins the
+// failures of the others.
+func (r *Registry) Deliver(ctx context.Context, m Message, done []string) ([]string, error) {
+	r.mu.RLock()
+	subs := slices.Clone(r.subs)
+	r.mu.RUnlock()
+
+	var errs []error
+	for _, sub := range subs {
+		if !sub.wants(m.Type) || slices.Contains(done, sub.name) {
+			continue
+		}
+		if err := sub.handler(ctx, m); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sub.name, err))
+			continue
+		}
+		done = append(done, sub.name)
+	}
+	return done, errors.Join(errs...)
+}
+
+// Backoff is the wait before relaying a message again after the given number
+// of failed attempts: 5s, 10s, 20s, ... capped at 10m.
+func Backoff(attempts int) time.Duration {
+	if attempts < 1 {
+		return 0
+	}
+	d := baseBackoff
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return d
+}
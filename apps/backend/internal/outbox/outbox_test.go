@@ -0,0 +1,56 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestRegistryDeliverSkipsDoneAndUninterested(t *testing.T) {
+	reg := NewRegistry()
+	var calls []string
+	record := func(name string, err error) Handler {
+		return func(context.Context, Message) error {
+			calls = append(calls, name)
+			return err
+		}
+	}
+	reg.Subscribe("realtime", record("realtime", nil))
+	reg.Subscribe("webhooks", record("webhooks", errors.New("boom")), TypeLinkApproved)
+	reg.Subscribe("audit", record("audit", nil), TypeEntriesSynced)
+
+	m := Message{ID: uuid.New(), Type: TypeLinkApproved}
+	done, err := reg.Deliver(context.Background(), m, nil)
+	if err == nil {
+		t.Fatalf("expected the webhooks failure to be reported")
+	}
+	if !slices.Equal(done, []string{"realtime"}) {
+		t.Fatalf("done = %v", done)
+	}
+
+	calls = nil
+	if _, err := reg.Deliver(context.Background(), m, done); err == nil {
+		t.Fatalf("expected the webhooks failure again")
+	}
+	if !slices.Equal(calls, []string{"webhooks"}) {
+		t.Fatalf("retry should only reach the failed subscriber, got %v", calls)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cases := map[int]time.Duration{
+		0:  0,
+		1:  5 * time.Second,
+		3:  20 * time.Second,
+		20: maxBackoff,
+	}
+	for attempts, want := range cases {
+		if got := Backoff(attempts); got != want {
+			t.Errorf("Backoff(%d) = %s, want %s", attempts, got, want)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Package pagination parses keyset pagination parameters for list endpoints.
+//
+// Clients page with ?limit=N&cursor=<opaque>&sort=<key|-key>. Cursors carry the
+// sort key they were issued for together with the last row's sort value and ID,
+// so a page boundary stays stable while rows are inserted or deleted.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidLimit  = errors.New("limit must be a positive integer")
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrInvalidSort   = errors.New("unsupported sort key")
+)
+
+// Options describes what a single endpoint accepts.
+type Options struct {
+	DefaultLimit int
+	MaxLimit     int
+	// Sorts lists the accepted sort keys; DefaultSort may be prefixed with "-" for descending.
+	Sorts       []string
+	DefaultSort string
+}
+
+// Params are the parsed pagination parameters of a request.
+type Params struct {
+	Limit  int
+	Sort   string
+	Desc   bool
+	Cursor *Cursor
+}
+
+// Cursor marks the last row of the previous page.
+type Cursor struct {
+	Sort  string    `json:"s"`
+	Desc  bool      `json:"d,omitempty"`
+	Value string    `json:"v"`
+	ID    uuid.UUID `json:"id"`
+}
+
+// Parse reads limit, sort and cursor from the query string.
+// Limits above MaxLimit are clamped rather than rejected.
+func Parse(q url.Values, opts Options) (Params, error) {
+	p := Params{Limit: opts.DefaultLimit}
+
+	if raw := strings.TrimSpace(q.Get("limit")); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 1 {
+			return Params{}, ErrInvalidLimit
+		}
+		p.Limit = n
+	}
+	if opts.MaxLimit > 0 && p.Limit > opts.MaxLimit {
+		p.Limit = opts.MaxLimit
+	}
+
+	sort := strings.TrimSpace(q.Get("sort"))
+	if sort == "" {
+		sort = opts.DefaultSort
+	}
+	if strings.HasPrefix(sort, "-") {
+		p.Desc = true
+		sort = sort[1:]
+	}
+	if !slices.Contains(opts.Sorts, sort) {
+		return Params{}, fmt.Errorf("%w %q", ErrInvalidSort, sort)
+	}
+	p.Sort = sort
+
+	if raw := strings.TrimSpace(q.Get("cursor")); raw != "" {
+		c, err := Decode(raw)
+		if err != nil {
+			return Params{}, err
+		}
+		// A cursor issued for another ordering would skip or repeat rows.
+		if c.Sort != p.Sort || c.Desc != p.Desc {
+			return Params{}, ErrInvalidCursor
+		}
+		p.Cursor = c
+	}
+
+	return p, nil
+}
+
+// Next builds the cursor that continues after a row with the given sort value.
+func (p Params) Next(value string, id uuid.UUID) *string {
+	encoded := Cursor{Sort: p.Sort, Desc: p.Desc, Value: value, ID: id}.Encode()
+	return &encoded
+}
+
+// Encode serialises the cursor into an opaque URL-safe token.
+func (c Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// Decode parses a token produced by Encode.
+func Decode(raw string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.Sort == "" || c.ID == uuid.Nil {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// Trim cuts a result fetched with Limit+1 rows down to the page size and
+// reports whether more rows follow.
+func Trim[T any](rows []T, limit int) ([]T, bool) {
+	if len(rows) > limit {
+		return rows[:limit], true
+	}
+	return rows, false
+}
+
+// Filter parses a comma-separated (or repeated) query parameter and rejects
+// values outside allowed. Values are lower-cased and de-duplicated.
+func Filter(q url.Values, key string, allowed []string) ([]string, error) {
+	var out []string
+	for _, raw := range q[key] {
+		for _, v := range strings.Split(raw, ",") {
+			v = strings.ToLower(strings.TrimSpace(v))
+			if v == "" || slices.Contains(out, v) {
+				continue
+			}
+			if !slices.Contains(allowed, v) {
+				return nil, fmt.Errorf("invalid %s %q", key, v)
+			}
+			out = append(out, v)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,98 @@
+package pagination
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+var testOptions = Options{
+	DefaultLimit: 10,
+	MaxLimit:     50,
+	Sorts:        []string{"happenedAt", "displayName"},
+	DefaultSort:  "-happenedAt",
+}
+
+func TestParseDefaults(t *testing.T) {
+	p, err := Parse(url.Values{}, testOptions)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if p.Limit != 10 || p.Sort != "happenedAt" || !p.Desc || p.Cursor != nil {
+		t.Fatalf("unexpected params: %+v", p)
+	}
+}
+
+func TestParseClampsLimit(t *testing.T) {
+	p, err := Parse(url.Values{"limit": {"1000"}}, testOptions)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if p.Limit != 50 {
+		t.Fatalf("expected limit clamped to 50, got %d", p.Limit)
+	}
+
+	if _, err := Parse(url.Values{"limit": {"0"}}, testOptions); !errors.Is(err, ErrInvalidLimit) {
+		t.Fatalf("expected ErrInvalidLimit, got %v", err)
+	}
+}
+
+func TestParseRejectsUnknownSort(t *testing.T) {
+	if _, err := Parse(url.Values{"sort": {"email"}}, testOptions); !errors.Is(err, ErrInvalidSort) {
+		t.Fatalf("expected ErrInvalidSort, got %v", err)
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	p, err := Parse(url.Values{"sort": {"displayName"}}, testOptions)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	id := uuid.New()
+	next := p.Next("Alice", id)
+
+	p2, err := Parse(url.Values{"sort": {"displayName"}, "cursor": {*next}}, testOptions)
+	if err != nil {
+		t.Fatalf("parse with cursor: %v", err)
+	}
+	if p2.Cursor == nil || p2.Cursor.Value != "Alice" || p2.Cursor.ID != id {
+		t.Fatalf("unexpected cursor: %+v", p2.Cursor)
+	}
+
+	// The same cursor must not be reused with a different ordering.
+	if _, err := Parse(url.Values{"sort": {"-displayName"}, "cursor": {*next}}, testOptions); !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("expected ErrInvalidCursor, got %v", err)
+	}
+	if _, err := Parse(url.Values{"cursor": {"not-a-cursor"}}, testOptions); !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("expected ErrInvalidCursor, got %v", err)
+	}
+}
+
+func TestTrim(t *testing.T) {
+	rows, more := Trim([]int{1, 2, 3}, 2)
+	if len(rows) != 2 || !more {
+		t.Fatalf("expected 2 rows and more, got %v %v", rows, more)
+	}
+	rows, more = Trim([]int{1, 2}, 2)
+	if len(rows) != 2 || more {
+		t.Fatalf("expected 2 rows and no more, got %v %v", rows, more)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	allowed := []string{"pending", "approved", "denied"}
+
+	got, err := Filter(url.Values{"status": {"Pending, denied", "pending"}}, "status", allowed)
+	if err != nil {
+		t.Fatalf("filter: %v", err)
+	}
+	if len(got) != 2 || got[0] != "pending" || got[1] != "denied" {
+		t.Fatalf("unexpected values: %v", got)
+	}
+
+	if _, err := Filter(url.Values{"status": {"archived"}}, "status", allowed); err == nil {
+		t.Fatalf("expected error for unknown value")
+	}
+}
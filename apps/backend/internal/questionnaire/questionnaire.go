@@ -0,0 +1,217 @@
+// Package questionnaire validates and scores standardised assessments such as
+// the S-24 or OASES. Instruments are data: each version lists its items, the
+// answer options with their points, and the scales computed from them.
+package questionnaire
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+)
+
+const (
+	MethodSum  = "sum"
+	MethodMean = "mean"
+)
+
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]{0,63}$`)
+
+// Definition is one version of an instrument.
+type Definition struct {
+	Items  []Item  `json:"items"`
+	Scales []Scale `json:"scales"`
+}
+
+// Item is a single question answered by picking one option.
+type Item struct {
+	ID       string   `json:"id"`
+	Text     string   `json:"text"`
+	Options  []Option `json:"options"`
+	Optional bool     `json:"optional,omitempty"`
+}
+
+// Option is an answer choice. A nil Score marks answers such as "not
+// applicable" that count as unanswered for scoring.
+type Option struct {
+	Value string   `json:"value"`
+	Label string   `json:"label"`
+	Score *float64 `json:"score,omitempty"`
+}
+
+// Scale aggregates the scores of its items. Reverse-keyed items are expressed
+// through their option scores. A scale with fewer than MinAnswered scored items
+// is reported without a score.
+type Scale struct {
+	Key         string   `json:"key"`
+	Title       string   `json:"title"`
+	Items       []string `json:"items"`
+	Method      string   `json:"method"`
+	MinAnswered int      `json:"minAnswered,omitempty"`
+	Bands       []Band   `json:"bands,omitempty"`
+}
+
+// Band labels scores up to and including Max; bands are checked in order.
+type Band struct {
+	Max   float64 `json:"max"`
+	Label string  `json:"label"`
+}
+
+// ScaleScore is the result for one scale.
+type ScaleScore struct {
+	Key      string   `json:"key"`
+	Title    string   `json:"title"`
+	Score    *float64 `json:"score,omitempty"`
+	Answered int      `json:"answered"`
+	Items    int      `json:"items"`
+	Band     string   `json:"band,omitempty"`
+}
+
+// ValidationError describes why a definition or a set of answers is unusable.
+type ValidationError struct {
+	msg string
+}
+
+func (e *ValidationError) Error() string { return e.msg }
+
+func invalid(format string, args ...any) error {
+	return &ValidationError{msg: fmt.Sprintf(format, args...)}
+}
+
+// IsValidationError reports whether err came from Validate or Score.
+func IsValidationError(err error) bool {
+	var v *ValidationError
+	return errors.As(err, &v)
+}
+
+// Validate checks that IDs are unique, every item has options and every scale
+// refers to existing items with a known method.
+func (d Definition) Validate() error {
+	if len(d.Items) == 0 {
+		return invalid("at least one item is required")
+	}
+	if len(d.Scales) == 0 {
+		return invalid("at least one scale is required")
+	}
+
+	items := make(map[string]struct{}, len(d.Items))
+	for _, it := range d.Items {
+		if !idPattern.MatchString(it.ID) {
+			return invalid("item id %q must be lower-case letters, digits, '.', '_' or '-'", it.ID)
+		}
+		if _, dup := items[it.ID]; dup {
+			return invalid("duplicate item id %q", it.ID)
+		}
+		items[it.ID] = struct{}{}
+		if it.Text == "" {
+			return invalid("item %q needs text", it.ID)
+		}
+		if len(it.Options) < 2 {
+			return invalid("item %q needs at least two options", it.ID)
+		}
+		values := make(map[string]struct{}, len(it.Options))
+		for _, o := range it.Options {
+			if o.Value == "" {
+				return invalid("item %q has an option without a value", it.ID)
+			}
+			if _, dup := values[o.Value]; dup {
+				return invalid("item %q has duplicate option %q", it.ID, o.Value)
+			}
+			values[o.Value] = struct{}{}
+		}
+	}
+
+	scales := make(map[string]struct{}, len(d.Scales))
+	for _, sc := range d.Scales {
+		if !idPattern.MatchString(sc.Key) {
+			return invalid("scale key %q must be lower-case letters, digits, '.', '_' or '-'", sc.Key)
+		}
+		if _, dup := scales[sc.Key]; dup {
+			return invalid("duplicate scale key %q", sc.Key)
+		}
+		scales[sc.Key] = struct{}{}
+		if sc.Method != MethodSum && sc.Method != MethodMean {
+			return invalid("scale %q method must be %s or %s", sc.Key, MethodSum, MethodMean)
+		}
+		if len(sc.Items) == 0 {
+			return invalid("scale %q needs items", sc.Key)
+		}
+		for _, id := range sc.Items {
+			if _, ok := items[id]; !ok {
+				return invalid("scale %q refers to unknown item %q", sc.Key, id)
+			}
+		}
+		if sc.MinAnswered < 0 || sc.MinAnswered > len(sc.Items) {
+			return invalid("scale %q minAnswered must be between 0 and %d", sc.Key, len(sc.Items))
+		}
+		for i := 1; i < len(sc.Bands); i++ {
+			if sc.Bands[i].Max <= sc.Bands[i-1].Max {
+				return invalid("scale %q bands must have increasing max values", sc.Key)
+			}
+		}
+	}
+	return nil
+}
+
+// Score checks answers (item ID to option value) against the definition and
+// computes every scale. Unknown items, unknown options and missing required
+// items are rejected.
+func (d Definition) Score(answers map[string]string) ([]ScaleScore, error) {
+	points := make(map[string]float64, len(answers))
+	known := make(map[string]struct{}, len(d.Items))
+	for _, it := range d.Items {
+		known[it.ID] = struct{}{}
+		value, answered := answers[it.ID]
+		if !answered || value == "" {
+			if !it.Optional {
+				return nil, invalid("item %q is required", it.ID)
+			}
+			continue
+		}
+		idx := slices.IndexFunc(it.Options, func(o Option) bool { return o.Value == value })
+		if idx < 0 {
+			return nil, invalid("item %q has no option %q", it.ID, value)
+		}
+		if score := it.Options[idx].Score; score != nil {
+			points[it.ID] = *score
+		}
+	}
+	for id := range answers {
+		if _, ok := known[id]; !ok {
+			return nil, invalid("unknown item %q", id)
+		}
+	}
+
+	out := make([]ScaleScore, 0, len(d.Scales))
+	for _, sc := range d.Scales {
+		res := ScaleScore{Key: sc.Key, Title: sc.Title, Items: len(sc.Items)}
+		var total float64
+		for _, id := range sc.Items {
+			if p, ok := points[id]; ok {
+				total += p
+				res.Answered++
+			}
+		}
+		if res.Answered > 0 && res.Answered >= sc.MinAnswered {
+			score := total
+			if sc.Method == MethodMean {
+				score = total / float64(res.Answered)
+			}
+			score = math.Round(score*100) / 100
+			res.Score = &score
+			res.Band = band(sc.Bands, score)
+		}
+		out = append(out, res)
+	}
+	return out, nil
+}
+
+func band(bands []Band, score float64) string {
+	for _, b := range bands {
+		if score <= b.Max {
+			return b.Label
+		}
+	}
+	return ""
+}
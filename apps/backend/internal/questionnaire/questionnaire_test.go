@@ -0,0 +1,80 @@
+package questionnaire
+
+import "testing"
+
+func points(v float64) *float64 { return &v }
+
+// testDefinition mimics the OASES layout: 1-5 ratings averaged per section
+// with "not applicable" answers skipped.
+var testDefinition = Definition{
+	Items: []Item{
+		{ID: "q1", Text: "Q1", Options: likert()},
+		{ID: "q2", Text: "Q2", Options: likert()},
+		{ID: "q3", Text: "Q3", Options: likert(), Optional: true},
+		{ID: "q4", Text: "Q4", Options: []Option{
+			{Value: "true", Label: "True", Score: points(0)},
+			{Value: "false", Label: "False", Score: points(1)},
+		}},
+	},
+	Scales: []Scale{
+		{Key: "impact", Title: "Impact", Items: []string{"q1", "q2", "q3"}, Method: MethodMean, MinAnswered: 2,
+			Bands: []Band{{Max: 1.49, Label: "mild"}, {Max: 2.99, Label: "moderate"}, {Max: 5, Label: "severe"}}},
+		{Key: "attitude", Title: "Attitude", Items: []string{"q4"}, Method: MethodSum},
+	},
+}
+
+func likert() []Option {
+	opts := []Option{{Value: "na", Label: "Not applicable"}}
+	for i := 1; i <= 5; i++ {
+		v := float64(i)
+		opts = append(opts, Option{Value: string(rune('0' + i)), Label: string(rune('0' + i)), Score: &v})
+	}
+	return opts
+}
+
+func TestValidate(t *testing.T) {
+	if err := testDefinition.Validate(); err != nil {
+		t.Fatalf("expected valid definition, got %v", err)
+	}
+
+	bad := testDefinition
+	bad.Scales = []Scale{{Key: "x", Items: []string{"missing"}, Method: MethodSum}}
+	if err := bad.Validate(); !IsValidationError(err) {
+		t.Fatalf("expected validation error for unknown item, got %v", err)
+	}
+}
+
+func TestScoreMeanWithBands(t *testing.T) {
+	got, err := testDefinition.Score(map[string]string{"q1": "2", "q2": "3", "q3": "4", "q4": "false"})
+	if err != nil {
+		t.Fatalf("score: %v", err)
+	}
+	if got[0].Score == nil || *got[0].Score != 3 || got[0].Band != "severe" || got[0].Answered != 3 {
+		t.Fatalf("unexpected impact score %+v", got[0])
+	}
+	if got[1].Score == nil || *got[1].Score != 1 {
+		t.Fatalf("unexpected attitude score %+v", got[1])
+	}
+}
+
+func TestScoreSkipsUnscoredAnswers(t *testing.T) {
+	got, err := testDefinition.Score(map[string]string{"q1": "1", "q2": "na", "q4": "true"})
+	if err != nil {
+		t.Fatalf("score: %v", err)
+	}
+	if got[0].Score != nil || got[0].Answered != 1 {
+		t.Fatalf("expected impact to fall below minAnswered, got %+v", got[0])
+	}
+}
+
+func TestScoreRejectsInvalidAnswers(t *testing.T) {
+	for name, answers := range map[string]map[string]string{
+		"missing required": {"q1": "1", "q4": "true"},
+		"unknown option":   {"q1": "9", "q2": "1", "q4": "true"},
+		"unknown item":     {"q1": "1", "q2": "1", "q4": "true", "q9": "1"},
+	} {
+		if _, err := testDefinition.Score(answers); !IsValidationError(err) {
+			t.Fatalf("%s: expected validation error, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package ratelimit
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Backends accepted in RATE_LIMIT_BACKEND.
+const (
+	BackendMemory   = "memory"
+	BackendPostgres = "postgres"
+	BackendOff      = "off"
+)
+
+type Config struct {
+	Backend string
+	// TrustProxy keys clients by the address a trusted proxy reports instead
+	// of the connection address; enable it only behind such a proxy.
+	TrustProxy bool
+	// ProxyHops is how many trusted proxies append to X-Forwarded-For; the
+	// client is the entry the outermost of them added. Entries further left
+	// are written by the client and ignored.
+	ProxyHops int
+	// ClientIPHeader, when set, names a header the proxy overwrites with the
+	// client address (e.g. X-Real-IP); it is used instead of X-Forwarded-For.
+	ClientIPHeader string
+	// Policies by name, after RATE_LIMIT_<NAME> overrides. A policy
+	// switched off with "off" is absent.
+	Policies map[string]Policy
+}
+
+// LoadConfig reads RATE_LIMIT_BACKEND (memory, postgres or off; default
+// memory), RATE_LIMIT_TRUST_PROXY, RATE_LIMIT_PROXY_HOPS (default 1),
+// RATE_LIMIT_CLIENT_IP_HEADER and one RATE_LIMIT_<NAME> override per default
+// policy, e.g. RATE_LIMIT_AUTH=20/1m or RATE_LIMIT_AUTH=off.
+func LoadConfig(defaults []Policy) (Config, error) {
+	cfg := Config{
+		Backend:        strings.ToLower(strings.TrimSpace(os.Getenv("RATE_LIMIT_BACKEND"))),
+		TrustProxy:     boolFromEnv("RATE_LIMIT_TRUST_PROXY"),
+		ProxyHops:      1,
+		ClientIPHeader: strings.TrimSpace(os.Getenv("RATE_LIMIT_CLIENT_IP_HEADER")),
+		Policies:       make(map[string]Policy, len(defaults)),
+	}
+	if raw := strings.TrimSpace(os.Getenv("RATE_LIMIT_PROXY_HOPS")); raw != "" {
+		hops, err := strconv.Atoi(raw)
+		if err != nil || hops < 1 {
+			return Config{}, fmt.Errorf("RATE_LIMIT_PROXY_HOPS must be a positive integer, got %q", raw)
+		}
+		cfg.ProxyHops = hops
+	}
+	switch cfg.Backend {
+	case "":
+		cfg.Backend = BackendMemory
+	case BackendMemory, BackendPostgres, BackendOff:
+	default:
+		return Config{}, fmt.Errorf("RATE_LIMIT_BACKEND must be %s, %s or %s", BackendMemory, BackendPostgres, BackendOff)
+	}
+
+	for _, p := range defaults {
+		key := "RATE_LIMIT_" + strings.ToUpper(p.Name)
+		raw := strings.TrimSpace(os.Getenv(key))
+		switch {
+		case raw == "":
+			cfg.Policies[p.Name] = p
+		case strings.EqualFold(raw, BackendOff):
+		default:
+			override, err := ParsePolicy(p.Name, raw)
+			if err != nil {
+				return Config{}, fmt.Errorf("%s: %w", key, err)
+			}
+			cfg.Policies[p.Name] = override
+		}
+	}
+	return cfg, nil
+}
+
+func boolFromEnv(key string) bool {
+	val := strings.ToLower(strings.TrimSpace(os.Getenv(key)))
+	return val == "1" || val == "true" || val == "yes" || val == "on"
+}
@@ -0,0 +1,85 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Limiter applies named policies against a Store. A nil *Limiter allows
+// everything.
+type Limiter struct {
+	Store      Store
+	Policies   map[string]Policy
+	TrustProxy bool
+	// ProxyHops and ClientIPHeader are described on Config; ProxyHops below
+	// 1 counts as 1.
+	ProxyHops      int
+	ClientIPHeader string
+	// Now defaults to time.Now.
+	Now func() time.Time
+}
+
+func NewLimiter(store Store, cfg Config) *Limiter {
+	return &Limiter{
+		Store:          store,
+		Policies:       cfg.Policies,
+		TrustProxy:     cfg.TrustProxy,
+		ProxyHops:      cfg.ProxyHops,
+		ClientIPHeader: cfg.ClientIPHeader,
+	}
+}
+
+// Take spends a token from key's bucket under the named policy. ok is false
+// when the limiter is nil or the policy is switched off.
+func (l *Limiter) Take(ctx context.Context, policy, key string) (d Decision, ok bool, err error) {
+	if l == nil {
+		return Decision{}, false, nil
+	}
+	p, ok := l.Policies[policy]
+	if !ok {
+		return Decision{}, false, nil
+	}
+	now := time.Now
+	if l.Now != nil {
+		now = l.Now
+	}
+	d, err = l.Store.Take(ctx, p.Name+":"+key, p, now().UTC())
+	return d, true, err
+}
+
+// ClientIP returns the address requests from r are attributed to.
+func (l *Limiter) ClientIP(r *http.Request) string {
+	if l != nil && l.TrustProxy {
+		if ip := l.forwardedIP(r); ip != "" {
+			return ip
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// forwardedIP returns the client address reported by the trusted proxies, or
+// "" when they did not report one. Proxies append to X-Forwarded-For, so only
+// the rightmost ProxyHops entries are theirs.
+func (l *Limiter) forwardedIP(r *http.Request) string {
+	if l.ClientIPHeader != "" {
+		return strings.TrimSpace(r.Header.Get(l.ClientIPHeader))
+	}
+	var entries []string
+	for _, v := range r.Header.Values("X-Forwarded-For") {
+		for _, entry := range strings.Split(v, ",") {
+			entries = append(entries, strings.TrimSpace(entry))
+		}
+	}
+	hops := max(1, l.ProxyHops)
+	if len(entries) < hops {
+		return ""
+	}
+	return entries[len(entries)-hops]
+}
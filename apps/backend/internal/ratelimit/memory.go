@@ -0,0 +1,64 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memorySweepInterval is how often idle buckets are dropped.
+const memorySweepInterval = time.Minute
+
+type memoryBucket struct {
+	tokens  float64
+	updated time.Time
+	policy  Policy
+}
+
+// MemoryStore keeps buckets in process memory. Each replica limits on its
+// own, so it suits single-instance deployments and tests.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*memoryBucket
+	lastSweep time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*memoryBucket)}
+}
+
+func (m *MemoryStore) Take(_ context.Context, key string, p Policy, now time.Time) (Decision, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sweep(now)
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &memoryBucket{tokens: float64(p.Limit), updated: now}
+		m.buckets[key] = b
+	}
+	b.policy = p
+	b.tokens = refill(p, b.tokens, b.updated, now)
+	b.updated = now
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+	return decide(p, b.tokens, allowed), nil
+}
+
+// sweep drops buckets that have refilled completely; they are
+// indistinguishable from new ones.
+func (m *MemoryStore) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < memorySweepInterval {
+		return
+	}
+	m.lastSweep = now
+	for key, b := range m.buckets {
+		if refill(b.policy, b.tokens, b.updated, now) >= float64(b.policy.Limit) {
+			delete(m.buckets, key)
+		}
+	}
+}
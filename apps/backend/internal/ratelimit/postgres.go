@@ -0,0 +1,66 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend/ent"
+	"backend/ent/ratelimitbucket"
+)
+
+// refillExpr is the bucket's tokens refilled up to the database clock.
+const refillExpr = `LEAST($2::float8, b.tokens + GREATEST(0, EXTRACT(EPOCH FROM (now() - b.updated_at))) * $3::float8)`
+
+// takeQuery takes a token in one statement so concurrent replicas cannot
+// both spend the last one.
+var takeQuery = strings.ReplaceAll(`
+INSERT INTO rate_limit_buckets AS b (id, tokens, allowed, updated_at)
+VALUES ($1, $2::float8 - 1, true, now())
+ON CONFLICT (id) DO UPDATE SET
+	tokens = CASE WHEN {refill} >= 1 THEN {refill} - 1 ELSE {refill} END,
+	allowed = {refill} >= 1,
+	updated_at = now()
+RETURNING tokens, allowed`, "{refill}", refillExpr)
+
+// PostgresStore keeps buckets in the rate_limit_buckets table so every
+// replica shares them. Buckets follow the database clock, so the now passed
+// to Take is ignored.
+type PostgresStore struct {
+	client *ent.Client
+}
+
+func NewPostgresStore(client *ent.Client) *PostgresStore {
+	return &PostgresStore{client: client}
+}
+
+func (s *PostgresStore) Take(ctx context.Context, key string, p Policy, _ time.Time) (Decision, error) {
+	rows, err := s.client.QueryContext(ctx, takeQuery, key, float64(p.Limit), p.rate())
+	if err != nil {
+		return Decision{}, fmt.Errorf("take rate limit token: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return Decision{}, fmt.Errorf("take rate limit token: %w", err)
+		}
+		return Decision{}, fmt.Errorf("take rate limit token: no row returned")
+	}
+	var (
+		tokens  float64
+		allowed bool
+	)
+	if err := rows.Scan(&tokens, &allowed); err != nil {
+		return Decision{}, fmt.Errorf("scan rate limit token: %w", err)
+	}
+	return decide(p, tokens, allowed), nil
+}
+
+// Prune deletes buckets untouched since before.
+func (s *PostgresStore) Prune(ctx context.Context, before time.Time) (int, error) {
+	return s.client.RateLimitBucket.Delete().
+		Where(ratelimitbucket.UpdatedAtLT(before)).
+		Exec(ctx)
+}
@@ -0,0 +1,82 @@
+// Package ratelimit implements token-bucket rate limiting with an in-memory
+// store for single instances and a Postgres store shared by replicas.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Policy allows Limit requests per Period, refilled continuously, with bursts
+// of up to Limit.
+type Policy struct {
+	Name   string
+	Limit  int
+	Period time.Duration
+}
+
+// rate is the refill rate in tokens per second.
+func (p Policy) rate() float64 {
+	return float64(p.Limit) / p.Period.Seconds()
+}
+
+func (p Policy) String() string {
+	return strconv.Itoa(p.Limit) + "/" + p.Period.String()
+}
+
+// ParsePolicy parses "<limit>/<period>", e.g. "10/1m".
+func ParsePolicy(name, raw string) (Policy, error) {
+	limitStr, periodStr, ok := strings.Cut(strings.TrimSpace(raw), "/")
+	if !ok {
+		return Policy{}, fmt.Errorf("rate limit %q must look like 10/1m", raw)
+	}
+	limit, err := strconv.Atoi(strings.TrimSpace(limitStr))
+	if err != nil || limit < 1 {
+		return Policy{}, fmt.Errorf("rate limit %q: limit must be a positive integer", raw)
+	}
+	period, err := time.ParseDuration(strings.TrimSpace(periodStr))
+	if err != nil || period <= 0 {
+		return Policy{}, fmt.Errorf("rate limit %q: period must be a positive duration", raw)
+	}
+	return Policy{Name: name, Limit: limit, Period: period}, nil
+}
+
+// Decision is the outcome of taking a token.
+type Decision struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// RetryAfter is how long until a token is available; zero when allowed.
+	RetryAfter time.Duration
+}
+
+// Store takes one token from the bucket identified by key.
+type Store interface {
+	Take(ctx context.Context, key string, p Policy, now time.Time) (Decision, error)
+}
+
+// decide turns the tokens left in a bucket after a take into a Decision.
+func decide(p Policy, tokens float64, allowed bool) Decision {
+	d := Decision{
+		Allowed:   allowed,
+		Limit:     p.Limit,
+		Remaining: max(0, int(math.Floor(tokens))),
+	}
+	if !allowed {
+		d.RetryAfter = time.Duration((1 - tokens) / p.rate() * float64(time.Second))
+	}
+	return d
+}
+
+// refill returns the tokens in a bucket last updated at updated.
+func refill(p Policy, tokens float64, updated, now time.Time) float64 {
+	elapsed := now.Sub(updated).Seconds()
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	return math.Min(float64(p.Limit), tokens+elapsed*p.rate())
+}
@@ -0,0 +1,126 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreTokenBucket(t *testing.T) {
+	store := NewMemoryStore()
+	p := Policy{Name: "auth", Limit: 2, Period: time.Minute}
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	for i := range 2 {
+		d, err := store.Take(ctx, "k", p, now)
+		if err != nil || !d.Allowed {
+			t.Fatalf("take %d: expected allowed, got %+v, %v", i, d, err)
+		}
+	}
+
+	d, _ := store.Take(ctx, "k", p, now)
+	if d.Allowed || d.Remaining != 0 || d.RetryAfter != 30*time.Second {
+		t.Fatalf("expected denial with 30s retry, got %+v", d)
+	}
+
+	if d, _ := store.Take(ctx, "other", p, now); !d.Allowed {
+		t.Fatalf("expected separate keys to have separate buckets")
+	}
+
+	// One token refills every 30s.
+	if d, _ := store.Take(ctx, "k", p, now.Add(30*time.Second)); !d.Allowed {
+		t.Fatalf("expected a refilled token, got %+v", d)
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	p, err := ParsePolicy("sync", "30/1m")
+	if err != nil || p.Limit != 30 || p.Period != time.Minute {
+		t.Fatalf("unexpected policy %+v, %v", p, err)
+	}
+	for _, raw := range []string{"30", "0/1m", "x/1m", "5/soon", "5/-1s"} {
+		if _, err := ParsePolicy("sync", raw); err == nil {
+			t.Errorf("expected %q to be rejected", raw)
+		}
+	}
+}
+
+func TestLoadConfigOverrides(t *testing.T) {
+	defaults := []Policy{
+		{Name: "auth", Limit: 10, Period: time.Minute},
+		{Name: "sync_upload", Limit: 30, Period: time.Minute},
+	}
+	t.Setenv("RATE_LIMIT_BACKEND", "")
+	t.Setenv("RATE_LIMIT_AUTH", "3/10s")
+	t.Setenv("RATE_LIMIT_SYNC_UPLOAD", "off")
+
+	cfg, err := LoadConfig(defaults)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if cfg.Backend != BackendMemory {
+		t.Fatalf("expected memory backend, got %q", cfg.Backend)
+	}
+	if p := cfg.Policies["auth"]; p.Limit != 3 || p.Period != 10*time.Second {
+		t.Fatalf("expected override, got %+v", p)
+	}
+	if _, ok := cfg.Policies["sync_upload"]; ok {
+		t.Fatalf("expected sync_upload to be switched off")
+	}
+
+	t.Setenv("RATE_LIMIT_BACKEND", "redis")
+	if _, err := LoadConfig(defaults); err == nil {
+		t.Fatalf("expected unknown backend to be rejected")
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	var nilLimiter *Limiter
+	if _, ok, _ := nilLimiter.Take(context.Background(), "auth", "k"); ok {
+		t.Fatalf("expected a nil limiter to allow everything")
+	}
+
+	l := NewLimiter(NewMemoryStore(), Config{Policies: map[string]Policy{
+		"auth": {Name: "auth", Limit: 1, Period: time.Minute},
+	}})
+	if _, ok, _ := l.Take(context.Background(), "missing", "k"); ok {
+		t.Fatalf("expected an unknown policy to be skipped")
+	}
+	l.Take(context.Background(), "auth", "k")
+	if d, ok, _ := l.Take(context.Background(), "auth", "k"); !ok || d.Allowed {
+		t.Fatalf("expected the second take to be denied, got %+v", d)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.RemoteAddr = "10.0.0.1:5555"
+	r.Header.Set("X-Forwarded-For", "203.0.113.9, 10.0.0.1")
+
+	if ip := (&Limiter{}).ClientIP(r); ip != "10.0.0.1" {
+		t.Fatalf("expected the connection address, got %q", ip)
+	}
+	if ip := (&Limiter{TrustProxy: true}).ClientIP(r); ip != "10.0.0.1" {
+		t.Fatalf("expected the entry added by the proxy, got %q", ip)
+	}
+	if ip := (&Limiter{TrustProxy: true, ProxyHops: 2}).ClientIP(r); ip != "203.0.113.9" {
+		t.Fatalf("expected the entry added by the outer proxy, got %q", ip)
+	}
+
+	// A client-written entry cannot move the key: the proxy appends the real
+	// address after it.
+	r.Header.Set("X-Forwarded-For", "198.51.100.77, 203.0.113.9")
+	if ip := (&Limiter{TrustProxy: true}).ClientIP(r); ip != "203.0.113.9" {
+		t.Fatalf("expected the rightmost entry, got %q", ip)
+	}
+	if ip := (&Limiter{TrustProxy: true, ProxyHops: 3}).ClientIP(r); ip != "10.0.0.1" {
+		t.Fatalf("expected the connection address when hops exceed entries, got %q", ip)
+	}
+
+	r.Header.Set("X-Real-IP", "192.0.2.4")
+	if ip := (&Limiter{TrustProxy: true, ClientIPHeader: "X-Real-IP"}).ClientIP(r); ip != "192.0.2.4" {
+		t.Fatalf("expected the configured header, got %q", ip)
+	}
+}
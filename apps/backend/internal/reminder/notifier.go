@@ -0,0 +1,273 @@
+package reminder
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+)
+
+// Delivery channels; they match the channel enum stored with each schedule.
+const (
+	ChannelPush  = "Push"
+	ChannelEmail = "Email"
+	ChannelLog   = "Log"
+)
+
+const (
+	envSMTPHost     = "SMTP_HOST"
+	envSMTPPort     = "SMTP_PORT"
+	envSMTPUsername = "SMTP_USERNAME"
+	envSMTPPassword = "SMTP_PASSWORD"
+	envSMTPFrom     = "SMTP_FROM"
+
+	envPushGatewayURL   = "PUSH_GATEWAY_URL"
+	envPushGatewayToken = "PUSH_GATEWAY_TOKEN"
+
+	defaultSMTPPort = "587"
+	pushTimeout     = 10 * time.Second
+	smtpTimeout     = 30 * time.Second
+)
+
+// ErrNoAddress is returned when the patient cannot be reached on a channel,
+// e.g. an email reminder for a patient without an email address.
+var ErrNoAddress = errors.New("patient has no address for this channel")
+
+// Notification is one reminder addressed to a patient.
+type Notification struct {
+	ID          uuid.UUID
+	PatientID   uuid.UUID
+	DisplayName string
+	Email       *string
+	Kind        string
+	Message     string
+}
+
+// Notifier delivers notifications on one channel. Implementations must be safe
+// for concurrent use.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// LoadNotifiers builds the notifiers configured through the environment. The
+// log sink is always available; email needs SMTP_HOST and SMTP_FROM, push
+// needs PUSH_GATEWAY_URL.
+func LoadNotifiers(logger *log.Logger) (map[string]Notifier, error) {
+	notifiers := map[string]Notifier{
+		ChannelLog: LogNotifier{Logger: logger},
+	}
+
+	if host := strings.TrimSpace(os.Getenv(envSMTPHost)); host != "" {
+		from := strings.TrimSpace(os.Getenv(envSMTPFrom))
+		if from == "" {
+			return nil, errors.New("SMTP_FROM is required when SMTP_HOST is set")
+		}
+		port := strings.TrimSpace(os.Getenv(envSMTPPort))
+		if port == "" {
+			port = defaultSMTPPort
+		}
+		notifiers[ChannelEmail] = &EmailNotifier{
+			Addr:     net.JoinHostPort(host, port),
+			Host:     host,
+			Username: os.Getenv(envSMTPUsername),
+			Password: os.Getenv(envSMTPPassword),
+			From:     from,
+			Timeout:  smtpTimeout,
+		}
+	}
+
+	if raw := strings.TrimSpace(os.Getenv(envPushGatewayURL)); raw != "" {
+		u, err := url.Parse(raw)
+		if err != nil || u.Host == "" {
+			return nil, errors.New("PUSH_GATEWAY_URL must be an absolute URL")
+		}
+		notifiers[ChannelPush] = &PushNotifier{
+			URL:    u.String(),
+			Token:  strings.TrimSpace(os.Getenv(envPushGatewayToken)),
+			Client: &http.Client{Timeout: pushTimeout},
+		}
+	}
+
+	return notifiers, nil
+}
+
+// LogNotifier writes notifications to the log; useful in development and as
+// an audit trail when no real channel is set up.
+type LogNotifier struct {
+	Logger *log.Logger
+}
+
+func (l LogNotifier) Notify(_ context.Context, n Notification) error {
+	logger := l.Logger
+	if logger == nil {
+		logger = log.Default()
+	}
+	logger.Info("reminder", "id", n.ID, "patient_id", n.PatientID, "kind", n.Kind, "message", n.Message)
+	return nil
+}
+
+// EmailNotifier sends plain-text mail through an SMTP relay.
+type EmailNotifier struct {
+	Addr     string
+	Host     string
+	Username string
+	Password string
+	From     string
+	// Timeout bounds the whole exchange with the relay; zero means no limit
+	// beyond ctx.
+	Timeout time.Duration
+}
+
+func (e *EmailNotifier) Notify(ctx context.Context, n Notification) error {
+	if n.Email == nil || strings.TrimSpace(*n.Email) == "" {
+		return ErrNoAddress
+	}
+	to := strings.TrimSpace(*n.Email)
+
+	var auth smtp.Auth
+	if e.Username != "" {
+		auth = smtp.PlainAuth("", e.Username, e.Password, e.Host)
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", e.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", subject(n.Kind))
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&msg, "Hi %s,\r\n\r\n%s\r\n", n.DisplayName, n.Message)
+
+	return e.send(ctx, auth, to, msg.Bytes())
+}
+
+// send does what smtp.SendMail does, but gives up once ctx is done or Timeout
+// has passed, so a stalled relay cannot hold up the reminder loop.
+func (e *EmailNotifier) send(ctx context.Context, auth smtp.Auth, to string, msg []byte) error {
+	if e.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.Timeout)
+		defer cancel()
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", e.Addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	c, err := smtp.NewClient(conn, e.Host)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: e.Host}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := c.Extension("AUTH"); ok {
+			if err := c.Auth(auth); err != nil {
+				return err
+			}
+		}
+	}
+	if err := c.Mail(e.From); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+func subject(kind string) string {
+	if kind == KindInactivity {
+		return "We miss your journal entries"
+	}
+	return "Time for your journal"
+}
+
+// PushNotifier hands notifications to a push gateway, which maps patient IDs
+// to device tokens. The gateway receives a JSON body and an optional bearer
+// token; any non-2xx answer counts as a failed delivery.
+type PushNotifier struct {
+	URL    string
+	Token  string
+	Client *http.Client
+}
+
+type pushPayload struct {
+	ID        string `json:"id"`
+	PatientID string `json:"patientId"`
+	Kind      string `json:"kind"`
+	Title     string `json:"title"`
+	Body      string `json:"body"`
+}
+
+func (p *PushNotifier) Notify(ctx context.Context, n Notification) error {
+	body, err := json.Marshal(pushPayload{
+		ID:        n.ID.String(),
+		PatientID: n.PatientID.String(),
+		Kind:      n.Kind,
+		Title:     subject(n.Kind),
+		Body:      n.Message,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.Token)
+	}
+
+	client := p.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("push gateway answered %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package reminder
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestPushNotifierPostsToGateway(t *testing.T) {
+	var got pushPayload
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer gateway.Close()
+
+	n := Notification{ID: uuid.New(), PatientID: uuid.New(), Kind: KindJournal, Message: "Time to journal"}
+	p := &PushNotifier{URL: gateway.URL, Token: "secret"}
+	if err := p.Notify(context.Background(), n); err != nil {
+		t.Fatalf("notify: %v", err)
+	}
+	if got.PatientID != n.PatientID.String() || got.Body != "Time to journal" {
+		t.Fatalf("unexpected payload %+v", got)
+	}
+
+	p.Token = "wrong"
+	if err := p.Notify(context.Background(), n); err == nil {
+		t.Fatalf("expected an error for a rejected push")
+	}
+}
+
+func TestEmailNotifierNeedsAddress(t *testing.T) {
+	e := &EmailNotifier{Addr: "127.0.0.1:1", Host: "127.0.0.1", From: "noreply@example.com"}
+	err := e.Notify(context.Background(), Notification{Kind: KindInactivity})
+	if !errors.Is(err, ErrNoAddress) {
+		t.Fatalf("expected ErrNoAddress, got %v", err)
+	}
+}
+
+func TestEmailNotifierGivesUpOnStalledRelay(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		// Accept and never greet, like a relay that hangs.
+		conn, err := ln.Accept()
+		if err == nil {
+			defer conn.Close()
+			time.Sleep(5 * time.Second)
+		}
+	}()
+
+	email := "pat@example.com"
+	e := &EmailNotifier{Addr: ln.Addr().String(), Host: "127.0.0.1", From: "noreply@example.com", Timeout: 100 * time.Millisecond}
+	start := time.Now()
+	if err := e.Notify(context.Background(), Notification{Kind: KindInactivity, Email: &email}); err == nil {
+		t.Fatal("expected an error from a stalled relay")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("expected Notify to give up after the timeout, took %s", elapsed)
+	}
+}
@@ -0,0 +1,132 @@
+// Package reminder decides when patient reminders fire and delivers them
+// through pluggable notifiers. Schedules are wall-clock times in the patient's
+// timezone, so a reminder at 20:00 stays at 20:00 across DST changes.
+package reminder
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	// Embed the zone database so schedules work in minimal containers.
+	_ "time/tzdata"
+)
+
+// Reminder kinds: Journal fires at every scheduled time, Inactivity only when
+// the patient has not journaled for a number of days.
+const (
+	KindJournal    = "Journal"
+	KindInactivity = "Inactivity"
+)
+
+// MaxInactivityDays bounds inactivity nudges to a practical range.
+const MaxInactivityDays = 90
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Schedule is a time of day in Location on the given weekdays; no weekdays
+// means every day.
+type Schedule struct {
+	Location *time.Location
+	Hour     int
+	Minute   int
+	Weekdays []time.Weekday
+}
+
+// LoadLocation resolves an IANA zone name such as "Europe/Berlin". The server's
+// local zone is rejected because it says nothing about the patient.
+func LoadLocation(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || name == "Local" {
+		return nil, errors.New("timezone must be an IANA name such as Europe/Berlin")
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q", name)
+	}
+	return loc, nil
+}
+
+// ParseClock parses a 24-hour "HH:MM" time of day.
+func ParseClock(raw string) (hour, minute int, err error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(raw))
+	if err != nil {
+		return 0, 0, errors.New("time of day must be HH:MM")
+	}
+	return t.Hour(), t.Minute(), nil
+}
+
+// ParseWeekdays accepts three-letter day names ("mon", "Tue", ...) and returns
+// them deduplicated in input order.
+func ParseWeekdays(names []string) ([]time.Weekday, error) {
+	days := make([]time.Weekday, 0, len(names))
+	seen := map[time.Weekday]bool{}
+	for _, name := range names {
+		d, ok := weekdayNames[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("unknown weekday %q; use sun, mon, tue, wed, thu, fri or sat", name)
+		}
+		if !seen[d] {
+			seen[d] = true
+			days = append(days, d)
+		}
+	}
+	return days, nil
+}
+
+// WeekdayName is the inverse of ParseWeekdays.
+func WeekdayName(d time.Weekday) string {
+	return strings.ToLower(d.String()[:3])
+}
+
+// Next returns the first scheduled instant strictly after the given time. Times
+// that fall into a DST gap are shifted forward by time.Date's normalisation.
+func (s Schedule) Next(after time.Time) time.Time {
+	local := after.In(s.Location)
+	// Eight days cover a full week plus today's already-passed slot.
+	for i := 0; i < 8; i++ {
+		day := local.AddDate(0, 0, i)
+		at := time.Date(day.Year(), day.Month(), day.Day(), s.Hour, s.Minute, 0, 0, s.Location)
+		if at.After(after) && s.onDay(at.Weekday()) {
+			return at
+		}
+	}
+	// Unreachable for valid schedules; fall back to a day later.
+	return after.Add(24 * time.Hour)
+}
+
+func (s Schedule) onDay(d time.Weekday) bool {
+	if len(s.Weekdays) == 0 {
+		return true
+	}
+	for _, w := range s.Weekdays {
+		if w == d {
+			return true
+		}
+	}
+	return false
+}
+
+// NudgeDue reports whether an inactivity nudge should go out: the patient has
+// been quiet for at least days, and the last nudge was either before their
+// latest activity or is itself days old, so a silent patient is nudged once
+// per period rather than at every check.
+func NudgeDue(lastActivity time.Time, lastNudge *time.Time, days int, now time.Time) bool {
+	window := time.Duration(days) * 24 * time.Hour
+	if now.Sub(lastActivity) < window {
+		return false
+	}
+	if lastNudge == nil || lastNudge.Before(lastActivity) {
+		return true
+	}
+	return now.Sub(*lastNudge) >= window
+}
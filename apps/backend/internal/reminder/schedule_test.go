@@ -0,0 +1,83 @@
+package reminder
+
+import (
+	"testing"
+	"time"
+)
+
+func mustLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := LoadLocation(name)
+	if err != nil {
+		t.Fatalf("load %s: %v", name, err)
+	}
+	return loc
+}
+
+func TestNextKeepsWallClockAcrossDST(t *testing.T) {
+	berlin := mustLocation(t, "Europe/Berlin")
+	s := Schedule{Location: berlin, Hour: 20, Minute: 0}
+
+	// Clocks go forward on 2025-03-30; 20:00 local moves from 19:00 to 18:00 UTC.
+	got := s.Next(time.Date(2025, 3, 29, 19, 30, 0, 0, time.UTC))
+	want := time.Date(2025, 3, 30, 18, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Fatalf("expected %s, got %s", want, got.UTC())
+	}
+}
+
+func TestNextHonoursWeekdays(t *testing.T) {
+	days, err := ParseWeekdays([]string{"Mon", "thu", "mon"})
+	if err != nil {
+		t.Fatalf("parse weekdays: %v", err)
+	}
+	if len(days) != 2 {
+		t.Fatalf("expected duplicates to be dropped, got %v", days)
+	}
+
+	s := Schedule{Location: time.UTC, Hour: 8, Minute: 30, Weekdays: days}
+	// 2025-01-02 is a Thursday; its slot has passed, so the next is Monday.
+	got := s.Next(time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC))
+	want := time.Date(2025, 1, 6, 8, 30, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+
+	if _, err := ParseWeekdays([]string{"funday"}); err == nil {
+		t.Fatalf("expected error for unknown weekday")
+	}
+}
+
+func TestParseClockAndLocation(t *testing.T) {
+	if h, m, err := ParseClock("07:05"); err != nil || h != 7 || m != 5 {
+		t.Fatalf("expected 07:05, got %d:%d (%v)", h, m, err)
+	}
+	if _, _, err := ParseClock("25:00"); err == nil {
+		t.Fatalf("expected error for invalid clock")
+	}
+	if _, err := LoadLocation("Local"); err == nil {
+		t.Fatalf("expected server-local zone to be rejected")
+	}
+}
+
+func TestNudgeDue(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	active := now.AddDate(0, 0, -2)
+	quiet := now.AddDate(0, 0, -5)
+
+	if NudgeDue(active, nil, 3, now) {
+		t.Fatalf("recently active patient should not be nudged")
+	}
+	if !NudgeDue(quiet, nil, 3, now) {
+		t.Fatalf("quiet patient should be nudged")
+	}
+
+	recent := now.AddDate(0, 0, -1)
+	if NudgeDue(quiet, &recent, 3, now) {
+		t.Fatalf("patient nudged yesterday should not be nudged again")
+	}
+	old := now.AddDate(0, 0, -3)
+	if !NudgeDue(quiet, &old, 3, now) {
+		t.Fatalf("expected a repeat nudge after another period")
+	}
+}
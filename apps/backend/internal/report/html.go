@@ -0,0 +1,88 @@
+package report
+
+import (
+	"embed"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+)
+
+//go:embed templates/report.html.tmpl
+var templateFS embed.FS
+
+var htmlTemplate = template.Must(
+	template.New("report.html.tmpl").
+		Funcs(template.FuncMap{
+			"date":     func(t time.Time) string { return t.Format("2006-01-02") },
+			"datetime": func(t time.Time) string { return t.Format("2006-01-02 15:04") },
+			"join":     func(items []string) string { return strings.Join(items, ", ") },
+			"rating":   formatRating,
+			"avg":      formatAverage,
+		}).
+		ParseFS(templateFS, "templates/report.html.tmpl"),
+)
+
+// RenderHTML writes the report as a self-contained HTML document.
+func RenderHTML(w io.Writer, r Report) error {
+	return htmlTemplate.Execute(w, htmlView{Report: r, Chart: buildChart(r.Trend)})
+}
+
+type htmlView struct {
+	Report
+	Chart chart
+}
+
+// chart is a pre-computed bar chart so the template only places rectangles.
+type chart struct {
+	Width  int
+	Height int
+	Bars   []chartBar
+}
+
+type chartBar struct {
+	X, Y, W, H float64
+	Label      string
+	Value      string
+}
+
+const (
+	chartWidth  = 640
+	chartHeight = 160
+)
+
+func buildChart(points []TrendPoint) chart {
+	c := chart{Width: chartWidth, Height: chartHeight}
+	if len(points) == 0 {
+		return c
+	}
+
+	slot := float64(chartWidth) / float64(len(points))
+	for i, p := range points {
+		h := p.AvgStutterRating / maxRating * (chartHeight - 20)
+		c.Bars = append(c.Bars, chartBar{
+			X:     float64(i)*slot + slot*0.15,
+			Y:     chartHeight - 20 - h,
+			W:     slot * 0.7,
+			H:     h,
+			Label: p.Label,
+			Value: fmt.Sprintf("%.1f", p.AvgStutterRating),
+		})
+	}
+	return c
+}
+
+func formatRating(v *int) string {
+	if v == nil {
+		return "–"
+	}
+	return fmt.Sprintf("%d/%d", *v, maxRating)
+}
+
+func formatAverage(v *float64) string {
+	if v == nil {
+		return "–"
+	}
+	return fmt.Sprintf("%.1f/%d", *v, maxRating)
+}
@@ -0,0 +1,206 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+)
+
+const (
+	pdfMargin     = 15.0
+	pdfLineHeight = 5.0
+	pdfChartH     = 40.0
+)
+
+// RenderPDF writes the report as an A4 PDF using the built-in core fonts.
+func RenderPDF(w io.Writer, r Report) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(true, pdfMargin)
+	pdf.SetTitle(r.Title(), true)
+	pdf.SetCreator("Eloquia", true)
+
+	// Core fonts are cp1252; translate so umlauts and dashes survive.
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pageWidth, _ := pdf.GetPageSize()
+	contentWidth := pageWidth - 2*pdfMargin
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-pdfMargin + 5)
+		pdf.SetFont("Helvetica", "I", 8)
+		pdf.SetTextColor(150, 150, 150)
+		pdf.CellFormat(0, 4, tr(fmt.Sprintf("Generated %s UTC from self-reported journal data. Page %d",
+			r.GeneratedAt.Format("2006-01-02 15:04"), pdf.PageNo())), "", 0, "C", false, 0, "")
+	})
+	pdf.AddPage()
+
+	// Practice branding header.
+	if r.Practice.Name != "" {
+		pdf.SetFont("Helvetica", "B", 14)
+		pdf.SetTextColor(31, 41, 51)
+		pdf.CellFormat(contentWidth, 7, tr(r.Practice.Name), "", 1, "L", false, 0, "")
+	}
+	if r.Practice.Address != "" {
+		pdf.SetFont("Helvetica", "", 9)
+		pdf.SetTextColor(82, 96, 109)
+		pdf.CellFormat(contentWidth, pdfLineHeight, tr(r.Practice.Address), "", 1, "L", false, 0, "")
+	}
+	pdf.SetDrawColor(125, 86, 244)
+	pdf.SetLineWidth(0.6)
+	pdf.Line(pdfMargin, pdf.GetY()+2, pageWidth-pdfMargin, pdf.GetY()+2)
+	pdf.Ln(6)
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.SetTextColor(31, 41, 51)
+	pdf.CellFormat(contentWidth, 8, tr(r.Title()), "", 1, "L", false, 0, "")
+	pdf.Ln(2)
+
+	meta := [][2]string{{"Patient", r.Patient.DisplayName}}
+	if r.Patient.Code != "" {
+		meta[0][1] += " (" + r.Patient.Code + ")"
+	}
+	if !r.Patient.BirthDate.IsZero() {
+		meta = append(meta, [2]string{"Date of birth", r.Patient.BirthDate.Format("2006-01-02")})
+	}
+	meta = append(meta, [2]string{"Period", r.Period()})
+	if r.DoctorName != "" {
+		meta = append(meta, [2]string{"Therapist", r.DoctorName})
+	}
+	meta = append(meta,
+		[2]string{"Journal entries", fmt.Sprintf("%d", r.Summary.Entries)},
+		[2]string{"Average stutter frequency", fmt.Sprintf("%s (%d ratings)", formatAverage(r.Summary.AvgStutterFrequency), r.Summary.Ratings)},
+	)
+	for _, row := range meta {
+		pdf.SetFont("Helvetica", "", 10)
+		pdf.SetTextColor(82, 96, 109)
+		pdf.CellFormat(55, pdfLineHeight+1, tr(row[0]), "", 0, "L", false, 0, "")
+		pdf.SetTextColor(31, 41, 51)
+		pdf.CellFormat(contentWidth-55, pdfLineHeight+1, tr(row[1]), "", 1, "L", false, 0, "")
+	}
+
+	sectionHeading(pdf, tr, contentWidth, fmt.Sprintf("Stutter frequency trend (%s)", r.TrendBucket))
+	drawTrendChart(pdf, tr, r.Trend, contentWidth)
+
+	sectionHeading(pdf, tr, contentWidth, "Distributions")
+	drawDistributions(pdf, tr, contentWidth, []string{"Emotions", "Triggers", "Techniques"}, [][]Count{r.Emotions, r.Triggers, r.Techniques})
+
+	sectionHeading(pdf, tr, contentWidth, "Journal entries")
+	if len(r.Entries) == 0 {
+		mutedLine(pdf, tr, contentWidth, "No entries in this period.")
+	}
+	for _, e := range r.Entries {
+		pdf.SetFont("Helvetica", "B", 10)
+		pdf.SetTextColor(31, 41, 51)
+		heading := e.HappenedAt.Format("2006-01-02 15:04") + "  ·  " + formatRating(e.StutterFrequency)
+		if e.Situation != "" {
+			heading += "  ·  " + e.Situation
+		}
+		pdf.MultiCell(contentWidth, pdfLineHeight, tr(heading), "", "L", false)
+
+		pdf.SetFont("Helvetica", "", 9)
+		details := []string{}
+		if len(e.Emotions) > 0 {
+			details = append(details, "Emotions: "+strings.Join(e.Emotions, ", "))
+		}
+		if len(e.Triggers) > 0 {
+			details = append(details, "Triggers: "+strings.Join(e.Triggers, ", "))
+		}
+		if len(e.Techniques) > 0 {
+			details = append(details, "Techniques: "+strings.Join(e.Techniques, ", "))
+		}
+		if len(details) > 0 {
+			pdf.MultiCell(contentWidth, pdfLineHeight, tr(strings.Join(details, "   ")), "", "L", false)
+		}
+		if e.Notes != "" {
+			pdf.MultiCell(contentWidth, pdfLineHeight, tr(e.Notes), "", "L", false)
+		}
+		pdf.SetFont("Helvetica", "I", 9)
+		pdf.SetTextColor(51, 78, 104)
+		for _, c := range e.Comments {
+			pdf.SetX(pdfMargin + 5)
+			pdf.MultiCell(contentWidth-5, pdfLineHeight,
+				tr(fmt.Sprintf("%s (%s): %s", c.Author, c.CreatedAt.Format("2006-01-02"), c.Body)), "", "L", false)
+		}
+		pdf.Ln(2)
+	}
+
+	return pdf.Output(w)
+}
+
+func sectionHeading(pdf *fpdf.Fpdf, tr func(string) string, width float64, title string) {
+	pdf.Ln(4)
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.SetTextColor(31, 41, 51)
+	pdf.CellFormat(width, 7, tr(title), "B", 1, "L", false, 0, "")
+	pdf.Ln(2)
+}
+
+func mutedLine(pdf *fpdf.Fpdf, tr func(string) string, width float64, text string) {
+	pdf.SetFont("Helvetica", "I", 9)
+	pdf.SetTextColor(154, 165, 177)
+	pdf.CellFormat(width, pdfLineHeight, tr(text), "", 1, "L", false, 0, "")
+}
+
+func drawTrendChart(pdf *fpdf.Fpdf, tr func(string) string, points []TrendPoint, width float64) {
+	if len(points) == 0 {
+		mutedLine(pdf, tr, width, "No stutter frequency ratings in this period.")
+		return
+	}
+
+	if pdf.GetY()+pdfChartH+8 > 297-pdfMargin {
+		pdf.AddPage()
+	}
+
+	top := pdf.GetY()
+	slot := width / float64(len(points))
+	pdf.SetFillColor(125, 86, 244)
+	pdf.SetFont("Helvetica", "", 6)
+	pdf.SetTextColor(82, 96, 109)
+	labelEvery := 1 + len(points)/12
+
+	for i, p := range points {
+		h := p.AvgStutterRating / maxRating * pdfChartH
+		x := pdfMargin + float64(i)*slot + slot*0.15
+		pdf.Rect(x, top+pdfChartH-h, slot*0.7, h, "F")
+		if i%labelEvery == 0 {
+			pdf.Text(x, top+pdfChartH+3, tr(p.Label))
+		}
+	}
+
+	pdf.SetDrawColor(203, 210, 217)
+	pdf.SetLineWidth(0.2)
+	pdf.Line(pdfMargin, top+pdfChartH, pdfMargin+width, top+pdfChartH)
+	pdf.SetY(top + pdfChartH + 6)
+}
+
+func drawDistributions(pdf *fpdf.Fpdf, tr func(string) string, width float64, titles []string, columns [][]Count) {
+	colWidth := width / float64(len(columns))
+	top := pdf.GetY()
+	bottom := top
+
+	for i, counts := range columns {
+		x := pdfMargin + float64(i)*colWidth
+		pdf.SetXY(x, top)
+		pdf.SetFont("Helvetica", "B", 10)
+		pdf.SetTextColor(31, 41, 51)
+		pdf.CellFormat(colWidth, pdfLineHeight+1, tr(titles[i]), "", 2, "L", false, 0, "")
+
+		pdf.SetFont("Helvetica", "", 9)
+		if len(counts) == 0 {
+			pdf.SetTextColor(154, 165, 177)
+			pdf.CellFormat(colWidth, pdfLineHeight, tr("None recorded"), "", 2, "L", false, 0, "")
+		}
+		pdf.SetTextColor(31, 41, 51)
+		for _, c := range counts {
+			pdf.CellFormat(colWidth-12, pdfLineHeight, tr(c.Name), "", 0, "L", false, 0, "")
+			pdf.CellFormat(10, pdfLineHeight, fmt.Sprintf("%d", c.Count), "", 2, "R", false, 0, "")
+			pdf.SetX(x)
+		}
+		if pdf.GetY() > bottom {
+			bottom = pdf.GetY()
+		}
+	}
+	pdf.SetXY(pdfMargin, bottom)
+}
@@ -0,0 +1,102 @@
+// Package report renders clinical journal summaries as HTML or PDF.
+//
+// Rendering is done entirely in-process (html/template and fpdf) so reports
+// can be generated without calling external services.
+package report
+
+import (
+	"sort"
+	"time"
+)
+
+// Report is the data rendered into a clinical summary.
+type Report struct {
+	Practice    Branding
+	DoctorName  string
+	Patient     Patient
+	From        time.Time
+	To          time.Time
+	GeneratedAt time.Time
+
+	Summary     Summary
+	Emotions    []Count
+	Triggers    []Count
+	Techniques  []Count
+	TrendBucket string
+	Trend       []TrendPoint
+	Entries     []Entry
+}
+
+// Branding identifies the practice issuing the report.
+type Branding struct {
+	Name    string
+	Address string
+}
+
+type Patient struct {
+	DisplayName string
+	Code        string
+	BirthDate   time.Time
+}
+
+type Summary struct {
+	Entries             int
+	Ratings             int
+	AvgStutterFrequency *float64
+}
+
+type Count struct {
+	Name  string
+	Count int
+}
+
+type TrendPoint struct {
+	Label            string
+	AvgStutterRating float64
+	Count            int
+}
+
+type Entry struct {
+	HappenedAt       time.Time
+	Situation        string
+	StutterFrequency *int
+	Emotions         []string
+	Triggers         []string
+	Techniques       []string
+	Notes            string
+	Comments         []Comment
+}
+
+type Comment struct {
+	Author    string
+	Body      string
+	CreatedAt time.Time
+}
+
+// Title is the document title used by both renderers.
+func (r Report) Title() string {
+	return "Clinical journal summary"
+}
+
+// Period formats the reporting window.
+func (r Report) Period() string {
+	return r.From.Format("2006-01-02") + " – " + r.To.Format("2006-01-02")
+}
+
+// SortedCounts converts a distribution map into counts ordered by frequency.
+func SortedCounts(counts map[string]int) []Count {
+	out := make([]Count, 0, len(counts))
+	for name, count := range counts {
+		out = append(out, Count{Name: name, Count: count})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Count != out[j].Count {
+			return out[i].Count > out[j].Count
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+// maxRating is the upper bound of the self-rated stutter frequency scale.
+const maxRating = 10
@@ -0,0 +1,69 @@
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func sampleReport() Report {
+	rating := 4
+	avg := 4.5
+	day := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	return Report{
+		Practice:    Branding{Name: "Praxis Müller", Address: "Hauptstraße 1"},
+		DoctorName:  "Dr. Test",
+		Patient:     Patient{DisplayName: "Pat <One>", Code: "P-1"},
+		From:        day.AddDate(0, 0, -7),
+		To:          day,
+		GeneratedAt: day,
+		Summary:     Summary{Entries: 1, Ratings: 1, AvgStutterFrequency: &avg},
+		Triggers:    SortedCounts(map[string]int{"phone": 2, "meeting": 3}),
+		TrendBucket: "day",
+		Trend:       []TrendPoint{{Label: "2026-03-02", AvgStutterRating: 4.5, Count: 2}},
+		Entries: []Entry{{
+			HappenedAt:       day,
+			Situation:        "Phone call",
+			StutterFrequency: &rating,
+			Triggers:         []string{"phone"},
+			Notes:            "Used easy onset",
+			Comments:         []Comment{{Author: "Dr. Test", Body: "Great progress", CreatedAt: day}},
+		}},
+	}
+}
+
+func TestRenderHTML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := RenderHTML(&buf, sampleReport()); err != nil {
+		t.Fatalf("render html: %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{"Praxis Müller", "Pat &lt;One&gt;", "Great progress", "<rect", "4.5/10"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected HTML to contain %q", want)
+		}
+	}
+	if strings.Contains(out, "Pat <One>") {
+		t.Fatalf("expected patient name to be escaped")
+	}
+}
+
+func TestRenderPDF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := RenderPDF(&buf, sampleReport()); err != nil {
+		t.Fatalf("render pdf: %v", err)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")) {
+		t.Fatalf("expected PDF header, got %q", buf.Bytes()[:8])
+	}
+}
+
+func TestSortedCounts(t *testing.T) {
+	got := SortedCounts(map[string]int{"b": 1, "a": 1, "c": 5})
+	if got[0].Name != "c" || got[1].Name != "a" || got[2].Name != "b" {
+		t.Fatalf("unexpected order: %+v", got)
+	}
+}
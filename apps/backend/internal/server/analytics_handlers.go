@@ -1,12 +1,10 @@
 package server
 
 import (
+	"context"
 	"net/http"
-	"sort"
 	"time"
 
-	"backend/ent/entry"
-
 	"github.com/charmbracelet/log"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
@@ -14,8 +12,11 @@ import (
 
 type analyticsResponse struct {
 	RangeDays     int                    `json:"rangeDays"`
+	Bucket        string                 `json:"bucket"`
 	Distributions analyticsDistributions `json:"distributions"`
 	Trend         []trendPoint           `json:"trend"`
+	// Assessments charts questionnaire scale scores submitted within the range.
+	Assessments []assessmentSeriesDTO `json:"assessments"`
 }
 
 type analyticsDistributions struct {
@@ -30,6 +31,21 @@ type trendPoint struct {
 	Count            int     `json:"count"`
 }
 
+// analyticsOptions are the query options shared by doctor analytics and patient insights.
+type analyticsOptions struct {
+	RangeDays int
+	Bucket    string
+}
+
+func (o analyticsOptions) scope(patientID uuid.UUID) analyticsScope {
+	to := time.Now().UTC()
+	return analyticsScope{
+		PatientIDs: []uuid.UUID{patientID},
+		From:       to.AddDate(0, 0, -o.RangeDays),
+		To:         to,
+	}
+}
+
 // analyticsHandler computes distributions and stutter trend for a patient.
 // @Summary Patient analytics (distributions and stutter trend)
 // @Tags Analytics
@@ -37,6 +53,7 @@ type trendPoint struct {
 // @Security SessionCookie
 // @Param id path string true "Patient ID"
 // @Param range query string false "Range in days (7, 30, or 90). Defaults to 7."
+// @Param bucket query string false "Trend bucket (day, week, or month). Defaults to day."
 // @Success 200 {object} AnalyticsResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
@@ -61,77 +78,50 @@ func (s *Server) analyticsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	rangeDays := parseRangeDays(r.URL.Query().Get("range"))
-	to := time.Now().UTC()
-	from := to.AddDate(0, 0, -rangeDays)
-
-	entries, err := s.Db.Ent().Entry.Query().
-		Where(
-			entry.PatientIDEQ(patientID),
-			entry.HappenedAtGTE(from),
-			entry.HappenedAtLTE(to),
-		).
-		All(r.Context())
+	resp, err := s.buildAnalytics(r.Context(), patientID, parseAnalyticsOptions(r))
 	if err != nil {
-		log.Error("failed to fetch entries for analytics", "err", err)
+		log.FromContext(r.Context()).Error("failed to aggregate analytics", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not compute analytics")
 		return
 	}
 
-	resp := analyticsResponse{
-		RangeDays: rangeDays,
-		Distributions: analyticsDistributions{
-			Emotions:   map[string]int{},
-			Triggers:   map[string]int{},
-			Techniques: map[string]int{},
-		},
-		Trend: []trendPoint{},
-	}
+	s.writeJSON(w, http.StatusOK, resp)
+}
+
+// buildAnalytics aggregates distributions, the stutter trend and questionnaire
+// scores for one patient.
+func (s *Server) buildAnalytics(ctx context.Context, patientID uuid.UUID, opts analyticsOptions) (analyticsResponse, error) {
+	scope := opts.scope(patientID)
 
-	trendMap := map[string][]int{}
-
-	for _, e := range entries {
-		dateKey := e.HappenedAt.UTC().Format("2006-01-02")
-
-		for _, emo := range e.Emotions {
-			key := emo.Name
-			resp.Distributions.Emotions[key]++
-		}
-		for _, trig := range e.Triggers {
-			resp.Distributions.Triggers[trig]++
-		}
-		for _, tech := range e.Techniques {
-			resp.Distributions.Techniques[tech]++
-		}
-
-		if e.StutterFrequency != nil {
-			trendMap[dateKey] = append(trendMap[dateKey], *e.StutterFrequency)
-		}
+	distributions, err := s.queryDistributions(ctx, scope)
+	if err != nil {
+		return analyticsResponse{}, err
 	}
 
-	dates := make([]string, 0, len(trendMap))
-	for d := range trendMap {
-		dates = append(dates, d)
+	trend, err := s.queryTrend(ctx, scope, opts.Bucket)
+	if err != nil {
+		return analyticsResponse{}, err
 	}
-	sort.Strings(dates)
-
-	for _, d := range dates {
-		points := trendMap[d]
-		if len(points) == 0 {
-			continue
-		}
-		sum := 0
-		for _, v := range points {
-			sum += v
-		}
-		resp.Trend = append(resp.Trend, trendPoint{
-			Date:             d,
-			AvgStutterRating: float64(sum) / float64(len(points)),
-			Count:            len(points),
-		})
+
+	assessments, err := s.queryAssessmentSeries(ctx, patientID, scope.From, scope.To, "")
+	if err != nil {
+		return analyticsResponse{}, err
 	}
 
-	s.writeJSON(w, http.StatusOK, resp)
+	return analyticsResponse{
+		RangeDays:     opts.RangeDays,
+		Bucket:        opts.Bucket,
+		Distributions: distributions,
+		Trend:         trend,
+		Assessments:   assessments,
+	}, nil
+}
+
+func parseAnalyticsOptions(r *http.Request) analyticsOptions {
+	return analyticsOptions{
+		RangeDays: parseRangeDays(r.URL.Query().Get("range")),
+		Bucket:    parseBucket(r.URL.Query().Get("bucket")),
+	}
 }
 
 func parseRangeDays(raw string) int {
@@ -144,3 +134,12 @@ func parseRangeDays(raw string) int {
 		return 7
 	}
 }
+
+func parseBucket(raw string) string {
+	switch raw {
+	case "week", "month":
+		return raw
+	default:
+		return "day"
+	}
+}
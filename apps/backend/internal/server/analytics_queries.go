@@ -0,0 +1,278 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// analyticsScope selects the entries an aggregate runs over: every entry of the
+// given patients with happened_at inside [From, To].
+type analyticsScope struct {
+	PatientIDs []uuid.UUID
+	From       time.Time
+	To         time.Time
+}
+
+// scopeWhere filters the entries table (aliased as "e") to the scope. It always
+// binds $1..$3, so callers append their own parameters from $4 onwards.
+const scopeWhere = `e.patient_id = ANY($1::uuid[]) AND e.happened_at >= $2 AND e.happened_at <= $3`
+
+// jsonArray guards jsonb_array_elements against NULL or non-array values; ent
+// stores a nil slice as JSON null.
+func jsonArray(column string) string {
+	return fmt.Sprintf("CASE WHEN jsonb_typeof(%[1]s) = 'array' THEN %[1]s ELSE '[]'::jsonb END", column)
+}
+
+func (a analyticsScope) args() []any {
+	ids := make([]string, 0, len(a.PatientIDs))
+	for _, id := range a.PatientIDs {
+		ids = append(ids, id.String())
+	}
+	return []any{ids, a.From, a.To}
+}
+
+var distributionsQuery = `
+SELECT 'emotions', COALESCE(item->>'name', ''), count(*)
+FROM entries e
+CROSS JOIN LATERAL jsonb_array_elements(` + jsonArray("e.emotions") + `) AS item
+WHERE ` + scopeWhere + `
+GROUP BY 2
+UNION ALL
+SELECT 'triggers', item, count(*)
+FROM entries e
+CROSS JOIN LATERAL jsonb_array_elements_text(` + jsonArray("e.triggers") + `) AS item
+WHERE ` + scopeWhere + `
+GROUP BY 2
+UNION ALL
+SELECT 'techniques', item, count(*)
+FROM entries e
+CROSS JOIN LATERAL jsonb_array_elements_text(` + jsonArray("e.techniques") + `) AS item
+WHERE ` + scopeWhere + `
+GROUP BY 2`
+
+// queryDistributions counts emotions, triggers and techniques in Postgres.
+func (s *Server) queryDistributions(ctx context.Context, scope analyticsScope) (analyticsDistributions, error) {
+	dist := analyticsDistributions{
+		Emotions:   map[string]int{},
+		Triggers:   map[string]int{},
+		Techniques: map[string]int{},
+	}
+	if len(scope.PatientIDs) == 0 {
+		return dist, nil
+	}
+
+	rows, err := s.Db.Ent().QueryContext(ctx, distributionsQuery, scope.args()...)
+	if err != nil {
+		return dist, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			kind, name string
+			count      int
+		)
+		if err := rows.Scan(&kind, &name, &count); err != nil {
+			return dist, err
+		}
+		switch kind {
+		case "emotions":
+			dist.Emotions[name] = count
+		case "triggers":
+			dist.Triggers[name] = count
+		case "techniques":
+			dist.Techniques[name] = count
+		}
+	}
+	return dist, rows.Err()
+}
+
+var trendQuery = `
+SELECT to_char(date_trunc($4, e.happened_at AT TIME ZONE 'UTC'), 'YYYY-MM-DD'),
+       avg(e.stutter_frequency)::float8,
+       count(*)
+FROM entries e
+WHERE ` + scopeWhere + ` AND e.stutter_frequency IS NOT NULL
+GROUP BY 1
+ORDER BY 1`
+
+// queryTrend averages the self-rated stutter frequency per UTC day, week or month.
+// Points are labelled with the first day of their bucket.
+func (s *Server) queryTrend(ctx context.Context, scope analyticsScope, bucket string) ([]trendPoint, error) {
+	points := []trendPoint{}
+	if len(scope.PatientIDs) == 0 {
+		return points, nil
+	}
+
+	rows, err := s.Db.Ent().QueryContext(ctx, trendQuery, append(scope.args(), bucket)...)
+	if err != nil {
+		return points, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var p trendPoint
+		if err := rows.Scan(&p.Date, &p.AvgStutterRating, &p.Count); err != nil {
+			return points, err
+		}
+		points = append(points, p)
+	}
+	return points, rows.Err()
+}
+
+// patientActivity summarises one patient's entries inside a scope.
+type patientActivity struct {
+	PatientID uuid.UUID
+	Entries   int
+	Ratings   int
+	// Slope is the least-squares change in stutter frequency per day; nil with
+	// fewer than two ratings.
+	Slope *float64
+}
+
+var patientActivityQuery = `
+SELECT e.patient_id::text,
+       count(*),
+       count(e.stutter_frequency),
+       regr_slope(e.stutter_frequency::float8, (extract(epoch FROM e.happened_at) / 86400)::float8)
+FROM entries e
+WHERE ` + scopeWhere + `
+GROUP BY e.patient_id`
+
+// queryPatientActivity returns per-patient entry counts and stutter trend slopes.
+func (s *Server) queryPatientActivity(ctx context.Context, scope analyticsScope) (map[uuid.UUID]patientActivity, error) {
+	out := make(map[uuid.UUID]patientActivity, len(scope.PatientIDs))
+	if len(scope.PatientIDs) == 0 {
+		return out, nil
+	}
+
+	rows, err := s.Db.Ent().QueryContext(ctx, patientActivityQuery, scope.args()...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			rawID string
+			a     patientActivity
+			slope sql.NullFloat64
+		)
+		if err := rows.Scan(&rawID, &a.Entries, &a.Ratings, &slope); err != nil {
+			return nil, err
+		}
+		id, err := uuid.Parse(rawID)
+		if err != nil {
+			return nil, err
+		}
+		a.PatientID = id
+		if slope.Valid {
+			a.Slope = &slope.Float64
+		}
+		out[id] = a
+	}
+	return out, rows.Err()
+}
+
+const lastEntryQuery = `
+SELECT e.patient_id::text, max(e.happened_at)
+FROM entries e
+WHERE e.patient_id = ANY($1::uuid[])
+GROUP BY e.patient_id`
+
+// queryLastEntries returns the most recent happened_at per patient regardless of range.
+func (s *Server) queryLastEntries(ctx context.Context, patientIDs []uuid.UUID) (map[uuid.UUID]time.Time, error) {
+	out := make(map[uuid.UUID]time.Time, len(patientIDs))
+	if len(patientIDs) == 0 {
+		return out, nil
+	}
+
+	rows, err := s.Db.Ent().QueryContext(ctx, lastEntryQuery, analyticsScope{PatientIDs: patientIDs}.args()[0])
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			rawID string
+			last  time.Time
+		)
+		if err := rows.Scan(&rawID, &last); err != nil {
+			return nil, err
+		}
+		id, err := uuid.Parse(rawID)
+		if err != nil {
+			return nil, err
+		}
+		out[id] = last
+	}
+	return out, rows.Err()
+}
+
+var weeklySummaryQuery = `
+SELECT to_char(date_trunc('week', e.happened_at AT TIME ZONE 'UTC'), 'YYYY-MM-DD'),
+       count(*),
+       avg(e.stutter_frequency)::float8
+FROM entries e
+WHERE ` + scopeWhere + `
+GROUP BY 1
+ORDER BY 1`
+
+// queryWeeklySummaries counts entries and averages ratings per ISO week (Monday start).
+func (s *Server) queryWeeklySummaries(ctx context.Context, scope analyticsScope) ([]weeklySummary, error) {
+	out := []weeklySummary{}
+	if len(scope.PatientIDs) == 0 {
+		return out, nil
+	}
+
+	rows, err := s.Db.Ent().QueryContext(ctx, weeklySummaryQuery, scope.args()...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			week weeklySummary
+			avg  sql.NullFloat64
+		)
+		if err := rows.Scan(&week.WeekStart, &week.Entries, &avg); err != nil {
+			return nil, err
+		}
+		if avg.Valid {
+			week.AvgStutterFrequency = &avg.Float64
+		}
+		out = append(out, week)
+	}
+	return out, rows.Err()
+}
+
+const entryDaysQuery = `
+SELECT DISTINCT (e.happened_at AT TIME ZONE 'UTC')::date
+FROM entries e
+WHERE e.patient_id = $1
+ORDER BY 1`
+
+// queryEntryDays lists every UTC calendar day on which the patient journaled.
+func (s *Server) queryEntryDays(ctx context.Context, patientID uuid.UUID) ([]time.Time, error) {
+	rows, err := s.Db.Ent().QueryContext(ctx, entryDaysQuery, patientID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var days []time.Time
+	for rows.Next() {
+		var day time.Time
+		if err := rows.Scan(&day); err != nil {
+			return nil, err
+		}
+		days = append(days, day)
+	}
+	return days, rows.Err()
+}
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"backend/ent"
+	"backend/ent/assignment"
+
+	"github.com/google/uuid"
+)
+
+const (
+	assignmentCompletionPending   = "pending"
+	assignmentCompletionCompleted = "completed"
+	assignmentCompletionOverdue   = "overdue"
+	assignmentCompletionOnTrack   = "on_track"
+	assignmentCompletionBehind    = "behind"
+	assignmentCompletionArchived  = "archived"
+)
+
+type assignmentCompletionDTO struct {
+	Status           string     `json:"status"`
+	Logs             int        `json:"logs"`
+	PeriodsCompleted int        `json:"periodsCompleted"`
+	PeriodsExpected  int        `json:"periodsExpected"`
+	Percent          float64    `json:"percent"`
+	LastCompletedAt  *time.Time `json:"lastCompletedAt,omitempty"`
+}
+
+// assignmentLogStats holds per-assignment log counts; days and weeks count
+// distinct calendar periods with at least one completion.
+type assignmentLogStats struct {
+	Logs  int
+	Days  int
+	Weeks int
+	Last  *time.Time
+}
+
+const assignmentLogStatsQuery = `
+SELECT l.assignment_id, count(*),
+	count(DISTINCT date_trunc('day', l.completed_at AT TIME ZONE 'UTC')),
+	count(DISTINCT date_trunc('week', l.completed_at AT TIME ZONE 'UTC')),
+	max(l.completed_at)
+FROM exercise_logs l
+WHERE l.assignment_id = ANY($1::uuid[])
+GROUP BY 1`
+
+func (s *Server) assignmentLogStats(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]assignmentLogStats, error) {
+	stats := make(map[uuid.UUID]assignmentLogStats, len(ids))
+	if len(ids) == 0 {
+		return stats, nil
+	}
+
+	raw := make([]string, len(ids))
+	for i, id := range ids {
+		raw[i] = id.String()
+	}
+
+	rows, err := s.Db.Ent().QueryContext(ctx, assignmentLogStatsQuery, raw)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			id   uuid.UUID
+			st   assignmentLogStats
+			last time.Time
+		)
+		if err := rows.Scan(&id, &st.Logs, &st.Days, &st.Weeks, &last); err != nil {
+			return nil, err
+		}
+		last = last.UTC()
+		st.Last = &last
+		stats[id] = st
+	}
+	return stats, rows.Err()
+}
+
+// assignmentCompletion compares logged completions with what the recurrence
+// expects between the assignment's creation and its due date (or now).
+func assignmentCompletion(a *ent.Assignment, st assignmentLogStats, now time.Time) assignmentCompletionDTO {
+	now = now.UTC()
+	c := assignmentCompletionDTO{Logs: st.Logs, LastCompletedAt: st.Last}
+
+	end := now
+	pastDue := a.DueAt != nil && a.DueAt.Before(now)
+	if pastDue {
+		end = a.DueAt.UTC()
+	}
+
+	switch a.Recurrence {
+	case assignment.RecurrenceDaily:
+		c.PeriodsExpected = periodsBetween(truncateDay(a.CreatedAt.UTC()), end, 24*time.Hour)
+		c.PeriodsCompleted = st.Days
+	case assignment.RecurrenceWeekly:
+		c.PeriodsExpected = periodsBetween(truncateWeek(a.CreatedAt.UTC()), end, 7*24*time.Hour)
+		c.PeriodsCompleted = st.Weeks
+	default:
+		c.PeriodsExpected = 1
+		c.PeriodsCompleted = min(st.Logs, 1)
+	}
+	c.PeriodsCompleted = min(c.PeriodsCompleted, c.PeriodsExpected)
+	c.Percent = math.Round(float64(c.PeriodsCompleted)/float64(c.PeriodsExpected)*1000) / 10
+
+	done := c.PeriodsCompleted >= c.PeriodsExpected
+	switch {
+	case a.Status == assignment.StatusArchived:
+		c.Status = assignmentCompletionArchived
+	case a.Recurrence == assignment.RecurrenceNone && done:
+		c.Status = assignmentCompletionCompleted
+	case a.Recurrence == assignment.RecurrenceNone && pastDue:
+		c.Status = assignmentCompletionOverdue
+	case a.Recurrence == assignment.RecurrenceNone:
+		c.Status = assignmentCompletionPending
+	case pastDue && done:
+		c.Status = assignmentCompletionCompleted
+	case pastDue:
+		c.Status = assignmentCompletionOverdue
+	// The current period may still be completed later.
+	case c.PeriodsCompleted >= c.PeriodsExpected-1:
+		c.Status = assignmentCompletionOnTrack
+	default:
+		c.Status = assignmentCompletionBehind
+	}
+	return c
+}
+
+// periodsBetween counts the periods of length step starting at start that
+// begin at or before end; it is at least 1.
+func periodsBetween(start, end time.Time, step time.Duration) int {
+	if end.Before(start) {
+		return 1
+	}
+	return int(end.Sub(start)/step) + 1
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// truncateWeek returns the Monday starting t's week, matching date_trunc('week').
+func truncateWeek(t time.Time) time.Time {
+	day := truncateDay(t)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
@@ -0,0 +1,737 @@
+package server
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"backend/ent"
+	"backend/ent/assignment"
+	"backend/ent/entry"
+	"backend/ent/vocabularyterm"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	attachmentUploadTTL   = 15 * time.Minute
+	attachmentDownloadTTL = time.Hour
+
+	// homeworkTag marks entries created from an exercise log.
+	homeworkTag = "homework"
+)
+
+type assignmentDTO struct {
+	ID                    string                  `json:"id"`
+	PatientID             string                  `json:"patientId"`
+	DoctorID              string                  `json:"doctorId"`
+	Title                 string                  `json:"title"`
+	Instructions          *string                 `json:"instructions,omitempty"`
+	DueAt                 *time.Time              `json:"dueAt,omitempty"`
+	Recurrence            string                  `json:"recurrence"`
+	Status                string                  `json:"status"`
+	HasAttachment         bool                    `json:"hasAttachment"`
+	AttachmentContentType *string                 `json:"attachmentContentType,omitempty"`
+	AttachmentURL         *string                 `json:"attachmentUrl,omitempty"`
+	CreatedAt             time.Time               `json:"createdAt"`
+	UpdatedAt             time.Time               `json:"updatedAt"`
+	Completion            assignmentCompletionDTO `json:"completion"`
+}
+
+type assignmentsResponse struct {
+	Assignments []assignmentDTO `json:"assignments"`
+}
+
+type assignmentOverviewResponse struct {
+	PatientID        string          `json:"patientId"`
+	Active           int             `json:"active"`
+	Completed        int             `json:"completed"`
+	Overdue          int             `json:"overdue"`
+	Behind           int             `json:"behind"`
+	PeriodsCompleted int             `json:"periodsCompleted"`
+	PeriodsExpected  int             `json:"periodsExpected"`
+	Percent          float64         `json:"percent"`
+	Assignments      []assignmentDTO `json:"assignments"`
+}
+
+type assignmentCreateRequest struct {
+	Title        string     `json:"title" validate:"notblank,max=200"`
+	Instructions *string    `json:"instructions,omitempty"`
+	DueAt        *time.Time `json:"dueAt,omitempty"`
+	Recurrence   string     `json:"recurrence,omitempty"`
+}
+
+type assignmentUpdateRequest struct {
+	Title        *string    `json:"title,omitempty" validate:"omitempty,notblank,max=200"`
+	Instructions *string    `json:"instructions,omitempty"`
+	DueAt        *time.Time `json:"dueAt,omitempty"`
+	Status       *string    `json:"status,omitempty"`
+}
+
+type attachmentUploadRequest struct {
+	ContentType string `json:"contentType" validate:"notblank"`
+}
+
+type attachmentUploadResponse struct {
+	UploadURL   string    `json:"uploadUrl"`
+	ContentType string    `json:"contentType"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+type exerciseLogEntryRequest struct {
+	Situation        *string  `json:"situation,omitempty"`
+	Notes            *string  `json:"notes,omitempty"`
+	StutterFrequency *int     `json:"stutterFrequency,omitempty" validate:"omitempty,gte=0,lte=10"`
+	Techniques       []string `json:"techniques,omitempty"`
+	Tags             []string `json:"tags,omitempty"`
+}
+
+type exerciseLogCreateRequest struct {
+	CompletedAt     *time.Time               `json:"completedAt,omitempty"`
+	DurationMinutes *int                     `json:"durationMinutes,omitempty" validate:"omitempty,gte=1,lte=1440"`
+	Notes           *string                  `json:"notes,omitempty"`
+	EntryID         *string                  `json:"entryId,omitempty" validate:"omitempty,uuid"`
+	Entry           *exerciseLogEntryRequest `json:"entry,omitempty" validate:"excluded_with=EntryID"`
+}
+
+type exerciseLogDTO struct {
+	ID              string    `json:"id"`
+	AssignmentID    string    `json:"assignmentId"`
+	EntryID         *string   `json:"entryId,omitempty"`
+	CompletedAt     time.Time `json:"completedAt"`
+	DurationMinutes *int      `json:"durationMinutes,omitempty"`
+	Notes           *string   `json:"notes,omitempty"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+type exerciseLogResponse struct {
+	Log        exerciseLogDTO `json:"log"`
+	Entry      *entryDTO      `json:"entry,omitempty"`
+	Assignment assignmentDTO  `json:"assignment"`
+}
+
+// createAssignmentHandler assigns a practice exercise to a linked patient.
+// @Summary Create a homework assignment for a patient (doctor must have approved link)
+// @Tags Assignments
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Patient ID"
+// @Param request body AssignmentCreateRequest true "Assignment"
+// @Success 201 {object} AssignmentDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /patients/{id}/assignments [post]
+func (s *Server) createAssignmentHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	patientID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid patient id")
+		return
+	}
+
+	if ok := s.hasApprovedLink(r.Context(), doc.ID, patientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return
+	}
+
+	var req assignmentCreateRequest
+	if !s.decodeJSON(w, r, &req) {
+		return
+	}
+
+	title := strings.TrimSpace(req.Title)
+
+	recurrence := assignment.RecurrenceNone
+	if req.Recurrence != "" {
+		rec, ok := parseAssignmentRecurrence(req.Recurrence)
+		if !ok {
+			s.writeError(w, http.StatusBadRequest, "recurrence must be None, Daily, or Weekly")
+			return
+		}
+		recurrence = rec
+	}
+
+	if req.DueAt != nil && !req.DueAt.After(time.Now()) {
+		s.writeError(w, http.StatusBadRequest, "dueAt must be in the future")
+		return
+	}
+
+	create := s.Db.Ent().Assignment.Create().
+		SetPatientID(patientID).
+		SetDoctorID(doc.ID).
+		SetTitle(title).
+		SetRecurrence(recurrence)
+	if req.DueAt != nil {
+		create.SetDueAt(req.DueAt.UTC())
+	}
+	if req.Instructions != nil && strings.TrimSpace(*req.Instructions) != "" {
+		create.SetInstructions(strings.TrimSpace(*req.Instructions))
+	}
+
+	a, err := create.Save(r.Context())
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to create assignment", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not create assignment")
+		return
+	}
+
+	dtos, err := s.buildAssignmentDTOs(r.Context(), []*ent.Assignment{a})
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to build assignment", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not create assignment")
+		return
+	}
+	s.writeJSON(w, http.StatusCreated, dtos[0])
+}
+
+// patientAssignmentsOverviewHandler summarises a linked patient's homework completion.
+// @Summary Homework completion overview for a patient (doctor must have approved link)
+// @Tags Assignments
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Patient ID"
+// @Param status query string false "Active (default), Archived, or all"
+// @Success 200 {object} AssignmentOverviewResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /patients/{id}/assignments [get]
+func (s *Server) patientAssignmentsOverviewHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	patientID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid patient id")
+		return
+	}
+
+	if ok := s.hasApprovedLink(r.Context(), doc.ID, patientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return
+	}
+
+	dtos, ok := s.queryAssignments(w, r, patientID)
+	if !ok {
+		return
+	}
+
+	resp := assignmentOverviewResponse{PatientID: patientID.String(), Assignments: dtos}
+	for _, a := range dtos {
+		if a.Status == assignment.StatusActive.String() {
+			resp.Active++
+		}
+		switch a.Completion.Status {
+		case assignmentCompletionCompleted:
+			resp.Completed++
+		case assignmentCompletionOverdue:
+			resp.Overdue++
+		case assignmentCompletionBehind:
+			resp.Behind++
+		}
+		resp.PeriodsCompleted += a.Completion.PeriodsCompleted
+		resp.PeriodsExpected += a.Completion.PeriodsExpected
+	}
+	if resp.PeriodsExpected > 0 {
+		resp.Percent = math.Round(float64(resp.PeriodsCompleted)/float64(resp.PeriodsExpected)*1000) / 10
+	}
+
+	s.writeJSON(w, http.StatusOK, resp)
+}
+
+// myAssignmentsHandler lists the current patient's homework.
+// @Summary List the current patient's homework assignments
+// @Tags Patient
+// @Produce json
+// @Security SessionCookie
+// @Param status query string false "Active (default), Archived, or all"
+// @Success 200 {object} AssignmentsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /patient/assignments [get]
+func (s *Server) myAssignmentsHandler(w http.ResponseWriter, r *http.Request) {
+	p, ok := currentPatient(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	dtos, ok := s.queryAssignments(w, r, p.ID)
+	if !ok {
+		return
+	}
+	s.writeJSON(w, http.StatusOK, assignmentsResponse{Assignments: dtos})
+}
+
+func (s *Server) queryAssignments(w http.ResponseWriter, r *http.Request, patientID uuid.UUID) ([]assignmentDTO, bool) {
+	q := s.Db.Ent().Assignment.Query().
+		Where(assignment.PatientIDEQ(patientID)).
+		Order(ent.Desc(assignment.FieldCreatedAt))
+
+	switch raw := r.URL.Query().Get("status"); {
+	case raw == "":
+		q = q.Where(assignment.StatusEQ(assignment.StatusActive))
+	case strings.EqualFold(raw, "all"):
+	default:
+		status, ok := parseAssignmentStatus(raw)
+		if !ok {
+			s.writeError(w, http.StatusBadRequest, "status must be Active, Archived, or all")
+			return nil, false
+		}
+		q = q.Where(assignment.StatusEQ(status))
+	}
+
+	assignments, err := q.All(r.Context())
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to list assignments", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not list assignments")
+		return nil, false
+	}
+
+	dtos, err := s.buildAssignmentDTOs(r.Context(), assignments)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to build assignments", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not list assignments")
+		return nil, false
+	}
+	return dtos, true
+}
+
+// updateAssignmentHandler edits an assignment or archives it.
+// @Summary Update a homework assignment (doctor must have approved link)
+// @Tags Assignments
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Assignment ID"
+// @Param request body AssignmentUpdateRequest true "Changes"
+// @Success 200 {object} AssignmentDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /assignments/{id} [patch]
+func (s *Server) updateAssignmentHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	assignmentID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid assignment id")
+		return
+	}
+
+	var req assignmentUpdateRequest
+	if !s.decodeJSON(w, r, &req) {
+		return
+	}
+
+	ctx := r.Context()
+	if _, ok := s.loadDoctorAssignment(w, r, doc.ID, assignmentID, "could not update assignment"); !ok {
+		return
+	}
+
+	update := s.Db.Ent().Assignment.UpdateOneID(assignmentID)
+	if req.Title != nil {
+		update.SetTitle(strings.TrimSpace(*req.Title))
+	}
+	if req.Instructions != nil {
+		if instructions := strings.TrimSpace(*req.Instructions); instructions == "" {
+			update.ClearInstructions()
+		} else {
+			update.SetInstructions(instructions)
+		}
+	}
+	if req.DueAt != nil {
+		update.SetDueAt(req.DueAt.UTC())
+	}
+	if req.Status != nil {
+		status, ok := parseAssignmentStatus(*req.Status)
+		if !ok {
+			s.writeError(w, http.StatusBadRequest, "status must be Active or Archived")
+			return
+		}
+		update.SetStatus(status)
+	}
+
+	a, err := update.Save(ctx)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to update assignment", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not update assignment")
+		return
+	}
+
+	dtos, err := s.buildAssignmentDTOs(ctx, []*ent.Assignment{a})
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to build assignment", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not update assignment")
+		return
+	}
+	s.writeJSON(w, http.StatusOK, dtos[0])
+}
+
+// assignmentAttachmentHandler issues an upload URL for an assignment's audio example.
+// @Summary Presign an audio attachment upload for an assignment (doctor must have approved link)
+// @Description Upload the file with a PUT to uploadUrl before it expires. A new upload replaces the previous attachment.
+// @Tags Assignments
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Assignment ID"
+// @Param request body AttachmentUploadRequest true "Attachment"
+// @Success 200 {object} AttachmentUploadResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Router /assignments/{id}/attachment [post]
+func (s *Server) assignmentAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	if s.Storage == nil {
+		s.writeError(w, http.StatusServiceUnavailable, "attachments are not configured")
+		return
+	}
+
+	assignmentID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid assignment id")
+		return
+	}
+
+	var req attachmentUploadRequest
+	if !s.decodeJSON(w, r, &req) {
+		return
+	}
+
+	contentType := strings.ToLower(strings.TrimSpace(req.ContentType))
+	if !strings.HasPrefix(contentType, "audio/") {
+		s.writeError(w, http.StatusBadRequest, "contentType must be an audio type")
+		return
+	}
+
+	if _, ok := s.loadDoctorAssignment(w, r, doc.ID, assignmentID, "could not create attachment"); !ok {
+		return
+	}
+
+	ctx := r.Context()
+	key := "assignments/" + assignmentID.String() + "/" + uuid.NewString()
+	uploadURL, err := s.Storage.PresignPut(ctx, key, attachmentUploadTTL)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to presign attachment upload", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not create attachment")
+		return
+	}
+
+	if err := s.Db.Ent().Assignment.UpdateOneID(assignmentID).
+		SetAttachmentKey(key).
+		SetAttachmentContentType(contentType).
+		Exec(ctx); err != nil {
+		log.FromContext(r.Context()).Error("failed to store attachment key", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not create attachment")
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, attachmentUploadResponse{
+		UploadURL:   uploadURL,
+		ContentType: contentType,
+		ExpiresAt:   time.Now().UTC().Add(attachmentUploadTTL),
+	})
+}
+
+// logExerciseHandler records that the current patient completed an assignment.
+// @Summary Log completion of a homework assignment
+// @Description Pass entryId to link an existing entry, or entry to create one tagged "homework".
+// @Tags Patient
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Assignment ID"
+// @Param request body ExerciseLogCreateRequest true "Completion"
+// @Success 201 {object} ExerciseLogResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /patient/assignments/{id}/logs [post]
+func (s *Server) logExerciseHandler(w http.ResponseWriter, r *http.Request) {
+	p, ok := currentPatient(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	assignmentID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid assignment id")
+		return
+	}
+
+	var req exerciseLogCreateRequest
+	if !s.decodeJSON(w, r, &req) {
+		return
+	}
+
+	now := time.Now().UTC()
+	completedAt := now
+	if req.CompletedAt != nil {
+		completedAt = req.CompletedAt.UTC()
+	}
+	if completedAt.After(now.Add(time.Minute)) {
+		s.writeError(w, http.StatusBadRequest, "completedAt must not be in the future")
+		return
+	}
+
+	ctx := r.Context()
+	a, err := s.Db.Ent().Assignment.Query().
+		Where(assignment.IDEQ(assignmentID), assignment.PatientIDEQ(p.ID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		s.writeError(w, http.StatusNotFound, "assignment not found")
+		return
+	} else if err != nil {
+		log.FromContext(r.Context()).Error("failed to load assignment", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not log exercise")
+		return
+	}
+	if a.Status != assignment.StatusActive {
+		s.writeError(w, http.StatusConflict, "assignment is archived")
+		return
+	}
+
+	var linkedEntryID *uuid.UUID
+	if req.EntryID != nil {
+		id, err := uuid.Parse(*req.EntryID)
+		if err != nil {
+			s.writeError(w, http.StatusBadRequest, "invalid entry id")
+			return
+		}
+		exists, err := s.Db.Ent().Entry.Query().
+			Where(entry.IDEQ(id), entry.PatientIDEQ(p.ID)).
+			Exist(ctx)
+		if err != nil {
+			log.FromContext(r.Context()).Error("failed to check entry", "err", err)
+			s.writeError(w, http.StatusInternalServerError, "could not log exercise")
+			return
+		}
+		if !exists {
+			s.writeError(w, http.StatusNotFound, "entry not found")
+			return
+		}
+		linkedEntryID = &id
+	}
+
+	tx, err := s.Db.Ent().Tx(ctx)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to begin transaction", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not log exercise")
+		return
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var createdEntry *ent.Entry
+	if req.Entry != nil {
+		createdEntry, err = s.createHomeworkEntry(ctx, tx, p.ID, a, completedAt, req.Entry)
+		if err != nil {
+			log.FromContext(r.Context()).Error("failed to create homework entry", "err", err)
+			s.writeError(w, http.StatusInternalServerError, "could not log exercise")
+			return
+		}
+		linkedEntryID = &createdEntry.ID
+	}
+
+	// Practice counts as activity for inactivity reminders.
+	if err := tx.Patient.UpdateOneID(p.ID).SetLastEntryAt(time.Now().UTC()).Exec(ctx); err != nil {
+		log.FromContext(r.Context()).Error("failed to record practice activity", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not log exercise")
+		return
+	}
+
+	create := tx.ExerciseLog.Create().
+		SetAssignmentID(a.ID).
+		SetCompletedAt(completedAt).
+		SetNillableEntryID(linkedEntryID).
+		SetNillableDurationMinutes(req.DurationMinutes)
+	if req.Notes != nil && strings.TrimSpace(*req.Notes) != "" {
+		create.SetNotes(strings.TrimSpace(*req.Notes))
+	}
+	l, err := create.Save(ctx)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to create exercise log", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not log exercise")
+		return
+	}
+
+	if createdEntry != nil {
+		if err := s.shareEntriesWithApprovedDoctors(ctx, tx, p.ID, []*ent.Entry{createdEntry}); err != nil {
+			log.FromContext(r.Context()).Error("failed to share homework entry", "err", err)
+			s.writeError(w, http.StatusInternalServerError, "could not log exercise")
+			return
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.FromContext(r.Context()).Error("failed to commit exercise log", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not log exercise")
+		return
+	}
+	s.wakeOutbox()
+
+	resp := exerciseLogResponse{Log: mapExerciseLogDTO(l)}
+	if createdEntry != nil {
+		dto := mapEntryDTO(createdEntry)
+		resp.Entry = &dto
+	}
+
+	dtos, err := s.buildAssignmentDTOs(ctx, []*ent.Assignment{a})
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to build assignment", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not log exercise")
+		return
+	}
+	resp.Assignment = dtos[0]
+
+	s.writeJSON(w, http.StatusCreated, resp)
+}
+
+func (s *Server) createHomeworkEntry(ctx context.Context, tx *ent.Tx, patientID uuid.UUID, a *ent.Assignment, at time.Time, req *exerciseLogEntryRequest) (*ent.Entry, error) {
+	normalizer, err := s.patientNormalizer(ctx, patientID)
+	if err != nil {
+		return nil, err
+	}
+
+	situation := a.Title
+	if req.Situation != nil && strings.TrimSpace(*req.Situation) != "" {
+		situation = strings.TrimSpace(*req.Situation)
+	}
+
+	create := tx.Entry.Create().
+		SetPatientID(patientID).
+		SetHappenedAt(at).
+		SetSituation(situation).
+		SetNillableStutterFrequency(req.StutterFrequency).
+		SetTechniques(normalizer.NormalizeAll(vocabularyterm.KindTechnique.String(), req.Techniques)).
+		SetTags(normalizer.NormalizeAll("", append([]string{homeworkTag}, req.Tags...)))
+	if req.Notes != nil && strings.TrimSpace(*req.Notes) != "" {
+		create.SetNotes(strings.TrimSpace(*req.Notes))
+	}
+	return create.Save(ctx)
+}
+
+// loadDoctorAssignment loads an assignment and checks the doctor may manage it,
+// writing the error response when not.
+func (s *Server) loadDoctorAssignment(w http.ResponseWriter, r *http.Request, doctorID, assignmentID uuid.UUID, failure string) (*ent.Assignment, bool) {
+	a, err := s.Db.Ent().Assignment.Get(r.Context(), assignmentID)
+	if ent.IsNotFound(err) {
+		s.writeError(w, http.StatusNotFound, "assignment not found")
+		return nil, false
+	} else if err != nil {
+		log.FromContext(r.Context()).Error("failed to load assignment", "err", err)
+		s.writeError(w, http.StatusInternalServerError, failure)
+		return nil, false
+	}
+
+	if ok := s.hasApprovedLink(r.Context(), doctorID, a.PatientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return nil, false
+	}
+	return a, true
+}
+
+func (s *Server) buildAssignmentDTOs(ctx context.Context, assignments []*ent.Assignment) ([]assignmentDTO, error) {
+	ids := make([]uuid.UUID, len(assignments))
+	for i, a := range assignments {
+		ids[i] = a.ID
+	}
+	stats, err := s.assignmentLogStats(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	dtos := make([]assignmentDTO, 0, len(assignments))
+	for _, a := range assignments {
+		dto := assignmentDTO{
+			ID:                    a.ID.String(),
+			PatientID:             a.PatientID.String(),
+			DoctorID:              a.DoctorID.String(),
+			Title:                 a.Title,
+			Instructions:          a.Instructions,
+			DueAt:                 a.DueAt,
+			Recurrence:            a.Recurrence.String(),
+			Status:                a.Status.String(),
+			HasAttachment:         a.AttachmentKey != nil,
+			AttachmentContentType: a.AttachmentContentType,
+			CreatedAt:             a.CreatedAt,
+			UpdatedAt:             a.UpdatedAt,
+			Completion:            assignmentCompletion(a, stats[a.ID], now),
+		}
+		if a.AttachmentKey != nil && s.Storage != nil {
+			url, err := s.Storage.PresignGet(ctx, *a.AttachmentKey, attachmentDownloadTTL)
+			if err != nil {
+				return nil, err
+			}
+			dto.AttachmentURL = &url
+		}
+		dtos = append(dtos, dto)
+	}
+	return dtos, nil
+}
+
+func mapExerciseLogDTO(l *ent.ExerciseLog) exerciseLogDTO {
+	dto := exerciseLogDTO{
+		ID:              l.ID.String(),
+		AssignmentID:    l.AssignmentID.String(),
+		CompletedAt:     l.CompletedAt,
+		DurationMinutes: l.DurationMinutes,
+		Notes:           l.Notes,
+		CreatedAt:       l.CreatedAt,
+	}
+	if l.EntryID != nil {
+		id := l.EntryID.String()
+		dto.EntryID = &id
+	}
+	return dto
+}
+
+func parseAssignmentRecurrence(raw string) (assignment.Recurrence, bool) {
+	for _, rec := range []assignment.Recurrence{assignment.RecurrenceNone, assignment.RecurrenceDaily, assignment.RecurrenceWeekly} {
+		if strings.EqualFold(strings.TrimSpace(raw), rec.String()) {
+			return rec, true
+		}
+	}
+	return "", false
+}
+
+func parseAssignmentStatus(raw string) (assignment.Status, bool) {
+	for _, st := range []assignment.Status{assignment.StatusActive, assignment.StatusArchived} {
+		if strings.EqualFold(strings.TrimSpace(raw), st.String()) {
+			return st, true
+		}
+	}
+	return "", false
+}
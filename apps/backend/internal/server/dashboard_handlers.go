@@ -0,0 +1,303 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"backend/ent"
+	"backend/ent/doctor"
+	"backend/ent/doctorpatientlink"
+	"backend/ent/patient"
+
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultDashboardRangeDays = 30
+	defaultInactiveAfterDays  = 14
+	dashboardTopTriggers      = 10
+
+	// worseningSlopePerDay is the minimum rise in self-rated stutter frequency
+	// per day (about half a point per week) for a patient to be flagged.
+	worseningSlopePerDay = 0.07
+	// worseningMinRatings avoids flagging patients on one or two noisy ratings.
+	worseningMinRatings = 3
+)
+
+type dashboardResponse struct {
+	From        time.Time               `json:"from"`
+	To          time.Time               `json:"to"`
+	PracticeID  *string                 `json:"practiceId,omitempty"`
+	Patients    dashboardPatientCounts  `json:"patients"`
+	Adherence   dashboardAdherence      `json:"adherence"`
+	Worsening   []dashboardWorseningRow `json:"worsening"`
+	TopTriggers []namedCount            `json:"topTriggers"`
+}
+
+type dashboardPatientCounts struct {
+	Total             int `json:"total"`
+	Active            int `json:"active"`
+	Inactive          int `json:"inactive"`
+	InactiveAfterDays int `json:"inactiveAfterDays"`
+}
+
+type dashboardAdherence struct {
+	AvgEntriesPerWeek float64                 `json:"avgEntriesPerWeek"`
+	Patients          []dashboardAdherenceRow `json:"patients"`
+}
+
+type dashboardAdherenceRow struct {
+	Patient        patientDTO `json:"patient"`
+	Entries        int        `json:"entries"`
+	EntriesPerWeek float64    `json:"entriesPerWeek"`
+	LastEntryAt    *time.Time `json:"lastEntryAt,omitempty"`
+	Active         bool       `json:"active"`
+}
+
+type dashboardWorseningRow struct {
+	Patient patientDTO `json:"patient"`
+	// SlopePerWeek is the change in average stutter frequency per week.
+	SlopePerWeek float64 `json:"slopePerWeek"`
+	Ratings      int     `json:"ratings"`
+}
+
+type namedCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// dashboardHandler summarises the doctor's approved caseload.
+// @Summary Caseload dashboard for the current doctor
+// @Tags Analytics
+// @Produce json
+// @Security SessionCookie
+// @Param practiceId query string false "Summarise every approved patient of the practice (owners only)"
+// @Param from query string false "ISO timestamp (RFC3339) lower bound. Defaults to 30 days before to."
+// @Param to query string false "ISO timestamp (RFC3339) upper bound. Defaults to now."
+// @Param inactiveDays query int false "Days without entries before a patient counts as inactive (default 14)"
+// @Success 200 {object} DashboardResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /dashboard [get]
+func (s *Server) dashboardHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	fromParam, toParam, err := parseTimeRange(r)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid time range")
+		return
+	}
+
+	to := time.Now().UTC()
+	if toParam != nil {
+		to = toParam.UTC()
+	}
+	from := to.AddDate(0, 0, -defaultDashboardRangeDays)
+	if fromParam != nil {
+		from = fromParam.UTC()
+	}
+	if !from.Before(to) {
+		s.writeError(w, http.StatusBadRequest, "from must be before to")
+		return
+	}
+
+	inactiveAfterDays := defaultInactiveAfterDays
+	if raw := r.URL.Query().Get("inactiveDays"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 {
+			s.writeError(w, http.StatusBadRequest, "invalid inactiveDays")
+			return
+		}
+		inactiveAfterDays = parsed
+	}
+
+	var practiceID *uuid.UUID
+	if raw := r.URL.Query().Get("practiceId"); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			s.writeError(w, http.StatusBadRequest, "invalid practice id")
+			return
+		}
+		if doc.PracticeID == nil || *doc.PracticeID != id || doc.Role != doctor.RoleOwner {
+			s.writeError(w, http.StatusForbidden, "practice dashboard requires practice ownership")
+			return
+		}
+		practiceID = &id
+	}
+
+	ctx := r.Context()
+	patients, err := s.caseloadPatients(ctx, doc.ID, practiceID)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to load caseload", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not build dashboard")
+		return
+	}
+
+	patientIDs := make([]uuid.UUID, 0, len(patients))
+	for _, p := range patients {
+		patientIDs = append(patientIDs, p.ID)
+	}
+	scope := analyticsScope{PatientIDs: patientIDs, From: from, To: to}
+
+	activity, err := s.queryPatientActivity(ctx, scope)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to aggregate caseload activity", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not build dashboard")
+		return
+	}
+
+	lastEntries, err := s.queryLastEntries(ctx, patientIDs)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to load last entries", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not build dashboard")
+		return
+	}
+
+	distributions, err := s.queryDistributions(ctx, scope)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to aggregate caseload triggers", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not build dashboard")
+		return
+	}
+
+	resp := dashboardResponse{
+		From: from,
+		To:   to,
+		Patients: dashboardPatientCounts{
+			Total:             len(patients),
+			InactiveAfterDays: inactiveAfterDays,
+		},
+		Adherence: dashboardAdherence{
+			Patients: make([]dashboardAdherenceRow, 0, len(patients)),
+		},
+		Worsening:   []dashboardWorseningRow{},
+		TopTriggers: topCounts(distributions.Triggers, dashboardTopTriggers),
+	}
+	if practiceID != nil {
+		id := practiceID.String()
+		resp.PracticeID = &id
+	}
+
+	weeks := to.Sub(from).Hours() / (24 * 7)
+	activeSince := time.Now().UTC().AddDate(0, 0, -inactiveAfterDays)
+	totalEntries := 0
+
+	for _, p := range patients {
+		a := activity[p.ID]
+		totalEntries += a.Entries
+
+		lastEntryAt := latestEntryAt(p, lastEntries)
+		active := lastEntryAt != nil && lastEntryAt.After(activeSince)
+		if active {
+			resp.Patients.Active++
+		} else {
+			resp.Patients.Inactive++
+		}
+
+		resp.Adherence.Patients = append(resp.Adherence.Patients, dashboardAdherenceRow{
+			Patient:        buildPatientDTO(p),
+			Entries:        a.Entries,
+			EntriesPerWeek: perWeek(a.Entries, weeks),
+			LastEntryAt:    lastEntryAt,
+			Active:         active,
+		})
+
+		if a.Slope != nil && a.Ratings >= worseningMinRatings && *a.Slope >= worseningSlopePerDay {
+			resp.Worsening = append(resp.Worsening, dashboardWorseningRow{
+				Patient:      buildPatientDTO(p),
+				SlopePerWeek: *a.Slope * 7,
+				Ratings:      a.Ratings,
+			})
+		}
+	}
+
+	if len(patients) > 0 {
+		resp.Adherence.AvgEntriesPerWeek = perWeek(totalEntries, weeks) / float64(len(patients))
+	}
+
+	sort.SliceStable(resp.Adherence.Patients, func(i, j int) bool {
+		return resp.Adherence.Patients[i].EntriesPerWeek < resp.Adherence.Patients[j].EntriesPerWeek
+	})
+	sort.SliceStable(resp.Worsening, func(i, j int) bool {
+		return resp.Worsening[i].SlopePerWeek > resp.Worsening[j].SlopePerWeek
+	})
+
+	s.writeJSON(w, http.StatusOK, resp)
+}
+
+// caseloadPatients returns the approved patients of the doctor or, when a practice
+// is given, of every doctor in that practice.
+func (s *Server) caseloadPatients(ctx context.Context, doctorID uuid.UUID, practiceID *uuid.UUID) ([]*ent.Patient, error) {
+	linkQuery := s.Db.Ent().DoctorPatientLink.
+		Query().
+		Where(doctorpatientlink.StatusEQ(doctorpatientlink.StatusApproved))
+	if practiceID != nil {
+		linkQuery = linkQuery.Where(doctorpatientlink.HasDoctorWith(doctor.PracticeIDEQ(*practiceID)))
+	} else {
+		linkQuery = linkQuery.Where(doctorpatientlink.DoctorIDEQ(doctorID))
+	}
+
+	var patientIDs []uuid.UUID
+	if err := linkQuery.
+		Select(doctorpatientlink.FieldPatientID).
+		Scan(ctx, &patientIDs); err != nil {
+		return nil, err
+	}
+	if len(patientIDs) == 0 {
+		return []*ent.Patient{}, nil
+	}
+
+	return s.Db.Ent().Patient.
+		Query().
+		Where(patient.IDIn(patientIDs...)).
+		Order(ent.Asc(patient.FieldDisplayName)).
+		All(ctx)
+}
+
+// latestEntryAt prefers the tracked last_entry_at and falls back to the newest entry.
+func latestEntryAt(p *ent.Patient, lastEntries map[uuid.UUID]time.Time) *time.Time {
+	var latest *time.Time
+	if p.LastEntryAt != nil {
+		t := p.LastEntryAt.UTC()
+		latest = &t
+	}
+	if t, ok := lastEntries[p.ID]; ok && (latest == nil || t.After(*latest)) {
+		t = t.UTC()
+		latest = &t
+	}
+	return latest
+}
+
+func perWeek(count int, weeks float64) float64 {
+	if weeks <= 0 {
+		return 0
+	}
+	return float64(count) / weeks
+}
+
+// topCounts orders a distribution by count (then name) and keeps the first n.
+func topCounts(counts map[string]int, n int) []namedCount {
+	out := make([]namedCount, 0, len(counts))
+	for name, count := range counts {
+		out = append(out, namedCount{Name: name, Count: count})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Count != out[j].Count {
+			return out[i].Count > out[j].Count
+		}
+		return out[i].Name < out[j].Name
+	})
+	if len(out) > n {
+		out = out[:n]
+	}
+	return out
+}
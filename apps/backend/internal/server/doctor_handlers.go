@@ -2,15 +2,14 @@ package server
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"net/http"
-	"net/mail"
 	"strings"
 
 	"backend/ent"
 	"backend/ent/doctor"
 	"backend/internal/auth"
+	internal_errors "backend/internal/server/errors"
 
 	"github.com/charmbracelet/log"
 	"github.com/google/uuid"
@@ -19,14 +18,23 @@ import (
 type doctorContextKey struct{}
 
 type doctorRegisterRequest struct {
-	Email       string `json:"email"`
-	DisplayName string `json:"displayName"`
-	Password    string `json:"password"`
+	Email       string `json:"email" validate:"notblank,email"`
+	DisplayName string `json:"displayName" validate:"notblank"`
+	Password    string `json:"password" validate:"required"`
+}
+
+func (req *doctorRegisterRequest) normalize() {
+	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
+	req.DisplayName = strings.TrimSpace(req.DisplayName)
 }
 
 type doctorLoginRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email    string `json:"email" validate:"notblank"`
+	Password string `json:"password" validate:"required"`
+}
+
+func (req *doctorLoginRequest) normalize() {
+	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
 }
 
 type doctorResponse struct {
@@ -46,6 +54,7 @@ type doctorResponse struct {
 // @Success 201 {object} DoctorResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 409 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
 // @Router /doctor/register [post]
 func (s *Server) doctorRegisterHandler(w http.ResponseWriter, r *http.Request) {
 	if !s.ensureAuthReady(w) {
@@ -57,19 +66,6 @@ func (s *Server) doctorRegisterHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
-	req.DisplayName = strings.TrimSpace(req.DisplayName)
-
-	if req.Email == "" || req.DisplayName == "" || req.Password == "" {
-		s.writeError(w, http.StatusBadRequest, "email, displayName, and password are required")
-		return
-	}
-
-	if _, err := mail.ParseAddress(req.Email); err != nil {
-		s.writeError(w, http.StatusBadRequest, "email is invalid")
-		return
-	}
-
 	hash, err := s.Auth.HashPassword(req.Password)
 	if err != nil {
 		s.writeError(w, http.StatusBadRequest, err.Error())
@@ -84,16 +80,16 @@ func (s *Server) doctorRegisterHandler(w http.ResponseWriter, r *http.Request) {
 		Save(r.Context())
 	if err != nil {
 		if ent.IsConstraintError(err) {
-			s.writeError(w, http.StatusConflict, "an account with that email already exists")
+			s.writeDomainError(w, r, internal_errors.ErrAccountExists, "could not create account")
 			return
 		}
-		log.Error("failed to create doctor", "err", err)
+		log.FromContext(r.Context()).Error("failed to create doctor", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not create account")
 		return
 	}
 
 	if err := s.Auth.IssueSession(w, doc.ID); err != nil {
-		log.Error("failed to set session cookie", "err", err)
+		log.FromContext(r.Context()).Error("failed to set session cookie", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not create session")
 		return
 	}
@@ -112,6 +108,7 @@ func (s *Server) doctorRegisterHandler(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {object} DoctorResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
 // @Router /doctor/login [post]
 func (s *Server) doctorLoginHandler(w http.ResponseWriter, r *http.Request) {
 	if !s.ensureAuthReady(w) {
@@ -123,40 +120,33 @@ func (s *Server) doctorLoginHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
-	if req.Email == "" || req.Password == "" {
-		log.Error("Email and password are required!")
-		s.writeError(w, http.StatusBadRequest, "email and password are required")
-		return
-	}
-
 	doc, err := s.Db.Ent().Doctor.Query().
 		Where(doctor.EmailEQ(req.Email)).
 		Only(r.Context())
 	if err != nil {
 		if ent.IsNotFound(err) {
-			log.Error("doctor not found")
-			s.writeError(w, http.StatusUnauthorized, "invalid email or password")
+			log.FromContext(r.Context()).Error("doctor not found")
+			s.writeDomainError(w, r, internal_errors.ErrInvalidCredentials, "could not check credentials")
 			return
 		}
-		log.Error("failed to query doctor", "err", err)
+		log.FromContext(r.Context()).Error("failed to query doctor", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not check credentials")
 		return
 	}
 
 	if err := s.Auth.VerifyPassword(doc.PasswordHash, req.Password); err != nil {
-		log.Error("Invalid email or password")
-		s.writeError(w, http.StatusUnauthorized, "invalid email or password")
+		log.FromContext(r.Context()).Error("Invalid email or password")
+		s.writeDomainError(w, r, internal_errors.ErrInvalidCredentials, "could not check credentials")
 		return
 	}
 
 	if err := s.Auth.IssueSession(w, doc.ID); err != nil {
-		log.Error("failed to set session cookie", "err", err)
+		log.FromContext(r.Context()).Error("failed to set session cookie", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not create session")
 		return
 	}
 
-	log.Info("Login was successful")
+	log.FromContext(r.Context()).Info("Login was successful")
 
 	s.writeJSON(w, http.StatusOK, map[string]any{
 		"doctor": buildDoctorResponse(doc),
@@ -207,7 +197,7 @@ func (s *Server) requireDoctor(next http.Handler) http.Handler {
 			if errors.Is(err, auth.ErrExpiredSession) || errors.Is(err, auth.ErrInvalidSession) {
 				s.Auth.ClearSession(w)
 			}
-			s.writeError(w, http.StatusUnauthorized, "unauthorized")
+			s.writeDomainError(w, r, err, "could not read session")
 			return
 		}
 
@@ -224,12 +214,13 @@ func (s *Server) requireDoctor(next http.Handler) http.Handler {
 				s.writeError(w, http.StatusUnauthorized, "unauthorized")
 				return
 			}
-			log.Error("failed to load doctor for session", "err", err)
+			log.FromContext(r.Context()).Error("failed to load doctor for session", "err", err)
 			s.writeError(w, http.StatusInternalServerError, "could not load account")
 			return
 		}
 
 		ctx := context.WithValue(r.Context(), doctorContextKey{}, doc)
+		ctx = withActor(ctx, "doctor", doc.ID)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
@@ -266,14 +257,3 @@ func currentDoctor(ctx context.Context) (*ent.Doctor, bool) {
 	doc, ok := val.(*ent.Doctor)
 	return doc, ok
 }
-
-func (s *Server) decodeJSON(w http.ResponseWriter, r *http.Request, dst any) bool {
-	r.Body = http.MaxBytesReader(w, r.Body, 1<<20) // 1MB limit
-	dec := json.NewDecoder(r.Body)
-	dec.DisallowUnknownFields()
-	if err := dec.Decode(dst); err != nil {
-		s.writeError(w, http.StatusBadRequest, "invalid JSON payload")
-		return false
-	}
-	return true
-}
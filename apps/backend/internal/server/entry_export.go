@@ -0,0 +1,217 @@
+package server
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/ent"
+	"backend/ent/patient"
+	"backend/internal/fhir"
+
+	"github.com/google/uuid"
+)
+
+const (
+	exportFormatJSON = "json"
+	exportFormatCSV  = "csv"
+	exportFormatFHIR = "fhir"
+)
+
+var errUnknownExportFormat = errors.New("format must be json, csv, or fhir")
+
+// entriesExportFormat resolves the format query parameter, falling back to the
+// Accept header so EHR tooling can negotiate FHIR without extra parameters.
+func entriesExportFormat(r *http.Request) (string, error) {
+	switch raw := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("format"))); raw {
+	case "":
+	case exportFormatJSON, exportFormatCSV, exportFormatFHIR:
+		return raw, nil
+	default:
+		return "", errUnknownExportFormat
+	}
+
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case "text/csv":
+			return exportFormatCSV, nil
+		case fhir.ContentType:
+			return exportFormatFHIR, nil
+		case "application/json":
+			return exportFormatJSON, nil
+		}
+	}
+	return exportFormatJSON, nil
+}
+
+var entriesCSVHeader = []string{
+	"id", "happened_at", "situation", "stutter_frequency", "emotions",
+	"triggers", "techniques", "tags", "notes", "created_at", "updated_at",
+}
+
+func (s *Server) writeEntriesCSV(w http.ResponseWriter, patientID string, entries []*ent.Entry) {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "entries-"+patientID+".csv"))
+	w.WriteHeader(http.StatusOK)
+
+	cw := csv.NewWriter(w)
+	_ = cw.Write(entriesCSVHeader)
+	for _, e := range entries {
+		emotions := make([]string, 0, len(e.Emotions))
+		for _, emo := range e.Emotions {
+			emotions = append(emotions, fmt.Sprintf("%s:%d", emo.Name, emo.Intensity))
+		}
+
+		stutter := ""
+		if e.StutterFrequency != nil {
+			stutter = strconv.Itoa(*e.StutterFrequency)
+		}
+
+		_ = cw.Write([]string{
+			e.ID.String(),
+			e.HappenedAt.UTC().Format(time.RFC3339),
+			csvSafe(valOrDefault(e.Situation, "")),
+			stutter,
+			csvSafe(strings.Join(emotions, "; ")),
+			csvSafe(strings.Join(e.Triggers, "; ")),
+			csvSafe(strings.Join(e.Techniques, "; ")),
+			csvSafe(strings.Join(e.Tags, "; ")),
+			csvSafe(valOrDefault(e.Notes, "")),
+			e.CreatedAt.UTC().Format(time.RFC3339),
+			e.UpdatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	cw.Flush()
+}
+
+// csvSafe neutralises values a spreadsheet would otherwise evaluate as a formula.
+func csvSafe(v string) string {
+	if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+		return "'" + v
+	}
+	return v
+}
+
+func (s *Server) writeEntriesFHIR(w http.ResponseWriter, p *ent.Patient, entries []*ent.Entry) {
+	bundle := fhir.NewCollection("", time.Now().UTC())
+	patientID := p.ID.String()
+	subject := fhir.Reference{Reference: fhir.URN(patientID)}
+
+	bundle.Add(patientID, buildFHIRPatient(p))
+
+	for _, e := range entries {
+		entryID := e.ID.String()
+		bundle.Add(entryID, buildFHIRQuestionnaireResponse(e, subject))
+
+		if e.StutterFrequency != nil {
+			// Observation IDs are derived from the entry so re-exports stay idempotent in the EHR.
+			obsID := uuid.NewSHA1(e.ID, []byte("stutter-frequency")).String()
+			bundle.Add(obsID, fhir.Observation{
+				ResourceType: "Observation",
+				ID:           obsID,
+				Status:       "final",
+				Category:     []fhir.CodeableConcept{fhir.SurveyCategory()},
+				Code: fhir.CodeableConcept{
+					Coding: []fhir.Coding{{System: fhir.SystemObservation, Code: "stutter-frequency", Display: "Self-rated stutter frequency (0-10)"}},
+					Text:   "Self-rated stutter frequency",
+				},
+				Subject:           subject,
+				EffectiveDateTime: e.HappenedAt.UTC(),
+				ValueInteger:      e.StutterFrequency,
+				DerivedFrom:       []fhir.Reference{{Reference: fhir.URN(entryID)}},
+			})
+		}
+	}
+
+	w.Header().Set("Content-Type", fhir.ContentType+"; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(bundle)
+}
+
+func buildFHIRPatient(p *ent.Patient) fhir.Patient {
+	res := fhir.Patient{
+		ResourceType: "Patient",
+		ID:           p.ID.String(),
+		Active:       p.Status == patient.StatusActive,
+		Name:         []fhir.HumanName{{Text: p.DisplayName}},
+	}
+	if p.PatientCode != nil {
+		res.Identifier = append(res.Identifier, fhir.Identifier{System: fhir.SystemPatientCode, Value: strings.TrimSpace(*p.PatientCode)})
+	}
+	if p.Email != nil {
+		res.Telecom = append(res.Telecom, fhir.ContactPoint{System: "email", Value: strings.TrimSpace(*p.Email)})
+	}
+	if p.BirthDate != nil {
+		res.BirthDate = p.BirthDate.Format("2006-01-02")
+	}
+	return res
+}
+
+func buildFHIRQuestionnaireResponse(e *ent.Entry, subject fhir.Reference) fhir.QuestionnaireResponse {
+	qr := fhir.QuestionnaireResponse{
+		ResourceType:  "QuestionnaireResponse",
+		ID:            e.ID.String(),
+		Questionnaire: fhir.JournalQuestionnaire,
+		Status:        "completed",
+		Subject:       subject,
+		Authored:      e.HappenedAt.UTC(),
+		Item:          []fhir.QuestionnaireResponseItem{},
+	}
+
+	if e.Situation != nil && *e.Situation != "" {
+		qr.Item = append(qr.Item, fhir.QuestionnaireResponseItem{
+			LinkID: "situation", Text: "Situation",
+			Answer: []fhir.QuestionnaireResponseAnswer{fhir.StringAnswer(*e.Situation)},
+		})
+	}
+	if e.StutterFrequency != nil {
+		qr.Item = append(qr.Item, fhir.QuestionnaireResponseItem{
+			LinkID: "stutter-frequency", Text: "Stutter frequency (0-10)",
+			Answer: []fhir.QuestionnaireResponseAnswer{fhir.IntegerAnswer(*e.StutterFrequency)},
+		})
+	}
+	if len(e.Emotions) > 0 {
+		item := fhir.QuestionnaireResponseItem{LinkID: "emotions", Text: "Emotions"}
+		for _, emo := range e.Emotions {
+			item.Answer = append(item.Answer, fhir.QuestionnaireResponseAnswer{
+				ValueCoding: &fhir.Coding{System: fhir.SystemEmotion, Code: emo.Name, Display: emo.Name},
+				Item: []fhir.QuestionnaireResponseItem{{
+					LinkID: "emotions.intensity", Text: "Intensity (0-10)",
+					Answer: []fhir.QuestionnaireResponseAnswer{fhir.IntegerAnswer(emo.Intensity)},
+				}},
+			})
+		}
+		qr.Item = append(qr.Item, item)
+	}
+	qr.Item = appendStringItem(qr.Item, "triggers", "Triggers", e.Triggers)
+	qr.Item = appendStringItem(qr.Item, "techniques", "Techniques", e.Techniques)
+	qr.Item = appendStringItem(qr.Item, "tags", "Tags", e.Tags)
+	if e.Notes != nil && *e.Notes != "" {
+		qr.Item = append(qr.Item, fhir.QuestionnaireResponseItem{
+			LinkID: "notes", Text: "Notes",
+			Answer: []fhir.QuestionnaireResponseAnswer{fhir.StringAnswer(*e.Notes)},
+		})
+	}
+	return qr
+}
+
+func appendStringItem(items []fhir.QuestionnaireResponseItem, linkID, text string, values []string) []fhir.QuestionnaireResponseItem {
+	if len(values) == 0 {
+		return items
+	}
+	item := fhir.QuestionnaireResponseItem{LinkID: linkID, Text: text}
+	for _, v := range values {
+		item.Answer = append(item.Answer, fhir.StringAnswer(v))
+	}
+	return append(items, item)
+}
@@ -3,14 +3,16 @@ package server
 import (
 	"context"
 	"net/http"
-	"strconv"
 	"time"
 
 	"backend/ent"
 	"backend/ent/doctorpatientlink"
 	"backend/ent/entry"
+	"backend/ent/predicate"
 	"backend/ent/schema"
+	"backend/internal/pagination"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/charmbracelet/log"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
@@ -32,7 +34,8 @@ type entryDTO struct {
 }
 
 type entriesResponse struct {
-	Entries []entryDTO `json:"entries"`
+	Entries    []entryDTO `json:"entries"`
+	NextCursor *string    `json:"nextCursor,omitempty"`
 }
 
 type recentEntriesRowDTO struct {
@@ -41,17 +44,42 @@ type recentEntriesRowDTO struct {
 }
 
 type recentEntriesResponse struct {
-	Rows []recentEntriesRowDTO `json:"rows"`
+	Rows       []recentEntriesRowDTO `json:"rows"`
+	NextCursor *string               `json:"nextCursor,omitempty"`
 }
 
+var (
+	entriesPageOptions = pagination.Options{
+		DefaultLimit: 100,
+		MaxLimit:     500,
+		Sorts:        []string{"happenedAt"},
+		DefaultSort:  "happenedAt",
+	}
+	recentEntriesPageOptions = pagination.Options{
+		DefaultLimit: 5,
+		MaxLimit:     20,
+		Sorts:        []string{"happenedAt"},
+		DefaultSort:  "-happenedAt",
+	}
+)
+
 // patientEntriesHandler lists patient entries with optional time range filtering.
+// The list can also be exported as CSV or as a FHIR R4 Bundle. JSON is only
+// paged when the client sends limit or cursor; older clients that never follow
+// nextCursor keep getting every entry.
 // @Summary List patient entries (doctor must have approved link)
 // @Tags Entries
 // @Produce json
+// @Produce text/csv
+// @Produce application/fhir+json
 // @Security SessionCookie
 // @Param id path string true "Patient ID"
 // @Param from query string false "ISO timestamp (RFC3339) lower bound"
 // @Param to query string false "ISO timestamp (RFC3339) upper bound"
+// @Param format query string false "json (default), csv, or fhir; falls back to the Accept header"
+// @Param limit query int false "Page size for JSON (default 100 once paging, max 500); omit limit and cursor for every entry"
+// @Param cursor query string false "Opaque cursor from a previous page"
+// @Param sort query string false "happenedAt (default) or -happenedAt"
 // @Success 200 {object} EntriesResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
@@ -82,9 +110,20 @@ func (s *Server) patientEntriesHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	format, err := entriesExportFormat(r)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page, err := pagination.Parse(r.URL.Query(), entriesPageOptions)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	q := s.Db.Ent().Entry.Query().
-		Where(entry.PatientIDEQ(patientID)).
-		Order(entry.ByHappenedAt())
+		Where(entry.PatientIDEQ(patientID))
 
 	if from != nil {
 		q = q.Where(entry.HappenedAtGTE(*from))
@@ -93,17 +132,61 @@ func (s *Server) patientEntriesHandler(w http.ResponseWriter, r *http.Request) {
 		q = q.Where(entry.HappenedAtLTE(*to))
 	}
 
+	// Exports always cover the whole range; only the JSON listing is paged,
+	// and only when asked to be.
+	query := r.URL.Query()
+	paged := format == exportFormatJSON && (query.Has("limit") || query.Has("cursor"))
+	switch {
+	case paged:
+		after, err := entriesAfter(page)
+		if err != nil {
+			s.writeDomainError(w, r, err, "could not list entries")
+			return
+		}
+		if after != nil {
+			q = q.Where(after)
+		}
+		q = q.Order(entriesOrder(page)...).Limit(page.Limit + 1)
+	case format == exportFormatJSON:
+		q = q.Order(entriesOrder(page)...)
+	default:
+		q = q.Order(entry.ByHappenedAt(), entry.ByID())
+	}
+
 	entries, err := q.All(r.Context())
 	if err != nil {
-		log.Error("failed to list entries", "err", err)
+		log.FromContext(r.Context()).Error("failed to list entries", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not list entries")
 		return
 	}
 
+	switch format {
+	case exportFormatCSV:
+		s.writeEntriesCSV(w, patientID.String(), entries)
+		return
+	case exportFormatFHIR:
+		p, err := s.Db.Ent().Patient.Get(r.Context(), patientID)
+		if err != nil {
+			log.FromContext(r.Context()).Error("failed to load patient for export", "err", err)
+			s.writeError(w, http.StatusInternalServerError, "could not list entries")
+			return
+		}
+		s.writeEntriesFHIR(w, p, entries)
+		return
+	}
+
+	more := false
+	if paged {
+		entries, more = pagination.Trim(entries, page.Limit)
+	}
 	resp := entriesResponse{Entries: make([]entryDTO, 0, len(entries))}
 	for _, e := range entries {
 		resp.Entries = append(resp.Entries, mapEntryDTO(e))
 	}
+	if more {
+		last := entries[len(entries)-1]
+		resp.NextCursor = page.Next(last.HappenedAt.UTC().Format(time.RFC3339Nano), last.ID)
+	}
 
 	s.writeJSON(w, http.StatusOK, resp)
 }
@@ -114,6 +197,7 @@ func (s *Server) patientEntriesHandler(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Security SessionCookie
 // @Param limit query int false "Max number of entries (default 5, max 20)"
+// @Param cursor query string false "Opaque cursor from a previous page"
 // @Success 200 {object} RecentEntriesResponse
 // @Failure 401 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
@@ -125,17 +209,15 @@ func (s *Server) recentEntriesHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	limit := 5
-	if raw := r.URL.Query().Get("limit"); raw != "" {
-		if parsed, err := strconv.Atoi(raw); err == nil {
-			limit = parsed
-		}
-	}
-	if limit < 1 {
-		limit = 1
+	page, err := pagination.Parse(r.URL.Query(), recentEntriesPageOptions)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
 	}
-	if limit > 20 {
-		limit = 20
+	after, err := entriesAfter(page)
+	if err != nil {
+		s.writeDomainError(w, r, err, "could not list entries")
+		return
 	}
 
 	ctx := r.Context()
@@ -148,7 +230,7 @@ func (s *Server) recentEntriesHandler(w http.ResponseWriter, r *http.Request) {
 		).
 		Select(doctorpatientlink.FieldPatientID).
 		Scan(ctx, &patientIDs); err != nil {
-		log.Error("failed to list approved patients", "err", err)
+		log.FromContext(r.Context()).Error("failed to list approved patients", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not list entries")
 		return
 	}
@@ -159,19 +241,29 @@ func (s *Server) recentEntriesHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	entries, err := s.Db.Ent().Entry.
+	q := s.Db.Ent().Entry.
 		Query().
-		Where(entry.PatientIDIn(patientIDs...)).
+		Where(entry.PatientIDIn(patientIDs...))
+	if after != nil {
+		q = q.Where(after)
+	}
+	entries, err := q.
 		WithPatient().
-		Order(ent.Desc(entry.FieldHappenedAt)).
-		Limit(limit).
+		Order(entriesOrder(page)...).
+		Limit(page.Limit + 1).
 		All(ctx)
 	if err != nil {
-		log.Error("failed to list recent entries", "err", err)
+		log.FromContext(r.Context()).Error("failed to list recent entries", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not list entries")
 		return
 	}
 
+	entries, more := pagination.Trim(entries, page.Limit)
+	if more {
+		last := entries[len(entries)-1]
+		resp.NextCursor = page.Next(last.HappenedAt.UTC().Format(time.RFC3339Nano), last.ID)
+	}
+
 	for _, e := range entries {
 		p := e.Edges.Patient
 		if p == nil {
@@ -197,12 +289,42 @@ func (s *Server) hasApprovedLink(ctx context.Context, doctorID, patientID uuid.U
 		).
 		Count(ctx)
 	if err != nil {
-		log.Error("failed to check link", "err", err)
+		log.FromContext(ctx).Error("failed to check link", "err", err)
 		return false
 	}
 	return count > 0
 }
 
+// entriesOrder sorts by happened_at with the ID as tie-breaker so keyset cursors are stable.
+func entriesOrder(page pagination.Params) []entry.OrderOption {
+	dir := sql.OrderAsc()
+	if page.Desc {
+		dir = sql.OrderDesc()
+	}
+	return []entry.OrderOption{entry.ByHappenedAt(dir), entry.ByID(dir)}
+}
+
+// entriesAfter returns the keyset predicate for the page after the cursor, or nil on the first page.
+func entriesAfter(page pagination.Params) (predicate.Entry, error) {
+	if page.Cursor == nil {
+		return nil, nil
+	}
+	at, err := time.Parse(time.RFC3339Nano, page.Cursor.Value)
+	if err != nil {
+		return nil, pagination.ErrInvalidCursor
+	}
+	if page.Desc {
+		return entry.Or(
+			entry.HappenedAtLT(at),
+			entry.And(entry.HappenedAtEQ(at), entry.IDLT(page.Cursor.ID)),
+		), nil
+	}
+	return entry.Or(
+		entry.HappenedAtGT(at),
+		entry.And(entry.HappenedAtEQ(at), entry.IDGT(page.Cursor.ID)),
+	), nil
+}
+
 func mapEntryDTO(e *ent.Entry) entryDTO {
 	return entryDTO{
 		ID:               e.ID.String(),
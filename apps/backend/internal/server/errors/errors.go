@@ -0,0 +1,152 @@
+package errors
+
+import (
+	stderrors "errors"
+	"net/http"
+
+	"backend/ent"
+	"backend/internal/auth"
+	"backend/internal/pagination"
+)
+
+// Code is a stable, machine-readable error identifier. Clients should switch
+// on the code; messages are meant for people and may change.
+type Code string
+
+// Codes shared by every route. They are derived from the status when a
+// handler does not name a more specific one.
+const (
+	CodeBadRequest         Code = "bad_request"
+	CodeValidationFailed   Code = "validation_failed"
+	CodeUnauthorized       Code = "unauthorized"
+	CodeForbidden          Code = "forbidden"
+	CodeNotFound           Code = "not_found"
+	CodeMethodNotAllowed   Code = "method_not_allowed"
+	CodeConflict           Code = "conflict"
+	CodePayloadTooLarge    Code = "payload_too_large"
+	CodeRateLimited        Code = "rate_limited"
+	CodeUpgradeRequired    Code = "upgrade_required"
+	CodeInternal           Code = "internal_error"
+	CodeNotImplemented     Code = "not_implemented"
+	CodeServiceUnavailable Code = "service_unavailable"
+)
+
+// Domain codes.
+const (
+	CodeInvalidCredentials   Code = "invalid_credentials"
+	CodeAccountExists        Code = "account_exists"
+	CodeSessionExpired       Code = "session_expired"
+	CodeInvalidPagination    Code = "invalid_pagination"
+	CodePatientNotFound      Code = "patient_not_found"
+	CodePairingCodeNotFound  Code = "pairing_code_not_found"
+	CodeNoDoctorAssigned     Code = "no_doctor_assigned"
+	CodeIdempotencyKeyReused Code = "idempotency_key_reused"
+	CodeIdempotencyInFlight  Code = "idempotency_request_in_progress"
+)
+
+var statusCodes = map[int]Code{
+	http.StatusBadRequest:            CodeBadRequest,
+	http.StatusUnauthorized:          CodeUnauthorized,
+	http.StatusForbidden:             CodeForbidden,
+	http.StatusNotFound:              CodeNotFound,
+	http.StatusMethodNotAllowed:      CodeMethodNotAllowed,
+	http.StatusConflict:              CodeConflict,
+	http.StatusRequestEntityTooLarge: CodePayloadTooLarge,
+	http.StatusTooManyRequests:       CodeRateLimited,
+	http.StatusUpgradeRequired:       CodeUpgradeRequired,
+	http.StatusInternalServerError:   CodeInternal,
+	http.StatusNotImplemented:        CodeNotImplemented,
+	http.StatusServiceUnavailable:    CodeServiceUnavailable,
+}
+
+// Catalog lists every code with the status it is reported with, so problem
+// type URIs can be documented.
+var Catalog = map[Code]int{
+	CodeValidationFailed:     http.StatusBadRequest,
+	CodeInvalidCredentials:   http.StatusUnauthorized,
+	CodeAccountExists:        http.StatusConflict,
+	CodeSessionExpired:       http.StatusUnauthorized,
+	CodeInvalidPagination:    http.StatusBadRequest,
+	CodePatientNotFound:      http.StatusNotFound,
+	CodePairingCodeNotFound:  http.StatusNotFound,
+	CodeNoDoctorAssigned:     http.StatusNotFound,
+	CodeIdempotencyKeyReused: http.StatusUnprocessableEntity,
+	CodeIdempotencyInFlight:  http.StatusConflict,
+}
+
+func init() {
+	for status, code := range statusCodes {
+		Catalog[code] = status
+	}
+}
+
+// CodeForStatus returns the generic code for an HTTP status.
+func CodeForStatus(status int) Code {
+	if code, ok := statusCodes[status]; ok {
+		return code
+	}
+	if status >= http.StatusInternalServerError {
+		return CodeInternal
+	}
+	return CodeBadRequest
+}
+
+// Error is a domain error that knows how it is reported to clients. Its
+// message must be safe to show to them.
+type Error struct {
+	Status  int
+	Code    Code
+	Message string
+}
+
+func New(status int, code Code, message string) *Error {
+	return &Error{Status: status, Code: code, Message: message}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+var (
+	ErrUnauthorized       = New(http.StatusUnauthorized, CodeUnauthorized, "unauthorized")
+	ErrInvalidCredentials = New(http.StatusUnauthorized, CodeInvalidCredentials, "invalid email or password")
+	ErrAccountExists      = New(http.StatusConflict, CodeAccountExists, "an account with that email already exists")
+	ErrPairingCodeInvalid = New(http.StatusNotFound, CodePairingCodeNotFound, "code not found or expired")
+	ErrNoDoctorAssigned   = New(http.StatusNotFound, CodeNoDoctorAssigned, "no doctor assigned")
+)
+
+// Errors from other packages that always mean the same thing to a client.
+var mapped = []struct {
+	target error
+	status int
+	code   Code
+}{
+	{auth.ErrNoSession, http.StatusUnauthorized, CodeUnauthorized},
+	{auth.ErrInvalidSession, http.StatusUnauthorized, CodeUnauthorized},
+	{auth.ErrExpiredSession, http.StatusUnauthorized, CodeSessionExpired},
+	{pagination.ErrInvalidLimit, http.StatusBadRequest, CodeInvalidPagination},
+	{pagination.ErrInvalidCursor, http.StatusBadRequest, CodeInvalidPagination},
+	{pagination.ErrInvalidSort, http.StatusBadRequest, CodeInvalidPagination},
+}
+
+// Resolve maps err to the status, code and message it should be reported
+// with. It reports false for errors that are not meant for clients, which
+// callers should log and answer with a 500.
+func Resolve(err error) (*Error, bool) {
+	var domain *Error
+	if stderrors.As(err, &domain) {
+		return domain, true
+	}
+	for _, m := range mapped {
+		if stderrors.Is(err, m.target) {
+			return New(m.status, m.code, err.Error()), true
+		}
+	}
+	switch {
+	case ent.IsNotFound(err):
+		return New(http.StatusNotFound, CodeNotFound, "not found"), true
+	case ent.IsConstraintError(err):
+		return New(http.StatusConflict, CodeConflict, "conflicts with an existing resource"), true
+	}
+	return nil, false
+}
@@ -0,0 +1,48 @@
+package errors
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"backend/internal/auth"
+	"backend/internal/pagination"
+)
+
+func TestResolve(t *testing.T) {
+	cases := []struct {
+		err    error
+		status int
+		code   Code
+	}{
+		{ErrPatientNotFound, http.StatusNotFound, CodePatientNotFound},
+		{fmt.Errorf("invite: %w", ErrAccountExists), http.StatusConflict, CodeAccountExists},
+		{pagination.ErrInvalidCursor, http.StatusBadRequest, CodeInvalidPagination},
+		{auth.ErrExpiredSession, http.StatusUnauthorized, CodeSessionExpired},
+	}
+	for _, tc := range cases {
+		got, ok := Resolve(tc.err)
+		if !ok {
+			t.Fatalf("Resolve(%v) reported no mapping", tc.err)
+		}
+		if got.Status != tc.status || got.Code != tc.code {
+			t.Fatalf("Resolve(%v) = %d %s; want %d %s", tc.err, got.Status, got.Code, tc.status, tc.code)
+		}
+	}
+
+	if _, ok := Resolve(fmt.Errorf("connection reset")); ok {
+		t.Fatal("expected internal errors to stay unmapped")
+	}
+}
+
+func TestCodeForStatus(t *testing.T) {
+	if got := CodeForStatus(http.StatusTooManyRequests); got != CodeRateLimited {
+		t.Fatalf("CodeForStatus(429) = %s", got)
+	}
+	if got := CodeForStatus(http.StatusBadGateway); got != CodeInternal {
+		t.Fatalf("CodeForStatus(502) = %s", got)
+	}
+	if got := CodeForStatus(http.StatusGone); got != CodeBadRequest {
+		t.Fatalf("CodeForStatus(410) = %s", got)
+	}
+}
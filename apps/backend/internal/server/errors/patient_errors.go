@@ -1,7 +1,5 @@
 package errors
 
-import (
-	err "errors"
-)
+import "net/http"
 
-var ErrPatientNotFound = err.New("patient couldn't be found in the database")
+var ErrPatientNotFound = New(http.StatusNotFound, CodePatientNotFound, "patient couldn't be found in the database")
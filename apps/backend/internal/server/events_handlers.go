@@ -0,0 +1,171 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/coder/websocket"
+)
+
+// eventsHeartbeat keeps idle streams alive through proxies that close quiet
+// connections.
+const eventsHeartbeat = 25 * time.Second
+
+// linkEventData is the payload of link.* events.
+type linkEventData struct {
+	LinkID    string `json:"linkId"`
+	PatientID string `json:"patientId"`
+	Status    string `json:"status"`
+}
+
+// entriesSyncedEventData is the payload of entries.synced events.
+type entriesSyncedEventData struct {
+	PatientID string `json:"patientId"`
+	Count     int    `json:"count"`
+}
+
+// eventsHandler streams the current doctor's events as Server-Sent Events.
+// @Summary Stream real-time events (Server-Sent Events)
+// @Description Sends link.requested, link.approved, link.revoked and entries.synced events. Each message's event field is the type and its data the JSON event. Events missed while disconnected are not replayed; refresh through the REST endpoints after reconnecting.
+// @Tags Events
+// @Produce text/event-stream
+// @Security SessionCookie
+// @Success 200 {string} string "event stream"
+// @Failure 401 {object} ErrorResponse
+// @Router /events [get]
+func (s *Server) eventsHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	// The stream is meant to outlive the server's write timeout.
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		log.FromContext(r.Context()).Error("failed to clear write deadline", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not open event stream")
+		return
+	}
+
+	ch, stop := s.Events.Subscribe(doc.ID)
+	defer stop()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Stop nginx from buffering the stream.
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprint(w, "retry: 5000\n\n"); err != nil {
+		return
+	}
+	if err := rc.Flush(); err != nil {
+		log.FromContext(r.Context()).Error("event stream cannot be flushed", "err", err)
+		return
+	}
+
+	heartbeat := time.NewTicker(eventsHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-s.stopping:
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		case ev, ok := <-ch:
+			if !ok {
+				return
+			}
+			payload, err := json.Marshal(ev)
+			if err != nil {
+				log.FromContext(r.Context()).Error("failed to encode event", "err", err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", ev.ID, ev.Type, payload); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// eventsWebSocketHandler streams the same events over a WebSocket.
+// @Summary Stream real-time events (WebSocket)
+// @Description Upgrades to a WebSocket that carries one JSON event per text message. Only same-origin upgrades are accepted; cross-origin clients should use the SSE stream.
+// @Tags Events
+// @Security SessionCookie
+// @Success 101 {string} string "switching protocols"
+// @Failure 401 {object} ErrorResponse
+// @Router /events/ws [get]
+func (s *Server) eventsWebSocketHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	// Deadlines set by the server stick to the connection after the upgrade.
+	rc := http.NewResponseController(w)
+	_ = rc.SetReadDeadline(time.Time{})
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	conn, err := websocket.Accept(w, r, nil)
+	if err != nil {
+		// Accept has already written the error response.
+		log.FromContext(r.Context()).Warn("websocket upgrade failed", "err", err)
+		return
+	}
+	defer conn.CloseNow()
+
+	ch, stop := s.Events.Subscribe(doc.ID)
+	defer stop()
+
+	// Clients only listen; CloseRead handles their pings and close frames.
+	ctx := conn.CloseRead(r.Context())
+
+	heartbeat := time.NewTicker(eventsHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stopping:
+			_ = conn.Close(websocket.StatusGoingAway, "server shutting down")
+			return
+		case <-heartbeat.C:
+			pingCtx, cancel := context.WithTimeout(ctx, eventsHeartbeat)
+			err := conn.Ping(pingCtx)
+			cancel()
+			if err != nil {
+				return
+			}
+		case ev, ok := <-ch:
+			if !ok {
+				return
+			}
+			payload, err := json.Marshal(ev)
+			if err != nil {
+				log.FromContext(r.Context()).Error("failed to encode event", "err", err)
+				continue
+			}
+			if err := conn.Write(ctx, websocket.MessageText, payload); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,386 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"backend/ent"
+	"backend/ent/goal"
+	"backend/ent/vocabularyterm"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type goalDTO struct {
+	ID          string          `json:"id"`
+	PatientID   string          `json:"patientId"`
+	DoctorID    string          `json:"doctorId"`
+	Title       string          `json:"title"`
+	Description *string         `json:"description,omitempty"`
+	Techniques  []string        `json:"techniques,omitempty"`
+	Triggers    []string        `json:"triggers,omitempty"`
+	Tags        []string        `json:"tags,omitempty"`
+	TargetCount int             `json:"targetCount"`
+	Period      string          `json:"period"`
+	StartsAt    time.Time       `json:"startsAt"`
+	EndsAt      *time.Time      `json:"endsAt,omitempty"`
+	Status      string          `json:"status"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+	Progress    goalProgressDTO `json:"progress"`
+}
+
+type goalsResponse struct {
+	Goals []goalDTO `json:"goals"`
+}
+
+type goalCreateRequest struct {
+	Title       string     `json:"title" validate:"notblank,max=200"`
+	Description *string    `json:"description,omitempty"`
+	Techniques  []string   `json:"techniques,omitempty"`
+	Triggers    []string   `json:"triggers,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
+	TargetCount int        `json:"targetCount" validate:"gte=1"`
+	Period      string     `json:"period,omitempty"`
+	StartsAt    *time.Time `json:"startsAt,omitempty"`
+	EndsAt      *time.Time `json:"endsAt,omitempty"`
+}
+
+type goalUpdateRequest struct {
+	Title       *string    `json:"title,omitempty" validate:"omitempty,notblank,max=200"`
+	Description *string    `json:"description,omitempty"`
+	TargetCount *int       `json:"targetCount,omitempty" validate:"omitempty,gte=1"`
+	EndsAt      *time.Time `json:"endsAt,omitempty"`
+	Status      *string    `json:"status,omitempty"`
+}
+
+// createGoalHandler assigns a goal to a linked patient.
+// @Summary Create a therapy goal for a patient (doctor must have approved link)
+// @Tags Goals
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Patient ID"
+// @Param request body GoalCreateRequest true "Goal"
+// @Success 201 {object} GoalDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /patients/{id}/goals [post]
+func (s *Server) createGoalHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	patientID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid patient id")
+		return
+	}
+
+	if ok := s.hasApprovedLink(r.Context(), doc.ID, patientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return
+	}
+
+	var req goalCreateRequest
+	if !s.decodeJSON(w, r, &req) {
+		return
+	}
+
+	title := strings.TrimSpace(req.Title)
+
+	period := goal.PeriodWeek
+	if req.Period != "" {
+		p, ok := parseGoalPeriod(req.Period)
+		if !ok {
+			s.writeError(w, http.StatusBadRequest, "period must be Day, Week, Month, or Total")
+			return
+		}
+		period = p
+	}
+
+	startsAt := time.Now().UTC()
+	if req.StartsAt != nil {
+		startsAt = req.StartsAt.UTC()
+	}
+	if req.EndsAt != nil && !req.EndsAt.After(startsAt) {
+		s.writeError(w, http.StatusBadRequest, "endsAt must be after startsAt")
+		return
+	}
+
+	ctx := r.Context()
+	// Goals match against stored entries, which hold vocabulary keys.
+	normalizer, err := s.patientNormalizer(ctx, patientID)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to load vocabulary", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not create goal")
+		return
+	}
+
+	create := s.Db.Ent().Goal.Create().
+		SetPatientID(patientID).
+		SetDoctorID(doc.ID).
+		SetTitle(title).
+		SetTargetCount(req.TargetCount).
+		SetPeriod(period).
+		SetStartsAt(startsAt).
+		SetNillableEndsAt(req.EndsAt).
+		SetTechniques(normalizer.NormalizeAll(vocabularyterm.KindTechnique.String(), req.Techniques)).
+		SetTriggers(normalizer.NormalizeAll(vocabularyterm.KindTrigger.String(), req.Triggers)).
+		// Tags have no vocabulary; this only trims and de-duplicates them.
+		SetTags(normalizer.NormalizeAll("", req.Tags))
+	if req.Description != nil && strings.TrimSpace(*req.Description) != "" {
+		create.SetDescription(strings.TrimSpace(*req.Description))
+	}
+
+	g, err := create.Save(ctx)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to create goal", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not create goal")
+		return
+	}
+
+	dto, err := s.buildGoalDTO(r.Context(), g)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to compute goal progress", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not create goal")
+		return
+	}
+	s.writeJSON(w, http.StatusCreated, dto)
+}
+
+// listPatientGoalsHandler lists a linked patient's goals with progress.
+// @Summary List a patient's goals with progress (doctor must have approved link)
+// @Tags Goals
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Patient ID"
+// @Param status query string false "Active (default), Completed, Cancelled, or all"
+// @Success 200 {object} GoalsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /patients/{id}/goals [get]
+func (s *Server) listPatientGoalsHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	patientID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid patient id")
+		return
+	}
+
+	if ok := s.hasApprovedLink(r.Context(), doc.ID, patientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return
+	}
+
+	s.serveGoals(w, r, patientID)
+}
+
+// myGoalsHandler lists the current patient's goals with progress.
+// @Summary List the current patient's goals with progress
+// @Tags Patient
+// @Produce json
+// @Security SessionCookie
+// @Param status query string false "Active (default), Completed, Cancelled, or all"
+// @Success 200 {object} GoalsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /patient/goals [get]
+func (s *Server) myGoalsHandler(w http.ResponseWriter, r *http.Request) {
+	p, ok := currentPatient(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	s.serveGoals(w, r, p.ID)
+}
+
+func (s *Server) serveGoals(w http.ResponseWriter, r *http.Request, patientID uuid.UUID) {
+	q := s.Db.Ent().Goal.Query().
+		Where(goal.PatientIDEQ(patientID)).
+		Order(ent.Desc(goal.FieldStartsAt))
+
+	switch raw := r.URL.Query().Get("status"); {
+	case raw == "":
+		q = q.Where(goal.StatusEQ(goal.StatusActive))
+	case strings.EqualFold(raw, "all"):
+	default:
+		status, ok := parseGoalStatus(raw)
+		if !ok {
+			s.writeError(w, http.StatusBadRequest, "status must be Active, Completed, Cancelled, or all")
+			return
+		}
+		q = q.Where(goal.StatusEQ(status))
+	}
+
+	goals, err := q.All(r.Context())
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to list goals", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not list goals")
+		return
+	}
+
+	resp := goalsResponse{Goals: make([]goalDTO, 0, len(goals))}
+	for _, g := range goals {
+		dto, err := s.buildGoalDTO(r.Context(), g)
+		if err != nil {
+			log.FromContext(r.Context()).Error("failed to compute goal progress", "goal_id", g.ID, "err", err)
+			s.writeError(w, http.StatusInternalServerError, "could not list goals")
+			return
+		}
+		resp.Goals = append(resp.Goals, dto)
+	}
+
+	s.writeJSON(w, http.StatusOK, resp)
+}
+
+// updateGoalHandler edits a goal or changes its status.
+// @Summary Update a goal (doctor must have approved link)
+// @Tags Goals
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Goal ID"
+// @Param request body GoalUpdateRequest true "Changes"
+// @Success 200 {object} GoalDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /goals/{id} [patch]
+func (s *Server) updateGoalHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	goalID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid goal id")
+		return
+	}
+
+	var req goalUpdateRequest
+	if !s.decodeJSON(w, r, &req) {
+		return
+	}
+
+	ctx := r.Context()
+	g, err := s.Db.Ent().Goal.Get(ctx, goalID)
+	if ent.IsNotFound(err) {
+		s.writeError(w, http.StatusNotFound, "goal not found")
+		return
+	} else if err != nil {
+		log.FromContext(r.Context()).Error("failed to load goal", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not update goal")
+		return
+	}
+
+	if ok := s.hasApprovedLink(ctx, doc.ID, g.PatientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return
+	}
+
+	update := s.Db.Ent().Goal.UpdateOneID(goalID)
+	if req.Title != nil {
+		update.SetTitle(strings.TrimSpace(*req.Title))
+	}
+	if req.Description != nil {
+		if desc := strings.TrimSpace(*req.Description); desc == "" {
+			update.ClearDescription()
+		} else {
+			update.SetDescription(desc)
+		}
+	}
+	if req.TargetCount != nil {
+		update.SetTargetCount(*req.TargetCount)
+	}
+	if req.EndsAt != nil {
+		if !req.EndsAt.After(g.StartsAt) {
+			s.writeError(w, http.StatusBadRequest, "endsAt must be after startsAt")
+			return
+		}
+		update.SetEndsAt(req.EndsAt.UTC())
+	}
+	if req.Status != nil {
+		status, ok := parseGoalStatus(*req.Status)
+		if !ok {
+			s.writeError(w, http.StatusBadRequest, "status must be Active, Completed, or Cancelled")
+			return
+		}
+		update.SetStatus(status)
+	}
+
+	g, err = update.Save(ctx)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to update goal", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not update goal")
+		return
+	}
+
+	dto, err := s.buildGoalDTO(r.Context(), g)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to compute goal progress", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not update goal")
+		return
+	}
+	s.writeJSON(w, http.StatusOK, dto)
+}
+
+func (s *Server) buildGoalDTO(ctx context.Context, g *ent.Goal) (goalDTO, error) {
+	progress, err := s.goalProgress(ctx, g, time.Now())
+	if err != nil {
+		return goalDTO{}, err
+	}
+	return goalDTO{
+		ID:          g.ID.String(),
+		PatientID:   g.PatientID.String(),
+		DoctorID:    g.DoctorID.String(),
+		Title:       g.Title,
+		Description: g.Description,
+		Techniques:  g.Techniques,
+		Triggers:    g.Triggers,
+		Tags:        g.Tags,
+		TargetCount: g.TargetCount,
+		Period:      g.Period.String(),
+		StartsAt:    g.StartsAt,
+		EndsAt:      g.EndsAt,
+		Status:      g.Status.String(),
+		CreatedAt:   g.CreatedAt,
+		UpdatedAt:   g.UpdatedAt,
+		Progress:    progress,
+	}, nil
+}
+
+func parseGoalPeriod(raw string) (goal.Period, bool) {
+	for _, p := range []goal.Period{goal.PeriodDay, goal.PeriodWeek, goal.PeriodMonth, goal.PeriodTotal} {
+		if strings.EqualFold(strings.TrimSpace(raw), p.String()) {
+			return p, true
+		}
+	}
+	return "", false
+}
+
+func parseGoalStatus(raw string) (goal.Status, bool) {
+	for _, st := range []goal.Status{goal.StatusActive, goal.StatusCompleted, goal.StatusCancelled} {
+		if strings.EqualFold(strings.TrimSpace(raw), st.String()) {
+			return st, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,190 @@
+package server
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"backend/ent"
+	"backend/ent/goal"
+)
+
+// goalHistoryPeriods caps how many past periods are returned per goal.
+const goalHistoryPeriods = 12
+
+const (
+	goalProgressUpcoming   = "upcoming"
+	goalProgressInProgress = "in_progress"
+	goalProgressOnTrack    = "on_track"
+	goalProgressBehind     = "behind"
+	goalProgressAchieved   = "achieved"
+	goalProgressEnded      = "ended"
+	goalProgressCompleted  = "completed"
+	goalProgressCancelled  = "cancelled"
+)
+
+type goalProgressDTO struct {
+	Status       string          `json:"status"`
+	PeriodStart  *time.Time      `json:"periodStart,omitempty"`
+	PeriodEnd    *time.Time      `json:"periodEnd,omitempty"`
+	Count        int             `json:"count"`
+	Target       int             `json:"target"`
+	Percent      float64         `json:"percent"`
+	TotalMatches int             `json:"totalMatches"`
+	PeriodsMet   int             `json:"periodsMet"`
+	PeriodsTotal int             `json:"periodsTotal"`
+	History      []goalPeriodDTO `json:"history,omitempty"`
+}
+
+type goalPeriodDTO struct {
+	Start time.Time `json:"start"`
+	Count int       `json:"count"`
+	Met   bool      `json:"met"`
+}
+
+// goalMatchesQuery counts entries matching a goal per period. Each criterion
+// list is ignored when empty; otherwise the entry must contain one of its values.
+// Periods are cut in UTC, whatever the session time zone.
+const goalMatchesQuery = `
+SELECT CASE WHEN $4 = 'total' THEN $2::timestamptz
+	ELSE date_trunc($4, e.happened_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC' END AS bucket, count(*)
+FROM entries e
+WHERE e.patient_id = $1::uuid AND e.happened_at >= $2 AND e.happened_at < $3
+	AND (cardinality($5::text[]) = 0 OR coalesce(e.techniques, '[]'::jsonb) ?| $5::text[])
+	AND (cardinality($6::text[]) = 0 OR coalesce(e.triggers, '[]'::jsonb) ?| $6::text[])
+	AND (cardinality($7::text[]) = 0 OR coalesce(e.tags, '[]'::jsonb) ?| $7::text[])
+GROUP BY 1`
+
+// goalProgress computes progress for g as of now.
+func (s *Server) goalProgress(ctx context.Context, g *ent.Goal, now time.Time) (goalProgressDTO, error) {
+	now = now.UTC()
+	progress := goalProgressDTO{Target: g.TargetCount}
+
+	start := g.StartsAt.UTC()
+	end := now
+	if g.EndsAt != nil && g.EndsAt.Before(end) {
+		end = g.EndsAt.UTC()
+	}
+
+	counts := map[time.Time]int{}
+	if start.Before(end) {
+		rows, err := s.Db.Ent().QueryContext(ctx, goalMatchesQuery,
+			g.PatientID.String(), start, end, strings.ToLower(g.Period.String()),
+			nonNilStrings(g.Techniques), nonNilStrings(g.Triggers), nonNilStrings(g.Tags))
+		if err != nil {
+			return progress, err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var (
+				bucket time.Time
+				count  int
+			)
+			if err := rows.Scan(&bucket, &count); err != nil {
+				return progress, err
+			}
+			counts[bucket.UTC()] = count
+			progress.TotalMatches += count
+		}
+		if err := rows.Err(); err != nil {
+			return progress, err
+		}
+	}
+
+	if g.Period == goal.PeriodTotal {
+		progress.Count = progress.TotalMatches
+	} else if start.Before(end) {
+		// Walk every period from the goal start so periods without entries count as missed.
+		var history []goalPeriodDTO
+		for p := goalPeriodStart(start, g.Period); p.Before(end); p = goalNextPeriod(p, g.Period) {
+			h := goalPeriodDTO{Start: p, Count: counts[p]}
+			h.Met = h.Count >= g.TargetCount
+			if h.Met {
+				progress.PeriodsMet++
+			}
+			history = append(history, h)
+		}
+		progress.PeriodsTotal = len(history)
+		if len(history) > 0 {
+			current := history[len(history)-1]
+			periodEnd := goalNextPeriod(current.Start, g.Period)
+			progress.PeriodStart = &current.Start
+			progress.PeriodEnd = &periodEnd
+			progress.Count = current.Count
+		}
+		if len(history) > goalHistoryPeriods {
+			history = history[len(history)-goalHistoryPeriods:]
+		}
+		progress.History = history
+	}
+
+	progress.Percent = math.Min(100, math.Round(float64(progress.Count)/float64(g.TargetCount)*1000)/10)
+	progress.Status = goalProgressStatus(g, progress, now)
+	return progress, nil
+}
+
+func goalProgressStatus(g *ent.Goal, progress goalProgressDTO, now time.Time) string {
+	switch {
+	case g.Status == goal.StatusCompleted:
+		return goalProgressCompleted
+	case g.Status == goal.StatusCancelled:
+		return goalProgressCancelled
+	case now.Before(g.StartsAt):
+		return goalProgressUpcoming
+	case progress.Count >= g.TargetCount:
+		return goalProgressAchieved
+	case g.EndsAt != nil && !now.Before(*g.EndsAt):
+		return goalProgressEnded
+	}
+
+	// Compare against the share of the target that should be reached by now.
+	var from, to time.Time
+	switch {
+	case g.Period != goal.PeriodTotal && progress.PeriodStart != nil:
+		from, to = *progress.PeriodStart, *progress.PeriodEnd
+	case g.Period == goal.PeriodTotal && g.EndsAt != nil:
+		from, to = g.StartsAt, *g.EndsAt
+	default:
+		return goalProgressInProgress
+	}
+	elapsed := now.Sub(from).Seconds() / to.Sub(from).Seconds()
+	if float64(progress.Count) >= math.Floor(float64(g.TargetCount)*elapsed) {
+		return goalProgressOnTrack
+	}
+	return goalProgressBehind
+}
+
+// goalPeriodStart mirrors Postgres date_trunc in UTC; weeks start on Monday.
+func goalPeriodStart(t time.Time, period goal.Period) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch period {
+	case goal.PeriodWeek:
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case goal.PeriodMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+func goalNextPeriod(start time.Time, period goal.Period) time.Time {
+	switch period {
+	case goal.PeriodWeek:
+		return start.AddDate(0, 0, 7)
+	case goal.PeriodMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
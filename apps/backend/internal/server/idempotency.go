@@ -0,0 +1,171 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"backend/internal/idempotency"
+	internal_errors "backend/internal/server/errors"
+
+	"github.com/charmbracelet/log"
+)
+
+const (
+	idempotencyPruneInterval = 10 * time.Minute
+	// maxReplayedResponse caps stored responses; larger ones are not kept
+	// and their key is released so a retry runs again.
+	maxReplayedResponse = 1 << 20
+)
+
+// idempotent handles requests carrying an Idempotency-Key once per caller
+// and key: retries get the stored response, a retry racing the first request
+// gets 409 and reusing the key for a different request gets 422. Responses
+// with a 5xx status are not kept, and store failures let requests through.
+// It must run after the auth middleware, which scopes keys to the caller.
+func (s *Server) idempotent(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(idempotency.Header)
+		if key == "" || s.Idempotency == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !idempotency.ValidKey(key) {
+			s.writeError(w, http.StatusBadRequest, "Idempotency-Key must be 1 to 255 visible ASCII characters")
+			return
+		}
+
+		limitBody(w, r)
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			s.writeDecodeError(w, err)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := r.Context()
+		logger := log.FromContext(ctx)
+		scoped := s.keyBySubject(r) + ":" + key
+		claim, ok, err := s.Idempotency.Begin(ctx, scoped, idempotency.Fingerprint(r.Method, r.URL.Path, body))
+		if err != nil {
+			logger.Error("failed to claim idempotency key", "err", err)
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		switch claim.State {
+		case idempotency.StateMismatch:
+			s.writeProblem(w, http.StatusUnprocessableEntity, internal_errors.CodeIdempotencyKeyReused,
+				"Idempotency-Key was already used for a different request", nil)
+			return
+		case idempotency.StateInProgress:
+			w.Header().Set("Retry-After", "1")
+			s.writeProblem(w, http.StatusConflict, internal_errors.CodeIdempotencyInFlight,
+				"a request with this Idempotency-Key is still in progress", nil)
+			return
+		case idempotency.StateCompleted:
+			logger.Info("replaying idempotent response", "status", claim.Response.Status)
+			if claim.Response.ContentType != "" {
+				w.Header().Set("Content-Type", claim.Response.ContentType)
+			}
+			w.Header().Set(idempotency.ReplayedHeader, "true")
+			w.WriteHeader(claim.Response.Status)
+			_, _ = w.Write(claim.Response.Body)
+			return
+		}
+
+		rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		// The client may be gone, but the outcome must still be recorded.
+		ctx = context.WithoutCancel(ctx)
+		if rec.status >= http.StatusInternalServerError || rec.overflow {
+			if err := s.Idempotency.Release(ctx, scoped); err != nil {
+				logger.Error("failed to release idempotency key", "err", err)
+			}
+			return
+		}
+		resp := idempotency.Response{
+			Status:      rec.status,
+			ContentType: rec.Header().Get("Content-Type"),
+			Body:        rec.body.Bytes(),
+		}
+		if err := s.Idempotency.Complete(ctx, scoped, resp); err != nil {
+			logger.Error("failed to store idempotent response", "err", err)
+		}
+	})
+}
+
+// responseRecorder copies the status and body of a response as it is written.
+type responseRecorder struct {
+	http.ResponseWriter
+	status   int
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(p []byte) (int, error) {
+	if !r.overflow {
+		if r.body.Len()+len(p) > maxReplayedResponse {
+			r.overflow = true
+			r.body.Reset()
+		} else {
+			r.body.Write(p)
+		}
+	}
+	return r.ResponseWriter.Write(p)
+}
+
+// setupIdempotency builds the keeper from IDEMPOTENCY_* settings.
+func (s *Server) setupIdempotency(ctx context.Context) error {
+	cfg, err := idempotency.LoadConfig()
+	if err != nil {
+		return err
+	}
+
+	switch cfg.Backend {
+	case idempotency.BackendOff:
+		log.Warn("idempotency keys are disabled")
+		return nil
+	case idempotency.BackendPostgres:
+		if s.Db == nil {
+			log.Warn("idempotency backend postgres needs a database; using memory")
+			break
+		}
+		store := idempotency.NewPostgresStore(s.Db.Ent())
+		s.Idempotency = idempotency.NewKeeper(store, cfg)
+		go s.runIdempotencyPrune(ctx, store)
+		return nil
+	}
+	s.Idempotency = idempotency.NewKeeper(idempotency.NewMemoryStore(), cfg)
+	return nil
+}
+
+// runIdempotencyPrune deletes expired keys every tick until ctx is cancelled.
+func (s *Server) runIdempotencyPrune(ctx context.Context, store *idempotency.PostgresStore) {
+	ticker := time.NewTicker(idempotencyPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		runJob(ctx, "idempotency.prune", func(ctx context.Context) error {
+			_, err := store.Prune(ctx, time.Now())
+			return err
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+const insightsTopCount = 5
+
+const (
+	trendImproving        = "improving"
+	trendWorsening        = "worsening"
+	trendStable           = "stable"
+	trendInsufficientData = "insufficient_data"
+)
+
+type insightsResponse struct {
+	analyticsResponse
+	Streak         insightsStreak  `json:"streak"`
+	Weekly         []weeklySummary `json:"weekly"`
+	TopTriggers    []namedCount    `json:"topTriggers"`
+	TopTechniques  []namedCount    `json:"topTechniques"`
+	TrendDirection string          `json:"trendDirection"`
+}
+
+type insightsStreak struct {
+	// Current counts consecutive journaling days up to today (or yesterday, so
+	// the streak survives until the patient had a chance to write today).
+	Current       int     `json:"current"`
+	Longest       int     `json:"longest"`
+	LastEntryDate *string `json:"lastEntryDate,omitempty"`
+}
+
+type weeklySummary struct {
+	WeekStart           string   `json:"weekStart"`
+	Entries             int      `json:"entries"`
+	AvgStutterFrequency *float64 `json:"avgStutterFrequency,omitempty"`
+}
+
+// patientInsightsHandler returns journaling feedback for the authenticated patient.
+// @Summary Self-insights for the current patient
+// @Tags Patient
+// @Produce json
+// @Security SessionCookie
+// @Param range query string false "Range in days (7, 30, or 90). Defaults to 7."
+// @Param bucket query string false "Trend bucket (day, week, or month). Defaults to day."
+// @Success 200 {object} InsightsResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /patient/insights [get]
+func (s *Server) patientInsightsHandler(w http.ResponseWriter, r *http.Request) {
+	p, ok := currentPatient(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	ctx := r.Context()
+	opts := parseAnalyticsOptions(r)
+
+	analytics, err := s.buildAnalytics(ctx, p.ID, opts)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to aggregate patient analytics", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not compute insights")
+		return
+	}
+
+	scope := opts.scope(p.ID)
+
+	weekly, err := s.queryWeeklySummaries(ctx, scope)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to aggregate weekly summaries", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not compute insights")
+		return
+	}
+
+	activity, err := s.queryPatientActivity(ctx, scope)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to aggregate patient trend", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not compute insights")
+		return
+	}
+
+	days, err := s.queryEntryDays(ctx, p.ID)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to load journaling days", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not compute insights")
+		return
+	}
+
+	resp := insightsResponse{
+		analyticsResponse: analytics,
+		Streak:            computeStreak(days, time.Now().UTC()),
+		Weekly:            weekly,
+		TopTriggers:       topCounts(analytics.Distributions.Triggers, insightsTopCount),
+		TopTechniques:     topCounts(analytics.Distributions.Techniques, insightsTopCount),
+		TrendDirection:    trendDirection(activity[p.ID]),
+	}
+
+	s.writeJSON(w, http.StatusOK, resp)
+}
+
+// computeStreak derives the current and longest run of consecutive days from
+// ascending, de-duplicated UTC calendar days.
+func computeStreak(days []time.Time, now time.Time) insightsStreak {
+	streak := insightsStreak{}
+	if len(days) == 0 {
+		return streak
+	}
+
+	run := 0
+	var prev time.Time
+	for i, day := range days {
+		if i > 0 && day.Sub(prev) == 24*time.Hour {
+			run++
+		} else {
+			run = 1
+		}
+		if run > streak.Longest {
+			streak.Longest = run
+		}
+		prev = day
+	}
+
+	last := days[len(days)-1]
+	lastDate := last.Format("2006-01-02")
+	streak.LastEntryDate = &lastDate
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if today.Sub(last) <= 24*time.Hour {
+		streak.Current = run
+	}
+	return streak
+}
+
+func trendDirection(a patientActivity) string {
+	if a.Slope == nil || a.Ratings < worseningMinRatings {
+		return trendInsufficientData
+	}
+	switch {
+	case *a.Slope >= worseningSlopePerDay:
+		return trendWorsening
+	case *a.Slope <= -worseningSlopePerDay:
+		return trendImproving
+	default:
+		return trendStable
+	}
+}
@@ -0,0 +1,30 @@
+package server
+
+import (
+	"context"
+
+	"backend/internal/tracing"
+
+	"github.com/charmbracelet/log"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// runJob runs one pass of a background job in its own trace, with a context
+// logger carrying the job name and trace ID. Failures are logged unless ctx
+// was cancelled.
+func runJob(ctx context.Context, name string, fn func(context.Context) error) {
+	ctx, span := tracing.Tracer().Start(ctx, name, trace.WithNewRoot())
+	defer span.End()
+
+	logger := log.Default().With("job", name).With(tracing.LogFields(ctx)...)
+	ctx = log.WithContext(ctx, logger)
+
+	if err := fn(ctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		if ctx.Err() == nil {
+			logger.Error("job failed", "err", err)
+		}
+	}
+}
@@ -2,16 +2,21 @@ package server
 
 import (
 	"context"
-	"errors"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
 	"backend/ent"
 	"backend/ent/doctorpatientlink"
 	"backend/ent/patient"
+	"backend/ent/predicate"
+	"backend/internal/metrics"
+	"backend/internal/outbox"
+	"backend/internal/pagination"
 	internal_errors "backend/internal/server/errors"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/charmbracelet/log"
 	"github.com/google/uuid"
 )
@@ -50,7 +55,27 @@ type patientRowDTO struct {
 }
 
 type patientsListResponse struct {
-	Rows []patientRowDTO `json:"rows"`
+	Rows       []patientRowDTO `json:"rows"`
+	NextCursor *string         `json:"nextCursor,omitempty"`
+}
+
+const (
+	patientsSortRequestedAt = "requestedAt"
+	patientsSortDisplayName = "displayName"
+)
+
+var patientsPageOptions = pagination.Options{
+	DefaultLimit: 50,
+	MaxLimit:     200,
+	Sorts:        []string{patientsSortRequestedAt, patientsSortDisplayName},
+	DefaultSort:  "-" + patientsSortRequestedAt,
+}
+
+// defaultPatientStatuses is used when no status filter is given; denied links stay hidden unless asked for.
+var defaultPatientStatuses = []doctorpatientlink.Status{
+	doctorpatientlink.StatusApproved,
+	doctorpatientlink.StatusPending,
+	doctorpatientlink.StatusRevoked,
 }
 
 // inviteLinkHandler invites or creates a patient and establishes a pending link.
@@ -60,10 +85,12 @@ type patientsListResponse struct {
 // @Produce json
 // @Security SessionCookie
 // @Param request body LinkInviteRequest true "Invite payload"
+// @Param Idempotency-Key header string false "Replays the stored response when a retry reuses the key"
 // @Success 201 {object} LinkResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
 // @Failure 409 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
 // @Router /links/invite [post]
 func (s *Server) inviteLinkHandler(w http.ResponseWriter, r *http.Request) {
 	doc, ok := currentDoctor(r.Context())
@@ -79,30 +106,47 @@ func (s *Server) inviteLinkHandler(w http.ResponseWriter, r *http.Request) {
 
 	p, err := s.resolvePatient(r.Context(), req)
 	if err != nil {
-		// resolvePatient already returns user-safe errors in the right cases
-		if errors.Is(err, internal_errors.ErrPatientNotFound) {
-			s.writeError(w, http.StatusNotFound, err.Error())
-			return
-		}
+		s.writeDomainError(w, r, err, "could not resolve patient")
+		return
+	}
 
-		s.writeError(w, http.StatusBadRequest, err.Error())
+	ctx := r.Context()
+	tx, err := s.Db.Ent().Tx(ctx)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to begin transaction", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not create link")
 		return
 	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
 
-	link, err := s.Db.Ent().DoctorPatientLink.
+	link, err := tx.DoctorPatientLink.
 		Create().
 		SetDoctorID(doc.ID).
 		SetPatientID(p.ID).
-		Save(r.Context())
+		Save(ctx)
 
 	if ent.IsConstraintError(err) {
 		s.writeError(w, http.StatusConflict, "link already exists")
 		return
 	} else if err != nil {
-		log.Error("failed to create link", "err", err)
+		log.FromContext(r.Context()).Error("failed to create link", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not create link")
+		return
+	}
+
+	if err := s.recordEvent(ctx, tx, outbox.TypeLinkRequested, linkOutboxDataOf(link)); err != nil {
+		log.FromContext(r.Context()).Error("failed to record link event", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not create link")
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		log.FromContext(r.Context()).Error("failed to commit link", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not create link")
 		return
 	}
+	s.wakeOutbox()
 
 	s.writeJSON(w, http.StatusCreated, map[string]any{
 		"link":    buildLinkDTO(link),
@@ -158,25 +202,49 @@ func (s *Server) approveLinkHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ctx := r.Context()
+	tx, err := s.Db.Ent().Tx(ctx)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to begin transaction", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not approve link")
+		return
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
 	now := time.Now()
-	link, err := s.Db.Ent().DoctorPatientLink.
+	link, err := tx.DoctorPatientLink.
 		UpdateOneID(id).
 		SetStatus(doctorpatientlink.StatusApproved).
 		SetApprovedAt(now).
 		SetApprovedByDoctorID(doc.ID).
-		Save(r.Context())
+		Save(ctx)
 	if ent.IsNotFound(err) {
 		s.writeError(w, http.StatusNotFound, "link not found")
 		return
 	} else if err != nil {
-		log.Error("failed to approve link", "err", err)
+		log.FromContext(r.Context()).Error("failed to approve link", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not approve link")
 		return
 	}
 
+	if err := s.recordEvent(ctx, tx, outbox.TypeLinkApproved, linkOutboxDataOf(link)); err != nil {
+		log.FromContext(r.Context()).Error("failed to record link event", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not approve link")
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		log.FromContext(r.Context()).Error("failed to commit link approval", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not approve link")
+		return
+	}
+	s.wakeOutbox()
+	s.Metrics.LinkApproved(metrics.LinkApprovedByDoctor)
+
 	p, err := s.Db.Ent().Patient.Get(r.Context(), link.PatientID)
 	if err != nil {
-		log.Error("failed to load patient", "err", err)
+		log.FromContext(r.Context()).Error("failed to load patient", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not load patient")
 		return
 	}
@@ -192,7 +260,13 @@ func (s *Server) approveLinkHandler(w http.ResponseWriter, r *http.Request) {
 // @Tags Patients
 // @Produce json
 // @Security SessionCookie
+// @Param search query string false "Case-insensitive match on name, email, or patient code"
+// @Param status query string false "Comma-separated link statuses (pending, approved, denied, revoked). Defaults to all but denied."
+// @Param sort query string false "requestedAt, -requestedAt (default), displayName, or -displayName"
+// @Param limit query int false "Page size (default 50, max 200)"
+// @Param cursor query string false "Opaque cursor from a previous page"
 // @Success 200 {object} PatientsResponse
+// @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
 // @Router /patients [get]
 func (s *Server) listPatientsHandler(w http.ResponseWriter, r *http.Request) {
@@ -203,30 +277,55 @@ func (s *Server) listPatientsHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := r.Context()
-	search := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("search")))
+	query := r.URL.Query()
+	search := strings.TrimSpace(query.Get("search"))
+
+	page, err := pagination.Parse(query, patientsPageOptions)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	statuses, err := parseLinkStatuses(query)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
-	links, err := s.Db.Ent().DoctorPatientLink.
+	q := s.Db.Ent().DoctorPatientLink.
 		Query().
 		Where(
 			doctorpatientlink.DoctorIDEQ(doc.ID),
-			// include revoked so the UI can show it
-			doctorpatientlink.StatusIn(
-				doctorpatientlink.StatusApproved,
-				doctorpatientlink.StatusPending,
-				doctorpatientlink.StatusRevoked,
-				// include denied if you want it visible:
-				// doctorpatientlink.StatusDenied,
-			),
-		).
+			doctorpatientlink.StatusIn(statuses...),
+		)
+	if search != "" {
+		q = q.Where(doctorpatientlink.HasPatientWith(patient.Or(
+			patient.DisplayNameContainsFold(search),
+			patient.EmailContainsFold(search),
+			patient.PatientCodeContainsFold(search),
+		)))
+	}
+	after, err := patientsAfter(page)
+	if err != nil {
+		s.writeDomainError(w, r, err, "could not list patients")
+		return
+	}
+	if after != nil {
+		q = q.Where(after)
+	}
+
+	links, err := q.
 		WithPatient().
-		Order(ent.Desc(doctorpatientlink.FieldRequestedAt)).
+		Order(patientsOrder(page)...).
+		Limit(page.Limit + 1).
 		All(ctx)
 	if err != nil {
-		log.Error("failed to list doctor links", "err", err)
+		log.FromContext(r.Context()).Error("failed to list doctor links", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not list patients")
 		return
 	}
 
+	links, more := pagination.Trim(links, page.Limit)
 	resp := patientsListResponse{Rows: make([]patientRowDTO, 0, len(links))}
 
 	for _, l := range links {
@@ -235,32 +334,108 @@ func (s *Server) listPatientsHandler(w http.ResponseWriter, r *http.Request) {
 			continue
 		}
 
-		if search != "" && !patientMatchesSearch(p, search) {
-			continue
-		}
-
 		resp.Rows = append(resp.Rows, patientRowDTO{
 			Patient: buildPatientDTO(p),
 			Link:    buildLinkDTO(l),
 		})
 	}
 
+	if more {
+		last := links[len(links)-1]
+		value := last.RequestedAt.UTC().Format(time.RFC3339Nano)
+		if page.Sort == patientsSortDisplayName && last.Edges.Patient != nil {
+			value = last.Edges.Patient.DisplayName
+		}
+		resp.NextCursor = page.Next(value, last.ID)
+	}
+
 	s.writeJSON(w, http.StatusOK, resp)
 }
 
-func patientMatchesSearch(p *ent.Patient, search string) bool {
-	if strings.Contains(strings.ToLower(strings.TrimSpace(p.DisplayName)), search) {
-		return true
+// linkStatusFilters maps the lower-case filter values accepted on the query string to link statuses.
+var linkStatusFilters = map[string]doctorpatientlink.Status{
+	"pending":  doctorpatientlink.StatusPending,
+	"approved": doctorpatientlink.StatusApproved,
+	"denied":   doctorpatientlink.StatusDenied,
+	"revoked":  doctorpatientlink.StatusRevoked,
+}
+
+func parseLinkStatuses(query url.Values) ([]doctorpatientlink.Status, error) {
+	raw, err := pagination.Filter(query, "status", []string{"pending", "approved", "denied", "revoked"})
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 {
+		return defaultPatientStatuses, nil
+	}
+
+	statuses := make([]doctorpatientlink.Status, 0, len(raw))
+	for _, v := range raw {
+		statuses = append(statuses, linkStatusFilters[v])
 	}
-	if p.Email != nil && strings.Contains(strings.ToLower(strings.TrimSpace(*p.Email)), search) {
-		return true
+	return statuses, nil
+}
+
+// patientsOrder sorts by the requested key with the link ID as tie-breaker.
+func patientsOrder(page pagination.Params) []doctorpatientlink.OrderOption {
+	dir := sql.OrderAsc()
+	if page.Desc {
+		dir = sql.OrderDesc()
 	}
-	if p.PatientCode != nil && strings.Contains(strings.ToLower(strings.TrimSpace(*p.PatientCode)), search) {
-		return true
+	if page.Sort == patientsSortDisplayName {
+		return []doctorpatientlink.OrderOption{
+			doctorpatientlink.ByPatientField(patient.FieldDisplayName, dir),
+			doctorpatientlink.ByID(dir),
+		}
 	}
-	return false
+	return []doctorpatientlink.OrderOption{doctorpatientlink.ByRequestedAt(dir), doctorpatientlink.ByID(dir)}
 }
 
+// patientsAfter returns the keyset predicate for the page after the cursor, or nil on the first page.
+func patientsAfter(page pagination.Params) (predicate.DoctorPatientLink, error) {
+	if page.Cursor == nil {
+		return nil, nil
+	}
+	id := page.Cursor.ID
+
+	if page.Sort == patientsSortDisplayName {
+		name := page.Cursor.Value
+		if page.Desc {
+			return doctorpatientlink.Or(
+				doctorpatientlink.HasPatientWith(patient.DisplayNameLT(name)),
+				doctorpatientlink.And(doctorpatientlink.HasPatientWith(patient.DisplayNameEQ(name)), doctorpatientlink.IDLT(id)),
+			), nil
+		}
+		return doctorpatientlink.Or(
+			doctorpatientlink.HasPatientWith(patient.DisplayNameGT(name)),
+			doctorpatientlink.And(doctorpatientlink.HasPatientWith(patient.DisplayNameEQ(name)), doctorpatientlink.IDGT(id)),
+		), nil
+	}
+
+	at, err := time.Parse(time.RFC3339Nano, page.Cursor.Value)
+	if err != nil {
+		return nil, pagination.ErrInvalidCursor
+	}
+	if page.Desc {
+		return doctorpatientlink.Or(
+			doctorpatientlink.RequestedAtLT(at),
+			doctorpatientlink.And(doctorpatientlink.RequestedAtEQ(at), doctorpatientlink.IDLT(id)),
+		), nil
+	}
+	return doctorpatientlink.Or(
+		doctorpatientlink.RequestedAtGT(at),
+		doctorpatientlink.And(doctorpatientlink.RequestedAtEQ(at), doctorpatientlink.IDGT(id)),
+	), nil
+}
+
+var (
+	errInvalidPatientID    = internal_errors.New(http.StatusBadRequest, internal_errors.CodeValidationFailed, "invalid patientId")
+	errDisplayNameRequired = internal_errors.New(http.StatusBadRequest, internal_errors.CodeValidationFailed, "displayName is required")
+	errNoPatientIdentifier = internal_errors.New(http.StatusBadRequest, internal_errors.CodeValidationFailed, "provide patientId, patientEmail, or patientCode")
+)
+
+// resolvePatient finds or creates the invited patient. Errors meant for the
+// client are domain errors.
 func (s *Server) resolvePatient(ctx context.Context, req linkInviteRequest) (*ent.Patient, error) {
 	q := s.Db.Ent().Patient.Query()
 
@@ -268,7 +443,7 @@ func (s *Server) resolvePatient(ctx context.Context, req linkInviteRequest) (*en
 	case req.PatientID != nil && strings.TrimSpace(*req.PatientID) != "":
 		id, err := uuid.Parse(strings.TrimSpace(*req.PatientID))
 		if err != nil {
-			return nil, errors.New("invalid patientId")
+			return nil, errInvalidPatientID
 		}
 		p, err := s.Db.Ent().Patient.Get(ctx, id)
 		if ent.IsNotFound(err) {
@@ -282,7 +457,7 @@ func (s *Server) resolvePatient(ctx context.Context, req linkInviteRequest) (*en
 		if ent.IsNotFound(err) {
 			displayName := strings.TrimSpace(valOrDefault(req.DisplayName, ""))
 			if displayName == "" {
-				return nil, errors.New("displayName is required")
+				return nil, errDisplayNameRequired
 			}
 
 			created, createErr := s.Db.Ent().Patient.
@@ -304,7 +479,7 @@ func (s *Server) resolvePatient(ctx context.Context, req linkInviteRequest) (*en
 		if ent.IsNotFound(err) {
 			displayName := strings.TrimSpace(valOrDefault(req.DisplayName, ""))
 			if displayName == "" {
-				return nil, errors.New("displayName is required")
+				return nil, errDisplayNameRequired
 			}
 
 			created, createErr := s.Db.Ent().Patient.
@@ -320,7 +495,7 @@ func (s *Server) resolvePatient(ctx context.Context, req linkInviteRequest) (*en
 		return p, err
 
 	default:
-		return nil, errors.New("provide patientId, patientEmail, or patientCode")
+		return nil, errNoPatientIdentifier
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"backend/ent/doctorpatientlink"
+	"backend/internal/outbox"
 
 	"github.com/charmbracelet/log"
 )
@@ -32,20 +33,64 @@ func (s *Server) revokeMyLinksHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := r.Context()
+	tx, err := s.Db.Ent().Tx(ctx)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to begin transaction", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not revoke link")
+		return
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
 
-	revoked, err := s.Db.Ent().DoctorPatientLink.
-		Update().
+	links, err := tx.DoctorPatientLink.
+		Query().
 		Where(
 			doctorpatientlink.PatientIDEQ(p.ID),
 			doctorpatientlink.StatusEQ(doctorpatientlink.StatusApproved),
 		).
-		SetStatus(doctorpatientlink.StatusRevoked).
-		Save(ctx)
+		All(ctx)
 	if err != nil {
-		log.Error("failed to revoke links", "err", err)
+		log.FromContext(r.Context()).Error("failed to load links", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not revoke link")
+		return
+	}
+
+	revoked := 0
+	for _, link := range links {
+		// Revoke one link at a time so each doctor hears only about their own.
+		n, err := tx.DoctorPatientLink.
+			Update().
+			Where(
+				doctorpatientlink.IDEQ(link.ID),
+				doctorpatientlink.StatusEQ(doctorpatientlink.StatusApproved),
+			).
+			SetStatus(doctorpatientlink.StatusRevoked).
+			Save(ctx)
+		if err != nil {
+			log.FromContext(r.Context()).Error("failed to revoke links", "err", err)
+			s.writeError(w, http.StatusInternalServerError, "could not revoke link")
+			return
+		}
+		if n == 0 {
+			continue
+		}
+		revoked += n
+
+		link.Status = doctorpatientlink.StatusRevoked
+		if err := s.recordEvent(ctx, tx, outbox.TypeLinkRevoked, linkOutboxDataOf(link)); err != nil {
+			log.FromContext(r.Context()).Error("failed to record link event", "err", err)
+			s.writeError(w, http.StatusInternalServerError, "could not revoke link")
+			return
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.FromContext(r.Context()).Error("failed to commit link revocation", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not revoke link")
 		return
 	}
+	s.wakeOutbox()
 
 	s.writeJSON(w, http.StatusOK, revokeLinksResponse{Revoked: revoked})
 }
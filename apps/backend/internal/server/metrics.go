@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"backend/ent"
+	"backend/ent/analysisjob"
+	"backend/internal/metrics"
+
+	"github.com/charmbracelet/log"
+)
+
+// setupMetrics creates the collectors and decides where /metrics is served:
+// on its own listener when METRICS_ADDR is set, otherwise on the API router
+// behind METRICS_TOKEN.
+func (s *Server) setupMetrics(ctx context.Context) {
+	s.Metrics = metrics.New()
+	if stats, ok := s.Db.(metrics.StatsSource); ok {
+		s.Metrics.RegisterDBStats(stats)
+	}
+	if s.Db != nil {
+		s.Metrics.RegisterStates("analysis_jobs", "Analysis jobs by state.", s.countAnalysisJobs)
+	}
+
+	cfg, err := metrics.LoadConfig()
+	if errors.Is(err, metrics.ErrNotConfigured) {
+		log.Warn("neither METRICS_ADDR nor METRICS_TOKEN set; /metrics is disabled")
+		return
+	}
+
+	handler := metrics.RequireToken(cfg.Token, s.Metrics.Handler())
+	if cfg.Addr == "" {
+		s.metricsHandler = handler
+		return
+	}
+	go serveMetrics(ctx, cfg.Addr, handler)
+}
+
+func serveMetrics(ctx context.Context, addr string, handler http.Handler) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", handler)
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+
+	log.Info("metrics listening", "addr", addr)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Error("metrics listener stopped", "err", err)
+	}
+}
+
+func (s *Server) countAnalysisJobs(ctx context.Context) (map[string]int, error) {
+	var rows []struct {
+		Status string `json:"status"`
+		Count  int    `json:"count"`
+	}
+	err := s.Db.Ent().AnalysisJob.
+		Query().
+		GroupBy(analysisjob.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	// Report every state so idle states read 0 rather than disappearing.
+	counts := make(map[string]int, 4)
+	for _, st := range []analysisjob.Status{
+		analysisjob.StatusQueued,
+		analysisjob.StatusRunning,
+		analysisjob.StatusDone,
+		analysisjob.StatusFailed,
+	} {
+		counts[st.String()] = 0
+	}
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,265 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"backend/ent"
+	"backend/ent/outboxevent"
+	"backend/internal/events"
+	"backend/internal/outbox"
+
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+)
+
+const (
+	outboxTickInterval = 5 * time.Second
+	outboxBatchSize    = 100
+	// outboxLease keeps other instances off a message while it is relayed.
+	outboxLease = 30 * time.Second
+
+	outboxPruneInterval = 10 * time.Minute
+	// outboxRetention is how long published messages are kept for
+	// inspection. Failed messages are kept until someone deals with them.
+	outboxRetention = 7 * 24 * time.Hour
+)
+
+// Outbox payloads. Each message concerns one doctor except entries.synced,
+// which lists every doctor the patient shares with.
+type linkOutboxData struct {
+	LinkID    string `json:"linkId"`
+	PatientID string `json:"patientId"`
+	DoctorID  string `json:"doctorId"`
+	Status    string `json:"status"`
+}
+
+type entriesSyncedOutboxData struct {
+	PatientID string      `json:"patientId"`
+	DoctorIDs []uuid.UUID `json:"doctorIds"`
+	Count     int         `json:"count"`
+}
+
+type entrySharedOutboxData struct {
+	PatientID string   `json:"patientId"`
+	DoctorID  string   `json:"doctorId"`
+	EntryIDs  []string `json:"entryIds"`
+}
+
+type patientInactiveOutboxData struct {
+	PatientID    string     `json:"patientId"`
+	DoctorID     string     `json:"doctorId"`
+	InactiveDays int        `json:"inactiveDays"`
+	LastEntryAt  *time.Time `json:"lastEntryAt,omitempty"`
+}
+
+func linkOutboxDataOf(link *ent.DoctorPatientLink) linkOutboxData {
+	return linkOutboxData{
+		LinkID:    link.ID.String(),
+		PatientID: link.PatientID.String(),
+		DoctorID:  link.DoctorID.String(),
+		Status:    link.Status.String(),
+	}
+}
+
+// recordEvent writes a domain event into the outbox as part of tx. Call
+// wakeOutbox once tx has committed to relay it without waiting for the next
+// tick.
+func (s *Server) recordEvent(ctx context.Context, tx *ent.Tx, eventType string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return tx.OutboxEvent.Create().
+		SetEventType(eventType).
+		SetPayload(payload).
+		SetNextAttemptAt(time.Now().UTC()).
+		Exec(ctx)
+}
+
+func (s *Server) wakeOutbox() {
+	select {
+	case s.outboxWake <- struct{}{}:
+	default:
+	}
+}
+
+// registerOutboxSubscribers connects the real-time event bus and practice
+// webhooks to the outbox.
+func (s *Server) registerOutboxSubscribers(reg *outbox.Registry) {
+	reg.Subscribe("realtime", s.publishOutboxEvent,
+		outbox.TypeLinkRequested, outbox.TypeLinkApproved, outbox.TypeLinkRevoked, outbox.TypeEntriesSynced)
+	reg.Subscribe("webhooks", s.enqueueOutboxWebhook,
+		outbox.TypeLinkApproved, outbox.TypeEntryShared, outbox.TypePatientInactive)
+}
+
+// runOutbox relays recorded events every tick, or sooner when woken, until
+// ctx is cancelled. Like the reminder scheduler it is safe to run on several
+// instances.
+func (s *Server) runOutbox(ctx context.Context) {
+	ticker := time.NewTicker(outboxTickInterval)
+	defer ticker.Stop()
+
+	for {
+		runJob(ctx, "outbox.relay", func(ctx context.Context) error {
+			return s.relayOutbox(ctx, time.Now().UTC())
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.outboxWake:
+		}
+	}
+}
+
+// runOutboxPrune deletes messages published longer than outboxRetention ago
+// every tick until ctx is cancelled.
+func (s *Server) runOutboxPrune(ctx context.Context) {
+	ticker := time.NewTicker(outboxPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		runJob(ctx, "outbox.prune", func(ctx context.Context) error {
+			_, err := s.Db.Ent().OutboxEvent.Delete().
+				Where(
+					outboxevent.StatusEQ(outboxevent.StatusPublished),
+					outboxevent.PublishedAtLT(time.Now().UTC().Add(-outboxRetention)),
+				).
+				Exec(ctx)
+			return err
+		})
+	}
+}
+
+// relayOutbox hands every due pending message to the subscribers, oldest
+// first.
+func (s *Server) relayOutbox(ctx context.Context, now time.Time) error {
+	if s.Outbox == nil {
+		return nil
+	}
+
+	rows, err := s.Db.Ent().OutboxEvent.Query().
+		Where(
+			outboxevent.StatusEQ(outboxevent.StatusPending),
+			outboxevent.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(outboxevent.FieldCreatedAt), ent.Asc(outboxevent.FieldID)).
+		Limit(outboxBatchSize).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err := s.relayOutboxEvent(ctx, row); err != nil {
+			log.FromContext(ctx).Error("failed to relay outbox event", "id", row.ID, "type", row.EventType, "err", err)
+		}
+	}
+	return nil
+}
+
+// relayOutboxEvent claims a message by leasing its next attempt, offers it to
+// the subscribers that have not accepted it yet and records the outcome. The
+// lease and the backoff start from the moment they are written, as earlier
+// messages in the batch may have taken a while.
+func (s *Server) relayOutboxEvent(ctx context.Context, row *ent.OutboxEvent) error {
+	claimed, err := s.Db.Ent().OutboxEvent.Update().
+		Where(
+			outboxevent.IDEQ(row.ID),
+			outboxevent.StatusEQ(outboxevent.StatusPending),
+			outboxevent.NextAttemptAtEQ(row.NextAttemptAt),
+		).
+		SetNextAttemptAt(time.Now().UTC().Add(outboxLease)).
+		Save(ctx)
+	if err != nil || claimed == 0 {
+		return err
+	}
+
+	msg := outbox.Message{ID: row.ID, Type: row.EventType, Payload: row.Payload, CreatedAt: row.CreatedAt}
+	done, deliverErr := s.Outbox.Deliver(ctx, msg, row.DeliveredTo)
+	if deliverErr != nil && ctx.Err() != nil {
+		// Shutting down; the lease expires and another attempt follows.
+		return nil
+	}
+
+	attempts := row.Attempts + 1
+	now := time.Now().UTC()
+	update := s.Db.Ent().OutboxEvent.UpdateOneID(row.ID).
+		SetDeliveredTo(done).
+		SetAttempts(attempts)
+	switch {
+	case deliverErr == nil:
+		update.SetStatus(outboxevent.StatusPublished).SetPublishedAt(now).ClearLastError()
+	case attempts >= outbox.MaxAttempts:
+		update.SetStatus(outboxevent.StatusFailed).SetLastError(deliverErr.Error())
+	default:
+		update.SetNextAttemptAt(now.Add(outbox.Backoff(attempts))).SetLastError(deliverErr.Error())
+	}
+	return update.Exec(ctx)
+}
+
+// publishOutboxEvent forwards link and sync events to the doctors' streams.
+// The event keeps the message ID so clients can drop redelivered duplicates.
+func (s *Server) publishOutboxEvent(ctx context.Context, m outbox.Message) error {
+	if s.Events == nil {
+		return nil
+	}
+
+	var doctorIDs []uuid.UUID
+	var data any
+	switch m.Type {
+	case outbox.TypeEntriesSynced:
+		var d entriesSyncedOutboxData
+		if err := m.Decode(&d); err != nil {
+			return err
+		}
+		doctorIDs = d.DoctorIDs
+		data = entriesSyncedEventData{PatientID: d.PatientID, Count: d.Count}
+	default:
+		var d linkOutboxData
+		if err := m.Decode(&d); err != nil {
+			return err
+		}
+		doctorID, err := uuid.Parse(d.DoctorID)
+		if err != nil {
+			return err
+		}
+		doctorIDs = []uuid.UUID{doctorID}
+		data = linkEventData{LinkID: d.LinkID, PatientID: d.PatientID, Status: d.Status}
+	}
+
+	for _, doctorID := range doctorIDs {
+		ev, err := events.New(m.Type, doctorID, data)
+		if err != nil {
+			return err
+		}
+		ev.ID = m.ID
+		if err := s.Events.Publish(ctx, ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enqueueOutboxWebhook queues the event for the webhooks of the doctor's
+// practice. Domain and webhook event types share their names, and the
+// payload is passed through as the webhook's data.
+func (s *Server) enqueueOutboxWebhook(ctx context.Context, m outbox.Message) error {
+	var target struct {
+		DoctorID uuid.UUID `json:"doctorId"`
+	}
+	if err := m.Decode(&target); err != nil {
+		return err
+	}
+	return s.queueWebhook(ctx, m.ID, m.Type, target.DoctorID, m.Payload)
+}
@@ -2,25 +2,24 @@ package server
 
 import (
 	"crypto/rand"
-	"encoding/json"
 	"errors"
 	"math/big"
 	"net/http"
-	"regexp"
 	"strings"
 	"time"
 
 	"backend/ent"
 	"backend/ent/doctorpatientlink"
 	"backend/ent/pairingcode"
+	"backend/internal/metrics"
+	"backend/internal/outbox"
+	internal_errors "backend/internal/server/errors"
 
 	"github.com/charmbracelet/log"
 )
 
 const pairingCodeTTL = 2 * time.Minute
 
-var pairingCodeRe = regexp.MustCompile(`^\d{6}$`)
-
 type PairingCodeCreateResponse struct {
 	Code      string    `json:"code"`
 	ExpiresAt time.Time `json:"expiresAt"`
@@ -28,7 +27,14 @@ type PairingCodeCreateResponse struct {
 }
 
 type PairingCodeRedeemRequest struct {
-	Code string `json:"code"`
+	Code string `json:"code" validate:"len=6,number"`
+}
+
+// normalize accepts codes typed with spaces or dashes, e.g. "123 456".
+func (req *PairingCodeRedeemRequest) normalize() {
+	code := strings.TrimSpace(req.Code)
+	code = strings.ReplaceAll(code, " ", "")
+	req.Code = strings.ReplaceAll(code, "-", "")
 }
 
 // createPairingCodeHandler creates a short-lived 6-digit code a patient can redeem.
@@ -64,7 +70,7 @@ func (s *Server) createPairingCodeHandler(w http.ResponseWriter, r *http.Request
 	for range 25 {
 		code, err := generateSixDigitCode()
 		if err != nil {
-			log.Error("failed to generate pairing code", "err", err)
+			log.FromContext(r.Context()).Error("failed to generate pairing code", "err", err)
 			s.writeError(w, http.StatusInternalServerError, "could not generate code")
 			return
 		}
@@ -78,7 +84,7 @@ func (s *Server) createPairingCodeHandler(w http.ResponseWriter, r *http.Request
 			).
 			Count(r.Context())
 		if err != nil {
-			log.Error("failed to check pairing code collision", "err", err)
+			log.FromContext(r.Context()).Error("failed to check pairing code collision", "err", err)
 			s.writeError(w, http.StatusInternalServerError, "could not generate code")
 			return
 		}
@@ -94,7 +100,7 @@ func (s *Server) createPairingCodeHandler(w http.ResponseWriter, r *http.Request
 			SetExpiresAt(expiresAt).
 			Save(r.Context())
 		if err != nil {
-			log.Error("failed to save pairing code", "err", err)
+			log.FromContext(r.Context()).Error("failed to save pairing code", "err", err)
 			s.writeError(w, http.StatusInternalServerError, "could not generate code")
 			return
 		}
@@ -118,11 +124,14 @@ func (s *Server) createPairingCodeHandler(w http.ResponseWriter, r *http.Request
 // @Produce json
 // @Security SessionCookie
 // @Param request body PairingCodeRedeemRequest true "Redeem payload"
+// @Param Idempotency-Key header string false "Replays the stored response when a retry reuses the key"
 // @Success 200 {object} LinkResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Failure 409 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /links/pairing-code/redeem [post]
 func (s *Server) redeemPairingCodeHandler(w http.ResponseWriter, r *http.Request) {
@@ -132,20 +141,16 @@ func (s *Server) redeemPairingCodeHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	var req PairingCodeRedeemRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		s.writeError(w, http.StatusBadRequest, "invalid JSON")
-		return
-	}
-
-	code := strings.TrimSpace(req.Code)
-	code = strings.ReplaceAll(code, " ", "")
-	code = strings.ReplaceAll(code, "-", "")
+	// Anything that does not reach a recognised outcome counts as an error.
+	result := metrics.PairingError
+	defer func() { s.Metrics.PairingCodeRedemption(result) }()
 
-	if !pairingCodeRe.MatchString(code) {
-		s.writeError(w, http.StatusBadRequest, "code must be 6 digits")
+	var req PairingCodeRedeemRequest
+	if !s.decodeJSON(w, r, &req) {
+		result = metrics.PairingInvalid
 		return
 	}
+	code := req.Code
 
 	now := time.Now().UTC()
 
@@ -159,17 +164,18 @@ func (s *Server) redeemPairingCodeHandler(w http.ResponseWriter, r *http.Request
 		Order(ent.Desc(pairingcode.FieldExpiresAt)).
 		First(r.Context())
 	if ent.IsNotFound(err) {
-		s.writeError(w, http.StatusNotFound, "code not found or expired")
+		result = metrics.PairingNotFound
+		s.writeDomainError(w, r, internal_errors.ErrPairingCodeInvalid, "could not redeem code")
 		return
 	} else if err != nil {
-		log.Error("failed to load pairing code", "err", err)
+		log.FromContext(r.Context()).Error("failed to load pairing code", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not redeem code")
 		return
 	}
 
 	tx, err := s.Db.Ent().Tx(r.Context())
 	if err != nil {
-		log.Error("failed to begin transaction", "err", err)
+		log.FromContext(r.Context()).Error("failed to begin transaction", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not redeem code")
 		return
 	}
@@ -188,15 +194,17 @@ func (s *Server) redeemPairingCodeHandler(w http.ResponseWriter, r *http.Request
 		SetConsumedByPatientID(p.ID).
 		Save(r.Context())
 	if ent.IsNotFound(err) {
-		s.writeError(w, http.StatusNotFound, "code not found or expired")
+		result = metrics.PairingNotFound
+		s.writeDomainError(w, r, internal_errors.ErrPairingCodeInvalid, "could not redeem code")
 		return
 	} else if err != nil {
-		log.Error("failed to consume pairing code", "err", err)
+		log.FromContext(r.Context()).Error("failed to consume pairing code", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not redeem code")
 		return
 	}
 
 	// Create (or approve) the link.
+	approved := false
 	link, err := tx.DoctorPatientLink.
 		Query().
 		Where(
@@ -216,13 +224,14 @@ func (s *Server) redeemPairingCodeHandler(w http.ResponseWriter, r *http.Request
 			SetApprovedByDoctorID(pc.DoctorID).
 			Save(r.Context())
 		if createErr != nil {
-			log.Error("failed to create doctor-patient link", "err", createErr)
+			log.FromContext(r.Context()).Error("failed to create doctor-patient link", "err", createErr)
 			s.writeError(w, http.StatusInternalServerError, "could not create link")
 			return
 		}
 		link = created
+		approved = true
 	} else if err != nil {
-		log.Error("failed to check existing link", "err", err)
+		log.FromContext(r.Context()).Error("failed to check existing link", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not redeem code")
 		return
 	} else {
@@ -235,19 +244,30 @@ func (s *Server) redeemPairingCodeHandler(w http.ResponseWriter, r *http.Request
 				SetApprovedByDoctorID(pc.DoctorID).
 				Save(r.Context())
 			if updErr != nil {
-				log.Error("failed to approve existing link", "err", updErr)
+				log.FromContext(r.Context()).Error("failed to approve existing link", "err", updErr)
 				s.writeError(w, http.StatusInternalServerError, "could not approve link")
 				return
 			}
 			link = updated
+			approved = true
 		}
 	}
 
+	if err := s.recordEvent(r.Context(), tx, outbox.TypeLinkApproved, linkOutboxDataOf(link)); err != nil {
+		log.FromContext(r.Context()).Error("failed to record link event", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not redeem code")
+		return
+	}
 	if err := tx.Commit(); err != nil {
-		log.Error("failed to commit pairing redeem", "err", err)
+		log.FromContext(r.Context()).Error("failed to commit pairing redeem", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not redeem code")
 		return
 	}
+	s.wakeOutbox()
+	result = metrics.PairingRedeemed
+	if approved {
+		s.Metrics.LinkApproved(metrics.LinkApprovedByCode)
+	}
 
 	s.writeJSON(w, http.StatusOK, map[string]any{
 		"link":    buildLinkDTO(link),
@@ -2,8 +2,6 @@ package server
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"net/http"
 	"time"
 
@@ -11,6 +9,10 @@ import (
 	"backend/ent/doctorpatientlink"
 	"backend/ent/entry"
 	"backend/ent/entryshare"
+	"backend/ent/schema"
+	"backend/ent/vocabularyterm"
+	"backend/internal/outbox"
+	"backend/internal/vocabulary"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/charmbracelet/log"
@@ -26,16 +28,23 @@ type patientEntriesSyncRequest struct {
 	To   string `json:"to,omitempty"`
 
 	// Entries is an optional upload payload (phone -> server). When present, the server will upsert entries.
-	Entries []entrySyncDTO `json:"entries,omitempty"`
+	Entries []entrySyncDTO `json:"entries,omitempty" validate:"omitempty,dive"`
 }
 
 type entrySyncDTO struct {
-	ID         string    `json:"id"`
+	ID         string    `json:"id" validate:"required,uuid"`
 	CreatedAt  time.Time `json:"createdAt"`
 	HappenedAt time.Time `json:"happenedAt"`
 	Notes      string    `json:"notes"`
 	Tags       []string  `json:"tags"`
 	UpdatedAt  time.Time `json:"updatedAt"`
+
+	// Structured fields are optional so older clients that only sync notes and tags keep working.
+	Situation        *string          `json:"situation,omitempty"`
+	Emotions         []schema.Emotion `json:"emotions,omitempty"`
+	Triggers         []string         `json:"triggers,omitempty"`
+	Techniques       []string         `json:"techniques,omitempty"`
+	StutterFrequency *int             `json:"stutterFrequency,omitempty" validate:"omitempty,gte=0,lte=10"`
 }
 
 type entriesSyncResponse struct {
@@ -47,71 +56,69 @@ type entriesSyncResponse struct {
 // Currently this behaves as a "download" endpoint and supports optional incremental sync:
 // - query: updatedSince, from, to (RFC3339)
 // - body (optional JSON): { updatedSince, from, to }
+// - header (optional): Idempotency-Key, so a retried upload replays the first response
 func (s *Server) patientEntriesSyncHandler(w http.ResponseWriter, r *http.Request) {
-	log := log.With(
-		"handler", "patientEntriesSync",
-		"method", r.Method,
-		"path", r.URL.Path,
-		"remote", r.RemoteAddr,
-	)
-
-	log.Info("request started")
+	log := log.FromContext(r.Context())
 
 	// ---------------------------------------------------------------------
 	// Auth / patient
 	// ---------------------------------------------------------------------
 	p, ok := currentPatient(r.Context())
 	if !ok {
-		log.Warn("unauthorized request")
 		s.writeError(w, http.StatusUnauthorized, "unauthorized")
 		return
 	}
-	log.Info("authenticated patient", "patient_id", p.ID)
 
 	// ---------------------------------------------------------------------
 	// Decode body (optional)
 	// ---------------------------------------------------------------------
 	req := patientEntriesSyncRequest{}
-	if r.Body != nil {
-		defer func() {
-			_ = r.Body.Close()
-			log.Debug("request body closed")
-		}()
-
-		dec := json.NewDecoder(r.Body)
-		if err := dec.Decode(&req); err != nil && err != io.EOF {
-			log.Warn("failed to decode json body", "err", err)
-			s.writeError(w, http.StatusBadRequest, "invalid json")
-			return
-		}
-
-		log.Debug("decoded request body",
-			"updatedSince", req.UpdatedSince,
-			"from", req.From,
-			"to", req.To,
-			"entries", len(req.Entries),
-		)
+	if !s.decodeOptionalJSON(w, r, &req) {
+		log.Warn("invalid request body")
+		return
 	}
+	log.Debug("decoded request body",
+		"updatedSince", req.UpdatedSince,
+		"from", req.From,
+		"to", req.To,
+		"entries", len(req.Entries),
+	)
 
 	// ---------------------------------------------------------------------
 	// Upload (optional): phone is the source of truth (one-way)
 	// ---------------------------------------------------------------------
 	uploadMode := len(req.Entries) > 0
+
+	// Uploads, the shares they cause and their outbox events commit together.
+	client := s.Db.Ent()
+	var tx *ent.Tx
+	if uploadMode || r.Method == http.MethodPost {
+		var err error
+		tx, err = s.Db.Ent().Tx(r.Context())
+		if err != nil {
+			log.Error("failed to begin transaction", "err", err)
+			s.writeError(w, http.StatusInternalServerError, "could not sync entries")
+			return
+		}
+		defer func() {
+			_ = tx.Rollback()
+		}()
+		client = tx.Client()
+	}
+
 	if uploadMode {
 		log.Info("uploading entries", "count", len(req.Entries))
-		for i, incoming := range req.Entries {
-			if incoming.ID == "" {
-				log.Warn("entry missing id", "index", i)
-				s.writeError(w, http.StatusBadRequest, "entry missing id")
-				return
-			}
 
-			id, err := uuid.Parse(incoming.ID)
-			if err != nil {
-				log.Warn("invalid entry id", "index", i, "id", incoming.ID, "err", err)
-				s.writeError(w, http.StatusBadRequest, "invalid entry id")
-				return
-			}
+		normalizer, err := s.patientNormalizer(r.Context(), p.ID)
+		if err != nil {
+			log.Error("failed to load vocabulary", "err", err)
+			s.writeError(w, http.StatusInternalServerError, "could not sync entries")
+			return
+		}
+
+		for i, incoming := range req.Entries {
+			// The id was validated with the request body.
+			id := uuid.MustParse(incoming.ID)
 
 			if incoming.HappenedAt.IsZero() {
 				log.Warn("entry missing happenedAt", "index", i, "id", incoming.ID)
@@ -119,7 +126,9 @@ func (s *Server) patientEntriesSyncHandler(w http.ResponseWriter, r *http.Reques
 				return
 			}
 
-			existing, err := s.Db.Ent().Entry.Get(r.Context(), id)
+			normalizeSyncEntry(normalizer, &incoming)
+
+			existing, err := client.Entry.Get(r.Context(), id)
 			if err != nil {
 				if !ent.IsNotFound(err) {
 					log.Error("failed to load entry", "index", i, "id", incoming.ID, "err", err)
@@ -127,7 +136,7 @@ func (s *Server) patientEntriesSyncHandler(w http.ResponseWriter, r *http.Reques
 					return
 				}
 
-				create := s.Db.Ent().Entry.Create().
+				create := client.Entry.Create().
 					SetID(id).
 					SetPatientID(p.ID).
 					SetHappenedAt(incoming.HappenedAt)
@@ -145,6 +154,21 @@ func (s *Server) patientEntriesSyncHandler(w http.ResponseWriter, r *http.Reques
 				if incoming.Tags != nil {
 					create.SetTags(incoming.Tags)
 				}
+				if incoming.Situation != nil && *incoming.Situation != "" {
+					create.SetSituation(*incoming.Situation)
+				}
+				if incoming.Emotions != nil {
+					create.SetEmotions(incoming.Emotions)
+				}
+				if incoming.Triggers != nil {
+					create.SetTriggers(incoming.Triggers)
+				}
+				if incoming.Techniques != nil {
+					create.SetTechniques(incoming.Techniques)
+				}
+				if incoming.StutterFrequency != nil {
+					create.SetStutterFrequency(*incoming.StutterFrequency)
+				}
 
 				if _, err := create.Save(r.Context()); err != nil {
 					log.Error("failed to create entry", "index", i, "id", incoming.ID, "err", err)
@@ -160,7 +184,7 @@ func (s *Server) patientEntriesSyncHandler(w http.ResponseWriter, r *http.Reques
 				return
 			}
 
-			update := s.Db.Ent().Entry.UpdateOneID(id).
+			update := client.Entry.UpdateOneID(id).
 				SetHappenedAt(incoming.HappenedAt)
 			if !incoming.UpdatedAt.IsZero() {
 				update.SetUpdatedAt(incoming.UpdatedAt)
@@ -173,6 +197,25 @@ func (s *Server) patientEntriesSyncHandler(w http.ResponseWriter, r *http.Reques
 			if incoming.Tags != nil {
 				update.SetTags(incoming.Tags)
 			}
+			if incoming.Situation != nil {
+				if *incoming.Situation == "" {
+					update.ClearSituation()
+				} else {
+					update.SetSituation(*incoming.Situation)
+				}
+			}
+			if incoming.Emotions != nil {
+				update.SetEmotions(incoming.Emotions)
+			}
+			if incoming.Triggers != nil {
+				update.SetTriggers(incoming.Triggers)
+			}
+			if incoming.Techniques != nil {
+				update.SetTechniques(incoming.Techniques)
+			}
+			if incoming.StutterFrequency != nil {
+				update.SetStutterFrequency(*incoming.StutterFrequency)
+			}
 
 			if _, err := update.Save(r.Context()); err != nil {
 				log.Error("failed to update entry", "index", i, "id", incoming.ID, "err", err)
@@ -181,6 +224,12 @@ func (s *Server) patientEntriesSyncHandler(w http.ResponseWriter, r *http.Reques
 			}
 		}
 
+		// Uploads count as journaling activity for inactivity reminders.
+		if err := client.Patient.UpdateOneID(p.ID).SetLastEntryAt(time.Now().UTC()).Exec(r.Context()); err != nil {
+			log.Error("failed to record journaling activity", "err", err)
+			s.writeError(w, http.StatusInternalServerError, "could not sync entries")
+			return
+		}
 	}
 
 	// ---------------------------------------------------------------------
@@ -249,7 +298,7 @@ func (s *Server) patientEntriesSyncHandler(w http.ResponseWriter, r *http.Reques
 	// ---------------------------------------------------------------------
 	log.Debug("building entry query")
 
-	q := s.Db.Ent().Entry.Query().
+	q := client.Entry.Query().
 		Where(entry.PatientIDEQ(p.ID)).
 		Order(entry.ByHappenedAt(sql.OrderDesc()))
 
@@ -280,13 +329,45 @@ func (s *Server) patientEntriesSyncHandler(w http.ResponseWriter, r *http.Reques
 	log.Info("entries fetched", "count", len(entries))
 
 	if r.Method == http.MethodPost {
-		if err := s.shareEntriesWithApprovedDoctors(r.Context(), p.ID, entries); err != nil {
+		if err := s.shareEntriesWithApprovedDoctors(r.Context(), tx, p.ID, entries); err != nil {
 			log.Error("failed to share entries with approved doctors", "err", err)
 			s.writeError(w, http.StatusInternalServerError, "could not sync entries")
 			return
 		}
 	}
 
+	if uploadMode {
+		doctorIDs, err := approvedDoctorIDs(r.Context(), client, p.ID)
+		if err != nil {
+			log.Error("failed to load approved doctors", "err", err)
+			s.writeError(w, http.StatusInternalServerError, "could not sync entries")
+			return
+		}
+		if len(doctorIDs) > 0 {
+			if err := s.recordEvent(r.Context(), tx, outbox.TypeEntriesSynced, entriesSyncedOutboxData{
+				PatientID: p.ID.String(),
+				DoctorIDs: doctorIDs,
+				Count:     len(req.Entries),
+			}); err != nil {
+				log.Error("failed to record sync event", "err", err)
+				s.writeError(w, http.StatusInternalServerError, "could not sync entries")
+				return
+			}
+		}
+	}
+
+	if tx != nil {
+		if err := tx.Commit(); err != nil {
+			log.Error("failed to commit entry sync", "err", err)
+			s.writeError(w, http.StatusInternalServerError, "could not sync entries")
+			return
+		}
+		s.wakeOutbox()
+	}
+	if uploadMode {
+		s.Metrics.EntriesSynced(len(req.Entries))
+	}
+
 	// ---------------------------------------------------------------------
 	// Build response
 	// ---------------------------------------------------------------------
@@ -306,12 +387,17 @@ func (s *Server) patientEntriesSyncHandler(w http.ResponseWriter, r *http.Reques
 		}
 
 		resp.Entries = append(resp.Entries, entrySyncDTO{
-			ID:         e.ID.String(),
-			CreatedAt:  e.CreatedAt,
-			HappenedAt: e.HappenedAt,
-			Notes:      notes,
-			Tags:       tags,
-			UpdatedAt:  e.UpdatedAt,
+			ID:               e.ID.String(),
+			CreatedAt:        e.CreatedAt,
+			HappenedAt:       e.HappenedAt,
+			Notes:            notes,
+			Tags:             tags,
+			UpdatedAt:        e.UpdatedAt,
+			Situation:        e.Situation,
+			Emotions:         e.Emotions,
+			Triggers:         e.Triggers,
+			Techniques:       e.Techniques,
+			StutterFrequency: e.StutterFrequency,
 		})
 	}
 
@@ -324,6 +410,26 @@ func (s *Server) patientEntriesSyncHandler(w http.ResponseWriter, r *http.Reques
 	log.Info("request completed successfully")
 }
 
+// normalizeSyncEntry maps emotions, triggers and techniques onto vocabulary keys
+// so that differently spelled uploads aggregate together.
+func normalizeSyncEntry(n *vocabulary.Normalizer, e *entrySyncDTO) {
+	if e.Emotions != nil {
+		emotions := make([]schema.Emotion, 0, len(e.Emotions))
+		seen := make(map[string]bool, len(e.Emotions))
+		for _, emo := range e.Emotions {
+			name := n.Normalize(vocabularyterm.KindEmotion.String(), emo.Name)
+			if name == "" || seen[name] {
+				continue
+			}
+			seen[name] = true
+			emotions = append(emotions, schema.Emotion{Name: name, Intensity: emo.Intensity})
+		}
+		e.Emotions = emotions
+	}
+	e.Triggers = n.NormalizeAll(vocabularyterm.KindTrigger.String(), e.Triggers)
+	e.Techniques = n.NormalizeAll(vocabularyterm.KindTechnique.String(), e.Techniques)
+}
+
 func firstNonEmpty(a, b string) string {
 	if a != "" {
 		return a
@@ -331,20 +437,16 @@ func firstNonEmpty(a, b string) string {
 	return b
 }
 
-func (s *Server) shareEntriesWithApprovedDoctors(ctx context.Context, patientID uuid.UUID, entries []*ent.Entry) error {
+// shareEntriesWithApprovedDoctors shares entries with every approved doctor as
+// part of tx and records an entry.shared event for each doctor that gained
+// access to any of them.
+func (s *Server) shareEntriesWithApprovedDoctors(ctx context.Context, tx *ent.Tx, patientID uuid.UUID, entries []*ent.Entry) error {
 	if len(entries) == 0 {
 		return nil
 	}
 
-	var doctorIDs []uuid.UUID
-	if err := s.Db.Ent().DoctorPatientLink.
-		Query().
-		Where(
-			doctorpatientlink.PatientIDEQ(patientID),
-			doctorpatientlink.StatusEQ(doctorpatientlink.StatusApproved),
-		).
-		Select(doctorpatientlink.FieldDoctorID).
-		Scan(ctx, &doctorIDs); err != nil {
+	doctorIDs, err := approvedDoctorIDs(ctx, tx.Client(), patientID)
+	if err != nil {
 		return err
 	}
 
@@ -353,8 +455,10 @@ func (s *Server) shareEntriesWithApprovedDoctors(ctx context.Context, patientID
 	}
 
 	now := time.Now().UTC()
+	// Entries newly visible to each doctor, for the entry.shared event.
+	shared := make(map[uuid.UUID][]string, len(doctorIDs))
 	for _, entryRecord := range entries {
-		shares, err := s.Db.Ent().EntryShare.
+		shares, err := tx.EntryShare.
 			Query().
 			Where(
 				entryshare.EntryIDEQ(entryRecord.ID),
@@ -373,31 +477,56 @@ func (s *Server) shareEntriesWithApprovedDoctors(ctx context.Context, patientID
 		for _, doctorID := range doctorIDs {
 			share, ok := sharesByDoctor[doctorID]
 			if !ok {
-				if _, err := s.Db.Ent().EntryShare.
+				// A concurrent share of the same entry fails the unique
+				// index and with it the transaction; the client retries.
+				if _, err := tx.EntryShare.
 					Create().
 					SetEntryID(entryRecord.ID).
 					SetSharedByPatientID(patientID).
 					SetSharedWithDoctorID(doctorID).
 					Save(ctx); err != nil {
-					if ent.IsConstraintError(err) {
-						continue
-					}
 					return err
 				}
+				shared[doctorID] = append(shared[doctorID], entryRecord.ID.String())
 				continue
 			}
 
 			if share.RevokedAt != nil {
-				if _, err := s.Db.Ent().EntryShare.
+				if _, err := tx.EntryShare.
 					UpdateOneID(share.ID).
 					ClearRevokedAt().
 					SetSharedAt(now).
 					Save(ctx); err != nil {
 					return err
 				}
+				shared[doctorID] = append(shared[doctorID], entryRecord.ID.String())
 			}
 		}
 	}
 
+	for doctorID, entryIDs := range shared {
+		if err := s.recordEvent(ctx, tx, outbox.TypeEntryShared, entrySharedOutboxData{
+			PatientID: patientID.String(),
+			DoctorID:  doctorID.String(),
+			EntryIDs:  entryIDs,
+		}); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// approvedDoctorIDs lists the doctors the patient currently shares data with.
+func approvedDoctorIDs(ctx context.Context, client *ent.Client, patientID uuid.UUID) ([]uuid.UUID, error) {
+	var doctorIDs []uuid.UUID
+	err := client.DoctorPatientLink.
+		Query().
+		Where(
+			doctorpatientlink.PatientIDEQ(patientID),
+			doctorpatientlink.StatusEQ(doctorpatientlink.StatusApproved),
+		).
+		Select(doctorpatientlink.FieldDoctorID).
+		Scan(ctx, &doctorIDs)
+	return doctorIDs, err
+}
@@ -4,13 +4,13 @@ import (
 	"context"
 	"errors"
 	"net/http"
-	"net/mail"
 	"strings"
 
 	"backend/ent"
 	"backend/ent/doctorpatientlink"
 	"backend/ent/patient"
 	"backend/internal/auth"
+	internal_errors "backend/internal/server/errors"
 
 	"github.com/charmbracelet/log"
 	"github.com/google/uuid"
@@ -19,14 +19,23 @@ import (
 type patientContextKey struct{}
 
 type patientRegisterRequest struct {
-	Email       string `json:"email"`
-	DisplayName string `json:"displayName"`
-	Password    string `json:"password"`
+	Email       string `json:"email" validate:"notblank,email"`
+	DisplayName string `json:"displayName" validate:"notblank"`
+	Password    string `json:"password" validate:"required"`
+}
+
+func (req *patientRegisterRequest) normalize() {
+	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
+	req.DisplayName = strings.TrimSpace(req.DisplayName)
 }
 
 type patientLoginRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email    string `json:"email" validate:"notblank"`
+	Password string `json:"password" validate:"required"`
+}
+
+func (req *patientLoginRequest) normalize() {
+	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
 }
 
 type patientResponse struct {
@@ -80,18 +89,18 @@ func (s *Server) myDoctorHandler(w http.ResponseWriter, r *http.Request) {
 		Order(ent.Desc(doctorpatientlink.FieldApprovedAt)).
 		First(r.Context())
 	if ent.IsNotFound(err) {
-		s.writeError(w, http.StatusNotFound, "no doctor assigned")
+		s.writeDomainError(w, r, internal_errors.ErrNoDoctorAssigned, "could not retrieve doctor information")
 		return
 	}
 	if err != nil {
-		log.Error("failed to query patient doctor link", "err", err)
+		log.FromContext(r.Context()).Error("failed to query patient doctor link", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not retrieve doctor information")
 		return
 	}
 
 	doc := link.Edges.Doctor
 	if doc == nil {
-		log.Error("doctor link missing doctor edge", "link_id", link.ID)
+		log.FromContext(r.Context()).Error("doctor link missing doctor edge", "link_id", link.ID)
 		s.writeError(w, http.StatusInternalServerError, "could not retrieve doctor information")
 		return
 	}
@@ -125,6 +134,7 @@ func (s *Server) myDoctorHandler(w http.ResponseWriter, r *http.Request) {
 // @Success 201 {object} PatientResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 409 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
 // @Router /patient/register [post]
 func (s *Server) patientRegisterHandler(w http.ResponseWriter, r *http.Request) {
 	if !s.ensureAuthReady(w) {
@@ -136,19 +146,6 @@ func (s *Server) patientRegisterHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
-	req.DisplayName = strings.TrimSpace(req.DisplayName)
-
-	if req.Email == "" || req.DisplayName == "" || req.Password == "" {
-		s.writeError(w, http.StatusBadRequest, "email, displayName, and password are required")
-		return
-	}
-
-	if _, err := mail.ParseAddress(req.Email); err != nil {
-		s.writeError(w, http.StatusBadRequest, "email is invalid")
-		return
-	}
-
 	hash, err := s.Auth.HashPassword(req.Password)
 	if err != nil {
 		s.writeError(w, http.StatusBadRequest, err.Error())
@@ -163,16 +160,16 @@ func (s *Server) patientRegisterHandler(w http.ResponseWriter, r *http.Request)
 		Save(r.Context())
 	if err != nil {
 		if ent.IsConstraintError(err) {
-			s.writeError(w, http.StatusConflict, "an account with that email already exists")
+			s.writeDomainError(w, r, internal_errors.ErrAccountExists, "could not create account")
 			return
 		}
-		log.Error("failed to create patient", "err", err)
+		log.FromContext(r.Context()).Error("failed to create patient", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not create account")
 		return
 	}
 
 	if err := s.Auth.IssuePatientSession(w, p.ID); err != nil {
-		log.Error("failed to set session cookie", "err", err)
+		log.FromContext(r.Context()).Error("failed to set session cookie", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not create session")
 		return
 	}
@@ -191,6 +188,7 @@ func (s *Server) patientRegisterHandler(w http.ResponseWriter, r *http.Request)
 // @Success 200 {object} PatientResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
 // @Router /patient/login [post]
 func (s *Server) patientLoginHandler(w http.ResponseWriter, r *http.Request) {
 	if !s.ensureAuthReady(w) {
@@ -202,37 +200,31 @@ func (s *Server) patientLoginHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
-	if req.Email == "" || req.Password == "" {
-		s.writeError(w, http.StatusBadRequest, "email and password are required")
-		return
-	}
-
 	p, err := s.Db.Ent().Patient.Query().
 		Where(patient.EmailEQ(req.Email)).
 		Only(r.Context())
 	if err != nil {
 		if ent.IsNotFound(err) {
-			s.writeError(w, http.StatusUnauthorized, "invalid email or password")
+			s.writeDomainError(w, r, internal_errors.ErrInvalidCredentials, "could not check credentials")
 			return
 		}
-		log.Error("failed to query patient", "err", err)
+		log.FromContext(r.Context()).Error("failed to query patient", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not check credentials")
 		return
 	}
 
 	if p.PasswordHash == nil || strings.TrimSpace(*p.PasswordHash) == "" {
-		s.writeError(w, http.StatusUnauthorized, "invalid email or password")
+		s.writeDomainError(w, r, internal_errors.ErrInvalidCredentials, "could not check credentials")
 		return
 	}
 
 	if err := s.Auth.VerifyPassword(*p.PasswordHash, req.Password); err != nil {
-		s.writeError(w, http.StatusUnauthorized, "invalid email or password")
+		s.writeDomainError(w, r, internal_errors.ErrInvalidCredentials, "could not check credentials")
 		return
 	}
 
 	if err := s.Auth.IssuePatientSession(w, p.ID); err != nil {
-		log.Error("failed to set session cookie", "err", err)
+		log.FromContext(r.Context()).Error("failed to set session cookie", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not create session")
 		return
 	}
@@ -286,7 +278,7 @@ func (s *Server) requirePatient(next http.Handler) http.Handler {
 			if errors.Is(err, auth.ErrExpiredSession) || errors.Is(err, auth.ErrInvalidSession) {
 				s.Auth.ClearSession(w)
 			}
-			s.writeError(w, http.StatusUnauthorized, "unauthorized")
+			s.writeDomainError(w, r, err, "could not read session")
 			return
 		}
 
@@ -303,12 +295,13 @@ func (s *Server) requirePatient(next http.Handler) http.Handler {
 				s.writeError(w, http.StatusUnauthorized, "unauthorized")
 				return
 			}
-			log.Error("failed to load patient for session", "err", err)
+			log.FromContext(r.Context()).Error("failed to load patient for session", "err", err)
 			s.writeError(w, http.StatusInternalServerError, "could not load account")
 			return
 		}
 
 		ctx := context.WithValue(r.Context(), patientContextKey{}, p)
+		ctx = withActor(ctx, "patient", p.ID)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
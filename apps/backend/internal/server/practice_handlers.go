@@ -11,11 +11,15 @@ import (
 )
 
 type practiceCreateRequest struct {
-	Name    string  `json:"name"`
+	Name    string  `json:"name" validate:"notblank"`
 	Address *string `json:"address,omitempty"`
 	LogoURL *string `json:"logoUrl,omitempty"`
 }
 
+func (req *practiceCreateRequest) normalize() {
+	req.Name = strings.TrimSpace(req.Name)
+}
+
 type practiceResponse struct {
 	ID      string  `json:"id"`
 	Name    string  `json:"name"`
@@ -46,12 +50,6 @@ func (s *Server) practiceCreateHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req.Name = strings.TrimSpace(req.Name)
-	if req.Name == "" {
-		s.writeError(w, http.StatusBadRequest, "name is required")
-		return
-	}
-
 	builder := s.Db.Ent().Practice.Create().SetName(req.Name)
 	if req.Address != nil {
 		addr := strings.TrimSpace(*req.Address)
@@ -60,7 +58,7 @@ func (s *Server) practiceCreateHandler(w http.ResponseWriter, r *http.Request) {
 
 	practice, err := builder.Save(r.Context())
 	if err != nil {
-		log.Error("failed to create practice", "err", err)
+		log.FromContext(r.Context()).Error("failed to create practice", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not create practice")
 		return
 	}
@@ -72,7 +70,7 @@ func (s *Server) practiceCreateHandler(w http.ResponseWriter, r *http.Request) {
 
 	updatedDoctor, err := update.Save(r.Context())
 	if err != nil {
-		log.Error("failed to assign practice to doctor", "err", err)
+		log.FromContext(r.Context()).Error("failed to assign practice to doctor", "err", err)
 		s.writeError(w, http.StatusInternalServerError, "could not assign practice")
 		return
 	}
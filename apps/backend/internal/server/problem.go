@@ -0,0 +1,108 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	internal_errors "backend/internal/server/errors"
+	"backend/internal/validation"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	problemContentType = "application/problem+json"
+	// Problem types are relative URIs documented by problemTypeHandler.
+	problemTypeBase = "/problems/"
+)
+
+// problemDetails is an RFC 7807 problem document with the code, request ID
+// and field errors as extension members.
+type problemDetails struct {
+	Type      string                  `json:"type"`
+	Title     string                  `json:"title"`
+	Status    int                     `json:"status"`
+	Code      internal_errors.Code    `json:"code"`
+	Detail    string                  `json:"detail,omitempty"`
+	RequestID string                  `json:"requestId,omitempty"`
+	Fields    []validation.FieldError `json:"fields,omitempty"`
+	// Error repeats Detail for clients that predate problem documents.
+	// Deprecated: use Code.
+	Error string `json:"error,omitempty"`
+}
+
+type problemTypeResponse struct {
+	Type   string               `json:"type"`
+	Title  string               `json:"title"`
+	Status int                  `json:"status"`
+	Code   internal_errors.Code `json:"code"`
+}
+
+func (s *Server) writeProblem(w http.ResponseWriter, status int, code internal_errors.Code, detail string, fields []validation.FieldError) {
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(status)
+
+	problem := problemDetails{
+		Type:   problemTypeBase + string(code),
+		Title:  http.StatusText(status),
+		Status: status,
+		Code:   code,
+		Detail: detail,
+		// requestLogger has already set the header on the response.
+		RequestID: w.Header().Get(requestIDHeader),
+		Fields:    fields,
+		Error:     detail,
+	}
+	if err := json.NewEncoder(w).Encode(problem); err != nil {
+		log.Error("failed to encode problem", "err", err)
+	}
+}
+
+// writeError writes a problem with the generic code for status.
+func (s *Server) writeError(w http.ResponseWriter, status int, message string) {
+	s.writeProblem(w, status, internal_errors.CodeForStatus(status), message, nil)
+}
+
+// writeDomainError reports err with the status and code it maps to. Errors
+// that are not meant for clients are logged and answered with a 500 carrying
+// failure instead of their message.
+func (s *Server) writeDomainError(w http.ResponseWriter, r *http.Request, err error, failure string) {
+	if domain, ok := internal_errors.Resolve(err); ok {
+		s.writeProblem(w, domain.Status, domain.Code, domain.Message, nil)
+		return
+	}
+	log.FromContext(r.Context()).Error(failure, "err", err)
+	s.writeError(w, http.StatusInternalServerError, failure)
+}
+
+func (s *Server) notFoundHandler(w http.ResponseWriter, r *http.Request) {
+	s.writeError(w, http.StatusNotFound, "no route matches "+r.URL.Path)
+}
+
+func (s *Server) methodNotAllowedHandler(w http.ResponseWriter, r *http.Request) {
+	s.writeError(w, http.StatusMethodNotAllowed, r.Method+" is not allowed on "+r.URL.Path)
+}
+
+// problemTypeHandler documents a problem type URI.
+// @Summary Describe a problem type
+// @Tags System
+// @Produce json
+// @Param code path string true "Error code"
+// @Success 200 {object} ProblemTypeResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /problems/{code} [get]
+func (s *Server) problemTypeHandler(w http.ResponseWriter, r *http.Request) {
+	code := internal_errors.Code(chi.URLParam(r, "code"))
+	status, ok := internal_errors.Catalog[code]
+	if !ok {
+		s.writeError(w, http.StatusNotFound, "unknown problem type")
+		return
+	}
+	s.writeJSON(w, http.StatusOK, problemTypeResponse{
+		Type:   problemTypeBase + string(code),
+		Title:  http.StatusText(status),
+		Status: status,
+		Code:   code,
+	})
+}
@@ -0,0 +1,784 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"backend/ent"
+	"backend/ent/questionnaireassignment"
+	"backend/ent/questionnairedefinition"
+	"backend/internal/questionnaire"
+	"backend/internal/validation"
+	"backend/internal/vocabulary"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// defaultAssessmentRangeDays is how far back score charts reach by default;
+// standardised questionnaires are typically repeated every few months.
+const defaultAssessmentRangeDays = 365
+
+type questionnaireSummaryDTO struct {
+	ID          string    `json:"id"`
+	Key         string    `json:"key"`
+	Version     int       `json:"version"`
+	Title       string    `json:"title"`
+	Description *string   `json:"description,omitempty"`
+	Items       int       `json:"items"`
+	Active      bool      `json:"active"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type questionnaireDTO struct {
+	questionnaireSummaryDTO
+	Definition questionnaire.Definition `json:"definition"`
+}
+
+type questionnairesResponse struct {
+	Questionnaires []questionnaireSummaryDTO `json:"questionnaires"`
+}
+
+type questionnaireCreateRequest struct {
+	Key         string                   `json:"key" validate:"notblank,max=64"`
+	Title       string                   `json:"title" validate:"notblank,max=200"`
+	Description *string                  `json:"description,omitempty"`
+	Definition  questionnaire.Definition `json:"definition"`
+}
+
+func (req *questionnaireCreateRequest) normalize() {
+	req.Key = vocabulary.Slug(req.Key)
+	req.Title = strings.TrimSpace(req.Title)
+}
+
+type questionnaireAssignRequest struct {
+	// DefinitionID pins a version; otherwise the latest active version of Key is used.
+	DefinitionID *string    `json:"definitionId,omitempty" validate:"omitempty,uuid"`
+	Key          *string    `json:"key,omitempty"`
+	DueAt        *time.Time `json:"dueAt,omitempty"`
+}
+
+type questionnaireAssignmentUpdateRequest struct {
+	Status string `json:"status"`
+}
+
+type questionnaireAssignmentDTO struct {
+	ID            string                     `json:"id"`
+	PatientID     string                     `json:"patientId"`
+	DoctorID      string                     `json:"doctorId"`
+	Status        string                     `json:"status"`
+	DueAt         *time.Time                 `json:"dueAt,omitempty"`
+	CompletedAt   *time.Time                 `json:"completedAt,omitempty"`
+	CreatedAt     time.Time                  `json:"createdAt"`
+	Questionnaire questionnaireDTO           `json:"questionnaire"`
+	Scores        []questionnaire.ScaleScore `json:"scores,omitempty"`
+}
+
+type questionnaireAssignmentsResponse struct {
+	Assignments []questionnaireAssignmentDTO `json:"assignments"`
+}
+
+type questionnaireSubmitRequest struct {
+	Answers map[string]string `json:"answers"`
+}
+
+type assessmentsResponse struct {
+	From   time.Time             `json:"from"`
+	To     time.Time             `json:"to"`
+	Series []assessmentSeriesDTO `json:"series"`
+}
+
+// listQuestionnairesHandler lists published questionnaires.
+// @Summary List questionnaires (latest active version of each, or every version of one key)
+// @Tags Questionnaires
+// @Produce json
+// @Security SessionCookie
+// @Param key query string false "List every version of this questionnaire"
+// @Success 200 {object} QuestionnairesResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /questionnaires [get]
+func (s *Server) listQuestionnairesHandler(w http.ResponseWriter, r *http.Request) {
+	if _, ok := currentDoctor(r.Context()); !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	q := s.Db.Ent().QuestionnaireDefinition.Query().
+		Order(ent.Asc(questionnairedefinition.FieldKey), ent.Desc(questionnairedefinition.FieldVersion))
+	key := strings.TrimSpace(r.URL.Query().Get("key"))
+	if key != "" {
+		q = q.Where(questionnairedefinition.KeyEQ(key))
+	} else {
+		q = q.Where(questionnairedefinition.Active(true))
+	}
+
+	defs, err := q.All(r.Context())
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to list questionnaires", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not list questionnaires")
+		return
+	}
+
+	resp := questionnairesResponse{Questionnaires: []questionnaireSummaryDTO{}}
+	for i, d := range defs {
+		// Rows are ordered newest version first within each key.
+		if key == "" && i > 0 && defs[i-1].Key == d.Key {
+			continue
+		}
+		resp.Questionnaires = append(resp.Questionnaires, mapQuestionnaireSummaryDTO(d))
+	}
+	s.writeJSON(w, http.StatusOK, resp)
+}
+
+// createQuestionnaireHandler publishes a questionnaire or a new version of one.
+// Only the doctor who published a key may publish further versions of it.
+// @Summary Publish a questionnaire definition
+// @Description Publishing an existing key creates the next version and retires the previous ones; responses keep pointing at the version they answered. Only the doctor who published the key may publish new versions.
+// @Tags Questionnaires
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param request body QuestionnaireCreateRequest true "Definition"
+// @Success 201 {object} QuestionnaireDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /questionnaires [post]
+func (s *Server) createQuestionnaireHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req questionnaireCreateRequest
+	if !s.decodeJSON(w, r, &req) {
+		return
+	}
+
+	key, title := req.Key, req.Title
+	if err := req.Definition.Validate(); err != nil {
+		s.writeValidationError(w, validation.Errors{{Field: "definition", Message: err.Error()}})
+		return
+	}
+
+	ctx := r.Context()
+	tx, err := s.Db.Ent().Tx(ctx)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to begin transaction", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not publish questionnaire")
+		return
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	version := 1
+	latest, err := tx.QuestionnaireDefinition.Query().
+		Where(questionnairedefinition.KeyEQ(key)).
+		Order(ent.Desc(questionnairedefinition.FieldVersion)).
+		First(ctx)
+	if err == nil {
+		if latest.CreatedByDoctorID == nil || *latest.CreatedByDoctorID != doc.ID {
+			s.writeError(w, http.StatusForbidden, "questionnaire key belongs to another doctor")
+			return
+		}
+		version = latest.Version + 1
+	} else if !ent.IsNotFound(err) {
+		log.FromContext(r.Context()).Error("failed to load questionnaire versions", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not publish questionnaire")
+		return
+	}
+
+	if _, err := tx.QuestionnaireDefinition.Update().
+		Where(questionnairedefinition.KeyEQ(key), questionnairedefinition.Active(true)).
+		SetActive(false).
+		Save(ctx); err != nil {
+		log.FromContext(r.Context()).Error("failed to retire questionnaire versions", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not publish questionnaire")
+		return
+	}
+
+	create := tx.QuestionnaireDefinition.Create().
+		SetKey(key).
+		SetVersion(version).
+		SetTitle(title).
+		SetDefinition(req.Definition).
+		SetCreatedByDoctorID(doc.ID)
+	if desc := trimmedOrNil(req.Description); desc != nil {
+		create.SetDescription(*desc)
+	}
+	def, err := create.Save(ctx)
+	if ent.IsConstraintError(err) {
+		s.writeError(w, http.StatusConflict, "questionnaire version was published concurrently")
+		return
+	} else if err != nil {
+		log.FromContext(r.Context()).Error("failed to create questionnaire", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not publish questionnaire")
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.FromContext(r.Context()).Error("failed to commit questionnaire", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not publish questionnaire")
+		return
+	}
+
+	s.writeJSON(w, http.StatusCreated, mapQuestionnaireDTO(def))
+}
+
+// getQuestionnaireHandler returns a questionnaire version with its items.
+// @Summary Get a questionnaire definition
+// @Tags Questionnaires
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Questionnaire definition ID"
+// @Success 200 {object} QuestionnaireDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /questionnaires/{id} [get]
+func (s *Server) getQuestionnaireHandler(w http.ResponseWriter, r *http.Request) {
+	if _, ok := currentDoctor(r.Context()); !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid questionnaire id")
+		return
+	}
+
+	def, err := s.Db.Ent().QuestionnaireDefinition.Get(r.Context(), id)
+	if ent.IsNotFound(err) {
+		s.writeError(w, http.StatusNotFound, "questionnaire not found")
+		return
+	} else if err != nil {
+		log.FromContext(r.Context()).Error("failed to load questionnaire", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not load questionnaire")
+		return
+	}
+	s.writeJSON(w, http.StatusOK, mapQuestionnaireDTO(def))
+}
+
+// assignQuestionnaireHandler asks a linked patient to fill in a questionnaire.
+// @Summary Assign a questionnaire to a patient (doctor must have approved link)
+// @Tags Questionnaires
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Patient ID"
+// @Param request body QuestionnaireAssignRequest true "Assignment"
+// @Success 201 {object} QuestionnaireAssignmentDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /patients/{id}/questionnaires [post]
+func (s *Server) assignQuestionnaireHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	patientID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid patient id")
+		return
+	}
+
+	if ok := s.hasApprovedLink(r.Context(), doc.ID, patientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return
+	}
+
+	var req questionnaireAssignRequest
+	if !s.decodeJSON(w, r, &req) {
+		return
+	}
+
+	q := s.Db.Ent().QuestionnaireDefinition.Query()
+	switch {
+	case req.DefinitionID != nil:
+		id, err := uuid.Parse(*req.DefinitionID)
+		if err != nil {
+			s.writeError(w, http.StatusBadRequest, "invalid definition id")
+			return
+		}
+		q = q.Where(questionnairedefinition.IDEQ(id))
+	case req.Key != nil && strings.TrimSpace(*req.Key) != "":
+		q = q.Where(
+			questionnairedefinition.KeyEQ(vocabulary.Slug(*req.Key)),
+			questionnairedefinition.Active(true),
+		).Order(ent.Desc(questionnairedefinition.FieldVersion))
+	default:
+		s.writeError(w, http.StatusBadRequest, "provide definitionId or key")
+		return
+	}
+	if req.DueAt != nil && !req.DueAt.After(time.Now()) {
+		s.writeError(w, http.StatusBadRequest, "dueAt must be in the future")
+		return
+	}
+
+	ctx := r.Context()
+	def, err := q.First(ctx)
+	if ent.IsNotFound(err) {
+		s.writeError(w, http.StatusNotFound, "questionnaire not found")
+		return
+	} else if err != nil {
+		log.FromContext(r.Context()).Error("failed to load questionnaire", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not assign questionnaire")
+		return
+	}
+
+	create := s.Db.Ent().QuestionnaireAssignment.Create().
+		SetPatientID(patientID).
+		SetDoctorID(doc.ID).
+		SetDefinitionID(def.ID)
+	if req.DueAt != nil {
+		create.SetDueAt(req.DueAt.UTC())
+	}
+	a, err := create.Save(ctx)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to assign questionnaire", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not assign questionnaire")
+		return
+	}
+	a.Edges.Definition = def
+
+	s.writeJSON(w, http.StatusCreated, mapQuestionnaireAssignmentDTO(a))
+}
+
+// listPatientQuestionnairesHandler lists a linked patient's questionnaire assignments.
+// @Summary List a patient's questionnaire assignments with scores (doctor must have approved link)
+// @Tags Questionnaires
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Patient ID"
+// @Param status query string false "Pending, Completed, Cancelled, or all (default)"
+// @Success 200 {object} QuestionnaireAssignmentsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /patients/{id}/questionnaires [get]
+func (s *Server) listPatientQuestionnairesHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	patientID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid patient id")
+		return
+	}
+
+	if ok := s.hasApprovedLink(r.Context(), doc.ID, patientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return
+	}
+
+	s.serveQuestionnaireAssignments(w, r, patientID, "")
+}
+
+// myQuestionnairesHandler lists the current patient's questionnaires.
+// @Summary List the current patient's questionnaires
+// @Tags Patient
+// @Produce json
+// @Security SessionCookie
+// @Param status query string false "Pending (default), Completed, Cancelled, or all"
+// @Success 200 {object} QuestionnaireAssignmentsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /patient/questionnaires [get]
+func (s *Server) myQuestionnairesHandler(w http.ResponseWriter, r *http.Request) {
+	p, ok := currentPatient(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	s.serveQuestionnaireAssignments(w, r, p.ID, questionnaireassignment.StatusPending)
+}
+
+// serveQuestionnaireAssignments lists assignments newest first; defaultStatus
+// applies when no status is given, and "" means all.
+func (s *Server) serveQuestionnaireAssignments(w http.ResponseWriter, r *http.Request, patientID uuid.UUID, defaultStatus questionnaireassignment.Status) {
+	q := s.Db.Ent().QuestionnaireAssignment.Query().
+		Where(questionnaireassignment.PatientIDEQ(patientID)).
+		WithDefinition().
+		WithResponse().
+		Order(ent.Desc(questionnaireassignment.FieldCreatedAt))
+
+	switch raw := r.URL.Query().Get("status"); {
+	case raw == "" && defaultStatus != "":
+		q = q.Where(questionnaireassignment.StatusEQ(defaultStatus))
+	case raw == "", strings.EqualFold(raw, "all"):
+	default:
+		status, ok := parseQuestionnaireAssignmentStatus(raw)
+		if !ok {
+			s.writeError(w, http.StatusBadRequest, "status must be Pending, Completed, Cancelled, or all")
+			return
+		}
+		q = q.Where(questionnaireassignment.StatusEQ(status))
+	}
+
+	assignments, err := q.All(r.Context())
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to list questionnaire assignments", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not list questionnaires")
+		return
+	}
+
+	resp := questionnaireAssignmentsResponse{Assignments: make([]questionnaireAssignmentDTO, 0, len(assignments))}
+	for _, a := range assignments {
+		resp.Assignments = append(resp.Assignments, mapQuestionnaireAssignmentDTO(a))
+	}
+	s.writeJSON(w, http.StatusOK, resp)
+}
+
+// updateQuestionnaireAssignmentHandler cancels or reopens a pending questionnaire.
+// @Summary Cancel or reopen a questionnaire assignment (doctor must have approved link)
+// @Tags Questionnaires
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Questionnaire assignment ID"
+// @Param request body QuestionnaireAssignmentUpdateRequest true "Pending or Cancelled"
+// @Success 200 {object} QuestionnaireAssignmentDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /questionnaires/assignments/{id} [patch]
+func (s *Server) updateQuestionnaireAssignmentHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	assignmentID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid assignment id")
+		return
+	}
+
+	var req questionnaireAssignmentUpdateRequest
+	if !s.decodeJSON(w, r, &req) {
+		return
+	}
+	status, ok := parseQuestionnaireAssignmentStatus(req.Status)
+	if !ok || status == questionnaireassignment.StatusCompleted {
+		s.writeError(w, http.StatusBadRequest, "status must be Pending or Cancelled")
+		return
+	}
+
+	ctx := r.Context()
+	a, err := s.Db.Ent().QuestionnaireAssignment.Get(ctx, assignmentID)
+	if ent.IsNotFound(err) {
+		s.writeError(w, http.StatusNotFound, "assignment not found")
+		return
+	} else if err != nil {
+		log.FromContext(r.Context()).Error("failed to load questionnaire assignment", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not update assignment")
+		return
+	}
+
+	if ok := s.hasApprovedLink(ctx, doc.ID, a.PatientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return
+	}
+	if a.Status == questionnaireassignment.StatusCompleted {
+		s.writeError(w, http.StatusConflict, "assignment is already completed")
+		return
+	}
+
+	if err := s.Db.Ent().QuestionnaireAssignment.UpdateOneID(assignmentID).
+		SetStatus(status).
+		Exec(ctx); err != nil {
+		log.FromContext(r.Context()).Error("failed to update questionnaire assignment", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not update assignment")
+		return
+	}
+
+	s.writeQuestionnaireAssignment(w, r, http.StatusOK, assignmentID, "could not update assignment")
+}
+
+// submitQuestionnaireHandler scores and stores the current patient's answers.
+// @Summary Submit answers to an assigned questionnaire
+// @Description Answers map item IDs to option values. Scores are computed on the server.
+// @Tags Patient
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Questionnaire assignment ID"
+// @Param request body QuestionnaireSubmitRequest true "Answers"
+// @Success 201 {object} QuestionnaireAssignmentDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /patient/questionnaires/{id}/responses [post]
+func (s *Server) submitQuestionnaireHandler(w http.ResponseWriter, r *http.Request) {
+	p, ok := currentPatient(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	assignmentID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid assignment id")
+		return
+	}
+
+	var req questionnaireSubmitRequest
+	if !s.decodeJSON(w, r, &req) {
+		return
+	}
+
+	ctx := r.Context()
+	a, err := s.Db.Ent().QuestionnaireAssignment.Query().
+		Where(
+			questionnaireassignment.IDEQ(assignmentID),
+			questionnaireassignment.PatientIDEQ(p.ID),
+		).
+		WithDefinition().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		s.writeError(w, http.StatusNotFound, "assignment not found")
+		return
+	} else if err != nil {
+		log.FromContext(r.Context()).Error("failed to load questionnaire assignment", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not submit questionnaire")
+		return
+	}
+	if a.Status != questionnaireassignment.StatusPending {
+		s.writeError(w, http.StatusConflict, "assignment is not pending")
+		return
+	}
+
+	scores, err := a.Edges.Definition.Definition.Score(req.Answers)
+	if questionnaire.IsValidationError(err) {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	} else if err != nil {
+		log.FromContext(r.Context()).Error("failed to score questionnaire", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not submit questionnaire")
+		return
+	}
+
+	if err := s.storeQuestionnaireResponse(ctx, a, req.Answers, scores); err != nil {
+		if ent.IsNotFound(err) || ent.IsConstraintError(err) {
+			s.writeError(w, http.StatusConflict, "assignment is not pending")
+			return
+		}
+		log.FromContext(r.Context()).Error("failed to store questionnaire response", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not submit questionnaire")
+		return
+	}
+
+	s.writeQuestionnaireAssignment(w, r, http.StatusCreated, assignmentID, "could not submit questionnaire")
+}
+
+// storeQuestionnaireResponse completes a pending assignment with its response.
+func (s *Server) storeQuestionnaireResponse(ctx context.Context, a *ent.QuestionnaireAssignment, answers map[string]string, scores []questionnaire.ScaleScore) error {
+	tx, err := s.Db.Ent().Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	now := time.Now().UTC()
+	// Completing only a still-pending row keeps double submissions out.
+	if _, err := tx.QuestionnaireAssignment.UpdateOneID(a.ID).
+		Where(questionnaireassignment.StatusEQ(questionnaireassignment.StatusPending)).
+		SetStatus(questionnaireassignment.StatusCompleted).
+		SetCompletedAt(now).
+		Save(ctx); err != nil {
+		return err
+	}
+
+	if _, err := tx.QuestionnaireResponse.Create().
+		SetPatientID(a.PatientID).
+		SetDefinitionID(a.DefinitionID).
+		SetAssignmentID(a.ID).
+		SetAnswers(answers).
+		SetScores(scores).
+		SetSubmittedAt(now).
+		Save(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// patientAssessmentsHandler charts a linked patient's questionnaire scores.
+// @Summary Questionnaire score history for a patient (doctor must have approved link)
+// @Tags Analytics
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Patient ID"
+// @Param key query string false "Limit to one questionnaire key"
+// @Param from query string false "ISO timestamp (RFC3339) lower bound. Defaults to 365 days before to."
+// @Param to query string false "ISO timestamp (RFC3339) upper bound. Defaults to now."
+// @Success 200 {object} AssessmentsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /patients/{id}/questionnaires/scores [get]
+func (s *Server) patientAssessmentsHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	patientID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid patient id")
+		return
+	}
+
+	if ok := s.hasApprovedLink(r.Context(), doc.ID, patientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return
+	}
+
+	s.serveAssessments(w, r, patientID)
+}
+
+// myAssessmentsHandler charts the current patient's questionnaire scores.
+// @Summary The current patient's questionnaire score history
+// @Tags Patient
+// @Produce json
+// @Security SessionCookie
+// @Param key query string false "Limit to one questionnaire key"
+// @Param from query string false "ISO timestamp (RFC3339) lower bound. Defaults to 365 days before to."
+// @Param to query string false "ISO timestamp (RFC3339) upper bound. Defaults to now."
+// @Success 200 {object} AssessmentsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /patient/questionnaires/scores [get]
+func (s *Server) myAssessmentsHandler(w http.ResponseWriter, r *http.Request) {
+	p, ok := currentPatient(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	s.serveAssessments(w, r, p.ID)
+}
+
+func (s *Server) serveAssessments(w http.ResponseWriter, r *http.Request, patientID uuid.UUID) {
+	fromParam, toParam, err := parseTimeRange(r)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid time range")
+		return
+	}
+	to := time.Now().UTC()
+	if toParam != nil {
+		to = toParam.UTC()
+	}
+	from := to.AddDate(0, 0, -defaultAssessmentRangeDays)
+	if fromParam != nil {
+		from = fromParam.UTC()
+	}
+	if !from.Before(to) {
+		s.writeError(w, http.StatusBadRequest, "from must be before to")
+		return
+	}
+
+	key := ""
+	if raw := r.URL.Query().Get("key"); raw != "" {
+		key = vocabulary.Slug(raw)
+	}
+
+	series, err := s.queryAssessmentSeries(r.Context(), patientID, from, to, key)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to query questionnaire scores", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not load scores")
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, assessmentsResponse{From: from, To: to, Series: series})
+}
+
+func (s *Server) writeQuestionnaireAssignment(w http.ResponseWriter, r *http.Request, status int, assignmentID uuid.UUID, failure string) {
+	a, err := s.Db.Ent().QuestionnaireAssignment.Query().
+		Where(questionnaireassignment.IDEQ(assignmentID)).
+		WithDefinition().
+		WithResponse().
+		Only(r.Context())
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to load questionnaire assignment", "err", err)
+		s.writeError(w, http.StatusInternalServerError, failure)
+		return
+	}
+	s.writeJSON(w, status, mapQuestionnaireAssignmentDTO(a))
+}
+
+func mapQuestionnaireSummaryDTO(d *ent.QuestionnaireDefinition) questionnaireSummaryDTO {
+	return questionnaireSummaryDTO{
+		ID:          d.ID.String(),
+		Key:         d.Key,
+		Version:     d.Version,
+		Title:       d.Title,
+		Description: d.Description,
+		Items:       len(d.Definition.Items),
+		Active:      d.Active,
+		CreatedAt:   d.CreatedAt,
+	}
+}
+
+func mapQuestionnaireDTO(d *ent.QuestionnaireDefinition) questionnaireDTO {
+	return questionnaireDTO{
+		questionnaireSummaryDTO: mapQuestionnaireSummaryDTO(d),
+		Definition:              d.Definition,
+	}
+}
+
+// mapQuestionnaireAssignmentDTO expects the definition edge to be loaded; the
+// response edge is optional.
+func mapQuestionnaireAssignmentDTO(a *ent.QuestionnaireAssignment) questionnaireAssignmentDTO {
+	dto := questionnaireAssignmentDTO{
+		ID:          a.ID.String(),
+		PatientID:   a.PatientID.String(),
+		DoctorID:    a.DoctorID.String(),
+		Status:      a.Status.String(),
+		DueAt:       a.DueAt,
+		CompletedAt: a.CompletedAt,
+		CreatedAt:   a.CreatedAt,
+	}
+	if def := a.Edges.Definition; def != nil {
+		dto.Questionnaire = mapQuestionnaireDTO(def)
+	}
+	if resp := a.Edges.Response; resp != nil {
+		dto.Scores = resp.Scores
+	}
+	return dto
+}
+
+func parseQuestionnaireAssignmentStatus(raw string) (questionnaireassignment.Status, bool) {
+	for _, st := range []questionnaireassignment.Status{
+		questionnaireassignment.StatusPending,
+		questionnaireassignment.StatusCompleted,
+		questionnaireassignment.StatusCancelled,
+	} {
+		if strings.EqualFold(strings.TrimSpace(raw), st.String()) {
+			return st, true
+		}
+	}
+	return "", false
+}
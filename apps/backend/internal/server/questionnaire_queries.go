@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// assessmentSeriesDTO is the score history of one questionnaire scale.
+type assessmentSeriesDTO struct {
+	Questionnaire string               `json:"questionnaire"`
+	Title         string               `json:"title"`
+	Scale         string               `json:"scale"`
+	ScaleTitle    string               `json:"scaleTitle"`
+	Points        []assessmentPointDTO `json:"points"`
+}
+
+type assessmentPointDTO struct {
+	SubmittedAt time.Time `json:"submittedAt"`
+	Score       float64   `json:"score"`
+	Band        string    `json:"band,omitempty"`
+	Version     int       `json:"version"`
+}
+
+// assessmentSeriesQuery unpacks the stored scale scores of a patient's
+// responses. Scales without a score (too few answers) are skipped; $4 limits
+// the result to one questionnaire key unless empty.
+var assessmentSeriesQuery = `
+SELECT d.key, d.title, s->>'key', coalesce(s->>'title', ''), r.submitted_at, (s->>'score')::float8, coalesce(s->>'band', ''), d.version
+FROM questionnaire_responses r
+JOIN questionnaire_definitions d ON d.id = r.definition_id
+CROSS JOIN LATERAL jsonb_array_elements(` + jsonArray("r.scores") + `) AS s
+WHERE r.patient_id = $1::uuid AND r.submitted_at >= $2 AND r.submitted_at <= $3
+	AND ($4::text = '' OR d.key = $4::text)
+	AND jsonb_typeof(s->'score') = 'number'
+ORDER BY d.key, s->>'key', r.submitted_at`
+
+// queryAssessmentSeries returns one series per questionnaire scale, oldest
+// point first, so clients can chart outcomes across versions of an instrument.
+func (s *Server) queryAssessmentSeries(ctx context.Context, patientID uuid.UUID, from, to time.Time, key string) ([]assessmentSeriesDTO, error) {
+	series := []assessmentSeriesDTO{}
+
+	rows, err := s.Db.Ent().QueryContext(ctx, assessmentSeriesQuery, patientID.String(), from, to, key)
+	if err != nil {
+		return series, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			qKey, title, scale, scaleTitle string
+			p                              assessmentPointDTO
+		)
+		if err := rows.Scan(&qKey, &title, &scale, &scaleTitle, &p.SubmittedAt, &p.Score, &p.Band, &p.Version); err != nil {
+			return series, err
+		}
+		if n := len(series); n == 0 || series[n-1].Questionnaire != qKey || series[n-1].Scale != scale {
+			series = append(series, assessmentSeriesDTO{Questionnaire: qKey, Title: title, Scale: scale, ScaleTitle: scaleTitle})
+		}
+		last := &series[len(series)-1]
+		// Later versions may retitle the instrument; show the newest title.
+		last.Title, last.ScaleTitle = title, scaleTitle
+		last.Points = append(last.Points, p)
+	}
+	return series, rows.Err()
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"backend/internal/ratelimit"
+
+	"github.com/charmbracelet/log"
+)
+
+// Rate limit policies by route group; each can be overridden with
+// RATE_LIMIT_<NAME>.
+const (
+	ratePolicyAuth                = "auth"
+	ratePolicyPairingRedeem       = "pairing_redeem"
+	ratePolicyPairingRedeemGlobal = "pairing_redeem_global"
+	ratePolicySyncUpload          = "sync_upload"
+)
+
+var defaultRatePolicies = []ratelimit.Policy{
+	// Registration and login, per client address.
+	{Name: ratePolicyAuth, Limit: 10, Period: time.Minute},
+	// Pairing codes are six digits: cap guesses per patient and, against
+	// guessing spread over many accounts, across everyone.
+	{Name: ratePolicyPairingRedeem, Limit: 5, Period: time.Minute},
+	{Name: ratePolicyPairingRedeemGlobal, Limit: 100, Period: time.Minute},
+	// Entry uploads, per patient.
+	{Name: ratePolicySyncUpload, Limit: 30, Period: time.Minute},
+}
+
+const (
+	rateLimitPruneInterval = 10 * time.Minute
+	// rateLimitIdleTTL outlives every default period, so pruned buckets
+	// would have refilled anyway.
+	rateLimitIdleTTL = time.Hour
+)
+
+// rateKeyFunc picks the bucket a request spends from.
+type rateKeyFunc func(r *http.Request) string
+
+// keyByIP buckets requests by client address.
+func (s *Server) keyByIP(r *http.Request) string {
+	return "ip:" + s.RateLimiter.ClientIP(r)
+}
+
+// keyBySubject buckets requests by the authenticated doctor or patient,
+// falling back to the client address before authentication.
+func (s *Server) keyBySubject(r *http.Request) string {
+	if actor, ok := r.Context().Value(requestActorKey{}).(*requestActor); ok && actor.Type != "" {
+		return actor.Type + ":" + actor.ID.String()
+	}
+	return s.keyByIP(r)
+}
+
+// keyByRoute shares one bucket between all clients of a route.
+func keyByRoute(r *http.Request) string {
+	return "route"
+}
+
+// rateLimit rejects requests over the named policy with 429 and a
+// Retry-After header. Store failures let requests through.
+func (s *Server) rateLimit(policy string, key rateKeyFunc) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			d, ok, err := s.RateLimiter.Take(r.Context(), policy, key(r))
+			if err != nil {
+				log.FromContext(r.Context()).Error("failed to check rate limit", "policy", policy, "err", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set("RateLimit-Limit", strconv.Itoa(d.Limit))
+			w.Header().Set("RateLimit-Remaining", strconv.Itoa(d.Remaining))
+			if !d.Allowed {
+				retry := int(math.Ceil(d.RetryAfter.Seconds()))
+				w.Header().Set("Retry-After", strconv.Itoa(max(1, retry)))
+				log.FromContext(r.Context()).Warn("rate limited", "policy", policy)
+				s.writeError(w, http.StatusTooManyRequests, "too many requests")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// setupRateLimits builds the limiter from RATE_LIMIT_* settings.
+func (s *Server) setupRateLimits(ctx context.Context) error {
+	cfg, err := ratelimit.LoadConfig(defaultRatePolicies)
+	if err != nil {
+		return err
+	}
+
+	switch cfg.Backend {
+	case ratelimit.BackendOff:
+		log.Warn("rate limiting is disabled")
+		return nil
+	case ratelimit.BackendPostgres:
+		if s.Db == nil {
+			log.Warn("rate limit backend postgres needs a database; using memory")
+			break
+		}
+		store := ratelimit.NewPostgresStore(s.Db.Ent())
+		s.RateLimiter = ratelimit.NewLimiter(store, cfg)
+		go s.runRateLimitPrune(ctx, store)
+		return nil
+	}
+	s.RateLimiter = ratelimit.NewLimiter(ratelimit.NewMemoryStore(), cfg)
+	return nil
+}
+
+// runRateLimitPrune deletes idle shared buckets every tick until ctx is
+// cancelled.
+func (s *Server) runRateLimitPrune(ctx context.Context, store *ratelimit.PostgresStore) {
+	ticker := time.NewTicker(rateLimitPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		runJob(ctx, "ratelimit.prune", func(ctx context.Context) error {
+			_, err := store.Prune(ctx, time.Now().Add(-rateLimitIdleTTL))
+			return err
+		})
+	}
+}
@@ -0,0 +1,704 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"backend/ent"
+	"backend/ent/predicate"
+	"backend/ent/reminderdelivery"
+	"backend/ent/reminderschedule"
+	"backend/internal/pagination"
+	"backend/internal/reminder"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type reminderScheduleDTO struct {
+	ID                string     `json:"id"`
+	PatientID         string     `json:"patientId"`
+	CreatedByDoctorID *string    `json:"createdByDoctorId,omitempty"`
+	Kind              string     `json:"kind"`
+	Channel           string     `json:"channel"`
+	Timezone          string     `json:"timezone"`
+	TimeOfDay         string     `json:"timeOfDay"`
+	Weekdays          []string   `json:"weekdays"`
+	InactivityDays    *int       `json:"inactivityDays,omitempty"`
+	Message           *string    `json:"message,omitempty"`
+	Enabled           bool       `json:"enabled"`
+	NextRunAt         *time.Time `json:"nextRunAt,omitempty"`
+	LastSentAt        *time.Time `json:"lastSentAt,omitempty"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	UpdatedAt         time.Time  `json:"updatedAt"`
+}
+
+type reminderSchedulesResponse struct {
+	Reminders []reminderScheduleDTO `json:"reminders"`
+}
+
+type reminderCreateRequest struct {
+	Kind    string `json:"kind"`
+	Channel string `json:"channel"`
+	// Timezone is an IANA name such as Europe/Berlin.
+	Timezone  string `json:"timezone"`
+	TimeOfDay string `json:"timeOfDay"`
+	// Weekdays are three-letter names (mon, tue, ...); empty means every day.
+	Weekdays       []string `json:"weekdays,omitempty"`
+	InactivityDays *int     `json:"inactivityDays,omitempty"`
+	Message        *string  `json:"message,omitempty"`
+	Enabled        *bool    `json:"enabled,omitempty"`
+}
+
+type reminderUpdateRequest struct {
+	Channel        *string   `json:"channel,omitempty"`
+	Timezone       *string   `json:"timezone,omitempty"`
+	TimeOfDay      *string   `json:"timeOfDay,omitempty"`
+	Weekdays       *[]string `json:"weekdays,omitempty"`
+	InactivityDays *int      `json:"inactivityDays,omitempty"`
+	Message        *string   `json:"message,omitempty"`
+	Enabled        *bool     `json:"enabled,omitempty"`
+}
+
+type reminderDeliveryDTO struct {
+	ID           string     `json:"id"`
+	ScheduleID   string     `json:"scheduleId"`
+	Kind         string     `json:"kind"`
+	Channel      string     `json:"channel"`
+	ScheduledFor time.Time  `json:"scheduledFor"`
+	Status       string     `json:"status"`
+	SentAt       *time.Time `json:"sentAt,omitempty"`
+	Error        *string    `json:"error,omitempty"`
+}
+
+type reminderDeliveriesResponse struct {
+	Deliveries []reminderDeliveryDTO `json:"deliveries"`
+	NextCursor *string               `json:"nextCursor,omitempty"`
+}
+
+var reminderDeliveriesPageOptions = pagination.Options{
+	DefaultLimit: 50,
+	MaxLimit:     200,
+	Sorts:        []string{"scheduledFor"},
+	DefaultSort:  "-scheduledFor",
+}
+
+// reminderSettings is a validated schedule configuration.
+type reminderSettings struct {
+	Kind           reminderschedule.Kind
+	Channel        reminderschedule.Channel
+	Timezone       string
+	TimeOfDay      string
+	Weekdays       []string
+	InactivityDays *int
+	Message        *string
+	Enabled        bool
+}
+
+// myRemindersHandler lists the current patient's reminder schedules.
+// @Summary List the current patient's reminders
+// @Tags Reminders
+// @Produce json
+// @Security SessionCookie
+// @Success 200 {object} ReminderSchedulesResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /patient/reminders [get]
+func (s *Server) myRemindersHandler(w http.ResponseWriter, r *http.Request) {
+	p, ok := currentPatient(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	s.serveReminderSchedules(w, r, p.ID)
+}
+
+// createMyReminderHandler lets the current patient schedule a reminder.
+// @Summary Create a reminder for the current patient
+// @Tags Reminders
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param request body ReminderCreateRequest true "Reminder"
+// @Success 201 {object} ReminderScheduleDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /patient/reminders [post]
+func (s *Server) createMyReminderHandler(w http.ResponseWriter, r *http.Request) {
+	p, ok := currentPatient(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	s.createReminder(w, r, p.ID, nil)
+}
+
+// updateMyReminderHandler changes one of the current patient's reminders,
+// including those a doctor set up.
+// @Summary Update a reminder of the current patient
+// @Tags Reminders
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Reminder ID"
+// @Param request body ReminderUpdateRequest true "Fields to change"
+// @Success 200 {object} ReminderScheduleDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /patient/reminders/{id} [patch]
+func (s *Server) updateMyReminderHandler(w http.ResponseWriter, r *http.Request) {
+	p, ok := currentPatient(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	sch, ok := s.loadPatientReminder(w, r, p.ID, "could not update reminder")
+	if !ok {
+		return
+	}
+	s.updateReminder(w, r, sch)
+}
+
+// deleteMyReminderHandler removes one of the current patient's reminders.
+// @Summary Delete a reminder of the current patient
+// @Tags Reminders
+// @Security SessionCookie
+// @Param id path string true "Reminder ID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /patient/reminders/{id} [delete]
+func (s *Server) deleteMyReminderHandler(w http.ResponseWriter, r *http.Request) {
+	p, ok := currentPatient(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	sch, ok := s.loadPatientReminder(w, r, p.ID, "could not delete reminder")
+	if !ok {
+		return
+	}
+	s.deleteReminder(w, r, sch)
+}
+
+// myReminderDeliveriesHandler lists reminders sent to the current patient.
+// @Summary Delivery history of the current patient's reminders
+// @Tags Reminders
+// @Produce json
+// @Security SessionCookie
+// @Param limit query int false "Page size (default 50, max 200)"
+// @Param cursor query string false "Opaque cursor from a previous page"
+// @Param sort query string false "-scheduledFor (default) or scheduledFor"
+// @Success 200 {object} ReminderDeliveriesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /patient/reminders/deliveries [get]
+func (s *Server) myReminderDeliveriesHandler(w http.ResponseWriter, r *http.Request) {
+	p, ok := currentPatient(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	s.serveReminderDeliveries(w, r, p.ID)
+}
+
+// patientRemindersHandler lists a linked patient's reminder schedules.
+// @Summary List a patient's reminders (doctor must have approved link)
+// @Tags Reminders
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Patient ID"
+// @Success 200 {object} ReminderSchedulesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /patients/{id}/reminders [get]
+func (s *Server) patientRemindersHandler(w http.ResponseWriter, r *http.Request) {
+	patientID, ok := s.linkedPatientParam(w, r)
+	if !ok {
+		return
+	}
+
+	s.serveReminderSchedules(w, r, patientID)
+}
+
+// createPatientReminderHandler schedules a reminder for a linked patient.
+// @Summary Create a reminder for a patient (doctor must have approved link)
+// @Tags Reminders
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Patient ID"
+// @Param request body ReminderCreateRequest true "Reminder"
+// @Success 201 {object} ReminderScheduleDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /patients/{id}/reminders [post]
+func (s *Server) createPatientReminderHandler(w http.ResponseWriter, r *http.Request) {
+	patientID, ok := s.linkedPatientParam(w, r)
+	if !ok {
+		return
+	}
+
+	doc, _ := currentDoctor(r.Context())
+	s.createReminder(w, r, patientID, &doc.ID)
+}
+
+// updatePatientReminderHandler changes a linked patient's reminder.
+// @Summary Update a patient's reminder (doctor must have approved link)
+// @Tags Reminders
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Reminder ID"
+// @Param request body ReminderUpdateRequest true "Fields to change"
+// @Success 200 {object} ReminderScheduleDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /reminders/{id} [patch]
+func (s *Server) updatePatientReminderHandler(w http.ResponseWriter, r *http.Request) {
+	sch, ok := s.loadDoctorReminder(w, r, "could not update reminder")
+	if !ok {
+		return
+	}
+	s.updateReminder(w, r, sch)
+}
+
+// deletePatientReminderHandler removes a linked patient's reminder.
+// @Summary Delete a patient's reminder (doctor must have approved link)
+// @Tags Reminders
+// @Security SessionCookie
+// @Param id path string true "Reminder ID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /reminders/{id} [delete]
+func (s *Server) deletePatientReminderHandler(w http.ResponseWriter, r *http.Request) {
+	sch, ok := s.loadDoctorReminder(w, r, "could not delete reminder")
+	if !ok {
+		return
+	}
+	s.deleteReminder(w, r, sch)
+}
+
+// patientReminderDeliveriesHandler lists reminders sent to a linked patient.
+// @Summary Delivery history of a patient's reminders (doctor must have approved link)
+// @Tags Reminders
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Patient ID"
+// @Param limit query int false "Page size (default 50, max 200)"
+// @Param cursor query string false "Opaque cursor from a previous page"
+// @Param sort query string false "-scheduledFor (default) or scheduledFor"
+// @Success 200 {object} ReminderDeliveriesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /patients/{id}/reminders/deliveries [get]
+func (s *Server) patientReminderDeliveriesHandler(w http.ResponseWriter, r *http.Request) {
+	patientID, ok := s.linkedPatientParam(w, r)
+	if !ok {
+		return
+	}
+
+	s.serveReminderDeliveries(w, r, patientID)
+}
+
+// linkedPatientParam parses the {id} patient and checks the current doctor's
+// link, writing the error response when either fails.
+func (s *Server) linkedPatientParam(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return uuid.Nil, false
+	}
+
+	patientID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid patient id")
+		return uuid.Nil, false
+	}
+
+	if ok := s.hasApprovedLink(r.Context(), doc.ID, patientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return uuid.Nil, false
+	}
+	return patientID, true
+}
+
+func (s *Server) serveReminderSchedules(w http.ResponseWriter, r *http.Request, patientID uuid.UUID) {
+	schedules, err := s.Db.Ent().ReminderSchedule.Query().
+		Where(reminderschedule.PatientIDEQ(patientID)).
+		Order(ent.Asc(reminderschedule.FieldCreatedAt)).
+		All(r.Context())
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to list reminders", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not list reminders")
+		return
+	}
+
+	resp := reminderSchedulesResponse{Reminders: make([]reminderScheduleDTO, 0, len(schedules))}
+	for _, sch := range schedules {
+		resp.Reminders = append(resp.Reminders, mapReminderScheduleDTO(sch))
+	}
+	s.writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) createReminder(w http.ResponseWriter, r *http.Request, patientID uuid.UUID, doctorID *uuid.UUID) {
+	var req reminderCreateRequest
+	if !s.decodeJSON(w, r, &req) {
+		return
+	}
+
+	kind, ok := parseReminderKind(req.Kind)
+	if !ok {
+		s.writeError(w, http.StatusBadRequest, "kind must be Journal or Inactivity")
+		return
+	}
+	settings := reminderSettings{
+		Kind:           kind,
+		Channel:        reminderschedule.Channel(strings.TrimSpace(req.Channel)),
+		Timezone:       strings.TrimSpace(req.Timezone),
+		TimeOfDay:      strings.TrimSpace(req.TimeOfDay),
+		Weekdays:       req.Weekdays,
+		InactivityDays: req.InactivityDays,
+		Message:        trimmedOrNil(req.Message),
+		Enabled:        req.Enabled == nil || *req.Enabled,
+	}
+	nextRunAt, err := s.validateReminder(&settings, time.Now().UTC())
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	create := s.Db.Ent().ReminderSchedule.Create().
+		SetPatientID(patientID).
+		SetNillableCreatedByDoctorID(doctorID).
+		SetKind(settings.Kind).
+		SetChannel(settings.Channel).
+		SetTimezone(settings.Timezone).
+		SetTimeOfDay(settings.TimeOfDay).
+		SetWeekdays(settings.Weekdays).
+		SetNillableInactivityDays(settings.InactivityDays).
+		SetNillableMessage(settings.Message).
+		SetEnabled(settings.Enabled).
+		SetNillableNextRunAt(nextRunAt)
+	sch, err := create.Save(r.Context())
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to create reminder", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not create reminder")
+		return
+	}
+
+	s.writeJSON(w, http.StatusCreated, mapReminderScheduleDTO(sch))
+}
+
+func (s *Server) updateReminder(w http.ResponseWriter, r *http.Request, sch *ent.ReminderSchedule) {
+	var req reminderUpdateRequest
+	if !s.decodeJSON(w, r, &req) {
+		return
+	}
+
+	settings := reminderSettings{
+		Kind:           sch.Kind,
+		Channel:        sch.Channel,
+		Timezone:       sch.Timezone,
+		TimeOfDay:      sch.TimeOfDay,
+		Weekdays:       sch.Weekdays,
+		InactivityDays: sch.InactivityDays,
+		Message:        sch.Message,
+		Enabled:        sch.Enabled,
+	}
+	if req.Channel != nil {
+		settings.Channel = reminderschedule.Channel(strings.TrimSpace(*req.Channel))
+	}
+	if req.Timezone != nil {
+		settings.Timezone = strings.TrimSpace(*req.Timezone)
+	}
+	if req.TimeOfDay != nil {
+		settings.TimeOfDay = strings.TrimSpace(*req.TimeOfDay)
+	}
+	if req.Weekdays != nil {
+		settings.Weekdays = *req.Weekdays
+	}
+	if req.InactivityDays != nil {
+		settings.InactivityDays = req.InactivityDays
+	}
+	if req.Message != nil {
+		settings.Message = trimmedOrNil(req.Message)
+	}
+	if req.Enabled != nil {
+		settings.Enabled = *req.Enabled
+	}
+
+	nextRunAt, err := s.validateReminder(&settings, time.Now().UTC())
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	update := s.Db.Ent().ReminderSchedule.UpdateOneID(sch.ID).
+		SetChannel(settings.Channel).
+		SetTimezone(settings.Timezone).
+		SetTimeOfDay(settings.TimeOfDay).
+		SetWeekdays(settings.Weekdays).
+		SetEnabled(settings.Enabled)
+	if settings.Message == nil {
+		update.ClearMessage()
+	} else {
+		update.SetMessage(*settings.Message)
+	}
+	if settings.InactivityDays != nil {
+		update.SetInactivityDays(*settings.InactivityDays)
+	}
+	if nextRunAt == nil {
+		update.ClearNextRunAt()
+	} else {
+		update.SetNextRunAt(*nextRunAt)
+	}
+
+	updated, err := update.Save(r.Context())
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to update reminder", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not update reminder")
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, mapReminderScheduleDTO(updated))
+}
+
+func (s *Server) deleteReminder(w http.ResponseWriter, r *http.Request, sch *ent.ReminderSchedule) {
+	if err := s.Db.Ent().ReminderSchedule.DeleteOneID(sch.ID).Exec(r.Context()); err != nil {
+		log.FromContext(r.Context()).Error("failed to delete reminder", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not delete reminder")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validateReminder normalises settings in place and returns the next run, or
+// nil when the reminder is disabled.
+func (s *Server) validateReminder(settings *reminderSettings, now time.Time) (*time.Time, error) {
+	if err := reminderschedule.ChannelValidator(settings.Channel); err != nil {
+		return nil, errors.New("channel must be Push, Email or Log")
+	}
+	if s.notifier(settings.Channel.String()) == nil {
+		return nil, errors.New("channel " + settings.Channel.String() + " is not available on this server")
+	}
+
+	loc, err := reminder.LoadLocation(settings.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	settings.Timezone = loc.String()
+
+	hour, minute, err := reminder.ParseClock(settings.TimeOfDay)
+	if err != nil {
+		return nil, err
+	}
+	days, err := reminder.ParseWeekdays(settings.Weekdays)
+	if err != nil {
+		return nil, err
+	}
+	settings.Weekdays = make([]string, 0, len(days))
+	for _, d := range days {
+		settings.Weekdays = append(settings.Weekdays, reminder.WeekdayName(d))
+	}
+
+	if settings.Kind == reminderschedule.KindInactivity {
+		if settings.InactivityDays == nil || *settings.InactivityDays < 1 || *settings.InactivityDays > reminder.MaxInactivityDays {
+			return nil, errors.New("inactivityDays must be between 1 and 90 for inactivity reminders")
+		}
+	} else if settings.InactivityDays != nil {
+		return nil, errors.New("inactivityDays only applies to inactivity reminders")
+	}
+	if settings.Message != nil && len(*settings.Message) > 500 {
+		return nil, errors.New("message must be at most 500 characters")
+	}
+
+	if !settings.Enabled {
+		return nil, nil
+	}
+	schedule := reminder.Schedule{Location: loc, Hour: hour, Minute: minute, Weekdays: days}
+	next := schedule.Next(now).UTC()
+	return &next, nil
+}
+
+func (s *Server) serveReminderDeliveries(w http.ResponseWriter, r *http.Request, patientID uuid.UUID) {
+	page, err := pagination.Parse(r.URL.Query(), reminderDeliveriesPageOptions)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	q := s.Db.Ent().ReminderDelivery.Query().
+		Where(reminderdelivery.PatientIDEQ(patientID))
+	after, err := reminderDeliveriesAfter(page)
+	if err != nil {
+		s.writeDomainError(w, r, err, "could not list deliveries")
+		return
+	}
+	if after != nil {
+		q = q.Where(after)
+	}
+
+	dir := sql.OrderAsc()
+	if page.Desc {
+		dir = sql.OrderDesc()
+	}
+	deliveries, err := q.
+		Order(reminderdelivery.ByScheduledFor(dir), reminderdelivery.ByID(dir)).
+		Limit(page.Limit + 1).
+		All(r.Context())
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to list reminder deliveries", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not list deliveries")
+		return
+	}
+
+	deliveries, more := pagination.Trim(deliveries, page.Limit)
+	resp := reminderDeliveriesResponse{Deliveries: make([]reminderDeliveryDTO, 0, len(deliveries))}
+	for _, d := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, reminderDeliveryDTO{
+			ID:           d.ID.String(),
+			ScheduleID:   d.ScheduleID.String(),
+			Kind:         d.Kind.String(),
+			Channel:      d.Channel.String(),
+			ScheduledFor: d.ScheduledFor,
+			Status:       d.Status.String(),
+			SentAt:       d.SentAt,
+			Error:        d.Error,
+		})
+	}
+	if more {
+		last := deliveries[len(deliveries)-1]
+		resp.NextCursor = page.Next(last.ScheduledFor.UTC().Format(time.RFC3339Nano), last.ID)
+	}
+	s.writeJSON(w, http.StatusOK, resp)
+}
+
+// reminderDeliveriesAfter returns the keyset predicate for the page after the
+// cursor, or nil on the first page.
+func reminderDeliveriesAfter(page pagination.Params) (predicate.ReminderDelivery, error) {
+	if page.Cursor == nil {
+		return nil, nil
+	}
+	at, err := time.Parse(time.RFC3339Nano, page.Cursor.Value)
+	if err != nil {
+		return nil, pagination.ErrInvalidCursor
+	}
+	if page.Desc {
+		return reminderdelivery.Or(
+			reminderdelivery.ScheduledForLT(at),
+			reminderdelivery.And(reminderdelivery.ScheduledForEQ(at), reminderdelivery.IDLT(page.Cursor.ID)),
+		), nil
+	}
+	return reminderdelivery.Or(
+		reminderdelivery.ScheduledForGT(at),
+		reminderdelivery.And(reminderdelivery.ScheduledForEQ(at), reminderdelivery.IDGT(page.Cursor.ID)),
+	), nil
+}
+
+func (s *Server) loadPatientReminder(w http.ResponseWriter, r *http.Request, patientID uuid.UUID, failure string) (*ent.ReminderSchedule, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid reminder id")
+		return nil, false
+	}
+
+	sch, err := s.Db.Ent().ReminderSchedule.Query().
+		Where(reminderschedule.IDEQ(id), reminderschedule.PatientIDEQ(patientID)).
+		Only(r.Context())
+	if ent.IsNotFound(err) {
+		s.writeError(w, http.StatusNotFound, "reminder not found")
+		return nil, false
+	} else if err != nil {
+		log.FromContext(r.Context()).Error("failed to load reminder", "err", err)
+		s.writeError(w, http.StatusInternalServerError, failure)
+		return nil, false
+	}
+	return sch, true
+}
+
+// loadDoctorReminder loads the {id} reminder and checks the current doctor is
+// linked to its patient, writing the error response when not.
+func (s *Server) loadDoctorReminder(w http.ResponseWriter, r *http.Request, failure string) (*ent.ReminderSchedule, bool) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return nil, false
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid reminder id")
+		return nil, false
+	}
+
+	sch, err := s.Db.Ent().ReminderSchedule.Get(r.Context(), id)
+	if ent.IsNotFound(err) {
+		s.writeError(w, http.StatusNotFound, "reminder not found")
+		return nil, false
+	} else if err != nil {
+		log.FromContext(r.Context()).Error("failed to load reminder", "err", err)
+		s.writeError(w, http.StatusInternalServerError, failure)
+		return nil, false
+	}
+
+	if ok := s.hasApprovedLink(r.Context(), doc.ID, sch.PatientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return nil, false
+	}
+	return sch, true
+}
+
+func mapReminderScheduleDTO(sch *ent.ReminderSchedule) reminderScheduleDTO {
+	dto := reminderScheduleDTO{
+		ID:             sch.ID.String(),
+		PatientID:      sch.PatientID.String(),
+		Kind:           sch.Kind.String(),
+		Channel:        sch.Channel.String(),
+		Timezone:       sch.Timezone,
+		TimeOfDay:      sch.TimeOfDay,
+		Weekdays:       sch.Weekdays,
+		InactivityDays: sch.InactivityDays,
+		Message:        sch.Message,
+		Enabled:        sch.Enabled,
+		NextRunAt:      sch.NextRunAt,
+		LastSentAt:     sch.LastSentAt,
+		CreatedAt:      sch.CreatedAt,
+		UpdatedAt:      sch.UpdatedAt,
+	}
+	if dto.Weekdays == nil {
+		dto.Weekdays = []string{}
+	}
+	if sch.CreatedByDoctorID != nil {
+		id := sch.CreatedByDoctorID.String()
+		dto.CreatedByDoctorID = &id
+	}
+	return dto
+}
+
+func parseReminderKind(raw string) (reminderschedule.Kind, bool) {
+	for _, k := range []reminderschedule.Kind{reminderschedule.KindJournal, reminderschedule.KindInactivity} {
+		if strings.EqualFold(strings.TrimSpace(raw), k.String()) {
+			return k, true
+		}
+	}
+	return "", false
+}
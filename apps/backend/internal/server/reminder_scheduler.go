@@ -0,0 +1,223 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"backend/ent"
+	"backend/ent/reminderdelivery"
+	"backend/ent/reminderschedule"
+	"backend/internal/outbox"
+	"backend/internal/reminder"
+
+	"github.com/charmbracelet/log"
+)
+
+const (
+	reminderTickInterval = time.Minute
+	// reminderBatchSize caps the schedules handled per tick; the rest are
+	// picked up on the next one.
+	reminderBatchSize = 100
+)
+
+// runReminders dispatches due reminders every tick until ctx is cancelled.
+// Several API instances may run it: each schedule run is claimed by moving
+// next_run_at, so only one instance delivers it.
+func (s *Server) runReminders(ctx context.Context) {
+	ticker := time.NewTicker(reminderTickInterval)
+	defer ticker.Stop()
+
+	for {
+		runJob(ctx, "reminders.dispatch", func(ctx context.Context) error {
+			return s.dispatchDueReminders(ctx, time.Now().UTC())
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatchDueReminders handles every enabled schedule whose next run is due.
+func (s *Server) dispatchDueReminders(ctx context.Context, now time.Time) error {
+	schedules, err := s.Db.Ent().ReminderSchedule.Query().
+		Where(
+			reminderschedule.Enabled(true),
+			reminderschedule.NextRunAtLTE(now),
+		).
+		WithPatient().
+		Order(ent.Asc(reminderschedule.FieldNextRunAt)).
+		Limit(reminderBatchSize).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, sch := range schedules {
+		if err := s.dispatchReminder(ctx, sch, now); err != nil {
+			log.FromContext(ctx).Error("failed to dispatch reminder", "schedule_id", sch.ID, "err", err)
+		}
+	}
+	return nil
+}
+
+// dispatchReminder claims one due run, decides whether it should notify and
+// records the delivery. Runs missed while the server was down collapse into
+// one; the next run is always computed from now.
+func (s *Server) dispatchReminder(ctx context.Context, sch *ent.ReminderSchedule, now time.Time) error {
+	scheduledFor := *sch.NextRunAt
+	schedule, err := reminderScheduleOf(sch)
+	if err != nil {
+		return err
+	}
+
+	claimed, err := s.Db.Ent().ReminderSchedule.Update().
+		Where(
+			reminderschedule.IDEQ(sch.ID),
+			reminderschedule.NextRunAtEQ(scheduledFor),
+		).
+		SetNextRunAt(schedule.Next(now)).
+		Save(ctx)
+	if err != nil || claimed == 0 {
+		return err
+	}
+
+	p := sch.Edges.Patient
+	if sch.Kind == reminderschedule.KindInactivity {
+		lastActivity := p.CreatedAt
+		if p.LastEntryAt != nil {
+			lastActivity = *p.LastEntryAt
+		}
+		if sch.InactivityDays == nil || !reminder.NudgeDue(lastActivity, sch.LastSentAt, *sch.InactivityDays, now) {
+			return nil
+		}
+	}
+
+	d, err := s.createReminderDelivery(ctx, sch, p, scheduledFor)
+	if ent.IsConstraintError(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	notification := reminder.Notification{
+		ID:          d.ID,
+		PatientID:   p.ID,
+		DisplayName: p.DisplayName,
+		Email:       p.Email,
+		Kind:        sch.Kind.String(),
+		Message:     reminderMessage(sch),
+	}
+
+	update := d.Update()
+	if err := s.notify(ctx, sch.Channel.String(), notification); err != nil {
+		update.SetStatus(reminderdelivery.StatusFailed).SetError(err.Error())
+	} else {
+		update.SetStatus(reminderdelivery.StatusSent).SetSentAt(time.Now().UTC())
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return err
+	}
+
+	// Failed nudges count too, so an unreachable patient is not retried every tick.
+	return s.Db.Ent().ReminderSchedule.UpdateOneID(sch.ID).
+		SetLastSentAt(now).
+		Exec(ctx)
+}
+
+// createReminderDelivery records the pending delivery of a run. An
+// inactivity nudge also records a patient.inactive event for each of the
+// patient's doctors in the same transaction.
+func (s *Server) createReminderDelivery(ctx context.Context, sch *ent.ReminderSchedule, p *ent.Patient, scheduledFor time.Time) (*ent.ReminderDelivery, error) {
+	tx, err := s.Db.Ent().Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	d, err := tx.ReminderDelivery.Create().
+		SetScheduleID(sch.ID).
+		SetPatientID(sch.PatientID).
+		SetKind(reminderdelivery.Kind(sch.Kind)).
+		SetChannel(reminderdelivery.Channel(sch.Channel)).
+		SetScheduledFor(scheduledFor).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if sch.Kind == reminderschedule.KindInactivity {
+		doctorIDs, err := approvedDoctorIDs(ctx, tx.Client(), p.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, doctorID := range doctorIDs {
+			if err := s.recordEvent(ctx, tx, outbox.TypePatientInactive, patientInactiveOutboxData{
+				PatientID:    p.ID.String(),
+				DoctorID:     doctorID.String(),
+				InactiveDays: *sch.InactivityDays,
+				LastEntryAt:  p.LastEntryAt,
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	s.wakeOutbox()
+	return d, nil
+}
+
+var errChannelUnavailable = errors.New("channel is not configured")
+
+func (s *Server) notify(ctx context.Context, channel string, n reminder.Notification) error {
+	notifier := s.notifier(channel)
+	if notifier == nil {
+		return fmt.Errorf("%s: %w", channel, errChannelUnavailable)
+	}
+	return notifier.Notify(ctx, n)
+}
+
+// notifier returns the notifier for a channel, or nil when it is not configured.
+func (s *Server) notifier(channel string) reminder.Notifier {
+	if n, ok := s.Notifiers[channel]; ok {
+		return n
+	}
+	if channel == reminder.ChannelLog {
+		return reminder.LogNotifier{}
+	}
+	return nil
+}
+
+func reminderScheduleOf(sch *ent.ReminderSchedule) (reminder.Schedule, error) {
+	loc, err := reminder.LoadLocation(sch.Timezone)
+	if err != nil {
+		return reminder.Schedule{}, err
+	}
+	hour, minute, err := reminder.ParseClock(sch.TimeOfDay)
+	if err != nil {
+		return reminder.Schedule{}, err
+	}
+	days, err := reminder.ParseWeekdays(sch.Weekdays)
+	if err != nil {
+		return reminder.Schedule{}, err
+	}
+	return reminder.Schedule{Location: loc, Hour: hour, Minute: minute, Weekdays: days}, nil
+}
+
+func reminderMessage(sch *ent.ReminderSchedule) string {
+	if sch.Message != nil {
+		return *sch.Message
+	}
+	if sch.Kind == reminderschedule.KindInactivity && sch.InactivityDays != nil {
+		return fmt.Sprintf("You have not journaled for %d days. How did speaking go recently?", *sch.InactivityDays)
+	}
+	return "Take a minute to note how speaking went today."
+}
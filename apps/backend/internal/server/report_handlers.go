@@ -0,0 +1,243 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"time"
+
+	"backend/ent"
+	"backend/ent/comment"
+	"backend/ent/entry"
+	"backend/internal/report"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultReportRangeDays = 30
+	reportTopCount         = 10
+)
+
+// patientReportHandler renders a clinical summary of a patient's journal.
+// @Summary Clinical report for a patient over a period (PDF or HTML)
+// @Tags Analytics
+// @Produce application/pdf
+// @Produce text/html
+// @Security SessionCookie
+// @Param id path string true "Patient ID"
+// @Param from query string false "ISO timestamp (RFC3339) lower bound. Defaults to 30 days before to."
+// @Param to query string false "ISO timestamp (RFC3339) upper bound. Defaults to now."
+// @Param format query string false "pdf (default) or html"
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /patients/{id}/report [get]
+func (s *Server) patientReportHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	patientIDParam := chi.URLParam(r, "id")
+	patientID, err := uuid.Parse(patientIDParam)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid patient id")
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "pdf"
+	}
+	if format != "pdf" && format != "html" {
+		s.writeError(w, http.StatusBadRequest, "format must be pdf or html")
+		return
+	}
+
+	fromParam, toParam, err := parseTimeRange(r)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid time range")
+		return
+	}
+	to := time.Now().UTC()
+	if toParam != nil {
+		to = toParam.UTC()
+	}
+	from := to.AddDate(0, 0, -defaultReportRangeDays)
+	if fromParam != nil {
+		from = fromParam.UTC()
+	}
+	if !from.Before(to) {
+		s.writeError(w, http.StatusBadRequest, "from must be before to")
+		return
+	}
+
+	if ok := s.hasApprovedLink(r.Context(), doc.ID, patientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return
+	}
+
+	ctx := r.Context()
+	p, err := s.Db.Ent().Patient.Get(ctx, patientID)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to load patient for report", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not build report")
+		return
+	}
+
+	var practice *ent.Practice
+	if doc.PracticeID != nil {
+		practice, err = s.Db.Ent().Practice.Get(ctx, *doc.PracticeID)
+		if err != nil && !ent.IsNotFound(err) {
+			log.FromContext(r.Context()).Error("failed to load practice for report", "err", err)
+			s.writeError(w, http.StatusInternalServerError, "could not build report")
+			return
+		}
+	}
+
+	entries, err := s.Db.Ent().Entry.Query().
+		Where(
+			entry.PatientIDEQ(patientID),
+			entry.HappenedAtGTE(from),
+			entry.HappenedAtLTE(to),
+		).
+		WithComments(func(q *ent.CommentQuery) {
+			q.WithAuthor().Order(comment.ByCreatedAt())
+		}).
+		Order(entry.ByHappenedAt()).
+		All(ctx)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to load entries for report", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not build report")
+		return
+	}
+
+	scope := analyticsScope{PatientIDs: []uuid.UUID{patientID}, From: from, To: to}
+	distributions, err := s.queryDistributions(ctx, scope)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to aggregate report distributions", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not build report")
+		return
+	}
+
+	bucket := reportBucket(from, to)
+	trend, err := s.queryTrend(ctx, scope, bucket)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to aggregate report trend", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not build report")
+		return
+	}
+
+	rep := buildReport(doc, practice, p, entries, distributions, trend)
+	rep.From = from
+	rep.To = to
+	rep.TrendBucket = bucket
+
+	var buf bytes.Buffer
+	contentType := "application/pdf"
+	if format == "html" {
+		contentType = "text/html; charset=utf-8"
+		err = report.RenderHTML(&buf, rep)
+	} else {
+		err = report.RenderPDF(&buf, rep)
+	}
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to render report", "format", format, "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not render report")
+		return
+	}
+
+	filename := fmt.Sprintf("report-%s-%s-%s.%s", patientID, from.Format("20060102"), to.Format("20060102"), format)
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// reportBucket keeps the trend chart readable for long periods.
+func reportBucket(from, to time.Time) string {
+	days := to.Sub(from).Hours() / 24
+	switch {
+	case days <= 31:
+		return "day"
+	case days <= 184:
+		return "week"
+	default:
+		return "month"
+	}
+}
+
+func buildReport(doc *ent.Doctor, practice *ent.Practice, p *ent.Patient, entries []*ent.Entry, distributions analyticsDistributions, trend []trendPoint) report.Report {
+	rep := report.Report{
+		DoctorName:  doc.DisplayName,
+		Patient:     report.Patient{DisplayName: p.DisplayName},
+		GeneratedAt: time.Now().UTC(),
+		Emotions:    limitCounts(report.SortedCounts(distributions.Emotions)),
+		Triggers:    limitCounts(report.SortedCounts(distributions.Triggers)),
+		Techniques:  limitCounts(report.SortedCounts(distributions.Techniques)),
+		Trend:       make([]report.TrendPoint, 0, len(trend)),
+		Entries:     make([]report.Entry, 0, len(entries)),
+	}
+	if practice != nil {
+		rep.Practice.Name = practice.Name
+		rep.Practice.Address = valOrDefault(practice.Address, "")
+	}
+	if p.PatientCode != nil {
+		rep.Patient.Code = *p.PatientCode
+	}
+	if p.BirthDate != nil {
+		rep.Patient.BirthDate = *p.BirthDate
+	}
+
+	for _, t := range trend {
+		rep.Trend = append(rep.Trend, report.TrendPoint{Label: t.Date, AvgStutterRating: t.AvgStutterRating, Count: t.Count})
+	}
+
+	ratingSum := 0
+	for _, e := range entries {
+		re := report.Entry{
+			HappenedAt:       e.HappenedAt.UTC(),
+			Situation:        valOrDefault(e.Situation, ""),
+			StutterFrequency: e.StutterFrequency,
+			Triggers:         e.Triggers,
+			Techniques:       e.Techniques,
+			Notes:            valOrDefault(e.Notes, ""),
+		}
+		for _, emo := range e.Emotions {
+			re.Emotions = append(re.Emotions, emo.Name)
+		}
+		for _, c := range e.Edges.Comments {
+			author := ""
+			if c.Edges.Author != nil {
+				author = c.Edges.Author.DisplayName
+			}
+			re.Comments = append(re.Comments, report.Comment{Author: author, Body: c.Body, CreatedAt: c.CreatedAt})
+		}
+		rep.Entries = append(rep.Entries, re)
+
+		if e.StutterFrequency != nil {
+			rep.Summary.Ratings++
+			ratingSum += *e.StutterFrequency
+		}
+	}
+	rep.Summary.Entries = len(entries)
+	if rep.Summary.Ratings > 0 {
+		avg := float64(ratingSum) / float64(rep.Summary.Ratings)
+		rep.Summary.AvgStutterFrequency = &avg
+	}
+
+	return rep
+}
+
+func limitCounts(counts []report.Count) []report.Count {
+	if len(counts) > reportTopCount {
+		return counts[:reportTopCount]
+	}
+	return counts
+}
@@ -0,0 +1,142 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	internal_errors "backend/internal/server/errors"
+	"backend/internal/validation"
+)
+
+// Request body limits. Routes without their own limit get the default.
+const (
+	defaultBodyLimit int64 = 1 << 20
+	authBodyLimit    int64 = 16 << 10
+	smallBodyLimit   int64 = 4 << 10
+	syncBodyLimit    int64 = 8 << 20
+)
+
+type bodyLimitKey struct{}
+
+// requestNormalizer is implemented by request bodies that tidy their fields
+// (trimming, lower-casing) before they are validated.
+type requestNormalizer interface {
+	normalize()
+}
+
+// bodyLimit caps the request body of the routes it wraps at n bytes.
+func bodyLimit(n int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.Body = http.MaxBytesReader(w, r.Body, n)
+			ctx := context.WithValue(r.Context(), bodyLimitKey{}, n)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// decodeJSON decodes a required JSON body into dst, rejecting unknown fields
+// and trailing data, then normalizes dst and validates its `validate` tags. On failure it
+// writes a 400 (listing invalid fields where it can) or 413 and returns false.
+func (s *Server) decodeJSON(w http.ResponseWriter, r *http.Request, dst any) bool {
+	return s.decodeBody(w, r, dst, false)
+}
+
+// decodeOptionalJSON is decodeJSON for routes where an empty body is valid;
+// dst is validated either way.
+func (s *Server) decodeOptionalJSON(w http.ResponseWriter, r *http.Request, dst any) bool {
+	return s.decodeBody(w, r, dst, true)
+}
+
+// limitBody applies the default limit on routes without their own.
+func limitBody(w http.ResponseWriter, r *http.Request) {
+	if _, ok := r.Context().Value(bodyLimitKey{}).(int64); !ok {
+		r.Body = http.MaxBytesReader(w, r.Body, defaultBodyLimit)
+	}
+}
+
+func (s *Server) decodeBody(w http.ResponseWriter, r *http.Request, dst any, optional bool) bool {
+	limitBody(w, r)
+
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	err := dec.Decode(dst)
+	if err == nil && dec.More() {
+		err = errors.New("unexpected data after JSON value")
+	}
+	if errors.Is(err, io.EOF) && optional {
+		err = nil
+	}
+	if err != nil {
+		s.writeDecodeError(w, err)
+		return false
+	}
+
+	if n, ok := dst.(requestNormalizer); ok {
+		n.normalize()
+	}
+	if errs := validation.Struct(dst); errs != nil {
+		s.writeValidationError(w, errs)
+		return false
+	}
+	return true
+}
+
+func (s *Server) writeDecodeError(w http.ResponseWriter, err error) {
+	var (
+		tooLarge  *http.MaxBytesError
+		typeErr   *json.UnmarshalTypeError
+		syntaxErr *json.SyntaxError
+	)
+	switch {
+	case errors.As(err, &tooLarge):
+		s.writeError(w, http.StatusRequestEntityTooLarge,
+			fmt.Sprintf("request body must not exceed %d bytes", tooLarge.Limit))
+	case errors.As(err, &typeErr) && typeErr.Field != "":
+		s.writeValidationError(w, validation.Errors{{
+			Field:   typeErr.Field,
+			Message: "must be a " + jsonTypeName(typeErr.Type.Kind().String()),
+		}})
+	case errors.As(err, &syntaxErr), errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		s.writeError(w, http.StatusBadRequest, "invalid JSON payload")
+	default:
+		if field, ok := unknownField(err); ok {
+			s.writeValidationError(w, validation.Errors{{Field: field, Message: "is not a known field"}})
+			return
+		}
+		s.writeError(w, http.StatusBadRequest, "invalid JSON payload")
+	}
+}
+
+func (s *Server) writeValidationError(w http.ResponseWriter, errs validation.Errors) {
+	s.writeProblem(w, http.StatusBadRequest, internal_errors.CodeValidationFailed, "invalid request body", errs)
+}
+
+// unknownField extracts the field name from encoding/json's
+// DisallowUnknownFields error, which has no dedicated type.
+func unknownField(err error) (string, bool) {
+	var field string
+	if _, scanErr := fmt.Sscanf(err.Error(), "json: unknown field %q", &field); scanErr != nil {
+		return "", false
+	}
+	return field, true
+}
+
+func jsonTypeName(kind string) string {
+	switch kind {
+	case "string":
+		return "string"
+	case "bool":
+		return "boolean"
+	case "slice", "array":
+		return "list"
+	case "map", "struct":
+		return "object"
+	default:
+		return "number"
+	}
+}
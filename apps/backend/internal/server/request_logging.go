@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"time"
+
+	"backend/internal/logging"
+	"backend/internal/tracing"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+// Incoming request IDs are kept only when they are short and cannot smuggle
+// separators into log lines.
+var requestIDRe = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// requestActor is filled in by the auth middleware once the session is known,
+// so the access log written after the handler can name the caller.
+type requestActor struct {
+	Type string
+	ID   uuid.UUID
+}
+
+type requestActorKey struct{}
+
+// requestLogger assigns or propagates X-Request-ID, puts a logger carrying it
+// into the request context, and writes one access log line and one metrics
+// observation per request.
+func (s *Server) requestLogger(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		id := r.Header.Get(requestIDHeader)
+		if !requestIDRe.MatchString(id) {
+			id = uuid.NewString()
+		}
+		w.Header().Set(requestIDHeader, id)
+
+		actor := &requestActor{}
+		logger := log.Default().With("request_id", id).With(tracing.LogFields(r.Context())...)
+		ctx := logging.WithRequestID(r.Context(), id)
+		ctx = context.WithValue(ctx, requestActorKey{}, actor)
+		ctx = log.WithContext(ctx, logger)
+
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r.WithContext(ctx))
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		elapsed := time.Since(start)
+		route := chi.RouteContext(r.Context()).RoutePattern()
+		s.Metrics.ObserveRequest(r.Method, route, status, elapsed)
+		nameRequestSpan(r, route)
+
+		fields := []any{
+			"method", r.Method,
+			"path", r.URL.Path,
+			"route", route,
+			"status", status,
+			"bytes", ww.BytesWritten(),
+			"duration_ms", elapsed.Milliseconds(),
+		}
+		if actor.Type != "" {
+			fields = append(fields, "actor_type", actor.Type, "actor_id", actor.ID)
+		}
+		switch {
+		case status >= http.StatusInternalServerError:
+			logger.Error("request", fields...)
+		case status >= http.StatusBadRequest:
+			logger.Warn("request", fields...)
+		default:
+			logger.Info("request", fields...)
+		}
+	})
+}
+
+// nameRequestSpan names the server span after the matched route, which is
+// only known once chi has routed the request.
+func nameRequestSpan(r *http.Request, route string) {
+	if route == "" {
+		return
+	}
+	span := trace.SpanFromContext(r.Context())
+	span.SetName(r.Method + " " + route)
+	span.SetAttributes(semconv.HTTPRoute(route))
+}
+
+// withActor records the authenticated caller for the access log and adds it
+// to the context logger used by handlers.
+func withActor(ctx context.Context, actorType string, id uuid.UUID) context.Context {
+	if actor, ok := ctx.Value(requestActorKey{}).(*requestActor); ok {
+		actor.Type = actorType
+		actor.ID = id
+	}
+	return log.WithContext(ctx, log.FromContext(ctx).With("actor_type", actorType, "actor_id", id))
+}
@@ -4,41 +4,87 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"backend/internal/clientversion"
+	"backend/internal/events"
+	"backend/internal/idempotency"
+
 	"github.com/charmbracelet/log"
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 func (s *Server) RegisterRoutes() http.Handler {
+	if s.Events == nil {
+		s.Events = events.NewLocalBus()
+	}
+
 	r := chi.NewRouter()
-	r.Use(middleware.Logger)
+	r.Use(s.requestLogger)
+	r.NotFound(s.notFoundHandler)
+	r.MethodNotAllowed(s.methodNotAllowedHandler)
 
 	r.Use(cors.Handler(cors.Options{
-		AllowedOrigins:   []string{"https://*", "http://*"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type"},
+		AllowedOrigins: []string{"https://*", "http://*"},
+		AllowedMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
+		AllowedHeaders: []string{
+			"Accept", "Authorization", "Content-Type",
+			requestIDHeader, clientversion.Header, idempotency.Header,
+		},
+		ExposedHeaders: []string{
+			requestIDHeader, "Retry-After", "RateLimit-Limit", "RateLimit-Remaining",
+			"Deprecation", "Sunset", "Link", appUpgradeHeader, idempotency.ReplayedHeader,
+		},
 		AllowCredentials: true,
 		MaxAge:           300,
 	}))
 
 	r.Get("/", s.HelloWorldHandler)
 
-	r.Get("/health", s.HealthHandler)
-	r.Get("/ready", s.ReadyHandler)
+	// Probes and problem types also stay at the root, where orchestrators and
+	// issued problem documents point.
+	s.registerSystemRoutes(r)
+	if s.metricsHandler != nil {
+		r.Method(http.MethodGet, "/metrics", s.metricsHandler)
+	}
 
 	s.registerDocsRoutes(r)
 
 	if s.Auth == nil {
 		log.Warn("auth manager missing; authentication routes are disabled")
-	} else {
-		s.registerDoctorRoutes(r)
-		s.registerPatientRoutes(r)
-		s.registerPracticeRoutes(r)
-		s.registerLinkRoutes(r)
 	}
+	r.Route(apiV1Prefix, func(r chi.Router) {
+		s.registerSystemRoutes(r)
+		if s.Auth == nil {
+			return
+		}
+		r.Group(func(r chi.Router) {
+			r.Use(s.appVersion)
+			s.registerAPIRoutes(r)
+		})
+	})
+	if s.Auth != nil {
+		// The root paths stay as deprecated aliases for apps that predate /v1.
+		r.Group(func(r chi.Router) {
+			r.Use(s.legacyAPI, s.appVersion)
+			s.registerAPIRoutes(r)
+		})
+	}
+
+	return otelhttp.NewHandler(r, "http.request")
+}
+
+func (s *Server) registerSystemRoutes(r chi.Router) {
+	r.Get("/health", s.HealthHandler)
+	r.Get("/ready", s.ReadyHandler)
+	r.Get("/problems/{code}", s.problemTypeHandler)
+}
 
-	return r
+func (s *Server) registerAPIRoutes(r chi.Router) {
+	s.registerDoctorRoutes(r)
+	s.registerPatientRoutes(r)
+	s.registerPracticeRoutes(r)
+	s.registerLinkRoutes(r)
 }
 
 func (s *Server) HelloWorldHandler(w http.ResponseWriter, r *http.Request) {
@@ -69,7 +115,7 @@ func (s *Server) ReadyHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := s.Db.Ping(r.Context()); err != nil {
-		log.Warn("database ping failed", "err", err)
+		log.FromContext(r.Context()).Warn("database ping failed", "err", err)
 		s.writeJSON(w, http.StatusServiceUnavailable, map[string]string{"status": "unavailable"})
 		return
 	}
@@ -86,14 +132,10 @@ func (s *Server) writeJSON(w http.ResponseWriter, status int, payload any) {
 	}
 }
 
-func (s *Server) writeError(w http.ResponseWriter, status int, message string) {
-	s.writeJSON(w, status, map[string]string{"error": message})
-}
-
 func (s *Server) registerDoctorRoutes(r chi.Router) {
 	r.Route("/doctor", func(r chi.Router) {
-		r.Post("/register", s.doctorRegisterHandler)
-		r.Post("/login", s.doctorLoginHandler)
+		r.With(s.rateLimit(ratePolicyAuth, s.keyByIP), bodyLimit(authBodyLimit)).Post("/register", s.doctorRegisterHandler)
+		r.With(s.rateLimit(ratePolicyAuth, s.keyByIP), bodyLimit(authBodyLimit)).Post("/login", s.doctorLoginHandler)
 
 		r.Group(func(r chi.Router) {
 			r.Use(s.requireDoctor)
@@ -105,15 +147,34 @@ func (s *Server) registerDoctorRoutes(r chi.Router) {
 
 func (s *Server) registerPatientRoutes(r chi.Router) {
 	r.Route("/patient", func(r chi.Router) {
-		r.Post("/register", s.patientRegisterHandler)
-		r.Post("/login", s.patientLoginHandler)
+		r.With(s.rateLimit(ratePolicyAuth, s.keyByIP), bodyLimit(authBodyLimit)).Post("/register", s.patientRegisterHandler)
+		r.With(s.rateLimit(ratePolicyAuth, s.keyByIP), bodyLimit(authBodyLimit)).Post("/login", s.patientLoginHandler)
 
 		r.Group(func(r chi.Router) {
 			r.Use(s.requirePatient)
 			r.Get("/me", s.patientMeHandler)
 			r.Get("/mydoctor", s.myDoctorHandler)
+			r.Get("/insights", s.patientInsightsHandler)
+			r.Get("/entries/search", s.myEntrySearchHandler)
+			r.Get("/vocabulary", s.patientVocabularyHandler)
+			r.Get("/goals", s.myGoalsHandler)
+			r.Get("/assignments", s.myAssignmentsHandler)
+			r.Post("/assignments/{id}/logs", s.logExerciseHandler)
+			r.Get("/timeline", s.myTimelineHandler)
+			r.Get("/questionnaires", s.myQuestionnairesHandler)
+			r.Get("/questionnaires/scores", s.myAssessmentsHandler)
+			r.Post("/questionnaires/{id}/responses", s.submitQuestionnaireHandler)
+			r.Get("/reminders", s.myRemindersHandler)
+			r.Post("/reminders", s.createMyReminderHandler)
+			r.Get("/reminders/deliveries", s.myReminderDeliveriesHandler)
+			r.Patch("/reminders/{id}", s.updateMyReminderHandler)
+			r.Delete("/reminders/{id}", s.deleteMyReminderHandler)
 			r.Get("/entries/sync", s.patientEntriesSyncHandler)
-			r.Post("/entries/sync", s.patientEntriesSyncHandler)
+			r.With(
+				s.rateLimit(ratePolicySyncUpload, s.keyBySubject),
+				bodyLimit(syncBodyLimit),
+				s.idempotent,
+			).Post("/entries/sync", s.patientEntriesSyncHandler)
 			r.Post("/logout", s.patientLogoutHandler)
 		})
 	})
@@ -123,25 +184,70 @@ func (s *Server) registerPracticeRoutes(r chi.Router) {
 	r.Group(func(r chi.Router) {
 		r.Use(s.requireDoctor)
 		r.Post("/practice", s.practiceCreateHandler)
+		r.Get("/practice/webhooks", s.listWebhooksHandler)
+		r.Post("/practice/webhooks", s.createWebhookHandler)
+		r.Post("/practice/webhooks/deliveries/{id}/redeliver", s.redeliverWebhookHandler)
+		r.Get("/practice/webhooks/{id}", s.getWebhookHandler)
+		r.Patch("/practice/webhooks/{id}", s.updateWebhookHandler)
+		r.Delete("/practice/webhooks/{id}", s.deleteWebhookHandler)
+		r.Get("/practice/webhooks/{id}/deliveries", s.webhookDeliveriesHandler)
 	})
 }
 
 func (s *Server) registerLinkRoutes(r chi.Router) {
 	r.Group(func(r chi.Router) {
 		r.Use(s.requireDoctor)
-		r.Post("/links/invite", s.inviteLinkHandler)
+		r.With(s.idempotent).Post("/links/invite", s.inviteLinkHandler)
 		r.Post("/links/request", s.requestLinkHandler)
 		r.Post("/links/pairing-code", s.createPairingCodeHandler)
 		r.Post("/links/{id}/approve", s.approveLinkHandler)
-			r.Get("/entries/recent", s.recentEntriesHandler)
+		r.Get("/entries/recent", s.recentEntriesHandler)
 		r.Get("/patients", s.listPatientsHandler)
 		r.Get("/patients/{id}/entries", s.patientEntriesHandler)
+		r.Get("/patients/{id}/entries/search", s.patientEntrySearchHandler)
 		r.Get("/patients/{id}/analytics", s.analyticsHandler)
+		r.Get("/patients/{id}/report", s.patientReportHandler)
+		r.Get("/patients/{id}/goals", s.listPatientGoalsHandler)
+		r.Post("/patients/{id}/goals", s.createGoalHandler)
+		r.Patch("/goals/{id}", s.updateGoalHandler)
+		r.Get("/patients/{id}/assignments", s.patientAssignmentsOverviewHandler)
+		r.Post("/patients/{id}/assignments", s.createAssignmentHandler)
+		r.Patch("/assignments/{id}", s.updateAssignmentHandler)
+		r.Post("/assignments/{id}/attachment", s.assignmentAttachmentHandler)
+		r.Get("/patients/{id}/sessions", s.listPatientSessionsHandler)
+		r.Post("/patients/{id}/sessions", s.createSessionHandler)
+		r.Get("/patients/{id}/timeline", s.patientTimelineHandler)
+		r.Get("/sessions/{id}", s.getSessionHandler)
+		r.Patch("/sessions/{id}", s.updateSessionHandler)
+		r.Delete("/sessions/{id}", s.deleteSessionHandler)
+		r.Get("/questionnaires", s.listQuestionnairesHandler)
+		r.Post("/questionnaires", s.createQuestionnaireHandler)
+		r.Get("/questionnaires/{id}", s.getQuestionnaireHandler)
+		r.Patch("/questionnaires/assignments/{id}", s.updateQuestionnaireAssignmentHandler)
+		r.Get("/patients/{id}/questionnaires", s.listPatientQuestionnairesHandler)
+		r.Post("/patients/{id}/questionnaires", s.assignQuestionnaireHandler)
+		r.Get("/patients/{id}/questionnaires/scores", s.patientAssessmentsHandler)
+		r.Get("/patients/{id}/reminders", s.patientRemindersHandler)
+		r.Post("/patients/{id}/reminders", s.createPatientReminderHandler)
+		r.Get("/patients/{id}/reminders/deliveries", s.patientReminderDeliveriesHandler)
+		r.Patch("/reminders/{id}", s.updatePatientReminderHandler)
+		r.Delete("/reminders/{id}", s.deletePatientReminderHandler)
+		r.Get("/events", s.eventsHandler)
+		r.Get("/events/ws", s.eventsWebSocketHandler)
+		r.Get("/dashboard", s.dashboardHandler)
+		r.Get("/vocabulary", s.doctorVocabularyHandler)
+		r.Post("/vocabulary", s.createVocabularyTermHandler)
+		r.Delete("/vocabulary/{id}", s.deleteVocabularyTermHandler)
 	})
 
 	r.Group(func(r chi.Router) {
 		r.Use(s.requirePatient)
-		r.Post("/links/pairing-code/redeem", s.redeemPairingCodeHandler)
+		r.With(
+			s.rateLimit(ratePolicyPairingRedeem, s.keyBySubject),
+			s.rateLimit(ratePolicyPairingRedeemGlobal, keyByRoute),
+			bodyLimit(smallBodyLimit),
+			s.idempotent,
+		).Post("/links/pairing-code/redeem", s.redeemPairingCodeHandler)
 		r.Post("/links/revoke", s.revokeMyLinksHandler)
 	})
 }
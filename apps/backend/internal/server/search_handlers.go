@@ -0,0 +1,151 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"backend/ent/entry"
+	"backend/internal/pagination"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+const maxSearchQueryLength = 200
+
+type entrySearchHitDTO struct {
+	Entry   entryDTO `json:"entry"`
+	Rank    float32  `json:"rank"`
+	Snippet string   `json:"snippet"`
+}
+
+type entrySearchResponse struct {
+	Query      string              `json:"query"`
+	Hits       []entrySearchHitDTO `json:"hits"`
+	NextCursor *string             `json:"nextCursor,omitempty"`
+}
+
+// patientEntrySearchHandler searches a linked patient's journal.
+// @Summary Full-text search over a patient's entries (doctor must have approved link)
+// @Tags Entries
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Patient ID"
+// @Param q query string true "Search terms; supports quoted phrases, OR and -exclusions"
+// @Param sort query string false "-rank (default), happenedAt, or -happenedAt"
+// @Param limit query int false "Page size (default 20, max 50)"
+// @Param cursor query string false "Opaque cursor from a previous page"
+// @Success 200 {object} EntrySearchResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /patients/{id}/entries/search [get]
+func (s *Server) patientEntrySearchHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	patientIDParam := chi.URLParam(r, "id")
+	patientID, err := uuid.Parse(patientIDParam)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid patient id")
+		return
+	}
+
+	if ok := s.hasApprovedLink(r.Context(), doc.ID, patientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return
+	}
+
+	s.serveEntrySearch(w, r, patientID)
+}
+
+// myEntrySearchHandler searches the authenticated patient's own journal.
+// @Summary Full-text search over the current patient's entries
+// @Tags Patient
+// @Produce json
+// @Security SessionCookie
+// @Param q query string true "Search terms; supports quoted phrases, OR and -exclusions"
+// @Param sort query string false "-rank (default), happenedAt, or -happenedAt"
+// @Param limit query int false "Page size (default 20, max 50)"
+// @Param cursor query string false "Opaque cursor from a previous page"
+// @Success 200 {object} EntrySearchResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /patient/entries/search [get]
+func (s *Server) myEntrySearchHandler(w http.ResponseWriter, r *http.Request) {
+	p, ok := currentPatient(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	s.serveEntrySearch(w, r, p.ID)
+}
+
+func (s *Server) serveEntrySearch(w http.ResponseWriter, r *http.Request, patientID uuid.UUID) {
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if query == "" {
+		s.writeError(w, http.StatusBadRequest, "q is required")
+		return
+	}
+	if utf8.RuneCountInString(query) > maxSearchQueryLength {
+		s.writeError(w, http.StatusBadRequest, "q is too long")
+		return
+	}
+
+	page, err := pagination.Parse(r.URL.Query(), entrySearchPageOptions)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	hits, err := s.searchEntries(ctx, patientID, query, page)
+	if err != nil {
+		s.writeDomainError(w, r, err, "could not search entries")
+		return
+	}
+
+	hits, more := pagination.Trim(hits, page.Limit)
+	resp := entrySearchResponse{Query: query, Hits: make([]entrySearchHitDTO, 0, len(hits))}
+	if len(hits) == 0 {
+		s.writeJSON(w, http.StatusOK, resp)
+		return
+	}
+
+	ids := make([]uuid.UUID, 0, len(hits))
+	for _, h := range hits {
+		ids = append(ids, h.ID)
+	}
+	entries, err := s.Db.Ent().Entry.Query().
+		Where(entry.IDIn(ids...), entry.PatientIDEQ(patientID)).
+		All(ctx)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to load search hits", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not search entries")
+		return
+	}
+	byID := make(map[uuid.UUID]entryDTO, len(entries))
+	for _, e := range entries {
+		byID[e.ID] = mapEntryDTO(e)
+	}
+
+	for _, h := range hits {
+		dto, ok := byID[h.ID]
+		if !ok {
+			// Deleted between the search and the load.
+			continue
+		}
+		resp.Hits = append(resp.Hits, entrySearchHitDTO{Entry: dto, Rank: h.Rank, Snippet: h.Snippet})
+	}
+	if more {
+		resp.NextCursor = page.Next(searchCursorValue(page, hits[len(hits)-1]), hits[len(hits)-1].ID)
+	}
+
+	s.writeJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/internal/pagination"
+
+	"github.com/google/uuid"
+)
+
+// entrySearchVector is the indexed document of an entry. It has to match the
+// "entry_search" GIN index expression exactly, otherwise Postgres falls back to
+// a sequential scan. Situation ranks above tags and notes.
+const entrySearchVector = `(setweight(to_tsvector('simple'::regconfig, coalesce(situation, '')), 'A') || setweight(jsonb_to_tsvector('simple'::regconfig, coalesce(triggers, '[]'::jsonb) || coalesce(techniques, '[]'::jsonb) || coalesce(tags, '[]'::jsonb), '["string"]'::jsonb), 'B') || setweight(to_tsvector('simple'::regconfig, coalesce(notes, '')), 'C'))`
+
+// entryTagsJSON concatenates the free-form label arrays for the snippet text.
+const entryTagsJSON = `coalesce(triggers, '[]'::jsonb) || coalesce(techniques, '[]'::jsonb) || coalesce(tags, '[]'::jsonb)`
+
+// Highlight markers are private-use runes so the snippet can be HTML-escaped
+// before they are swapped for <mark> tags.
+const (
+	searchMarkStart = "\uE000"
+	searchMarkStop  = "\uE001"
+)
+
+const searchHeadlineOptions = "StartSel=" + searchMarkStart + ", StopSel=" + searchMarkStop + ", MaxFragments=2, MaxWords=25, MinWords=8, FragmentDelimiter=\" … \""
+
+const (
+	searchSortRank       = "rank"
+	searchSortHappenedAt = "happenedAt"
+)
+
+var entrySearchPageOptions = pagination.Options{
+	DefaultLimit: 20,
+	MaxLimit:     50,
+	Sorts:        []string{searchSortRank, searchSortHappenedAt},
+	DefaultSort:  "-" + searchSortRank,
+}
+
+type entrySearchHit struct {
+	ID         uuid.UUID
+	HappenedAt time.Time
+	Rank       float32
+	Snippet    string
+}
+
+// searchEntries runs a ranked websearch-style query ("phone call", -school, a OR b)
+// over one patient's entries and returns at most page.Limit+1 hits.
+func (s *Server) searchEntries(ctx context.Context, patientID uuid.UUID, query string, page pagination.Params) ([]entrySearchHit, error) {
+	args := []any{patientID.String(), query, searchHeadlineOptions}
+
+	dir := "ASC"
+	cmp := ">"
+	if page.Desc {
+		dir, cmp = "DESC", "<"
+	}
+
+	sortCol := "rank"
+	if page.Sort == searchSortHappenedAt {
+		sortCol = "happened_at"
+	}
+
+	cursorWhere := ""
+	if page.Cursor != nil {
+		var value any = page.Cursor.Value
+		cast := "real"
+		if page.Sort == searchSortHappenedAt {
+			at, err := time.Parse(time.RFC3339Nano, page.Cursor.Value)
+			if err != nil {
+				return nil, pagination.ErrInvalidCursor
+			}
+			value, cast = at, "timestamptz"
+		} else if _, err := strconv.ParseFloat(page.Cursor.Value, 32); err != nil {
+			return nil, pagination.ErrInvalidCursor
+		}
+		args = append(args, value, page.Cursor.ID.String())
+		cursorWhere = fmt.Sprintf("WHERE (%s, id) %s ($4::%s, $5::uuid)", sortCol, cmp, cast)
+	}
+	args = append(args, page.Limit+1)
+
+	// Headlines are only computed for the returned page; ts_headline re-parses the text.
+	sqlQuery := fmt.Sprintf(`
+SELECT id, happened_at, rank,
+	ts_headline('simple'::regconfig, concat_ws(' · ', situation, notes, tag_text), query, $3)
+FROM (
+	SELECT e.id, e.happened_at, e.situation, e.notes, q.query,
+		ts_rank_cd(%[1]s, q.query) AS rank,
+		(SELECT string_agg(t, ', ') FROM jsonb_array_elements_text(%[2]s) AS t) AS tag_text
+	FROM entries e, websearch_to_tsquery('simple'::regconfig, $2) AS q(query)
+	WHERE e.patient_id = $1::uuid AND %[1]s @@ q.query
+) hits
+%[3]s
+ORDER BY %[4]s %[5]s, id %[5]s
+LIMIT $%[6]d`,
+		entrySearchVector, entryTagsJSON, cursorWhere, sortCol, dir, len(args))
+
+	rows, err := s.Db.Ent().QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hits []entrySearchHit
+	for rows.Next() {
+		var (
+			h       entrySearchHit
+			id      string
+			snippet string
+		)
+		if err := rows.Scan(&id, &h.HappenedAt, &h.Rank, &snippet); err != nil {
+			return nil, err
+		}
+		if h.ID, err = uuid.Parse(id); err != nil {
+			return nil, err
+		}
+		h.Snippet = highlightSnippet(snippet)
+		hits = append(hits, h)
+	}
+	return hits, rows.Err()
+}
+
+// highlightSnippet escapes a ts_headline result and turns its markers into <mark> tags.
+func highlightSnippet(raw string) string {
+	escaped := html.EscapeString(raw)
+	escaped = strings.ReplaceAll(escaped, searchMarkStart, "<mark>")
+	return strings.ReplaceAll(escaped, searchMarkStop, "</mark>")
+}
+
+// searchCursorValue renders the sort value of a hit for the next-page cursor.
+func searchCursorValue(page pagination.Params, h entrySearchHit) string {
+	if page.Sort == searchSortHappenedAt {
+		return h.HappenedAt.UTC().Format(time.RFC3339Nano)
+	}
+	return strconv.FormatFloat(float64(h.Rank), 'g', -1, 32)
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -10,6 +11,15 @@ import (
 
 	"backend/ent"
 	"backend/internal/auth"
+	"backend/internal/clientversion"
+	"backend/internal/events"
+	"backend/internal/idempotency"
+	"backend/internal/metrics"
+	"backend/internal/outbox"
+	"backend/internal/ratelimit"
+	"backend/internal/reminder"
+	"backend/internal/storage"
+	"backend/internal/webhook"
 	_ "github.com/joho/godotenv/autoload"
 
 	"github.com/charmbracelet/log"
@@ -27,6 +37,39 @@ type Server struct {
 
 	Db   Database
 	Auth *auth.Manager
+	// Storage presigns attachment uploads; nil disables attachments.
+	Storage storage.Store
+	// Notifiers deliver reminders keyed by channel; without one the log sink
+	// is used for the Log channel and other channels are unavailable.
+	Notifiers map[string]reminder.Notifier
+	// Events fans out real-time events to doctors; RegisterRoutes falls back
+	// to an in-process bus when nil.
+	Events events.Bus
+	// Webhooks sends practice webhook deliveries; nil uses a default sender.
+	Webhooks *webhook.Sender
+	// Outbox holds the subscribers recorded domain events are relayed to;
+	// nil leaves recorded events pending.
+	Outbox *outbox.Registry
+	// Metrics records Prometheus metrics; nil records nothing.
+	Metrics *metrics.Metrics
+	// RateLimiter enforces per-route policies; nil disables rate limiting.
+	RateLimiter *ratelimit.Limiter
+	// Idempotency replays responses to retried requests; nil ignores
+	// Idempotency-Key.
+	Idempotency *idempotency.Keeper
+	// ClientVersions decides which app versions must or should upgrade; the
+	// zero value only tracks them.
+	ClientVersions clientversion.Policy
+	// LegacySunset is announced on the unversioned routes; zero omits it.
+	LegacySunset time.Time
+
+	// stopping is closed on shutdown so long-lived streams end promptly.
+	stopping <-chan struct{}
+	// metricsHandler serves /metrics on the API router when metrics are
+	// not on a listener of their own.
+	metricsHandler http.Handler
+	// outboxWake nudges the outbox relay after a commit.
+	outboxWake chan struct{}
 }
 
 func NewServer(db Database) *http.Server {
@@ -51,6 +94,53 @@ func NewServer(db Database) *http.Server {
 		Auth: authManager,
 	}
 
+	storageCfg, err := storage.LoadConfig()
+	switch {
+	case errors.Is(err, storage.ErrNotConfigured):
+		log.Warn("object storage not configured; attachments are disabled")
+	case err != nil:
+		log.Fatalf("storage configuration error: %v", err)
+	default:
+		s.Storage = storage.NewS3(storageCfg)
+	}
+
+	notifiers, err := reminder.LoadNotifiers(log.Default())
+	if err != nil {
+		log.Fatalf("notifier configuration error: %v", err)
+	}
+	s.Notifiers = notifiers
+	s.Webhooks = &webhook.Sender{AllowLocal: webhook.AllowLocalFromEnv()}
+
+	if s.ClientVersions, err = clientversion.LoadPolicy(); err != nil {
+		log.Fatalf("app version configuration error: %v", err)
+	}
+	if s.LegacySunset, err = parseLegacySunset(); err != nil {
+		log.Fatalf("api versioning configuration error: %v", err)
+	}
+
+	ctx, stop := context.WithCancel(context.Background())
+	s.stopping = ctx.Done()
+
+	// With a real database, events travel over LISTEN/NOTIFY so that every
+	// replica can serve every doctor's stream.
+	if listener, ok := db.(events.Listener); ok {
+		bus := events.NewPGBus(db.Ent(), listener)
+		s.Events = bus
+		go bus.Run(ctx)
+	}
+
+	s.setupMetrics(ctx)
+	if err := s.setupRateLimits(ctx); err != nil {
+		log.Fatalf("rate limit configuration error: %v", err)
+	}
+	if err := s.setupIdempotency(ctx); err != nil {
+		log.Fatalf("idempotency configuration error: %v", err)
+	}
+
+	s.Outbox = outbox.NewRegistry()
+	s.registerOutboxSubscribers(s.Outbox)
+	s.outboxWake = make(chan struct{}, 1)
+
 	server := &http.Server{
 		Addr:         fmt.Sprintf(":%d", s.Port),
 		Handler:      s.RegisterRoutes(),
@@ -58,6 +148,14 @@ func NewServer(db Database) *http.Server {
 		ReadTimeout:  10 * time.Second,
 		WriteTimeout: 30 * time.Second,
 	}
+	server.RegisterOnShutdown(stop)
+
+	if db != nil {
+		go s.runReminders(ctx)
+		go s.runWebhooks(ctx)
+		go s.runOutbox(ctx)
+		go s.runOutboxPrune(ctx)
+	}
 
 	log.Infof("Server listening on :%d", s.Port)
 
@@ -0,0 +1,437 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"backend/ent"
+	"backend/ent/entry"
+	"backend/ent/session"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// sessionDTO carries clinician notes only for the doctor who wrote them.
+type sessionDTO struct {
+	ID              string    `json:"id"`
+	PatientID       string    `json:"patientId"`
+	DoctorID        string    `json:"doctorId"`
+	HeldAt          time.Time `json:"heldAt"`
+	DurationMinutes *int      `json:"durationMinutes,omitempty"`
+	ClinicianNotes  *string   `json:"clinicianNotes,omitempty"`
+	PatientSummary  *string   `json:"patientSummary,omitempty"`
+	EntryIDs        []string  `json:"entryIds"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+type sessionsResponse struct {
+	Sessions []sessionDTO `json:"sessions"`
+}
+
+type sessionCreateRequest struct {
+	HeldAt          time.Time `json:"heldAt" validate:"required"`
+	DurationMinutes *int      `json:"durationMinutes,omitempty" validate:"omitempty,gte=1,lte=1440"`
+	ClinicianNotes  *string   `json:"clinicianNotes,omitempty"`
+	PatientSummary  *string   `json:"patientSummary,omitempty"`
+	EntryIDs        []string  `json:"entryIds,omitempty" validate:"dive,uuid"`
+}
+
+type sessionUpdateRequest struct {
+	HeldAt          *time.Time `json:"heldAt,omitempty"`
+	DurationMinutes *int       `json:"durationMinutes,omitempty" validate:"omitempty,gte=1,lte=1440"`
+	ClinicianNotes  *string    `json:"clinicianNotes,omitempty"`
+	PatientSummary  *string    `json:"patientSummary,omitempty"`
+	// EntryIDs replaces the linked entries when present.
+	EntryIDs *[]string `json:"entryIds,omitempty" validate:"omitempty,dive,uuid"`
+}
+
+// createSessionHandler records a session with a linked patient.
+// @Summary Record a session with a patient (doctor must have approved link)
+// @Tags Sessions
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Patient ID"
+// @Param request body SessionCreateRequest true "Session"
+// @Success 201 {object} SessionDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /patients/{id}/sessions [post]
+func (s *Server) createSessionHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	patientID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid patient id")
+		return
+	}
+
+	if ok := s.hasApprovedLink(r.Context(), doc.ID, patientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return
+	}
+
+	var req sessionCreateRequest
+	if !s.decodeJSON(w, r, &req) {
+		return
+	}
+
+	ctx := r.Context()
+	entryIDs, ok := s.patientEntryIDs(w, r, patientID, req.EntryIDs, "could not create session")
+	if !ok {
+		return
+	}
+
+	create := s.Db.Ent().Session.Create().
+		SetPatientID(patientID).
+		SetDoctorID(doc.ID).
+		SetHeldAt(req.HeldAt.UTC()).
+		SetNillableDurationMinutes(req.DurationMinutes).
+		AddEntryIDs(entryIDs...)
+	if notes := trimmedOrNil(req.ClinicianNotes); notes != nil {
+		create.SetClinicianNotes(*notes)
+	}
+	if summary := trimmedOrNil(req.PatientSummary); summary != nil {
+		create.SetPatientSummary(*summary)
+	}
+
+	sess, err := create.Save(ctx)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to create session", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not create session")
+		return
+	}
+
+	s.writeSession(w, r, http.StatusCreated, sess.ID, doc.ID, "could not create session")
+}
+
+// listPatientSessionsHandler lists the current doctor's sessions with a patient.
+// @Summary List sessions with a patient (doctor must have approved link)
+// @Tags Sessions
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Patient ID"
+// @Param from query string false "ISO timestamp (RFC3339) lower bound"
+// @Param to query string false "ISO timestamp (RFC3339) upper bound"
+// @Success 200 {object} SessionsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /patients/{id}/sessions [get]
+func (s *Server) listPatientSessionsHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	patientID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid patient id")
+		return
+	}
+
+	if ok := s.hasApprovedLink(r.Context(), doc.ID, patientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return
+	}
+
+	from, to, err := parseTimeRange(r)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid time range")
+		return
+	}
+
+	sessions, err := s.querySessions(r.Context(), patientID, &doc.ID, from, to)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to list sessions", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not list sessions")
+		return
+	}
+
+	resp := sessionsResponse{Sessions: make([]sessionDTO, 0, len(sessions))}
+	for _, sess := range sessions {
+		resp.Sessions = append(resp.Sessions, mapSessionDTO(sess, doc.ID))
+	}
+	s.writeJSON(w, http.StatusOK, resp)
+}
+
+// getSessionHandler returns one of the current doctor's sessions.
+// @Summary Get a session (doctor must have approved link)
+// @Tags Sessions
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Session ID"
+// @Success 200 {object} SessionDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /sessions/{id} [get]
+func (s *Server) getSessionHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	sessionID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid session id")
+		return
+	}
+
+	if _, ok := s.loadDoctorSession(w, r, doc.ID, sessionID, "could not load session"); !ok {
+		return
+	}
+	s.writeSession(w, r, http.StatusOK, sessionID, doc.ID, "could not load session")
+}
+
+// updateSessionHandler edits one of the current doctor's sessions.
+// @Summary Update a session (doctor must have approved link)
+// @Tags Sessions
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Session ID"
+// @Param request body SessionUpdateRequest true "Changes"
+// @Success 200 {object} SessionDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /sessions/{id} [patch]
+func (s *Server) updateSessionHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	sessionID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid session id")
+		return
+	}
+
+	var req sessionUpdateRequest
+	if !s.decodeJSON(w, r, &req) {
+		return
+	}
+
+	sess, ok := s.loadDoctorSession(w, r, doc.ID, sessionID, "could not update session")
+	if !ok {
+		return
+	}
+
+	update := s.Db.Ent().Session.UpdateOneID(sessionID)
+	if req.HeldAt != nil {
+		if req.HeldAt.IsZero() {
+			s.writeError(w, http.StatusBadRequest, "heldAt must not be empty")
+			return
+		}
+		update.SetHeldAt(req.HeldAt.UTC())
+	}
+	if req.DurationMinutes != nil {
+		update.SetDurationMinutes(*req.DurationMinutes)
+	}
+	if req.ClinicianNotes != nil {
+		if notes := trimmedOrNil(req.ClinicianNotes); notes == nil {
+			update.ClearClinicianNotes()
+		} else {
+			update.SetClinicianNotes(*notes)
+		}
+	}
+	if req.PatientSummary != nil {
+		if summary := trimmedOrNil(req.PatientSummary); summary == nil {
+			update.ClearPatientSummary()
+		} else {
+			update.SetPatientSummary(*summary)
+		}
+	}
+	if req.EntryIDs != nil {
+		entryIDs, ok := s.patientEntryIDs(w, r, sess.PatientID, *req.EntryIDs, "could not update session")
+		if !ok {
+			return
+		}
+		update.ClearEntries().AddEntryIDs(entryIDs...)
+	}
+
+	if err := update.Exec(r.Context()); err != nil {
+		log.FromContext(r.Context()).Error("failed to update session", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not update session")
+		return
+	}
+
+	s.writeSession(w, r, http.StatusOK, sessionID, doc.ID, "could not update session")
+}
+
+// deleteSessionHandler removes one of the current doctor's sessions.
+// @Summary Delete a session (doctor must have approved link)
+// @Tags Sessions
+// @Security SessionCookie
+// @Param id path string true "Session ID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /sessions/{id} [delete]
+func (s *Server) deleteSessionHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	sessionID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid session id")
+		return
+	}
+
+	if _, ok := s.loadDoctorSession(w, r, doc.ID, sessionID, "could not delete session"); !ok {
+		return
+	}
+
+	if err := s.Db.Ent().Session.DeleteOneID(sessionID).Exec(r.Context()); err != nil {
+		log.FromContext(r.Context()).Error("failed to delete session", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not delete session")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// loadDoctorSession loads a session written by the doctor and checks the link
+// to its patient is still approved, writing the error response when not.
+func (s *Server) loadDoctorSession(w http.ResponseWriter, r *http.Request, doctorID, sessionID uuid.UUID, failure string) (*ent.Session, bool) {
+	sess, err := s.Db.Ent().Session.Query().
+		Where(session.IDEQ(sessionID), session.DoctorIDEQ(doctorID)).
+		Only(r.Context())
+	if ent.IsNotFound(err) {
+		s.writeError(w, http.StatusNotFound, "session not found")
+		return nil, false
+	} else if err != nil {
+		log.FromContext(r.Context()).Error("failed to load session", "err", err)
+		s.writeError(w, http.StatusInternalServerError, failure)
+		return nil, false
+	}
+
+	if ok := s.hasApprovedLink(r.Context(), doctorID, sess.PatientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return nil, false
+	}
+	return sess, true
+}
+
+// patientEntryIDs parses raw entry IDs and checks they all belong to the
+// patient, writing the error response when not.
+func (s *Server) patientEntryIDs(w http.ResponseWriter, r *http.Request, patientID uuid.UUID, raw []string, failure string) ([]uuid.UUID, bool) {
+	seen := make(map[uuid.UUID]struct{}, len(raw))
+	ids := make([]uuid.UUID, 0, len(raw))
+	for _, v := range raw {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			s.writeError(w, http.StatusBadRequest, "invalid entry id")
+			return nil, false
+		}
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return ids, true
+	}
+
+	count, err := s.Db.Ent().Entry.Query().
+		Where(entry.IDIn(ids...), entry.PatientIDEQ(patientID)).
+		Count(r.Context())
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to check entries", "err", err)
+		s.writeError(w, http.StatusInternalServerError, failure)
+		return nil, false
+	}
+	if count != len(ids) {
+		s.writeError(w, http.StatusBadRequest, "entryIds must reference the patient's entries")
+		return nil, false
+	}
+	return ids, true
+}
+
+func (s *Server) writeSession(w http.ResponseWriter, r *http.Request, status int, sessionID, viewerID uuid.UUID, failure string) {
+	sess, err := s.Db.Ent().Session.Query().
+		Where(session.IDEQ(sessionID)).
+		WithEntries(func(q *ent.EntryQuery) { q.Select(entry.FieldID) }).
+		Only(r.Context())
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to load session", "err", err)
+		s.writeError(w, http.StatusInternalServerError, failure)
+		return
+	}
+	s.writeJSON(w, status, mapSessionDTO(sess, viewerID))
+}
+
+// querySessions lists a patient's sessions newest first, optionally limited to
+// one doctor and a time range, with linked entry IDs loaded.
+func (s *Server) querySessions(ctx context.Context, patientID uuid.UUID, doctorID *uuid.UUID, from, to *time.Time) ([]*ent.Session, error) {
+	q := s.Db.Ent().Session.Query().
+		Where(session.PatientIDEQ(patientID)).
+		WithEntries(func(q *ent.EntryQuery) { q.Select(entry.FieldID) }).
+		Order(ent.Desc(session.FieldHeldAt))
+	if doctorID != nil {
+		q = q.Where(session.DoctorIDEQ(*doctorID))
+	}
+	if from != nil {
+		q = q.Where(session.HeldAtGTE(*from))
+	}
+	if to != nil {
+		q = q.Where(session.HeldAtLTE(*to))
+	}
+	return q.All(ctx)
+}
+
+// mapSessionDTO includes clinician notes only when viewerID wrote the session;
+// pass uuid.Nil for patients.
+func mapSessionDTO(sess *ent.Session, viewerID uuid.UUID) sessionDTO {
+	dto := sessionDTO{
+		ID:              sess.ID.String(),
+		PatientID:       sess.PatientID.String(),
+		DoctorID:        sess.DoctorID.String(),
+		HeldAt:          sess.HeldAt,
+		DurationMinutes: sess.DurationMinutes,
+		PatientSummary:  sess.PatientSummary,
+		EntryIDs:        make([]string, 0, len(sess.Edges.Entries)),
+		CreatedAt:       sess.CreatedAt,
+		UpdatedAt:       sess.UpdatedAt,
+	}
+	if viewerID != uuid.Nil && viewerID == sess.DoctorID {
+		dto.ClinicianNotes = sess.ClinicianNotes
+	}
+	for _, e := range sess.Edges.Entries {
+		dto.EntryIDs = append(dto.EntryIDs, e.ID.String())
+	}
+	return dto
+}
+
+func trimmedOrNil(v *string) *string {
+	if v == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*v)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
@@ -1,11 +1,11 @@
 package server
 
-//go:generate swag init --dir .,../../cmd/api --generalInfo ../../cmd/api/main.go --output ./docs --parseDependency --parseInternal
+//go:generate swag init --dir .,../../cmd/api --generalInfo swagger.go --output ./docs --parseDependency --parseInternal
 
 // @title Eloquia API
 // @version 0.1.0
-// @description Backend HTTP API for Eloquia.
-// @BasePath /
+// @description Backend HTTP API for Eloquia. API routes are served under /v1; the unversioned paths are deprecated aliases.
+// @BasePath /v1
 // @securityDefinitions.apikey SessionCookie
 // @in cookie
 // @name eloquia_session
@@ -35,13 +35,104 @@ type PatientsResponse = patientsListResponse
 
 type EntriesResponse = entriesResponse
 
+type RecentEntriesResponse = recentEntriesResponse
+
 type AnalyticsResponse = analyticsResponse
 
 type TrendPoint = trendPoint
 
-type ErrorResponse struct {
-	Error string `json:"error"`
-}
+type DashboardResponse = dashboardResponse
+
+type InsightsResponse = insightsResponse
+
+type EntrySearchResponse = entrySearchResponse
+
+type VocabularyResponse = vocabularyResponse
+
+type VocabularyTermDTO = vocabularyTermDTO
+
+type VocabularyTermCreateRequest = vocabularyTermCreateRequest
+
+type GoalDTO = goalDTO
+
+type GoalsResponse = goalsResponse
+
+type GoalCreateRequest = goalCreateRequest
+
+type GoalUpdateRequest = goalUpdateRequest
+
+type AssignmentDTO = assignmentDTO
+
+type AssignmentsResponse = assignmentsResponse
+
+type AssignmentOverviewResponse = assignmentOverviewResponse
+
+type AssignmentCreateRequest = assignmentCreateRequest
+
+type AssignmentUpdateRequest = assignmentUpdateRequest
+
+type AttachmentUploadRequest = attachmentUploadRequest
+
+type AttachmentUploadResponse = attachmentUploadResponse
+
+type ExerciseLogCreateRequest = exerciseLogCreateRequest
+
+type ExerciseLogResponse = exerciseLogResponse
+
+type SessionDTO = sessionDTO
+
+type SessionsResponse = sessionsResponse
+
+type SessionCreateRequest = sessionCreateRequest
+
+type SessionUpdateRequest = sessionUpdateRequest
+
+type TimelineResponse = timelineResponse
+
+type QuestionnaireDTO = questionnaireDTO
+
+type QuestionnairesResponse = questionnairesResponse
+
+type QuestionnaireCreateRequest = questionnaireCreateRequest
+
+type QuestionnaireAssignRequest = questionnaireAssignRequest
+
+type QuestionnaireAssignmentDTO = questionnaireAssignmentDTO
+
+type QuestionnaireAssignmentsResponse = questionnaireAssignmentsResponse
+
+type QuestionnaireAssignmentUpdateRequest = questionnaireAssignmentUpdateRequest
+
+type QuestionnaireSubmitRequest = questionnaireSubmitRequest
+
+type AssessmentsResponse = assessmentsResponse
+
+type ReminderScheduleDTO = reminderScheduleDTO
+
+type ReminderSchedulesResponse = reminderSchedulesResponse
+
+type ReminderCreateRequest = reminderCreateRequest
+
+type ReminderUpdateRequest = reminderUpdateRequest
+
+type ReminderDeliveriesResponse = reminderDeliveriesResponse
+
+type WebhookSubscriptionDTO = webhookSubscriptionDTO
+
+type WebhookSubscriptionsResponse = webhookSubscriptionsResponse
+
+type WebhookCreateRequest = webhookCreateRequest
+
+type WebhookUpdateRequest = webhookUpdateRequest
+
+type WebhookDeliveryDTO = webhookDeliveryDTO
+
+type WebhookDeliveriesResponse = webhookDeliveriesResponse
+
+// ErrorResponse is the application/problem+json body of every error.
+type ErrorResponse = problemDetails
+
+type ProblemTypeResponse = problemTypeResponse
 
 type StatusResponse struct {
 	Status string `json:"status"`
@@ -102,6 +102,7 @@ func initAnalyticsScenario(sc *godog.ScenarioContext, env *bddtest.Env) {
 	sc.Step(`^the API is running$`, f.apiIsRunning)
 	sc.Step(`^I register a doctor with email "([^"]+)" password "([^"]+)" displayName "([^"]+)"$`, f.registerDoctor)
 	sc.Step(`^patient "([^"]+)" has analytics entries:$`, f.patientHasEntries)
+	sc.Step(`^I register a patient with email "([^"]+)" password "([^"]+)" displayName "([^"]+)" and analytics entries:$`, f.registerPatientWithEntries)
 	sc.Step(`^I call GET "([^"]+)"$`, f.callGet)
 	sc.Step(`^the response status should be (\d+)$`, f.statusShouldBe)
 	sc.Step(`^the response JSON field "([^"]+)" should be "([^"]+)"$`, f.jsonFieldShouldBe)
@@ -163,6 +164,45 @@ func (f *analyticsFeature) patientHasEntries(name string, table *godog.Table) er
 		return err
 	}
 
+	if err := createAnalyticsEntries(ctx, f.env, p.ID, table); err != nil {
+		return err
+	}
+
+	f.patientID = p.ID
+	return nil
+}
+
+func (f *analyticsFeature) registerPatientWithEntries(email, password, displayName string, table *godog.Table) error {
+	if err := f.client.PostJSON("/patient/register", map[string]string{
+		"email":       email,
+		"password":    password,
+		"displayName": displayName,
+	}); err != nil {
+		return err
+	}
+	if err := f.client.RequireStatus(http.StatusCreated); err != nil {
+		return err
+	}
+	id, err := bddtest.ExtractField(f.client.LastBody, "patient.id")
+	if err != nil {
+		return err
+	}
+	patientID, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := createAnalyticsEntries(ctx, f.env, patientID, table); err != nil {
+		return err
+	}
+
+	f.patientID = patientID
+	return nil
+}
+
+func createAnalyticsEntries(ctx context.Context, env *bddtest.Env, patientID uuid.UUID, table *godog.Table) error {
 	for i, row := range table.Rows {
 		if i == 0 {
 			continue
@@ -185,8 +225,8 @@ func (f *analyticsFeature) patientHasEntries(name string, table *godog.Table) er
 			emoObjs = append(emoObjs, schema.Emotion{Name: e})
 		}
 
-		if _, err := f.env.DB.Ent().Entry.Create().
-			SetPatientID(p.ID).
+		if _, err := env.DB.Ent().Entry.Create().
+			SetPatientID(patientID).
 			SetHappenedAt(happenedAt).
 			SetStutterFrequency(sf).
 			SetEmotions(emoObjs).
@@ -197,7 +237,6 @@ func (f *analyticsFeature) patientHasEntries(name string, table *godog.Table) er
 		}
 	}
 
-	f.patientID = p.ID
 	return nil
 }
 
@@ -2,6 +2,7 @@ package tests
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -82,6 +83,33 @@ func initEntriesScenario(sc *godog.ScenarioContext, env *bddtest.Env) {
 
 		cctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
+		if _, err := env.DB.Ent().Goal.Delete().Exec(cctx); err != nil {
+			return ctx, err
+		}
+		if _, err := env.DB.Ent().Session.Delete().Exec(cctx); err != nil {
+			return ctx, err
+		}
+		if _, err := env.DB.Ent().ExerciseLog.Delete().Exec(cctx); err != nil {
+			return ctx, err
+		}
+		if _, err := env.DB.Ent().Assignment.Delete().Exec(cctx); err != nil {
+			return ctx, err
+		}
+		if _, err := env.DB.Ent().ReminderDelivery.Delete().Exec(cctx); err != nil {
+			return ctx, err
+		}
+		if _, err := env.DB.Ent().ReminderSchedule.Delete().Exec(cctx); err != nil {
+			return ctx, err
+		}
+		if _, err := env.DB.Ent().QuestionnaireResponse.Delete().Exec(cctx); err != nil {
+			return ctx, err
+		}
+		if _, err := env.DB.Ent().QuestionnaireAssignment.Delete().Exec(cctx); err != nil {
+			return ctx, err
+		}
+		if _, err := env.DB.Ent().QuestionnaireDefinition.Delete().Exec(cctx); err != nil {
+			return ctx, err
+		}
 		if _, err := env.DB.Ent().Doctor.Delete().Exec(cctx); err != nil {
 			return ctx, err
 		}
@@ -101,6 +129,7 @@ func initEntriesScenario(sc *godog.ScenarioContext, env *bddtest.Env) {
 	sc.Step(`^I register a doctor with email "([^"]+)" password "([^"]+)" displayName "([^"]+)"$`, f.registerDoctor)
 	sc.Step(`^patient "([^"]+)" has entries:$`, f.patientHasEntries)
 	sc.Step(`^I call GET "([^"]+)"$`, f.callGet)
+	sc.Step(`^I call POST "([^"]+)" with JSON:$`, f.callPostJSON)
 	sc.Step(`^the response status should be (\d+)$`, f.statusShouldBe)
 	sc.Step(`^the response JSON field "([^"]+)" should be "([^"]+)"$`, f.jsonFieldShouldBe)
 }
@@ -196,6 +225,13 @@ func (f *entriesFeature) callGet(path string) error {
 	return f.client.Get(path)
 }
 
+func (f *entriesFeature) callPostJSON(path string, body *godog.DocString) error {
+	if f.patientID != uuid.Nil {
+		path = strings.ReplaceAll(path, "{patOneID}", f.patientID.String())
+	}
+	return f.client.PostJSON(path, json.RawMessage(body.Content))
+}
+
 func (f *entriesFeature) statusShouldBe(code int) error {
 	return f.client.RequireStatus(code)
 }
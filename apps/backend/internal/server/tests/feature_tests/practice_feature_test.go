@@ -2,11 +2,13 @@ package tests
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -70,6 +72,7 @@ type practiceFeature struct {
 	client     *bddtest.Client
 	doctorID   string
 	practiceID string
+	webhookID  string
 }
 
 func initPracticeScenario(sc *godog.ScenarioContext, env *bddtest.Env) {
@@ -79,6 +82,7 @@ func initPracticeScenario(sc *godog.ScenarioContext, env *bddtest.Env) {
 		p.client = bddtest.NewClient(env.BaseURL)
 		p.doctorID = ""
 		p.practiceID = ""
+		p.webhookID = ""
 
 		cctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
@@ -94,8 +98,11 @@ func initPracticeScenario(sc *godog.ScenarioContext, env *bddtest.Env) {
 	sc.Step(`^the API is running$`, p.apiIsRunning)
 	sc.Step(`^I register a doctor with email "([^"]+)" password "([^"]+)" displayName "([^"]+)"$`, p.registerDoctorArgs)
 	sc.Step(`^I create a practice:$`, p.createPractice)
+	sc.Step(`^I call GET "([^"]+)"$`, p.callGet)
+	sc.Step(`^I call POST "([^"]+)" with JSON:$`, p.callPostJSON)
 	sc.Step(`^the response status should be (\d+)$`, p.statusShouldBe)
 	sc.Step(`^the response JSON field "([^"]+)" should be "([^"]+)"$`, p.jsonFieldShouldBe)
+	sc.Step(`^the response JSON field "([^"]+)" should not be empty$`, p.jsonFieldNotEmpty)
 	sc.Step(`^the current doctor is assigned to the created practice$`, p.doctorAssignedToPractice)
 	sc.Step(`^the current doctor role is "([^"]+)"$`, p.doctorRoleIs)
 }
@@ -141,6 +148,33 @@ func (p *practiceFeature) createPractice(table *godog.Table) error {
 	return nil
 }
 
+func (p *practiceFeature) callGet(path string) error {
+	return p.client.Get(strings.ReplaceAll(path, "{webhookID}", p.webhookID))
+}
+
+func (p *practiceFeature) callPostJSON(path string, body *godog.DocString) error {
+	if err := p.client.PostJSON(strings.ReplaceAll(path, "{webhookID}", p.webhookID), json.RawMessage(body.Content)); err != nil {
+		return err
+	}
+	if path == "/practice/webhooks" && p.client.LastResp != nil && p.client.LastResp.StatusCode == http.StatusCreated {
+		if id, err := bddtest.ExtractField(p.client.LastBody, "id"); err == nil {
+			p.webhookID = id
+		}
+	}
+	return nil
+}
+
+func (p *practiceFeature) jsonFieldNotEmpty(field string) error {
+	got, err := bddtest.ExtractField(p.client.LastBody, field)
+	if err != nil {
+		return err
+	}
+	if got == "" {
+		return fmt.Errorf("expected %s to be set", field)
+	}
+	return nil
+}
+
 func (p *practiceFeature) statusShouldBe(code int) error {
 	return p.client.RequireStatus(code)
 }
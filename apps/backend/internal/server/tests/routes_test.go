@@ -7,8 +7,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"backend/ent"
+	"backend/internal/auth"
+	"backend/internal/clientversion"
 	"backend/internal/server"
 )
 
@@ -105,3 +108,167 @@ func TestHealthHandler(t *testing.T) {
 		t.Fatalf("expected response body to be %v; got %v", expected, string(body))
 	}
 }
+
+func TestHealthIsServedAtRootAndV1(t *testing.T) {
+	handler := (&server.Server{}).RegisterRoutes()
+	for _, path := range []string{"/health", "/v1/health"} {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("GET %s: expected 200, got %d", path, w.Code)
+		}
+	}
+}
+
+func TestRequestIDIsPropagated(t *testing.T) {
+	h := (&server.Server{Db: &fakeDB{}}).RegisterRoutes()
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	req.Header.Set("X-Request-ID", "client-abc.123")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if got := w.Header().Get("X-Request-ID"); got != "client-abc.123" {
+		t.Fatalf("expected request ID to be echoed; got %q", got)
+	}
+}
+
+func TestRequestIDIsAssignedWhenMissingOrInvalid(t *testing.T) {
+	h := (&server.Server{Db: &fakeDB{}}).RegisterRoutes()
+
+	for _, incoming := range []string{"", "bad id\nwith=newline"} {
+		req := httptest.NewRequest(http.MethodGet, "/health", nil)
+		if incoming != "" {
+			req.Header.Set("X-Request-ID", incoming)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		got := w.Header().Get("X-Request-ID")
+		if got == "" || got == incoming {
+			t.Fatalf("expected a generated request ID for %q; got %q", incoming, got)
+		}
+	}
+}
+
+func TestUnknownRouteReturnsProblem(t *testing.T) {
+	h := (&server.Server{Db: &fakeDB{}}).RegisterRoutes()
+
+	req := httptest.NewRequest(http.MethodGet, "/nope", nil)
+	req.Header.Set("X-Request-ID", "req-1")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404; got %d", w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/problem+json" {
+		t.Fatalf("expected problem content type; got %q", ct)
+	}
+
+	var problem server.ErrorResponse
+	if err := json.NewDecoder(w.Body).Decode(&problem); err != nil {
+		t.Fatalf("decode problem: %v", err)
+	}
+	if problem.Code != "not_found" || problem.Type != "/problems/not_found" || problem.Status != http.StatusNotFound {
+		t.Fatalf("unexpected problem: %+v", problem)
+	}
+	if problem.RequestID != "req-1" {
+		t.Fatalf("expected request ID in problem; got %q", problem.RequestID)
+	}
+}
+
+func TestProblemTypeIsDocumented(t *testing.T) {
+	h := (&server.Server{Db: &fakeDB{}}).RegisterRoutes()
+
+	req := httptest.NewRequest(http.MethodGet, "/problems/rate_limited", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200; got %d", w.Code)
+	}
+	var problemType server.ProblemTypeResponse
+	if err := json.NewDecoder(w.Body).Decode(&problemType); err != nil {
+		t.Fatalf("decode problem type: %v", err)
+	}
+	if problemType.Status != http.StatusTooManyRequests {
+		t.Fatalf("expected status 429 for rate_limited; got %d", problemType.Status)
+	}
+}
+
+func newAPIServer(t *testing.T) *server.Server {
+	t.Helper()
+	authManager, err := auth.NewManager(auth.Config{SecretKey: []byte("0123456789abcdef0123456789abcdef"), SessionTTL: time.Hour})
+	if err != nil {
+		t.Fatalf("auth manager: %v", err)
+	}
+	return &server.Server{Db: &fakeDB{}, Auth: authManager}
+}
+
+func TestLegacyRoutesAreDeprecatedAliases(t *testing.T) {
+	s := newAPIServer(t)
+	s.LegacySunset = time.Date(2027, time.April, 30, 0, 0, 0, 0, time.UTC)
+	h := s.RegisterRoutes()
+
+	req := httptest.NewRequest(http.MethodGet, "/doctor/me", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code == http.StatusNotFound {
+		t.Fatal("expected the legacy path to stay routed")
+	}
+	if w.Header().Get("Deprecation") == "" {
+		t.Fatal("expected a Deprecation header on the legacy path")
+	}
+	if got := w.Header().Get("Sunset"); got != "Fri, 30 Apr 2027 00:00:00 GMT" {
+		t.Fatalf("unexpected Sunset header %q", got)
+	}
+	if got := w.Header().Get("Link"); got != `</v1/doctor/me>; rel="successor-version"` {
+		t.Fatalf("unexpected Link header %q", got)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/doctor/me", nil)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code == http.StatusNotFound {
+		t.Fatal("expected /v1 to be routed")
+	}
+	if w.Header().Get("Deprecation") != "" {
+		t.Fatal("expected no Deprecation header under /v1")
+	}
+}
+
+func TestAppVersionUpgrade(t *testing.T) {
+	s := newAPIServer(t)
+	s.ClientVersions = clientversion.Policy{Minimum: "v1.2.0", Latest: "v1.5.0"}
+	h := s.RegisterRoutes()
+
+	cases := []struct {
+		version string
+		status  int
+		upgrade string
+	}{
+		{"1.1.0", http.StatusUpgradeRequired, "required"},
+		{"1.3.0", http.StatusInternalServerError, "available"},
+		{"1.5.0", http.StatusInternalServerError, ""},
+		{"", http.StatusInternalServerError, ""},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, "/v1/doctor/me", nil)
+		if tc.version != "" {
+			req.Header.Set(clientversion.Header, tc.version)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		// Past the version check, the fake database makes auth fail with 500.
+		if w.Code != tc.status {
+			t.Fatalf("version %q: expected status %d; got %d", tc.version, tc.status, w.Code)
+		}
+		if got := w.Header().Get("X-App-Upgrade"); got != tc.upgrade {
+			t.Fatalf("version %q: expected X-App-Upgrade %q; got %q", tc.version, tc.upgrade, got)
+		}
+	}
+}
@@ -0,0 +1,341 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"backend/ent"
+	"backend/ent/analysisjob"
+	"backend/ent/comment"
+	"backend/ent/doctorpatientlink"
+	"backend/ent/entry"
+	"backend/ent/goal"
+	"backend/ent/session"
+	"backend/internal/pagination"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// timelineEventDTO is one item of the stream; Type says which payload is set.
+type timelineEventDTO struct {
+	ID          string               `json:"id"`
+	Type        string               `json:"type"`
+	At          time.Time            `json:"at"`
+	Entry       *entryDTO            `json:"entry,omitempty"`
+	Comment     *timelineCommentDTO  `json:"comment,omitempty"`
+	Session     *sessionDTO          `json:"session,omitempty"`
+	Goal        *timelineGoalDTO     `json:"goal,omitempty"`
+	Link        *linkDTO             `json:"link,omitempty"`
+	AnalysisJob *timelineAnalysisDTO `json:"analysisJob,omitempty"`
+}
+
+type timelineCommentDTO struct {
+	ID             string    `json:"id"`
+	EntryID        string    `json:"entryId"`
+	AuthorDoctorID string    `json:"authorDoctorId"`
+	Body           string    `json:"body"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+// timelineGoalDTO omits progress, which is costly to compute per event; fetch
+// the goals endpoint for it.
+type timelineGoalDTO struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	TargetCount int    `json:"targetCount"`
+	Period      string `json:"period"`
+	Status      string `json:"status"`
+}
+
+type timelineAnalysisDTO struct {
+	ID           string     `json:"id"`
+	Kind         string     `json:"kind"`
+	Status       string     `json:"status"`
+	EntryID      *string    `json:"entryId,omitempty"`
+	ErrorMessage *string    `json:"errorMessage,omitempty"`
+	FinishedAt   *time.Time `json:"finishedAt,omitempty"`
+}
+
+type timelineResponse struct {
+	Events     []timelineEventDTO `json:"events"`
+	NextCursor *string            `json:"nextCursor,omitempty"`
+}
+
+// patientTimelineHandler streams everything that happened around a linked patient.
+// @Summary Timeline of a patient's entries, comments, sessions, goals, link changes and analyses (doctor must have approved link)
+// @Description Link events are limited to the current doctor's link. Clinician notes are included only for sessions the current doctor recorded.
+// @Tags Sessions
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Patient ID"
+// @Param types query string false "Comma-separated event types to include (default all)"
+// @Param from query string false "ISO timestamp (RFC3339) lower bound"
+// @Param to query string false "ISO timestamp (RFC3339) upper bound"
+// @Param limit query int false "Page size (default 50, max 200)"
+// @Param cursor query string false "Opaque cursor from a previous page"
+// @Param sort query string false "-at (default) or at"
+// @Success 200 {object} TimelineResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /patients/{id}/timeline [get]
+func (s *Server) patientTimelineHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	patientID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid patient id")
+		return
+	}
+
+	if ok := s.hasApprovedLink(r.Context(), doc.ID, patientID); !ok {
+		s.writeError(w, http.StatusForbidden, "no approved link for patient")
+		return
+	}
+
+	s.serveTimeline(w, r, timelineFilter{PatientID: patientID, DoctorID: doc.ID, Types: doctorTimelineTypes})
+}
+
+// myTimelineHandler streams the current patient's entries, session summaries,
+// goals and link changes.
+// @Summary Timeline of the current patient's entries, session summaries, goals and link changes
+// @Tags Patient
+// @Produce json
+// @Security SessionCookie
+// @Param types query string false "Comma-separated event types to include (default all)"
+// @Param from query string false "ISO timestamp (RFC3339) lower bound"
+// @Param to query string false "ISO timestamp (RFC3339) upper bound"
+// @Param limit query int false "Page size (default 50, max 200)"
+// @Param cursor query string false "Opaque cursor from a previous page"
+// @Param sort query string false "-at (default) or at"
+// @Success 200 {object} TimelineResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /patient/timeline [get]
+func (s *Server) myTimelineHandler(w http.ResponseWriter, r *http.Request) {
+	p, ok := currentPatient(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	s.serveTimeline(w, r, timelineFilter{PatientID: p.ID, Types: patientTimelineTypes})
+}
+
+// serveTimeline narrows f.Types to the requested ones and writes one page.
+// f.DoctorID doubles as the viewer whose clinician notes may be shown.
+func (s *Server) serveTimeline(w http.ResponseWriter, r *http.Request, f timelineFilter) {
+	q := r.URL.Query()
+
+	page, err := pagination.Parse(q, timelinePageOptions)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	types, err := pagination.Filter(q, "types", f.Types)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "types must be a subset of "+strings.Join(f.Types, ", "))
+		return
+	}
+	if len(types) > 0 {
+		f.Types = types
+	}
+
+	if f.From, f.To, err = parseTimeRange(r); err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid time range")
+		return
+	}
+
+	refs, err := s.timelineEvents(r.Context(), f, page)
+	if err != nil {
+		s.writeDomainError(w, r, err, "could not load timeline")
+		return
+	}
+	refs, more := pagination.Trim(refs, page.Limit)
+
+	events, err := s.hydrateTimeline(r.Context(), refs, f.DoctorID)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to load timeline events", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not load timeline")
+		return
+	}
+
+	resp := timelineResponse{Events: events}
+	if more {
+		last := refs[len(refs)-1]
+		resp.NextCursor = page.Next(timelineCursorValue(last), last.ID)
+	}
+	s.writeJSON(w, http.StatusOK, resp)
+}
+
+// hydrateTimeline loads the rows behind refs with one query per table. Events
+// whose row disappeared between the two steps are dropped.
+func (s *Server) hydrateTimeline(ctx context.Context, refs []timelineEventRef, viewerID uuid.UUID) ([]timelineEventDTO, error) {
+	ids := map[string][]uuid.UUID{}
+	for _, ref := range refs {
+		table := timelineTable(ref.Type)
+		if !slices.Contains(ids[table], ref.ID) {
+			ids[table] = append(ids[table], ref.ID)
+		}
+	}
+
+	client := s.Db.Ent()
+	entries := map[uuid.UUID]*ent.Entry{}
+	if len(ids[timelineEntry]) > 0 {
+		rows, err := client.Entry.Query().Where(entry.IDIn(ids[timelineEntry]...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			entries[row.ID] = row
+		}
+	}
+	comments := map[uuid.UUID]*ent.Comment{}
+	if len(ids[timelineComment]) > 0 {
+		rows, err := client.Comment.Query().Where(comment.IDIn(ids[timelineComment]...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			comments[row.ID] = row
+		}
+	}
+	sessions := map[uuid.UUID]*ent.Session{}
+	if len(ids[timelineSession]) > 0 {
+		rows, err := client.Session.Query().
+			Where(session.IDIn(ids[timelineSession]...)).
+			WithEntries(func(q *ent.EntryQuery) { q.Select(entry.FieldID) }).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			sessions[row.ID] = row
+		}
+	}
+	goals := map[uuid.UUID]*ent.Goal{}
+	if len(ids["goal"]) > 0 {
+		rows, err := client.Goal.Query().Where(goal.IDIn(ids["goal"]...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			goals[row.ID] = row
+		}
+	}
+	links := map[uuid.UUID]*ent.DoctorPatientLink{}
+	if len(ids["link"]) > 0 {
+		rows, err := client.DoctorPatientLink.Query().Where(doctorpatientlink.IDIn(ids["link"]...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			links[row.ID] = row
+		}
+	}
+	jobs := map[uuid.UUID]*ent.AnalysisJob{}
+	if len(ids["analysis"]) > 0 {
+		rows, err := client.AnalysisJob.Query().Where(analysisjob.IDIn(ids["analysis"]...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			jobs[row.ID] = row
+		}
+	}
+
+	events := make([]timelineEventDTO, 0, len(refs))
+	for _, ref := range refs {
+		ev := timelineEventDTO{ID: ref.ID.String(), Type: ref.Type, At: ref.At}
+		switch timelineTable(ref.Type) {
+		case timelineEntry:
+			e, ok := entries[ref.ID]
+			if !ok {
+				continue
+			}
+			dto := mapEntryDTO(e)
+			ev.Entry = &dto
+		case timelineComment:
+			c, ok := comments[ref.ID]
+			if !ok {
+				continue
+			}
+			ev.Comment = &timelineCommentDTO{
+				ID:             c.ID.String(),
+				EntryID:        c.EntryID.String(),
+				AuthorDoctorID: c.AuthorDoctorID.String(),
+				Body:           c.Body,
+				CreatedAt:      c.CreatedAt,
+			}
+		case timelineSession:
+			sess, ok := sessions[ref.ID]
+			if !ok {
+				continue
+			}
+			dto := mapSessionDTO(sess, viewerID)
+			ev.Session = &dto
+		case "goal":
+			g, ok := goals[ref.ID]
+			if !ok {
+				continue
+			}
+			ev.Goal = &timelineGoalDTO{
+				ID:          g.ID.String(),
+				Title:       g.Title,
+				TargetCount: g.TargetCount,
+				Period:      g.Period.String(),
+				Status:      g.Status.String(),
+			}
+		case "link":
+			l, ok := links[ref.ID]
+			if !ok {
+				continue
+			}
+			dto := buildLinkDTO(l)
+			ev.Link = &dto
+		case "analysis":
+			j, ok := jobs[ref.ID]
+			if !ok {
+				continue
+			}
+			dto := timelineAnalysisDTO{
+				ID:           j.ID.String(),
+				Kind:         j.Kind,
+				Status:       j.Status.String(),
+				ErrorMessage: j.ErrorMessage,
+				FinishedAt:   j.FinishedAt,
+			}
+			if j.EntryID != nil {
+				entryID := j.EntryID.String()
+				dto.EntryID = &entryID
+			}
+			ev.AnalysisJob = &dto
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
+
+// timelineTable groups event types by the table their ID refers to.
+func timelineTable(eventType string) string {
+	switch {
+	case strings.HasPrefix(eventType, "goal_"):
+		return "goal"
+	case strings.HasPrefix(eventType, "link_"):
+		return "link"
+	case strings.HasPrefix(eventType, "analysis_"):
+		return "analysis"
+	default:
+		return eventType
+	}
+}
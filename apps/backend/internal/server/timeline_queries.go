@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend/internal/pagination"
+
+	"github.com/google/uuid"
+)
+
+// Timeline event types. Link and goal events are derived from the row's
+// timestamps, so a single row can produce several events.
+const (
+	timelineEntry             = "entry"
+	timelineComment           = "comment"
+	timelineSession           = "session"
+	timelineGoalCreated       = "goal_created"
+	timelineGoalCompleted     = "goal_completed"
+	timelineGoalCancelled     = "goal_cancelled"
+	timelineLinkRequested     = "link_requested"
+	timelineLinkApproved      = "link_approved"
+	timelineLinkDenied        = "link_denied"
+	timelineLinkRevoked       = "link_revoked"
+	timelineAnalysisCompleted = "analysis_completed"
+	timelineAnalysisFailed    = "analysis_failed"
+)
+
+// doctorTimelineTypes is everything a linked doctor sees.
+var doctorTimelineTypes = []string{
+	timelineEntry, timelineComment, timelineSession,
+	timelineGoalCreated, timelineGoalCompleted, timelineGoalCancelled,
+	timelineLinkRequested, timelineLinkApproved, timelineLinkDenied, timelineLinkRevoked,
+	timelineAnalysisCompleted, timelineAnalysisFailed,
+}
+
+// patientTimelineTypes leaves out clinician-facing comments and analysis jobs.
+var patientTimelineTypes = []string{
+	timelineEntry, timelineSession,
+	timelineGoalCreated, timelineGoalCompleted, timelineGoalCancelled,
+	timelineLinkRequested, timelineLinkApproved, timelineLinkDenied, timelineLinkRevoked,
+}
+
+var timelinePageOptions = pagination.Options{
+	DefaultLimit: 50,
+	MaxLimit:     200,
+	Sorts:        []string{"at"},
+	DefaultSort:  "-at",
+}
+
+// timelineEventsQuery lists event references for one patient. $2 restricts
+// link events to one doctor when not null; Denied and Revoked have no own
+// timestamp, so the link's updated_at stands in.
+const timelineEventsQuery = `
+SELECT 'entry' AS type, e.id, e.happened_at AS at FROM entries e WHERE e.patient_id = $1::uuid
+UNION ALL
+SELECT 'comment', c.id, c.created_at FROM comments c JOIN entries e ON e.id = c.entry_id WHERE e.patient_id = $1::uuid
+UNION ALL
+SELECT 'session', s.id, s.held_at FROM sessions s WHERE s.patient_id = $1::uuid
+UNION ALL
+SELECT 'goal_created', g.id, g.created_at FROM goals g WHERE g.patient_id = $1::uuid
+UNION ALL
+SELECT 'goal_' || lower(g.status), g.id, g.updated_at FROM goals g WHERE g.patient_id = $1::uuid AND g.status IN ('Completed', 'Cancelled')
+UNION ALL
+SELECT 'link_requested', l.id, l.requested_at FROM doctor_patient_links l
+	WHERE l.patient_id = $1::uuid AND ($2::uuid IS NULL OR l.doctor_id = $2::uuid)
+UNION ALL
+SELECT 'link_approved', l.id, l.approved_at FROM doctor_patient_links l
+	WHERE l.patient_id = $1::uuid AND ($2::uuid IS NULL OR l.doctor_id = $2::uuid) AND l.approved_at IS NOT NULL
+UNION ALL
+SELECT 'link_' || lower(l.status), l.id, l.updated_at FROM doctor_patient_links l
+	WHERE l.patient_id = $1::uuid AND ($2::uuid IS NULL OR l.doctor_id = $2::uuid) AND l.status IN ('Denied', 'Revoked')
+UNION ALL
+SELECT CASE j.status WHEN 'Done' THEN 'analysis_completed' ELSE 'analysis_failed' END, j.id, j.finished_at FROM analysis_jobs j
+	WHERE j.patient_id = $1::uuid AND j.status IN ('Done', 'Failed') AND j.finished_at IS NOT NULL`
+
+type timelineEventRef struct {
+	Type string
+	ID   uuid.UUID
+	At   time.Time
+}
+
+type timelineFilter struct {
+	PatientID uuid.UUID
+	// DoctorID limits link events to one doctor; uuid.Nil shows all links.
+	DoctorID uuid.UUID
+	Types    []string
+	From, To *time.Time
+}
+
+// timelineEvents returns at most page.Limit+1 event references ordered by time,
+// breaking ties by type and ID so cursors stay stable.
+func (s *Server) timelineEvents(ctx context.Context, f timelineFilter, page pagination.Params) ([]timelineEventRef, error) {
+	var doctorID any
+	if f.DoctorID != uuid.Nil {
+		doctorID = f.DoctorID.String()
+	}
+	args := []any{f.PatientID.String(), doctorID, f.Types, f.From, f.To}
+
+	dir, cmp := "ASC", ">"
+	if page.Desc {
+		dir, cmp = "DESC", "<"
+	}
+
+	cursorWhere := ""
+	if page.Cursor != nil {
+		at, typ, err := parseTimelineCursorValue(page.Cursor.Value)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, at, typ, page.Cursor.ID.String())
+		cursorWhere = fmt.Sprintf("AND (at, type, id) %s ($6::timestamptz, $7::text, $8::uuid)", cmp)
+	}
+	args = append(args, page.Limit+1)
+
+	sqlQuery := fmt.Sprintf(`
+SELECT type, id, at FROM (%s) events
+WHERE type = ANY($3::text[])
+	AND ($4::timestamptz IS NULL OR at >= $4::timestamptz)
+	AND ($5::timestamptz IS NULL OR at <= $5::timestamptz)
+	%s
+ORDER BY at %[3]s, type %[3]s, id %[3]s
+LIMIT $%[4]d`, timelineEventsQuery, cursorWhere, dir, len(args))
+
+	rows, err := s.Db.Ent().QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var refs []timelineEventRef
+	for rows.Next() {
+		var (
+			ref timelineEventRef
+			id  string
+		)
+		if err := rows.Scan(&ref.Type, &id, &ref.At); err != nil {
+			return nil, err
+		}
+		if ref.ID, err = uuid.Parse(id); err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+	return refs, rows.Err()
+}
+
+// timelineCursorValue encodes the time and type of the last event; the type is
+// needed because one row can yield several events at the same instant.
+func timelineCursorValue(ref timelineEventRef) string {
+	return ref.At.UTC().Format(time.RFC3339Nano) + "|" + ref.Type
+}
+
+func parseTimelineCursorValue(raw string) (time.Time, string, error) {
+	rawAt, typ, ok := strings.Cut(raw, "|")
+	if !ok || typ == "" {
+		return time.Time{}, "", pagination.ErrInvalidCursor
+	}
+	at, err := time.Parse(time.RFC3339Nano, rawAt)
+	if err != nil {
+		return time.Time{}, "", pagination.ErrInvalidCursor
+	}
+	return at, typ, nil
+}
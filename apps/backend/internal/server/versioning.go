@@ -0,0 +1,87 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"backend/internal/clientversion"
+	internal_errors "backend/internal/server/errors"
+
+	"github.com/charmbracelet/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	apiV1Prefix = "/v1"
+	// appUpgradeHeader tells apps below LATEST_APP_VERSION ("available") or
+	// MIN_APP_VERSION ("required") to offer an update.
+	appUpgradeHeader = "X-App-Upgrade"
+)
+
+// legacyDeprecatedAt is when the unversioned root paths were superseded by /v1.
+var legacyDeprecatedAt = time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+// legacyAPI marks the unversioned aliases of /v1 routes as deprecated
+// (RFC 9745), announces the sunset when one is configured (RFC 8594) and
+// links to the versioned path.
+func (s *Server) legacyAPI(next http.Handler) http.Handler {
+	deprecation := fmt.Sprintf("@%d", legacyDeprecatedAt.Unix())
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h := w.Header()
+		h.Set("Deprecation", deprecation)
+		if !s.LegacySunset.IsZero() {
+			h.Set("Sunset", s.LegacySunset.UTC().Format(http.TimeFormat))
+		}
+		h.Add("Link", fmt.Sprintf(`<%s%s>; rel="successor-version"`, apiV1Prefix, r.URL.Path))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// appVersion tracks the X-App-Version sent by the apps, tells outdated ones
+// to upgrade and refuses those below MIN_APP_VERSION with 426.
+func (s *Server) appVersion(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		version, status := s.ClientVersions.Check(r.Header.Get(clientversion.Header))
+		s.Metrics.AppRequest(s.ClientVersions.Label(version), status.String())
+
+		ctx := r.Context()
+		if version != "" {
+			trace.SpanFromContext(ctx).SetAttributes(attribute.String("app.version", version))
+			ctx = log.WithContext(ctx, log.FromContext(ctx).With("app_version", version))
+			r = r.WithContext(ctx)
+		}
+
+		switch status {
+		case clientversion.StatusOutdated:
+			w.Header().Set(appUpgradeHeader, "available")
+		case clientversion.StatusUnsupported:
+			w.Header().Set(appUpgradeHeader, "required")
+			s.writeProblem(w, http.StatusUpgradeRequired, internal_errors.CodeUpgradeRequired,
+				"this app version is no longer supported; please update to "+
+					strings.TrimPrefix(s.ClientVersions.Minimum, "v")+" or later", nil)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// parseLegacySunset reads LEGACY_API_SUNSET as a date (2027-04-30) or an
+// RFC 3339 timestamp. Unset means no sunset has been announced.
+func parseLegacySunset() (time.Time, error) {
+	raw := strings.TrimSpace(os.Getenv("LEGACY_API_SUNSET"))
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.DateOnly, raw); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("LEGACY_API_SUNSET must be a date or RFC 3339 timestamp, got %q", raw)
+	}
+	return t, nil
+}
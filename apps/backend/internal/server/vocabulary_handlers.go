@@ -0,0 +1,414 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	"backend/ent"
+	"backend/ent/doctor"
+	"backend/ent/doctorpatientlink"
+	"backend/ent/vocabularyterm"
+	"backend/internal/vocabulary"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type vocabularyTermDTO struct {
+	ID       string            `json:"id"`
+	Kind     string            `json:"kind"`
+	Key      string            `json:"key"`
+	Label    string            `json:"label"`
+	Labels   map[string]string `json:"labels"`
+	Synonyms []string          `json:"synonyms,omitempty"`
+	Scope    string            `json:"scope"`
+}
+
+type vocabularyResponse struct {
+	Locale string              `json:"locale"`
+	Terms  []vocabularyTermDTO `json:"terms"`
+}
+
+type vocabularyTermCreateRequest struct {
+	Kind     string            `json:"kind"`
+	Key      *string           `json:"key,omitempty"`
+	Labels   map[string]string `json:"labels"`
+	Synonyms []string          `json:"synonyms,omitempty"`
+	// Scope is "doctor" (default) or "practice"; practice terms require practice ownership.
+	Scope string `json:"scope,omitempty"`
+}
+
+// vocabularyOwners are the doctors and practices whose scoped terms apply.
+type vocabularyOwners struct {
+	DoctorIDs   []uuid.UUID
+	PracticeIDs []uuid.UUID
+}
+
+// doctorVocabularyHandler lists the vocabulary visible to the current doctor.
+// @Summary List emotions, triggers and techniques for the current doctor
+// @Tags Vocabulary
+// @Produce json
+// @Security SessionCookie
+// @Param kind query string false "Emotion, Trigger, or Technique"
+// @Param locale query string false "Label locale; defaults to Accept-Language, then en"
+// @Success 200 {object} VocabularyResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /vocabulary [get]
+func (s *Server) doctorVocabularyHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	owners := vocabularyOwners{DoctorIDs: []uuid.UUID{doc.ID}}
+	if doc.PracticeID != nil {
+		owners.PracticeIDs = []uuid.UUID{*doc.PracticeID}
+	}
+	s.serveVocabulary(w, r, owners)
+}
+
+// patientVocabularyHandler lists the vocabulary for the current patient, including
+// terms added by their linked doctors and practices.
+// @Summary List emotions, triggers and techniques for the current patient
+// @Tags Patient
+// @Produce json
+// @Security SessionCookie
+// @Param kind query string false "Emotion, Trigger, or Technique"
+// @Param locale query string false "Label locale; defaults to Accept-Language, then en"
+// @Success 200 {object} VocabularyResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Router /patient/vocabulary [get]
+func (s *Server) patientVocabularyHandler(w http.ResponseWriter, r *http.Request) {
+	p, ok := currentPatient(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	owners, err := s.patientVocabularyOwners(r.Context(), p.ID)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to resolve vocabulary owners", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not load vocabulary")
+		return
+	}
+	s.serveVocabulary(w, r, owners)
+}
+
+func (s *Server) serveVocabulary(w http.ResponseWriter, r *http.Request, owners vocabularyOwners) {
+	var kind *vocabularyterm.Kind
+	if raw := r.URL.Query().Get("kind"); raw != "" {
+		k, ok := parseVocabularyKind(raw)
+		if !ok {
+			s.writeError(w, http.StatusBadRequest, "kind must be Emotion, Trigger, or Technique")
+			return
+		}
+		kind = &k
+	}
+
+	terms, err := s.vocabularyTerms(r.Context(), owners, kind)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to load vocabulary", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not load vocabulary")
+		return
+	}
+
+	locale := requestLocale(r)
+	resp := vocabularyResponse{Locale: locale, Terms: make([]vocabularyTermDTO, 0, len(terms))}
+	for _, t := range terms {
+		resp.Terms = append(resp.Terms, buildVocabularyTermDTO(t, locale))
+	}
+	sort.SliceStable(resp.Terms, func(i, j int) bool {
+		if resp.Terms[i].Kind != resp.Terms[j].Kind {
+			return resp.Terms[i].Kind < resp.Terms[j].Kind
+		}
+		return strings.ToLower(resp.Terms[i].Label) < strings.ToLower(resp.Terms[j].Label)
+	})
+
+	s.writeJSON(w, http.StatusOK, resp)
+}
+
+// createVocabularyTermHandler adds a doctor- or practice-scoped term.
+// @Summary Extend the vocabulary for the doctor's or practice's patients
+// @Tags Vocabulary
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param request body VocabularyTermCreateRequest true "Term"
+// @Success 201 {object} VocabularyTermDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /vocabulary [post]
+func (s *Server) createVocabularyTermHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req vocabularyTermCreateRequest
+	if !s.decodeJSON(w, r, &req) {
+		return
+	}
+
+	kind, ok := parseVocabularyKind(req.Kind)
+	if !ok {
+		s.writeError(w, http.StatusBadRequest, "kind must be Emotion, Trigger, or Technique")
+		return
+	}
+
+	labels := make(map[string]string, len(req.Labels))
+	for locale, label := range req.Labels {
+		locale = strings.ToLower(strings.TrimSpace(locale))
+		label = strings.TrimSpace(label)
+		if locale != "" && label != "" {
+			labels[locale] = label
+		}
+	}
+	if len(labels) == 0 {
+		s.writeError(w, http.StatusBadRequest, "at least one label is required")
+		return
+	}
+
+	key := ""
+	if req.Key != nil {
+		key = vocabulary.Slug(*req.Key)
+	} else if label, ok := labels[vocabulary.DefaultLocale]; ok {
+		key = vocabulary.Slug(label)
+	} else {
+		for _, label := range labels {
+			key = vocabulary.Slug(label)
+			break
+		}
+	}
+	if key == "" || len(key) > 64 {
+		s.writeError(w, http.StatusBadRequest, "key must be 1-64 characters")
+		return
+	}
+
+	synonyms := make([]string, 0, len(req.Synonyms))
+	for _, syn := range req.Synonyms {
+		if syn = strings.TrimSpace(syn); syn != "" {
+			synonyms = append(synonyms, syn)
+		}
+	}
+
+	ctx := r.Context()
+	create := s.Db.Ent().VocabularyTerm.Create().
+		SetKind(kind).
+		SetKey(key).
+		SetLabels(labels).
+		SetSynonyms(synonyms)
+
+	scopeQuery := s.Db.Ent().VocabularyTerm.Query().
+		Where(vocabularyterm.KindEQ(kind), vocabularyterm.KeyEQ(key), vocabularyterm.Active(true))
+
+	switch req.Scope {
+	case "", vocabulary.ScopeDoctor.String():
+		create.SetDoctorID(doc.ID)
+		scopeQuery.Where(vocabularyterm.DoctorIDEQ(doc.ID))
+	case vocabulary.ScopePractice.String():
+		if doc.PracticeID == nil || doc.Role != doctor.RoleOwner {
+			s.writeError(w, http.StatusForbidden, "practice terms require practice ownership")
+			return
+		}
+		create.SetPracticeID(*doc.PracticeID)
+		scopeQuery.Where(vocabularyterm.PracticeIDEQ(*doc.PracticeID))
+	default:
+		s.writeError(w, http.StatusBadRequest, "scope must be doctor or practice")
+		return
+	}
+
+	exists, err := scopeQuery.Exist(ctx)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to check vocabulary term", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not create term")
+		return
+	}
+	if exists {
+		s.writeError(w, http.StatusConflict, "term already exists")
+		return
+	}
+
+	term, err := create.Save(ctx)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to create vocabulary term", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not create term")
+		return
+	}
+
+	s.writeJSON(w, http.StatusCreated, buildVocabularyTermDTO(term, requestLocale(r)))
+}
+
+// deleteVocabularyTermHandler retires a doctor- or practice-scoped term. Entries
+// keep the stored key; it simply stops being offered and matched.
+// @Summary Retire a doctor- or practice-scoped vocabulary term
+// @Tags Vocabulary
+// @Security SessionCookie
+// @Param id path string true "Term ID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /vocabulary/{id} [delete]
+func (s *Server) deleteVocabularyTermHandler(w http.ResponseWriter, r *http.Request) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid term id")
+		return
+	}
+
+	ctx := r.Context()
+	term, err := s.Db.Ent().VocabularyTerm.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		s.writeError(w, http.StatusNotFound, "term not found")
+		return
+	} else if err != nil {
+		log.FromContext(r.Context()).Error("failed to load vocabulary term", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not delete term")
+		return
+	}
+
+	ownsDoctorTerm := term.DoctorID != nil && *term.DoctorID == doc.ID
+	ownsPracticeTerm := term.PracticeID != nil && doc.PracticeID != nil &&
+		*term.PracticeID == *doc.PracticeID && doc.Role == doctor.RoleOwner
+	if !ownsDoctorTerm && !ownsPracticeTerm {
+		s.writeError(w, http.StatusForbidden, "term is not editable")
+		return
+	}
+
+	if err := s.Db.Ent().VocabularyTerm.UpdateOneID(id).SetActive(false).Exec(ctx); err != nil {
+		log.FromContext(r.Context()).Error("failed to retire vocabulary term", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not delete term")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// vocabularyTerms loads the active global terms plus those scoped to owners.
+func (s *Server) vocabularyTerms(ctx context.Context, owners vocabularyOwners, kind *vocabularyterm.Kind) ([]*ent.VocabularyTerm, error) {
+	scopes := vocabularyterm.And(vocabularyterm.DoctorIDIsNil(), vocabularyterm.PracticeIDIsNil())
+	if len(owners.DoctorIDs) > 0 {
+		scopes = vocabularyterm.Or(scopes, vocabularyterm.DoctorIDIn(owners.DoctorIDs...))
+	}
+	if len(owners.PracticeIDs) > 0 {
+		scopes = vocabularyterm.Or(scopes, vocabularyterm.PracticeIDIn(owners.PracticeIDs...))
+	}
+
+	q := s.Db.Ent().VocabularyTerm.Query().
+		Where(vocabularyterm.Active(true), scopes)
+	if kind != nil {
+		q = q.Where(vocabularyterm.KindEQ(*kind))
+	}
+	return q.All(ctx)
+}
+
+// patientVocabularyOwners returns the approved doctors of a patient and their practices.
+func (s *Server) patientVocabularyOwners(ctx context.Context, patientID uuid.UUID) (vocabularyOwners, error) {
+	doctors, err := s.Db.Ent().Doctor.Query().
+		Where(doctor.HasPatientLinksWith(
+			doctorpatientlink.PatientIDEQ(patientID),
+			doctorpatientlink.StatusEQ(doctorpatientlink.StatusApproved),
+		)).
+		All(ctx)
+	if err != nil {
+		return vocabularyOwners{}, err
+	}
+
+	var owners vocabularyOwners
+	seenPractice := map[uuid.UUID]bool{}
+	for _, d := range doctors {
+		owners.DoctorIDs = append(owners.DoctorIDs, d.ID)
+		if d.PracticeID != nil && !seenPractice[*d.PracticeID] {
+			seenPractice[*d.PracticeID] = true
+			owners.PracticeIDs = append(owners.PracticeIDs, *d.PracticeID)
+		}
+	}
+	return owners, nil
+}
+
+// patientNormalizer builds the normalizer used to canonicalise a patient's uploads.
+func (s *Server) patientNormalizer(ctx context.Context, patientID uuid.UUID) (*vocabulary.Normalizer, error) {
+	owners, err := s.patientVocabularyOwners(ctx, patientID)
+	if err != nil {
+		return nil, err
+	}
+	terms, err := s.vocabularyTerms(ctx, owners, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	mapped := make([]vocabulary.Term, 0, len(terms))
+	for _, t := range terms {
+		mapped = append(mapped, vocabularyTerm(t))
+	}
+	return vocabulary.NewNormalizer(mapped), nil
+}
+
+func vocabularyTerm(t *ent.VocabularyTerm) vocabulary.Term {
+	scope := vocabulary.ScopeGlobal
+	switch {
+	case t.DoctorID != nil:
+		scope = vocabulary.ScopeDoctor
+	case t.PracticeID != nil:
+		scope = vocabulary.ScopePractice
+	}
+	return vocabulary.Term{
+		Kind:     t.Kind.String(),
+		Key:      t.Key,
+		Labels:   t.Labels,
+		Synonyms: t.Synonyms,
+		Scope:    scope,
+	}
+}
+
+func buildVocabularyTermDTO(t *ent.VocabularyTerm, locale string) vocabularyTermDTO {
+	term := vocabularyTerm(t)
+	return vocabularyTermDTO{
+		ID:       t.ID.String(),
+		Kind:     t.Kind.String(),
+		Key:      t.Key,
+		Label:    term.Label(locale),
+		Labels:   t.Labels,
+		Synonyms: t.Synonyms,
+		Scope:    term.Scope.String(),
+	}
+}
+
+func parseVocabularyKind(raw string) (vocabularyterm.Kind, bool) {
+	for _, k := range []vocabularyterm.Kind{vocabularyterm.KindEmotion, vocabularyterm.KindTrigger, vocabularyterm.KindTechnique} {
+		if strings.EqualFold(strings.TrimSpace(raw), k.String()) {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// requestLocale prefers ?locale= and otherwise takes the first Accept-Language tag.
+func requestLocale(r *http.Request) string {
+	if locale := strings.TrimSpace(r.URL.Query().Get("locale")); locale != "" {
+		return strings.ToLower(locale)
+	}
+	header := r.Header.Get("Accept-Language")
+	first, _, _ := strings.Cut(header, ",")
+	tag, _, _ := strings.Cut(first, ";")
+	if tag = strings.TrimSpace(tag); tag != "" && tag != "*" {
+		return strings.ToLower(tag)
+	}
+	return vocabulary.DefaultLocale
+}
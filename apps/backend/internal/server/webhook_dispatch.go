@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"backend/ent"
+	"backend/ent/webhookdelivery"
+	"backend/ent/webhooksubscription"
+	"backend/internal/webhook"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+)
+
+const (
+	webhookTickInterval = 10 * time.Second
+	// webhookBatchSize caps the deliveries attempted per tick; the rest are
+	// picked up on the next one.
+	webhookBatchSize = 50
+	// webhookLease keeps other instances off a delivery while it is being
+	// sent. It must exceed the sender's timeout.
+	webhookLease = time.Minute
+)
+
+// queueWebhook queues eventType for every active subscription of the
+// doctor's practice that asked for it. eventID becomes the payload ID; a
+// unique index on (subscription, event) skips subscriptions that already hold
+// a delivery for it, so relaying the same event twice, even concurrently,
+// queues it once.
+func (s *Server) queueWebhook(ctx context.Context, eventID uuid.UUID, eventType string, doctorID uuid.UUID, data any) error {
+	doc, err := s.Db.Ent().Doctor.Get(ctx, doctorID)
+	if err != nil {
+		return err
+	}
+	if doc.PracticeID == nil {
+		return nil
+	}
+
+	subs, err := s.Db.Ent().WebhookSubscription.Query().
+		Where(
+			webhooksubscription.PracticeIDEQ(*doc.PracticeID),
+			webhooksubscription.Active(true),
+			func(sel *sql.Selector) {
+				sel.Where(sqljson.ValueContains(webhooksubscription.FieldEvents, eventType))
+			},
+		).
+		All(ctx)
+	if err != nil || len(subs) == 0 {
+		return err
+	}
+
+	payload, err := webhook.NewPayload(eventID, eventType, *doc.PracticeID, data)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	creates := make([]*ent.WebhookDeliveryCreate, 0, len(subs))
+	for _, sub := range subs {
+		creates = append(creates, s.Db.Ent().WebhookDelivery.Create().
+			SetSubscriptionID(sub.ID).
+			SetEventID(payload.ID).
+			SetEventType(eventType).
+			SetPayload(body).
+			SetNextAttemptAt(now))
+	}
+	return s.Db.Ent().WebhookDelivery.CreateBulk(creates...).
+		OnConflict(
+			sql.ConflictColumns(webhookdelivery.FieldSubscriptionID, webhookdelivery.FieldEventID),
+			sql.ConflictWhere(sql.IsNull(webhookdelivery.FieldRedeliveryOf)),
+		).
+		DoNothing().
+		Exec(ctx)
+}
+
+// runWebhooks sends due deliveries every tick until ctx is cancelled. Like
+// the reminder scheduler it is safe to run on several instances.
+func (s *Server) runWebhooks(ctx context.Context) {
+	ticker := time.NewTicker(webhookTickInterval)
+	defer ticker.Stop()
+
+	for {
+		runJob(ctx, "webhooks.dispatch", func(ctx context.Context) error {
+			return s.dispatchDueWebhooks(ctx, time.Now().UTC())
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatchDueWebhooks attempts every pending delivery whose next attempt is
+// due. Each subscription's deliveries are sent in order on their own
+// goroutine, so a slow receiver does not hold up the others.
+func (s *Server) dispatchDueWebhooks(ctx context.Context, now time.Time) error {
+	deliveries, err := s.Db.Ent().WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		WithSubscription().
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(webhookBatchSize).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	bySubscription := make(map[uuid.UUID][]*ent.WebhookDelivery)
+	for _, d := range deliveries {
+		bySubscription[d.SubscriptionID] = append(bySubscription[d.SubscriptionID], d)
+	}
+
+	var wg sync.WaitGroup
+	for _, queue := range bySubscription {
+		wg.Go(func() {
+			for _, d := range queue {
+				if ctx.Err() != nil {
+					return
+				}
+				if err := s.attemptWebhook(ctx, d); err != nil {
+					log.FromContext(ctx).Error("failed to attempt webhook", "delivery_id", d.ID, "err", err)
+				}
+			}
+		})
+	}
+	wg.Wait()
+	return nil
+}
+
+// attemptWebhook claims a delivery by leasing its next attempt, sends it and
+// records the outcome. Failures are retried with backoff until
+// webhook.MaxAttempts is reached. The lease and the backoff start from the
+// moment they are written, as earlier sends in the tick may have taken a while.
+func (s *Server) attemptWebhook(ctx context.Context, d *ent.WebhookDelivery) error {
+	claimed, err := s.Db.Ent().WebhookDelivery.Update().
+		Where(
+			webhookdelivery.IDEQ(d.ID),
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtEQ(*d.NextAttemptAt),
+		).
+		SetNextAttemptAt(time.Now().UTC().Add(webhookLease)).
+		Save(ctx)
+	if err != nil || claimed == 0 {
+		return err
+	}
+
+	sub := d.Edges.Subscription
+	update := s.Db.Ent().WebhookDelivery.UpdateOneID(d.ID)
+	if !sub.Active {
+		return update.
+			SetStatus(webhookdelivery.StatusFailed).
+			ClearNextAttemptAt().
+			SetLastError("subscription is inactive").
+			Exec(ctx)
+	}
+
+	status, sendErr := s.webhookSender().Send(ctx, sub.URL, sub.Secret, d.ID, d.EventType, d.Payload)
+	if sendErr != nil && ctx.Err() != nil {
+		// Shutting down; the lease expires and another attempt follows.
+		return nil
+	}
+	if sendErr != nil {
+		log.FromContext(ctx).Warn("webhook delivery failed", "delivery_id", d.ID, "err", sendErr)
+	}
+	attempts := d.Attempts + 1
+	now := time.Now().UTC()
+	update.
+		SetAttempts(attempts).
+		SetLastAttemptAt(now)
+	if status != 0 {
+		update.SetResponseStatus(status)
+	} else {
+		update.ClearResponseStatus()
+	}
+
+	switch {
+	case sendErr == nil:
+		update.SetStatus(webhookdelivery.StatusSucceeded).ClearNextAttemptAt().ClearLastError()
+	case attempts >= webhook.MaxAttempts:
+		update.SetStatus(webhookdelivery.StatusFailed).ClearNextAttemptAt().SetLastError(webhook.ErrorClass(sendErr))
+	default:
+		update.SetNextAttemptAt(now.Add(webhook.Backoff(attempts))).SetLastError(webhook.ErrorClass(sendErr))
+	}
+	return update.Exec(ctx)
+}
+
+func (s *Server) webhookSender() *webhook.Sender {
+	if s.Webhooks != nil {
+		return s.Webhooks
+	}
+	return &webhook.Sender{}
+}
@@ -0,0 +1,575 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"backend/ent"
+	"backend/ent/doctor"
+	"backend/ent/predicate"
+	"backend/ent/webhookdelivery"
+	"backend/ent/webhooksubscription"
+	"backend/internal/pagination"
+	"backend/internal/validation"
+	"backend/internal/webhook"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/charmbracelet/log"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type webhookSubscriptionDTO struct {
+	ID          string   `json:"id"`
+	URL         string   `json:"url"`
+	Events      []string `json:"events"`
+	Description *string  `json:"description,omitempty"`
+	Active      bool     `json:"active"`
+	// Secret is only returned when the subscription is created or its secret
+	// rotated.
+	Secret    *string   `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type webhookSubscriptionsResponse struct {
+	Webhooks []webhookSubscriptionDTO `json:"webhooks"`
+}
+
+type webhookCreateRequest struct {
+	URL string `json:"url" validate:"notblank,max=2048"`
+	// Events are any of link.approved, entry.shared and patient.inactive.
+	Events      []string `json:"events" validate:"min=1"`
+	Description *string  `json:"description,omitempty" validate:"omitempty,max=200"`
+	Active      *bool    `json:"active,omitempty"`
+}
+
+func (req *webhookCreateRequest) normalize() {
+	req.URL = strings.TrimSpace(req.URL)
+	req.Description = trimmedOrNil(req.Description)
+}
+
+type webhookUpdateRequest struct {
+	URL          *string   `json:"url,omitempty" validate:"omitempty,notblank,max=2048"`
+	Events       *[]string `json:"events,omitempty" validate:"omitempty,min=1"`
+	Description  *string   `json:"description,omitempty" validate:"omitempty,max=200"`
+	Active       *bool     `json:"active,omitempty"`
+	RotateSecret bool      `json:"rotateSecret,omitempty"`
+}
+
+// normalize trims the URL and description; a blank description clears it.
+func (req *webhookUpdateRequest) normalize() {
+	if req.URL != nil {
+		url := strings.TrimSpace(*req.URL)
+		req.URL = &url
+	}
+	if req.Description != nil {
+		description := strings.TrimSpace(*req.Description)
+		req.Description = &description
+	}
+}
+
+type webhookDeliveryDTO struct {
+	ID             string          `json:"id"`
+	SubscriptionID string          `json:"subscriptionId"`
+	EventID        string          `json:"eventId"`
+	EventType      string          `json:"eventType"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"nextAttemptAt,omitempty"`
+	LastAttemptAt  *time.Time      `json:"lastAttemptAt,omitempty"`
+	ResponseStatus *int            `json:"responseStatus,omitempty"`
+	LastError      *string         `json:"lastError,omitempty"`
+	CreatedAt      time.Time       `json:"createdAt"`
+}
+
+type webhookDeliveriesResponse struct {
+	Deliveries []webhookDeliveryDTO `json:"deliveries"`
+	NextCursor *string              `json:"nextCursor,omitempty"`
+}
+
+var webhookDeliveriesPageOptions = pagination.Options{
+	DefaultLimit: 50,
+	MaxLimit:     200,
+	Sorts:        []string{"createdAt"},
+	DefaultSort:  "-createdAt",
+}
+
+// listWebhooksHandler lists the practice's webhook subscriptions.
+// @Summary List the practice's webhooks (practice owner only)
+// @Tags Webhooks
+// @Produce json
+// @Security SessionCookie
+// @Success 200 {object} WebhookSubscriptionsResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /practice/webhooks [get]
+func (s *Server) listWebhooksHandler(w http.ResponseWriter, r *http.Request) {
+	practiceID, ok := s.ownedPractice(w, r)
+	if !ok {
+		return
+	}
+
+	subs, err := s.Db.Ent().WebhookSubscription.Query().
+		Where(webhooksubscription.PracticeIDEQ(practiceID)).
+		Order(ent.Asc(webhooksubscription.FieldCreatedAt)).
+		All(r.Context())
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to list webhooks", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not list webhooks")
+		return
+	}
+
+	resp := webhookSubscriptionsResponse{Webhooks: make([]webhookSubscriptionDTO, 0, len(subs))}
+	for _, sub := range subs {
+		resp.Webhooks = append(resp.Webhooks, mapWebhookSubscriptionDTO(sub, false))
+	}
+	s.writeJSON(w, http.StatusOK, resp)
+}
+
+// createWebhookHandler subscribes an endpoint to practice events.
+// @Summary Create a webhook (practice owner only)
+// @Description Deliveries are POSTed as JSON with an X-Webhook-Signature header of the form "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>">" keyed with the secret, which is only returned here and on rotation. Failed deliveries are retried with exponential backoff.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param request body WebhookCreateRequest true "Webhook"
+// @Success 201 {object} WebhookSubscriptionDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /practice/webhooks [post]
+func (s *Server) createWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	practiceID, ok := s.ownedPractice(w, r)
+	if !ok {
+		return
+	}
+	doc, _ := currentDoctor(r.Context())
+
+	var req webhookCreateRequest
+	if !s.decodeJSON(w, r, &req) {
+		return
+	}
+
+	url := req.URL
+	if err := s.webhookSender().ValidateURL(url); err != nil {
+		s.writeValidationError(w, validation.Errors{{Field: "url", Message: err.Error()}})
+		return
+	}
+	eventTypes, ok := normalizeWebhookEvents(req.Events)
+	if !ok {
+		s.writeValidationError(w, webhookEventsError)
+		return
+	}
+	description := req.Description
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to generate webhook secret", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not create webhook")
+		return
+	}
+
+	sub, err := s.Db.Ent().WebhookSubscription.Create().
+		SetPracticeID(practiceID).
+		SetCreatedByDoctorID(doc.ID).
+		SetURL(url).
+		SetSecret(secret).
+		SetEvents(eventTypes).
+		SetNillableDescription(description).
+		SetActive(req.Active == nil || *req.Active).
+		Save(r.Context())
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to create webhook", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not create webhook")
+		return
+	}
+
+	s.writeJSON(w, http.StatusCreated, mapWebhookSubscriptionDTO(sub, true))
+}
+
+// getWebhookHandler returns one of the practice's webhooks.
+// @Summary Get a webhook (practice owner only)
+// @Tags Webhooks
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} WebhookSubscriptionDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /practice/webhooks/{id} [get]
+func (s *Server) getWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	sub, ok := s.loadPracticeWebhook(w, r, "could not load webhook")
+	if !ok {
+		return
+	}
+	s.writeJSON(w, http.StatusOK, mapWebhookSubscriptionDTO(sub, false))
+}
+
+// updateWebhookHandler changes a webhook, optionally rotating its secret.
+// @Summary Update a webhook (practice owner only)
+// @Description With rotateSecret the old secret stops working immediately and the new one is returned once.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Webhook ID"
+// @Param request body WebhookUpdateRequest true "Fields to change"
+// @Success 200 {object} WebhookSubscriptionDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /practice/webhooks/{id} [patch]
+func (s *Server) updateWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	sub, ok := s.loadPracticeWebhook(w, r, "could not update webhook")
+	if !ok {
+		return
+	}
+
+	var req webhookUpdateRequest
+	if !s.decodeJSON(w, r, &req) {
+		return
+	}
+
+	update := s.Db.Ent().WebhookSubscription.UpdateOneID(sub.ID)
+	if req.URL != nil {
+		if err := s.webhookSender().ValidateURL(*req.URL); err != nil {
+			s.writeValidationError(w, validation.Errors{{Field: "url", Message: err.Error()}})
+			return
+		}
+		update.SetURL(*req.URL)
+	}
+	if req.Events != nil {
+		eventTypes, ok := normalizeWebhookEvents(*req.Events)
+		if !ok {
+			s.writeValidationError(w, webhookEventsError)
+			return
+		}
+		update.SetEvents(eventTypes)
+	}
+	if req.Description != nil {
+		if description := trimmedOrNil(req.Description); description == nil {
+			update.ClearDescription()
+		} else {
+			update.SetDescription(*description)
+		}
+	}
+	if req.Active != nil {
+		update.SetActive(*req.Active)
+	}
+	if req.RotateSecret {
+		secret, err := webhook.NewSecret()
+		if err != nil {
+			log.FromContext(r.Context()).Error("failed to generate webhook secret", "err", err)
+			s.writeError(w, http.StatusInternalServerError, "could not update webhook")
+			return
+		}
+		update.SetSecret(secret)
+	}
+
+	updated, err := update.Save(r.Context())
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to update webhook", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not update webhook")
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, mapWebhookSubscriptionDTO(updated, req.RotateSecret))
+}
+
+// deleteWebhookHandler removes a webhook and its delivery log.
+// @Summary Delete a webhook (practice owner only)
+// @Tags Webhooks
+// @Security SessionCookie
+// @Param id path string true "Webhook ID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /practice/webhooks/{id} [delete]
+func (s *Server) deleteWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	sub, ok := s.loadPracticeWebhook(w, r, "could not delete webhook")
+	if !ok {
+		return
+	}
+
+	if err := s.Db.Ent().WebhookSubscription.DeleteOneID(sub.ID).Exec(r.Context()); err != nil {
+		log.FromContext(r.Context()).Error("failed to delete webhook", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not delete webhook")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// webhookDeliveriesHandler lists a webhook's delivery log.
+// @Summary Delivery log of a webhook (practice owner only)
+// @Tags Webhooks
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Webhook ID"
+// @Param status query string false "Pending, Succeeded or Failed"
+// @Param limit query int false "Page size (default 50, max 200)"
+// @Param cursor query string false "Opaque cursor from a previous page"
+// @Param sort query string false "-createdAt (default) or createdAt"
+// @Success 200 {object} WebhookDeliveriesResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /practice/webhooks/{id}/deliveries [get]
+func (s *Server) webhookDeliveriesHandler(w http.ResponseWriter, r *http.Request) {
+	sub, ok := s.loadPracticeWebhook(w, r, "could not list deliveries")
+	if !ok {
+		return
+	}
+
+	page, err := pagination.Parse(r.URL.Query(), webhookDeliveriesPageOptions)
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	q := s.Db.Ent().WebhookDelivery.Query().
+		Where(webhookdelivery.SubscriptionIDEQ(sub.ID))
+	if raw := r.URL.Query().Get("status"); raw != "" {
+		status := webhookdelivery.Status(raw)
+		if err := webhookdelivery.StatusValidator(status); err != nil {
+			s.writeError(w, http.StatusBadRequest, "status must be Pending, Succeeded or Failed")
+			return
+		}
+		q = q.Where(webhookdelivery.StatusEQ(status))
+	}
+	after, err := webhookDeliveriesAfter(page)
+	if err != nil {
+		s.writeDomainError(w, r, err, "could not list deliveries")
+		return
+	}
+	if after != nil {
+		q = q.Where(after)
+	}
+
+	dir := sql.OrderAsc()
+	if page.Desc {
+		dir = sql.OrderDesc()
+	}
+	deliveries, err := q.
+		Order(webhookdelivery.ByCreatedAt(dir), webhookdelivery.ByID(dir)).
+		Limit(page.Limit + 1).
+		All(r.Context())
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to list webhook deliveries", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not list deliveries")
+		return
+	}
+
+	deliveries, more := pagination.Trim(deliveries, page.Limit)
+	resp := webhookDeliveriesResponse{Deliveries: make([]webhookDeliveryDTO, 0, len(deliveries))}
+	for _, d := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, mapWebhookDeliveryDTO(d))
+	}
+	if more {
+		last := deliveries[len(deliveries)-1]
+		resp.NextCursor = page.Next(last.CreatedAt.UTC().Format(time.RFC3339Nano), last.ID)
+	}
+	s.writeJSON(w, http.StatusOK, resp)
+}
+
+// redeliverWebhookHandler queues a delivery again.
+// @Summary Redeliver a webhook event (practice owner only)
+// @Description Queues a new delivery with the original payload and event id, so receivers can deduplicate. The original delivery is left as it was.
+// @Tags Webhooks
+// @Produce json
+// @Security SessionCookie
+// @Param id path string true "Delivery ID"
+// @Success 202 {object} WebhookDeliveryDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Router /practice/webhooks/deliveries/{id}/redeliver [post]
+func (s *Server) redeliverWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	practiceID, ok := s.ownedPractice(w, r)
+	if !ok {
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid delivery id")
+		return
+	}
+
+	ctx := r.Context()
+	d, err := s.Db.Ent().WebhookDelivery.Query().
+		Where(
+			webhookdelivery.IDEQ(id),
+			webhookdelivery.HasSubscriptionWith(webhooksubscription.PracticeIDEQ(practiceID)),
+		).
+		WithSubscription().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		s.writeError(w, http.StatusNotFound, "delivery not found")
+		return
+	} else if err != nil {
+		log.FromContext(r.Context()).Error("failed to load webhook delivery", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not redeliver")
+		return
+	}
+	if !d.Edges.Subscription.Active {
+		s.writeError(w, http.StatusConflict, "webhook is inactive")
+		return
+	}
+
+	original := d.ID
+	if d.RedeliveryOf != nil {
+		original = *d.RedeliveryOf
+	}
+	redelivery, err := s.Db.Ent().WebhookDelivery.Create().
+		SetSubscriptionID(d.SubscriptionID).
+		SetRedeliveryOf(original).
+		SetEventID(d.EventID).
+		SetEventType(d.EventType).
+		SetPayload(d.Payload).
+		SetNextAttemptAt(time.Now().UTC()).
+		Save(ctx)
+	if err != nil {
+		log.FromContext(r.Context()).Error("failed to queue webhook redelivery", "err", err)
+		s.writeError(w, http.StatusInternalServerError, "could not redeliver")
+		return
+	}
+
+	s.writeJSON(w, http.StatusAccepted, mapWebhookDeliveryDTO(redelivery))
+}
+
+// ownedPractice returns the current doctor's practice, writing the error
+// response unless they own one.
+func (s *Server) ownedPractice(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	doc, ok := currentDoctor(r.Context())
+	if !ok {
+		s.writeError(w, http.StatusUnauthorized, "unauthorized")
+		return uuid.Nil, false
+	}
+	if doc.PracticeID == nil || doc.Role != doctor.RoleOwner {
+		s.writeError(w, http.StatusForbidden, "webhooks require practice ownership")
+		return uuid.Nil, false
+	}
+	return *doc.PracticeID, true
+}
+
+// loadPracticeWebhook loads the {id} webhook of the current owner's practice,
+// writing the error response when it cannot.
+func (s *Server) loadPracticeWebhook(w http.ResponseWriter, r *http.Request, failure string) (*ent.WebhookSubscription, bool) {
+	practiceID, ok := s.ownedPractice(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		s.writeError(w, http.StatusBadRequest, "invalid webhook id")
+		return nil, false
+	}
+
+	sub, err := s.Db.Ent().WebhookSubscription.Query().
+		Where(webhooksubscription.IDEQ(id), webhooksubscription.PracticeIDEQ(practiceID)).
+		Only(r.Context())
+	if ent.IsNotFound(err) {
+		s.writeError(w, http.StatusNotFound, "webhook not found")
+		return nil, false
+	} else if err != nil {
+		log.FromContext(r.Context()).Error("failed to load webhook", "err", err)
+		s.writeError(w, http.StatusInternalServerError, failure)
+		return nil, false
+	}
+	return sub, true
+}
+
+// webhookDeliveriesAfter returns the keyset predicate for the page after the
+// cursor, or nil on the first page.
+func webhookDeliveriesAfter(page pagination.Params) (predicate.WebhookDelivery, error) {
+	if page.Cursor == nil {
+		return nil, nil
+	}
+	at, err := time.Parse(time.RFC3339Nano, page.Cursor.Value)
+	if err != nil {
+		return nil, pagination.ErrInvalidCursor
+	}
+	if page.Desc {
+		return webhookdelivery.Or(
+			webhookdelivery.CreatedAtLT(at),
+			webhookdelivery.And(webhookdelivery.CreatedAtEQ(at), webhookdelivery.IDLT(page.Cursor.ID)),
+		), nil
+	}
+	return webhookdelivery.Or(
+		webhookdelivery.CreatedAtGT(at),
+		webhookdelivery.And(webhookdelivery.CreatedAtEQ(at), webhookdelivery.IDGT(page.Cursor.ID)),
+	), nil
+}
+
+// normalizeWebhookEvents trims, lowercases and deduplicates event types,
+// rejecting unknown ones and empty lists.
+var webhookEventsError = validation.Errors{{
+	Field:   "events",
+	Message: "must only contain " + strings.Join(webhook.Events, ", "),
+}}
+
+func normalizeWebhookEvents(raw []string) ([]string, bool) {
+	eventTypes := make([]string, 0, len(raw))
+	for _, e := range raw {
+		e = strings.ToLower(strings.TrimSpace(e))
+		if !webhook.ValidEvent(e) {
+			return nil, false
+		}
+		if !slices.Contains(eventTypes, e) {
+			eventTypes = append(eventTypes, e)
+		}
+	}
+	return eventTypes, len(eventTypes) > 0
+}
+
+func mapWebhookSubscriptionDTO(sub *ent.WebhookSubscription, withSecret bool) webhookSubscriptionDTO {
+	dto := webhookSubscriptionDTO{
+		ID:          sub.ID.String(),
+		URL:         sub.URL,
+		Events:      sub.Events,
+		Description: sub.Description,
+		Active:      sub.Active,
+		CreatedAt:   sub.CreatedAt,
+		UpdatedAt:   sub.UpdatedAt,
+	}
+	if dto.Events == nil {
+		dto.Events = []string{}
+	}
+	if withSecret {
+		secret := sub.Secret
+		dto.Secret = &secret
+	}
+	return dto
+}
+
+func mapWebhookDeliveryDTO(d *ent.WebhookDelivery) webhookDeliveryDTO {
+	return webhookDeliveryDTO{
+		ID:             d.ID.String(),
+		SubscriptionID: d.SubscriptionID.String(),
+		EventID:        d.EventID.String(),
+		EventType:      d.EventType,
+		Payload:        d.Payload,
+		Status:         d.Status.String(),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastAttemptAt:  d.LastAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt,
+	}
+}
@@ -0,0 +1,82 @@
+// Package storage hands out presigned URLs for an S3-compatible object store
+// (AWS S3, MinIO, ...). Clients upload and download directly; the API only
+// records object keys.
+package storage
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	envStorageEndpoint  = "STORAGE_ENDPOINT"
+	envStorageRegion    = "STORAGE_REGION"
+	envStorageBucket    = "STORAGE_BUCKET"
+	envStorageAccessKey = "STORAGE_ACCESS_KEY_ID"
+	envStorageSecretKey = "STORAGE_SECRET_ACCESS_KEY"
+	envStoragePathStyle = "STORAGE_PATH_STYLE"
+
+	defaultRegion = "us-east-1"
+)
+
+// ErrNotConfigured is returned by LoadConfig when no bucket is configured.
+var ErrNotConfigured = errors.New("object storage is not configured")
+
+// Store issues presigned URLs for objects in a single bucket.
+type Store interface {
+	PresignPut(ctx context.Context, key string, ttl time.Duration) (string, error)
+	PresignGet(ctx context.Context, key string, ttl time.Duration) (string, error)
+}
+
+// Config describes the bucket. PathStyle addresses objects as
+// endpoint/bucket/key, which MinIO needs; otherwise bucket.endpoint/key is used.
+type Config struct {
+	Endpoint  *url.URL
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	PathStyle bool
+}
+
+// LoadConfig reads STORAGE_* environment variables. It returns ErrNotConfigured
+// when STORAGE_BUCKET is unset so callers can run without attachments.
+func LoadConfig() (Config, error) {
+	bucket := strings.TrimSpace(os.Getenv(envStorageBucket))
+	if bucket == "" {
+		return Config{}, ErrNotConfigured
+	}
+
+	rawEndpoint := strings.TrimSpace(os.Getenv(envStorageEndpoint))
+	if rawEndpoint == "" {
+		rawEndpoint = "https://s3.amazonaws.com"
+	}
+	endpoint, err := url.Parse(rawEndpoint)
+	if err != nil || endpoint.Host == "" {
+		return Config{}, errors.New("STORAGE_ENDPOINT must be an absolute URL")
+	}
+
+	cfg := Config{
+		Endpoint:  endpoint,
+		Region:    strings.TrimSpace(os.Getenv(envStorageRegion)),
+		Bucket:    bucket,
+		AccessKey: strings.TrimSpace(os.Getenv(envStorageAccessKey)),
+		SecretKey: strings.TrimSpace(os.Getenv(envStorageSecretKey)),
+	}
+	if cfg.Region == "" {
+		cfg.Region = defaultRegion
+	}
+	switch strings.ToLower(strings.TrimSpace(os.Getenv(envStoragePathStyle))) {
+	case "1", "true", "yes", "on":
+		cfg.PathStyle = true
+	}
+
+	if cfg.AccessKey == "" || cfg.SecretKey == "" {
+		return Config{}, errors.New("STORAGE_ACCESS_KEY_ID and STORAGE_SECRET_ACCESS_KEY are required when STORAGE_BUCKET is set")
+	}
+	return cfg, nil
+}
@@ -0,0 +1,147 @@
+package tracing
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var errUnsupported = errors.New("tracing: operation not supported by the wrapped driver")
+
+// execQuerier matches the raw SQL methods Ent's sql/execquery feature calls
+// on its driver.
+type execQuerier interface {
+	ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error)
+}
+
+// Driver wraps an Ent driver so every statement runs in a span. Statements
+// are recorded without their arguments, which may hold patient data.
+func Driver(drv dialect.Driver) dialect.Driver {
+	return &driver{Driver: drv}
+}
+
+type driver struct {
+	dialect.Driver
+}
+
+func (d *driver) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := startQuery(ctx, "db.exec", d.Dialect(), query)
+	return finish(span, d.Driver.Exec(ctx, query, args, v))
+}
+
+func (d *driver) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := startQuery(ctx, "db.query", d.Dialect(), query)
+	return finish(span, d.Driver.Query(ctx, query, args, v))
+}
+
+func (d *driver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	eq, ok := d.Driver.(execQuerier)
+	if !ok {
+		return nil, errUnsupported
+	}
+	ctx, span := startQuery(ctx, "db.exec", d.Dialect(), query)
+	res, err := eq.ExecContext(ctx, query, args...)
+	return res, finish(span, err)
+}
+
+func (d *driver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	eq, ok := d.Driver.(execQuerier)
+	if !ok {
+		return nil, errUnsupported
+	}
+	ctx, span := startQuery(ctx, "db.query", d.Dialect(), query)
+	rows, err := eq.QueryContext(ctx, query, args...)
+	return rows, finish(span, err)
+}
+
+func (d *driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	ctx, span := Tracer().Start(ctx, "db.begin", trace.WithSpanKind(trace.SpanKindClient))
+	t, err := d.Driver.Tx(ctx)
+	if err = finish(span, err); err != nil {
+		return nil, err
+	}
+	return &tracedTx{Tx: t, dialect: d.Dialect()}, nil
+}
+
+func (d *driver) BeginTx(ctx context.Context, opts *stdsql.TxOptions) (dialect.Tx, error) {
+	b, ok := d.Driver.(interface {
+		BeginTx(context.Context, *stdsql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, errUnsupported
+	}
+	ctx, span := Tracer().Start(ctx, "db.begin", trace.WithSpanKind(trace.SpanKindClient))
+	t, err := b.BeginTx(ctx, opts)
+	if err = finish(span, err); err != nil {
+		return nil, err
+	}
+	return &tracedTx{Tx: t, dialect: d.Dialect()}, nil
+}
+
+type tracedTx struct {
+	dialect.Tx
+	dialect string
+}
+
+func (t *tracedTx) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := startQuery(ctx, "db.exec", t.dialect, query)
+	return finish(span, t.Tx.Exec(ctx, query, args, v))
+}
+
+func (t *tracedTx) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := startQuery(ctx, "db.query", t.dialect, query)
+	return finish(span, t.Tx.Query(ctx, query, args, v))
+}
+
+func (t *tracedTx) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	eq, ok := t.Tx.(execQuerier)
+	if !ok {
+		return nil, errUnsupported
+	}
+	ctx, span := startQuery(ctx, "db.exec", t.dialect, query)
+	res, err := eq.ExecContext(ctx, query, args...)
+	return res, finish(span, err)
+}
+
+func (t *tracedTx) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	eq, ok := t.Tx.(execQuerier)
+	if !ok {
+		return nil, errUnsupported
+	}
+	ctx, span := startQuery(ctx, "db.query", t.dialect, query)
+	rows, err := eq.QueryContext(ctx, query, args...)
+	return rows, finish(span, err)
+}
+
+func startQuery(ctx context.Context, name, dialectName, query string) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String(string(semconv.DBSystemNameKey), dbSystem(dialectName)),
+			semconv.DBQueryText(query),
+		),
+	)
+}
+
+func finish(span trace.Span, err error) error {
+	if err != nil && !errors.Is(err, stdsql.ErrNoRows) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+	return err
+}
+
+func dbSystem(dialectName string) string {
+	if dialectName == dialect.Postgres {
+		return semconv.DBSystemNamePostgreSQL.Value.AsString()
+	}
+	return dialectName
+}
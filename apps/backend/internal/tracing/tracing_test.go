@@ -0,0 +1,98 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type fakeDriver struct {
+	err error
+}
+
+func (d *fakeDriver) Exec(context.Context, string, any, any) error  { return d.err }
+func (d *fakeDriver) Query(context.Context, string, any, any) error { return d.err }
+func (d *fakeDriver) Tx(context.Context) (dialect.Tx, error)        { return fakeTx{d}, nil }
+func (d *fakeDriver) Close() error                                  { return nil }
+func (d *fakeDriver) Dialect() string                               { return dialect.Postgres }
+
+type fakeTx struct{ *fakeDriver }
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	rec := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	return rec
+}
+
+func TestDriverRecordsStatementsWithoutArgs(t *testing.T) {
+	rec := recordSpans(t)
+	drv := Driver(&fakeDriver{})
+
+	if err := drv.Query(context.Background(), "SELECT * FROM entries WHERE notes = $1", []any{"private"}, nil); err != nil {
+		t.Fatalf("query: %v", err)
+	}
+
+	spans := rec.Ended()
+	if len(spans) != 1 || spans[0].Name() != "db.query" {
+		t.Fatalf("expected one db.query span, got %v", spans)
+	}
+	for _, attr := range spans[0].Attributes() {
+		if attr.Value.AsString() == "private" {
+			t.Fatalf("span leaks query argument: %v", attr)
+		}
+	}
+}
+
+func TestDriverTracesTransactionsAndErrors(t *testing.T) {
+	rec := recordSpans(t)
+	drv := Driver(&fakeDriver{err: errors.New("boom")})
+
+	tx, err := drv.Tx(context.Background())
+	if err != nil {
+		t.Fatalf("tx: %v", err)
+	}
+	if err := tx.Exec(context.Background(), "UPDATE entries SET tags = $1", []any{}, nil); err == nil {
+		t.Fatalf("expected exec error")
+	}
+
+	spans := rec.Ended()
+	if len(spans) != 2 || spans[0].Name() != "db.begin" || spans[1].Name() != "db.exec" {
+		t.Fatalf("expected db.begin and db.exec spans, got %v", spans)
+	}
+	if spans[1].Status().Code != codes.Error {
+		t.Fatalf("expected error status, got %v", spans[1].Status())
+	}
+}
+
+func TestLogFields(t *testing.T) {
+	if fields := LogFields(context.Background()); fields != nil {
+		t.Fatalf("expected no fields outside a span, got %v", fields)
+	}
+
+	recordSpans(t)
+	ctx, span := Tracer().Start(context.Background(), "test")
+	defer span.End()
+	fields := LogFields(ctx)
+	if len(fields) != 4 || fields[1] != span.SpanContext().TraceID().String() {
+		t.Fatalf("unexpected fields %v", fields)
+	}
+}
+
+func TestSetupRejectsUnknownExporter(t *testing.T) {
+	t.Setenv("OTEL_TRACES_EXPORTER", "zipkin")
+	if _, err := Setup(context.Background()); err == nil {
+		t.Fatalf("expected an error")
+	}
+}
@@ -0,0 +1,157 @@
+// Package validation checks decoded request bodies against `validate` struct
+// tags and reports problems per field, named as they appear in the JSON.
+//
+// Besides the standard validator tags it understands "notblank": a string
+// that is not empty once surrounding whitespace is trimmed.
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldError describes one invalid field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Errors lists every invalid field of a value.
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	parts := make([]string, len(e))
+	for i, fe := range e {
+		parts[i] = fe.Field + " " + fe.Message
+	}
+	return strings.Join(parts, "; ")
+}
+
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return f.Name
+		}
+		return name
+	})
+	if err := v.RegisterValidation("notblank", func(fl validator.FieldLevel) bool {
+		return strings.TrimSpace(fl.Field().String()) != ""
+	}); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Struct validates v, returning nil when it is valid.
+func Struct(v any) Errors {
+	err := validate.Struct(v)
+	if err == nil {
+		return nil
+	}
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		// Only reachable for values that are not structs: a programming error.
+		panic(fmt.Sprintf("validation: %v", err))
+	}
+
+	root := reflect.TypeOf(v)
+	out := make(Errors, 0, len(verrs))
+	for _, fe := range verrs {
+		out = append(out, FieldError{Field: fieldPath(fe), Message: message(root, fe)})
+	}
+	return out
+}
+
+// fieldPath is the JSON path of the field without the root struct name,
+// e.g. "entries[2].id".
+func fieldPath(fe validator.FieldError) string {
+	_, path, ok := strings.Cut(fe.Namespace(), ".")
+	if !ok {
+		return fe.Field()
+	}
+	return path
+}
+
+func message(root reflect.Type, fe validator.FieldError) string {
+	param := fe.Param()
+	switch fe.Tag() {
+	case "required", "notblank":
+		return "is required"
+	case "email":
+		return "must be a valid email address"
+	case "number":
+		return "must contain only digits"
+	case "uuid", "uuid4":
+		return "must be a UUID"
+	case "url", "http_url":
+		return "must be a valid URL"
+	case "oneof":
+		return "must be one of: " + strings.Join(strings.Fields(param), ", ")
+	case "excluded_with":
+		return "must not be combined with " + siblingName(root, fe)
+	case "min", "gte":
+		return boundMessage(fe, "at least", param)
+	case "max", "lte":
+		return boundMessage(fe, "at most", param)
+	case "len":
+		return boundMessage(fe, "exactly", param)
+	case "unique":
+		return "must not contain duplicates"
+	default:
+		return "is invalid (" + fe.Tag() + ")"
+	}
+}
+
+// siblingName is the JSON name of the field a cross-field tag such as
+// excluded_with refers to by its Go name.
+func siblingName(root reflect.Type, fe validator.FieldError) string {
+	t := root
+	parts := strings.Split(fe.StructNamespace(), ".")
+	// Skip the root type name and the failing field itself.
+	for _, part := range parts[1 : len(parts)-1] {
+		part, _, _ = strings.Cut(part, "[")
+		t = elem(t)
+		f, ok := t.FieldByName(part)
+		if !ok {
+			return fe.Param()
+		}
+		t = f.Type
+	}
+	f, ok := elem(t).FieldByName(fe.Param())
+	if !ok {
+		return fe.Param()
+	}
+	if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name != "" && name != "-" {
+		return name
+	}
+	return f.Name
+}
+
+func elem(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	return t
+}
+
+func boundMessage(fe validator.FieldError, bound, param string) string {
+	switch fe.Kind() {
+	case reflect.String:
+		return fmt.Sprintf("must be %s %s characters long", bound, param)
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return fmt.Sprintf("must have %s %s items", bound, param)
+	default:
+		return fmt.Sprintf("must be %s %s", bound, param)
+	}
+}
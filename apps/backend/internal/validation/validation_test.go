@@ -0,0 +1,68 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+)
+
+type item struct {
+	ID string `json:"id" validate:"notblank"`
+}
+
+type sample struct {
+	Email   string   `json:"email" validate:"notblank,email"`
+	Name    *string  `json:"name,omitempty" validate:"omitempty,notblank,max=5"`
+	Kind    string   `json:"kind,omitempty" validate:"omitempty,oneof=Daily Weekly"`
+	Score   *int     `json:"score,omitempty" validate:"omitempty,gte=0,lte=10"`
+	Tags    []string `json:"tags,omitempty" validate:"max=2"`
+	Items   []item   `json:"items,omitempty" validate:"dive"`
+	RefID   *string  `json:"refId,omitempty"`
+	Ref     *item    `json:"ref,omitempty" validate:"excluded_with=RefID"`
+	Ignored string   `json:"-"`
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func TestStructValid(t *testing.T) {
+	if errs := Struct(&sample{Email: "a@b.co", Name: ptr("Ann"), Kind: "Daily", Score: ptr(0)}); errs != nil {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+}
+
+func TestStructReportsJSONFieldNames(t *testing.T) {
+	errs := Struct(&sample{
+		Email: "  ",
+		Name:  ptr(" "),
+		Kind:  "Hourly",
+		Score: ptr(11),
+		Tags:  []string{"a", "b", "c"},
+		Items: []item{{ID: "x"}, {ID: ""}},
+	})
+
+	want := Errors{
+		{Field: "email", Message: "is required"},
+		{Field: "name", Message: "is required"},
+		{Field: "kind", Message: "must be one of: Daily, Weekly"},
+		{Field: "score", Message: "must be at most 10"},
+		{Field: "tags", Message: "must have at most 2 items"},
+		{Field: "items[1].id", Message: "is required"},
+	}
+	if !reflect.DeepEqual(errs, want) {
+		t.Fatalf("got  %v\nwant %v", errs, want)
+	}
+}
+
+func TestStructNamesCrossFieldSiblings(t *testing.T) {
+	errs := Struct(&sample{Email: "a@b.co", RefID: ptr("1"), Ref: &item{ID: "1"}})
+	want := Errors{{Field: "ref", Message: "must not be combined with refId"}}
+	if !reflect.DeepEqual(errs, want) {
+		t.Fatalf("got  %v\nwant %v", errs, want)
+	}
+}
+
+func TestStructStringLength(t *testing.T) {
+	errs := Struct(&sample{Email: "a@b.co", Name: ptr("toolong")})
+	if len(errs) != 1 || errs[0].Message != "must be at most 5 characters long" {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+}
@@ -0,0 +1,154 @@
+// Package vocabulary maps free-form emotion, trigger and technique values onto
+// curated terms so that analytics count "Phone calls" and "phone call" together.
+package vocabulary
+
+import (
+	"strings"
+	"unicode"
+)
+
+const DefaultLocale = "en"
+
+// Scope orders terms by specificity; a doctor's term overrides a practice term,
+// which overrides a global one when both claim the same spelling.
+type Scope int
+
+const (
+	ScopeGlobal Scope = iota
+	ScopePractice
+	ScopeDoctor
+)
+
+func (s Scope) String() string {
+	switch s {
+	case ScopePractice:
+		return "practice"
+	case ScopeDoctor:
+		return "doctor"
+	default:
+		return "global"
+	}
+}
+
+// Term is one curated value. Key is what gets stored on entries.
+type Term struct {
+	Kind     string
+	Key      string
+	Labels   map[string]string
+	Synonyms []string
+	Scope    Scope
+}
+
+// Label returns the label for locale, falling back to its base language, the
+// default locale and finally the key.
+func (t Term) Label(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if l, ok := t.Labels[locale]; ok && l != "" {
+		return l
+	}
+	if base, _, ok := strings.Cut(locale, "-"); ok {
+		if l, ok := t.Labels[base]; ok && l != "" {
+			return l
+		}
+	}
+	if l, ok := t.Labels[DefaultLocale]; ok && l != "" {
+		return l
+	}
+	return t.Key
+}
+
+// Normalizer resolves spellings to term keys.
+type Normalizer struct {
+	byKind map[string]map[string]resolved
+}
+
+type resolved struct {
+	key   string
+	scope Scope
+}
+
+// NewNormalizer indexes the key, every label and every synonym of each term.
+func NewNormalizer(terms []Term) *Normalizer {
+	n := &Normalizer{byKind: map[string]map[string]resolved{}}
+	for _, t := range terms {
+		idx, ok := n.byKind[t.Kind]
+		if !ok {
+			idx = map[string]resolved{}
+			n.byKind[t.Kind] = idx
+		}
+
+		spellings := append([]string{t.Key}, t.Synonyms...)
+		for _, l := range t.Labels {
+			spellings = append(spellings, l)
+		}
+		for _, s := range spellings {
+			folded := Fold(s)
+			if folded == "" {
+				continue
+			}
+			if cur, exists := idx[folded]; exists && cur.scope > t.Scope {
+				continue
+			}
+			idx[folded] = resolved{key: t.Key, scope: t.Scope}
+		}
+	}
+	return n
+}
+
+// Normalize returns the term key for value, or the trimmed value itself when no
+// term matches so that unknown entries are kept rather than dropped.
+func (n *Normalizer) Normalize(kind, value string) string {
+	trimmed := strings.TrimSpace(value)
+	if n == nil {
+		return trimmed
+	}
+	if r, ok := n.byKind[kind][Fold(trimmed)]; ok {
+		return r.key
+	}
+	return trimmed
+}
+
+// NormalizeAll normalises values and drops blanks and duplicates, keeping order.
+func (n *Normalizer) NormalizeAll(kind string, values []string) []string {
+	if values == nil {
+		return nil
+	}
+	out := make([]string, 0, len(values))
+	seen := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		key := n.Normalize(kind, v)
+		if key == "" {
+			continue
+		}
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, key)
+	}
+	return out
+}
+
+// Fold lower-cases a spelling and collapses whitespace, hyphens and underscores
+// into single spaces.
+func Fold(s string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsSpace(r) || r == '-' || r == '_' {
+			space = b.Len() > 0
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Slug derives a key from a label, e.g. "Phone call" becomes "phone-call".
+func Slug(label string) string {
+	return strings.ReplaceAll(Fold(label), " ", "-")
+}
@@ -0,0 +1,62 @@
+package vocabulary
+
+import (
+	"slices"
+	"testing"
+)
+
+var testTerms = []Term{
+	{Kind: "Trigger", Key: "phone-call", Labels: map[string]string{"en": "Phone call", "de": "Telefonat"}, Synonyms: []string{"phone calls"}},
+	{Kind: "Technique", Key: "easy-onset", Labels: map[string]string{"en": "Easy onset"}},
+}
+
+func TestNormalize(t *testing.T) {
+	n := NewNormalizer(testTerms)
+
+	for _, in := range []string{"Phone calls", " phone call ", "PHONE-CALL", "telefonat", "phone_call"} {
+		if got := n.Normalize("Trigger", in); got != "phone-call" {
+			t.Fatalf("Normalize(%q) = %q, want phone-call", in, got)
+		}
+	}
+	if got := n.Normalize("Technique", "Phone call"); got != "Phone call" {
+		t.Fatalf("expected kinds to be separate, got %q", got)
+	}
+	if got := n.Normalize("Trigger", "  Elevator  "); got != "Elevator" {
+		t.Fatalf("expected unknown value to be kept trimmed, got %q", got)
+	}
+}
+
+func TestNormalizeAllDeduplicates(t *testing.T) {
+	n := NewNormalizer(testTerms)
+	got := n.NormalizeAll("Trigger", []string{"Phone calls", "phone call", "", "Elevator"})
+	if !slices.Equal(got, []string{"phone-call", "Elevator"}) {
+		t.Fatalf("unexpected result: %v", got)
+	}
+}
+
+func TestScopedTermOverridesGlobal(t *testing.T) {
+	terms := append(slices.Clone(testTerms), Term{
+		Kind: "Trigger", Key: "work-call", Labels: map[string]string{"en": "Work call"},
+		Synonyms: []string{"phone call"}, Scope: ScopeDoctor,
+	})
+	n := NewNormalizer(terms)
+	if got := n.Normalize("Trigger", "phone call"); got != "work-call" {
+		t.Fatalf("expected doctor term to win, got %q", got)
+	}
+	if got := n.Normalize("Trigger", "telefonat"); got != "phone-call" {
+		t.Fatalf("expected global term to remain for other spellings, got %q", got)
+	}
+}
+
+func TestLabelFallback(t *testing.T) {
+	term := testTerms[0]
+	if got := term.Label("de-AT"); got != "Telefonat" {
+		t.Fatalf("expected base language fallback, got %q", got)
+	}
+	if got := term.Label("fr"); got != "Phone call" {
+		t.Fatalf("expected default locale fallback, got %q", got)
+	}
+	if got := testTerms[1].Label(""); got != "Easy onset" {
+		t.Fatalf("unexpected label %q", got)
+	}
+}
@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrBlockedAddress is returned when a receiver resolves to an address
+// deliveries must not reach, such as loopback, private networks or cloud
+// metadata endpoints.
+var ErrBlockedAddress = errors.New("destination address is not allowed")
+
+// carrierNAT is the shared address space of RFC 6598, which netip does not
+// count as private.
+var carrierNAT = netip.MustParsePrefix("100.64.0.0/10")
+
+// AllowLocalFromEnv reads WEBHOOK_ALLOW_LOCALHOST, which lets development
+// setups deliver to receivers on localhost.
+func AllowLocalFromEnv() bool {
+	val := strings.ToLower(strings.TrimSpace(os.Getenv("WEBHOOK_ALLOW_LOCALHOST")))
+	return val == "1" || val == "true" || val == "yes" || val == "on"
+}
+
+// blockedAddr reports whether deliveries must not be sent to addr. Loopback
+// is let through only with allowLocal.
+func blockedAddr(addr netip.Addr, allowLocal bool) bool {
+	addr = addr.Unmap()
+	if addr.IsLoopback() {
+		return !allowLocal
+	}
+	return addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() ||
+		addr.IsUnspecified() ||
+		carrierNAT.Contains(addr)
+}
+
+// newClient returns a client that checks every dialled address after DNS
+// resolution, ignores proxy settings (the check would see the proxy) and
+// refuses redirects, which are reported as the receiver's 3xx status.
+func newClient(allowLocal bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			ap, err := netip.ParseAddrPort(address)
+			if err != nil || blockedAddr(ap.Addr(), allowLocal) {
+				return ErrBlockedAddress
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: sendTimeout,
+		Transport: &http.Transport{
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 5 * time.Second,
+			MaxIdleConnsPerHost: 2,
+			IdleConnTimeout:     time.Minute,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// ErrorClass describes a Send error without details of the network the
+// server sits in, so it can be shown to practices.
+func ErrorClass(err error) string {
+	var (
+		statusErr *StatusError
+		dnsErr    *net.DNSError
+		netErr    net.Error
+		certErr   *tls.CertificateVerificationError
+		recordErr tls.RecordHeaderError
+	)
+	switch {
+	case errors.As(err, &statusErr):
+		return statusErr.Error()
+	case errors.Is(err, ErrBlockedAddress):
+		return ErrBlockedAddress.Error()
+	case errors.As(err, &dnsErr):
+		return "receiver host could not be resolved"
+	case errors.As(err, &certErr), errors.As(err, &recordErr):
+		return "tls handshake failed"
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return "receiver timed out"
+	default:
+		return "could not connect to receiver"
+	}
+}
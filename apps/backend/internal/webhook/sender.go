@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Request headers set on every delivery.
+const (
+	HeaderSignature = "X-Webhook-Signature"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+
+	sendTimeout = 15 * time.Second
+	userAgent   = "Eloquia-Webhooks/1"
+)
+
+// Payload is the JSON body of a delivery. EventID stays the same across
+// retries and redeliveries so receivers can deduplicate.
+type Payload struct {
+	ID         uuid.UUID       `json:"id"`
+	Type       string          `json:"type"`
+	PracticeID uuid.UUID       `json:"practiceId"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// NewPayload builds the payload of event id with data marshalled into it.
+func NewPayload(id uuid.UUID, eventType string, practiceID uuid.UUID, data any) (Payload, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return Payload{}, err
+	}
+	return Payload{
+		ID:         id,
+		Type:       eventType,
+		PracticeID: practiceID,
+		CreatedAt:  time.Now().UTC(),
+		Data:       raw,
+	}, nil
+}
+
+// Sender posts signed deliveries. The zero value is usable and refuses
+// receivers on loopback, private and link-local addresses.
+type Sender struct {
+	// Client replaces the guarded default client, e.g. in tests.
+	Client *http.Client
+	// AllowLocal lets deliveries reach localhost; see ValidateURL.
+	AllowLocal bool
+	// Now is overridable in tests.
+	Now func() time.Time
+
+	once   sync.Once
+	client *http.Client
+}
+
+// ValidateURL checks a receiver URL under the sender's localhost setting.
+func (s *Sender) ValidateURL(raw string) error {
+	return ValidateURL(raw, s.AllowLocal)
+}
+
+// Send posts body to url, signed with secret. It returns the receiver's
+// status code when one was received; any non-2xx answer is a *StatusError.
+func (s *Sender) Send(ctx context.Context, url, secret string, deliveryID uuid.UUID, eventType string, body []byte) (int, error) {
+	now := time.Now
+	if s.Now != nil {
+		now = s.Now
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(HeaderEvent, eventType)
+	req.Header.Set(HeaderDelivery, deliveryID.String())
+	req.Header.Set(HeaderSignature, Sign(secret, now(), body))
+
+	client := s.Client
+	if client == nil {
+		s.once.Do(func() { s.client = newClient(s.AllowLocal) })
+		client = s.client
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// Drain a little so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, &StatusError{StatusCode: resp.StatusCode}
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,162 @@
+// Package webhook signs and sends practice webhook deliveries. Events are
+// queued per subscription and retried with exponential backoff; receivers
+// verify each request with the subscription's shared secret.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event types a practice can subscribe to.
+const (
+	EventLinkApproved    = "link.approved"
+	EventEntryShared     = "entry.shared"
+	EventPatientInactive = "patient.inactive"
+)
+
+// Events lists every subscribable event type.
+var Events = []string{EventLinkApproved, EventEntryShared, EventPatientInactive}
+
+const (
+	// MaxAttempts is how often a delivery is tried before it is marked failed.
+	MaxAttempts = 10
+
+	baseBackoff = 30 * time.Second
+	maxBackoff  = 6 * time.Hour
+
+	// SignatureTolerance is how old a signed timestamp Verify still accepts.
+	SignatureTolerance = 5 * time.Minute
+
+	secretPrefix = "whsec_"
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrExpiredSignature = errors.New("webhook signature timestamp outside tolerance")
+)
+
+// ValidEvent reports whether name is a subscribable event type.
+func ValidEvent(name string) bool {
+	return slices.Contains(Events, name)
+}
+
+// ValidateURL accepts absolute https URLs. With allowLocal, plain http to
+// localhost is accepted too so integrations can be tried out locally; it is
+// off unless WEBHOOK_ALLOW_LOCALHOST is set. Hosts that resolve to private
+// addresses are refused when the delivery is dialled.
+func ValidateURL(raw string, allowLocal bool) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return errors.New("url must be absolute")
+	}
+	host := u.Hostname()
+	local := host == "localhost" || host == "127.0.0.1" || host == "::1"
+	if local && !allowLocal {
+		return errors.New("url must not point at this server")
+	}
+	switch {
+	case u.Scheme == "https":
+		return nil
+	case u.Scheme == "http" && local:
+		return nil
+	default:
+		return errors.New("url must use https")
+	}
+}
+
+// NewSecret returns a random signing secret.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return secretPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Sign returns the signature header value for body sent at ts:
+// "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<unix seconds>.<body>">".
+func Sign(secret string, ts time.Time, body []byte) string {
+	unix := strconv.FormatInt(ts.Unix(), 10)
+	return "t=" + unix + ",v1=" + hex.EncodeToString(mac(secret, unix, body))
+}
+
+// Verify checks a signature header produced by Sign. Receivers can use it
+// as the reference implementation.
+func Verify(secret, header string, body []byte, now time.Time) error {
+	var unix string
+	var sigs [][]byte
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			unix = value
+		case "v1":
+			if sig, err := hex.DecodeString(value); err == nil {
+				sigs = append(sigs, sig)
+			}
+		}
+	}
+	secs, err := strconv.ParseInt(unix, 10, 64)
+	if err != nil || len(sigs) == 0 {
+		return ErrInvalidSignature
+	}
+	if d := now.Sub(time.Unix(secs, 0)); d > SignatureTolerance || d < -SignatureTolerance {
+		return ErrExpiredSignature
+	}
+
+	expected := mac(secret, unix, body)
+	for _, sig := range sigs {
+		if hmac.Equal(sig, expected) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+func mac(secret, unix string, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(unix))
+	h.Write([]byte("."))
+	h.Write(body)
+	return h.Sum(nil)
+}
+
+// Backoff is the wait before retrying after the given number of failed
+// attempts: 30s, 1m, 2m, ... capped at 6h.
+func Backoff(attempts int) time.Duration {
+	if attempts < 1 {
+		return 0
+	}
+	d := baseBackoff
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return d
+}
+
+// StatusError is returned by Send when the receiver answers with a non-2xx
+// status.
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("receiver answered %d", e.StatusCode)
+}
@@ -0,0 +1,139 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestSignVerifyRoundTrip(t *testing.T) {
+	body := []byte(`{"type":"link.approved"}`)
+	now := time.Unix(1_700_000_000, 0)
+	header := Sign("whsec_test", now, body)
+
+	if !strings.HasPrefix(header, "t=1700000000,v1=") {
+		t.Fatalf("unexpected header %q", header)
+	}
+	if err := Verify("whsec_test", header, body, now.Add(time.Minute)); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if err := Verify("whsec_other", header, body, now); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("expected invalid signature for wrong secret, got %v", err)
+	}
+	if err := Verify("whsec_test", header, []byte(`{}`), now); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("expected invalid signature for tampered body, got %v", err)
+	}
+	if err := Verify("whsec_test", header, body, now.Add(SignatureTolerance+time.Second)); !errors.Is(err, ErrExpiredSignature) {
+		t.Fatalf("expected expired signature, got %v", err)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cases := map[int]time.Duration{
+		0:  0,
+		1:  30 * time.Second,
+		2:  time.Minute,
+		5:  8 * time.Minute,
+		12: maxBackoff,
+	}
+	for attempts, want := range cases {
+		if got := Backoff(attempts); got != want {
+			t.Errorf("Backoff(%d) = %s, want %s", attempts, got, want)
+		}
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	for _, ok := range []string{"https://ehr.example.com/hooks"} {
+		if err := ValidateURL(ok, false); err != nil {
+			t.Errorf("ValidateURL(%q): %v", ok, err)
+		}
+	}
+	for _, bad := range []string{"", "/relative", "http://ehr.example.com/hooks", "ftp://example.com", "http://localhost:8080/hook", "https://127.0.0.1/hook"} {
+		if err := ValidateURL(bad, false); err == nil {
+			t.Errorf("ValidateURL(%q) should fail", bad)
+		}
+	}
+	if err := ValidateURL("http://localhost:8080/hook", true); err != nil {
+		t.Errorf("ValidateURL with allowLocal: %v", err)
+	}
+}
+
+func TestBlockedAddr(t *testing.T) {
+	for _, raw := range []string{"127.0.0.1", "::1", "10.0.0.5", "172.16.3.4", "192.168.1.1", "169.254.169.254", "fe80::1", "fd00::1", "100.64.0.1", "0.0.0.0", "::ffff:10.0.0.5"} {
+		if !blockedAddr(netip.MustParseAddr(raw), false) {
+			t.Errorf("expected %s to be blocked", raw)
+		}
+	}
+	for _, raw := range []string{"93.184.216.34", "2606:2800:220:1:248:1893:25c8:1946"} {
+		if blockedAddr(netip.MustParseAddr(raw), false) {
+			t.Errorf("expected %s to be allowed", raw)
+		}
+	}
+	if blockedAddr(netip.MustParseAddr("127.0.0.1"), true) {
+		t.Error("expected loopback to be allowed with allowLocal")
+	}
+	if !blockedAddr(netip.MustParseAddr("10.0.0.5"), true) {
+		t.Error("expected private addresses to stay blocked with allowLocal")
+	}
+}
+
+func TestSenderRefusesLoopbackAndRedirects(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://10.0.0.5/", http.StatusFound)
+	}))
+	defer srv.Close()
+
+	_, err := (&Sender{}).Send(context.Background(), srv.URL, "whsec_test", uuid.New(), EventEntryShared, nil)
+	if !errors.Is(err, ErrBlockedAddress) {
+		t.Fatalf("expected loopback to be refused, got %v", err)
+	}
+	if got := ErrorClass(err); got != ErrBlockedAddress.Error() {
+		t.Fatalf("ErrorClass = %q", got)
+	}
+
+	status, err := (&Sender{AllowLocal: true}).Send(context.Background(), srv.URL, "whsec_test", uuid.New(), EventEntryShared, nil)
+	if status != http.StatusFound || err == nil {
+		t.Fatalf("expected the redirect to be reported, got %d, %v", status, err)
+	}
+}
+
+func TestSenderSignsRequest(t *testing.T) {
+	deliveryID := uuid.New()
+	body := []byte(`{"hello":"world"}`)
+	now := time.Now()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ := io.ReadAll(r.Body)
+		if r.Header.Get(HeaderEvent) != EventEntryShared || r.Header.Get(HeaderDelivery) != deliveryID.String() {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if err := Verify("whsec_test", r.Header.Get(HeaderSignature), got, now); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	sender := &Sender{Client: srv.Client(), Now: func() time.Time { return now }}
+	status, err := sender.Send(context.Background(), srv.URL, "whsec_test", deliveryID, EventEntryShared, body)
+	if err != nil || status != http.StatusNoContent {
+		t.Fatalf("send: status %d, err %v", status, err)
+	}
+
+	status, err = sender.Send(context.Background(), srv.URL, "whsec_wrong", deliveryID, EventEntryShared, body)
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || status != http.StatusUnauthorized {
+		t.Fatalf("expected 401 status error, got %d, %v", status, err)
+	}
+}